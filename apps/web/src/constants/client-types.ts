@@ -35,6 +35,11 @@ export const CLIENT_TYPE_META: Record<string, ClientTypeMeta> = {
     label: 'Google Generative AI',
     hint: 'Gemini API',
   },
+  'ollama': {
+    value: 'ollama',
+    label: 'Ollama',
+    hint: 'Native Ollama API (model discovery)',
+  },
   'edge-speech': {
     value: 'edge-speech',
     label: 'Edge Speech',
@@ -112,8 +112,8 @@ export const providerPresets: ProviderPreset[] = [
   {
     id: 'ollama',
     name: 'Ollama',
-    clientType: 'openai-completions',
-    baseUrl: 'http://127.0.0.1:11434/v1',
+    clientType: 'ollama',
+    baseUrl: 'http://127.0.0.1:11434',
     icon: 'ollama',
     source: 'ollama.yaml',
     requiresApiKey: false,
@@ -6,7 +6,7 @@ export interface LoginCredentials {
   password: string
 }
 
-interface LoginResponseData {
+export interface LoginResponseData {
   access_token?: string
   user_id?: string
   username?: string
@@ -23,6 +23,17 @@ export interface SubmitLoginDependencies {
   notifyInvalidCredentials: () => void
 }
 
+export function toUserInfo(data: LoginResponseData & { user_id: string }): UserInfo {
+  return {
+    id: data.user_id,
+    username: data.username ?? '',
+    displayName: data.display_name ?? '',
+    role: data.role ?? '',
+    avatarUrl: data.avatar_url ?? '',
+    timezone: data.timezone ?? 'UTC',
+  }
+}
+
 export async function submitLogin(
   values: LoginCredentials,
   isSubmitting: Ref<boolean>,
@@ -46,14 +57,7 @@ export async function submitLogin(
       return false
     }
 
-    dependencies.applyLogin({
-      id: data.user_id,
-      username: data.username ?? '',
-      displayName: data.display_name ?? '',
-      role: data.role ?? '',
-      avatarUrl: data.avatar_url ?? '',
-      timezone: data.timezone ?? 'UTC',
-    }, data.access_token)
+    dependencies.applyLogin(toUserInfo({ ...data, user_id: data.user_id }), data.access_token)
 
     await dependencies.navigateHome()
     return true
@@ -0,0 +1,37 @@
+import { beforeEach, describe, expect, it } from 'vitest'
+import { consumeSSOState, rememberSSOState } from './sso'
+
+const storage = new Map<string, string>()
+
+Object.defineProperty(globalThis, 'sessionStorage', {
+  value: {
+    getItem: (key: string) => storage.get(key) ?? null,
+    setItem: (key: string, value: string) => storage.set(key, value),
+    removeItem: (key: string) => storage.delete(key),
+    clear: () => storage.clear(),
+  },
+  configurable: true,
+})
+
+describe('sso state', () => {
+  beforeEach(() => {
+    storage.clear()
+  })
+
+  it('accepts the state this tab started, once', () => {
+    rememberSSOState('abc')
+
+    expect(consumeSSOState('abc')).toBe(true)
+    expect(consumeSSOState('abc')).toBe(false)
+  })
+
+  it('rejects a state this tab did not start', () => {
+    rememberSSOState('abc')
+
+    expect(consumeSSOState('other')).toBe(false)
+  })
+
+  it('rejects any state when none was started', () => {
+    expect(consumeSSOState('abc')).toBe(false)
+  })
+})
@@ -0,0 +1,16 @@
+import { safeSessionGet, safeSessionRemove, safeSessionSet } from '@/utils/safe-storage'
+
+// The state returned by /auth/oidc/authorize is remembered in this tab so the
+// callback page only completes logins this browser started. Without it a
+// crafted callback link could sign the victim into someone else's account.
+const SSO_STATE_KEY = 'memoh:sso-state'
+
+export function rememberSSOState(state: string): boolean {
+  return safeSessionSet(SSO_STATE_KEY, state)
+}
+
+export function consumeSSOState(state: string): boolean {
+  const expected = safeSessionGet(SSO_STATE_KEY)
+  safeSessionRemove(SSO_STATE_KEY)
+  return !!expected && expected === state
+}
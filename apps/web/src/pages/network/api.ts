@@ -54,6 +54,32 @@ export interface NetworkBotStatus {
   proxy_address?: string
   details?: Record<string, unknown> | null
   workspace?: WorkspaceRuntimeStatus | null
+  egress?: EgressStatus | null
+}
+
+export interface EgressPolicy {
+  enabled: boolean
+  allow: string[]
+  deny: string[]
+  block_private_ranges: boolean
+}
+
+export interface EgressBlockedConnection {
+  time: string
+  source: 'workspace' | 'web_fetch'
+  host: string
+  port: number
+  address?: string
+  reason: string
+  rule?: string
+}
+
+export interface EgressStatus {
+  enabled: boolean
+  enforced: boolean
+  message?: string
+  blocked_total: number
+  blocked?: EgressBlockedConnection[]
 }
 
 export interface OverlayProviderMeta {
@@ -90,8 +116,8 @@ export interface NetworkNodeListResponse {
   message?: string
 }
 
-async function request<T>(method: 'GET' | 'POST', path: string, body?: unknown): Promise<T> {
-  const { data, response, error } = await client[method === 'GET' ? 'get' : 'post']({
+async function request<T>(method: 'GET' | 'POST' | 'PUT', path: string, body?: unknown): Promise<T> {
+  const { data, response, error } = await client[method === 'GET' ? 'get' : method === 'PUT' ? 'put' : 'post']({
     url: path,
     ...(body !== undefined ? { body: body as Record<string, unknown> } : {}),
   })
@@ -117,3 +143,11 @@ export function listBotNetworkNodes(botID: string) {
 export function executeBotNetworkAction(botID: string, actionID: string, body: Record<string, unknown>) {
   return request<OverlayProviderActionExecution>('POST', `/bots/${botID}/network/actions/${actionID}`, body)
 }
+
+export function getBotEgressPolicy(botID: string) {
+  return request<EgressPolicy>('GET', `/bots/${botID}/network/egress`)
+}
+
+export function updateBotEgressPolicy(botID: string, policy: EgressPolicy) {
+  return request<EgressPolicy>('PUT', `/bots/${botID}/network/egress`, policy)
+}
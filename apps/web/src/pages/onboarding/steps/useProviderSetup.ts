@@ -70,6 +70,8 @@ export function useProviderSetup(options: {
         return 'https://api.anthropic.com'
       case 'google-generative-ai':
         return 'https://generativelanguage.googleapis.com/v1beta'
+      case 'ollama':
+        return 'http://127.0.0.1:11434'
       default:
         return 'https://api.example.com/v1'
     }
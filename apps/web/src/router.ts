@@ -229,6 +229,11 @@ const routes = [
     path: '/oauth/mcp/callback',
     component: () => import('@/pages/oauth/mcp-callback.vue'),
   },
+  {
+    name: 'oauth-oidc-callback',
+    path: '/oauth/oidc/callback',
+    component: () => import('@/pages/oauth/oidc-callback.vue'),
+  },
 ]
 
 const router = createRouter({
@@ -19,6 +19,7 @@ import (
 	"go.uber.org/fx"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/memohai/memoh/internal/accesstoken"
 	"github.com/memohai/memoh/internal/accounts"
 	"github.com/memohai/memoh/internal/acl"
 	"github.com/memohai/memoh/internal/acpagent"
@@ -27,22 +28,28 @@ import (
 	"github.com/memohai/memoh/internal/agent/background"
 	agenttools "github.com/memohai/memoh/internal/agent/tools"
 	audiopkg "github.com/memohai/memoh/internal/audio"
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/boot"
 	"github.com/memohai/memoh/internal/botbackup"
 	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/bottemplate"
+	"github.com/memohai/memoh/internal/budget"
 	"github.com/memohai/memoh/internal/channel"
 	"github.com/memohai/memoh/internal/channel/adapters/dingtalk"
 	"github.com/memohai/memoh/internal/channel/adapters/discord"
 	"github.com/memohai/memoh/internal/channel/adapters/feishu"
+	"github.com/memohai/memoh/internal/channel/adapters/irc"
 	"github.com/memohai/memoh/internal/channel/adapters/local"
 	"github.com/memohai/memoh/internal/channel/adapters/matrix"
 	"github.com/memohai/memoh/internal/channel/adapters/misskey"
 	"github.com/memohai/memoh/internal/channel/adapters/qq"
 	slackadapter "github.com/memohai/memoh/internal/channel/adapters/slack"
 	"github.com/memohai/memoh/internal/channel/adapters/telegram"
+	"github.com/memohai/memoh/internal/channel/adapters/webhook"
 	"github.com/memohai/memoh/internal/channel/adapters/wechatoa"
 	"github.com/memohai/memoh/internal/channel/adapters/wecom"
 	"github.com/memohai/memoh/internal/channel/adapters/weixin"
+	"github.com/memohai/memoh/internal/channel/adapters/xmpp"
 	"github.com/memohai/memoh/internal/channel/identities"
 	"github.com/memohai/memoh/internal/channel/inbound"
 	"github.com/memohai/memoh/internal/channel/route"
@@ -57,6 +64,7 @@ import (
 	postgresstore "github.com/memohai/memoh/internal/db/postgres/store"
 	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
 	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/delegation"
 	emailpkg "github.com/memohai/memoh/internal/email"
 	emailgeneric "github.com/memohai/memoh/internal/email/adapters/generic"
 	emailgmail "github.com/memohai/memoh/internal/email/adapters/gmail"
@@ -82,8 +90,11 @@ import (
 	"github.com/memohai/memoh/internal/messaging"
 	"github.com/memohai/memoh/internal/models"
 	netctl "github.com/memohai/memoh/internal/network"
+	"github.com/memohai/memoh/internal/network/egress"
 	netoverlay "github.com/memohai/memoh/internal/network/overlay"
+	"github.com/memohai/memoh/internal/oidc"
 	pipelinepkg "github.com/memohai/memoh/internal/pipeline"
+	"github.com/memohai/memoh/internal/plugins"
 	"github.com/memohai/memoh/internal/policy"
 	"github.com/memohai/memoh/internal/providers"
 	"github.com/memohai/memoh/internal/registry"
@@ -92,12 +103,14 @@ import (
 	"github.com/memohai/memoh/internal/server"
 	sessionpkg "github.com/memohai/memoh/internal/session"
 	"github.com/memohai/memoh/internal/settings"
+	"github.com/memohai/memoh/internal/snapshotpolicy"
 	"github.com/memohai/memoh/internal/storage/providers/containerfs"
 	"github.com/memohai/memoh/internal/storage/providers/fallback"
 	"github.com/memohai/memoh/internal/storage/providers/localfs"
 	"github.com/memohai/memoh/internal/toolapproval"
 	"github.com/memohai/memoh/internal/userinput"
 	"github.com/memohai/memoh/internal/version"
+	"github.com/memohai/memoh/internal/workflow"
 	"github.com/memohai/memoh/internal/workspace"
 	"github.com/memohai/memoh/internal/workspace/bridge"
 )
@@ -189,8 +202,23 @@ func provideOverlayProviderRegistry(service ctr.Service, cfg config.Config, rc *
 	return registry
 }
 
-func provideNetworkService(log *slog.Logger, queries dbstore.Queries, registry *netctl.Registry, service ctr.Service, rc *boot.RuntimeConfig, cfg config.Config) *netctl.Service {
-	return netctl.NewService(log, queries, registry, service, rc.ContainerBackend, cfg.Workspace.CNIBinaryDir, cfg.Workspace.CNIConfigDir, cfg.Workspace.DataRoot)
+func provideNetworkService(lc fx.Lifecycle, log *slog.Logger, queries dbstore.Queries, registry *netctl.Registry, service ctr.Service, rc *boot.RuntimeConfig, cfg config.Config) *netctl.Service {
+	networkService := netctl.NewService(log, queries, registry, service, rc.ContainerBackend, cfg.Workspace.CNIBinaryDir, cfg.Workspace.CNIConfigDir, cfg.Workspace.DataRoot)
+	// The egress proxy is owned by the network service so it is listening
+	// before the workspace manager starts any workspace with a policy.
+	proxy := egress.NewProxy(log, networkService, egress.NewLog(egress.DefaultLogSize), []byte(cfg.Auth.JWTSecret))
+	networkService.SetEgressProxy(proxy, filepath.Join(cfg.Workspace.DataRoot, "run"))
+	if netctl.NewContainerRuntimeFromBackend(rc.ContainerBackend, service).Descriptor().Capabilities.EgressFirewall {
+		lc.Append(fx.Hook{
+			OnStart: func(_ context.Context) error {
+				return proxy.Start(cfg.Egress.ProxyAddrOrDefault())
+			},
+			OnStop: func(ctx context.Context) error {
+				return proxy.Close(ctx)
+			},
+		})
+	}
+	return networkService
 }
 
 func provideSQLiteStore(conn *sql.DB) (*sqlitestore.Store, error) {
@@ -260,7 +288,7 @@ func provideMemoryLLM(modelsService *models.Service, settingsService *settings.S
 	}
 }
 
-func provideMemoryProviderRegistry(log *slog.Logger, llm memprovider.LLM, chatService *conversation.Service, accountService *accounts.Service, provider bridge.Provider, queries dbstore.Queries, cfg config.Config) *memprovider.Registry {
+func provideMemoryProviderRegistry(log *slog.Logger, llm memprovider.LLM, chatService *conversation.Service, accountService *accounts.Service, provider bridge.Provider, queries dbstore.Queries, sqliteConn *sql.DB, cfg config.Config) *memprovider.Registry {
 	registry := memprovider.NewRegistry(log)
 	fileRuntime := handlers.NewBuiltinMemoryRuntime(provider)
 	fileStore := storefs.New(log, provider)
@@ -271,6 +299,7 @@ func provideMemoryProviderRegistry(log *slog.Logger, llm memprovider.LLM, chatSe
 		}
 		p := membuiltin.NewBuiltinProvider(log, runtime, chatService, accountService)
 		p.SetLLM(llm)
+		p.SetHistoryStore(fileStore)
 		p.ApplyProviderConfig(providerConfig)
 		return p, nil
 	})
@@ -280,8 +309,20 @@ func provideMemoryProviderRegistry(log *slog.Logger, llm memprovider.LLM, chatSe
 	registry.RegisterFactory(string(memprovider.ProviderOpenViking), func(_ string, providerConfig map[string]any) (memprovider.Provider, error) {
 		return memopenviking.NewOpenVikingProvider(log, providerConfig)
 	})
+	registry.RegisterFactory(string(memprovider.ProviderSQLite), func(_ string, providerConfig map[string]any) (memprovider.Provider, error) {
+		runtime, err := membuiltin.NewSQLiteRuntimeFromConfig(log, providerConfig, sqliteConn, fileStore, queries)
+		if err != nil {
+			return nil, err
+		}
+		p := membuiltin.NewBuiltinProvider(log, runtime, chatService, accountService)
+		p.SetLLM(llm)
+		p.SetHistoryStore(fileStore)
+		p.ApplyProviderConfig(providerConfig)
+		return p, nil
+	})
 	defaultProvider := membuiltin.NewBuiltinProvider(log, fileRuntime, chatService, accountService)
 	defaultProvider.SetLLM(llm)
+	defaultProvider.SetHistoryStore(fileStore)
 	registry.Register("__builtin_default__", defaultProvider)
 	return registry
 }
@@ -467,6 +508,9 @@ func provideChannelRegistry(log *slog.Logger, hub *local.RouteHub, mediaService
 	registry.MustRegister(weixinAdapter)
 	registry.MustRegister(local.NewWebAdapter(hub))
 	registry.MustRegister(misskey.NewMisskeyAdapter(log))
+	registry.MustRegister(irc.NewIRCAdapter(log))
+	registry.MustRegister(xmpp.NewXMPPAdapter(log))
+	registry.MustRegister(webhook.NewWebhookAdapter(log))
 
 	return registry
 }
@@ -527,6 +571,7 @@ func provideChannelRouter(
 	processor.SetSpeechService(audioService, &settingsSpeechModelResolver{settings: settingsService})
 	processor.SetTranscriptionService(&settingsTranscriptionAdapter{audio: audioService}, &settingsTranscriptionModelResolver{settings: settingsService})
 	processor.SetIMDisplayOptions(&settingsIMDisplayOptions{settings: settingsService})
+	processor.SetMessageObserver(&scheduleMessageObserver{schedules: scheduleService})
 	cmdHandler := command.NewHandler(
 		log,
 		&command.BotMemberRoleAdapter{BotService: botService},
@@ -569,11 +614,25 @@ func provideChannelLifecycleService(channelStore *channel.Store, channelManager
 	return channel.NewLifecycle(channelStore, channelManager)
 }
 
-func provideContainerdHandler(log *slog.Logger, manager *workspace.Manager, cfg config.Config, rc *boot.RuntimeConfig, botService *bots.Service, accountService *accounts.Service, policyService *policy.Service) *handlers.ContainerdHandler {
-	return handlers.NewContainerdHandler(log, manager, cfg.Workspace, rc.ContainerBackend, botService, accountService, policyService)
+func provideContainerdHandler(log *slog.Logger, manager *workspace.Manager, cfg config.Config, rc *boot.RuntimeConfig, botService *bots.Service, accountService *accounts.Service, policyService *policy.Service, snapshotPolicy *snapshotpolicy.Service) *handlers.ContainerdHandler {
+	h := handlers.NewContainerdHandler(log, manager, cfg.Workspace, rc.ContainerBackend, botService, accountService, policyService)
+	h.SetSkillInstallHook(snapshotPolicy)
+	return h
+}
+
+func provideSnapshotPolicyService(log *slog.Logger, queries dbstore.Queries, manager *workspace.Manager, auditService *audit.Service) *snapshotpolicy.Service {
+	service := snapshotpolicy.NewService(log, queries, manager)
+	service.SetAuditLog(auditService)
+	return service
+}
+
+func provideSupermarketHandler(log *slog.Logger, cfg config.Config, pluginService *plugins.Service, containers bridge.Provider, botService *bots.Service, accountService *accounts.Service, snapshotPolicy *snapshotpolicy.Service) *handlers.SupermarketHandler {
+	h := handlers.NewSupermarketHandler(log, cfg, pluginService, containers, botService, accountService)
+	h.SetSkillInstallHook(snapshotPolicy)
+	return h
 }
 
-func provideBotBackupService(log *slog.Logger, conn *pgxpool.Pool, queries dbstore.Queries, botService *bots.Service, settingsService *settings.Service, aclService *acl.Service, channelStore *channel.Store, mcpService *mcp.ConnectionService, scheduleService *schedule.Service, emailService *emailpkg.Service, providerService *providers.Service, modelsService *models.Service, searchProviderService *searchproviders.Service, memoryProviderService *memprovider.Service, manager *workspace.Manager) *botbackup.Service {
+func provideBotBackupService(log *slog.Logger, conn *pgxpool.Pool, queries dbstore.Queries, botService *bots.Service, settingsService *settings.Service, aclService *acl.Service, channelStore *channel.Store, mcpService *mcp.ConnectionService, scheduleService *schedule.Service, emailService *emailpkg.Service, providerService *providers.Service, modelsService *models.Service, searchProviderService *searchproviders.Service, memoryProviderService *memprovider.Service, manager *workspace.Manager, auditService *audit.Service) *botbackup.Service {
 	return botbackup.New(botbackup.Params{
 		Logger:          log,
 		DB:              conn,
@@ -590,9 +649,20 @@ func provideBotBackupService(log *slog.Logger, conn *pgxpool.Pool, queries dbsto
 		SearchProviders: searchProviderService,
 		MemoryProviders: memoryProviderService,
 		Workspace:       manager,
+		AuditLog:        auditService,
 	})
 }
 
+func provideBotTemplateService(log *slog.Logger, queries dbstore.Queries, backupService *botbackup.Service, botService *bots.Service, auditService *audit.Service, cfg config.Config) *bottemplate.Service {
+	dataRoot := cfg.Workspace.DataRoot
+	if dataRoot == "" {
+		dataRoot = config.DefaultDataRoot
+	}
+	service := bottemplate.NewService(log, queries, backupService, botService, localfs.New(filepath.Join(dataRoot, "templates")))
+	service.SetAuditLog(auditService)
+	return service
+}
+
 func provideFederationGateway(log *slog.Logger, containerdHandler *handlers.ContainerdHandler) *handlers.MCPFederationGateway {
 	return handlers.NewMCPFederationGateway(log, containerdHandler)
 }
@@ -653,12 +723,18 @@ func provideBackgroundManager(log *slog.Logger) *background.Manager {
 	return background.New(log)
 }
 
-func provideToolProviders(log *slog.Logger, channelManager *channel.Manager, registry *channel.Registry, routeService *route.DBService, scheduleService *schedule.Service, settingsService *settings.Service, searchProviderService *searchproviders.Service, manager *workspace.Manager, mediaService *media.Service, memoryRegistry *memprovider.Registry, emailService *emailpkg.Service, emailManager *emailpkg.Manager, fedGateway *handlers.MCPFederationGateway, mcpConnService *mcp.ConnectionService, modelsService *models.Service, queries dbstore.Queries, audioService *audiopkg.Service, sessionService *sessionpkg.Service, bgManager *background.Manager) []agenttools.ToolProvider {
+func provideToolProviders(log *slog.Logger, channelManager *channel.Manager, registry *channel.Registry, routeService *route.DBService, scheduleService *schedule.Service, settingsService *settings.Service, searchProviderService *searchproviders.Service, manager *workspace.Manager, mediaService *media.Service, memoryRegistry *memprovider.Registry, emailService *emailpkg.Service, emailManager *emailpkg.Manager, fedGateway *handlers.MCPFederationGateway, mcpConnService *mcp.ConnectionService, modelsService *models.Service, queries dbstore.Queries, audioService *audiopkg.Service, sessionService *sessionpkg.Service, bgManager *background.Manager, delegationService *delegation.Service, networkService *netctl.Service, snapshotPolicy *snapshotpolicy.Service) []agenttools.ToolProvider {
 	var assetResolver messaging.AssetResolver
 	if mediaService != nil {
 		assetResolver = &mediaAssetResolverAdapter{media: mediaService}
 	}
 	fedSource := mcpfederation.NewSource(log, fedGateway, mcpConnService)
+	webFetch := agenttools.NewWebFetchProvider(log)
+	if proxy := networkService.EgressProxy(); proxy != nil {
+		webFetch.SetEgressDialer(proxy)
+	}
+	containerTools := agenttools.NewContainerProvider(log, manager, bgManager, config.DefaultDataMount)
+	containerTools.SetSnapshotHook(snapshotPolicy)
 	return []agenttools.ToolProvider{
 		agenttools.NewAskUserProvider(log),
 		agenttools.NewMessageProvider(log, channelManager, channelManager, registry, assetResolver),
@@ -666,11 +742,12 @@ func provideToolProviders(log *slog.Logger, channelManager *channel.Manager, reg
 		agenttools.NewScheduleProvider(log, scheduleService),
 		agenttools.NewMemoryProvider(log, memoryRegistry, settingsService),
 		agenttools.NewWebProvider(log, settingsService, searchProviderService),
-		agenttools.NewContainerProvider(log, manager, bgManager, config.DefaultDataMount),
+		containerTools,
 		agenttools.NewBrowserProvider(log, settingsService, manager, manager, config.DefaultDataMount),
 		agenttools.NewEmailProvider(log, emailService, emailManager),
-		agenttools.NewWebFetchProvider(log),
+		webFetch,
 		agenttools.NewSpawnProvider(log, settingsService, modelsService, queries, sessionService, bgManager),
+		agenttools.NewDelegationProvider(log, delegationService, bgManager),
 		agenttools.NewSkillProvider(log),
 		agenttools.NewTTSProvider(log, settingsService, audioService, channelManager, registry),
 		agenttools.NewTranscriptionProvider(log, settingsService, audioService, mediaService),
@@ -688,8 +765,23 @@ func provideMemoryHandler(log *slog.Logger, botService *bots.Service, accountSer
 	return h
 }
 
-func provideAuthHandler(log *slog.Logger, accountService *accounts.Service, rc *boot.RuntimeConfig) *handlers.AuthHandler {
-	return handlers.NewAuthHandler(log, accountService, rc.JwtSecret, rc.JwtExpiresIn)
+func provideBotMCPServerHandler(log *slog.Logger, resolver *flow.Resolver, sessionService *sessionpkg.Service, botService *bots.Service, accountService *accounts.Service, scheduleService *schedule.Service, aclService *acl.Service, provider bridge.Provider, memoryRegistry *memprovider.Registry, settingsService *settings.Service) *handlers.BotMCPServerHandler {
+	h := handlers.NewBotMCPServerHandler(log, resolver, sessionService, botService, accountService, scheduleService)
+	h.SetACLService(aclService)
+	h.SetMemoryRegistry(memoryRegistry)
+	h.SetSettingsService(settingsService)
+	h.SetMCPClientProvider(provider)
+	return h
+}
+
+func provideOIDCProvider(log *slog.Logger, cfg config.Config) (*oidc.Provider, error) {
+	return oidc.NewProvider(log, cfg.Auth.OIDC, nil)
+}
+
+func provideAuthHandler(log *slog.Logger, accountService *accounts.Service, oidcProvider *oidc.Provider, rc *boot.RuntimeConfig) *handlers.AuthHandler {
+	h := handlers.NewAuthHandler(log, accountService, rc.JwtSecret, rc.JwtExpiresIn)
+	h.SetOIDCProvider(oidcProvider)
+	return h
 }
 
 func provideMessageHandler(log *slog.Logger, chatService *conversation.Service, msgService *message.DBService, sessionService *sessionpkg.Service, mediaService *media.Service, botService *bots.Service, accountService *accounts.Service, hub *event.Hub, toolApproval *toolapproval.Service, userInput *userinput.Service, bgManager *background.Manager) *handlers.MessageHandler {
@@ -786,6 +878,20 @@ func startBackgroundTaskCleanup(lc fx.Lifecycle, mgr *background.Manager) {
 	})
 }
 
+func startToolApprovalSweeper(lc fx.Lifecycle, approvalService *toolapproval.Service) {
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go approvalService.StartSweepLoop(done, toolapproval.DefaultSweepInterval)
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			close(done)
+			return nil
+		},
+	})
+}
+
 type sessionEnsurerAdapter struct {
 	svc *sessionpkg.Service
 }
@@ -826,6 +932,14 @@ func (r *settingsSpeechModelResolver) ResolveSpeechModelID(ctx context.Context,
 	return s.TtsModelID, nil
 }
 
+type scheduleMessageObserver struct {
+	schedules *schedule.Service
+}
+
+func (o *scheduleMessageObserver) ObserveInboundMessage(ctx context.Context, botID, routeID, platform, sender, text string) {
+	o.schedules.Dispatch(ctx, schedule.ChannelMessageEvent(botID, routeID, platform, sender, text))
+}
+
 type settingsIMDisplayOptions struct {
 	settings *settings.Service
 }
@@ -936,13 +1050,14 @@ type serverParams struct {
 	Config            config.Config
 	ServerHandlers    []server.Handler `group:"server_handlers"`
 	ContainerdHandler *handlers.ContainerdHandler
+	AccessTokens      *accesstoken.Service
 }
 
 func provideServer(params serverParams) *server.Server {
 	allHandlers := make([]server.Handler, 0, len(params.ServerHandlers)+1)
 	allHandlers = append(allHandlers, params.ServerHandlers...)
 	allHandlers = append(allHandlers, params.ContainerdHandler)
-	return server.NewServer(params.Logger, params.RuntimeConfig.ServerAddr, params.Config.Auth.JWTSecret, allHandlers...)
+	return server.NewServer(params.Logger, params.RuntimeConfig.ServerAddr, params.Config.Auth.JWTSecret, params.AccessTokens, allHandlers...)
 }
 
 func startRegistrySync(lc fx.Lifecycle, log *slog.Logger, cfg config.Config, queries dbstore.Queries) {
@@ -1025,6 +1140,20 @@ func startScheduleService(lc fx.Lifecycle, scheduleService *schedule.Service) {
 	})
 }
 
+// wireScheduleEvents feeds email, background task and MCP status events into
+// event-triggered schedules. Channel messages are wired in provideChannelRouter.
+func wireScheduleEvents(scheduleService *schedule.Service, emailTrigger *emailpkg.Trigger, bgManager *background.Manager, mcpConnService *mcp.ConnectionService) {
+	emailTrigger.SetEventFunc(func(ctx context.Context, botID string, mail emailpkg.InboundEmail) {
+		scheduleService.Dispatch(ctx, schedule.EmailEvent(botID, mail.From, mail.Subject, mail.BodyText))
+	})
+	bgManager.SetFinishFunc(func(n background.Notification) {
+		scheduleService.Dispatch(context.Background(), schedule.BackgroundTaskEvent(n.BotID, n.SessionID, n.TaskID, string(n.Status), n.Command, n.ExitCode, n.OutputTail))
+	})
+	mcpConnService.SetStatusChangeFunc(func(ctx context.Context, change mcp.StatusChange) {
+		scheduleService.Dispatch(ctx, schedule.MCPStatusEvent(change.BotID, change.ConnectionID, change.Name, change.PreviousStatus, change.Status, change.Message))
+	})
+}
+
 func startHeartbeatService(lc fx.Lifecycle, heartbeatService *heartbeat.Service) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -1042,6 +1171,117 @@ func wireResolverOutbound(resolver *flow.Resolver, channelManager *channel.Manag
 	})
 }
 
+// wireBudgets makes chat turns, schedules and heartbeats stop once a bot's
+// spending budget is used up, and sends budget notices to the bot owner.
+func wireBudgets(budgetService *budget.Service, resolver *flow.Resolver, scheduleService *schedule.Service, heartbeatService *heartbeat.Service, queries dbstore.Queries, channelManager *channel.Manager) {
+	resolver.SetBudgetGuard(budgetService)
+	scheduleService.SetBudgetGuard(budgetService)
+	heartbeatService.SetBudgetGuard(budgetService)
+	budgetService.SetNotifier(&budgetOwnerNotifier{queries: queries, channelManager: channelManager})
+}
+
+// wireAuditLog makes the services that change bot configuration record their
+// changes to the audit log.
+func wireAuditLog(auditService *audit.Service, aclService *acl.Service, settingsService *settings.Service, botService *bots.Service, mcpService *mcp.ConnectionService, approvalService *toolapproval.Service, manager *workspace.Manager, networkService *netctl.Service) {
+	aclService.SetAuditLog(auditService)
+	settingsService.SetAuditLog(auditService)
+	botService.SetAuditLog(auditService)
+	mcpService.SetAuditLog(auditService)
+	approvalService.SetAuditLog(auditService)
+	manager.SetAuditLog(auditService)
+	networkService.SetAuditLog(auditService)
+}
+
+// wireToolApprovalWorkflows lets approval workflows prompt approvers on their
+// own channels and continue conversations whose requests expire.
+func wireToolApprovalWorkflows(approvalService *toolapproval.Service, channelManager *channel.Manager, resolver *flow.Resolver) {
+	approvalService.SetSender(channelManager)
+	approvalService.SetExpiryHandler(resolver.HandleExpiredToolApproval)
+}
+
+// wireBotDelegation lets delegated tasks run as the target bot and records
+// them to the audit log.
+func wireBotDelegation(delegationService *delegation.Service, resolver *flow.Resolver, auditService *audit.Service) {
+	delegationService.SetRunner(resolver)
+	delegationService.SetAuditLog(auditService)
+}
+
+// wireWorkflows connects workflow steps to the agent, the tool gateway, the
+// bot's egress policy and the approval and ask_user flows used for human
+// steps. Workflows with a cron pattern run through the schedule service.
+func wireWorkflows(workflowService *workflow.Service, resolver *flow.Resolver, toolGateway *mcp.ToolGatewayService, approvalService *toolapproval.Service, userInputService *userinput.Service, auditService *audit.Service, networkService *netctl.Service, scheduleService *schedule.Service) {
+	workflowService.SetAgentRunner(resolver)
+	workflowService.SetScheduler(scheduleService)
+	scheduleService.SetWorkflowRunner(workflowService)
+	if proxy := networkService.EgressProxy(); proxy != nil {
+		workflowService.SetEgressDialer(proxy)
+	}
+	workflowService.SetToolCaller(toolGateway)
+	workflowService.SetApprovals(approvalService)
+	workflowService.SetUserInputs(userInputService)
+	workflowService.SetAuditLog(auditService)
+}
+
+// startWorkflowService resumes unfinished runs on start; on stop, runs in
+// flight are interrupted and left for the next start.
+func startWorkflowService(lc fx.Lifecycle, workflowService *workflow.Service) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return workflowService.Bootstrap(ctx)
+		},
+		OnStop: func(_ context.Context) error {
+			workflowService.Stop()
+			return nil
+		},
+	})
+}
+
+func startSnapshotPolicyService(lc fx.Lifecycle, snapshotPolicy *snapshotpolicy.Service) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			snapshotPolicy.Start()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			snapshotPolicy.Stop()
+			return nil
+		},
+	})
+}
+
+// budgetOwnerNotifier delivers budget notices through the first of the
+// owner's bound channels that the bot can send on.
+type budgetOwnerNotifier struct {
+	queries        dbstore.Queries
+	channelManager *channel.Manager
+}
+
+func (n *budgetOwnerNotifier) NotifyOwner(ctx context.Context, botID, ownerUserID, text string) error {
+	pgUserID, err := db.ParseUUID(ownerUserID)
+	if err != nil {
+		return err
+	}
+	bindings, err := n.queries.ListUserChannelBindingsByUser(ctx, pgUserID)
+	if err != nil {
+		return err
+	}
+	if len(bindings) == 0 {
+		return errors.New("owner has no bound channel")
+	}
+	var errs []error
+	for _, binding := range bindings {
+		err := n.channelManager.Send(ctx, botID, channel.ChannelType(binding.ChannelType), channel.SendRequest{
+			ChannelIdentityID: ownerUserID,
+			Message:           channel.Message{Text: text},
+		})
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
 func startChannelManager(lc fx.Lifecycle, channelManager *channel.Manager) {
 	ctx, cancel := context.WithCancel(context.Background())
 	lc.Append(fx.Hook{
@@ -6,17 +6,21 @@ import (
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxevent"
 
+	"github.com/memohai/memoh/internal/accesstoken"
 	"github.com/memohai/memoh/internal/accounts"
 	"github.com/memohai/memoh/internal/acl"
 	audiopkg "github.com/memohai/memoh/internal/audio"
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/boot"
 	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/budget"
 	"github.com/memohai/memoh/internal/channel"
 	"github.com/memohai/memoh/internal/channel/adapters/local"
 	"github.com/memohai/memoh/internal/channel/adapters/weixin"
 	"github.com/memohai/memoh/internal/channel/identities"
 	"github.com/memohai/memoh/internal/compaction"
 	"github.com/memohai/memoh/internal/conversation"
+	"github.com/memohai/memoh/internal/delegation"
 	emailpkg "github.com/memohai/memoh/internal/email"
 	"github.com/memohai/memoh/internal/handlers"
 	"github.com/memohai/memoh/internal/heartbeat"
@@ -31,6 +35,7 @@ import (
 	"github.com/memohai/memoh/internal/settings"
 	"github.com/memohai/memoh/internal/toolapproval"
 	"github.com/memohai/memoh/internal/userinput"
+	"github.com/memohai/memoh/internal/workflow"
 )
 
 func runServe() {
@@ -65,6 +70,7 @@ func options() fx.Option {
 			provideACPCodexOAuthHandler,
 			provideACPClaudeCodeOAuthHandler,
 			accounts.NewService,
+			provideOIDCProvider,
 			acl.NewService,
 			settings.NewService,
 			toolapproval.NewService,
@@ -110,9 +116,16 @@ func options() fx.Option {
 			schedule.NewService,
 			provideHeartbeatTriggerer,
 			heartbeat.NewService,
+			budget.NewService,
+			accesstoken.NewService,
+			audit.NewService,
+			delegation.NewService,
+			workflow.NewService,
 			compaction.NewService,
 			provideContainerdHandler,
 			provideBotBackupService,
+			provideBotTemplateService,
+			provideSnapshotPolicyService,
 			provideFederationGateway,
 			provideACPToolSource,
 			provideToolGatewayService,
@@ -156,10 +169,19 @@ func options() fx.Option {
 			provideServerHandler(handlers.NewMCPOAuthHandler),
 			provideServerHandler(handlers.NewPluginsHandler),
 			provideServerHandler(handlers.NewBotBackupHandler),
+			provideServerHandler(handlers.NewBotTemplateHandler),
+			provideServerHandler(handlers.NewWorkspaceSnapshotHandler),
 			provideOAuthService,
 			provideServerHandler(handlers.NewTokenUsageHandler),
+			provideServerHandler(handlers.NewBudgetHandler),
+			provideServerHandler(handlers.NewAccessTokenHandler),
+			provideServerHandler(handlers.NewAuditHandler),
+			provideServerHandler(handlers.NewDelegationHandler),
+			provideServerHandler(handlers.NewWorkflowHandler),
+			provideServerHandler(handlers.NewOpenAICompatHandler),
+			provideServerHandler(provideBotMCPServerHandler),
 			provideServerHandler(handlers.NewSessionInfoHandler),
-			provideServerHandler(handlers.NewSupermarketHandler),
+			provideServerHandler(provideSupermarketHandler),
 			provideServerHandler(provideWebHandler),
 			provideServer,
 		),
@@ -171,12 +193,21 @@ func options() fx.Option {
 			startMemoryProviderBootstrap,
 			startSearchProviderBootstrap,
 			startScheduleService,
+			wireScheduleEvents,
 			startHeartbeatService,
 			wireResolverOutbound,
+			wireBudgets,
+			wireAuditLog,
+			wireToolApprovalWorkflows,
+			wireBotDelegation,
+			wireWorkflows,
+			startWorkflowService,
+			startSnapshotPolicyService,
 			startChannelManager,
 			startEmailManager,
 			startContainerReconciliation,
 			startBackgroundTaskCleanup,
+			startToolApprovalSweeper,
 			startAudioTempStoreCleanup,
 			startServer,
 		),
@@ -25,6 +25,10 @@ const (
 	defaultSocketPath = "/run/memoh/bridge.sock"
 	templateDir       = "/opt/memoh/templates"
 
+	// defaultExecEnvFile carries the egress proxy variables the server
+	// writes while the bot's egress policy is enforced.
+	defaultExecEnvFile = "/run/memoh/egress.env"
+
 	agentsFileName         = "AGENTS.md"
 	legacyIdentityFileName = "IDENTITY.md"
 )
@@ -162,6 +166,7 @@ func main() {
 		DataMount:         bridgesvc.DefaultWorkDir,
 		AllowHostAbsolute: true,
 		ReverseHTTP:       reverseHTTP,
+		EnvFile:           defaultExecEnvFile,
 	}))
 	reflection.Register(srv)
 
@@ -30,6 +30,7 @@ func newBotsCreateCommand(ctx *cliContext) *cobra.Command {
 	var avatarURL string
 	var timezone string
 	var inactive bool
+	var template string
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -46,6 +47,21 @@ func newBotsCreateCommand(ctx *cliContext) *cobra.Command {
 
 			req := buildCreateBotRequest(displayName, avatarURL, timezone, inactive)
 
+			if template = strings.TrimSpace(template); template != "" {
+				created, err := client.CreateBotFromTemplate(requestCtx, template, req)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Created bot %s (%s) from template %s\n", created.Bot.DisplayName, created.Bot.ID, template)
+				for _, warning := range created.Warnings {
+					fmt.Printf("warning: %s\n", warning)
+				}
+				return nil
+			}
+			if strings.TrimSpace(displayName) == "" {
+				return errors.New("--name is required unless --template is set")
+			}
+
 			bot, err := client.CreateBot(requestCtx, req)
 			if err != nil {
 				return err
@@ -60,7 +76,7 @@ func newBotsCreateCommand(ctx *cliContext) *cobra.Command {
 	cmd.Flags().StringVar(&avatarURL, "avatar-url", "", "Bot avatar URL")
 	cmd.Flags().StringVar(&timezone, "timezone", "", "Bot timezone")
 	cmd.Flags().BoolVar(&inactive, "inactive", false, "Create the bot in inactive state")
-	_ = cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVar(&template, "template", "", "Create the bot from this bot template (ID or name); --name defaults to the template's")
 
 	return cmd
 }
@@ -104,10 +120,14 @@ func newBotsDeleteCommand(ctx *cliContext) *cobra.Command {
 }
 
 // localClient returns a tui.Client targeting the desktop-managed local
-// server. When --server is supplied it falls back to a token-less
-// remote client (advanced override; assumes the caller knows the
-// remote auth flow).
+// server. A personal access token (--token or $MEMOH_TOKEN) is used as is
+// against the configured server, skipping the local admin self-login. When
+// only --server is supplied it falls back to a token-less remote client
+// (advanced override; assumes the caller knows the remote auth flow).
 func localClient(ctx context.Context, cli *cliContext) (*tui.Client, error) {
+	if cli.token != "" {
+		return tui.NewClient(cli.state.ServerURL, cli.token), nil
+	}
 	if cli.server != "" {
 		return tui.NewClient(cli.state.ServerURL, ""), nil
 	}
@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -11,11 +13,15 @@ import (
 	"github.com/memohai/memoh/internal/version"
 )
 
+// tokenEnv names the environment variable holding a personal access token.
+const tokenEnv = "MEMOH_TOKEN"
+
 // cliContext is shared by every cobra subcommand. It carries the
 // loaded user preferences (server URL, etc.) and CLI-level overrides.
 type cliContext struct {
 	state  tui.State
 	server string
+	token  string
 }
 
 func newRootCommand() *cobra.Command {
@@ -36,11 +42,15 @@ func newRootCommand() *cobra.Command {
 			if ctx.server != "" {
 				ctx.state.ServerURL = tui.NormalizeServerURL(ctx.server)
 			}
+			if ctx.token == "" {
+				ctx.token = strings.TrimSpace(os.Getenv(tokenEnv))
+			}
 			return nil
 		},
 	}
 
 	rootCmd.PersistentFlags().StringVar(&ctx.server, "server", "", "Override the local Memoh server URL (defaults to "+tui.DefaultProdServerURL+")")
+	rootCmd.PersistentFlags().StringVar(&ctx.token, "token", "", "Personal access token to authenticate with (defaults to $"+tokenEnv+")")
 
 	rootCmd.AddCommand(newChatCommand(ctx))
 	rootCmd.AddCommand(newBotsCommand(ctx))
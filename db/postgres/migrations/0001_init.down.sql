@@ -1,3 +1,16 @@
+DROP TABLE IF EXISTS bot_snapshot_policies;
+DROP TABLE IF EXISTS bot_templates;
+DROP TABLE IF EXISTS bot_egress_policies;
+DROP TABLE IF EXISTS workflow_run_steps;
+DROP TABLE IF EXISTS workflow_runs;
+DROP TABLE IF EXISTS workflows;
+DROP TABLE IF EXISTS bot_delegations;
+DROP TABLE IF EXISTS audit_logs;
+DROP FUNCTION IF EXISTS audit_logs_append_only();
+DROP TABLE IF EXISTS personal_access_tokens;
+DROP TABLE IF EXISTS user_identities;
+DROP TABLE IF EXISTS bot_model_fallbacks;
+DROP TABLE IF EXISTS budgets;
 DROP TABLE IF EXISTS bot_user_grants;
 DROP TABLE IF EXISTS bot_history_message_assets;
 DROP TABLE IF EXISTS media_assets;
@@ -10,6 +23,8 @@ DROP TABLE IF EXISTS container_versions;
 DROP TABLE IF EXISTS snapshots;
 DROP TABLE IF EXISTS bot_workspace_resource_limits;
 DROP TABLE IF EXISTS containers;
+DROP TABLE IF EXISTS tool_approval_votes;
+DROP TABLE IF EXISTS tool_approval_workflows;
 DROP TABLE IF EXISTS user_input_requests;
 DROP TABLE IF EXISTS bot_history_messages;
 DROP TABLE IF EXISTS bot_channel_routes;
@@ -479,7 +479,6 @@ CREATE TABLE IF NOT EXISTS tool_approval_requests (
   conversation_type TEXT NOT NULL DEFAULT '',
   created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
   decided_at TIMESTAMPTZ,
-  CONSTRAINT tool_approval_tool_name_check CHECK (tool_name IN ('write', 'edit', 'exec')),
   CONSTRAINT tool_approval_status_check CHECK (status IN ('pending', 'approved', 'rejected', 'expired', 'cancelled')),
   CONSTRAINT tool_approval_short_id_unique UNIQUE (session_id, short_id),
   CONSTRAINT tool_approval_tool_call_unique UNIQUE (session_id, tool_call_id)
@@ -493,6 +492,34 @@ CREATE INDEX IF NOT EXISTS idx_tool_approval_prompt_external
   ON tool_approval_requests(prompt_external_message_id)
   WHERE prompt_external_message_id != '';
 
+CREATE TABLE IF NOT EXISTS tool_approval_workflows (
+  request_id UUID PRIMARY KEY REFERENCES tool_approval_requests(id) ON DELETE CASCADE,
+  approvers JSONB NOT NULL DEFAULT '[]'::jsonb,
+  quorum INTEGER NOT NULL DEFAULT 1,
+  escalation_approvers JSONB NOT NULL DEFAULT '[]'::jsonb,
+  escalate_at TIMESTAMPTZ,
+  escalated_at TIMESTAMPTZ,
+  expires_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tool_approval_workflows_escalate_at
+  ON tool_approval_workflows(escalate_at)
+  WHERE escalate_at IS NOT NULL AND escalated_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tool_approval_workflows_expires_at
+  ON tool_approval_workflows(expires_at)
+  WHERE expires_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS tool_approval_votes (
+  request_id UUID NOT NULL REFERENCES tool_approval_requests(id) ON DELETE CASCADE,
+  channel_identity_id UUID NOT NULL,
+  decision TEXT NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (request_id, channel_identity_id),
+  CONSTRAINT tool_approval_votes_decision_check CHECK (decision IN ('approve', 'reject'))
+);
+
 CREATE TABLE IF NOT EXISTS user_input_requests (
   id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
   bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
@@ -622,11 +649,18 @@ CREATE TABLE IF NOT EXISTS schedule (
   updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
   enabled BOOLEAN NOT NULL DEFAULT true,
   command TEXT NOT NULL,
-  bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE
+  bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  trigger_kind TEXT NOT NULL DEFAULT 'cron',
+  trigger_filter JSONB NOT NULL DEFAULT '{}'::jsonb,
+  retry_policy JSONB NOT NULL DEFAULT '{}'::jsonb,
+  output_schema JSONB,
+  workflow_id UUID
 );
 
 CREATE INDEX IF NOT EXISTS idx_schedule_bot_id ON schedule(bot_id);
 CREATE INDEX IF NOT EXISTS idx_schedule_enabled ON schedule(enabled);
+CREATE INDEX IF NOT EXISTS idx_schedule_trigger_kind ON schedule(trigger_kind);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_schedule_workflow_id ON schedule(workflow_id) WHERE workflow_id IS NOT NULL;
 
 -- storage_providers: pluggable object storage backends
 CREATE TABLE IF NOT EXISTS storage_providers (
@@ -709,17 +743,23 @@ CREATE TABLE IF NOT EXISTS schedule_logs (
   schedule_id UUID NOT NULL REFERENCES schedule(id) ON DELETE CASCADE,
   bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
   session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
-  status TEXT NOT NULL DEFAULT 'ok' CHECK (status IN ('ok', 'error')),
+  status TEXT NOT NULL DEFAULT 'ok' CHECK (status IN ('ok', 'error', 'retrying', 'retried', 'dead_letter')),
   result_text TEXT NOT NULL DEFAULT '',
   error_message TEXT NOT NULL DEFAULT '',
   usage JSONB,
   model_id UUID REFERENCES models(id) ON DELETE SET NULL,
   started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
-  completed_at TIMESTAMPTZ
+  completed_at TIMESTAMPTZ,
+  attempt INTEGER NOT NULL DEFAULT 1,
+  trigger_event TEXT NOT NULL DEFAULT '',
+  replay_of UUID REFERENCES schedule_logs(id) ON DELETE SET NULL,
+  result_json JSONB
 );
 
 CREATE INDEX IF NOT EXISTS idx_schedule_logs_schedule ON schedule_logs(schedule_id, started_at DESC);
 CREATE INDEX IF NOT EXISTS idx_schedule_logs_bot ON schedule_logs(bot_id, started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_schedule_logs_failed ON schedule_logs(bot_id, started_at DESC)
+  WHERE status IN ('error', 'dead_letter');
 
 -- email_providers: pluggable email service backends
 CREATE TABLE IF NOT EXISTS email_providers (
@@ -847,3 +887,226 @@ CREATE INDEX IF NOT EXISTS idx_bot_user_grants_bot_id ON bot_user_grants(bot_id)
 CREATE INDEX IF NOT EXISTS idx_bot_user_grants_user_id ON bot_user_grants(user_id);
 CREATE UNIQUE INDEX IF NOT EXISTS idx_bot_user_grants_unique_user ON bot_user_grants(bot_id, user_id) WHERE subject_type = 'user';
 CREATE UNIQUE INDEX IF NOT EXISTS idx_bot_user_grants_unique_everyone ON bot_user_grants(bot_id) WHERE subject_type = 'everyone';
+
+-- budgets: per-bot and per-user spending limits over a daily or monthly period,
+-- measured in tokens or in cost priced from models.config.pricing.
+CREATE TABLE IF NOT EXISTS budgets (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  bot_id UUID REFERENCES bots(id) ON DELETE CASCADE,
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  period TEXT NOT NULL,
+  unit TEXT NOT NULL,
+  limit_amount DOUBLE PRECISION NOT NULL,
+  warn_percent INTEGER NOT NULL DEFAULT 80,
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  warned_period TEXT NOT NULL DEFAULT '',
+  exceeded_period TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT budgets_scope_check CHECK ((bot_id IS NULL) <> (user_id IS NULL)),
+  CONSTRAINT budgets_period_check CHECK (period IN ('daily', 'monthly')),
+  CONSTRAINT budgets_unit_check CHECK (unit IN ('tokens', 'cost')),
+  CONSTRAINT budgets_limit_check CHECK (limit_amount > 0),
+  CONSTRAINT budgets_warn_percent_check CHECK (warn_percent BETWEEN 0 AND 100)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budgets_bot_id ON budgets(bot_id);
+CREATE INDEX IF NOT EXISTS idx_budgets_user_id ON budgets(user_id);
+
+-- bot_model_fallbacks: ordered chat model fallback chain per bot, tried when
+-- the primary model's provider fails.
+CREATE TABLE IF NOT EXISTS bot_model_fallbacks (
+  bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  model_id UUID NOT NULL REFERENCES models(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (bot_id, model_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bot_model_fallbacks_bot_position ON bot_model_fallbacks(bot_id, position);
+
+-- user_identities: external (OpenID Connect) identities linked to users.
+CREATE TABLE IF NOT EXISTS user_identities (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  issuer TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  email TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_login_at TIMESTAMPTZ,
+  CONSTRAINT user_identities_issuer_subject_unique UNIQUE (issuer, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
+
+-- personal_access_tokens: hashed, scoped API tokens for automation clients.
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  bot_id UUID REFERENCES bots(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  token_prefix TEXT NOT NULL,
+  token_hash TEXT NOT NULL,
+  scopes TEXT NOT NULL,
+  expires_at TIMESTAMPTZ,
+  last_used_at TIMESTAMPTZ,
+  revoked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT personal_access_tokens_hash_unique UNIQUE (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON personal_access_tokens(user_id);
+
+-- audit_logs: append-only record of administrative and agent-side actions.
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id BIGSERIAL PRIMARY KEY,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  actor_type TEXT NOT NULL,
+  actor_id TEXT NOT NULL DEFAULT '',
+  bot_id UUID,
+  action TEXT NOT NULL,
+  target_type TEXT NOT NULL DEFAULT '',
+  target_id TEXT NOT NULL DEFAULT '',
+  before JSONB NOT NULL DEFAULT 'null'::jsonb,
+  after JSONB NOT NULL DEFAULT 'null'::jsonb,
+  metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+  CONSTRAINT audit_logs_actor_type_check CHECK (actor_type IN ('user', 'bot', 'schedule', 'channel_identity', 'system'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_bot_id ON audit_logs(bot_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor ON audit_logs(actor_type, actor_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs(action);
+
+CREATE OR REPLACE FUNCTION audit_logs_append_only() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_logs_no_modify ON audit_logs;
+CREATE TRIGGER audit_logs_no_modify
+  BEFORE UPDATE OR DELETE ON audit_logs
+  FOR EACH ROW EXECUTE FUNCTION audit_logs_append_only();
+
+DROP TRIGGER IF EXISTS audit_logs_no_truncate ON audit_logs;
+CREATE TRIGGER audit_logs_no_truncate
+  BEFORE TRUNCATE ON audit_logs
+  FOR EACH STATEMENT EXECUTE FUNCTION audit_logs_append_only();
+
+-- bot_delegations: tasks one bot hands to another, per hop.
+CREATE TABLE IF NOT EXISTS bot_delegations (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  parent_id UUID REFERENCES bot_delegations(id) ON DELETE SET NULL,
+  from_bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  from_session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  to_bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  to_session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  task TEXT NOT NULL,
+  hop INTEGER NOT NULL DEFAULT 1,
+  chain JSONB NOT NULL DEFAULT '[]'::jsonb,
+  status TEXT NOT NULL DEFAULT 'running',
+  result TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT '',
+  model_id UUID REFERENCES models(id) ON DELETE SET NULL,
+  usage JSONB NOT NULL DEFAULT '{}'::jsonb,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  completed_at TIMESTAMPTZ,
+  CONSTRAINT bot_delegations_status_check CHECK (status IN ('running', 'completed', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_from_bot ON bot_delegations(from_bot_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_to_bot ON bot_delegations(to_bot_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_to_session ON bot_delegations(to_session_id) WHERE to_session_id IS NOT NULL;
+
+-- workflows: declarative multi-step automations and their runs.
+CREATE TABLE IF NOT EXISTS workflows (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  source TEXT NOT NULL,
+  definition JSONB NOT NULL DEFAULT '{}'::jsonb,
+  pattern TEXT NOT NULL DEFAULT '',
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT workflows_bot_name_unique UNIQUE (bot_id, name)
+);
+
+ALTER TABLE schedule ADD CONSTRAINT fk_schedule_workflow_id FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS workflow_runs (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  workflow_id UUID NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+  bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  trigger TEXT NOT NULL DEFAULT 'manual',
+  triggered_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+  definition JSONB NOT NULL DEFAULT '{}'::jsonb,
+  inputs JSONB NOT NULL DEFAULT '{}'::jsonb,
+  status TEXT NOT NULL DEFAULT 'running',
+  error TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  completed_at TIMESTAMPTZ,
+  CONSTRAINT workflow_runs_trigger_check CHECK (trigger IN ('manual', 'cron')),
+  CONSTRAINT workflow_runs_status_check CHECK (status IN ('running', 'completed', 'failed', 'cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_workflow ON workflow_runs(workflow_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_running ON workflow_runs(status) WHERE status = 'running';
+
+CREATE TABLE IF NOT EXISTS workflow_run_steps (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  run_id UUID NOT NULL REFERENCES workflow_runs(id) ON DELETE CASCADE,
+  step_id TEXT NOT NULL,
+  type TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'running',
+  input JSONB NOT NULL DEFAULT '{}'::jsonb,
+  output JSONB NOT NULL DEFAULT 'null'::jsonb,
+  error TEXT NOT NULL DEFAULT '',
+  ref TEXT NOT NULL DEFAULT '',
+  session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  completed_at TIMESTAMPTZ,
+  CONSTRAINT workflow_run_steps_run_step_unique UNIQUE (run_id, step_id),
+  CONSTRAINT workflow_run_steps_status_check CHECK (status IN ('running', 'waiting', 'completed', 'failed', 'skipped'))
+);
+
+-- bot_egress_policies: per-bot outbound network policy for workspaces.
+CREATE TABLE IF NOT EXISTS bot_egress_policies (
+  bot_id UUID PRIMARY KEY REFERENCES bots(id) ON DELETE CASCADE,
+  policy JSONB NOT NULL DEFAULT '{}'::jsonb,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- bot_templates: reusable blueprints new bots are created from.
+CREATE TABLE IF NOT EXISTS bot_templates (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  image TEXT NOT NULL DEFAULT '',
+  contents JSONB NOT NULL DEFAULT '{}'::jsonb,
+  size_bytes BIGINT NOT NULL DEFAULT 0,
+  source_bot_id UUID REFERENCES bots(id) ON DELETE SET NULL,
+  created_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  shared BOOLEAN NOT NULL DEFAULT false,
+  CONSTRAINT bot_templates_name_unique UNIQUE (name)
+);
+
+-- bot_snapshot_policies: scheduled workspace snapshots and their retention.
+CREATE TABLE IF NOT EXISTS bot_snapshot_policies (
+  bot_id UUID PRIMARY KEY REFERENCES bots(id) ON DELETE CASCADE,
+  enabled BOOLEAN NOT NULL DEFAULT false,
+  keep_hourly INTEGER NOT NULL DEFAULT 0,
+  keep_daily INTEGER NOT NULL DEFAULT 0,
+  keep_weekly INTEGER NOT NULL DEFAULT 0,
+  keep_monthly INTEGER NOT NULL DEFAULT 0,
+  exec_patterns JSONB NOT NULL DEFAULT '[]'::jsonb,
+  before_skill_install BOOLEAN NOT NULL DEFAULT false,
+  last_run_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
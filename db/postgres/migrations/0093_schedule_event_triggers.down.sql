@@ -0,0 +1,11 @@
+-- 0093_schedule_event_triggers
+-- Remove event-triggered schedules. Event schedules have no cron pattern, so
+-- they are deleted rather than left behind as broken cron entries.
+
+DELETE FROM schedule WHERE trigger_kind <> 'cron';
+
+DROP INDEX IF EXISTS idx_schedule_trigger_kind;
+
+ALTER TABLE schedule
+  DROP COLUMN IF EXISTS trigger_filter,
+  DROP COLUMN IF EXISTS trigger_kind;
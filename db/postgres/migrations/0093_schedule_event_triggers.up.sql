@@ -0,0 +1,10 @@
+-- 0093_schedule_event_triggers
+-- Add event-triggered schedules. trigger_kind selects what fires the schedule
+-- ('cron' keeps the existing pattern-driven behaviour); trigger_filter narrows
+-- which events of that kind match.
+
+ALTER TABLE schedule
+  ADD COLUMN IF NOT EXISTS trigger_kind TEXT NOT NULL DEFAULT 'cron',
+  ADD COLUMN IF NOT EXISTS trigger_filter JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_schedule_trigger_kind ON schedule(trigger_kind);
@@ -0,0 +1,19 @@
+-- 0094_schedule_retries
+-- Remove schedule retry policies and dead-letter run state. Runs in the new
+-- states are folded back into 'error' so the original status check holds.
+
+DROP INDEX IF EXISTS idx_schedule_logs_failed;
+
+UPDATE schedule_logs SET status = 'error' WHERE status IN ('retrying', 'retried', 'dead_letter');
+
+ALTER TABLE schedule_logs DROP CONSTRAINT IF EXISTS schedule_logs_status_check;
+ALTER TABLE schedule_logs ADD CONSTRAINT schedule_logs_status_check
+  CHECK (status IN ('ok', 'error'));
+
+ALTER TABLE schedule_logs
+  DROP COLUMN IF EXISTS replay_of,
+  DROP COLUMN IF EXISTS trigger_event,
+  DROP COLUMN IF EXISTS attempt;
+
+ALTER TABLE schedule
+  DROP COLUMN IF EXISTS retry_policy;
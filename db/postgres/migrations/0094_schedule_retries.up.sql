@@ -0,0 +1,21 @@
+-- 0094_schedule_retries
+-- Add per-schedule retry policies and dead-letter run state. retry_policy
+-- holds max attempts, exponential backoff bounds and jitter; every attempt is
+-- logged with its attempt number, and exhausted runs end as 'dead_letter' so
+-- they can be listed and replayed. trigger_event keeps the payload of
+-- event-triggered runs for replays; replay_of links a replay to its source.
+
+ALTER TABLE schedule
+  ADD COLUMN IF NOT EXISTS retry_policy JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE schedule_logs
+  ADD COLUMN IF NOT EXISTS attempt INTEGER NOT NULL DEFAULT 1,
+  ADD COLUMN IF NOT EXISTS trigger_event TEXT NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS replay_of UUID REFERENCES schedule_logs(id) ON DELETE SET NULL;
+
+ALTER TABLE schedule_logs DROP CONSTRAINT IF EXISTS schedule_logs_status_check;
+ALTER TABLE schedule_logs ADD CONSTRAINT schedule_logs_status_check
+  CHECK (status IN ('ok', 'error', 'retrying', 'retried', 'dead_letter'));
+
+CREATE INDEX IF NOT EXISTS idx_schedule_logs_failed ON schedule_logs(bot_id, started_at DESC)
+  WHERE status IN ('error', 'dead_letter');
@@ -0,0 +1,4 @@
+-- 0095_budgets
+-- Remove spending budgets.
+
+DROP TABLE IF EXISTS budgets;
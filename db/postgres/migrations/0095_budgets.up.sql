@@ -0,0 +1,28 @@
+-- 0095_budgets
+-- Add per-bot and per-user spending budgets. A budget caps token usage or
+-- cost (priced from models.config.pricing) over a daily or monthly period.
+-- warned_period and exceeded_period record the period key ("2026-10" or
+-- "2026-10-18") the owner was last notified for so each notice goes out once.
+
+CREATE TABLE IF NOT EXISTS budgets (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  bot_id UUID REFERENCES bots(id) ON DELETE CASCADE,
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  period TEXT NOT NULL,
+  unit TEXT NOT NULL,
+  limit_amount DOUBLE PRECISION NOT NULL,
+  warn_percent INTEGER NOT NULL DEFAULT 80,
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  warned_period TEXT NOT NULL DEFAULT '',
+  exceeded_period TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT budgets_scope_check CHECK ((bot_id IS NULL) <> (user_id IS NULL)),
+  CONSTRAINT budgets_period_check CHECK (period IN ('daily', 'monthly')),
+  CONSTRAINT budgets_unit_check CHECK (unit IN ('tokens', 'cost')),
+  CONSTRAINT budgets_limit_check CHECK (limit_amount > 0),
+  CONSTRAINT budgets_warn_percent_check CHECK (warn_percent BETWEEN 0 AND 100)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budgets_bot_id ON budgets(bot_id);
+CREATE INDEX IF NOT EXISTS idx_budgets_user_id ON budgets(user_id);
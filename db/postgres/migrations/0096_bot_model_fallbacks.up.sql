@@ -0,0 +1,14 @@
+-- 0096_bot_model_fallbacks
+-- Add per-bot model fallback chains. When the chat model's provider fails
+-- with a 5xx, rate limit, context-length or auth error, the agent retries the
+-- turn on each fallback model in ascending position order.
+
+CREATE TABLE IF NOT EXISTS bot_model_fallbacks (
+  bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  model_id UUID NOT NULL REFERENCES models(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (bot_id, model_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bot_model_fallbacks_bot_position ON bot_model_fallbacks(bot_id, position);
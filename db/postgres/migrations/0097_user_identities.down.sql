@@ -0,0 +1,4 @@
+-- 0097_user_identities (rollback)
+-- Drop external identity links.
+
+DROP TABLE IF EXISTS user_identities;
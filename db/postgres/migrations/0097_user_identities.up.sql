@@ -0,0 +1,17 @@
+-- 0097_user_identities
+-- Link users to external (OpenID Connect) identities. A login through an
+-- identity provider resolves the user by (issuer, subject), never by email,
+-- so a changed or reused email at the provider cannot take over an account.
+
+CREATE TABLE IF NOT EXISTS user_identities (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  issuer TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  email TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_login_at TIMESTAMPTZ,
+  CONSTRAINT user_identities_issuer_subject_unique UNIQUE (issuer, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
@@ -0,0 +1,4 @@
+-- 0098_personal_access_tokens
+-- Remove personal access tokens.
+
+DROP TABLE IF EXISTS personal_access_tokens;
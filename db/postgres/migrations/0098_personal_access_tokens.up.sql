@@ -0,0 +1,22 @@
+-- 0098_personal_access_tokens
+-- Add long-lived personal access tokens for automation clients. Only the
+-- SHA-256 hash of a token is stored; token_prefix keeps the first characters
+-- so users can tell tokens apart. scopes is a space-separated scope list and
+-- bot_id optionally restricts the token to a single bot.
+
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  bot_id UUID REFERENCES bots(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  token_prefix TEXT NOT NULL,
+  token_hash TEXT NOT NULL,
+  scopes TEXT NOT NULL,
+  expires_at TIMESTAMPTZ,
+  last_used_at TIMESTAMPTZ,
+  revoked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT personal_access_tokens_hash_unique UNIQUE (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON personal_access_tokens(user_id);
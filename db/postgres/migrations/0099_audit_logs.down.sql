@@ -0,0 +1,5 @@
+-- 0099_audit_logs
+-- Remove the audit log.
+
+DROP TABLE IF EXISTS audit_logs;
+DROP FUNCTION IF EXISTS audit_logs_append_only();
@@ -0,0 +1,39 @@
+-- 0099_audit_logs
+-- Add an append-only audit log of administrative and agent-side actions.
+-- bot_id has no foreign key so entries outlive the bots they describe, and
+-- a trigger rejects UPDATE, DELETE and TRUNCATE.
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id BIGSERIAL PRIMARY KEY,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  actor_type TEXT NOT NULL,
+  actor_id TEXT NOT NULL DEFAULT '',
+  bot_id UUID,
+  action TEXT NOT NULL,
+  target_type TEXT NOT NULL DEFAULT '',
+  target_id TEXT NOT NULL DEFAULT '',
+  before JSONB NOT NULL DEFAULT 'null'::jsonb,
+  after JSONB NOT NULL DEFAULT 'null'::jsonb,
+  metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+  CONSTRAINT audit_logs_actor_type_check CHECK (actor_type IN ('user', 'bot', 'schedule', 'channel_identity', 'system'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_bot_id ON audit_logs(bot_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor ON audit_logs(actor_type, actor_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs(action);
+
+CREATE OR REPLACE FUNCTION audit_logs_append_only() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_logs_no_modify ON audit_logs;
+CREATE TRIGGER audit_logs_no_modify
+  BEFORE UPDATE OR DELETE ON audit_logs
+  FOR EACH ROW EXECUTE FUNCTION audit_logs_append_only();
+
+DROP TRIGGER IF EXISTS audit_logs_no_truncate ON audit_logs;
+CREATE TRIGGER audit_logs_no_truncate
+  BEFORE TRUNCATE ON audit_logs
+  FOR EACH STATEMENT EXECUTE FUNCTION audit_logs_append_only();
@@ -0,0 +1,7 @@
+-- 0100_tool_approval_any_tool
+-- Restore the write/edit/exec tool name check. Requests for other tools are
+-- dropped.
+
+DELETE FROM tool_approval_requests WHERE tool_name NOT IN ('write', 'edit', 'exec');
+ALTER TABLE tool_approval_requests
+  ADD CONSTRAINT tool_approval_tool_name_check CHECK (tool_name IN ('write', 'edit', 'exec'));
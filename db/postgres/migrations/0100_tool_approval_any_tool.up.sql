@@ -0,0 +1,5 @@
+-- 0100_tool_approval_any_tool
+-- Tool approval policies can hold any native or MCP tool, not only write,
+-- edit and exec.
+
+ALTER TABLE tool_approval_requests DROP CONSTRAINT IF EXISTS tool_approval_tool_name_check;
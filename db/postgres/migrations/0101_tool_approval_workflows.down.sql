@@ -0,0 +1,5 @@
+-- 0101_tool_approval_workflows
+-- Remove tool approval workflows and votes.
+
+DROP TABLE IF EXISTS tool_approval_votes;
+DROP TABLE IF EXISTS tool_approval_workflows;
@@ -0,0 +1,31 @@
+-- 0101_tool_approval_workflows
+-- Add per-request approval workflows (approvers, quorum, escalation and
+-- expiry) and the votes cast on them.
+
+CREATE TABLE IF NOT EXISTS tool_approval_workflows (
+  request_id UUID PRIMARY KEY REFERENCES tool_approval_requests(id) ON DELETE CASCADE,
+  approvers JSONB NOT NULL DEFAULT '[]'::jsonb,
+  quorum INTEGER NOT NULL DEFAULT 1,
+  escalation_approvers JSONB NOT NULL DEFAULT '[]'::jsonb,
+  escalate_at TIMESTAMPTZ,
+  escalated_at TIMESTAMPTZ,
+  expires_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tool_approval_workflows_escalate_at
+  ON tool_approval_workflows(escalate_at)
+  WHERE escalate_at IS NOT NULL AND escalated_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tool_approval_workflows_expires_at
+  ON tool_approval_workflows(expires_at)
+  WHERE expires_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS tool_approval_votes (
+  request_id UUID NOT NULL REFERENCES tool_approval_requests(id) ON DELETE CASCADE,
+  channel_identity_id UUID NOT NULL,
+  decision TEXT NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (request_id, channel_identity_id),
+  CONSTRAINT tool_approval_votes_decision_check CHECK (decision IN ('approve', 'reject'))
+);
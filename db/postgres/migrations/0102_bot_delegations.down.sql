@@ -0,0 +1,4 @@
+-- 0102_bot_delegations
+-- Remove bot_delegations.
+
+DROP TABLE IF EXISTS bot_delegations;
@@ -0,0 +1,27 @@
+-- 0102_bot_delegations
+-- Add bot_delegations to record tasks one bot hands to another, with the
+-- delegation chain for loop protection and the usage of each hop.
+
+CREATE TABLE IF NOT EXISTS bot_delegations (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  parent_id UUID REFERENCES bot_delegations(id) ON DELETE SET NULL,
+  from_bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  from_session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  to_bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  to_session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  task TEXT NOT NULL,
+  hop INTEGER NOT NULL DEFAULT 1,
+  chain JSONB NOT NULL DEFAULT '[]'::jsonb,
+  status TEXT NOT NULL DEFAULT 'running',
+  result TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT '',
+  model_id UUID REFERENCES models(id) ON DELETE SET NULL,
+  usage JSONB NOT NULL DEFAULT '{}'::jsonb,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  completed_at TIMESTAMPTZ,
+  CONSTRAINT bot_delegations_status_check CHECK (status IN ('running', 'completed', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_from_bot ON bot_delegations(from_bot_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_to_bot ON bot_delegations(to_bot_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_to_session ON bot_delegations(to_session_id) WHERE to_session_id IS NOT NULL;
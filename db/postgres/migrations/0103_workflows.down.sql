@@ -0,0 +1,6 @@
+-- 0103_workflows
+-- Remove workflows and their run history.
+
+DROP TABLE IF EXISTS workflow_run_steps;
+DROP TABLE IF EXISTS workflow_runs;
+DROP TABLE IF EXISTS workflows;
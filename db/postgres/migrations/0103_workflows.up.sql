@@ -0,0 +1,54 @@
+-- 0103_workflows
+-- Add workflows, declarative multi-step automations across bots, with their
+-- runs and the persisted inputs and outputs of each step.
+
+CREATE TABLE IF NOT EXISTS workflows (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  source TEXT NOT NULL,
+  definition JSONB NOT NULL DEFAULT '{}'::jsonb,
+  pattern TEXT NOT NULL DEFAULT '',
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT workflows_bot_name_unique UNIQUE (bot_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS workflow_runs (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  workflow_id UUID NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+  bot_id UUID NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  trigger TEXT NOT NULL DEFAULT 'manual',
+  triggered_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+  definition JSONB NOT NULL DEFAULT '{}'::jsonb,
+  inputs JSONB NOT NULL DEFAULT '{}'::jsonb,
+  status TEXT NOT NULL DEFAULT 'running',
+  error TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  completed_at TIMESTAMPTZ,
+  CONSTRAINT workflow_runs_trigger_check CHECK (trigger IN ('manual', 'cron')),
+  CONSTRAINT workflow_runs_status_check CHECK (status IN ('running', 'completed', 'failed', 'cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_workflow ON workflow_runs(workflow_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_running ON workflow_runs(status) WHERE status = 'running';
+
+CREATE TABLE IF NOT EXISTS workflow_run_steps (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  run_id UUID NOT NULL REFERENCES workflow_runs(id) ON DELETE CASCADE,
+  step_id TEXT NOT NULL,
+  type TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'running',
+  input JSONB NOT NULL DEFAULT '{}'::jsonb,
+  output JSONB NOT NULL DEFAULT 'null'::jsonb,
+  error TEXT NOT NULL DEFAULT '',
+  ref TEXT NOT NULL DEFAULT '',
+  session_id UUID REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  started_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  completed_at TIMESTAMPTZ,
+  CONSTRAINT workflow_run_steps_run_step_unique UNIQUE (run_id, step_id),
+  CONSTRAINT workflow_run_steps_status_check CHECK (status IN ('running', 'waiting', 'completed', 'failed', 'skipped'))
+);
@@ -0,0 +1,8 @@
+-- 0104_structured_output
+-- Remove structured output from schedules.
+
+ALTER TABLE schedule_logs
+  DROP COLUMN IF EXISTS result_json;
+
+ALTER TABLE schedule
+  DROP COLUMN IF EXISTS output_schema;
@@ -0,0 +1,10 @@
+-- 0104_structured_output
+-- Add structured output to schedules. output_schema is an optional JSON
+-- Schema the final reply of every run must satisfy; result_json keeps the
+-- validated JSON of a run next to its free-text result.
+
+ALTER TABLE schedule
+  ADD COLUMN IF NOT EXISTS output_schema JSONB;
+
+ALTER TABLE schedule_logs
+  ADD COLUMN IF NOT EXISTS result_json JSONB;
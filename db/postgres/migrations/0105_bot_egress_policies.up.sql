@@ -0,0 +1,10 @@
+-- 0105_bot_egress_policies
+-- Add per-bot egress policies: allow and deny lists by domain, CIDR and port
+-- that the workspace firewall and egress proxy enforce.
+
+CREATE TABLE IF NOT EXISTS bot_egress_policies (
+  bot_id UUID PRIMARY KEY REFERENCES bots(id) ON DELETE CASCADE,
+  policy JSONB NOT NULL DEFAULT '{}'::jsonb,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
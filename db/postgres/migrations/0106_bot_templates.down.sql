@@ -0,0 +1,4 @@
+-- 0106_bot_templates
+-- Remove bot templates.
+
+DROP TABLE IF EXISTS bot_templates;
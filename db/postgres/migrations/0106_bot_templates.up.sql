@@ -0,0 +1,18 @@
+-- 0106_bot_templates
+-- Add bot templates: named blueprints bundling a workspace image, a seed for
+-- /data, settings, MCP connections and schedules that new bots are created
+-- from. The bundle itself is kept in the template store on disk.
+
+CREATE TABLE IF NOT EXISTS bot_templates (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  image TEXT NOT NULL DEFAULT '',
+  contents JSONB NOT NULL DEFAULT '{}'::jsonb,
+  size_bytes BIGINT NOT NULL DEFAULT 0,
+  source_bot_id UUID REFERENCES bots(id) ON DELETE SET NULL,
+  created_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT bot_templates_name_unique UNIQUE (name)
+);
@@ -0,0 +1,18 @@
+-- 0107_bot_snapshot_policies
+-- Add per-bot workspace snapshot policies: scheduled snapshots kept with
+-- grandfather-father-son retention, and snapshots taken before risky exec
+-- commands and skill installs.
+
+CREATE TABLE IF NOT EXISTS bot_snapshot_policies (
+  bot_id UUID PRIMARY KEY REFERENCES bots(id) ON DELETE CASCADE,
+  enabled BOOLEAN NOT NULL DEFAULT false,
+  keep_hourly INTEGER NOT NULL DEFAULT 0,
+  keep_daily INTEGER NOT NULL DEFAULT 0,
+  keep_weekly INTEGER NOT NULL DEFAULT 0,
+  keep_monthly INTEGER NOT NULL DEFAULT 0,
+  exec_patterns JSONB NOT NULL DEFAULT '[]'::jsonb,
+  before_skill_install BOOLEAN NOT NULL DEFAULT false,
+  last_run_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
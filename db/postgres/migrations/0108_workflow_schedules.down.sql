@@ -0,0 +1,9 @@
+-- 0108_workflow_schedules
+-- Remove workflow schedules.
+
+DELETE FROM schedule WHERE workflow_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_schedule_workflow_id;
+
+ALTER TABLE schedule
+  DROP COLUMN IF EXISTS workflow_id;
@@ -0,0 +1,18 @@
+-- 0108_workflow_schedules
+-- Run cron-triggered workflows through schedules. A schedule with a
+-- workflow_id starts a run of that workflow instead of prompting the agent;
+-- it is kept in step with the workflow's cron pattern and removed with it.
+
+ALTER TABLE schedule
+  ADD COLUMN IF NOT EXISTS workflow_id UUID REFERENCES workflows(id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_schedule_workflow_id ON schedule(workflow_id) WHERE workflow_id IS NOT NULL;
+
+INSERT INTO schedule (name, description, pattern, enabled, command, bot_id, workflow_id)
+SELECT
+  'Workflow: ' || w.name,
+  'Runs workflow ' || w.name || ' on its cron pattern.',
+  w.pattern, w.enabled, 'Run workflow ' || w.name, w.bot_id, w.id
+FROM workflows w
+WHERE w.pattern <> ''
+  AND NOT EXISTS (SELECT 1 FROM schedule s WHERE s.workflow_id = w.id);
@@ -0,0 +1,5 @@
+-- 0109_bot_template_sharing
+-- Remove template sharing; every template is visible to every user again.
+
+ALTER TABLE bot_templates
+  DROP COLUMN IF EXISTS shared;
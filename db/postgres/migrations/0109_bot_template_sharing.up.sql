@@ -0,0 +1,6 @@
+-- 0109_bot_template_sharing
+-- Keep bot templates private to their creator unless shared. Existing
+-- templates stay private; their creators can share them again.
+
+ALTER TABLE bot_templates
+  ADD COLUMN IF NOT EXISTS shared BOOLEAN NOT NULL DEFAULT false;
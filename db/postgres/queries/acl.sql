@@ -92,6 +92,9 @@ VALUES (
 )
 RETURNING *;
 
+-- name: GetBotACLRuleByID :one
+SELECT * FROM bot_acl_rules WHERE id = $1;
+
 -- name: UpdateBotACLRule :one
 UPDATE bot_acl_rules
 SET
@@ -0,0 +1,48 @@
+-- name: CreateAuditLog :one
+INSERT INTO audit_logs (actor_type, actor_id, bot_id, action, target_type, target_id, before, after, metadata)
+VALUES (
+  sqlc.arg(actor_type),
+  sqlc.arg(actor_id),
+  sqlc.narg(bot_id)::uuid,
+  sqlc.arg(action),
+  sqlc.arg(target_type),
+  sqlc.arg(target_id),
+  sqlc.arg(before),
+  sqlc.arg(after),
+  sqlc.arg(metadata)
+)
+RETURNING *;
+
+-- name: ListAuditLogs :many
+SELECT * FROM audit_logs
+WHERE (sqlc.narg(bot_id)::uuid IS NULL OR bot_id = sqlc.narg(bot_id)::uuid)
+  AND (sqlc.narg(actor_type)::text IS NULL OR actor_type = sqlc.narg(actor_type)::text)
+  AND (sqlc.narg(actor_id)::text IS NULL OR actor_id = sqlc.narg(actor_id)::text)
+  AND (
+    sqlc.narg(action)::text IS NULL
+    OR action = sqlc.narg(action)::text
+    OR action LIKE sqlc.narg(action)::text || '.%'
+  )
+  AND (sqlc.narg(target_type)::text IS NULL OR target_type = sqlc.narg(target_type)::text)
+  AND (sqlc.narg(target_id)::text IS NULL OR target_id = sqlc.narg(target_id)::text)
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until)::timestamptz)
+  AND (sqlc.arg(before_id)::bigint = 0 OR id < sqlc.arg(before_id)::bigint)
+ORDER BY id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAuditLogs :one
+SELECT COUNT(*)::bigint AS total FROM audit_logs
+WHERE (sqlc.narg(bot_id)::uuid IS NULL OR bot_id = sqlc.narg(bot_id)::uuid)
+  AND (sqlc.narg(actor_type)::text IS NULL OR actor_type = sqlc.narg(actor_type)::text)
+  AND (sqlc.narg(actor_id)::text IS NULL OR actor_id = sqlc.narg(actor_id)::text)
+  AND (
+    sqlc.narg(action)::text IS NULL
+    OR action = sqlc.narg(action)::text
+    OR action LIKE sqlc.narg(action)::text || '.%'
+  )
+  AND (sqlc.narg(target_type)::text IS NULL OR target_type = sqlc.narg(target_type)::text)
+  AND (sqlc.narg(target_id)::text IS NULL OR target_id = sqlc.narg(target_id)::text)
+  AND (sqlc.narg(since)::timestamptz IS NULL OR created_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR created_at < sqlc.narg(until)::timestamptz);
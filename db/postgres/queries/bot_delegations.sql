@@ -0,0 +1,40 @@
+-- name: CreateBotDelegation :one
+INSERT INTO bot_delegations (parent_id, from_bot_id, from_session_id, to_bot_id, to_session_id, task, hop, chain)
+VALUES (
+  sqlc.narg(parent_id)::uuid,
+  sqlc.arg(from_bot_id),
+  sqlc.narg(from_session_id)::uuid,
+  sqlc.arg(to_bot_id),
+  sqlc.narg(to_session_id)::uuid,
+  sqlc.arg(task),
+  sqlc.arg(hop),
+  sqlc.arg(chain)
+)
+RETURNING *;
+
+-- name: CompleteBotDelegation :one
+UPDATE bot_delegations
+SET status = sqlc.arg(status),
+    result = sqlc.arg(result),
+    error = sqlc.arg(error),
+    model_id = sqlc.narg(model_id)::uuid,
+    usage = sqlc.arg(usage),
+    completed_at = now()
+WHERE id = sqlc.arg(id)
+  AND status = 'running'
+RETURNING *;
+
+-- name: GetBotDelegationByID :one
+SELECT * FROM bot_delegations WHERE id = sqlc.arg(id);
+
+-- name: GetBotDelegationByToSession :one
+SELECT * FROM bot_delegations
+WHERE to_session_id = sqlc.arg(to_session_id)
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ListBotDelegationsByBot :many
+SELECT * FROM bot_delegations
+WHERE from_bot_id = sqlc.arg(bot_id) OR to_bot_id = sqlc.arg(bot_id)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(page_limit);
@@ -0,0 +1,41 @@
+-- name: CreateBotTemplate :one
+INSERT INTO bot_templates (name, description, image, contents, size_bytes, source_bot_id, created_by_user_id, shared)
+VALUES (
+  sqlc.arg(name),
+  sqlc.arg(description),
+  sqlc.arg(image),
+  sqlc.arg(contents),
+  sqlc.arg(size_bytes),
+  sqlc.narg(source_bot_id)::uuid,
+  sqlc.narg(created_by_user_id)::uuid,
+  sqlc.arg(shared)
+)
+RETURNING *;
+
+-- name: GetBotTemplateByID :one
+SELECT * FROM bot_templates WHERE id = sqlc.arg(id);
+
+-- name: GetBotTemplateByName :one
+SELECT * FROM bot_templates WHERE name = sqlc.arg(name);
+
+-- name: ListBotTemplates :many
+SELECT * FROM bot_templates
+ORDER BY name ASC;
+
+-- name: ListVisibleBotTemplates :many
+SELECT * FROM bot_templates
+WHERE shared OR created_by_user_id = sqlc.arg(user_id)
+ORDER BY name ASC;
+
+-- name: UpdateBotTemplate :one
+UPDATE bot_templates
+SET name = sqlc.arg(name),
+    description = sqlc.arg(description),
+    image = sqlc.arg(image),
+    shared = sqlc.arg(shared),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteBotTemplate :exec
+DELETE FROM bot_templates WHERE id = sqlc.arg(id);
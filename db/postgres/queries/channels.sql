@@ -73,3 +73,9 @@ SELECT id, user_id, channel_type, config, created_at, updated_at
 FROM user_channel_bindings
 WHERE channel_type = $1
 ORDER BY created_at DESC;
+
+-- name: ListUserChannelBindingsByUser :many
+SELECT id, user_id, channel_type, config, created_at, updated_at
+FROM user_channel_bindings
+WHERE user_id = $1
+ORDER BY created_at ASC;
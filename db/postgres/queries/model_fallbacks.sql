@@ -0,0 +1,11 @@
+-- name: ListBotModelFallbacks :many
+SELECT model_id FROM bot_model_fallbacks
+WHERE bot_id = sqlc.arg(bot_id)
+ORDER BY position ASC;
+
+-- name: CreateBotModelFallback :exec
+INSERT INTO bot_model_fallbacks (bot_id, model_id, position)
+VALUES (sqlc.arg(bot_id), sqlc.arg(model_id), sqlc.arg(position));
+
+-- name: DeleteBotModelFallbacks :exec
+DELETE FROM bot_model_fallbacks WHERE bot_id = sqlc.arg(bot_id);
@@ -0,0 +1,30 @@
+-- name: CreatePersonalAccessToken :one
+INSERT INTO personal_access_tokens (user_id, bot_id, name, token_prefix, token_hash, scopes, expires_at)
+VALUES (
+  sqlc.arg(user_id),
+  sqlc.narg(bot_id)::uuid,
+  sqlc.arg(name),
+  sqlc.arg(token_prefix),
+  sqlc.arg(token_hash),
+  sqlc.arg(scopes),
+  sqlc.narg(expires_at)::timestamptz
+)
+RETURNING *;
+
+-- name: GetPersonalAccessTokenByHash :one
+SELECT * FROM personal_access_tokens WHERE token_hash = sqlc.arg(token_hash);
+
+-- name: ListPersonalAccessTokensByUser :many
+SELECT * FROM personal_access_tokens
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY created_at DESC;
+
+-- name: RevokePersonalAccessToken :execrows
+UPDATE personal_access_tokens
+SET revoked_at = now()
+WHERE id = sqlc.arg(id)
+  AND user_id = sqlc.arg(user_id)
+  AND revoked_at IS NULL;
+
+-- name: TouchPersonalAccessToken :exec
+UPDATE personal_access_tokens SET last_used_at = now() WHERE id = sqlc.arg(id);
@@ -1,7 +1,7 @@
 -- name: CreateScheduleLog :one
-INSERT INTO schedule_logs (schedule_id, bot_id, session_id, started_at)
-VALUES ($1, $2, sqlc.narg(session_id)::uuid, now())
-RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at;
+INSERT INTO schedule_logs (schedule_id, bot_id, session_id, attempt, trigger_event, replay_of, started_at)
+VALUES ($1, $2, sqlc.narg(session_id)::uuid, sqlc.arg(attempt), sqlc.arg(trigger_event), sqlc.narg(replay_of)::uuid, now())
+RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json;
 
 -- name: CompleteScheduleLog :one
 UPDATE schedule_logs
@@ -10,12 +10,13 @@ SET status = $2,
     error_message = $4,
     usage = $5,
     model_id = $6,
+    result_json = $7,
     completed_at = now()
 WHERE id = $1
-RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, model_id, started_at, completed_at;
+RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, model_id, started_at, completed_at, attempt, trigger_event, replay_of, result_json;
 
 -- name: ListScheduleLogsByBot :many
-SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 FROM schedule_logs
 WHERE bot_id = $1
 ORDER BY started_at DESC
@@ -25,7 +26,7 @@ LIMIT $2 OFFSET $3;
 SELECT count(*) FROM schedule_logs WHERE bot_id = $1;
 
 -- name: ListScheduleLogsBySchedule :many
-SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 FROM schedule_logs
 WHERE schedule_id = $1
 ORDER BY started_at DESC
@@ -34,6 +35,42 @@ LIMIT $2 OFFSET $3;
 -- name: CountScheduleLogsBySchedule :one
 SELECT count(*) FROM schedule_logs WHERE schedule_id = $1;
 
+-- name: GetScheduleLogByID :one
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
+FROM schedule_logs
+WHERE id = $1;
+
+-- name: ListFailedScheduleLogsByBot :many
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
+FROM schedule_logs
+WHERE bot_id = $1
+  AND status IN ('error', 'dead_letter')
+ORDER BY started_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountFailedScheduleLogsByBot :one
+SELECT count(*) FROM schedule_logs WHERE bot_id = $1 AND status IN ('error', 'dead_letter');
+
+-- name: CountActiveScheduleReplays :one
+-- Replays of a log that are still running, waiting on a retry or succeeded.
+SELECT count(*) FROM schedule_logs
+WHERE replay_of = $1
+  AND (completed_at IS NULL OR status IN ('ok', 'retrying', 'retried'));
+
+-- name: ResolveScheduleLogRetry :exec
+UPDATE schedule_logs SET status = $2 WHERE id = $1 AND status = 'retrying';
+
+-- name: DeadLetterPendingScheduleRetries :exec
+UPDATE schedule_logs SET status = 'dead_letter' WHERE status = 'retrying';
+
+-- name: FailInterruptedScheduleLogs :exec
+-- Runs still in flight when the server stopped will never complete.
+UPDATE schedule_logs
+SET status = 'error',
+    error_message = 'interrupted by a server restart',
+    completed_at = now()
+WHERE completed_at IS NULL;
+
 -- name: DeleteScheduleLogsByBot :exec
 DELETE FROM schedule_logs WHERE bot_id = $1;
 
@@ -93,3 +93,36 @@ WHERE m.bot_id = sqlc.arg(bot_id)
       'chat'
     ) = sqlc.narg(session_type)::text
   );
+
+-- name: GetBotBudgetUsageByModel :many
+SELECT
+  m.model_id,
+  COALESCE(SUM((m.usage->>'inputTokens')::bigint), 0)::bigint AS input_tokens,
+  COALESCE(SUM((m.usage->>'outputTokens')::bigint), 0)::bigint AS output_tokens,
+  COALESCE(SUM((m.usage->'inputTokenDetails'->>'cacheReadTokens')::bigint), 0)::bigint AS cache_read_tokens,
+  COALESCE(MAX((mo.config->'pricing'->>'input_per_million')::float8), 0)::float8 AS input_price,
+  COALESCE(MAX((mo.config->'pricing'->>'output_per_million')::float8), 0)::float8 AS output_price,
+  COALESCE(MAX((mo.config->'pricing'->>'cache_read_per_million')::float8), 0)::float8 AS cache_read_price
+FROM bot_history_messages m
+LEFT JOIN models mo ON mo.id = m.model_id
+WHERE m.bot_id = sqlc.arg(bot_id)
+  AND m.usage IS NOT NULL
+  AND m.created_at >= sqlc.arg(from_time)
+GROUP BY m.model_id;
+
+-- name: GetOwnerBudgetUsageByModel :many
+SELECT
+  m.model_id,
+  COALESCE(SUM((m.usage->>'inputTokens')::bigint), 0)::bigint AS input_tokens,
+  COALESCE(SUM((m.usage->>'outputTokens')::bigint), 0)::bigint AS output_tokens,
+  COALESCE(SUM((m.usage->'inputTokenDetails'->>'cacheReadTokens')::bigint), 0)::bigint AS cache_read_tokens,
+  COALESCE(MAX((mo.config->'pricing'->>'input_per_million')::float8), 0)::float8 AS input_price,
+  COALESCE(MAX((mo.config->'pricing'->>'output_per_million')::float8), 0)::float8 AS output_price,
+  COALESCE(MAX((mo.config->'pricing'->>'cache_read_per_million')::float8), 0)::float8 AS cache_read_price
+FROM bot_history_messages m
+JOIN bots b ON b.id = m.bot_id
+LEFT JOIN models mo ON mo.id = m.model_id
+WHERE b.owner_user_id = sqlc.arg(owner_user_id)
+  AND m.usage IS NOT NULL
+  AND m.created_at >= sqlc.arg(from_time)
+GROUP BY m.model_id;
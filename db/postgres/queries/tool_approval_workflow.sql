@@ -0,0 +1,57 @@
+-- name: CreateToolApprovalWorkflow :exec
+INSERT INTO tool_approval_workflows (
+  request_id,
+  approvers,
+  quorum,
+  escalation_approvers,
+  escalate_at,
+  expires_at
+) VALUES (
+  sqlc.arg(request_id),
+  sqlc.arg(approvers),
+  sqlc.arg(quorum),
+  sqlc.arg(escalation_approvers),
+  sqlc.narg(escalate_at),
+  sqlc.narg(expires_at)
+)
+ON CONFLICT (request_id) DO NOTHING;
+
+-- name: GetToolApprovalWorkflow :one
+SELECT *
+FROM tool_approval_workflows
+WHERE request_id = $1;
+
+-- name: UpsertToolApprovalVote :exec
+INSERT INTO tool_approval_votes (request_id, channel_identity_id, decision, reason)
+VALUES (sqlc.arg(request_id), sqlc.arg(channel_identity_id), sqlc.arg(decision), sqlc.arg(reason))
+ON CONFLICT (request_id, channel_identity_id) DO UPDATE
+SET decision = EXCLUDED.decision,
+    reason = EXCLUDED.reason,
+    created_at = now();
+
+-- name: ListToolApprovalVotes :many
+SELECT *
+FROM tool_approval_votes
+WHERE request_id = $1
+ORDER BY created_at ASC, channel_identity_id ASC;
+
+-- name: ClaimDueToolApprovalEscalations :many
+UPDATE tool_approval_workflows
+SET escalated_at = now()
+WHERE escalated_at IS NULL
+  AND escalate_at <= sqlc.arg(now)
+  AND request_id IN (
+    SELECT id FROM tool_approval_requests WHERE status = 'pending'
+  )
+RETURNING *;
+
+-- name: ExpireDueToolApprovalRequests :many
+UPDATE tool_approval_requests
+SET status = 'expired',
+    decision_reason = sqlc.arg(reason),
+    decided_at = now()
+WHERE status = 'pending'
+  AND id IN (
+    SELECT request_id FROM tool_approval_workflows WHERE expires_at <= sqlc.arg(now)
+  )
+RETURNING *;
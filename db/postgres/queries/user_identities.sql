@@ -0,0 +1,14 @@
+-- name: GetUserIdentity :one
+SELECT * FROM user_identities
+WHERE issuer = sqlc.arg(issuer) AND subject = sqlc.arg(subject);
+
+-- name: CreateUserIdentity :one
+INSERT INTO user_identities (user_id, issuer, subject, email, last_login_at)
+VALUES (sqlc.arg(user_id), sqlc.arg(issuer), sqlc.arg(subject), sqlc.arg(email), now())
+RETURNING *;
+
+-- name: TouchUserIdentity :exec
+UPDATE user_identities
+SET email = sqlc.arg(email),
+    last_login_at = now()
+WHERE id = sqlc.arg(id);
@@ -6,7 +6,9 @@ SELECT
   cv.version,
   cv.created_at,
   s.runtime_snapshot_name,
-  s.display_name
+  s.display_name,
+  s.snapshotter,
+  s.source
 FROM container_versions cv
 JOIN snapshots s ON s.id = cv.snapshot_id
 WHERE cv.container_id = sqlc.arg(container_id)
@@ -30,3 +32,9 @@ FROM container_versions cv
 JOIN snapshots s ON s.id = cv.snapshot_id
 WHERE cv.container_id = sqlc.arg(container_id)
   AND cv.version = sqlc.arg(version);
+
+-- name: DeleteVersion :one
+DELETE FROM container_versions
+WHERE container_id = sqlc.arg(container_id)
+  AND version = sqlc.arg(version)
+RETURNING snapshot_id;
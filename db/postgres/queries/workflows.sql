@@ -0,0 +1,104 @@
+-- name: CreateWorkflow :one
+INSERT INTO workflows (bot_id, name, description, source, definition, pattern, enabled)
+VALUES (
+  sqlc.arg(bot_id),
+  sqlc.arg(name),
+  sqlc.arg(description),
+  sqlc.arg(source),
+  sqlc.arg(definition),
+  sqlc.arg(pattern),
+  sqlc.arg(enabled)
+)
+RETURNING *;
+
+-- name: GetWorkflowByID :one
+SELECT * FROM workflows WHERE id = sqlc.arg(id);
+
+-- name: ListWorkflowsByBot :many
+SELECT * FROM workflows
+WHERE bot_id = sqlc.arg(bot_id)
+ORDER BY name ASC;
+
+-- name: UpdateWorkflow :one
+UPDATE workflows
+SET name = sqlc.arg(name),
+    description = sqlc.arg(description),
+    source = sqlc.arg(source),
+    definition = sqlc.arg(definition),
+    pattern = sqlc.arg(pattern),
+    enabled = sqlc.arg(enabled),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteWorkflow :exec
+DELETE FROM workflows WHERE id = sqlc.arg(id);
+
+-- name: CreateWorkflowRun :one
+INSERT INTO workflow_runs (workflow_id, bot_id, session_id, trigger, triggered_by_user_id, definition, inputs)
+VALUES (
+  sqlc.arg(workflow_id),
+  sqlc.arg(bot_id),
+  sqlc.narg(session_id)::uuid,
+  sqlc.arg(trigger),
+  sqlc.narg(triggered_by_user_id)::uuid,
+  sqlc.arg(definition),
+  sqlc.arg(inputs)
+)
+RETURNING *;
+
+-- name: GetWorkflowRunByID :one
+SELECT * FROM workflow_runs WHERE id = sqlc.arg(id);
+
+-- name: ListWorkflowRunsByWorkflow :many
+SELECT * FROM workflow_runs
+WHERE workflow_id = sqlc.arg(workflow_id)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountWorkflowRunsByWorkflow :one
+SELECT COUNT(*) FROM workflow_runs WHERE workflow_id = sqlc.arg(workflow_id);
+
+-- name: ListRunningWorkflowRuns :many
+SELECT * FROM workflow_runs
+WHERE status = 'running'
+ORDER BY created_at ASC;
+
+-- name: CompleteWorkflowRun :one
+UPDATE workflow_runs
+SET status = sqlc.arg(status),
+    error = sqlc.arg(error),
+    completed_at = now()
+WHERE id = sqlc.arg(id)
+  AND status = 'running'
+RETURNING *;
+
+-- name: UpsertWorkflowRunStep :one
+INSERT INTO workflow_run_steps (run_id, step_id, type, status, input, output, error, ref, session_id, completed_at)
+VALUES (
+  sqlc.arg(run_id),
+  sqlc.arg(step_id),
+  sqlc.arg(type),
+  sqlc.arg(status),
+  sqlc.arg(input),
+  sqlc.arg(output),
+  sqlc.arg(error),
+  sqlc.arg(ref),
+  sqlc.narg(session_id)::uuid,
+  CASE WHEN sqlc.arg(status) IN ('completed', 'failed', 'skipped') THEN now() END
+)
+ON CONFLICT (run_id, step_id) DO UPDATE
+SET status = excluded.status,
+    input = excluded.input,
+    output = excluded.output,
+    error = excluded.error,
+    ref = excluded.ref,
+    session_id = excluded.session_id,
+    completed_at = excluded.completed_at
+RETURNING *;
+
+-- name: ListWorkflowRunSteps :many
+SELECT * FROM workflow_run_steps
+WHERE run_id = sqlc.arg(run_id)
+ORDER BY started_at ASC, step_id ASC;
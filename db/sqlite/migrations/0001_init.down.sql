@@ -3,6 +3,18 @@
 
 PRAGMA foreign_keys = OFF;
 
+DROP TABLE IF EXISTS bot_snapshot_policies;
+DROP TABLE IF EXISTS bot_templates;
+DROP TABLE IF EXISTS bot_egress_policies;
+DROP TABLE IF EXISTS workflow_run_steps;
+DROP TABLE IF EXISTS workflow_runs;
+DROP TABLE IF EXISTS workflows;
+DROP TABLE IF EXISTS bot_delegations;
+DROP TABLE IF EXISTS audit_logs;
+DROP TABLE IF EXISTS personal_access_tokens;
+DROP TABLE IF EXISTS user_identities;
+DROP TABLE IF EXISTS bot_model_fallbacks;
+DROP TABLE IF EXISTS budgets;
 DROP TABLE IF EXISTS bot_user_grants;
 DROP TABLE IF EXISTS user_provider_oauth_tokens;
 DROP TABLE IF EXISTS provider_oauth_tokens;
@@ -23,6 +35,8 @@ DROP TABLE IF EXISTS snapshots;
 DROP TABLE IF EXISTS bot_workspace_resource_limits;
 DROP TABLE IF EXISTS containers;
 DROP TABLE IF EXISTS user_input_requests;
+DROP TABLE IF EXISTS tool_approval_votes;
+DROP TABLE IF EXISTS tool_approval_workflows;
 DROP TABLE IF EXISTS tool_approval_requests;
 DROP TABLE IF EXISTS bot_history_messages;
 DROP TABLE IF EXISTS bot_session_events;
@@ -470,7 +470,6 @@ CREATE TABLE IF NOT EXISTS tool_approval_requests (
   conversation_type TEXT NOT NULL DEFAULT '',
   created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
   decided_at TEXT,
-  CONSTRAINT tool_approval_tool_name_check CHECK (tool_name IN ('write', 'edit', 'exec')),
   CONSTRAINT tool_approval_status_check CHECK (status IN ('pending', 'approved', 'rejected', 'expired', 'cancelled')),
   CONSTRAINT tool_approval_short_id_unique UNIQUE (session_id, short_id),
   CONSTRAINT tool_approval_tool_call_unique UNIQUE (session_id, tool_call_id)
@@ -484,6 +483,34 @@ CREATE INDEX IF NOT EXISTS idx_tool_approval_prompt_external
   ON tool_approval_requests(prompt_external_message_id)
   WHERE prompt_external_message_id != '';
 
+CREATE TABLE IF NOT EXISTS tool_approval_workflows (
+  request_id TEXT PRIMARY KEY REFERENCES tool_approval_requests(id) ON DELETE CASCADE,
+  approvers TEXT NOT NULL DEFAULT '[]',
+  quorum INTEGER NOT NULL DEFAULT 1,
+  escalation_approvers TEXT NOT NULL DEFAULT '[]',
+  escalate_at TEXT,
+  escalated_at TEXT,
+  expires_at TEXT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_tool_approval_workflows_escalate_at
+  ON tool_approval_workflows(escalate_at)
+  WHERE escalate_at IS NOT NULL AND escalated_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tool_approval_workflows_expires_at
+  ON tool_approval_workflows(expires_at)
+  WHERE expires_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS tool_approval_votes (
+  request_id TEXT NOT NULL REFERENCES tool_approval_requests(id) ON DELETE CASCADE,
+  channel_identity_id TEXT NOT NULL,
+  decision TEXT NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (request_id, channel_identity_id),
+  CONSTRAINT tool_approval_votes_decision_check CHECK (decision IN ('approve', 'reject'))
+);
+
 CREATE TABLE IF NOT EXISTS user_input_requests (
   id TEXT PRIMARY KEY,
   bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
@@ -613,11 +640,18 @@ CREATE TABLE IF NOT EXISTS schedule (
   updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
   enabled INTEGER NOT NULL DEFAULT 1,
   command TEXT NOT NULL,
-  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  trigger_kind TEXT NOT NULL DEFAULT 'cron',
+  trigger_filter TEXT NOT NULL DEFAULT '{}',
+  retry_policy TEXT NOT NULL DEFAULT '{}',
+  output_schema TEXT,
+  workflow_id TEXT REFERENCES workflows(id) ON DELETE CASCADE
 );
 
 CREATE INDEX IF NOT EXISTS idx_schedule_bot_id ON schedule(bot_id);
 CREATE INDEX IF NOT EXISTS idx_schedule_enabled ON schedule(enabled);
+CREATE INDEX IF NOT EXISTS idx_schedule_trigger_kind ON schedule(trigger_kind);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_schedule_workflow_id ON schedule(workflow_id) WHERE workflow_id IS NOT NULL;
 
 -- storage_providers: pluggable object storage backends
 CREATE TABLE IF NOT EXISTS storage_providers (
@@ -698,17 +732,23 @@ CREATE TABLE IF NOT EXISTS schedule_logs (
   schedule_id TEXT NOT NULL REFERENCES schedule(id) ON DELETE CASCADE,
   bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
   session_id TEXT REFERENCES bot_sessions(id) ON DELETE SET NULL,
-  status TEXT NOT NULL DEFAULT 'ok' CHECK (status IN ('ok', 'error')),
+  status TEXT NOT NULL DEFAULT 'ok' CHECK (status IN ('ok', 'error', 'retrying', 'retried', 'dead_letter')),
   result_text TEXT NOT NULL DEFAULT '',
   error_message TEXT NOT NULL DEFAULT '',
   usage TEXT,
   model_id TEXT REFERENCES models(id) ON DELETE SET NULL,
   started_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
-  completed_at TEXT
+  completed_at TEXT,
+  attempt INTEGER NOT NULL DEFAULT 1,
+  trigger_event TEXT NOT NULL DEFAULT '',
+  replay_of TEXT REFERENCES schedule_logs(id) ON DELETE SET NULL,
+  result_json TEXT
 );
 
 CREATE INDEX IF NOT EXISTS idx_schedule_logs_schedule ON schedule_logs(schedule_id, started_at DESC);
 CREATE INDEX IF NOT EXISTS idx_schedule_logs_bot ON schedule_logs(bot_id, started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_schedule_logs_failed ON schedule_logs(bot_id, started_at DESC)
+  WHERE status IN ('error', 'dead_letter');
 
 -- email_providers: pluggable email service backends
 CREATE TABLE IF NOT EXISTS email_providers (
@@ -836,3 +876,220 @@ CREATE INDEX IF NOT EXISTS idx_bot_user_grants_bot_id ON bot_user_grants(bot_id)
 CREATE INDEX IF NOT EXISTS idx_bot_user_grants_user_id ON bot_user_grants(user_id);
 CREATE UNIQUE INDEX IF NOT EXISTS idx_bot_user_grants_unique_user ON bot_user_grants(bot_id, user_id) WHERE subject_type = 'user';
 CREATE UNIQUE INDEX IF NOT EXISTS idx_bot_user_grants_unique_everyone ON bot_user_grants(bot_id) WHERE subject_type = 'everyone';
+
+-- budgets: per-bot and per-user spending limits over a daily or monthly period,
+-- measured in tokens or in cost priced from models.config.pricing.
+CREATE TABLE IF NOT EXISTS budgets (
+  id TEXT PRIMARY KEY,
+  bot_id TEXT REFERENCES bots(id) ON DELETE CASCADE,
+  user_id TEXT REFERENCES users(id) ON DELETE CASCADE,
+  period TEXT NOT NULL,
+  unit TEXT NOT NULL,
+  limit_amount REAL NOT NULL,
+  warn_percent INTEGER NOT NULL DEFAULT 80,
+  enabled INTEGER NOT NULL DEFAULT 1,
+  warned_period TEXT NOT NULL DEFAULT '',
+  exceeded_period TEXT NOT NULL DEFAULT '',
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT budgets_scope_check CHECK ((bot_id IS NULL) <> (user_id IS NULL)),
+  CONSTRAINT budgets_period_check CHECK (period IN ('daily', 'monthly')),
+  CONSTRAINT budgets_unit_check CHECK (unit IN ('tokens', 'cost')),
+  CONSTRAINT budgets_limit_check CHECK (limit_amount > 0),
+  CONSTRAINT budgets_warn_percent_check CHECK (warn_percent BETWEEN 0 AND 100)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budgets_bot_id ON budgets(bot_id);
+CREATE INDEX IF NOT EXISTS idx_budgets_user_id ON budgets(user_id);
+
+-- bot_model_fallbacks: ordered chat model fallback chain per bot, tried when
+-- the primary model's provider fails.
+CREATE TABLE IF NOT EXISTS bot_model_fallbacks (
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  model_id TEXT NOT NULL REFERENCES models(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (bot_id, model_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bot_model_fallbacks_bot_position ON bot_model_fallbacks(bot_id, position);
+
+-- user_identities: external (OpenID Connect) identities linked to users.
+CREATE TABLE IF NOT EXISTS user_identities (
+  id TEXT PRIMARY KEY,
+  user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  issuer TEXT NOT NULL,
+  subject TEXT NOT NULL,
+  email TEXT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_login_at TEXT,
+  CONSTRAINT user_identities_issuer_subject_unique UNIQUE (issuer, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
+
+-- personal_access_tokens: hashed, scoped API tokens for automation clients.
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+  id TEXT PRIMARY KEY,
+  user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  bot_id TEXT REFERENCES bots(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  token_prefix TEXT NOT NULL,
+  token_hash TEXT NOT NULL,
+  scopes TEXT NOT NULL,
+  expires_at TEXT,
+  last_used_at TEXT,
+  revoked_at TEXT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT personal_access_tokens_hash_unique UNIQUE (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON personal_access_tokens(user_id);
+
+-- audit_logs: append-only record of administrative and agent-side actions.
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  actor_type TEXT NOT NULL,
+  actor_id TEXT NOT NULL DEFAULT '',
+  bot_id TEXT,
+  action TEXT NOT NULL,
+  target_type TEXT NOT NULL DEFAULT '',
+  target_id TEXT NOT NULL DEFAULT '',
+  before TEXT NOT NULL DEFAULT 'null',
+  after TEXT NOT NULL DEFAULT 'null',
+  metadata TEXT NOT NULL DEFAULT '{}',
+  CONSTRAINT audit_logs_actor_type_check CHECK (actor_type IN ('user', 'bot', 'schedule', 'channel_identity', 'system'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_bot_id ON audit_logs(bot_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor ON audit_logs(actor_type, actor_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs(action);
+
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_update
+BEFORE UPDATE ON audit_logs
+BEGIN
+  SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_delete
+BEFORE DELETE ON audit_logs
+BEGIN
+  SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
+
+-- bot_delegations: tasks one bot hands to another, per hop.
+CREATE TABLE IF NOT EXISTS bot_delegations (
+  id TEXT PRIMARY KEY,
+  parent_id TEXT REFERENCES bot_delegations(id) ON DELETE SET NULL,
+  from_bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  from_session_id TEXT REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  to_bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  to_session_id TEXT REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  task TEXT NOT NULL,
+  hop INTEGER NOT NULL DEFAULT 1,
+  chain TEXT NOT NULL DEFAULT '[]',
+  status TEXT NOT NULL DEFAULT 'running',
+  result TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT '',
+  model_id TEXT REFERENCES models(id) ON DELETE SET NULL,
+  usage TEXT NOT NULL DEFAULT '{}',
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  completed_at TEXT,
+  CONSTRAINT bot_delegations_status_check CHECK (status IN ('running', 'completed', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_from_bot ON bot_delegations(from_bot_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_to_bot ON bot_delegations(to_bot_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_bot_delegations_to_session ON bot_delegations(to_session_id) WHERE to_session_id IS NOT NULL;
+
+-- workflows: declarative multi-step automations and their runs.
+CREATE TABLE IF NOT EXISTS workflows (
+  id TEXT PRIMARY KEY,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  source TEXT NOT NULL,
+  definition TEXT NOT NULL DEFAULT '{}',
+  pattern TEXT NOT NULL DEFAULT '',
+  enabled INTEGER NOT NULL DEFAULT 1,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT workflows_bot_name_unique UNIQUE (bot_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS workflow_runs (
+  id TEXT PRIMARY KEY,
+  workflow_id TEXT NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  session_id TEXT REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  trigger TEXT NOT NULL DEFAULT 'manual',
+  triggered_by_user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+  definition TEXT NOT NULL DEFAULT '{}',
+  inputs TEXT NOT NULL DEFAULT '{}',
+  status TEXT NOT NULL DEFAULT 'running',
+  error TEXT NOT NULL DEFAULT '',
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  completed_at TEXT,
+  CONSTRAINT workflow_runs_trigger_check CHECK (trigger IN ('manual', 'cron')),
+  CONSTRAINT workflow_runs_status_check CHECK (status IN ('running', 'completed', 'failed', 'cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_workflow ON workflow_runs(workflow_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_running ON workflow_runs(status) WHERE status = 'running';
+
+CREATE TABLE IF NOT EXISTS workflow_run_steps (
+  id TEXT PRIMARY KEY,
+  run_id TEXT NOT NULL REFERENCES workflow_runs(id) ON DELETE CASCADE,
+  step_id TEXT NOT NULL,
+  type TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'running',
+  input TEXT NOT NULL DEFAULT '{}',
+  output TEXT NOT NULL DEFAULT 'null',
+  error TEXT NOT NULL DEFAULT '',
+  ref TEXT NOT NULL DEFAULT '',
+  session_id TEXT REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  started_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  completed_at TEXT,
+  CONSTRAINT workflow_run_steps_run_step_unique UNIQUE (run_id, step_id),
+  CONSTRAINT workflow_run_steps_status_check CHECK (status IN ('running', 'waiting', 'completed', 'failed', 'skipped'))
+);
+
+-- bot_egress_policies: per-bot outbound network policy for workspaces.
+CREATE TABLE IF NOT EXISTS bot_egress_policies (
+  bot_id TEXT PRIMARY KEY REFERENCES bots(id) ON DELETE CASCADE,
+  policy TEXT NOT NULL DEFAULT '{}',
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- bot_templates: reusable blueprints new bots are created from.
+CREATE TABLE IF NOT EXISTS bot_templates (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  image TEXT NOT NULL DEFAULT '',
+  contents TEXT NOT NULL DEFAULT '{}',
+  size_bytes INTEGER NOT NULL DEFAULT 0,
+  source_bot_id TEXT REFERENCES bots(id) ON DELETE SET NULL,
+  created_by_user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  shared INTEGER NOT NULL DEFAULT 0,
+  CONSTRAINT bot_templates_name_unique UNIQUE (name)
+);
+
+-- bot_snapshot_policies: scheduled workspace snapshots and their retention.
+CREATE TABLE IF NOT EXISTS bot_snapshot_policies (
+  bot_id TEXT PRIMARY KEY REFERENCES bots(id) ON DELETE CASCADE,
+  enabled INTEGER NOT NULL DEFAULT 0,
+  keep_hourly INTEGER NOT NULL DEFAULT 0,
+  keep_daily INTEGER NOT NULL DEFAULT 0,
+  keep_weekly INTEGER NOT NULL DEFAULT 0,
+  keep_monthly INTEGER NOT NULL DEFAULT 0,
+  exec_patterns TEXT NOT NULL DEFAULT '[]',
+  before_skill_install INTEGER NOT NULL DEFAULT 0,
+  last_run_at TEXT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
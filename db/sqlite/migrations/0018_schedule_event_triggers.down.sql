@@ -0,0 +1,38 @@
+-- 0018_schedule_event_triggers
+-- Remove event-triggered schedules. Event schedules have no cron pattern, so
+-- they are deleted rather than left behind as broken cron entries.
+
+PRAGMA foreign_keys = OFF;
+
+DELETE FROM schedule WHERE trigger_kind <> 'cron';
+
+CREATE TABLE schedule_new (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL,
+  pattern TEXT NOT NULL,
+  max_calls INTEGER,
+  current_calls INTEGER NOT NULL DEFAULT 0,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  enabled INTEGER NOT NULL DEFAULT 1,
+  command TEXT NOT NULL,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE
+);
+
+INSERT INTO schedule_new (
+  id, name, description, pattern, max_calls, current_calls,
+  created_at, updated_at, enabled, command, bot_id
+)
+SELECT
+  id, name, description, pattern, max_calls, current_calls,
+  created_at, updated_at, enabled, command, bot_id
+FROM schedule;
+
+DROP TABLE schedule;
+ALTER TABLE schedule_new RENAME TO schedule;
+
+CREATE INDEX IF NOT EXISTS idx_schedule_bot_id ON schedule(bot_id);
+CREATE INDEX IF NOT EXISTS idx_schedule_enabled ON schedule(enabled);
+
+PRAGMA foreign_keys = ON;
@@ -0,0 +1,44 @@
+-- 0018_schedule_event_triggers
+-- Add event-triggered schedules. trigger_kind selects what fires the schedule
+-- ('cron' keeps the existing pattern-driven behaviour); trigger_filter narrows
+-- which events of that kind match.
+--
+-- The 0001 baseline already carries both columns and SQLite has no
+-- `ADD COLUMN IF NOT EXISTS`, so rebuild the table instead of altering it to
+-- keep fresh replays and incremental upgrades on the same path.
+
+PRAGMA foreign_keys = OFF;
+
+CREATE TABLE schedule_new (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL,
+  pattern TEXT NOT NULL,
+  max_calls INTEGER,
+  current_calls INTEGER NOT NULL DEFAULT 0,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  enabled INTEGER NOT NULL DEFAULT 1,
+  command TEXT NOT NULL,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  trigger_kind TEXT NOT NULL DEFAULT 'cron',
+  trigger_filter TEXT NOT NULL DEFAULT '{}'
+);
+
+INSERT INTO schedule_new (
+  id, name, description, pattern, max_calls, current_calls,
+  created_at, updated_at, enabled, command, bot_id
+)
+SELECT
+  id, name, description, pattern, max_calls, current_calls,
+  created_at, updated_at, enabled, command, bot_id
+FROM schedule;
+
+DROP TABLE schedule;
+ALTER TABLE schedule_new RENAME TO schedule;
+
+CREATE INDEX IF NOT EXISTS idx_schedule_bot_id ON schedule(bot_id);
+CREATE INDEX IF NOT EXISTS idx_schedule_enabled ON schedule(enabled);
+CREATE INDEX IF NOT EXISTS idx_schedule_trigger_kind ON schedule(trigger_kind);
+
+PRAGMA foreign_keys = ON;
@@ -0,0 +1,4 @@
+-- 0020_budgets
+-- Remove spending budgets.
+
+DROP TABLE IF EXISTS budgets;
@@ -0,0 +1,4 @@
+-- 0021_bot_model_fallbacks
+-- Remove per-bot model fallback chains.
+
+DROP TABLE IF EXISTS bot_model_fallbacks;
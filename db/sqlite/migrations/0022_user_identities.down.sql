@@ -0,0 +1,4 @@
+-- 0022_user_identities (rollback)
+-- Drop external identity links.
+
+DROP TABLE IF EXISTS user_identities;
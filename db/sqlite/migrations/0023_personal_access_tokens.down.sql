@@ -0,0 +1,4 @@
+-- 0023_personal_access_tokens
+-- Remove personal access tokens.
+
+DROP TABLE IF EXISTS personal_access_tokens;
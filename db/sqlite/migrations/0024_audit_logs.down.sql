@@ -0,0 +1,4 @@
+-- 0024_audit_logs
+-- Remove the audit log.
+
+DROP TABLE IF EXISTS audit_logs;
@@ -0,0 +1,35 @@
+-- 0024_audit_logs
+-- Add an append-only audit log of administrative and agent-side actions.
+-- bot_id has no foreign key so entries outlive the bots they describe, and
+-- triggers reject UPDATE and DELETE.
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  actor_type TEXT NOT NULL,
+  actor_id TEXT NOT NULL DEFAULT '',
+  bot_id TEXT,
+  action TEXT NOT NULL,
+  target_type TEXT NOT NULL DEFAULT '',
+  target_id TEXT NOT NULL DEFAULT '',
+  before TEXT NOT NULL DEFAULT 'null',
+  after TEXT NOT NULL DEFAULT 'null',
+  metadata TEXT NOT NULL DEFAULT '{}',
+  CONSTRAINT audit_logs_actor_type_check CHECK (actor_type IN ('user', 'bot', 'schedule', 'channel_identity', 'system'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_bot_id ON audit_logs(bot_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor ON audit_logs(actor_type, actor_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs(action);
+
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_update
+BEFORE UPDATE ON audit_logs
+BEGIN
+  SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_delete
+BEFORE DELETE ON audit_logs
+BEGIN
+  SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
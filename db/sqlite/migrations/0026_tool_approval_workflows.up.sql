@@ -0,0 +1,31 @@
+-- 0026_tool_approval_workflows
+-- Add per-request approval workflows (approvers, quorum, escalation and
+-- expiry) and the votes cast on them.
+
+CREATE TABLE IF NOT EXISTS tool_approval_workflows (
+  request_id TEXT PRIMARY KEY REFERENCES tool_approval_requests(id) ON DELETE CASCADE,
+  approvers TEXT NOT NULL DEFAULT '[]',
+  quorum INTEGER NOT NULL DEFAULT 1,
+  escalation_approvers TEXT NOT NULL DEFAULT '[]',
+  escalate_at TEXT,
+  escalated_at TEXT,
+  expires_at TEXT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_tool_approval_workflows_escalate_at
+  ON tool_approval_workflows(escalate_at)
+  WHERE escalate_at IS NOT NULL AND escalated_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tool_approval_workflows_expires_at
+  ON tool_approval_workflows(expires_at)
+  WHERE expires_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS tool_approval_votes (
+  request_id TEXT NOT NULL REFERENCES tool_approval_requests(id) ON DELETE CASCADE,
+  channel_identity_id TEXT NOT NULL,
+  decision TEXT NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (request_id, channel_identity_id),
+  CONSTRAINT tool_approval_votes_decision_check CHECK (decision IN ('approve', 'reject'))
+);
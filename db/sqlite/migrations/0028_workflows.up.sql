@@ -0,0 +1,54 @@
+-- 0028_workflows
+-- Add workflows, declarative multi-step automations across bots, with their
+-- runs and the persisted inputs and outputs of each step.
+
+CREATE TABLE IF NOT EXISTS workflows (
+  id TEXT PRIMARY KEY,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  source TEXT NOT NULL,
+  definition TEXT NOT NULL DEFAULT '{}',
+  pattern TEXT NOT NULL DEFAULT '',
+  enabled INTEGER NOT NULL DEFAULT 1,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT workflows_bot_name_unique UNIQUE (bot_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS workflow_runs (
+  id TEXT PRIMARY KEY,
+  workflow_id TEXT NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  session_id TEXT REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  trigger TEXT NOT NULL DEFAULT 'manual',
+  triggered_by_user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+  definition TEXT NOT NULL DEFAULT '{}',
+  inputs TEXT NOT NULL DEFAULT '{}',
+  status TEXT NOT NULL DEFAULT 'running',
+  error TEXT NOT NULL DEFAULT '',
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  completed_at TEXT,
+  CONSTRAINT workflow_runs_trigger_check CHECK (trigger IN ('manual', 'cron')),
+  CONSTRAINT workflow_runs_status_check CHECK (status IN ('running', 'completed', 'failed', 'cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_workflow ON workflow_runs(workflow_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_running ON workflow_runs(status) WHERE status = 'running';
+
+CREATE TABLE IF NOT EXISTS workflow_run_steps (
+  id TEXT PRIMARY KEY,
+  run_id TEXT NOT NULL REFERENCES workflow_runs(id) ON DELETE CASCADE,
+  step_id TEXT NOT NULL,
+  type TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'running',
+  input TEXT NOT NULL DEFAULT '{}',
+  output TEXT NOT NULL DEFAULT 'null',
+  error TEXT NOT NULL DEFAULT '',
+  ref TEXT NOT NULL DEFAULT '',
+  session_id TEXT REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  started_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  completed_at TEXT,
+  CONSTRAINT workflow_run_steps_run_step_unique UNIQUE (run_id, step_id),
+  CONSTRAINT workflow_run_steps_status_check CHECK (status IN ('running', 'waiting', 'completed', 'failed', 'skipped'))
+);
@@ -0,0 +1,82 @@
+-- 0029_structured_output
+-- Add structured output to schedules. output_schema is an optional JSON
+-- Schema the final reply of every run must satisfy; result_json keeps the
+-- validated JSON of a run next to its free-text result.
+--
+-- The 0001 baseline already carries both columns and SQLite has no
+-- `ADD COLUMN IF NOT EXISTS`, so both tables are rebuilt.
+
+PRAGMA foreign_keys = OFF;
+
+CREATE TABLE schedule_new (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL,
+  pattern TEXT NOT NULL,
+  max_calls INTEGER,
+  current_calls INTEGER NOT NULL DEFAULT 0,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  enabled INTEGER NOT NULL DEFAULT 1,
+  command TEXT NOT NULL,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  trigger_kind TEXT NOT NULL DEFAULT 'cron',
+  trigger_filter TEXT NOT NULL DEFAULT '{}',
+  retry_policy TEXT NOT NULL DEFAULT '{}',
+  output_schema TEXT
+);
+
+INSERT INTO schedule_new (
+  id, name, description, pattern, max_calls, current_calls,
+  created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter,
+  retry_policy
+)
+SELECT
+  id, name, description, pattern, max_calls, current_calls,
+  created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter,
+  retry_policy
+FROM schedule;
+
+DROP TABLE schedule;
+ALTER TABLE schedule_new RENAME TO schedule;
+
+CREATE INDEX IF NOT EXISTS idx_schedule_bot_id ON schedule(bot_id);
+CREATE INDEX IF NOT EXISTS idx_schedule_enabled ON schedule(enabled);
+CREATE INDEX IF NOT EXISTS idx_schedule_trigger_kind ON schedule(trigger_kind);
+
+CREATE TABLE schedule_logs_new (
+  id TEXT PRIMARY KEY,
+  schedule_id TEXT NOT NULL REFERENCES schedule(id) ON DELETE CASCADE,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  session_id TEXT REFERENCES bot_sessions(id) ON DELETE SET NULL,
+  status TEXT NOT NULL DEFAULT 'ok' CHECK (status IN ('ok', 'error', 'retrying', 'retried', 'dead_letter')),
+  result_text TEXT NOT NULL DEFAULT '',
+  error_message TEXT NOT NULL DEFAULT '',
+  usage TEXT,
+  model_id TEXT REFERENCES models(id) ON DELETE SET NULL,
+  started_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  completed_at TEXT,
+  attempt INTEGER NOT NULL DEFAULT 1,
+  trigger_event TEXT NOT NULL DEFAULT '',
+  replay_of TEXT REFERENCES schedule_logs(id) ON DELETE SET NULL,
+  result_json TEXT
+);
+
+INSERT INTO schedule_logs_new (
+  id, schedule_id, bot_id, session_id, status, result_text, error_message,
+  usage, model_id, started_at, completed_at, attempt, trigger_event, replay_of
+)
+SELECT
+  id, schedule_id, bot_id, session_id, status, result_text, error_message,
+  usage, model_id, started_at, completed_at, attempt, trigger_event, replay_of
+FROM schedule_logs;
+
+DROP TABLE schedule_logs;
+ALTER TABLE schedule_logs_new RENAME TO schedule_logs;
+
+CREATE INDEX IF NOT EXISTS idx_schedule_logs_schedule ON schedule_logs(schedule_id, started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_schedule_logs_bot ON schedule_logs(bot_id, started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_schedule_logs_failed ON schedule_logs(bot_id, started_at DESC)
+  WHERE status IN ('error', 'dead_letter');
+
+PRAGMA foreign_keys = ON;
@@ -0,0 +1,4 @@
+-- 0030_bot_egress_policies
+-- Remove per-bot egress policies.
+
+DROP TABLE IF EXISTS bot_egress_policies;
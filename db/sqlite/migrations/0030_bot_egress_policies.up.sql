@@ -0,0 +1,10 @@
+-- 0030_bot_egress_policies
+-- Add per-bot egress policies: allow and deny lists by domain, CIDR and port
+-- that the workspace firewall and egress proxy enforce.
+
+CREATE TABLE IF NOT EXISTS bot_egress_policies (
+  bot_id TEXT PRIMARY KEY REFERENCES bots(id) ON DELETE CASCADE,
+  policy TEXT NOT NULL DEFAULT '{}',
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,18 @@
+-- 0031_bot_templates
+-- Add bot templates: named blueprints bundling a workspace image, a seed for
+-- /data, settings, MCP connections and schedules that new bots are created
+-- from. The bundle itself is kept in the template store on disk.
+
+CREATE TABLE IF NOT EXISTS bot_templates (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  image TEXT NOT NULL DEFAULT '',
+  contents TEXT NOT NULL DEFAULT '{}',
+  size_bytes INTEGER NOT NULL DEFAULT 0,
+  source_bot_id TEXT REFERENCES bots(id) ON DELETE SET NULL,
+  created_by_user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT bot_templates_name_unique UNIQUE (name)
+);
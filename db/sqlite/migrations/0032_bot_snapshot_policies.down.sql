@@ -0,0 +1,4 @@
+-- 0032_bot_snapshot_policies
+-- Remove per-bot workspace snapshot policies.
+
+DROP TABLE IF EXISTS bot_snapshot_policies;
@@ -0,0 +1,18 @@
+-- 0032_bot_snapshot_policies
+-- Add per-bot workspace snapshot policies: scheduled snapshots kept with
+-- grandfather-father-son retention, and snapshots taken before risky exec
+-- commands and skill installs.
+
+CREATE TABLE IF NOT EXISTS bot_snapshot_policies (
+  bot_id TEXT PRIMARY KEY REFERENCES bots(id) ON DELETE CASCADE,
+  enabled INTEGER NOT NULL DEFAULT 0,
+  keep_hourly INTEGER NOT NULL DEFAULT 0,
+  keep_daily INTEGER NOT NULL DEFAULT 0,
+  keep_weekly INTEGER NOT NULL DEFAULT 0,
+  keep_monthly INTEGER NOT NULL DEFAULT 0,
+  exec_patterns TEXT NOT NULL DEFAULT '[]',
+  before_skill_install INTEGER NOT NULL DEFAULT 0,
+  last_run_at TEXT,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
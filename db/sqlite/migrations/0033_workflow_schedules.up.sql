@@ -0,0 +1,63 @@
+-- 0033_workflow_schedules
+-- Run cron-triggered workflows through schedules. A schedule with a
+-- workflow_id starts a run of that workflow instead of prompting the agent;
+-- it is kept in step with the workflow's cron pattern and removed with it.
+--
+-- The 0001 baseline already carries the column and SQLite has no
+-- `ADD COLUMN IF NOT EXISTS`, so the table is rebuilt.
+
+PRAGMA foreign_keys = OFF;
+
+CREATE TABLE schedule_new (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL,
+  pattern TEXT NOT NULL,
+  max_calls INTEGER,
+  current_calls INTEGER NOT NULL DEFAULT 0,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  enabled INTEGER NOT NULL DEFAULT 1,
+  command TEXT NOT NULL,
+  bot_id TEXT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+  trigger_kind TEXT NOT NULL DEFAULT 'cron',
+  trigger_filter TEXT NOT NULL DEFAULT '{}',
+  retry_policy TEXT NOT NULL DEFAULT '{}',
+  output_schema TEXT,
+  workflow_id TEXT REFERENCES workflows(id) ON DELETE CASCADE
+);
+
+INSERT INTO schedule_new (
+  id, name, description, pattern, max_calls, current_calls,
+  created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter,
+  retry_policy, output_schema
+)
+SELECT
+  id, name, description, pattern, max_calls, current_calls,
+  created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter,
+  retry_policy, output_schema
+FROM schedule;
+
+DROP TABLE schedule;
+ALTER TABLE schedule_new RENAME TO schedule;
+
+CREATE INDEX IF NOT EXISTS idx_schedule_bot_id ON schedule(bot_id);
+CREATE INDEX IF NOT EXISTS idx_schedule_enabled ON schedule(enabled);
+CREATE INDEX IF NOT EXISTS idx_schedule_trigger_kind ON schedule(trigger_kind);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_schedule_workflow_id ON schedule(workflow_id) WHERE workflow_id IS NOT NULL;
+
+PRAGMA foreign_keys = ON;
+
+INSERT INTO schedule (id, name, description, pattern, enabled, command, bot_id, workflow_id)
+SELECT
+  lower(hex(randomblob(4))) || '-' ||
+  lower(hex(randomblob(2))) || '-' ||
+  '4' || substr(lower(hex(randomblob(2))), 2) || '-' ||
+  substr('89ab', abs(random()) % 4 + 1, 1) || substr(lower(hex(randomblob(2))), 2) || '-' ||
+  lower(hex(randomblob(6))),
+  'Workflow: ' || w.name,
+  'Runs workflow ' || w.name || ' on its cron pattern.',
+  w.pattern, w.enabled, 'Run workflow ' || w.name, w.bot_id, w.id
+FROM workflows w
+WHERE w.pattern <> ''
+  AND NOT EXISTS (SELECT 1 FROM schedule s WHERE s.workflow_id = w.id);
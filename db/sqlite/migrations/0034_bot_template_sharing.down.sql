@@ -0,0 +1,32 @@
+-- 0034_bot_template_sharing
+-- Remove template sharing; every template is visible to every user again.
+
+PRAGMA foreign_keys = OFF;
+
+CREATE TABLE bot_templates_new (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  image TEXT NOT NULL DEFAULT '',
+  contents TEXT NOT NULL DEFAULT '{}',
+  size_bytes INTEGER NOT NULL DEFAULT 0,
+  source_bot_id TEXT REFERENCES bots(id) ON DELETE SET NULL,
+  created_by_user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+  created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT bot_templates_name_unique UNIQUE (name)
+);
+
+INSERT INTO bot_templates_new (
+  id, name, description, image, contents, size_bytes, source_bot_id,
+  created_by_user_id, created_at, updated_at
+)
+SELECT
+  id, name, description, image, contents, size_bytes, source_bot_id,
+  created_by_user_id, created_at, updated_at
+FROM bot_templates;
+
+DROP TABLE bot_templates;
+ALTER TABLE bot_templates_new RENAME TO bot_templates;
+
+PRAGMA foreign_keys = ON;
@@ -93,6 +93,13 @@ RETURNING id, bot_id, enabled, description, action, effect, channel_identity_id,
   subject_channel_type, source_channel, source_conversation_type, source_conversation_id, source_thread_id,
   created_by_user_id, created_at, updated_at;
 
+-- name: GetBotACLRuleByID :one
+SELECT id, bot_id, enabled, description, action, effect, channel_identity_id,
+  subject_channel_type, source_channel, source_conversation_type, source_conversation_id, source_thread_id,
+  created_by_user_id, created_at, updated_at
+FROM bot_acl_rules
+WHERE id = sqlc.arg(id);
+
 -- name: UpdateBotACLRule :one
 UPDATE bot_acl_rules
 SET
@@ -0,0 +1,48 @@
+-- name: CreateAuditLog :one
+INSERT INTO audit_logs (actor_type, actor_id, bot_id, action, target_type, target_id, before, after, metadata)
+VALUES (
+  sqlc.arg(actor_type),
+  sqlc.arg(actor_id),
+  sqlc.narg(bot_id),
+  sqlc.arg(action),
+  sqlc.arg(target_type),
+  sqlc.arg(target_id),
+  sqlc.arg(before),
+  sqlc.arg(after),
+  sqlc.arg(metadata)
+)
+RETURNING *;
+
+-- name: ListAuditLogs :many
+SELECT * FROM audit_logs
+WHERE (sqlc.narg(bot_id) IS NULL OR bot_id = sqlc.narg(bot_id))
+  AND (sqlc.narg(actor_type) IS NULL OR actor_type = sqlc.narg(actor_type))
+  AND (sqlc.narg(actor_id) IS NULL OR actor_id = sqlc.narg(actor_id))
+  AND (
+    sqlc.narg(action) IS NULL
+    OR action = sqlc.narg(action)
+    OR action LIKE sqlc.narg(action) || '.%'
+  )
+  AND (sqlc.narg(target_type) IS NULL OR target_type = sqlc.narg(target_type))
+  AND (sqlc.narg(target_id) IS NULL OR target_id = sqlc.narg(target_id))
+  AND (sqlc.narg(since) IS NULL OR datetime(created_at) >= datetime(sqlc.narg(since)))
+  AND (sqlc.narg(until) IS NULL OR datetime(created_at) < datetime(sqlc.narg(until)))
+  AND (sqlc.arg(before_id) = 0 OR id < sqlc.arg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAuditLogs :one
+SELECT COUNT(*) AS total FROM audit_logs
+WHERE (sqlc.narg(bot_id) IS NULL OR bot_id = sqlc.narg(bot_id))
+  AND (sqlc.narg(actor_type) IS NULL OR actor_type = sqlc.narg(actor_type))
+  AND (sqlc.narg(actor_id) IS NULL OR actor_id = sqlc.narg(actor_id))
+  AND (
+    sqlc.narg(action) IS NULL
+    OR action = sqlc.narg(action)
+    OR action LIKE sqlc.narg(action) || '.%'
+  )
+  AND (sqlc.narg(target_type) IS NULL OR target_type = sqlc.narg(target_type))
+  AND (sqlc.narg(target_id) IS NULL OR target_id = sqlc.narg(target_id))
+  AND (sqlc.narg(since) IS NULL OR datetime(created_at) >= datetime(sqlc.narg(since)))
+  AND (sqlc.narg(until) IS NULL OR datetime(created_at) < datetime(sqlc.narg(until)));
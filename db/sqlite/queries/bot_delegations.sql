@@ -0,0 +1,45 @@
+-- name: CreateBotDelegation :one
+INSERT INTO bot_delegations (id, parent_id, from_bot_id, from_session_id, to_bot_id, to_session_id, task, hop, chain)
+VALUES (
+  lower(hex(randomblob(4))) || '-' ||
+  lower(hex(randomblob(2))) || '-' ||
+  '4' || substr(lower(hex(randomblob(2))), 2) || '-' ||
+  substr('89ab', abs(random()) % 4 + 1, 1) || substr(lower(hex(randomblob(2))), 2) || '-' ||
+  lower(hex(randomblob(6))),
+  sqlc.narg(parent_id),
+  sqlc.arg(from_bot_id),
+  sqlc.narg(from_session_id),
+  sqlc.arg(to_bot_id),
+  sqlc.narg(to_session_id),
+  sqlc.arg(task),
+  sqlc.arg(hop),
+  sqlc.arg(chain)
+)
+RETURNING *;
+
+-- name: CompleteBotDelegation :one
+UPDATE bot_delegations
+SET status = sqlc.arg(status),
+    result = sqlc.arg(result),
+    error = sqlc.arg(error),
+    model_id = sqlc.narg(model_id),
+    usage = sqlc.arg(usage),
+    completed_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id)
+  AND status = 'running'
+RETURNING *;
+
+-- name: GetBotDelegationByID :one
+SELECT * FROM bot_delegations WHERE id = sqlc.arg(id);
+
+-- name: GetBotDelegationByToSession :one
+SELECT * FROM bot_delegations
+WHERE to_session_id = sqlc.arg(to_session_id)
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ListBotDelegationsByBot :many
+SELECT * FROM bot_delegations
+WHERE from_bot_id = sqlc.arg(bot_id) OR to_bot_id = sqlc.arg(bot_id)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(page_limit);
@@ -0,0 +1,10 @@
+-- name: GetBotEgressPolicy :one
+SELECT * FROM bot_egress_policies WHERE bot_id = sqlc.arg(bot_id);
+
+-- name: UpsertBotEgressPolicy :one
+INSERT INTO bot_egress_policies (bot_id, policy)
+VALUES (sqlc.arg(bot_id), sqlc.arg(policy))
+ON CONFLICT (bot_id) DO UPDATE
+SET policy = EXCLUDED.policy,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
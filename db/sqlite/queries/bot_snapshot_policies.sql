@@ -0,0 +1,35 @@
+-- name: GetBotSnapshotPolicy :one
+SELECT * FROM bot_snapshot_policies WHERE bot_id = sqlc.arg(bot_id);
+
+-- name: UpsertBotSnapshotPolicy :one
+INSERT INTO bot_snapshot_policies (bot_id, enabled, keep_hourly, keep_daily, keep_weekly, keep_monthly, exec_patterns, before_skill_install)
+VALUES (
+  sqlc.arg(bot_id),
+  sqlc.arg(enabled),
+  sqlc.arg(keep_hourly),
+  sqlc.arg(keep_daily),
+  sqlc.arg(keep_weekly),
+  sqlc.arg(keep_monthly),
+  sqlc.arg(exec_patterns),
+  sqlc.arg(before_skill_install)
+)
+ON CONFLICT (bot_id) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    keep_hourly = EXCLUDED.keep_hourly,
+    keep_daily = EXCLUDED.keep_daily,
+    keep_weekly = EXCLUDED.keep_weekly,
+    keep_monthly = EXCLUDED.keep_monthly,
+    exec_patterns = EXCLUDED.exec_patterns,
+    before_skill_install = EXCLUDED.before_skill_install,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: ListEnabledBotSnapshotPolicies :many
+SELECT * FROM bot_snapshot_policies
+WHERE enabled = true
+ORDER BY bot_id ASC;
+
+-- name: MarkBotSnapshotPolicyRun :exec
+UPDATE bot_snapshot_policies
+SET last_run_at = CURRENT_TIMESTAMP
+WHERE bot_id = sqlc.arg(bot_id);
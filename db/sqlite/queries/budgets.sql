@@ -0,0 +1,63 @@
+-- name: CreateBudget :one
+INSERT INTO budgets (id, bot_id, user_id, period, unit, limit_amount, warn_percent, enabled)
+VALUES (
+  lower(hex(randomblob(4))) || '-' ||
+  lower(hex(randomblob(2))) || '-' ||
+  '4' || substr(lower(hex(randomblob(2))), 2) || '-' ||
+  substr('89ab', abs(random()) % 4 + 1, 1) || substr(lower(hex(randomblob(2))), 2) || '-' ||
+  lower(hex(randomblob(6))),
+  sqlc.narg(bot_id),
+  sqlc.narg(user_id),
+  sqlc.arg(period),
+  sqlc.arg(unit),
+  sqlc.arg(limit_amount),
+  sqlc.arg(warn_percent),
+  sqlc.arg(enabled)
+)
+RETURNING *;
+
+-- name: GetBudgetByID :one
+SELECT * FROM budgets WHERE id = sqlc.arg(id);
+
+-- name: ListBudgetsByBot :many
+SELECT * FROM budgets
+WHERE bot_id = sqlc.arg(bot_id)
+ORDER BY created_at ASC;
+
+-- name: ListBudgetsByUser :many
+SELECT * FROM budgets
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY created_at ASC;
+
+-- name: ListEnabledBudgetsForBot :many
+SELECT * FROM budgets
+WHERE enabled = 1
+  AND (bot_id = sqlc.arg(bot_id) OR user_id = sqlc.arg(owner_user_id))
+ORDER BY created_at ASC;
+
+-- name: UpdateBudget :one
+UPDATE budgets
+SET
+  period = sqlc.arg(period),
+  unit = sqlc.arg(unit),
+  limit_amount = sqlc.arg(limit_amount),
+  warn_percent = sqlc.arg(warn_percent),
+  enabled = sqlc.arg(enabled),
+  warned_period = '',
+  exceeded_period = '',
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteBudget :exec
+DELETE FROM budgets WHERE id = sqlc.arg(id);
+
+-- name: MarkBudgetWarned :execrows
+UPDATE budgets
+SET warned_period = sqlc.arg(period_key)
+WHERE id = sqlc.arg(id) AND warned_period <> sqlc.arg(period_key);
+
+-- name: MarkBudgetExceeded :execrows
+UPDATE budgets
+SET exceeded_period = sqlc.arg(period_key)
+WHERE id = sqlc.arg(id) AND exceeded_period <> sqlc.arg(period_key);
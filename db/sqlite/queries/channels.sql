@@ -95,3 +95,9 @@ SELECT id, user_id, channel_type, config, created_at, updated_at
 FROM user_channel_bindings
 WHERE channel_type = sqlc.arg(channel_type)
 ORDER BY created_at DESC;
+
+-- name: ListUserChannelBindingsByUser :many
+SELECT id, user_id, channel_type, config, created_at, updated_at
+FROM user_channel_bindings
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY created_at ASC;
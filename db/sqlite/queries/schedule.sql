@@ -1,5 +1,5 @@
 -- name: CreateSchedule :one
-INSERT INTO schedule (id, name, description, pattern, max_calls, enabled, command, bot_id)
+INSERT INTO schedule (id, name, description, pattern, max_calls, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id)
 VALUES (
   lower(hex(randomblob(4))) || '-' ||
   lower(hex(randomblob(2))) || '-' ||
@@ -12,23 +12,33 @@ VALUES (
   sqlc.arg(max_calls),
   sqlc.arg(enabled),
   sqlc.arg(command),
-  sqlc.arg(bot_id)
+  sqlc.arg(bot_id),
+  sqlc.arg(trigger_kind),
+  sqlc.arg(trigger_filter),
+  sqlc.arg(retry_policy),
+  sqlc.arg(output_schema),
+  sqlc.narg(workflow_id)
 )
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id;
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id;
 
 -- name: GetScheduleByID :one
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE id = sqlc.arg(id);
 
+-- name: GetScheduleByWorkflowID :one
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
+FROM schedule
+WHERE workflow_id = sqlc.arg(workflow_id);
+
 -- name: ListSchedulesByBot :many
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE bot_id = sqlc.arg(bot_id)
 ORDER BY created_at DESC;
 
 -- name: ListEnabledSchedules :many
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE enabled = true
 ORDER BY created_at DESC;
@@ -41,9 +51,13 @@ SET name = sqlc.arg(name),
     max_calls = sqlc.arg(max_calls),
     enabled = sqlc.arg(enabled),
     command = sqlc.arg(command),
+    trigger_kind = sqlc.arg(trigger_kind),
+    trigger_filter = sqlc.arg(trigger_filter),
+    retry_policy = sqlc.arg(retry_policy),
+    output_schema = sqlc.arg(output_schema),
     updated_at = CURRENT_TIMESTAMP
 WHERE id = sqlc.arg(id)
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id;
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id;
 
 -- name: DeleteSchedule :exec
 DELETE FROM schedule WHERE id = sqlc.arg(id);
@@ -57,4 +71,4 @@ SET current_calls = current_calls + 1,
     END,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = sqlc.arg(id)
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id;
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id;
@@ -1,5 +1,5 @@
 -- name: CreateScheduleLog :one
-INSERT INTO schedule_logs (id, schedule_id, bot_id, session_id, started_at)
+INSERT INTO schedule_logs (id, schedule_id, bot_id, session_id, attempt, trigger_event, replay_of, started_at)
 VALUES (
   lower(hex(randomblob(4))) || '-' ||
   lower(hex(randomblob(2))) || '-' ||
@@ -9,9 +9,12 @@ VALUES (
   sqlc.arg(schedule_id),
   sqlc.arg(bot_id),
   sqlc.narg(session_id),
+  sqlc.arg(attempt),
+  sqlc.arg(trigger_event),
+  sqlc.narg(replay_of),
   CURRENT_TIMESTAMP
 )
-RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at;
+RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json;
 
 -- name: CompleteScheduleLog :one
 UPDATE schedule_logs
@@ -20,12 +23,13 @@ SET status = sqlc.arg(status),
     error_message = sqlc.arg(error_message),
     usage = sqlc.arg(usage),
     model_id = sqlc.arg(model_id),
+    result_json = sqlc.arg(result_json),
     completed_at = CURRENT_TIMESTAMP
 WHERE id = sqlc.arg(id)
-RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, model_id, started_at, completed_at;
+RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, model_id, started_at, completed_at, attempt, trigger_event, replay_of, result_json;
 
 -- name: ListScheduleLogsByBot :many
-SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 FROM schedule_logs
 WHERE bot_id = sqlc.arg(bot_id)
 ORDER BY started_at DESC
@@ -35,7 +39,7 @@ LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
 SELECT count(*) FROM schedule_logs WHERE bot_id = sqlc.arg(bot_id);
 
 -- name: ListScheduleLogsBySchedule :many
-SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 FROM schedule_logs
 WHERE schedule_id = sqlc.arg(schedule_id)
 ORDER BY started_at DESC
@@ -44,6 +48,42 @@ LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
 -- name: CountScheduleLogsBySchedule :one
 SELECT count(*) FROM schedule_logs WHERE schedule_id = sqlc.arg(schedule_id);
 
+-- name: GetScheduleLogByID :one
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
+FROM schedule_logs
+WHERE id = sqlc.arg(id);
+
+-- name: ListFailedScheduleLogsByBot :many
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
+FROM schedule_logs
+WHERE bot_id = sqlc.arg(bot_id)
+  AND status IN ('error', 'dead_letter')
+ORDER BY started_at DESC
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: CountFailedScheduleLogsByBot :one
+SELECT count(*) FROM schedule_logs WHERE bot_id = sqlc.arg(bot_id) AND status IN ('error', 'dead_letter');
+
+-- name: CountActiveScheduleReplays :one
+-- Replays of a log that are still running, waiting on a retry or succeeded.
+SELECT count(*) FROM schedule_logs
+WHERE replay_of = sqlc.arg(replay_of)
+  AND (completed_at IS NULL OR status IN ('ok', 'retrying', 'retried'));
+
+-- name: ResolveScheduleLogRetry :exec
+UPDATE schedule_logs SET status = sqlc.arg(status) WHERE id = sqlc.arg(id) AND status = 'retrying';
+
+-- name: DeadLetterPendingScheduleRetries :exec
+UPDATE schedule_logs SET status = 'dead_letter' WHERE status = 'retrying';
+
+-- name: FailInterruptedScheduleLogs :exec
+-- Runs still in flight when the server stopped will never complete.
+UPDATE schedule_logs
+SET status = 'error',
+    error_message = 'interrupted by a server restart',
+    completed_at = CURRENT_TIMESTAMP
+WHERE completed_at IS NULL;
+
 -- name: DeleteScheduleLogsByBot :exec
 DELETE FROM schedule_logs WHERE bot_id = sqlc.arg(bot_id);
 
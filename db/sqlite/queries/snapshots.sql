@@ -50,3 +50,8 @@ FROM snapshots
 WHERE container_id = sqlc.arg(container_id)
   AND runtime_snapshot_name = sqlc.arg(runtime_snapshot_name)
 LIMIT 1;
+
+-- name: DeleteUnversionedSnapshot :exec
+DELETE FROM snapshots
+WHERE id = sqlc.arg(id)
+  AND NOT EXISTS (SELECT 1 FROM container_versions WHERE snapshot_id = sqlc.arg(id));
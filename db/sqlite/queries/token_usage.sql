@@ -97,3 +97,38 @@ WHERE m.bot_id = sqlc.arg(bot_id)
       'chat'
     ) = sqlc.narg(session_type)
   );
+
+-- name: GetBotBudgetUsageByModel :many
+SELECT
+  m.model_id,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.inputTokens') AS INTEGER)), 0) AS input_tokens,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.outputTokens') AS INTEGER)), 0) AS output_tokens,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.inputTokenDetails.cacheReadTokens') AS INTEGER)), 0) AS cache_read_tokens,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.input_per_million') AS REAL)), 0.0) AS input_price,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.output_per_million') AS REAL)), 0.0) AS output_price,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.cache_read_per_million') AS REAL)), 0.0) AS cache_read_price
+FROM bot_history_messages m
+LEFT JOIN models mo ON mo.id = m.model_id
+WHERE m.bot_id = sqlc.arg(bot_id)
+  AND m.usage IS NOT NULL
+  AND json_valid(m.usage)
+  AND datetime(m.created_at) >= datetime(sqlc.arg(from_time))
+GROUP BY m.model_id;
+
+-- name: GetOwnerBudgetUsageByModel :many
+SELECT
+  m.model_id,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.inputTokens') AS INTEGER)), 0) AS input_tokens,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.outputTokens') AS INTEGER)), 0) AS output_tokens,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.inputTokenDetails.cacheReadTokens') AS INTEGER)), 0) AS cache_read_tokens,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.input_per_million') AS REAL)), 0.0) AS input_price,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.output_per_million') AS REAL)), 0.0) AS output_price,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.cache_read_per_million') AS REAL)), 0.0) AS cache_read_price
+FROM bot_history_messages m
+JOIN bots b ON b.id = m.bot_id
+LEFT JOIN models mo ON mo.id = m.model_id
+WHERE b.owner_user_id = sqlc.arg(owner_user_id)
+  AND m.usage IS NOT NULL
+  AND json_valid(m.usage)
+  AND datetime(m.created_at) >= datetime(sqlc.arg(from_time))
+GROUP BY m.model_id;
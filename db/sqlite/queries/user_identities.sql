@@ -0,0 +1,25 @@
+-- name: GetUserIdentity :one
+SELECT * FROM user_identities
+WHERE issuer = sqlc.arg(issuer) AND subject = sqlc.arg(subject);
+
+-- name: CreateUserIdentity :one
+INSERT INTO user_identities (id, user_id, issuer, subject, email, last_login_at)
+VALUES (
+  lower(hex(randomblob(4))) || '-' ||
+  lower(hex(randomblob(2))) || '-' ||
+  '4' || substr(lower(hex(randomblob(2))), 2) || '-' ||
+  substr('89ab', abs(random()) % 4 + 1, 1) || substr(lower(hex(randomblob(2))), 2) || '-' ||
+  lower(hex(randomblob(6))),
+  sqlc.arg(user_id),
+  sqlc.arg(issuer),
+  sqlc.arg(subject),
+  sqlc.arg(email),
+  CURRENT_TIMESTAMP
+)
+RETURNING *;
+
+-- name: TouchUserIdentity :exec
+UPDATE user_identities
+SET email = sqlc.arg(email),
+    last_login_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id);
@@ -1,7 +1,7 @@
 -- name: ListVersionsByContainerID :many
 SELECT
   cv.id, cv.container_id, cv.snapshot_id, cv.version, cv.created_at,
-  s.runtime_snapshot_name, s.display_name
+  s.runtime_snapshot_name, s.display_name, s.snapshotter, s.source
 FROM container_versions cv
 JOIN snapshots s ON s.id = cv.snapshot_id
 WHERE cv.container_id = sqlc.arg(container_id)
@@ -30,3 +30,9 @@ FROM container_versions cv
 JOIN snapshots s ON s.id = cv.snapshot_id
 WHERE cv.container_id = sqlc.arg(container_id)
   AND cv.version = sqlc.arg(version);
+
+-- name: DeleteVersion :one
+DELETE FROM container_versions
+WHERE container_id = sqlc.arg(container_id)
+  AND version = sqlc.arg(version)
+RETURNING snapshot_id;
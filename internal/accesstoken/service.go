@@ -0,0 +1,224 @@
+// Package accesstoken manages personal access tokens: long-lived, revocable
+// credentials that let scripts call the API without a password login. A
+// token carries a set of auth scopes, may be restricted to one bot and may
+// expire. Only the SHA-256 hash of the secret is stored.
+package accesstoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+)
+
+const (
+	// prefixLength is how much of the secret is kept in clear so users can
+	// tell their tokens apart.
+	prefixLength  = len(auth.PersonalTokenPrefix) + 6
+	maxNameLength = 100
+	// touchInterval throttles last_used_at writes for busy tokens.
+	touchInterval = time.Minute
+)
+
+type Service struct {
+	queries dbstore.Queries
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+func NewService(log *slog.Logger, queries dbstore.Queries) *Service {
+	return &Service{
+		queries: queries,
+		logger:  log.With(slog.String("service", "accesstoken")),
+		now:     time.Now,
+	}
+}
+
+// Create issues a token for userID. The caller is responsible for checking
+// that the user may access req.BotID.
+func (s *Service) Create(ctx context.Context, userID string, req CreateRequest) (CreateResponse, error) {
+	if s.queries == nil {
+		return CreateResponse{}, errors.New("access token queries not configured")
+	}
+	pgUserID, err := db.ParseUUID(userID)
+	if err != nil {
+		return CreateResponse{}, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return CreateResponse{}, fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	if len(name) > maxNameLength {
+		return CreateResponse{}, fmt.Errorf("%w: name must be at most %d characters", ErrInvalid, maxNameLength)
+	}
+	scopes, err := normalizeScopes(req.Scopes)
+	if err != nil {
+		return CreateResponse{}, err
+	}
+	if req.ExpiresInDays < 0 {
+		return CreateResponse{}, fmt.Errorf("%w: expires_in_days must not be negative", ErrInvalid)
+	}
+	var pgBotID pgtype.UUID
+	if botID := strings.TrimSpace(req.BotID); botID != "" {
+		if pgBotID, err = db.ParseUUID(botID); err != nil {
+			return CreateResponse{}, fmt.Errorf("%w: %w", ErrInvalid, err)
+		}
+	}
+	var expiresAt pgtype.Timestamptz
+	if req.ExpiresInDays > 0 {
+		expiresAt = pgtype.Timestamptz{Time: s.now().UTC().AddDate(0, 0, req.ExpiresInDays), Valid: true}
+	}
+
+	secret := auth.PersonalTokenPrefix + rand.Text()
+	row, err := s.queries.CreatePersonalAccessToken(ctx, sqlc.CreatePersonalAccessTokenParams{
+		UserID:      pgUserID,
+		BotID:       pgBotID,
+		Name:        name,
+		TokenPrefix: secret[:prefixLength],
+		TokenHash:   hashToken(secret),
+		Scopes:      strings.Join(scopes, " "),
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return CreateResponse{}, err
+	}
+	return CreateResponse{Token: toToken(row), Secret: secret}, nil
+}
+
+// List returns every token of userID, including revoked and expired ones,
+// newest first.
+func (s *Service) List(ctx context.Context, userID string) ([]Token, error) {
+	pgUserID, err := db.ParseUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.ListPersonalAccessTokensByUser(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Token, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, toToken(row))
+	}
+	return items, nil
+}
+
+// Revoke disables a token of userID immediately.
+func (s *Service) Revoke(ctx context.Context, userID, id string) error {
+	pgUserID, err := db.ParseUUID(userID)
+	if err != nil {
+		return err
+	}
+	pgID, err := db.ParseUUID(id)
+	if err != nil {
+		return ErrNotFound
+	}
+	affected, err := s.queries.RevokePersonalAccessToken(ctx, sqlc.RevokePersonalAccessTokenParams{ID: pgID, UserID: pgUserID})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// VerifyPersonalToken implements auth.PersonalTokenVerifier.
+func (s *Service) VerifyPersonalToken(ctx context.Context, raw string) (auth.PersonalToken, error) {
+	if s.queries == nil || !auth.IsPersonalToken(raw) {
+		return auth.PersonalToken{}, ErrUnauthorized
+	}
+	row, err := s.queries.GetPersonalAccessTokenByHash(ctx, hashToken(raw))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return auth.PersonalToken{}, ErrUnauthorized
+		}
+		return auth.PersonalToken{}, err
+	}
+	now := s.now()
+	if row.RevokedAt.Valid || (row.ExpiresAt.Valid && !now.Before(row.ExpiresAt.Time)) {
+		return auth.PersonalToken{}, ErrUnauthorized
+	}
+	user, err := s.queries.GetUserByID(ctx, row.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return auth.PersonalToken{}, ErrUnauthorized
+		}
+		return auth.PersonalToken{}, err
+	}
+	if !user.IsActive {
+		return auth.PersonalToken{}, ErrUnauthorized
+	}
+	if !row.LastUsedAt.Valid || now.Sub(row.LastUsedAt.Time) >= touchInterval {
+		if err := s.queries.TouchPersonalAccessToken(ctx, row.ID); err != nil {
+			s.logger.Warn("record token use failed", slog.String("token_id", row.ID.String()), slog.Any("error", err))
+		}
+	}
+	return auth.PersonalToken{
+		ID:     row.ID.String(),
+		UserID: row.UserID.String(),
+		BotID:  row.BotID.String(),
+		Scopes: strings.Fields(row.Scopes),
+	}, nil
+}
+
+func normalizeScopes(scopes []string) ([]string, error) {
+	out := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !auth.IsValidScope(scope) {
+			return nil, fmt.Errorf("%w: unknown scope %q", ErrInvalid, scope)
+		}
+		if !slices.Contains(out, scope) {
+			out = append(out, scope)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrInvalid)
+	}
+	slices.SortFunc(out, func(a, b string) int {
+		return slices.Index(auth.Scopes, a) - slices.Index(auth.Scopes, b)
+	})
+	return out, nil
+}
+
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func toToken(row sqlc.PersonalAccessToken) Token {
+	return Token{
+		ID:         row.ID.String(),
+		Name:       row.Name,
+		Prefix:     row.TokenPrefix,
+		Scopes:     strings.Fields(row.Scopes),
+		BotID:      row.BotID.String(),
+		ExpiresAt:  timePtr(row.ExpiresAt),
+		LastUsedAt: timePtr(row.LastUsedAt),
+		RevokedAt:  timePtr(row.RevokedAt),
+		CreatedAt:  row.CreatedAt.Time,
+	}
+}
+
+func timePtr(value pgtype.Timestamptz) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	t := value.Time
+	return &t
+}
@@ -0,0 +1,172 @@
+package accesstoken
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+)
+
+const (
+	testUserID  = "00000000-0000-0000-0000-0000000000d1"
+	otherUserID = "00000000-0000-0000-0000-0000000000d2"
+	testBotID   = "00000000-0000-0000-0000-0000000000d3"
+)
+
+func newSQLiteTokenService(t *testing.T) (*Service, *sql.DB) {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('` + testUserID + `','ci@example.com','member')`,
+		`INSERT INTO users(id,email,role) VALUES('` + otherUserID + `','other@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES('` + testBotID + `','` + testUserID + `','personal','cibot','CI Bot')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	return NewService(slog.Default(), sqlitestore.NewQueries(store)), conn
+}
+
+func TestCreateAndVerify(t *testing.T) {
+	svc, conn := newSQLiteTokenService(t)
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, testUserID, CreateRequest{
+		Name:   "nightly export",
+		Scopes: []string{auth.ScopeMemoryAdmin, auth.ScopeBotsRead, auth.ScopeBotsRead},
+		BotID:  testBotID,
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !strings.HasPrefix(created.Secret, auth.PersonalTokenPrefix) || !strings.HasPrefix(created.Secret, created.Prefix) {
+		t.Fatalf("secret %q does not match prefix %q", created.Secret, created.Prefix)
+	}
+	if strings.Join(created.Scopes, " ") != "bots:read memory:admin" || created.BotID != testBotID || created.ExpiresAt != nil {
+		t.Fatalf("created = %+v", created.Token)
+	}
+
+	var storedHash string
+	if err := conn.QueryRowContext(ctx, `SELECT token_hash FROM personal_access_tokens WHERE id = ?`, created.ID).Scan(&storedHash); err != nil {
+		t.Fatalf("read hash: %v", err)
+	}
+	if storedHash == created.Secret || strings.Contains(storedHash, created.Secret[len(created.Prefix):]) {
+		t.Fatal("token secret stored in clear")
+	}
+
+	verified, err := svc.VerifyPersonalToken(ctx, created.Secret)
+	if err != nil {
+		t.Fatalf("VerifyPersonalToken() error = %v", err)
+	}
+	if verified.ID != created.ID || verified.UserID != testUserID || verified.BotID != testBotID || !verified.HasScope(auth.ScopeMemoryRead) {
+		t.Fatalf("verified = %+v", verified)
+	}
+
+	items, err := svc.List(ctx, testUserID)
+	if err != nil || len(items) != 1 || items[0].LastUsedAt == nil {
+		t.Fatalf("List() = %+v, %v; want one token with last_used_at", items, err)
+	}
+
+	if _, err := svc.VerifyPersonalToken(ctx, created.Secret+"x"); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("VerifyPersonalToken(wrong) error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	svc, _ := newSQLiteTokenService(t)
+	ctx := context.Background()
+	created, err := svc.Create(ctx, testUserID, CreateRequest{Name: "ci", Scopes: []string{auth.ScopeBotsChat}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := svc.Revoke(ctx, otherUserID, created.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Revoke(other user) error = %v, want ErrNotFound", err)
+	}
+	if err := svc.Revoke(ctx, testUserID, created.ID); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if err := svc.Revoke(ctx, testUserID, created.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("second Revoke() error = %v, want ErrNotFound", err)
+	}
+	if _, err := svc.VerifyPersonalToken(ctx, created.Secret); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("VerifyPersonalToken(revoked) error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestExpiryAndInactiveUser(t *testing.T) {
+	svc, conn := newSQLiteTokenService(t)
+	ctx := context.Background()
+	created, err := svc.Create(ctx, testUserID, CreateRequest{Name: "ci", Scopes: []string{auth.ScopeBotsRead}, ExpiresInDays: 30})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.ExpiresAt == nil {
+		t.Fatal("expires_at not set")
+	}
+	if _, err := svc.VerifyPersonalToken(ctx, created.Secret); err != nil {
+		t.Fatalf("VerifyPersonalToken() error = %v", err)
+	}
+
+	svc.now = func() time.Time { return time.Now().AddDate(0, 0, 31) }
+	if _, err := svc.VerifyPersonalToken(ctx, created.Secret); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("VerifyPersonalToken(expired) error = %v, want ErrUnauthorized", err)
+	}
+
+	svc.now = time.Now
+	if _, err := conn.ExecContext(ctx, `UPDATE users SET is_active = 0 WHERE id = ?`, testUserID); err != nil {
+		t.Fatalf("deactivate user: %v", err)
+	}
+	if _, err := svc.VerifyPersonalToken(ctx, created.Secret); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("VerifyPersonalToken(inactive user) error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestCreateValidation(t *testing.T) {
+	svc, _ := newSQLiteTokenService(t)
+	ctx := context.Background()
+	cases := map[string]CreateRequest{
+		"missing name":    {Scopes: []string{auth.ScopeBotsRead}},
+		"no scopes":       {Name: "ci"},
+		"unknown scope":   {Name: "ci", Scopes: []string{"bots:*"}},
+		"bad bot id":      {Name: "ci", Scopes: []string{auth.ScopeBotsRead}, BotID: "nope"},
+		"negative expiry": {Name: "ci", Scopes: []string{auth.ScopeBotsRead}, ExpiresInDays: -1},
+	}
+	for name, req := range cases {
+		if _, err := svc.Create(ctx, testUserID, req); !errors.Is(err, ErrInvalid) {
+			t.Fatalf("Create(%s) error = %v, want ErrInvalid", name, err)
+		}
+	}
+}
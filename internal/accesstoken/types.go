@@ -0,0 +1,57 @@
+package accesstoken
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when a token does not exist or belongs to
+	// another user.
+	ErrNotFound = errors.New("access token not found")
+	// ErrInvalid wraps validation failures of create requests.
+	ErrInvalid = errors.New("invalid access token request")
+	// ErrUnauthorized is returned by VerifyPersonalToken for unknown, revoked
+	// or expired tokens and for tokens of deactivated users.
+	ErrUnauthorized = errors.New("invalid access token")
+)
+
+// Token describes a personal access token. The secret itself is only
+// returned once, by Create.
+type Token struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	BotID      string     `json:"bot_id,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreateRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	// BotID restricts the token to a single bot.
+	BotID string `json:"bot_id,omitempty"`
+	// ExpiresInDays is the token lifetime; zero means the token never
+	// expires.
+	ExpiresInDays int `json:"expires_in_days,omitempty"`
+}
+
+// CreateResponse carries the new token and its secret, which cannot be
+// retrieved again.
+type CreateResponse struct {
+	Token
+	Secret string `json:"token"`
+}
+
+type ListResponse struct {
+	Items []Token `json:"items"`
+}
+
+// ScopesResponse lists the scopes a token can be granted.
+type ScopesResponse struct {
+	Scopes []string `json:"scopes"`
+}
@@ -0,0 +1,201 @@
+package accounts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"github.com/memohai/memoh/internal/db"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+)
+
+var (
+	ErrExternalIdentityNotLinked = errors.New("no account is linked to this identity")
+	ErrEmailInUse                = errors.New("an account with this email already exists")
+)
+
+// LoginExternal signs in a user authenticated by an external identity
+// provider. Identities are matched by (issuer, subject). An unknown identity
+// is linked to an existing account by verified email when opts.LinkByEmail
+// is set, otherwise a password-less account is provisioned when
+// opts.Provision is set. The account role follows identity.Role on every
+// login so group changes at the provider take effect.
+func (s *Service) LoginExternal(ctx context.Context, identity ExternalIdentity, opts ExternalLoginOptions) (Account, error) {
+	if s.store == nil {
+		return Account{}, errors.New("account store not configured")
+	}
+	identity.Issuer = strings.TrimSpace(identity.Issuer)
+	identity.Subject = strings.TrimSpace(identity.Subject)
+	identity.Email = strings.TrimSpace(identity.Email)
+	if identity.Issuer == "" || identity.Subject == "" {
+		return Account{}, ErrInvalidCredentials
+	}
+	role, err := normalizeRole(identity.Role)
+	if err != nil {
+		return Account{}, err
+	}
+
+	link, err := s.store.GetExternalIdentity(ctx, identity.Issuer, identity.Subject)
+	switch {
+	case err == nil:
+		row, err := s.store.GetByUserID(ctx, link.UserID)
+		if err != nil {
+			return Account{}, err
+		}
+		if !row.IsActive {
+			return Account{}, ErrInactiveAccount
+		}
+		if row, err = s.syncExternalRole(ctx, row, role); err != nil {
+			return Account{}, err
+		}
+		if err := s.store.TouchExternalIdentity(ctx, link.ID, identity.Email); err != nil {
+			s.logger.Warn("touch external identity failed", slog.Any("error", err))
+		}
+		s.touchLastLogin(ctx, row.ID)
+		return toAccount(row), nil
+	case errors.Is(err, db.ErrNotFound):
+	default:
+		return Account{}, err
+	}
+
+	row, err := s.resolveUnlinkedIdentity(ctx, identity, role, opts)
+	if err != nil {
+		return Account{}, err
+	}
+	if _, err := s.store.CreateExternalIdentity(ctx, dbstore.CreateExternalIdentityInput{
+		UserID:  row.ID,
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+		Email:   identity.Email,
+	}); err != nil {
+		return Account{}, err
+	}
+	s.logger.Info("external identity linked",
+		slog.String("user_id", row.ID),
+		slog.String("issuer", identity.Issuer),
+		slog.String("subject", identity.Subject),
+	)
+	s.touchLastLogin(ctx, row.ID)
+	return toAccount(row), nil
+}
+
+func (s *Service) resolveUnlinkedIdentity(ctx context.Context, identity ExternalIdentity, role string, opts ExternalLoginOptions) (dbstore.AccountRecord, error) {
+	if identity.Email != "" {
+		existing, err := s.store.GetByIdentity(ctx, identity.Email)
+		switch {
+		case err == nil && strings.EqualFold(existing.Email, identity.Email):
+			// Linking by email is only safe when the provider vouches for
+			// the address; otherwise anyone could claim a local account.
+			if !opts.LinkByEmail || !identity.EmailVerified {
+				return dbstore.AccountRecord{}, ErrEmailInUse
+			}
+			if !existing.IsActive {
+				return dbstore.AccountRecord{}, ErrInactiveAccount
+			}
+			return s.syncExternalRole(ctx, existing, role)
+		case err == nil, errors.Is(err, db.ErrNotFound):
+		default:
+			return dbstore.AccountRecord{}, err
+		}
+	}
+	if !opts.Provision {
+		return dbstore.AccountRecord{}, ErrExternalIdentityNotLinked
+	}
+
+	username, err := s.availableUsername(ctx, identity)
+	if err != nil {
+		return dbstore.AccountRecord{}, err
+	}
+	displayName := strings.TrimSpace(identity.DisplayName)
+	if displayName == "" {
+		displayName = username
+	}
+	userRow, err := s.store.CreateUser(ctx, dbstore.CreateUserInput{
+		IsActive: true,
+		Metadata: []byte("{}"),
+	})
+	if err != nil {
+		return dbstore.AccountRecord{}, err
+	}
+	return s.store.CreateAccount(ctx, dbstore.CreateAccountInput{
+		UserID:      userRow.ID,
+		Username:    username,
+		Email:       identity.Email,
+		Role:        role,
+		DisplayName: displayName,
+		AvatarURL:   strings.TrimSpace(identity.AvatarURL),
+		IsActive:    true,
+	})
+}
+
+// availableUsername derives a username from the identity's preferred
+// username or email local part. On a clash with an existing account a short
+// suffix derived from the subject is appended, which keeps it stable across
+// retries.
+func (s *Service) availableUsername(ctx context.Context, identity ExternalIdentity) (string, error) {
+	base := sanitizeUsername(identity.Username)
+	if base == "" {
+		local, _, _ := strings.Cut(identity.Email, "@")
+		base = sanitizeUsername(local)
+	}
+	if base == "" {
+		base = "user"
+	}
+	sum := sha256.Sum256([]byte(identity.Issuer + "\x00" + identity.Subject))
+	suffix := hex.EncodeToString(sum[:])
+	for _, candidate := range []string{base, base + "-" + suffix[:6], base + "-" + suffix[:12]} {
+		_, err := s.store.GetByIdentity(ctx, candidate)
+		if errors.Is(err, db.ErrNotFound) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", errors.New("could not find a free username for external identity")
+}
+
+func sanitizeUsername(raw string) string {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(raw) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '.', r == '_', r == '-':
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			b.WriteRune('-')
+		}
+	}
+	return strings.Trim(b.String(), ".-_")
+}
+
+func (s *Service) syncExternalRole(ctx context.Context, row dbstore.AccountRecord, role string) (dbstore.AccountRecord, error) {
+	if strings.EqualFold(row.Role, role) {
+		return row, nil
+	}
+	updated, err := s.store.UpdateAdmin(ctx, dbstore.UpdateAccountAdminInput{
+		UserID:      row.ID,
+		Role:        role,
+		DisplayName: row.DisplayName,
+		AvatarURL:   row.AvatarURL,
+		IsActive:    row.IsActive,
+	})
+	if err != nil {
+		return dbstore.AccountRecord{}, err
+	}
+	s.logger.Info("account role synced from identity provider",
+		slog.String("user_id", row.ID),
+		slog.String("from", row.Role),
+		slog.String("to", role),
+	)
+	return updated, nil
+}
+
+func (s *Service) touchLastLogin(ctx context.Context, userID string) {
+	if err := s.store.UpdateLastLogin(ctx, userID); err != nil {
+		s.logger.Warn("touch last login failed", slog.Any("error", err))
+	}
+}
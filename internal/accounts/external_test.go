@@ -0,0 +1,159 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+)
+
+const testIssuer = "https://id.example.com"
+
+func newSQLiteAccountService(t *testing.T) *Service {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	return NewService(slog.Default(), store)
+}
+
+func TestLoginExternalProvisionsAndSyncsRole(t *testing.T) {
+	svc := newSQLiteAccountService(t)
+	ctx := context.Background()
+	identity := ExternalIdentity{
+		Issuer:        testIssuer,
+		Subject:       "alice-sub",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Username:      "alice",
+		DisplayName:   "Alice",
+		Role:          "admin",
+	}
+	opts := ExternalLoginOptions{Provision: true}
+
+	first, err := svc.LoginExternal(ctx, identity, opts)
+	if err != nil {
+		t.Fatalf("LoginExternal() error = %v", err)
+	}
+	if first.Username != "alice" || first.Email != "alice@example.com" || first.Role != "admin" || first.DisplayName != "Alice" {
+		t.Fatalf("provisioned account = %+v", first)
+	}
+	if _, err := svc.Login(ctx, "alice", "anything"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("password login for SSO account error = %v, want ErrInvalidCredentials", err)
+	}
+
+	identity.Role = "member"
+	second, err := svc.LoginExternal(ctx, identity, opts)
+	if err != nil {
+		t.Fatalf("second LoginExternal() error = %v", err)
+	}
+	if second.ID != first.ID || second.Role != "member" {
+		t.Fatalf("second login = %+v, want same user demoted to member", second)
+	}
+
+	inactive := false
+	if _, err := svc.UpdateAdmin(ctx, first.ID, UpdateAccountRequest{IsActive: &inactive}); err != nil {
+		t.Fatalf("deactivate: %v", err)
+	}
+	if _, err := svc.LoginExternal(ctx, identity, opts); !errors.Is(err, ErrInactiveAccount) {
+		t.Fatalf("LoginExternal(inactive) error = %v, want ErrInactiveAccount", err)
+	}
+}
+
+func TestLoginExternalUsernameClash(t *testing.T) {
+	svc := newSQLiteAccountService(t)
+	ctx := context.Background()
+	if _, err := svc.CreateHuman(ctx, "", CreateAccountRequest{Username: "bob", Password: "secret"}); err != nil {
+		t.Fatalf("create local account: %v", err)
+	}
+
+	account, err := svc.LoginExternal(ctx, ExternalIdentity{
+		Issuer:   testIssuer,
+		Subject:  "bob-sso",
+		Username: "bob",
+	}, ExternalLoginOptions{Provision: true})
+	if err != nil {
+		t.Fatalf("LoginExternal() error = %v", err)
+	}
+	if account.Username == "bob" || account.Role != "member" {
+		t.Fatalf("account = %+v, want a distinct member username", account)
+	}
+}
+
+func TestLoginExternalEmailConflict(t *testing.T) {
+	svc := newSQLiteAccountService(t)
+	ctx := context.Background()
+	local, err := svc.CreateHuman(ctx, "", CreateAccountRequest{Username: "root", Password: "secret", Email: "ops@example.com", Role: "admin"})
+	if err != nil {
+		t.Fatalf("create local account: %v", err)
+	}
+	identity := ExternalIdentity{Issuer: testIssuer, Subject: "ops-sub", Email: "ops@example.com", Role: "admin"}
+
+	if _, err := svc.LoginExternal(ctx, identity, ExternalLoginOptions{Provision: true}); !errors.Is(err, ErrEmailInUse) {
+		t.Fatalf("LoginExternal() without link_by_email error = %v, want ErrEmailInUse", err)
+	}
+	if _, err := svc.LoginExternal(ctx, identity, ExternalLoginOptions{LinkByEmail: true}); !errors.Is(err, ErrEmailInUse) {
+		t.Fatalf("LoginExternal() with unverified email error = %v, want ErrEmailInUse", err)
+	}
+
+	identity.EmailVerified = true
+	linked, err := svc.LoginExternal(ctx, identity, ExternalLoginOptions{LinkByEmail: true})
+	if err != nil {
+		t.Fatalf("LoginExternal() link by email error = %v", err)
+	}
+	if linked.ID != local.ID {
+		t.Fatalf("linked to %s, want %s", linked.ID, local.ID)
+	}
+	// The local password keeps working as break-glass access.
+	if _, err := svc.Login(ctx, "root", "secret"); err != nil {
+		t.Fatalf("local login after linking error = %v", err)
+	}
+}
+
+func TestLoginExternalWithoutProvisioning(t *testing.T) {
+	svc := newSQLiteAccountService(t)
+
+	_, err := svc.LoginExternal(context.Background(), ExternalIdentity{Issuer: testIssuer, Subject: "nobody"}, ExternalLoginOptions{})
+	if !errors.Is(err, ErrExternalIdentityNotLinked) {
+		t.Fatalf("LoginExternal() error = %v, want ErrExternalIdentityNotLinked", err)
+	}
+}
+
+func TestSanitizeUsername(t *testing.T) {
+	tests := map[string]string{
+		"alice":          "alice",
+		"Jane Doe":       "Jane-Doe",
+		"bob@corp":       "bobcorp",
+		"  .x_y.  ":      "x_y",
+		"DOMAIN\\carol":  "DOMAINcarol",
+		"!!!":            "",
+		"zoë.müller-lee": "zoë.müller-lee",
+	}
+	for in, want := range tests {
+		if got := sanitizeUsername(in); got != want {
+			t.Fatalf("sanitizeUsername(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
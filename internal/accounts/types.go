@@ -71,3 +71,26 @@ type ResetPasswordRequest struct {
 type ListAccountsResponse struct {
 	Items []Account `json:"items"`
 }
+
+// ExternalIdentity is a user asserted by an external identity provider
+// (OpenID Connect), with its groups already mapped onto a local role.
+type ExternalIdentity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Username      string
+	DisplayName   string
+	AvatarURL     string
+	Role          string
+}
+
+// ExternalLoginOptions controls how an identity that is not linked to an
+// account yet is handled.
+type ExternalLoginOptions struct {
+	// Provision creates a new account on first login.
+	Provision bool
+	// LinkByEmail links the identity to an existing account whose email
+	// matches the identity's verified email.
+	LinkByEmail bool
+}
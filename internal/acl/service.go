@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/db"
 	"github.com/memohai/memoh/internal/db/postgres/sqlc"
 	dbstore "github.com/memohai/memoh/internal/db/store"
@@ -23,8 +24,9 @@ var (
 )
 
 type Service struct {
-	queries dbstore.Queries
-	logger  *slog.Logger
+	queries  dbstore.Queries
+	logger   *slog.Logger
+	auditLog *audit.Service
 }
 
 func NewService(log *slog.Logger, queries dbstore.Queries) *Service {
@@ -37,6 +39,13 @@ func NewService(log *slog.Logger, queries dbstore.Queries) *Service {
 	}
 }
 
+// SetAuditLog records rule and default effect changes to the audit log.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	if s != nil {
+		s.auditLog = auditLog
+	}
+}
+
 // Evaluate checks whether the given request is allowed to perform chat.trigger.
 // Rules only override the bot's default mode: deny rules matter in blacklist mode,
 // and allow rules matter in whitelist mode.
@@ -100,10 +109,28 @@ func (s *Service) SetDefaultEffect(ctx context.Context, botID, effect string) er
 	if err != nil {
 		return err
 	}
-	return s.queries.SetBotACLDefaultEffect(ctx, sqlc.SetBotACLDefaultEffectParams{
+	var before string
+	if s.auditLog != nil {
+		before, _ = s.queries.GetBotACLDefaultEffect(ctx, pgBotID)
+	}
+	if err := s.queries.SetBotACLDefaultEffect(ctx, sqlc.SetBotACLDefaultEffectParams{
 		ID:               pgBotID,
 		AclDefaultEffect: effect,
+	}); err != nil {
+		return err
+	}
+	if s.auditLog == nil || before == effect {
+		return nil
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionACLDefaultEffect,
+		BotID:      botID,
+		TargetType: "bot",
+		TargetID:   botID,
+		Before:     map[string]string{"default_effect": before},
+		After:      map[string]string{"default_effect": effect},
 	})
+	return nil
 }
 
 // ListRules returns all ACL rules for a bot, newest first.
@@ -165,7 +192,9 @@ func (s *Service) CreateRule(ctx context.Context, botID, createdByUserID string,
 	if err != nil {
 		return Rule{}, err
 	}
-	return ruleFromWrite(row), nil
+	rule := ruleFromWrite(row)
+	s.recordRule(ctx, audit.ActionACLRuleCreate, rule.BotID, rule.ID, nil, rule)
+	return rule, nil
 }
 
 // UpdateRule updates an existing ACL rule.
@@ -191,6 +220,7 @@ func (s *Service) UpdateRule(ctx context.Context, ruleID string, req UpdateRuleR
 	if err != nil {
 		return Rule{}, err
 	}
+	before := s.ruleForAudit(ctx, pgRuleID)
 	row, err := s.queries.UpdateBotACLRule(ctx, sqlc.UpdateBotACLRuleParams{
 		ID:                     pgRuleID,
 		Enabled:                req.Enabled,
@@ -206,7 +236,9 @@ func (s *Service) UpdateRule(ctx context.Context, ruleID string, req UpdateRuleR
 	if err != nil {
 		return Rule{}, err
 	}
-	return ruleFromUpdateRow(row), nil
+	rule := ruleFromUpdateRow(row)
+	s.recordRule(ctx, audit.ActionACLRuleUpdate, rule.BotID, rule.ID, before, rule)
+	return rule, nil
 }
 
 // resolveSourceChannel derives the source_channel value from the rule's target context.
@@ -243,7 +275,42 @@ func (s *Service) DeleteRule(ctx context.Context, ruleID string) error {
 	if err != nil {
 		return err
 	}
-	return s.queries.DeleteBotACLRuleByID(ctx, pgRuleID)
+	before := s.ruleForAudit(ctx, pgRuleID)
+	if err := s.queries.DeleteBotACLRuleByID(ctx, pgRuleID); err != nil {
+		return err
+	}
+	if before != nil {
+		s.recordRule(ctx, audit.ActionACLRuleDelete, before.BotID, before.ID, before, nil)
+	}
+	return nil
+}
+
+// ruleForAudit loads the current state of a rule for the audit log. It
+// returns nil when auditing is off or the rule does not exist.
+func (s *Service) ruleForAudit(ctx context.Context, ruleID pgtype.UUID) *Rule {
+	if s.auditLog == nil {
+		return nil
+	}
+	row, err := s.queries.GetBotACLRuleByID(ctx, ruleID)
+	if err != nil {
+		return nil
+	}
+	rule := ruleFromWrite(row)
+	return &rule
+}
+
+func (s *Service) recordRule(ctx context.Context, action, botID, ruleID string, before *Rule, after any) {
+	entry := audit.Entry{
+		Action:     action,
+		BotID:      botID,
+		TargetType: "acl_rule",
+		TargetID:   ruleID,
+		After:      after,
+	}
+	if before != nil {
+		entry.Before = before
+	}
+	s.auditLog.Record(ctx, entry)
 }
 
 // ListObservedConversationsByChannelIdentity returns conversations observed for a specific
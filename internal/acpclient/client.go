@@ -34,7 +34,7 @@ type Workspace interface {
 type ToolApprovalService interface {
 	EvaluatePolicy(ctx context.Context, input toolapproval.CreatePendingInput) (toolapproval.Evaluation, error)
 	CreatePending(ctx context.Context, input toolapproval.CreatePendingInput) (toolapproval.Request, error)
-	Reject(ctx context.Context, approvalID, actorID, reason string) (toolapproval.Request, error)
+	Dismiss(ctx context.Context, approvalID, reason string) (toolapproval.Request, error)
 	WaitForDecision(ctx context.Context, approvalID string) (toolapproval.Request, error)
 }
 
@@ -482,22 +482,27 @@ func (c *clientCallbacks) requireToolApproval(ctx context.Context, toolCallID, t
 		SourcePlatform:               session.CurrentPlatform,
 		ReplyTarget:                  session.ReplyTarget,
 		ConversationType:             session.ConversationType,
+		SessionType:                  session.SessionType,
 	}
 	eval, err := c.approval.EvaluatePolicy(ctx, approvalInput)
 	if err != nil {
 		return false, err
 	}
-	if eval.Decision == toolapproval.DecisionBypass {
+	switch eval.Decision {
+	case toolapproval.DecisionBypass:
 		return true, nil
+	case toolapproval.DecisionDeny:
+		return false, nil
 	}
 
+	approvalInput.Workflow = eval.Policy.Workflow
 	req, err := c.approval.CreatePending(ctx, approvalInput)
 	if err != nil {
 		return false, err
 	}
 	if strings.TrimSpace(session.StreamID) == "" {
 		reason := "tool execution requires approval, but this ACP permission request is not attached to an interactive stream"
-		if _, rejectErr := c.approval.Reject(ctx, req.ID, session.ChannelIdentityID, reason); rejectErr != nil {
+		if _, rejectErr := c.approval.Dismiss(ctx, req.ID, reason); rejectErr != nil {
 			return false, rejectErr
 		}
 		return false, nil
@@ -511,7 +516,7 @@ func (c *clientCallbacks) requireToolApproval(ctx context.Context, toolCallID, t
 		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
 			rejectCtx, rejectCancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
 			defer rejectCancel()
-			if _, rejectErr := c.approval.Reject(rejectCtx, req.ID, session.ChannelIdentityID, "tool approval timed out"); rejectErr != nil {
+			if _, rejectErr := c.approval.Dismiss(rejectCtx, req.ID, "tool approval timed out"); rejectErr != nil {
 				return false, rejectErr
 			}
 			timeoutReq := req
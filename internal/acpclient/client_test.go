@@ -2026,7 +2026,7 @@ func (f *fakeACPToolApproval) CreatePending(_ context.Context, input toolapprova
 	return req, nil
 }
 
-func (*fakeACPToolApproval) Reject(context.Context, string, string, string) (toolapproval.Request, error) {
+func (*fakeACPToolApproval) Dismiss(context.Context, string, string) (toolapproval.Request, error) {
 	return toolapproval.Request{Status: toolapproval.StatusRejected}, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	sdk "github.com/memohai/twilight-ai/sdk"
 
 	"github.com/memohai/memoh/internal/agent/background"
+	"github.com/memohai/memoh/internal/agent/failover"
 	"github.com/memohai/memoh/internal/agent/tools"
 	"github.com/memohai/memoh/internal/models"
 	"github.com/memohai/memoh/internal/userinput"
@@ -24,6 +25,7 @@ type Agent struct {
 	client         *sdk.Client
 	toolProviders  []tools.ToolProvider
 	bridgeProvider bridge.Provider
+	breaker        *failover.Breaker
 	logger         *slog.Logger
 }
 
@@ -36,6 +38,7 @@ func New(deps Deps) *Agent {
 	return &Agent{
 		client:         sdk.NewClient(),
 		bridgeProvider: deps.BridgeProvider,
+		breaker:        failover.NewBreaker(failover.DefaultThreshold, failover.DefaultCooldown),
 		logger:         logger.With(slog.String("service", "agent")),
 	}
 }
@@ -115,6 +118,7 @@ func sendEvent(ctx context.Context, ch chan<- StreamEvent, evt StreamEvent) bool
 }
 
 func (a *Agent) runStream(ctx context.Context, cfg RunConfig, ch chan<- StreamEvent) {
+	cfg = withStructuredOutput(cfg)
 	streamCtx, cancel := context.WithCancelCause(ctx)
 	defer cancel(nil)
 
@@ -232,49 +236,11 @@ func (a *Agent) runStream(ctx context.Context, cfg RunConfig, ch chan<- StreamEv
 		}
 	}
 
-	opts := a.buildGenerateOptions(cfg, sdkTools, prepareStep)
-
-	retryCfg := cfg.Retry
-	if retryCfg.MaxAttempts <= 0 {
-		retryCfg = DefaultRetryConfig()
-	}
-
-	var streamResult *sdk.StreamResult
-	for attempt := 0; attempt < retryCfg.MaxAttempts; attempt++ {
-		var err error
-		streamResult, err = a.client.StreamText(streamCtx, opts...)
-		if err == nil {
-			break
-		}
-		if !isRetryableStreamError(err) {
-			sendEvent(ctx, ch, StreamEvent{Type: EventError, Error: fmt.Sprintf("stream start: %v", err)})
-			return
-		}
-		a.logger.Warn("stream start failed, retrying",
-			slog.Int("attempt", attempt+1),
-			slog.Int("max_attempts", retryCfg.MaxAttempts),
-			slog.String("error", err.Error()),
-		)
-		if !sendEvent(ctx, ch, StreamEvent{
-			Type:       EventRetry,
-			Attempt:    attempt + 1,
-			MaxAttempt: retryCfg.MaxAttempts,
-			RetryError: err.Error(),
-		}) {
-			return
-		}
-		if attempt+1 >= retryCfg.MaxAttempts {
-			sendEvent(ctx, ch, StreamEvent{Type: EventError, Error: fmt.Sprintf("stream start: all %d attempts failed (last: %v)", retryCfg.MaxAttempts, err)})
-			return
-		}
-		delay := retryDelay(attempt, retryCfg)
-		if delay > 0 {
-			if err := sleepWithContext(streamCtx, delay); err != nil {
-				sendEvent(ctx, ch, StreamEvent{Type: EventError, Error: fmt.Sprintf("stream start: context cancelled during retry: %v", err)})
-				return
-			}
-		}
+	streamResult, runCfg, ok := a.startStream(ctx, streamCtx, ch, cfg, sdkTools, prepareStep)
+	if !ok {
+		return
 	}
+	cfg = runCfg
 
 	sendEvent(ctx, ch, StreamEvent{Type: EventAgentStart})
 
@@ -510,22 +476,30 @@ func (a *Agent) runStream(ctx context.Context, cfg RunConfig, ch chan<- StreamEv
 	}
 	var totalUsage sdk.Usage
 	for _, step := range streamResult.Steps {
-		totalUsage.InputTokens += step.Usage.InputTokens
-		totalUsage.OutputTokens += step.Usage.OutputTokens
-		totalUsage.TotalTokens += step.Usage.TotalTokens
-		totalUsage.ReasoningTokens += step.Usage.ReasoningTokens
-		totalUsage.CachedInputTokens += step.Usage.CachedInputTokens
-		totalUsage.InputTokenDetails.NoCacheTokens += step.Usage.InputTokenDetails.NoCacheTokens
-		totalUsage.InputTokenDetails.CacheReadTokens += step.Usage.InputTokenDetails.CacheReadTokens
-		totalUsage.InputTokenDetails.CacheWriteTokens += step.Usage.InputTokenDetails.CacheWriteTokens
-		totalUsage.OutputTokenDetails.TextTokens += step.Usage.OutputTokenDetails.TextTokens
-		totalUsage.OutputTokenDetails.ReasoningTokens += step.Usage.OutputTokenDetails.ReasoningTokens
+		addUsage(&totalUsage, step.Usage)
+	}
+
+	var structuredValue json.RawMessage
+	if cfg.OutputSchema != nil && !aborted && streamResult.DeferredToolApproval == nil {
+		output, err := a.completeStructuredOutput(streamCtx, cfg, sdkTools, finalMessages)
+		finalMessages = append(finalMessages, output.Messages...)
+		addUsage(&totalUsage, output.Usage)
+		if len(output.Messages) > 0 && output.Text != "" {
+			sendEvent(ctx, ch, StreamEvent{Type: EventTextStart})
+			sendEvent(ctx, ch, StreamEvent{Type: EventTextDelta, Delta: output.Text})
+			sendEvent(ctx, ch, StreamEvent{Type: EventTextEnd})
+		}
+		if err != nil {
+			sendEvent(ctx, ch, StreamEvent{Type: EventError, Error: err.Error()})
+		}
+		structuredValue = output.Value
 	}
 	usageJSON, _ := json.Marshal(totalUsage)
 
 	termEvent := StreamEvent{
-		Messages: mustMarshal(finalMessages),
-		Usage:    usageJSON,
+		Messages:   mustMarshal(finalMessages),
+		Usage:      usageJSON,
+		Structured: structuredValue,
 	}
 	if streamResult.DeferredToolApproval != nil {
 		termEvent.ApprovalID = streamResult.DeferredToolApproval.ApprovalID
@@ -567,6 +541,7 @@ func (a *Agent) runStream(ctx context.Context, cfg RunConfig, ch chan<- StreamEv
 }
 
 func (a *Agent) runGenerate(ctx context.Context, cfg RunConfig) (*GenerateResult, error) {
+	cfg = withStructuredOutput(cfg)
 	genCtx, cancel := context.WithCancelCause(ctx)
 	defer cancel(nil)
 	loopAbort := newLoopAbortState()
@@ -620,29 +595,56 @@ func (a *Agent) runGenerate(ctx context.Context, cfg RunConfig) (*GenerateResult
 		}
 	}
 
-	opts := a.buildGenerateOptions(cfg, sdkTools, prepareStep)
-	opts = append(opts,
-		sdk.WithOnStep(func(step *sdk.StepResult) *sdk.GenerateParams {
-			if cfg.LoopDetection.Enabled {
-				if toolLoopAbortCallIDs.Any() {
-					loopAbort.Set(ErrToolLoopDetected)
-					cancel(ErrToolLoopDetected)
+	// completedSteps guards failover: once a step has run, its tool calls
+	// may have had side effects, so the error is returned instead of
+	// replaying the turn on a fallback model.
+	completedSteps := 0
+	onStep := sdk.WithOnStep(func(step *sdk.StepResult) *sdk.GenerateParams {
+		completedSteps++
+		if cfg.LoopDetection.Enabled {
+			if toolLoopAbortCallIDs.Any() {
+				loopAbort.Set(ErrToolLoopDetected)
+				cancel(ErrToolLoopDetected)
+				return nil
+			}
+			if textLoopGuard != nil && isNonEmptyString(step.Text) {
+				result := textLoopGuard.Inspect(step.Text)
+				if result.Abort {
+					loopAbort.Set(ErrTextLoopDetected)
+					cancel(ErrTextLoopDetected)
 					return nil
 				}
-				if textLoopGuard != nil && isNonEmptyString(step.Text) {
-					result := textLoopGuard.Inspect(step.Text)
-					if result.Abort {
-						loopAbort.Set(ErrTextLoopDetected)
-						cancel(ErrTextLoopDetected)
-						return nil
-					}
-				}
 			}
-			return nil
-		}),
-	)
+		}
+		return nil
+	})
 
-	genResult, err := a.client.GenerateTextResult(genCtx, opts...)
+	var (
+		genResult *sdk.GenerateResult
+		err       error
+	)
+	runCfg := cfg
+	chain := a.modelChain(cfg)
+	for i, candidate := range chain {
+		runCfg = withModelCandidate(cfg, candidate)
+		opts := append(a.buildGenerateOptions(runCfg, sdkTools, prepareStep), onStep)
+		genResult, err = a.client.GenerateTextResult(genCtx, opts...)
+		if err == nil {
+			a.selectedModel(cfg, candidate)
+			break
+		}
+		reason := failover.Classify(err)
+		a.breaker.Failure(candidate.ProviderID, reason)
+		if i+1 == len(chain) || reason == failover.ReasonNone || completedSteps > 0 {
+			break
+		}
+		a.logger.Warn("generate failed, falling back to next model",
+			slog.String("model", candidate.Model.ID),
+			slog.String("fallback_model", chain[i+1].Model.ID),
+			slog.String("reason", string(reason)),
+			slog.String("error", err.Error()),
+		)
+	}
 	if err != nil {
 		if loopErr := detectGenerateLoopAbort(genCtx, err); loopErr != nil {
 			return nil, loopErr
@@ -679,14 +681,25 @@ func (a *Agent) runGenerate(ctx context.Context, cfg RunConfig) (*GenerateResult
 	if readMediaState != nil {
 		finalMessages = readMediaState.mergeMessages(genResult.Steps, finalMessages)
 	}
-	return &GenerateResult{
+	result := &GenerateResult{
 		Messages:    finalMessages,
 		Text:        genResult.Text,
 		Attachments: attachments,
 		Reactions:   reactions,
 		Speeches:    speeches,
 		Usage:       &genResult.Usage,
-	}, nil
+	}
+	if cfg.OutputSchema != nil {
+		output, err := a.completeStructuredOutput(ctx, runCfg, sdkTools, finalMessages)
+		result.Messages = append(result.Messages, output.Messages...)
+		addUsage(result.Usage, output.Usage)
+		if err != nil {
+			return nil, err
+		}
+		result.Text = output.Text
+		result.Structured = output.Value
+	}
+	return result, nil
 }
 
 func (*Agent) buildGenerateOptions(cfg RunConfig, tools []sdk.Tool, prepareStep func(*sdk.GenerateParams) *sdk.GenerateParams) []sdk.GenerateOption {
@@ -705,6 +718,9 @@ func (*Agent) buildGenerateOptions(cfg RunConfig, tools []sdk.Tool, prepareStep
 	if cfg.ToolApprovalHandler != nil {
 		opts = append(opts, sdk.WithApprovalHandler(cfg.ToolApprovalHandler))
 	}
+	if format, ok := structuredResponseFormat(cfg); ok {
+		opts = append(opts, sdk.WithResponseFormat(format))
+	}
 
 	// Wrap the existing prepareStep (if any) with mid-task context pruning.
 	// When the message array grows large during multi-tool runs, this prunes
@@ -788,12 +804,11 @@ func (a *Agent) assembleTools(ctx context.Context, cfg RunConfig, emitter tools.
 	return allTools, nil
 }
 
+// markApprovalTools routes every tool call through the approval handler so
+// bot tool policies can allow, deny or hold any native or MCP tool.
 func markApprovalTools(tools []sdk.Tool) []sdk.Tool {
 	for i := range tools {
-		switch tools[i].Name {
-		case "write", "edit", "exec":
-			tools[i].RequireApproval = true
-		}
+		tools[i].RequireApproval = true
 	}
 	return tools
 }
@@ -0,0 +1,176 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// DelegateTaskTimeout is the safety ceiling for a background delegation,
+// mirroring SpawnTaskTimeout.
+const DelegateTaskTimeout = 30 * time.Minute
+
+// MaxRunningDelegateTasks caps concurrently running delegations per
+// bot+session so one conversation cannot fan out work across every bot it
+// can reach.
+const MaxRunningDelegateTasks = 3
+
+// delegateReportMaxBytes caps the target bot's answer carried in the
+// notification; the full transcript stays in the target bot's session.
+const delegateReportMaxBytes = 4096
+
+// DelegateOutcome describes a task handed to another bot by delegate_to_bot.
+// TargetSessionID points at the session on the target bot that ran the task.
+type DelegateOutcome struct {
+	DelegationID    string
+	TargetBotID     string
+	TargetBotName   string
+	TargetSessionID string
+	Hop             int
+	Status          TaskStatus
+	Report          string
+	Error           string
+}
+
+// StartDelegateTask registers a background task for a delegation to another
+// bot. It returns the task ID and a detached, cancelable context the
+// delegation run must derive from so Kill can stop it.
+func (m *Manager) StartDelegateTask(parentCtx context.Context, botID, sessionID, description string, outcome DelegateOutcome) (string, context.Context, error) {
+	ctx, cancel := detachedContextWithTimeout(parentCtx, DelegateTaskTimeout)
+
+	m.mu.Lock()
+	if m.runningKindCountLocked(KindDelegate, botID, sessionID) >= MaxRunningDelegateTasks {
+		m.mu.Unlock()
+		cancel()
+		return "", nil, fmt.Errorf("delegation limit reached: max %d concurrently running delegations per session", MaxRunningDelegateTasks)
+	}
+	taskID := m.newTaskIDLocked(botID)
+	outcome.Status = TaskRunning
+	task := &Task{
+		ID:          taskID,
+		Kind:        KindDelegate,
+		BotID:       botID,
+		SessionID:   sessionID,
+		Description: description,
+		Status:      TaskRunning,
+		StartedAt:   time.Now(),
+		cancel:      cancel,
+		delegation:  &outcome,
+	}
+	m.tasks[taskID] = task
+	m.mu.Unlock()
+
+	m.logger.Info("background delegate task started",
+		slog.String("task_id", taskID),
+		slog.String("bot_id", botID),
+		slog.String("target_bot_id", outcome.TargetBotID),
+		slog.String("delegation_id", outcome.DelegationID),
+	)
+	m.emitTaskEvent(task, TaskEventStarted, "", "")
+	return taskID, ctx, nil
+}
+
+// CompleteDelegateTask finalises a delegate task with the target bot's answer
+// (or error) and enqueues the notification for the delegating session.
+// Killed tasks record the outcome but never notify.
+func (m *Manager) CompleteDelegateTask(taskID, report string, runErr error) {
+	m.mu.Lock()
+	task := m.tasks[taskID]
+	m.mu.Unlock()
+	if task == nil || task.Kind != KindDelegate {
+		return
+	}
+	defer task.Cancel() // release the safety-timeout context
+
+	status := TaskCompleted
+	errText := ""
+	if runErr != nil {
+		status = TaskFailed
+		errText = truncate(runErr.Error(), spawnBranchErrorMaxBytes)
+	}
+	if len(report) > delegateReportMaxBytes {
+		report = report[len(report)-delegateReportMaxBytes:]
+	}
+
+	task.mu.Lock()
+	outcome := DelegateOutcome{}
+	if task.delegation != nil {
+		outcome = *task.delegation
+	}
+	outcome.Status = status
+	outcome.Report = report
+	outcome.Error = errText
+	task.delegation = &outcome
+	if task.Status == TaskKilled {
+		task.mu.Unlock()
+		return
+	}
+	task.CompletedAt = time.Now()
+	task.Status = status
+	duration := task.CompletedAt.Sub(task.StartedAt)
+	task.mu.Unlock()
+
+	m.logger.Info("background delegate task finished",
+		slog.String("task_id", task.ID),
+		slog.String("status", string(status)),
+		slog.String("target_bot_id", outcome.TargetBotID),
+		slog.Duration("duration", duration),
+	)
+
+	eventType := TaskEventCompleted
+	if status == TaskFailed {
+		eventType = TaskEventFailed
+	}
+	m.emitTaskEvent(task, eventType, "", "")
+
+	n := Notification{
+		TaskID:      task.ID,
+		Kind:        KindDelegate,
+		BotID:       task.BotID,
+		SessionID:   task.SessionID,
+		Status:      status,
+		Description: task.Description,
+		Delegation:  &outcome,
+		Duration:    duration,
+	}
+	m.emitFinished(n)
+
+	if !task.MarkNotified() {
+		return
+	}
+	m.enqueueNotification(n)
+}
+
+// formatDelegateForAgent renders the outcome of a delegation.
+func (n Notification) formatDelegateForAgent() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<task-notification>\n")
+	fmt.Fprintf(&b, "  <task-id>%s</task-id>\n", n.TaskID)
+	fmt.Fprintf(&b, "  <kind>delegate</kind>\n")
+	fmt.Fprintf(&b, "  <status>%s</status>\n", n.Status)
+	if n.Description != "" {
+		fmt.Fprintf(&b, "  <description>%s</description>\n", n.Description)
+	}
+	fmt.Fprintf(&b, "  <duration>%s</duration>\n", n.Duration.Round(time.Millisecond))
+	if d := n.Delegation; d != nil {
+		fmt.Fprintf(&b, "  <bot id=%q", d.TargetBotID)
+		if d.TargetBotName != "" {
+			fmt.Fprintf(&b, " name=%q", d.TargetBotName)
+		}
+		fmt.Fprintf(&b, ">\n")
+		if d.DelegationID != "" {
+			fmt.Fprintf(&b, "    <delegation-id>%s</delegation-id>\n", d.DelegationID)
+		}
+		if d.Report != "" {
+			fmt.Fprintf(&b, "    <report>\n%s\n    </report>\n", strings.TrimRight(d.Report, "\n"))
+		}
+		if d.Error != "" {
+			fmt.Fprintf(&b, "    <error>%s</error>\n", d.Error)
+		}
+		fmt.Fprintf(&b, "  </bot>\n")
+	}
+	fmt.Fprintf(&b, "</task-notification>")
+	return b.String()
+}
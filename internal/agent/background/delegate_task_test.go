@@ -0,0 +1,93 @@
+package background
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCompleteDelegateTaskNotifiesOutcome(t *testing.T) {
+	mgr := New(nil)
+
+	taskID, _, err := mgr.StartDelegateTask(context.Background(), "bot1", "sess1", "delegate: summarize the logs", DelegateOutcome{
+		DelegationID:  "del-1",
+		TargetBotID:   "bot2",
+		TargetBotName: "analyst",
+		Hop:           1,
+	})
+	if err != nil {
+		t.Fatalf("StartDelegateTask failed: %v", err)
+	}
+	snap := mgr.GetForSession("bot1", "sess1", taskID).Snapshot()
+	if snap.Kind != KindDelegate || snap.Delegation == nil || snap.Delegation.Status != TaskRunning {
+		t.Fatalf("unexpected running snapshot: %+v", snap)
+	}
+
+	mgr.CompleteDelegateTask(taskID, "three errors, all timeouts", nil)
+
+	n := waitDrain(t, mgr, "bot1", "sess1", 1)[0]
+	if n.Kind != KindDelegate || n.Status != TaskCompleted {
+		t.Fatalf("unexpected notification: %+v", n)
+	}
+	if n.Delegation == nil || n.Delegation.TargetBotID != "bot2" || n.Delegation.Report != "three errors, all timeouts" {
+		t.Fatalf("unexpected delegation outcome: %+v", n.Delegation)
+	}
+
+	text := n.FormatForAgent()
+	for _, want := range []string{"<kind>delegate</kind>", `name="analyst"`, "del-1", "three errors, all timeouts"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("delegate notification missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestCompleteDelegateTaskFailure(t *testing.T) {
+	mgr := New(nil)
+
+	taskID, _, err := mgr.StartDelegateTask(context.Background(), "bot1", "sess1", "delegate", DelegateOutcome{TargetBotID: "bot2"})
+	if err != nil {
+		t.Fatalf("StartDelegateTask failed: %v", err)
+	}
+	mgr.CompleteDelegateTask(taskID, "", errors.New("model unavailable"))
+
+	n := waitDrain(t, mgr, "bot1", "sess1", 1)[0]
+	if n.Status != TaskFailed || n.Delegation.Error != "model unavailable" {
+		t.Fatalf("unexpected failed notification: %+v %+v", n, n.Delegation)
+	}
+}
+
+func TestKillDelegateTaskSuppressesNotification(t *testing.T) {
+	mgr := New(nil)
+
+	taskID, taskCtx, err := mgr.StartDelegateTask(context.Background(), "bot1", "sess1", "delegate", DelegateOutcome{TargetBotID: "bot2"})
+	if err != nil {
+		t.Fatalf("StartDelegateTask failed: %v", err)
+	}
+	if err := mgr.Kill(taskID); err != nil {
+		t.Fatalf("kill failed: %v", err)
+	}
+	if taskCtx.Err() == nil {
+		t.Fatal("expected task context to be canceled by Kill")
+	}
+	mgr.CompleteDelegateTask(taskID, "", context.Canceled)
+	if notifications := mgr.DrainNotifications("bot1", "sess1"); len(notifications) != 0 {
+		t.Errorf("expected no notifications for killed task, got %d", len(notifications))
+	}
+}
+
+func TestStartDelegateTaskEnforcesRunningCap(t *testing.T) {
+	mgr := New(nil)
+
+	for range MaxRunningDelegateTasks {
+		if _, _, err := mgr.StartDelegateTask(context.Background(), "bot1", "sess1", "delegate", DelegateOutcome{}); err != nil {
+			t.Fatalf("StartDelegateTask under cap failed: %v", err)
+		}
+	}
+	if _, _, err := mgr.StartDelegateTask(context.Background(), "bot1", "sess1", "over cap", DelegateOutcome{}); err == nil {
+		t.Fatal("expected error when running delegate cap is reached")
+	}
+	if _, _, err := mgr.StartSpawnTask(context.Background(), "bot1", "sess1", "spawn"); err != nil {
+		t.Fatalf("expected spawn tasks to be counted separately: %v", err)
+	}
+}
@@ -68,6 +68,7 @@ type Manager struct {
 	logger        *slog.Logger
 	wakeFunc      func(botID, sessionID string) // optional callback to wake agent on new notification
 	eventFunc     func(TaskEvent)               // optional callback for live UI task updates
+	finishFunc    func(Notification)            // optional callback for finished (not stalled) tasks
 }
 
 // New creates a new background task Manager.
@@ -97,6 +98,14 @@ func (m *Manager) SetEventFunc(fn func(TaskEvent)) {
 	m.mu.Unlock()
 }
 
+// SetFinishFunc registers a callback that is invoked (in a goroutine) once per
+// task when it completes or fails, independent of agent notification delivery.
+func (m *Manager) SetFinishFunc(fn func(Notification)) {
+	m.mu.Lock()
+	m.finishFunc = fn
+	m.mu.Unlock()
+}
+
 func (m *Manager) emitEvent(event TaskEvent) {
 	m.mu.Lock()
 	fn := m.eventFunc
@@ -123,6 +132,16 @@ func (m *Manager) enqueueNotification(n Notification) {
 	}
 }
 
+// emitFinished hands a terminal notification to the finish callback, if any.
+func (m *Manager) emitFinished(n Notification) {
+	m.mu.Lock()
+	fn := m.finishFunc
+	m.mu.Unlock()
+	if fn != nil {
+		go fn(n)
+	}
+}
+
 func (m *Manager) emitTaskEvent(task *Task, event TaskEventType, stream, chunk string) {
 	if task == nil {
 		return
@@ -449,14 +468,7 @@ func (m *Manager) completeTask(task *Task, stdout, stderr string, execErr error,
 	}
 	m.emitTaskEvent(task, eventType, "", "")
 
-	// Guard against double notification when Kill or an auto-background race
-	// already enqueued one for this task. UI terminal events are emitted above
-	// even if an earlier stalled notification already woke the agent.
-	if !task.MarkNotified() {
-		return
-	}
-
-	m.enqueueNotification(Notification{
+	n := Notification{
 		TaskID:      task.ID,
 		BotID:       task.BotID,
 		SessionID:   task.SessionID,
@@ -467,7 +479,17 @@ func (m *Manager) completeTask(task *Task, stdout, stderr string, execErr error,
 		OutputFile:  task.OutputFile,
 		OutputTail:  task.OutputTail(),
 		Duration:    duration,
-	})
+	}
+	m.emitFinished(n)
+
+	// Guard against double notification when Kill or an auto-background race
+	// already enqueued one for this task. UI terminal events are emitted above
+	// even if an earlier stalled notification already woke the agent.
+	if !task.MarkNotified() {
+		return
+	}
+
+	m.enqueueNotification(n)
 }
 
 func readSentinelExitCode(ctx context.Context, path string, readFn ReadFileFunc) (int32, error) {
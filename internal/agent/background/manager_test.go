@@ -522,3 +522,24 @@ func TestNotificationFormat(t *testing.T) {
 		}
 	}
 }
+
+func TestFinishFuncReceivesTerminalTask(t *testing.T) {
+	mgr := New(nil)
+	finished := make(chan Notification, 1)
+	mgr.SetFinishFunc(func(n Notification) { finished <- n })
+	execFn := func(_ context.Context, _, _ string, _ int32) (*bridge.ExecResult, error) {
+		return &bridge.ExecResult{Stdout: "boom\n", ExitCode: 3}, nil
+	}
+
+	taskID, _ := mgr.Spawn(context.Background(), "bot1", "sess1", "make test", "/data", "", execFn, nil, nil)
+
+	select {
+	case n := <-finished:
+		if n.TaskID != taskID || n.Status != TaskFailed || n.ExitCode != 3 || n.Command != "make test" {
+			t.Fatalf("unexpected finish notification: %+v", n)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for finish callback")
+	}
+	_ = waitDrain(t, mgr, "bot1", "sess1", 1)
+}
@@ -16,6 +16,8 @@ const (
 	KindExec TaskKind = "exec"
 	// KindSpawn is a background subagent batch run by the spawn tool.
 	KindSpawn TaskKind = "spawn"
+	// KindDelegate is a task handed to another bot by delegate_to_bot.
+	KindDelegate TaskKind = "delegate"
 )
 
 // SpawnTaskTimeout is the safety ceiling for a background spawn task,
@@ -97,10 +99,7 @@ func (m *Manager) CompleteSpawnTask(taskID string, branches []SpawnBranch) {
 	}
 	m.emitTaskEvent(task, eventType, "", "")
 
-	if !task.MarkNotified() {
-		return
-	}
-	m.enqueueNotification(Notification{
+	n := Notification{
 		TaskID:      task.ID,
 		Kind:        KindSpawn,
 		BotID:       task.BotID,
@@ -109,7 +108,13 @@ func (m *Manager) CompleteSpawnTask(taskID string, branches []SpawnBranch) {
 		Description: task.Description,
 		Branches:    append([]SpawnBranch(nil), branches...),
 		Duration:    duration,
-	})
+	}
+	m.emitFinished(n)
+
+	if !task.MarkNotified() {
+		return
+	}
+	m.enqueueNotification(n)
 }
 
 // clampSpawnBranches returns a copy of branches with each text field bounded:
@@ -161,12 +166,12 @@ func (n Notification) formatSpawnForAgent() string {
 	return b.String()
 }
 
-// runningSpawnCountLocked counts running spawn tasks for a bot+session.
+// runningKindCountLocked counts running tasks of one kind for a bot+session.
 // Caller must hold m.mu.
-func (m *Manager) runningSpawnCountLocked(botID, sessionID string) int {
+func (m *Manager) runningKindCountLocked(kind TaskKind, botID, sessionID string) int {
 	count := 0
 	for _, t := range m.tasks {
-		if t.Kind != KindSpawn || t.BotID != botID || t.SessionID != sessionID {
+		if t.Kind != kind || t.BotID != botID || t.SessionID != sessionID {
 			continue
 		}
 		t.mu.Lock()
@@ -186,7 +191,7 @@ func (m *Manager) StartSpawnTask(parentCtx context.Context, botID, sessionID, de
 	ctx, cancel := detachedContextWithTimeout(parentCtx, SpawnTaskTimeout)
 
 	m.mu.Lock()
-	if m.runningSpawnCountLocked(botID, sessionID) >= MaxRunningSpawnTasks {
+	if m.runningKindCountLocked(KindSpawn, botID, sessionID) >= MaxRunningSpawnTasks {
 		m.mu.Unlock()
 		cancel()
 		return "", nil, fmt.Errorf("spawn limit reached: max %d concurrently running background spawn tasks per session", MaxRunningSpawnTasks)
@@ -18,8 +18,8 @@ const (
 	TaskKilled    TaskStatus = "killed"
 )
 
-// Task represents a single background task (a container command execution
-// or a spawn subagent batch, per Kind).
+// Task represents a single background task (a container command execution,
+// a spawn subagent batch or a delegation to another bot, per Kind).
 type Task struct {
 	ID          string
 	Kind        TaskKind
@@ -36,10 +36,11 @@ type Task struct {
 
 	mu              sync.Mutex
 	cancel          context.CancelFunc
-	notified        bool            // true once a terminal notification has been enqueued; prevents duplicates
-	stalledNotified bool            // true once a stalled notification has been enqueued
-	output          strings.Builder // buffered output tail
-	branches        []SpawnBranch   // spawn-kind branch outcomes, set at completion
+	notified        bool             // true once a terminal notification has been enqueued; prevents duplicates
+	stalledNotified bool             // true once a stalled notification has been enqueued
+	output          strings.Builder  // buffered output tail
+	branches        []SpawnBranch    // spawn-kind branch outcomes, set at completion
+	delegation      *DelegateOutcome // delegate-kind target and outcome
 }
 
 // TaskSnapshot is a lock-safe, immutable view of a task for handler/UI code.
@@ -56,6 +57,7 @@ type TaskSnapshot struct {
 	OutputFile  string
 	OutputTail  string
 	Branches    []SpawnBranch
+	Delegation  *DelegateOutcome
 	StartedAt   time.Time
 	CompletedAt time.Time
 	Duration    time.Duration
@@ -74,6 +76,11 @@ func (t *Task) Snapshot() TaskSnapshot {
 	if !t.CompletedAt.IsZero() {
 		duration = t.CompletedAt.Sub(t.StartedAt)
 	}
+	var delegation *DelegateOutcome
+	if t.delegation != nil {
+		d := *t.delegation
+		delegation = &d
+	}
 	return TaskSnapshot{
 		TaskID:      t.ID,
 		Kind:        t.Kind,
@@ -87,6 +94,7 @@ func (t *Task) Snapshot() TaskSnapshot {
 		OutputFile:  t.OutputFile,
 		OutputTail:  t.outputTailLocked(),
 		Branches:    append([]SpawnBranch(nil), t.branches...),
+		Delegation:  delegation,
 		StartedAt:   t.StartedAt,
 		CompletedAt: t.CompletedAt,
 		Duration:    duration,
@@ -193,6 +201,7 @@ type Notification struct {
 	OutputFile  string
 	OutputTail  string // last N bytes of output for quick summary
 	Branches    []SpawnBranch
+	Delegation  *DelegateOutcome
 	Duration    time.Duration
 	Stalled     bool // true when task appears stuck on interactive input
 }
@@ -242,8 +251,11 @@ func (n Notification) MessageText() string {
 // FormatForAgent returns a human-readable task-notification block that can be
 // injected into the agent's message stream.
 func (n Notification) FormatForAgent() string {
-	if n.Kind == KindSpawn {
+	switch n.Kind {
+	case KindSpawn:
 		return n.formatSpawnForAgent()
+	case KindDelegate:
+		return n.formatDelegateForAgent()
 	}
 	var b strings.Builder
 	fmt.Fprintf(&b, "<task-notification>\n")
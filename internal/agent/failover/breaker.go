@@ -0,0 +1,99 @@
+package failover
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultThreshold is the number of consecutive failures that opens a
+	// provider's circuit.
+	DefaultThreshold = 3
+	// DefaultCooldown is how long an open circuit skips its provider before
+	// letting a probe request through.
+	DefaultCooldown = time.Minute
+)
+
+// Breaker is a per-provider circuit breaker shared by all agent runs. A
+// provider's circuit opens after threshold consecutive failures, or at once
+// on an auth failure, and stays open for the cooldown. After the cooldown the
+// provider is tried again; one more failure reopens it and a success closes
+// it. Breaker is safe for concurrent use.
+type Breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	states    map[string]*breakerState
+}
+
+type breakerState struct {
+	failures  int
+	openUntil time.Time
+}
+
+// NewBreaker creates a Breaker. Non-positive arguments fall back to
+// DefaultThreshold and DefaultCooldown.
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCooldown
+	}
+	return &Breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		states:    make(map[string]*breakerState),
+	}
+}
+
+// Allow reports whether provider may be called. Unknown and empty provider
+// keys are always allowed.
+func (b *Breaker) Allow(provider string) bool {
+	if b == nil || provider == "" {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state, ok := b.states[provider]
+	if !ok {
+		return true
+	}
+	return !b.now().Before(state.openUntil)
+}
+
+// Success closes provider's circuit.
+func (b *Breaker) Success(provider string) {
+	if b == nil || provider == "" {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.states, provider)
+}
+
+// Failure records a failed call to provider and reports whether its circuit
+// is now open. Reasons that say nothing about provider health are ignored.
+func (b *Breaker) Failure(provider string, reason Reason) bool {
+	if b == nil || provider == "" || !reason.countsAgainstProvider() {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state, ok := b.states[provider]
+	if !ok {
+		state = &breakerState{}
+		b.states[provider] = state
+	}
+	state.failures++
+	if reason == ReasonAuth && state.failures < b.threshold {
+		state.failures = b.threshold
+	}
+	if state.failures < b.threshold {
+		return false
+	}
+	state.openUntil = b.now().Add(b.cooldown)
+	return true
+}
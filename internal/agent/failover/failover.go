@@ -0,0 +1,77 @@
+// Package failover decides when an agent run should move from one chat model
+// to the next entry of a bot's fallback chain, and remembers which providers
+// are currently failing so they can be skipped for a cooldown period.
+//
+// Classification works on the error text the SDK surfaces ("api error 503:
+// ...") plus net.Error, mirroring the agent's retry heuristics.
+package failover
+
+import (
+	"context"
+	"errors"
+	"net"
+	"regexp"
+)
+
+// Reason is why a model call failed, as far as failover is concerned.
+type Reason string
+
+const (
+	// ReasonNone means the error is not one a different model would fix
+	// (bad request, tool error, cancellation); the run should fail as is.
+	ReasonNone          Reason = ""
+	ReasonServerError   Reason = "server_error"
+	ReasonRateLimit     Reason = "rate_limit"
+	ReasonContextLength Reason = "context_length"
+	ReasonAuth          Reason = "auth"
+	ReasonNetwork       Reason = "network"
+)
+
+var (
+	serverErrPattern = regexp.MustCompile(`api error 5\d{2}`)
+	rateLimitPattern = regexp.MustCompile(`(^|[^0-9])429($|[^0-9])|(?i)rate[ _]limit|too many requests`)
+	authPattern      = regexp.MustCompile(`api error 40[13]|(?i)unauthorized|invalid[ _]api[ _]key|authentication|permission denied`)
+	contextPattern   = regexp.MustCompile(`(?i)context[ _]length|context window|maximum context|prompt is too long|too many tokens|max_tokens_exceeded`)
+	networkPattern   = regexp.MustCompile(`(?i)connection (reset|refused)|EOF$|no such host`)
+)
+
+// Classify maps a model call error to a failover Reason. Context length is
+// checked before the status patterns because providers often report it as a
+// plain 400.
+func Classify(err error) Reason {
+	if err == nil {
+		return ReasonNone
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return ReasonNone
+	}
+	msg := err.Error()
+	switch {
+	case contextPattern.MatchString(msg):
+		return ReasonContextLength
+	case rateLimitPattern.MatchString(msg):
+		return ReasonRateLimit
+	case serverErrPattern.MatchString(msg):
+		return ReasonServerError
+	case authPattern.MatchString(msg):
+		return ReasonAuth
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) || networkPattern.MatchString(msg) {
+		return ReasonNetwork
+	}
+	return ReasonNone
+}
+
+// Immediate reports whether retrying the same model is pointless for reason,
+// so the run should move to the next fallback without further attempts.
+func (r Reason) Immediate() bool {
+	return r == ReasonContextLength || r == ReasonAuth
+}
+
+// countsAgainstProvider reports whether a failure says something about the
+// provider's health. A context-length error is specific to one request and
+// one model, so it never trips the breaker.
+func (r Reason) countsAgainstProvider() bool {
+	return r != ReasonNone && r != ReasonContextLength
+}
@@ -0,0 +1,112 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Reason
+	}{
+		{"nil", nil, ReasonNone},
+		{"canceled", fmt.Errorf("stream: %w", context.Canceled), ReasonNone},
+		{"bad request", errors.New("api error 400: invalid tool schema"), ReasonNone},
+		{"server", errors.New("api error 503: overloaded"), ReasonServerError},
+		{"rate limit status", errors.New("api error 429: slow down"), ReasonRateLimit},
+		{"rate limit text", errors.New("rate_limit_error: too fast"), ReasonRateLimit},
+		{"context length", errors.New("api error 400: This model's maximum context length is 128000 tokens"), ReasonContextLength},
+		{"prompt too long", errors.New("api error 400: prompt is too long: 210000 tokens > 200000 maximum"), ReasonContextLength},
+		{"unauthorized", errors.New("api error 401: invalid x-api-key"), ReasonAuth},
+		{"forbidden", errors.New("api error 403: permission denied"), ReasonAuth},
+		{"net error", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, ReasonNetwork},
+		{"reset", errors.New("read tcp: connection reset by peer"), ReasonNetwork},
+		{"unrelated number", errors.New("api error 400: budget 4290 too small"), ReasonNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.err); got != tt.want {
+				t.Fatalf("Classify(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReasonImmediate(t *testing.T) {
+	for _, r := range []Reason{ReasonContextLength, ReasonAuth} {
+		if !r.Immediate() {
+			t.Fatalf("%q should fail over immediately", r)
+		}
+	}
+	for _, r := range []Reason{ReasonNone, ReasonServerError, ReasonRateLimit, ReasonNetwork} {
+		if r.Immediate() {
+			t.Fatalf("%q should retry before failing over", r)
+		}
+	}
+}
+
+func TestBreakerOpensAfterThresholdAndRecovers(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	b := NewBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	if b.Failure("p1", ReasonServerError) {
+		t.Fatal("circuit opened before threshold")
+	}
+	if !b.Allow("p1") {
+		t.Fatal("provider should be allowed below threshold")
+	}
+	if !b.Failure("p1", ReasonRateLimit) {
+		t.Fatal("circuit should open at threshold")
+	}
+	if b.Allow("p1") {
+		t.Fatal("open circuit should skip provider")
+	}
+	if !b.Allow("p2") {
+		t.Fatal("other providers must not be affected")
+	}
+
+	now = now.Add(time.Minute)
+	if !b.Allow("p1") {
+		t.Fatal("provider should be probed after cooldown")
+	}
+	if !b.Failure("p1", ReasonServerError) {
+		t.Fatal("a failed probe should reopen the circuit")
+	}
+	if b.Allow("p1") {
+		t.Fatal("reopened circuit should skip provider")
+	}
+
+	now = now.Add(time.Minute)
+	b.Success("p1")
+	if !b.Allow("p1") || b.Failure("p1", ReasonServerError) {
+		t.Fatal("success should reset the failure count")
+	}
+}
+
+func TestBreakerAuthOpensImmediatelyAndContextLengthIgnored(t *testing.T) {
+	b := NewBreaker(0, 0)
+	if b.threshold != DefaultThreshold || b.cooldown != DefaultCooldown {
+		t.Fatalf("defaults = (%d, %v)", b.threshold, b.cooldown)
+	}
+	for range DefaultThreshold + 1 {
+		if b.Failure("p1", ReasonContextLength) {
+			t.Fatal("context length errors must not open the circuit")
+		}
+	}
+	if !b.Allow("p1") {
+		t.Fatal("provider should still be allowed")
+	}
+	if !b.Failure("p1", ReasonAuth) || b.Allow("p1") {
+		t.Fatal("auth failure should open the circuit at once")
+	}
+	if !b.Allow("") {
+		t.Fatal("empty provider key is always allowed")
+	}
+}
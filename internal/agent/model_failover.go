@@ -0,0 +1,188 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	sdk "github.com/memohai/twilight-ai/sdk"
+
+	"github.com/memohai/memoh/internal/agent/failover"
+)
+
+// failoverAttempts is how many times a transient error (5xx, rate limit,
+// network) is tried on one model before the run moves down the fallback
+// chain. Context-length and auth errors fail over on the first attempt.
+const failoverAttempts = 2
+
+// modelChain returns the primary model followed by cfg.Fallbacks, dropping
+// candidates whose provider circuit is open. When every candidate is open
+// the full chain is returned so the run still gets a chance to succeed.
+func (a *Agent) modelChain(cfg RunConfig) []ModelCandidate {
+	chain := make([]ModelCandidate, 0, len(cfg.Fallbacks)+1)
+	chain = append(chain, ModelCandidate{
+		ProviderID:            cfg.ProviderID,
+		Model:                 cfg.Model,
+		ReasoningEffort:       cfg.ReasoningEffort,
+		ReasoningDisabled:     cfg.ReasoningDisabled,
+		ChatCompletionsCompat: cfg.ChatCompletionsCompat,
+		PromptCacheTTL:        cfg.PromptCacheTTL,
+		SupportsImageInput:    cfg.SupportsImageInput,
+		SupportsToolCall:      cfg.SupportsToolCall,
+	})
+	for _, candidate := range cfg.Fallbacks {
+		if candidate.Model != nil {
+			chain = append(chain, candidate)
+		}
+	}
+	if len(chain) == 1 {
+		return chain
+	}
+	allowed := make([]ModelCandidate, 0, len(chain))
+	for _, candidate := range chain {
+		if a.breaker.Allow(candidate.ProviderID) {
+			allowed = append(allowed, candidate)
+			continue
+		}
+		a.logger.Info("skipping model with open provider circuit",
+			slog.String("bot_id", cfg.Identity.BotID),
+			slog.String("model", candidate.Model.ID),
+			slog.String("provider_id", candidate.ProviderID),
+		)
+	}
+	if len(allowed) == 0 {
+		return chain
+	}
+	return allowed
+}
+
+// withModelCandidate returns cfg with its model-specific fields replaced by
+// candidate's.
+func withModelCandidate(cfg RunConfig, candidate ModelCandidate) RunConfig {
+	cfg.Model = candidate.Model
+	cfg.ProviderID = candidate.ProviderID
+	cfg.ReasoningEffort = candidate.ReasoningEffort
+	cfg.ReasoningDisabled = candidate.ReasoningDisabled
+	cfg.ChatCompletionsCompat = candidate.ChatCompletionsCompat
+	cfg.PromptCacheTTL = candidate.PromptCacheTTL
+	cfg.SupportsImageInput = candidate.SupportsImageInput
+	cfg.SupportsToolCall = candidate.SupportsToolCall
+	return cfg
+}
+
+// shouldFailOver reports whether a failed attempt should move the run to the
+// next model instead of retrying the current one.
+func shouldFailOver(reason failover.Reason, attempt, maxAttempts int) bool {
+	if reason == failover.ReasonNone {
+		return false
+	}
+	if reason.Immediate() {
+		return true
+	}
+	return attempt+1 >= min(failoverAttempts, maxAttempts)
+}
+
+// selectedModel records a successful call on candidate and, when candidate
+// is not the primary model, reports the switch to the caller.
+func (a *Agent) selectedModel(cfg RunConfig, candidate ModelCandidate) {
+	a.breaker.Success(candidate.ProviderID)
+	if candidate.Model == cfg.Model {
+		return
+	}
+	a.logger.Warn("model fallback selected",
+		slog.String("bot_id", cfg.Identity.BotID),
+		slog.String("primary_model", cfg.Model.ID),
+		slog.String("model", candidate.Model.ID),
+	)
+	if cfg.OnModelFallback != nil {
+		cfg.OnModelFallback(candidate)
+	}
+}
+
+// startStream opens the model stream, retrying transient failures and
+// walking the fallback chain. It returns the stream together with the run
+// config of the model that accepted the request; on failure it has already
+// emitted an error event and ok is false.
+func (a *Agent) startStream(
+	ctx context.Context,
+	streamCtx context.Context,
+	ch chan<- StreamEvent,
+	cfg RunConfig,
+	sdkTools []sdk.Tool,
+	prepareStep func(*sdk.GenerateParams) *sdk.GenerateParams,
+) (*sdk.StreamResult, RunConfig, bool) {
+	retryCfg := cfg.Retry
+	if retryCfg.MaxAttempts <= 0 {
+		retryCfg = DefaultRetryConfig()
+	}
+
+	chain := a.modelChain(cfg)
+	for i, candidate := range chain {
+		runCfg := withModelCandidate(cfg, candidate)
+		opts := a.buildGenerateOptions(runCfg, sdkTools, prepareStep)
+		hasNext := i+1 < len(chain)
+
+		for attempt := 0; attempt < retryCfg.MaxAttempts; attempt++ {
+			streamResult, err := a.client.StreamText(streamCtx, opts...)
+			if err == nil {
+				a.selectedModel(cfg, candidate)
+				return streamResult, runCfg, true
+			}
+			reason := failover.Classify(err)
+			if a.breaker.Failure(candidate.ProviderID, reason) {
+				a.logger.Warn("provider circuit opened",
+					slog.String("provider_id", candidate.ProviderID),
+					slog.String("reason", string(reason)),
+				)
+			}
+			if hasNext && shouldFailOver(reason, attempt, retryCfg.MaxAttempts) {
+				next := chain[i+1]
+				a.logger.Warn("stream start failed, falling back to next model",
+					slog.String("model", candidate.Model.ID),
+					slog.String("fallback_model", next.Model.ID),
+					slog.String("reason", string(reason)),
+					slog.String("error", err.Error()),
+				)
+				if !sendEvent(ctx, ch, StreamEvent{
+					Type:       EventRetry,
+					Attempt:    attempt + 1,
+					MaxAttempt: retryCfg.MaxAttempts,
+					RetryError: fmt.Sprintf("%v (falling back to %s)", err, next.Model.ID),
+				}) {
+					return nil, runCfg, false
+				}
+				break
+			}
+			if !isRetryableStreamError(err) {
+				sendEvent(ctx, ch, StreamEvent{Type: EventError, Error: fmt.Sprintf("stream start: %v", err)})
+				return nil, runCfg, false
+			}
+			a.logger.Warn("stream start failed, retrying",
+				slog.Int("attempt", attempt+1),
+				slog.Int("max_attempts", retryCfg.MaxAttempts),
+				slog.String("error", err.Error()),
+			)
+			if !sendEvent(ctx, ch, StreamEvent{
+				Type:       EventRetry,
+				Attempt:    attempt + 1,
+				MaxAttempt: retryCfg.MaxAttempts,
+				RetryError: err.Error(),
+			}) {
+				return nil, runCfg, false
+			}
+			if attempt+1 >= retryCfg.MaxAttempts {
+				sendEvent(ctx, ch, StreamEvent{Type: EventError, Error: fmt.Sprintf("stream start: all %d attempts failed (last: %v)", retryCfg.MaxAttempts, err)})
+				return nil, runCfg, false
+			}
+			delay := retryDelay(attempt, retryCfg)
+			if delay > 0 {
+				if err := sleepWithContext(streamCtx, delay); err != nil {
+					sendEvent(ctx, ch, StreamEvent{Type: EventError, Error: fmt.Sprintf("stream start: context cancelled during retry: %v", err)})
+					return nil, runCfg, false
+				}
+			}
+		}
+	}
+	sendEvent(ctx, ch, StreamEvent{Type: EventError, Error: "stream start: no model available"})
+	return nil, cfg, false
+}
@@ -0,0 +1,127 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/memohai/twilight-ai/sdk"
+)
+
+func failingProvider(err error) *atomicMockProvider {
+	return &atomicMockProvider{
+		handler: func(int, sdk.GenerateParams) (*sdk.GenerateResult, error) {
+			return nil, err
+		},
+	}
+}
+
+func answeringProvider(text string) *atomicMockProvider {
+	return &atomicMockProvider{
+		handler: func(int, sdk.GenerateParams) (*sdk.GenerateResult, error) {
+			return &sdk.GenerateResult{Text: text, FinishReason: sdk.FinishReasonStop}, nil
+		},
+	}
+}
+
+func TestAgentGenerateFailsOverToNextModel(t *testing.T) {
+	t.Parallel()
+
+	primary := failingProvider(errors.New("api error 503: overloaded"))
+	fallback := answeringProvider("from fallback")
+
+	var selected []string
+	a := New(Deps{})
+	result, err := a.Generate(context.Background(), RunConfig{
+		Model:      &sdk.Model{ID: "primary-model", Provider: primary},
+		ProviderID: "provider-a",
+		Messages:   []sdk.Message{sdk.UserMessage("hi")},
+		Identity:   SessionContext{BotID: "bot-1"},
+		Fallbacks: []ModelCandidate{{
+			ModelID:    "model-uuid-b",
+			ProviderID: "provider-b",
+			Model:      &sdk.Model{ID: "fallback-model", Provider: fallback},
+		}},
+		OnModelFallback: func(candidate ModelCandidate) {
+			selected = append(selected, candidate.ModelID)
+		},
+	})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if result.Text != "from fallback" {
+		t.Fatalf("Generate() text = %q", result.Text)
+	}
+	if len(selected) != 1 || selected[0] != "model-uuid-b" {
+		t.Fatalf("OnModelFallback calls = %v", selected)
+	}
+	if primary.calls.Load() != 1 || fallback.calls.Load() != 1 {
+		t.Fatalf("calls = (%d, %d), want (1, 1)", primary.calls.Load(), fallback.calls.Load())
+	}
+}
+
+func TestAgentGenerateDoesNotFailOverOnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	primary := failingProvider(errors.New("api error 400: invalid tool schema"))
+	fallback := answeringProvider("unused")
+
+	a := New(Deps{})
+	_, err := a.Generate(context.Background(), RunConfig{
+		Model:    &sdk.Model{ID: "primary-model", Provider: primary},
+		Messages: []sdk.Message{sdk.UserMessage("hi")},
+		Fallbacks: []ModelCandidate{{
+			ProviderID: "provider-b",
+			Model:      &sdk.Model{ID: "fallback-model", Provider: fallback},
+		}},
+	})
+	if err == nil {
+		t.Fatal("expected bad request to be returned")
+	}
+	if fallback.calls.Load() != 0 {
+		t.Fatalf("fallback called %d times", fallback.calls.Load())
+	}
+}
+
+func TestAgentStreamFailsOverAndSkipsOpenCircuit(t *testing.T) {
+	t.Parallel()
+
+	primary := failingProvider(errors.New("api error 401: invalid x-api-key"))
+	fallback := answeringProvider("streamed from fallback")
+
+	a := New(Deps{})
+	cfg := RunConfig{
+		Model:      &sdk.Model{ID: "primary-model", Provider: primary},
+		ProviderID: "provider-a",
+		Messages:   []sdk.Message{sdk.UserMessage("hi")},
+		Retry:      RetryConfig{MaxAttempts: 3},
+		Fallbacks: []ModelCandidate{{
+			ModelID:    "model-uuid-b",
+			ProviderID: "provider-b",
+			Model:      &sdk.Model{ID: "fallback-model", Provider: fallback},
+		}},
+	}
+
+	for run := range 2 {
+		var text string
+		for evt := range a.Stream(context.Background(), cfg) {
+			switch evt.Type {
+			case EventTextDelta:
+				text += evt.Delta
+			case EventError:
+				t.Fatalf("run %d: unexpected error event %q", run, evt.Error)
+			}
+		}
+		if text != "streamed from fallback" {
+			t.Fatalf("run %d: streamed text = %q", run, text)
+		}
+	}
+	// The auth failure opened provider-a's circuit, so the second run went
+	// straight to the fallback.
+	if primary.calls.Load() != 1 {
+		t.Fatalf("primary called %d times, want 1", primary.calls.Load())
+	}
+	if fallback.calls.Load() != 2 {
+		t.Fatalf("fallback called %d times, want 2", fallback.calls.Load())
+	}
+}
@@ -17,14 +17,18 @@ import (
 var promptsFS embed.FS
 
 var (
-	systemCommonTmpl  string
-	modeChatTmpl      string
-	modeDiscussTmpl   string
-	modeHeartbeatTmpl string
-	modeScheduleTmpl  string
-	modeSubagentTmpl  string
-	scheduleTmpl      string
-	heartbeatTmpl     string
+	systemCommonTmpl   string
+	modeChatTmpl       string
+	modeDiscussTmpl    string
+	modeHeartbeatTmpl  string
+	modeScheduleTmpl   string
+	modeSubagentTmpl   string
+	modeDelegationTmpl string
+	modeWorkflowTmpl   string
+	scheduleTmpl       string
+	heartbeatTmpl      string
+	delegationTmpl     string
+	workflowTmpl       string
 
 	MemoryExtractPrompt string
 	MemoryUpdatePrompt  string
@@ -41,8 +45,12 @@ func init() {
 	modeHeartbeatTmpl = mustReadPrompt("prompts/mode_heartbeat.md")
 	modeScheduleTmpl = mustReadPrompt("prompts/mode_schedule.md")
 	modeSubagentTmpl = mustReadPrompt("prompts/mode_subagent.md")
+	modeDelegationTmpl = mustReadPrompt("prompts/mode_delegation.md")
+	modeWorkflowTmpl = mustReadPrompt("prompts/mode_workflow.md")
 	scheduleTmpl = mustReadPrompt("prompts/schedule.md")
 	heartbeatTmpl = mustReadPrompt("prompts/heartbeat.md")
+	delegationTmpl = mustReadPrompt("prompts/delegation.md")
+	workflowTmpl = mustReadPrompt("prompts/workflow.md")
 	MemoryExtractPrompt = mustReadPrompt("prompts/memory_extract.md")
 	MemoryUpdatePrompt = mustReadPrompt("prompts/memory_update.md")
 
@@ -53,6 +61,7 @@ func init() {
 		"_identities":    mustReadPrompt("prompts/_identities.md"),
 		"_schedule_task": mustReadPrompt("prompts/_schedule_task.md"),
 		"_subagent":      mustReadPrompt("prompts/_subagent.md"),
+		"_delegation":    mustReadPrompt("prompts/_delegation.md"),
 	}
 
 	systemCommonTmpl = resolveIncludes(systemCommonTmpl)
@@ -61,6 +70,8 @@ func init() {
 	modeHeartbeatTmpl = resolveIncludes(modeHeartbeatTmpl)
 	modeScheduleTmpl = resolveIncludes(modeScheduleTmpl)
 	modeSubagentTmpl = resolveIncludes(modeSubagentTmpl)
+	modeDelegationTmpl = resolveIncludes(modeDelegationTmpl)
+	modeWorkflowTmpl = resolveIncludes(modeWorkflowTmpl)
 }
 
 func mustReadPrompt(name string) string {
@@ -105,6 +116,10 @@ func selectModeTemplate(sessionType string) string {
 		return modeScheduleTmpl
 	case "subagent":
 		return modeSubagentTmpl
+	case "delegation":
+		return modeDelegationTmpl
+	case "workflow":
+		return modeWorkflowTmpl
 	default:
 		return modeChatTmpl
 	}
@@ -208,12 +223,42 @@ func GenerateSchedulePrompt(s Schedule) string {
 	if s.MaxCalls != nil {
 		maxCallsStr = strconv.Itoa(*s.MaxCalls)
 	}
+	trigger := "cron: " + s.Pattern
+	if s.TriggerKind != "" && s.TriggerKind != "cron" {
+		trigger = "trigger: " + s.TriggerKind
+	}
+	eventSection := ""
+	if strings.TrimSpace(s.Event) != "" {
+		eventSection = "\nTriggering event:\n" + strings.TrimSpace(s.Event)
+	}
 	return render(scheduleTmpl, map[string]string{
 		"name":        s.Name,
 		"description": s.Description,
 		"maxCalls":    maxCallsStr,
-		"pattern":     s.Pattern,
+		"trigger":     trigger,
 		"command":     s.Command,
+		"event":       eventSection,
+	})
+}
+
+// GenerateDelegationPrompt builds the user message for a delegated task.
+func GenerateDelegationPrompt(d Delegation) string {
+	return render(delegationTmpl, map[string]string{
+		"from":         d.FromBot,
+		"delegationID": d.ID,
+		"hop":          strconv.Itoa(d.Hop),
+		"task":         d.Task,
+	})
+}
+
+// GenerateWorkflowStepPrompt builds the user message for an agent step of a
+// workflow run.
+func GenerateWorkflowStepPrompt(w WorkflowStep) string {
+	return render(workflowTmpl, map[string]string{
+		"workflow": w.Workflow,
+		"runID":    w.RunID,
+		"step":     w.StepID,
+		"prompt":   w.Prompt,
 	})
 }
 
@@ -284,6 +329,7 @@ func buildMainAgentSections(platformIdentitiesSection, skillsSection, fileSectio
 		includes["_schedule_task"],
 		"When a scheduled task triggers, it runs in its own session. Use `send` in the schedule command to deliver results to the intended channel.",
 		includes["_subagent"],
+		includes["_delegation"],
 		skillsSection,
 		fileSections,
 	}
@@ -71,6 +71,23 @@ func TestGenerateSystemPromptIncludesCommonAndModeContracts(t *testing.T) {
 				"You are a task-focused worker spawned by a parent agent.",
 			},
 		},
+		{
+			sessionType: "delegation",
+			want: []string{
+				"You are an AI agent running inside a private Memoh workspace.",
+				"## Session mode: delegation",
+				"Another bot on this instance handed you a task.",
+				"## Other bots",
+			},
+		},
+		{
+			sessionType: "workflow",
+			want: []string{
+				"You are an AI agent running inside a private Memoh workspace.",
+				"## Session mode: workflow",
+				"You are running one step of an automated workflow.",
+			},
+		},
 	}
 
 	for _, tc := range cases {
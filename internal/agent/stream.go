@@ -48,6 +48,7 @@ type StreamEvent struct {
 	Speeches       []SpeechItem     `json:"speeches,omitempty"`
 	Messages       json.RawMessage  `json:"messages,omitempty"`
 	Usage          json.RawMessage  `json:"usage,omitempty"`
+	Structured     json.RawMessage  `json:"structured,omitempty"`
 	Reasoning      []string         `json:"reasoning,omitempty"`
 	Error          string           `json:"error,omitempty"`
 	Attempt        int              `json:"attempt,omitempty"`
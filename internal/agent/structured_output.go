@@ -0,0 +1,140 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	sdk "github.com/memohai/twilight-ai/sdk"
+
+	"github.com/memohai/memoh/internal/models"
+	"github.com/memohai/memoh/internal/structured"
+)
+
+// structuredOutput is the validated reply of a run with an output schema,
+// together with the repair round trips it took to get there.
+type structuredOutput struct {
+	Value    json.RawMessage
+	Text     string
+	Messages []sdk.Message
+	Usage    sdk.Usage
+}
+
+// withStructuredOutput appends the output format instructions to the system
+// prompt. It runs before any prepare-step hook captures the base prompt.
+func withStructuredOutput(cfg RunConfig) RunConfig {
+	if cfg.OutputSchema == nil {
+		return cfg
+	}
+	instructions := structured.Instructions(cfg.OutputSchema)
+	if strings.TrimSpace(cfg.System) == "" {
+		cfg.System = instructions
+	} else {
+		cfg.System = cfg.System + "\n\n" + instructions
+	}
+	return cfg
+}
+
+// structuredResponseFormat returns the native response format for the run's
+// output schema when the model's client type supports one.
+func structuredResponseFormat(cfg RunConfig) (sdk.ResponseFormat, bool) {
+	if cfg.OutputSchema == nil || !cfg.OutputSchema.ObjectRoot() {
+		return sdk.ResponseFormat{}, false
+	}
+	if !models.SupportsStructuredOutput(models.ResolveClientType(cfg.Model), cfg.ChatCompletionsCompat) {
+		return sdk.ResponseFormat{}, false
+	}
+	return sdk.ResponseFormat{
+		Type:       sdk.ResponseFormatJSONSchema,
+		JSONSchema: cfg.OutputSchema.Document(),
+	}, true
+}
+
+// completeStructuredOutput validates the final reply of output against the
+// run's schema. An invalid reply is sent back to the model with the
+// validation error, up to structured.MaxRepairAttempts times. Repair calls
+// keep the tool definitions so the history stays acceptable to every
+// provider, but forbid tool use.
+func (a *Agent) completeStructuredOutput(ctx context.Context, cfg RunConfig, sdkTools []sdk.Tool, output []sdk.Message) (structuredOutput, error) {
+	result := structuredOutput{Text: finalAssistantText(output)}
+	value, err := cfg.OutputSchema.Validate(result.Text)
+	if err == nil {
+		result.Value = value
+		return result, nil
+	}
+
+	history := append(append([]sdk.Message(nil), cfg.Messages...), output...)
+	for attempt := 1; attempt <= structured.MaxRepairAttempts; attempt++ {
+		a.logger.Warn("structured output invalid, asking the model to repair it",
+			slog.String("bot_id", cfg.Identity.BotID),
+			slog.Int("attempt", attempt),
+			slog.String("error", err.Error()),
+		)
+		prompt := sdk.UserMessage(structured.RepairPrompt(cfg.OutputSchema, err))
+		history = append(history, prompt)
+		result.Messages = append(result.Messages, prompt)
+
+		opts := []sdk.GenerateOption{
+			sdk.WithModel(cfg.Model),
+			sdk.WithMessages(history),
+			sdk.WithSystem(cfg.System),
+		}
+		if len(sdkTools) > 0 && cfg.SupportsToolCall {
+			opts = append(opts, sdk.WithTools(sdkTools), sdk.WithToolChoice("none"))
+		}
+		if format, ok := structuredResponseFormat(cfg); ok {
+			opts = append(opts, sdk.WithResponseFormat(format))
+		}
+		genResult, genErr := a.client.GenerateTextResult(ctx, opts...)
+		if genErr != nil {
+			return result, fmt.Errorf("repair structured output: %w", genErr)
+		}
+		addUsage(&result.Usage, genResult.Usage)
+		history = append(history, genResult.Messages...)
+		result.Messages = append(result.Messages, genResult.Messages...)
+		result.Text = finalAssistantText(genResult.Messages)
+		if result.Text == "" {
+			result.Text = genResult.Text
+		}
+		if value, err = cfg.OutputSchema.Validate(result.Text); err == nil {
+			result.Value = value
+			return result, nil
+		}
+	}
+	return result, err
+}
+
+// finalAssistantText returns the text of the last assistant message, which
+// carries the answer after any tool rounds.
+func finalAssistantText(messages []sdk.Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role != sdk.MessageRoleAssistant {
+			continue
+		}
+		var b strings.Builder
+		for _, part := range messages[i].Content {
+			if text, ok := part.(sdk.TextPart); ok {
+				b.WriteString(text.Text)
+			}
+		}
+		if b.Len() > 0 {
+			return b.String()
+		}
+	}
+	return ""
+}
+
+func addUsage(total *sdk.Usage, usage sdk.Usage) {
+	total.InputTokens += usage.InputTokens
+	total.OutputTokens += usage.OutputTokens
+	total.TotalTokens += usage.TotalTokens
+	total.ReasoningTokens += usage.ReasoningTokens
+	total.CachedInputTokens += usage.CachedInputTokens
+	total.InputTokenDetails.NoCacheTokens += usage.InputTokenDetails.NoCacheTokens
+	total.InputTokenDetails.CacheReadTokens += usage.InputTokenDetails.CacheReadTokens
+	total.InputTokenDetails.CacheWriteTokens += usage.InputTokenDetails.CacheWriteTokens
+	total.OutputTokenDetails.TextTokens += usage.OutputTokenDetails.TextTokens
+	total.OutputTokenDetails.ReasoningTokens += usage.OutputTokenDetails.ReasoningTokens
+}
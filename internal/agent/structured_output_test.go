@@ -0,0 +1,76 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/memohai/twilight-ai/sdk"
+
+	"github.com/memohai/memoh/internal/structured"
+)
+
+const structuredTestSchema = `{"type":"object","properties":{"answer":{"type":"integer"}},"required":["answer"]}`
+
+func TestAgentGenerateRepairsStructuredOutput(t *testing.T) {
+	t.Parallel()
+
+	schema, err := structured.Compile([]byte(structuredTestSchema))
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	modelProvider := &atomicMockProvider{
+		handler: func(call int, _ sdk.GenerateParams) (*sdk.GenerateResult, error) {
+			text := "The answer is forty-two."
+			if call > 1 {
+				text = "```json\n{\"answer\": 42}\n```"
+			}
+			return &sdk.GenerateResult{Text: text, FinishReason: sdk.FinishReasonStop}, nil
+		},
+	}
+
+	a := New(Deps{})
+	result, err := a.Generate(context.Background(), RunConfig{
+		Model:        &sdk.Model{ID: "mock-model", Provider: modelProvider},
+		Messages:     []sdk.Message{sdk.UserMessage("what is the answer?")},
+		Identity:     SessionContext{BotID: "bot-1"},
+		OutputSchema: schema,
+	})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if string(result.Structured) != `{"answer":42}` {
+		t.Fatalf("structured = %s", result.Structured)
+	}
+	if modelProvider.calls.Load() != 2 {
+		t.Fatalf("expected one repair call, got %d provider calls", modelProvider.calls.Load())
+	}
+}
+
+func TestAgentGenerateFailsAfterStructuredRepairAttempts(t *testing.T) {
+	t.Parallel()
+
+	schema, err := structured.Compile([]byte(structuredTestSchema))
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	modelProvider := &atomicMockProvider{
+		handler: func(int, sdk.GenerateParams) (*sdk.GenerateResult, error) {
+			return &sdk.GenerateResult{Text: `{"answer":"many"}`, FinishReason: sdk.FinishReasonStop}, nil
+		},
+	}
+
+	a := New(Deps{})
+	_, err = a.Generate(context.Background(), RunConfig{
+		Model:        &sdk.Model{ID: "mock-model", Provider: modelProvider},
+		Messages:     []sdk.Message{sdk.UserMessage("what is the answer?")},
+		Identity:     SessionContext{BotID: "bot-1"},
+		OutputSchema: schema,
+	})
+	if !errors.Is(err, structured.ErrInvalidOutput) {
+		t.Fatalf("expected ErrInvalidOutput, got %v", err)
+	}
+	if got := modelProvider.calls.Load(); got != 1+structured.MaxRepairAttempts {
+		t.Fatalf("expected %d provider calls, got %d", 1+structured.MaxRepairAttempts, got)
+	}
+}
@@ -36,11 +36,18 @@ const containerOpTimeout = 30 * time.Second
 // Files <= this threshold use the simpler synchronous gRPC calls.
 const largeFileThreshold = 512 * 1024 // 512 KB
 
+// SnapshotHook is called before an exec command runs, so the workspace can
+// be snapshotted first. It must not fail the command.
+type SnapshotHook interface {
+	BeforeExec(ctx context.Context, botID, command string)
+}
+
 type ContainerProvider struct {
-	clients     bridge.Provider
-	bgManager   *background.Manager
-	execWorkDir string
-	logger      *slog.Logger
+	clients      bridge.Provider
+	bgManager    *background.Manager
+	execWorkDir  string
+	snapshotHook SnapshotHook
+	logger       *slog.Logger
 }
 
 func NewContainerProvider(log *slog.Logger, clients bridge.Provider, bgManager *background.Manager, execWorkDir string) *ContainerProvider {
@@ -54,6 +61,11 @@ func NewContainerProvider(log *slog.Logger, clients bridge.Provider, bgManager *
 	return &ContainerProvider{clients: clients, bgManager: bgManager, execWorkDir: wd, logger: log.With(slog.String("tool", "container"))}
 }
 
+// SetSnapshotHook sets the hook run before exec commands.
+func (p *ContainerProvider) SetSnapshotHook(hook SnapshotHook) {
+	p.snapshotHook = hook
+}
+
 func (p *ContainerProvider) Tools(ctx context.Context, session SessionContext) ([]sdk.Tool, error) {
 	workspace := p.resolveToolWorkspace(ctx, session)
 	wd := workspace.defaultWorkDir
@@ -484,10 +496,6 @@ func (p *ContainerProvider) execEdit(ctx context.Context, session SessionContext
 
 func (p *ContainerProvider) execExec(ctx context.Context, session SessionContext, args map[string]any) (any, error) {
 	botID := strings.TrimSpace(session.BotID)
-	client, err := p.getClient(ctx, botID)
-	if err != nil {
-		return nil, err
-	}
 	command := strings.TrimSpace(StringArg(args, "command"))
 	if command == "" {
 		return nil, errors.New("command is required")
@@ -521,6 +529,17 @@ func (p *ContainerProvider) execExec(ctx context.Context, session SessionContext
 		}
 	}
 
+	// Snapshot before the command touches /data. The snapshot is an archive
+	// read over the bridge, so the workspace and its background tasks keep
+	// running.
+	if p.snapshotHook != nil {
+		p.snapshotHook.BeforeExec(ctx, botID, command)
+	}
+	client, err := p.getClient(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Background execution path.
 	if runInBg && p.bgManager != nil {
 		return p.execExecBackground(ctx, session, client, command, workDir, description)
@@ -822,7 +841,7 @@ func (p *ContainerProvider) execBgStatus(_ context.Context, session SessionConte
 				"status":      string(s.Status),
 				"started_at":  session.FormatTime(s.StartedAt),
 			}
-			if s.Kind != background.KindSpawn {
+			if s.Kind == background.KindExec {
 				entry["command"] = truncateStr(s.Command, 120)
 				entry["output_file"] = s.OutputFile
 			}
@@ -869,6 +888,20 @@ func (p *ContainerProvider) execBgStatus(_ context.Context, session SessionConte
 			}
 			return result, nil
 		}
+		if s.Kind == background.KindDelegate {
+			if d := s.Delegation; d != nil {
+				result["bot_id"] = d.TargetBotID
+				result["bot_name"] = d.TargetBotName
+				result["delegation_id"] = d.DelegationID
+				if d.Report != "" {
+					result["report"] = d.Report
+				}
+				if d.Error != "" {
+					result["error"] = d.Error
+				}
+			}
+			return result, nil
+		}
 		result["command"] = s.Command
 		result["output_file"] = s.OutputFile
 		if s.Status != background.TaskRunning {
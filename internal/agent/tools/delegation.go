@@ -0,0 +1,209 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	sdk "github.com/memohai/twilight-ai/sdk"
+
+	"github.com/memohai/memoh/internal/agent/background"
+	"github.com/memohai/memoh/internal/delegation"
+)
+
+// askBotTimeout bounds a synchronous ask_bot call. Longer tasks should use
+// delegate_to_bot, which runs in the background.
+const askBotTimeout = 10 * time.Minute
+
+// BotDelegator starts and runs tasks handed to another bot.
+type BotDelegator interface {
+	Start(ctx context.Context, input delegation.StartInput) (delegation.Delegation, error)
+	Run(ctx context.Context, d delegation.Delegation) (delegation.Delegation, error)
+	Fail(ctx context.Context, d delegation.Delegation, reason error) (delegation.Delegation, error)
+}
+
+type DelegationProvider struct {
+	delegator BotDelegator
+	bgManager *background.Manager
+	logger    *slog.Logger
+}
+
+func NewDelegationProvider(log *slog.Logger, delegator BotDelegator, bgManager *background.Manager) *DelegationProvider {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &DelegationProvider{
+		delegator: delegator,
+		bgManager: bgManager,
+		logger:    log.With(slog.String("tool", "delegation")),
+	}
+}
+
+func (p *DelegationProvider) Tools(_ context.Context, session SessionContext) ([]sdk.Tool, error) {
+	if session.IsSubagent || p.delegator == nil {
+		return nil, nil
+	}
+	sess := session
+	params := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"bot": map[string]any{
+				"type":        "string",
+				"description": "Name or ID of the bot to hand the task to. The bot's owner must have granted you access to it.",
+			},
+			"task": map[string]any{
+				"type":        "string",
+				"description": "Self-contained task description. The other bot does not see this conversation, so include every detail it needs.",
+			},
+		},
+		"required": []string{"bot", "task"},
+	}
+	tools := []sdk.Tool{
+		{
+			Name:        "ask_bot",
+			Description: "Ask another bot on this instance to do a short task and wait for its answer. The task runs in the other bot's own workspace, memory and model settings.",
+			Parameters:  params,
+			Execute: func(ctx *sdk.ToolExecContext, input any) (any, error) {
+				return p.execAsk(ctx.Context, sess, inputAsMap(input))
+			},
+		},
+	}
+	if p.bgManager != nil {
+		tools = append(tools, sdk.Tool{
+			Name:        "delegate_to_bot",
+			Description: "Hand a longer task to another bot on this instance and continue working. Returns immediately with a task ID; the other bot's report arrives as a notification when it finishes.",
+			Parameters:  params,
+			Execute: func(ctx *sdk.ToolExecContext, input any) (any, error) {
+				return p.execDelegate(ctx.Context, sess, inputAsMap(input))
+			},
+		})
+	}
+	return tools, nil
+}
+
+func (p *DelegationProvider) start(ctx context.Context, session SessionContext, args map[string]any) (delegation.Delegation, any, error) {
+	botID := strings.TrimSpace(session.BotID)
+	if botID == "" {
+		return delegation.Delegation{}, nil, errors.New("bot_id is required")
+	}
+	target := strings.TrimSpace(FirstStringArg(args, "bot"))
+	if target == "" {
+		return delegation.Delegation{}, nil, errors.New("bot is required")
+	}
+	d, err := p.delegator.Start(ctx, delegation.StartInput{
+		FromBotID:     botID,
+		FromSessionID: session.SessionID,
+		Target:        target,
+		Task:          FirstStringArg(args, "task"),
+	})
+	if err != nil {
+		if errResult := delegationErrorResult(err); errResult != nil {
+			return delegation.Delegation{}, errResult, nil
+		}
+		return delegation.Delegation{}, nil, err
+	}
+	return d, nil, nil
+}
+
+func (p *DelegationProvider) execAsk(ctx context.Context, session SessionContext, args map[string]any) (any, error) {
+	d, errResult, err := p.start(ctx, session, args)
+	if err != nil || errResult != nil {
+		return errResult, err
+	}
+	runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), askBotTimeout)
+	defer cancel()
+	done, runErr := p.delegator.Run(runCtx, d)
+	if runErr != nil {
+		p.logger.Warn("ask_bot failed", slog.String("delegation_id", d.ID), slog.Any("error", runErr))
+	}
+	result := map[string]any{
+		"delegation_id": d.ID,
+		"bot_id":        d.ToBotID,
+		"bot_name":      d.ToBotName,
+		"status":        done.Status,
+	}
+	if done.Result != "" {
+		result["answer"] = done.Result
+	}
+	if done.Error != "" {
+		result["error"] = done.Error
+	} else if runErr != nil {
+		result["error"] = runErr.Error()
+	}
+	if len(done.Usage) > 0 {
+		result["usage"] = done.Usage
+	}
+	return result, nil
+}
+
+// execDelegate starts the delegation as a background task. The run derives
+// from the task context so bg_status kill can cancel it; the completion
+// notification carries the other bot's report.
+func (p *DelegationProvider) execDelegate(ctx context.Context, session SessionContext, args map[string]any) (any, error) {
+	d, errResult, err := p.start(ctx, session, args)
+	if err != nil || errResult != nil {
+		return errResult, err
+	}
+	description := truncateTitle(fmt.Sprintf("delegate to %s: %s", d.ToBotName, d.Task), 120)
+	taskID, taskCtx, err := p.bgManager.StartDelegateTask(context.WithoutCancel(ctx), session.BotID, session.SessionID, description, background.DelegateOutcome{
+		DelegationID:    d.ID,
+		TargetBotID:     d.ToBotID,
+		TargetBotName:   d.ToBotName,
+		TargetSessionID: d.ToSessionID,
+		Hop:             d.Hop,
+	})
+	if err != nil {
+		// Close the record so it does not stay running forever.
+		_, _ = p.delegator.Fail(ctx, d, err)
+		return map[string]any{
+			"isError": true,
+			"content": []map[string]any{{
+				"type": "text",
+				"text": fmt.Sprintf("%s. Check running tasks with bg_status (and kill stale ones) before starting more.", err),
+			}},
+		}, nil
+	}
+
+	go func() {
+		done, runErr := p.delegator.Run(taskCtx, d)
+		if runErr != nil {
+			p.logger.Warn("delegate_to_bot failed", slog.String("delegation_id", d.ID), slog.Any("error", runErr))
+		}
+		p.bgManager.CompleteDelegateTask(taskID, done.Result, runErr)
+	}()
+
+	return map[string]any{
+		"status":        "background_started",
+		"task_id":       taskID,
+		"kind":          string(background.KindDelegate),
+		"delegation_id": d.ID,
+		"bot_name":      d.ToBotName,
+		"description":   description,
+		"message":       fmt.Sprintf("Task handed to %s in the background. You will be notified with its report when it finishes. Do NOT poll or sleep — the notification arrives automatically.", d.ToBotName),
+	}, nil
+}
+
+// delegationErrorResult turns refusals the model can act on into tool
+// errors; other errors are returned as-is.
+func delegationErrorResult(err error) any {
+	var text string
+	switch {
+	case errors.Is(err, delegation.ErrForbidden):
+		text = err.Error() + ". Only bots whose owner granted you access can receive tasks."
+	case errors.Is(err, delegation.ErrLoop):
+		text = err.Error() + ". Do the work yourself or pick a bot that has not handled this task yet."
+	case errors.Is(err, delegation.ErrTooDeep):
+		text = fmt.Sprintf("%s (max %d hops). Do the work yourself.", err, delegation.MaxHops)
+	case errors.Is(err, delegation.ErrTaskRequired):
+		text = err.Error()
+	default:
+		return nil
+	}
+	return map[string]any{
+		"isError": true,
+		"content": []map[string]any{{"type": "text", "text": text}},
+	}
+}
@@ -35,7 +35,7 @@ type NativeToolSourceOptions struct {
 type NativeToolApprovalService interface {
 	EvaluatePolicy(ctx context.Context, input toolapproval.CreatePendingInput) (toolapproval.Evaluation, error)
 	CreatePending(ctx context.Context, input toolapproval.CreatePendingInput) (toolapproval.Request, error)
-	Reject(ctx context.Context, approvalID, actorID, reason string) (toolapproval.Request, error)
+	Dismiss(ctx context.Context, approvalID, reason string) (toolapproval.Request, error)
 	WaitForDecision(ctx context.Context, approvalID string) (toolapproval.Request, error)
 }
 
@@ -324,22 +324,27 @@ func (s *NativeToolSource) requireApproval(ctx context.Context, session mcp.Tool
 		SourcePlatform:               session.CurrentPlatform,
 		ReplyTarget:                  session.ReplyTarget,
 		ConversationType:             session.ConversationType,
+		SessionType:                  session.SessionType,
 	}
 	eval, err := s.approval.EvaluatePolicy(ctx, input)
 	if err != nil {
 		return nativeApprovalResult{}, err
 	}
-	if eval.Decision == toolapproval.DecisionBypass {
+	switch eval.Decision {
+	case toolapproval.DecisionBypass:
 		return nativeApprovalResult{approved: true}, nil
+	case toolapproval.DecisionDeny:
+		return nativeApprovalResult{message: rejectedToolApprovalText(eval.Policy.DenyReason())}, nil
 	}
 
+	input.Workflow = eval.Policy.Workflow
 	req, err := s.approval.CreatePending(ctx, input)
 	if err != nil {
 		return nativeApprovalResult{}, err
 	}
 	if strings.TrimSpace(session.StreamID) == "" {
 		reason := "tool execution requires approval, but this ACP tool call is not attached to an interactive stream"
-		rejected, rejectErr := s.approval.Reject(ctx, req.ID, session.ChannelIdentityID, reason)
+		rejected, rejectErr := s.approval.Dismiss(ctx, req.ID, reason)
 		if rejectErr != nil {
 			return nativeApprovalResult{}, rejectErr
 		}
@@ -354,7 +359,7 @@ func (s *NativeToolSource) requireApproval(ctx context.Context, session mcp.Tool
 		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
 			rejectCtx, rejectCancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
 			defer rejectCancel()
-			rejected, rejectErr := s.approval.Reject(rejectCtx, req.ID, session.ChannelIdentityID, "tool approval timed out")
+			rejected, rejectErr := s.approval.Dismiss(rejectCtx, req.ID, "tool approval timed out")
 			if rejectErr != nil {
 				return nativeApprovalResult{}, rejectErr
 			}
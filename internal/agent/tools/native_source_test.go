@@ -720,7 +720,7 @@ func (a *nativeSourceApproval) CreatePending(_ context.Context, input toolapprov
 	}, nil
 }
 
-func (a *nativeSourceApproval) Reject(_ context.Context, approvalID, _, reason string) (toolapproval.Request, error) {
+func (a *nativeSourceApproval) Dismiss(_ context.Context, approvalID, reason string) (toolapproval.Request, error) {
 	a.rejected = append(a.rejected, reason)
 	if a.rejectErr != nil {
 		return toolapproval.Request{}, a.rejectErr
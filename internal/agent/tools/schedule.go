@@ -2,7 +2,9 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"strings"
 
@@ -86,16 +88,20 @@ func (p *ScheduleProvider) Tools(_ context.Context, session SessionContext) ([]s
 			},
 		},
 		{
-			Name: "create_schedule", Description: "Create a new schedule",
+			Name: "create_schedule", Description: "Create a new schedule fired by a cron pattern or by matching events",
 			Parameters: map[string]any{
 				"type": "object",
 				"properties": map[string]any{
 					"name": map[string]any{"type": "string"}, "description": map[string]any{"type": "string"},
-					"pattern": map[string]any{"type": "string"}, "command": map[string]any{"type": "string"},
-					"max_calls": map[string]any{"anyOf": []map[string]any{{"type": "integer"}, {"type": "null"}}, "description": "Optional max calls, null means unlimited"},
-					"enabled":   map[string]any{"type": "boolean"},
+					"pattern": map[string]any{"type": "string", "description": "Cron pattern, required when trigger_kind is cron"}, "command": map[string]any{"type": "string"},
+					"max_calls":      map[string]any{"anyOf": []map[string]any{{"type": "integer"}, {"type": "null"}}, "description": "Optional max calls, null means unlimited"},
+					"enabled":        map[string]any{"type": "boolean"},
+					"trigger_kind":   triggerKindSchema(),
+					"trigger_filter": triggerFilterSchema(),
+					"retry_policy":   retryPolicySchema(),
+					"output_schema":  outputSchemaSchema(),
 				},
-				"required": []string{"name", "description", "pattern", "command"},
+				"required": []string{"name", "description", "command"},
 			},
 			Execute: func(ctx *sdk.ToolExecContext, input any) (any, error) {
 				args := inputAsMap(input)
@@ -107,10 +113,26 @@ func (p *ScheduleProvider) Tools(_ context.Context, session SessionContext) ([]s
 				description := StringArg(args, "description")
 				pattern := StringArg(args, "pattern")
 				command := StringArg(args, "command")
-				if name == "" || description == "" || pattern == "" || command == "" {
-					return nil, errors.New("name, description, pattern, command are required")
+				if name == "" || description == "" || command == "" {
+					return nil, errors.New("name, description, command are required")
 				}
 				req := sched.CreateRequest{Name: name, Description: description, Pattern: pattern, Command: command}
+				req.TriggerKind = sched.TriggerKind(StringArg(args, "trigger_kind"))
+				filter, err := parseTriggerFilterArg(args, "trigger_filter")
+				if err != nil {
+					return nil, err
+				}
+				req.TriggerFilter = filter
+				retry, err := parseRetryPolicyArg(args, "retry_policy")
+				if err != nil {
+					return nil, err
+				}
+				req.RetryPolicy = retry
+				outputSchema, err := parseOutputSchemaArg(args, "output_schema")
+				if err != nil {
+					return nil, err
+				}
+				req.OutputSchema = outputSchema
 				maxCalls, err := parseNullableIntArg(args, "max_calls")
 				if err != nil {
 					return nil, err
@@ -135,9 +157,13 @@ func (p *ScheduleProvider) Tools(_ context.Context, session SessionContext) ([]s
 				"properties": map[string]any{
 					"id": map[string]any{"type": "string"}, "name": map[string]any{"type": "string"},
 					"description": map[string]any{"type": "string"}, "pattern": map[string]any{"type": "string"},
-					"command":   map[string]any{"type": "string"},
-					"max_calls": map[string]any{"anyOf": []map[string]any{{"type": "integer"}, {"type": "null"}}},
-					"enabled":   map[string]any{"type": "boolean"},
+					"command":        map[string]any{"type": "string"},
+					"max_calls":      map[string]any{"anyOf": []map[string]any{{"type": "integer"}, {"type": "null"}}},
+					"enabled":        map[string]any{"type": "boolean"},
+					"trigger_kind":   triggerKindSchema(),
+					"trigger_filter": triggerFilterSchema(),
+					"retry_policy":   retryPolicySchema(),
+					"output_schema":  outputSchemaSchema(),
 				},
 				"required": []string{"id"},
 			},
@@ -169,6 +195,25 @@ func (p *ScheduleProvider) Tools(_ context.Context, session SessionContext) ([]s
 				if v := StringArg(args, "command"); v != "" {
 					req.Command = &v
 				}
+				if v := StringArg(args, "trigger_kind"); v != "" {
+					kind := sched.TriggerKind(v)
+					req.TriggerKind = &kind
+				}
+				filter, err := parseTriggerFilterArg(args, "trigger_filter")
+				if err != nil {
+					return nil, err
+				}
+				req.TriggerFilter = filter
+				retry, err := parseRetryPolicyArg(args, "retry_policy")
+				if err != nil {
+					return nil, err
+				}
+				req.RetryPolicy = retry
+				outputSchema, err := parseOutputSchemaArg(args, "output_schema")
+				if err != nil {
+					return nil, err
+				}
+				req.OutputSchema = outputSchema
 				if enabled, ok, err := BoolArg(args, "enabled"); err != nil {
 					return nil, err
 				} else if ok {
@@ -241,6 +286,102 @@ func parseNullableIntArg(arguments map[string]any, key string) (sched.NullableIn
 	return req, nil
 }
 
+func triggerKindSchema() map[string]any {
+	return map[string]any{
+		"type": "string",
+		"enum": []string{
+			string(sched.TriggerKindCron),
+			string(sched.TriggerKindEmail),
+			string(sched.TriggerKindChannelMessage),
+			string(sched.TriggerKindBackgroundTask),
+			string(sched.TriggerKindMCPStatus),
+		},
+		"description": "What fires the schedule. Defaults to cron; other kinds fire on matching events and must not set pattern",
+	}
+}
+
+func triggerFilterSchema() map[string]any {
+	return map[string]any{
+		"type":        "object",
+		"description": "Event filter. email: from, subject (regex). channel_message: route_id, pattern (regex on text). background_task: status, pattern (regex on command). mcp_status: connection_id, status",
+		"properties": map[string]any{
+			"from":          map[string]any{"type": "string"},
+			"subject":       map[string]any{"type": "string"},
+			"route_id":      map[string]any{"type": "string"},
+			"pattern":       map[string]any{"type": "string"},
+			"connection_id": map[string]any{"type": "string"},
+			"status":        map[string]any{"type": "string"},
+		},
+	}
+}
+
+func parseTriggerFilterArg(arguments map[string]any, key string) (*sched.TriggerFilter, error) {
+	raw, ok := arguments[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	payload, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	var filter sched.TriggerFilter
+	if err := json.Unmarshal(payload, &filter); err != nil {
+		return nil, fmt.Errorf("%s must be an object: %w", key, err)
+	}
+	return &filter, nil
+}
+
+func retryPolicySchema() map[string]any {
+	return map[string]any{
+		"type":        "object",
+		"description": "Retry failed runs with exponential backoff. max_attempts counts the first run (1 disables retries); a run that still fails is kept as a dead letter that can be replayed",
+		"properties": map[string]any{
+			"max_attempts":            map[string]any{"type": "integer", "minimum": 0, "maximum": 10},
+			"initial_backoff_seconds": map[string]any{"type": "integer", "description": "Delay before the first retry, doubled for each later one. Defaults to 60"},
+			"max_backoff_seconds":     map[string]any{"type": "integer", "description": "Upper bound on the delay. Defaults to 3600"},
+			"jitter":                  map[string]any{"type": "number", "minimum": 0, "maximum": 1, "description": "Random fraction taken off each delay"},
+		},
+	}
+}
+
+func parseRetryPolicyArg(arguments map[string]any, key string) (*sched.RetryPolicy, error) {
+	raw, ok := arguments[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	payload, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	var policy sched.RetryPolicy
+	if err := json.Unmarshal(payload, &policy); err != nil {
+		return nil, fmt.Errorf("%s must be an object: %w", key, err)
+	}
+	return &policy, nil
+}
+
+func outputSchemaSchema() map[string]any {
+	return map[string]any{
+		"type":        "object",
+		"description": "Optional JSON Schema the final reply of every run must satisfy. The validated JSON is stored in the run log next to the text result",
+	}
+}
+
+func parseOutputSchemaArg(arguments map[string]any, key string) (json.RawMessage, error) {
+	raw, ok := arguments[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	if _, isObject := raw.(map[string]any); !isObject {
+		return nil, fmt.Errorf("%s must be an object", key)
+	}
+	payload, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	return payload, nil
+}
+
 func emptyObjectSchema() map[string]any {
 	return map[string]any{"type": "object", "properties": map[string]any{}}
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -23,9 +24,15 @@ const (
 	webFetchUserAgent      = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36"
 )
 
+// EgressDialer dials on behalf of a bot under its egress policy.
+type EgressDialer interface {
+	DialContext(ctx context.Context, botID, network, address string) (net.Conn, error)
+}
+
 type WebFetchProvider struct {
 	logger *slog.Logger
 	client *http.Client
+	egress EgressDialer
 }
 
 func NewWebFetchProvider(log *slog.Logger) *WebFetchProvider {
@@ -38,7 +45,14 @@ func NewWebFetchProvider(log *slog.Logger) *WebFetchProvider {
 	}
 }
 
-func (p *WebFetchProvider) Tools(_ context.Context, _ SessionContext) ([]sdk.Tool, error) {
+// SetEgressDialer routes fetches through the bot's egress policy, so
+// web_fetch cannot reach what the bot's workspace is not allowed to.
+func (p *WebFetchProvider) SetEgressDialer(d EgressDialer) {
+	p.egress = d
+}
+
+func (p *WebFetchProvider) Tools(_ context.Context, session SessionContext) ([]sdk.Tool, error) {
+	client := p.clientFor(session.BotID)
 	return []sdk.Tool{
 		{
 			Name:        "web_fetch",
@@ -68,20 +82,32 @@ func (p *WebFetchProvider) Tools(_ context.Context, _ SessionContext) ([]sdk.Too
 				if format == "" {
 					format = "auto"
 				}
-				return p.callWebFetch(ctx.Context, rawURL, format)
+				return p.callWebFetch(ctx.Context, client, rawURL, format)
 			},
 		},
 	}, nil
 }
 
-func (p *WebFetchProvider) callWebFetch(ctx context.Context, rawURL, format string) (any, error) {
+func (p *WebFetchProvider) clientFor(botID string) *http.Client {
+	if p.egress == nil || botID == "" {
+		return p.client
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		return p.egress.DialContext(ctx, botID, network, address)
+	}
+	return &http.Client{Timeout: webFetchTimeout, Transport: transport}
+}
+
+func (p *WebFetchProvider) callWebFetch(ctx context.Context, client *http.Client, rawURL, format string) (any, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("invalid url: %w", err)
 	}
 	req.Header.Set("User-Agent", webFetchUserAgent)
 
-	resp, err := p.client.Do(req) //nolint:gosec // intentionally fetches user-specified URLs
+	resp, err := client.Do(req) //nolint:gosec // intentionally fetches user-specified URLs
 	if err != nil {
 		return nil, err
 	}
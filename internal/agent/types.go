@@ -9,6 +9,7 @@ import (
 	sdk "github.com/memohai/twilight-ai/sdk"
 
 	"github.com/memohai/memoh/internal/agent/background"
+	"github.com/memohai/memoh/internal/structured"
 )
 
 // SessionContext carries request-scoped identity and routing information.
@@ -51,6 +52,24 @@ type Schedule struct {
 	Pattern     string `json:"pattern"`
 	MaxCalls    *int   `json:"maxCalls,omitempty"`
 	Command     string `json:"command"`
+	TriggerKind string `json:"triggerKind,omitempty"`
+	Event       string `json:"event,omitempty"`
+}
+
+// Delegation describes a task handed to this bot by another bot.
+type Delegation struct {
+	ID      string `json:"id"`
+	FromBot string `json:"fromBot"`
+	Hop     int    `json:"hop"`
+	Task    string `json:"task"`
+}
+
+// WorkflowStep describes an agent step of a workflow run.
+type WorkflowStep struct {
+	Workflow string `json:"workflow"`
+	RunID    string `json:"runId"`
+	StepID   string `json:"stepId"`
+	Prompt   string `json:"prompt"`
 }
 
 // LoopDetectionConfig controls loop detection behavior.
@@ -124,6 +143,39 @@ type RunConfig struct {
 	BackgroundManager *background.Manager
 
 	ToolApprovalHandler func(ctx context.Context, call sdk.ToolCall) (sdk.ToolApprovalResult, error)
+
+	// ProviderID identifies the primary model's provider for the failover
+	// circuit breaker. Empty disables breaker tracking for the primary.
+	ProviderID string
+
+	// Fallbacks is the bot's ordered model fallback chain. When the primary
+	// model fails before producing output with a server, rate-limit,
+	// context-length, auth or network error, the run moves on to the next
+	// candidate whose provider circuit is closed.
+	Fallbacks []ModelCandidate
+
+	// OnModelFallback is called when the run switches to a fallback model so
+	// the caller can attribute token usage to the model that answered.
+	OnModelFallback func(candidate ModelCandidate)
+
+	// OutputSchema constrains the final reply to JSON matching the schema.
+	// Models whose client type supports it get a native response format;
+	// every reply is validated and, when invalid, sent back for repair.
+	OutputSchema *structured.Schema
+}
+
+// ModelCandidate is one entry of a model fallback chain, carrying the
+// model-specific fields of RunConfig it replaces.
+type ModelCandidate struct {
+	ModelID               string // database UUID of the model
+	ProviderID            string
+	Model                 *sdk.Model
+	ReasoningEffort       string
+	ReasoningDisabled     bool
+	ChatCompletionsCompat string
+	PromptCacheTTL        string
+	SupportsImageInput    bool
+	SupportsToolCall      bool
 }
 
 // GenerateResult holds the result of a non-streaming agent invocation.
@@ -134,6 +186,8 @@ type GenerateResult struct {
 	Reactions   []ReactionItem
 	Speeches    []SpeechItem
 	Usage       *sdk.Usage
+	// Structured is the validated JSON reply of a run with an OutputSchema.
+	Structured json.RawMessage
 }
 
 // FileAttachment represents a file reference extracted from agent output.
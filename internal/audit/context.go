@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/auth"
+)
+
+type (
+	actorContextKey   struct{}
+	requestContextKey struct{}
+)
+
+// RequestInfo describes the API request that caused an action.
+type RequestInfo struct {
+	RequestID string
+	IP        string
+	UserAgent string
+	Method    string
+	Route     string
+	TokenID   string
+}
+
+// WithActor returns a context attributing actions to actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// WithDefaultActor attributes actions to actor unless the context already
+// carries one.
+func WithDefaultActor(ctx context.Context, actor Actor) context.Context {
+	if _, ok := ActorFromContext(ctx); ok || actor.IsZero() {
+		return ctx
+	}
+	return WithActor(ctx, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor or the middleware.
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorContextKey{}).(Actor)
+	return actor, ok && !actor.IsZero()
+}
+
+// WithRequest returns a context carrying request metadata for audit records.
+func WithRequest(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestContextKey{}, info)
+}
+
+// RequestFromContext returns the request metadata set by WithRequest.
+func RequestFromContext(ctx context.Context) (RequestInfo, bool) {
+	info, ok := ctx.Value(requestContextKey{}).(RequestInfo)
+	return info, ok
+}
+
+// Middleware attributes the request context to the authenticated user and
+// attaches request metadata, so services record who called them without the
+// handlers passing it along. It must run after the auth middlewares.
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			requestID := strings.TrimSpace(req.Header.Get(echo.HeaderXRequestID))
+			if requestID == "" {
+				requestID = uuid.NewString()
+			}
+			c.Response().Header().Set(echo.HeaderXRequestID, requestID)
+			info := RequestInfo{
+				RequestID: requestID,
+				IP:        c.RealIP(),
+				UserAgent: req.UserAgent(),
+				Method:    req.Method,
+				Route:     c.Path(),
+			}
+			if token, ok := auth.PersonalTokenFromContext(c); ok {
+				info.TokenID = token.ID
+			}
+			ctx := WithRequest(req.Context(), info)
+			if userID := auth.SubjectFromContext(c); userID != "" {
+				ctx = WithActor(ctx, Actor{Type: ActorUser, ID: userID})
+			}
+			c.SetRequest(req.WithContext(ctx))
+			return next(c)
+		}
+	}
+}
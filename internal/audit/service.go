@@ -0,0 +1,384 @@
+// Package audit keeps an append-only record of administrative and agent-side
+// actions. Services call Record after a mutation succeeds; the actor and the
+// request metadata come from the context, set by Middleware for API calls and
+// by WithActor for scheduled runs and channel messages.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+	exportPageSize   = 500
+	redactedValue    = "[REDACTED]"
+)
+
+var actorTypes = []string{ActorUser, ActorBot, ActorSchedule, ActorChannelIdentity, ActorSystem}
+
+type Service struct {
+	queries dbstore.Queries
+	logger  *slog.Logger
+}
+
+func NewService(log *slog.Logger, queries dbstore.Queries) *Service {
+	return &Service{
+		queries: queries,
+		logger:  log.With(slog.String("service", "audit")),
+	}
+}
+
+// Record appends entry to the audit log. It is safe to call on a nil
+// Service. The action has already happened when Record runs, so a failed
+// write is logged rather than returned.
+func (s *Service) Record(ctx context.Context, entry Entry) {
+	if s == nil || s.queries == nil {
+		return
+	}
+	params, err := s.buildParams(ctx, entry)
+	if err == nil {
+		_, err = s.queries.CreateAuditLog(context.WithoutCancel(ctx), params)
+	}
+	if err != nil {
+		s.logger.Error("write audit log failed",
+			slog.String("action", entry.Action),
+			slog.String("bot_id", entry.BotID),
+			slog.String("target_id", entry.TargetID),
+			slog.Any("error", err))
+	}
+}
+
+func (s *Service) buildParams(ctx context.Context, entry Entry) (sqlc.CreateAuditLogParams, error) {
+	metadata := make(map[string]any, len(entry.Metadata)+6)
+	for k, v := range entry.Metadata {
+		metadata[k] = v
+	}
+	if info, ok := RequestFromContext(ctx); ok {
+		putString(metadata, "request_id", info.RequestID)
+		putString(metadata, "ip", info.IP)
+		putString(metadata, "user_agent", info.UserAgent)
+		putString(metadata, "method", info.Method)
+		putString(metadata, "route", info.Route)
+		putString(metadata, "token_id", info.TokenID)
+	}
+	actor := entry.Actor
+	ctxActor, hasCtxActor := ActorFromContext(ctx)
+	switch {
+	case actor.IsZero() && hasCtxActor:
+		actor = ctxActor
+	case actor.IsZero():
+		actor = Actor{Type: ActorSystem}
+	case hasCtxActor && ctxActor != actor:
+		metadata["initiator"] = ctxActor
+	}
+	if !slices.Contains(actorTypes, actor.Type) {
+		return sqlc.CreateAuditLogParams{}, fmt.Errorf("unknown actor type %q", actor.Type)
+	}
+
+	var botID pgtype.UUID
+	if strings.TrimSpace(entry.BotID) != "" {
+		parsed, err := db.ParseUUID(entry.BotID)
+		if err != nil {
+			return sqlc.CreateAuditLogParams{}, err
+		}
+		botID = parsed
+	}
+	before, err := marshalRedacted(entry.Before)
+	if err != nil {
+		return sqlc.CreateAuditLogParams{}, fmt.Errorf("marshal before: %w", err)
+	}
+	after, err := marshalRedacted(entry.After)
+	if err != nil {
+		return sqlc.CreateAuditLogParams{}, fmt.Errorf("marshal after: %w", err)
+	}
+	meta, err := marshalRedacted(metadata)
+	if err != nil {
+		return sqlc.CreateAuditLogParams{}, fmt.Errorf("marshal metadata: %w", err)
+	}
+	return sqlc.CreateAuditLogParams{
+		ActorType:  actor.Type,
+		ActorID:    actor.ID,
+		BotID:      botID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Before:     before,
+		After:      after,
+		Metadata:   meta,
+	}, nil
+}
+
+// List returns one page of entries matching filter, newest first.
+func (s *Service) List(ctx context.Context, filter Filter) (ListResponse, error) {
+	if s == nil || s.queries == nil {
+		return ListResponse{}, errors.New("audit queries not configured")
+	}
+	count, err := countParams(filter)
+	if err != nil {
+		return ListResponse{}, err
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	limit = min(limit, maxListLimit)
+	rows, err := s.queries.ListAuditLogs(ctx, listParams(count, 0, limit, max(filter.Offset, 0)))
+	if err != nil {
+		return ListResponse{}, err
+	}
+	total, err := s.queries.CountAuditLogs(ctx, count)
+	if err != nil {
+		return ListResponse{}, err
+	}
+	items := make([]Record, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, toRecord(row))
+	}
+	return ListResponse{Items: items, Total: total}, nil
+}
+
+// Export writes every entry matching filter to w as JSON Lines, newest
+// first. Limit and Offset are ignored. Paging is keyed on the entry id, so
+// entries appended during the export do not shift the result.
+func (s *Service) Export(ctx context.Context, filter Filter, w io.Writer) error {
+	if s == nil || s.queries == nil {
+		return errors.New("audit queries not configured")
+	}
+	count, err := countParams(filter)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	var beforeID int64
+	for {
+		rows, err := s.queries.ListAuditLogs(ctx, listParams(count, beforeID, exportPageSize, 0))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := enc.Encode(toRecord(row)); err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportPageSize {
+			return nil
+		}
+		beforeID = rows[len(rows)-1].ID
+	}
+}
+
+// Validate reports whether filter is usable, wrapping ErrInvalidFilter.
+func (f Filter) Validate() error {
+	_, err := countParams(f)
+	return err
+}
+
+func countParams(filter Filter) (sqlc.CountAuditLogsParams, error) {
+	var params sqlc.CountAuditLogsParams
+	if botID := strings.TrimSpace(filter.BotID); botID != "" {
+		parsed, err := db.ParseUUID(botID)
+		if err != nil {
+			return params, fmt.Errorf("%w: bot_id: %w", ErrInvalidFilter, err)
+		}
+		params.BotID = parsed
+	}
+	if actorType := strings.TrimSpace(filter.ActorType); actorType != "" {
+		if !slices.Contains(actorTypes, actorType) {
+			return params, fmt.Errorf("%w: actor_type must be one of %s", ErrInvalidFilter, strings.Join(actorTypes, ", "))
+		}
+		params.ActorType = optionalText(actorType)
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return params, fmt.Errorf("%w: since must be before until", ErrInvalidFilter)
+	}
+	params.ActorID = optionalText(filter.ActorID)
+	params.Action = optionalText(filter.Action)
+	params.TargetType = optionalText(filter.TargetType)
+	params.TargetID = optionalText(filter.TargetID)
+	params.Since = optionalTime(filter.Since)
+	params.Until = optionalTime(filter.Until)
+	return params, nil
+}
+
+func listParams(count sqlc.CountAuditLogsParams, beforeID int64, limit, offset int) sqlc.ListAuditLogsParams {
+	return sqlc.ListAuditLogsParams{
+		BotID:      count.BotID,
+		ActorType:  count.ActorType,
+		ActorID:    count.ActorID,
+		Action:     count.Action,
+		TargetType: count.TargetType,
+		TargetID:   count.TargetID,
+		Since:      count.Since,
+		Until:      count.Until,
+		BeforeID:   beforeID,
+		PageLimit:  int32(limit),  //nolint:gosec // bounded by maxListLimit
+		PageOffset: int32(offset), //nolint:gosec // offsets beyond int32 are not meaningful
+	}
+}
+
+func toRecord(row sqlc.AuditLog) Record {
+	record := Record{
+		ID:         row.ID,
+		CreatedAt:  row.CreatedAt.Time,
+		Actor:      Actor{Type: row.ActorType, ID: row.ActorID},
+		Action:     row.Action,
+		TargetType: row.TargetType,
+		TargetID:   row.TargetID,
+		Before:     nullableJSON(row.Before),
+		After:      nullableJSON(row.After),
+	}
+	if row.BotID.Valid {
+		record.BotID = row.BotID.String()
+	}
+	if len(row.Metadata) > 0 {
+		_ = json.Unmarshal(row.Metadata, &record.Metadata)
+	}
+	record.Changes = diff(record.Before, record.After)
+	return record
+}
+
+// diff lists the top-level fields that differ when both sides are objects.
+func diff(before, after json.RawMessage) []Change {
+	var b, a map[string]json.RawMessage
+	if json.Unmarshal(before, &b) != nil || json.Unmarshal(after, &a) != nil || b == nil || a == nil {
+		return nil
+	}
+	fields := make([]string, 0, len(a)+len(b))
+	for k := range b {
+		fields = append(fields, k)
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+	slices.Sort(fields)
+	var changes []Change
+	for _, field := range fields {
+		if !jsonEqual(b[field], a[field]) {
+			changes = append(changes, Change{Field: field, Before: b[field], After: a[field]})
+		}
+	}
+	return changes
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	var av, bv any
+	if json.Unmarshal(a, &av) != nil || json.Unmarshal(b, &bv) != nil {
+		return bytes.Equal(a, b)
+	}
+	an, _ := json.Marshal(av)
+	bn, _ := json.Marshal(bv)
+	return bytes.Equal(an, bn)
+}
+
+// marshalRedacted encodes value as JSON with secret-looking fields masked.
+func marshalRedacted(value any) ([]byte, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redact(generic))
+}
+
+func redact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, item := range v {
+			switch {
+			case isSecretKey(k):
+				if item != nil && item != "" {
+					v[k] = redactedValue
+				}
+			case isSecretMap(k):
+				if m, ok := item.(map[string]any); ok {
+					for mk := range m {
+						m[mk] = redactedValue
+					}
+				}
+			default:
+				v[k] = redact(item)
+			}
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// isSecretKey matches credential fields such as api_key, client_secret,
+// password and access_token without catching counters like max_tokens.
+func isSecretKey(key string) bool {
+	k := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	switch k {
+	case "token", "accesstoken", "refreshtoken", "idtoken", "bearertoken", "authorization", "cookie", "passphrase":
+		return true
+	}
+	for _, suffix := range []string{"password", "secret", "apikey", "privatekey"} {
+		if strings.HasSuffix(k, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretMap matches maps whose values are commonly credentials, such as an
+// MCP server's environment and HTTP headers.
+func isSecretMap(key string) bool {
+	switch strings.ToLower(key) {
+	case "env", "headers":
+		return true
+	}
+	return false
+}
+
+func nullableJSON(raw []byte) json.RawMessage {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	return json.RawMessage(trimmed)
+}
+
+func putString(m map[string]any, key, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		m[key] = value
+	}
+}
+
+func optionalText(value string) pgtype.Text {
+	value = strings.TrimSpace(value)
+	return pgtype.Text{String: value, Valid: value != ""}
+}
+
+func optionalTime(value time.Time) pgtype.Timestamptz {
+	if value.IsZero() {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: value.UTC(), Valid: true}
+}
@@ -0,0 +1,202 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+)
+
+const (
+	testUserID = "00000000-0000-0000-0000-0000000000f1"
+	testBotID  = "00000000-0000-0000-0000-0000000000f2"
+)
+
+func newSQLiteAuditService(t *testing.T) (*Service, *sql.DB) {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	return NewService(slog.Default(), sqlitestore.NewQueries(store)), conn
+}
+
+func TestRecordAndList(t *testing.T) {
+	svc, _ := newSQLiteAuditService(t)
+	ctx := WithRequest(WithActor(context.Background(), Actor{Type: ActorUser, ID: testUserID}), RequestInfo{RequestID: "req-1", Route: "/bots/:bot_id/acl/rules"})
+
+	svc.Record(ctx, Entry{
+		Action:     ActionACLRuleCreate,
+		BotID:      testBotID,
+		TargetType: "acl_rule",
+		TargetID:   "rule-1",
+		After:      map[string]any{"effect": "allow", "enabled": true},
+	})
+	svc.Record(ctx, Entry{
+		Action:     ActionACLRuleUpdate,
+		BotID:      testBotID,
+		TargetType: "acl_rule",
+		TargetID:   "rule-1",
+		Before:     map[string]any{"effect": "allow", "enabled": true},
+		After:      map[string]any{"effect": "deny", "enabled": true},
+	})
+	svc.Record(context.Background(), Entry{
+		Actor:  Actor{Type: ActorBot, ID: testBotID},
+		Action: ActionToolApprovalRequest,
+		BotID:  testBotID,
+	})
+
+	all, err := svc.List(context.Background(), Filter{BotID: testBotID})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if all.Total != 3 || len(all.Items) != 3 || all.Items[0].Action != ActionToolApprovalRequest {
+		t.Fatalf("list = %+v", all)
+	}
+
+	acl, err := svc.List(context.Background(), Filter{Action: "acl"})
+	if err != nil {
+		t.Fatalf("list acl: %v", err)
+	}
+	if acl.Total != 2 {
+		t.Fatalf("acl total = %d, want 2", acl.Total)
+	}
+	update := acl.Items[0]
+	if update.Actor != (Actor{Type: ActorUser, ID: testUserID}) || update.Metadata["request_id"] != "req-1" {
+		t.Fatalf("update actor/metadata = %+v %+v", update.Actor, update.Metadata)
+	}
+	if len(update.Changes) != 1 || update.Changes[0].Field != "effect" || string(update.Changes[0].After) != `"deny"` {
+		t.Fatalf("changes = %+v", update.Changes)
+	}
+
+	// "acl.rule" must not match an unrelated "acl.ruleset" family.
+	page, err := svc.List(context.Background(), Filter{Action: "acl.rule", Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatalf("list page: %v", err)
+	}
+	if page.Total != 2 || len(page.Items) != 1 || page.Items[0].Action != ActionACLRuleCreate {
+		t.Fatalf("page = %+v", page)
+	}
+
+	if _, err := svc.List(context.Background(), Filter{ActorType: "robot"}); !errors.Is(err, ErrInvalidFilter) {
+		t.Fatalf("invalid actor type err = %v", err)
+	}
+}
+
+func TestRecordKeepsInitiatorAndRedactsSecrets(t *testing.T) {
+	svc, _ := newSQLiteAuditService(t)
+	ctx := WithActor(context.Background(), Actor{Type: ActorSchedule, ID: "sched-1"})
+	svc.Record(ctx, Entry{
+		Actor:  Actor{Type: ActorBot, ID: testBotID},
+		Action: ActionMCPConnectionCreate,
+		BotID:  testBotID,
+		After: map[string]any{
+			"name":       "github",
+			"api_key":    "sk-live",
+			"max_tokens": 100,
+			"config": map[string]any{
+				"env":     map[string]any{"GITHUB_TOKEN": "ghp_x"},
+				"headers": map[string]any{"X-Custom": "v"},
+			},
+		},
+	})
+
+	res, err := svc.List(context.Background(), Filter{})
+	if err != nil || len(res.Items) != 1 {
+		t.Fatalf("list = %+v, %v", res, err)
+	}
+	item := res.Items[0]
+	if item.Actor.Type != ActorBot {
+		t.Fatalf("actor = %+v", item.Actor)
+	}
+	initiator, _ := item.Metadata["initiator"].(map[string]any)
+	if initiator["type"] != ActorSchedule || initiator["id"] != "sched-1" {
+		t.Fatalf("initiator = %+v", item.Metadata)
+	}
+	after := string(item.After)
+	for _, secret := range []string{"sk-live", "ghp_x", `"v"`} {
+		if strings.Contains(after, secret) {
+			t.Fatalf("after leaks %s: %s", secret, after)
+		}
+	}
+	if !strings.Contains(after, `"max_tokens":100`) || !strings.Contains(after, `"name":"github"`) {
+		t.Fatalf("after over-redacted: %s", after)
+	}
+}
+
+func TestAuditLogIsAppendOnly(t *testing.T) {
+	svc, conn := newSQLiteAuditService(t)
+	svc.Record(context.Background(), Entry{Action: ActionSettingsUpdate, BotID: testBotID})
+
+	if _, err := conn.ExecContext(context.Background(), `UPDATE audit_logs SET action = 'x'`); err == nil {
+		t.Fatal("update succeeded, want append-only error")
+	}
+	if _, err := conn.ExecContext(context.Background(), `DELETE FROM audit_logs`); err == nil {
+		t.Fatal("delete succeeded, want append-only error")
+	}
+	res, err := svc.List(context.Background(), Filter{})
+	if err != nil || res.Total != 1 || res.Items[0].Actor.Type != ActorSystem {
+		t.Fatalf("list = %+v, %v", res, err)
+	}
+}
+
+func TestExportJSONL(t *testing.T) {
+	svc, _ := newSQLiteAuditService(t)
+	ctx := WithActor(context.Background(), Actor{Type: ActorUser, ID: testUserID})
+	const n = exportPageSize + 3
+	for i := 0; i < n; i++ {
+		svc.Record(ctx, Entry{Action: ActionSettingsUpdate, BotID: testBotID})
+	}
+	svc.Record(ctx, Entry{Action: ActionBackupImport, BotID: testBotID})
+
+	var buf bytes.Buffer
+	if err := svc.Export(context.Background(), Filter{Action: ActionSettingsUpdate}, &buf); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	scanner := bufio.NewScanner(&buf)
+	lines := 0
+	lastID := int64(0)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("decode line %d: %v", lines, err)
+		}
+		if record.Action != ActionSettingsUpdate {
+			t.Fatalf("line %d action = %q", lines, record.Action)
+		}
+		if lastID != 0 && record.ID >= lastID {
+			t.Fatalf("line %d id %d not descending after %d", lines, record.ID, lastID)
+		}
+		lastID = record.ID
+		lines++
+	}
+	if lines != n {
+		t.Fatalf("exported %d lines, want %d", lines, n)
+	}
+}
@@ -0,0 +1,123 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrInvalidFilter wraps validation failures of list and export filters.
+var ErrInvalidFilter = errors.New("invalid audit log filter")
+
+// Actor types.
+const (
+	ActorUser            = "user"
+	ActorBot             = "bot"
+	ActorSchedule        = "schedule"
+	ActorChannelIdentity = "channel_identity"
+	ActorSystem          = "system"
+)
+
+// Actions recorded by the services. Names are dotted so filters can select a
+// whole family, e.g. "acl" or "mcp.connection".
+const (
+	ActionACLRuleCreate                 = "acl.rule.create"
+	ActionACLRuleUpdate                 = "acl.rule.update"
+	ActionACLRuleDelete                 = "acl.rule.delete"
+	ActionACLDefaultEffect              = "acl.default_effect.update"
+	ActionSettingsUpdate                = "settings.update"
+	ActionSettingsDelete                = "settings.delete"
+	ActionBotOwnerTransfer              = "bot.owner.transfer"
+	ActionBotDelegate                   = "bot.delegate"
+	ActionMCPConnectionCreate           = "mcp.connection.create"
+	ActionMCPConnectionUpdate           = "mcp.connection.update"
+	ActionMCPConnectionDelete           = "mcp.connection.delete"
+	ActionMCPConnectionImport           = "mcp.connection.import"
+	ActionToolApprovalRequest           = "tool_approval.request"
+	ActionToolApprovalApprove           = "tool_approval.approve"
+	ActionToolApprovalReject            = "tool_approval.reject"
+	ActionToolApprovalVote              = "tool_approval.vote"
+	ActionToolApprovalEscalate          = "tool_approval.escalate"
+	ActionToolApprovalExpire            = "tool_approval.expire"
+	ActionWorkspaceRollback             = "workspace.rollback"
+	ActionWorkspaceSnapshotPolicyUpdate = "workspace.snapshot_policy.update"
+	ActionWorkspaceSnapshotPrune        = "workspace.snapshot.prune"
+	ActionBackupImport                  = "backup.import"
+	ActionWorkflowCreate                = "workflow.create"
+	ActionWorkflowUpdate                = "workflow.update"
+	ActionWorkflowDelete                = "workflow.delete"
+	ActionNetworkEgressUpdate           = "network.egress.update"
+	ActionBotTemplateCreate             = "bot_template.create"
+	ActionBotTemplateUpdate             = "bot_template.update"
+	ActionBotTemplateDelete             = "bot_template.delete"
+	ActionBotTemplateUse                = "bot_template.instantiate"
+)
+
+// Actor identifies who performed an action.
+type Actor struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+}
+
+// IsZero reports whether no actor is set.
+func (a Actor) IsZero() bool {
+	return a.Type == ""
+}
+
+// Entry is an action to record. Before and After are marshalled to JSON and
+// secrets in them are redacted; either may be nil.
+type Entry struct {
+	// Actor overrides the actor carried by the context. When both are set and
+	// differ, the context actor is kept in the metadata as the initiator, so
+	// a bot acting during a scheduled run records both.
+	Actor      Actor
+	Action     string
+	BotID      string
+	TargetType string
+	TargetID   string
+	Before     any
+	After      any
+	Metadata   map[string]any
+}
+
+// Change is a top-level field that differs between Before and After.
+type Change struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After  json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+}
+
+// Record is a stored audit log entry.
+type Record struct {
+	ID         int64           `json:"id"`
+	CreatedAt  time.Time       `json:"created_at"`
+	Actor      Actor           `json:"actor"`
+	Action     string          `json:"action"`
+	BotID      string          `json:"bot_id,omitempty"`
+	TargetType string          `json:"target_type,omitempty"`
+	TargetID   string          `json:"target_id,omitempty"`
+	Before     json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After      json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+	Changes    []Change        `json:"changes,omitempty"`
+	Metadata   map[string]any  `json:"metadata,omitempty"`
+}
+
+// Filter selects audit log entries. Action matches exactly or as a dotted
+// prefix. Since is inclusive and Until exclusive.
+type Filter struct {
+	BotID      string
+	ActorType  string
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Since      time.Time
+	Until      time.Time
+	Limit      int
+	Offset     int
+}
+
+type ListResponse struct {
+	Items []Record `json:"items"`
+	Total int64    `json:"total"`
+}
@@ -25,12 +25,18 @@ const (
 )
 
 // JWTMiddleware returns a JWT auth middleware configured for HS256 tokens.
+// Requests already authenticated by PersonalTokenMiddleware are skipped.
 func JWTMiddleware(secret string, skipper middleware.Skipper) echo.MiddlewareFunc {
 	return echojwt.WithConfig(echojwt.Config{
 		SigningKey:    []byte(secret),
 		SigningMethod: "HS256",
 		TokenLookup:   "header:Authorization:Bearer ,query:token",
-		Skipper:       skipper,
+		Skipper: func(c echo.Context) bool {
+			if _, ok := PersonalTokenFromContext(c); ok {
+				return true
+			}
+			return skipper != nil && skipper(c)
+		},
 		NewClaimsFunc: func(_ echo.Context) jwt.Claims {
 			return jwt.MapClaims{}
 		},
@@ -47,6 +53,11 @@ func UserIDFromContext(c echo.Context) (string, error) {
 	if !ok {
 		return "", echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
 	}
+	if isPersonalTokenClaims(claims) {
+		if checked, _ := c.Get(scopeCheckedKey).(bool); !checked {
+			return "", echo.NewHTTPError(http.StatusForbidden, "personal access tokens cannot access this endpoint")
+		}
+	}
 	if userID := claimString(claims, claimUserID); userID != "" {
 		return userID, nil
 	}
@@ -56,6 +67,24 @@ func UserIDFromContext(c echo.Context) (string, error) {
 	return "", echo.NewHTTPError(http.StatusUnauthorized, "user id missing")
 }
 
+// SubjectFromContext returns the user id of the request's credential without
+// enforcing personal access token scopes. It is meant for attribution, such
+// as audit records, and must not be used for authorization.
+func SubjectFromContext(c echo.Context) string {
+	token, ok := c.Get("user").(*jwt.Token)
+	if !ok || token == nil || !token.Valid {
+		return ""
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return ""
+	}
+	if userID := claimString(claims, claimUserID); userID != "" {
+		return userID
+	}
+	return claimString(claims, claimSubject)
+}
+
 // GenerateToken creates a signed JWT for the user.
 func GenerateToken(userID, secret string, expiresIn time.Duration) (string, time.Time, error) {
 	if strings.TrimSpace(userID) == "" {
@@ -172,6 +201,9 @@ func RefreshTokenFromContext(c echo.Context, secret string, defaultExpiresIn tim
 	if !ok {
 		return "", time.Time{}, echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
 	}
+	if isPersonalTokenClaims(claims) {
+		return "", time.Time{}, echo.NewHTTPError(http.StatusForbidden, "personal access tokens cannot be refreshed")
+	}
 
 	// Calculate original duration if possible
 	expiresIn := defaultExpiresIn
@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// PersonalTokenPrefix marks a bearer credential as a personal access token
+// rather than a session JWT.
+const PersonalTokenPrefix = "memoh_pat_"
+
+const (
+	personalTokenType = "personal_access_token"
+	personalTokenKey  = "personal_token"
+	scopeCheckedKey   = "personal_token_scope_checked"
+	claimScopes       = "scopes"
+	claimTokenID      = "token_id"
+)
+
+// Scopes a personal access token can be granted.
+const (
+	ScopeAccountRead    = "account:read"
+	ScopeBotsRead       = "bots:read"
+	ScopeBotsWrite      = "bots:write"
+	ScopeBotsChat       = "bots:chat"
+	ScopeBotsMCP        = "bots:mcp"
+	ScopeSchedulesRead  = "schedules:read"
+	ScopeSchedulesWrite = "schedules:write"
+	ScopeMemoryRead     = "memory:read"
+	ScopeMemoryAdmin    = "memory:admin"
+	ScopeAuditRead      = "audit:read"
+)
+
+// Scopes lists every scope in the order clients should present them.
+var Scopes = []string{
+	ScopeAccountRead,
+	ScopeBotsRead,
+	ScopeBotsWrite,
+	ScopeBotsChat,
+	ScopeBotsMCP,
+	ScopeSchedulesRead,
+	ScopeSchedulesWrite,
+	ScopeMemoryRead,
+	ScopeMemoryAdmin,
+	ScopeAuditRead,
+}
+
+// impliedScopes maps a write scope to the read scope it includes.
+var impliedScopes = map[string]string{
+	ScopeBotsWrite:      ScopeBotsRead,
+	ScopeSchedulesWrite: ScopeSchedulesRead,
+	ScopeMemoryAdmin:    ScopeMemoryRead,
+}
+
+// IsValidScope reports whether scope is a known personal access token scope.
+func IsValidScope(scope string) bool {
+	return slices.Contains(Scopes, scope)
+}
+
+// IsPersonalToken reports whether raw looks like a personal access token.
+func IsPersonalToken(raw string) bool {
+	return strings.HasPrefix(raw, PersonalTokenPrefix)
+}
+
+// PersonalToken is a verified personal access token. BotID is empty when the
+// token is not restricted to a single bot.
+type PersonalToken struct {
+	ID     string
+	UserID string
+	BotID  string
+	Scopes []string
+}
+
+// HasScope reports whether the token grants scope, directly or through a
+// broader scope that implies it.
+func (t PersonalToken) HasScope(scope string) bool {
+	for _, granted := range t.Scopes {
+		if granted == scope || impliedScopes[granted] == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// PersonalTokenVerifier resolves a raw personal access token. It returns an
+// error for unknown, revoked or expired tokens.
+type PersonalTokenVerifier interface {
+	VerifyPersonalToken(ctx context.Context, raw string) (PersonalToken, error)
+}
+
+// PersonalTokenMiddleware authenticates requests that carry a personal access
+// token in the Authorization header or the token query parameter. Requests
+// with any other credential are passed on untouched so JWTMiddleware can
+// handle them.
+//
+// A personal access token only reaches routes guarded by RequireScope or
+// RequireBotScope; UserIDFromContext refuses it everywhere else, so new
+// endpoints stay closed to tokens until they declare a scope.
+func PersonalTokenMiddleware(verifier PersonalTokenVerifier, skipper middleware.Skipper) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if verifier == nil || (skipper != nil && skipper(c)) {
+				return next(c)
+			}
+			raw := requestToken(c)
+			if !IsPersonalToken(raw) {
+				return next(c)
+			}
+			token, err := verifier.VerifyPersonalToken(c.Request().Context(), raw)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired token")
+			}
+			c.Set(personalTokenKey, token)
+			c.Set("user", &jwt.Token{
+				Method: jwt.SigningMethodHS256,
+				Valid:  true,
+				Claims: jwt.MapClaims{
+					claimType:    personalTokenType,
+					claimSubject: token.UserID,
+					claimUserID:  token.UserID,
+					claimTokenID: token.ID,
+					claimBotID:   token.BotID,
+					claimScopes:  strings.Join(token.Scopes, " "),
+				},
+			})
+			return next(c)
+		}
+	}
+}
+
+// PersonalTokenFromContext returns the personal access token that
+// authenticated the request, if any.
+func PersonalTokenFromContext(c echo.Context) (PersonalToken, bool) {
+	token, ok := c.Get(personalTokenKey).(PersonalToken)
+	return token, ok
+}
+
+// RequireScope lets personal access tokens holding scope through to the
+// route. Session JWTs carry the full access of their account and are not
+// affected.
+func RequireScope(scope string) echo.MiddlewareFunc {
+	return requireScope(scope, "", false)
+}
+
+// RequireBotScope is RequireScope for routes addressing a single bot through
+// the path parameter param. Tokens restricted to a different bot are
+// refused.
+func RequireBotScope(scope, param string) echo.MiddlewareFunc {
+	return requireScope(scope, param, false)
+}
+
+// RequireUnrestrictedScope is RequireScope for routes that reach beyond any
+// single bot, such as creating bots or bot templates. Tokens restricted to a
+// bot are refused.
+func RequireUnrestrictedScope(scope string) echo.MiddlewareFunc {
+	return requireScope(scope, "", true)
+}
+
+func requireScope(scope, botParam string, unrestricted bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token, ok := PersonalTokenFromContext(c)
+			if !ok {
+				return next(c)
+			}
+			if !token.HasScope(scope) {
+				return echo.NewHTTPError(http.StatusForbidden, "token is missing scope "+scope)
+			}
+			if unrestricted && token.BotID != "" {
+				return echo.NewHTTPError(http.StatusForbidden, "token is restricted to a single bot")
+			}
+			if botParam != "" && token.BotID != "" && strings.TrimSpace(c.Param(botParam)) != token.BotID {
+				return echo.NewHTTPError(http.StatusForbidden, "token is restricted to another bot")
+			}
+			c.Set(scopeCheckedKey, true)
+			return next(c)
+		}
+	}
+}
+
+func isPersonalTokenClaims(claims jwt.MapClaims) bool {
+	return claimString(claims, claimType) == personalTokenType
+}
+
+func requestToken(c echo.Context) string {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if token, ok := strings.CutPrefix(header, "Bearer "); ok {
+		return strings.TrimSpace(token)
+	}
+	return strings.TrimSpace(c.QueryParam("token"))
+}
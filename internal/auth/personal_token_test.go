@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPersonalToken = PersonalTokenPrefix + "valid"
+
+type fakeVerifier struct {
+	token PersonalToken
+}
+
+func (v fakeVerifier) VerifyPersonalToken(_ context.Context, raw string) (PersonalToken, error) {
+	if raw != testPersonalToken {
+		return PersonalToken{}, errors.New("unknown token")
+	}
+	return v.token, nil
+}
+
+func newPersonalTokenServer(token PersonalToken) *echo.Echo {
+	e := echo.New()
+	e.Use(PersonalTokenMiddleware(fakeVerifier{token: token}, nil))
+	e.Use(JWTMiddleware("test-secret", nil))
+	whoami := func(c echo.Context) error {
+		userID, err := UserIDFromContext(c)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, userID)
+	}
+	e.GET("/users/me", whoami, RequireScope(ScopeAccountRead))
+	e.GET("/bots/:bot_id/schedule", whoami, RequireBotScope(ScopeSchedulesRead, "bot_id"))
+	e.PUT("/bots/:bot_id/schedule", whoami, RequireBotScope(ScopeSchedulesWrite, "bot_id"))
+	e.POST("/bots", whoami, RequireUnrestrictedScope(ScopeBotsWrite))
+	e.GET("/users", whoami)
+	e.POST("/auth/refresh", func(c echo.Context) error {
+		_, _, err := RefreshTokenFromContext(c, "test-secret", time.Hour)
+		return err
+	})
+	return e
+}
+
+func doRequest(e *echo.Echo, method, path, bearer string) *httptest.ResponseRecorder {
+	req := httptest.NewRequestWithContext(context.Background(), method, path, nil)
+	if bearer != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+bearer)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPersonalTokenScopes(t *testing.T) {
+	e := newPersonalTokenServer(PersonalToken{
+		ID:     "tok-1",
+		UserID: "user-1",
+		Scopes: []string{ScopeAccountRead, ScopeSchedulesWrite, ScopeBotsWrite},
+	})
+
+	rec := doRequest(e, http.MethodGet, "/users/me", testPersonalToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "user-1", rec.Body.String())
+
+	// schedules:write implies schedules:read.
+	assert.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/bots/bot-1/schedule", testPersonalToken).Code)
+	assert.Equal(t, http.StatusOK, doRequest(e, http.MethodPut, "/bots/bot-1/schedule", testPersonalToken).Code)
+	assert.Equal(t, http.StatusOK, doRequest(e, http.MethodPost, "/bots", testPersonalToken).Code)
+
+	// Routes that declare no scope are closed to tokens.
+	assert.Equal(t, http.StatusForbidden, doRequest(e, http.MethodGet, "/users", testPersonalToken).Code)
+	assert.Equal(t, http.StatusForbidden, doRequest(e, http.MethodPost, "/auth/refresh", testPersonalToken).Code)
+
+	assert.Equal(t, http.StatusUnauthorized, doRequest(e, http.MethodGet, "/users/me", PersonalTokenPrefix+"revoked").Code)
+}
+
+func TestPersonalTokenMissingScope(t *testing.T) {
+	e := newPersonalTokenServer(PersonalToken{ID: "tok-1", UserID: "user-1", Scopes: []string{ScopeSchedulesRead}})
+
+	assert.Equal(t, http.StatusForbidden, doRequest(e, http.MethodGet, "/users/me", testPersonalToken).Code)
+	assert.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/bots/bot-1/schedule", testPersonalToken).Code)
+	assert.Equal(t, http.StatusForbidden, doRequest(e, http.MethodPut, "/bots/bot-1/schedule", testPersonalToken).Code)
+}
+
+func TestPersonalTokenBotRestriction(t *testing.T) {
+	e := newPersonalTokenServer(PersonalToken{
+		ID:     "tok-1",
+		UserID: "user-1",
+		BotID:  "bot-1",
+		Scopes: []string{ScopeSchedulesRead, ScopeBotsWrite},
+	})
+
+	assert.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/bots/bot-1/schedule", testPersonalToken).Code)
+	assert.Equal(t, http.StatusForbidden, doRequest(e, http.MethodGet, "/bots/bot-2/schedule", testPersonalToken).Code)
+	// A token for one bot cannot create others.
+	assert.Equal(t, http.StatusForbidden, doRequest(e, http.MethodPost, "/bots", testPersonalToken).Code)
+}
+
+func TestSessionTokenUnaffectedByScopes(t *testing.T) {
+	e := newPersonalTokenServer(PersonalToken{})
+	token, _, err := GenerateToken("user-2", "test-secret", time.Hour)
+	require.NoError(t, err)
+
+	for _, path := range []string{"/users/me", "/users", "/bots/bot-9/schedule"} {
+		rec := doRequest(e, http.MethodGet, path, token)
+		require.Equal(t, http.StatusOK, rec.Code, path)
+		assert.Equal(t, "user-2", rec.Body.String())
+	}
+	assert.Equal(t, http.StatusUnauthorized, doRequest(e, http.MethodGet, "/users/me", "").Code)
+}
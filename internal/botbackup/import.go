@@ -18,6 +18,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/memohai/memoh/internal/acl"
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/botbackup/secure"
 	"github.com/memohai/memoh/internal/bots"
 	"github.com/memohai/memoh/internal/channel"
@@ -450,6 +451,29 @@ func (s *Service) Import(ctx context.Context, actorUserID string, raw []byte, op
 	if manifest.SchemaVersion != BackupSchemaVersion {
 		return ImportResult{}, fmt.Errorf("unsupported backup schema version: %d", manifest.SchemaVersion)
 	}
+	result, sourceBotID, err := s.importBundle(ctx, actorUserID, entries, manifest, opts, nil)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionBackupImport,
+		BotID:      result.BotID,
+		TargetType: "bot",
+		TargetID:   result.BotID,
+		After:      result,
+		Metadata: map[string]any{
+			"mode":          opts.Mode,
+			"source_bot_id": sourceBotID,
+			"sections":      opts.Sections,
+		},
+	})
+	return result, nil
+}
+
+// importBundle restores a decoded bundle and returns the result along with
+// the ID of the bot it was exported from. A non-nil create replaces the
+// backup profile as the request creating the bot in create mode.
+func (s *Service) importBundle(ctx context.Context, actorUserID string, entries map[string]backupZipEntry, manifest Manifest, opts ImportOptions, create *bots.CreateBotRequest) (ImportResult, string, error) {
 	state := &importState{
 		entries:  entries,
 		manifest: manifest,
@@ -460,11 +484,11 @@ func (s *Service) Import(ctx context.Context, actorUserID string, raw []byte, op
 
 	profile, err := readEntry[bots.Bot](state, "bot/profile.json")
 	if err != nil {
-		return ImportResult{}, err
+		return ImportResult{}, "", err
 	}
 	cfg, err := readEntry[settings.Settings](state, "bot/settings.json")
 	if err != nil {
-		return ImportResult{}, err
+		return ImportResult{}, "", err
 	}
 	// Dependencies (providers/models/...) are global, idempotent resources; they
 	// are created before the bot and are intentionally NOT rolled back, so a
@@ -473,12 +497,12 @@ func (s *Service) Import(ctx context.Context, actorUserID string, raw []byte, op
 	if opts.wants(SectionModels) || opts.wants(SectionEmail) {
 		deps, err = s.importDependencies(ctx, state)
 		if err != nil {
-			return ImportResult{}, err
+			return ImportResult{}, "", err
 		}
 	}
-	targetBotID, created, err := s.restoreBot(ctx, actorUserID, profile, opts)
+	targetBotID, created, err := s.restoreBot(ctx, actorUserID, profile, opts, create)
 	if err != nil {
-		return ImportResult{}, err
+		return ImportResult{}, "", err
 	}
 	state.idMap[profile.ID] = targetBotID
 	state.createMode = created
@@ -501,11 +525,11 @@ func (s *Service) Import(ctx context.Context, actorUserID string, raw []byte, op
 	}
 
 	if err := s.applyRestore(ctx, actorUserID, targetBotID, cfg, deps, opts, state); err != nil {
-		return ImportResult{}, err
+		return ImportResult{}, "", err
 	}
 
 	committed = true
-	return ImportResult{BotID: targetBotID, Created: created, Warnings: state.warnings, Imported: state.counts}, nil
+	return ImportResult{BotID: targetBotID, Created: created, Warnings: state.warnings, Imported: state.counts}, profile.ID, nil
 }
 
 // applyRestore runs every selected section in order. A returned error is fatal
@@ -714,7 +738,7 @@ func (s *Service) importDependencies(ctx context.Context, state *importState) (d
 	return deps, nil
 }
 
-func (s *Service) restoreBot(ctx context.Context, actorUserID string, profile bots.Bot, opts ImportOptions) (string, bool, error) {
+func (s *Service) restoreBot(ctx context.Context, actorUserID string, profile bots.Bot, opts ImportOptions, create *bots.CreateBotRequest) (string, bool, error) {
 	mode := normalizeImportMode(opts.Mode)
 	if mode == ImportModeOverwrite {
 		target := strings.TrimSpace(opts.TargetBotID)
@@ -741,13 +765,17 @@ func (s *Service) restoreBot(ctx context.Context, actorUserID string, profile bo
 	}
 	tz := profile.Timezone
 	active := profile.IsActive
-	created, err := s.bots.Create(ctx, actorUserID, bots.CreateBotRequest{
+	req := bots.CreateBotRequest{
 		DisplayName: profile.DisplayName,
 		AvatarURL:   profile.AvatarURL,
 		Timezone:    &tz,
 		IsActive:    &active,
 		Metadata:    profile.Metadata,
-	})
+	}
+	if create != nil {
+		req = templateCreateRequest(profile, *create)
+	}
+	created, err := s.bots.Create(ctx, actorUserID, req)
 	if err != nil {
 		return "", false, err
 	}
@@ -982,14 +1010,25 @@ func (s *Service) restoreSchedules(ctx context.Context, botID string, state *imp
 	}
 	for _, item := range items {
 		enabled := item.Enabled
-		_, err := s.schedules.Create(ctx, botID, schedule.CreateRequest{
-			Name:        item.Name,
-			Description: item.Description,
-			Pattern:     item.Pattern,
-			MaxCalls:    schedule.NullableInt{Value: item.MaxCalls, Set: true},
-			Command:     item.Command,
-			Enabled:     &enabled,
-		})
+		req := schedule.CreateRequest{
+			Name:         item.Name,
+			Description:  item.Description,
+			Pattern:      item.Pattern,
+			MaxCalls:     schedule.NullableInt{Value: item.MaxCalls, Set: true},
+			Command:      item.Command,
+			Enabled:      &enabled,
+			TriggerKind:  item.TriggerKind,
+			OutputSchema: item.OutputSchema,
+		}
+		if !item.TriggerFilter.IsZero() {
+			filter := item.TriggerFilter
+			req.TriggerFilter = &filter
+		}
+		if item.RetryPolicy.Enabled() {
+			retry := item.RetryPolicy
+			req.RetryPolicy = &retry
+		}
+		_, err := s.schedules.Create(ctx, botID, req)
 		if err != nil {
 			if e := state.itemErr("schedule", err); e != nil {
 				return e
@@ -21,6 +21,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/memohai/memoh/internal/acl"
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/bots"
 	"github.com/memohai/memoh/internal/channel"
 	"github.com/memohai/memoh/internal/db"
@@ -61,6 +62,7 @@ type Service struct {
 	searchProviders *searchpkg.Service
 	memoryProviders *memprovider.Service
 	workspace       WorkspaceData
+	auditLog        *audit.Service
 }
 
 type Params struct {
@@ -79,6 +81,7 @@ type Params struct {
 	SearchProviders *searchpkg.Service
 	MemoryProviders *memprovider.Service
 	Workspace       WorkspaceData
+	AuditLog        *audit.Service
 }
 
 func New(params Params) *Service {
@@ -102,6 +105,7 @@ func New(params Params) *Service {
 		searchProviders: params.SearchProviders,
 		memoryProviders: params.MemoryProviders,
 		workspace:       params.Workspace,
+		auditLog:        params.AuditLog,
 	}
 }
 
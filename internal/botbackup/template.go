@@ -0,0 +1,518 @@
+package botbackup
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/memohai/memoh/internal/botbackup/secure"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/mcp"
+	"github.com/memohai/memoh/internal/settings"
+)
+
+// TemplateSections are the sections a bot template carries: what shapes how a
+// bot behaves, without its history, channels, access rules or anything bound
+// to credentials. Skills and the identity file travel in the workspace seed.
+var TemplateSections = []Section{SectionSettings, SectionMCP, SectionSchedules, SectionWorkspace}
+
+// ErrInvalidTemplate is returned for a bundle that cannot be used as a
+// template.
+var ErrInvalidTemplate = errors.New("invalid bot template")
+
+const (
+	templateIdentityFile = "AGENTS.md"
+	templateSkillsDir    = "skills"
+)
+
+// templateEntryPaths are the bundle entries a template keeps besides the
+// profile, the workspace seed and the manifest.
+var templateEntryPaths = []string{
+	"bot/settings.json",
+	"bot/workspace_resource_limits.json",
+	"bot/mcp_connections.json",
+	"bot/schedules.json",
+}
+
+// templateCredentialPaths are workspace files holding credentials of the
+// source bot. They are dropped from the seed; a trailing slash matches a
+// whole directory.
+var templateCredentialPaths = []string{
+	".codex/auth.json",
+	".claude/.credentials.json",
+	".docker/config.json",
+	".config/gh/hosts.yml",
+	".config/gcloud/",
+	".kube/config",
+	".aws/",
+	".ssh/",
+}
+
+// templateCredentialNames are file name patterns of credentials that are
+// dropped wherever they sit in the workspace, such as the .env file or the
+// private key of a project.
+var templateCredentialNames = []string{
+	".env",
+	".env.*",
+	".git-credentials",
+	".netrc",
+	".npmrc",
+	".pypirc",
+	"*.pem",
+	"*.key",
+	"*.p12",
+	"*.pfx",
+	"id_rsa",
+	"id_dsa",
+	"id_ecdsa",
+	"id_ecdsa_sk",
+	"id_ed25519",
+	"id_ed25519_sk",
+}
+
+// templateCredentialNameExceptions are names matching
+// templateCredentialNames that hold examples rather than credentials.
+var templateCredentialNameExceptions = []string{
+	".env.example",
+	".env.sample",
+	".env.template",
+}
+
+// templateMCPQuerySecrets are URL query parameters of MCP connections that
+// carry credentials. Names are compared in lower case with '-' and '_'
+// removed.
+var templateMCPQuerySecrets = []string{
+	"token",
+	"accesstoken",
+	"apikey",
+	"key",
+	"secret",
+	"clientsecret",
+	"password",
+	"auth",
+	"authorization",
+	"sig",
+	"signature",
+}
+
+// TemplateContents describes what a template bundle holds.
+type TemplateContents struct {
+	Sections []SectionSummary `json:"sections"`
+	// Skills lists the skills seeded into the workspace.
+	Skills []string `json:"skills,omitempty"`
+	// IdentityFile reports whether the seed carries the bot's AGENTS.md.
+	IdentityFile bool     `json:"identity_file"`
+	Warnings     []string `json:"warnings,omitempty"`
+}
+
+// ExportTemplate exports a bot as a template bundle.
+func (s *Service) ExportTemplate(ctx context.Context, botID string) ([]byte, TemplateContents, error) {
+	var buf bytes.Buffer
+	if err := s.Export(ctx, botID, ExportOptions{Sections: TemplateSections}, &buf); err != nil {
+		return nil, TemplateContents{}, err
+	}
+	return SanitizeTemplate(buf.Bytes())
+}
+
+// SanitizeTemplate turns a backup bundle into a template bundle. Sections a
+// template does not carry are dropped, model and provider bindings and the
+// overlay network are cleared from the settings, MCP header and environment
+// values are blanked, and credential files are removed from the workspace
+// seed.
+func SanitizeTemplate(raw []byte) ([]byte, TemplateContents, error) {
+	if secure.IsEncrypted(raw) {
+		return nil, TemplateContents{}, fmt.Errorf("%w: encrypted backups cannot be used as templates", ErrInvalidTemplate)
+	}
+	entries, manifest, err := loadManifest(raw)
+	if err != nil {
+		return nil, TemplateContents{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	if manifest.SchemaVersion != BackupSchemaVersion {
+		return nil, TemplateContents{}, fmt.Errorf("%w: unsupported schema version %d", ErrInvalidTemplate, manifest.SchemaVersion)
+	}
+	if !hasEntry(entries, "bot/profile.json") {
+		return nil, TemplateContents{}, fmt.Errorf("%w: bot profile not found", ErrInvalidTemplate)
+	}
+	state := &importState{entries: entries}
+	profile, err := readEntry[bots.Bot](state, "bot/profile.json")
+	if err != nil {
+		return nil, TemplateContents{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	out := Manifest{
+		SchemaVersion: BackupSchemaVersion,
+		App:           manifest.App,
+		ExportedAt:    manifest.ExportedAt,
+		SourceBotID:   manifest.SourceBotID,
+		SourceBotName: manifest.SourceBotName,
+		Warnings:      append([]string(nil), manifest.Warnings...),
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	writer := &zipBackupWriter{zw: zw, manifest: &out, checksum: map[string]string{}}
+	opts := ExportOptions{}
+
+	// The profile only seeds defaults for the new bot's identity.
+	if err := writer.writeJSON("bot/profile.json", "bot_profile", bots.Bot{
+		DisplayName: profile.DisplayName,
+		AvatarURL:   profile.AvatarURL,
+		Timezone:    profile.Timezone,
+		IsActive:    true,
+	}, opts); err != nil {
+		return nil, TemplateContents{}, err
+	}
+	for _, entryPath := range templateEntryPaths {
+		if !hasEntry(entries, entryPath) {
+			continue
+		}
+		switch entryPath {
+		case "bot/settings.json":
+			cfg, err := readEntry[settings.Settings](state, entryPath)
+			if err != nil {
+				return nil, TemplateContents{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+			}
+			if err := writer.writeJSON(entryPath, "bot_settings", templateSettings(cfg), opts); err != nil {
+				return nil, TemplateContents{}, err
+			}
+		case "bot/mcp_connections.json":
+			items, err := readEntry[[]mcp.Connection](state, entryPath)
+			if err != nil {
+				return nil, TemplateContents{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+			}
+			items, warnings := templateMCPConnections(items)
+			out.Warnings = append(out.Warnings, warnings...)
+			if err := writer.writeJSON(entryPath, "bot_mcp_connections", items, opts); err != nil {
+				return nil, TemplateContents{}, err
+			}
+		default:
+			if err := writer.writeRaw(entryPath, entries[entryPath].data); err != nil {
+				return nil, TemplateContents{}, err
+			}
+			writer.appendEntry(entryPath, entryTypeOf(manifest, entryPath))
+		}
+	}
+	if hasWorkspaceEntries(entries) {
+		seed, removed, err := templateWorkspaceSeed(entries[workspaceArchivePath].data)
+		if err != nil {
+			return nil, TemplateContents{}, fmt.Errorf("%w: workspace archive: %w", ErrInvalidTemplate, err)
+		}
+		for _, name := range removed {
+			out.Warnings = append(out.Warnings, "workspace credential file removed: "+name)
+		}
+		if err := writer.writeStream(workspaceArchivePath, bytes.NewReader(seed), 0o640, time.Time{}, zip.Store); err != nil {
+			return nil, TemplateContents{}, err
+		}
+		writer.appendEntry(workspaceArchivePath, "workspace_data")
+	}
+
+	out.Options.Sections = templateSectionsIn(entries)
+	out.Checksums = writer.checksum
+	if err := writer.writeManifest(); err != nil {
+		return nil, TemplateContents{}, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, TemplateContents{}, err
+	}
+	sanitized, err := readZipEntries(buf.Bytes())
+	if err != nil {
+		return nil, TemplateContents{}, err
+	}
+	contents := templateContents(sanitized)
+	contents.Warnings = out.Warnings
+	return buf.Bytes(), contents, nil
+}
+
+// InstantiateTemplate creates a bot from a template bundle. Fields of req left
+// empty fall back to the template's profile; the template's own metadata is
+// never copied, so req.Metadata alone decides the new bot's preferences.
+func (s *Service) InstantiateTemplate(ctx context.Context, actorUserID string, raw []byte, req bots.CreateBotRequest) (ImportResult, error) {
+	if secure.IsEncrypted(raw) {
+		return ImportResult{}, fmt.Errorf("%w: template bundles cannot be encrypted", ErrInvalidTemplate)
+	}
+	entries, manifest, err := loadManifest(raw)
+	if err != nil {
+		return ImportResult{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	if manifest.SchemaVersion != BackupSchemaVersion {
+		return ImportResult{}, fmt.Errorf("%w: unsupported schema version %d", ErrInvalidTemplate, manifest.SchemaVersion)
+	}
+	opts := ImportOptions{Mode: ImportModeCreate, Sections: map[Section]ImportStrategy{}}
+	for _, section := range templateSectionsIn(entries) {
+		opts.Sections[section] = StrategyMerge
+	}
+	result, _, err := s.importBundle(ctx, actorUserID, entries, manifest, opts, &req)
+	return result, err
+}
+
+// templateCreateRequest builds the create request of a bot instantiated from
+// a template, filling what the caller left empty from the template profile.
+func templateCreateRequest(profile bots.Bot, req bots.CreateBotRequest) bots.CreateBotRequest {
+	if strings.TrimSpace(req.DisplayName) == "" {
+		req.DisplayName = profile.DisplayName
+	}
+	if strings.TrimSpace(req.AvatarURL) == "" {
+		req.AvatarURL = profile.AvatarURL
+	}
+	if req.Timezone == nil && strings.TrimSpace(profile.Timezone) != "" {
+		tz := profile.Timezone
+		req.Timezone = &tz
+	}
+	return req
+}
+
+// templateSettings clears the settings that bind a bot to resources of the
+// source installation: models, providers and its overlay network.
+func templateSettings(cfg settings.Settings) settings.Settings {
+	cfg.ChatModelID = ""
+	cfg.FallbackModelIDs = nil
+	cfg.ImageModelID = ""
+	cfg.SearchProviderID = ""
+	cfg.MemoryProviderID = ""
+	cfg.TtsModelID = ""
+	cfg.TranscriptionModelID = ""
+	cfg.HeartbeatModelID = ""
+	cfg.TitleModelID = ""
+	cfg.CompactionModelID = ""
+	cfg.DiscussProbeModelID = ""
+	cfg.OverlayEnabled = false
+	cfg.OverlayProvider = ""
+	cfg.OverlayConfig = nil
+	return cfg
+}
+
+// templateMCPConnections blanks the header and environment values of MCP
+// connections, along with URL passwords and query parameters that carry
+// credentials. A connection that lost a value is stored inactive so it is
+// not started before the new bot's owner fills its credentials in.
+func templateMCPConnections(items []mcp.Connection) ([]mcp.Connection, []string) {
+	var warnings []string
+	out := make([]mcp.Connection, 0, len(items))
+	for _, item := range items {
+		cleared := 0
+		cfg := make(map[string]any, len(item.Config))
+		for key, value := range item.Config {
+			if key == "headers" || key == "env" {
+				values := stringMapFromAny(value)
+				blank := make(map[string]string, len(values))
+				for name, v := range values {
+					if v != "" {
+						cleared++
+					}
+					blank[name] = ""
+				}
+				cfg[key] = blank
+				continue
+			}
+			if raw, ok := value.(string); ok && key == "url" {
+				stripped, n := templateMCPURL(raw)
+				cleared += n
+				cfg[key] = stripped
+				continue
+			}
+			cfg[key] = value
+		}
+		active := item.Active && cleared == 0
+		if cleared > 0 {
+			warnings = append(warnings, fmt.Sprintf("mcp connection %s: %d credential values removed", item.Name, cleared))
+		}
+		out = append(out, mcp.Connection{
+			Name:     item.Name,
+			Type:     item.Type,
+			Config:   cfg,
+			Active:   active,
+			AuthType: item.AuthType,
+		})
+	}
+	return out, warnings
+}
+
+// templateMCPURL blanks the password and the credential query parameters of
+// an MCP server URL, returning how many values it cleared.
+func templateMCPURL(raw string) (string, int) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw, 0
+	}
+	cleared := 0
+	if _, ok := u.User.Password(); ok {
+		u.User = url.User(u.User.Username())
+		cleared++
+	}
+	query := u.Query()
+	for name, values := range query {
+		if !isTemplateMCPQuerySecret(name) {
+			continue
+		}
+		for _, v := range values {
+			if v != "" {
+				cleared++
+			}
+		}
+		query[name] = []string{""}
+	}
+	if cleared == 0 {
+		return raw, 0
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), cleared
+}
+
+func isTemplateMCPQuerySecret(name string) bool {
+	name = strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(name))
+	if slices.Contains(templateMCPQuerySecrets, name) {
+		return true
+	}
+	return strings.HasSuffix(name, "token") || strings.HasSuffix(name, "apikey") || strings.HasSuffix(name, "secret")
+}
+
+// templateWorkspaceSeed re-packs a workspace archive without the credential
+// files of the source bot, returning the names it dropped.
+func templateWorkspaceSeed(raw []byte) ([]byte, []string, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = gr.Close() }()
+	tr := tar.NewReader(gr)
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	var removed []string
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		name := strings.TrimPrefix(path.Clean(strings.TrimPrefix(header.Name, "./")), "/")
+		if isTemplateCredentialPath(name, header.Typeflag == tar.TypeDir) {
+			if header.Typeflag == tar.TypeReg {
+				removed = append(removed, name)
+			}
+			continue
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, nil, err
+		}
+		if _, err := io.Copy(tw, tr); err != nil { //nolint:gosec // G110: the archive was produced by our own export
+			return nil, nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), removed, nil
+}
+
+// isTemplateCredentialPath reports whether a workspace entry is dropped from
+// a template seed. File name patterns only apply to files, so a directory
+// that happens to be called .env keeps its contents.
+func isTemplateCredentialPath(name string, dir bool) bool {
+	for _, p := range templateCredentialPaths {
+		if strings.HasSuffix(p, "/") {
+			if name == strings.TrimSuffix(p, "/") || strings.HasPrefix(name, p) {
+				return true
+			}
+			continue
+		}
+		if name == p {
+			return true
+		}
+	}
+	if dir {
+		return false
+	}
+	base := path.Base(name)
+	if slices.Contains(templateCredentialNameExceptions, base) {
+		return false
+	}
+	for _, pattern := range templateCredentialNames {
+		if ok, _ := path.Match(pattern, base); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// templateSectionsIn lists the template sections a bundle has entries for.
+func templateSectionsIn(entries map[string]backupZipEntry) []Section {
+	var out []Section
+	for _, section := range TemplateSections {
+		switch section {
+		case SectionSettings:
+			if hasEntry(entries, "bot/settings.json") {
+				out = append(out, section)
+			}
+		case SectionMCP:
+			if hasEntry(entries, "bot/mcp_connections.json") {
+				out = append(out, section)
+			}
+		case SectionSchedules:
+			if hasEntry(entries, "bot/schedules.json") {
+				out = append(out, section)
+			}
+		case SectionWorkspace:
+			if hasWorkspaceEntries(entries) {
+				out = append(out, section)
+			}
+		}
+	}
+	return out
+}
+
+func templateContents(entries map[string]backupZipEntry) TemplateContents {
+	wanted := map[Section]bool{}
+	for _, section := range TemplateSections {
+		wanted[section] = true
+	}
+	contents := TemplateContents{Sections: []SectionSummary{}}
+	for _, summary := range summarizeSections(entries) {
+		if wanted[summary.Key] {
+			contents.Sections = append(contents.Sections, summary)
+		}
+	}
+	names, _ := readTarGzNames(entries[workspaceArchivePath].data, 0)
+	skills := map[string]struct{}{}
+	for _, name := range names {
+		if name == templateIdentityFile {
+			contents.IdentityFile = true
+		}
+		rest, ok := strings.CutPrefix(name, templateSkillsDir+"/")
+		if !ok {
+			continue
+		}
+		if skill, _, nested := strings.Cut(rest, "/"); nested && skill != "" {
+			skills[skill] = struct{}{}
+		}
+	}
+	for skill := range skills {
+		contents.Skills = append(contents.Skills, skill)
+	}
+	sort.Strings(contents.Skills)
+	return contents
+}
+
+func entryTypeOf(manifest Manifest, entryPath string) string {
+	for _, entry := range manifest.Entries {
+		if entry.Path == entryPath {
+			return entry.Type
+		}
+	}
+	return strings.TrimSuffix(strings.ReplaceAll(entryPath, "/", "_"), ".json")
+}
@@ -264,6 +264,38 @@ func (s *Service) AuthorizeAccessWithPermission(ctx context.Context, userID, bot
 	return Bot{}, ErrBotAccessDenied
 }
 
+// AuthorizeBotDelegation checks whether the bot fromBotID may hand tasks to the
+// bot identified by target (UUID or name). A bot acts on behalf of its owner, so
+// the owner needs the chat permission on the target through ownership, a direct
+// grant or an everyone grant. It returns the resolved target bot.
+func (s *Service) AuthorizeBotDelegation(ctx context.Context, fromBotID, target string) (Bot, error) {
+	from, err := s.Get(ctx, fromBotID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Bot{}, ErrBotNotFound
+		}
+		return Bot{}, err
+	}
+	to, err := s.Get(ctx, target)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Bot{}, ErrBotNotFound
+		}
+		return Bot{}, err
+	}
+	if to.ID == from.ID || !to.IsActive {
+		return Bot{}, ErrBotAccessDenied
+	}
+	perms, err := s.ResolveUserPermissions(ctx, to.ID, from.OwnerUserID, false)
+	if err != nil {
+		return Bot{}, err
+	}
+	if !hasPermission(perms, PermissionChat) {
+		return Bot{}, ErrBotAccessDenied
+	}
+	return to, nil
+}
+
 // ListUserGrants returns all workspace user access grants for a bot, with the
 // owner prepended as an implicit full-access entry.
 func (s *Service) ListUserGrants(ctx context.Context, botID string) ([]UserGrant, error) {
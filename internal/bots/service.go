@@ -15,6 +15,7 @@ import (
 
 	"github.com/memohai/memoh/internal/acl"
 	"github.com/memohai/memoh/internal/acpprofile"
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/db"
 	"github.com/memohai/memoh/internal/db/postgres/sqlc"
 	dbstore "github.com/memohai/memoh/internal/db/store"
@@ -28,6 +29,7 @@ type Service struct {
 	containerLifecycle    ContainerLifecycle
 	checkers              []RuntimeChecker
 	containerReachability func(ctx context.Context, botID string) error
+	auditLog              *audit.Service
 }
 
 const (
@@ -65,6 +67,11 @@ func (s *Service) SetContainerReachability(fn func(ctx context.Context, botID st
 	s.containerReachability = fn
 }
 
+// SetAuditLog records ownership transfers to the audit log.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
 // AddRuntimeChecker registers an additional runtime checker.
 func (s *Service) AddRuntimeChecker(c RuntimeChecker) {
 	if c != nil {
@@ -430,6 +437,12 @@ func (s *Service) TransferOwner(ctx context.Context, botID string, ownerUserID s
 	if err := s.ensureUserExists(ctx, ownerUUID); err != nil {
 		return Bot{}, err
 	}
+	var previousOwner string
+	if s.auditLog != nil {
+		if previous, err := s.queries.GetBotByID(ctx, botUUID); err == nil && previous.OwnerUserID.Valid {
+			previousOwner = previous.OwnerUserID.String()
+		}
+	}
 	row, err := s.queries.UpdateBotOwner(ctx, sqlc.UpdateBotOwnerParams{
 		ID:          botUUID,
 		OwnerUserID: ownerUUID,
@@ -444,6 +457,14 @@ func (s *Service) TransferOwner(ctx context.Context, botID string, ownerUserID s
 	if err := s.attachCheckSummary(ctx, &bot, asSQLCBot(row)); err != nil {
 		return Bot{}, err
 	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionBotOwnerTransfer,
+		BotID:      botID,
+		TargetType: "bot",
+		TargetID:   botID,
+		Before:     map[string]string{"owner_user_id": previousOwner},
+		After:      map[string]string{"owner_user_id": ownerUserID},
+	})
 	return bot, nil
 }
 
@@ -0,0 +1,385 @@
+package bottemplate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/audit"
+	"github.com/memohai/memoh/internal/botbackup"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/storage"
+	"github.com/memohai/memoh/internal/workspace"
+)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+
+// Backups builds template bundles from bots and creates bots from them.
+// *botbackup.Service implements it.
+type Backups interface {
+	ExportTemplate(ctx context.Context, botID string) ([]byte, botbackup.TemplateContents, error)
+	InstantiateTemplate(ctx context.Context, actorUserID string, raw []byte, req bots.CreateBotRequest) (botbackup.ImportResult, error)
+}
+
+// Service manages bot templates. Template rows live in the database; their
+// bundles are kept in a storage provider under the template ID.
+type Service struct {
+	logger   *slog.Logger
+	queries  dbstore.Queries
+	backups  Backups
+	bots     *bots.Service
+	bundles  storage.Provider
+	auditLog *audit.Service
+}
+
+func NewService(log *slog.Logger, queries dbstore.Queries, backups Backups, botService *bots.Service, bundles storage.Provider) *Service {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &Service{
+		logger:  log.With(slog.String("service", "bot_templates")),
+		queries: queries,
+		backups: backups,
+		bots:    botService,
+		bundles: bundles,
+	}
+}
+
+// SetAuditLog records template changes and the bots created from templates.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
+// List returns every template. It is meant for admins; ListVisible is what
+// other users see.
+func (s *Service) List(ctx context.Context) ([]Template, error) {
+	rows, err := s.queries.ListBotTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTemplates(rows), nil
+}
+
+// ListVisible returns the templates a user created and the shared ones.
+func (s *Service) ListVisible(ctx context.Context, userID string) ([]Template, error) {
+	rows, err := s.queries.ListVisibleBotTemplates(ctx, optionalUUID(userID))
+	if err != nil {
+		return nil, err
+	}
+	return toTemplates(rows), nil
+}
+
+func toTemplates(rows []sqlc.BotTemplate) []Template {
+	items := make([]Template, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, toTemplate(row))
+	}
+	return items
+}
+
+// Get returns a template by ID or by name.
+func (s *Service) Get(ctx context.Context, ref string) (Template, error) {
+	ref = strings.TrimSpace(ref)
+	var (
+		row sqlc.BotTemplate
+		err error
+	)
+	if _, parseErr := uuid.Parse(ref); parseErr == nil {
+		row, err = s.queries.GetBotTemplateByID(ctx, db.ParseUUIDOrEmpty(ref))
+	} else {
+		row, err = s.queries.GetBotTemplateByName(ctx, ref)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Template{}, ErrNotFound
+		}
+		return Template{}, err
+	}
+	return toTemplate(row), nil
+}
+
+// CreateFromBot captures a bot as a new template.
+func (s *Service) CreateFromBot(ctx context.Context, actorUserID string, req CreateRequest) (Template, error) {
+	name, err := normalizeName(req.Name)
+	if err != nil {
+		return Template{}, err
+	}
+	if err := s.ensureNameFree(ctx, name, ""); err != nil {
+		return Template{}, err
+	}
+	bot, err := s.bots.Get(ctx, req.BotID)
+	if err != nil {
+		return Template{}, err
+	}
+	image := strings.TrimSpace(req.Image)
+	if image == "" {
+		image = workspace.ImagePreferenceFromMetadata(bot.Metadata)
+	}
+	raw, contents, err := s.backups.ExportTemplate(ctx, bot.ID)
+	if err != nil {
+		return Template{}, fmt.Errorf("export bot: %w", err)
+	}
+	return s.create(ctx, actorUserID, name, req.Description, image, bot.ID, req.Shared, raw, contents)
+}
+
+// Upload stores a bot backup bundle as a new template. The bundle is reduced
+// to the template sections and stripped of credentials first.
+func (s *Service) Upload(ctx context.Context, actorUserID string, req UploadRequest, raw []byte) (Template, error) {
+	name, err := normalizeName(req.Name)
+	if err != nil {
+		return Template{}, err
+	}
+	if err := s.ensureNameFree(ctx, name, ""); err != nil {
+		return Template{}, err
+	}
+	bundle, contents, err := botbackup.SanitizeTemplate(raw)
+	if err != nil {
+		return Template{}, err
+	}
+	return s.create(ctx, actorUserID, name, req.Description, req.Image, "", req.Shared, bundle, contents)
+}
+
+func (s *Service) create(ctx context.Context, actorUserID, name, description, image, sourceBotID string, shared bool, bundle []byte, contents botbackup.TemplateContents) (Template, error) {
+	contentsJSON, err := json.Marshal(contents)
+	if err != nil {
+		return Template{}, err
+	}
+	row, err := s.queries.CreateBotTemplate(ctx, sqlc.CreateBotTemplateParams{
+		Name:            name,
+		Description:     strings.TrimSpace(description),
+		Image:           strings.TrimSpace(image),
+		Contents:        contentsJSON,
+		SizeBytes:       int64(len(bundle)),
+		SourceBotID:     optionalUUID(sourceBotID),
+		CreatedByUserID: optionalUUID(actorUserID),
+		Shared:          shared,
+	})
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			return Template{}, ErrNameInUse
+		}
+		return Template{}, err
+	}
+	tpl := toTemplate(row)
+	if err := s.bundles.Put(ctx, bundleKey(tpl.ID), bytes.NewReader(bundle)); err != nil {
+		if delErr := s.queries.DeleteBotTemplate(context.WithoutCancel(ctx), row.ID); delErr != nil {
+			s.logger.Warn("remove template without bundle failed", slog.String("template_id", tpl.ID), slog.Any("error", delErr))
+		}
+		return Template{}, fmt.Errorf("store template bundle: %w", err)
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionBotTemplateCreate,
+		BotID:      sourceBotID,
+		TargetType: "bot_template",
+		TargetID:   tpl.ID,
+		After:      auditState(tpl),
+	})
+	return tpl, nil
+}
+
+// Update renames a template, changes its description or image, or shares it
+// with every user or stops sharing it. The bundle is immutable; capture the
+// bot again to refresh it.
+func (s *Service) Update(ctx context.Context, ref string, req UpdateRequest) (Template, error) {
+	existing, err := s.Get(ctx, ref)
+	if err != nil {
+		return Template{}, err
+	}
+	name, description, image, shared := existing.Name, existing.Description, existing.Image, existing.Shared
+	if req.Name != nil {
+		if name, err = normalizeName(*req.Name); err != nil {
+			return Template{}, err
+		}
+		if err := s.ensureNameFree(ctx, name, existing.ID); err != nil {
+			return Template{}, err
+		}
+	}
+	if req.Description != nil {
+		description = strings.TrimSpace(*req.Description)
+	}
+	if req.Image != nil {
+		image = strings.TrimSpace(*req.Image)
+	}
+	if req.Shared != nil {
+		shared = *req.Shared
+	}
+	row, err := s.queries.UpdateBotTemplate(ctx, sqlc.UpdateBotTemplateParams{
+		ID:          db.ParseUUIDOrEmpty(existing.ID),
+		Name:        name,
+		Description: description,
+		Image:       image,
+		Shared:      shared,
+	})
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			return Template{}, ErrNameInUse
+		}
+		return Template{}, err
+	}
+	tpl := toTemplate(row)
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionBotTemplateUpdate,
+		TargetType: "bot_template",
+		TargetID:   tpl.ID,
+		Before:     auditState(existing),
+		After:      auditState(tpl),
+	})
+	return tpl, nil
+}
+
+func (s *Service) Delete(ctx context.Context, ref string) error {
+	existing, err := s.Get(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if err := s.queries.DeleteBotTemplate(ctx, db.ParseUUIDOrEmpty(existing.ID)); err != nil {
+		return err
+	}
+	if err := s.bundles.Delete(ctx, bundleKey(existing.ID)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		s.logger.Warn("remove template bundle failed", slog.String("template_id", existing.ID), slog.Any("error", err))
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionBotTemplateDelete,
+		TargetType: "bot_template",
+		TargetID:   existing.ID,
+		Before:     auditState(existing),
+	})
+	return nil
+}
+
+// Bundle opens the bundle of a template. The caller closes the reader.
+func (s *Service) Bundle(ctx context.Context, ref string) (Template, io.ReadCloser, error) {
+	tpl, err := s.Get(ctx, ref)
+	if err != nil {
+		return Template{}, nil, err
+	}
+	rc, err := s.bundles.Open(ctx, bundleKey(tpl.ID))
+	if err != nil {
+		return Template{}, nil, fmt.Errorf("open template bundle: %w", err)
+	}
+	return tpl, rc, nil
+}
+
+// Instantiate creates a bot owned by actorUserID from a template. Fields of
+// req left empty fall back to the template; a template image applies unless
+// req.Metadata already picks one.
+func (s *Service) Instantiate(ctx context.Context, actorUserID, ref string, req bots.CreateBotRequest) (InstantiateResult, error) {
+	tpl, rc, err := s.Bundle(ctx, ref)
+	if err != nil {
+		return InstantiateResult{}, err
+	}
+	raw, err := io.ReadAll(rc)
+	_ = rc.Close()
+	if err != nil {
+		return InstantiateResult{}, fmt.Errorf("read template bundle: %w", err)
+	}
+	if tpl.Image != "" && workspace.ImagePreferenceFromMetadata(req.Metadata) == "" {
+		req.Metadata = workspace.WithImagePreference(req.Metadata, tpl.Image)
+	}
+	imported, err := s.backups.InstantiateTemplate(ctx, actorUserID, raw, req)
+	if err != nil {
+		return InstantiateResult{}, err
+	}
+	bot, err := s.bots.Get(ctx, imported.BotID)
+	if err != nil {
+		return InstantiateResult{}, err
+	}
+	result := InstantiateResult{
+		Bot:        bot,
+		TemplateID: tpl.ID,
+		Warnings:   imported.Warnings,
+		Imported:   imported.Imported,
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionBotTemplateUse,
+		BotID:      bot.ID,
+		TargetType: "bot_template",
+		TargetID:   tpl.ID,
+		After:      result,
+	})
+	return result, nil
+}
+
+func (s *Service) ensureNameFree(ctx context.Context, name, selfID string) error {
+	row, err := s.queries.GetBotTemplateByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if uuidString(row.ID) == selfID {
+		return nil
+	}
+	return ErrNameInUse
+}
+
+func normalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if !namePattern.MatchString(name) {
+		return "", ErrInvalidName
+	}
+	return name, nil
+}
+
+func bundleKey(id string) string {
+	return id + ".memoh.zip"
+}
+
+func toTemplate(row sqlc.BotTemplate) Template {
+	tpl := Template{
+		ID:              uuidString(row.ID),
+		Name:            row.Name,
+		Description:     row.Description,
+		Image:           row.Image,
+		SizeBytes:       row.SizeBytes,
+		SourceBotID:     uuidString(row.SourceBotID),
+		CreatedByUserID: uuidString(row.CreatedByUserID),
+		Shared:          row.Shared,
+		CreatedAt:       db.TimeFromPg(row.CreatedAt),
+		UpdatedAt:       db.TimeFromPg(row.UpdatedAt),
+	}
+	_ = json.Unmarshal(row.Contents, &tpl.Contents)
+	if tpl.Contents.Sections == nil {
+		tpl.Contents.Sections = []botbackup.SectionSummary{}
+	}
+	return tpl
+}
+
+// auditState is the configured part of a template.
+func auditState(tpl Template) map[string]any {
+	return map[string]any{
+		"name":        tpl.Name,
+		"description": tpl.Description,
+		"image":       tpl.Image,
+		"shared":      tpl.Shared,
+	}
+}
+
+func optionalUUID(id string) pgtype.UUID {
+	if strings.TrimSpace(id) == "" {
+		return pgtype.UUID{}
+	}
+	return db.ParseUUIDOrEmpty(id)
+}
+
+func uuidString(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return uuid.UUID(id.Bytes).String()
+}
@@ -0,0 +1,81 @@
+// Package bottemplate stores named bot blueprints — a workspace image, a seed
+// for /data and the settings, MCP connections and schedules of a bot — and
+// creates new bots from them.
+package bottemplate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/memohai/memoh/internal/botbackup"
+	"github.com/memohai/memoh/internal/bots"
+)
+
+var (
+	ErrNotFound    = errors.New("bot template not found")
+	ErrNameInUse   = errors.New("bot template name already in use")
+	ErrInvalidName = errors.New("bot template names are 1-64 lowercase letters, digits, '.', '_' or '-', starting with a letter or digit")
+)
+
+// Template is a named blueprint new bots are created from. The bundle it
+// describes is a bot backup restricted to botbackup.TemplateSections.
+type Template struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Image is the workspace image bots created from the template start
+	// from. Empty means the server's default workspace image.
+	Image           string                     `json:"image"`
+	Contents        botbackup.TemplateContents `json:"contents"`
+	SizeBytes       int64                      `json:"size_bytes"`
+	SourceBotID     string                     `json:"source_bot_id,omitempty"`
+	CreatedByUserID string                     `json:"created_by_user_id,omitempty"`
+	// Shared makes the template visible to every user. Otherwise only its
+	// creator and admins see, download and use it.
+	Shared    bool      `json:"shared"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VisibleTo reports whether a user who is not an admin may see and use the
+// template.
+func (t Template) VisibleTo(userID string) bool {
+	return t.Shared || (t.CreatedByUserID != "" && t.CreatedByUserID == userID)
+}
+
+// CreateRequest captures an existing bot as a template.
+type CreateRequest struct {
+	BotID       string `json:"bot_id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Image defaults to the workspace image preference of the source bot.
+	Image  string `json:"image,omitempty"`
+	Shared bool   `json:"shared,omitempty"`
+}
+
+// UploadRequest describes a template uploaded as a bot backup bundle.
+type UploadRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Image       string `json:"image,omitempty"`
+	Shared      bool   `json:"shared,omitempty"`
+}
+
+type UpdateRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Image       *string `json:"image,omitempty"`
+	Shared      *bool   `json:"shared,omitempty"`
+}
+
+type ListResponse struct {
+	Items []Template `json:"items"`
+}
+
+// InstantiateResult is the bot created from a template.
+type InstantiateResult struct {
+	Bot        bots.Bot                  `json:"bot"`
+	TemplateID string                    `json:"template_id"`
+	Warnings   []string                  `json:"warnings,omitempty"`
+	Imported   map[botbackup.Section]int `json:"imported,omitempty"`
+}
@@ -0,0 +1,467 @@
+// Package budget enforces per-bot and per-user spending limits. Usage is
+// summed from the token usage recorded on bot history messages, either as
+// raw tokens or as cost priced from each model's config.pricing. Crossing the
+// warning threshold notifies the bot owner once per period; crossing the
+// limit notifies them again and makes Check fail, which chat turns,
+// schedules and heartbeats treat as a hard stop.
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/boot"
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+)
+
+// notifyTimeout caps how long delivering a budget notification may take.
+const notifyTimeout = 30 * time.Second
+
+var (
+	// ErrNotFound is returned when a budget does not exist.
+	ErrNotFound = errors.New("budget not found")
+	// ErrInvalid wraps validation failures of create and update requests.
+	ErrInvalid = errors.New("invalid budget")
+)
+
+// Notifier delivers budget warnings to a bot owner, typically through one of
+// the owner's bound channels.
+type Notifier interface {
+	NotifyOwner(ctx context.Context, botID, ownerUserID, text string) error
+}
+
+type Service struct {
+	queries  dbstore.Queries
+	logger   *slog.Logger
+	location *time.Location
+	notifier Notifier
+	now      func() time.Time
+}
+
+func NewService(log *slog.Logger, queries dbstore.Queries, runtimeConfig *boot.RuntimeConfig) *Service {
+	location := time.UTC
+	if runtimeConfig != nil && runtimeConfig.TimezoneLocation != nil {
+		location = runtimeConfig.TimezoneLocation
+	}
+	return &Service{
+		queries:  queries,
+		logger:   log.With(slog.String("service", "budget")),
+		location: location,
+		now:      time.Now,
+	}
+}
+
+// SetNotifier configures how warnings reach bot owners. Without a notifier
+// thresholds are still enforced but only logged.
+func (s *Service) SetNotifier(notifier Notifier) {
+	s.notifier = notifier
+}
+
+func (s *Service) CreateForBot(ctx context.Context, botID string, req CreateRequest) (Status, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return Status{}, err
+	}
+	return s.create(ctx, pgBotID, pgtype.UUID{}, req)
+}
+
+func (s *Service) CreateForUser(ctx context.Context, userID string, req CreateRequest) (Status, error) {
+	pgUserID, err := db.ParseUUID(userID)
+	if err != nil {
+		return Status{}, err
+	}
+	return s.create(ctx, pgtype.UUID{}, pgUserID, req)
+}
+
+func (s *Service) create(ctx context.Context, botID, userID pgtype.UUID, req CreateRequest) (Status, error) {
+	if s.queries == nil {
+		return Status{}, errors.New("budget queries not configured")
+	}
+	period, err := normalizePeriod(req.Period)
+	if err != nil {
+		return Status{}, err
+	}
+	unit, err := normalizeUnit(req.Unit)
+	if err != nil {
+		return Status{}, err
+	}
+	warnPercent := defaultWarnPercent
+	if req.WarnPercent != nil {
+		warnPercent = *req.WarnPercent
+	}
+	if err := validateLimits(req.Limit, warnPercent); err != nil {
+		return Status{}, err
+	}
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	row, err := s.queries.CreateBudget(ctx, sqlc.CreateBudgetParams{
+		BotID:       botID,
+		UserID:      userID,
+		Period:      string(period),
+		Unit:        string(unit),
+		LimitAmount: req.Limit,
+		WarnPercent: int32(warnPercent), //nolint:gosec // validated to 0..100
+		Enabled:     enabled,
+	})
+	if err != nil {
+		return Status{}, err
+	}
+	return s.Status(ctx, toBudget(row))
+}
+
+func (s *Service) Get(ctx context.Context, id string) (Budget, error) {
+	pgID, err := db.ParseUUID(id)
+	if err != nil {
+		return Budget{}, err
+	}
+	row, err := s.queries.GetBudgetByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Budget{}, ErrNotFound
+		}
+		return Budget{}, err
+	}
+	return toBudget(row), nil
+}
+
+// ListByBot returns the budgets set directly on a bot with their usage.
+func (s *Service) ListByBot(ctx context.Context, botID string) ([]Status, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.ListBudgetsByBot(ctx, pgBotID)
+	if err != nil {
+		return nil, err
+	}
+	return s.statuses(ctx, rows)
+}
+
+// ListByUser returns the budgets covering all bots of a user with their usage.
+func (s *Service) ListByUser(ctx context.Context, userID string) ([]Status, error) {
+	pgUserID, err := db.ParseUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.ListBudgetsByUser(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.statuses(ctx, rows)
+}
+
+func (s *Service) statuses(ctx context.Context, rows []sqlc.Budget) ([]Status, error) {
+	items := make([]Status, 0, len(rows))
+	for _, row := range rows {
+		status, err := s.Status(ctx, toBudget(row))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, status)
+	}
+	return items, nil
+}
+
+// Update changes a budget. Any change re-arms the warning and hard-stop
+// notifications for the current period.
+func (s *Service) Update(ctx context.Context, id string, req UpdateRequest) (Status, error) {
+	existing, err := s.Get(ctx, id)
+	if err != nil {
+		return Status{}, err
+	}
+	period := existing.Period
+	if req.Period != nil {
+		if period, err = normalizePeriod(*req.Period); err != nil {
+			return Status{}, err
+		}
+	}
+	unit := existing.Unit
+	if req.Unit != nil {
+		if unit, err = normalizeUnit(*req.Unit); err != nil {
+			return Status{}, err
+		}
+	}
+	limit := existing.Limit
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+	warnPercent := existing.WarnPercent
+	if req.WarnPercent != nil {
+		warnPercent = *req.WarnPercent
+	}
+	if err := validateLimits(limit, warnPercent); err != nil {
+		return Status{}, err
+	}
+	enabled := existing.Enabled
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	row, err := s.queries.UpdateBudget(ctx, sqlc.UpdateBudgetParams{
+		ID:          db.ParseUUIDOrEmpty(id),
+		Period:      string(period),
+		Unit:        string(unit),
+		LimitAmount: limit,
+		WarnPercent: int32(warnPercent), //nolint:gosec // validated to 0..100
+		Enabled:     enabled,
+	})
+	if err != nil {
+		return Status{}, err
+	}
+	return s.Status(ctx, toBudget(row))
+}
+
+func (s *Service) Delete(ctx context.Context, id string) error {
+	pgID, err := db.ParseUUID(id)
+	if err != nil {
+		return err
+	}
+	return s.queries.DeleteBudget(ctx, pgID)
+}
+
+// Status computes a budget's usage in the current period.
+func (s *Service) Status(ctx context.Context, b Budget) (Status, error) {
+	start, end := periodWindow(b.Period, s.now().In(s.location))
+	var (
+		rows []sqlc.GetBotBudgetUsageByModelRow
+		err  error
+	)
+	if b.BotID != "" {
+		rows, err = s.queries.GetBotBudgetUsageByModel(ctx, sqlc.GetBotBudgetUsageByModelParams{
+			BotID:    db.ParseUUIDOrEmpty(b.BotID),
+			FromTime: pgtype.Timestamptz{Time: start, Valid: true},
+		})
+	} else {
+		var ownerRows []sqlc.GetOwnerBudgetUsageByModelRow
+		ownerRows, err = s.queries.GetOwnerBudgetUsageByModel(ctx, sqlc.GetOwnerBudgetUsageByModelParams{
+			OwnerUserID: db.ParseUUIDOrEmpty(b.UserID),
+			FromTime:    pgtype.Timestamptz{Time: start, Valid: true},
+		})
+		for _, row := range ownerRows {
+			rows = append(rows, sqlc.GetBotBudgetUsageByModelRow(row))
+		}
+	}
+	if err != nil {
+		return Status{}, fmt.Errorf("load budget usage: %w", err)
+	}
+	used := usageAmount(b.Unit, rows)
+	return Status{
+		Budget:      b,
+		Used:        used,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Warning:     b.WarnPercent > 0 && used >= b.Limit*float64(b.WarnPercent)/100,
+		Exceeded:    used >= b.Limit,
+	}, nil
+}
+
+// Check returns an error wrapping ErrExceeded when any enabled budget of the
+// bot or its owner is used up for the current period, and notifies the owner
+// the first time a budget crosses its warning threshold or limit in a period.
+// Failures to load budgets or usage are logged and do not block the bot.
+func (s *Service) Check(ctx context.Context, botID string) error {
+	if s == nil || s.queries == nil {
+		return nil
+	}
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return err
+	}
+	bot, err := s.queries.GetBotByID(ctx, pgBotID)
+	if err != nil {
+		s.logger.Warn("budget check skipped: load bot failed", slog.String("bot_id", botID), slog.Any("error", err))
+		return nil
+	}
+	rows, err := s.queries.ListEnabledBudgetsForBot(ctx, sqlc.ListEnabledBudgetsForBotParams{
+		BotID:       pgBotID,
+		OwnerUserID: bot.OwnerUserID,
+	})
+	if err != nil {
+		s.logger.Warn("budget check skipped: list budgets failed", slog.String("bot_id", botID), slog.Any("error", err))
+		return nil
+	}
+	ownerUserID := bot.OwnerUserID.String()
+	botName := strings.TrimSpace(bot.DisplayName.String)
+	if botName == "" {
+		botName = bot.Name
+	}
+
+	var exceeded *ExceededError
+	for _, row := range rows {
+		status, err := s.Status(ctx, toBudget(row))
+		if err != nil {
+			s.logger.Warn("budget check skipped", slog.String("budget_id", row.ID.String()), slog.Any("error", err))
+			continue
+		}
+		key := periodKey(status.Period, status.PeriodStart)
+		switch {
+		case status.Exceeded:
+			if exceeded == nil {
+				exceeded = &ExceededError{Status: status}
+			}
+			n, err := s.queries.MarkBudgetExceeded(ctx, sqlc.MarkBudgetExceededParams{ID: row.ID, PeriodKey: key})
+			if err != nil {
+				s.logger.Warn("mark budget exceeded failed", slog.String("budget_id", status.ID), slog.Any("error", err))
+			} else if n > 0 {
+				s.logger.Warn("budget exceeded", slog.String("budget_id", status.ID), slog.String("bot_id", botID))
+				s.notify(ctx, botID, ownerUserID, exceededMessage(botName, status))
+			}
+		case status.Warning:
+			n, err := s.queries.MarkBudgetWarned(ctx, sqlc.MarkBudgetWarnedParams{ID: row.ID, PeriodKey: key})
+			if err != nil {
+				s.logger.Warn("mark budget warned failed", slog.String("budget_id", status.ID), slog.Any("error", err))
+			} else if n > 0 {
+				s.notify(ctx, botID, ownerUserID, warningMessage(botName, status))
+			}
+		}
+	}
+	if exceeded != nil {
+		return exceeded
+	}
+	return nil
+}
+
+// notify delivers text to the owner in the background so the caller's turn
+// is not held up by channel delivery.
+func (s *Service) notify(ctx context.Context, botID, ownerUserID, text string) {
+	if s.notifier == nil || ownerUserID == "" {
+		s.logger.Info("budget notification not delivered", slog.String("bot_id", botID), slog.String("text", text))
+		return
+	}
+	go func() {
+		notifyCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notifyTimeout)
+		defer cancel()
+		if err := s.notifier.NotifyOwner(notifyCtx, botID, ownerUserID, text); err != nil {
+			s.logger.Warn("budget notification failed", slog.String("bot_id", botID), slog.Any("error", err))
+		}
+	}()
+}
+
+func warningMessage(botName string, st Status) string {
+	percent := 0.0
+	if st.Limit > 0 {
+		percent = st.Used / st.Limit * 100
+	}
+	return fmt.Sprintf("Budget warning: %s used %s of the %s %s budget (%.0f%%).",
+		budgetSubject(botName, st), formatAmount(st.Unit, st.Used), st.Period, formatAmount(st.Unit, st.Limit), percent)
+}
+
+func exceededMessage(botName string, st Status) string {
+	return fmt.Sprintf("Budget exceeded: %s used %s of the %s %s budget. New turns, schedules and heartbeats are paused until %s.",
+		budgetSubject(botName, st), formatAmount(st.Unit, st.Used), st.Period, formatAmount(st.Unit, st.Limit),
+		st.PeriodEnd.Format("2006-01-02 15:04 MST"))
+}
+
+func budgetSubject(botName string, st Status) string {
+	if st.UserID != "" {
+		return "your bots have"
+	}
+	return fmt.Sprintf("bot %q has", botName)
+}
+
+func formatAmount(unit Unit, amount float64) string {
+	if unit == UnitTokens {
+		return fmt.Sprintf("%.0f tokens", amount)
+	}
+	return fmt.Sprintf("%.2f", amount)
+}
+
+// usageAmount sums per-model usage in the budget's unit. Cost is priced per
+// million tokens; cached input is billed at the cache-read price when the
+// model sets one and at the input price otherwise. Models without pricing
+// cost nothing.
+func usageAmount(unit Unit, rows []sqlc.GetBotBudgetUsageByModelRow) float64 {
+	var total float64
+	for _, row := range rows {
+		if unit == UnitTokens {
+			total += float64(row.InputTokens + row.OutputTokens)
+			continue
+		}
+		cached := min(row.CacheReadTokens, row.InputTokens)
+		cachePrice := row.CacheReadPrice
+		if cachePrice == 0 {
+			cachePrice = row.InputPrice
+		}
+		total += (float64(row.InputTokens-cached)*row.InputPrice +
+			float64(cached)*cachePrice +
+			float64(row.OutputTokens)*row.OutputPrice) / 1_000_000
+	}
+	return total
+}
+
+// periodWindow returns the [start, end) window of the period containing now,
+// in now's location.
+func periodWindow(period Period, now time.Time) (time.Time, time.Time) {
+	y, m, d := now.Date()
+	if period == PeriodDaily {
+		start := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 0, 1)
+	}
+	start := time.Date(y, m, 1, 0, 0, 0, 0, now.Location())
+	return start, start.AddDate(0, 1, 0)
+}
+
+// periodKey identifies a period so notifications fire once per period.
+func periodKey(period Period, start time.Time) string {
+	if period == PeriodDaily {
+		return start.Format("2006-01-02")
+	}
+	return start.Format("2006-01")
+}
+
+func normalizePeriod(p Period) (Period, error) {
+	switch Period(strings.ToLower(strings.TrimSpace(string(p)))) {
+	case PeriodDaily:
+		return PeriodDaily, nil
+	case PeriodMonthly, "":
+		return PeriodMonthly, nil
+	default:
+		return "", fmt.Errorf("%w: unknown period %q", ErrInvalid, p)
+	}
+}
+
+func normalizeUnit(u Unit) (Unit, error) {
+	switch Unit(strings.ToLower(strings.TrimSpace(string(u)))) {
+	case UnitTokens, "":
+		return UnitTokens, nil
+	case UnitCost:
+		return UnitCost, nil
+	default:
+		return "", fmt.Errorf("%w: unknown unit %q", ErrInvalid, u)
+	}
+}
+
+func validateLimits(limit float64, warnPercent int) error {
+	if limit <= 0 {
+		return fmt.Errorf("%w: limit must be greater than 0", ErrInvalid)
+	}
+	if warnPercent < 0 || warnPercent > 100 {
+		return fmt.Errorf("%w: warn_percent must be between 0 and 100", ErrInvalid)
+	}
+	return nil
+}
+
+func toBudget(row sqlc.Budget) Budget {
+	return Budget{
+		ID:          row.ID.String(),
+		BotID:       row.BotID.String(),
+		UserID:      row.UserID.String(),
+		Period:      Period(row.Period),
+		Unit:        Unit(row.Unit),
+		Limit:       row.LimitAmount,
+		WarnPercent: int(row.WarnPercent),
+		Enabled:     row.Enabled,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,222 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+)
+
+const (
+	testUserID = "00000000-0000-0000-0000-0000000000b1"
+	testBotID  = "00000000-0000-0000-0000-0000000000b2"
+)
+
+type fakeNotifier struct {
+	texts chan string
+}
+
+func (n *fakeNotifier) NotifyOwner(_ context.Context, botID, ownerUserID, text string) error {
+	if botID != testBotID || ownerUserID != testUserID {
+		return errors.New("unexpected recipient")
+	}
+	n.texts <- text
+	return nil
+}
+
+func (n *fakeNotifier) expect(t *testing.T, prefix string) {
+	t.Helper()
+	select {
+	case text := <-n.texts:
+		if !strings.HasPrefix(text, prefix) {
+			t.Fatalf("notification = %q, want prefix %q", text, prefix)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected %q notification", prefix)
+	}
+}
+
+func (n *fakeNotifier) expectNone(t *testing.T) {
+	t.Helper()
+	select {
+	case text := <-n.texts:
+		t.Fatalf("unexpected notification %q", text)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// newSQLiteBudgetService migrates a fresh SQLite database and seeds one bot
+// with two metered replies this month and one last month.
+func newSQLiteBudgetService(t *testing.T) (*Service, *fakeNotifier) {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('` + testUserID + `','budget@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES('` + testBotID + `','` + testUserID + `','personal','budgetbot','Budget Bot')`,
+		`INSERT INTO providers(id,name) VALUES('00000000-0000-0000-0000-0000000000b3','openai')`,
+		`INSERT INTO models(id,model_id,provider_id,config) VALUES('00000000-0000-0000-0000-0000000000b4','gpt-4o','00000000-0000-0000-0000-0000000000b3','{"pricing":{"input_per_million":2,"output_per_million":8,"cache_read_per_million":1}}')`,
+		`INSERT INTO bot_history_messages(id,bot_id,role,content,usage,model_id,created_at) VALUES('00000000-0000-0000-0000-0000000000c1','` + testBotID + `','assistant','hi','{"inputTokens":1000,"outputTokens":200,"inputTokenDetails":{"cacheReadTokens":400}}','00000000-0000-0000-0000-0000000000b4','2026-10-18 08:00:00')`,
+		`INSERT INTO bot_history_messages(id,bot_id,role,content,usage,model_id,created_at) VALUES('00000000-0000-0000-0000-0000000000c2','` + testBotID + `','assistant','hi','{"inputTokens":500,"outputTokens":100}','00000000-0000-0000-0000-0000000000b4','2026-10-02 08:00:00')`,
+		`INSERT INTO bot_history_messages(id,bot_id,role,content,usage,model_id,created_at) VALUES('00000000-0000-0000-0000-0000000000c3','` + testBotID + `','assistant','hi','{"inputTokens":90000,"outputTokens":9000}','00000000-0000-0000-0000-0000000000b4','2026-09-30 23:00:00')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	svc := NewService(slog.Default(), sqlitestore.NewQueries(store), nil)
+	svc.now = func() time.Time { return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC) }
+	notifier := &fakeNotifier{texts: make(chan string, 8)}
+	svc.SetNotifier(notifier)
+	return svc, notifier
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestServiceStatusSumsCurrentPeriod(t *testing.T) {
+	svc, _ := newSQLiteBudgetService(t)
+	ctx := context.Background()
+
+	daily, err := svc.CreateForBot(ctx, testBotID, CreateRequest{Period: PeriodDaily, Unit: UnitTokens, Limit: 10000})
+	if err != nil {
+		t.Fatalf("CreateForBot() error = %v", err)
+	}
+	if daily.Used != 1200 || daily.WarnPercent != defaultWarnPercent || !daily.Enabled {
+		t.Fatalf("unexpected daily status %#v", daily)
+	}
+
+	monthly, err := svc.CreateForUser(ctx, testUserID, CreateRequest{Period: PeriodMonthly, Unit: UnitCost, Limit: 1})
+	if err != nil {
+		t.Fatalf("CreateForUser() error = %v", err)
+	}
+	// 600 uncached input at $2, 400 cached at $1, 200 output at $8, plus
+	// 500 input at $2 and 100 output at $8 from earlier this month.
+	want := (600*2 + 400*1 + 200*8 + 500*2 + 100*8) / 1_000_000.0
+	if math.Abs(monthly.Used-want) > 1e-12 {
+		t.Fatalf("monthly cost = %v, want %v", monthly.Used, want)
+	}
+	if !monthly.PeriodStart.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected period start %v", monthly.PeriodStart)
+	}
+
+	items, err := svc.ListByUser(ctx, testUserID)
+	if err != nil || len(items) != 1 || items[0].ID != monthly.ID {
+		t.Fatalf("ListByUser() = %#v, %v", items, err)
+	}
+	if _, err := svc.CreateForBot(ctx, testBotID, CreateRequest{Limit: 0}); !errors.Is(err, ErrInvalid) {
+		t.Fatal("expected zero limit to be rejected")
+	}
+	if _, err := svc.CreateForBot(ctx, testBotID, CreateRequest{Limit: 1, Period: "weekly"}); !errors.Is(err, ErrInvalid) {
+		t.Fatal("expected unknown period to be rejected")
+	}
+}
+
+func TestServiceCheckWarnsThenStops(t *testing.T) {
+	svc, notifier := newSQLiteBudgetService(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateForBot(ctx, testBotID, CreateRequest{Period: PeriodDaily, Unit: UnitTokens, Limit: 2000, WarnPercent: intPtr(50)})
+	if err != nil {
+		t.Fatalf("CreateForBot() error = %v", err)
+	}
+	if err := svc.Check(ctx, testBotID); err != nil {
+		t.Fatalf("Check() under limit error = %v", err)
+	}
+	notifier.expect(t, "Budget warning")
+	if err := svc.Check(ctx, testBotID); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	notifier.expectNone(t)
+
+	limit := 1000.0
+	if _, err := svc.Update(ctx, created.ID, UpdateRequest{Limit: &limit}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	err = svc.Check(ctx, testBotID)
+	var exceeded *ExceededError
+	if !errors.Is(err, ErrExceeded) || !errors.As(err, &exceeded) || exceeded.Status.ID != created.ID {
+		t.Fatalf("Check() over limit error = %v", err)
+	}
+	notifier.expect(t, "Budget exceeded")
+	if err := svc.Check(ctx, testBotID); !errors.Is(err, ErrExceeded) {
+		t.Fatalf("Check() error = %v, want ErrExceeded", err)
+	}
+	notifier.expectNone(t)
+
+	disabled := false
+	if _, err := svc.Update(ctx, created.ID, UpdateRequest{Enabled: &disabled}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if err := svc.Check(ctx, testBotID); err != nil {
+		t.Fatalf("Check() with disabled budget error = %v", err)
+	}
+	if err := svc.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := svc.Get(ctx, created.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestUsageAmountFallsBackToInputPriceForCache(t *testing.T) {
+	rows := []sqlc.GetBotBudgetUsageByModelRow{
+		{InputTokens: 1_000_000, OutputTokens: 1_000_000, CacheReadTokens: 500_000, InputPrice: 2, OutputPrice: 4},
+		{InputTokens: 1_000_000, OutputTokens: 1_000_000},
+	}
+	if got := usageAmount(UnitCost, rows); got != 6 {
+		t.Fatalf("usageAmount(cost) = %v, want 6", got)
+	}
+	if got := usageAmount(UnitTokens, rows); got != 4_000_000 {
+		t.Fatalf("usageAmount(tokens) = %v, want 4000000", got)
+	}
+}
+
+func TestPeriodWindow(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	now := time.Date(2026, 12, 31, 23, 30, 0, 0, loc)
+
+	start, end := periodWindow(PeriodDaily, now)
+	if !start.Equal(time.Date(2026, 12, 31, 0, 0, 0, 0, loc)) || !end.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, loc)) {
+		t.Fatalf("daily window = %v..%v", start, end)
+	}
+	if key := periodKey(PeriodDaily, start); key != "2026-12-31" {
+		t.Fatalf("daily key = %q", key)
+	}
+	start, end = periodWindow(PeriodMonthly, now)
+	if !start.Equal(time.Date(2026, 12, 1, 0, 0, 0, 0, loc)) || !end.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, loc)) {
+		t.Fatalf("monthly window = %v..%v", start, end)
+	}
+	if key := periodKey(PeriodMonthly, start); key != "2026-12" {
+		t.Fatalf("monthly key = %q", key)
+	}
+}
@@ -0,0 +1,95 @@
+package budget
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Period is the window a budget's usage is summed over. Windows start at
+// midnight (daily) or on the first of the month (monthly) in the server
+// timezone.
+type Period string
+
+const (
+	PeriodDaily   Period = "daily"
+	PeriodMonthly Period = "monthly"
+)
+
+// Unit is what a budget limit is measured in: input plus output tokens, or
+// cost priced from each model's config.pricing.
+type Unit string
+
+const (
+	UnitTokens Unit = "tokens"
+	UnitCost   Unit = "cost"
+)
+
+const defaultWarnPercent = 80
+
+// ErrExceeded is wrapped by every error returned when a hard stop applies.
+var ErrExceeded = errors.New("budget exceeded")
+
+// ExceededError reports the budget that blocked a run.
+type ExceededError struct {
+	Status Status
+}
+
+func (e *ExceededError) Error() string {
+	scope := "bot"
+	if e.Status.UserID != "" {
+		scope = "owner"
+	}
+	return fmt.Sprintf("%s %s %s budget exceeded: used %s of %s",
+		scope, e.Status.Period, e.Status.Unit,
+		formatAmount(e.Status.Unit, e.Status.Used), formatAmount(e.Status.Unit, e.Status.Limit))
+}
+
+func (*ExceededError) Unwrap() error {
+	return ErrExceeded
+}
+
+// Budget limits the usage of a single bot (BotID set) or of every bot owned
+// by a user (UserID set).
+type Budget struct {
+	ID          string    `json:"id"`
+	BotID       string    `json:"bot_id,omitempty"`
+	UserID      string    `json:"user_id,omitempty"`
+	Period      Period    `json:"period"`
+	Unit        Unit      `json:"unit"`
+	Limit       float64   `json:"limit"`
+	WarnPercent int       `json:"warn_percent"`
+	Enabled     bool      `json:"enabled"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Status is a budget together with its usage in the current period.
+type Status struct {
+	Budget
+	Used        float64   `json:"used"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	Warning     bool      `json:"warning"`
+	Exceeded    bool      `json:"exceeded"`
+}
+
+type CreateRequest struct {
+	Period      Period  `json:"period"`
+	Unit        Unit    `json:"unit"`
+	Limit       float64 `json:"limit"`
+	WarnPercent *int    `json:"warn_percent,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+type UpdateRequest struct {
+	Period      *Period  `json:"period,omitempty"`
+	Unit        *Unit    `json:"unit,omitempty"`
+	Limit       *float64 `json:"limit,omitempty"`
+	WarnPercent *int     `json:"warn_percent,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty"`
+}
+
+type ListResponse struct {
+	Items []Status `json:"items"`
+}
@@ -0,0 +1,328 @@
+package irc
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ircDialTimeout     = 15 * time.Second
+	ircRegisterTimeout = 30 * time.Second
+	ircWriteTimeout    = 10 * time.Second
+	ircMaxNickRetries  = 3
+)
+
+// ircMessage is a parsed IRC protocol line (RFC 1459 with IRCv3 message tags).
+type ircMessage struct {
+	Tags    map[string]string
+	Prefix  string
+	Command string
+	Params  []string
+}
+
+// Nick returns the nickname part of the message prefix.
+func (m ircMessage) Nick() string {
+	nick, _, _ := splitPrefix(m.Prefix)
+	return nick
+}
+
+// Param returns the i-th parameter or an empty string.
+func (m ircMessage) Param(i int) string {
+	if i < 0 || i >= len(m.Params) {
+		return ""
+	}
+	return m.Params[i]
+}
+
+func parseIRCMessage(line string) (ircMessage, error) {
+	line = strings.TrimRight(line, "\r\n")
+	if strings.TrimSpace(line) == "" {
+		return ircMessage{}, errors.New("empty irc line")
+	}
+	var msg ircMessage
+	if strings.HasPrefix(line, "@") {
+		idx := strings.IndexByte(line, ' ')
+		if idx < 0 {
+			return ircMessage{}, errors.New("irc line has tags only")
+		}
+		msg.Tags = parseTags(line[1:idx])
+		line = strings.TrimLeft(line[idx+1:], " ")
+	}
+	if strings.HasPrefix(line, ":") {
+		idx := strings.IndexByte(line, ' ')
+		if idx < 0 {
+			return ircMessage{}, errors.New("irc line has prefix only")
+		}
+		msg.Prefix = line[1:idx]
+		line = strings.TrimLeft(line[idx+1:], " ")
+	}
+	trailing, hasTrailing := "", false
+	if idx := strings.Index(line, " :"); idx >= 0 {
+		trailing = line[idx+2:]
+		line = line[:idx]
+		hasTrailing = true
+	}
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ircMessage{}, errors.New("irc line has no command")
+	}
+	msg.Command = strings.ToUpper(fields[0])
+	msg.Params = fields[1:]
+	if hasTrailing {
+		msg.Params = append(msg.Params, trailing)
+	}
+	return msg, nil
+}
+
+func parseTags(raw string) map[string]string {
+	tags := make(map[string]string)
+	for _, item := range strings.Split(raw, ";") {
+		if item == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(item, "=")
+		tags[key] = unescapeTagValue(value)
+	}
+	return tags
+}
+
+func unescapeTagValue(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 >= len(value) {
+			b.WriteByte(value[i])
+			continue
+		}
+		i++
+		switch value[i] {
+		case ':':
+			b.WriteByte(';')
+		case 's':
+			b.WriteByte(' ')
+		case 'r':
+			b.WriteByte('\r')
+		case 'n':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String()
+}
+
+func splitPrefix(prefix string) (nick, user, host string) {
+	nick = prefix
+	if idx := strings.IndexByte(nick, '@'); idx >= 0 {
+		host = nick[idx+1:]
+		nick = nick[:idx]
+	}
+	if idx := strings.IndexByte(nick, '!'); idx >= 0 {
+		user = nick[idx+1:]
+		nick = nick[:idx]
+	}
+	return nick, user, host
+}
+
+// ircClient is a single registered connection to an IRC server.
+type ircClient struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	writeMu sync.Mutex
+
+	nickMu sync.RWMutex
+	nick   string
+}
+
+func dialIRC(ctx context.Context, cfg Config) (*ircClient, error) {
+	dialer := &net.Dialer{Timeout: ircDialTimeout}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if cfg.TLS {
+		host, _, _ := net.SplitHostPort(cfg.Server)
+		tlsDialer := &tls.Dialer{
+			NetDialer: dialer,
+			Config: &tls.Config{
+				ServerName:         host,
+				MinVersion:         tls.VersionTLS12,
+				InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // opt-in for self-hosted servers with private CAs
+			},
+		}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", cfg.Server)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", cfg.Server)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("irc dial %s: %w", cfg.Server, err)
+	}
+	return &ircClient{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		nick:   cfg.Nick,
+	}, nil
+}
+
+// register performs connection registration, including optional SASL PLAIN
+// authentication, and blocks until RPL_WELCOME is received.
+func (c *ircClient) register(ctx context.Context, cfg Config) error {
+	deadline := time.Now().Add(ircRegisterTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = c.conn.SetReadDeadline(deadline)
+	defer func() { _ = c.conn.SetReadDeadline(time.Time{}) }()
+
+	saslPending := cfg.SASLPassword != ""
+	if saslPending {
+		if err := c.writeLine("CAP REQ :sasl"); err != nil {
+			return err
+		}
+	}
+	if cfg.Password != "" {
+		if err := c.writeLine("PASS " + cfg.Password); err != nil {
+			return err
+		}
+	}
+	if err := c.writeLine("NICK " + cfg.Nick); err != nil {
+		return err
+	}
+	if err := c.writeLine(fmt.Sprintf("USER %s 0 * :%s", cfg.Username, cfg.Realname)); err != nil {
+		return err
+	}
+
+	nickRetries := 0
+	for {
+		msg, err := c.readMessage()
+		if err != nil {
+			return fmt.Errorf("irc register: %w", err)
+		}
+		switch msg.Command {
+		case "PING":
+			if err := c.writeLine("PONG :" + msg.Param(0)); err != nil {
+				return err
+			}
+		case "CAP":
+			if !saslPending {
+				continue
+			}
+			switch strings.ToUpper(msg.Param(1)) {
+			case "ACK":
+				if err := c.writeLine("AUTHENTICATE PLAIN"); err != nil {
+					return err
+				}
+			case "NAK":
+				return errors.New("irc server does not support sasl")
+			}
+		case "AUTHENTICATE":
+			if msg.Param(0) != "+" {
+				continue
+			}
+			payload := base64.StdEncoding.EncodeToString([]byte(cfg.SASLUsername + "\x00" + cfg.SASLUsername + "\x00" + cfg.SASLPassword))
+			if err := c.writeLine("AUTHENTICATE " + payload); err != nil {
+				return err
+			}
+		case "903":
+			saslPending = false
+			if err := c.writeLine("CAP END"); err != nil {
+				return err
+			}
+		case "902", "904", "905", "906":
+			return fmt.Errorf("irc sasl authentication failed: %s", msg.Param(len(msg.Params)-1))
+		case "433":
+			nickRetries++
+			if nickRetries > ircMaxNickRetries {
+				return fmt.Errorf("irc nick %s is already in use", cfg.Nick)
+			}
+			next := cfg.Nick + strings.Repeat("_", nickRetries)
+			c.setNick(next)
+			if err := c.writeLine("NICK " + next); err != nil {
+				return err
+			}
+		case "464":
+			return errors.New("irc server password rejected")
+		case "465":
+			return errors.New("irc server banned this connection")
+		case "ERROR":
+			return fmt.Errorf("irc server error: %s", msg.Param(0))
+		case "001":
+			if nick := strings.TrimSpace(msg.Param(0)); nick != "" {
+				c.setNick(nick)
+			}
+			return nil
+		}
+	}
+}
+
+func (c *ircClient) currentNick() string {
+	c.nickMu.RLock()
+	defer c.nickMu.RUnlock()
+	return c.nick
+}
+
+func (c *ircClient) setNick(nick string) {
+	c.nickMu.Lock()
+	c.nick = nick
+	c.nickMu.Unlock()
+}
+
+func (c *ircClient) readMessage() (ircMessage, error) {
+	for {
+		line, err := c.reader.ReadString('\n')
+		if err != nil {
+			return ircMessage{}, err
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		msg, err := parseIRCMessage(line)
+		if err != nil {
+			continue
+		}
+		return msg, nil
+	}
+}
+
+func (c *ircClient) writeLine(line string) error {
+	if strings.ContainsAny(line, "\r\n") {
+		return errors.New("irc line must not contain line breaks")
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(ircWriteTimeout))
+	_, err := c.conn.Write([]byte(line + "\r\n"))
+	return err
+}
+
+func (c *ircClient) join(channels []string) error {
+	for _, name := range channels {
+		if err := c.writeLine("JOIN " + name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ircClient) privmsg(target, text string) error {
+	return c.writeLine("PRIVMSG " + target + " :" + text)
+}
+
+func (c *ircClient) quit(reason string) {
+	_ = c.writeLine("QUIT :" + reason)
+	_ = c.conn.Close()
+}
+
+func (c *ircClient) close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,265 @@
+package irc
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/memohai/memoh/internal/channel"
+)
+
+const (
+	ircDefaultTLSPort   = "6697"
+	ircDefaultPlainPort = "6667"
+)
+
+// Config holds the IRC network credentials extracted from a channel configuration.
+type Config struct {
+	Server             string // host:port of the IRC server
+	TLS                bool
+	InsecureSkipVerify bool
+	Nick               string
+	Username           string
+	Realname           string
+	Password           string //nolint:gosec // intentional: operator-supplied server password
+	SASLUsername       string
+	SASLPassword       string //nolint:gosec // intentional: operator-supplied SASL password
+	Channels           []string
+}
+
+// UserConfig holds the identifiers used to target an IRC user.
+type UserConfig struct {
+	Nick    string
+	Account string
+}
+
+func normalizeConfig(raw map[string]any) (map[string]any, error) {
+	cfg, err := parseConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{
+		"server":             cfg.Server,
+		"tls":                cfg.TLS,
+		"insecureSkipVerify": cfg.InsecureSkipVerify,
+		"nick":               cfg.Nick,
+		"username":           cfg.Username,
+		"realname":           cfg.Realname,
+		"channels":           strings.Join(cfg.Channels, ","),
+	}
+	if cfg.Password != "" {
+		out["password"] = cfg.Password
+	}
+	if cfg.SASLPassword != "" {
+		out["saslUsername"] = cfg.SASLUsername
+		out["saslPassword"] = cfg.SASLPassword
+	}
+	return out, nil
+}
+
+func normalizeUserConfig(raw map[string]any) (map[string]any, error) {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{"nick": cfg.Nick}
+	if cfg.Account != "" {
+		out["account"] = cfg.Account
+	}
+	return out, nil
+}
+
+func resolveTarget(raw map[string]any) (string, error) {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return "", err
+	}
+	return cfg.Nick, nil
+}
+
+func normalizeTarget(raw string) string {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return ""
+	}
+	if strings.HasPrefix(strings.ToLower(value), "irc:") {
+		value = strings.TrimSpace(value[len("irc:"):])
+	}
+	if strings.ContainsAny(value, " \r\n,") {
+		return ""
+	}
+	return value
+}
+
+func matchBinding(raw map[string]any, criteria channel.BindingCriteria) bool {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return false
+	}
+	if account := criteria.Attribute("account"); account != "" && cfg.Account != "" {
+		return strings.EqualFold(account, cfg.Account)
+	}
+	if nick := criteria.Attribute("nick"); nick != "" && strings.EqualFold(nick, cfg.Nick) {
+		return true
+	}
+	return criteria.SubjectID != "" && strings.EqualFold(criteria.SubjectID, cfg.Nick)
+}
+
+func buildUserConfig(identity channel.Identity) map[string]any {
+	nick := identity.Attribute("nick")
+	if nick == "" {
+		nick = strings.TrimSpace(identity.SubjectID)
+	}
+	if nick == "" {
+		return map[string]any{}
+	}
+	out := map[string]any{"nick": nick}
+	if account := identity.Attribute("account"); account != "" {
+		out["account"] = account
+	}
+	return out
+}
+
+func parseConfig(raw map[string]any) (Config, error) {
+	useTLS := readBool(raw, true, "tls", "useTLS", "use_tls")
+	server, err := normalizeServer(channel.ReadString(raw, "server", "host"), useTLS)
+	if err != nil {
+		return Config{}, err
+	}
+	nick := strings.TrimSpace(channel.ReadString(raw, "nick", "nickname"))
+	if nick == "" {
+		return Config{}, errors.New("irc nick is required")
+	}
+	if strings.ContainsAny(nick, " ,:!@#\r\n") {
+		return Config{}, errors.New("irc nick contains invalid characters")
+	}
+	username := strings.TrimSpace(channel.ReadString(raw, "username", "user"))
+	if username == "" {
+		username = nick
+	}
+	realname := strings.TrimSpace(channel.ReadString(raw, "realname", "real_name"))
+	if realname == "" {
+		realname = "Memoh"
+	}
+	saslPassword := strings.TrimSpace(channel.ReadString(raw, "saslPassword", "sasl_password"))
+	saslUsername := strings.TrimSpace(channel.ReadString(raw, "saslUsername", "sasl_username"))
+	if saslPassword != "" && saslUsername == "" {
+		saslUsername = nick
+	}
+	return Config{
+		Server:             server,
+		TLS:                useTLS,
+		InsecureSkipVerify: readBool(raw, false, "insecureSkipVerify", "insecure_skip_verify"),
+		Nick:               nick,
+		Username:           username,
+		Realname:           realname,
+		Password:           strings.TrimSpace(channel.ReadString(raw, "password", "serverPassword", "server_password")),
+		SASLUsername:       saslUsername,
+		SASLPassword:       saslPassword,
+		Channels:           parseChannels(raw["channels"]),
+	}, nil
+}
+
+func parseUserConfig(raw map[string]any) (UserConfig, error) {
+	nick := normalizeTarget(channel.ReadString(raw, "nick", "nickname"))
+	if nick == "" {
+		return UserConfig{}, errors.New("irc user config requires nick")
+	}
+	if isChannelName(nick) {
+		return UserConfig{}, errors.New("irc nick must not be a channel name")
+	}
+	return UserConfig{
+		Nick:    nick,
+		Account: strings.TrimSpace(channel.ReadString(raw, "account")),
+	}, nil
+}
+
+func normalizeServer(raw string, useTLS bool) (string, error) {
+	value := strings.TrimSpace(raw)
+	for _, prefix := range []string{"ircs://", "irc://"} {
+		if strings.HasPrefix(strings.ToLower(value), prefix) {
+			value = value[len(prefix):]
+			break
+		}
+	}
+	value = strings.TrimRight(value, "/")
+	if value == "" {
+		return "", errors.New("irc server is required")
+	}
+	if _, _, err := net.SplitHostPort(value); err == nil {
+		return value, nil
+	}
+	port := ircDefaultPlainPort
+	if useTLS {
+		port = ircDefaultTLSPort
+	}
+	return net.JoinHostPort(value, port), nil
+}
+
+func parseChannels(raw any) []string {
+	var items []string
+	switch v := raw.(type) {
+	case string:
+		items = strings.FieldsFunc(v, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\n'
+		})
+	case []string:
+		items = v
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				items = append(items, s)
+			}
+		}
+	}
+	seen := make(map[string]struct{}, len(items))
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		name := strings.TrimSpace(item)
+		if name == "" {
+			continue
+		}
+		if !isChannelName(name) {
+			name = "#" + name
+		}
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, name)
+	}
+	return out
+}
+
+// isChannelName reports whether target uses one of the RFC 2812 channel prefixes.
+func isChannelName(target string) bool {
+	if target == "" {
+		return false
+	}
+	switch target[0] {
+	case '#', '&', '+', '!':
+		return true
+	default:
+		return false
+	}
+}
+
+func readBool(raw map[string]any, fallback bool, keys ...string) bool {
+	for _, key := range keys {
+		value, ok := raw[key]
+		if !ok {
+			continue
+		}
+		switch v := value.(type) {
+		case bool:
+			return v
+		case string:
+			if parsed, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return parsed
+			}
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,65 @@
+package irc
+
+import (
+	"testing"
+
+	"github.com/memohai/memoh/internal/channel"
+)
+
+func TestParseConfigDefaults(t *testing.T) {
+	cfg, err := parseConfig(map[string]any{
+		"server":   "irc.example.com",
+		"nick":     "memoh",
+		"channels": "ops, #dev,#ops",
+	})
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	if cfg.Server != "irc.example.com:6697" || !cfg.TLS {
+		t.Fatalf("unexpected server defaults: %+v", cfg)
+	}
+	if cfg.Username != "memoh" {
+		t.Fatalf("expected username to default to nick, got %q", cfg.Username)
+	}
+	if len(cfg.Channels) != 2 || cfg.Channels[0] != "#ops" || cfg.Channels[1] != "#dev" {
+		t.Fatalf("unexpected channels: %v", cfg.Channels)
+	}
+}
+
+func TestParseConfigPlainPort(t *testing.T) {
+	cfg, err := parseConfig(map[string]any{"server": "irc://irc.example.com", "tls": "false", "nick": "memoh"})
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	if cfg.Server != "irc.example.com:6667" || cfg.TLS {
+		t.Fatalf("unexpected server: %+v", cfg)
+	}
+}
+
+func TestParseConfigRejectsInvalidNick(t *testing.T) {
+	if _, err := parseConfig(map[string]any{"server": "irc.example.com", "nick": "bad nick"}); err == nil {
+		t.Fatal("expected invalid nick error")
+	}
+}
+
+func TestMatchBindingPrefersAccount(t *testing.T) {
+	binding := map[string]any{"nick": "alice", "account": "alice-acct"}
+	if !matchBinding(binding, channel.BindingCriteria{SubjectID: "someone", Attributes: map[string]string{"account": "alice-acct"}}) {
+		t.Fatal("expected account match")
+	}
+	if matchBinding(binding, channel.BindingCriteria{SubjectID: "alice", Attributes: map[string]string{"account": "mallory"}}) {
+		t.Fatal("expected account mismatch to win over nick")
+	}
+	if !matchBinding(map[string]any{"nick": "alice"}, channel.BindingCriteria{SubjectID: "ALICE"}) {
+		t.Fatal("expected case-insensitive nick match")
+	}
+}
+
+func TestNormalizeTarget(t *testing.T) {
+	if got := normalizeTarget("irc:#ops"); got != "#ops" {
+		t.Fatalf("unexpected target: %q", got)
+	}
+	if got := normalizeTarget("#ops,#dev"); got != "" {
+		t.Fatalf("expected multi-target to be rejected, got %q", got)
+	}
+}
@@ -0,0 +1,7 @@
+// Package irc implements the IRC channel adapter.
+package irc
+
+import "github.com/memohai/memoh/internal/channel"
+
+// Type is the registered ChannelType identifier for IRC.
+const Type channel.ChannelType = "irc"
@@ -0,0 +1,674 @@
+package irc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/channel/common"
+)
+
+const (
+	// ircMaxMessageBytes keeps PRIVMSG lines well below the 512-byte protocol
+	// limit once the server prepends our nick!user@host prefix.
+	ircMaxMessageBytes   = 400
+	ircMaxLinesPerSend   = 40
+	ircReconnectDelay    = 5 * time.Second
+	ircMaxReconnectDelay = 2 * time.Minute
+	ircPingInterval      = 90 * time.Second
+	ircSendInterval      = 300 * time.Millisecond
+)
+
+// IRCAdapter implements the channel.Adapter interfaces for IRC networks.
+type IRCAdapter struct {
+	logger *slog.Logger
+
+	mu       sync.RWMutex
+	sessions map[string]*ircClient // keyed by config ID
+}
+
+// NewIRCAdapter creates an IRCAdapter with the given logger.
+func NewIRCAdapter(log *slog.Logger) *IRCAdapter {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &IRCAdapter{
+		logger:   log.With(slog.String("adapter", "irc")),
+		sessions: make(map[string]*ircClient),
+	}
+}
+
+// Type returns the IRC channel type.
+func (*IRCAdapter) Type() channel.ChannelType {
+	return Type
+}
+
+// Descriptor returns the IRC channel metadata.
+func (*IRCAdapter) Descriptor() channel.Descriptor {
+	return channel.Descriptor{
+		Type:        Type,
+		DisplayName: "IRC",
+		Capabilities: channel.ChannelCapabilities{
+			Text:           true,
+			BlockStreaming: true,
+			ChatTypes:      []string{channel.ConversationTypePrivate, channel.ConversationTypeGroup},
+		},
+		OutboundPolicy: channel.OutboundPolicy{
+			TextChunkLimit: ircMaxMessageBytes * ircMaxLinesPerSend / 2,
+			ChunkerMode:    channel.ChunkerModeText,
+		},
+		ConfigSchema: channel.ConfigSchema{
+			Version: 1,
+			Fields: map[string]channel.FieldSchema{
+				"server": {
+					Type:        channel.FieldString,
+					Required:    true,
+					Title:       "Server",
+					Description: "IRC server host, optionally with port (defaults to 6697 with TLS, 6667 without)",
+					Example:     "irc.example.com:6697",
+				},
+				"tls": {
+					Type:  channel.FieldBool,
+					Title: "Use TLS",
+				},
+				"insecureSkipVerify": {
+					Type:        channel.FieldBool,
+					Title:       "Skip TLS Verification",
+					Description: "Accept self-signed server certificates",
+				},
+				"nick": {
+					Type:     channel.FieldString,
+					Required: true,
+					Title:    "Nickname",
+					Example:  "memoh",
+				},
+				"username": {
+					Type:  channel.FieldString,
+					Title: "Username",
+				},
+				"realname": {
+					Type:  channel.FieldString,
+					Title: "Real Name",
+				},
+				"password": {
+					Type:  channel.FieldSecret,
+					Title: "Server Password",
+				},
+				"saslUsername": {
+					Type:        channel.FieldString,
+					Title:       "SASL Username",
+					Description: "Account name for SASL PLAIN; defaults to the nickname",
+				},
+				"saslPassword": {
+					Type:  channel.FieldSecret,
+					Title: "SASL Password",
+				},
+				"channels": {
+					Type:        channel.FieldString,
+					Title:       "Channels",
+					Description: "Comma-separated channels to join on connect",
+					Example:     "#ops,#dev",
+				},
+			},
+		},
+		UserConfigSchema: channel.ConfigSchema{
+			Version: 1,
+			Fields: map[string]channel.FieldSchema{
+				"nick":    {Type: channel.FieldString, Required: true, Title: "Nickname"},
+				"account": {Type: channel.FieldString, Title: "Services Account"},
+			},
+		},
+		TargetSpec: channel.TargetSpec{
+			Format: "#channel | nick",
+			Hints: []channel.TargetHint{
+				{Label: "Channel", Example: "#ops"},
+				{Label: "Nickname", Example: "alice"},
+			},
+		},
+	}
+}
+
+// --- ConfigNormalizer ---
+
+// NormalizeConfig validates and normalizes an IRC channel configuration map.
+func (*IRCAdapter) NormalizeConfig(raw map[string]any) (map[string]any, error) {
+	return normalizeConfig(raw)
+}
+
+// NormalizeUserConfig validates and normalizes an IRC user-binding configuration map.
+func (*IRCAdapter) NormalizeUserConfig(raw map[string]any) (map[string]any, error) {
+	return normalizeUserConfig(raw)
+}
+
+// --- TargetResolver ---
+
+// NormalizeTarget normalizes an IRC delivery target string.
+func (*IRCAdapter) NormalizeTarget(raw string) string {
+	return normalizeTarget(raw)
+}
+
+// ResolveTarget derives a delivery target from an IRC user-binding configuration.
+func (*IRCAdapter) ResolveTarget(userConfig map[string]any) (string, error) {
+	return resolveTarget(userConfig)
+}
+
+// --- BindingMatcher ---
+
+// MatchBinding reports whether an IRC user binding matches the given criteria.
+func (*IRCAdapter) MatchBinding(config map[string]any, criteria channel.BindingCriteria) bool {
+	return matchBinding(config, criteria)
+}
+
+// BuildUserConfig constructs an IRC user-binding config from an Identity.
+func (*IRCAdapter) BuildUserConfig(identity channel.Identity) map[string]any {
+	return buildUserConfig(identity)
+}
+
+// --- SelfDiscoverer ---
+
+// DiscoverSelf registers with the IRC server once to validate the credentials
+// and report the nickname the server actually assigned.
+func (*IRCAdapter) DiscoverSelf(ctx context.Context, credentials map[string]any) (map[string]any, string, error) {
+	cfg, err := parseConfig(credentials)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := dialIRC(ctx, cfg)
+	if err != nil {
+		return nil, "", fmt.Errorf("irc discover self: %w", err)
+	}
+	defer client.quit("identity check")
+	if err := client.register(ctx, cfg); err != nil {
+		return nil, "", fmt.Errorf("irc discover self: %w", err)
+	}
+	nick := client.currentNick()
+	identity := map[string]any{
+		"nick":   nick,
+		"server": cfg.Server,
+	}
+	if cfg.SASLUsername != "" {
+		identity["account"] = cfg.SASLUsername
+	}
+	return identity, nick, nil
+}
+
+// --- Receiver ---
+
+// Connect registers with the IRC server, joins the configured channels and
+// keeps the session alive, reconnecting with backoff when it drops.
+func (a *IRCAdapter) Connect(ctx context.Context, cfg channel.ChannelConfig, handler channel.InboundHandler) (channel.Connection, error) {
+	if a.logger != nil {
+		a.logger.Info("start", slog.String("config_id", cfg.ID))
+	}
+	parsed, err := parseConfig(cfg.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	channel.SetIMErrorSecrets("irc:"+cfg.ID, parsed.Password, parsed.SASLPassword)
+
+	client, err := a.openSession(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+	connCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	conn := channel.NewConnection(cfg, func(context.Context) error {
+		if a.logger != nil {
+			a.logger.Info("stop", slog.String("config_id", cfg.ID))
+		}
+		cancel()
+		<-done
+		return nil
+	})
+	go func() {
+		defer close(done)
+		a.runSessionLoop(connCtx, cfg, parsed, client, handler)
+	}()
+	return conn, nil
+}
+
+func (a *IRCAdapter) openSession(ctx context.Context, cfg Config) (*ircClient, error) {
+	client, err := dialIRC(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.register(ctx, cfg); err != nil {
+		_ = client.close()
+		return nil, err
+	}
+	if err := client.join(cfg.Channels); err != nil {
+		_ = client.close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func (a *IRCAdapter) runSessionLoop(ctx context.Context, cfg channel.ChannelConfig, parsed Config, client *ircClient, handler channel.InboundHandler) {
+	delay := ircReconnectDelay
+	for {
+		a.setSession(cfg.ID, client)
+		err := a.serve(ctx, cfg, client, handler)
+		a.clearSession(cfg.ID, client)
+		if ctx.Err() != nil {
+			client.quit("shutting down")
+			return
+		}
+		_ = client.close()
+		if a.logger != nil {
+			a.logger.Warn("session disconnected", slog.String("config_id", cfg.ID), slog.Any("error", err))
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			next, err := a.openSession(ctx, parsed)
+			if err == nil {
+				client = next
+				delay = ircReconnectDelay
+				break
+			}
+			if a.logger != nil {
+				a.logger.Warn("reconnect failed", slog.String("config_id", cfg.ID), slog.Any("error", err))
+			}
+			delay = min(delay*2, ircMaxReconnectDelay)
+		}
+	}
+}
+
+func (a *IRCAdapter) serve(ctx context.Context, cfg channel.ChannelConfig, client *ircClient, handler channel.InboundHandler) error {
+	stopPing := make(chan struct{})
+	defer close(stopPing)
+	go func() {
+		ticker := time.NewTicker(ircPingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopPing:
+				return
+			case <-ticker.C:
+				_ = client.writeLine("PING :memoh")
+			}
+		}
+	}()
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = client.writeLine("QUIT :shutting down")
+			_ = client.close()
+		case <-stopPing:
+		}
+	}()
+
+	for {
+		msg, err := client.readMessage()
+		if err != nil {
+			return err
+		}
+		switch msg.Command {
+		case "PING":
+			if err := client.writeLine("PONG :" + msg.Param(0)); err != nil {
+				return err
+			}
+		case "NICK":
+			if strings.EqualFold(msg.Nick(), client.currentNick()) {
+				client.setNick(msg.Param(0))
+			}
+		case "ERROR":
+			return fmt.Errorf("irc server error: %s", msg.Param(0))
+		case "PRIVMSG":
+			inbound, ok := buildInboundMessage(client.currentNick(), msg)
+			if !ok {
+				continue
+			}
+			a.logInbound(cfg.ID, inbound)
+			go func() {
+				if err := handler(ctx, cfg, inbound); err != nil && a.logger != nil {
+					a.logger.Error("handle inbound failed", slog.String("config_id", cfg.ID), slog.Any("error", err))
+				}
+			}()
+		}
+	}
+}
+
+func (a *IRCAdapter) setSession(configID string, client *ircClient) {
+	a.mu.Lock()
+	a.sessions[configID] = client
+	a.mu.Unlock()
+}
+
+func (a *IRCAdapter) clearSession(configID string, client *ircClient) {
+	a.mu.Lock()
+	if a.sessions[configID] == client {
+		delete(a.sessions, configID)
+	}
+	a.mu.Unlock()
+}
+
+func (a *IRCAdapter) session(configID string) (*ircClient, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	client, ok := a.sessions[configID]
+	return client, ok
+}
+
+func buildInboundMessage(selfNick string, msg ircMessage) (channel.InboundMessage, bool) {
+	nick, user, host := splitPrefix(msg.Prefix)
+	if nick == "" || strings.EqualFold(nick, selfNick) {
+		return channel.InboundMessage{}, false
+	}
+	target := msg.Param(0)
+	text := msg.Param(1)
+	if action, ok := ctcpAction(text); ok {
+		text = "* " + nick + " " + action
+	} else if strings.HasPrefix(text, "\x01") {
+		// Other CTCP requests (VERSION, PING, ...) are not conversational.
+		return channel.InboundMessage{}, false
+	}
+	text = stripFormatting(text)
+
+	convType := channel.ConversationTypeGroup
+	convID := target
+	replyTarget := target
+	isMentioned := false
+	if !isChannelName(target) {
+		convType = channel.ConversationTypePrivate
+		convID = nick
+		replyTarget = nick
+		isMentioned = true
+	} else {
+		var addressed bool
+		text, addressed = stripAddressing(text, selfNick)
+		isMentioned = addressed || containsNick(text, selfNick)
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return channel.InboundMessage{}, false
+	}
+
+	attrs := map[string]string{"nick": nick}
+	if user != "" {
+		attrs["user"] = user
+	}
+	if host != "" {
+		attrs["host"] = host
+	}
+	if account := strings.TrimSpace(msg.Tags["account"]); account != "" {
+		attrs["account"] = account
+	}
+	receivedAt := time.Now().UTC()
+	if ts := strings.TrimSpace(msg.Tags["time"]); ts != "" {
+		if parsed, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			receivedAt = parsed.UTC()
+		}
+	}
+	return channel.InboundMessage{
+		Channel: Type,
+		Message: channel.Message{
+			ID:     strings.TrimSpace(msg.Tags["msgid"]),
+			Format: channel.MessageFormatPlain,
+			Text:   text,
+		},
+		ReplyTarget: replyTarget,
+		Sender: channel.Identity{
+			SubjectID:   nick,
+			DisplayName: nick,
+			Attributes:  attrs,
+		},
+		Conversation: channel.Conversation{
+			ID:   convID,
+			Type: convType,
+			Name: convID,
+		},
+		ReceivedAt: receivedAt,
+		Source:     "irc",
+		Metadata: map[string]any{
+			"is_mentioned": isMentioned,
+		},
+	}, true
+}
+
+func ctcpAction(text string) (string, bool) {
+	if !strings.HasPrefix(text, "\x01ACTION ") {
+		return "", false
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(text, "\x01ACTION "), "\x01"), true
+}
+
+// stripAddressing removes a leading "nick:" or "nick," addressing prefix.
+func stripAddressing(text, nick string) (string, bool) {
+	trimmed := strings.TrimSpace(text)
+	if nick == "" || len(trimmed) <= len(nick) || !strings.EqualFold(trimmed[:len(nick)], nick) {
+		return text, false
+	}
+	switch trimmed[len(nick)] {
+	case ':', ',':
+		return trimmed[len(nick)+1:], true
+	default:
+		return text, false
+	}
+}
+
+func containsNick(text, nick string) bool {
+	if nick == "" {
+		return false
+	}
+	lower := strings.ToLower(text)
+	needle := strings.ToLower(nick)
+	for offset := 0; ; {
+		idx := strings.Index(lower[offset:], needle)
+		if idx < 0 {
+			return false
+		}
+		start := offset + idx
+		end := start + len(needle)
+		before, _ := utf8.DecodeLastRuneInString(lower[:start])
+		after, _ := utf8.DecodeRuneInString(lower[end:])
+		if (start == 0 || !isNickRune(before)) && (end == len(lower) || !isNickRune(after)) {
+			return true
+		}
+		offset = end
+	}
+}
+
+func isNickRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-_[]\\`^{}|", r)
+}
+
+// stripFormatting drops mIRC color/formatting control codes.
+func stripFormatting(text string) string {
+	if !strings.ContainsAny(text, "\x02\x03\x0f\x11\x16\x1d\x1e\x1f") {
+		return text
+	}
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\x02', '\x0f', '\x11', '\x16', '\x1d', '\x1e', '\x1f':
+			continue
+		case '\x03':
+			digits := 0
+			for i+1 < len(text) && digits < 2 && text[i+1] >= '0' && text[i+1] <= '9' {
+				i++
+				digits++
+			}
+			if digits > 0 && i+2 < len(text) && text[i+1] == ',' && text[i+2] >= '0' && text[i+2] <= '9' {
+				i += 2
+				if i+1 < len(text) && text[i+1] >= '0' && text[i+1] <= '9' {
+					i++
+				}
+			}
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+	return b.String()
+}
+
+func (a *IRCAdapter) logInbound(configID string, msg channel.InboundMessage) {
+	if a.logger == nil {
+		return
+	}
+	a.logger.Info("inbound received",
+		slog.String("config_id", configID),
+		slog.String("conversation", msg.Conversation.ID),
+		slog.String("nick", msg.Sender.Attribute("nick")),
+		slog.String("text", common.SummarizeText(msg.Message.Text)),
+	)
+}
+
+// --- Sender ---
+
+// Send delivers an outbound message as one or more PRIVMSG lines over the
+// config's active session. Attachments are sent as their URLs when available.
+func (a *IRCAdapter) Send(ctx context.Context, cfg channel.ChannelConfig, msg channel.PreparedOutboundMessage) error {
+	target := normalizeTarget(msg.Target)
+	if target == "" {
+		return errors.New("irc target is required")
+	}
+	client, ok := a.session(cfg.ID)
+	if !ok {
+		return errors.New("irc connection is not running")
+	}
+	lines := splitMessageLines(outboundText(msg.Message), ircMaxMessageBytes)
+	if len(lines) == 0 {
+		return errors.New("message is required")
+	}
+	if len(lines) > ircMaxLinesPerSend {
+		lines = append(lines[:ircMaxLinesPerSend-1], "[truncated]")
+	}
+	for i, line := range lines {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(ircSendInterval):
+			}
+		}
+		if err := client.privmsg(target, line); err != nil {
+			if a.logger != nil {
+				a.logger.Error("send failed", slog.String("config_id", cfg.ID), slog.Any("error", err))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func outboundText(msg channel.PreparedMessage) string {
+	parts := make([]string, 0, 1+len(msg.Attachments))
+	if text := strings.TrimSpace(msg.Message.PlainText()); text != "" {
+		parts = append(parts, text)
+	}
+	for _, att := range msg.Attachments {
+		ref := strings.TrimSpace(att.PublicURL)
+		if ref == "" {
+			ref = strings.TrimSpace(att.Logical.URL)
+		}
+		if ref == "" || strings.HasPrefix(ref, "data:") {
+			continue
+		}
+		parts = append(parts, ref)
+	}
+	return strings.Join(parts, "\n")
+}
+
+// splitMessageLines splits text on line breaks and then wraps each line so it
+// fits in limit bytes, preferring whitespace and never splitting a rune.
+func splitMessageLines(text string, limit int) []string {
+	var out []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		for len(line) > limit {
+			cut := limit
+			for cut > 0 && !utf8.RuneStart(line[cut]) {
+				cut--
+			}
+			if space := strings.LastIndexByte(line[:cut], ' '); space > limit/2 {
+				cut = space
+			}
+			out = append(out, line[:cut])
+			line = strings.TrimLeft(line[cut:], " ")
+		}
+		if line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+// --- StreamSender (block-streaming: buffer deltas, send final once) ---
+
+// OpenStream opens a block-streaming session that buffers deltas and sends
+// the final message when the stream is closed.
+func (a *IRCAdapter) OpenStream(_ context.Context, cfg channel.ChannelConfig, target string, _ channel.StreamOptions) (channel.PreparedOutboundStream, error) {
+	target = normalizeTarget(target)
+	if target == "" {
+		return nil, errors.New("irc target is required")
+	}
+	return &ircBlockStream{adapter: a, cfg: cfg, target: target}, nil
+}
+
+type ircBlockStream struct {
+	adapter     *IRCAdapter
+	cfg         channel.ChannelConfig
+	target      string
+	textBuilder strings.Builder
+	attachments []channel.PreparedAttachment
+	final       *channel.PreparedMessage
+	closed      bool
+}
+
+func (s *ircBlockStream) Push(_ context.Context, event channel.PreparedStreamEvent) error {
+	if s.closed {
+		return nil
+	}
+	switch event.Type {
+	case channel.StreamEventDelta:
+		if event.Delta != "" && event.Phase != channel.StreamPhaseReasoning {
+			s.textBuilder.WriteString(event.Delta)
+		}
+	case channel.StreamEventAttachment:
+		s.attachments = append(s.attachments, event.Attachments...)
+	case channel.StreamEventFinal:
+		if event.Final != nil {
+			msg := event.Final.Message
+			s.final = &msg
+		}
+	}
+	return nil
+}
+
+func (s *ircBlockStream) Close(ctx context.Context) error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	msg := channel.PreparedMessage{Message: channel.Message{Format: channel.MessageFormatPlain}}
+	if s.final != nil {
+		msg = *s.final
+	}
+	if strings.TrimSpace(msg.Message.PlainText()) == "" {
+		msg.Message.Text = strings.TrimSpace(s.textBuilder.String())
+	}
+	if len(msg.Attachments) == 0 {
+		msg.Attachments = s.attachments
+	}
+	if strings.TrimSpace(outboundText(msg)) == "" {
+		return nil
+	}
+	return s.adapter.Send(ctx, s.cfg, channel.PreparedOutboundMessage{
+		Target:  s.target,
+		Message: msg,
+	})
+}
@@ -0,0 +1,174 @@
+package irc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/channel/channeltest"
+)
+
+func newTestServer(t *testing.T) *channeltest.IRCServer {
+	t.Helper()
+	srv, err := channeltest.NewIRCServer()
+	if err != nil {
+		t.Fatalf("start fake irc server: %v", err)
+	}
+	t.Cleanup(func() { _ = srv.Close() })
+	return srv
+}
+
+func testConfig(srv *channeltest.IRCServer) channel.ChannelConfig {
+	return channel.ChannelConfig{
+		ID:          "cfg-1",
+		BotID:       "bot-1",
+		ChannelType: Type,
+		Credentials: map[string]any{
+			"server":   srv.Addr(),
+			"tls":      false,
+			"nick":     "memoh",
+			"channels": "#ops",
+		},
+	}
+}
+
+func TestParseIRCMessage(t *testing.T) {
+	msg, err := parseIRCMessage("@msgid=abc;account=alice;time=2024-01-02T03:04:05.000Z :alice!a@host PRIVMSG #ops :hello there\r\n")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if msg.Command != "PRIVMSG" || msg.Param(0) != "#ops" || msg.Param(1) != "hello there" {
+		t.Fatalf("unexpected message: %+v", msg)
+	}
+	if msg.Nick() != "alice" || msg.Tags["msgid"] != "abc" || msg.Tags["account"] != "alice" {
+		t.Fatalf("unexpected prefix/tags: %+v", msg)
+	}
+}
+
+func TestBuildInboundMessageChannelMention(t *testing.T) {
+	msg, _ := parseIRCMessage(":alice!a@host PRIVMSG #ops :memoh: \x02deploy\x02 status?")
+	inbound, ok := buildInboundMessage("memoh", msg)
+	if !ok {
+		t.Fatal("expected inbound message")
+	}
+	if inbound.Message.Text != "deploy status?" {
+		t.Fatalf("unexpected text: %q", inbound.Message.Text)
+	}
+	if inbound.Conversation.Type != channel.ConversationTypeGroup || inbound.ReplyTarget != "#ops" {
+		t.Fatalf("unexpected conversation: %+v target=%q", inbound.Conversation, inbound.ReplyTarget)
+	}
+	if mentioned, _ := inbound.Metadata["is_mentioned"].(bool); !mentioned {
+		t.Fatal("expected addressed message to be a mention")
+	}
+}
+
+func TestBuildInboundMessageIgnoresSelfAndCTCP(t *testing.T) {
+	self, _ := parseIRCMessage(":memoh!m@host PRIVMSG #ops :echo")
+	if _, ok := buildInboundMessage("memoh", self); ok {
+		t.Fatal("expected own message to be ignored")
+	}
+	ctcp, _ := parseIRCMessage(":alice!a@host PRIVMSG memoh :\x01VERSION\x01")
+	if _, ok := buildInboundMessage("memoh", ctcp); ok {
+		t.Fatal("expected CTCP request to be ignored")
+	}
+}
+
+func TestContainsNickRequiresWordBoundary(t *testing.T) {
+	if containsNick("ask memohbot instead", "memoh") {
+		t.Fatal("expected substring not to count as mention")
+	}
+	if !containsNick("hey Memoh, ping", "memoh") {
+		t.Fatal("expected case-insensitive mention")
+	}
+}
+
+func TestSplitMessageLines(t *testing.T) {
+	long := strings.Repeat("word ", 30)
+	lines := splitMessageLines("first\n\n"+long, 40)
+	if lines[0] != "first" {
+		t.Fatalf("unexpected first line: %q", lines[0])
+	}
+	for _, line := range lines {
+		if len(line) > 40 {
+			t.Fatalf("line exceeds limit: %q", line)
+		}
+	}
+	if got := strings.Join(lines[1:], " "); got != strings.TrimSpace(long) {
+		t.Fatalf("wrapped text mismatch: %q", got)
+	}
+}
+
+func TestConnectReceivesAndReplies(t *testing.T) {
+	srv := newTestServer(t)
+	adapter := NewIRCAdapter(nil)
+	cfg := testConfig(srv)
+
+	received := make(chan channel.InboundMessage, 1)
+	conn, err := adapter.Connect(context.Background(), cfg, func(_ context.Context, _ channel.ChannelConfig, msg channel.InboundMessage) error {
+		received <- msg
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Stop(context.Background()) })
+
+	if _, err := srv.WaitForLine("JOIN #ops", 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	srv.Broadcast("@msgid=m1 :alice!a@host PRIVMSG #ops :memoh, hello")
+
+	select {
+	case msg := <-received:
+		if msg.Message.ID != "m1" || msg.Message.Text != "hello" || msg.Sender.SubjectID != "alice" {
+			t.Fatalf("unexpected inbound: %+v", msg)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for inbound message")
+	}
+
+	err = adapter.Send(context.Background(), cfg, channel.PreparedOutboundMessage{
+		Target:  "#ops",
+		Message: channel.PreparedMessage{Message: channel.Message{Text: "line one\nline two"}},
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if _, err := srv.WaitForLine("PRIVMSG #ops :line two", 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSendWithoutSessionFails(t *testing.T) {
+	adapter := NewIRCAdapter(nil)
+	err := adapter.Send(context.Background(), channel.ChannelConfig{ID: "missing"}, channel.PreparedOutboundMessage{
+		Target:  "#ops",
+		Message: channel.PreparedMessage{Message: channel.Message{Text: "hi"}},
+	})
+	if err == nil {
+		t.Fatal("expected error without an active session")
+	}
+}
+
+func TestDiscoverSelfWithSASL(t *testing.T) {
+	srv := newTestServer(t)
+	srv.SASLPassword = "s3cret"
+	adapter := NewIRCAdapter(nil)
+
+	creds := testConfig(srv).Credentials
+	creds["saslPassword"] = "s3cret"
+	identity, externalID, err := adapter.DiscoverSelf(context.Background(), creds)
+	if err != nil {
+		t.Fatalf("discover self: %v", err)
+	}
+	if externalID != "memoh" || identity["account"] != "memoh" {
+		t.Fatalf("unexpected identity: %v %q", identity, externalID)
+	}
+
+	creds["saslPassword"] = "wrong"
+	if _, _, err := adapter.DiscoverSelf(context.Background(), creds); err == nil {
+		t.Fatal("expected sasl failure")
+	}
+}
@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/channel/common"
+)
+
+const (
+	defaultReplayWindow = 5 * time.Minute
+	maxReplayWindow     = 24 * time.Hour
+)
+
+// Config holds the webhook signing and delivery settings of a channel configuration.
+type Config struct {
+	Secret        string //nolint:gosec // intentional: shared HMAC secret supplied by the operator
+	CallbackURL   string
+	ReplayWindow  time.Duration
+	PayloadSchema string // optional JSON Schema applied on top of the built-in payload schema
+	HTTPProxy     common.HTTPProxyConfig
+}
+
+// UserConfig identifies a webhook sender for user bindings.
+type UserConfig struct {
+	SenderID string
+}
+
+func normalizeConfig(raw map[string]any) (map[string]any, error) {
+	cfg, err := parseConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{
+		"secret":              cfg.Secret,
+		"replayWindowSeconds": int(cfg.ReplayWindow / time.Second),
+	}
+	if cfg.CallbackURL != "" {
+		out["callbackUrl"] = cfg.CallbackURL
+	}
+	if cfg.PayloadSchema != "" {
+		out["payloadSchema"] = cfg.PayloadSchema
+	}
+	common.NormalizeHTTPProxyConfig(out, cfg.HTTPProxy)
+	return out, nil
+}
+
+func normalizeUserConfig(raw map[string]any) (map[string]any, error) {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"sender_id": cfg.SenderID}, nil
+}
+
+func resolveTarget(raw map[string]any) (string, error) {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return "", err
+	}
+	return cfg.SenderID, nil
+}
+
+func normalizeTarget(raw string) string {
+	value := strings.TrimSpace(raw)
+	if strings.HasPrefix(strings.ToLower(value), "webhook:") {
+		value = strings.TrimSpace(value[len("webhook:"):])
+	}
+	return value
+}
+
+func matchBinding(raw map[string]any, criteria channel.BindingCriteria) bool {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return false
+	}
+	if id := criteria.Attribute("sender_id"); id != "" {
+		return id == cfg.SenderID
+	}
+	return criteria.SubjectID != "" && criteria.SubjectID == cfg.SenderID
+}
+
+func buildUserConfig(identity channel.Identity) map[string]any {
+	id := identity.Attribute("sender_id")
+	if id == "" {
+		id = strings.TrimSpace(identity.SubjectID)
+	}
+	if id == "" {
+		return map[string]any{}
+	}
+	return map[string]any{"sender_id": id}
+}
+
+func parseConfig(raw map[string]any) (Config, error) {
+	secret := strings.TrimSpace(channel.ReadString(raw, "secret", "signingSecret", "signing_secret"))
+	if secret == "" {
+		return Config{}, errors.New("webhook secret is required")
+	}
+	if len(secret) < 16 {
+		return Config{}, errors.New("webhook secret must be at least 16 characters")
+	}
+	callbackURL := strings.TrimSpace(channel.ReadString(raw, "callbackUrl", "callback_url"))
+	if callbackURL != "" {
+		parsed, err := url.Parse(callbackURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return Config{}, errors.New("webhook callbackUrl must be an absolute http(s) URL")
+		}
+		callbackURL = parsed.String()
+	}
+	window, err := parseReplayWindow(raw)
+	if err != nil {
+		return Config{}, err
+	}
+	payloadSchema := strings.TrimSpace(channel.ReadString(raw, "payloadSchema", "payload_schema"))
+	if payloadSchema != "" {
+		if _, err := compileSchema(payloadSchema); err != nil {
+			return Config{}, err
+		}
+	}
+	proxyCfg, err := common.ParseHTTPProxyConfig(raw)
+	if err != nil {
+		return Config{}, err
+	}
+	return Config{
+		Secret:        secret,
+		CallbackURL:   callbackURL,
+		ReplayWindow:  window,
+		PayloadSchema: payloadSchema,
+		HTTPProxy:     proxyCfg,
+	}, nil
+}
+
+func parseReplayWindow(raw map[string]any) (time.Duration, error) {
+	var seconds float64
+	switch v := firstValue(raw, "replayWindowSeconds", "replay_window_seconds").(type) {
+	case nil:
+		return defaultReplayWindow, nil
+	case float64:
+		seconds = v
+	case int:
+		seconds = float64(v)
+	case int64:
+		seconds = float64(v)
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return defaultReplayWindow, nil
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, errors.New("webhook replayWindowSeconds must be a number")
+		}
+		seconds = parsed
+	default:
+		return 0, errors.New("webhook replayWindowSeconds must be a number")
+	}
+	if seconds <= 0 {
+		return defaultReplayWindow, nil
+	}
+	window := time.Duration(seconds) * time.Second
+	if window > maxReplayWindow {
+		return 0, errors.New("webhook replayWindowSeconds must not exceed 86400")
+	}
+	return window, nil
+}
+
+func parseUserConfig(raw map[string]any) (UserConfig, error) {
+	id := strings.TrimSpace(channel.ReadString(raw, "sender_id", "senderId", "user_id"))
+	if id == "" {
+		return UserConfig{}, errors.New("webhook user config requires sender_id")
+	}
+	return UserConfig{SenderID: id}, nil
+}
+
+func firstValue(raw map[string]any, keys ...string) any {
+	for _, key := range keys {
+		if value, ok := raw[key]; ok {
+			return value
+		}
+	}
+	return nil
+}
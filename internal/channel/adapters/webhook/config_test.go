@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef-secret"
+
+func TestParseConfigDefaults(t *testing.T) {
+	cfg, err := parseConfig(map[string]any{
+		"secret":      testSecret,
+		"callbackUrl": "https://hooks.example.com/memoh",
+	})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if cfg.ReplayWindow != defaultReplayWindow || cfg.CallbackURL != "https://hooks.example.com/memoh" {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestParseConfigValidation(t *testing.T) {
+	cases := []map[string]any{
+		{},
+		{"secret": "short"},
+		{"secret": testSecret, "callbackUrl": "ftp://example.com"},
+		{"secret": testSecret, "replayWindowSeconds": "abc"},
+		{"secret": testSecret, "replayWindowSeconds": float64(90000)},
+		{"secret": testSecret, "payloadSchema": "{not json"},
+	}
+	for _, raw := range cases {
+		if _, err := parseConfig(raw); err == nil {
+			t.Fatalf("expected error for %v", raw)
+		}
+	}
+}
+
+func TestParseConfigReplayWindow(t *testing.T) {
+	cfg, err := parseConfig(map[string]any{"secret": testSecret, "replayWindowSeconds": "60"})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if cfg.ReplayWindow != time.Minute {
+		t.Fatalf("unexpected replay window: %v", cfg.ReplayWindow)
+	}
+}
@@ -0,0 +1,10 @@
+// Package webhook implements a generic signed HTTP webhook channel adapter.
+// Inbound JSON events arrive on the per-config webhook URL and replies are
+// POSTed to a configured callback URL; both directions are signed with
+// HMAC-SHA256 over "<timestamp>.<body>".
+package webhook
+
+import "github.com/memohai/memoh/internal/channel"
+
+// Type is the registered ChannelType identifier for generic webhooks.
+const Type channel.ChannelType = "webhook"
@@ -0,0 +1,223 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	"github.com/memohai/memoh/internal/channel"
+)
+
+// defaultPayloadSchema is the JSON Schema every inbound event must satisfy.
+// It is exposed through the config schema so callers can see the contract.
+const defaultPayloadSchema = `{
+  "type": "object",
+  "required": ["text", "sender"],
+  "properties": {
+    "id": {"type": "string", "description": "Unique event ID; used for de-duplication"},
+    "text": {"type": "string", "minLength": 1},
+    "sender": {
+      "type": "object",
+      "required": ["id"],
+      "properties": {
+        "id": {"type": "string", "minLength": 1},
+        "name": {"type": "string"}
+      }
+    },
+    "conversation": {
+      "type": "object",
+      "properties": {
+        "id": {"type": "string"},
+        "type": {"enum": ["private", "group", "thread"]},
+        "name": {"type": "string"},
+        "thread_id": {"type": "string"}
+      }
+    },
+    "reply_to": {"type": "string"},
+    "attachments": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["url"],
+        "properties": {
+          "type": {"enum": ["image", "audio", "video", "voice", "file", "gif"]},
+          "url": {"type": "string"},
+          "name": {"type": "string"},
+          "mime": {"type": "string"}
+        }
+      }
+    },
+    "metadata": {"type": "object"}
+  }
+}`
+
+type inboundPayload struct {
+	ID     string `json:"id"`
+	Text   string `json:"text"`
+	Sender struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"sender"`
+	Conversation struct {
+		ID       string `json:"id"`
+		Type     string `json:"type"`
+		Name     string `json:"name"`
+		ThreadID string `json:"thread_id"`
+	} `json:"conversation"`
+	ReplyTo     string              `json:"reply_to"`
+	Attachments []inboundAttachment `json:"attachments"`
+	Metadata    map[string]any      `json:"metadata"`
+}
+
+type inboundAttachment struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+	Name string `json:"name"`
+	Mime string `json:"mime"`
+}
+
+// outboundPayload is the signed JSON body POSTed to the callback URL.
+type outboundPayload struct {
+	ID          string               `json:"id"`
+	Event       string               `json:"event"`
+	BotID       string               `json:"bot_id"`
+	ConfigID    string               `json:"config_id"`
+	Target      string               `json:"target"`
+	Text        string               `json:"text"`
+	Format      string               `json:"format,omitempty"`
+	ReplyTo     string               `json:"reply_to,omitempty"`
+	ThreadID    string               `json:"thread_id,omitempty"`
+	Attachments []outboundAttachment `json:"attachments,omitempty"`
+	SentAt      time.Time            `json:"sent_at"`
+}
+
+type outboundAttachment struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+	Name string `json:"name,omitempty"`
+	Mime string `json:"mime,omitempty"`
+}
+
+var (
+	schemaCacheMu sync.Mutex
+	schemaCache   = map[string]*jsonschema.Resolved{}
+)
+
+// compileSchema parses and resolves a JSON Schema document, caching the
+// result by source text.
+func compileSchema(source string) (*jsonschema.Resolved, error) {
+	schemaCacheMu.Lock()
+	defer schemaCacheMu.Unlock()
+	if resolved, ok := schemaCache[source]; ok {
+		return resolved, nil
+	}
+	var schema jsonschema.Schema
+	if err := json.Unmarshal([]byte(source), &schema); err != nil {
+		return nil, fmt.Errorf("webhook payloadSchema is not valid JSON Schema: %w", err)
+	}
+	resolved, err := schema.Resolve(nil)
+	if err != nil {
+		return nil, fmt.Errorf("webhook payloadSchema is not valid JSON Schema: %w", err)
+	}
+	schemaCache[source] = resolved
+	return resolved, nil
+}
+
+// decodePayload validates body against the built-in schema and the optional
+// per-config schema, then decodes it.
+func decodePayload(body []byte, extraSchema string) (inboundPayload, error) {
+	var instance any
+	if err := json.Unmarshal(body, &instance); err != nil {
+		return inboundPayload{}, errors.New("payload must be a JSON object")
+	}
+	for _, source := range []string{defaultPayloadSchema, extraSchema} {
+		if source == "" {
+			continue
+		}
+		resolved, err := compileSchema(source)
+		if err != nil {
+			return inboundPayload{}, err
+		}
+		if err := resolved.Validate(instance); err != nil {
+			return inboundPayload{}, fmt.Errorf("payload does not match schema: %w", err)
+		}
+	}
+	var payload inboundPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return inboundPayload{}, fmt.Errorf("decode payload: %w", err)
+	}
+	if strings.TrimSpace(payload.Text) == "" || strings.TrimSpace(payload.Sender.ID) == "" {
+		return inboundPayload{}, errors.New("payload text and sender.id are required")
+	}
+	return payload, nil
+}
+
+func buildInboundMessage(cfg channel.ChannelConfig, payload inboundPayload, eventID string, receivedAt time.Time) channel.InboundMessage {
+	senderID := strings.TrimSpace(payload.Sender.ID)
+	convID := strings.TrimSpace(payload.Conversation.ID)
+	if convID == "" {
+		convID = senderID
+	}
+	convType := strings.TrimSpace(payload.Conversation.Type)
+	if convType == "" {
+		convType = channel.ConversationTypePrivate
+	}
+	msg := channel.Message{
+		ID:       eventID,
+		Format:   channel.MessageFormatPlain,
+		Text:     strings.TrimSpace(payload.Text),
+		Metadata: payload.Metadata,
+	}
+	if replyTo := strings.TrimSpace(payload.ReplyTo); replyTo != "" {
+		msg.Reply = &channel.ReplyRef{Target: convID, MessageID: replyTo}
+	}
+	threadID := strings.TrimSpace(payload.Conversation.ThreadID)
+	if threadID != "" {
+		msg.Thread = &channel.ThreadRef{ID: threadID}
+	}
+	for _, att := range payload.Attachments {
+		attType := channel.AttachmentType(strings.TrimSpace(att.Type))
+		if attType == "" {
+			attType = channel.AttachmentFile
+		}
+		msg.Attachments = append(msg.Attachments, channel.NormalizeInboundChannelAttachment(channel.Attachment{
+			Type:           attType,
+			URL:            strings.TrimSpace(att.URL),
+			Name:           strings.TrimSpace(att.Name),
+			Mime:           strings.TrimSpace(att.Mime),
+			SourcePlatform: Type.String(),
+		}))
+	}
+	displayName := strings.TrimSpace(payload.Sender.Name)
+	if displayName == "" {
+		displayName = senderID
+	}
+	return channel.InboundMessage{
+		Channel:     Type,
+		Message:     msg,
+		BotID:       cfg.BotID,
+		ReplyTarget: convID,
+		Sender: channel.Identity{
+			SubjectID:   senderID,
+			DisplayName: displayName,
+			Attributes:  map[string]string{"sender_id": senderID},
+		},
+		Conversation: channel.Conversation{
+			ID:       convID,
+			Type:     convType,
+			Name:     strings.TrimSpace(payload.Conversation.Name),
+			ThreadID: threadID,
+		},
+		ReceivedAt: receivedAt,
+		Source:     "webhook",
+		Metadata: map[string]any{
+			// Webhook events are addressed to the bot by construction.
+			"is_mentioned": true,
+		},
+	}
+}
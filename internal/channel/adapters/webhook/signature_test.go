@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerifySignature(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	body := []byte(`{"text":"hi"}`)
+	sig := Sign(testSecret, now.Unix(), body)
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	if _, err := verifySignature(testSecret, sig, ts, body, time.Minute, now.Add(30*time.Second)); err != nil {
+		t.Fatalf("expected valid signature: %v", err)
+	}
+	if _, err := verifySignature(testSecret, sig, ts, []byte(`{"text":"tampered"}`), time.Minute, now); !errors.Is(err, errInvalidSignature) {
+		t.Fatalf("expected invalid signature, got %v", err)
+	}
+	if _, err := verifySignature("another-secret-value", sig, ts, body, time.Minute, now); !errors.Is(err, errInvalidSignature) {
+		t.Fatalf("expected invalid signature for wrong secret, got %v", err)
+	}
+	if _, err := verifySignature(testSecret, sig, ts, body, time.Minute, now.Add(2*time.Minute)); !errors.Is(err, errStaleTimestamp) {
+		t.Fatalf("expected stale timestamp, got %v", err)
+	}
+	if _, err := verifySignature(testSecret, "", ts, body, time.Minute, now); !errors.Is(err, errMissingSignature) {
+		t.Fatalf("expected missing signature, got %v", err)
+	}
+}
+
+func TestReplayGuard(t *testing.T) {
+	guard := newReplayGuard()
+	now := time.Unix(1_700_000_000, 0)
+	if !guard.remember(now.Add(time.Minute), now, "sig:a", "id:evt-1") {
+		t.Fatal("expected first delivery to be accepted")
+	}
+	if guard.remember(now.Add(time.Minute), now.Add(time.Second), "sig:a", "id:evt-2") {
+		t.Fatal("expected a delivery with a seen signature to be rejected")
+	}
+	if guard.remember(now.Add(time.Minute), now.Add(time.Second), "sig:b", "id:evt-1") {
+		t.Fatal("expected a delivery with a seen event ID to be rejected")
+	}
+	if !guard.remember(now.Add(time.Minute), now.Add(time.Second), "sig:c", "id:evt-2") {
+		t.Fatal("expected a rejected delivery not to record its other keys")
+	}
+	if !guard.remember(now.Add(3*time.Minute), now.Add(2*time.Minute), "sig:a", "id:evt-1") {
+		t.Fatal("expected expired entries to be forgotten")
+	}
+}
@@ -0,0 +1,210 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/channel"
+)
+
+func signedRequest(t *testing.T, body string, ts time.Time) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/channels/webhook/webhook/cfg-1", bytes.NewBufferString(body))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(ts.Unix(), 10))
+	req.Header.Set(SignatureHeader, Sign(testSecret, ts.Unix(), []byte(body)))
+	return req
+}
+
+// newTestAdapter returns an adapter whose clock is fixed at now and which
+// started well before it.
+func newTestAdapter(now time.Time) *WebhookAdapter {
+	adapter := NewWebhookAdapter(nil)
+	adapter.now = func() time.Time { return now }
+	adapter.started = now.Add(-time.Hour)
+	return adapter
+}
+
+func httpStatus(err error) int {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+	return 0
+}
+
+func TestHandleWebhookAcceptsSignedEvent(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	adapter := newTestAdapter(now)
+	cfg := channel.ChannelConfig{ID: "cfg-1", BotID: "bot-1", ChannelType: Type, Credentials: map[string]any{"secret": testSecret}}
+
+	var got []channel.InboundMessage
+	handler := func(_ context.Context, _ channel.ChannelConfig, msg channel.InboundMessage) error {
+		got = append(got, msg)
+		return nil
+	}
+	body := `{"id":"evt-1","text":"CI failed on main","sender":{"id":"ci","name":"CI"},"conversation":{"id":"pipeline-9","type":"group"},"reply_to":"m-1"}`
+
+	rec := httptest.NewRecorder()
+	if err := adapter.HandleWebhook(context.Background(), cfg, handler, signedRequest(t, body, now), rec); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	if rec.Code != http.StatusAccepted || len(got) != 1 {
+		t.Fatalf("unexpected response %d with %d messages", rec.Code, len(got))
+	}
+	msg := got[0]
+	if msg.Message.ID != "evt-1" || msg.Message.Text != "CI failed on main" || msg.BotID != "bot-1" {
+		t.Fatalf("unexpected message: %+v", msg.Message)
+	}
+	if msg.ReplyTarget != "pipeline-9" || msg.Conversation.Type != channel.ConversationTypeGroup || msg.Sender.SubjectID != "ci" {
+		t.Fatalf("unexpected routing: %+v", msg)
+	}
+	if msg.Message.Reply == nil || msg.Message.Reply.MessageID != "m-1" {
+		t.Fatalf("unexpected reply: %+v", msg.Message.Reply)
+	}
+
+	rec = httptest.NewRecorder()
+	if err := adapter.HandleWebhook(context.Background(), cfg, handler, signedRequest(t, body, now), rec); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatal("expected replayed event not to reach the handler")
+	}
+}
+
+func TestHandleWebhookRejectsReplays(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	adapter := newTestAdapter(now)
+	cfg := channel.ChannelConfig{ID: "cfg-1", ChannelType: Type, Credentials: map[string]any{"secret": testSecret}}
+	delivered := 0
+	handler := func(context.Context, channel.ChannelConfig, channel.InboundMessage) error {
+		delivered++
+		return nil
+	}
+	body := `{"text":"deploy","sender":{"id":"ci"}}`
+
+	first := signedRequest(t, body, now)
+	first.Header.Set(EventIDHeader, "evt-1")
+	if err := adapter.HandleWebhook(context.Background(), cfg, handler, first, httptest.NewRecorder()); err != nil {
+		t.Fatalf("handle: %v", err)
+	}
+	// The event ID header is not signed, so rewriting it must not help.
+	rewritten := signedRequest(t, body, now)
+	rewritten.Header.Set(EventIDHeader, "evt-2")
+	if err := adapter.HandleWebhook(context.Background(), cfg, handler, rewritten, httptest.NewRecorder()); err != nil {
+		t.Fatalf("rewritten header: %v", err)
+	}
+	// Nor must a reconnect of the channel.
+	conn, err := adapter.Connect(context.Background(), cfg, handler)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	if err := conn.Stop(context.Background()); err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+	if err := adapter.HandleWebhook(context.Background(), cfg, handler, signedRequest(t, body, now), httptest.NewRecorder()); err != nil {
+		t.Fatalf("after reconnect: %v", err)
+	}
+	if delivered != 1 {
+		t.Fatalf("expected replays not to reach the handler, got %d deliveries", delivered)
+	}
+
+	// A restarted adapter has forgotten the delivery and refuses anything
+	// signed before it started.
+	restarted := newTestAdapter(now)
+	restarted.started = now.Add(time.Second)
+	if status := httpStatus(restarted.HandleWebhook(context.Background(), cfg, handler, signedRequest(t, body, now), httptest.NewRecorder())); status != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for an event signed before the restart, got %d", status)
+	}
+}
+
+func TestHandleWebhookRejectsBadRequests(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	adapter := newTestAdapter(now)
+	schema := `{"type":"object","required":["metadata"]}`
+	cfg := channel.ChannelConfig{ID: "cfg-1", Credentials: map[string]any{"secret": testSecret, "payloadSchema": schema}}
+	valid := `{"text":"hi","sender":{"id":"u1"},"metadata":{}}`
+
+	stale := signedRequest(t, valid, now.Add(-10*time.Minute))
+	if status := httpStatus(adapter.HandleWebhook(context.Background(), cfg, nil, stale, httptest.NewRecorder())); status != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for stale timestamp, got %d", status)
+	}
+	tampered := signedRequest(t, valid, now)
+	tampered.Header.Set(SignatureHeader, Sign("some-other-secret-value", now.Unix(), []byte(valid)))
+	if status := httpStatus(adapter.HandleWebhook(context.Background(), cfg, nil, tampered, httptest.NewRecorder())); status != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for bad signature, got %d", status)
+	}
+	missingSender := signedRequest(t, `{"text":"hi","metadata":{}}`, now)
+	if status := httpStatus(adapter.HandleWebhook(context.Background(), cfg, nil, missingSender, httptest.NewRecorder())); status != http.StatusBadRequest {
+		t.Fatalf("expected 400 for schema violation, got %d", status)
+	}
+	missingCustom := signedRequest(t, `{"text":"hi","sender":{"id":"u1"}}`, now)
+	if status := httpStatus(adapter.HandleWebhook(context.Background(), cfg, nil, missingCustom, httptest.NewRecorder())); status != http.StatusBadRequest {
+		t.Fatalf("expected 400 for custom schema violation, got %d", status)
+	}
+}
+
+func TestSendPostsSignedCallback(t *testing.T) {
+	var (
+		gotBody []byte
+		gotSig  string
+		gotTS   string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSig = r.Header.Get(SignatureHeader)
+		gotTS = r.Header.Get(TimestampHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	adapter := NewWebhookAdapter(nil)
+	cfg := channel.ChannelConfig{ID: "cfg-1", BotID: "bot-1", Credentials: map[string]any{"secret": testSecret, "callbackUrl": srv.URL}}
+	stream, err := adapter.OpenStream(context.Background(), cfg, "pipeline-9", channel.StreamOptions{SourceMessageID: "evt-1"})
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	_ = stream.Push(context.Background(), channel.PreparedStreamEvent{Type: channel.StreamEventDelta, Delta: "Looking "})
+	_ = stream.Push(context.Background(), channel.PreparedStreamEvent{Type: channel.StreamEventDelta, Delta: "into it"})
+	if err := stream.Close(context.Background()); err != nil {
+		t.Fatalf("close stream: %v", err)
+	}
+
+	ts, _ := strconv.ParseInt(gotTS, 10, 64)
+	if gotSig != Sign(testSecret, ts, gotBody) {
+		t.Fatal("callback signature does not verify")
+	}
+	var payload outboundPayload
+	if err := json.Unmarshal(gotBody, &payload); err != nil {
+		t.Fatalf("decode callback: %v", err)
+	}
+	if payload.Target != "pipeline-9" || payload.Text != "Looking into it" || payload.ReplyTo != "evt-1" || payload.BotID != "bot-1" {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestSendReportsCallbackFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "nope", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	adapter := NewWebhookAdapter(nil)
+	cfg := channel.ChannelConfig{ID: "cfg-1", Credentials: map[string]any{"secret": testSecret, "callbackUrl": srv.URL}}
+	err := adapter.Send(context.Background(), cfg, channel.PreparedOutboundMessage{
+		Target:  "pipeline-9",
+		Message: channel.PreparedMessage{Message: channel.Message{Text: "hi"}},
+	})
+	if err == nil {
+		t.Fatal("expected callback error")
+	}
+}
@@ -0,0 +1,453 @@
+package xmpp
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	nsClient  = "jabber:client"
+	nsStream  = "http://etherx.jabber.org/streams"
+	nsTLS     = "urn:ietf:params:xml:ns:xmpp-tls"
+	nsSASL    = "urn:ietf:params:xml:ns:xmpp-sasl"
+	nsReply   = "urn:xmpp:reply:0"
+	nsStanzas = "urn:ietf:params:xml:ns:xmpp-stanzas"
+
+	xmppDialTimeout      = 15 * time.Second
+	xmppHandshakeTimeout = 30 * time.Second
+	xmppWriteTimeout     = 10 * time.Second
+)
+
+// --- Wire types ---
+
+type streamFeatures struct {
+	XMLName    xml.Name        `xml:"http://etherx.jabber.org/streams features"`
+	StartTLS   *startTLSFeat   `xml:"urn:ietf:params:xml:ns:xmpp-tls starttls"`
+	Mechanisms *saslMechanisms `xml:"urn:ietf:params:xml:ns:xmpp-sasl mechanisms"`
+	Bind       *struct{}       `xml:"urn:ietf:params:xml:ns:xmpp-bind bind"`
+	Session    *sessionFeat    `xml:"urn:ietf:params:xml:ns:xmpp-session session"`
+}
+
+type startTLSFeat struct {
+	Required *struct{} `xml:"required"`
+}
+
+type saslMechanisms struct {
+	Mechanism []string `xml:"mechanism"`
+}
+
+type sessionFeat struct {
+	Optional *struct{} `xml:"optional"`
+}
+
+type saslFailure struct {
+	Condition xml.Name `xml:",any"`
+	Text      string   `xml:"text"`
+}
+
+type xmppEmpty struct{}
+
+type xmppBind struct {
+	Resource string `xml:"resource,omitempty"`
+	JID      string `xml:"jid,omitempty"`
+}
+
+type xmppStanzaError struct {
+	Type      string   `xml:"type,attr"`
+	Condition xml.Name `xml:",any"`
+}
+
+type xmppIQ struct {
+	XMLName xml.Name         `xml:"iq"`
+	ID      string           `xml:"id,attr,omitempty"`
+	Type    string           `xml:"type,attr"`
+	To      string           `xml:"to,attr,omitempty"`
+	From    string           `xml:"from,attr,omitempty"`
+	Bind    *xmppBind        `xml:"urn:ietf:params:xml:ns:xmpp-bind bind,omitempty"`
+	Session *xmppEmpty       `xml:"urn:ietf:params:xml:ns:xmpp-session session,omitempty"`
+	Ping    *xmppEmpty       `xml:"urn:xmpp:ping ping,omitempty"`
+	Error   *xmppStanzaError `xml:"error,omitempty"`
+}
+
+// xmppReply is the XEP-0461 reply reference.
+type xmppReply struct {
+	To string `xml:"to,attr,omitempty"`
+	ID string `xml:"id,attr"`
+}
+
+// xmppReplace is the XEP-0308 last message correction marker.
+type xmppReplace struct {
+	ID string `xml:"id,attr"`
+}
+
+// xmppStanzaID is the XEP-0359 server-assigned stanza id.
+type xmppStanzaID struct {
+	ID string `xml:"id,attr"`
+	By string `xml:"by,attr"`
+}
+
+type xmppDelay struct {
+	Stamp string `xml:"stamp,attr"`
+}
+
+// xmppFallback marks the quoted part of a XEP-0461 reply body (XEP-0428).
+type xmppFallback struct {
+	For  string             `xml:"for,attr"`
+	Body []xmppFallbackBody `xml:"body"`
+}
+
+type xmppFallbackBody struct {
+	Start *int `xml:"start,attr"`
+	End   *int `xml:"end,attr"`
+}
+
+type xmppMessage struct {
+	XMLName   xml.Name         `xml:"message"`
+	From      string           `xml:"from,attr,omitempty"`
+	To        string           `xml:"to,attr,omitempty"`
+	Type      string           `xml:"type,attr,omitempty"`
+	ID        string           `xml:"id,attr,omitempty"`
+	Body      string           `xml:"body,omitempty"`
+	Thread    string           `xml:"thread,omitempty"`
+	Reply     *xmppReply       `xml:"urn:xmpp:reply:0 reply,omitempty"`
+	Replace   *xmppReplace     `xml:"urn:xmpp:message-correct:0 replace,omitempty"`
+	StanzaIDs []xmppStanzaID   `xml:"urn:xmpp:sid:0 stanza-id,omitempty"`
+	Delay     *xmppDelay       `xml:"urn:xmpp:delay delay,omitempty"`
+	Fallbacks []xmppFallback   `xml:"urn:xmpp:fallback:0 fallback,omitempty"`
+	Error     *xmppStanzaError `xml:"error,omitempty"`
+}
+
+type xmppMUCJoin struct {
+	History *xmppMUCHistory `xml:"history,omitempty"`
+}
+
+type xmppMUCHistory struct {
+	MaxStanzas int `xml:"maxstanzas,attr"`
+}
+
+type xmppPresence struct {
+	XMLName xml.Name     `xml:"presence"`
+	To      string       `xml:"to,attr,omitempty"`
+	From    string       `xml:"from,attr,omitempty"`
+	Type    string       `xml:"type,attr,omitempty"`
+	MUC     *xmppMUCJoin `xml:"http://jabber.org/protocol/muc x,omitempty"`
+}
+
+// --- Client ---
+
+// xmppClient is a single authenticated and bound client-to-server stream.
+type xmppClient struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	dec     *xml.Decoder
+	writeMu sync.Mutex
+	jid     string // full JID assigned by the server
+	nextID  atomic.Uint64
+}
+
+func dialXMPP(ctx context.Context, cfg Config) (*xmppClient, error) {
+	domain := cfg.Domain()
+	addr := cfg.Server
+	if addr == "" {
+		addr = lookupServer(ctx, domain, cfg.DirectTLS)
+	}
+	dialer := &net.Dialer{Timeout: xmppDialTimeout}
+	tlsConfig := &tls.Config{
+		ServerName:         domain,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // opt-in for self-hosted servers with private CAs
+	}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if cfg.DirectTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("xmpp dial %s: %w", addr, err)
+	}
+	client := &xmppClient{conn: conn, reader: bufio.NewReader(conn)}
+	deadline := time.Now().Add(xmppHandshakeTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+	if err := client.handshake(cfg, tlsConfig); err != nil {
+		_ = client.conn.Close()
+		return nil, err
+	}
+	_ = client.conn.SetDeadline(time.Time{})
+	return client, nil
+}
+
+// lookupServer resolves the client-to-server endpoint through SRV records,
+// falling back to the domain itself on the standard port.
+func lookupServer(ctx context.Context, domain string, directTLS bool) string {
+	service, port := "xmpp-client", "5222"
+	if directTLS {
+		service, port = "xmpps-client", "5223"
+	}
+	_, records, err := net.DefaultResolver.LookupSRV(ctx, service, "tcp", domain)
+	if err == nil && len(records) > 0 {
+		target := strings.TrimSuffix(records[0].Target, ".")
+		if target != "" {
+			return net.JoinHostPort(target, strconv.Itoa(int(records[0].Port)))
+		}
+	}
+	return net.JoinHostPort(domain, port)
+}
+
+func (c *xmppClient) handshake(cfg Config, tlsConfig *tls.Config) error {
+	_, secure := c.conn.(*tls.Conn)
+	features, err := c.openStream(cfg.Domain())
+	if err != nil {
+		return err
+	}
+	if !secure && features.StartTLS != nil {
+		if err := c.startTLS(tlsConfig); err != nil {
+			return err
+		}
+		secure = true
+		if features, err = c.openStream(cfg.Domain()); err != nil {
+			return err
+		}
+	}
+	if !secure && !cfg.AllowPlaintext {
+		return errors.New("xmpp server does not offer TLS; enable allowPlaintext to connect anyway")
+	}
+	if err := c.authenticate(cfg, features); err != nil {
+		return err
+	}
+	if features, err = c.openStream(cfg.Domain()); err != nil {
+		return err
+	}
+	if features.Bind == nil {
+		return errors.New("xmpp server does not offer resource binding")
+	}
+	if err := c.bind(cfg.Resource); err != nil {
+		return err
+	}
+	if features.Session != nil && features.Session.Optional == nil {
+		if _, err := c.roundTrip(xmppIQ{Type: "set", Session: &xmppEmpty{}}); err != nil {
+			return fmt.Errorf("xmpp session: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *xmppClient) openStream(domain string) (streamFeatures, error) {
+	header := fmt.Sprintf(`<?xml version='1.0'?><stream:stream to='%s' xmlns='%s' xmlns:stream='%s' version='1.0'>`, escapeAttr(domain), nsClient, nsStream)
+	if err := c.writeRaw(header); err != nil {
+		return streamFeatures{}, err
+	}
+	// A fresh decoder is required for every stream restart; the reader is
+	// shared so no buffered bytes are lost between restarts.
+	c.dec = xml.NewDecoder(c.reader)
+	for {
+		tok, err := c.dec.Token()
+		if err != nil {
+			return streamFeatures{}, fmt.Errorf("xmpp read stream header: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if start.Name.Space != nsStream || start.Name.Local != "stream" {
+				return streamFeatures{}, fmt.Errorf("xmpp unexpected element %s", start.Name.Local)
+			}
+			break
+		}
+	}
+	start, err := c.nextElement()
+	if err != nil {
+		return streamFeatures{}, err
+	}
+	if start.Name.Space != nsStream || start.Name.Local != "features" {
+		return streamFeatures{}, c.streamError(start)
+	}
+	var features streamFeatures
+	if err := c.dec.DecodeElement(&features, &start); err != nil {
+		return streamFeatures{}, fmt.Errorf("xmpp decode features: %w", err)
+	}
+	return features, nil
+}
+
+func (c *xmppClient) startTLS(tlsConfig *tls.Config) error {
+	if err := c.writeRaw(fmt.Sprintf(`<starttls xmlns='%s'/>`, nsTLS)); err != nil {
+		return err
+	}
+	start, err := c.nextElement()
+	if err != nil {
+		return err
+	}
+	if start.Name.Local != "proceed" {
+		return errors.New("xmpp server refused starttls")
+	}
+	if err := c.dec.Skip(); err != nil {
+		return err
+	}
+	tlsConn := tls.Client(c.conn, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		return fmt.Errorf("xmpp tls handshake: %w", err)
+	}
+	c.conn = tlsConn
+	c.reader = bufio.NewReader(tlsConn)
+	return nil
+}
+
+func (c *xmppClient) authenticate(cfg Config, features streamFeatures) error {
+	if features.Mechanisms == nil || !slices.Contains(features.Mechanisms.Mechanism, "PLAIN") {
+		return errors.New("xmpp server does not offer SASL PLAIN")
+	}
+	payload := base64.StdEncoding.EncodeToString([]byte("\x00" + localpart(cfg.JID) + "\x00" + cfg.Password))
+	if err := c.writeRaw(fmt.Sprintf(`<auth xmlns='%s' mechanism='PLAIN'>%s</auth>`, nsSASL, payload)); err != nil {
+		return err
+	}
+	start, err := c.nextElement()
+	if err != nil {
+		return err
+	}
+	switch start.Name.Local {
+	case "success":
+		return c.dec.Skip()
+	case "failure":
+		var failure saslFailure
+		_ = c.dec.DecodeElement(&failure, &start)
+		reason := failure.Condition.Local
+		if text := strings.TrimSpace(failure.Text); text != "" {
+			reason += ": " + text
+		}
+		return fmt.Errorf("xmpp authentication failed: %s", reason)
+	default:
+		return fmt.Errorf("xmpp unexpected sasl response %s", start.Name.Local)
+	}
+}
+
+func (c *xmppClient) bind(resource string) error {
+	resp, err := c.roundTrip(xmppIQ{Type: "set", Bind: &xmppBind{Resource: resource}})
+	if err != nil {
+		return fmt.Errorf("xmpp bind: %w", err)
+	}
+	if resp.Bind == nil || strings.TrimSpace(resp.Bind.JID) == "" {
+		return errors.New("xmpp bind: server returned no jid")
+	}
+	c.jid = strings.TrimSpace(resp.Bind.JID)
+	return nil
+}
+
+// roundTrip sends an IQ and waits for its response. It must only be used
+// during the handshake, before the read loop owns the decoder.
+func (c *xmppClient) roundTrip(iq xmppIQ) (xmppIQ, error) {
+	iq.ID = c.newID()
+	if err := c.send(iq); err != nil {
+		return xmppIQ{}, err
+	}
+	for {
+		start, err := c.nextElement()
+		if err != nil {
+			return xmppIQ{}, err
+		}
+		if start.Name.Local != "iq" {
+			if err := c.dec.Skip(); err != nil {
+				return xmppIQ{}, err
+			}
+			continue
+		}
+		var resp xmppIQ
+		if err := c.dec.DecodeElement(&resp, &start); err != nil {
+			return xmppIQ{}, err
+		}
+		if resp.ID != iq.ID {
+			continue
+		}
+		if resp.Type == "error" {
+			condition := "unknown"
+			if resp.Error != nil {
+				condition = resp.Error.Condition.Local
+			}
+			return xmppIQ{}, fmt.Errorf("iq error: %s", condition)
+		}
+		return resp, nil
+	}
+}
+
+// nextElement returns the next top-level start element, skipping whitespace
+// keepalives. It returns io.EOF when the server closes the stream.
+func (c *xmppClient) nextElement() (xml.StartElement, error) {
+	for {
+		tok, err := c.dec.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			return t, nil
+		case xml.EndElement:
+			if t.Name.Space == nsStream && t.Name.Local == "stream" {
+				return xml.StartElement{}, io.EOF
+			}
+		}
+	}
+}
+
+func (c *xmppClient) streamError(start xml.StartElement) error {
+	if start.Name.Space == nsStream && start.Name.Local == "error" {
+		var streamErr struct {
+			Condition xml.Name `xml:",any"`
+		}
+		_ = c.dec.DecodeElement(&streamErr, &start)
+		return fmt.Errorf("xmpp stream error: %s", streamErr.Condition.Local)
+	}
+	return fmt.Errorf("xmpp unexpected element %s", start.Name.Local)
+}
+
+func (c *xmppClient) newID() string {
+	return "memoh-" + strconv.FormatUint(c.nextID.Add(1), 36) + "-" + strconv.FormatInt(time.Now().UnixNano()%1e6, 36)
+}
+
+func (c *xmppClient) send(v any) error {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.writeRaw(string(data))
+}
+
+func (c *xmppClient) writeRaw(data string) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(xmppWriteTimeout))
+	_, err := io.WriteString(c.conn, data)
+	return err
+}
+
+func (c *xmppClient) joinRoom(room, nick string) error {
+	return c.send(xmppPresence{
+		To:  room + "/" + nick,
+		MUC: &xmppMUCJoin{History: &xmppMUCHistory{MaxStanzas: 0}},
+	})
+}
+
+func (c *xmppClient) close() error {
+	_ = c.writeRaw("</stream:stream>")
+	return c.conn.Close()
+}
+
+func escapeAttr(value string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
@@ -0,0 +1,258 @@
+package xmpp
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/memohai/memoh/internal/channel"
+)
+
+const defaultResource = "memoh"
+
+// Config holds the XMPP account credentials extracted from a channel configuration.
+type Config struct {
+	JID                string // bare account JID, e.g. memoh@example.com
+	Password           string //nolint:gosec // intentional: operator-supplied XMPP account password
+	Server             string // optional host:port override; SRV lookup is used when empty
+	Resource           string
+	Nick               string // MUC nickname
+	Rooms              []string
+	DirectTLS          bool
+	AllowPlaintext     bool
+	InsecureSkipVerify bool
+}
+
+// Domain returns the domainpart of the account JID.
+func (c Config) Domain() string {
+	return domainpart(c.JID)
+}
+
+// UserConfig holds the identifiers used to target an XMPP user.
+type UserConfig struct {
+	JID string
+}
+
+func normalizeConfig(raw map[string]any) (map[string]any, error) {
+	cfg, err := parseConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{
+		"jid":                cfg.JID,
+		"password":           cfg.Password,
+		"resource":           cfg.Resource,
+		"nick":               cfg.Nick,
+		"rooms":              strings.Join(cfg.Rooms, ","),
+		"directTLS":          cfg.DirectTLS,
+		"allowPlaintext":     cfg.AllowPlaintext,
+		"insecureSkipVerify": cfg.InsecureSkipVerify,
+	}
+	if cfg.Server != "" {
+		out["server"] = cfg.Server
+	}
+	return out, nil
+}
+
+func normalizeUserConfig(raw map[string]any) (map[string]any, error) {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"jid": cfg.JID}, nil
+}
+
+func resolveTarget(raw map[string]any) (string, error) {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return "", err
+	}
+	return cfg.JID, nil
+}
+
+func normalizeTarget(raw string) string {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return ""
+	}
+	if strings.HasPrefix(strings.ToLower(value), "xmpp:") {
+		value = strings.TrimSpace(value[len("xmpp:"):])
+	}
+	// xmpp: URIs may carry a query such as ?join or ?message.
+	if idx := strings.IndexByte(value, '?'); idx >= 0 {
+		value = value[:idx]
+	}
+	if !isValidJID(value) {
+		return ""
+	}
+	return bareJID(value)
+}
+
+func matchBinding(raw map[string]any, criteria channel.BindingCriteria) bool {
+	cfg, err := parseUserConfig(raw)
+	if err != nil {
+		return false
+	}
+	if jid := criteria.Attribute("jid"); jid != "" {
+		return strings.EqualFold(bareJID(jid), cfg.JID)
+	}
+	return criteria.SubjectID != "" && strings.EqualFold(bareJID(criteria.SubjectID), cfg.JID)
+}
+
+func buildUserConfig(identity channel.Identity) map[string]any {
+	jid := identity.Attribute("jid")
+	if jid == "" {
+		return map[string]any{}
+	}
+	return map[string]any{"jid": bareJID(jid)}
+}
+
+func parseConfig(raw map[string]any) (Config, error) {
+	jid := strings.TrimSpace(channel.ReadString(raw, "jid", "username"))
+	if jid == "" {
+		return Config{}, errors.New("xmpp jid is required")
+	}
+	if !isValidJID(jid) || localpart(jid) == "" {
+		return Config{}, errors.New("xmpp jid must look like user@domain")
+	}
+	password := channel.ReadString(raw, "password")
+	if strings.TrimSpace(password) == "" {
+		return Config{}, errors.New("xmpp password is required")
+	}
+	resource := strings.TrimSpace(channel.ReadString(raw, "resource"))
+	if resource == "" {
+		resource = resourcepart(jid)
+	}
+	if resource == "" {
+		resource = defaultResource
+	}
+	nick := strings.TrimSpace(channel.ReadString(raw, "nick", "nickname"))
+	if nick == "" {
+		nick = localpart(jid)
+	}
+	server := strings.TrimSpace(channel.ReadString(raw, "server", "host"))
+	directTLS := readBool(raw, false, "directTLS", "direct_tls")
+	if server != "" {
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			port := "5222"
+			if directTLS {
+				port = "5223"
+			}
+			server = net.JoinHostPort(server, port)
+		}
+	}
+	rooms, err := parseRooms(raw["rooms"])
+	if err != nil {
+		return Config{}, err
+	}
+	return Config{
+		JID:                bareJID(jid),
+		Password:           password,
+		Server:             server,
+		Resource:           resource,
+		Nick:               nick,
+		Rooms:              rooms,
+		DirectTLS:          directTLS,
+		AllowPlaintext:     readBool(raw, false, "allowPlaintext", "allow_plaintext"),
+		InsecureSkipVerify: readBool(raw, false, "insecureSkipVerify", "insecure_skip_verify"),
+	}, nil
+}
+
+func parseUserConfig(raw map[string]any) (UserConfig, error) {
+	jid := normalizeTarget(channel.ReadString(raw, "jid", "user_id"))
+	if jid == "" {
+		return UserConfig{}, errors.New("xmpp user config requires jid")
+	}
+	return UserConfig{JID: jid}, nil
+}
+
+func parseRooms(raw any) ([]string, error) {
+	var items []string
+	switch v := raw.(type) {
+	case string:
+		items = strings.FieldsFunc(v, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\n'
+		})
+	case []string:
+		items = v
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				items = append(items, s)
+			}
+		}
+	}
+	seen := make(map[string]struct{}, len(items))
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		room := normalizeTarget(item)
+		if room == "" {
+			return nil, errors.New("xmpp room must be a bare JID such as room@conference.example.com: " + strings.TrimSpace(item))
+		}
+		key := strings.ToLower(room)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, room)
+	}
+	return out, nil
+}
+
+// isValidJID performs a light structural check of a JID.
+func isValidJID(jid string) bool {
+	if jid == "" || strings.ContainsAny(jid, " \t\r\n<>'\"&") {
+		return false
+	}
+	domain := domainpart(jid)
+	return domain != "" && !strings.HasPrefix(domain, ".") && !strings.HasSuffix(domain, ".")
+}
+
+func bareJID(jid string) string {
+	if idx := strings.IndexByte(jid, '/'); idx >= 0 {
+		jid = jid[:idx]
+	}
+	return strings.ToLower(strings.TrimSpace(jid))
+}
+
+func localpart(jid string) string {
+	bare := bareJID(jid)
+	if idx := strings.IndexByte(bare, '@'); idx >= 0 {
+		return bare[:idx]
+	}
+	return ""
+}
+
+func domainpart(jid string) string {
+	bare := bareJID(jid)
+	if idx := strings.IndexByte(bare, '@'); idx >= 0 {
+		return bare[idx+1:]
+	}
+	return bare
+}
+
+func resourcepart(jid string) string {
+	if idx := strings.IndexByte(jid, '/'); idx >= 0 {
+		return strings.TrimSpace(jid[idx+1:])
+	}
+	return ""
+}
+
+func readBool(raw map[string]any, fallback bool, keys ...string) bool {
+	for _, key := range keys {
+		value, ok := raw[key]
+		if !ok {
+			continue
+		}
+		switch v := value.(type) {
+		case bool:
+			return v
+		case string:
+			if parsed, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return parsed
+			}
+		}
+	}
+	return fallback
+}
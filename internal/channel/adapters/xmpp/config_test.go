@@ -0,0 +1,61 @@
+package xmpp
+
+import (
+	"testing"
+
+	"github.com/memohai/memoh/internal/channel"
+)
+
+func TestParseConfigDefaults(t *testing.T) {
+	cfg, err := parseConfig(map[string]any{
+		"jid":      "Memoh@Example.com/laptop",
+		"password": "secret",
+		"server":   "xmpp.example.com",
+		"rooms":    "ops@conference.example.com, OPS@conference.example.com",
+	})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if cfg.JID != "memoh@example.com" || cfg.Resource != "laptop" || cfg.Nick != "memoh" {
+		t.Fatalf("unexpected identity: %+v", cfg)
+	}
+	if cfg.Server != "xmpp.example.com:5222" || cfg.Domain() != "example.com" {
+		t.Fatalf("unexpected server: %+v", cfg)
+	}
+	if len(cfg.Rooms) != 1 || cfg.Rooms[0] != "ops@conference.example.com" {
+		t.Fatalf("unexpected rooms: %v", cfg.Rooms)
+	}
+}
+
+func TestParseConfigRequiresCredentials(t *testing.T) {
+	if _, err := parseConfig(map[string]any{"jid": "example.com", "password": "x"}); err == nil {
+		t.Fatal("expected error for JID without localpart")
+	}
+	if _, err := parseConfig(map[string]any{"jid": "memoh@example.com"}); err == nil {
+		t.Fatal("expected error for missing password")
+	}
+}
+
+func TestNormalizeTarget(t *testing.T) {
+	cases := map[string]string{
+		"xmpp:Alice@Example.com?message": "alice@example.com",
+		"alice@example.com/phone":        "alice@example.com",
+		"not a jid":                      "",
+		"":                               "",
+	}
+	for in, want := range cases {
+		if got := normalizeTarget(in); got != want {
+			t.Fatalf("normalizeTarget(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestMatchBinding(t *testing.T) {
+	cfg := map[string]any{"jid": "alice@example.com"}
+	if !matchBinding(cfg, channel.BindingCriteria{Attributes: map[string]string{"jid": "Alice@example.com/phone"}}) {
+		t.Fatal("expected jid attribute to match")
+	}
+	if matchBinding(cfg, channel.BindingCriteria{SubjectID: "bob@example.com"}) {
+		t.Fatal("expected different subject not to match")
+	}
+}
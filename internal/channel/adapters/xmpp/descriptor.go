@@ -0,0 +1,7 @@
+// Package xmpp implements the XMPP (Jabber) channel adapter.
+package xmpp
+
+import "github.com/memohai/memoh/internal/channel"
+
+// Type is the registered ChannelType identifier for XMPP.
+const Type channel.ChannelType = "xmpp"
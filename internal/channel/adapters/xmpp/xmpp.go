@@ -0,0 +1,765 @@
+package xmpp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/channel/common"
+)
+
+const (
+	xmppMaxBodyRunes      = 10000
+	xmppReconnectDelay    = 5 * time.Second
+	xmppMaxReconnectDelay = 2 * time.Minute
+	xmppKeepaliveInterval = 60 * time.Second
+)
+
+// xmppSession is an active stream plus the rooms it has joined.
+type xmppSession struct {
+	client *xmppClient
+	nick   string
+	rooms  map[string]struct{} // bare room JIDs
+}
+
+func (s *xmppSession) isRoom(jid string) bool {
+	_, ok := s.rooms[bareJID(jid)]
+	return ok
+}
+
+// XMPPAdapter implements the channel.Adapter interfaces for XMPP servers.
+type XMPPAdapter struct {
+	logger *slog.Logger
+
+	mu       sync.RWMutex
+	sessions map[string]*xmppSession // keyed by config ID
+}
+
+// NewXMPPAdapter creates an XMPPAdapter with the given logger.
+func NewXMPPAdapter(log *slog.Logger) *XMPPAdapter {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &XMPPAdapter{
+		logger:   log.With(slog.String("adapter", "xmpp")),
+		sessions: make(map[string]*xmppSession),
+	}
+}
+
+// Type returns the XMPP channel type.
+func (*XMPPAdapter) Type() channel.ChannelType {
+	return Type
+}
+
+// Descriptor returns the XMPP channel metadata. Replies and threads map to
+// XEP-0461, edits to XEP-0308 last message correction.
+func (*XMPPAdapter) Descriptor() channel.Descriptor {
+	return channel.Descriptor{
+		Type:        Type,
+		DisplayName: "XMPP",
+		Capabilities: channel.ChannelCapabilities{
+			Text:           true,
+			Reply:          true,
+			Threads:        true,
+			Edit:           true,
+			BlockStreaming: true,
+			ChatTypes:      []string{channel.ConversationTypePrivate, channel.ConversationTypeGroup},
+		},
+		OutboundPolicy: channel.OutboundPolicy{
+			TextChunkLimit: xmppMaxBodyRunes,
+			ChunkerMode:    channel.ChunkerModeText,
+		},
+		ConfigSchema: channel.ConfigSchema{
+			Version: 1,
+			Fields: map[string]channel.FieldSchema{
+				"jid": {
+					Type:        channel.FieldString,
+					Required:    true,
+					Title:       "JID",
+					Description: "Bot account address",
+					Example:     "memoh@example.com",
+				},
+				"password": {
+					Type:     channel.FieldSecret,
+					Required: true,
+					Title:    "Password",
+				},
+				"server": {
+					Type:        channel.FieldString,
+					Title:       "Server",
+					Description: "Optional host:port override; DNS SRV records are used when empty",
+					Example:     "xmpp.example.com:5222",
+				},
+				"resource": {
+					Type:  channel.FieldString,
+					Title: "Resource",
+				},
+				"nick": {
+					Type:        channel.FieldString,
+					Title:       "Room Nickname",
+					Description: "Nickname used in group chats; defaults to the JID localpart",
+				},
+				"rooms": {
+					Type:        channel.FieldString,
+					Title:       "Rooms",
+					Description: "Comma-separated MUC rooms to join on connect",
+					Example:     "ops@conference.example.com",
+				},
+				"directTLS": {
+					Type:        channel.FieldBool,
+					Title:       "Direct TLS",
+					Description: "Use implicit TLS (port 5223) instead of STARTTLS",
+				},
+				"allowPlaintext": {
+					Type:        channel.FieldBool,
+					Title:       "Allow Plaintext",
+					Description: "Connect even if the server does not offer TLS",
+				},
+				"insecureSkipVerify": {
+					Type:        channel.FieldBool,
+					Title:       "Skip TLS Verification",
+					Description: "Accept self-signed server certificates",
+				},
+			},
+		},
+		UserConfigSchema: channel.ConfigSchema{
+			Version: 1,
+			Fields: map[string]channel.FieldSchema{
+				"jid": {Type: channel.FieldString, Required: true, Title: "JID"},
+			},
+		},
+		TargetSpec: channel.TargetSpec{
+			Format: "user@domain | room@conference.domain",
+			Hints: []channel.TargetHint{
+				{Label: "User JID", Example: "alice@example.com"},
+				{Label: "Room JID", Example: "ops@conference.example.com"},
+			},
+		},
+	}
+}
+
+// --- ConfigNormalizer ---
+
+// NormalizeConfig validates and normalizes an XMPP channel configuration map.
+func (*XMPPAdapter) NormalizeConfig(raw map[string]any) (map[string]any, error) {
+	return normalizeConfig(raw)
+}
+
+// NormalizeUserConfig validates and normalizes an XMPP user-binding configuration map.
+func (*XMPPAdapter) NormalizeUserConfig(raw map[string]any) (map[string]any, error) {
+	return normalizeUserConfig(raw)
+}
+
+// --- TargetResolver ---
+
+// NormalizeTarget normalizes an XMPP delivery target to a bare JID.
+func (*XMPPAdapter) NormalizeTarget(raw string) string {
+	return normalizeTarget(raw)
+}
+
+// ResolveTarget derives a delivery target from an XMPP user-binding configuration.
+func (*XMPPAdapter) ResolveTarget(userConfig map[string]any) (string, error) {
+	return resolveTarget(userConfig)
+}
+
+// --- BindingMatcher ---
+
+// MatchBinding reports whether an XMPP user binding matches the given criteria.
+func (*XMPPAdapter) MatchBinding(config map[string]any, criteria channel.BindingCriteria) bool {
+	return matchBinding(config, criteria)
+}
+
+// BuildUserConfig constructs an XMPP user-binding config from an Identity.
+func (*XMPPAdapter) BuildUserConfig(identity channel.Identity) map[string]any {
+	return buildUserConfig(identity)
+}
+
+// --- SelfDiscoverer ---
+
+// DiscoverSelf logs in once to validate the credentials and reports the
+// bound JID.
+func (*XMPPAdapter) DiscoverSelf(ctx context.Context, credentials map[string]any) (map[string]any, string, error) {
+	cfg, err := parseConfig(credentials)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := dialXMPP(ctx, cfg)
+	if err != nil {
+		return nil, "", fmt.Errorf("xmpp discover self: %w", err)
+	}
+	defer func() { _ = client.close() }()
+	bare := bareJID(client.jid)
+	identity := map[string]any{
+		"jid":      bare,
+		"resource": resourcepart(client.jid),
+		"nick":     cfg.Nick,
+	}
+	return identity, bare, nil
+}
+
+// --- Receiver ---
+
+// Connect logs in, joins the configured rooms and keeps the stream alive,
+// reconnecting with backoff when it drops.
+func (a *XMPPAdapter) Connect(ctx context.Context, cfg channel.ChannelConfig, handler channel.InboundHandler) (channel.Connection, error) {
+	if a.logger != nil {
+		a.logger.Info("start", slog.String("config_id", cfg.ID))
+	}
+	parsed, err := parseConfig(cfg.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	channel.SetIMErrorSecrets("xmpp:"+cfg.ID, parsed.Password)
+
+	session, err := openSession(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+	connCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	conn := channel.NewConnection(cfg, func(context.Context) error {
+		if a.logger != nil {
+			a.logger.Info("stop", slog.String("config_id", cfg.ID))
+		}
+		cancel()
+		<-done
+		return nil
+	})
+	go func() {
+		defer close(done)
+		a.runSessionLoop(connCtx, cfg, parsed, session, handler)
+	}()
+	return conn, nil
+}
+
+func openSession(ctx context.Context, cfg Config) (*xmppSession, error) {
+	client, err := dialXMPP(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.send(xmppPresence{}); err != nil {
+		_ = client.close()
+		return nil, err
+	}
+	session := &xmppSession{
+		client: client,
+		nick:   cfg.Nick,
+		rooms:  make(map[string]struct{}, len(cfg.Rooms)),
+	}
+	for _, room := range cfg.Rooms {
+		if err := client.joinRoom(room, cfg.Nick); err != nil {
+			_ = client.close()
+			return nil, err
+		}
+		session.rooms[room] = struct{}{}
+	}
+	return session, nil
+}
+
+func (a *XMPPAdapter) runSessionLoop(ctx context.Context, cfg channel.ChannelConfig, parsed Config, session *xmppSession, handler channel.InboundHandler) {
+	delay := xmppReconnectDelay
+	for {
+		a.setSession(cfg.ID, session)
+		err := a.serve(ctx, cfg, session, handler)
+		a.clearSession(cfg.ID, session)
+		_ = session.client.close()
+		if ctx.Err() != nil {
+			return
+		}
+		if a.logger != nil {
+			a.logger.Warn("session disconnected", slog.String("config_id", cfg.ID), slog.Any("error", err))
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			next, err := openSession(ctx, parsed)
+			if err == nil {
+				session = next
+				delay = xmppReconnectDelay
+				break
+			}
+			if a.logger != nil {
+				a.logger.Warn("reconnect failed", slog.String("config_id", cfg.ID), slog.Any("error", err))
+			}
+			delay = min(delay*2, xmppMaxReconnectDelay)
+		}
+	}
+}
+
+func (a *XMPPAdapter) serve(ctx context.Context, cfg channel.ChannelConfig, session *xmppSession, handler channel.InboundHandler) error {
+	client := session.client
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		ticker := time.NewTicker(xmppKeepaliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				_ = client.close()
+				return
+			case <-ticker.C:
+				_ = client.writeRaw(" ")
+			}
+		}
+	}()
+
+	for {
+		start, err := client.nextElement()
+		if err != nil {
+			return err
+		}
+		switch start.Name.Local {
+		case "message":
+			var msg xmppMessage
+			if err := client.dec.DecodeElement(&msg, &start); err != nil {
+				return err
+			}
+			inbound, ok := buildInboundMessage(session, client.jid, msg)
+			if !ok {
+				continue
+			}
+			a.logInbound(cfg.ID, inbound)
+			go func() {
+				if err := handler(ctx, cfg, inbound); err != nil && a.logger != nil {
+					a.logger.Error("handle inbound failed", slog.String("config_id", cfg.ID), slog.Any("error", err))
+				}
+			}()
+		case "iq":
+			var iq xmppIQ
+			if err := client.dec.DecodeElement(&iq, &start); err != nil {
+				return err
+			}
+			if err := answerIQ(client, iq); err != nil {
+				return err
+			}
+		case "error":
+			if start.Name.Space == nsStream {
+				return client.streamError(start)
+			}
+			if err := client.dec.Skip(); err != nil {
+				return err
+			}
+		default:
+			if err := client.dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// answerIQ responds to XEP-0199 pings and rejects any other request, as
+// required for entities that do not understand a get/set payload.
+func answerIQ(client *xmppClient, iq xmppIQ) error {
+	if iq.Type != "get" && iq.Type != "set" {
+		return nil
+	}
+	if iq.Ping != nil {
+		return client.send(xmppIQ{ID: iq.ID, Type: "result", To: iq.From})
+	}
+	data := fmt.Sprintf(`<iq type='error' id='%s' to='%s'><error type='cancel'><service-unavailable xmlns='%s'/></error></iq>`,
+		escapeAttr(iq.ID), escapeAttr(iq.From), nsStanzas)
+	return client.writeRaw(data)
+}
+
+func (a *XMPPAdapter) setSession(configID string, session *xmppSession) {
+	a.mu.Lock()
+	a.sessions[configID] = session
+	a.mu.Unlock()
+}
+
+func (a *XMPPAdapter) clearSession(configID string, session *xmppSession) {
+	a.mu.Lock()
+	if a.sessions[configID] == session {
+		delete(a.sessions, configID)
+	}
+	a.mu.Unlock()
+}
+
+func (a *XMPPAdapter) session(configID string) (*xmppSession, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	session, ok := a.sessions[configID]
+	return session, ok
+}
+
+func buildInboundMessage(session *xmppSession, selfJID string, msg xmppMessage) (channel.InboundMessage, bool) {
+	if msg.Type == "error" || msg.Error != nil || msg.Replace != nil {
+		// Corrections of earlier messages are not new turns.
+		return channel.InboundMessage{}, false
+	}
+	body := stripReplyFallback(msg)
+	text := strings.TrimSpace(body)
+	if text == "" {
+		return channel.InboundMessage{}, false
+	}
+	from := strings.TrimSpace(msg.From)
+	fromBare := bareJID(from)
+	if fromBare == "" {
+		return channel.InboundMessage{}, false
+	}
+
+	var (
+		convType    string
+		convID      string
+		replyTarget string
+		sender      channel.Identity
+		messageID   = strings.TrimSpace(msg.ID)
+		isMentioned bool
+	)
+	if msg.Type == "groupchat" || session.isRoom(fromBare) {
+		nick := resourcepart(from)
+		// Room subject changes and our own reflected messages are not turns;
+		// delayed messages are history replayed on join.
+		if nick == "" || nick == session.nick || msg.Delay != nil {
+			return channel.InboundMessage{}, false
+		}
+		convType = channel.ConversationTypeGroup
+		convID = fromBare
+		replyTarget = fromBare
+		sender = channel.Identity{
+			SubjectID:   fromBare + "/" + nick,
+			DisplayName: nick,
+			Attributes:  map[string]string{"nick": nick, "room": fromBare},
+		}
+		for _, sid := range msg.StanzaIDs {
+			if bareJID(sid.By) == fromBare && strings.TrimSpace(sid.ID) != "" {
+				messageID = strings.TrimSpace(sid.ID)
+				break
+			}
+		}
+		var addressed bool
+		text, addressed = stripAddressing(text, session.nick)
+		text = strings.TrimSpace(text)
+		isMentioned = addressed || containsNick(text, session.nick)
+	} else {
+		if fromBare == bareJID(selfJID) {
+			return channel.InboundMessage{}, false
+		}
+		convType = channel.ConversationTypePrivate
+		convID = fromBare
+		replyTarget = fromBare
+		sender = channel.Identity{
+			SubjectID:   fromBare,
+			DisplayName: localpart(fromBare),
+			Attributes:  map[string]string{"jid": fromBare},
+		}
+		isMentioned = true
+	}
+	if text == "" {
+		return channel.InboundMessage{}, false
+	}
+
+	message := channel.Message{
+		ID:     messageID,
+		Format: channel.MessageFormatPlain,
+		Text:   text,
+	}
+	if msg.Reply != nil && strings.TrimSpace(msg.Reply.ID) != "" {
+		replySender := resourcepart(msg.Reply.To)
+		if replySender == "" {
+			replySender = bareJID(msg.Reply.To)
+		}
+		message.Reply = &channel.ReplyRef{
+			Target:    replyTarget,
+			MessageID: strings.TrimSpace(msg.Reply.ID),
+			Sender:    replySender,
+		}
+	}
+	threadID := strings.TrimSpace(msg.Thread)
+	if threadID != "" {
+		message.Thread = &channel.ThreadRef{ID: threadID}
+	}
+	receivedAt := time.Now().UTC()
+	if msg.Delay != nil {
+		if stamp, err := time.Parse(time.RFC3339, strings.TrimSpace(msg.Delay.Stamp)); err == nil {
+			receivedAt = stamp.UTC()
+		}
+	}
+	return channel.InboundMessage{
+		Channel:     Type,
+		Message:     message,
+		ReplyTarget: replyTarget,
+		Sender:      sender,
+		Conversation: channel.Conversation{
+			ID:       convID,
+			Type:     convType,
+			ThreadID: threadID,
+		},
+		ReceivedAt: receivedAt,
+		Source:     "xmpp",
+		Metadata: map[string]any{
+			"is_mentioned": isMentioned,
+			"origin_id":    strings.TrimSpace(msg.ID),
+		},
+	}, true
+}
+
+// stripReplyFallback removes the quoted fallback that XEP-0461 senders prepend
+// to the body, using the code point ranges from the XEP-0428 fallback marker.
+func stripReplyFallback(msg xmppMessage) string {
+	body := msg.Body
+	if msg.Reply == nil {
+		return body
+	}
+	runes := []rune(body)
+	for _, fb := range msg.Fallbacks {
+		if fb.For != nsReply {
+			continue
+		}
+		for _, rng := range fb.Body {
+			if rng.Start == nil || rng.End == nil {
+				continue
+			}
+			start, end := *rng.Start, *rng.End
+			if start < 0 || end > len(runes) || start >= end {
+				continue
+			}
+			return string(runes[:start]) + string(runes[end:])
+		}
+	}
+	return body
+}
+
+// stripAddressing removes a leading "nick:" or "nick," addressing prefix.
+func stripAddressing(text, nick string) (string, bool) {
+	trimmed := strings.TrimSpace(text)
+	if nick == "" || len(trimmed) <= len(nick) || !strings.EqualFold(trimmed[:len(nick)], nick) {
+		return text, false
+	}
+	switch trimmed[len(nick)] {
+	case ':', ',':
+		return trimmed[len(nick)+1:], true
+	default:
+		return text, false
+	}
+}
+
+func containsNick(text, nick string) bool {
+	if nick == "" {
+		return false
+	}
+	lower := strings.ToLower(text)
+	needle := strings.ToLower(nick)
+	for offset := 0; ; {
+		idx := strings.Index(lower[offset:], needle)
+		if idx < 0 {
+			return false
+		}
+		start := offset + idx
+		end := start + len(needle)
+		before, _ := utf8.DecodeLastRuneInString(lower[:start])
+		after, _ := utf8.DecodeRuneInString(lower[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(lower) || !isWordRune(after)) {
+			return true
+		}
+		offset = end
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+}
+
+func (a *XMPPAdapter) logInbound(configID string, msg channel.InboundMessage) {
+	if a.logger == nil {
+		return
+	}
+	a.logger.Info("inbound received",
+		slog.String("config_id", configID),
+		slog.String("conversation", msg.Conversation.ID),
+		slog.String("sender", msg.Sender.SubjectID),
+		slog.String("text", common.SummarizeText(msg.Message.Text)),
+	)
+}
+
+// --- Sender ---
+
+// Send delivers an outbound message over the config's active stream. Reply
+// and thread references are carried as XEP-0461 reply and <thread> elements.
+func (a *XMPPAdapter) Send(_ context.Context, cfg channel.ChannelConfig, msg channel.PreparedOutboundMessage) error {
+	session, target, err := a.resolveSession(cfg, msg.Target)
+	if err != nil {
+		return err
+	}
+	out, err := buildOutboundMessage(session, target, msg.Message)
+	if err != nil {
+		return err
+	}
+	out.ID = session.client.newID()
+	if err := session.client.send(out); err != nil {
+		if a.logger != nil {
+			a.logger.Error("send failed", slog.String("config_id", cfg.ID), slog.Any("error", err))
+		}
+		return err
+	}
+	return nil
+}
+
+// --- MessageEditor ---
+
+// Update corrects a previously sent message using XEP-0308.
+func (a *XMPPAdapter) Update(_ context.Context, cfg channel.ChannelConfig, target string, messageID string, msg channel.PreparedMessage) error {
+	messageID = strings.TrimSpace(messageID)
+	if messageID == "" {
+		return errors.New("xmpp message id is required")
+	}
+	session, resolved, err := a.resolveSession(cfg, target)
+	if err != nil {
+		return err
+	}
+	msg.Message.Reply = nil
+	out, err := buildOutboundMessage(session, resolved, msg)
+	if err != nil {
+		return err
+	}
+	out.ID = session.client.newID()
+	out.Replace = &xmppReplace{ID: messageID}
+	return session.client.send(out)
+}
+
+// Unsend is not supported; message retraction is not implemented.
+func (*XMPPAdapter) Unsend(context.Context, channel.ChannelConfig, string, string) error {
+	return errors.New("xmpp unsend not supported")
+}
+
+func (a *XMPPAdapter) resolveSession(cfg channel.ChannelConfig, rawTarget string) (*xmppSession, string, error) {
+	target := normalizeTarget(rawTarget)
+	if target == "" {
+		return nil, "", errors.New("xmpp target must be a JID")
+	}
+	session, ok := a.session(cfg.ID)
+	if !ok {
+		return nil, "", errors.New("xmpp connection is not running")
+	}
+	return session, target, nil
+}
+
+func buildOutboundMessage(session *xmppSession, target string, msg channel.PreparedMessage) (xmppMessage, error) {
+	parts := make([]string, 0, 1+len(msg.Attachments))
+	if text := strings.TrimSpace(msg.Message.PlainText()); text != "" {
+		parts = append(parts, text)
+	}
+	for _, att := range msg.Attachments {
+		ref := strings.TrimSpace(att.PublicURL)
+		if ref == "" {
+			ref = strings.TrimSpace(att.Logical.URL)
+		}
+		if ref != "" && !strings.HasPrefix(ref, "data:") {
+			parts = append(parts, ref)
+		}
+	}
+	body := strings.Join(parts, "\n")
+	if body == "" {
+		return xmppMessage{}, errors.New("message is required")
+	}
+	out := xmppMessage{
+		To:   target,
+		Type: "chat",
+		Body: body,
+	}
+	if session.isRoom(target) {
+		out.Type = "groupchat"
+	}
+	if reply := msg.Message.Reply; reply != nil && strings.TrimSpace(reply.MessageID) != "" {
+		out.Reply = &xmppReply{ID: strings.TrimSpace(reply.MessageID)}
+		if sender := strings.TrimSpace(reply.Sender); sender != "" {
+			if out.Type == "groupchat" && !strings.Contains(sender, "/") {
+				sender = target + "/" + sender
+			}
+			if isValidJID(sender) {
+				out.Reply.To = sender
+			}
+		}
+	}
+	if thread := msg.Message.Thread; thread != nil && strings.TrimSpace(thread.ID) != "" {
+		out.Thread = strings.TrimSpace(thread.ID)
+	}
+	return out, nil
+}
+
+// --- StreamSender (block-streaming: buffer deltas, send final once) ---
+
+// OpenStream opens a block-streaming session that buffers deltas and sends
+// the final message, as a reply to the source message, when closed.
+func (a *XMPPAdapter) OpenStream(_ context.Context, cfg channel.ChannelConfig, target string, opts channel.StreamOptions) (channel.PreparedOutboundStream, error) {
+	target = normalizeTarget(target)
+	if target == "" {
+		return nil, errors.New("xmpp target must be a JID")
+	}
+	reply := opts.Reply
+	if reply == nil && strings.TrimSpace(opts.SourceMessageID) != "" {
+		reply = &channel.ReplyRef{Target: target, MessageID: strings.TrimSpace(opts.SourceMessageID)}
+	}
+	return &xmppBlockStream{adapter: a, cfg: cfg, target: target, reply: reply}, nil
+}
+
+type xmppBlockStream struct {
+	adapter     *XMPPAdapter
+	cfg         channel.ChannelConfig
+	target      string
+	reply       *channel.ReplyRef
+	textBuilder strings.Builder
+	attachments []channel.PreparedAttachment
+	final       *channel.PreparedMessage
+	closed      bool
+}
+
+func (s *xmppBlockStream) Push(_ context.Context, event channel.PreparedStreamEvent) error {
+	if s.closed {
+		return nil
+	}
+	switch event.Type {
+	case channel.StreamEventDelta:
+		if event.Delta != "" && event.Phase != channel.StreamPhaseReasoning {
+			s.textBuilder.WriteString(event.Delta)
+		}
+	case channel.StreamEventAttachment:
+		s.attachments = append(s.attachments, event.Attachments...)
+	case channel.StreamEventFinal:
+		if event.Final != nil {
+			msg := event.Final.Message
+			s.final = &msg
+		}
+	}
+	return nil
+}
+
+func (s *xmppBlockStream) Close(ctx context.Context) error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	msg := channel.PreparedMessage{Message: channel.Message{Format: channel.MessageFormatPlain}}
+	if s.final != nil {
+		msg = *s.final
+	}
+	if strings.TrimSpace(msg.Message.PlainText()) == "" {
+		msg.Message.Text = strings.TrimSpace(s.textBuilder.String())
+	}
+	if len(msg.Attachments) == 0 {
+		msg.Attachments = s.attachments
+	}
+	if msg.Message.Reply == nil {
+		msg.Message.Reply = s.reply
+	}
+	if strings.TrimSpace(msg.Message.PlainText()) == "" && len(msg.Attachments) == 0 {
+		return nil
+	}
+	return s.adapter.Send(ctx, s.cfg, channel.PreparedOutboundMessage{
+		Target:  s.target,
+		Message: msg,
+	})
+}
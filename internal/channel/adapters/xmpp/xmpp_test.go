@@ -0,0 +1,193 @@
+package xmpp
+
+import (
+	"context"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/channel/channeltest"
+)
+
+func newTestServer(t *testing.T) *channeltest.XMPPServer {
+	t.Helper()
+	srv, err := channeltest.NewXMPPServer("example.com", "secret")
+	if err != nil {
+		t.Fatalf("start fake xmpp server: %v", err)
+	}
+	t.Cleanup(func() { _ = srv.Close() })
+	return srv
+}
+
+func testConfig(srv *channeltest.XMPPServer) channel.ChannelConfig {
+	return channel.ChannelConfig{
+		ID:          "cfg-1",
+		BotID:       "bot-1",
+		ChannelType: Type,
+		Credentials: map[string]any{
+			"jid":            "memoh@example.com",
+			"password":       "secret",
+			"server":         srv.Addr(),
+			"rooms":          "ops@conference.example.com",
+			"allowPlaintext": true,
+		},
+	}
+}
+
+func decodeMessage(t *testing.T, raw string) xmppMessage {
+	t.Helper()
+	var msg xmppMessage
+	if err := xml.Unmarshal([]byte(raw), &msg); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return msg
+}
+
+func testSession() *xmppSession {
+	return &xmppSession{nick: "memoh", rooms: map[string]struct{}{"ops@conference.example.com": {}}}
+}
+
+func TestBuildInboundMessageGroupReply(t *testing.T) {
+	msg := decodeMessage(t, `<message xmlns='jabber:client' from='ops@conference.example.com/alice' type='groupchat' id='origin-1'>`+
+		`<body>&gt; earlier
+memoh: what about this?</body>`+
+		`<reply xmlns='urn:xmpp:reply:0' to='ops@conference.example.com/memoh' id='prev-1'/>`+
+		`<fallback xmlns='urn:xmpp:fallback:0' for='urn:xmpp:reply:0'><body start='0' end='10'/></fallback>`+
+		`<stanza-id xmlns='urn:xmpp:sid:0' by='ops@conference.example.com' id='sid-1'/>`+
+		`<thread>t-1</thread></message>`)
+
+	inbound, ok := buildInboundMessage(testSession(), "memoh@example.com/memoh", msg)
+	if !ok {
+		t.Fatal("expected inbound message")
+	}
+	if inbound.Message.Text != "what about this?" || inbound.Message.ID != "sid-1" {
+		t.Fatalf("unexpected message: %+v", inbound.Message)
+	}
+	if inbound.Message.Reply == nil || inbound.Message.Reply.MessageID != "prev-1" || inbound.Message.Reply.Sender != "memoh" {
+		t.Fatalf("unexpected reply: %+v", inbound.Message.Reply)
+	}
+	if inbound.Conversation.Type != channel.ConversationTypeGroup || inbound.Conversation.ThreadID != "t-1" || inbound.ReplyTarget != "ops@conference.example.com" {
+		t.Fatalf("unexpected conversation: %+v target=%q", inbound.Conversation, inbound.ReplyTarget)
+	}
+	if mentioned, _ := inbound.Metadata["is_mentioned"].(bool); !mentioned {
+		t.Fatal("expected addressed message to be a mention")
+	}
+}
+
+func TestBuildInboundMessageIgnoresEchoHistoryAndCorrections(t *testing.T) {
+	session := testSession()
+	cases := []string{
+		`<message from='ops@conference.example.com/memoh' type='groupchat'><body>echo</body></message>`,
+		`<message from='ops@conference.example.com/alice' type='groupchat'><body>old</body><delay xmlns='urn:xmpp:delay' stamp='2024-01-02T03:04:05Z'/></message>`,
+		`<message from='alice@example.com/phone' type='chat'><body>fixed</body><replace xmlns='urn:xmpp:message-correct:0' id='m1'/></message>`,
+		`<message from='alice@example.com/phone' type='chat'><body>  </body></message>`,
+	}
+	for _, raw := range cases {
+		if _, ok := buildInboundMessage(session, "memoh@example.com/memoh", decodeMessage(t, raw)); ok {
+			t.Fatalf("expected message to be ignored: %s", raw)
+		}
+	}
+}
+
+func TestConnectReceivesAndReplies(t *testing.T) {
+	srv := newTestServer(t)
+	adapter := NewXMPPAdapter(nil)
+	cfg := testConfig(srv)
+
+	received := make(chan channel.InboundMessage, 1)
+	conn, err := adapter.Connect(context.Background(), cfg, func(_ context.Context, _ channel.ChannelConfig, msg channel.InboundMessage) error {
+		received <- msg
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Stop(context.Background()) })
+
+	if _, err := srv.WaitForStanza(func(s channeltest.XMPPStanza) bool {
+		return s.XMLName.Local == "presence" && s.Attr("to") == "ops@conference.example.com/memoh"
+	}, 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	srv.Deliver(`<message from='alice@example.com/phone' to='memoh@example.com/memoh' type='chat' id='m1'><body>hello</body></message>`)
+
+	select {
+	case msg := <-received:
+		if msg.Message.ID != "m1" || msg.Message.Text != "hello" || msg.Sender.SubjectID != "alice@example.com" {
+			t.Fatalf("unexpected inbound: %+v", msg)
+		}
+		if msg.Conversation.Type != channel.ConversationTypePrivate || msg.ReplyTarget != "alice@example.com" {
+			t.Fatalf("unexpected conversation: %+v", msg.Conversation)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for inbound message")
+	}
+
+	err = adapter.Send(context.Background(), cfg, channel.PreparedOutboundMessage{
+		Target: "ops@conference.example.com",
+		Message: channel.PreparedMessage{Message: channel.Message{
+			Text:  "on it",
+			Reply: &channel.ReplyRef{MessageID: "sid-9", Sender: "alice"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	sent, err := srv.WaitForStanza(func(s channeltest.XMPPStanza) bool {
+		return s.XMLName.Local == "message" && s.Attr("to") == "ops@conference.example.com"
+	}, 2*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent.Attr("type") != "groupchat" || !strings.Contains(sent.InnerXML, "on it") ||
+		!strings.Contains(sent.InnerXML, `id="sid-9"`) || !strings.Contains(sent.InnerXML, "ops@conference.example.com/alice") {
+		t.Fatalf("unexpected outbound stanza: %+v", sent)
+	}
+
+	if err := adapter.Update(context.Background(), cfg, "alice@example.com", "out-1", channel.PreparedMessage{
+		Message: channel.Message{Text: "on it, corrected"},
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	edit, err := srv.WaitForStanza(func(s channeltest.XMPPStanza) bool {
+		return s.XMLName.Local == "message" && s.Attr("to") == "alice@example.com"
+	}, 2*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edit.Attr("type") != "chat" || !strings.Contains(edit.InnerXML, "urn:xmpp:message-correct:0") {
+		t.Fatalf("unexpected correction stanza: %+v", edit)
+	}
+}
+
+func TestSendWithoutSessionFails(t *testing.T) {
+	adapter := NewXMPPAdapter(nil)
+	err := adapter.Send(context.Background(), channel.ChannelConfig{ID: "missing"}, channel.PreparedOutboundMessage{
+		Target:  "alice@example.com",
+		Message: channel.PreparedMessage{Message: channel.Message{Text: "hi"}},
+	})
+	if err == nil {
+		t.Fatal("expected error without an active session")
+	}
+}
+
+func TestDiscoverSelf(t *testing.T) {
+	srv := newTestServer(t)
+	adapter := NewXMPPAdapter(nil)
+
+	creds := testConfig(srv).Credentials
+	identity, externalID, err := adapter.DiscoverSelf(context.Background(), creds)
+	if err != nil {
+		t.Fatalf("discover self: %v", err)
+	}
+	if externalID != "memoh@example.com" || identity["resource"] != "memoh" {
+		t.Fatalf("unexpected identity: %v %q", identity, externalID)
+	}
+
+	creds["password"] = "wrong"
+	if _, _, err := adapter.DiscoverSelf(context.Background(), creds); err == nil {
+		t.Fatal("expected authentication failure")
+	}
+}
@@ -0,0 +1,263 @@
+package channeltest
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IRCServer is a minimal in-process IRC server for adapter tests. It accepts
+// registration (optionally via SASL PLAIN), answers PING, echoes JOIN and
+// records every line received from clients.
+type IRCServer struct {
+	listener net.Listener
+
+	// SASLPassword, when set, is the only password accepted by SASL PLAIN.
+	SASLPassword string
+
+	mu      sync.Mutex
+	clients map[net.Conn]*ircServerClient
+	lines   []string
+	notify  chan struct{}
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+type ircServerClient struct {
+	conn    net.Conn
+	writeMu sync.Mutex
+	nick    string
+}
+
+// NewIRCServer starts a fake IRC server listening on a random loopback port.
+func NewIRCServer() (*IRCServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &IRCServer{
+		listener: ln,
+		clients:  make(map[net.Conn]*ircServerClient),
+		notify:   make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.acceptLoop()
+	return s, nil
+}
+
+// Addr returns the host:port the server listens on.
+func (s *IRCServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Close stops the listener and disconnects all clients.
+func (s *IRCServer) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	for conn := range s.clients {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+// Lines returns a copy of every line received from clients so far.
+func (s *IRCServer) Lines() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.lines...)
+}
+
+// WaitForLine blocks until a received line starts with prefix or the timeout elapses.
+func (s *IRCServer) WaitForLine(prefix string, timeout time.Duration) (string, error) {
+	deadline := time.After(timeout)
+	for {
+		s.mu.Lock()
+		for _, line := range s.lines {
+			if strings.HasPrefix(line, prefix) {
+				s.mu.Unlock()
+				return line, nil
+			}
+		}
+		notify := s.notify
+		s.mu.Unlock()
+		select {
+		case <-notify:
+		case <-deadline:
+			return "", errors.New("timed out waiting for irc line: " + prefix)
+		}
+	}
+}
+
+// Broadcast writes a raw line to every registered client.
+func (s *IRCServer) Broadcast(line string) {
+	s.mu.Lock()
+	clients := make([]*ircServerClient, 0, len(s.clients))
+	for _, c := range s.clients {
+		clients = append(clients, c)
+	}
+	s.mu.Unlock()
+	for _, c := range clients {
+		c.write(line)
+	}
+}
+
+// DisconnectAll drops every client connection without stopping the listener.
+func (s *IRCServer) DisconnectAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.clients {
+		_ = conn.Close()
+	}
+}
+
+func (s *IRCServer) acceptLoop() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		client := &ircServerClient{conn: conn}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = conn.Close()
+			return
+		}
+		s.clients[conn] = client
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go s.serve(client)
+	}
+}
+
+func (s *IRCServer) record(line string) {
+	s.mu.Lock()
+	s.lines = append(s.lines, line)
+	close(s.notify)
+	s.notify = make(chan struct{})
+	s.mu.Unlock()
+}
+
+func (s *IRCServer) serve(c *ircServerClient) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, c.conn)
+		s.mu.Unlock()
+		_ = c.conn.Close()
+	}()
+	reader := bufio.NewReader(c.conn)
+	registered := false
+	capNegotiating := false
+	user := ""
+	for {
+		raw, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line := strings.TrimRight(raw, "\r\n")
+		if line == "" {
+			continue
+		}
+		s.record(line)
+		command, params := splitIRCLine(line)
+		switch command {
+		case "CAP":
+			if len(params) >= 2 && params[0] == "REQ" {
+				capNegotiating = true
+				c.write(":fake.irc CAP * ACK :" + params[1])
+			}
+			if len(params) >= 1 && params[0] == "END" {
+				capNegotiating = false
+			}
+		case "AUTHENTICATE":
+			if len(params) == 0 {
+				continue
+			}
+			if params[0] == "PLAIN" {
+				c.write("AUTHENTICATE +")
+				continue
+			}
+			decoded, _ := base64.StdEncoding.DecodeString(params[0])
+			fields := strings.Split(string(decoded), "\x00")
+			if len(fields) == 3 && fields[2] == s.SASLPassword {
+				c.write(":fake.irc 903 * :SASL authentication successful")
+			} else {
+				c.write(":fake.irc 904 * :SASL authentication failed")
+			}
+		case "NICK":
+			if len(params) > 0 {
+				c.nick = params[0]
+			}
+		case "USER":
+			if len(params) > 0 {
+				user = params[0]
+			}
+		case "PING":
+			token := ""
+			if len(params) > 0 {
+				token = params[0]
+			}
+			c.write(":fake.irc PONG fake.irc :" + token)
+		case "JOIN":
+			if len(params) > 0 {
+				for _, ch := range strings.Split(params[0], ",") {
+					c.write(":" + c.nick + "!" + user + "@localhost JOIN " + ch)
+				}
+			}
+		case "QUIT":
+			return
+		}
+		if !registered && !capNegotiating && c.nick != "" && user != "" {
+			registered = true
+			c.write(":fake.irc 001 " + c.nick + " :Welcome to the fake IRC network " + c.nick)
+		}
+	}
+}
+
+func (c *ircServerClient) write(line string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, _ = c.conn.Write([]byte(line + "\r\n"))
+}
+
+func splitIRCLine(line string) (string, []string) {
+	if strings.HasPrefix(line, "@") {
+		if idx := strings.IndexByte(line, ' '); idx >= 0 {
+			line = line[idx+1:]
+		}
+	}
+	if strings.HasPrefix(line, ":") {
+		if idx := strings.IndexByte(line, ' '); idx >= 0 {
+			line = line[idx+1:]
+		}
+	}
+	trailing := ""
+	hasTrailing := false
+	if idx := strings.Index(line, " :"); idx >= 0 {
+		trailing = line[idx+2:]
+		line = line[:idx]
+		hasTrailing = true
+	}
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return "", nil
+	}
+	params := fields[1:]
+	if hasTrailing {
+		params = append(params, trailing)
+	}
+	return strings.ToUpper(fields[0]), params
+}
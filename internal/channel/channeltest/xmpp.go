@@ -0,0 +1,311 @@
+package channeltest
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// XMPPStanza is a top-level stanza received by XMPPServer.
+type XMPPStanza struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	InnerXML string     `xml:",innerxml"`
+}
+
+// Attr returns the value of the named attribute or an empty string.
+func (s XMPPStanza) Attr(name string) string {
+	for _, attr := range s.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// XMPPServer is a minimal in-process XMPP server for adapter tests. It speaks
+// plaintext client-to-server streams with SASL PLAIN, resource binding and
+// MUC join echoes, and records every stanza received after binding.
+type XMPPServer struct {
+	listener net.Listener
+	domain   string
+	password string
+
+	mu       sync.Mutex
+	clients  map[net.Conn]*xmppServerClient
+	stanzas  []XMPPStanza
+	notify   chan struct{}
+	closed   bool
+	streamID int
+	wg       sync.WaitGroup
+}
+
+type xmppServerClient struct {
+	conn    net.Conn
+	writeMu sync.Mutex
+	jid     string
+	bound   bool
+}
+
+// NewXMPPServer starts a fake XMPP server for domain that accepts any
+// localpart authenticating with password.
+func NewXMPPServer(domain, password string) (*XMPPServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &XMPPServer{
+		listener: ln,
+		domain:   domain,
+		password: password,
+		clients:  make(map[net.Conn]*xmppServerClient),
+		notify:   make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.acceptLoop()
+	return s, nil
+}
+
+// Addr returns the host:port the server listens on.
+func (s *XMPPServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Close stops the listener and disconnects all clients.
+func (s *XMPPServer) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	for conn := range s.clients {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+// Stanzas returns a copy of every stanza received from bound clients.
+func (s *XMPPServer) Stanzas() []XMPPStanza {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]XMPPStanza(nil), s.stanzas...)
+}
+
+// WaitForStanza blocks until a received stanza satisfies match or the timeout elapses.
+func (s *XMPPServer) WaitForStanza(match func(XMPPStanza) bool, timeout time.Duration) (XMPPStanza, error) {
+	deadline := time.After(timeout)
+	for {
+		s.mu.Lock()
+		for _, stanza := range s.stanzas {
+			if match(stanza) {
+				s.mu.Unlock()
+				return stanza, nil
+			}
+		}
+		notify := s.notify
+		s.mu.Unlock()
+		select {
+		case <-notify:
+		case <-deadline:
+			return XMPPStanza{}, errors.New("timed out waiting for xmpp stanza")
+		}
+	}
+}
+
+// Deliver writes a raw stanza to every bound client.
+func (s *XMPPServer) Deliver(stanza string) {
+	s.mu.Lock()
+	clients := make([]*xmppServerClient, 0, len(s.clients))
+	for _, c := range s.clients {
+		if c.bound {
+			clients = append(clients, c)
+		}
+	}
+	s.mu.Unlock()
+	for _, c := range clients {
+		c.write(stanza)
+	}
+}
+
+func (s *XMPPServer) acceptLoop() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		client := &xmppServerClient{conn: conn}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = conn.Close()
+			return
+		}
+		s.clients[conn] = client
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go s.serve(client)
+	}
+}
+
+func (s *XMPPServer) record(stanza XMPPStanza) {
+	s.mu.Lock()
+	s.stanzas = append(s.stanzas, stanza)
+	close(s.notify)
+	s.notify = make(chan struct{})
+	s.mu.Unlock()
+}
+
+func (s *XMPPServer) nextStreamID() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.streamID++
+	return s.streamID
+}
+
+func (s *XMPPServer) serve(c *xmppServerClient) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, c.conn)
+		s.mu.Unlock()
+		_ = c.conn.Close()
+	}()
+	reader := bufio.NewReader(c.conn)
+	localpart, ok := s.authenticate(c, reader)
+	if !ok {
+		return
+	}
+	dec := xml.NewDecoder(reader)
+	if err := s.openStream(c, dec); err != nil {
+		return
+	}
+	c.write(`<stream:features><bind xmlns='urn:ietf:params:xml:ns:xmpp-bind'/><session xmlns='urn:ietf:params:xml:ns:xmpp-session'><optional/></session></stream:features>`)
+	for {
+		start, err := nextStart(dec)
+		if err != nil {
+			return
+		}
+		var stanza XMPPStanza
+		if err := dec.DecodeElement(&stanza, &start); err != nil {
+			return
+		}
+		switch stanza.XMLName.Local {
+		case "iq":
+			s.handleIQ(c, localpart, stanza)
+		case "presence":
+			s.record(stanza)
+			if to := stanza.Attr("to"); to != "" && strings.Contains(stanza.InnerXML, "http://jabber.org/protocol/muc") {
+				c.write(fmt.Sprintf(`<presence from='%s' to='%s'><x xmlns='http://jabber.org/protocol/muc#user'><item affiliation='member' role='participant'/><status code='110'/></x></presence>`, xmlAttr(to), xmlAttr(c.jid)))
+			}
+		default:
+			s.record(stanza)
+		}
+	}
+}
+
+func (s *XMPPServer) authenticate(c *xmppServerClient, reader *bufio.Reader) (string, bool) {
+	dec := xml.NewDecoder(reader)
+	if err := s.openStream(c, dec); err != nil {
+		return "", false
+	}
+	c.write(`<stream:features><mechanisms xmlns='urn:ietf:params:xml:ns:xmpp-sasl'><mechanism>PLAIN</mechanism></mechanisms></stream:features>`)
+	start, err := nextStart(dec)
+	if err != nil || start.Name.Local != "auth" {
+		return "", false
+	}
+	var auth struct {
+		Mechanism string `xml:"mechanism,attr"`
+		Payload   string `xml:",chardata"`
+	}
+	if err := dec.DecodeElement(&auth, &start); err != nil {
+		return "", false
+	}
+	decoded, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(auth.Payload))
+	fields := strings.Split(string(decoded), "\x00")
+	if auth.Mechanism != "PLAIN" || len(fields) != 3 || fields[2] != s.password {
+		c.write(`<failure xmlns='urn:ietf:params:xml:ns:xmpp-sasl'><not-authorized/></failure></stream:stream>`)
+		return "", false
+	}
+	c.write(`<success xmlns='urn:ietf:params:xml:ns:xmpp-sasl'/>`)
+	return fields[1], true
+}
+
+func (s *XMPPServer) openStream(c *xmppServerClient, dec *xml.Decoder) error {
+	start, err := nextStart(dec)
+	if err != nil {
+		return err
+	}
+	if start.Name.Local != "stream" {
+		return errors.New("expected stream header")
+	}
+	c.write(fmt.Sprintf(`<?xml version='1.0'?><stream:stream xmlns='jabber:client' xmlns:stream='http://etherx.jabber.org/streams' id='s%d' from='%s' version='1.0'>`, s.nextStreamID(), xmlAttr(s.domain)))
+	return nil
+}
+
+func (s *XMPPServer) handleIQ(c *xmppServerClient, localpart string, stanza XMPPStanza) {
+	id := xmlAttr(stanza.Attr("id"))
+	switch {
+	case strings.Contains(stanza.InnerXML, "urn:ietf:params:xml:ns:xmpp-bind"):
+		resource := "fake"
+		var bind struct {
+			Resource string `xml:"bind>resource"`
+		}
+		if err := xml.Unmarshal([]byte("<iq>"+stanza.InnerXML+"</iq>"), &bind); err == nil && strings.TrimSpace(bind.Resource) != "" {
+			resource = strings.TrimSpace(bind.Resource)
+		}
+		c.jid = localpart + "@" + s.domain + "/" + resource
+		c.write(fmt.Sprintf(`<iq type='result' id='%s'><bind xmlns='urn:ietf:params:xml:ns:xmpp-bind'><jid>%s</jid></bind></iq>`, id, xmlText(c.jid)))
+		s.mu.Lock()
+		c.bound = true
+		s.mu.Unlock()
+	case strings.Contains(stanza.InnerXML, "urn:ietf:params:xml:ns:xmpp-session"),
+		strings.Contains(stanza.InnerXML, "urn:xmpp:ping"):
+		c.write(fmt.Sprintf(`<iq type='result' id='%s'/>`, id))
+	default:
+		s.record(stanza)
+	}
+}
+
+func (c *xmppServerClient) write(data string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, _ = c.conn.Write([]byte(data))
+}
+
+func nextStart(dec *xml.Decoder) (xml.StartElement, error) {
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			return t, nil
+		case xml.EndElement:
+			return xml.StartElement{}, errors.New("stream closed")
+		}
+	}
+}
+
+func xmlAttr(value string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(value))
+	return strings.ReplaceAll(b.String(), "'", "&#39;")
+}
+
+func xmlText(value string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/memohai/memoh/internal/acl"
 	"github.com/memohai/memoh/internal/attachment"
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/auth"
 	"github.com/memohai/memoh/internal/channel"
 	"github.com/memohai/memoh/internal/channel/route"
@@ -107,6 +108,13 @@ type IMDisplayOptionsReader interface {
 	ShowToolCallsInIM(ctx context.Context, botID string) (bool, error)
 }
 
+// MessageObserver is notified of every ACL-approved inbound message after its
+// route is resolved, e.g. to fire event-triggered schedules. Implementations
+// must not block.
+type MessageObserver interface {
+	ObserveInboundMessage(ctx context.Context, botID, routeID, platform, sender, text string)
+}
+
 // SessionResult carries the minimum fields needed from a session.
 type SessionResult struct {
 	ID   string
@@ -139,6 +147,7 @@ type ChannelInboundProcessor struct {
 	eventStore          *pipelinepkg.EventStore
 	discussDriver       *pipelinepkg.DiscussDriver
 	imDisplayOptions    IMDisplayOptionsReader
+	messageObserver     MessageObserver
 
 	// activeStreams maps "botID:routeID" to a context.CancelFunc for the
 	// currently running agent stream. Used by /stop to abort generation
@@ -283,6 +292,14 @@ func (p *ChannelInboundProcessor) SetIMDisplayOptions(reader IMDisplayOptionsRea
 	p.imDisplayOptions = reader
 }
 
+// SetMessageObserver registers an observer for approved inbound messages.
+func (p *ChannelInboundProcessor) SetMessageObserver(observer MessageObserver) {
+	if p == nil {
+		return
+	}
+	p.messageObserver = observer
+}
+
 // shouldShowToolCallsInIM reports whether tool_call_start / tool_call_end
 // events should reach the IM adapter for the given bot. Failures and missing
 // configuration default to false so tool calls remain hidden unless explicitly
@@ -358,6 +375,11 @@ func (p *ChannelInboundProcessor) HandleInbound(ctx context.Context, cfg channel
 	}
 
 	identity := state.Identity
+	if channelIdentityID := strings.TrimSpace(identity.ChannelIdentityID); channelIdentityID != "" {
+		// Commands and approvals sent from a channel are attributed to the
+		// sender's channel identity.
+		ctx = audit.WithDefaultActor(ctx, audit.Actor{Type: audit.ActorChannelIdentity, ID: channelIdentityID})
+	}
 
 	// Intercept slash commands before they reach the LLM.
 	// Use raw_text (without prepended quote/forward context) so that
@@ -541,6 +563,10 @@ func (p *ChannelInboundProcessor) HandleInbound(ctx context.Context, cfg channel
 		return p.handleToolApprovalCommand(ctx, msg, sender, identity, resolved.RouteID, sessionID, cmdText)
 	}
 
+	if p.messageObserver != nil && text != "" {
+		p.messageObserver.ObserveInboundMessage(ctx, identity.BotID, resolved.RouteID, msg.Channel.String(), strings.TrimSpace(identity.DisplayName), text)
+	}
+
 	// Push event into the DCP pipeline (persist + in-memory projection).
 	// On first access for a session, replay persisted events to warm the pipeline.
 	var latestRC pipelinepkg.RenderedContext
@@ -911,6 +937,7 @@ startStream:
 	if re, _ := msg.Metadata["reasoning_effort"].(string); strings.TrimSpace(re) != "" {
 		chatReq.ReasoningEffort = strings.TrimSpace(re)
 	}
+	chatReq.OutputSchema = inboundOutputSchema(msg.Metadata)
 	// Create a cancellable context so /stop can abort the stream.
 	streamCtx, streamCancel := context.WithCancel(ctx)
 	defer streamCancel()
@@ -3388,3 +3415,21 @@ func (p *ChannelInboundProcessor) handleStatusCommand(
 		Message: statusOut,
 	})
 }
+
+// inboundOutputSchema returns the output schema a message API caller
+// attached to the inbound metadata. Metadata that went through JSON carries
+// the schema as a decoded object instead of raw bytes.
+func inboundOutputSchema(metadata map[string]any) json.RawMessage {
+	switch schema := metadata["output_schema"].(type) {
+	case json.RawMessage:
+		return schema
+	case map[string]any:
+		raw, err := json.Marshal(schema)
+		if err != nil {
+			return nil
+		}
+		return raw
+	default:
+		return nil
+	}
+}
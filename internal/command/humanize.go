@@ -279,6 +279,12 @@ func humanizeStatus(s string) string {
 		return "Queued"
 	case "bounced":
 		return "Bounced"
+	case "retrying":
+		return "Retrying"
+	case "retried":
+		return "Retried"
+	case "dead_letter":
+		return "Dead letter"
 	}
 	r := []rune(t)
 	r[0] = unicode.ToUpper(r[0])
@@ -321,6 +327,12 @@ func humanizeStatusT(cc CommandContext, s string) string {
 		return cc.T("cmd.statusValue.queued")
 	case "bounced":
 		return cc.T("cmd.statusValue.bounced")
+	case "retrying":
+		return cc.T("cmd.statusValue.retrying")
+	case "retried":
+		return cc.T("cmd.statusValue.retried")
+	case "dead_letter":
+		return cc.T("cmd.statusValue.deadLetter")
 	}
 	r := []rune(t)
 	r[0] = unicode.ToUpper(r[0])
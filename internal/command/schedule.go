@@ -33,7 +33,7 @@ func (h *Handler) buildScheduleGroup() *CommandGroup {
 				// enabled schedule is the expected state and needs no flag).
 				fields := []kv{
 					{cc.T("cmd.common.fieldName"), item.Name},
-					{"", humanizeCronT(cc, item.Pattern)},
+					{"", scheduleTriggerT(cc, item)},
 				}
 				if !item.Enabled {
 					fields = append(fields, kv{"", cc.T("cmd.schedule.paused")})
@@ -85,7 +85,7 @@ func (h *Handler) buildScheduleGroup() *CommandGroup {
 			}
 			pairs := []kv{
 				{cc.T("cmd.schedule.fieldDescription"), desc},
-				{cc.T("cmd.schedule.fieldSchedule"), humanizeCronT(cc, item.Pattern)},
+				{cc.T("cmd.schedule.fieldSchedule"), scheduleTriggerT(cc, item)},
 				{cc.T("cmd.schedule.fieldCommand"), item.Command},
 				{cc.T("cmd.common.fieldStatus"), status},
 				{cc.T("cmd.schedule.fieldRuns"), runs},
@@ -125,7 +125,7 @@ func (h *Handler) buildScheduleGroup() *CommandGroup {
 			// pattern was parsed as intended ("did 0 9 * * * mean 9am?").
 			return cc.T("cmd.schedule.created", map[string]any{
 				"name":    MdCode(item.Name),
-				"runs":    renderValue(humanizeCronT(cc, item.Pattern)),
+				"runs":    renderValue(scheduleTriggerT(cc, item)),
 				"command": renderValue(item.Command),
 			}), nil
 		},
@@ -231,9 +231,96 @@ func (h *Handler) buildScheduleGroup() *CommandGroup {
 			return cc.T("cmd.schedule.pausedDone", map[string]any{"name": MdCode(item.Name)}), nil
 		},
 	})
+	g.Register(SubCommand{
+		Name:  "failed",
+		Usage: "failed - List failed schedule runs",
+		ResultHandler: func(cc CommandContext) (*Result, error) {
+			const pageSize = 10
+			page := cc.Page
+			if page < 0 {
+				page = 0
+			}
+			items, total, err := h.scheduleService.ListFailedLogs(cc.Ctx, cc.BotID, pageSize, page*pageSize)
+			if err != nil {
+				return nil, err
+			}
+			if total > 0 && page > 0 && page*pageSize >= int(total) {
+				page = (int(total) - 1) / pageSize
+				items, total, err = h.scheduleService.ListFailedLogs(cc.Ctx, cc.BotID, pageSize, page*pageSize)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if total == 0 {
+				return WithButtons(
+					&Result{Text: cc.T("cmd.schedule.failedEmpty")},
+					ListItem{Label: cc.T("cmd.schedule.back"), Action: &ItemAction{Resource: "schedule", Action: "list"}},
+				), nil
+			}
+			names := map[string]string{}
+			if schedules, err := h.scheduleService.List(cc.Ctx, cc.BotID); err == nil {
+				for _, item := range schedules {
+					names[item.ID] = item.Name
+				}
+			}
+			records := make([]listRecord, 0, len(items))
+			for _, item := range items {
+				name := names[item.ScheduleID]
+				if name == "" {
+					name = item.ScheduleID
+				}
+				fields := []kv{
+					{cc.T("cmd.common.fieldName"), name},
+					{cc.T("cmd.schedule.fieldTime"), humanizeTimeT(cc, item.StartedAt)},
+					{cc.T("cmd.common.fieldStatus"), humanizeStatusT(cc, item.Status)},
+				}
+				if item.Attempt > 1 {
+					fields = append(fields, kv{cc.T("cmd.schedule.fieldAttempt"), strconv.Itoa(item.Attempt)})
+				}
+				records = append(records, listRecord{
+					fields: fields,
+					note:   truncate(item.ErrorMessage, 80),
+					// Tap a failed run to replay it.
+					action: &ItemAction{Resource: "schedule", Action: "replay", Args: []string{item.ID}},
+				})
+			}
+			return buildPagedListResult(cc.T("cmd.schedule.failedTitle"), "schedule", "failed", nil, records, page, pageSize, int(total), "", cc.L), nil
+		},
+	})
+	g.Register(SubCommand{
+		Name:    "replay",
+		Usage:   "replay <log_id> - Replay a failed schedule run",
+		IsWrite: true,
+		Handler: func(cc CommandContext) (string, error) {
+			if len(cc.Args) < 1 {
+				return cc.T("cmd.schedule.replayUsage", map[string]any{"command": CmdRef("schedule replay <log_id>")}), nil
+			}
+			item, err := h.scheduleService.GetLog(cc.Ctx, cc.Args[0])
+			if err != nil || item.BotID != cc.BotID {
+				return "", fmt.Errorf("schedule run %q not found", cc.Args[0])
+			}
+			if err := h.scheduleService.Replay(cc.Ctx, item.ID); err != nil {
+				return "", err
+			}
+			name := item.ScheduleID
+			if sched, err := h.scheduleService.Get(cc.Ctx, item.ScheduleID); err == nil {
+				name = sched.Name
+			}
+			return cc.T("cmd.schedule.replayStarted", map[string]any{"id": MdCode(item.ID), "name": MdCode(name)}), nil
+		},
+	})
 	return g
 }
 
+// scheduleTriggerT describes what fires a schedule: the humanized cron phrase
+// for cron schedules, or the event kind for event-triggered ones.
+func scheduleTriggerT(cc CommandContext, item schedule.Schedule) string {
+	if item.TriggerKind != "" && item.TriggerKind != schedule.TriggerKindCron {
+		return cc.T("cmd.schedule.onEvent", map[string]any{"kind": string(item.TriggerKind)})
+	}
+	return humanizeCronT(cc, item.Pattern)
+}
+
 func (h *Handler) findScheduleByName(cc CommandContext, name string) (schedule.Schedule, error) {
 	items, err := h.scheduleService.List(cc.Ctx, cc.BotID)
 	if err != nil {
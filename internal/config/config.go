@@ -35,6 +35,7 @@ const (
 	DefaultWorkspaceMirrorImage  = "memoh.cn/memohai/workspace:debian"
 	DefaultTimezone              = "UTC"
 	DefaultContainerdRuntimeType = "io.containerd.runc.v2"
+	DefaultEgressProxyAddr       = ":3128"
 
 	ImagePullPolicyIfNotPresent = "if_not_present"
 	ImagePullPolicyAlways       = "always"
@@ -51,8 +52,10 @@ type Config struct {
 	Container    ContainerConfig    `toml:"container"`
 	Containerd   ContainerdConfig   `toml:"containerd"`
 	Docker       DockerConfig       `toml:"docker"`
+	Podman       PodmanConfig       `toml:"podman"`
 	Apple        AppleConfig        `toml:"apple"`
 	Local        LocalConfig        `toml:"local"`
+	Egress       EgressConfig       `toml:"egress"`
 	Workspace    WorkspaceConfig    `toml:"workspace"`
 	Postgres     PostgresConfig     `toml:"postgres"`
 	SQLite       SQLiteConfig       `toml:"sqlite"`
@@ -79,8 +82,65 @@ type AdminConfig struct {
 }
 
 type AuthConfig struct {
-	JWTSecret    string `toml:"jwt_secret"    json:"-"`
-	JWTExpiresIn string `toml:"jwt_expires_in"`
+	JWTSecret    string     `toml:"jwt_secret"    json:"-"`
+	JWTExpiresIn string     `toml:"jwt_expires_in"`
+	OIDC         OIDCConfig `toml:"oidc"`
+}
+
+// OIDCConfig enables single sign-on through an OpenID Connect provider.
+// Local username/password accounts keep working alongside it so an admin
+// can always log in when the identity provider is unavailable.
+type OIDCConfig struct {
+	Enabled      bool     `toml:"enabled"`
+	DisplayName  string   `toml:"display_name"`
+	Issuer       string   `toml:"issuer"`
+	ClientID     string   `toml:"client_id"`
+	ClientSecret string   `toml:"client_secret" json:"-"`
+	RedirectURL  string   `toml:"redirect_url"`
+	Scopes       []string `toml:"scopes"`
+	// UsernameClaim and GroupsClaim name the ID token claims used for the
+	// provisioned username and for role mapping.
+	UsernameClaim string `toml:"username_claim"`
+	GroupsClaim   string `toml:"groups_claim"`
+	// Members of AdminGroups get the admin role, everyone else member. When
+	// AllowedGroups is set, users outside it (and outside AdminGroups) are
+	// refused.
+	AdminGroups   []string `toml:"admin_groups"`
+	AllowedGroups []string `toml:"allowed_groups"`
+	// DisableProvisioning refuses users that have not been linked yet
+	// instead of creating an account on first login.
+	DisableProvisioning bool `toml:"disable_provisioning"`
+	// LinkByEmail attaches a first-time SSO login to an existing local
+	// account with the same verified email instead of refusing it.
+	LinkByEmail bool `toml:"link_by_email"`
+}
+
+func (c OIDCConfig) DisplayNameOrDefault() string {
+	if name := strings.TrimSpace(c.DisplayName); name != "" {
+		return name
+	}
+	return "SSO"
+}
+
+func (c OIDCConfig) ScopesOrDefault() []string {
+	if len(c.Scopes) > 0 {
+		return c.Scopes
+	}
+	return []string{"openid", "profile", "email"}
+}
+
+func (c OIDCConfig) UsernameClaimOrDefault() string {
+	if claim := strings.TrimSpace(c.UsernameClaim); claim != "" {
+		return claim
+	}
+	return "preferred_username"
+}
+
+func (c OIDCConfig) GroupsClaimOrDefault() string {
+	if claim := strings.TrimSpace(c.GroupsClaim); claim != "" {
+		return claim
+	}
+	return "groups"
 }
 
 type DatabaseConfig struct {
@@ -118,6 +178,13 @@ type DockerConfig struct {
 	Host string `toml:"host"`
 }
 
+// PodmanConfig locates the Podman API service. SocketPath takes a path or a
+// unix:// URL; when empty, CONTAINER_HOST, the rootless socket under
+// $XDG_RUNTIME_DIR and then /run/podman/podman.sock are tried.
+type PodmanConfig struct {
+	SocketPath string `toml:"socket_path"`
+}
+
 type AppleConfig struct {
 	SocketPath string `toml:"socket_path"`
 	BinaryPath string `toml:"binary_path"`
@@ -130,6 +197,21 @@ type LocalConfig struct {
 	AllowAbsolutePaths     bool   `toml:"allow_absolute_paths"`
 }
 
+// EgressConfig configures the proxy that workspaces with an egress policy
+// send their traffic through.
+type EgressConfig struct {
+	// ProxyAddr is the listen address of the egress proxy. Workspaces reach
+	// it on their network gateway, so it must listen there.
+	ProxyAddr string `toml:"proxy_addr"`
+}
+
+func (c EgressConfig) ProxyAddrOrDefault() string {
+	if addr := strings.TrimSpace(c.ProxyAddr); addr != "" {
+		return addr
+	}
+	return DefaultEgressProxyAddr
+}
+
 func (c LocalConfig) WorkspaceParent() string {
 	if strings.TrimSpace(c.DefaultWorkspaceParent) != "" {
 		return absPath(expandHome(strings.TrimSpace(c.DefaultWorkspaceParent)))
@@ -46,6 +46,7 @@ type (
 	LayerStatus            = containerapi.LayerStatus
 	NetworkRequest         = containerapi.NetworkRequest
 	NetworkResult          = containerapi.NetworkResult
+	EgressFirewallRules    = containerapi.EgressFirewallRules
 )
 
 const (
@@ -0,0 +1,107 @@
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// egressChain is the chain the egress rules live in. Only the chain and its
+// jump from OUTPUT are touched, so rules an overlay sidecar installs in the
+// shared namespace survive.
+const egressChain = "MEMOH-EGRESS"
+
+func (s *DefaultService) ApplyEgressFirewall(ctx context.Context, req NetworkRequest, rules EgressFirewallRules) error {
+	ctx, netnsPath, err := s.firewallTarget(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, family := range []struct {
+		bin   string
+		rules []string
+	}{
+		{bin: "iptables", rules: rules.IPv4},
+		{bin: "ip6tables", rules: rules.IPv6},
+	} {
+		if err := restoreEgressChain(ctx, netnsPath, family.bin, family.rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DefaultService) RemoveEgressFirewall(ctx context.Context, req NetworkRequest) error {
+	ctx, netnsPath, err := s.firewallTarget(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, bin := range []string{"iptables", "ip6tables"} {
+		if err := runInNetns(ctx, netnsPath, nil, bin, "-w", "-n", "-L", egressChain); err != nil {
+			continue // the chain was never installed
+		}
+		// Drop every jump, including duplicates left by an interrupted apply.
+		for {
+			if err := runInNetns(ctx, netnsPath, nil, bin, "-w", "-D", "OUTPUT", "-j", egressChain); err != nil {
+				break
+			}
+		}
+		if err := runInNetns(ctx, netnsPath, nil, bin, "-w", "-F", egressChain); err != nil {
+			return err
+		}
+		if err := runInNetns(ctx, netnsPath, nil, bin, "-w", "-X", egressChain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DefaultService) firewallTarget(ctx context.Context, req NetworkRequest) (context.Context, string, error) {
+	if req.ContainerID == "" {
+		return ctx, "", ErrInvalidArgument
+	}
+	if req.JoinTarget.PID == 0 && strings.TrimSpace(req.JoinTarget.Value) == "" {
+		task, taskCtx, err := s.getTask(ctx, req.ContainerID)
+		if err != nil {
+			return ctx, "", mapContainerdErr(err)
+		}
+		ctx = taskCtx
+		req.JoinTarget.PID = task.Pid()
+	}
+	_, netnsPath, err := s.resolveNetworkTarget(req)
+	if err != nil {
+		return ctx, "", err
+	}
+	return ctx, netnsPath, nil
+}
+
+// restoreEgressChain replaces the content of the egress chain in one
+// iptables-restore transaction and then makes sure OUTPUT jumps to it, so
+// the namespace is never left without the chain while rules change.
+func restoreEgressChain(ctx context.Context, netnsPath, bin string, rules []string) error {
+	var input strings.Builder
+	input.WriteString("*filter\n")
+	fmt.Fprintf(&input, ":%s - [0:0]\n", egressChain)
+	for _, rule := range rules {
+		fmt.Fprintf(&input, "-A %s %s\n", egressChain, rule)
+	}
+	input.WriteString("COMMIT\n")
+	if err := runInNetns(ctx, netnsPath, strings.NewReader(input.String()), bin+"-restore", "-w", "--noflush"); err != nil {
+		return err
+	}
+	if runInNetns(ctx, netnsPath, nil, bin, "-w", "-C", "OUTPUT", "-j", egressChain) == nil {
+		return nil
+	}
+	return runInNetns(ctx, netnsPath, nil, bin, "-w", "-I", "OUTPUT", "1", "-j", egressChain)
+}
+
+func runInNetns(ctx context.Context, netnsPath string, stdin *strings.Reader, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "nsenter", append([]string{"--net=" + netnsPath, name}, args...)...) //nolint:gosec // G204: fixed binaries, arguments are rendered by the egress package.
+	if stdin != nil {
+		cmd.Stdin = stdin
+	}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s %s: %w: %s", name, strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -12,26 +12,26 @@ import (
 	gocni "github.com/containerd/go-cni"
 )
 
-func (s *DefaultService) setupNetwork(ctx context.Context, req NetworkRequest) (string, error) {
+func (s *DefaultService) setupNetwork(ctx context.Context, req NetworkRequest) (NetworkResult, error) {
 	containerID, netnsPath, err := s.resolveNetworkTarget(req)
 	if err != nil {
-		return "", err
+		return NetworkResult{}, err
 	}
 	cni, err := gocni.New(
 		gocni.WithPluginDir([]string{s.cniBinDir}),
 		gocni.WithPluginConfDir(s.cniConfDir),
 	)
 	if err != nil {
-		return "", err
+		return NetworkResult{}, err
 	}
 	if err := cni.Load(gocni.WithLoNetwork, gocni.WithDefaultConf); err != nil {
-		return "", err
+		return NetworkResult{}, err
 	}
 	result, err := cni.Setup(ctx, containerID, netnsPath)
 	if err != nil {
 		retryable := isDuplicateAllocationError(err) || isVethExistsError(err) || isBridgeMACError(err)
 		if !retryable {
-			return "", err
+			return NetworkResult{}, err
 		}
 		if isBridgeMACError(err) {
 			// Stale bridge with zeroed MAC after container restart; delete it so
@@ -41,14 +41,14 @@ func (s *DefaultService) setupNetwork(ctx context.Context, req NetworkRequest) (
 		_ = cni.Remove(ctx, containerID, netnsPath)
 		result, err = cni.Setup(ctx, containerID, netnsPath)
 		if err != nil {
-			return "", err
+			return NetworkResult{}, err
 		}
 	}
 	ip := extractIP(result)
 	if ip == "" {
-		return "", fmt.Errorf("cni setup returned no usable IP for %s", containerID)
+		return NetworkResult{}, fmt.Errorf("cni setup returned no usable IP for %s", containerID)
 	}
-	return ip, nil
+	return NetworkResult{IP: ip, Gateway: extractGateway(result)}, nil
 }
 
 func (s *DefaultService) resolveNetworkTarget(req NetworkRequest) (string, string, error) {
@@ -93,6 +93,20 @@ func extractIP(result *gocni.Result) string {
 	return ""
 }
 
+func extractGateway(result *gocni.Result) string {
+	if result == nil {
+		return ""
+	}
+	for _, cfg := range result.Interfaces {
+		for _, ipCfg := range cfg.IPConfigs {
+			if ipCfg.Gateway != nil {
+				return ipCfg.Gateway.String()
+			}
+		}
+	}
+	return ""
+}
+
 func (s *DefaultService) checkNetwork(ctx context.Context, req NetworkRequest) error {
 	containerID, netnsPath, err := s.resolveNetworkTarget(req)
 	if err != nil {
@@ -832,6 +832,16 @@ func (s *DefaultService) SnapshotUsage(ctx context.Context, snapshotter, key str
 	return result, nil
 }
 
+// RemoveSnapshot removes a committed snapshot. Snapshots other snapshots are
+// built on cannot be removed until their children are gone.
+func (s *DefaultService) RemoveSnapshot(ctx context.Context, snapshotter, key string) error {
+	if snapshotter == "" || key == "" {
+		return ErrInvalidArgument
+	}
+	ctx = s.withNamespace(ctx)
+	return mapContainerdErr(s.client.SnapshotService(snapshotter).Remove(ctx, key))
+}
+
 func (s *DefaultService) SetupNetwork(ctx context.Context, req NetworkRequest) (NetworkResult, error) {
 	if req.ContainerID == "" {
 		return NetworkResult{}, ErrInvalidArgument
@@ -844,11 +854,11 @@ func (s *DefaultService) SetupNetwork(ctx context.Context, req NetworkRequest) (
 		ctx = taskCtx
 		req.JoinTarget.PID = task.Pid()
 	}
-	ip, err := s.setupNetwork(ctx, req)
+	result, err := s.setupNetwork(ctx, req)
 	if err != nil {
 		return NetworkResult{}, mapContainerdErr(err)
 	}
-	return NetworkResult{IP: ip}, nil
+	return result, nil
 }
 
 func (s *DefaultService) RemoveNetwork(ctx context.Context, req NetworkRequest) error {
@@ -7,4 +7,5 @@ const (
 	BackendContainerd = "containerd"
 	BackendApple      = "apple"
 	BackendDocker     = "docker"
+	BackendPodman     = "podman"
 )
@@ -0,0 +1,973 @@
+package podman
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/memohai/memoh/internal/config"
+	containerapi "github.com/memohai/memoh/internal/container"
+)
+
+const (
+	// apiPrefix is the versioned libpod endpoint root. Podman 4 and 5 both
+	// serve it.
+	apiPrefix = "/v4.0.0/libpod"
+
+	// snapshotImageRepository is fully qualified: Podman stores unqualified
+	// local images under localhost/, and short-name resolution must never
+	// send a snapshot lookup to a remote registry.
+	snapshotImageRepository = "localhost/memoh-workspace-snapshot"
+	snapshotParentLabel     = "memoh.snapshot_parent"
+	storageDriver           = "podman"
+	bridgeTCPPort           = 9090
+	workspaceContainerPref  = "workspace-"
+
+	rootfulSocketPath = "/run/podman/podman.sock"
+	cpuPeriod         = 100_000
+)
+
+var invalidSnapshotTagChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// Service implements the container service against the libpod REST API of
+// a Podman service, rootless or rootful. Snapshots commit the container to
+// an image like the Docker backend does; Podman checkpoints need CRIU and
+// root, so they are not used.
+type Service struct {
+	client  *http.Client
+	baseURL string
+	logger  *slog.Logger
+}
+
+func NewService(log *slog.Logger, cfg config.Config) (*Service, error) {
+	if log == nil {
+		log = slog.Default()
+	}
+	socketPath, err := resolveSocketPath(cfg.Podman.SocketPath)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socketPath)
+		},
+		MaxIdleConns:    4,
+		IdleConnTimeout: 30 * time.Second,
+	}
+	return &Service{
+		client:  &http.Client{Transport: transport},
+		baseURL: "http://podman" + apiPrefix,
+		logger:  log.With(slog.String("service", "podman"), slog.String("socket", socketPath)),
+	}, nil
+}
+
+// resolveSocketPath picks the configured socket, then CONTAINER_HOST, then
+// the rootless socket of the current user, then the rootful one.
+func resolveSocketPath(configured string) (string, error) {
+	for _, candidate := range []string{configured, os.Getenv("CONTAINER_HOST")} {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "" {
+			continue
+		}
+		if scheme, rest, ok := strings.Cut(candidate, "://"); ok {
+			if scheme != "unix" {
+				return "", fmt.Errorf("podman: unsupported socket address %q, only unix:// is supported", candidate)
+			}
+			candidate = rest
+		}
+		return candidate, nil
+	}
+	if runtimeDir := strings.TrimSpace(os.Getenv("XDG_RUNTIME_DIR")); runtimeDir != "" {
+		rootless := filepath.Join(runtimeDir, "podman", "podman.sock")
+		if _, err := os.Stat(rootless); err == nil {
+			return rootless, nil
+		}
+	}
+	return rootfulSocketPath, nil
+}
+
+func (s *Service) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+func (s *Service) PullImage(ctx context.Context, ref string, opts *containerapi.PullImageOptions) (containerapi.ImageInfo, error) {
+	ref = config.NormalizeImageRef(strings.TrimSpace(ref))
+	if ref == "" {
+		return containerapi.ImageInfo{}, containerapi.ErrInvalidArgument
+	}
+	resp, err := s.do(ctx, http.MethodPost, "/images/pull", url.Values{"reference": {ref}, "policy": {"always"}}, nil)
+	if err != nil {
+		return containerapi.ImageInfo{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	var onProgress func(containerapi.PullProgress)
+	if opts != nil {
+		onProgress = opts.OnProgress
+	}
+	if err := decodePullStream(resp.Body, onProgress); err != nil {
+		return containerapi.ImageInfo{}, err
+	}
+	return s.GetImage(ctx, ref)
+}
+
+func (s *Service) GetImage(ctx context.Context, ref string) (containerapi.ImageInfo, error) {
+	ref = config.NormalizeImageRef(strings.TrimSpace(ref))
+	if ref == "" {
+		return containerapi.ImageInfo{}, containerapi.ErrInvalidArgument
+	}
+	var info imageInspect
+	if err := s.call(ctx, http.MethodGet, "/images/"+url.PathEscape(ref)+"/json", nil, nil, &info); err != nil {
+		return containerapi.ImageInfo{}, err
+	}
+	return imageInfoFromInspect(ref, info), nil
+}
+
+func (s *Service) ListImages(ctx context.Context) ([]containerapi.ImageInfo, error) {
+	var images []imageSummary
+	if err := s.call(ctx, http.MethodGet, "/images/json", url.Values{"all": {"true"}}, nil, &images); err != nil {
+		return nil, err
+	}
+	out := make([]containerapi.ImageInfo, len(images))
+	for i, img := range images {
+		out[i] = imageInfoFromSummary(img)
+	}
+	return out, nil
+}
+
+func (s *Service) DeleteImage(ctx context.Context, ref string, _ *containerapi.DeleteImageOptions) error {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return containerapi.ErrInvalidArgument
+	}
+	err := s.removeImage(ctx, ref)
+	if err != nil {
+		normalized := config.NormalizeImageRef(ref)
+		if normalized != ref && containerapi.IsNotFound(err) {
+			err = s.removeImage(ctx, normalized)
+		}
+	}
+	return err
+}
+
+func (s *Service) removeImage(ctx context.Context, ref string) error {
+	return s.call(ctx, http.MethodDelete, "/images/"+url.PathEscape(ref), url.Values{"force": {"true"}}, nil, nil)
+}
+
+func (*Service) ResolveRemoteDigest(context.Context, string) (string, error) {
+	return "", containerapi.ErrNotSupported
+}
+
+func (s *Service) CreateContainer(ctx context.Context, req containerapi.CreateContainerRequest) (containerapi.ContainerInfo, error) {
+	if strings.TrimSpace(req.ID) == "" || strings.TrimSpace(req.ImageRef) == "" {
+		return containerapi.ContainerInfo{}, containerapi.ErrInvalidArgument
+	}
+	var created struct {
+		ID string `json:"Id"`
+	}
+	if err := s.call(ctx, http.MethodPost, "/containers/create", nil, specFromRequest(req), &created); err != nil {
+		return containerapi.ContainerInfo{}, err
+	}
+	return s.GetContainer(ctx, created.ID)
+}
+
+func specFromRequest(req containerapi.CreateContainerRequest) specGenerator {
+	labels := cloneLabels(req.Labels)
+	if req.StorageRef.Key != "" {
+		labels[containerapi.StorageKeyLabel] = req.StorageRef.Key
+	}
+	spec := specGenerator{
+		Name:      req.ID,
+		Image:     config.NormalizeImageRef(req.ImageRef),
+		Command:   req.Spec.Cmd,
+		Env:       envMap(req.Spec.Env),
+		WorkDir:   req.Spec.WorkDir,
+		User:      req.Spec.User,
+		Terminal:  req.Spec.TTY,
+		Labels:    labels,
+		Mounts:    toPodmanMounts(req.Spec.Mounts),
+		DNSServer: req.Spec.DNS,
+		Init:      true,
+		CapAdd:    req.Spec.AddedCapabilities,
+		PortMappings: []portMapping{{
+			HostIP:        "127.0.0.1",
+			ContainerPort: bridgeTCPPort,
+			Protocol:      "tcp",
+		}},
+	}
+	spec.Env["BRIDGE_TCP_ADDR"] = ":" + strconv.Itoa(bridgeTCPPort)
+	for _, device := range req.Spec.CDIDevices {
+		spec.Devices = append(spec.Devices, linuxDevice{Path: device})
+	}
+	if req.ResourceLimits.MemoryBytes > 0 || req.ResourceLimits.CPUMillicores > 0 {
+		spec.ResourceLimits = &resourceLimits{}
+		if req.ResourceLimits.MemoryBytes > 0 {
+			spec.ResourceLimits.Memory = &memoryLimit{Limit: req.ResourceLimits.MemoryBytes}
+		}
+		if req.ResourceLimits.CPUMillicores > 0 {
+			spec.ResourceLimits.CPU = &cpuLimit{
+				Quota:  req.ResourceLimits.CPUMillicores * cpuPeriod / 1000,
+				Period: cpuPeriod,
+			}
+		}
+	}
+	if target := strings.TrimSpace(req.Spec.NetworkJoinTarget.Value); target != "" {
+		if strings.HasPrefix(target, "/") {
+			spec.NetNS = &namespace{NSMode: "path", Value: target}
+		} else {
+			spec.NetNS = &namespace{NSMode: "none"}
+		}
+		spec.PortMappings = nil
+	}
+	return spec
+}
+
+func (s *Service) BridgeTarget(botID string) string {
+	if strings.TrimSpace(botID) == "" {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	info, err := s.inspectContainer(ctx, workspaceContainerPref+strings.TrimSpace(botID))
+	if err != nil {
+		return ""
+	}
+	if host := firstHostPort(info, bridgeTCPPort); host != "" {
+		return host
+	}
+	ip := firstContainerIP(info)
+	if ip == "" {
+		return ""
+	}
+	return net.JoinHostPort(ip, strconv.Itoa(bridgeTCPPort))
+}
+
+func firstHostPort(info containerInspect, port int) string {
+	if info.NetworkSettings == nil {
+		return ""
+	}
+	for _, binding := range info.NetworkSettings.Ports[strconv.Itoa(port)+"/tcp"] {
+		hostIP := strings.TrimSpace(binding.HostIP)
+		hostPort := strings.TrimSpace(binding.HostPort)
+		if hostPort == "" || hostPort == "0" {
+			continue
+		}
+		if hostIP == "" || hostIP == "0.0.0.0" || hostIP == "::" {
+			hostIP = "127.0.0.1"
+		}
+		return net.JoinHostPort(hostIP, hostPort)
+	}
+	return ""
+}
+
+func (s *Service) inspectContainer(ctx context.Context, id string) (containerInspect, error) {
+	var info containerInspect
+	err := s.call(ctx, http.MethodGet, "/containers/"+url.PathEscape(id)+"/json", nil, nil, &info)
+	return info, err
+}
+
+func (s *Service) listContainers(ctx context.Context, filters map[string][]string) ([]containerSummary, error) {
+	query := url.Values{"all": {"true"}}
+	if len(filters) > 0 {
+		raw, err := json.Marshal(filters)
+		if err != nil {
+			return nil, err
+		}
+		query.Set("filters", string(raw))
+	}
+	var items []containerSummary
+	if err := s.call(ctx, http.MethodGet, "/containers/json", query, nil, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *Service) GetContainer(ctx context.Context, id string) (containerapi.ContainerInfo, error) {
+	if strings.TrimSpace(id) == "" {
+		return containerapi.ContainerInfo{}, containerapi.ErrInvalidArgument
+	}
+	info, err := s.inspectContainer(ctx, id)
+	if err != nil {
+		return containerapi.ContainerInfo{}, err
+	}
+	return containerInfoFromInspect(info), nil
+}
+
+func (s *Service) ListContainers(ctx context.Context) ([]containerapi.ContainerInfo, error) {
+	items, err := s.listContainers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]containerapi.ContainerInfo, len(items))
+	for i, item := range items {
+		out[i] = containerInfoFromSummary(item)
+	}
+	return out, nil
+}
+
+func (s *Service) DeleteContainer(ctx context.Context, id string, opts *containerapi.DeleteContainerOptions) error {
+	if strings.TrimSpace(id) == "" {
+		return containerapi.ErrInvalidArgument
+	}
+	removeVolumes := opts != nil && opts.CleanupSnapshot
+	query := url.Values{"force": {"true"}, "v": {strconv.FormatBool(removeVolumes)}}
+	return s.call(ctx, http.MethodDelete, "/containers/"+url.PathEscape(id), query, nil, nil)
+}
+
+func (s *Service) ListContainersByLabel(ctx context.Context, key, value string) ([]containerapi.ContainerInfo, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, containerapi.ErrInvalidArgument
+	}
+	label := strings.TrimSpace(key)
+	if strings.TrimSpace(value) != "" {
+		label += "=" + strings.TrimSpace(value)
+	}
+	items, err := s.listContainers(ctx, map[string][]string{"label": {label}})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]containerapi.ContainerInfo, len(items))
+	for i, item := range items {
+		out[i] = containerInfoFromSummary(item)
+	}
+	return out, nil
+}
+
+func (s *Service) RestoreContainer(ctx context.Context, req containerapi.CreateContainerRequest) (containerapi.ContainerInfo, error) {
+	if strings.TrimSpace(req.ID) == "" || strings.TrimSpace(req.StorageRef.Key) == "" {
+		return containerapi.ContainerInfo{}, containerapi.ErrInvalidArgument
+	}
+	req.ImageRef = snapshotImageRef(req.StorageRef.Key)
+	return s.CreateContainer(ctx, req)
+}
+
+func (s *Service) StartContainer(ctx context.Context, id string, _ *containerapi.StartTaskOptions) error {
+	if strings.TrimSpace(id) == "" {
+		return containerapi.ErrInvalidArgument
+	}
+	return s.call(ctx, http.MethodPost, "/containers/"+url.PathEscape(id)+"/start", nil, nil, nil)
+}
+
+func (s *Service) StopContainer(ctx context.Context, id string, opts *containerapi.StopTaskOptions) error {
+	if strings.TrimSpace(id) == "" {
+		return containerapi.ErrInvalidArgument
+	}
+	if opts != nil && opts.Signal == syscall.SIGKILL {
+		return s.kill(ctx, id, "SIGKILL")
+	}
+	query := url.Values{}
+	if opts != nil && opts.Timeout > 0 {
+		seconds := int(opts.Timeout.Seconds())
+		if seconds == 0 {
+			seconds = 1
+		}
+		query.Set("timeout", strconv.Itoa(seconds))
+	}
+	if opts != nil && opts.Signal != 0 && opts.Signal != syscall.SIGTERM {
+		// The stop endpoint always sends the container's stop signal, so a
+		// different one is delivered first and stop waits for the exit.
+		if err := s.kill(ctx, id, signalName(opts.Signal)); err != nil && !containerapi.IsNotFound(err) {
+			return err
+		}
+	}
+	err := s.call(ctx, http.MethodPost, "/containers/"+url.PathEscape(id)+"/stop", query, nil, nil)
+	if err == nil || opts == nil || !opts.Force {
+		return err
+	}
+	return s.kill(ctx, id, "SIGKILL")
+}
+
+func (s *Service) kill(ctx context.Context, id, signal string) error {
+	return s.call(ctx, http.MethodPost, "/containers/"+url.PathEscape(id)+"/kill", url.Values{"signal": {signal}}, nil, nil)
+}
+
+func (s *Service) DeleteTask(ctx context.Context, id string, opts *containerapi.DeleteTaskOptions) error {
+	info, err := s.inspectContainer(ctx, id)
+	if err != nil {
+		return err
+	}
+	if info.State == nil || !info.State.Running {
+		return nil
+	}
+	if opts != nil && opts.Force {
+		return s.kill(ctx, id, "SIGKILL")
+	}
+	return s.StopContainer(ctx, id, nil)
+}
+
+func (s *Service) GetTaskInfo(ctx context.Context, id string) (containerapi.TaskInfo, error) {
+	info, err := s.inspectContainer(ctx, id)
+	if err != nil {
+		return containerapi.TaskInfo{}, err
+	}
+	return taskInfoFromInspect(info), nil
+}
+
+func (s *Service) GetContainerMetrics(ctx context.Context, id string) (containerapi.ContainerMetrics, error) {
+	if strings.TrimSpace(id) == "" {
+		return containerapi.ContainerMetrics{}, containerapi.ErrInvalidArgument
+	}
+	var report statsReport
+	query := url.Values{"containers": {id}, "stream": {"false"}}
+	if err := s.call(ctx, http.MethodGet, "/containers/stats", query, nil, &report); err != nil {
+		return containerapi.ContainerMetrics{}, err
+	}
+	if report.Error != nil && report.Error.Message != "" {
+		return containerapi.ContainerMetrics{}, mapAPIError(&apiError{Status: http.StatusInternalServerError, Message: report.Error.Message})
+	}
+	if len(report.Stats) == 0 {
+		return containerapi.ContainerMetrics{}, errors.Join(containerapi.ErrNotFound, fmt.Errorf("no stats for container %s", id))
+	}
+	return metricsFromStats(report.Stats[0], time.Now()), nil
+}
+
+func (s *Service) ListTasks(ctx context.Context, _ *containerapi.ListTasksOptions) ([]containerapi.TaskInfo, error) {
+	items, err := s.listContainers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]containerapi.TaskInfo, 0, len(items))
+	for _, item := range items {
+		out = append(out, taskInfoFromSummary(item))
+	}
+	return out, nil
+}
+
+func (s *Service) SetupNetwork(ctx context.Context, req containerapi.NetworkRequest) (containerapi.NetworkResult, error) {
+	info, err := s.inspectContainer(ctx, req.ContainerID)
+	if err != nil {
+		return containerapi.NetworkResult{}, err
+	}
+	return containerapi.NetworkResult{IP: firstContainerIP(info), Gateway: firstGateway(info)}, nil
+}
+
+func (*Service) RemoveNetwork(context.Context, containerapi.NetworkRequest) error {
+	return nil
+}
+
+func (s *Service) CheckNetwork(ctx context.Context, req containerapi.NetworkRequest) error {
+	_, err := s.inspectContainer(ctx, req.ContainerID)
+	return err
+}
+
+func (s *Service) CommitSnapshot(ctx context.Context, req containerapi.CommitSnapshotRequest) error {
+	name := strings.TrimSpace(req.Target.Key)
+	key := strings.TrimSpace(req.Source.Key)
+	if name == "" || key == "" {
+		return containerapi.ErrInvalidArgument
+	}
+	if !supportedDriver(req.Source.Driver) {
+		return containerapi.ErrNotSupported
+	}
+	info, err := s.inspectContainer(ctx, key)
+	if err != nil {
+		return err
+	}
+	parent := ""
+	if info.Config != nil {
+		parent = strings.TrimSpace(info.Config.Labels[containerapi.StorageKeyLabel])
+	}
+	query := url.Values{
+		"container": {key},
+		"repo":      {snapshotImageRepository},
+		"tag":       {snapshotTag(name)},
+		"comment":   {"memoh workspace snapshot"},
+		"changes":   {"LABEL " + containerapi.StorageKeyLabel + "=" + name},
+	}
+	if parent != "" {
+		query.Add("changes", "LABEL "+snapshotParentLabel+"="+parent)
+	}
+	return s.call(ctx, http.MethodPost, "/commit", query, nil, nil)
+}
+
+func (s *Service) ListSnapshots(ctx context.Context, req containerapi.ListSnapshotsRequest) ([]containerapi.SnapshotInfo, error) {
+	if !supportedDriver(req.Driver) {
+		return nil, containerapi.ErrNotSupported
+	}
+	filters, err := json.Marshal(map[string][]string{"label": {containerapi.StorageKeyLabel}})
+	if err != nil {
+		return nil, err
+	}
+	var images []imageSummary
+	if err := s.call(ctx, http.MethodGet, "/images/json", url.Values{"all": {"true"}, "filters": {string(filters)}}, nil, &images); err != nil {
+		return nil, err
+	}
+	out := make([]containerapi.SnapshotInfo, 0, len(images))
+	for _, img := range images {
+		out = appendImageSnapshots(out, img)
+	}
+	containers, err := s.listContainers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range containers {
+		if snapshot, ok := activeSnapshotFromContainer(containerInfoFromSummary(item)); ok {
+			out = append(out, snapshot)
+		}
+	}
+	return out, nil
+}
+
+func (s *Service) PrepareSnapshot(ctx context.Context, req containerapi.PrepareSnapshotRequest) error {
+	key := strings.TrimSpace(req.Target.Key)
+	parent := strings.TrimSpace(req.Parent.Key)
+	if key == "" || parent == "" {
+		return containerapi.ErrInvalidArgument
+	}
+	if !supportedDriver(req.Target.Driver) {
+		return containerapi.ErrNotSupported
+	}
+	query := url.Values{"repo": {snapshotImageRepository}, "tag": {snapshotTag(key)}}
+	return s.call(ctx, http.MethodPost, "/images/"+url.PathEscape(snapshotImageRef(parent))+"/tag", query, nil, nil)
+}
+
+func supportedDriver(driver string) bool {
+	driver = strings.TrimSpace(driver)
+	return driver == "" || driver == storageDriver
+}
+
+func appendImageSnapshots(out []containerapi.SnapshotInfo, img imageSummary) []containerapi.SnapshotInfo {
+	name := strings.TrimSpace(img.Labels[containerapi.StorageKeyLabel])
+	created := time.Unix(img.Created, 0)
+	labels := cloneLabels(img.Labels)
+	if name != "" {
+		out = append(out, containerapi.SnapshotInfo{
+			Name:    name,
+			Parent:  strings.TrimSpace(img.Labels[snapshotParentLabel]),
+			Kind:    "committed",
+			Created: created,
+			Updated: created,
+			Labels:  labels,
+		})
+	}
+	for _, tag := range img.RepoTags {
+		tagName, ok := snapshotNameFromImageRef(tag)
+		if !ok || tagName == "" || tagName == name {
+			continue
+		}
+		out = append(out, containerapi.SnapshotInfo{
+			Name:    tagName,
+			Parent:  name,
+			Kind:    "committed",
+			Created: created,
+			Updated: created,
+			Labels:  labels,
+		})
+	}
+	return out
+}
+
+func snapshotNameFromImageRef(ref string) (string, bool) {
+	name := strings.TrimSpace(ref)
+	prefix := snapshotImageRepository + ":"
+	if !strings.HasPrefix(name, prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(name, prefix)), true
+}
+
+func activeSnapshotFromContainer(info containerapi.ContainerInfo) (containerapi.SnapshotInfo, bool) {
+	name := strings.TrimSpace(info.StorageRef.Key)
+	if name == "" {
+		return containerapi.SnapshotInfo{}, false
+	}
+	parent := strings.TrimSpace(info.Labels[containerapi.StorageKeyLabel])
+	if parent == name {
+		parent = ""
+	}
+	return containerapi.SnapshotInfo{
+		Name:    name,
+		Parent:  parent,
+		Kind:    "active",
+		Created: info.CreatedAt,
+		Updated: info.UpdatedAt,
+		Labels:  cloneLabels(info.Labels),
+	}, true
+}
+
+func snapshotImageRef(name string) string {
+	return snapshotImageRepository + ":" + snapshotTag(name)
+}
+
+func snapshotTag(name string) string {
+	tag := invalidSnapshotTagChars.ReplaceAllString(strings.TrimSpace(name), "-")
+	tag = strings.Trim(tag, ".-")
+	if tag == "" {
+		tag = "snapshot"
+	}
+	if len(tag) > 128 {
+		tag = tag[:128]
+		tag = strings.TrimRight(tag, ".-")
+	}
+	return tag
+}
+
+func toPodmanMounts(in []containerapi.MountSpec) []specMount {
+	out := make([]specMount, 0, len(in))
+	for _, m := range in {
+		if strings.TrimSpace(m.Source) == "" || strings.TrimSpace(m.Destination) == "" {
+			continue
+		}
+		mountType := strings.TrimSpace(m.Type)
+		switch mountType {
+		case "volume", "tmpfs":
+		default:
+			mountType = "bind"
+		}
+		options := append([]string(nil), m.Options...)
+		if mountType == "bind" && !hasOption(options, "bind", "rbind") {
+			options = append(options, "rbind")
+		}
+		out = append(out, specMount{
+			Destination: m.Destination,
+			Type:        mountType,
+			Source:      m.Source,
+			Options:     options,
+		})
+	}
+	return out
+}
+
+func hasOption(options []string, names ...string) bool {
+	for _, opt := range options {
+		for _, name := range names {
+			if strings.TrimSpace(opt) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func envMap(env []string) map[string]string {
+	out := make(map[string]string, len(env)+1)
+	for _, item := range env {
+		key, value, _ := strings.Cut(item, "=")
+		if key != "" {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func cloneLabels(in map[string]string) map[string]string {
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func imageInfoFromInspect(ref string, info imageInspect) containerapi.ImageInfo {
+	tags := append([]string(nil), info.RepoTags...)
+	name := ref
+	if len(tags) > 0 {
+		name = tags[0]
+	}
+	return containerapi.ImageInfo{Name: name, ID: info.ID, Tags: tags}
+}
+
+func imageInfoFromSummary(info imageSummary) containerapi.ImageInfo {
+	name := info.ID
+	if len(info.RepoTags) > 0 {
+		name = info.RepoTags[0]
+	}
+	return containerapi.ImageInfo{Name: name, ID: info.ID, Tags: append([]string(nil), info.RepoTags...)}
+}
+
+func containerInfoFromInspect(info containerInspect) containerapi.ContainerInfo {
+	imageRef := info.ImageName
+	if info.Config != nil && strings.TrimSpace(info.Config.Image) != "" {
+		imageRef = info.Config.Image
+	}
+	if imageRef == "" {
+		imageRef = info.Image
+	}
+	var labels map[string]string
+	if info.Config != nil {
+		labels = info.Config.Labels
+	}
+	id := strings.TrimPrefix(strings.TrimSpace(info.Name), "/")
+	if id == "" {
+		id = info.ID
+	}
+	return containerapi.ContainerInfo{
+		ID:         id,
+		Image:      imageRef,
+		Labels:     labels,
+		StorageRef: containerapi.StorageRef{Driver: storageDriver, Key: info.ID, Kind: "container"},
+		Runtime:    containerapi.RuntimeInfo{Name: storageDriver},
+		CreatedAt:  info.Created,
+		UpdatedAt:  info.Created,
+	}
+}
+
+func containerInfoFromSummary(info containerSummary) containerapi.ContainerInfo {
+	id := info.ID
+	for _, name := range info.Names {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "/")
+		if name != "" {
+			id = name
+			break
+		}
+	}
+	return containerapi.ContainerInfo{
+		ID:         id,
+		Image:      info.Image,
+		Labels:     info.Labels,
+		StorageRef: containerapi.StorageRef{Driver: storageDriver, Key: info.ID, Kind: "container"},
+		Runtime:    containerapi.RuntimeInfo{Name: storageDriver},
+		CreatedAt:  info.Created,
+		UpdatedAt:  info.Created,
+	}
+}
+
+func taskInfoFromInspect(info containerInspect) containerapi.TaskInfo {
+	task := containerapi.TaskInfo{ContainerID: info.ID, ID: info.ID, Status: containerapi.TaskStatusUnknown}
+	if info.State == nil {
+		return task
+	}
+	if info.State.Pid > 0 {
+		task.PID = uint32(info.State.Pid) //nolint:gosec // Podman PIDs are non-negative here
+	}
+	if info.State.ExitCode > 0 {
+		task.ExitCode = uint32(info.State.ExitCode) //nolint:gosec // exit codes are small non-negative values
+	}
+	task.Status = taskStatusFromPodman(info.State.Status, info.State.Running, info.State.Paused)
+	return task
+}
+
+func taskInfoFromSummary(info containerSummary) containerapi.TaskInfo {
+	return containerapi.TaskInfo{
+		ContainerID: info.ID,
+		ID:          info.ID,
+		Status:      taskStatusFromPodman(info.State, false, false),
+	}
+}
+
+func taskStatusFromPodman(status string, running, paused bool) containerapi.TaskStatus {
+	if running {
+		return containerapi.TaskStatusRunning
+	}
+	if paused {
+		return containerapi.TaskStatusPaused
+	}
+	switch strings.ToLower(status) {
+	case "created", "configured", "initialized":
+		return containerapi.TaskStatusCreated
+	case "running":
+		return containerapi.TaskStatusRunning
+	case "paused":
+		return containerapi.TaskStatusPaused
+	case "exited", "stopped", "stopping", "dead", "removing":
+		return containerapi.TaskStatusStopped
+	default:
+		return containerapi.TaskStatusUnknown
+	}
+}
+
+func metricsFromStats(stats containerStats, sampledAt time.Time) containerapi.ContainerMetrics {
+	memoryLimit := normalizeMemoryLimit(stats.MemLimit)
+	memory := &containerapi.MemoryMetrics{
+		UsageBytes: stats.MemUsage,
+		LimitBytes: memoryLimit,
+	}
+	if memoryLimit > 0 {
+		memory.UsagePercent = (float64(memory.UsageBytes) / float64(memoryLimit)) * 100
+	}
+	cpu := &containerapi.CPUMetrics{
+		UsagePercent:      stats.CPU,
+		UsageNanoseconds:  stats.CPUNano,
+		KernelNanoseconds: stats.CPUSystemNano,
+	}
+	if stats.CPUNano > stats.CPUSystemNano {
+		cpu.UserNanoseconds = stats.CPUNano - stats.CPUSystemNano
+	}
+	return containerapi.ContainerMetrics{SampledAt: sampledAt, CPU: cpu, Memory: memory}
+}
+
+// normalizeMemoryLimit drops the "unlimited" sentinel, which rootless
+// containers without a memory controller report as the host's total.
+func normalizeMemoryLimit(limit uint64) uint64 {
+	if limit == 0 || limit > uint64(1)<<60 {
+		return 0
+	}
+	return limit
+}
+
+func firstContainerIP(info containerInspect) string {
+	if info.NetworkSettings == nil {
+		return ""
+	}
+	if ip := strings.TrimSpace(info.NetworkSettings.IPAddress); ip != "" {
+		return ip
+	}
+	for _, network := range info.NetworkSettings.Networks {
+		if strings.TrimSpace(network.IPAddress) != "" {
+			return strings.TrimSpace(network.IPAddress)
+		}
+	}
+	return ""
+}
+
+func firstGateway(info containerInspect) string {
+	if info.NetworkSettings == nil {
+		return ""
+	}
+	if gateway := strings.TrimSpace(info.NetworkSettings.Gateway); gateway != "" {
+		return gateway
+	}
+	for _, network := range info.NetworkSettings.Networks {
+		if strings.TrimSpace(network.Gateway) != "" {
+			return strings.TrimSpace(network.Gateway)
+		}
+	}
+	return ""
+}
+
+func signalName(sig syscall.Signal) string {
+	switch sig {
+	case syscall.SIGKILL:
+		return "SIGKILL"
+	case syscall.SIGINT:
+		return "SIGINT"
+	case syscall.SIGQUIT:
+		return "SIGQUIT"
+	default:
+		return "SIGTERM"
+	}
+}
+
+// decodePullStream reads the pull report stream. Podman reports copied
+// blobs rather than byte counts, so progress lists each layer once it starts.
+func decodePullStream(reader io.Reader, onProgress func(containerapi.PullProgress)) error {
+	decoder := json.NewDecoder(bufio.NewReader(reader))
+	var layers []containerapi.LayerStatus
+	for {
+		var event struct {
+			Stream string `json:"stream"`
+			Error  string `json:"error"`
+		}
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Join(containerapi.ErrRuntime, err)
+		}
+		if event.Error != "" {
+			return mapAPIError(&apiError{Status: http.StatusInternalServerError, Message: event.Error})
+		}
+		if onProgress == nil {
+			continue
+		}
+		blob, ok := strings.CutPrefix(strings.TrimSpace(event.Stream), "Copying blob ")
+		if !ok {
+			continue
+		}
+		blob = strings.TrimSpace(strings.TrimSuffix(blob, "done"))
+		layers = append(layers, containerapi.LayerStatus{Ref: blob})
+		onProgress(containerapi.PullProgress{Layers: append([]containerapi.LayerStatus(nil), layers...)})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// HTTP
+// ---------------------------------------------------------------------------
+
+// apiError is the error body libpod returns with a non-2xx status.
+type apiError struct {
+	Status  int    `json:"response"`
+	Message string `json:"message"`
+	Cause   string `json:"cause"`
+}
+
+func (e *apiError) Error() string {
+	if e.Message != "" {
+		return "podman: " + e.Message
+	}
+	return "podman: " + http.StatusText(e.Status)
+}
+
+func (s *Service) do(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(raw)
+	}
+	target := s.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Join(containerapi.ErrRuntime, fmt.Errorf("podman service unavailable: %w", err))
+	}
+	// 304 answers start and stop calls on a container already in that state.
+	if resp.StatusCode >= 300 && resp.StatusCode != http.StatusNotModified {
+		defer func() { _ = resp.Body.Close() }()
+		apiErr := &apiError{Status: resp.StatusCode}
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		if json.Unmarshal(raw, apiErr) != nil || apiErr.Message == "" {
+			apiErr.Message = strings.TrimSpace(string(raw))
+		}
+		apiErr.Status = resp.StatusCode
+		return nil, mapAPIError(apiErr)
+	}
+	return resp, nil
+}
+
+func (s *Service) call(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	resp, err := s.do(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if out == nil || resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Join(containerapi.ErrRuntime, fmt.Errorf("decode podman %s %s response: %w", method, path, err))
+	}
+	return nil
+}
+
+func mapAPIError(err *apiError) error {
+	msg := strings.ToLower(err.Message + " " + err.Cause)
+	switch {
+	case err.Status == http.StatusNotFound || strings.Contains(msg, "no such"):
+		return errors.Join(containerapi.ErrNotFound, err)
+	case err.Status == http.StatusConflict ||
+		strings.Contains(msg, "already exists") ||
+		strings.Contains(msg, "is already in use"):
+		return errors.Join(containerapi.ErrAlreadyExists, err)
+	default:
+		return errors.Join(containerapi.ErrRuntime, err)
+	}
+}
@@ -0,0 +1,463 @@
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/memohai/memoh/internal/config"
+	containerapi "github.com/memohai/memoh/internal/container"
+)
+
+// fakeLibpod serves the libpod endpoints the service calls, keeping just
+// enough state for a workspace lifecycle.
+type fakeLibpod struct {
+	mu         sync.Mutex
+	containers map[string]*containerInspect
+	specs      map[string]specGenerator
+	images     map[string]imageSummary
+	commits    []string
+}
+
+func startFakeLibpod(t *testing.T) (*Service, *fakeLibpod) {
+	t.Helper()
+	fake := &fakeLibpod{
+		containers: map[string]*containerInspect{},
+		specs:      map[string]specGenerator{},
+		images: map[string]imageSummary{
+			"docker.io/library/debian:bookworm-slim": {ID: "img-debian", RepoTags: []string{"docker.io/library/debian:bookworm-slim"}},
+		},
+	}
+	mux := http.NewServeMux()
+	p := apiPrefix
+	mux.HandleFunc("GET "+p+"/images/{name}/json", fake.inspectImage)
+	mux.HandleFunc("GET "+p+"/images/json", fake.listImages)
+	mux.HandleFunc("POST "+p+"/images/{name}/tag", fake.tagImage)
+	mux.HandleFunc("POST "+p+"/containers/create", fake.createContainer)
+	mux.HandleFunc("GET "+p+"/containers/json", fake.listContainers)
+	mux.HandleFunc("GET "+p+"/containers/stats", fake.stats)
+	mux.HandleFunc("GET "+p+"/containers/{name}/json", fake.inspectContainer)
+	mux.HandleFunc("POST "+p+"/containers/{name}/{action}", fake.containerAction)
+	mux.HandleFunc("DELETE "+p+"/containers/{name}", fake.deleteContainer)
+	mux.HandleFunc("POST "+p+"/commit", fake.commit)
+
+	// Unix socket paths are length-limited, so keep the directory short.
+	dir, err := os.MkdirTemp("", "podman")
+	if err != nil {
+		t.Fatalf("MkdirTemp: %v", err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "api.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	server := httptest.NewUnstartedServer(mux)
+	server.Listener = lis
+	server.Start()
+	t.Cleanup(server.Close)
+
+	svc, err := NewService(nil, config.Config{Podman: config.PodmanConfig{SocketPath: "unix://" + socket}})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	t.Cleanup(func() { _ = svc.Close() })
+	return svc, fake
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func notFound(w http.ResponseWriter, what string) {
+	writeJSON(w, http.StatusNotFound, apiError{Status: http.StatusNotFound, Cause: "no such " + what, Message: what + " not found"})
+}
+
+func (f *fakeLibpod) inspectImage(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	img, ok := f.images[r.PathValue("name")]
+	if !ok {
+		notFound(w, "image")
+		return
+	}
+	writeJSON(w, http.StatusOK, imageInspect{ID: img.ID, RepoTags: img.RepoTags})
+}
+
+func (f *fakeLibpod) listImages(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var filters map[string][]string
+	_ = json.Unmarshal([]byte(r.URL.Query().Get("filters")), &filters)
+	out := []imageSummary{}
+	for _, img := range f.images {
+		if labels := filters["label"]; len(labels) > 0 {
+			if _, ok := img.Labels[labels[0]]; !ok {
+				continue
+			}
+		}
+		out = append(out, img)
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (f *fakeLibpod) tagImage(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	source, ok := f.images[r.PathValue("name")]
+	if !ok {
+		notFound(w, "image")
+		return
+	}
+	ref := r.URL.Query().Get("repo") + ":" + r.URL.Query().Get("tag")
+	source.RepoTags = append(append([]string(nil), source.RepoTags...), ref)
+	f.images[r.PathValue("name")] = source
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (f *fakeLibpod) createContainer(w http.ResponseWriter, r *http.Request) {
+	var spec specGenerator
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+		writeJSON(w, http.StatusBadRequest, apiError{Status: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.images[spec.Image]; !ok {
+		notFound(w, "image")
+		return
+	}
+	if _, ok := f.containers[spec.Name]; ok {
+		writeJSON(w, http.StatusConflict, apiError{Status: http.StatusConflict, Message: "container name is already in use"})
+		return
+	}
+	f.specs[spec.Name] = spec
+	f.containers[spec.Name] = &containerInspect{
+		ID:        "id-" + spec.Name,
+		Name:      spec.Name,
+		Created:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		ImageName: spec.Image,
+		Config:    &containerConfig{Image: spec.Image, Labels: spec.Labels},
+		State:     &containerState{Status: "created"},
+		NetworkSettings: &containerNetworkState{
+			Networks: map[string]containerNetworkAddr{"podman": {IPAddress: "10.88.0.5", Gateway: "10.88.0.1"}},
+		},
+	}
+	writeJSON(w, http.StatusCreated, map[string]string{"Id": "id-" + spec.Name})
+}
+
+// lookup resolves a name or ID. The caller holds f.mu.
+func (f *fakeLibpod) lookup(nameOrID string) (*containerInspect, bool) {
+	for name, c := range f.containers {
+		if name == nameOrID || c.ID == nameOrID {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+func (f *fakeLibpod) inspectContainer(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c, ok := f.lookup(r.PathValue("name"))
+	if !ok {
+		notFound(w, "container")
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (f *fakeLibpod) listContainers(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var filters map[string][]string
+	_ = json.Unmarshal([]byte(r.URL.Query().Get("filters")), &filters)
+	out := []containerSummary{}
+	for name, c := range f.containers {
+		if labels := filters["label"]; len(labels) > 0 {
+			key, value, _ := strings.Cut(labels[0], "=")
+			if got, ok := c.Config.Labels[key]; !ok || (value != "" && got != value) {
+				continue
+			}
+		}
+		out = append(out, containerSummary{ID: c.ID, Names: []string{name}, Image: c.ImageName, Labels: c.Config.Labels, Created: c.Created, State: c.State.Status})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (f *fakeLibpod) containerAction(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c, ok := f.lookup(r.PathValue("name"))
+	if !ok {
+		notFound(w, "container")
+		return
+	}
+	running := c.State.Running
+	switch r.PathValue("action") {
+	case "start":
+		c.State = &containerState{Status: "running", Running: true, Pid: 4242}
+		c.NetworkSettings.Ports = map[string][]hostPortBinding{"9090/tcp": {{HostIP: "127.0.0.1", HostPort: "41234"}}}
+	case "stop", "kill":
+		c.State = &containerState{Status: "exited", ExitCode: 137}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if r.PathValue("action") != "kill" && running == c.State.Running {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeLibpod) deleteContainer(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c, ok := f.lookup(r.PathValue("name"))
+	if !ok {
+		notFound(w, "container")
+		return
+	}
+	delete(f.containers, strings.TrimPrefix(c.ID, "id-"))
+	writeJSON(w, http.StatusOK, []map[string]string{{"Id": c.ID}})
+}
+
+func (f *fakeLibpod) stats(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c, ok := f.lookup(r.URL.Query().Get("containers"))
+	if !ok {
+		notFound(w, "container")
+		return
+	}
+	writeJSON(w, http.StatusOK, statsReport{Stats: []containerStats{{
+		ContainerID:   c.ID,
+		CPU:           12.5,
+		CPUNano:       3_000,
+		CPUSystemNano: 1_000,
+		MemUsage:      256 << 20,
+		MemLimit:      1 << 30,
+	}}})
+}
+
+func (f *fakeLibpod) commit(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	query := r.URL.Query()
+	c, ok := f.lookup(query.Get("container"))
+	if !ok {
+		notFound(w, "container")
+		return
+	}
+	labels := cloneLabels(c.Config.Labels)
+	for _, change := range query["changes"] {
+		key, value, _ := strings.Cut(strings.TrimPrefix(change, "LABEL "), "=")
+		labels[key] = value
+	}
+	ref := query.Get("repo") + ":" + query.Get("tag")
+	f.commits = append(f.commits, ref)
+	f.images[ref] = imageSummary{ID: "img-" + query.Get("tag"), RepoTags: []string{ref}, Created: 1767323045, Labels: labels}
+	writeJSON(w, http.StatusCreated, map[string]string{"Id": "img-" + query.Get("tag")})
+}
+
+func TestWorkspaceLifecycle(t *testing.T) {
+	svc, fake := startFakeLibpod(t)
+	ctx := context.Background()
+
+	info, err := svc.CreateContainer(ctx, containerapi.CreateContainerRequest{
+		ID:         "workspace-bot-1",
+		ImageRef:   "debian:bookworm-slim",
+		Labels:     map[string]string{"memoh.bot_id": "bot-1"},
+		StorageRef: containerapi.StorageRef{Key: "workspace-bot-1"},
+		Spec: containerapi.ContainerSpec{
+			Cmd:    []string{"/opt/memoh/bridge"},
+			Env:    []string{"HOME=/root"},
+			Mounts: []containerapi.MountSpec{{Source: "/srv/data/bot-1", Destination: "/data", Options: []string{"ro"}}},
+		},
+		ResourceLimits: containerapi.ResourceLimits{CPUMillicores: 1500, MemoryBytes: 512 << 20},
+	})
+	if err != nil {
+		t.Fatalf("CreateContainer: %v", err)
+	}
+	if info.ID != "workspace-bot-1" || info.StorageRef.Driver != "podman" || info.StorageRef.Key != "id-workspace-bot-1" {
+		t.Fatalf("unexpected container info: %+v", info)
+	}
+
+	spec := fake.specs["workspace-bot-1"]
+	if spec.Env["BRIDGE_TCP_ADDR"] != ":9090" || spec.Env["HOME"] != "/root" {
+		t.Fatalf("env = %v", spec.Env)
+	}
+	if spec.Labels[containerapi.StorageKeyLabel] != "workspace-bot-1" || !spec.Init {
+		t.Fatalf("spec labels = %v, init = %v", spec.Labels, spec.Init)
+	}
+	if len(spec.Mounts) != 1 || spec.Mounts[0].Type != "bind" || !hasOption(spec.Mounts[0].Options, "ro") || !hasOption(spec.Mounts[0].Options, "rbind") {
+		t.Fatalf("mounts = %+v", spec.Mounts)
+	}
+	if spec.ResourceLimits == nil || spec.ResourceLimits.CPU.Quota != 150_000 || spec.ResourceLimits.Memory.Limit != 512<<20 {
+		t.Fatalf("resource limits = %+v", spec.ResourceLimits)
+	}
+	if len(spec.PortMappings) != 1 || spec.PortMappings[0].HostIP != "127.0.0.1" || spec.PortMappings[0].ContainerPort != 9090 {
+		t.Fatalf("port mappings = %+v", spec.PortMappings)
+	}
+
+	if _, err := svc.CreateContainer(ctx, containerapi.CreateContainerRequest{ID: "workspace-bot-1", ImageRef: "debian:bookworm-slim"}); !containerapi.IsAlreadyExists(err) {
+		t.Fatalf("duplicate CreateContainer error = %v, want already exists", err)
+	}
+
+	if err := svc.StartContainer(ctx, "workspace-bot-1", nil); err != nil {
+		t.Fatalf("StartContainer: %v", err)
+	}
+	if err := svc.StartContainer(ctx, "workspace-bot-1", nil); err != nil {
+		t.Fatalf("StartContainer on a running container: %v", err)
+	}
+	task, err := svc.GetTaskInfo(ctx, "workspace-bot-1")
+	if err != nil || task.Status != containerapi.TaskStatusRunning || task.PID != 4242 {
+		t.Fatalf("GetTaskInfo = %+v, %v", task, err)
+	}
+	if target := svc.BridgeTarget("bot-1"); target != "127.0.0.1:41234" {
+		t.Fatalf("BridgeTarget = %q", target)
+	}
+	network, err := svc.SetupNetwork(ctx, containerapi.NetworkRequest{ContainerID: "workspace-bot-1"})
+	if err != nil || network.IP != "10.88.0.5" || network.Gateway != "10.88.0.1" {
+		t.Fatalf("SetupNetwork = %+v, %v", network, err)
+	}
+
+	metrics, err := svc.GetContainerMetrics(ctx, "workspace-bot-1")
+	if err != nil {
+		t.Fatalf("GetContainerMetrics: %v", err)
+	}
+	if metrics.CPU.UsagePercent != 12.5 || metrics.CPU.UserNanoseconds != 2_000 || metrics.Memory.UsagePercent != 25 {
+		t.Fatalf("metrics = %+v %+v", metrics.CPU, metrics.Memory)
+	}
+
+	byLabel, err := svc.ListContainersByLabel(ctx, "memoh.bot_id", "bot-1")
+	if err != nil || len(byLabel) != 1 || byLabel[0].ID != "workspace-bot-1" {
+		t.Fatalf("ListContainersByLabel = %+v, %v", byLabel, err)
+	}
+
+	if err := svc.StopContainer(ctx, "workspace-bot-1", &containerapi.StopTaskOptions{Timeout: 10 * time.Second}); err != nil {
+		t.Fatalf("StopContainer: %v", err)
+	}
+	if err := svc.StopContainer(ctx, "workspace-bot-1", nil); err != nil {
+		t.Fatalf("StopContainer on a stopped container: %v", err)
+	}
+	if err := svc.DeleteTask(ctx, "workspace-bot-1", nil); err != nil {
+		t.Fatalf("DeleteTask on a stopped container: %v", err)
+	}
+
+	if err := svc.DeleteContainer(ctx, "workspace-bot-1", nil); err != nil {
+		t.Fatalf("DeleteContainer: %v", err)
+	}
+	if _, err := svc.GetContainer(ctx, "workspace-bot-1"); !containerapi.IsNotFound(err) {
+		t.Fatalf("GetContainer after delete error = %v, want not found", err)
+	}
+}
+
+func TestSnapshotCommitPrepareAndRestore(t *testing.T) {
+	svc, fake := startFakeLibpod(t)
+	ctx := context.Background()
+
+	if _, err := svc.CreateContainer(ctx, containerapi.CreateContainerRequest{
+		ID:         "workspace-bot-1",
+		ImageRef:   "debian:bookworm-slim",
+		StorageRef: containerapi.StorageRef{Key: "base"},
+	}); err != nil {
+		t.Fatalf("CreateContainer: %v", err)
+	}
+	if err := svc.CommitSnapshot(ctx, containerapi.CommitSnapshotRequest{
+		Target: containerapi.SnapshotRef{Key: "workspace-bot-1-snapshot-1"},
+		Source: containerapi.StorageRef{Driver: "podman", Key: "id-workspace-bot-1"},
+	}); err != nil {
+		t.Fatalf("CommitSnapshot: %v", err)
+	}
+	if want := snapshotImageRef("workspace-bot-1-snapshot-1"); len(fake.commits) != 1 || fake.commits[0] != want {
+		t.Fatalf("commits = %v, want %s", fake.commits, want)
+	}
+	if err := svc.CommitSnapshot(ctx, containerapi.CommitSnapshotRequest{
+		Target: containerapi.SnapshotRef{Key: "x"},
+		Source: containerapi.StorageRef{Driver: "overlayfs", Key: "id-workspace-bot-1"},
+	}); err == nil {
+		t.Fatal("CommitSnapshot with a foreign driver must fail")
+	}
+
+	if err := svc.PrepareSnapshot(ctx, containerapi.PrepareSnapshotRequest{
+		Target: containerapi.StorageRef{Key: "workspace-bot-1-restore"},
+		Parent: containerapi.SnapshotRef{Key: "workspace-bot-1-snapshot-1"},
+	}); err != nil {
+		t.Fatalf("PrepareSnapshot: %v", err)
+	}
+
+	snapshots, err := svc.ListSnapshots(ctx, containerapi.ListSnapshotsRequest{Driver: "podman"})
+	if err != nil {
+		t.Fatalf("ListSnapshots: %v", err)
+	}
+	found := map[string]containerapi.SnapshotInfo{}
+	for _, snapshot := range snapshots {
+		found[snapshot.Name] = snapshot
+	}
+	if s := found["workspace-bot-1-snapshot-1"]; s.Kind != "committed" || s.Parent != "base" {
+		t.Fatalf("committed snapshot = %+v", s)
+	}
+	if s := found["workspace-bot-1-restore"]; s.Kind != "committed" || s.Parent != "workspace-bot-1-snapshot-1" {
+		t.Fatalf("prepared snapshot = %+v", s)
+	}
+	if s := found["id-workspace-bot-1"]; s.Kind != "active" || s.Parent != "base" {
+		t.Fatalf("active snapshot = %+v", s)
+	}
+
+	if err := svc.DeleteContainer(ctx, "workspace-bot-1", nil); err != nil {
+		t.Fatalf("DeleteContainer: %v", err)
+	}
+	restored, err := svc.RestoreContainer(ctx, containerapi.CreateContainerRequest{
+		ID:         "workspace-bot-1",
+		StorageRef: containerapi.StorageRef{Key: "workspace-bot-1-snapshot-1"},
+	})
+	if err != nil {
+		t.Fatalf("RestoreContainer: %v", err)
+	}
+	if restored.Image != snapshotImageRef("workspace-bot-1-snapshot-1") {
+		t.Fatalf("restored image = %q", restored.Image)
+	}
+}
+
+func TestSnapshotImageRefSanitizesRuntimeName(t *testing.T) {
+	ref := snapshotImageRef("workspace/foo:snapshot@123")
+	if !strings.HasPrefix(ref, snapshotImageRepository+":") {
+		t.Fatalf("snapshot image ref = %q, want repo prefix", ref)
+	}
+	if strings.ContainsAny(strings.TrimPrefix(ref, snapshotImageRepository+":"), "/:@") {
+		t.Fatalf("snapshot image ref contains invalid tag chars: %q", ref)
+	}
+	if config.NormalizeImageRef(ref) != ref {
+		t.Fatalf("snapshot image ref %q must already be fully qualified", ref)
+	}
+}
+
+func TestResolveSocketPath(t *testing.T) {
+	t.Setenv("CONTAINER_HOST", "")
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+	if got, _ := resolveSocketPath(""); got != rootfulSocketPath {
+		t.Fatalf("resolveSocketPath without a rootless socket = %q", got)
+	}
+	if got, _ := resolveSocketPath("unix:///run/user/1000/podman/podman.sock"); got != "/run/user/1000/podman/podman.sock" {
+		t.Fatalf("resolveSocketPath(unix URL) = %q", got)
+	}
+	t.Setenv("CONTAINER_HOST", "unix:///tmp/podman.sock")
+	if got, _ := resolveSocketPath(""); got != "/tmp/podman.sock" {
+		t.Fatalf("resolveSocketPath from CONTAINER_HOST = %q", got)
+	}
+	if _, err := resolveSocketPath("ssh://core@host/run/podman/podman.sock"); err == nil {
+		t.Fatal("resolveSocketPath must reject non-unix addresses")
+	}
+}
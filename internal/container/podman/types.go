@@ -0,0 +1,140 @@
+package podman
+
+import "time"
+
+// The types below mirror the subset of the libpod API the service uses.
+
+type specGenerator struct {
+	Name           string            `json:"name"`
+	Image          string            `json:"image"`
+	Command        []string          `json:"command,omitempty"`
+	Env            map[string]string `json:"env,omitempty"`
+	WorkDir        string            `json:"work_dir,omitempty"`
+	User           string            `json:"user,omitempty"`
+	Terminal       bool              `json:"terminal,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Mounts         []specMount       `json:"mounts,omitempty"`
+	DNSServer      []string          `json:"dns_server,omitempty"`
+	Init           bool              `json:"init,omitempty"`
+	CapAdd         []string          `json:"cap_add,omitempty"`
+	Devices        []linuxDevice     `json:"devices,omitempty"`
+	PortMappings   []portMapping     `json:"portmappings,omitempty"`
+	ResourceLimits *resourceLimits   `json:"resource_limits,omitempty"`
+	NetNS          *namespace        `json:"netns,omitempty"`
+}
+
+type specMount struct {
+	Destination string   `json:"destination"`
+	Type        string   `json:"type"`
+	Source      string   `json:"source"`
+	Options     []string `json:"options,omitempty"`
+}
+
+// linuxDevice carries a device path or, as here, a fully-qualified CDI name.
+type linuxDevice struct {
+	Path string `json:"path"`
+}
+
+type portMapping struct {
+	HostIP        string `json:"host_ip,omitempty"`
+	ContainerPort uint16 `json:"container_port"`
+	HostPort      uint16 `json:"host_port,omitempty"`
+	Protocol      string `json:"protocol,omitempty"`
+}
+
+type resourceLimits struct {
+	Memory *memoryLimit `json:"memory,omitempty"`
+	CPU    *cpuLimit    `json:"cpu,omitempty"`
+}
+
+type memoryLimit struct {
+	Limit int64 `json:"limit"`
+}
+
+type cpuLimit struct {
+	Quota  int64  `json:"quota"`
+	Period uint64 `json:"period"`
+}
+
+type namespace struct {
+	NSMode string `json:"nsmode"`
+	Value  string `json:"value,omitempty"`
+}
+
+type imageInspect struct {
+	ID       string   `json:"Id"`
+	RepoTags []string `json:"RepoTags"`
+}
+
+type imageSummary struct {
+	ID       string            `json:"Id"`
+	RepoTags []string          `json:"RepoTags"`
+	Created  int64             `json:"Created"`
+	Labels   map[string]string `json:"Labels"`
+}
+
+type containerInspect struct {
+	ID              string                 `json:"Id"`
+	Name            string                 `json:"Name"`
+	Created         time.Time              `json:"Created"`
+	Image           string                 `json:"Image"`
+	ImageName       string                 `json:"ImageName"`
+	Config          *containerConfig       `json:"Config"`
+	State           *containerState        `json:"State"`
+	NetworkSettings *containerNetworkState `json:"NetworkSettings"`
+}
+
+type containerConfig struct {
+	Image  string            `json:"Image"`
+	Labels map[string]string `json:"Labels"`
+}
+
+type containerState struct {
+	Status   string `json:"Status"`
+	Running  bool   `json:"Running"`
+	Paused   bool   `json:"Paused"`
+	Pid      int    `json:"Pid"`
+	ExitCode int32  `json:"ExitCode"`
+}
+
+type containerNetworkState struct {
+	IPAddress string                          `json:"IPAddress"`
+	Gateway   string                          `json:"Gateway"`
+	Ports     map[string][]hostPortBinding    `json:"Ports"`
+	Networks  map[string]containerNetworkAddr `json:"Networks"`
+}
+
+type hostPortBinding struct {
+	HostIP   string `json:"HostIp"`
+	HostPort string `json:"HostPort"`
+}
+
+type containerNetworkAddr struct {
+	IPAddress string `json:"IPAddress"`
+	Gateway   string `json:"Gateway"`
+}
+
+type containerSummary struct {
+	ID      string            `json:"Id"`
+	Names   []string          `json:"Names"`
+	Image   string            `json:"Image"`
+	Labels  map[string]string `json:"Labels"`
+	Created time.Time         `json:"Created"`
+	State   string            `json:"State"`
+}
+
+type statsReport struct {
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"Error"`
+	Stats []containerStats `json:"Stats"`
+}
+
+type containerStats struct {
+	ContainerID   string  `json:"ContainerID"`
+	CPU           float64 `json:"CPU"`
+	CPUNano       uint64  `json:"CPUNano"`
+	CPUSystemNano uint64  `json:"CPUSystemNano"`
+	MemUsage      uint64  `json:"MemUsage"`
+	MemLimit      uint64  `json:"MemLimit"`
+}
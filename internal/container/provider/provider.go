@@ -10,6 +10,7 @@ import (
 	appleadapter "github.com/memohai/memoh/internal/container/apple"
 	containerdadapter "github.com/memohai/memoh/internal/container/containerd"
 	dockeradapter "github.com/memohai/memoh/internal/container/docker"
+	podmanadapter "github.com/memohai/memoh/internal/container/podman"
 )
 
 // ProvideService creates the appropriate Service based on the backend type.
@@ -31,6 +32,12 @@ func ProvideService(ctx context.Context, log *slog.Logger, cfg config.Config, ba
 			return nil, nil, err
 		}
 		return svc, func() { _ = svc.Close() }, nil
+	case containerapi.BackendPodman:
+		svc, err := podmanadapter.NewService(log, cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return svc, func() { _ = svc.Close() }, nil
 	case containerapi.BackendContainerd:
 		client, err := containerdadapter.NewClient(ctx, cfg.Containerd.SocketPath)
 		if err != nil {
@@ -86,6 +86,14 @@ type NetworkService interface {
 	CheckNetwork(ctx context.Context, req NetworkRequest) error
 }
 
+// FirewallService is implemented by backends that can filter the egress of a
+// workspace network namespace. Backends without it cannot enforce egress
+// policies.
+type FirewallService interface {
+	ApplyEgressFirewall(ctx context.Context, req NetworkRequest, rules EgressFirewallRules) error
+	RemoveEgressFirewall(ctx context.Context, req NetworkRequest) error
+}
+
 // SnapshotService groups snapshot lifecycle operations.
 type SnapshotService interface {
 	CommitSnapshot(ctx context.Context, req CommitSnapshotRequest) error
@@ -217,4 +217,14 @@ type NetworkRequest struct {
 // container network.
 type NetworkResult struct {
 	IP string
+	// Gateway is the address of the host side of the container network,
+	// where services of the server are reachable from the workspace.
+	Gateway string
+}
+
+// EgressFirewallRules is the content of the chain a runtime hooks into the
+// OUTPUT chain of a workspace network namespace, as iptables rule specs.
+type EgressFirewallRules struct {
+	IPv4 []string
+	IPv6 []string
 }
@@ -5,7 +5,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
 	"log/slog"
 	"math"
@@ -31,11 +30,10 @@ import (
 	messagepkg "github.com/memohai/memoh/internal/message"
 	messageevent "github.com/memohai/memoh/internal/message/event"
 	"github.com/memohai/memoh/internal/models"
-	"github.com/memohai/memoh/internal/oauthctx"
 	pipelinepkg "github.com/memohai/memoh/internal/pipeline"
-	"github.com/memohai/memoh/internal/providers"
 	sessionpkg "github.com/memohai/memoh/internal/session"
 	"github.com/memohai/memoh/internal/settings"
+	"github.com/memohai/memoh/internal/structured"
 	"github.com/memohai/memoh/internal/toolapproval"
 	"github.com/memohai/memoh/internal/userinput"
 )
@@ -72,6 +70,11 @@ type botChannelConfigReader interface {
 	ListBotConfigs(ctx context.Context, botID string) ([]channel.ChannelConfig, error)
 }
 
+// BudgetGuard refuses new turns for bots whose spending budget is used up.
+type BudgetGuard interface {
+	Check(ctx context.Context, botID string) error
+}
+
 // Resolver orchestrates chat with the internal agent.
 type Resolver struct {
 	agent             *agentpkg.Agent
@@ -95,6 +98,7 @@ type Resolver struct {
 	bgManager         *background.Manager
 	toolApproval      *toolapproval.Service
 	userInput         userInputService
+	budgetGuard       BudgetGuard
 	// continueUserInputFn overrides the chat-flow resume after a user input
 	// response; nil means storeUserInputResultAndContinue. Test seam.
 	continueUserInputFn func(ctx context.Context, req userinput.Request, input UserInputResponseInput, result sdk.ToolResultPart, eventCh chan<- WSStreamEvent) error
@@ -198,6 +202,12 @@ func (r *Resolver) SetToolApprovalService(s *toolapproval.Service) {
 	r.toolApproval = s
 }
 
+// SetBudgetGuard makes every new turn fail once the bot or its owner has
+// used up a spending budget.
+func (r *Resolver) SetBudgetGuard(g BudgetGuard) {
+	r.budgetGuard = g
+}
+
 func (r *Resolver) SetUserInputService(s *userinput.Service) {
 	if s == nil {
 		r.userInput = nil
@@ -269,7 +279,39 @@ type resolvedContext struct {
 	provider        sqlc.Provider
 	query           string // headerified query
 	injectedRecords *[]conversation.InjectedMessageRecord
-	estimatedTokens int // estimated input token count for compaction
+	estimatedTokens int                    // estimated input token count for compaction
+	fallback        *modelFallbackRecorder // set when the run has fallback models
+}
+
+// modelID returns the database ID of the model that answered the turn: the
+// fallback the agent switched to, if any, otherwise the selected chat model.
+func (rc resolvedContext) modelID() string {
+	if id := rc.fallback.modelID(); id != "" {
+		return id
+	}
+	return rc.model.ID
+}
+
+// modelFallbackRecorder captures the fallback model an agent run switched to.
+// The agent reports the switch from its own goroutine.
+type modelFallbackRecorder struct {
+	mu sync.Mutex
+	id string
+}
+
+func (f *modelFallbackRecorder) record(candidate agentpkg.ModelCandidate) {
+	f.mu.Lock()
+	f.id = candidate.ModelID
+	f.mu.Unlock()
+}
+
+func (f *modelFallbackRecorder) modelID() string {
+	if f == nil {
+		return ""
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.id
 }
 
 func (r *Resolver) resolve(ctx context.Context, req conversation.ChatRequest) (resolvedContext, error) {
@@ -282,6 +324,10 @@ func (r *Resolver) resolve(ctx context.Context, req conversation.ChatRequest) (r
 	if strings.TrimSpace(req.ChatID) == "" {
 		return resolvedContext{}, errors.New("chat id is required")
 	}
+	outputSchema, err := structured.Compile(req.OutputSchema)
+	if err != nil {
+		return resolvedContext{}, err
+	}
 
 	runCfg, chatModel, provider, err := r.buildBaseRunConfig(ctx, baseRunConfigParams{
 		BotID:             req.BotID,
@@ -294,6 +340,7 @@ func (r *Resolver) resolve(ctx context.Context, req conversation.ChatRequest) (r
 		ReplyTarget:       req.ReplyTarget,
 		ConversationType:  req.ConversationType,
 		SessionToken:      req.ChatToken,
+		SessionType:       req.SessionType,
 		Model:             req.Model,
 		Provider:          req.Provider,
 		ReasoningEffort:   req.ReasoningEffort,
@@ -424,6 +471,7 @@ func (r *Resolver) resolve(ctx context.Context, req conversation.ChatRequest) (r
 		runCfg.Query = headerifiedQuery
 	}
 	runCfg.InlineImages = extractNativeImageParts(mergedAttachments)
+	runCfg.OutputSchema = outputSchema
 
 	var injectedRecords *[]conversation.InjectedMessageRecord
 	if req.InjectCh != nil {
@@ -458,6 +506,12 @@ func (r *Resolver) resolve(ctx context.Context, req conversation.ChatRequest) (r
 		}
 	}
 
+	var fallback *modelFallbackRecorder
+	if len(runCfg.Fallbacks) > 0 {
+		fallback = &modelFallbackRecorder{}
+		runCfg.OnModelFallback = fallback.record
+	}
+
 	return resolvedContext{
 		runConfig:       runCfg,
 		model:           chatModel,
@@ -465,6 +519,7 @@ func (r *Resolver) resolve(ctx context.Context, req conversation.ChatRequest) (r
 		query:           headerifiedQuery,
 		injectedRecords: injectedRecords,
 		estimatedTokens: estimatedTokens,
+		fallback:        fallback,
 	}, nil
 }
 
@@ -494,18 +549,24 @@ func (r *Resolver) Chat(ctx context.Context, req conversation.ChatRequest) (conv
 
 	outputMessages := sdkMessagesToModelMessages(result.Messages)
 	roundMessages := prependUserMessage(req.Query, outputMessages)
-	if err := r.storeRound(ctx, req, roundMessages, rc.model.ID); err != nil {
+	if err := r.storeRoundWithOptions(ctx, req, roundMessages, rc.modelID(), storeRoundOptions{
+		StructuredOutput: result.Structured,
+	}); err != nil {
 		return conversation.ChatResponse{}, err
 	}
 
+	var usage json.RawMessage
 	if result.Usage != nil {
 		go r.maybeCompact(context.WithoutCancel(ctx), req, rc, result.Usage.InputTokens)
+		usage, _ = json.Marshal(result.Usage)
 	}
 
 	return conversation.ChatResponse{
-		Messages: outputMessages,
-		Model:    rc.model.ModelID,
-		Provider: rc.provider.ClientType,
+		Messages:   outputMessages,
+		Model:      rc.model.ModelID,
+		Provider:   rc.provider.ClientType,
+		Structured: result.Structured,
+		Usage:      usage,
 	}, nil
 }
 
@@ -532,6 +593,11 @@ type baseRunConfigParams struct {
 // identity and system prompt — everything except Messages/Query/InlineImages.
 // Both resolve() and ResolveRunConfig() delegate to this shared builder.
 func (r *Resolver) buildBaseRunConfig(ctx context.Context, p baseRunConfigParams) (agentpkg.RunConfig, models.GetResponse, sqlc.Provider, error) {
+	if r.budgetGuard != nil {
+		if err := r.budgetGuard.Check(ctx, p.BotID); err != nil {
+			return agentpkg.RunConfig{}, models.GetResponse{}, sqlc.Provider{}, err
+		}
+	}
 	botSettings, err := r.loadBotSettings(ctx, p.BotID)
 	if err != nil {
 		return agentpkg.RunConfig{}, models.GetResponse{}, sqlc.Provider{}, err
@@ -551,35 +617,10 @@ func (r *Resolver) buildBaseRunConfig(ctx context.Context, p baseRunConfigParams
 		return agentpkg.RunConfig{}, models.GetResponse{}, sqlc.Provider{}, err
 	}
 
-	reasoningConfig := resolveReasoningConfig(chatModel, botSettings, p.ReasoningEffort)
-	reasoningEffort := ""
-	if reasoningConfig != nil && reasoningConfig.Enabled {
-		reasoningEffort = reasoningConfig.Effort
-	}
-
-	authResolver := providers.NewService(nil, r.queries, "")
-	authCtx := oauthctx.WithUserID(ctx, p.UserID)
-	creds, err := authResolver.ResolveModelCredentials(authCtx, provider)
+	primary, err := r.buildModelCandidate(ctx, p.UserID, chatModel, provider, botSettings, p.ReasoningEffort)
 	if err != nil {
-		return agentpkg.RunConfig{}, models.GetResponse{}, sqlc.Provider{}, fmt.Errorf("resolve provider credentials: %w", err)
-	}
-
-	baseURL := providers.ProviderConfigString(provider, "base_url")
-	chatCompletionsCompat := models.ResolveChatCompletionsCompat(
-		baseURL,
-		providers.ProviderConfigString(provider, "chat_completions_compat"),
-	)
-
-	sdkModel := models.NewSDKChatModel(models.SDKModelConfig{
-		ModelID:               chatModel.ModelID,
-		ClientType:            provider.ClientType,
-		APIKey:                creds.APIKey,
-		CodexAccountID:        creds.CodexAccountID,
-		BaseURL:               baseURL,
-		ChatCompletionsCompat: chatCompletionsCompat,
-		HTTPClient:            r.streamHTTPClient,
-		ReasoningConfig:       reasoningConfig,
-	})
+		return agentpkg.RunConfig{}, models.GetResponse{}, sqlc.Provider{}, err
+	}
 
 	var agentSkills []agentpkg.SkillEntry
 	if r.skillLoader != nil {
@@ -599,14 +640,14 @@ func (r *Resolver) buildBaseRunConfig(ctx context.Context, p baseRunConfigParams
 	}
 
 	cfg := agentpkg.RunConfig{
-		Model:                 sdkModel,
-		ReasoningEffort:       reasoningEffort,
-		ReasoningDisabled:     reasoningConfig != nil && reasoningConfig.Disabled,
-		ChatCompletionsCompat: chatCompletionsCompat,
-		PromptCacheTTL:        providers.ProviderConfigString(provider, "prompt_cache_ttl"),
+		Model:                 primary.Model,
+		ReasoningEffort:       primary.ReasoningEffort,
+		ReasoningDisabled:     primary.ReasoningDisabled,
+		ChatCompletionsCompat: primary.ChatCompletionsCompat,
+		PromptCacheTTL:        primary.PromptCacheTTL,
 		SessionType:           p.SessionType,
-		SupportsImageInput:    chatModel.HasCompatibility(models.CompatVision),
-		SupportsToolCall:      chatModel.HasCompatibility(models.CompatToolCall),
+		SupportsImageInput:    primary.SupportsImageInput,
+		SupportsToolCall:      primary.SupportsToolCall,
 		DisplayEnabled:        botSettings.DisplayEnabled,
 		Identity: agentpkg.SessionContext{
 			BotID:             p.BotID,
@@ -624,6 +665,8 @@ func (r *Resolver) buildBaseRunConfig(ctx context.Context, p baseRunConfigParams
 		Skills:            agentSkills,
 		LoopDetection:     agentpkg.LoopDetectionConfig{Enabled: loopDetectionEnabled},
 		BackgroundManager: r.bgManager,
+		ProviderID:        primary.ProviderID,
+		Fallbacks:         r.buildModelFallbacks(ctx, p, botSettings, chatModel.ID),
 	}
 	if r.toolApproval != nil || r.userInput != nil {
 		cfg.ToolApprovalHandler = r.buildToolApprovalHandler(p)
@@ -742,13 +785,20 @@ func (r *Resolver) buildToolApprovalHandler(p baseRunConfigParams) func(context.
 			SourcePlatform:               p.CurrentPlatform,
 			ReplyTarget:                  p.ReplyTarget,
 			ConversationType:             p.ConversationType,
+			SessionType:                  p.SessionType,
 		}
 		eval, err := r.toolApproval.EvaluatePolicy(ctx, input)
 		if err != nil {
 			return sdk.ToolApprovalResult{}, err
 		}
-		if eval.Decision == toolapproval.DecisionBypass {
+		switch eval.Decision {
+		case toolapproval.DecisionBypass:
 			return sdk.ToolApprovalResult{Decision: sdk.ToolApprovalDecisionApproved}, nil
+		case toolapproval.DecisionDeny:
+			return sdk.ToolApprovalResult{
+				Decision: sdk.ToolApprovalDecisionRejected,
+				Reason:   eval.Policy.DenyReason(),
+			}, nil
 		}
 		if !isInteractiveApprovalSession(p.SessionType) {
 			req, err := r.toolApproval.CreatePending(ctx, input)
@@ -756,7 +806,7 @@ func (r *Resolver) buildToolApprovalHandler(p baseRunConfigParams) func(context.
 				return sdk.ToolApprovalResult{}, err
 			}
 			reason := "tool execution requires approval, but this session type cannot request approval"
-			rejected, err := r.toolApproval.Reject(ctx, req.ID, p.ChannelIdentityID, reason)
+			rejected, err := r.toolApproval.Dismiss(ctx, req.ID, reason)
 			if err != nil {
 				return sdk.ToolApprovalResult{}, err
 			}
@@ -0,0 +1,74 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	sdk "github.com/memohai/twilight-ai/sdk"
+
+	agentpkg "github.com/memohai/memoh/internal/agent"
+	"github.com/memohai/memoh/internal/conversation"
+	"github.com/memohai/memoh/internal/delegation"
+)
+
+// delegationSessionType is the runtime session mode of a task handed over by
+// another bot. The stored session stays a chat session so the target bot's
+// owner can read it; the runtime mode makes the run non-interactive.
+const delegationSessionType = "delegation"
+
+// RunDelegation executes a task delegated by another bot as the target bot,
+// with the target bot's own workspace, memory and model settings. The round
+// is stored in the target bot's session and its usage is returned so the
+// delegation record carries the cost of this hop.
+func (r *Resolver) RunDelegation(ctx context.Context, input delegation.RunInput) (delegation.RunResult, error) {
+	if strings.TrimSpace(input.BotID) == "" {
+		return delegation.RunResult{}, errors.New("bot id is required")
+	}
+	if strings.TrimSpace(input.Task) == "" {
+		return delegation.RunResult{}, errors.New("delegation task is required")
+	}
+
+	req := conversation.ChatRequest{
+		BotID:       input.BotID,
+		ChatID:      input.BotID,
+		SessionID:   input.SessionID,
+		Query:       input.Task,
+		UserID:      input.OwnerUserID,
+		SessionType: delegationSessionType,
+	}
+	rc, err := r.resolve(ctx, req)
+	if err != nil {
+		return delegation.RunResult{}, err
+	}
+
+	cfg := rc.runConfig
+	cfg.Identity.ChannelIdentityID = strings.TrimSpace(input.OwnerUserID)
+	cfg.Messages = append(cfg.Messages, sdk.UserMessage(agentpkg.GenerateDelegationPrompt(agentpkg.Delegation{
+		ID:      input.DelegationID,
+		FromBot: input.FromBotName,
+		Hop:     input.Hop,
+		Task:    input.Task,
+	})))
+	cfg = r.prepareRunConfig(ctx, cfg)
+
+	result, err := r.agent.Generate(ctx, cfg)
+	if err != nil {
+		return delegation.RunResult{}, err
+	}
+
+	outputMessages := sdkMessagesToModelMessages(result.Messages)
+	roundMessages := prependUserMessage(req.Query, outputMessages)
+	storeErr := r.storeRound(ctx, req, roundMessages, rc.modelID())
+
+	var usage map[string]any
+	if raw, err := json.Marshal(result.Usage); err == nil {
+		_ = json.Unmarshal(raw, &usage)
+	}
+	return delegation.RunResult{
+		Text:    strings.TrimSpace(result.Text),
+		ModelID: rc.modelID(),
+		Usage:   usage,
+	}, storeErr
+}
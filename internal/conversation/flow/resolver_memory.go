@@ -72,6 +72,8 @@ func (r *Resolver) storeMemory(ctx context.Context, req conversation.ChatRequest
 	_, tzLoc := r.resolveTimezone(ctx, req.BotID, req.UserID)
 	if err := p.OnAfterChat(ctx, memprovider.AfterChatRequest{
 		BotID:             botID,
+		ChatID:            strings.TrimSpace(req.ChatID),
+		SessionID:         strings.TrimSpace(req.SessionID),
 		Messages:          memMsgs,
 		UserID:            strings.TrimSpace(req.UserID),
 		ChannelIdentityID: strings.TrimSpace(req.SourceChannelIdentityID),
@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 
 	"github.com/jackc/pgx/v5"
 
+	agentpkg "github.com/memohai/memoh/internal/agent"
 	"github.com/memohai/memoh/internal/conversation"
 	"github.com/memohai/memoh/internal/db"
 	"github.com/memohai/memoh/internal/db/postgres/sqlc"
 	"github.com/memohai/memoh/internal/models"
+	"github.com/memohai/memoh/internal/oauthctx"
+	"github.com/memohai/memoh/internal/providers"
 	"github.com/memohai/memoh/internal/settings"
 )
 
@@ -117,3 +121,81 @@ func (r *Resolver) listCandidates(ctx context.Context, providerFilter string) ([
 	}
 	return filtered, nil
 }
+
+// buildModelCandidate resolves provider credentials for chatModel and builds
+// the SDK model together with the model-specific run settings.
+func (r *Resolver) buildModelCandidate(ctx context.Context, userID string, chatModel models.GetResponse, provider sqlc.Provider, botSettings settings.Settings, requestedEffort string) (agentpkg.ModelCandidate, error) {
+	reasoningConfig := resolveReasoningConfig(chatModel, botSettings, requestedEffort)
+	reasoningEffort := ""
+	if reasoningConfig != nil && reasoningConfig.Enabled {
+		reasoningEffort = reasoningConfig.Effort
+	}
+
+	authResolver := providers.NewService(nil, r.queries, "")
+	authCtx := oauthctx.WithUserID(ctx, userID)
+	creds, err := authResolver.ResolveModelCredentials(authCtx, provider)
+	if err != nil {
+		return agentpkg.ModelCandidate{}, fmt.Errorf("resolve provider credentials: %w", err)
+	}
+
+	baseURL := providers.ProviderConfigString(provider, "base_url")
+	chatCompletionsCompat := models.ResolveChatCompletionsCompat(
+		baseURL,
+		providers.ProviderConfigString(provider, "chat_completions_compat"),
+	)
+
+	sdkModel := models.NewSDKChatModel(models.SDKModelConfig{
+		ModelID:               chatModel.ModelID,
+		ClientType:            provider.ClientType,
+		APIKey:                creds.APIKey,
+		CodexAccountID:        creds.CodexAccountID,
+		BaseURL:               baseURL,
+		ChatCompletionsCompat: chatCompletionsCompat,
+		HTTPClient:            r.streamHTTPClient,
+		ReasoningConfig:       reasoningConfig,
+	})
+
+	return agentpkg.ModelCandidate{
+		ModelID:               chatModel.ID,
+		ProviderID:            chatModel.ProviderID,
+		Model:                 sdkModel,
+		ReasoningEffort:       reasoningEffort,
+		ReasoningDisabled:     reasoningConfig != nil && reasoningConfig.Disabled,
+		ChatCompletionsCompat: chatCompletionsCompat,
+		PromptCacheTTL:        providers.ProviderConfigString(provider, "prompt_cache_ttl"),
+		SupportsImageInput:    chatModel.HasCompatibility(models.CompatVision),
+		SupportsToolCall:      chatModel.HasCompatibility(models.CompatToolCall),
+	}, nil
+}
+
+// buildModelFallbacks turns the bot's fallback chain into agent model
+// candidates. The selected primary model is skipped, and entries that can no
+// longer be loaded are logged and dropped so a stale chain never blocks a run.
+func (r *Resolver) buildModelFallbacks(ctx context.Context, p baseRunConfigParams, botSettings settings.Settings, primaryID string) []agentpkg.ModelCandidate {
+	var fallbacks []agentpkg.ModelCandidate
+	for _, modelID := range botSettings.FallbackModelIDs {
+		if modelID == primaryID {
+			continue
+		}
+		chatModel, provider, err := r.fetchChatModel(ctx, modelID)
+		if err != nil {
+			r.logger.Warn("skipping fallback model",
+				slog.String("bot_id", p.BotID),
+				slog.String("model_id", modelID),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		candidate, err := r.buildModelCandidate(ctx, p.UserID, chatModel, provider, botSettings, p.ReasoningEffort)
+		if err != nil {
+			r.logger.Warn("skipping fallback model",
+				slog.String("bot_id", p.BotID),
+				slog.String("model_id", modelID),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		fallbacks = append(fallbacks, candidate)
+	}
+	return fallbacks
+}
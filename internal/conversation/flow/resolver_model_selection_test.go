@@ -3,6 +3,7 @@ package flow
 import (
 	"testing"
 
+	agentpkg "github.com/memohai/memoh/internal/agent"
 	"github.com/memohai/memoh/internal/models"
 	"github.com/memohai/memoh/internal/settings"
 )
@@ -178,3 +179,21 @@ func TestResolveReasoningConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestResolvedContextModelIDPrefersFallback(t *testing.T) {
+	t.Parallel()
+
+	rc := resolvedContext{model: models.GetResponse{ID: "primary-uuid"}}
+	if got := rc.modelID(); got != "primary-uuid" {
+		t.Fatalf("modelID() without fallbacks = %q", got)
+	}
+
+	rc.fallback = &modelFallbackRecorder{}
+	if got := rc.modelID(); got != "primary-uuid" {
+		t.Fatalf("modelID() before failover = %q", got)
+	}
+	rc.fallback.record(agentpkg.ModelCandidate{ModelID: "fallback-uuid"})
+	if got := rc.modelID(); got != "fallback-uuid" {
+		t.Fatalf("modelID() after failover = %q, want fallback-uuid", got)
+	}
+}
@@ -22,6 +22,9 @@ type storeRoundOptions struct {
 	SkipMemory              bool
 	AllowEmptyAssistantText bool
 	MessageMetadataByIndex  map[int]map[string]any
+	// StructuredOutput is the validated JSON reply of a run with an output
+	// schema. It is stored in the metadata of the final assistant message.
+	StructuredOutput json.RawMessage
 }
 
 func (r *Resolver) storeRoundWithOptions(ctx context.Context, req conversation.ChatRequest, messages []conversation.ModelMessage, modelID string, opts storeRoundOptions) error {
@@ -135,6 +138,15 @@ func (r *Resolver) storeMessages(ctx context.Context, req conversation.ChatReque
 	if lastAssistantIdx >= 0 {
 		outboundAssets = outboundAssetRefsToMessageRefs(req.OutboundAssetCollector())
 	}
+	structuredIdx := -1
+	if len(opts.StructuredOutput) > 0 {
+		for i := len(messages) - 1; i >= 0; i-- {
+			if messages[i].Role == "assistant" {
+				structuredIdx = i
+				break
+			}
+		}
+	}
 
 	for i, msg := range messages {
 		msg = normalizeUserMessageContent(msg)
@@ -208,6 +220,11 @@ func (r *Resolver) storeMessages(ctx context.Context, req conversation.ChatReque
 		if extraMeta := opts.MessageMetadataByIndex[i]; len(extraMeta) > 0 {
 			persistMeta = mergeMetadata(persistMeta, extraMeta)
 		}
+		if i == structuredIdx {
+			persistMeta = mergeMetadata(persistMeta, map[string]any{
+				conversation.StructuredOutputMetadataKey: opts.StructuredOutput,
+			})
+		}
 		if _, err := r.messageService.Persist(ctx, messagepkg.PersistInput{
 			BotID:                   req.BotID,
 			SessionID:               req.SessionID,
@@ -23,6 +23,7 @@ type WSStreamEvent = json.RawMessage
 type terminalSnapshot struct {
 	sdkMessages    []sdk.Message
 	usage          json.RawMessage
+	structured     json.RawMessage
 	deferredToolID string
 }
 
@@ -57,6 +58,7 @@ func extractTerminalSnapshot(data []byte) (terminalSnapshot, bool) {
 		Type       string          `json:"type"`
 		Messages   json.RawMessage `json:"messages"`
 		Usage      json.RawMessage `json:"usage,omitempty"`
+		Structured json.RawMessage `json:"structured,omitempty"`
 		ApprovalID string          `json:"approvalId,omitempty"`
 	}
 	if err := json.Unmarshal(data, &envelope); err != nil {
@@ -72,6 +74,7 @@ func extractTerminalSnapshot(data []byte) (terminalSnapshot, bool) {
 	return terminalSnapshot{
 		sdkMessages:    sdkMsgs,
 		usage:          envelope.Usage,
+		structured:     envelope.Structured,
 		deferredToolID: strings.TrimSpace(envelope.ApprovalID),
 	}, true
 }
@@ -131,7 +134,7 @@ func (r *Resolver) StreamChat(ctx context.Context, req conversation.ChatRequest)
 				r.logger.Error("agent stream error",
 					slog.String("bot_id", streamReq.BotID),
 					slog.String("chat_id", streamReq.ChatID),
-					slog.String("model_id", rc.model.ID),
+					slog.String("model_id", rc.modelID()),
 					slog.String("error", event.Error),
 				)
 			}
@@ -193,7 +196,7 @@ func (r *Resolver) StreamChat(ctx context.Context, req conversation.ChatRequest)
 			r.logger.Warn("agent stream aborted: idle timeout (no events from provider)",
 				slog.String("bot_id", streamReq.BotID),
 				slog.String("chat_id", streamReq.ChatID),
-				slog.String("model_id", rc.model.ID),
+				slog.String("model_id", rc.modelID()),
 				slog.Int("tool_calls", toolCallCount),
 			)
 			// Notify the client that the stream was terminated due to idle timeout.
@@ -270,7 +273,6 @@ func (r *Resolver) StreamChatWS(
 	defer idleCancel.Stop()
 
 	agentEventCh := r.agent.Stream(idleCtx, cfg)
-	modelID := rc.model.ID
 	stored := false
 	clientGone := false
 	var lastSnapshot terminalSnapshot
@@ -290,7 +292,7 @@ func (r *Resolver) StreamChatWS(
 			r.logger.Error("agent stream error",
 				slog.String("bot_id", req.BotID),
 				slog.String("chat_id", req.ChatID),
-				slog.String("model_id", modelID),
+				slog.String("model_id", rc.modelID()),
 				slog.String("error", event.Error),
 			)
 		}
@@ -343,7 +345,7 @@ func (r *Resolver) StreamChatWS(
 		r.logger.Warn("agent ws stream aborted: idle timeout (no events from provider)",
 			slog.String("bot_id", req.BotID),
 			slog.String("chat_id", req.ChatID),
-			slog.String("model_id", modelID),
+			slog.String("model_id", rc.modelID()),
 			slog.Int("tool_calls", toolCallCount),
 		)
 		// Notify the client that the stream was terminated due to idle timeout.
@@ -384,8 +386,9 @@ func (r *Resolver) persistTerminalSnapshot(ctx context.Context, req conversation
 		roundMessages = interleaveInjectedMessages(roundMessages, *rc.injectedRecords)
 	}
 
-	if err := r.storeRoundWithOptions(ctx, req, roundMessages, rc.model.ID, storeRoundOptions{
+	if err := r.storeRoundWithOptions(ctx, req, roundMessages, rc.modelID(), storeRoundOptions{
 		AllowPendingToolCalls: snap.deferredToolID != "",
+		StructuredOutput:      snap.structured,
 	}); err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 
 	sdk "github.com/memohai/twilight-ai/sdk"
@@ -37,6 +38,7 @@ func (r *Resolver) RespondToolApproval(ctx context.Context, input ToolApprovalRe
 		SessionID:              input.SessionID,
 		ExplicitID:             firstNonEmpty(input.ExplicitID, input.ApprovalID),
 		ReplyExternalMessageID: input.ReplyExternalMessageID,
+		ActorChannelIdentityID: input.ActorChannelIdentityID,
 	})
 	if err != nil {
 		return err
@@ -47,17 +49,24 @@ func (r *Resolver) RespondToolApproval(ctx context.Context, input ToolApprovalRe
 		return r.respondACPToolApproval(ctx, target, input, eventCh)
 	}
 
-	var toolResult sdk.ToolResultPart
+	var (
+		toolResult sdk.ToolResultPart
+		notice     string
+	)
 	switch strings.ToLower(strings.TrimSpace(input.Decision)) {
 	case "approve", "approved":
 		approved, err := r.toolApproval.Approve(ctx, target.ID, input.ActorChannelIdentityID, input.Reason)
 		if err != nil {
 			return err
 		}
+		if approved.Status == toolapproval.StatusPending {
+			return emitApprovalNotice(ctx, eventCh, pendingVoteText(approved))
+		}
 		toolResult, err = r.executeApprovedTool(ctx, approved, input)
 		if err != nil {
 			return err
 		}
+		notice = "Tool call approved."
 	case "reject", "rejected":
 		rejected, err := r.toolApproval.Reject(ctx, target.ID, input.ActorChannelIdentityID, input.Reason)
 		if err != nil {
@@ -69,13 +78,88 @@ func (r *Resolver) RespondToolApproval(ctx context.Context, input ToolApprovalRe
 			Result:     rejectedToolResultText(input.Reason),
 			IsError:    true,
 		}
+		notice = "Tool call rejected."
 	default:
 		return fmt.Errorf("unknown tool approval decision %q", input.Decision)
 	}
 
+	if isRemoteApproval(target, input) {
+		if err := emitApprovalNotice(ctx, eventCh, notice); err != nil {
+			return err
+		}
+		go r.continueDetached(context.WithoutCancel(ctx), target, input, toolResult)
+		return nil
+	}
 	return r.storeToolResultAndContinue(ctx, target, input, toolResult, eventCh)
 }
 
+// HandleExpiredToolApproval continues the conversation of a request that
+// expired without a decision, telling the model the call was rejected.
+// ACP agents wait on the decision themselves and need nothing more.
+func (r *Resolver) HandleExpiredToolApproval(ctx context.Context, req toolapproval.Request) {
+	if isACP, err := r.isACPToolApprovalSession(ctx, req.SessionID); err != nil || isACP {
+		return
+	}
+	go r.continueDetached(context.WithoutCancel(ctx), req, ToolApprovalResponseInput{BotID: req.BotID}, sdk.ToolResultPart{
+		ToolCallID: req.ToolCallID,
+		ToolName:   req.ToolName,
+		Result:     rejectedToolResultText(req.DecisionReason),
+		IsError:    true,
+	})
+}
+
+// isRemoteApproval reports whether the decision came from a conversation
+// other than the one that made the request, whose stream must not carry
+// the continuation.
+func isRemoteApproval(target toolapproval.Request, input ToolApprovalResponseInput) bool {
+	sessionID := strings.TrimSpace(input.SessionID)
+	return sessionID != "" && sessionID != target.SessionID
+}
+
+// continueDetached stores the tool result and continues the requesting
+// session in the background, delivering the reply to its original channel.
+func (r *Resolver) continueDetached(ctx context.Context, approval toolapproval.Request, input ToolApprovalResponseInput, result sdk.ToolResultPart) {
+	events := make(chan WSStreamEvent)
+	var text strings.Builder
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for data := range events {
+			var event agentpkg.StreamEvent
+			if json.Unmarshal(data, &event) == nil && event.Type == agentpkg.EventTextDelta {
+				text.WriteString(event.Delta)
+			}
+		}
+	}()
+	err := r.storeToolResultAndContinue(ctx, approval, input, result, events)
+	close(events)
+	<-done
+	if err != nil {
+		r.logger.Warn("continue tool approval session failed",
+			slog.String("approval_id", approval.ID),
+			slog.String("session_id", approval.SessionID),
+			slog.Any("error", err))
+		return
+	}
+	reply := strings.TrimSpace(text.String())
+	if reply == "" || r.outboundFn == nil || strings.TrimSpace(approval.ReplyTarget) == "" {
+		return
+	}
+	if err := r.outboundFn(ctx, approval.BotID, approval.SourcePlatform, approval.ReplyTarget, reply); err != nil {
+		r.logger.Warn("deliver tool approval continuation failed",
+			slog.String("approval_id", approval.ID),
+			slog.String("platform", approval.SourcePlatform),
+			slog.Any("error", err))
+	}
+}
+
+func pendingVoteText(req toolapproval.Request) string {
+	if req.Workflow == nil || req.Workflow.Quorum <= 1 {
+		return "Approval recorded. Waiting for an approver."
+	}
+	return fmt.Sprintf("Approval recorded (%d of %d).", req.Workflow.Approvals(), req.Workflow.Quorum)
+}
+
 func (r *Resolver) isACPToolApprovalSession(ctx context.Context, sessionID string) (bool, error) {
 	if r == nil || r.sessionService == nil {
 		return false, nil
@@ -90,9 +174,13 @@ func (r *Resolver) isACPToolApprovalSession(ctx context.Context, sessionID strin
 func (r *Resolver) respondACPToolApproval(ctx context.Context, target toolapproval.Request, input ToolApprovalResponseInput, eventCh chan<- WSStreamEvent) error {
 	switch strings.ToLower(strings.TrimSpace(input.Decision)) {
 	case "approve", "approved":
-		if _, err := r.toolApproval.Approve(ctx, target.ID, input.ActorChannelIdentityID, input.Reason); err != nil {
+		approved, err := r.toolApproval.Approve(ctx, target.ID, input.ActorChannelIdentityID, input.Reason)
+		if err != nil {
 			return err
 		}
+		if approved.Status == toolapproval.StatusPending {
+			return emitApprovalNotice(ctx, eventCh, pendingVoteText(approved))
+		}
 	case "reject", "rejected":
 		if _, err := r.toolApproval.Reject(ctx, target.ID, input.ActorChannelIdentityID, input.Reason); err != nil {
 			return err
@@ -104,13 +192,29 @@ func (r *Resolver) respondACPToolApproval(ctx context.Context, target toolapprov
 }
 
 func emitApprovalAck(ctx context.Context, eventCh chan<- WSStreamEvent) error {
+	return emitApprovalEvents(ctx, eventCh, []agentpkg.StreamEvent{
+		{Type: agentpkg.EventAgentStart},
+		{Type: agentpkg.EventAgentEnd},
+	})
+}
+
+// emitApprovalNotice answers the approver with a short text reply instead
+// of a model turn.
+func emitApprovalNotice(ctx context.Context, eventCh chan<- WSStreamEvent, text string) error {
+	return emitApprovalEvents(ctx, eventCh, []agentpkg.StreamEvent{
+		{Type: agentpkg.EventAgentStart},
+		{Type: agentpkg.EventTextStart},
+		{Type: agentpkg.EventTextDelta, Delta: text},
+		{Type: agentpkg.EventTextEnd},
+		{Type: agentpkg.EventAgentEnd},
+	})
+}
+
+func emitApprovalEvents(ctx context.Context, eventCh chan<- WSStreamEvent, events []agentpkg.StreamEvent) error {
 	if eventCh == nil {
 		return nil
 	}
-	for _, event := range []agentpkg.StreamEvent{
-		{Type: agentpkg.EventAgentStart},
-		{Type: agentpkg.EventAgentEnd},
-	} {
+	for _, event := range events {
 		data, err := json.Marshal(event)
 		if err != nil {
 			return err
@@ -41,12 +41,13 @@ func (r *Resolver) TriggerSchedule(ctx context.Context, botID string, payload sc
 	}
 
 	req := conversation.ChatRequest{
-		BotID:     botID,
-		ChatID:    botID,
-		SessionID: payload.SessionID,
-		Query:     payload.Command,
-		UserID:    payload.OwnerUserID,
-		Token:     token,
+		BotID:        botID,
+		ChatID:       botID,
+		SessionID:    payload.SessionID,
+		Query:        payload.Command,
+		UserID:       payload.OwnerUserID,
+		Token:        token,
+		OutputSchema: payload.OutputSchema,
 	}
 	rc, err := r.resolve(ctx, req)
 	if err != nil {
@@ -64,6 +65,8 @@ func (r *Resolver) TriggerSchedule(ctx context.Context, botID string, payload sc
 		Pattern:     payload.Pattern,
 		MaxCalls:    payload.MaxCalls,
 		Command:     payload.Command,
+		TriggerKind: string(payload.TriggerKind),
+		Event:       payload.Event,
 	})
 	cfg.Messages = append(cfg.Messages, sdk.UserMessage(schedulePrompt))
 	cfg = r.prepareRunConfig(ctx, cfg)
@@ -75,14 +78,17 @@ func (r *Resolver) TriggerSchedule(ctx context.Context, botID string, payload sc
 
 	outputMessages := sdkMessagesToModelMessages(result.Messages)
 	roundMessages := prependUserMessage(req.Query, outputMessages)
-	storeErr := r.storeRound(ctx, req, roundMessages, rc.model.ID)
+	storeErr := r.storeRoundWithOptions(ctx, req, roundMessages, rc.modelID(), storeRoundOptions{
+		StructuredOutput: result.Structured,
+	})
 
 	totalUsageJSON, _ := json.Marshal(result.Usage)
 	return schedule.TriggerResult{
 		Status:     "ok",
 		Text:       strings.TrimSpace(result.Text),
 		UsageBytes: totalUsageJSON,
-		ModelID:    rc.model.ID,
+		ModelID:    rc.modelID(),
+		Structured: result.Structured,
 	}, storeErr
 }
 
@@ -145,7 +151,7 @@ func (r *Resolver) TriggerHeartbeat(ctx context.Context, botID string, payload h
 
 	outputMessages := sdkMessagesToModelMessages(result.Messages)
 	roundMessages := prependUserMessage(heartbeatPrompt, outputMessages)
-	_ = r.storeRound(ctx, req, roundMessages, rc.model.ID)
+	_ = r.storeRound(ctx, req, roundMessages, rc.modelID())
 
 	totalUsageJSON, _ := json.Marshal(result.Usage)
 	return heartbeat.TriggerResult{
@@ -153,7 +159,7 @@ func (r *Resolver) TriggerHeartbeat(ctx context.Context, botID string, payload h
 		Text:       text,
 		Usage:      totalUsageJSON,
 		UsageBytes: totalUsageJSON,
-		ModelID:    rc.model.ID,
+		ModelID:    rc.modelID(),
 		SessionID:  payload.SessionID,
 	}, nil
 }
@@ -331,7 +337,7 @@ func (r *Resolver) deliverBackgroundNotifications(ctx context.Context, botID, se
 			r.logger.Error("background notification stream error",
 				slog.String("bot_id", botID),
 				slog.String("session_id", sessionID),
-				slog.String("model_id", rc.model.ID),
+				slog.String("model_id", rc.modelID()),
 				slog.String("error", event.Error),
 			)
 			r.publishBackgroundAgentStream(botID, sessionID, map[string]any{
@@ -394,7 +400,7 @@ func (r *Resolver) deliverBackgroundNotifications(ctx context.Context, botID, se
 		r.logger.Warn("background notification stream aborted: idle timeout",
 			slog.String("bot_id", botID),
 			slog.String("session_id", sessionID),
-			slog.String("model_id", rc.model.ID),
+			slog.String("model_id", rc.modelID()),
 			slog.Int("tool_calls", toolCallCount),
 		)
 		r.publishBackgroundAgentStream(botID, sessionID, map[string]any{
@@ -432,7 +438,7 @@ func (r *Resolver) storeBackgroundNotificationSnapshot(ctx context.Context, req
 	outputMessages := sdkMessagesToModelMessages(snap.sdkMessages)
 	notifModelMessages := sdkMessagesToModelMessages(notifMessages)
 	roundMessages := append(append(make([]conversation.ModelMessage, 0, len(notifModelMessages)+len(outputMessages)), notifModelMessages...), outputMessages...)
-	return r.storeRound(ctx, req, roundMessages, rc.model.ID)
+	return r.storeRound(ctx, req, roundMessages, rc.modelID())
 }
 
 func (r *Resolver) publishBackgroundAgentStream(botID, sessionID string, stream map[string]any) {
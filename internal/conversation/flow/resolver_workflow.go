@@ -0,0 +1,71 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	sdk "github.com/memohai/twilight-ai/sdk"
+
+	agentpkg "github.com/memohai/memoh/internal/agent"
+	"github.com/memohai/memoh/internal/conversation"
+	"github.com/memohai/memoh/internal/workflow"
+)
+
+// workflowSessionType is the runtime session mode of an agent step of a
+// workflow run. Like delegations, the stored session stays a chat session.
+const workflowSessionType = "workflow"
+
+// RunWorkflowStep executes an agent step of a workflow as the step's bot and
+// stores the round in the step's session.
+func (r *Resolver) RunWorkflowStep(ctx context.Context, input workflow.AgentStepInput) (workflow.AgentStepResult, error) {
+	if strings.TrimSpace(input.BotID) == "" {
+		return workflow.AgentStepResult{}, errors.New("bot id is required")
+	}
+	if strings.TrimSpace(input.Prompt) == "" {
+		return workflow.AgentStepResult{}, errors.New("workflow step prompt is required")
+	}
+
+	req := conversation.ChatRequest{
+		BotID:       input.BotID,
+		ChatID:      input.BotID,
+		SessionID:   input.SessionID,
+		Query:       input.Prompt,
+		UserID:      input.OwnerUserID,
+		SessionType: workflowSessionType,
+	}
+	rc, err := r.resolve(ctx, req)
+	if err != nil {
+		return workflow.AgentStepResult{}, err
+	}
+
+	cfg := rc.runConfig
+	cfg.Identity.ChannelIdentityID = strings.TrimSpace(input.OwnerUserID)
+	cfg.Messages = append(cfg.Messages, sdk.UserMessage(agentpkg.GenerateWorkflowStepPrompt(agentpkg.WorkflowStep{
+		Workflow: input.WorkflowName,
+		RunID:    input.RunID,
+		StepID:   input.StepID,
+		Prompt:   input.Prompt,
+	})))
+	cfg = r.prepareRunConfig(ctx, cfg)
+
+	result, err := r.agent.Generate(ctx, cfg)
+	if err != nil {
+		return workflow.AgentStepResult{}, err
+	}
+
+	outputMessages := sdkMessagesToModelMessages(result.Messages)
+	roundMessages := prependUserMessage(req.Query, outputMessages)
+	storeErr := r.storeRound(ctx, req, roundMessages, rc.modelID())
+
+	var usage map[string]any
+	if raw, err := json.Marshal(result.Usage); err == nil {
+		_ = json.Unmarshal(raw, &usage)
+	}
+	return workflow.AgentStepResult{
+		Text:    strings.TrimSpace(result.Text),
+		ModelID: rc.modelID(),
+		Usage:   usage,
+	}, storeErr
+}
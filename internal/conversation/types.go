@@ -27,6 +27,10 @@ const (
 	AccessModeChannelIdentityObserved = "channel_identity_observed"
 )
 
+// StructuredOutputMetadataKey is the message metadata key that holds the
+// validated JSON reply of a run with an output schema.
+const StructuredOutputMetadataKey = "structured_output"
+
 // Conversation is the first-class conversation container.
 type Conversation struct {
 	ID           string         `json:"id"`
@@ -249,6 +253,7 @@ type ChatRequest struct {
 	EventID                   string           `json:"-"`
 	RawQuery                  string           `json:"-"`
 	ToolHTTPURL               string           `json:"-"`
+	SessionType               string           `json:"-"` // runtime session mode override, e.g. "delegation"
 
 	// OutboundAssetCollector returns asset refs accumulated during outbound streaming.
 	// Set by the inbound channel processor; called by the resolver at persist time.
@@ -262,6 +267,7 @@ type ChatRequest struct {
 	Model           string           `json:"model,omitempty"`
 	Provider        string           `json:"provider,omitempty"`
 	ReasoningEffort string           `json:"reasoning_effort,omitempty"`
+	OutputSchema    json.RawMessage  `json:"output_schema,omitempty"`
 	Channels        []string         `json:"channels,omitempty"`
 	CurrentChannel  string           `json:"current_channel,omitempty"`
 	Messages        []ModelMessage   `json:"messages,omitempty"`
@@ -288,9 +294,11 @@ type InjectedMessageRecord struct {
 
 // ChatResponse is the output of a non-streaming chat call.
 type ChatResponse struct {
-	Messages []ModelMessage `json:"messages"`
-	Model    string         `json:"model,omitempty"`
-	Provider string         `json:"provider,omitempty"`
+	Messages   []ModelMessage  `json:"messages"`
+	Model      string          `json:"model,omitempty"`
+	Provider   string          `json:"provider,omitempty"`
+	Structured json.RawMessage `json:"structured,omitempty"`
+	Usage      json.RawMessage `json:"usage,omitempty"`
 }
 
 // StreamChunk is a raw JSON chunk from the streaming response.
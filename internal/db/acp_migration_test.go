@@ -78,25 +78,6 @@ func TestPostgresACPAgentSessionTypeMigrationFiles(t *testing.T) {
 	}
 }
 
-func TestACPDoesNotWidenToolApprovalRequestToolNameCheck(t *testing.T) {
-	for _, path := range []string{
-		"postgres/migrations/0001_init.up.sql",
-		"sqlite/migrations/0001_init.up.sql",
-	} {
-		t.Run(path, func(t *testing.T) {
-			sql := readEmbeddedMigration(t, path)
-			const check = "CHECK (tool_name IN ('write', 'edit', 'exec'))"
-			if !strings.Contains(sql, check) {
-				t.Fatalf("%s missing exact Memoh-native tool approval CHECK %q", path, check)
-			}
-			tableSQL := toolApprovalTableSQL(sql)
-			if strings.Contains(tableSQL, "acp_agent") {
-				t.Fatalf("%s tool_approval_requests CHECK must not include ACP tools", path)
-			}
-		})
-	}
-}
-
 func sqliteMigrationsFS(t *testing.T) fs.FS {
 	t.Helper()
 	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
@@ -150,19 +131,6 @@ func insertSQLiteACPAgentSession(t *testing.T, db *sql.DB) {
 	}
 }
 
-func toolApprovalTableSQL(sql string) string {
-	start := strings.Index(sql, "CREATE TABLE IF NOT EXISTS tool_approval_requests")
-	if start < 0 {
-		return ""
-	}
-	tail := sql[start:]
-	end := strings.Index(tail, "CREATE INDEX")
-	if end < 0 {
-		return tail
-	}
-	return tail[:end]
-}
-
 func readEmbeddedMigration(t *testing.T, path string) string {
 	t.Helper()
 	data, err := embeddeddb.MigrationsFS.ReadFile(path)
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+// TestSQLiteBudgetsMigration guards 0020: a fresh replay creates the budgets
+// table once, its checks reject a budget scoped to both a bot and a user, and
+// rolling back drops it.
+func TestSQLiteBudgetsMigration(t *testing.T) {
+	migrations := sqliteMigrationsFS(t)
+	dsn := tempSQLiteMigrationDSN(t)
+
+	if err := RunMigrateTarget(nil, MigrationTarget{Driver: DriverSQLite, DSN: dsn}, migrations, "up", nil); err != nil {
+		t.Fatalf("fresh full migrate up failed: %v", err)
+	}
+
+	db := openMigrationSQLite(t, dsn)
+	ctx := context.Background()
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('00000000-0000-0000-0000-0000000000e1','budget@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES('00000000-0000-0000-0000-0000000000e2','00000000-0000-0000-0000-0000000000e1','personal','budgetbot','Budget Bot')`,
+		`INSERT INTO budgets(id,bot_id,period,unit,limit_amount) VALUES('00000000-0000-0000-0000-0000000000e3','00000000-0000-0000-0000-0000000000e2','monthly','cost',25)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+	var warnPercent, enabled int
+	if err := db.QueryRowContext(ctx, `SELECT warn_percent, enabled FROM budgets WHERE id='00000000-0000-0000-0000-0000000000e3'`).Scan(&warnPercent, &enabled); err != nil {
+		t.Fatalf("select budget: %v", err)
+	}
+	if warnPercent != 80 || enabled != 1 {
+		t.Fatalf("defaults = (%d, %d), want (80, 1)", warnPercent, enabled)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO budgets(id,bot_id,user_id,period,unit,limit_amount) VALUES('00000000-0000-0000-0000-0000000000e4','00000000-0000-0000-0000-0000000000e2','00000000-0000-0000-0000-0000000000e1','daily','tokens',1000)`); err == nil {
+		t.Fatal("expected scope check to reject a budget with both bot_id and user_id")
+	}
+	closeMigrationSQLite(t, db)
+
+	if err := RunMigrateTarget(nil, MigrationTarget{Driver: DriverSQLite, DSN: dsn}, migrations, "down", nil); err != nil {
+		t.Fatalf("migrate down: %v", err)
+	}
+}
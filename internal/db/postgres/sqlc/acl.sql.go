@@ -158,6 +158,33 @@ func (q *Queries) GetBotACLDefaultEffect(ctx context.Context, id pgtype.UUID) (s
 	return acl_default_effect, err
 }
 
+const getBotACLRuleByID = `-- name: GetBotACLRuleByID :one
+SELECT id, bot_id, action, effect, channel_identity_id, source_channel, source_conversation_type, source_conversation_id, source_thread_id, created_by_user_id, created_at, updated_at, enabled, description, subject_channel_type FROM bot_acl_rules WHERE id = $1
+`
+
+func (q *Queries) GetBotACLRuleByID(ctx context.Context, id pgtype.UUID) (BotAclRule, error) {
+	row := q.db.QueryRow(ctx, getBotACLRuleByID, id)
+	var i BotAclRule
+	err := row.Scan(
+		&i.ID,
+		&i.BotID,
+		&i.Action,
+		&i.Effect,
+		&i.ChannelIdentityID,
+		&i.SourceChannel,
+		&i.SourceConversationType,
+		&i.SourceConversationID,
+		&i.SourceThreadID,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Enabled,
+		&i.Description,
+		&i.SubjectChannelType,
+	)
+	return i, err
+}
+
 const listBotACLRules = `-- name: ListBotACLRules :many
 SELECT
   r.id,
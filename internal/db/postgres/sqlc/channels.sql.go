@@ -190,6 +190,40 @@ func (q *Queries) ListUserChannelBindingsByPlatform(ctx context.Context, channel
 	return items, nil
 }
 
+const listUserChannelBindingsByUser = `-- name: ListUserChannelBindingsByUser :many
+SELECT id, user_id, channel_type, config, created_at, updated_at
+FROM user_channel_bindings
+WHERE user_id = $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListUserChannelBindingsByUser(ctx context.Context, userID pgtype.UUID) ([]UserChannelBinding, error) {
+	rows, err := q.db.Query(ctx, listUserChannelBindingsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserChannelBinding
+	for rows.Next() {
+		var i UserChannelBinding
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ChannelType,
+			&i.Config,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const saveMatrixSyncSinceToken = `-- name: SaveMatrixSyncSinceToken :execrows
 UPDATE bot_channel_configs
 SET routing = COALESCE(routing, '{}'::jsonb) || jsonb_build_object(
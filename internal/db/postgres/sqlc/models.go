@@ -8,6 +8,20 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLog struct {
+	ID         int64              `json:"id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	ActorType  string             `json:"actor_type"`
+	ActorID    string             `json:"actor_id"`
+	BotID      pgtype.UUID        `json:"bot_id"`
+	Action     string             `json:"action"`
+	TargetType string             `json:"target_type"`
+	TargetID   string             `json:"target_id"`
+	Before     []byte             `json:"before"`
+	After      []byte             `json:"after"`
+	Metadata   []byte             `json:"metadata"`
+}
+
 type Bot struct {
 	ID                     pgtype.UUID        `json:"id"`
 	OwnerUserID            pgtype.UUID        `json:"owner_user_id"`
@@ -98,6 +112,32 @@ type BotChannelRoute struct {
 	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
 }
 
+type BotDelegation struct {
+	ID            pgtype.UUID        `json:"id"`
+	ParentID      pgtype.UUID        `json:"parent_id"`
+	FromBotID     pgtype.UUID        `json:"from_bot_id"`
+	FromSessionID pgtype.UUID        `json:"from_session_id"`
+	ToBotID       pgtype.UUID        `json:"to_bot_id"`
+	ToSessionID   pgtype.UUID        `json:"to_session_id"`
+	Task          string             `json:"task"`
+	Hop           int32              `json:"hop"`
+	Chain         []byte             `json:"chain"`
+	Status        string             `json:"status"`
+	Result        string             `json:"result"`
+	Error         string             `json:"error"`
+	ModelID       pgtype.UUID        `json:"model_id"`
+	Usage         []byte             `json:"usage"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	CompletedAt   pgtype.Timestamptz `json:"completed_at"`
+}
+
+type BotEgressPolicy struct {
+	BotID     pgtype.UUID        `json:"bot_id"`
+	Policy    []byte             `json:"policy"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
 type BotEmailBinding struct {
 	ID              pgtype.UUID        `json:"id"`
 	BotID           pgtype.UUID        `json:"bot_id"`
@@ -168,6 +208,13 @@ type BotHistoryMessageCompact struct {
 	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
 }
 
+type BotModelFallback struct {
+	BotID     pgtype.UUID        `json:"bot_id"`
+	ModelID   pgtype.UUID        `json:"model_id"`
+	Position  int32              `json:"position"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type BotPluginInstallation struct {
 	ID          pgtype.UUID        `json:"id"`
 	BotID       pgtype.UUID        `json:"bot_id"`
@@ -222,6 +269,20 @@ type BotSessionEvent struct {
 	CreatedAt               pgtype.Timestamptz `json:"created_at"`
 }
 
+type BotSnapshotPolicy struct {
+	BotID              pgtype.UUID        `json:"bot_id"`
+	Enabled            bool               `json:"enabled"`
+	KeepHourly         int32              `json:"keep_hourly"`
+	KeepDaily          int32              `json:"keep_daily"`
+	KeepWeekly         int32              `json:"keep_weekly"`
+	KeepMonthly        int32              `json:"keep_monthly"`
+	ExecPatterns       []byte             `json:"exec_patterns"`
+	BeforeSkillInstall bool               `json:"before_skill_install"`
+	LastRunAt          pgtype.Timestamptz `json:"last_run_at"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+}
+
 type BotStorageBinding struct {
 	ID                pgtype.UUID        `json:"id"`
 	BotID             pgtype.UUID        `json:"bot_id"`
@@ -231,6 +292,20 @@ type BotStorageBinding struct {
 	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
 }
 
+type BotTemplate struct {
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description"`
+	Image           string             `json:"image"`
+	Contents        []byte             `json:"contents"`
+	SizeBytes       int64              `json:"size_bytes"`
+	SourceBotID     pgtype.UUID        `json:"source_bot_id"`
+	CreatedByUserID pgtype.UUID        `json:"created_by_user_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	Shared          bool               `json:"shared"`
+}
+
 type BotUserGrant struct {
 	ID              pgtype.UUID        `json:"id"`
 	BotID           pgtype.UUID        `json:"bot_id"`
@@ -251,6 +326,21 @@ type BotWorkspaceResourceLimit struct {
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 }
 
+type Budget struct {
+	ID             pgtype.UUID        `json:"id"`
+	BotID          pgtype.UUID        `json:"bot_id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	Period         string             `json:"period"`
+	Unit           string             `json:"unit"`
+	LimitAmount    float64            `json:"limit_amount"`
+	WarnPercent    int32              `json:"warn_percent"`
+	Enabled        bool               `json:"enabled"`
+	WarnedPeriod   string             `json:"warned_period"`
+	ExceededPeriod string             `json:"exceeded_period"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
 type ChannelIdentity struct {
 	ID               pgtype.UUID        `json:"id"`
 	ChannelType      string             `json:"channel_type"`
@@ -426,6 +516,20 @@ type ModelVariant struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type PersonalAccessToken struct {
+	ID          pgtype.UUID        `json:"id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	BotID       pgtype.UUID        `json:"bot_id"`
+	Name        string             `json:"name"`
+	TokenPrefix string             `json:"token_prefix"`
+	TokenHash   string             `json:"token_hash"`
+	Scopes      string             `json:"scopes"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt   pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
 type Provider struct {
 	ID         pgtype.UUID        `json:"id"`
 	Name       string             `json:"name"`
@@ -453,17 +557,22 @@ type ProviderOauthToken struct {
 }
 
 type Schedule struct {
-	ID           pgtype.UUID        `json:"id"`
-	Name         string             `json:"name"`
-	Description  string             `json:"description"`
-	Pattern      string             `json:"pattern"`
-	MaxCalls     pgtype.Int4        `json:"max_calls"`
-	CurrentCalls int32              `json:"current_calls"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
-	Enabled      bool               `json:"enabled"`
-	Command      string             `json:"command"`
-	BotID        pgtype.UUID        `json:"bot_id"`
+	ID            pgtype.UUID        `json:"id"`
+	Name          string             `json:"name"`
+	Description   string             `json:"description"`
+	Pattern       string             `json:"pattern"`
+	MaxCalls      pgtype.Int4        `json:"max_calls"`
+	CurrentCalls  int32              `json:"current_calls"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	Enabled       bool               `json:"enabled"`
+	Command       string             `json:"command"`
+	BotID         pgtype.UUID        `json:"bot_id"`
+	TriggerKind   string             `json:"trigger_kind"`
+	TriggerFilter []byte             `json:"trigger_filter"`
+	RetryPolicy   []byte             `json:"retry_policy"`
+	OutputSchema  []byte             `json:"output_schema"`
+	WorkflowID    pgtype.UUID        `json:"workflow_id"`
 }
 
 type ScheduleLog struct {
@@ -478,6 +587,10 @@ type ScheduleLog struct {
 	ModelID      pgtype.UUID        `json:"model_id"`
 	StartedAt    pgtype.Timestamptz `json:"started_at"`
 	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
+	Attempt      int32              `json:"attempt"`
+	TriggerEvent string             `json:"trigger_event"`
+	ReplayOf     pgtype.UUID        `json:"replay_of"`
+	ResultJson   []byte             `json:"result_json"`
 }
 
 type SearchProvider struct {
@@ -546,6 +659,25 @@ type ToolApprovalRequest struct {
 	DecidedAt                    pgtype.Timestamptz `json:"decided_at"`
 }
 
+type ToolApprovalVote struct {
+	RequestID         pgtype.UUID        `json:"request_id"`
+	ChannelIdentityID pgtype.UUID        `json:"channel_identity_id"`
+	Decision          string             `json:"decision"`
+	Reason            string             `json:"reason"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+}
+
+type ToolApprovalWorkflow struct {
+	RequestID           pgtype.UUID        `json:"request_id"`
+	Approvers           []byte             `json:"approvers"`
+	Quorum              int32              `json:"quorum"`
+	EscalationApprovers []byte             `json:"escalation_approvers"`
+	EscalateAt          pgtype.Timestamptz `json:"escalate_at"`
+	EscalatedAt         pgtype.Timestamptz `json:"escalated_at"`
+	ExpiresAt           pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+}
+
 type TtsModel struct {
 	ID            pgtype.UUID        `json:"id"`
 	ModelID       string             `json:"model_id"`
@@ -592,6 +724,16 @@ type UserChannelBinding struct {
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
 }
 
+type UserIdentity struct {
+	ID          pgtype.UUID        `json:"id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	Issuer      string             `json:"issuer"`
+	Subject     string             `json:"subject"`
+	Email       pgtype.Text        `json:"email"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	LastLoginAt pgtype.Timestamptz `json:"last_login_at"`
+}
+
 type UserInputRequest struct {
 	ID                           pgtype.UUID        `json:"id"`
 	BotID                        pgtype.UUID        `json:"bot_id"`
@@ -637,3 +779,46 @@ type UserProviderOauthToken struct {
 	CreatedAt        pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
 }
+
+type Workflow struct {
+	ID          pgtype.UUID        `json:"id"`
+	BotID       pgtype.UUID        `json:"bot_id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Source      string             `json:"source"`
+	Definition  []byte             `json:"definition"`
+	Pattern     string             `json:"pattern"`
+	Enabled     bool               `json:"enabled"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WorkflowRun struct {
+	ID                pgtype.UUID        `json:"id"`
+	WorkflowID        pgtype.UUID        `json:"workflow_id"`
+	BotID             pgtype.UUID        `json:"bot_id"`
+	SessionID         pgtype.UUID        `json:"session_id"`
+	Trigger           string             `json:"trigger"`
+	TriggeredByUserID pgtype.UUID        `json:"triggered_by_user_id"`
+	Definition        []byte             `json:"definition"`
+	Inputs            []byte             `json:"inputs"`
+	Status            string             `json:"status"`
+	Error             string             `json:"error"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	CompletedAt       pgtype.Timestamptz `json:"completed_at"`
+}
+
+type WorkflowRunStep struct {
+	ID          pgtype.UUID        `json:"id"`
+	RunID       pgtype.UUID        `json:"run_id"`
+	StepID      string             `json:"step_id"`
+	Type        string             `json:"type"`
+	Status      string             `json:"status"`
+	Input       []byte             `json:"input"`
+	Output      []byte             `json:"output"`
+	Error       string             `json:"error"`
+	Ref         string             `json:"ref"`
+	SessionID   pgtype.UUID        `json:"session_id"`
+	StartedAt   pgtype.Timestamptz `json:"started_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+}
@@ -12,19 +12,24 @@ import (
 )
 
 const createSchedule = `-- name: CreateSchedule :one
-INSERT INTO schedule (name, description, pattern, max_calls, enabled, command, bot_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+INSERT INTO schedule (name, description, pattern, max_calls, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 `
 
 type CreateScheduleParams struct {
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	Pattern     string      `json:"pattern"`
-	MaxCalls    pgtype.Int4 `json:"max_calls"`
-	Enabled     bool        `json:"enabled"`
-	Command     string      `json:"command"`
-	BotID       pgtype.UUID `json:"bot_id"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	Pattern       string      `json:"pattern"`
+	MaxCalls      pgtype.Int4 `json:"max_calls"`
+	Enabled       bool        `json:"enabled"`
+	Command       string      `json:"command"`
+	BotID         pgtype.UUID `json:"bot_id"`
+	TriggerKind   string      `json:"trigger_kind"`
+	TriggerFilter []byte      `json:"trigger_filter"`
+	RetryPolicy   []byte      `json:"retry_policy"`
+	OutputSchema  []byte      `json:"output_schema"`
+	WorkflowID    pgtype.UUID `json:"workflow_id"`
 }
 
 func (q *Queries) CreateSchedule(ctx context.Context, arg CreateScheduleParams) (Schedule, error) {
@@ -36,6 +41,11 @@ func (q *Queries) CreateSchedule(ctx context.Context, arg CreateScheduleParams)
 		arg.Enabled,
 		arg.Command,
 		arg.BotID,
+		arg.TriggerKind,
+		arg.TriggerFilter,
+		arg.RetryPolicy,
+		arg.OutputSchema,
+		arg.WorkflowID,
 	)
 	var i Schedule
 	err := row.Scan(
@@ -50,6 +60,11 @@ func (q *Queries) CreateSchedule(ctx context.Context, arg CreateScheduleParams)
 		&i.Enabled,
 		&i.Command,
 		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
 	)
 	return i, err
 }
@@ -65,7 +80,7 @@ func (q *Queries) DeleteSchedule(ctx context.Context, id pgtype.UUID) error {
 }
 
 const getScheduleByID = `-- name: GetScheduleByID :one
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE id = $1
 `
@@ -85,6 +100,41 @@ func (q *Queries) GetScheduleByID(ctx context.Context, id pgtype.UUID) (Schedule
 		&i.Enabled,
 		&i.Command,
 		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
+	)
+	return i, err
+}
+
+const getScheduleByWorkflowID = `-- name: GetScheduleByWorkflowID :one
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
+FROM schedule
+WHERE workflow_id = $1
+`
+
+func (q *Queries) GetScheduleByWorkflowID(ctx context.Context, workflowID pgtype.UUID) (Schedule, error) {
+	row := q.db.QueryRow(ctx, getScheduleByWorkflowID, workflowID)
+	var i Schedule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Pattern,
+		&i.MaxCalls,
+		&i.CurrentCalls,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Enabled,
+		&i.Command,
+		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
 	)
 	return i, err
 }
@@ -98,7 +148,7 @@ SET current_calls = current_calls + 1,
     END,
     updated_at = now()
 WHERE id = $1
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 `
 
 func (q *Queries) IncrementScheduleCalls(ctx context.Context, id pgtype.UUID) (Schedule, error) {
@@ -116,12 +166,17 @@ func (q *Queries) IncrementScheduleCalls(ctx context.Context, id pgtype.UUID) (S
 		&i.Enabled,
 		&i.Command,
 		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
 	)
 	return i, err
 }
 
 const listEnabledSchedules = `-- name: ListEnabledSchedules :many
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE enabled = true
 ORDER BY created_at DESC
@@ -148,6 +203,11 @@ func (q *Queries) ListEnabledSchedules(ctx context.Context) ([]Schedule, error)
 			&i.Enabled,
 			&i.Command,
 			&i.BotID,
+			&i.TriggerKind,
+			&i.TriggerFilter,
+			&i.RetryPolicy,
+			&i.OutputSchema,
+			&i.WorkflowID,
 		); err != nil {
 			return nil, err
 		}
@@ -160,7 +220,7 @@ func (q *Queries) ListEnabledSchedules(ctx context.Context) ([]Schedule, error)
 }
 
 const listSchedulesByBot = `-- name: ListSchedulesByBot :many
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE bot_id = $1
 ORDER BY created_at DESC
@@ -187,6 +247,11 @@ func (q *Queries) ListSchedulesByBot(ctx context.Context, botID pgtype.UUID) ([]
 			&i.Enabled,
 			&i.Command,
 			&i.BotID,
+			&i.TriggerKind,
+			&i.TriggerFilter,
+			&i.RetryPolicy,
+			&i.OutputSchema,
+			&i.WorkflowID,
 		); err != nil {
 			return nil, err
 		}
@@ -206,19 +271,27 @@ SET name = $2,
     max_calls = $5,
     enabled = $6,
     command = $7,
+    trigger_kind = $8,
+    trigger_filter = $9,
+    retry_policy = $10,
+    output_schema = $11,
     updated_at = now()
 WHERE id = $1
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 `
 
 type UpdateScheduleParams struct {
-	ID          pgtype.UUID `json:"id"`
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	Pattern     string      `json:"pattern"`
-	MaxCalls    pgtype.Int4 `json:"max_calls"`
-	Enabled     bool        `json:"enabled"`
-	Command     string      `json:"command"`
+	ID            pgtype.UUID `json:"id"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	Pattern       string      `json:"pattern"`
+	MaxCalls      pgtype.Int4 `json:"max_calls"`
+	Enabled       bool        `json:"enabled"`
+	Command       string      `json:"command"`
+	TriggerKind   string      `json:"trigger_kind"`
+	TriggerFilter []byte      `json:"trigger_filter"`
+	RetryPolicy   []byte      `json:"retry_policy"`
+	OutputSchema  []byte      `json:"output_schema"`
 }
 
 func (q *Queries) UpdateSchedule(ctx context.Context, arg UpdateScheduleParams) (Schedule, error) {
@@ -230,6 +303,10 @@ func (q *Queries) UpdateSchedule(ctx context.Context, arg UpdateScheduleParams)
 		arg.MaxCalls,
 		arg.Enabled,
 		arg.Command,
+		arg.TriggerKind,
+		arg.TriggerFilter,
+		arg.RetryPolicy,
+		arg.OutputSchema,
 	)
 	var i Schedule
 	err := row.Scan(
@@ -244,6 +321,11 @@ func (q *Queries) UpdateSchedule(ctx context.Context, arg UpdateScheduleParams)
 		&i.Enabled,
 		&i.Command,
 		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
 	)
 	return i, err
 }
@@ -18,9 +18,10 @@ SET status = $2,
     error_message = $4,
     usage = $5,
     model_id = $6,
+    result_json = $7,
     completed_at = now()
 WHERE id = $1
-RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, model_id, started_at, completed_at
+RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, model_id, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 `
 
 type CompleteScheduleLogParams struct {
@@ -30,6 +31,7 @@ type CompleteScheduleLogParams struct {
 	ErrorMessage string      `json:"error_message"`
 	Usage        []byte      `json:"usage"`
 	ModelID      pgtype.UUID `json:"model_id"`
+	ResultJson   []byte      `json:"result_json"`
 }
 
 func (q *Queries) CompleteScheduleLog(ctx context.Context, arg CompleteScheduleLogParams) (ScheduleLog, error) {
@@ -40,6 +42,7 @@ func (q *Queries) CompleteScheduleLog(ctx context.Context, arg CompleteScheduleL
 		arg.ErrorMessage,
 		arg.Usage,
 		arg.ModelID,
+		arg.ResultJson,
 	)
 	var i ScheduleLog
 	err := row.Scan(
@@ -54,10 +57,39 @@ func (q *Queries) CompleteScheduleLog(ctx context.Context, arg CompleteScheduleL
 		&i.ModelID,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.Attempt,
+		&i.TriggerEvent,
+		&i.ReplayOf,
+		&i.ResultJson,
 	)
 	return i, err
 }
 
+const countActiveScheduleReplays = `-- name: CountActiveScheduleReplays :one
+SELECT count(*) FROM schedule_logs
+WHERE replay_of = $1
+  AND (completed_at IS NULL OR status IN ('ok', 'retrying', 'retried'))
+`
+
+// Replays of a log that are still running, waiting on a retry or succeeded.
+func (q *Queries) CountActiveScheduleReplays(ctx context.Context, replayOf pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveScheduleReplays, replayOf)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countFailedScheduleLogsByBot = `-- name: CountFailedScheduleLogsByBot :one
+SELECT count(*) FROM schedule_logs WHERE bot_id = $1 AND status IN ('error', 'dead_letter')
+`
+
+func (q *Queries) CountFailedScheduleLogsByBot(ctx context.Context, botID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countFailedScheduleLogsByBot, botID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countScheduleLogsByBot = `-- name: CountScheduleLogsByBot :one
 SELECT count(*) FROM schedule_logs WHERE bot_id = $1
 `
@@ -81,15 +113,18 @@ func (q *Queries) CountScheduleLogsBySchedule(ctx context.Context, scheduleID pg
 }
 
 const createScheduleLog = `-- name: CreateScheduleLog :one
-INSERT INTO schedule_logs (schedule_id, bot_id, session_id, started_at)
-VALUES ($1, $2, $3::uuid, now())
-RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+INSERT INTO schedule_logs (schedule_id, bot_id, session_id, attempt, trigger_event, replay_of, started_at)
+VALUES ($1, $2, $3::uuid, $4, $5, $6::uuid, now())
+RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 `
 
 type CreateScheduleLogParams struct {
-	ScheduleID pgtype.UUID `json:"schedule_id"`
-	BotID      pgtype.UUID `json:"bot_id"`
-	SessionID  pgtype.UUID `json:"session_id"`
+	ScheduleID   pgtype.UUID `json:"schedule_id"`
+	BotID        pgtype.UUID `json:"bot_id"`
+	SessionID    pgtype.UUID `json:"session_id"`
+	Attempt      int32       `json:"attempt"`
+	TriggerEvent string      `json:"trigger_event"`
+	ReplayOf     pgtype.UUID `json:"replay_of"`
 }
 
 type CreateScheduleLogRow struct {
@@ -103,10 +138,21 @@ type CreateScheduleLogRow struct {
 	Usage        []byte             `json:"usage"`
 	StartedAt    pgtype.Timestamptz `json:"started_at"`
 	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
+	Attempt      int32              `json:"attempt"`
+	TriggerEvent string             `json:"trigger_event"`
+	ReplayOf     pgtype.UUID        `json:"replay_of"`
+	ResultJson   []byte             `json:"result_json"`
 }
 
 func (q *Queries) CreateScheduleLog(ctx context.Context, arg CreateScheduleLogParams) (CreateScheduleLogRow, error) {
-	row := q.db.QueryRow(ctx, createScheduleLog, arg.ScheduleID, arg.BotID, arg.SessionID)
+	row := q.db.QueryRow(ctx, createScheduleLog,
+		arg.ScheduleID,
+		arg.BotID,
+		arg.SessionID,
+		arg.Attempt,
+		arg.TriggerEvent,
+		arg.ReplayOf,
+	)
 	var i CreateScheduleLogRow
 	err := row.Scan(
 		&i.ID,
@@ -119,10 +165,23 @@ func (q *Queries) CreateScheduleLog(ctx context.Context, arg CreateScheduleLogPa
 		&i.Usage,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.Attempt,
+		&i.TriggerEvent,
+		&i.ReplayOf,
+		&i.ResultJson,
 	)
 	return i, err
 }
 
+const deadLetterPendingScheduleRetries = `-- name: DeadLetterPendingScheduleRetries :exec
+UPDATE schedule_logs SET status = 'dead_letter' WHERE status = 'retrying'
+`
+
+func (q *Queries) DeadLetterPendingScheduleRetries(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deadLetterPendingScheduleRetries)
+	return err
+}
+
 const deleteScheduleLogsByBot = `-- name: DeleteScheduleLogsByBot :exec
 DELETE FROM schedule_logs WHERE bot_id = $1
 `
@@ -141,8 +200,134 @@ func (q *Queries) DeleteScheduleLogsBySchedule(ctx context.Context, scheduleID p
 	return err
 }
 
+const failInterruptedScheduleLogs = `-- name: FailInterruptedScheduleLogs :exec
+UPDATE schedule_logs
+SET status = 'error',
+    error_message = 'interrupted by a server restart',
+    completed_at = now()
+WHERE completed_at IS NULL
+`
+
+// Runs still in flight when the server stopped will never complete.
+func (q *Queries) FailInterruptedScheduleLogs(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, failInterruptedScheduleLogs)
+	return err
+}
+
+const getScheduleLogByID = `-- name: GetScheduleLogByID :one
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
+FROM schedule_logs
+WHERE id = $1
+`
+
+type GetScheduleLogByIDRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	ScheduleID   pgtype.UUID        `json:"schedule_id"`
+	BotID        pgtype.UUID        `json:"bot_id"`
+	SessionID    pgtype.UUID        `json:"session_id"`
+	Status       string             `json:"status"`
+	ResultText   string             `json:"result_text"`
+	ErrorMessage string             `json:"error_message"`
+	Usage        []byte             `json:"usage"`
+	StartedAt    pgtype.Timestamptz `json:"started_at"`
+	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
+	Attempt      int32              `json:"attempt"`
+	TriggerEvent string             `json:"trigger_event"`
+	ReplayOf     pgtype.UUID        `json:"replay_of"`
+	ResultJson   []byte             `json:"result_json"`
+}
+
+func (q *Queries) GetScheduleLogByID(ctx context.Context, id pgtype.UUID) (GetScheduleLogByIDRow, error) {
+	row := q.db.QueryRow(ctx, getScheduleLogByID, id)
+	var i GetScheduleLogByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduleID,
+		&i.BotID,
+		&i.SessionID,
+		&i.Status,
+		&i.ResultText,
+		&i.ErrorMessage,
+		&i.Usage,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.Attempt,
+		&i.TriggerEvent,
+		&i.ReplayOf,
+		&i.ResultJson,
+	)
+	return i, err
+}
+
+const listFailedScheduleLogsByBot = `-- name: ListFailedScheduleLogsByBot :many
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
+FROM schedule_logs
+WHERE bot_id = $1
+  AND status IN ('error', 'dead_letter')
+ORDER BY started_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListFailedScheduleLogsByBotParams struct {
+	BotID  pgtype.UUID `json:"bot_id"`
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+}
+
+type ListFailedScheduleLogsByBotRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	ScheduleID   pgtype.UUID        `json:"schedule_id"`
+	BotID        pgtype.UUID        `json:"bot_id"`
+	SessionID    pgtype.UUID        `json:"session_id"`
+	Status       string             `json:"status"`
+	ResultText   string             `json:"result_text"`
+	ErrorMessage string             `json:"error_message"`
+	Usage        []byte             `json:"usage"`
+	StartedAt    pgtype.Timestamptz `json:"started_at"`
+	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
+	Attempt      int32              `json:"attempt"`
+	TriggerEvent string             `json:"trigger_event"`
+	ReplayOf     pgtype.UUID        `json:"replay_of"`
+	ResultJson   []byte             `json:"result_json"`
+}
+
+func (q *Queries) ListFailedScheduleLogsByBot(ctx context.Context, arg ListFailedScheduleLogsByBotParams) ([]ListFailedScheduleLogsByBotRow, error) {
+	rows, err := q.db.Query(ctx, listFailedScheduleLogsByBot, arg.BotID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFailedScheduleLogsByBotRow
+	for rows.Next() {
+		var i ListFailedScheduleLogsByBotRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduleID,
+			&i.BotID,
+			&i.SessionID,
+			&i.Status,
+			&i.ResultText,
+			&i.ErrorMessage,
+			&i.Usage,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.Attempt,
+			&i.TriggerEvent,
+			&i.ReplayOf,
+			&i.ResultJson,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listScheduleLogsByBot = `-- name: ListScheduleLogsByBot :many
-SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 FROM schedule_logs
 WHERE bot_id = $1
 ORDER BY started_at DESC
@@ -166,6 +351,10 @@ type ListScheduleLogsByBotRow struct {
 	Usage        []byte             `json:"usage"`
 	StartedAt    pgtype.Timestamptz `json:"started_at"`
 	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
+	Attempt      int32              `json:"attempt"`
+	TriggerEvent string             `json:"trigger_event"`
+	ReplayOf     pgtype.UUID        `json:"replay_of"`
+	ResultJson   []byte             `json:"result_json"`
 }
 
 func (q *Queries) ListScheduleLogsByBot(ctx context.Context, arg ListScheduleLogsByBotParams) ([]ListScheduleLogsByBotRow, error) {
@@ -188,6 +377,10 @@ func (q *Queries) ListScheduleLogsByBot(ctx context.Context, arg ListScheduleLog
 			&i.Usage,
 			&i.StartedAt,
 			&i.CompletedAt,
+			&i.Attempt,
+			&i.TriggerEvent,
+			&i.ReplayOf,
+			&i.ResultJson,
 		); err != nil {
 			return nil, err
 		}
@@ -200,7 +393,7 @@ func (q *Queries) ListScheduleLogsByBot(ctx context.Context, arg ListScheduleLog
 }
 
 const listScheduleLogsBySchedule = `-- name: ListScheduleLogsBySchedule :many
-SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 FROM schedule_logs
 WHERE schedule_id = $1
 ORDER BY started_at DESC
@@ -224,6 +417,10 @@ type ListScheduleLogsByScheduleRow struct {
 	Usage        []byte             `json:"usage"`
 	StartedAt    pgtype.Timestamptz `json:"started_at"`
 	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
+	Attempt      int32              `json:"attempt"`
+	TriggerEvent string             `json:"trigger_event"`
+	ReplayOf     pgtype.UUID        `json:"replay_of"`
+	ResultJson   []byte             `json:"result_json"`
 }
 
 func (q *Queries) ListScheduleLogsBySchedule(ctx context.Context, arg ListScheduleLogsByScheduleParams) ([]ListScheduleLogsByScheduleRow, error) {
@@ -246,6 +443,10 @@ func (q *Queries) ListScheduleLogsBySchedule(ctx context.Context, arg ListSchedu
 			&i.Usage,
 			&i.StartedAt,
 			&i.CompletedAt,
+			&i.Attempt,
+			&i.TriggerEvent,
+			&i.ReplayOf,
+			&i.ResultJson,
 		); err != nil {
 			return nil, err
 		}
@@ -256,3 +457,17 @@ func (q *Queries) ListScheduleLogsBySchedule(ctx context.Context, arg ListSchedu
 	}
 	return items, nil
 }
+
+const resolveScheduleLogRetry = `-- name: ResolveScheduleLogRetry :exec
+UPDATE schedule_logs SET status = $2 WHERE id = $1 AND status = 'retrying'
+`
+
+type ResolveScheduleLogRetryParams struct {
+	ID     pgtype.UUID `json:"id"`
+	Status string      `json:"status"`
+}
+
+func (q *Queries) ResolveScheduleLogRetry(ctx context.Context, arg ResolveScheduleLogRetryParams) error {
+	_, err := q.db.Exec(ctx, resolveScheduleLogRetry, arg.ID, arg.Status)
+	return err
+}
@@ -11,6 +11,17 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deleteUnversionedSnapshot = `-- name: DeleteUnversionedSnapshot :exec
+DELETE FROM snapshots
+WHERE id = $1
+  AND NOT EXISTS (SELECT 1 FROM container_versions WHERE snapshot_id = $1)
+`
+
+func (q *Queries) DeleteUnversionedSnapshot(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUnversionedSnapshot, id)
+	return err
+}
+
 const getSnapshotByContainerAndRuntimeName = `-- name: GetSnapshotByContainerAndRuntimeName :one
 SELECT
   id,
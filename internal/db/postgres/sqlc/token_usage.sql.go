@@ -51,6 +51,127 @@ func (q *Queries) CountTokenUsageRecords(ctx context.Context, arg CountTokenUsag
 	return total, err
 }
 
+const getBotBudgetUsageByModel = `-- name: GetBotBudgetUsageByModel :many
+SELECT
+  m.model_id,
+  COALESCE(SUM((m.usage->>'inputTokens')::bigint), 0)::bigint AS input_tokens,
+  COALESCE(SUM((m.usage->>'outputTokens')::bigint), 0)::bigint AS output_tokens,
+  COALESCE(SUM((m.usage->'inputTokenDetails'->>'cacheReadTokens')::bigint), 0)::bigint AS cache_read_tokens,
+  COALESCE(MAX((mo.config->'pricing'->>'input_per_million')::float8), 0)::float8 AS input_price,
+  COALESCE(MAX((mo.config->'pricing'->>'output_per_million')::float8), 0)::float8 AS output_price,
+  COALESCE(MAX((mo.config->'pricing'->>'cache_read_per_million')::float8), 0)::float8 AS cache_read_price
+FROM bot_history_messages m
+LEFT JOIN models mo ON mo.id = m.model_id
+WHERE m.bot_id = $1
+  AND m.usage IS NOT NULL
+  AND m.created_at >= $2
+GROUP BY m.model_id
+`
+
+type GetBotBudgetUsageByModelParams struct {
+	BotID    pgtype.UUID        `json:"bot_id"`
+	FromTime pgtype.Timestamptz `json:"from_time"`
+}
+
+type GetBotBudgetUsageByModelRow struct {
+	ModelID         pgtype.UUID `json:"model_id"`
+	InputTokens     int64       `json:"input_tokens"`
+	OutputTokens    int64       `json:"output_tokens"`
+	CacheReadTokens int64       `json:"cache_read_tokens"`
+	InputPrice      float64     `json:"input_price"`
+	OutputPrice     float64     `json:"output_price"`
+	CacheReadPrice  float64     `json:"cache_read_price"`
+}
+
+func (q *Queries) GetBotBudgetUsageByModel(ctx context.Context, arg GetBotBudgetUsageByModelParams) ([]GetBotBudgetUsageByModelRow, error) {
+	rows, err := q.db.Query(ctx, getBotBudgetUsageByModel, arg.BotID, arg.FromTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBotBudgetUsageByModelRow
+	for rows.Next() {
+		var i GetBotBudgetUsageByModelRow
+		if err := rows.Scan(
+			&i.ModelID,
+			&i.InputTokens,
+			&i.OutputTokens,
+			&i.CacheReadTokens,
+			&i.InputPrice,
+			&i.OutputPrice,
+			&i.CacheReadPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOwnerBudgetUsageByModel = `-- name: GetOwnerBudgetUsageByModel :many
+SELECT
+  m.model_id,
+  COALESCE(SUM((m.usage->>'inputTokens')::bigint), 0)::bigint AS input_tokens,
+  COALESCE(SUM((m.usage->>'outputTokens')::bigint), 0)::bigint AS output_tokens,
+  COALESCE(SUM((m.usage->'inputTokenDetails'->>'cacheReadTokens')::bigint), 0)::bigint AS cache_read_tokens,
+  COALESCE(MAX((mo.config->'pricing'->>'input_per_million')::float8), 0)::float8 AS input_price,
+  COALESCE(MAX((mo.config->'pricing'->>'output_per_million')::float8), 0)::float8 AS output_price,
+  COALESCE(MAX((mo.config->'pricing'->>'cache_read_per_million')::float8), 0)::float8 AS cache_read_price
+FROM bot_history_messages m
+JOIN bots b ON b.id = m.bot_id
+LEFT JOIN models mo ON mo.id = m.model_id
+WHERE b.owner_user_id = $1
+  AND m.usage IS NOT NULL
+  AND m.created_at >= $2
+GROUP BY m.model_id
+`
+
+type GetOwnerBudgetUsageByModelParams struct {
+	OwnerUserID pgtype.UUID        `json:"owner_user_id"`
+	FromTime    pgtype.Timestamptz `json:"from_time"`
+}
+
+type GetOwnerBudgetUsageByModelRow struct {
+	ModelID         pgtype.UUID `json:"model_id"`
+	InputTokens     int64       `json:"input_tokens"`
+	OutputTokens    int64       `json:"output_tokens"`
+	CacheReadTokens int64       `json:"cache_read_tokens"`
+	InputPrice      float64     `json:"input_price"`
+	OutputPrice     float64     `json:"output_price"`
+	CacheReadPrice  float64     `json:"cache_read_price"`
+}
+
+func (q *Queries) GetOwnerBudgetUsageByModel(ctx context.Context, arg GetOwnerBudgetUsageByModelParams) ([]GetOwnerBudgetUsageByModelRow, error) {
+	rows, err := q.db.Query(ctx, getOwnerBudgetUsageByModel, arg.OwnerUserID, arg.FromTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOwnerBudgetUsageByModelRow
+	for rows.Next() {
+		var i GetOwnerBudgetUsageByModelRow
+		if err := rows.Scan(
+			&i.ModelID,
+			&i.InputTokens,
+			&i.OutputTokens,
+			&i.CacheReadTokens,
+			&i.InputPrice,
+			&i.OutputPrice,
+			&i.CacheReadPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTokenUsageByDayAndType = `-- name: GetTokenUsageByDayAndType :many
 SELECT
   COALESCE(
@@ -11,6 +11,25 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deleteVersion = `-- name: DeleteVersion :one
+DELETE FROM container_versions
+WHERE container_id = $1
+  AND version = $2
+RETURNING snapshot_id
+`
+
+type DeleteVersionParams struct {
+	ContainerID string `json:"container_id"`
+	Version     int32  `json:"version"`
+}
+
+func (q *Queries) DeleteVersion(ctx context.Context, arg DeleteVersionParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteVersion, arg.ContainerID, arg.Version)
+	var snapshot_id pgtype.UUID
+	err := row.Scan(&snapshot_id)
+	return snapshot_id, err
+}
+
 const getVersionSnapshotRuntimeName = `-- name: GetVersionSnapshotRuntimeName :one
 SELECT s.runtime_snapshot_name
 FROM container_versions cv
@@ -68,7 +87,9 @@ SELECT
   cv.version,
   cv.created_at,
   s.runtime_snapshot_name,
-  s.display_name
+  s.display_name,
+  s.snapshotter,
+  s.source
 FROM container_versions cv
 JOIN snapshots s ON s.id = cv.snapshot_id
 WHERE cv.container_id = $1
@@ -83,6 +104,8 @@ type ListVersionsByContainerIDRow struct {
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	RuntimeSnapshotName string             `json:"runtime_snapshot_name"`
 	DisplayName         pgtype.Text        `json:"display_name"`
+	Snapshotter         string             `json:"snapshotter"`
+	Source              string             `json:"source"`
 }
 
 func (q *Queries) ListVersionsByContainerID(ctx context.Context, containerID string) ([]ListVersionsByContainerIDRow, error) {
@@ -102,6 +125,8 @@ func (q *Queries) ListVersionsByContainerID(ctx context.Context, containerID str
 			&i.CreatedAt,
 			&i.RuntimeSnapshotName,
 			&i.DisplayName,
+			&i.Snapshotter,
+			&i.Source,
 		); err != nil {
 			return nil, err
 		}
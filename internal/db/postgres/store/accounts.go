@@ -155,6 +155,45 @@ func (s *Store) RemoveMember(ctx context.Context, userID string) error {
 	return mapQueryErr(err)
 }
 
+func (s *Store) GetExternalIdentity(ctx context.Context, issuer, subject string) (dbstore.ExternalIdentityRecord, error) {
+	row, err := s.queries.GetUserIdentity(ctx, dbsqlc.GetUserIdentityParams{
+		Issuer:  issuer,
+		Subject: subject,
+	})
+	if err != nil {
+		return dbstore.ExternalIdentityRecord{}, mapQueryErr(err)
+	}
+	return externalIdentityRecord(row), nil
+}
+
+func (s *Store) CreateExternalIdentity(ctx context.Context, input dbstore.CreateExternalIdentityInput) (dbstore.ExternalIdentityRecord, error) {
+	userID, err := db.ParseUUID(input.UserID)
+	if err != nil {
+		return dbstore.ExternalIdentityRecord{}, err
+	}
+	row, err := s.queries.CreateUserIdentity(ctx, dbsqlc.CreateUserIdentityParams{
+		UserID:  userID,
+		Issuer:  input.Issuer,
+		Subject: input.Subject,
+		Email:   optionalText(input.Email),
+	})
+	if err != nil {
+		return dbstore.ExternalIdentityRecord{}, err
+	}
+	return externalIdentityRecord(row), nil
+}
+
+func (s *Store) TouchExternalIdentity(ctx context.Context, id, email string) error {
+	identityID, err := db.ParseUUID(id)
+	if err != nil {
+		return err
+	}
+	return s.queries.TouchUserIdentity(ctx, dbsqlc.TouchUserIdentityParams{
+		ID:    identityID,
+		Email: optionalText(email),
+	})
+}
+
 func mapQueryErr(err error) error {
 	if errors.Is(err, pgx.ErrNoRows) {
 		return db.ErrNotFound
@@ -203,3 +242,20 @@ func accountRecord(row dbsqlc.User) dbstore.AccountRecord {
 	}
 	return rec
 }
+
+func externalIdentityRecord(row dbsqlc.UserIdentity) dbstore.ExternalIdentityRecord {
+	rec := dbstore.ExternalIdentityRecord{
+		ID:      row.ID.String(),
+		UserID:  row.UserID.String(),
+		Issuer:  row.Issuer,
+		Subject: row.Subject,
+		Email:   row.Email.String,
+	}
+	if row.CreatedAt.Valid {
+		rec.CreatedAt = row.CreatedAt.Time
+	}
+	if row.LastLoginAt.Valid {
+		rec.LastLoginAt = row.LastLoginAt.Time
+	}
+	return rec
+}
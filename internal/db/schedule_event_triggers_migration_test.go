@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestSQLiteScheduleEventTriggersMigration guards the schedule rebuild in
+// 0018: the baseline already declares trigger_kind/trigger_filter, so a fresh
+// replay must not end up with duplicated columns, and existing rows must keep
+// cron semantics by default.
+func TestSQLiteScheduleEventTriggersMigration(t *testing.T) {
+	migrations := sqliteMigrationsFS(t)
+	dsn := tempSQLiteMigrationDSN(t)
+
+	if err := RunMigrateTarget(nil, MigrationTarget{Driver: DriverSQLite, DSN: dsn}, migrations, "up", nil); err != nil {
+		t.Fatalf("fresh full migrate up failed: %v", err)
+	}
+
+	db := openMigrationSQLite(t, dsn)
+	schema := sqliteTableSQL(t, db, "schedule")
+	for _, column := range []string{"trigger_kind", "trigger_filter"} {
+		if n := strings.Count(schema, column); n != 1 {
+			t.Fatalf("%s appears %d times in fresh schedule schema, want exactly 1:\n%s", column, n, schema)
+		}
+	}
+
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, `INSERT INTO users(id,email,role) VALUES('00000000-0000-0000-0000-0000000000c1','sched@example.com','member')`); err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES('00000000-0000-0000-0000-0000000000c2','00000000-0000-0000-0000-0000000000c1','personal','schedbot','Sched Bot')`); err != nil {
+		t.Fatalf("insert bot: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO schedule(id,name,description,pattern,command,bot_id) VALUES('00000000-0000-0000-0000-0000000000c3','daily','daily report','0 9 * * *','report','00000000-0000-0000-0000-0000000000c2')`); err != nil {
+		t.Fatalf("insert schedule: %v", err)
+	}
+	var kind, filter string
+	if err := db.QueryRowContext(ctx, `SELECT trigger_kind, trigger_filter FROM schedule WHERE id='00000000-0000-0000-0000-0000000000c3'`).Scan(&kind, &filter); err != nil {
+		t.Fatalf("select trigger columns: %v", err)
+	}
+	if kind != "cron" || filter != "{}" {
+		t.Fatalf("schedule trigger defaults = (%q, %q), want (\"cron\", \"{}\")", kind, filter)
+	}
+	closeMigrationSQLite(t, db)
+
+	if err := RunMigrateTarget(nil, MigrationTarget{Driver: DriverSQLite, DSN: dsn}, migrations, "down", nil); err != nil {
+		t.Fatalf("migrate down: %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestSQLiteScheduleRetriesMigration guards the schedule/schedule_logs
+// rebuilds in 0019: a fresh replay must not duplicate the baseline columns,
+// the widened status check must accept dead-letter runs, and rolling back
+// must fold the new statuses into 'error'.
+func TestSQLiteScheduleRetriesMigration(t *testing.T) {
+	migrations := sqliteMigrationsFS(t)
+	dsn := tempSQLiteMigrationDSN(t)
+
+	if err := RunMigrateTarget(nil, MigrationTarget{Driver: DriverSQLite, DSN: dsn}, migrations, "up", nil); err != nil {
+		t.Fatalf("fresh full migrate up failed: %v", err)
+	}
+
+	db := openMigrationSQLite(t, dsn)
+	if n := strings.Count(sqliteTableSQL(t, db, "schedule"), "retry_policy"); n != 1 {
+		t.Fatalf("retry_policy appears %d times in fresh schedule schema, want exactly 1", n)
+	}
+	logsSchema := sqliteTableSQL(t, db, "schedule_logs")
+	for _, column := range []string{"attempt", "trigger_event", "replay_of"} {
+		if n := strings.Count(logsSchema, column+" "); n != 1 {
+			t.Fatalf("%s appears %d times in fresh schedule_logs schema, want exactly 1:\n%s", column, n, logsSchema)
+		}
+	}
+
+	ctx := context.Background()
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('00000000-0000-0000-0000-0000000000d1','retry@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES('00000000-0000-0000-0000-0000000000d2','00000000-0000-0000-0000-0000000000d1','personal','retrybot','Retry Bot')`,
+		`INSERT INTO schedule(id,name,description,pattern,command,bot_id) VALUES('00000000-0000-0000-0000-0000000000d3','daily','daily report','0 9 * * *','report','00000000-0000-0000-0000-0000000000d2')`,
+		`INSERT INTO schedule_logs(id,schedule_id,bot_id,status,attempt) VALUES('00000000-0000-0000-0000-0000000000d4','00000000-0000-0000-0000-0000000000d3','00000000-0000-0000-0000-0000000000d2','dead_letter',3)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+	var policy string
+	if err := db.QueryRowContext(ctx, `SELECT retry_policy FROM schedule WHERE id='00000000-0000-0000-0000-0000000000d3'`).Scan(&policy); err != nil {
+		t.Fatalf("select retry_policy: %v", err)
+	}
+	if policy != "{}" {
+		t.Fatalf("retry_policy default = %q, want {}", policy)
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE schedule_logs SET status='bogus' WHERE id='00000000-0000-0000-0000-0000000000d4'`); err == nil {
+		t.Fatal("expected status check to reject unknown status")
+	}
+	closeMigrationSQLite(t, db)
+
+	if err := RunMigrateTarget(nil, MigrationTarget{Driver: DriverSQLite, DSN: dsn}, migrations, "down", nil); err != nil {
+		t.Fatalf("migrate down: %v", err)
+	}
+}
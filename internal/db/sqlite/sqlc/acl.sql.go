@@ -158,6 +158,37 @@ func (q *Queries) GetBotACLDefaultEffect(ctx context.Context, id string) (string
 	return acl_default_effect, err
 }
 
+const getBotACLRuleByID = `-- name: GetBotACLRuleByID :one
+SELECT id, bot_id, enabled, description, action, effect, channel_identity_id,
+  subject_channel_type, source_channel, source_conversation_type, source_conversation_id, source_thread_id,
+  created_by_user_id, created_at, updated_at
+FROM bot_acl_rules
+WHERE id = ?1
+`
+
+func (q *Queries) GetBotACLRuleByID(ctx context.Context, id string) (BotAclRule, error) {
+	row := q.db.QueryRowContext(ctx, getBotACLRuleByID, id)
+	var i BotAclRule
+	err := row.Scan(
+		&i.ID,
+		&i.BotID,
+		&i.Enabled,
+		&i.Description,
+		&i.Action,
+		&i.Effect,
+		&i.ChannelIdentityID,
+		&i.SubjectChannelType,
+		&i.SourceChannel,
+		&i.SourceConversationType,
+		&i.SourceConversationID,
+		&i.SourceThreadID,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const listBotACLRules = `-- name: ListBotACLRules :many
 SELECT
   r.id,
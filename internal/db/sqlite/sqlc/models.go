@@ -8,6 +8,20 @@ import (
 	"database/sql"
 )
 
+type AuditLog struct {
+	ID         int64          `json:"id"`
+	CreatedAt  string         `json:"created_at"`
+	ActorType  string         `json:"actor_type"`
+	ActorID    string         `json:"actor_id"`
+	BotID      sql.NullString `json:"bot_id"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"target_type"`
+	TargetID   string         `json:"target_id"`
+	Before     string         `json:"before"`
+	After      string         `json:"after"`
+	Metadata   string         `json:"metadata"`
+}
+
 type Bot struct {
 	ID                     string         `json:"id"`
 	OwnerUserID            string         `json:"owner_user_id"`
@@ -99,6 +113,32 @@ type BotChannelRoute struct {
 	UpdatedAt              string         `json:"updated_at"`
 }
 
+type BotDelegation struct {
+	ID            string         `json:"id"`
+	ParentID      sql.NullString `json:"parent_id"`
+	FromBotID     string         `json:"from_bot_id"`
+	FromSessionID sql.NullString `json:"from_session_id"`
+	ToBotID       string         `json:"to_bot_id"`
+	ToSessionID   sql.NullString `json:"to_session_id"`
+	Task          string         `json:"task"`
+	Hop           int64          `json:"hop"`
+	Chain         string         `json:"chain"`
+	Status        string         `json:"status"`
+	Result        string         `json:"result"`
+	Error         string         `json:"error"`
+	ModelID       sql.NullString `json:"model_id"`
+	Usage         string         `json:"usage"`
+	CreatedAt     string         `json:"created_at"`
+	CompletedAt   sql.NullString `json:"completed_at"`
+}
+
+type BotEgressPolicy struct {
+	BotID     string `json:"bot_id"`
+	Policy    string `json:"policy"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
 type BotEmailBinding struct {
 	ID              string `json:"id"`
 	BotID           string `json:"bot_id"`
@@ -169,6 +209,13 @@ type BotHistoryMessageCompact struct {
 	CompletedAt  sql.NullString `json:"completed_at"`
 }
 
+type BotModelFallback struct {
+	BotID     string `json:"bot_id"`
+	ModelID   string `json:"model_id"`
+	Position  int64  `json:"position"`
+	CreatedAt string `json:"created_at"`
+}
+
 type BotPluginInstallation struct {
 	ID          string `json:"id"`
 	BotID       string `json:"bot_id"`
@@ -223,6 +270,20 @@ type BotSessionEvent struct {
 	CreatedAt               string         `json:"created_at"`
 }
 
+type BotSnapshotPolicy struct {
+	BotID              string         `json:"bot_id"`
+	Enabled            int64          `json:"enabled"`
+	KeepHourly         int64          `json:"keep_hourly"`
+	KeepDaily          int64          `json:"keep_daily"`
+	KeepWeekly         int64          `json:"keep_weekly"`
+	KeepMonthly        int64          `json:"keep_monthly"`
+	ExecPatterns       string         `json:"exec_patterns"`
+	BeforeSkillInstall int64          `json:"before_skill_install"`
+	LastRunAt          sql.NullString `json:"last_run_at"`
+	CreatedAt          string         `json:"created_at"`
+	UpdatedAt          string         `json:"updated_at"`
+}
+
 type BotStorageBinding struct {
 	ID                string `json:"id"`
 	BotID             string `json:"bot_id"`
@@ -232,6 +293,20 @@ type BotStorageBinding struct {
 	UpdatedAt         string `json:"updated_at"`
 }
 
+type BotTemplate struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Description     string         `json:"description"`
+	Image           string         `json:"image"`
+	Contents        string         `json:"contents"`
+	SizeBytes       int64          `json:"size_bytes"`
+	SourceBotID     sql.NullString `json:"source_bot_id"`
+	CreatedByUserID sql.NullString `json:"created_by_user_id"`
+	CreatedAt       string         `json:"created_at"`
+	UpdatedAt       string         `json:"updated_at"`
+	Shared          int64          `json:"shared"`
+}
+
 type BotUserGrant struct {
 	ID              string         `json:"id"`
 	BotID           string         `json:"bot_id"`
@@ -252,6 +327,21 @@ type BotWorkspaceResourceLimit struct {
 	UpdatedAt     string `json:"updated_at"`
 }
 
+type Budget struct {
+	ID             string         `json:"id"`
+	BotID          sql.NullString `json:"bot_id"`
+	UserID         sql.NullString `json:"user_id"`
+	Period         string         `json:"period"`
+	Unit           string         `json:"unit"`
+	LimitAmount    float64        `json:"limit_amount"`
+	WarnPercent    int64          `json:"warn_percent"`
+	Enabled        int64          `json:"enabled"`
+	WarnedPeriod   string         `json:"warned_period"`
+	ExceededPeriod string         `json:"exceeded_period"`
+	CreatedAt      string         `json:"created_at"`
+	UpdatedAt      string         `json:"updated_at"`
+}
+
 type ChannelIdentity struct {
 	ID               string         `json:"id"`
 	ChannelType      string         `json:"channel_type"`
@@ -410,6 +500,20 @@ type ModelVariant struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+type PersonalAccessToken struct {
+	ID          string         `json:"id"`
+	UserID      string         `json:"user_id"`
+	BotID       sql.NullString `json:"bot_id"`
+	Name        string         `json:"name"`
+	TokenPrefix string         `json:"token_prefix"`
+	TokenHash   string         `json:"token_hash"`
+	Scopes      string         `json:"scopes"`
+	ExpiresAt   sql.NullString `json:"expires_at"`
+	LastUsedAt  sql.NullString `json:"last_used_at"`
+	RevokedAt   sql.NullString `json:"revoked_at"`
+	CreatedAt   string         `json:"created_at"`
+}
+
 type Provider struct {
 	ID         string         `json:"id"`
 	Name       string         `json:"name"`
@@ -437,17 +541,22 @@ type ProviderOauthToken struct {
 }
 
 type Schedule struct {
-	ID           string        `json:"id"`
-	Name         string        `json:"name"`
-	Description  string        `json:"description"`
-	Pattern      string        `json:"pattern"`
-	MaxCalls     sql.NullInt64 `json:"max_calls"`
-	CurrentCalls int64         `json:"current_calls"`
-	CreatedAt    string        `json:"created_at"`
-	UpdatedAt    string        `json:"updated_at"`
-	Enabled      int64         `json:"enabled"`
-	Command      string        `json:"command"`
-	BotID        string        `json:"bot_id"`
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Pattern       string         `json:"pattern"`
+	MaxCalls      sql.NullInt64  `json:"max_calls"`
+	CurrentCalls  int64          `json:"current_calls"`
+	CreatedAt     string         `json:"created_at"`
+	UpdatedAt     string         `json:"updated_at"`
+	Enabled       int64          `json:"enabled"`
+	Command       string         `json:"command"`
+	BotID         string         `json:"bot_id"`
+	TriggerKind   string         `json:"trigger_kind"`
+	TriggerFilter string         `json:"trigger_filter"`
+	RetryPolicy   string         `json:"retry_policy"`
+	OutputSchema  sql.NullString `json:"output_schema"`
+	WorkflowID    sql.NullString `json:"workflow_id"`
 }
 
 type ScheduleLog struct {
@@ -462,6 +571,10 @@ type ScheduleLog struct {
 	ModelID      sql.NullString `json:"model_id"`
 	StartedAt    string         `json:"started_at"`
 	CompletedAt  sql.NullString `json:"completed_at"`
+	Attempt      int64          `json:"attempt"`
+	TriggerEvent string         `json:"trigger_event"`
+	ReplayOf     sql.NullString `json:"replay_of"`
+	ResultJson   sql.NullString `json:"result_json"`
 }
 
 type SearchProvider struct {
@@ -518,6 +631,25 @@ type ToolApprovalRequest struct {
 	DecidedAt                    sql.NullString `json:"decided_at"`
 }
 
+type ToolApprovalVote struct {
+	RequestID         string `json:"request_id"`
+	ChannelIdentityID string `json:"channel_identity_id"`
+	Decision          string `json:"decision"`
+	Reason            string `json:"reason"`
+	CreatedAt         string `json:"created_at"`
+}
+
+type ToolApprovalWorkflow struct {
+	RequestID           string         `json:"request_id"`
+	Approvers           string         `json:"approvers"`
+	Quorum              int64          `json:"quorum"`
+	EscalationApprovers string         `json:"escalation_approvers"`
+	EscalateAt          sql.NullString `json:"escalate_at"`
+	EscalatedAt         sql.NullString `json:"escalated_at"`
+	ExpiresAt           sql.NullString `json:"expires_at"`
+	CreatedAt           string         `json:"created_at"`
+}
+
 type User struct {
 	ID           string         `json:"id"`
 	Username     sql.NullString `json:"username"`
@@ -544,6 +676,16 @@ type UserChannelBinding struct {
 	UpdatedAt   string `json:"updated_at"`
 }
 
+type UserIdentity struct {
+	ID          string         `json:"id"`
+	UserID      string         `json:"user_id"`
+	Issuer      string         `json:"issuer"`
+	Subject     string         `json:"subject"`
+	Email       sql.NullString `json:"email"`
+	CreatedAt   string         `json:"created_at"`
+	LastLoginAt sql.NullString `json:"last_login_at"`
+}
+
 type UserInputRequest struct {
 	ID                           string         `json:"id"`
 	BotID                        string         `json:"bot_id"`
@@ -589,3 +731,46 @@ type UserProviderOauthToken struct {
 	CreatedAt        string         `json:"created_at"`
 	UpdatedAt        string         `json:"updated_at"`
 }
+
+type Workflow struct {
+	ID          string `json:"id"`
+	BotID       string `json:"bot_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Source      string `json:"source"`
+	Definition  string `json:"definition"`
+	Pattern     string `json:"pattern"`
+	Enabled     int64  `json:"enabled"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type WorkflowRun struct {
+	ID                string         `json:"id"`
+	WorkflowID        string         `json:"workflow_id"`
+	BotID             string         `json:"bot_id"`
+	SessionID         sql.NullString `json:"session_id"`
+	Trigger           string         `json:"trigger"`
+	TriggeredByUserID sql.NullString `json:"triggered_by_user_id"`
+	Definition        string         `json:"definition"`
+	Inputs            string         `json:"inputs"`
+	Status            string         `json:"status"`
+	Error             string         `json:"error"`
+	CreatedAt         string         `json:"created_at"`
+	CompletedAt       sql.NullString `json:"completed_at"`
+}
+
+type WorkflowRunStep struct {
+	ID          string         `json:"id"`
+	RunID       string         `json:"run_id"`
+	StepID      string         `json:"step_id"`
+	Type        string         `json:"type"`
+	Status      string         `json:"status"`
+	Input       string         `json:"input"`
+	Output      string         `json:"output"`
+	Error       string         `json:"error"`
+	Ref         string         `json:"ref"`
+	SessionID   sql.NullString `json:"session_id"`
+	StartedAt   string         `json:"started_at"`
+	CompletedAt sql.NullString `json:"completed_at"`
+}
@@ -11,7 +11,7 @@ import (
 )
 
 const createSchedule = `-- name: CreateSchedule :one
-INSERT INTO schedule (id, name, description, pattern, max_calls, enabled, command, bot_id)
+INSERT INTO schedule (id, name, description, pattern, max_calls, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id)
 VALUES (
   lower(hex(randomblob(4))) || '-' ||
   lower(hex(randomblob(2))) || '-' ||
@@ -24,19 +24,29 @@ VALUES (
   ?4,
   ?5,
   ?6,
-  ?7
+  ?7,
+  ?8,
+  ?9,
+  ?10,
+  ?11,
+  ?12
 )
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 `
 
 type CreateScheduleParams struct {
-	Name        string        `json:"name"`
-	Description string        `json:"description"`
-	Pattern     string        `json:"pattern"`
-	MaxCalls    sql.NullInt64 `json:"max_calls"`
-	Enabled     int64         `json:"enabled"`
-	Command     string        `json:"command"`
-	BotID       string        `json:"bot_id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Pattern       string         `json:"pattern"`
+	MaxCalls      sql.NullInt64  `json:"max_calls"`
+	Enabled       int64          `json:"enabled"`
+	Command       string         `json:"command"`
+	BotID         string         `json:"bot_id"`
+	TriggerKind   string         `json:"trigger_kind"`
+	TriggerFilter string         `json:"trigger_filter"`
+	RetryPolicy   string         `json:"retry_policy"`
+	OutputSchema  sql.NullString `json:"output_schema"`
+	WorkflowID    sql.NullString `json:"workflow_id"`
 }
 
 func (q *Queries) CreateSchedule(ctx context.Context, arg CreateScheduleParams) (Schedule, error) {
@@ -48,6 +58,11 @@ func (q *Queries) CreateSchedule(ctx context.Context, arg CreateScheduleParams)
 		arg.Enabled,
 		arg.Command,
 		arg.BotID,
+		arg.TriggerKind,
+		arg.TriggerFilter,
+		arg.RetryPolicy,
+		arg.OutputSchema,
+		arg.WorkflowID,
 	)
 	var i Schedule
 	err := row.Scan(
@@ -62,6 +77,11 @@ func (q *Queries) CreateSchedule(ctx context.Context, arg CreateScheduleParams)
 		&i.Enabled,
 		&i.Command,
 		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
 	)
 	return i, err
 }
@@ -76,7 +96,7 @@ func (q *Queries) DeleteSchedule(ctx context.Context, id string) error {
 }
 
 const getScheduleByID = `-- name: GetScheduleByID :one
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE id = ?1
 `
@@ -96,6 +116,41 @@ func (q *Queries) GetScheduleByID(ctx context.Context, id string) (Schedule, err
 		&i.Enabled,
 		&i.Command,
 		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
+	)
+	return i, err
+}
+
+const getScheduleByWorkflowID = `-- name: GetScheduleByWorkflowID :one
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
+FROM schedule
+WHERE workflow_id = ?1
+`
+
+func (q *Queries) GetScheduleByWorkflowID(ctx context.Context, workflowID sql.NullString) (Schedule, error) {
+	row := q.db.QueryRowContext(ctx, getScheduleByWorkflowID, workflowID)
+	var i Schedule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Pattern,
+		&i.MaxCalls,
+		&i.CurrentCalls,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Enabled,
+		&i.Command,
+		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
 	)
 	return i, err
 }
@@ -109,7 +164,7 @@ SET current_calls = current_calls + 1,
     END,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?1
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 `
 
 func (q *Queries) IncrementScheduleCalls(ctx context.Context, id string) (Schedule, error) {
@@ -127,12 +182,17 @@ func (q *Queries) IncrementScheduleCalls(ctx context.Context, id string) (Schedu
 		&i.Enabled,
 		&i.Command,
 		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
 	)
 	return i, err
 }
 
 const listEnabledSchedules = `-- name: ListEnabledSchedules :many
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE enabled = true
 ORDER BY created_at DESC
@@ -159,6 +219,11 @@ func (q *Queries) ListEnabledSchedules(ctx context.Context) ([]Schedule, error)
 			&i.Enabled,
 			&i.Command,
 			&i.BotID,
+			&i.TriggerKind,
+			&i.TriggerFilter,
+			&i.RetryPolicy,
+			&i.OutputSchema,
+			&i.WorkflowID,
 		); err != nil {
 			return nil, err
 		}
@@ -174,7 +239,7 @@ func (q *Queries) ListEnabledSchedules(ctx context.Context) ([]Schedule, error)
 }
 
 const listSchedulesByBot = `-- name: ListSchedulesByBot :many
-SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+SELECT id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 FROM schedule
 WHERE bot_id = ?1
 ORDER BY created_at DESC
@@ -201,6 +266,11 @@ func (q *Queries) ListSchedulesByBot(ctx context.Context, botID string) ([]Sched
 			&i.Enabled,
 			&i.Command,
 			&i.BotID,
+			&i.TriggerKind,
+			&i.TriggerFilter,
+			&i.RetryPolicy,
+			&i.OutputSchema,
+			&i.WorkflowID,
 		); err != nil {
 			return nil, err
 		}
@@ -223,19 +293,27 @@ SET name = ?1,
     max_calls = ?4,
     enabled = ?5,
     command = ?6,
+    trigger_kind = ?7,
+    trigger_filter = ?8,
+    retry_policy = ?9,
+    output_schema = ?10,
     updated_at = CURRENT_TIMESTAMP
-WHERE id = ?7
-RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id
+WHERE id = ?11
+RETURNING id, name, description, pattern, max_calls, current_calls, created_at, updated_at, enabled, command, bot_id, trigger_kind, trigger_filter, retry_policy, output_schema, workflow_id
 `
 
 type UpdateScheduleParams struct {
-	Name        string        `json:"name"`
-	Description string        `json:"description"`
-	Pattern     string        `json:"pattern"`
-	MaxCalls    sql.NullInt64 `json:"max_calls"`
-	Enabled     int64         `json:"enabled"`
-	Command     string        `json:"command"`
-	ID          string        `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Pattern       string         `json:"pattern"`
+	MaxCalls      sql.NullInt64  `json:"max_calls"`
+	Enabled       int64          `json:"enabled"`
+	Command       string         `json:"command"`
+	TriggerKind   string         `json:"trigger_kind"`
+	TriggerFilter string         `json:"trigger_filter"`
+	RetryPolicy   string         `json:"retry_policy"`
+	OutputSchema  sql.NullString `json:"output_schema"`
+	ID            string         `json:"id"`
 }
 
 func (q *Queries) UpdateSchedule(ctx context.Context, arg UpdateScheduleParams) (Schedule, error) {
@@ -246,6 +324,10 @@ func (q *Queries) UpdateSchedule(ctx context.Context, arg UpdateScheduleParams)
 		arg.MaxCalls,
 		arg.Enabled,
 		arg.Command,
+		arg.TriggerKind,
+		arg.TriggerFilter,
+		arg.RetryPolicy,
+		arg.OutputSchema,
 		arg.ID,
 	)
 	var i Schedule
@@ -261,6 +343,11 @@ func (q *Queries) UpdateSchedule(ctx context.Context, arg UpdateScheduleParams)
 		&i.Enabled,
 		&i.Command,
 		&i.BotID,
+		&i.TriggerKind,
+		&i.TriggerFilter,
+		&i.RetryPolicy,
+		&i.OutputSchema,
+		&i.WorkflowID,
 	)
 	return i, err
 }
@@ -17,9 +17,10 @@ SET status = ?1,
     error_message = ?3,
     usage = ?4,
     model_id = ?5,
+    result_json = ?6,
     completed_at = CURRENT_TIMESTAMP
-WHERE id = ?6
-RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, model_id, started_at, completed_at
+WHERE id = ?7
+RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, model_id, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 `
 
 type CompleteScheduleLogParams struct {
@@ -28,6 +29,7 @@ type CompleteScheduleLogParams struct {
 	ErrorMessage string         `json:"error_message"`
 	Usage        sql.NullString `json:"usage"`
 	ModelID      sql.NullString `json:"model_id"`
+	ResultJson   sql.NullString `json:"result_json"`
 	ID           string         `json:"id"`
 }
 
@@ -38,6 +40,7 @@ func (q *Queries) CompleteScheduleLog(ctx context.Context, arg CompleteScheduleL
 		arg.ErrorMessage,
 		arg.Usage,
 		arg.ModelID,
+		arg.ResultJson,
 		arg.ID,
 	)
 	var i ScheduleLog
@@ -53,10 +56,39 @@ func (q *Queries) CompleteScheduleLog(ctx context.Context, arg CompleteScheduleL
 		&i.ModelID,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.Attempt,
+		&i.TriggerEvent,
+		&i.ReplayOf,
+		&i.ResultJson,
 	)
 	return i, err
 }
 
+const countActiveScheduleReplays = `-- name: CountActiveScheduleReplays :one
+SELECT count(*) FROM schedule_logs
+WHERE replay_of = ?1
+  AND (completed_at IS NULL OR status IN ('ok', 'retrying', 'retried'))
+`
+
+// Replays of a log that are still running, waiting on a retry or succeeded.
+func (q *Queries) CountActiveScheduleReplays(ctx context.Context, replayOf sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveScheduleReplays, replayOf)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countFailedScheduleLogsByBot = `-- name: CountFailedScheduleLogsByBot :one
+SELECT count(*) FROM schedule_logs WHERE bot_id = ?1 AND status IN ('error', 'dead_letter')
+`
+
+func (q *Queries) CountFailedScheduleLogsByBot(ctx context.Context, botID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countFailedScheduleLogsByBot, botID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countScheduleLogsByBot = `-- name: CountScheduleLogsByBot :one
 SELECT count(*) FROM schedule_logs WHERE bot_id = ?1
 `
@@ -80,7 +112,7 @@ func (q *Queries) CountScheduleLogsBySchedule(ctx context.Context, scheduleID st
 }
 
 const createScheduleLog = `-- name: CreateScheduleLog :one
-INSERT INTO schedule_logs (id, schedule_id, bot_id, session_id, started_at)
+INSERT INTO schedule_logs (id, schedule_id, bot_id, session_id, attempt, trigger_event, replay_of, started_at)
 VALUES (
   lower(hex(randomblob(4))) || '-' ||
   lower(hex(randomblob(2))) || '-' ||
@@ -90,15 +122,21 @@ VALUES (
   ?1,
   ?2,
   ?3,
+  ?4,
+  ?5,
+  ?6,
   CURRENT_TIMESTAMP
 )
-RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+RETURNING id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 `
 
 type CreateScheduleLogParams struct {
-	ScheduleID string         `json:"schedule_id"`
-	BotID      string         `json:"bot_id"`
-	SessionID  sql.NullString `json:"session_id"`
+	ScheduleID   string         `json:"schedule_id"`
+	BotID        string         `json:"bot_id"`
+	SessionID    sql.NullString `json:"session_id"`
+	Attempt      int64          `json:"attempt"`
+	TriggerEvent string         `json:"trigger_event"`
+	ReplayOf     sql.NullString `json:"replay_of"`
 }
 
 type CreateScheduleLogRow struct {
@@ -112,10 +150,21 @@ type CreateScheduleLogRow struct {
 	Usage        sql.NullString `json:"usage"`
 	StartedAt    string         `json:"started_at"`
 	CompletedAt  sql.NullString `json:"completed_at"`
+	Attempt      int64          `json:"attempt"`
+	TriggerEvent string         `json:"trigger_event"`
+	ReplayOf     sql.NullString `json:"replay_of"`
+	ResultJson   sql.NullString `json:"result_json"`
 }
 
 func (q *Queries) CreateScheduleLog(ctx context.Context, arg CreateScheduleLogParams) (CreateScheduleLogRow, error) {
-	row := q.db.QueryRowContext(ctx, createScheduleLog, arg.ScheduleID, arg.BotID, arg.SessionID)
+	row := q.db.QueryRowContext(ctx, createScheduleLog,
+		arg.ScheduleID,
+		arg.BotID,
+		arg.SessionID,
+		arg.Attempt,
+		arg.TriggerEvent,
+		arg.ReplayOf,
+	)
 	var i CreateScheduleLogRow
 	err := row.Scan(
 		&i.ID,
@@ -128,10 +177,23 @@ func (q *Queries) CreateScheduleLog(ctx context.Context, arg CreateScheduleLogPa
 		&i.Usage,
 		&i.StartedAt,
 		&i.CompletedAt,
+		&i.Attempt,
+		&i.TriggerEvent,
+		&i.ReplayOf,
+		&i.ResultJson,
 	)
 	return i, err
 }
 
+const deadLetterPendingScheduleRetries = `-- name: DeadLetterPendingScheduleRetries :exec
+UPDATE schedule_logs SET status = 'dead_letter' WHERE status = 'retrying'
+`
+
+func (q *Queries) DeadLetterPendingScheduleRetries(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deadLetterPendingScheduleRetries)
+	return err
+}
+
 const deleteScheduleLogsByBot = `-- name: DeleteScheduleLogsByBot :exec
 DELETE FROM schedule_logs WHERE bot_id = ?1
 `
@@ -150,8 +212,137 @@ func (q *Queries) DeleteScheduleLogsBySchedule(ctx context.Context, scheduleID s
 	return err
 }
 
+const failInterruptedScheduleLogs = `-- name: FailInterruptedScheduleLogs :exec
+UPDATE schedule_logs
+SET status = 'error',
+    error_message = 'interrupted by a server restart',
+    completed_at = CURRENT_TIMESTAMP
+WHERE completed_at IS NULL
+`
+
+// Runs still in flight when the server stopped will never complete.
+func (q *Queries) FailInterruptedScheduleLogs(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, failInterruptedScheduleLogs)
+	return err
+}
+
+const getScheduleLogByID = `-- name: GetScheduleLogByID :one
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
+FROM schedule_logs
+WHERE id = ?1
+`
+
+type GetScheduleLogByIDRow struct {
+	ID           string         `json:"id"`
+	ScheduleID   string         `json:"schedule_id"`
+	BotID        string         `json:"bot_id"`
+	SessionID    sql.NullString `json:"session_id"`
+	Status       string         `json:"status"`
+	ResultText   string         `json:"result_text"`
+	ErrorMessage string         `json:"error_message"`
+	Usage        sql.NullString `json:"usage"`
+	StartedAt    string         `json:"started_at"`
+	CompletedAt  sql.NullString `json:"completed_at"`
+	Attempt      int64          `json:"attempt"`
+	TriggerEvent string         `json:"trigger_event"`
+	ReplayOf     sql.NullString `json:"replay_of"`
+	ResultJson   sql.NullString `json:"result_json"`
+}
+
+func (q *Queries) GetScheduleLogByID(ctx context.Context, id string) (GetScheduleLogByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getScheduleLogByID, id)
+	var i GetScheduleLogByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduleID,
+		&i.BotID,
+		&i.SessionID,
+		&i.Status,
+		&i.ResultText,
+		&i.ErrorMessage,
+		&i.Usage,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.Attempt,
+		&i.TriggerEvent,
+		&i.ReplayOf,
+		&i.ResultJson,
+	)
+	return i, err
+}
+
+const listFailedScheduleLogsByBot = `-- name: ListFailedScheduleLogsByBot :many
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
+FROM schedule_logs
+WHERE bot_id = ?1
+  AND status IN ('error', 'dead_letter')
+ORDER BY started_at DESC
+LIMIT ?3 OFFSET ?2
+`
+
+type ListFailedScheduleLogsByBotParams struct {
+	BotID string `json:"bot_id"`
+	Off   int64  `json:"off"`
+	Lim   int64  `json:"lim"`
+}
+
+type ListFailedScheduleLogsByBotRow struct {
+	ID           string         `json:"id"`
+	ScheduleID   string         `json:"schedule_id"`
+	BotID        string         `json:"bot_id"`
+	SessionID    sql.NullString `json:"session_id"`
+	Status       string         `json:"status"`
+	ResultText   string         `json:"result_text"`
+	ErrorMessage string         `json:"error_message"`
+	Usage        sql.NullString `json:"usage"`
+	StartedAt    string         `json:"started_at"`
+	CompletedAt  sql.NullString `json:"completed_at"`
+	Attempt      int64          `json:"attempt"`
+	TriggerEvent string         `json:"trigger_event"`
+	ReplayOf     sql.NullString `json:"replay_of"`
+	ResultJson   sql.NullString `json:"result_json"`
+}
+
+func (q *Queries) ListFailedScheduleLogsByBot(ctx context.Context, arg ListFailedScheduleLogsByBotParams) ([]ListFailedScheduleLogsByBotRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFailedScheduleLogsByBot, arg.BotID, arg.Off, arg.Lim)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFailedScheduleLogsByBotRow
+	for rows.Next() {
+		var i ListFailedScheduleLogsByBotRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduleID,
+			&i.BotID,
+			&i.SessionID,
+			&i.Status,
+			&i.ResultText,
+			&i.ErrorMessage,
+			&i.Usage,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.Attempt,
+			&i.TriggerEvent,
+			&i.ReplayOf,
+			&i.ResultJson,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listScheduleLogsByBot = `-- name: ListScheduleLogsByBot :many
-SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 FROM schedule_logs
 WHERE bot_id = ?1
 ORDER BY started_at DESC
@@ -175,6 +366,10 @@ type ListScheduleLogsByBotRow struct {
 	Usage        sql.NullString `json:"usage"`
 	StartedAt    string         `json:"started_at"`
 	CompletedAt  sql.NullString `json:"completed_at"`
+	Attempt      int64          `json:"attempt"`
+	TriggerEvent string         `json:"trigger_event"`
+	ReplayOf     sql.NullString `json:"replay_of"`
+	ResultJson   sql.NullString `json:"result_json"`
 }
 
 func (q *Queries) ListScheduleLogsByBot(ctx context.Context, arg ListScheduleLogsByBotParams) ([]ListScheduleLogsByBotRow, error) {
@@ -197,6 +392,10 @@ func (q *Queries) ListScheduleLogsByBot(ctx context.Context, arg ListScheduleLog
 			&i.Usage,
 			&i.StartedAt,
 			&i.CompletedAt,
+			&i.Attempt,
+			&i.TriggerEvent,
+			&i.ReplayOf,
+			&i.ResultJson,
 		); err != nil {
 			return nil, err
 		}
@@ -212,7 +411,7 @@ func (q *Queries) ListScheduleLogsByBot(ctx context.Context, arg ListScheduleLog
 }
 
 const listScheduleLogsBySchedule = `-- name: ListScheduleLogsBySchedule :many
-SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at
+SELECT id, schedule_id, bot_id, session_id, status, result_text, error_message, usage, started_at, completed_at, attempt, trigger_event, replay_of, result_json
 FROM schedule_logs
 WHERE schedule_id = ?1
 ORDER BY started_at DESC
@@ -236,6 +435,10 @@ type ListScheduleLogsByScheduleRow struct {
 	Usage        sql.NullString `json:"usage"`
 	StartedAt    string         `json:"started_at"`
 	CompletedAt  sql.NullString `json:"completed_at"`
+	Attempt      int64          `json:"attempt"`
+	TriggerEvent string         `json:"trigger_event"`
+	ReplayOf     sql.NullString `json:"replay_of"`
+	ResultJson   sql.NullString `json:"result_json"`
 }
 
 func (q *Queries) ListScheduleLogsBySchedule(ctx context.Context, arg ListScheduleLogsByScheduleParams) ([]ListScheduleLogsByScheduleRow, error) {
@@ -258,6 +461,10 @@ func (q *Queries) ListScheduleLogsBySchedule(ctx context.Context, arg ListSchedu
 			&i.Usage,
 			&i.StartedAt,
 			&i.CompletedAt,
+			&i.Attempt,
+			&i.TriggerEvent,
+			&i.ReplayOf,
+			&i.ResultJson,
 		); err != nil {
 			return nil, err
 		}
@@ -271,3 +478,17 @@ func (q *Queries) ListScheduleLogsBySchedule(ctx context.Context, arg ListSchedu
 	}
 	return items, nil
 }
+
+const resolveScheduleLogRetry = `-- name: ResolveScheduleLogRetry :exec
+UPDATE schedule_logs SET status = ?1 WHERE id = ?2 AND status = 'retrying'
+`
+
+type ResolveScheduleLogRetryParams struct {
+	Status string `json:"status"`
+	ID     string `json:"id"`
+}
+
+func (q *Queries) ResolveScheduleLogRetry(ctx context.Context, arg ResolveScheduleLogRetryParams) error {
+	_, err := q.db.ExecContext(ctx, resolveScheduleLogRetry, arg.Status, arg.ID)
+	return err
+}
@@ -10,6 +10,17 @@ import (
 	"database/sql"
 )
 
+const deleteUnversionedSnapshot = `-- name: DeleteUnversionedSnapshot :exec
+DELETE FROM snapshots
+WHERE id = ?1
+  AND NOT EXISTS (SELECT 1 FROM container_versions WHERE snapshot_id = ?1)
+`
+
+func (q *Queries) DeleteUnversionedSnapshot(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteUnversionedSnapshot, id)
+	return err
+}
+
 const getSnapshotByContainerAndRuntimeName = `-- name: GetSnapshotByContainerAndRuntimeName :one
 SELECT
   id, container_id, runtime_snapshot_name, display_name,
@@ -51,6 +51,135 @@ func (q *Queries) CountTokenUsageRecords(ctx context.Context, arg CountTokenUsag
 	return total, err
 }
 
+const getBotBudgetUsageByModel = `-- name: GetBotBudgetUsageByModel :many
+SELECT
+  m.model_id,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.inputTokens') AS INTEGER)), 0) AS input_tokens,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.outputTokens') AS INTEGER)), 0) AS output_tokens,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.inputTokenDetails.cacheReadTokens') AS INTEGER)), 0) AS cache_read_tokens,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.input_per_million') AS REAL)), 0.0) AS input_price,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.output_per_million') AS REAL)), 0.0) AS output_price,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.cache_read_per_million') AS REAL)), 0.0) AS cache_read_price
+FROM bot_history_messages m
+LEFT JOIN models mo ON mo.id = m.model_id
+WHERE m.bot_id = ?1
+  AND m.usage IS NOT NULL
+  AND json_valid(m.usage)
+  AND datetime(m.created_at) >= datetime(?2)
+GROUP BY m.model_id
+`
+
+type GetBotBudgetUsageByModelParams struct {
+	BotID    string      `json:"bot_id"`
+	FromTime interface{} `json:"from_time"`
+}
+
+type GetBotBudgetUsageByModelRow struct {
+	ModelID         sql.NullString `json:"model_id"`
+	InputTokens     interface{}    `json:"input_tokens"`
+	OutputTokens    interface{}    `json:"output_tokens"`
+	CacheReadTokens interface{}    `json:"cache_read_tokens"`
+	InputPrice      interface{}    `json:"input_price"`
+	OutputPrice     interface{}    `json:"output_price"`
+	CacheReadPrice  interface{}    `json:"cache_read_price"`
+}
+
+func (q *Queries) GetBotBudgetUsageByModel(ctx context.Context, arg GetBotBudgetUsageByModelParams) ([]GetBotBudgetUsageByModelRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBotBudgetUsageByModel, arg.BotID, arg.FromTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBotBudgetUsageByModelRow
+	for rows.Next() {
+		var i GetBotBudgetUsageByModelRow
+		if err := rows.Scan(
+			&i.ModelID,
+			&i.InputTokens,
+			&i.OutputTokens,
+			&i.CacheReadTokens,
+			&i.InputPrice,
+			&i.OutputPrice,
+			&i.CacheReadPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOwnerBudgetUsageByModel = `-- name: GetOwnerBudgetUsageByModel :many
+SELECT
+  m.model_id,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.inputTokens') AS INTEGER)), 0) AS input_tokens,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.outputTokens') AS INTEGER)), 0) AS output_tokens,
+  COALESCE(SUM(CAST(json_extract(m.usage, '$.inputTokenDetails.cacheReadTokens') AS INTEGER)), 0) AS cache_read_tokens,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.input_per_million') AS REAL)), 0.0) AS input_price,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.output_per_million') AS REAL)), 0.0) AS output_price,
+  COALESCE(MAX(CAST(json_extract(mo.config, '$.pricing.cache_read_per_million') AS REAL)), 0.0) AS cache_read_price
+FROM bot_history_messages m
+JOIN bots b ON b.id = m.bot_id
+LEFT JOIN models mo ON mo.id = m.model_id
+WHERE b.owner_user_id = ?1
+  AND m.usage IS NOT NULL
+  AND json_valid(m.usage)
+  AND datetime(m.created_at) >= datetime(?2)
+GROUP BY m.model_id
+`
+
+type GetOwnerBudgetUsageByModelParams struct {
+	OwnerUserID string      `json:"owner_user_id"`
+	FromTime    interface{} `json:"from_time"`
+}
+
+type GetOwnerBudgetUsageByModelRow struct {
+	ModelID         sql.NullString `json:"model_id"`
+	InputTokens     interface{}    `json:"input_tokens"`
+	OutputTokens    interface{}    `json:"output_tokens"`
+	CacheReadTokens interface{}    `json:"cache_read_tokens"`
+	InputPrice      interface{}    `json:"input_price"`
+	OutputPrice     interface{}    `json:"output_price"`
+	CacheReadPrice  interface{}    `json:"cache_read_price"`
+}
+
+func (q *Queries) GetOwnerBudgetUsageByModel(ctx context.Context, arg GetOwnerBudgetUsageByModelParams) ([]GetOwnerBudgetUsageByModelRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOwnerBudgetUsageByModel, arg.OwnerUserID, arg.FromTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOwnerBudgetUsageByModelRow
+	for rows.Next() {
+		var i GetOwnerBudgetUsageByModelRow
+		if err := rows.Scan(
+			&i.ModelID,
+			&i.InputTokens,
+			&i.OutputTokens,
+			&i.CacheReadTokens,
+			&i.InputPrice,
+			&i.OutputPrice,
+			&i.CacheReadPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTokenUsageByDayAndType = `-- name: GetTokenUsageByDayAndType :many
 SELECT
   COALESCE(
@@ -10,6 +10,25 @@ import (
 	"database/sql"
 )
 
+const deleteVersion = `-- name: DeleteVersion :one
+DELETE FROM container_versions
+WHERE container_id = ?1
+  AND version = ?2
+RETURNING snapshot_id
+`
+
+type DeleteVersionParams struct {
+	ContainerID string `json:"container_id"`
+	Version     int64  `json:"version"`
+}
+
+func (q *Queries) DeleteVersion(ctx context.Context, arg DeleteVersionParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, deleteVersion, arg.ContainerID, arg.Version)
+	var snapshot_id string
+	err := row.Scan(&snapshot_id)
+	return snapshot_id, err
+}
+
 const getVersionSnapshotRuntimeName = `-- name: GetVersionSnapshotRuntimeName :one
 SELECT s.runtime_snapshot_name
 FROM container_versions cv
@@ -67,7 +86,7 @@ func (q *Queries) InsertVersion(ctx context.Context, arg InsertVersionParams) (C
 const listVersionsByContainerID = `-- name: ListVersionsByContainerID :many
 SELECT
   cv.id, cv.container_id, cv.snapshot_id, cv.version, cv.created_at,
-  s.runtime_snapshot_name, s.display_name
+  s.runtime_snapshot_name, s.display_name, s.snapshotter, s.source
 FROM container_versions cv
 JOIN snapshots s ON s.id = cv.snapshot_id
 WHERE cv.container_id = ?1
@@ -82,6 +101,8 @@ type ListVersionsByContainerIDRow struct {
 	CreatedAt           string         `json:"created_at"`
 	RuntimeSnapshotName string         `json:"runtime_snapshot_name"`
 	DisplayName         sql.NullString `json:"display_name"`
+	Snapshotter         string         `json:"snapshotter"`
+	Source              string         `json:"source"`
 }
 
 func (q *Queries) ListVersionsByContainerID(ctx context.Context, containerID string) ([]ListVersionsByContainerIDRow, error) {
@@ -101,6 +122,8 @@ func (q *Queries) ListVersionsByContainerID(ctx context.Context, containerID str
 			&i.CreatedAt,
 			&i.RuntimeSnapshotName,
 			&i.DisplayName,
+			&i.Snapshotter,
+			&i.Source,
 		); err != nil {
 			return nil, err
 		}
@@ -128,6 +128,37 @@ func (s *Store) RemoveMember(ctx context.Context, userID string) error {
 	return mapQueryErr(err)
 }
 
+func (s *Store) GetExternalIdentity(ctx context.Context, issuer, subject string) (dbstore.ExternalIdentityRecord, error) {
+	row, err := s.queries.GetUserIdentity(ctx, sqlitesqlc.GetUserIdentityParams{
+		Issuer:  issuer,
+		Subject: subject,
+	})
+	if err != nil {
+		return dbstore.ExternalIdentityRecord{}, mapQueryErr(err)
+	}
+	return externalIdentityRecord(row), nil
+}
+
+func (s *Store) CreateExternalIdentity(ctx context.Context, input dbstore.CreateExternalIdentityInput) (dbstore.ExternalIdentityRecord, error) {
+	row, err := s.queries.CreateUserIdentity(ctx, sqlitesqlc.CreateUserIdentityParams{
+		UserID:  input.UserID,
+		Issuer:  input.Issuer,
+		Subject: input.Subject,
+		Email:   nullable(input.Email),
+	})
+	if err != nil {
+		return dbstore.ExternalIdentityRecord{}, err
+	}
+	return externalIdentityRecord(row), nil
+}
+
+func (s *Store) TouchExternalIdentity(ctx context.Context, id, email string) error {
+	return s.queries.TouchUserIdentity(ctx, sqlitesqlc.TouchUserIdentityParams{
+		ID:    id,
+		Email: nullable(email),
+	})
+}
+
 func mapQueryErr(err error) error {
 	if errors.Is(err, sql.ErrNoRows) {
 		return errors.Join(db.ErrNotFound, pgx.ErrNoRows)
@@ -173,6 +204,18 @@ func accountRecord(row sqlitesqlc.User) dbstore.AccountRecord {
 	}
 }
 
+func externalIdentityRecord(row sqlitesqlc.UserIdentity) dbstore.ExternalIdentityRecord {
+	return dbstore.ExternalIdentityRecord{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Issuer:      row.Issuer,
+		Subject:     row.Subject,
+		Email:       row.Email.String,
+		CreatedAt:   parseTime(row.CreatedAt),
+		LastLoginAt: parseTime(row.LastLoginAt.String),
+	}
+}
+
 func parseTime(value string) time.Time {
 	if value == "" {
 		return time.Time{}
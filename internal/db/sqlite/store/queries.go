@@ -23,6 +23,791 @@ func NewQueries(store *Store) *Queries {
 	return &Queries{store: store}
 }
 
+func (q *Queries) CountAuditLogs(ctx context.Context, arg pgsqlc.CountAuditLogsParams) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CountAuditLogsParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.CountAuditLogs(ctx, sqliteArg)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	return out, nil
+}
+
+func (q *Queries) CreateAuditLog(ctx context.Context, arg pgsqlc.CreateAuditLogParams) (pgsqlc.AuditLog, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.AuditLog{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateAuditLogParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.AuditLog{}, err
+	}
+	out, err := q.store.queries.CreateAuditLog(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.AuditLog{}, mapQueryErr(err)
+	}
+	var result pgsqlc.AuditLog
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.AuditLog{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListAuditLogs(ctx context.Context, arg pgsqlc.ListAuditLogsParams) ([]pgsqlc.AuditLog, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.ListAuditLogsParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListAuditLogs(ctx, sqliteArg)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.AuditLog
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreatePersonalAccessToken(ctx context.Context, arg pgsqlc.CreatePersonalAccessTokenParams) (pgsqlc.PersonalAccessToken, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.PersonalAccessToken{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreatePersonalAccessTokenParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.PersonalAccessToken{}, err
+	}
+	out, err := q.store.queries.CreatePersonalAccessToken(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.PersonalAccessToken{}, mapQueryErr(err)
+	}
+	var result pgsqlc.PersonalAccessToken
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.PersonalAccessToken{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (pgsqlc.PersonalAccessToken, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.PersonalAccessToken{}, errSQLiteQueriesNotConfigured
+	}
+	out, err := q.store.queries.GetPersonalAccessTokenByHash(ctx, tokenHash)
+	if err != nil {
+		return pgsqlc.PersonalAccessToken{}, mapQueryErr(err)
+	}
+	var result pgsqlc.PersonalAccessToken
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.PersonalAccessToken{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListPersonalAccessTokensByUser(ctx context.Context, userID pgtype.UUID) ([]pgsqlc.PersonalAccessToken, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteUserID string
+	if err := convertValue(userID, &sqliteUserID); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListPersonalAccessTokensByUser(ctx, sqliteUserID)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.PersonalAccessToken
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) RevokePersonalAccessToken(ctx context.Context, arg pgsqlc.RevokePersonalAccessTokenParams) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.RevokePersonalAccessTokenParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.RevokePersonalAccessToken(ctx, sqliteArg)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	return out, nil
+}
+
+func (q *Queries) TouchPersonalAccessToken(ctx context.Context, id pgtype.UUID) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteID string
+	if err := convertValue(id, &sqliteID); err != nil {
+		return err
+	}
+	err := q.store.queries.TouchPersonalAccessToken(ctx, sqliteID)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) ClaimDueToolApprovalEscalations(ctx context.Context, now pgtype.Timestamptz) ([]pgsqlc.ToolApprovalWorkflow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteNow interface{}
+	if err := convertValue(now, &sqliteNow); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ClaimDueToolApprovalEscalations(ctx, sqliteNow)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.ToolApprovalWorkflow
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateToolApprovalWorkflow(ctx context.Context, arg pgsqlc.CreateToolApprovalWorkflowParams) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateToolApprovalWorkflowParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return err
+	}
+	err := q.store.queries.CreateToolApprovalWorkflow(ctx, sqliteArg)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) ExpireDueToolApprovalRequests(ctx context.Context, arg pgsqlc.ExpireDueToolApprovalRequestsParams) ([]pgsqlc.ToolApprovalRequest, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.ExpireDueToolApprovalRequestsParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ExpireDueToolApprovalRequests(ctx, sqliteArg)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.ToolApprovalRequest
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetToolApprovalWorkflow(ctx context.Context, requestID pgtype.UUID) (pgsqlc.ToolApprovalWorkflow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.ToolApprovalWorkflow{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteRequestID string
+	if err := convertValue(requestID, &sqliteRequestID); err != nil {
+		return pgsqlc.ToolApprovalWorkflow{}, err
+	}
+	out, err := q.store.queries.GetToolApprovalWorkflow(ctx, sqliteRequestID)
+	if err != nil {
+		return pgsqlc.ToolApprovalWorkflow{}, mapQueryErr(err)
+	}
+	var result pgsqlc.ToolApprovalWorkflow
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.ToolApprovalWorkflow{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListToolApprovalVotes(ctx context.Context, requestID pgtype.UUID) ([]pgsqlc.ToolApprovalVote, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteRequestID string
+	if err := convertValue(requestID, &sqliteRequestID); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListToolApprovalVotes(ctx, sqliteRequestID)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.ToolApprovalVote
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) UpsertToolApprovalVote(ctx context.Context, arg pgsqlc.UpsertToolApprovalVoteParams) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.UpsertToolApprovalVoteParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return err
+	}
+	err := q.store.queries.UpsertToolApprovalVote(ctx, sqliteArg)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) CompleteBotDelegation(ctx context.Context, arg pgsqlc.CompleteBotDelegationParams) (pgsqlc.BotDelegation, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotDelegation{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CompleteBotDelegationParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.BotDelegation{}, err
+	}
+	out, err := q.store.queries.CompleteBotDelegation(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.BotDelegation{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotDelegation
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotDelegation{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateBotDelegation(ctx context.Context, arg pgsqlc.CreateBotDelegationParams) (pgsqlc.BotDelegation, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotDelegation{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateBotDelegationParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.BotDelegation{}, err
+	}
+	out, err := q.store.queries.CreateBotDelegation(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.BotDelegation{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotDelegation
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotDelegation{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetBotDelegationByID(ctx context.Context, id pgtype.UUID) (pgsqlc.BotDelegation, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotDelegation{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return pgsqlc.BotDelegation{}, err
+	}
+	out, err := q.store.queries.GetBotDelegationByID(ctx, sqliteId)
+	if err != nil {
+		return pgsqlc.BotDelegation{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotDelegation
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotDelegation{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetBotDelegationByToSession(ctx context.Context, toSessionID pgtype.UUID) (pgsqlc.BotDelegation, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotDelegation{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteToSessionID sql.NullString
+	if err := convertValue(toSessionID, &sqliteToSessionID); err != nil {
+		return pgsqlc.BotDelegation{}, err
+	}
+	out, err := q.store.queries.GetBotDelegationByToSession(ctx, sqliteToSessionID)
+	if err != nil {
+		return pgsqlc.BotDelegation{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotDelegation
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotDelegation{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListBotDelegationsByBot(ctx context.Context, arg pgsqlc.ListBotDelegationsByBotParams) ([]pgsqlc.BotDelegation, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.ListBotDelegationsByBotParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListBotDelegationsByBot(ctx, sqliteArg)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.BotDelegation
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateWorkflow(ctx context.Context, arg pgsqlc.CreateWorkflowParams) (pgsqlc.Workflow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.Workflow{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateWorkflowParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.Workflow{}, err
+	}
+	out, err := q.store.queries.CreateWorkflow(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.Workflow{}, mapQueryErr(err)
+	}
+	var result pgsqlc.Workflow
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.Workflow{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetWorkflowByID(ctx context.Context, id pgtype.UUID) (pgsqlc.Workflow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.Workflow{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return pgsqlc.Workflow{}, err
+	}
+	out, err := q.store.queries.GetWorkflowByID(ctx, sqliteId)
+	if err != nil {
+		return pgsqlc.Workflow{}, mapQueryErr(err)
+	}
+	var result pgsqlc.Workflow
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.Workflow{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListWorkflowsByBot(ctx context.Context, botID pgtype.UUID) ([]pgsqlc.Workflow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListWorkflowsByBot(ctx, sqliteBotID)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.Workflow
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) UpdateWorkflow(ctx context.Context, arg pgsqlc.UpdateWorkflowParams) (pgsqlc.Workflow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.Workflow{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.UpdateWorkflowParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.Workflow{}, err
+	}
+	out, err := q.store.queries.UpdateWorkflow(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.Workflow{}, mapQueryErr(err)
+	}
+	var result pgsqlc.Workflow
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.Workflow{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) DeleteWorkflow(ctx context.Context, id pgtype.UUID) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return err
+	}
+	err := q.store.queries.DeleteWorkflow(ctx, sqliteId)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) CreateWorkflowRun(ctx context.Context, arg pgsqlc.CreateWorkflowRunParams) (pgsqlc.WorkflowRun, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.WorkflowRun{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateWorkflowRunParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.WorkflowRun{}, err
+	}
+	out, err := q.store.queries.CreateWorkflowRun(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.WorkflowRun{}, mapQueryErr(err)
+	}
+	var result pgsqlc.WorkflowRun
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.WorkflowRun{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetWorkflowRunByID(ctx context.Context, id pgtype.UUID) (pgsqlc.WorkflowRun, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.WorkflowRun{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return pgsqlc.WorkflowRun{}, err
+	}
+	out, err := q.store.queries.GetWorkflowRunByID(ctx, sqliteId)
+	if err != nil {
+		return pgsqlc.WorkflowRun{}, mapQueryErr(err)
+	}
+	var result pgsqlc.WorkflowRun
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.WorkflowRun{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListWorkflowRunsByWorkflow(ctx context.Context, arg pgsqlc.ListWorkflowRunsByWorkflowParams) ([]pgsqlc.WorkflowRun, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.ListWorkflowRunsByWorkflowParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListWorkflowRunsByWorkflow(ctx, sqliteArg)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.WorkflowRun
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CountWorkflowRunsByWorkflow(ctx context.Context, workflowID pgtype.UUID) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteWorkflowID string
+	if err := convertValue(workflowID, &sqliteWorkflowID); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.CountWorkflowRunsByWorkflow(ctx, sqliteWorkflowID)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	return out, nil
+}
+
+func (q *Queries) ListRunningWorkflowRuns(ctx context.Context) ([]pgsqlc.WorkflowRun, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	out, err := q.store.queries.ListRunningWorkflowRuns(ctx)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.WorkflowRun
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CompleteWorkflowRun(ctx context.Context, arg pgsqlc.CompleteWorkflowRunParams) (pgsqlc.WorkflowRun, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.WorkflowRun{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CompleteWorkflowRunParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.WorkflowRun{}, err
+	}
+	out, err := q.store.queries.CompleteWorkflowRun(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.WorkflowRun{}, mapQueryErr(err)
+	}
+	var result pgsqlc.WorkflowRun
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.WorkflowRun{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) UpsertWorkflowRunStep(ctx context.Context, arg pgsqlc.UpsertWorkflowRunStepParams) (pgsqlc.WorkflowRunStep, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.WorkflowRunStep{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.UpsertWorkflowRunStepParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.WorkflowRunStep{}, err
+	}
+	out, err := q.store.queries.UpsertWorkflowRunStep(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.WorkflowRunStep{}, mapQueryErr(err)
+	}
+	var result pgsqlc.WorkflowRunStep
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.WorkflowRunStep{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListWorkflowRunSteps(ctx context.Context, runID pgtype.UUID) ([]pgsqlc.WorkflowRunStep, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteRunID string
+	if err := convertValue(runID, &sqliteRunID); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListWorkflowRunSteps(ctx, sqliteRunID)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.WorkflowRunStep
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetBotEgressPolicy(ctx context.Context, botID pgtype.UUID) (pgsqlc.BotEgressPolicy, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotEgressPolicy{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return pgsqlc.BotEgressPolicy{}, err
+	}
+	out, err := q.store.queries.GetBotEgressPolicy(ctx, sqliteBotID)
+	if err != nil {
+		return pgsqlc.BotEgressPolicy{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotEgressPolicy
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotEgressPolicy{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) UpsertBotEgressPolicy(ctx context.Context, arg pgsqlc.UpsertBotEgressPolicyParams) (pgsqlc.BotEgressPolicy, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotEgressPolicy{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.UpsertBotEgressPolicyParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.BotEgressPolicy{}, err
+	}
+	out, err := q.store.queries.UpsertBotEgressPolicy(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.BotEgressPolicy{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotEgressPolicy
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotEgressPolicy{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateBotTemplate(ctx context.Context, arg pgsqlc.CreateBotTemplateParams) (pgsqlc.BotTemplate, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotTemplate{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateBotTemplateParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.BotTemplate{}, err
+	}
+	out, err := q.store.queries.CreateBotTemplate(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.BotTemplate{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotTemplate
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotTemplate{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetBotTemplateByID(ctx context.Context, id pgtype.UUID) (pgsqlc.BotTemplate, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotTemplate{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return pgsqlc.BotTemplate{}, err
+	}
+	out, err := q.store.queries.GetBotTemplateByID(ctx, sqliteId)
+	if err != nil {
+		return pgsqlc.BotTemplate{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotTemplate
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotTemplate{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetBotTemplateByName(ctx context.Context, name string) (pgsqlc.BotTemplate, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotTemplate{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteName string
+	if err := convertValue(name, &sqliteName); err != nil {
+		return pgsqlc.BotTemplate{}, err
+	}
+	out, err := q.store.queries.GetBotTemplateByName(ctx, sqliteName)
+	if err != nil {
+		return pgsqlc.BotTemplate{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotTemplate
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotTemplate{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListBotTemplates(ctx context.Context) ([]pgsqlc.BotTemplate, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	out, err := q.store.queries.ListBotTemplates(ctx)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.BotTemplate
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListVisibleBotTemplates(ctx context.Context, userID pgtype.UUID) ([]pgsqlc.BotTemplate, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteUserID sql.NullString
+	if err := convertValue(userID, &sqliteUserID); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListVisibleBotTemplates(ctx, sqliteUserID)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.BotTemplate
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) UpdateBotTemplate(ctx context.Context, arg pgsqlc.UpdateBotTemplateParams) (pgsqlc.BotTemplate, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotTemplate{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.UpdateBotTemplateParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.BotTemplate{}, err
+	}
+	out, err := q.store.queries.UpdateBotTemplate(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.BotTemplate{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotTemplate
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotTemplate{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) DeleteBotTemplate(ctx context.Context, id pgtype.UUID) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return err
+	}
+	err := q.store.queries.DeleteBotTemplate(ctx, sqliteId)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) GetBotSnapshotPolicy(ctx context.Context, botID pgtype.UUID) (pgsqlc.BotSnapshotPolicy, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotSnapshotPolicy{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return pgsqlc.BotSnapshotPolicy{}, err
+	}
+	out, err := q.store.queries.GetBotSnapshotPolicy(ctx, sqliteBotID)
+	if err != nil {
+		return pgsqlc.BotSnapshotPolicy{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotSnapshotPolicy
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotSnapshotPolicy{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) UpsertBotSnapshotPolicy(ctx context.Context, arg pgsqlc.UpsertBotSnapshotPolicyParams) (pgsqlc.BotSnapshotPolicy, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotSnapshotPolicy{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.UpsertBotSnapshotPolicyParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.BotSnapshotPolicy{}, err
+	}
+	out, err := q.store.queries.UpsertBotSnapshotPolicy(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.BotSnapshotPolicy{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotSnapshotPolicy
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotSnapshotPolicy{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) ListEnabledBotSnapshotPolicies(ctx context.Context) ([]pgsqlc.BotSnapshotPolicy, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	out, err := q.store.queries.ListEnabledBotSnapshotPolicies(ctx)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.BotSnapshotPolicy
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) MarkBotSnapshotPolicyRun(ctx context.Context, botID pgtype.UUID) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return err
+	}
+	err := q.store.queries.MarkBotSnapshotPolicyRun(ctx, sqliteBotID)
+	return mapQueryErr(err)
+}
+
 func (q *Queries) WithTx(_ pgx.Tx) dbstore.Queries {
 	return q
 }
@@ -168,6 +953,36 @@ func (q *Queries) CountEmailOutboxByBot(ctx context.Context, botID pgtype.UUID)
 	return result, nil
 }
 
+func (q *Queries) CountActiveScheduleReplays(ctx context.Context, replayOf pgtype.UUID) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteReplayOf sql.NullString
+	if err := convertValue(replayOf, &sqliteReplayOf); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.CountActiveScheduleReplays(ctx, sqliteReplayOf)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	return out, nil
+}
+
+func (q *Queries) CountFailedScheduleLogsByBot(ctx context.Context, botID pgtype.UUID) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.CountFailedScheduleLogsByBot(ctx, sqliteBotID)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	return out, nil
+}
+
 func (q *Queries) CountHeartbeatLogsByBot(ctx context.Context, botID pgtype.UUID) (int64, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return 0, errSQLiteQueriesNotConfigured
@@ -293,188 +1108,433 @@ func (q *Queries) CountModelsByType(ctx context.Context, type_ string) (int64, e
 	return result, nil
 }
 
-func (q *Queries) CountProviders(ctx context.Context) (int64, error) {
+func (q *Queries) CountProviders(ctx context.Context) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	out, err := q.store.queries.CountProviders(ctx)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	var result int64
+	if err := convertValue(out, &result); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CountScheduleLogsByBot(ctx context.Context, botID pgtype.UUID) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.CountScheduleLogsByBot(ctx, sqliteBotID)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	var result int64
+	if err := convertValue(out, &result); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CountScheduleLogsBySchedule(ctx context.Context, scheduleID pgtype.UUID) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteScheduleID string
+	if err := convertValue(scheduleID, &sqliteScheduleID); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.CountScheduleLogsBySchedule(ctx, sqliteScheduleID)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	var result int64
+	if err := convertValue(out, &result); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CountSessionEvents(ctx context.Context, sessionID pgtype.UUID) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteSessionID string
+	if err := convertValue(sessionID, &sqliteSessionID); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.CountSessionEvents(ctx, sqliteSessionID)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	var result int64
+	if err := convertValue(out, &result); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CountTokenUsageRecords(ctx context.Context, arg pgsqlc.CountTokenUsageRecordsParams) (int64, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return 0, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CountTokenUsageRecordsParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return 0, err
+	}
+	out, err := q.store.queries.CountTokenUsageRecords(ctx, sqliteArg)
+	if err != nil {
+		return 0, mapQueryErr(err)
+	}
+	var result int64
+	if err := convertValue(out, &result); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateAccount(ctx context.Context, arg pgsqlc.CreateAccountParams) (pgsqlc.User, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.User{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateAccountParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.User{}, err
+	}
+	out, err := q.store.queries.CreateAccount(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.User{}, mapQueryErr(err)
+	}
+	var result pgsqlc.User
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.User{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateBot(ctx context.Context, arg pgsqlc.CreateBotParams) (pgsqlc.CreateBotRow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.CreateBotRow{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateBotParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.CreateBotRow{}, err
+	}
+	out, err := q.store.queries.CreateBot(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.CreateBotRow{}, mapQueryErr(err)
+	}
+	var result pgsqlc.CreateBotRow
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.CreateBotRow{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateBotACLRule(ctx context.Context, arg pgsqlc.CreateBotACLRuleParams) (pgsqlc.BotAclRule, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotAclRule{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateBotACLRuleParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.BotAclRule{}, err
+	}
+	out, err := q.store.queries.CreateBotACLRule(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.BotAclRule{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotAclRule
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotAclRule{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateBotModelFallback(ctx context.Context, arg pgsqlc.CreateBotModelFallbackParams) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateBotModelFallbackParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return err
+	}
+	err := q.store.queries.CreateBotModelFallback(ctx, sqliteArg)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) DeleteBotModelFallbacks(ctx context.Context, botID pgtype.UUID) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return err
+	}
+	err := q.store.queries.DeleteBotModelFallbacks(ctx, sqliteBotID)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) ListBotModelFallbacks(ctx context.Context, botID pgtype.UUID) ([]pgtype.UUID, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.ListBotModelFallbacks(ctx, sqliteBotID)
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgtype.UUID
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateBotUserGrant(ctx context.Context, arg pgsqlc.CreateBotUserGrantParams) (pgsqlc.BotUserGrant, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotUserGrant{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateBotUserGrantParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.BotUserGrant{}, err
+	}
+	out, err := q.store.queries.CreateBotUserGrant(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.BotUserGrant{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotUserGrant
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotUserGrant{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) CreateBudget(ctx context.Context, arg pgsqlc.CreateBudgetParams) (pgsqlc.Budget, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.Budget{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.CreateBudgetParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.Budget{}, err
+	}
+	out, err := q.store.queries.CreateBudget(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.Budget{}, mapQueryErr(err)
+	}
+	var result pgsqlc.Budget
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.Budget{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) DeleteBudget(ctx context.Context, id pgtype.UUID) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return err
+	}
+	err := q.store.queries.DeleteBudget(ctx, sqliteId)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) GetBotBudgetUsageByModel(ctx context.Context, arg pgsqlc.GetBotBudgetUsageByModelParams) ([]pgsqlc.GetBotBudgetUsageByModelRow, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return 0, errSQLiteQueriesNotConfigured
+		return nil, errSQLiteQueriesNotConfigured
 	}
-	out, err := q.store.queries.CountProviders(ctx)
+	var sqliteArg sqlitesqlc.GetBotBudgetUsageByModelParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return nil, err
+	}
+	out, err := q.store.queries.GetBotBudgetUsageByModel(ctx, sqliteArg)
 	if err != nil {
-		return 0, mapQueryErr(err)
+		return nil, mapQueryErr(err)
 	}
-	var result int64
+	var result []pgsqlc.GetBotBudgetUsageByModelRow
 	if err := convertValue(out, &result); err != nil {
-		return 0, err
+		return nil, err
 	}
 	return result, nil
 }
 
-func (q *Queries) CountScheduleLogsByBot(ctx context.Context, botID pgtype.UUID) (int64, error) {
+func (q *Queries) GetBotUserGrantByID(ctx context.Context, id pgtype.UUID) (pgsqlc.BotUserGrant, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return 0, errSQLiteQueriesNotConfigured
+		return pgsqlc.BotUserGrant{}, errSQLiteQueriesNotConfigured
 	}
-	var sqliteBotID string
-	if err := convertValue(botID, &sqliteBotID); err != nil {
-		return 0, err
+	var sqliteID string
+	if err := convertValue(id, &sqliteID); err != nil {
+		return pgsqlc.BotUserGrant{}, err
 	}
-	out, err := q.store.queries.CountScheduleLogsByBot(ctx, sqliteBotID)
+	out, err := q.store.queries.GetBotUserGrantByID(ctx, sqliteID)
 	if err != nil {
-		return 0, mapQueryErr(err)
+		return pgsqlc.BotUserGrant{}, mapQueryErr(err)
 	}
-	var result int64
+	var result pgsqlc.BotUserGrant
 	if err := convertValue(out, &result); err != nil {
-		return 0, err
+		return pgsqlc.BotUserGrant{}, err
 	}
 	return result, nil
 }
 
-func (q *Queries) CountScheduleLogsBySchedule(ctx context.Context, scheduleID pgtype.UUID) (int64, error) {
+func (q *Queries) GetBudgetByID(ctx context.Context, id pgtype.UUID) (pgsqlc.Budget, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return 0, errSQLiteQueriesNotConfigured
+		return pgsqlc.Budget{}, errSQLiteQueriesNotConfigured
 	}
-	var sqliteScheduleID string
-	if err := convertValue(scheduleID, &sqliteScheduleID); err != nil {
-		return 0, err
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return pgsqlc.Budget{}, err
 	}
-	out, err := q.store.queries.CountScheduleLogsBySchedule(ctx, sqliteScheduleID)
+	out, err := q.store.queries.GetBudgetByID(ctx, sqliteId)
 	if err != nil {
-		return 0, mapQueryErr(err)
+		return pgsqlc.Budget{}, mapQueryErr(err)
 	}
-	var result int64
+	var result pgsqlc.Budget
 	if err := convertValue(out, &result); err != nil {
-		return 0, err
+		return pgsqlc.Budget{}, err
 	}
 	return result, nil
 }
 
-func (q *Queries) CountSessionEvents(ctx context.Context, sessionID pgtype.UUID) (int64, error) {
+func (q *Queries) GetOwnerBudgetUsageByModel(ctx context.Context, arg pgsqlc.GetOwnerBudgetUsageByModelParams) ([]pgsqlc.GetOwnerBudgetUsageByModelRow, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return 0, errSQLiteQueriesNotConfigured
+		return nil, errSQLiteQueriesNotConfigured
 	}
-	var sqliteSessionID string
-	if err := convertValue(sessionID, &sqliteSessionID); err != nil {
-		return 0, err
+	var sqliteArg sqlitesqlc.GetOwnerBudgetUsageByModelParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return nil, err
 	}
-	out, err := q.store.queries.CountSessionEvents(ctx, sqliteSessionID)
+	out, err := q.store.queries.GetOwnerBudgetUsageByModel(ctx, sqliteArg)
 	if err != nil {
-		return 0, mapQueryErr(err)
+		return nil, mapQueryErr(err)
 	}
-	var result int64
+	var result []pgsqlc.GetOwnerBudgetUsageByModelRow
 	if err := convertValue(out, &result); err != nil {
-		return 0, err
+		return nil, err
 	}
 	return result, nil
 }
 
-func (q *Queries) CountTokenUsageRecords(ctx context.Context, arg pgsqlc.CountTokenUsageRecordsParams) (int64, error) {
+func (q *Queries) ListBudgetsByBot(ctx context.Context, botID pgtype.UUID) ([]pgsqlc.Budget, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return 0, errSQLiteQueriesNotConfigured
+		return nil, errSQLiteQueriesNotConfigured
 	}
-	var sqliteArg sqlitesqlc.CountTokenUsageRecordsParams
-	if err := convertValue(arg, &sqliteArg); err != nil {
-		return 0, err
+	var sqliteBotID sql.NullString
+	if err := convertValue(botID, &sqliteBotID); err != nil {
+		return nil, err
 	}
-	out, err := q.store.queries.CountTokenUsageRecords(ctx, sqliteArg)
+	out, err := q.store.queries.ListBudgetsByBot(ctx, sqliteBotID)
 	if err != nil {
-		return 0, mapQueryErr(err)
+		return nil, mapQueryErr(err)
 	}
-	var result int64
+	var result []pgsqlc.Budget
 	if err := convertValue(out, &result); err != nil {
-		return 0, err
+		return nil, err
 	}
 	return result, nil
 }
 
-func (q *Queries) CreateAccount(ctx context.Context, arg pgsqlc.CreateAccountParams) (pgsqlc.User, error) {
+func (q *Queries) ListBudgetsByUser(ctx context.Context, userID pgtype.UUID) ([]pgsqlc.Budget, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return pgsqlc.User{}, errSQLiteQueriesNotConfigured
+		return nil, errSQLiteQueriesNotConfigured
 	}
-	var sqliteArg sqlitesqlc.CreateAccountParams
-	if err := convertValue(arg, &sqliteArg); err != nil {
-		return pgsqlc.User{}, err
+	var sqliteUserID sql.NullString
+	if err := convertValue(userID, &sqliteUserID); err != nil {
+		return nil, err
 	}
-	out, err := q.store.queries.CreateAccount(ctx, sqliteArg)
+	out, err := q.store.queries.ListBudgetsByUser(ctx, sqliteUserID)
 	if err != nil {
-		return pgsqlc.User{}, mapQueryErr(err)
+		return nil, mapQueryErr(err)
 	}
-	var result pgsqlc.User
+	var result []pgsqlc.Budget
 	if err := convertValue(out, &result); err != nil {
-		return pgsqlc.User{}, err
+		return nil, err
 	}
 	return result, nil
 }
 
-func (q *Queries) CreateBot(ctx context.Context, arg pgsqlc.CreateBotParams) (pgsqlc.CreateBotRow, error) {
+func (q *Queries) ListEnabledBudgetsForBot(ctx context.Context, arg pgsqlc.ListEnabledBudgetsForBotParams) ([]pgsqlc.Budget, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return pgsqlc.CreateBotRow{}, errSQLiteQueriesNotConfigured
+		return nil, errSQLiteQueriesNotConfigured
 	}
-	var sqliteArg sqlitesqlc.CreateBotParams
+	var sqliteArg sqlitesqlc.ListEnabledBudgetsForBotParams
 	if err := convertValue(arg, &sqliteArg); err != nil {
-		return pgsqlc.CreateBotRow{}, err
+		return nil, err
 	}
-	out, err := q.store.queries.CreateBot(ctx, sqliteArg)
+	out, err := q.store.queries.ListEnabledBudgetsForBot(ctx, sqliteArg)
 	if err != nil {
-		return pgsqlc.CreateBotRow{}, mapQueryErr(err)
+		return nil, mapQueryErr(err)
 	}
-	var result pgsqlc.CreateBotRow
+	var result []pgsqlc.Budget
 	if err := convertValue(out, &result); err != nil {
-		return pgsqlc.CreateBotRow{}, err
+		return nil, err
 	}
 	return result, nil
 }
 
-func (q *Queries) CreateBotACLRule(ctx context.Context, arg pgsqlc.CreateBotACLRuleParams) (pgsqlc.BotAclRule, error) {
+func (q *Queries) ListUserChannelBindingsByUser(ctx context.Context, userID pgtype.UUID) ([]pgsqlc.UserChannelBinding, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return pgsqlc.BotAclRule{}, errSQLiteQueriesNotConfigured
+		return nil, errSQLiteQueriesNotConfigured
 	}
-	var sqliteArg sqlitesqlc.CreateBotACLRuleParams
-	if err := convertValue(arg, &sqliteArg); err != nil {
-		return pgsqlc.BotAclRule{}, err
+	var sqliteUserID string
+	if err := convertValue(userID, &sqliteUserID); err != nil {
+		return nil, err
 	}
-	out, err := q.store.queries.CreateBotACLRule(ctx, sqliteArg)
+	out, err := q.store.queries.ListUserChannelBindingsByUser(ctx, sqliteUserID)
 	if err != nil {
-		return pgsqlc.BotAclRule{}, mapQueryErr(err)
+		return nil, mapQueryErr(err)
 	}
-	var result pgsqlc.BotAclRule
+	var result []pgsqlc.UserChannelBinding
 	if err := convertValue(out, &result); err != nil {
-		return pgsqlc.BotAclRule{}, err
+		return nil, err
 	}
 	return result, nil
 }
 
-func (q *Queries) CreateBotUserGrant(ctx context.Context, arg pgsqlc.CreateBotUserGrantParams) (pgsqlc.BotUserGrant, error) {
+func (q *Queries) MarkBudgetExceeded(ctx context.Context, arg pgsqlc.MarkBudgetExceededParams) (int64, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return pgsqlc.BotUserGrant{}, errSQLiteQueriesNotConfigured
+		return 0, errSQLiteQueriesNotConfigured
 	}
-	var sqliteArg sqlitesqlc.CreateBotUserGrantParams
+	var sqliteArg sqlitesqlc.MarkBudgetExceededParams
 	if err := convertValue(arg, &sqliteArg); err != nil {
-		return pgsqlc.BotUserGrant{}, err
+		return 0, err
 	}
-	out, err := q.store.queries.CreateBotUserGrant(ctx, sqliteArg)
+	out, err := q.store.queries.MarkBudgetExceeded(ctx, sqliteArg)
 	if err != nil {
-		return pgsqlc.BotUserGrant{}, mapQueryErr(err)
+		return 0, mapQueryErr(err)
 	}
-	var result pgsqlc.BotUserGrant
+	var result int64
 	if err := convertValue(out, &result); err != nil {
-		return pgsqlc.BotUserGrant{}, err
+		return 0, err
 	}
 	return result, nil
 }
 
-func (q *Queries) GetBotUserGrantByID(ctx context.Context, id pgtype.UUID) (pgsqlc.BotUserGrant, error) {
+func (q *Queries) MarkBudgetWarned(ctx context.Context, arg pgsqlc.MarkBudgetWarnedParams) (int64, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
-		return pgsqlc.BotUserGrant{}, errSQLiteQueriesNotConfigured
+		return 0, errSQLiteQueriesNotConfigured
 	}
-	var sqliteID string
-	if err := convertValue(id, &sqliteID); err != nil {
-		return pgsqlc.BotUserGrant{}, err
+	var sqliteArg sqlitesqlc.MarkBudgetWarnedParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return 0, err
 	}
-	out, err := q.store.queries.GetBotUserGrantByID(ctx, sqliteID)
+	out, err := q.store.queries.MarkBudgetWarned(ctx, sqliteArg)
 	if err != nil {
-		return pgsqlc.BotUserGrant{}, mapQueryErr(err)
+		return 0, mapQueryErr(err)
 	}
-	var result pgsqlc.BotUserGrant
+	var result int64
 	if err := convertValue(out, &result); err != nil {
-		return pgsqlc.BotUserGrant{}, err
+		return 0, err
 	}
 	return result, nil
 }
@@ -1003,6 +2063,14 @@ func (q *Queries) CreateUser(ctx context.Context, arg pgsqlc.CreateUserParams) (
 	return result, nil
 }
 
+func (q *Queries) DeadLetterPendingScheduleRetries(ctx context.Context) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	err := q.store.queries.DeadLetterPendingScheduleRetries(ctx)
+	return mapQueryErr(err)
+}
+
 func (q *Queries) DeleteBotACLRuleByID(ctx context.Context, id pgtype.UUID) error {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return errSQLiteQueriesNotConfigured
@@ -1465,6 +2533,25 @@ func (q *Queries) GetBotACLDefaultEffect(ctx context.Context, id pgtype.UUID) (s
 	return result, nil
 }
 
+func (q *Queries) GetBotACLRuleByID(ctx context.Context, id pgtype.UUID) (pgsqlc.BotAclRule, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.BotAclRule{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return pgsqlc.BotAclRule{}, err
+	}
+	out, err := q.store.queries.GetBotACLRuleByID(ctx, sqliteId)
+	if err != nil {
+		return pgsqlc.BotAclRule{}, mapQueryErr(err)
+	}
+	var result pgsqlc.BotAclRule
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.BotAclRule{}, err
+	}
+	return result, nil
+}
+
 func (q *Queries) GetBotByID(ctx context.Context, id pgtype.UUID) (pgsqlc.GetBotByIDRow, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return pgsqlc.GetBotByIDRow{}, errSQLiteQueriesNotConfigured
@@ -2274,6 +3361,44 @@ func (q *Queries) GetScheduleByID(ctx context.Context, id pgtype.UUID) (pgsqlc.S
 	return result, nil
 }
 
+func (q *Queries) GetScheduleByWorkflowID(ctx context.Context, workflowID pgtype.UUID) (pgsqlc.Schedule, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.Schedule{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteWorkflowID sql.NullString
+	if err := convertValue(workflowID, &sqliteWorkflowID); err != nil {
+		return pgsqlc.Schedule{}, err
+	}
+	out, err := q.store.queries.GetScheduleByWorkflowID(ctx, sqliteWorkflowID)
+	if err != nil {
+		return pgsqlc.Schedule{}, mapQueryErr(err)
+	}
+	var result pgsqlc.Schedule
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.Schedule{}, err
+	}
+	return result, nil
+}
+
+func (q *Queries) GetScheduleLogByID(ctx context.Context, id pgtype.UUID) (pgsqlc.GetScheduleLogByIDRow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.GetScheduleLogByIDRow{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return pgsqlc.GetScheduleLogByIDRow{}, err
+	}
+	out, err := q.store.queries.GetScheduleLogByID(ctx, sqliteId)
+	if err != nil {
+		return pgsqlc.GetScheduleLogByIDRow{}, mapQueryErr(err)
+	}
+	var result pgsqlc.GetScheduleLogByIDRow
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.GetScheduleLogByIDRow{}, err
+	}
+	return result, nil
+}
+
 func (q *Queries) GetSearchProviderByID(ctx context.Context, id pgtype.UUID) (pgsqlc.SearchProvider, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return pgsqlc.SearchProvider{}, errSQLiteQueriesNotConfigured
@@ -2388,6 +3513,37 @@ func (q *Queries) GetSettingsByBotID(ctx context.Context, id pgtype.UUID) (pgsql
 	return result, nil
 }
 
+func (q *Queries) DeleteUnversionedSnapshot(ctx context.Context, id pgtype.UUID) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteId string
+	if err := convertValue(id, &sqliteId); err != nil {
+		return err
+	}
+	err := q.store.queries.DeleteUnversionedSnapshot(ctx, sqliteId)
+	return mapQueryErr(err)
+}
+
+func (q *Queries) DeleteVersion(ctx context.Context, arg pgsqlc.DeleteVersionParams) (pgtype.UUID, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgtype.UUID{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.DeleteVersionParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgtype.UUID{}, err
+	}
+	out, err := q.store.queries.DeleteVersion(ctx, sqliteArg)
+	if err != nil {
+		return pgtype.UUID{}, mapQueryErr(err)
+	}
+	var result pgtype.UUID
+	if err := convertValue(out, &result); err != nil {
+		return pgtype.UUID{}, err
+	}
+	return result, nil
+}
+
 func (q *Queries) GetSnapshotByContainerAndRuntimeName(ctx context.Context, arg pgsqlc.GetSnapshotByContainerAndRuntimeNameParams) (pgsqlc.Snapshot, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return pgsqlc.Snapshot{}, errSQLiteQueriesNotConfigured
@@ -3064,6 +4220,31 @@ func (q *Queries) ListEnabledSchedules(ctx context.Context) ([]pgsqlc.Schedule,
 	return result, nil
 }
 
+func (q *Queries) ListFailedScheduleLogsByBot(ctx context.Context, arg pgsqlc.ListFailedScheduleLogsByBotParams) ([]pgsqlc.ListFailedScheduleLogsByBotRow, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return nil, errSQLiteQueriesNotConfigured
+	}
+	var sqliteBotID string
+	if err := convertValue(arg.BotID, &sqliteBotID); err != nil {
+		return nil, err
+	}
+	// Postgres names the paging params limit/offset while the SQLite query
+	// uses lim/off, so map them explicitly instead of by JSON tag.
+	out, err := q.store.queries.ListFailedScheduleLogsByBot(ctx, sqlitesqlc.ListFailedScheduleLogsByBotParams{
+		BotID: sqliteBotID,
+		Off:   int64(arg.Offset),
+		Lim:   int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, mapQueryErr(err)
+	}
+	var result []pgsqlc.ListFailedScheduleLogsByBotRow
+	if err := convertValue(out, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (q *Queries) ListHeartbeatEnabledBots(ctx context.Context) ([]pgsqlc.ListHeartbeatEnabledBotsRow, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return nil, errSQLiteQueriesNotConfigured
@@ -4131,6 +5312,18 @@ func (q *Queries) RemoveChatParticipant(ctx context.Context, arg pgsqlc.RemoveCh
 	return mapQueryErr(err)
 }
 
+func (q *Queries) ResolveScheduleLogRetry(ctx context.Context, arg pgsqlc.ResolveScheduleLogRetryParams) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.ResolveScheduleLogRetryParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return err
+	}
+	err := q.store.queries.ResolveScheduleLogRetry(ctx, sqliteArg)
+	return mapQueryErr(err)
+}
+
 func (q *Queries) SaveMatrixSyncSinceToken(ctx context.Context, arg pgsqlc.SaveMatrixSyncSinceTokenParams) (int64, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return 0, errSQLiteQueriesNotConfigured
@@ -4462,6 +5655,25 @@ func (q *Queries) UpdateBotStatus(ctx context.Context, arg pgsqlc.UpdateBotStatu
 	return mapQueryErr(err)
 }
 
+func (q *Queries) UpdateBudget(ctx context.Context, arg pgsqlc.UpdateBudgetParams) (pgsqlc.Budget, error) {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return pgsqlc.Budget{}, errSQLiteQueriesNotConfigured
+	}
+	var sqliteArg sqlitesqlc.UpdateBudgetParams
+	if err := convertValue(arg, &sqliteArg); err != nil {
+		return pgsqlc.Budget{}, err
+	}
+	out, err := q.store.queries.UpdateBudget(ctx, sqliteArg)
+	if err != nil {
+		return pgsqlc.Budget{}, mapQueryErr(err)
+	}
+	var result pgsqlc.Budget
+	if err := convertValue(out, &result); err != nil {
+		return pgsqlc.Budget{}, err
+	}
+	return result, nil
+}
+
 func (q *Queries) UpdateChatRouteMetadata(ctx context.Context, arg pgsqlc.UpdateChatRouteMetadataParams) error {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return errSQLiteQueriesNotConfigured
@@ -5224,6 +6436,14 @@ func (q *Queries) CreateUserInputRequest(ctx context.Context, arg pgsqlc.CreateU
 	return result, nil
 }
 
+func (q *Queries) FailInterruptedScheduleLogs(ctx context.Context) error {
+	if q == nil || q.store == nil || q.store.queries == nil {
+		return errSQLiteQueriesNotConfigured
+	}
+	err := q.store.queries.FailInterruptedScheduleLogs(ctx)
+	return mapQueryErr(err)
+}
+
 func (q *Queries) FailUserInputRequest(ctx context.Context, arg pgsqlc.FailUserInputRequestParams) (pgsqlc.UserInputRequest, error) {
 	if q == nil || q.store == nil || q.store.queries == nil {
 		return pgsqlc.UserInputRequest{}, errSQLiteQueriesNotConfigured
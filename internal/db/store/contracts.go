@@ -75,6 +75,25 @@ type UpdateAccountPasswordInput struct {
 	PasswordHash string
 }
 
+// ExternalIdentityRecord links a user to a subject at an external identity
+// provider.
+type ExternalIdentityRecord struct {
+	ID          string
+	UserID      string
+	Issuer      string
+	Subject     string
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
+
+type CreateExternalIdentityInput struct {
+	UserID  string
+	Issuer  string
+	Subject string
+	Email   string
+}
+
 type AccountStore interface {
 	CountAccounts(ctx context.Context) (int64, error)
 	GetByUserID(ctx context.Context, userID string) (AccountRecord, error)
@@ -88,6 +107,9 @@ type AccountStore interface {
 	UpdateProfile(ctx context.Context, input UpdateAccountProfileInput) (AccountRecord, error)
 	UpdatePassword(ctx context.Context, input UpdateAccountPasswordInput) error
 	RemoveMember(ctx context.Context, userID string) error
+	GetExternalIdentity(ctx context.Context, issuer, subject string) (ExternalIdentityRecord, error)
+	CreateExternalIdentity(ctx context.Context, input CreateExternalIdentityInput) (ExternalIdentityRecord, error)
+	TouchExternalIdentity(ctx context.Context, id, email string) error
 }
 
 type BotStore interface {
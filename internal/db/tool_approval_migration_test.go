@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestToolApprovalRequestsAcceptAnyToolName(t *testing.T) {
+	// Approval policies may name any native or MCP tool, so neither the
+	// baselines nor the migrations may restrict tool_name.
+	for _, path := range []string{
+		"postgres/migrations/0001_init.up.sql",
+		"sqlite/migrations/0001_init.up.sql",
+	} {
+		tableSQL := toolApprovalTableSQL(readEmbeddedMigration(t, path))
+		if tableSQL == "" {
+			t.Fatalf("%s missing tool_approval_requests table", path)
+		}
+		if strings.Contains(tableSQL, "tool_approval_tool_name_check") {
+			t.Fatalf("%s tool_approval_requests must not restrict tool_name", path)
+		}
+	}
+	up := readEmbeddedMigration(t, "postgres/migrations/0100_tool_approval_any_tool.up.sql")
+	if !strings.Contains(up, "DROP CONSTRAINT IF EXISTS tool_approval_tool_name_check") {
+		t.Fatal("postgres 0100 up migration does not drop tool_approval_tool_name_check")
+	}
+
+	dsn := tempSQLiteMigrationDSN(t)
+	if err := RunMigrateTarget(nil, MigrationTarget{Driver: DriverSQLite, DSN: dsn}, sqliteMigrationsFS(t), "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	db := openMigrationSQLite(t, dsn)
+	defer closeMigrationSQLite(t, db)
+	insertSQLiteACPAgentSession(t, db)
+	if _, err := db.ExecContext(context.Background(), `INSERT INTO tool_approval_requests(id,bot_id,session_id,tool_call_id,tool_name,tool_input,short_id)
+		VALUES('00000000-0000-0000-0000-000000000004','00000000-0000-0000-0000-000000000002','00000000-0000-0000-0000-000000000003','call-1','github_create_issue','{}',1)`); err != nil {
+		t.Fatalf("insert approval request for an MCP tool: %v", err)
+	}
+}
+
+func toolApprovalTableSQL(sql string) string {
+	start := strings.Index(sql, "CREATE TABLE IF NOT EXISTS tool_approval_requests")
+	if start < 0 {
+		return ""
+	}
+	tail := sql[start:]
+	end := strings.Index(tail, "CREATE INDEX")
+	if end < 0 {
+		return tail
+	}
+	return tail[:end]
+}
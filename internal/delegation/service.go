@@ -0,0 +1,335 @@
+package delegation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/audit"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+	sessionpkg "github.com/memohai/memoh/internal/session"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+	sessionTitleMax  = 80
+)
+
+// Service hands tasks between bots on the same instance. Each hop runs in a
+// session of the target bot and is recorded with its result and usage so
+// cost is attributed to the bot that did the work.
+type Service struct {
+	queries  dbstore.Queries
+	bots     *bots.Service
+	sessions *sessionpkg.Service
+	runner   Runner
+	auditLog *audit.Service
+	logger   *slog.Logger
+}
+
+func NewService(log *slog.Logger, queries dbstore.Queries, botService *bots.Service, sessions *sessionpkg.Service) *Service {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &Service{
+		queries:  queries,
+		bots:     botService,
+		sessions: sessions,
+		logger:   log.With(slog.String("service", "delegation")),
+	}
+}
+
+// SetRunner sets the runner that executes delegated tasks as the target bot.
+func (s *Service) SetRunner(runner Runner) {
+	s.runner = runner
+}
+
+// SetAuditLog records started delegations to the audit log.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
+// Start authorizes a delegation, checks the chain it extends for loops and
+// depth, and creates the target bot's session and the delegation record.
+// The task is not run until Run is called.
+func (s *Service) Start(ctx context.Context, input StartInput) (Delegation, error) {
+	task := strings.TrimSpace(input.Task)
+	if task == "" {
+		return Delegation{}, ErrTaskRequired
+	}
+	from, err := s.bots.Get(ctx, input.FromBotID)
+	if err != nil {
+		return Delegation{}, err
+	}
+	target, err := s.bots.AuthorizeBotDelegation(ctx, from.ID, input.Target)
+	if err != nil {
+		if errors.Is(err, bots.ErrBotAccessDenied) || errors.Is(err, bots.ErrBotNotFound) {
+			return Delegation{}, fmt.Errorf("%w: %s", ErrForbidden, strings.TrimSpace(input.Target))
+		}
+		return Delegation{}, err
+	}
+
+	parent, err := s.parentOf(ctx, input.FromSessionID)
+	if err != nil {
+		return Delegation{}, err
+	}
+	hop := 1
+	chain := []string{from.ID}
+	if parent != nil {
+		hop = parent.Hop + 1
+		chain = parent.Chain
+	}
+	if slices.Contains(chain, target.ID) {
+		return Delegation{}, ErrLoop
+	}
+	if hop > MaxHops {
+		return Delegation{}, ErrTooDeep
+	}
+	chain = append(slices.Clone(chain), target.ID)
+	chainJSON, err := json.Marshal(chain)
+	if err != nil {
+		return Delegation{}, err
+	}
+
+	sess, err := s.sessions.Create(ctx, sessionpkg.CreateInput{
+		BotID: target.ID,
+		Type:  sessionpkg.TypeChat,
+		Title: sessionTitle(from, task),
+		Metadata: map[string]any{
+			"delegated_by_bot_id": from.ID,
+			"delegation_hop":      hop,
+		},
+		CreatedByUserID: from.OwnerUserID,
+	})
+	if err != nil {
+		return Delegation{}, fmt.Errorf("create target session: %w", err)
+	}
+
+	params := sqlc.CreateBotDelegationParams{
+		FromBotID:     db.ParseUUIDOrEmpty(from.ID),
+		FromSessionID: db.ParseUUIDOrEmpty(input.FromSessionID),
+		ToBotID:       db.ParseUUIDOrEmpty(target.ID),
+		ToSessionID:   db.ParseUUIDOrEmpty(sess.ID),
+		Task:          task,
+		Hop:           int32(hop), //nolint:gosec // bounded by MaxHops
+		Chain:         chainJSON,
+	}
+	if parent != nil {
+		params.ParentID = db.ParseUUIDOrEmpty(parent.ID)
+	}
+	row, err := s.queries.CreateBotDelegation(ctx, params)
+	if err != nil {
+		return Delegation{}, err
+	}
+	d := fromRow(row)
+	d.ToBotName = botLabel(target)
+	s.auditLog.Record(ctx, audit.Entry{
+		Actor:      audit.Actor{Type: audit.ActorBot, ID: from.ID},
+		Action:     audit.ActionBotDelegate,
+		BotID:      from.ID,
+		TargetType: "bot",
+		TargetID:   target.ID,
+		After:      d,
+	})
+	return d, nil
+}
+
+// Run executes a started delegation as the target bot and records its
+// result, model and token usage.
+func (s *Service) Run(ctx context.Context, d Delegation) (Delegation, error) {
+	if s.runner == nil {
+		return s.complete(ctx, d, RunResult{}, ErrRunnerNotReady)
+	}
+	target, err := s.bots.Get(ctx, d.ToBotID)
+	if err != nil {
+		return s.complete(ctx, d, RunResult{}, err)
+	}
+	from, err := s.bots.Get(ctx, d.FromBotID)
+	if err != nil {
+		return s.complete(ctx, d, RunResult{}, err)
+	}
+	result, runErr := s.runner.RunDelegation(ctx, RunInput{
+		DelegationID: d.ID,
+		BotID:        target.ID,
+		SessionID:    d.ToSessionID,
+		OwnerUserID:  target.OwnerUserID,
+		FromBotID:    from.ID,
+		FromBotName:  botLabel(from),
+		Task:         d.Task,
+		Hop:          d.Hop,
+	})
+	out, err := s.complete(ctx, d, result, runErr)
+	out.ToBotName = d.ToBotName
+	return out, err
+}
+
+// Fail records a started delegation as failed without running it, e.g. when
+// the caller could not schedule the run.
+func (s *Service) Fail(ctx context.Context, d Delegation, reason error) (Delegation, error) {
+	if reason == nil {
+		reason = errors.New("delegation was not run")
+	}
+	out, err := s.complete(ctx, d, RunResult{}, reason)
+	out.ToBotName = d.ToBotName
+	if errors.Is(err, reason) {
+		return out, nil
+	}
+	return out, err
+}
+
+// Get returns a delegation by ID.
+func (s *Service) Get(ctx context.Context, id string) (Delegation, error) {
+	pgID, err := db.ParseUUID(id)
+	if err != nil {
+		return Delegation{}, ErrNotFound
+	}
+	row, err := s.queries.GetBotDelegationByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Delegation{}, ErrNotFound
+		}
+		return Delegation{}, err
+	}
+	return fromRow(row), nil
+}
+
+// ListByBot returns the most recent delegations sent or received by a bot.
+func (s *Service) ListByBot(ctx context.Context, botID string, limit int) ([]Delegation, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	limit = min(limit, maxListLimit)
+	rows, err := s.queries.ListBotDelegationsByBot(ctx, sqlc.ListBotDelegationsByBotParams{
+		BotID:     pgBotID,
+		PageLimit: int32(limit), //nolint:gosec // bounded by maxListLimit
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Delegation, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, fromRow(row))
+	}
+	return items, nil
+}
+
+// parentOf returns the delegation that created sessionID, or nil when the
+// session was not started by another bot.
+func (s *Service) parentOf(ctx context.Context, sessionID string) (*Delegation, error) {
+	pgSessionID := db.ParseUUIDOrEmpty(sessionID)
+	if !pgSessionID.Valid {
+		return nil, nil
+	}
+	row, err := s.queries.GetBotDelegationByToSession(ctx, pgSessionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	parent := fromRow(row)
+	return &parent, nil
+}
+
+func (s *Service) complete(ctx context.Context, d Delegation, result RunResult, runErr error) (Delegation, error) {
+	// Record the outcome even when the run was cancelled.
+	ctx = context.WithoutCancel(ctx)
+	status := StatusCompleted
+	errText := ""
+	if runErr != nil {
+		status = StatusFailed
+		errText = runErr.Error()
+	}
+	usage := result.Usage
+	if usage == nil {
+		usage = map[string]any{}
+	}
+	usageJSON, err := json.Marshal(usage)
+	if err != nil {
+		return d, err
+	}
+	row, err := s.queries.CompleteBotDelegation(ctx, sqlc.CompleteBotDelegationParams{
+		ID:      db.ParseUUIDOrEmpty(d.ID),
+		Status:  status,
+		Result:  strings.TrimSpace(result.Text),
+		Error:   errText,
+		ModelID: db.ParseUUIDOrEmpty(result.ModelID),
+		Usage:   usageJSON,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return d, ErrNotFound
+		}
+		return d, err
+	}
+	return fromRow(row), runErr
+}
+
+func sessionTitle(from bots.Bot, task string) string {
+	title := "Task from " + botLabel(from) + ": " + strings.Join(strings.Fields(task), " ")
+	if runes := []rune(title); len(runes) > sessionTitleMax {
+		title = string(runes[:sessionTitleMax-1]) + "…"
+	}
+	return title
+}
+
+func botLabel(b bots.Bot) string {
+	if name := strings.TrimSpace(b.DisplayName); name != "" {
+		return name
+	}
+	return b.Name
+}
+
+func fromRow(row sqlc.BotDelegation) Delegation {
+	d := Delegation{
+		ID:            uuidString(row.ID),
+		ParentID:      uuidString(row.ParentID),
+		FromBotID:     uuidString(row.FromBotID),
+		FromSessionID: uuidString(row.FromSessionID),
+		ToBotID:       uuidString(row.ToBotID),
+		ToSessionID:   uuidString(row.ToSessionID),
+		Task:          row.Task,
+		Hop:           int(row.Hop),
+		Status:        row.Status,
+		Result:        row.Result,
+		Error:         row.Error,
+		ModelID:       uuidString(row.ModelID),
+		CreatedAt:     db.TimeFromPg(row.CreatedAt),
+	}
+	_ = json.Unmarshal(row.Chain, &d.Chain)
+	if d.Chain == nil {
+		d.Chain = []string{}
+	}
+	var usage map[string]any
+	if err := json.Unmarshal(row.Usage, &usage); err == nil && len(usage) > 0 {
+		d.Usage = usage
+	}
+	if row.CompletedAt.Valid {
+		completedAt := row.CompletedAt.Time
+		d.CompletedAt = &completedAt
+	}
+	return d
+}
+
+func uuidString(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return uuid.UUID(id.Bytes).String()
+}
@@ -0,0 +1,208 @@
+package delegation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+	sessionpkg "github.com/memohai/memoh/internal/session"
+)
+
+const (
+	testOwnerID = "00000000-0000-0000-0000-0000000000d1"
+	testOtherID = "00000000-0000-0000-0000-0000000000d2"
+
+	testBotA = "00000000-0000-0000-0000-0000000000b1"
+	testBotB = "00000000-0000-0000-0000-0000000000b2"
+	testBotC = "00000000-0000-0000-0000-0000000000b3"
+	testBotD = "00000000-0000-0000-0000-0000000000b4"
+	testBotE = "00000000-0000-0000-0000-0000000000b5"
+	testBotF = "00000000-0000-0000-0000-0000000000b6"
+
+	testSessionA = "00000000-0000-0000-0000-0000000000c1"
+)
+
+type fakeRunner struct {
+	inputs []RunInput
+	err    error
+}
+
+func (r *fakeRunner) RunDelegation(_ context.Context, input RunInput) (RunResult, error) {
+	r.inputs = append(r.inputs, input)
+	if r.err != nil {
+		return RunResult{}, r.err
+	}
+	return RunResult{Text: "done: " + input.Task, Usage: map[string]any{"inputTokens": float64(12), "outputTokens": float64(3)}}, nil
+}
+
+func newSQLiteDelegationService(t *testing.T) (*Service, *fakeRunner, *sql.DB) {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('` + testOwnerID + `','owner@example.com','member'),('` + testOtherID + `','other@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES
+			('` + testBotA + `','` + testOwnerID + `','personal','alpha','Alpha'),
+			('` + testBotB + `','` + testOtherID + `','personal','bravo','Bravo'),
+			('` + testBotC + `','` + testOwnerID + `','personal','charlie','Charlie'),
+			('` + testBotD + `','` + testOwnerID + `','personal','delta','Delta'),
+			('` + testBotE + `','` + testOwnerID + `','personal','echo','Echo'),
+			('` + testBotF + `','` + testOwnerID + `','personal','foxtrot','Foxtrot')`,
+		`INSERT INTO bot_sessions(id,bot_id,type) VALUES('` + testSessionA + `','` + testBotA + `','chat')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	queries := sqlitestore.NewQueries(store)
+	runner := &fakeRunner{}
+	svc := NewService(slog.Default(), queries, bots.NewService(slog.Default(), queries), sessionpkg.NewService(slog.Default(), queries))
+	svc.SetRunner(runner)
+	return svc, runner, conn
+}
+
+func TestStartAndRunRecordsHop(t *testing.T) {
+	svc, runner, _ := newSQLiteDelegationService(t)
+	ctx := context.Background()
+
+	d, err := svc.Start(ctx, StartInput{FromBotID: testBotA, FromSessionID: testSessionA, Target: "charlie", Task: "summarize the logs"})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if d.ToBotID != testBotC || d.ToBotName != "Charlie" || d.Hop != 1 || d.Status != StatusRunning || d.ToSessionID == "" {
+		t.Fatalf("started = %+v", d)
+	}
+	if len(d.Chain) != 2 || d.Chain[0] != testBotA || d.Chain[1] != testBotC {
+		t.Fatalf("chain = %v", d.Chain)
+	}
+
+	done, err := svc.Run(ctx, d)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if len(runner.inputs) != 1 || runner.inputs[0].BotID != testBotC || runner.inputs[0].SessionID != d.ToSessionID || runner.inputs[0].FromBotName != "Alpha" {
+		t.Fatalf("runner inputs = %+v", runner.inputs)
+	}
+	if done.Status != StatusCompleted || done.Result != "done: summarize the logs" || done.Usage["inputTokens"] != float64(12) || done.CompletedAt == nil {
+		t.Fatalf("completed = %+v", done)
+	}
+
+	items, err := svc.ListByBot(ctx, testBotC, 0)
+	if err != nil || len(items) != 1 || items[0].ID != d.ID {
+		t.Fatalf("list = %+v, %v", items, err)
+	}
+}
+
+func TestStartRequiresGrant(t *testing.T) {
+	svc, _, conn := newSQLiteDelegationService(t)
+	ctx := context.Background()
+
+	if _, err := svc.Start(ctx, StartInput{FromBotID: testBotA, Target: testBotB, Task: "help"}); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("ungranted err = %v", err)
+	}
+	if _, err := svc.Start(ctx, StartInput{FromBotID: testBotA, Target: testBotA, Task: "help"}); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("self err = %v", err)
+	}
+	if _, err := conn.ExecContext(ctx, `INSERT INTO bot_user_grants(id,bot_id,subject_type,user_id,permissions) VALUES('00000000-0000-0000-0000-0000000000f1','`+testBotB+`','user','`+testOwnerID+`','["chat"]')`); err != nil {
+		t.Fatalf("grant: %v", err)
+	}
+	if _, err := svc.Start(ctx, StartInput{FromBotID: testBotA, Target: testBotB, Task: "help"}); err != nil {
+		t.Fatalf("granted start: %v", err)
+	}
+}
+
+func TestStartRejectsLoopsAndDeepChains(t *testing.T) {
+	svc, _, _ := newSQLiteDelegationService(t)
+	ctx := context.Background()
+
+	ac, err := svc.Start(ctx, StartInput{FromBotID: testBotA, FromSessionID: testSessionA, Target: testBotC, Task: "step"})
+	if err != nil {
+		t.Fatalf("a->c: %v", err)
+	}
+	if _, err := svc.Start(ctx, StartInput{FromBotID: testBotC, FromSessionID: ac.ToSessionID, Target: testBotA, Task: "step"}); !errors.Is(err, ErrLoop) {
+		t.Fatalf("c->a err = %v", err)
+	}
+	cd, err := svc.Start(ctx, StartInput{FromBotID: testBotC, FromSessionID: ac.ToSessionID, Target: testBotD, Task: "step"})
+	if err != nil || cd.Hop != 2 || cd.ParentID != ac.ID {
+		t.Fatalf("c->d = %+v, %v", cd, err)
+	}
+	de, err := svc.Start(ctx, StartInput{FromBotID: testBotD, FromSessionID: cd.ToSessionID, Target: testBotE, Task: "step"})
+	if err != nil || de.Hop != MaxHops {
+		t.Fatalf("d->e = %+v, %v", de, err)
+	}
+	if _, err := svc.Start(ctx, StartInput{FromBotID: testBotE, FromSessionID: de.ToSessionID, Target: "foxtrot", Task: "step"}); !errors.Is(err, ErrTooDeep) {
+		t.Fatalf("e->f err = %v", err)
+	}
+	if _, err := svc.Start(ctx, StartInput{FromBotID: testBotE, FromSessionID: de.ToSessionID, Target: testBotD, Task: "step"}); !errors.Is(err, ErrLoop) {
+		t.Fatalf("e->d err = %v", err)
+	}
+	// A fresh conversation on the last bot starts a new chain.
+	if _, err := svc.Start(ctx, StartInput{FromBotID: testBotE, Target: testBotA, Task: "step"}); err != nil {
+		t.Fatalf("new chain: %v", err)
+	}
+}
+
+func TestRunFailureIsRecorded(t *testing.T) {
+	svc, runner, _ := newSQLiteDelegationService(t)
+	runner.err = errors.New("model unavailable")
+	ctx := context.Background()
+
+	d, err := svc.Start(ctx, StartInput{FromBotID: testBotA, Target: testBotC, Task: "step"})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	done, err := svc.Run(ctx, d)
+	if err == nil || done.Status != StatusFailed || done.Error != "model unavailable" {
+		t.Fatalf("failed run = %+v, %v", done, err)
+	}
+	got, err := svc.Get(ctx, d.ID)
+	if err != nil || got.Status != StatusFailed {
+		t.Fatalf("get = %+v, %v", got, err)
+	}
+}
+
+func TestFailClosesStartedDelegation(t *testing.T) {
+	svc, runner, _ := newSQLiteDelegationService(t)
+	ctx := context.Background()
+
+	d, err := svc.Start(ctx, StartInput{FromBotID: testBotA, Target: testBotC, Task: "step"})
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	failed, err := svc.Fail(ctx, d, errors.New("too many running tasks"))
+	if err != nil || failed.Status != StatusFailed || failed.Error != "too many running tasks" {
+		t.Fatalf("fail = %+v, %v", failed, err)
+	}
+	if len(runner.inputs) != 0 {
+		t.Fatalf("runner called: %+v", runner.inputs)
+	}
+	if _, err := svc.Run(ctx, d); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("run after fail err = %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package delegation
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+// MaxHops bounds how far a task may travel along a delegation chain
+// (A -> B -> C is two hops).
+const MaxHops = 3
+
+var (
+	ErrNotFound       = errors.New("delegation not found")
+	ErrForbidden      = errors.New("bot is not allowed to delegate to the target bot")
+	ErrLoop           = errors.New("target bot is already part of this delegation chain")
+	ErrTooDeep        = errors.New("delegation chain is too deep")
+	ErrTaskRequired   = errors.New("delegation task is required")
+	ErrRunnerNotReady = errors.New("delegation runner not configured")
+)
+
+// Delegation is one hop of a task handed from one bot to another. Chain lists
+// the bot IDs the task has travelled through, ending with the target.
+type Delegation struct {
+	ID            string         `json:"id"`
+	ParentID      string         `json:"parent_id,omitempty"`
+	FromBotID     string         `json:"from_bot_id"`
+	FromSessionID string         `json:"from_session_id,omitempty"`
+	ToBotID       string         `json:"to_bot_id"`
+	ToBotName     string         `json:"to_bot_name,omitempty"`
+	ToSessionID   string         `json:"to_session_id,omitempty"`
+	Task          string         `json:"task"`
+	Hop           int            `json:"hop"`
+	Chain         []string       `json:"chain"`
+	Status        string         `json:"status"`
+	Result        string         `json:"result,omitempty"`
+	Error         string         `json:"error,omitempty"`
+	ModelID       string         `json:"model_id,omitempty"`
+	Usage         map[string]any `json:"usage,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CompletedAt   *time.Time     `json:"completed_at,omitempty"`
+}
+
+type ListResponse struct {
+	Items []Delegation `json:"items"`
+}
+
+// StartInput asks to hand Task from a bot's session to the bot named by
+// Target (UUID or name).
+type StartInput struct {
+	FromBotID     string
+	FromSessionID string
+	Target        string
+	Task          string
+}
+
+// RunInput is what a Runner needs to execute a delegation on the target bot.
+type RunInput struct {
+	DelegationID string
+	BotID        string
+	SessionID    string
+	OwnerUserID  string
+	FromBotID    string
+	FromBotName  string
+	Task         string
+	Hop          int
+}
+
+// RunResult is the target bot's answer and the cost of producing it.
+type RunResult struct {
+	Text    string
+	ModelID string
+	Usage   map[string]any
+}
+
+// Runner executes a delegated task as the target bot, with its own
+// workspace, memory and model settings.
+type Runner interface {
+	RunDelegation(ctx context.Context, input RunInput) (RunResult, error)
+}
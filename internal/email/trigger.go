@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync"
 )
 
 // ChatTriggerer triggers a proactive bot conversation (e.g. when a new email arrives).
@@ -17,6 +18,9 @@ type Trigger struct {
 	logger        *slog.Logger
 	emailService  *Service
 	chatTriggerer ChatTriggerer
+
+	mu        sync.Mutex
+	eventFunc func(ctx context.Context, botID string, mail InboundEmail) // optional callback for event-driven consumers
 }
 
 func NewTrigger(log *slog.Logger, emailService *Service, chatTriggerer ChatTriggerer) *Trigger {
@@ -27,6 +31,14 @@ func NewTrigger(log *slog.Logger, emailService *Service, chatTriggerer ChatTrigg
 	}
 }
 
+// SetEventFunc registers a callback invoked for every bot bound to the
+// receiving mailbox, in addition to the chat trigger. It must not block.
+func (t *Trigger) SetEventFunc(fn func(ctx context.Context, botID string, mail InboundEmail)) {
+	t.mu.Lock()
+	t.eventFunc = fn
+	t.mu.Unlock()
+}
+
 // HandleInbound triggers a conversation for each bound bot so it can process
 // the incoming email.
 func (t *Trigger) HandleInbound(ctx context.Context, providerID string, mail InboundEmail) error {
@@ -41,7 +53,15 @@ func (t *Trigger) HandleInbound(ctx context.Context, providerID string, mail Inb
 		return err
 	}
 
+	t.mu.Lock()
+	eventFn := t.eventFunc
+	t.mu.Unlock()
+
 	for _, binding := range bindings {
+		if eventFn != nil {
+			eventFn(ctx, binding.BotID, mail)
+		}
+
 		content := fmt.Sprintf("New email received at %s from %s — %s", binding.EmailAddress, mail.From, mail.Subject)
 
 		t.logger.Info("bot notified of new email",
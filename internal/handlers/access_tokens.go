@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accesstoken"
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/bots"
+)
+
+// AccessTokenHandler manages the personal access tokens of the current user.
+// The routes declare no scope, so a personal access token can never be used
+// to mint or revoke other tokens.
+type AccessTokenHandler struct {
+	service        *accesstoken.Service
+	botService     *bots.Service
+	accountService *accounts.Service
+	logger         *slog.Logger
+}
+
+func NewAccessTokenHandler(log *slog.Logger, service *accesstoken.Service, botService *bots.Service, accountService *accounts.Service) *AccessTokenHandler {
+	return &AccessTokenHandler{
+		service:        service,
+		botService:     botService,
+		accountService: accountService,
+		logger:         log.With(slog.String("handler", "access_tokens")),
+	}
+}
+
+func (h *AccessTokenHandler) Register(e *echo.Echo) {
+	group := e.Group("/users/me/tokens")
+	group.GET("", h.List)
+	group.POST("", h.Create)
+	group.GET("/scopes", h.ListScopes)
+	group.DELETE("/:id", h.Revoke)
+}
+
+// List godoc
+// @Summary List personal access tokens
+// @Description List the personal access tokens of the current user, including revoked and expired ones
+// @Tags access-tokens
+// @Success 200 {object} accesstoken.ListResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/me/tokens [get].
+func (h *AccessTokenHandler) List(c echo.Context) error {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return err
+	}
+	items, err := h.service.List(c.Request().Context(), userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, accesstoken.ListResponse{Items: items})
+}
+
+// Create godoc
+// @Summary Create personal access token
+// @Description Create a scoped personal access token. The token secret is only returned in this response.
+// @Tags access-tokens
+// @Param payload body accesstoken.CreateRequest true "Token payload"
+// @Success 201 {object} accesstoken.CreateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/me/tokens [post].
+func (h *AccessTokenHandler) Create(c echo.Context) error {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return err
+	}
+	var req accesstoken.CreateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	req.BotID = strings.TrimSpace(req.BotID)
+	if req.BotID != "" {
+		if _, err := AuthorizeBotAccessWithPermission(c.Request().Context(), h.botService, h.accountService, userID, req.BotID, bots.PermissionChat); err != nil {
+			return err
+		}
+	}
+	resp, err := h.service.Create(c.Request().Context(), userID, req)
+	if err != nil {
+		if errors.Is(err, accesstoken.ErrInvalid) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	h.logger.Info("personal access token created",
+		slog.String("user_id", userID),
+		slog.String("token_id", resp.ID),
+		slog.String("scopes", strings.Join(resp.Scopes, " ")))
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// ListScopes godoc
+// @Summary List token scopes
+// @Description List the scopes a personal access token can be granted
+// @Tags access-tokens
+// @Success 200 {object} accesstoken.ScopesResponse
+// @Router /users/me/tokens/scopes [get].
+func (*AccessTokenHandler) ListScopes(c echo.Context) error {
+	return c.JSON(http.StatusOK, accesstoken.ScopesResponse{Scopes: auth.Scopes})
+}
+
+// Revoke godoc
+// @Summary Revoke personal access token
+// @Description Revoke a personal access token of the current user. Requests using it fail immediately.
+// @Tags access-tokens
+// @Param id path string true "Token ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/me/tokens/{id} [delete].
+func (h *AccessTokenHandler) Revoke(c echo.Context) error {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return err
+	}
+	id := strings.TrimSpace(c.Param("id"))
+	if err := h.service.Revoke(c.Request().Context(), userID, id); err != nil {
+		if errors.Is(err, accesstoken.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "token not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	h.logger.Info("personal access token revoked", slog.String("user_id", userID), slog.String("token_id", id))
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/accesstoken"
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+)
+
+const accessTokenTestUserID = "00000000-0000-0000-0000-0000000000e1"
+
+func newAccessTokenTestServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	if _, err := conn.ExecContext(ctx, `INSERT INTO users(id,email,role) VALUES('`+accessTokenTestUserID+`','ci@example.com','member')`); err != nil {
+		t.Fatalf("seed user: %v", err)
+	}
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+
+	service := accesstoken.NewService(slog.Default(), sqlitestore.NewQueries(store))
+	h := NewAccessTokenHandler(slog.Default(), service, nil, accounts.NewService(slog.Default(), store))
+	e := echo.New()
+	e.Use(auth.PersonalTokenMiddleware(service, nil))
+	e.Use(auth.JWTMiddleware("test-secret", nil))
+	h.Register(e)
+	return e
+}
+
+func doAuthed(t *testing.T, e *echo.Echo, method, path, bearer, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+bearer)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAccessTokenLifecycle(t *testing.T) {
+	e := newAccessTokenTestServer(t)
+	session, _, err := auth.GenerateToken(accessTokenTestUserID, "test-secret", time.Hour)
+	if err != nil {
+		t.Fatalf("generate session token: %v", err)
+	}
+
+	rec := doAuthed(t, e, http.MethodPost, "/users/me/tokens", session, `{"name":"ci","scopes":["bots:chat"],"expires_in_days":90}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var created accesstoken.CreateResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("decode create: %v", err)
+	}
+	if !auth.IsPersonalToken(created.Secret) || created.ExpiresAt == nil {
+		t.Fatalf("created = %+v", created)
+	}
+
+	// A personal access token cannot manage tokens, whatever its scopes.
+	if rec := doAuthed(t, e, http.MethodPost, "/users/me/tokens", created.Secret, `{"name":"x","scopes":["bots:read"]}`); rec.Code != http.StatusForbidden {
+		t.Fatalf("create with token status = %d, want 403", rec.Code)
+	}
+
+	rec = doAuthed(t, e, http.MethodGet, "/users/me/tokens", session, "")
+	if rec.Code != http.StatusOK || strings.Contains(rec.Body.String(), created.Secret) || !strings.Contains(rec.Body.String(), created.Prefix) {
+		t.Fatalf("list = %d %s", rec.Code, rec.Body.String())
+	}
+
+	if rec := doAuthed(t, e, http.MethodPost, "/users/me/tokens", session, `{"name":"bad","scopes":["root"]}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("create with unknown scope status = %d, want 400", rec.Code)
+	}
+
+	if rec := doAuthed(t, e, http.MethodDelete, "/users/me/tokens/"+created.ID, session, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("revoke status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if rec := doAuthed(t, e, http.MethodGet, "/users/me/tokens", created.Secret, ""); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("revoked token status = %d, want 401", rec.Code)
+	}
+	if rec := doAuthed(t, e, http.MethodDelete, "/users/me/tokens/"+created.ID, session, ""); rec.Code != http.StatusNotFound {
+		t.Fatalf("second revoke status = %d, want 404", rec.Code)
+	}
+}
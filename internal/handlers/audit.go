@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/audit"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/bots"
+)
+
+// AuditHandler serves the audit log. Admins can read every entry; anyone
+// who can manage a bot can read the entries of that bot.
+type AuditHandler struct {
+	service        *audit.Service
+	botService     *bots.Service
+	accountService *accounts.Service
+	logger         *slog.Logger
+}
+
+func NewAuditHandler(log *slog.Logger, service *audit.Service, botService *bots.Service, accountService *accounts.Service) *AuditHandler {
+	return &AuditHandler{
+		service:        service,
+		botService:     botService,
+		accountService: accountService,
+		logger:         log.With(slog.String("handler", "audit")),
+	}
+}
+
+func (h *AuditHandler) Register(e *echo.Echo) {
+	read := auth.RequireScope(auth.ScopeAuditRead)
+	e.GET("/audit-logs", h.List, read)
+	e.GET("/audit-logs/export", h.Export, read)
+
+	botRead := auth.RequireBotScope(auth.ScopeAuditRead, "bot_id")
+	botGroup := e.Group("/bots/:bot_id/audit-logs")
+	botGroup.GET("", h.ListBot, botRead)
+	botGroup.GET("/export", h.ExportBot, botRead)
+}
+
+// List godoc
+// @Summary List audit logs
+// @Description List audit log entries across all bots, newest first (admin only)
+// @Tags audit
+// @Param bot_id query string false "Bot ID"
+// @Param actor_type query string false "Actor type (user, bot, schedule, channel_identity, system)"
+// @Param actor_id query string false "Actor ID"
+// @Param action query string false "Action, or a dotted prefix such as acl or mcp.connection"
+// @Param target_type query string false "Target type"
+// @Param target_id query string false "Target ID"
+// @Param since query string false "Earliest entry time (RFC3339, inclusive)"
+// @Param until query string false "Latest entry time (RFC3339, exclusive)"
+// @Param limit query int false "Page size (default 50, max 500)"
+// @Param offset query int false "Offset"
+// @Success 200 {object} audit.ListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /audit-logs [get].
+func (h *AuditHandler) List(c echo.Context) error {
+	if err := h.requireAdmin(c); err != nil {
+		return err
+	}
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		return err
+	}
+	return h.list(c, filter)
+}
+
+// Export godoc
+// @Summary Export audit logs
+// @Description Download every audit log entry matching the filters as JSON Lines, newest first (admin only)
+// @Tags audit
+// @Produce application/x-ndjson
+// @Param bot_id query string false "Bot ID"
+// @Param actor_type query string false "Actor type (user, bot, schedule, channel_identity, system)"
+// @Param actor_id query string false "Actor ID"
+// @Param action query string false "Action, or a dotted prefix such as acl or mcp.connection"
+// @Param target_type query string false "Target type"
+// @Param target_id query string false "Target ID"
+// @Param since query string false "Earliest entry time (RFC3339, inclusive)"
+// @Param until query string false "Latest entry time (RFC3339, exclusive)"
+// @Success 200 {file} binary
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /audit-logs/export [get].
+func (h *AuditHandler) Export(c echo.Context) error {
+	if err := h.requireAdmin(c); err != nil {
+		return err
+	}
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		return err
+	}
+	return h.export(c, filter)
+}
+
+// ListBot godoc
+// @Summary List bot audit logs
+// @Description List audit log entries of a bot, newest first
+// @Tags audit
+// @Param bot_id path string true "Bot ID"
+// @Param actor_type query string false "Actor type (user, bot, schedule, channel_identity, system)"
+// @Param actor_id query string false "Actor ID"
+// @Param action query string false "Action, or a dotted prefix such as acl or mcp.connection"
+// @Param target_type query string false "Target type"
+// @Param target_id query string false "Target ID"
+// @Param since query string false "Earliest entry time (RFC3339, inclusive)"
+// @Param until query string false "Latest entry time (RFC3339, exclusive)"
+// @Param limit query int false "Page size (default 50, max 500)"
+// @Param offset query int false "Offset"
+// @Success 200 {object} audit.ListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/audit-logs [get].
+func (h *AuditHandler) ListBot(c echo.Context) error {
+	filter, err := h.botFilter(c)
+	if err != nil {
+		return err
+	}
+	return h.list(c, filter)
+}
+
+// ExportBot godoc
+// @Summary Export bot audit logs
+// @Description Download every audit log entry of a bot matching the filters as JSON Lines, newest first
+// @Tags audit
+// @Produce application/x-ndjson
+// @Param bot_id path string true "Bot ID"
+// @Param actor_type query string false "Actor type (user, bot, schedule, channel_identity, system)"
+// @Param actor_id query string false "Actor ID"
+// @Param action query string false "Action, or a dotted prefix such as acl or mcp.connection"
+// @Param target_type query string false "Target type"
+// @Param target_id query string false "Target ID"
+// @Param since query string false "Earliest entry time (RFC3339, inclusive)"
+// @Param until query string false "Latest entry time (RFC3339, exclusive)"
+// @Success 200 {file} binary
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/audit-logs/export [get].
+func (h *AuditHandler) ExportBot(c echo.Context) error {
+	filter, err := h.botFilter(c)
+	if err != nil {
+		return err
+	}
+	return h.export(c, filter)
+}
+
+func (h *AuditHandler) list(c echo.Context, filter audit.Filter) error {
+	filter.Limit, filter.Offset = parseOffsetLimit(c)
+	resp, err := h.service.List(c.Request().Context(), filter)
+	if err != nil {
+		return auditHTTPError(err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// export streams the entries. Filter errors are reported before anything is
+// written; a failure mid-stream can only truncate the download.
+func (h *AuditHandler) export(c echo.Context, filter audit.Filter) error {
+	if err := filter.Validate(); err != nil {
+		return auditHTTPError(err)
+	}
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	res.Header().Set(echo.HeaderContentDisposition, `attachment; filename="audit-logs.jsonl"`)
+	res.WriteHeader(http.StatusOK)
+	if err := h.service.Export(c.Request().Context(), filter, res); err != nil {
+		h.logger.Error("export audit logs failed", slog.Any("error", err))
+	}
+	return nil
+}
+
+// botFilter authorizes the caller to manage the bot in the path and returns
+// the query filter scoped to it.
+func (h *AuditHandler) botFilter(c echo.Context) (audit.Filter, error) {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return audit.Filter{}, err
+	}
+	botID := strings.TrimSpace(c.Param("bot_id"))
+	if botID == "" {
+		return audit.Filter{}, echo.NewHTTPError(http.StatusBadRequest, "bot id is required")
+	}
+	if _, err := AuthorizeBotAccess(c.Request().Context(), h.botService, h.accountService, userID, botID); err != nil {
+		return audit.Filter{}, err
+	}
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		return audit.Filter{}, err
+	}
+	filter.BotID = botID
+	return filter, nil
+}
+
+func (h *AuditHandler) requireAdmin(c echo.Context) error {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return err
+	}
+	isAdmin, err := h.accountService.IsAdmin(c.Request().Context(), userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if !isAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "admin role required")
+	}
+	return nil
+}
+
+func parseAuditFilter(c echo.Context) (audit.Filter, error) {
+	filter := audit.Filter{
+		BotID:      strings.TrimSpace(c.QueryParam("bot_id")),
+		ActorType:  strings.TrimSpace(c.QueryParam("actor_type")),
+		ActorID:    strings.TrimSpace(c.QueryParam("actor_id")),
+		Action:     strings.TrimSpace(c.QueryParam("action")),
+		TargetType: strings.TrimSpace(c.QueryParam("target_type")),
+		TargetID:   strings.TrimSpace(c.QueryParam("target_id")),
+	}
+	var err error
+	if filter.Since, err = parseAuditTime(c.QueryParam("since")); err != nil {
+		return audit.Filter{}, echo.NewHTTPError(http.StatusBadRequest, "invalid since parameter")
+	}
+	if filter.Until, err = parseAuditTime(c.QueryParam("until")); err != nil {
+		return audit.Filter{}, echo.NewHTTPError(http.StatusBadRequest, "invalid until parameter")
+	}
+	return filter, nil
+}
+
+func parseAuditTime(raw string) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, raw)
+}
+
+func auditHTTPError(err error) error {
+	if errors.Is(err, audit.ErrInvalidFilter) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
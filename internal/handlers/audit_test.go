@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/acl"
+	"github.com/memohai/memoh/internal/audit"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+)
+
+const (
+	auditTestAdminID = "00000000-0000-0000-0000-0000000000a1"
+	auditTestOwnerID = "00000000-0000-0000-0000-0000000000a2"
+	auditTestBotID   = "00000000-0000-0000-0000-0000000000a3"
+)
+
+func newAuditTestServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('` + auditTestAdminID + `','admin@example.com','admin')`,
+		`INSERT INTO users(id,email,role) VALUES('` + auditTestOwnerID + `','owner@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES('` + auditTestBotID + `','` + auditTestOwnerID + `','personal','auditbot','Audit Bot')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	queries := sqlitestore.NewQueries(store)
+
+	auditService := audit.NewService(slog.Default(), queries)
+	aclService := acl.NewService(slog.Default(), queries)
+	aclService.SetAuditLog(auditService)
+
+	e := echo.New()
+	e.Use(auth.JWTMiddleware("test-secret", nil))
+	e.Use(audit.Middleware())
+	e.PUT("/bots/:bot_id/acl/default-effect", func(c echo.Context) error {
+		if err := aclService.SetDefaultEffect(c.Request().Context(), c.Param("bot_id"), acl.EffectDeny); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+	NewAuditHandler(slog.Default(), auditService, bots.NewService(nil, queries), accounts.NewService(slog.Default(), store)).Register(e)
+	return e
+}
+
+func TestAuditLogEndpoints(t *testing.T) {
+	e := newAuditTestServer(t)
+	session := func(userID string) string {
+		token, _, err := auth.GenerateToken(userID, "test-secret", time.Hour)
+		if err != nil {
+			t.Fatalf("generate token: %v", err)
+		}
+		return token
+	}
+	owner, admin := session(auditTestOwnerID), session(auditTestAdminID)
+
+	rec := doAuthed(t, e, http.MethodPut, "/bots/"+auditTestBotID+"/acl/default-effect", owner, "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("set default effect status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	requestID := rec.Header().Get(echo.HeaderXRequestID)
+	if requestID == "" {
+		t.Fatal("missing request id header")
+	}
+
+	rec = doAuthed(t, e, http.MethodGet, "/bots/"+auditTestBotID+"/audit-logs?action=acl", owner, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("bot list status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var list audit.ListResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	if list.Total != 1 {
+		t.Fatalf("list = %+v", list)
+	}
+	item := list.Items[0]
+	if item.Action != audit.ActionACLDefaultEffect || item.Actor != (audit.Actor{Type: audit.ActorUser, ID: auditTestOwnerID}) {
+		t.Fatalf("item = %+v", item)
+	}
+	if item.Metadata["request_id"] != requestID || item.Metadata["route"] != "/bots/:bot_id/acl/default-effect" {
+		t.Fatalf("metadata = %+v", item.Metadata)
+	}
+
+	// Only admins can read across bots.
+	if rec := doAuthed(t, e, http.MethodGet, "/audit-logs", owner, ""); rec.Code != http.StatusForbidden {
+		t.Fatalf("member global list status = %d, want 403", rec.Code)
+	}
+	if rec := doAuthed(t, e, http.MethodGet, "/audit-logs?actor_type=robot", admin, ""); rec.Code != http.StatusBadRequest {
+		t.Fatalf("invalid filter status = %d, want 400", rec.Code)
+	}
+	if rec := doAuthed(t, e, http.MethodGet, "/audit-logs?since=yesterday", admin, ""); rec.Code != http.StatusBadRequest {
+		t.Fatalf("invalid since status = %d, want 400", rec.Code)
+	}
+
+	for query, want := range map[string]int64{
+		"since=2000-01-01T00:00:00Z":                            1,
+		"until=2000-01-01T00:00:00Z":                            0,
+		"since=2000-01-01T00:00:00Z&until=2999-01-01T00:00:00Z": 1,
+	} {
+		rec := doAuthed(t, e, http.MethodGet, "/audit-logs?"+query, admin, "")
+		var got audit.ListResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || rec.Code != http.StatusOK || got.Total != want {
+			t.Fatalf("%s = %d %s, want total %d", query, rec.Code, rec.Body.String(), want)
+		}
+	}
+
+	rec = doAuthed(t, e, http.MethodGet, "/audit-logs/export?bot_id="+auditTestBotID, admin, "")
+	if rec.Code != http.StatusOK || rec.Header().Get(echo.HeaderContentType) != "application/x-ndjson" {
+		t.Fatalf("export = %d %q", rec.Code, rec.Header().Get(echo.HeaderContentType))
+	}
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	if len(lines) != 1 || !strings.Contains(lines[0], `"action":"`+audit.ActionACLDefaultEffect+`"`) {
+		t.Fatalf("export body = %q", rec.Body.String())
+	}
+}
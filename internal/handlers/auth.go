@@ -11,10 +11,12 @@ import (
 
 	"github.com/memohai/memoh/internal/accounts"
 	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/oidc"
 )
 
 type AuthHandler struct {
 	accountService *accounts.Service
+	oidcProvider   *oidc.Provider
 	jwtSecret      string
 	expiresIn      time.Duration
 	logger         *slog.Logger
@@ -45,9 +47,18 @@ func NewAuthHandler(log *slog.Logger, accountService *accounts.Service, jwtSecre
 	}
 }
 
+// SetOIDCProvider enables single sign-on. A nil provider keeps local
+// username/password login only.
+func (h *AuthHandler) SetOIDCProvider(provider *oidc.Provider) {
+	h.oidcProvider = provider
+}
+
 func (h *AuthHandler) Register(e *echo.Echo) {
 	e.POST("/auth/login", h.Login)
 	e.POST("/auth/refresh", h.Refresh)
+	e.GET("/auth/oidc", h.OIDCConfig)
+	e.POST("/auth/oidc/authorize", h.OIDCAuthorize)
+	e.POST("/auth/oidc/exchange", h.OIDCExchange)
 }
 
 // Login godoc
@@ -90,6 +101,10 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
+	return h.issueLogin(c, account)
+}
+
+func (h *AuthHandler) issueLogin(c echo.Context, account accounts.Account) error {
 	token, expiresAt, err := auth.GenerateToken(account.ID, h.jwtSecret, h.expiresIn)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/oidc"
+)
+
+type OIDCConfigResponse struct {
+	Enabled     bool   `json:"enabled"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+type OIDCAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+type OIDCExchangeRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+// OIDCConfig godoc
+// @Summary Get SSO configuration
+// @Description Report whether OpenID Connect single sign-on is enabled, for the login page
+// @Tags auth
+// @Success 200 {object} OIDCConfigResponse
+// @Router /auth/oidc [get].
+func (h *AuthHandler) OIDCConfig(c echo.Context) error {
+	if h.oidcProvider == nil {
+		return c.JSON(http.StatusOK, OIDCConfigResponse{Enabled: false})
+	}
+	return c.JSON(http.StatusOK, OIDCConfigResponse{
+		Enabled:     true,
+		DisplayName: h.oidcProvider.Config().DisplayNameOrDefault(),
+	})
+}
+
+// OIDCAuthorize godoc
+// @Summary Start SSO login
+// @Description Start an OpenID Connect authorization code login with PKCE and return the identity provider URL to redirect the browser to
+// @Tags auth
+// @Success 200 {object} OIDCAuthorizeResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Router /auth/oidc/authorize [post].
+func (h *AuthHandler) OIDCAuthorize(c echo.Context) error {
+	if h.oidcProvider == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "sso is not enabled")
+	}
+	req, err := h.oidcProvider.Start(c.Request().Context())
+	if err != nil {
+		h.logger.Error("start sso login failed", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusBadGateway, "identity provider unavailable")
+	}
+	return c.JSON(http.StatusOK, OIDCAuthorizeResponse{
+		AuthorizationURL: req.URL,
+		State:            req.State,
+	})
+}
+
+// OIDCExchange godoc
+// @Summary Complete SSO login
+// @Description Redeem the authorization code returned by the identity provider, validate the ID token, provision or resolve the account and issue a JWT
+// @Tags auth
+// @Param payload body OIDCExchangeRequest true "Authorization response"
+// @Success 200 {object} LoginResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/oidc/exchange [post].
+func (h *AuthHandler) OIDCExchange(c echo.Context) error {
+	if h.oidcProvider == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "sso is not enabled")
+	}
+	if h.accountService == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "user service not configured")
+	}
+	if strings.TrimSpace(h.jwtSecret) == "" {
+		return echo.NewHTTPError(http.StatusInternalServerError, "jwt secret not configured")
+	}
+
+	var req OIDCExchangeRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if strings.TrimSpace(req.Code) == "" || strings.TrimSpace(req.State) == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "code and state are required")
+	}
+
+	ctx := c.Request().Context()
+	identity, err := h.oidcProvider.Exchange(ctx, req.State, req.Code)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidState) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		h.logger.Warn("sso login rejected", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusUnauthorized, "sso login failed")
+	}
+	role, err := h.oidcProvider.Role(identity)
+	if err != nil {
+		h.logger.Info("sso login refused by group policy", slog.String("subject", identity.Subject))
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+
+	cfg := h.oidcProvider.Config()
+	account, err := h.accountService.LoginExternal(ctx, accounts.ExternalIdentity{
+		Issuer:        identity.Issuer,
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		EmailVerified: identity.EmailVerified,
+		Username:      identity.Username,
+		DisplayName:   identity.Name,
+		AvatarURL:     identity.Picture,
+		Role:          role,
+	}, accounts.ExternalLoginOptions{
+		Provision:   !cfg.DisableProvisioning,
+		LinkByEmail: cfg.LinkByEmail,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, accounts.ErrInactiveAccount):
+			return echo.NewHTTPError(http.StatusUnauthorized, "user is inactive")
+		case errors.Is(err, accounts.ErrExternalIdentityNotLinked):
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case errors.Is(err, accounts.ErrEmailInUse):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	return h.issueLogin(c, account)
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+	"github.com/memohai/memoh/internal/oidc"
+	"github.com/memohai/memoh/internal/oidc/oidctest"
+)
+
+func newOIDCTestServer(t *testing.T, issuer *oidctest.Issuer, mutate func(*config.OIDCConfig)) *echo.Echo {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+
+	cfg := config.OIDCConfig{
+		Enabled:       true,
+		DisplayName:   "Corp SSO",
+		Issuer:        issuer.URL,
+		ClientID:      issuer.ClientID,
+		RedirectURL:   "https://memoh.example.com/oauth/oidc/callback",
+		AdminGroups:   []string{"admins"},
+		AllowedGroups: []string{"staff"},
+	}
+	if mutate != nil {
+		mutate(&cfg)
+	}
+	provider, err := oidc.NewProvider(slog.Default(), cfg, issuer.Client())
+	if err != nil {
+		t.Fatalf("oidc provider: %v", err)
+	}
+	h := NewAuthHandler(slog.Default(), accounts.NewService(slog.Default(), store), "test-secret", time.Hour)
+	h.SetOIDCProvider(provider)
+	e := echo.New()
+	h.Register(e)
+	return e
+}
+
+func doJSON(t *testing.T, e *echo.Echo, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func oidcLogin(t *testing.T, e *echo.Echo, issuer *oidctest.Issuer) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := doJSON(t, e, http.MethodPost, "/auth/oidc/authorize", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("authorize status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var start OIDCAuthorizeResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &start); err != nil {
+		t.Fatalf("decode authorize: %v", err)
+	}
+	code, state := issuer.Login(t, start.AuthorizationURL)
+	body, _ := json.Marshal(OIDCExchangeRequest{Code: code, State: state})
+	return doJSON(t, e, http.MethodPost, "/auth/oidc/exchange", string(body))
+}
+
+func TestOIDCLoginProvisionsAdmin(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	issuer.SetUser(map[string]any{
+		"sub":                "carol-sub",
+		"email":              "carol@example.com",
+		"email_verified":     true,
+		"preferred_username": "carol",
+		"name":               "Carol",
+		"groups":             []any{"staff", "admins"},
+	})
+	e := newOIDCTestServer(t, issuer, nil)
+
+	rec := doJSON(t, e, http.MethodGet, "/auth/oidc", "")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"display_name":"Corp SSO"`) {
+		t.Fatalf("config = %d %s", rec.Code, rec.Body.String())
+	}
+
+	rec = oidcLogin(t, e, issuer)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("exchange status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var login LoginResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &login); err != nil {
+		t.Fatalf("decode login: %v", err)
+	}
+	if login.AccessToken == "" || login.Role != "admin" || login.Username != "carol" || login.DisplayName != "Carol" {
+		t.Fatalf("login = %+v", login)
+	}
+}
+
+func TestOIDCLoginRefusesUserOutsideAllowedGroups(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	issuer.SetUser(map[string]any{"sub": "mallory", "groups": []any{"contractors"}})
+	e := newOIDCTestServer(t, issuer, nil)
+
+	if rec := oidcLogin(t, e, issuer); rec.Code != http.StatusForbidden {
+		t.Fatalf("exchange status = %d, want 403; body = %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestOIDCLoginWithoutProvisioning(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	issuer.SetUser(map[string]any{"sub": "dave", "groups": []any{"staff"}})
+	e := newOIDCTestServer(t, issuer, func(cfg *config.OIDCConfig) { cfg.DisableProvisioning = true })
+
+	if rec := oidcLogin(t, e, issuer); rec.Code != http.StatusForbidden {
+		t.Fatalf("exchange status = %d, want 403; body = %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestOIDCExchangeRejectsForgedState(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	e := newOIDCTestServer(t, issuer, nil)
+
+	rec := doJSON(t, e, http.MethodPost, "/auth/oidc/exchange", `{"code":"abc","state":"forged"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("exchange status = %d, want 400", rec.Code)
+	}
+}
+
+func TestOIDCDisabled(t *testing.T) {
+	h := NewAuthHandler(slog.Default(), nil, "test-secret", time.Hour)
+	e := echo.New()
+	h.Register(e)
+
+	rec := doJSON(t, e, http.MethodGet, "/auth/oidc", "")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"enabled":false`) {
+		t.Fatalf("config = %d %s", rec.Code, rec.Body.String())
+	}
+	if rec := doJSON(t, e, http.MethodPost, "/auth/oidc/authorize", ""); rec.Code != http.StatusNotFound {
+		t.Fatalf("authorize status = %d, want 404", rec.Code)
+	}
+}
@@ -0,0 +1,347 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/botbackup"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/bottemplate"
+)
+
+type BotTemplateHandler struct {
+	service        *bottemplate.Service
+	botService     *bots.Service
+	accountService *accounts.Service
+}
+
+func NewBotTemplateHandler(service *bottemplate.Service, botService *bots.Service, accountService *accounts.Service) *BotTemplateHandler {
+	return &BotTemplateHandler{service: service, botService: botService, accountService: accountService}
+}
+
+func (h *BotTemplateHandler) Register(e *echo.Echo) {
+	read := auth.RequireScope(auth.ScopeBotsRead)
+	// Templates are not tied to one bot, so bot-restricted tokens may only
+	// read them.
+	write := auth.RequireUnrestrictedScope(auth.ScopeBotsWrite)
+	group := e.Group("/bot-templates")
+	group.GET("", h.List, read)
+	group.POST("", h.Create, write)
+	group.POST("/import", h.Import, write)
+	group.GET("/:id", h.Get, read)
+	group.PUT("/:id", h.Update, write)
+	group.DELETE("/:id", h.Delete, write)
+	group.GET("/:id/bundle", h.Download, read)
+	group.POST("/:id/bots", h.Instantiate, write)
+}
+
+// List godoc
+// @Summary List bot templates
+// @Description List the bot templates new bots can be created from: the caller's own and the shared ones. Admins see every template.
+// @Tags bot-templates
+// @Success 200 {object} bottemplate.ListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bot-templates [get].
+func (h *BotTemplateHandler) List(c echo.Context) error {
+	userID, err := auth.UserIDFromContext(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	isAdmin, err := h.accountService.IsAdmin(c.Request().Context(), userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	var items []bottemplate.Template
+	if isAdmin {
+		items, err = h.service.List(c.Request().Context())
+	} else {
+		items, err = h.service.ListVisible(c.Request().Context(), userID)
+	}
+	if err != nil {
+		return botTemplateHTTPError(err)
+	}
+	return c.JSON(http.StatusOK, bottemplate.ListResponse{Items: items})
+}
+
+// Create godoc
+// @Summary Create bot template from bot
+// @Description Capture the settings, MCP connections, schedules and /data of a bot as a template. Model choices and credentials are left out.
+// @Tags bot-templates
+// @Param payload body bottemplate.CreateRequest true "Template payload"
+// @Success 201 {object} bottemplate.Template
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bot-templates [post].
+func (h *BotTemplateHandler) Create(c echo.Context) error {
+	userID, err := auth.UserIDFromContext(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	var req bottemplate.CreateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	req.BotID = strings.TrimSpace(req.BotID)
+	if req.BotID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "bot_id is required")
+	}
+	if _, err := AuthorizeBotAccess(c.Request().Context(), h.botService, h.accountService, userID, req.BotID); err != nil {
+		return err
+	}
+	tpl, err := h.service.CreateFromBot(c.Request().Context(), userID, req)
+	if err != nil {
+		return botTemplateHTTPError(err)
+	}
+	return c.JSON(http.StatusCreated, tpl)
+}
+
+// Import godoc
+// @Summary Import bot template
+// @Description Store a bot backup bundle as a template. Sections other than settings, MCP, schedules and workspace are dropped, as are credentials.
+// @Tags bot-templates
+// @Accept multipart/form-data
+// @Param file formData file true "Bot backup bundle (.memoh.zip)"
+// @Param name formData string true "Template name"
+// @Param description formData string false "Template description"
+// @Param image formData string false "Workspace image reference"
+// @Param shared formData boolean false "Share the template with every user"
+// @Success 201 {object} bottemplate.Template
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bot-templates/import [post].
+func (h *BotTemplateHandler) Import(c echo.Context) error {
+	userID, err := auth.UserIDFromContext(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	shared := false
+	if value := strings.TrimSpace(c.FormValue("shared")); value != "" {
+		if shared, err = strconv.ParseBool(value); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "shared must be a boolean")
+		}
+	}
+	raw, err := readUploadedBackup(c)
+	if err != nil {
+		return err
+	}
+	tpl, err := h.service.Upload(c.Request().Context(), userID, bottemplate.UploadRequest{
+		Name:        c.FormValue("name"),
+		Description: c.FormValue("description"),
+		Image:       c.FormValue("image"),
+		Shared:      shared,
+	}, raw)
+	if err != nil {
+		return botTemplateHTTPError(err)
+	}
+	return c.JSON(http.StatusCreated, tpl)
+}
+
+// Get godoc
+// @Summary Get bot template
+// @Description Get a template the caller created, a shared one, or any template for admins.
+// @Tags bot-templates
+// @Param id path string true "Template ID or name"
+// @Success 200 {object} bottemplate.Template
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bot-templates/{id} [get].
+func (h *BotTemplateHandler) Get(c echo.Context) error {
+	tpl, err := h.loadVisibleTemplate(c)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, tpl)
+}
+
+// Update godoc
+// @Summary Update bot template
+// @Description Rename a template, change its description or workspace image, or share it with every user. Only the creator or an admin may change a template.
+// @Tags bot-templates
+// @Param id path string true "Template ID or name"
+// @Param payload body bottemplate.UpdateRequest true "Template changes"
+// @Success 200 {object} bottemplate.Template
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bot-templates/{id} [put].
+func (h *BotTemplateHandler) Update(c echo.Context) error {
+	tpl, err := h.loadOwnedTemplate(c)
+	if err != nil {
+		return err
+	}
+	var req bottemplate.UpdateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	updated, err := h.service.Update(c.Request().Context(), tpl.ID, req)
+	if err != nil {
+		return botTemplateHTTPError(err)
+	}
+	return c.JSON(http.StatusOK, updated)
+}
+
+// Delete godoc
+// @Summary Delete bot template
+// @Description Delete a template. Bots created from it are not affected. Only the creator or an admin may delete a template.
+// @Tags bot-templates
+// @Param id path string true "Template ID or name"
+// @Success 204 "No Content"
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bot-templates/{id} [delete].
+func (h *BotTemplateHandler) Delete(c echo.Context) error {
+	tpl, err := h.loadOwnedTemplate(c)
+	if err != nil {
+		return err
+	}
+	if err := h.service.Delete(c.Request().Context(), tpl.ID); err != nil {
+		return botTemplateHTTPError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Download godoc
+// @Summary Download bot template bundle
+// @Description Download the template as a bot backup bundle. Only templates visible to the caller can be downloaded.
+// @Tags bot-templates
+// @Produce application/zip
+// @Param id path string true "Template ID or name"
+// @Success 200 {file} file
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bot-templates/{id}/bundle [get].
+func (h *BotTemplateHandler) Download(c echo.Context) error {
+	visible, err := h.loadVisibleTemplate(c)
+	if err != nil {
+		return err
+	}
+	tpl, rc, err := h.service.Bundle(c.Request().Context(), visible.ID)
+	if err != nil {
+		return botTemplateHTTPError(err)
+	}
+	defer func() { _ = rc.Close() }()
+	filename := "template-" + safeFilename(tpl.Name, tpl.ID) + ".memoh.zip"
+	c.Response().Header().Set(echo.HeaderContentType, "application/zip")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="`+filename+`"`)
+	if tpl.SizeBytes > 0 {
+		c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(tpl.SizeBytes, 10))
+	}
+	c.Response().WriteHeader(http.StatusOK)
+	_, err = io.Copy(c.Response(), rc)
+	return err
+}
+
+// Instantiate godoc
+// @Summary Create bot from template
+// @Description Create a bot owned by the current user from a template. Only templates visible to the caller can be used. Empty fields of the payload fall back to the template; the template image applies unless the metadata picks one.
+// @Tags bot-templates
+// @Param id path string true "Template ID or name"
+// @Param payload body bots.CreateBotRequest true "Bot payload"
+// @Success 201 {object} bottemplate.InstantiateResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bot-templates/{id}/bots [post].
+func (h *BotTemplateHandler) Instantiate(c echo.Context) error {
+	userID, err := auth.UserIDFromContext(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	var req bots.CreateBotRequest
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(&req); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+	tpl, err := h.loadVisibleTemplate(c)
+	if err != nil {
+		return err
+	}
+	result, err := h.service.Instantiate(c.Request().Context(), userID, tpl.ID, req)
+	if err != nil {
+		return botTemplateHTTPError(err)
+	}
+	return c.JSON(http.StatusCreated, result)
+}
+
+// loadVisibleTemplate resolves the :id template and requires it to be shared,
+// created by the caller, or the caller to be an admin. Templates the caller
+// cannot see are reported as not found.
+func (h *BotTemplateHandler) loadVisibleTemplate(c echo.Context) (bottemplate.Template, error) {
+	userID, err := auth.UserIDFromContext(c)
+	if err != nil {
+		return bottemplate.Template{}, echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	tpl, err := h.service.Get(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return bottemplate.Template{}, botTemplateHTTPError(err)
+	}
+	if tpl.VisibleTo(userID) {
+		return tpl, nil
+	}
+	isAdmin, err := h.accountService.IsAdmin(c.Request().Context(), userID)
+	if err != nil {
+		return bottemplate.Template{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if !isAdmin {
+		return bottemplate.Template{}, botTemplateHTTPError(bottemplate.ErrNotFound)
+	}
+	return tpl, nil
+}
+
+// loadOwnedTemplate resolves the :id template and requires the caller to be
+// its creator or an admin.
+func (h *BotTemplateHandler) loadOwnedTemplate(c echo.Context) (bottemplate.Template, error) {
+	userID, err := auth.UserIDFromContext(c)
+	if err != nil {
+		return bottemplate.Template{}, echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	tpl, err := h.service.Get(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return bottemplate.Template{}, botTemplateHTTPError(err)
+	}
+	if tpl.CreatedByUserID == userID {
+		return tpl, nil
+	}
+	isAdmin, err := h.accountService.IsAdmin(c.Request().Context(), userID)
+	if err != nil {
+		return bottemplate.Template{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if !isAdmin && !tpl.VisibleTo(userID) {
+		return bottemplate.Template{}, botTemplateHTTPError(bottemplate.ErrNotFound)
+	}
+	if !isAdmin {
+		return bottemplate.Template{}, echo.NewHTTPError(http.StatusForbidden, "only the template creator or an admin can change it")
+	}
+	return tpl, nil
+}
+
+func botTemplateHTTPError(err error) error {
+	switch {
+	case errors.Is(err, bottemplate.ErrNotFound), errors.Is(err, bots.ErrBotNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, bottemplate.ErrNameInUse), errors.Is(err, bots.ErrBotNameTaken):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, bottemplate.ErrInvalidName), errors.Is(err, botbackup.ErrInvalidTemplate),
+		errors.Is(err, bots.ErrBotNameInvalid), errors.Is(err, bots.ErrBotNameReserved):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
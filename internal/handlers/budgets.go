@@ -0,0 +1,348 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/budget"
+)
+
+// BudgetHandler manages spending budgets. Bot budgets are managed by anyone
+// who can manage the bot; user budgets cover every bot a user owns and can
+// only be changed by admins.
+type BudgetHandler struct {
+	service        *budget.Service
+	botService     *bots.Service
+	accountService *accounts.Service
+	logger         *slog.Logger
+}
+
+func NewBudgetHandler(log *slog.Logger, service *budget.Service, botService *bots.Service, accountService *accounts.Service) *BudgetHandler {
+	return &BudgetHandler{
+		service:        service,
+		botService:     botService,
+		accountService: accountService,
+		logger:         log.With(slog.String("handler", "budgets")),
+	}
+}
+
+func (h *BudgetHandler) Register(e *echo.Echo) {
+	botGroup := e.Group("/bots/:bot_id/budgets")
+	botGroup.GET("", h.ListBotBudgets)
+	botGroup.POST("", h.CreateBotBudget)
+	botGroup.PUT("/:id", h.UpdateBotBudget)
+	botGroup.DELETE("/:id", h.DeleteBotBudget)
+
+	userGroup := e.Group("/users/:user_id/budgets")
+	userGroup.GET("", h.ListUserBudgets)
+	userGroup.POST("", h.CreateUserBudget)
+	userGroup.PUT("/:id", h.UpdateUserBudget)
+	userGroup.DELETE("/:id", h.DeleteUserBudget)
+}
+
+// ListBotBudgets godoc
+// @Summary List bot budgets
+// @Description List the spending budgets of a bot with their usage in the current period
+// @Tags budgets
+// @Param bot_id path string true "Bot ID"
+// @Success 200 {object} budget.ListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/budgets [get].
+func (h *BudgetHandler) ListBotBudgets(c echo.Context) error {
+	botID, err := h.requireBotAccess(c)
+	if err != nil {
+		return err
+	}
+	items, err := h.service.ListByBot(c.Request().Context(), botID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, budget.ListResponse{Items: items})
+}
+
+// CreateBotBudget godoc
+// @Summary Create bot budget
+// @Description Create a daily or monthly token or cost budget for a bot
+// @Tags budgets
+// @Param bot_id path string true "Bot ID"
+// @Param payload body budget.CreateRequest true "Budget payload"
+// @Success 201 {object} budget.Status
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/budgets [post].
+func (h *BudgetHandler) CreateBotBudget(c echo.Context) error {
+	botID, err := h.requireBotAccess(c)
+	if err != nil {
+		return err
+	}
+	var req budget.CreateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	resp, err := h.service.CreateForBot(c.Request().Context(), botID, req)
+	if err != nil {
+		return budgetHTTPError(err)
+	}
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// UpdateBotBudget godoc
+// @Summary Update bot budget
+// @Description Update a bot budget; changes re-arm its notifications for the current period
+// @Tags budgets
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Budget ID"
+// @Param payload body budget.UpdateRequest true "Budget payload"
+// @Success 200 {object} budget.Status
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/budgets/{id} [put].
+func (h *BudgetHandler) UpdateBotBudget(c echo.Context) error {
+	botID, err := h.requireBotAccess(c)
+	if err != nil {
+		return err
+	}
+	id, err := h.requireBudget(c, func(b budget.Budget) bool { return b.BotID == botID })
+	if err != nil {
+		return err
+	}
+	var req budget.UpdateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	resp, err := h.service.Update(c.Request().Context(), id, req)
+	if err != nil {
+		return budgetHTTPError(err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteBotBudget godoc
+// @Summary Delete bot budget
+// @Description Delete a bot budget
+// @Tags budgets
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Budget ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/budgets/{id} [delete].
+func (h *BudgetHandler) DeleteBotBudget(c echo.Context) error {
+	botID, err := h.requireBotAccess(c)
+	if err != nil {
+		return err
+	}
+	id, err := h.requireBudget(c, func(b budget.Budget) bool { return b.BotID == botID })
+	if err != nil {
+		return err
+	}
+	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListUserBudgets godoc
+// @Summary List user budgets
+// @Description List the budgets covering all bots owned by a user. Users may read their own; admins may read anyone's.
+// @Tags budgets
+// @Param user_id path string true "User ID"
+// @Success 200 {object} budget.ListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/budgets [get].
+func (h *BudgetHandler) ListUserBudgets(c echo.Context) error {
+	channelIdentityID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return err
+	}
+	userID := strings.TrimSpace(c.Param("user_id"))
+	if userID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "user id is required")
+	}
+	if userID != channelIdentityID {
+		if err := h.requireAdmin(c.Request().Context(), channelIdentityID); err != nil {
+			return err
+		}
+	}
+	items, err := h.service.ListByUser(c.Request().Context(), userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, budget.ListResponse{Items: items})
+}
+
+// CreateUserBudget godoc
+// @Summary Create user budget
+// @Description Create a budget covering all bots owned by a user (admin only)
+// @Tags budgets
+// @Param user_id path string true "User ID"
+// @Param payload body budget.CreateRequest true "Budget payload"
+// @Success 201 {object} budget.Status
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/budgets [post].
+func (h *BudgetHandler) CreateUserBudget(c echo.Context) error {
+	userID, err := h.requireAdminForUser(c)
+	if err != nil {
+		return err
+	}
+	var req budget.CreateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	resp, err := h.service.CreateForUser(c.Request().Context(), userID, req)
+	if err != nil {
+		return budgetHTTPError(err)
+	}
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// UpdateUserBudget godoc
+// @Summary Update user budget
+// @Description Update a user budget (admin only); changes re-arm its notifications for the current period
+// @Tags budgets
+// @Param user_id path string true "User ID"
+// @Param id path string true "Budget ID"
+// @Param payload body budget.UpdateRequest true "Budget payload"
+// @Success 200 {object} budget.Status
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/budgets/{id} [put].
+func (h *BudgetHandler) UpdateUserBudget(c echo.Context) error {
+	userID, err := h.requireAdminForUser(c)
+	if err != nil {
+		return err
+	}
+	id, err := h.requireBudget(c, func(b budget.Budget) bool { return b.UserID == userID })
+	if err != nil {
+		return err
+	}
+	var req budget.UpdateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	resp, err := h.service.Update(c.Request().Context(), id, req)
+	if err != nil {
+		return budgetHTTPError(err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteUserBudget godoc
+// @Summary Delete user budget
+// @Description Delete a user budget (admin only)
+// @Tags budgets
+// @Param user_id path string true "User ID"
+// @Param id path string true "Budget ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/budgets/{id} [delete].
+func (h *BudgetHandler) DeleteUserBudget(c echo.Context) error {
+	userID, err := h.requireAdminForUser(c)
+	if err != nil {
+		return err
+	}
+	id, err := h.requireBudget(c, func(b budget.Budget) bool { return b.UserID == userID })
+	if err != nil {
+		return err
+	}
+	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// requireBotAccess authorizes the caller to manage the bot in the path and
+// returns its ID.
+func (h *BudgetHandler) requireBotAccess(c echo.Context) (string, error) {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return "", err
+	}
+	botID := strings.TrimSpace(c.Param("bot_id"))
+	if botID == "" {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "bot id is required")
+	}
+	if _, err := AuthorizeBotAccess(c.Request().Context(), h.botService, h.accountService, userID, botID); err != nil {
+		return "", err
+	}
+	return botID, nil
+}
+
+// requireAdminForUser requires an admin caller and returns the user ID in the
+// path.
+func (h *BudgetHandler) requireAdminForUser(c echo.Context) (string, error) {
+	channelIdentityID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return "", err
+	}
+	if err := h.requireAdmin(c.Request().Context(), channelIdentityID); err != nil {
+		return "", err
+	}
+	userID := strings.TrimSpace(c.Param("user_id"))
+	if userID == "" {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "user id is required")
+	}
+	return userID, nil
+}
+
+func (h *BudgetHandler) requireAdmin(ctx context.Context, channelIdentityID string) error {
+	isAdmin, err := h.accountService.IsAdmin(ctx, channelIdentityID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if !isAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "admin role required")
+	}
+	return nil
+}
+
+// requireBudget loads the budget in the path and checks that it belongs to
+// the bot or user it was addressed through.
+func (h *BudgetHandler) requireBudget(c echo.Context, belongs func(budget.Budget) bool) (string, error) {
+	id := strings.TrimSpace(c.Param("id"))
+	if id == "" {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "id is required")
+	}
+	item, err := h.service.Get(c.Request().Context(), id)
+	if err != nil {
+		return "", budgetHTTPError(err)
+	}
+	if !belongs(item) {
+		return "", echo.NewHTTPError(http.StatusNotFound, "budget not found")
+	}
+	return id, nil
+}
+
+func budgetHTTPError(err error) error {
+	switch {
+	case errors.Is(err, budget.ErrNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, budget.ErrInvalid):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -42,6 +42,7 @@ type ContainerdHandler struct {
 	policyService    *policy.Service
 	displayService   *displaypkg.Service
 	browserSessions  *browserSessionStore
+	skillInstallHook skillInstallHook
 }
 
 type ContainerGPURequest struct {
@@ -1071,7 +1072,7 @@ func buildSnapshotListResponse(data *workspace.BotSnapshotData) (ListSnapshotsRe
 
 func snapshotLineageRootRequired(snapshotter string) bool {
 	switch strings.ToLower(strings.TrimSpace(snapshotter)) {
-	case "archive", "docker", "local":
+	case "archive", "docker", "podman", "local":
 		return false
 	default:
 		return true
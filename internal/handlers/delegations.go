@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/delegation"
+)
+
+// DelegationHandler lists the tasks a bot handed to, or received from, other
+// bots, with the result and usage of each hop.
+type DelegationHandler struct {
+	service        *delegation.Service
+	botService     *bots.Service
+	accountService *accounts.Service
+	logger         *slog.Logger
+}
+
+func NewDelegationHandler(log *slog.Logger, service *delegation.Service, botService *bots.Service, accountService *accounts.Service) *DelegationHandler {
+	return &DelegationHandler{
+		service:        service,
+		botService:     botService,
+		accountService: accountService,
+		logger:         log.With(slog.String("handler", "delegations")),
+	}
+}
+
+func (h *DelegationHandler) Register(e *echo.Echo) {
+	e.GET("/bots/:bot_id/delegations", h.List, auth.RequireBotScope(auth.ScopeBotsRead, "bot_id"))
+}
+
+// List godoc
+// @Summary List bot delegations
+// @Description List tasks the bot delegated to other bots or received from them, newest first
+// @Tags delegations
+// @Param bot_id path string true "Bot ID"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Success 200 {object} delegation.ListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/delegations [get].
+func (h *DelegationHandler) List(c echo.Context) error {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return err
+	}
+	botID := strings.TrimSpace(c.Param("bot_id"))
+	if botID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "bot id is required")
+	}
+	if _, err := AuthorizeBotAccess(c.Request().Context(), h.botService, h.accountService, userID, botID); err != nil {
+		return err
+	}
+	limit := 0
+	if raw := strings.TrimSpace(c.QueryParam("limit")); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+	}
+	items, err := h.service.ListByBot(c.Request().Context(), botID, limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, delegation.ListResponse{Items: items})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -56,6 +57,26 @@ func AuthorizeBotAccessWithPermission(ctx context.Context, botService *bots.Serv
 	return bot, nil
 }
 
+// tokenBotRestriction returns the bot the request's personal access token is
+// restricted to, or "" for session tokens and unrestricted tokens.
+func tokenBotRestriction(c echo.Context) string {
+	token, ok := auth.PersonalTokenFromContext(c)
+	if !ok {
+		return ""
+	}
+	return token.BotID
+}
+
+// filterTokenBots drops bots outside the personal access token's bot
+// restriction from a listing.
+func filterTokenBots(c echo.Context, items []bots.Bot) []bots.Bot {
+	botID := tokenBotRestriction(c)
+	if botID == "" {
+		return items
+	}
+	return slices.DeleteFunc(items, func(bot bots.Bot) bool { return bot.ID != botID })
+}
+
 // parseOffsetLimit extracts limit and offset query parameters with defaults.
 func parseOffsetLimit(c echo.Context) (limit, offset int) {
 	limit = 50
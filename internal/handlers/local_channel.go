@@ -22,6 +22,7 @@ import (
 	"github.com/memohai/memoh/internal/accounts"
 	agentpkg "github.com/memohai/memoh/internal/agent"
 	attachmentpkg "github.com/memohai/memoh/internal/attachment"
+	"github.com/memohai/memoh/internal/auth"
 	"github.com/memohai/memoh/internal/bots"
 	"github.com/memohai/memoh/internal/channel"
 	"github.com/memohai/memoh/internal/channel/adapters/local"
@@ -29,6 +30,7 @@ import (
 	"github.com/memohai/memoh/internal/conversation/flow"
 	"github.com/memohai/memoh/internal/media"
 	messagepkg "github.com/memohai/memoh/internal/message"
+	"github.com/memohai/memoh/internal/structured"
 	"github.com/memohai/memoh/internal/userinput"
 )
 
@@ -91,10 +93,11 @@ func (h *LocalChannelHandler) SetSpeechService(synth localSpeechSynthesizer, res
 // Register registers the local channel routes.
 func (h *LocalChannelHandler) Register(e *echo.Echo) {
 	prefix := fmt.Sprintf("/bots/:bot_id/%s", h.channelType.String())
+	chat := auth.RequireBotScope(auth.ScopeBotsChat, "bot_id")
 	group := e.Group(prefix)
-	group.GET("/stream", h.StreamMessages)
-	group.POST("/messages", h.PostMessage)
-	group.GET("/ws", h.HandleWebSocket)
+	group.GET("/stream", h.StreamMessages, chat)
+	group.POST("/messages", h.PostMessage, chat)
+	group.GET("/ws", h.HandleWebSocket, chat)
 }
 
 // StreamMessages godoc
@@ -173,6 +176,10 @@ type LocalChannelMessageRequest struct {
 	Message         channel.Message `json:"message"`
 	ModelID         string          `json:"model_id,omitempty"`
 	ReasoningEffort string          `json:"reasoning_effort,omitempty"`
+	// OutputSchema constrains the reply to JSON matching this JSON Schema.
+	// The validated JSON is stored in the reply's metadata under
+	// "structured_output".
+	OutputSchema json.RawMessage `json:"output_schema,omitempty" swaggertype:"object"`
 }
 
 // PostMessage godoc
@@ -213,6 +220,10 @@ func (h *LocalChannelHandler) PostMessage(c echo.Context) error {
 	if req.Message.IsEmpty() {
 		return echo.NewHTTPError(http.StatusBadRequest, "message is required")
 	}
+	outputSchema, err := structured.Compile(req.OutputSchema)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
 	cfg, err := h.channelStore.ResolveEffectiveConfig(c.Request().Context(), botID, h.channelType)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -249,6 +260,12 @@ func (h *LocalChannelHandler) PostMessage(c echo.Context) error {
 		}
 		msg.Metadata["reasoning_effort"] = re
 	}
+	if outputSchema != nil {
+		if msg.Metadata == nil {
+			msg.Metadata = make(map[string]any)
+		}
+		msg.Metadata["output_schema"] = outputSchema.Raw()
+	}
 	if err := h.channelManager.HandleInbound(c.Request().Context(), cfg, msg); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
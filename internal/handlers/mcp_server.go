@@ -0,0 +1,389 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/acl"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/conversation"
+	"github.com/memohai/memoh/internal/conversation/flow"
+	mcpgw "github.com/memohai/memoh/internal/mcp"
+	memprovider "github.com/memohai/memoh/internal/memory/adapters"
+	"github.com/memohai/memoh/internal/openaicompat"
+	"github.com/memohai/memoh/internal/schedule"
+	"github.com/memohai/memoh/internal/session"
+	"github.com/memohai/memoh/internal/settings"
+	"github.com/memohai/memoh/internal/workspace/bridge"
+)
+
+const (
+	// remoteMCPChannelType tags the sessions created through a bot's remote
+	// MCP server, and is the subject channel type ACL rules match it by.
+	remoteMCPChannelType = "mcp"
+	// remoteMCPFilesRoot is the workspace directory remote MCP clients see
+	// first.
+	remoteMCPFilesRoot = "/data"
+)
+
+// BotMCPServerHandler exposes each bot as a remote MCP server, so Claude
+// Desktop and other MCP clients can use a bot as a tool. Clients
+// authenticate with a personal access token holding the bots:mcp scope,
+// usually one restricted to the bot. What a caller may do follows their
+// access to the bot: chatting needs the chat permission and passes the
+// bot's ACL, files need workspace_read, and memory and schedules need
+// manage.
+type BotMCPServerHandler struct {
+	resolver        *flow.Resolver
+	sessionService  *session.Service
+	botService      *bots.Service
+	accountService  *accounts.Service
+	scheduleService *schedule.Service
+	aclService      *acl.Service
+	settingsService *settings.Service
+	memoryRegistry  *memprovider.Registry
+	mcpClients      bridge.Provider
+	logger          *slog.Logger
+}
+
+func NewBotMCPServerHandler(log *slog.Logger, resolver *flow.Resolver, sessionService *session.Service, botService *bots.Service, accountService *accounts.Service, scheduleService *schedule.Service) *BotMCPServerHandler {
+	return &BotMCPServerHandler{
+		resolver:        resolver,
+		sessionService:  sessionService,
+		botService:      botService,
+		accountService:  accountService,
+		scheduleService: scheduleService,
+		logger:          log.With(slog.String("handler", "bot_mcp_server")),
+	}
+}
+
+// SetACLService makes non-managers pass the bot's ACL before chatting.
+func (h *BotMCPServerHandler) SetACLService(service *acl.Service) {
+	h.aclService = service
+}
+
+// SetMemoryRegistry sets the provider registry used to search memory.
+func (h *BotMCPServerHandler) SetMemoryRegistry(registry *memprovider.Registry) {
+	h.memoryRegistry = registry
+}
+
+// SetSettingsService sets the settings service for provider resolution.
+func (h *BotMCPServerHandler) SetSettingsService(svc *settings.Service) {
+	h.settingsService = svc
+}
+
+// SetMCPClientProvider sets the workspace client provider used for files.
+func (h *BotMCPServerHandler) SetMCPClientProvider(p bridge.Provider) {
+	h.mcpClients = p
+}
+
+func (h *BotMCPServerHandler) Register(e *echo.Echo) {
+	e.POST("/bots/:bot_id/mcp-server", h.Serve, auth.RequireBotScope(auth.ScopeBotsMCP, "bot_id"))
+}
+
+// Serve godoc
+// @Summary Bot remote MCP server
+// @Description Streamable HTTP MCP endpoint exposing the bot to external MCP clients. Tools: chat_with_bot, search_bot_memory, list_bot_files and run_bot_schedule; resources: workspace files and memories. Only what the caller may access is listed.
+// @Tags mcp
+// @Param bot_id path string true "Bot ID"
+// @Param payload body object true "JSON-RPC request"
+// @Success 200 {object} object "JSON-RPC response: {jsonrpc,id,result|error}"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/mcp-server [post].
+func (h *BotMCPServerHandler) Serve(c echo.Context) error {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return err
+	}
+	ctx := c.Request().Context()
+	botID := strings.TrimSpace(c.Param("bot_id"))
+	bot, err := AuthorizeBotAccessWithPermission(ctx, h.botService, h.accountService, userID, botID, bots.PermissionChat)
+	if err != nil {
+		bot, err = AuthorizeBotAccessWithPermission(ctx, h.botService, h.accountService, userID, botID, bots.PermissionWorkspaceRead)
+		if err != nil {
+			return err
+		}
+	}
+	isAdmin, err := h.accountService.IsAdmin(ctx, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	perms, err := h.botService.ResolveUserPermissions(ctx, bot.ID, userID, isAdmin)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	access, err := h.access(ctx, bot.ID, userID, perms)
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(bot.DisplayName)
+	if name == "" {
+		name = bot.Name
+	}
+	mcpgw.ServeRemoteBotMCPHTTP(c.Response().Writer, c.Request(), h.logger, mcpgw.RemoteBotServer{
+		BotID:     bot.ID,
+		BotName:   name,
+		FilesRoot: remoteMCPFilesRoot,
+		Access:    access,
+		Backend:   &botMCPBackend{handler: h, botID: bot.ID, userID: userID},
+	})
+	return nil
+}
+
+// access maps the caller's permissions on the bot to the parts of the
+// remote server they may use. Chat additionally passes the bot's ACL for
+// the mcp channel unless the caller manages the bot.
+func (h *BotMCPServerHandler) access(ctx context.Context, botID, userID string, perms []string) (mcpgw.RemoteBotAccess, error) {
+	manage := bots.HasPermission(perms, bots.PermissionManage)
+	access := mcpgw.RemoteBotAccess{
+		Chat:      bots.HasPermission(perms, bots.PermissionChat) && h.resolver != nil && h.sessionService != nil,
+		Files:     bots.HasPermission(perms, bots.PermissionWorkspaceRead) && h.mcpClients != nil,
+		Memory:    manage && h.memoryRegistry != nil,
+		Schedules: manage && h.scheduleService != nil,
+	}
+	if access.Chat && !manage && h.aclService != nil {
+		allowed, err := h.aclService.Evaluate(ctx, acl.EvaluateRequest{
+			BotID:             botID,
+			ChannelIdentityID: userID,
+			ChannelType:       remoteMCPChannelType,
+		})
+		if err != nil {
+			return mcpgw.RemoteBotAccess{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		access.Chat = allowed
+	}
+	return access, nil
+}
+
+// botMCPBackend carries out remote MCP requests of one caller against one
+// bot.
+type botMCPBackend struct {
+	handler *BotMCPServerHandler
+	botID   string
+	userID  string
+}
+
+// Chat runs one turn in a chat session of the caller, so memory formation
+// and usage accounting happen as for any other chat.
+func (b *botMCPBackend) Chat(ctx context.Context, message, sessionID string) (mcpgw.RemoteChatResult, error) {
+	h := b.handler
+	if sessionID = strings.TrimSpace(sessionID); sessionID != "" {
+		sess, err := h.sessionService.Get(ctx, sessionID)
+		if err != nil || sess.BotID != b.botID || sess.CreatedByUserID != b.userID || sess.Type != session.TypeChat {
+			return mcpgw.RemoteChatResult{}, errors.New("session not found")
+		}
+	} else {
+		sess, err := h.sessionService.Create(ctx, session.CreateInput{
+			BotID:           b.botID,
+			ChannelType:     remoteMCPChannelType,
+			Type:            session.TypeChat,
+			CreatedByUserID: b.userID,
+		})
+		if err != nil {
+			return mcpgw.RemoteChatResult{}, err
+		}
+		sessionID = sess.ID
+	}
+	resp, err := h.resolver.Chat(ctx, conversation.ChatRequest{
+		BotID:                   b.botID,
+		ChatID:                  b.botID,
+		SessionID:               sessionID,
+		UserID:                  b.userID,
+		SourceChannelIdentityID: b.userID,
+		ConversationType:        channel.ConversationTypePrivate,
+		Query:                   message,
+	})
+	if err != nil {
+		return mcpgw.RemoteChatResult{}, err
+	}
+	reply := openaicompat.ReplyFromMessages(resp.Messages, resp.Structured, resp.Usage)
+	return mcpgw.RemoteChatResult{SessionID: sessionID, Reply: reply.Text}, nil
+}
+
+func (b *botMCPBackend) SearchMemory(ctx context.Context, query string, limit int) ([]mcpgw.RemoteMemoryItem, error) {
+	provider, err := b.memoryProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := provider.Search(ctx, memprovider.SearchRequest{
+		Query:   query,
+		BotID:   b.botID,
+		Limit:   limit,
+		Filters: buildNamespaceFilters(sharedMemoryNamespace, b.botID, nil),
+		NoStats: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return remoteMemoryItems(resp.Results), nil
+}
+
+func (b *botMCPBackend) ListMemory(ctx context.Context, limit int) ([]mcpgw.RemoteMemoryItem, error) {
+	provider, err := b.memoryProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := provider.GetAll(ctx, memprovider.GetAllRequest{
+		BotID:   b.botID,
+		Limit:   limit,
+		Filters: buildNamespaceFilters(sharedMemoryNamespace, b.botID, nil),
+		NoStats: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return remoteMemoryItems(resp.Results), nil
+}
+
+func (b *botMCPBackend) GetMemory(ctx context.Context, id string) (mcpgw.RemoteMemoryItem, error) {
+	provider, err := b.memoryProvider(ctx)
+	if err != nil {
+		return mcpgw.RemoteMemoryItem{}, err
+	}
+	resp, err := provider.GetAll(ctx, memprovider.GetAllRequest{
+		BotID:   b.botID,
+		Filters: buildNamespaceFilters(sharedMemoryNamespace, b.botID, nil),
+		NoStats: true,
+	})
+	if err != nil {
+		return mcpgw.RemoteMemoryItem{}, err
+	}
+	for _, item := range remoteMemoryItems(resp.Results) {
+		if item.ID == id {
+			return item, nil
+		}
+	}
+	return mcpgw.RemoteMemoryItem{}, mcpgw.ErrRemoteNotFound
+}
+
+func (b *botMCPBackend) ListFiles(ctx context.Context, dir string) ([]mcpgw.RemoteFileEntry, error) {
+	dir, err := resolveContainerPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	client, err := b.handler.mcpClients.MCPClient(ctx, b.botID)
+	if err != nil {
+		return nil, fmt.Errorf("workspace not reachable: %w", err)
+	}
+	entries, err := client.ListDirAll(ctx, dir, false)
+	if err != nil {
+		return nil, remoteFileError(err)
+	}
+	out := make([]mcpgw.RemoteFileEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.Path == dir {
+			continue
+		}
+		out = append(out, mcpgw.RemoteFileEntry{
+			Path:    path.Join(dir, path.Base(e.Path)),
+			IsDir:   e.IsDir,
+			Size:    e.Size,
+			ModTime: e.ModTime,
+		})
+	}
+	return out, nil
+}
+
+func (b *botMCPBackend) ReadFile(ctx context.Context, filePath string) ([]byte, error) {
+	filePath, err := resolveContainerPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	client, err := b.handler.mcpClients.MCPClient(ctx, b.botID)
+	if err != nil {
+		return nil, fmt.Errorf("workspace not reachable: %w", err)
+	}
+	rc, err := client.ReadRaw(ctx, filePath)
+	if err != nil {
+		return nil, remoteFileError(err)
+	}
+	defer func() { _ = rc.Close() }()
+	return io.ReadAll(rc)
+}
+
+// RunSchedule triggers a schedule of the bot by ID or name and reports the
+// run it produced.
+func (b *botMCPBackend) RunSchedule(ctx context.Context, ref string) (mcpgw.RemoteScheduleRun, error) {
+	svc := b.handler.scheduleService
+	items, err := svc.List(ctx, b.botID)
+	if err != nil {
+		return mcpgw.RemoteScheduleRun{}, err
+	}
+	var target *schedule.Schedule
+	for i := range items {
+		if items[i].ID == ref || strings.EqualFold(items[i].Name, ref) {
+			target = &items[i]
+			break
+		}
+	}
+	if target == nil {
+		return mcpgw.RemoteScheduleRun{}, fmt.Errorf("schedule %q %w", ref, mcpgw.ErrRemoteNotFound)
+	}
+
+	run := mcpgw.RemoteScheduleRun{ScheduleID: target.ID, Name: target.Name}
+	triggerErr := svc.Trigger(ctx, target.ID)
+	logs, _, err := svc.ListLogsBySchedule(ctx, target.ID, 1, 0)
+	if err != nil || len(logs) == 0 {
+		if triggerErr != nil {
+			return mcpgw.RemoteScheduleRun{}, triggerErr
+		}
+		run.Status = "ok"
+		return run, nil
+	}
+	latest := logs[0]
+	run.Status = latest.Status
+	run.Result = latest.ResultText
+	if len(latest.ResultJSON) > 0 {
+		run.Result = string(latest.ResultJSON)
+	}
+	run.Error = latest.ErrorMessage
+	if run.Error == "" && triggerErr != nil {
+		run.Error = triggerErr.Error()
+	}
+	return run, nil
+}
+
+func (b *botMCPBackend) memoryProvider(ctx context.Context) (memprovider.Provider, error) {
+	h := b.handler
+	if provider := resolveMemoryProvider(ctx, h.logger, h.memoryRegistry, h.settingsService, b.botID); provider != nil {
+		return provider, nil
+	}
+	return nil, errors.New("memory service not available")
+}
+
+func remoteMemoryItems(items []memprovider.MemoryItem) []mcpgw.RemoteMemoryItem {
+	items = deduplicateMemoryItems(items)
+	out := make([]mcpgw.RemoteMemoryItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, mcpgw.RemoteMemoryItem{
+			ID:        item.ID,
+			Memory:    item.Memory,
+			Score:     item.Score,
+			CreatedAt: item.CreatedAt,
+			UpdatedAt: item.UpdatedAt,
+		})
+	}
+	return out
+}
+
+func remoteFileError(err error) error {
+	if errors.Is(err, bridge.ErrNotFound) {
+		return mcpgw.ErrRemoteNotFound
+	}
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/auth"
 	"github.com/memohai/memoh/internal/bots"
 	"github.com/memohai/memoh/internal/config"
 	memprovider "github.com/memohai/memoh/internal/memory/adapters"
@@ -64,6 +66,14 @@ type memoryCompactPayload struct {
 	DecayDays *int    `json:"decay_days,omitempty"`
 }
 
+type memoryRollbackPayload struct {
+	Revision int `json:"revision"`
+}
+
+type memoryRestorePayload struct {
+	AsOf string `json:"as_of"` // RFC3339 timestamp
+}
+
 // namespaceScope holds namespace + scopeId for a single memory scope.
 type namespaceScope struct {
 	Namespace string
@@ -96,23 +106,29 @@ func (h *MemoryHandler) SetSettingsService(svc *settings.Service) {
 
 // resolveProvider returns the memory provider for a bot, or nil if not configured.
 func (h *MemoryHandler) resolveProvider(ctx context.Context, botID string) memprovider.Provider {
-	if h.memoryRegistry == nil {
+	return resolveMemoryProvider(ctx, h.logger, h.memoryRegistry, h.settingsService, botID)
+}
+
+// resolveMemoryProvider returns the memory provider selected in the bot's
+// settings, falling back to the built-in provider, or nil if not configured.
+func resolveMemoryProvider(ctx context.Context, logger *slog.Logger, registry *memprovider.Registry, settingsService *settings.Service, botID string) memprovider.Provider {
+	if registry == nil {
 		return nil
 	}
-	if h.settingsService != nil {
-		botSettings, err := h.settingsService.GetBot(ctx, botID)
+	if settingsService != nil {
+		botSettings, err := settingsService.GetBot(ctx, botID)
 		if err == nil {
 			providerID := strings.TrimSpace(botSettings.MemoryProviderID)
 			if providerID != "" {
-				p, getErr := h.memoryRegistry.Get(providerID)
+				p, getErr := registry.Get(providerID)
 				if getErr == nil {
 					return p
 				}
-				h.logger.Warn("memory provider lookup failed", slog.String("provider_id", providerID), slog.Any("error", getErr))
+				logger.Warn("memory provider lookup failed", slog.String("provider_id", providerID), slog.Any("error", getErr))
 			}
 		}
 	}
-	p, err := h.memoryRegistry.Get(defaultBuiltinProviderID)
+	p, err := registry.Get(defaultBuiltinProviderID)
 	if err != nil {
 		return nil
 	}
@@ -130,16 +146,21 @@ func (h *MemoryHandler) SetMCPClientProvider(p bridge.Provider) {
 
 // Register registers chat-level memory routes.
 func (h *MemoryHandler) Register(e *echo.Echo) {
+	read := auth.RequireBotScope(auth.ScopeMemoryRead, "bot_id")
+	admin := auth.RequireBotScope(auth.ScopeMemoryAdmin, "bot_id")
 	chatGroup := e.Group("/bots/:bot_id/memory")
-	chatGroup.POST("", h.ChatAdd)
-	chatGroup.POST("/search", h.ChatSearch)
-	chatGroup.POST("/compact", h.ChatCompact)
-	chatGroup.POST("/rebuild", h.ChatRebuild)
-	chatGroup.GET("/status", h.ChatStatus)
-	chatGroup.GET("", h.ChatGetAll)
-	chatGroup.GET("/usage", h.ChatUsage)
-	chatGroup.DELETE("", h.ChatDelete)
-	chatGroup.DELETE("/:memory_id", h.ChatDeleteOne)
+	chatGroup.POST("", h.ChatAdd, admin)
+	chatGroup.POST("/search", h.ChatSearch, read)
+	chatGroup.POST("/compact", h.ChatCompact, admin)
+	chatGroup.POST("/rebuild", h.ChatRebuild, admin)
+	chatGroup.GET("/status", h.ChatStatus, read)
+	chatGroup.GET("", h.ChatGetAll, read)
+	chatGroup.GET("/usage", h.ChatUsage, read)
+	chatGroup.DELETE("", h.ChatDelete, admin)
+	chatGroup.DELETE("/:memory_id", h.ChatDeleteOne, admin)
+	chatGroup.GET("/:memory_id/history", h.ChatHistory, read)
+	chatGroup.POST("/:memory_id/rollback", h.ChatRollback, admin)
+	chatGroup.POST("/restore", h.ChatRestore, admin)
 }
 
 func (h *MemoryHandler) checkService(ctx context.Context, botID string) (memprovider.Provider, error) {
@@ -206,7 +227,7 @@ func (h *MemoryHandler) ChatAdd(c echo.Context) error {
 	if checkErr != nil {
 		return checkErr
 	}
-	resp, err := provider.Add(c.Request().Context(), req)
+	resp, err := provider.Add(memoryChangeContext(c, channelIdentityID, storefs.ChangeSourceAPI), req)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -346,8 +367,9 @@ func (h *MemoryHandler) ChatDelete(c echo.Context) error {
 	var payload memoryDeletePayload
 	_ = c.Bind(&payload)
 
+	ctx := memoryChangeContext(c, "", storefs.ChangeSourceAPI)
 	if len(payload.MemoryIDs) > 0 {
-		resp, delErr := provider.DeleteBatch(c.Request().Context(), payload.MemoryIDs)
+		resp, delErr := provider.DeleteBatch(ctx, payload.MemoryIDs)
 		if delErr != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, delErr.Error())
 		}
@@ -362,7 +384,7 @@ func (h *MemoryHandler) ChatDelete(c echo.Context) error {
 		req := memprovider.DeleteAllRequest{
 			Filters: buildNamespaceFilters(scope.Namespace, scope.ScopeID, nil),
 		}
-		if _, delErr := provider.DeleteAll(c.Request().Context(), req); delErr != nil {
+		if _, delErr := provider.DeleteAll(ctx, req); delErr != nil {
 			h.logger.Warn("deleteall namespace failed", slog.String("namespace", scope.Namespace), slog.Any("error", delErr))
 		}
 	}
@@ -396,7 +418,7 @@ func (h *MemoryHandler) ChatDeleteOne(c echo.Context) error {
 	if memoryID == "" {
 		return echo.NewHTTPError(http.StatusBadRequest, "memory_id is required")
 	}
-	resp, err := provider.Delete(c.Request().Context(), memoryID)
+	resp, err := provider.Delete(memoryChangeContext(c, "", storefs.ChangeSourceAPI), memoryID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -457,7 +479,7 @@ func (h *MemoryHandler) ChatCompact(c echo.Context) error {
 
 	scope := scopes[0]
 	filters := buildNamespaceFilters(scope.Namespace, scope.ScopeID, nil)
-	result, err := provider.Compact(c.Request().Context(), filters, ratio, decayDays)
+	result, err := provider.Compact(memoryChangeContext(c, "", storefs.ChangeSourceCompact), filters, ratio, decayDays)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -582,6 +604,126 @@ func (h *MemoryHandler) ChatStatus(c echo.Context) error {
 	return c.JSON(http.StatusOK, status)
 }
 
+// ChatHistory godoc
+// @Summary Get memory revision history
+// @Description List every recorded revision of a memory item, oldest first, including who or what changed it and the formation decision behind it
+// @Tags memory
+// @Produce json
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Memory ID"
+// @Success 200 {array} adapters.MemoryRevision
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /bots/{bot_id}/memory/{id}/history [get].
+func (h *MemoryHandler) ChatHistory(c echo.Context) error {
+	botID, err := h.requireBotAccess(c)
+	if err != nil {
+		return err
+	}
+	historyProvider, err := h.historyProvider(c.Request().Context(), botID)
+	if err != nil {
+		return err
+	}
+	memoryID := strings.TrimSpace(c.Param("memory_id"))
+	if memoryID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "memory_id is required")
+	}
+	revisions, err := historyProvider.History(c.Request().Context(), botID, memoryID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, revisions)
+}
+
+// ChatRollback godoc
+// @Summary Roll back a memory
+// @Description Rewrite a memory item with the content of an earlier revision. The rollback is recorded as a new revision.
+// @Tags memory
+// @Accept json
+// @Produce json
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Memory ID"
+// @Param payload body memoryRollbackPayload true "Revision to roll back to"
+// @Success 200 {object} adapters.MemoryItem
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /bots/{bot_id}/memory/{id}/rollback [post].
+func (h *MemoryHandler) ChatRollback(c echo.Context) error {
+	botID, err := h.requireBotAccess(c)
+	if err != nil {
+		return err
+	}
+	var payload memoryRollbackPayload
+	if err := c.Bind(&payload); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if payload.Revision <= 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "revision is required")
+	}
+	memoryID := strings.TrimSpace(c.Param("memory_id"))
+	if memoryID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "memory_id is required")
+	}
+	historyProvider, err := h.historyProvider(c.Request().Context(), botID)
+	if err != nil {
+		return err
+	}
+	item, err := historyProvider.Rollback(memoryChangeContext(c, "", storefs.ChangeSourceRollback), botID, memoryID, payload.Revision)
+	if err != nil {
+		return memoryHistoryError(err)
+	}
+	return c.JSON(http.StatusOK, item)
+}
+
+// ChatRestore godoc
+// @Summary Restore memory as of a time
+// @Description Rewrite all of a bot's memories to the state they had at the given time. Items created later are removed, and items changed or deleted since are restored.
+// @Tags memory
+// @Accept json
+// @Produce json
+// @Param bot_id path string true "Bot ID"
+// @Param payload body memoryRestorePayload true "RFC3339 point in time to restore"
+// @Success 200 {object} adapters.RestoreResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /bots/{bot_id}/memory/restore [post].
+func (h *MemoryHandler) ChatRestore(c echo.Context) error {
+	botID, err := h.requireBotAccess(c)
+	if err != nil {
+		return err
+	}
+	var payload memoryRestorePayload
+	if err := c.Bind(&payload); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	asOf, err := time.Parse(time.RFC3339, strings.TrimSpace(payload.AsOf))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "as_of must be an RFC3339 timestamp")
+	}
+	if asOf.After(time.Now()) {
+		return echo.NewHTTPError(http.StatusBadRequest, "as_of must not be in the future")
+	}
+	historyProvider, err := h.historyProvider(c.Request().Context(), botID)
+	if err != nil {
+		return err
+	}
+	result, err := historyProvider.RestoreAsOf(memoryChangeContext(c, "", storefs.ChangeSourceRestore), botID, asOf)
+	if err != nil {
+		return memoryHistoryError(err)
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
 // --- helpers ---
 
 // resolveEnabledScopes returns bot-shared namespace scope.
@@ -651,6 +793,41 @@ func deduplicateMemoryItems(items []memprovider.MemoryItem) []memprovider.Memory
 	return result
 }
 
+func (h *MemoryHandler) historyProvider(ctx context.Context, botID string) (memprovider.HistoryProvider, error) {
+	provider, err := h.checkService(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+	historyProvider, ok := provider.(memprovider.HistoryProvider)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusConflict, "selected memory provider does not keep memory history")
+	}
+	return historyProvider, nil
+}
+
+func memoryHistoryError(err error) error {
+	switch {
+	case errors.Is(err, storefs.ErrRevisionNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, storefs.ErrRevisionNotRestorable):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
+
+// memoryChangeContext attributes memory writes made by this request to the
+// calling channel identity so they show up in the revision history.
+func memoryChangeContext(c echo.Context, channelIdentityID, source string) context.Context {
+	if channelIdentityID == "" {
+		channelIdentityID, _ = RequireChannelIdentityID(c)
+	}
+	return storefs.WithChange(c.Request().Context(), storefs.Change{
+		Source: source,
+		Actor:  strings.TrimSpace(channelIdentityID),
+	})
+}
+
 func (*MemoryHandler) requireChannelIdentityID(c echo.Context) (string, error) {
 	return RequireChannelIdentityID(c)
 }
@@ -18,6 +18,7 @@ import (
 
 	"github.com/memohai/memoh/internal/accounts"
 	"github.com/memohai/memoh/internal/agent/background"
+	"github.com/memohai/memoh/internal/auth"
 	"github.com/memohai/memoh/internal/bots"
 	"github.com/memohai/memoh/internal/conversation"
 	"github.com/memohai/memoh/internal/media"
@@ -81,11 +82,12 @@ func (h *MessageHandler) SetBackgroundManager(mgr *background.Manager) {
 func (h *MessageHandler) Register(e *echo.Echo) {
 	// Bot-scoped message container (single shared history per bot).
 	botGroup := e.Group("/bots/:bot_id")
-	botGroup.GET("/messages", h.ListMessages)
-	botGroup.GET("/messages/locate", h.LocateMessage)
-	botGroup.GET("/messages/events", h.StreamMessageEvents)
+	chat := auth.RequireBotScope(auth.ScopeBotsChat, "bot_id")
+	botGroup.GET("/messages", h.ListMessages, chat)
+	botGroup.GET("/messages/locate", h.LocateMessage, chat)
+	botGroup.GET("/messages/events", h.StreamMessageEvents, chat)
 	botGroup.DELETE("/messages", h.DeleteMessages)
-	botGroup.GET("/media/:content_hash", h.ServeMedia)
+	botGroup.GET("/media/:content_hash", h.ServeMedia, chat)
 }
 
 // --- Messages ---
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/memohai/memoh/internal/accounts"
 	"github.com/memohai/memoh/internal/bots"
 	netctl "github.com/memohai/memoh/internal/network"
+	"github.com/memohai/memoh/internal/network/egress"
 )
 
 type NetworkHandler struct {
@@ -36,6 +38,8 @@ func (h *NetworkHandler) Register(e *echo.Echo) {
 	group.GET("/status", h.Status)
 	group.GET("/nodes", h.ListNodes)
 	group.POST("/actions/:action_id", h.ExecuteAction)
+	group.GET("/egress", h.GetEgress)
+	group.PUT("/egress", h.UpdateEgress)
 }
 
 func (h *NetworkHandler) ListMeta(c echo.Context) error {
@@ -89,6 +93,40 @@ func (h *NetworkHandler) ExecuteAction(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+func (h *NetworkHandler) GetEgress(c echo.Context) error {
+	botID, err := h.authorize(c)
+	if err != nil {
+		return err
+	}
+	policy, err := h.service.EgressPolicy(c.Request().Context(), botID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, policy)
+}
+
+func (h *NetworkHandler) UpdateEgress(c echo.Context) error {
+	botID, err := h.authorize(c)
+	if err != nil {
+		return err
+	}
+	var req egress.Policy
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	policy, err := h.service.UpdateEgressPolicy(c.Request().Context(), botID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, netctl.ErrInvalidEgressPolicy):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.Is(err, netctl.ErrNotSupported):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, policy)
+}
+
 func (h *NetworkHandler) authorize(c echo.Context) (string, error) {
 	channelIdentityID, err := RequireChannelIdentityID(c)
 	if err != nil {
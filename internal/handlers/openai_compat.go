@@ -0,0 +1,442 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	agentpkg "github.com/memohai/memoh/internal/agent"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/conversation"
+	"github.com/memohai/memoh/internal/conversation/flow"
+	"github.com/memohai/memoh/internal/openaicompat"
+	"github.com/memohai/memoh/internal/session"
+	"github.com/memohai/memoh/internal/structured"
+)
+
+// openAIChannelType tags the sessions created through the OpenAI-compatible
+// API.
+const openAIChannelType = "openai"
+
+// OpenAICompatHandler exposes bots through the OpenAI Chat Completions and
+// Responses APIs. The request's model names the bot. Clients authenticate
+// with a personal access token holding the bots:chat scope, usually one
+// restricted to the bot, in which case the model may be omitted. Every
+// exchange is stored in a bot session, so memory formation and token usage
+// accounting work as for any other chat.
+type OpenAICompatHandler struct {
+	resolver       *flow.Resolver
+	sessionService *session.Service
+	botService     *bots.Service
+	accountService *accounts.Service
+	logger         *slog.Logger
+}
+
+func NewOpenAICompatHandler(log *slog.Logger, resolver *flow.Resolver, sessionService *session.Service, botService *bots.Service, accountService *accounts.Service) *OpenAICompatHandler {
+	return &OpenAICompatHandler{
+		resolver:       resolver,
+		sessionService: sessionService,
+		botService:     botService,
+		accountService: accountService,
+		logger:         log.With(slog.String("handler", "openai_compat")),
+	}
+}
+
+func (h *OpenAICompatHandler) Register(e *echo.Echo) {
+	chat := auth.RequireScope(auth.ScopeBotsChat)
+	group := e.Group("/v1")
+	group.GET("/models", h.ListModels, chat)
+	group.POST("/chat/completions", h.ChatCompletions, chat)
+	group.POST("/responses", h.Responses, chat)
+}
+
+// ListModels godoc
+// @Summary List bots as OpenAI models
+// @Description List the bots the caller can chat with, in the OpenAI model list format
+// @Tags openai
+// @Produce json
+// @Success 200 {object} openaicompat.ModelList
+// @Failure 401 {object} openaicompat.ErrorResponse
+// @Failure 500 {object} openaicompat.ErrorResponse
+// @Router /v1/models [get].
+func (h *OpenAICompatHandler) ListModels(c echo.Context) error {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	items, err := h.botService.ListAccessible(c.Request().Context(), userID)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	list := openaicompat.ModelList{Object: openaicompat.ObjectList, Data: []openaicompat.Model{}}
+	for _, bot := range filterTokenBots(c, items) {
+		list.Data = append(list.Data, openaicompat.Model{
+			ID:      bot.ID,
+			Object:  openaicompat.ObjectModel,
+			Created: bot.CreatedAt.Unix(),
+			OwnedBy: "memoh",
+		})
+	}
+	return c.JSON(http.StatusOK, list)
+}
+
+// ChatCompletions godoc
+// @Summary Chat with a bot through the Chat Completions API
+// @Description OpenAI-compatible chat completion. The model is a bot ID; it may be omitted with a token restricted to one bot. Tools the bot uses are reported as tool_call annotations. Pass X-Memoh-Session-Id to continue a session; otherwise a new session is created and earlier request messages are used as context. The session is returned in the same header.
+// @Tags openai
+// @Accept json
+// @Produce json
+// @Produce text/event-stream
+// @Param X-Memoh-Session-Id header string false "Session to continue"
+// @Param payload body openaicompat.ChatCompletionRequest true "Chat completion request"
+// @Success 200 {object} openaicompat.ChatCompletion
+// @Failure 400 {object} openaicompat.ErrorResponse
+// @Failure 401 {object} openaicompat.ErrorResponse
+// @Failure 403 {object} openaicompat.ErrorResponse
+// @Failure 404 {object} openaicompat.ErrorResponse
+// @Failure 500 {object} openaicompat.ErrorResponse
+// @Router /v1/chat/completions [post].
+func (h *OpenAICompatHandler) ChatCompletions(c echo.Context) error {
+	var req openaicompat.ChatCompletionRequest
+	if err := c.Bind(&req); err != nil {
+		return openAIError(c, echo.NewHTTPError(http.StatusBadRequest, err.Error()))
+	}
+	turn, err := openaicompat.ChatTurn(req)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	target, err := h.resolveTarget(c, req.Model, c.Request().Header.Get(openaicompat.SessionHeader))
+	if err != nil {
+		return openAIError(c, err)
+	}
+	chatReq, err := target.chatRequest(turn, req.ReasoningEffort)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	c.Response().Header().Set(openaicompat.SessionHeader, target.sessionID)
+
+	id := openaicompat.NewChatCompletionID()
+	created := time.Now().Unix()
+	if req.Stream {
+		includeUsage := req.StreamOptions != nil && req.StreamOptions.IncludeUsage
+		stream := openaicompat.NewChatStream(id, target.model, created, includeUsage, len(turn.OutputSchema) > 0)
+		return h.stream(c, chatReq, nil, func(w *bufio.Writer, f http.Flusher, event agentpkg.StreamEvent) error {
+			for _, chunk := range stream.Handle(event) {
+				if err := writeSSEJSON(w, f, chunk); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, func(w *bufio.Writer, f http.Flusher, runErr error) error {
+			if failure := streamFailure(stream.Done(), stream.Failure(), runErr); failure != "" {
+				return writeSSEJSON(w, f, openAIErrorBody(http.StatusInternalServerError, failure))
+			}
+			return writeSSEData(w, f, "[DONE]")
+		})
+	}
+
+	resp, err := h.resolver.Chat(c.Request().Context(), chatReq)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	reply := openaicompat.ReplyFromMessages(resp.Messages, resp.Structured, resp.Usage)
+	return c.JSON(http.StatusOK, openaicompat.ChatCompletionFromReply(id, target.model, created, reply))
+}
+
+// Responses godoc
+// @Summary Chat with a bot through the Responses API
+// @Description OpenAI-compatible response. The model is a bot ID; it may be omitted with a token restricted to one bot. Response IDs identify the bot session, so previous_response_id continues it; X-Memoh-Session-Id works as well. Tools the bot uses are reported as tool_call annotations.
+// @Tags openai
+// @Accept json
+// @Produce json
+// @Produce text/event-stream
+// @Param X-Memoh-Session-Id header string false "Session to continue"
+// @Param payload body openaicompat.ResponseRequest true "Response request"
+// @Success 200 {object} openaicompat.Response
+// @Failure 400 {object} openaicompat.ErrorResponse
+// @Failure 401 {object} openaicompat.ErrorResponse
+// @Failure 403 {object} openaicompat.ErrorResponse
+// @Failure 404 {object} openaicompat.ErrorResponse
+// @Failure 500 {object} openaicompat.ErrorResponse
+// @Router /v1/responses [post].
+func (h *OpenAICompatHandler) Responses(c echo.Context) error {
+	var req openaicompat.ResponseRequest
+	if err := c.Bind(&req); err != nil {
+		return openAIError(c, echo.NewHTTPError(http.StatusBadRequest, err.Error()))
+	}
+	turn, err := openaicompat.ResponseTurn(req)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	sessionID := c.Request().Header.Get(openaicompat.SessionHeader)
+	if previous := strings.TrimSpace(req.PreviousResponseID); previous != "" {
+		var ok bool
+		if sessionID, ok = openaicompat.SessionFromResponseID(previous); !ok {
+			return openAIError(c, echo.NewHTTPError(http.StatusNotFound, "previous response not found"))
+		}
+	}
+	target, err := h.resolveTarget(c, req.Model, sessionID)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	effort := ""
+	if req.Reasoning != nil {
+		effort = req.Reasoning.Effort
+	}
+	chatReq, err := target.chatRequest(turn, effort)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	c.Response().Header().Set(openaicompat.SessionHeader, target.sessionID)
+
+	id := openaicompat.NewResponseID(target.sessionID)
+	created := time.Now().Unix()
+	if req.Stream {
+		stream := openaicompat.NewResponseStream(id, target.model, req.PreviousResponseID, created, len(turn.OutputSchema) > 0)
+		writeEvents := func(w *bufio.Writer, f http.Flusher, events []openaicompat.ResponseStreamEvent) error {
+			for _, event := range events {
+				if err := writeSSEEvent(w, f, event.Type, event); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return h.stream(c, chatReq, func(w *bufio.Writer, f http.Flusher) error {
+			return writeEvents(w, f, stream.Start())
+		}, func(w *bufio.Writer, f http.Flusher, event agentpkg.StreamEvent) error {
+			return writeEvents(w, f, stream.Handle(event))
+		}, func(w *bufio.Writer, f http.Flusher, runErr error) error {
+			if failure := streamFailure(stream.Done(), "", runErr); failure != "" {
+				return writeEvents(w, f, stream.Fail(failure))
+			}
+			return nil
+		})
+	}
+
+	resp, err := h.resolver.Chat(c.Request().Context(), chatReq)
+	if err != nil {
+		return openAIError(c, err)
+	}
+	reply := openaicompat.ReplyFromMessages(resp.Messages, resp.Structured, resp.Usage)
+	response := openaicompat.ResponseFromReply(id, target.model, created, reply)
+	response.PreviousResponseID = req.PreviousResponseID
+	return c.JSON(http.StatusOK, response)
+}
+
+// openAITarget is the bot and session a request runs in.
+type openAITarget struct {
+	userID     string
+	bot        bots.Bot
+	model      string
+	sessionID  string
+	newSession bool
+}
+
+// resolveTarget authorizes the bot named by model and picks the session:
+// the requested one, which must be a chat session of the caller on that
+// bot, or a new one.
+func (h *OpenAICompatHandler) resolveTarget(c echo.Context, model, sessionID string) (openAITarget, error) {
+	userID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return openAITarget{}, err
+	}
+	ctx := c.Request().Context()
+	restricted := tokenBotRestriction(c)
+	model = strings.TrimSpace(model)
+	if model == "" {
+		model = restricted
+	}
+	if model == "" {
+		return openAITarget{}, echo.NewHTTPError(http.StatusBadRequest, "model is required")
+	}
+	bot, err := AuthorizeBotAccessWithPermission(ctx, h.botService, h.accountService, userID, model, bots.PermissionChat)
+	if err != nil {
+		return openAITarget{}, err
+	}
+	if restricted != "" && bot.ID != restricted {
+		return openAITarget{}, echo.NewHTTPError(http.StatusForbidden, "token is restricted to another bot")
+	}
+	if h.sessionService == nil || h.resolver == nil {
+		return openAITarget{}, echo.NewHTTPError(http.StatusInternalServerError, "chat is not configured")
+	}
+	target := openAITarget{userID: userID, bot: bot, model: model}
+
+	if sessionID = strings.TrimSpace(sessionID); sessionID != "" {
+		sess, err := h.sessionService.Get(ctx, sessionID)
+		if err != nil || sess.BotID != bot.ID || sess.CreatedByUserID != userID {
+			return openAITarget{}, echo.NewHTTPError(http.StatusNotFound, "session not found")
+		}
+		if sess.Type != session.TypeChat {
+			return openAITarget{}, echo.NewHTTPError(http.StatusBadRequest, "only chat sessions can be continued")
+		}
+		target.sessionID = sess.ID
+		return target, nil
+	}
+	sess, err := h.sessionService.Create(ctx, session.CreateInput{
+		BotID:           bot.ID,
+		ChannelType:     openAIChannelType,
+		Type:            session.TypeChat,
+		CreatedByUserID: userID,
+	})
+	if err != nil {
+		return openAITarget{}, sessionServiceError(err)
+	}
+	target.sessionID = sess.ID
+	target.newSession = true
+	return target, nil
+}
+
+// chatRequest builds the bot turn. Earlier request messages are only used
+// as context for a new session; a continued session has its own history.
+func (t openAITarget) chatRequest(turn openaicompat.Turn, reasoningEffort string) (conversation.ChatRequest, error) {
+	if _, err := structured.Compile(turn.OutputSchema); err != nil {
+		return conversation.ChatRequest{}, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return conversation.ChatRequest{
+		BotID:                   t.bot.ID,
+		ChatID:                  t.bot.ID,
+		SessionID:               t.sessionID,
+		UserID:                  t.userID,
+		SourceChannelIdentityID: t.userID,
+		ConversationType:        channel.ConversationTypePrivate,
+		Query:                   turn.Query,
+		Attachments:             turn.Attachments,
+		Messages:                turn.ContextMessages(t.newSession),
+		ReasoningEffort:         strings.TrimSpace(reasoningEffort),
+		OutputSchema:            turn.OutputSchema,
+	}, nil
+}
+
+type (
+	sseStart  func(*bufio.Writer, http.Flusher) error
+	sseEvent  func(*bufio.Writer, http.Flusher, agentpkg.StreamEvent) error
+	sseFinish func(*bufio.Writer, http.Flusher, error) error
+)
+
+// stream runs the turn and relays agent events as server-sent events. The
+// response headers are only sent with the first event, so a run that fails
+// to start still gets a plain JSON error. A client that disconnects aborts
+// the run; what was produced so far is kept in the session.
+func (h *OpenAICompatHandler) stream(c echo.Context, req conversation.ChatRequest, start sseStart, handle sseEvent, finish sseFinish) error {
+	ctx := c.Request().Context()
+	eventCh := make(chan flow.WSStreamEvent, 64)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(eventCh)
+		errCh <- h.resolver.StreamChatWS(ctx, req, eventCh, nil)
+	}()
+
+	var writer *bufio.Writer
+	var flusher http.Flusher
+	var writeErr error
+	open := func() error {
+		if writer != nil {
+			return nil
+		}
+		var ok bool
+		if flusher, ok = c.Response().Writer.(http.Flusher); !ok {
+			return errors.New("streaming not supported")
+		}
+		c.Response().Header().Set(echo.HeaderContentType, "text/event-stream")
+		c.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+		c.Response().Header().Set(echo.HeaderConnection, "keep-alive")
+		c.Response().WriteHeader(http.StatusOK)
+		writer = bufio.NewWriter(c.Response().Writer)
+		if start != nil {
+			return start(writer, flusher)
+		}
+		return nil
+	}
+
+	for raw := range eventCh {
+		if writeErr != nil {
+			continue
+		}
+		var event agentpkg.StreamEvent
+		if err := json.Unmarshal(raw, &event); err != nil {
+			continue
+		}
+		if writeErr = open(); writeErr == nil {
+			writeErr = handle(writer, flusher, event)
+		}
+	}
+	runErr := <-errCh
+	if writeErr != nil {
+		h.logger.Debug("openai stream client gone", slog.String("bot_id", req.BotID), slog.Any("error", writeErr))
+		return nil
+	}
+	if writer == nil {
+		if runErr == nil {
+			runErr = errors.New("stream ended without output")
+		}
+		return openAIError(c, runErr)
+	}
+	if runErr != nil && ctx.Err() == nil {
+		h.logger.Error("openai stream error", slog.String("bot_id", req.BotID), slog.String("session_id", req.SessionID), slog.Any("error", runErr))
+	}
+	_ = finish(writer, flusher, runErr)
+	return nil
+}
+
+// streamFailure returns the error message a stream ends with, or "" when
+// the run finished normally.
+func streamFailure(done bool, failure string, runErr error) string {
+	switch {
+	case failure != "":
+		return failure
+	case done:
+		return ""
+	case runErr != nil:
+		return runErr.Error()
+	default:
+		return "stream ended before the run finished"
+	}
+}
+
+func writeSSEEvent(writer *bufio.Writer, flusher http.Flusher, event string, payload any) error {
+	if _, err := fmt.Fprintf(writer, "event: %s\n", event); err != nil {
+		return err
+	}
+	return writeSSEJSON(writer, flusher, payload)
+}
+
+// openAIError writes err in the OpenAI error envelope, which OpenAI SDKs
+// surface to their callers.
+func openAIError(c echo.Context, err error) error {
+	status := http.StatusInternalServerError
+	message := err.Error()
+	var httpErr *echo.HTTPError
+	switch {
+	case errors.As(err, &httpErr):
+		status = httpErr.Code
+		message = fmt.Sprint(httpErr.Message)
+	case errors.Is(err, openaicompat.ErrInvalidRequest), errors.Is(err, structured.ErrInvalidSchema):
+		status = http.StatusBadRequest
+	}
+	return c.JSON(status, openAIErrorBody(status, message))
+}
+
+func openAIErrorBody(status int, message string) openaicompat.ErrorResponse {
+	errType := "server_error"
+	switch status {
+	case http.StatusBadRequest:
+		errType = "invalid_request_error"
+	case http.StatusUnauthorized:
+		errType = "authentication_error"
+	case http.StatusForbidden:
+		errType = "permission_error"
+	case http.StatusNotFound:
+		errType = "not_found_error"
+	}
+	return openaicompat.ErrorResponse{Error: openaicompat.ErrorBody{Message: message, Type: errType}}
+}
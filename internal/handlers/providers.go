@@ -335,8 +335,10 @@ func (h *ProvidersHandler) ImportModels(c echo.Context) error {
 		if strings.TrimSpace(m.Type) == string(models.ModelTypeEmbedding) {
 			modelType = models.ModelTypeEmbedding
 		}
+		// nil means the provider did not report capabilities; an empty
+		// slice means it did and the model has none.
 		compatibilities := m.Compatibilities
-		if len(compatibilities) == 0 && modelType == models.ModelTypeChat {
+		if compatibilities == nil && modelType == models.ModelTypeChat {
 			compatibilities = []string{models.CompatVision, models.CompatToolCall, models.CompatReasoning}
 		}
 		name := strings.TrimSpace(m.Name)
@@ -352,6 +354,7 @@ func (h *ProvidersHandler) ImportModels(c echo.Context) error {
 				Compatibilities:  compatibilities,
 				ReasoningEfforts: m.ReasoningEfforts,
 				Dimensions:       m.Dimensions,
+				ContextWindow:    m.ContextWindow,
 			},
 		})
 		if err != nil {
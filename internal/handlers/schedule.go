@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -9,8 +10,10 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/auth"
 	"github.com/memohai/memoh/internal/bots"
 	"github.com/memohai/memoh/internal/schedule"
+	"github.com/memohai/memoh/internal/structured"
 )
 
 type ScheduleHandler struct {
@@ -30,15 +33,19 @@ func NewScheduleHandler(log *slog.Logger, service *schedule.Service, botService
 }
 
 func (h *ScheduleHandler) Register(e *echo.Echo) {
+	read := auth.RequireBotScope(auth.ScopeSchedulesRead, "bot_id")
+	write := auth.RequireBotScope(auth.ScopeSchedulesWrite, "bot_id")
 	group := e.Group("/bots/:bot_id/schedule")
-	group.POST("", h.Create)
-	group.GET("", h.List)
-	group.GET("/logs", h.ListLogs)
-	group.DELETE("/logs", h.DeleteLogs)
-	group.GET("/:id", h.Get)
-	group.GET("/:id/logs", h.ListLogsBySchedule)
-	group.PUT("/:id", h.Update)
-	group.DELETE("/:id", h.Delete)
+	group.POST("", h.Create, write)
+	group.GET("", h.List, read)
+	group.GET("/logs", h.ListLogs, read)
+	group.DELETE("/logs", h.DeleteLogs, write)
+	group.GET("/logs/failed", h.ListFailedLogs, read)
+	group.POST("/logs/:log_id/replay", h.ReplayLog, write)
+	group.GET("/:id", h.Get, read)
+	group.GET("/:id/logs", h.ListLogsBySchedule, read)
+	group.PUT("/:id", h.Update, write)
+	group.DELETE("/:id", h.Delete, write)
 }
 
 // Create godoc
@@ -68,6 +75,9 @@ func (h *ScheduleHandler) Create(c echo.Context) error {
 	}
 	resp, err := h.service.Create(c.Request().Context(), botID, req)
 	if err != nil {
+		if errors.Is(err, structured.ErrInvalidSchema) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusCreated, resp)
@@ -138,12 +148,13 @@ func (h *ScheduleHandler) Get(c echo.Context) error {
 
 // Update godoc
 // @Summary Update schedule
-// @Description Update a schedule by ID
+// @Description Update a schedule by ID. Only the retry policy of a workflow's schedule can be changed; 409 is returned for other changes.
 // @Tags schedule
 // @Param id path string true "Schedule ID"
 // @Param payload body schedule.UpdateRequest true "Schedule payload"
 // @Success 200 {object} schedule.Schedule
 // @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /bots/{bot_id}/schedule/{id} [put].
 func (h *ScheduleHandler) Update(c echo.Context) error {
@@ -175,6 +186,12 @@ func (h *ScheduleHandler) Update(c echo.Context) error {
 	}
 	resp, err := h.service.Update(c.Request().Context(), id, req)
 	if err != nil {
+		if errors.Is(err, structured.ErrInvalidSchema) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		if errors.Is(err, schedule.ErrManagedByWorkflow) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, resp)
@@ -182,11 +199,12 @@ func (h *ScheduleHandler) Update(c echo.Context) error {
 
 // Delete godoc
 // @Summary Delete schedule
-// @Description Delete a schedule by ID
+// @Description Delete a schedule by ID. The schedule of a workflow is removed with the workflow or its cron pattern; deleting it returns 409.
 // @Tags schedule
 // @Param id path string true "Schedule ID"
 // @Success 204 "No Content"
 // @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /bots/{bot_id}/schedule/{id} [delete].
 func (h *ScheduleHandler) Delete(c echo.Context) error {
@@ -213,6 +231,9 @@ func (h *ScheduleHandler) Delete(c echo.Context) error {
 		return err
 	}
 	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		if errors.Is(err, schedule.ErrManagedByWorkflow) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.NoContent(http.StatusNoContent)
@@ -287,6 +308,82 @@ func (h *ScheduleHandler) ListLogsBySchedule(c echo.Context) error {
 	return c.JSON(http.StatusOK, schedule.ListLogsResponse{Items: items, TotalCount: total})
 }
 
+// ListFailedLogs godoc
+// @Summary List failed schedule runs
+// @Description List schedule runs of a bot that failed for good (errors and dead letters)
+// @Tags schedule
+// @Param bot_id path string true "Bot ID"
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} schedule.ListLogsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/schedule/logs/failed [get].
+func (h *ScheduleHandler) ListFailedLogs(c echo.Context) error {
+	userID, err := h.requireUserID(c)
+	if err != nil {
+		return err
+	}
+	botID := strings.TrimSpace(c.Param("bot_id"))
+	if botID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "bot id is required")
+	}
+	if _, err := h.authorizeBotAccess(c.Request().Context(), userID, botID); err != nil {
+		return err
+	}
+
+	limit, offset := parseOffsetLimit(c)
+	items, total, err := h.service.ListFailedLogs(c.Request().Context(), botID, limit, offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, schedule.ListLogsResponse{Items: items, TotalCount: total})
+}
+
+// ReplayLog godoc
+// @Summary Replay failed schedule run
+// @Description Re-run a failed schedule run in the background with its original trigger event. A run is not replayed again while a replay of it is in flight or after one succeeded
+// @Tags schedule
+// @Param bot_id path string true "Bot ID"
+// @Param log_id path string true "Schedule log ID"
+// @Success 202 "Accepted"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /bots/{bot_id}/schedule/logs/{log_id}/replay [post].
+func (h *ScheduleHandler) ReplayLog(c echo.Context) error {
+	userID, err := h.requireUserID(c)
+	if err != nil {
+		return err
+	}
+	botID := strings.TrimSpace(c.Param("bot_id"))
+	if botID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "bot id is required")
+	}
+	logID := strings.TrimSpace(c.Param("log_id"))
+	if logID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "log id is required")
+	}
+	if _, err := h.authorizeBotAccess(c.Request().Context(), userID, botID); err != nil {
+		return err
+	}
+	item, err := h.service.GetLog(c.Request().Context(), logID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if item.BotID != botID {
+		return echo.NewHTTPError(http.StatusForbidden, "bot mismatch")
+	}
+	if err := h.service.Replay(c.Request().Context(), logID); err != nil {
+		if errors.Is(err, schedule.ErrReplayInProgress) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.NoContent(http.StatusAccepted)
+}
+
 // DeleteLogs godoc
 // @Summary Delete schedule logs
 // @Description Delete all schedule execution logs for a bot
@@ -10,6 +10,7 @@ import (
 
 	"github.com/memohai/memoh/internal/accounts"
 	"github.com/memohai/memoh/internal/acpprofile"
+	"github.com/memohai/memoh/internal/auth"
 	"github.com/memohai/memoh/internal/bots"
 	"github.com/memohai/memoh/internal/session"
 )
@@ -41,12 +42,13 @@ func NewSessionHandler(log *slog.Logger, sessionService *session.Service, acpPoo
 
 // Register registers session routes.
 func (h *SessionHandler) Register(e *echo.Echo) {
+	chat := auth.RequireBotScope(auth.ScopeBotsChat, "bot_id")
 	g := e.Group("/bots/:bot_id/sessions")
-	g.POST("", h.CreateSession)
-	g.GET("", h.ListSessions)
-	g.GET("/:session_id", h.GetSession)
-	g.PATCH("/:session_id", h.UpdateSession)
-	g.DELETE("/:session_id", h.DeleteSession)
+	g.POST("", h.CreateSession, chat)
+	g.GET("", h.ListSessions, chat)
+	g.GET("/:session_id", h.GetSession, chat)
+	g.PATCH("/:session_id", h.UpdateSession, chat)
+	g.DELETE("/:session_id", h.DeleteSession, chat)
 }
 
 type createSessionRequest struct {
@@ -98,7 +98,7 @@ func (h *SettingsHandler) Upsert(c echo.Context) error {
 	}
 	resp, err := h.service.UpsertBot(c.Request().Context(), botID, req)
 	if err != nil {
-		if errors.Is(err, settings.ErrInvalidModelRef) {
+		if errors.Is(err, settings.ErrInvalidModelRef) || errors.Is(err, settings.ErrInvalidToolPolicy) {
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 		if errors.Is(err, settings.ErrModelIDAmbiguous) {
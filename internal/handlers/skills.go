@@ -94,19 +94,29 @@ func (h *ContainerdHandler) UpsertSkills(c echo.Context) error {
 	if len(req.Skills) == 0 {
 		return echo.NewHTTPError(http.StatusBadRequest, "skills is required")
 	}
+	dirs := make([]string, len(req.Skills))
+	names := make([]string, len(req.Skills))
+	for i, raw := range req.Skills {
+		parsed := skillset.ParseFile(raw, "")
+		dirPath, dirErr := skillset.ManagedSkillDirForName(parsed.Name)
+		if dirErr != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "skill must have a valid name in YAML frontmatter")
+		}
+		dirs[i] = dirPath
+		names[i] = parsed.Name
+	}
 
 	ctx := c.Request().Context()
+	if h.skillInstallHook != nil {
+		h.skillInstallHook.BeforeSkillInstall(ctx, botID, strings.Join(names, ", "))
+	}
 	client, err := h.getGRPCClient(ctx, botID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("container not reachable: %v", err))
 	}
 
-	for _, raw := range req.Skills {
-		parsed := skillset.ParseFile(raw, "")
-		dirPath, dirErr := skillset.ManagedSkillDirForName(parsed.Name)
-		if dirErr != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, "skill must have a valid name in YAML frontmatter")
-		}
+	for i, raw := range req.Skills {
+		dirPath := dirs[i]
 		if err := client.Mkdir(ctx, dirPath); err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("mkdir failed: %v", err))
 		}
@@ -30,7 +30,9 @@ type SupermarketHandler struct {
 	containers     bridge.Provider
 	botService     *bots.Service
 	accountService *accounts.Service
-	logger         *slog.Logger
+	// skillInstallHook snapshots the workspace before a skill is installed.
+	skillInstallHook skillInstallHook
+	logger           *slog.Logger
 }
 
 func NewSupermarketHandler(
@@ -248,6 +250,9 @@ func (h *SupermarketHandler) InstallSkill(c echo.Context) error {
 	}
 
 	ctx := c.Request().Context()
+	if h.skillInstallHook != nil {
+		h.skillInstallHook.BeforeSkillInstall(ctx, botID, skillID)
+	}
 	client, err := h.containers.MCPClient(ctx, botID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("container not reachable: %v", err))
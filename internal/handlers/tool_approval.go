@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/memohai/memoh/internal/accounts"
 	"github.com/memohai/memoh/internal/bots"
 	"github.com/memohai/memoh/internal/conversation/flow"
+	"github.com/memohai/memoh/internal/toolapproval"
 )
 
 type ToolApprovalHandler struct {
@@ -18,18 +20,20 @@ type ToolApprovalHandler struct {
 	botService     *bots.Service
 	accountService *accounts.Service
 	resolver       *flow.Resolver
+	approvals      *toolapproval.Service
 }
 
 type ToolApprovalDecisionRequest struct {
 	Reason string `json:"reason,omitempty"`
 }
 
-func NewToolApprovalHandler(log *slog.Logger, botService *bots.Service, accountService *accounts.Service, resolver *flow.Resolver) *ToolApprovalHandler {
+func NewToolApprovalHandler(log *slog.Logger, botService *bots.Service, accountService *accounts.Service, resolver *flow.Resolver, approvals *toolapproval.Service) *ToolApprovalHandler {
 	return &ToolApprovalHandler{
 		logger:         log.With(slog.String("handler", "tool_approval")),
 		botService:     botService,
 		accountService: accountService,
 		resolver:       resolver,
+		approvals:      approvals,
 	}
 }
 
@@ -37,6 +41,44 @@ func (h *ToolApprovalHandler) Register(e *echo.Echo) {
 	group := e.Group("/bots/:bot_id/tool-approvals")
 	group.POST("/:approval_id/approve", h.Approve)
 	group.POST("/:approval_id/reject", h.Reject)
+	group.POST("/dry-run", h.DryRun)
+}
+
+// DryRun godoc
+// @Summary Test tool approval policies
+// @Description Evaluate a sample tool call against the bot's tool approval policies, or against an unsaved config, and report which rule decided
+// @Tags tool-approvals
+// @Param bot_id path string true "Bot ID"
+// @Param payload body toolapproval.DryRunRequest true "Sample tool call"
+// @Success 200 {object} toolapproval.PolicyResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/tool-approvals/dry-run [post].
+func (h *ToolApprovalHandler) DryRun(c echo.Context) error {
+	channelIdentityID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return err
+	}
+	botID := strings.TrimSpace(c.Param("bot_id"))
+	if botID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "bot id is required")
+	}
+	if _, err := AuthorizeBotAccess(c.Request().Context(), h.botService, h.accountService, channelIdentityID, botID); err != nil {
+		return err
+	}
+	var req toolapproval.DryRunRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	result, err := h.approvals.DryRun(c.Request().Context(), botID, req)
+	if err != nil {
+		if errors.Is(err, toolapproval.ErrInvalidDryRun) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
 }
 
 // Approve godoc
@@ -48,6 +90,8 @@ func (h *ToolApprovalHandler) Register(e *echo.Echo) {
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} ErrorResponse
 // @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /bots/{bot_id}/tool-approvals/{approval_id}/approve [post].
 func (h *ToolApprovalHandler) Approve(c echo.Context) error {
@@ -63,6 +107,8 @@ func (h *ToolApprovalHandler) Approve(c echo.Context) error {
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} ErrorResponse
 // @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /bots/{bot_id}/tool-approvals/{approval_id}/reject [post].
 func (h *ToolApprovalHandler) Reject(c echo.Context) error {
@@ -91,6 +137,14 @@ func (h *ToolApprovalHandler) respond(c echo.Context, decision string) error {
 		Decision:               decision,
 		Reason:                 strings.TrimSpace(req.Reason),
 	}, nil); err != nil {
+		switch {
+		case errors.Is(err, toolapproval.ErrForbidden):
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case errors.Is(err, toolapproval.ErrNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case errors.Is(err, toolapproval.ErrAlreadyDecided):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, map[string]string{"status": decision})
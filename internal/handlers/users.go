@@ -75,7 +75,7 @@ func NewUsersHandler(log *slog.Logger, service *accounts.Service, botService *bo
 
 func (h *UsersHandler) Register(e *echo.Echo) {
 	userGroup := e.Group("/users")
-	userGroup.GET("/me", h.GetMe)
+	userGroup.GET("/me", h.GetMe, auth.RequireScope(auth.ScopeAccountRead))
 	userGroup.PUT("/me", h.UpdateMe)
 	userGroup.PUT("/me/password", h.UpdateMyPassword)
 	userGroup.GET("", h.ListUsers)
@@ -86,20 +86,20 @@ func (h *UsersHandler) Register(e *echo.Echo) {
 	userGroup.DELETE("/:id", h.RemoveMember)
 
 	botGroup := e.Group("/bots")
-	botGroup.POST("", h.CreateBot)
-	botGroup.GET("", h.ListBots)
+	botGroup.POST("", h.CreateBot, auth.RequireUnrestrictedScope(auth.ScopeBotsWrite))
+	botGroup.GET("", h.ListBots, auth.RequireScope(auth.ScopeBotsRead))
 	botGroup.GET("/name-availability", h.CheckBotName)
-	botGroup.GET("/:id", h.GetBot)
-	botGroup.GET("/:id/checks", h.ListBotChecks)
-	botGroup.PUT("/:id", h.UpdateBot)
+	botGroup.GET("/:id", h.GetBot, auth.RequireBotScope(auth.ScopeBotsRead, "id"))
+	botGroup.GET("/:id/checks", h.ListBotChecks, auth.RequireBotScope(auth.ScopeBotsRead, "id"))
+	botGroup.PUT("/:id", h.UpdateBot, auth.RequireBotScope(auth.ScopeBotsWrite, "id"))
 	botGroup.PUT("/:id/owner", h.TransferBotOwner)
-	botGroup.DELETE("/:id", h.DeleteBot)
+	botGroup.DELETE("/:id", h.DeleteBot, auth.RequireBotScope(auth.ScopeBotsWrite, "id"))
 	botGroup.GET("/:id/channel/:platform", h.GetBotChannelConfig)
 	botGroup.PUT("/:id/channel/:platform", h.UpsertBotChannelConfig)
 	botGroup.PATCH("/:id/channel/:platform/status", h.UpdateBotChannelStatus)
 	botGroup.DELETE("/:id/channel/:platform", h.DeleteBotChannelConfig)
-	botGroup.POST("/:id/channel/:platform/send", h.SendBotMessage)
-	botGroup.POST("/:id/channel/:platform/send_chat", h.SendBotMessageSession)
+	botGroup.POST("/:id/channel/:platform/send", h.SendBotMessage, auth.RequireBotScope(auth.ScopeBotsChat, "id"))
+	botGroup.POST("/:id/channel/:platform/send_chat", h.SendBotMessageSession, auth.RequireBotScope(auth.ScopeBotsChat, "id"))
 }
 
 // GetMe godoc
@@ -685,7 +685,7 @@ func (h *UsersHandler) ListBots(c echo.Context) error {
 		if err := h.attachCurrentUserPermissionsList(c.Request().Context(), channelIdentityID, items); err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 		}
-		return c.JSON(http.StatusOK, bots.ListBotsResponse{Items: scrubBotsForResponse(items)})
+		return c.JSON(http.StatusOK, bots.ListBotsResponse{Items: scrubBotsForResponse(filterTokenBots(c, items))})
 	}
 	items, err := h.botService.ListAccessible(c.Request().Context(), channelIdentityID)
 	if err != nil {
@@ -694,7 +694,7 @@ func (h *UsersHandler) ListBots(c echo.Context) error {
 	if err := h.attachCurrentUserPermissionsList(c.Request().Context(), channelIdentityID, items); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-	return c.JSON(http.StatusOK, bots.ListBotsResponse{Items: scrubBotsForResponse(items)})
+	return c.JSON(http.StatusOK, bots.ListBotsResponse{Items: scrubBotsForResponse(filterTokenBots(c, items))})
 }
 
 // GetBot godoc
@@ -0,0 +1,348 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/auth"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/workflow"
+)
+
+// WorkflowHandler manages a bot's workflows, triggers their runs and serves
+// the run history.
+type WorkflowHandler struct {
+	service        *workflow.Service
+	botService     *bots.Service
+	accountService *accounts.Service
+	logger         *slog.Logger
+}
+
+func NewWorkflowHandler(log *slog.Logger, service *workflow.Service, botService *bots.Service, accountService *accounts.Service) *WorkflowHandler {
+	return &WorkflowHandler{
+		service:        service,
+		botService:     botService,
+		accountService: accountService,
+		logger:         log.With(slog.String("handler", "workflows")),
+	}
+}
+
+func (h *WorkflowHandler) Register(e *echo.Echo) {
+	read := auth.RequireBotScope(auth.ScopeSchedulesRead, "bot_id")
+	write := auth.RequireBotScope(auth.ScopeSchedulesWrite, "bot_id")
+	group := e.Group("/bots/:bot_id/workflows")
+	group.POST("", h.Create, write)
+	group.GET("", h.List, read)
+	group.GET("/:id", h.Get, read)
+	group.PUT("/:id", h.Update, write)
+	group.DELETE("/:id", h.Delete, write)
+	group.POST("/:id/runs", h.Trigger, write)
+	group.GET("/:id/runs", h.ListRuns, read)
+	group.GET("/:id/runs/:run_id", h.GetRun, read)
+	group.POST("/:id/runs/:run_id/cancel", h.CancelRun, write)
+}
+
+// Create godoc
+// @Summary Create workflow
+// @Description Create a workflow from its YAML or JSON definition
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Param payload body workflow.CreateRequest true "Workflow payload"
+// @Success 201 {object} workflow.Workflow
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows [post].
+func (h *WorkflowHandler) Create(c echo.Context) error {
+	botID, _, err := h.authorize(c)
+	if err != nil {
+		return err
+	}
+	var req workflow.CreateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	item, err := h.service.Create(c.Request().Context(), botID, req)
+	if err != nil {
+		return workflowHTTPError(err)
+	}
+	return c.JSON(http.StatusCreated, item)
+}
+
+// List godoc
+// @Summary List workflows
+// @Description List the workflows of a bot
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Success 200 {object} workflow.ListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows [get].
+func (h *WorkflowHandler) List(c echo.Context) error {
+	botID, _, err := h.authorize(c)
+	if err != nil {
+		return err
+	}
+	items, err := h.service.List(c.Request().Context(), botID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, workflow.ListResponse{Items: items})
+}
+
+// Get godoc
+// @Summary Get workflow
+// @Description Get a workflow by ID
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Workflow ID"
+// @Success 200 {object} workflow.Workflow
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows/{id} [get].
+func (h *WorkflowHandler) Get(c echo.Context) error {
+	item, _, err := h.loadWorkflow(c)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, item)
+}
+
+// Update godoc
+// @Summary Update workflow
+// @Description Replace the definition of a workflow or enable and disable it. Runs in progress keep their definition.
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Workflow ID"
+// @Param payload body workflow.UpdateRequest true "Workflow payload"
+// @Success 200 {object} workflow.Workflow
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows/{id} [put].
+func (h *WorkflowHandler) Update(c echo.Context) error {
+	item, _, err := h.loadWorkflow(c)
+	if err != nil {
+		return err
+	}
+	var req workflow.UpdateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	updated, err := h.service.Update(c.Request().Context(), item.ID, req)
+	if err != nil {
+		return workflowHTTPError(err)
+	}
+	return c.JSON(http.StatusOK, updated)
+}
+
+// Delete godoc
+// @Summary Delete workflow
+// @Description Delete a workflow with its run history. Runs in progress are cancelled.
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Workflow ID"
+// @Success 204 "No Content"
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows/{id} [delete].
+func (h *WorkflowHandler) Delete(c echo.Context) error {
+	item, _, err := h.loadWorkflow(c)
+	if err != nil {
+		return err
+	}
+	if err := h.service.Delete(c.Request().Context(), item.ID); err != nil {
+		return workflowHTTPError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Trigger godoc
+// @Summary Run workflow
+// @Description Start a run of a workflow. The run continues in the background; poll it for progress.
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Workflow ID"
+// @Param payload body workflow.TriggerRequest false "Run inputs"
+// @Success 202 {object} workflow.Run
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows/{id}/runs [post].
+func (h *WorkflowHandler) Trigger(c echo.Context) error {
+	item, userID, err := h.loadWorkflow(c)
+	if err != nil {
+		return err
+	}
+	var req workflow.TriggerRequest
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(&req); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+	run, err := h.service.Trigger(c.Request().Context(), item.ID, workflow.TriggerManual, userID, req.Inputs)
+	if err != nil {
+		return workflowHTTPError(err)
+	}
+	return c.JSON(http.StatusAccepted, run)
+}
+
+// ListRuns godoc
+// @Summary List workflow runs
+// @Description List the runs of a workflow, newest first, without their steps
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Workflow ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Offset"
+// @Success 200 {object} workflow.RunListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows/{id}/runs [get].
+func (h *WorkflowHandler) ListRuns(c echo.Context) error {
+	item, _, err := h.loadWorkflow(c)
+	if err != nil {
+		return err
+	}
+	limit, err := parseOptionalInt(c.QueryParam("limit"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+	}
+	offset, err := parseOptionalInt(c.QueryParam("offset"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid offset")
+	}
+	items, total, err := h.service.ListRuns(c.Request().Context(), item.ID, limit, offset)
+	if err != nil {
+		return workflowHTTPError(err)
+	}
+	return c.JSON(http.StatusOK, workflow.RunListResponse{Items: items, Total: total})
+}
+
+// GetRun godoc
+// @Summary Get workflow run
+// @Description Get a run with the input, output and state of each step
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Workflow ID"
+// @Param run_id path string true "Run ID"
+// @Success 200 {object} workflow.Run
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows/{id}/runs/{run_id} [get].
+func (h *WorkflowHandler) GetRun(c echo.Context) error {
+	run, err := h.loadRun(c)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, run)
+}
+
+// CancelRun godoc
+// @Summary Cancel workflow run
+// @Description Cancel a running run. Pending approvals and questions of the run are withdrawn.
+// @Tags workflows
+// @Param bot_id path string true "Bot ID"
+// @Param id path string true "Workflow ID"
+// @Param run_id path string true "Run ID"
+// @Success 200 {object} workflow.Run
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/workflows/{id}/runs/{run_id}/cancel [post].
+func (h *WorkflowHandler) CancelRun(c echo.Context) error {
+	run, err := h.loadRun(c)
+	if err != nil {
+		return err
+	}
+	cancelled, err := h.service.CancelRun(c.Request().Context(), run.ID)
+	if err != nil {
+		return workflowHTTPError(err)
+	}
+	return c.JSON(http.StatusOK, cancelled)
+}
+
+func (h *WorkflowHandler) authorize(c echo.Context) (botID, userID string, err error) {
+	userID, err = RequireChannelIdentityID(c)
+	if err != nil {
+		return "", "", err
+	}
+	botID = strings.TrimSpace(c.Param("bot_id"))
+	if botID == "" {
+		return "", "", echo.NewHTTPError(http.StatusBadRequest, "bot id is required")
+	}
+	if _, err := AuthorizeBotAccess(c.Request().Context(), h.botService, h.accountService, userID, botID); err != nil {
+		return "", "", err
+	}
+	return botID, userID, nil
+}
+
+func (h *WorkflowHandler) loadWorkflow(c echo.Context) (workflow.Workflow, string, error) {
+	botID, userID, err := h.authorize(c)
+	if err != nil {
+		return workflow.Workflow{}, "", err
+	}
+	item, err := h.service.Get(c.Request().Context(), strings.TrimSpace(c.Param("id")))
+	if err != nil {
+		return workflow.Workflow{}, "", workflowHTTPError(err)
+	}
+	if item.BotID != botID {
+		return workflow.Workflow{}, "", echo.NewHTTPError(http.StatusNotFound, workflow.ErrNotFound.Error())
+	}
+	return item, userID, nil
+}
+
+func (h *WorkflowHandler) loadRun(c echo.Context) (workflow.Run, error) {
+	item, _, err := h.loadWorkflow(c)
+	if err != nil {
+		return workflow.Run{}, err
+	}
+	run, err := h.service.GetRun(c.Request().Context(), strings.TrimSpace(c.Param("run_id")))
+	if err != nil {
+		return workflow.Run{}, workflowHTTPError(err)
+	}
+	if run.WorkflowID != item.ID {
+		return workflow.Run{}, echo.NewHTTPError(http.StatusNotFound, workflow.ErrRunNotFound.Error())
+	}
+	return run, nil
+}
+
+func parseOptionalInt(raw string) (int, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		return 0, errors.New("invalid integer")
+	}
+	return value, nil
+}
+
+func workflowHTTPError(err error) error {
+	switch {
+	case errors.Is(err, workflow.ErrInvalidDefinition):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, workflow.ErrNotFound), errors.Is(err, workflow.ErrRunNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, workflow.ErrRunFinished):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/memohai/memoh/internal/accounts"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/snapshotpolicy"
+	"github.com/memohai/memoh/internal/workspace"
+)
+
+// skillInstallHook is called before a skill is written into a workspace, so
+// the workspace can be snapshotted first. It must not fail the install.
+type skillInstallHook interface {
+	BeforeSkillInstall(ctx context.Context, botID, skill string)
+}
+
+// SetSkillInstallHook sets the hook run before skills are installed.
+func (h *ContainerdHandler) SetSkillInstallHook(hook skillInstallHook) {
+	h.skillInstallHook = hook
+}
+
+// SetSkillInstallHook sets the hook run before supermarket skills are
+// installed.
+func (h *SupermarketHandler) SetSkillInstallHook(hook skillInstallHook) {
+	h.skillInstallHook = hook
+}
+
+type WorkspaceSnapshotHandler struct {
+	service        *snapshotpolicy.Service
+	botService     *bots.Service
+	accountService *accounts.Service
+}
+
+func NewWorkspaceSnapshotHandler(service *snapshotpolicy.Service, botService *bots.Service, accountService *accounts.Service) *WorkspaceSnapshotHandler {
+	return &WorkspaceSnapshotHandler{service: service, botService: botService, accountService: accountService}
+}
+
+func (h *WorkspaceSnapshotHandler) Register(e *echo.Echo) {
+	group := e.Group("/bots/:bot_id/container/snapshots")
+	group.GET("/policy", h.GetPolicy)
+	group.PUT("/policy", h.UpdatePolicy)
+	group.POST("/prune", h.Prune)
+	group.GET("/diff", h.Diff)
+}
+
+// GetPolicy godoc
+// @Summary Get snapshot policy
+// @Description Get the scheduled snapshot, retention and pre-tool-call snapshot settings of a bot. Bots without a saved policy get a disabled default.
+// @Tags containerd
+// @Param bot_id path string true "Bot ID"
+// @Success 200 {object} snapshotpolicy.Policy
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/container/snapshots/policy [get].
+func (h *WorkspaceSnapshotHandler) GetPolicy(c echo.Context) error {
+	botID, err := h.authorize(c, bots.PermissionWorkspaceRead)
+	if err != nil {
+		return err
+	}
+	policy, err := h.service.Get(c.Request().Context(), botID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, policy)
+}
+
+// UpdatePolicy godoc
+// @Summary Update snapshot policy
+// @Description Enable scheduled snapshots with hourly, daily, weekly and monthly retention, and snapshots before exec commands matching a pattern or before skill installs. Automatic snapshots archive /data without restarting the workspace, and only they are pruned.
+// @Tags containerd
+// @Param bot_id path string true "Bot ID"
+// @Param payload body snapshotpolicy.Policy true "Snapshot policy"
+// @Success 200 {object} snapshotpolicy.Policy
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/container/snapshots/policy [put].
+func (h *WorkspaceSnapshotHandler) UpdatePolicy(c echo.Context) error {
+	botID, err := h.authorize(c, bots.PermissionManage)
+	if err != nil {
+		return err
+	}
+	var req snapshotpolicy.Policy
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	policy, err := h.service.Update(c.Request().Context(), botID, req)
+	if err != nil {
+		if errors.Is(err, snapshotpolicy.ErrInvalidPolicy) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, policy)
+}
+
+// Prune godoc
+// @Summary Prune snapshots
+// @Description Delete the automatic snapshots the bot's policy no longer keeps. Manual snapshots are never deleted.
+// @Tags containerd
+// @Param bot_id path string true "Bot ID"
+// @Success 200 {object} snapshotpolicy.PruneResult
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/container/snapshots/prune [post].
+func (h *WorkspaceSnapshotHandler) Prune(c echo.Context) error {
+	botID, err := h.authorize(c, bots.PermissionManage)
+	if err != nil {
+		return err
+	}
+	result, err := h.service.Prune(c.Request().Context(), botID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// Diff godoc
+// @Summary Diff snapshot versions
+// @Description List the files added, removed or modified between two versions, or between a version and the live workspace when to is omitted.
+// @Tags containerd
+// @Param bot_id path string true "Bot ID"
+// @Param from query int true "Older version"
+// @Param to query int false "Newer version; omit to compare with the live workspace"
+// @Success 200 {object} workspace.VersionDiff
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bots/{bot_id}/container/snapshots/diff [get].
+func (h *WorkspaceSnapshotHandler) Diff(c echo.Context) error {
+	botID, err := h.authorize(c, bots.PermissionWorkspaceRead)
+	if err != nil {
+		return err
+	}
+	from, err := strconv.Atoi(strings.TrimSpace(c.QueryParam("from")))
+	if err != nil || from < 1 {
+		return echo.NewHTTPError(http.StatusBadRequest, "from must be a version >= 1")
+	}
+	to := 0
+	if raw := strings.TrimSpace(c.QueryParam("to")); raw != "" {
+		to, err = strconv.Atoi(raw)
+		if err != nil || to < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, "to must be a version >= 1")
+		}
+	}
+	diff, err := h.service.Diff(c.Request().Context(), botID, from, to)
+	if err != nil {
+		switch {
+		case errors.Is(err, workspace.ErrVersionNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case errors.Is(err, workspace.ErrManifestUnavailable):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, diff)
+}
+
+func (h *WorkspaceSnapshotHandler) authorize(c echo.Context, permission string) (string, error) {
+	channelIdentityID, err := RequireChannelIdentityID(c)
+	if err != nil {
+		return "", err
+	}
+	botID := strings.TrimSpace(c.Param("bot_id"))
+	if botID == "" {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "bot id is required")
+	}
+	if _, err := AuthorizeBotAccessWithPermission(c.Request().Context(), h.botService, h.accountService, channelIdentityID, botID, permission); err != nil {
+		return "", err
+	}
+	return botID, nil
+}
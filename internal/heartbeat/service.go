@@ -38,6 +38,7 @@ type Service struct {
 	cron           *cron.Cron
 	triggerer      Triggerer
 	sessionCreator SessionCreator
+	budgetGuard    BudgetGuard
 	jwtSecret      string
 	logger         *slog.Logger
 	mu             sync.Mutex
@@ -59,6 +60,12 @@ func NewService(log *slog.Logger, queries dbstore.Queries, triggerer Triggerer,
 	return service
 }
 
+// SetBudgetGuard makes heartbeats of bots whose spending budget is used up
+// skip instead of calling the agent.
+func (s *Service) SetBudgetGuard(guard BudgetGuard) {
+	s.budgetGuard = guard
+}
+
 func (s *Service) Bootstrap(ctx context.Context) error {
 	if s.queries == nil {
 		return errors.New("heartbeat queries not configured")
@@ -121,6 +128,13 @@ func (s *Service) runHeartbeat(ctx context.Context, cfg Config) {
 		return
 	}
 
+	if s.budgetGuard != nil {
+		if err := s.budgetGuard.Check(ctx, cfg.BotID); err != nil {
+			s.logger.Warn("heartbeat skipped", slog.String("bot_id", cfg.BotID), slog.Any("error", err))
+			return
+		}
+	}
+
 	var sessionID string
 	var pgSessionID pgtype.UUID
 	if s.sessionCreator != nil {
@@ -22,3 +22,9 @@ type TriggerResult struct {
 type Triggerer interface {
 	TriggerHeartbeat(ctx context.Context, botID string, payload TriggerPayload, token string) (TriggerResult, error)
 }
+
+// BudgetGuard reports whether a bot may still spend tokens. Check returns an
+// error once one of the bot's spending budgets is used up.
+type BudgetGuard interface {
+	Check(ctx context.Context, botID string) error
+}
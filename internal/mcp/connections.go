@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/db"
 	"github.com/memohai/memoh/internal/db/postgres/sqlc"
 	dbstore "github.com/memohai/memoh/internal/db/store"
@@ -83,10 +85,24 @@ type ManagedConnectionRequest struct {
 	Metadata       map[string]any
 }
 
+// StatusChange describes an MCP connection whose probe status changed.
+type StatusChange struct {
+	BotID          string
+	ConnectionID   string
+	Name           string
+	PreviousStatus string
+	Status         string
+	Message        string
+}
+
 // ConnectionService handles CRUD operations for MCP connections.
 type ConnectionService struct {
-	queries dbstore.Queries
-	logger  *slog.Logger
+	queries  dbstore.Queries
+	logger   *slog.Logger
+	auditLog *audit.Service
+
+	mu           sync.Mutex
+	onStatusFunc func(context.Context, StatusChange) // optional callback for probe status transitions
 }
 
 // NewConnectionService creates a ConnectionService backed by sqlc queries.
@@ -100,6 +116,20 @@ func NewConnectionService(log *slog.Logger, queries dbstore.Queries) *Connection
 	}
 }
 
+// SetStatusChangeFunc registers a callback invoked after a probe result moves a
+// connection to a different status.
+func (s *ConnectionService) SetStatusChangeFunc(fn func(context.Context, StatusChange)) {
+	s.mu.Lock()
+	s.onStatusFunc = fn
+	s.mu.Unlock()
+}
+
+// SetAuditLog records user-initiated connection changes to the audit log.
+// Plugin-managed connections are not recorded.
+func (s *ConnectionService) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
 // ListByBot returns all MCP connections for a bot.
 func (s *ConnectionService) ListByBot(ctx context.Context, botID string) ([]Connection, error) {
 	if s.queries == nil {
@@ -202,7 +232,12 @@ func (s *ConnectionService) Create(ctx context.Context, botID string, req Upsert
 	if err != nil {
 		return Connection{}, err
 	}
-	return normalizeMCPConnection(row)
+	conn, err := normalizeMCPConnection(row)
+	if err != nil {
+		return Connection{}, err
+	}
+	s.recordConnection(ctx, audit.ActionMCPConnectionCreate, botID, conn.ID, nil, &conn)
+	return conn, nil
 }
 
 func (s *ConnectionService) CreateManaged(ctx context.Context, botID string, req UpsertRequest, managed ManagedConnectionRequest) (Connection, error) {
@@ -296,6 +331,7 @@ func (s *ConnectionService) Update(ctx context.Context, botID, id string, req Up
 	if err != nil {
 		return Connection{}, err
 	}
+	before := s.connectionForAudit(ctx, botID, id)
 	row, err := s.queries.UpdateMCPConnection(ctx, sqlc.UpdateMCPConnectionParams{
 		BotID:    botUUID,
 		ID:       connUUID,
@@ -308,7 +344,12 @@ func (s *ConnectionService) Update(ctx context.Context, botID, id string, req Up
 	if err != nil {
 		return Connection{}, err
 	}
-	return normalizeMCPConnection(row)
+	conn, err := normalizeMCPConnection(row)
+	if err != nil {
+		return Connection{}, err
+	}
+	s.recordConnection(ctx, audit.ActionMCPConnectionUpdate, botID, conn.ID, before, &conn)
+	return conn, nil
 }
 
 // Import performs a declarative sync from a standard mcpServers dict.
@@ -356,6 +397,18 @@ func (s *ConnectionService) Import(ctx context.Context, botID string, req Import
 		}
 		results = append(results, conn)
 	}
+	if s.auditLog != nil {
+		imported := make([]map[string]any, 0, len(results))
+		for i := range results {
+			imported = append(imported, connectionAuditState(&results[i]))
+		}
+		s.auditLog.Record(ctx, audit.Entry{
+			Action:     audit.ActionMCPConnectionImport,
+			BotID:      botID,
+			TargetType: "mcp_connection",
+			After:      imported,
+		})
+	}
 	return results, nil
 }
 
@@ -385,10 +438,17 @@ func (s *ConnectionService) Delete(ctx context.Context, botID, id string) error
 	if err != nil {
 		return err
 	}
-	return s.queries.DeleteMCPConnection(ctx, sqlc.DeleteMCPConnectionParams{
+	before := s.connectionForAudit(ctx, botID, id)
+	if err := s.queries.DeleteMCPConnection(ctx, sqlc.DeleteMCPConnectionParams{
 		BotID: botUUID,
 		ID:    connUUID,
-	})
+	}); err != nil {
+		return err
+	}
+	if before != nil {
+		s.recordConnection(ctx, audit.ActionMCPConnectionDelete, botID, id, before, nil)
+	}
+	return nil
 }
 
 func (s *ConnectionService) SetPluginConnectionsActive(ctx context.Context, botID, installationID string, active bool) error {
@@ -449,6 +509,51 @@ func (s *ConnectionService) BatchDelete(ctx context.Context, botID string, ids [
 	return lastErr
 }
 
+// connectionForAudit loads a connection's current state for the audit log.
+// It returns nil when auditing is off or the connection does not exist.
+func (s *ConnectionService) connectionForAudit(ctx context.Context, botID, id string) *Connection {
+	if s.auditLog == nil {
+		return nil
+	}
+	conn, err := s.Get(ctx, botID, id)
+	if err != nil {
+		return nil
+	}
+	return &conn
+}
+
+func (s *ConnectionService) recordConnection(ctx context.Context, action, botID, id string, before, after *Connection) {
+	if s.auditLog == nil {
+		return
+	}
+	entry := audit.Entry{
+		Action:     action,
+		BotID:      botID,
+		TargetType: "mcp_connection",
+		TargetID:   id,
+	}
+	if before != nil {
+		entry.Before = connectionAuditState(before)
+	}
+	if after != nil {
+		entry.After = connectionAuditState(after)
+	}
+	s.auditLog.Record(ctx, entry)
+}
+
+// connectionAuditState is the configured part of a connection; probe
+// results are left out so diffs show what the user changed.
+func connectionAuditState(conn *Connection) map[string]any {
+	return map[string]any{
+		"id":        conn.ID,
+		"name":      conn.Name,
+		"type":      conn.Type,
+		"config":    conn.Config,
+		"is_active": conn.Active,
+		"auth_type": conn.AuthType,
+	}
+}
+
 func normalizeMCPConnection(row sqlc.McpConnection) (Connection, error) {
 	config, err := decodeMCPConfig(row.Config)
 	if err != nil {
@@ -517,13 +622,36 @@ func (s *ConnectionService) UpdateProbeResult(ctx context.Context, botID, id, st
 	if err != nil {
 		return err
 	}
-	return s.queries.UpdateMCPConnectionProbeResult(ctx, sqlc.UpdateMCPConnectionProbeResultParams{
+	s.mu.Lock()
+	onStatus := s.onStatusFunc
+	s.mu.Unlock()
+	var previous sqlc.McpConnection
+	if onStatus != nil {
+		previous, err = s.queries.GetMCPConnectionByID(ctx, sqlc.GetMCPConnectionByIDParams{BotID: pgBotID, ID: pgID})
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.queries.UpdateMCPConnectionProbeResult(ctx, sqlc.UpdateMCPConnectionProbeResultParams{
 		BotID:         pgBotID,
 		ID:            pgID,
 		Status:        status,
 		ToolsCache:    toolsPayload,
 		StatusMessage: message,
-	})
+	}); err != nil {
+		return err
+	}
+	if onStatus != nil && previous.Status != status {
+		onStatus(ctx, StatusChange{
+			BotID:          botID,
+			ConnectionID:   id,
+			Name:           previous.Name,
+			PreviousStatus: previous.Status,
+			Status:         status,
+			Message:        message,
+		})
+	}
+	return nil
 }
 
 func decodeMCPConfig(raw []byte) (map[string]any, error) {
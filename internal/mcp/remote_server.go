@@ -0,0 +1,468 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	sdkmcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Tools of a bot's remote MCP server.
+const (
+	RemoteToolChatWithBot  = "chat_with_bot"
+	RemoteToolSearchMemory = "search_bot_memory"
+	RemoteToolListFiles    = "list_bot_files"
+	RemoteToolRunSchedule  = "run_bot_schedule"
+)
+
+const (
+	remoteResourceScheme   = "memoh://bots/"
+	remoteMemoryListLimit  = 50
+	remoteMemorySearchMax  = 50
+	remoteDefaultSearchTop = 10
+)
+
+// ErrRemoteNotFound reports a file, memory or schedule the remote server
+// cannot find.
+var ErrRemoteNotFound = errors.New("not found")
+
+// RemoteBotAccess is what the caller of a bot's remote MCP server may do.
+// Tools and resources the caller may not use are not listed.
+type RemoteBotAccess struct {
+	Chat      bool
+	Memory    bool
+	Files     bool
+	Schedules bool
+}
+
+// RemoteChatResult is the bot's answer to chat_with_bot. SessionID continues
+// the conversation in a later call.
+type RemoteChatResult struct {
+	SessionID string `json:"session_id"`
+	Reply     string `json:"reply"`
+}
+
+// RemoteMemoryItem is a memory of the bot.
+type RemoteMemoryItem struct {
+	ID        string  `json:"id"`
+	Memory    string  `json:"memory"`
+	Score     float64 `json:"score,omitempty"`
+	CreatedAt string  `json:"created_at,omitempty"`
+	UpdatedAt string  `json:"updated_at,omitempty"`
+}
+
+// RemoteFileEntry is an entry of the bot's workspace.
+type RemoteFileEntry struct {
+	Path    string `json:"path"`
+	IsDir   bool   `json:"is_dir"`
+	Size    int64  `json:"size,omitempty"`
+	ModTime string `json:"mod_time,omitempty"`
+}
+
+// RemoteScheduleRun is the outcome of run_bot_schedule.
+type RemoteScheduleRun struct {
+	ScheduleID string `json:"schedule_id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Result     string `json:"result,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// RemoteBotBackend carries out the requests of one caller against one bot.
+// Implementations enforce nothing beyond what they are asked; access is
+// checked by the server through RemoteBotAccess.
+type RemoteBotBackend interface {
+	Chat(ctx context.Context, message, sessionID string) (RemoteChatResult, error)
+	SearchMemory(ctx context.Context, query string, limit int) ([]RemoteMemoryItem, error)
+	ListMemory(ctx context.Context, limit int) ([]RemoteMemoryItem, error)
+	GetMemory(ctx context.Context, id string) (RemoteMemoryItem, error)
+	ListFiles(ctx context.Context, dir string) ([]RemoteFileEntry, error)
+	ReadFile(ctx context.Context, filePath string) ([]byte, error)
+	RunSchedule(ctx context.Context, schedule string) (RemoteScheduleRun, error)
+}
+
+// RemoteBotServer describes the remote MCP server of one bot for one caller.
+// FilesRoot is the workspace directory listed by default.
+type RemoteBotServer struct {
+	BotID     string
+	BotName   string
+	FilesRoot string
+	Access    RemoteBotAccess
+	Backend   RemoteBotBackend
+}
+
+// ServeRemoteBotMCPHTTP serves one streamable HTTP request of a bot's remote
+// MCP server. The server is stateless: every request carries its own
+// credentials and is answered with a single JSON response.
+func ServeRemoteBotMCPHTTP(w http.ResponseWriter, req *http.Request, log *slog.Logger, bot RemoteBotServer) {
+	if bot.Backend == nil {
+		http.Error(w, "remote MCP server not configured", http.StatusServiceUnavailable)
+		return
+	}
+	EnsureStreamableAcceptHeader(req)
+	handler := sdkmcp.NewStreamableHTTPHandler(
+		func(*http.Request) *sdkmcp.Server {
+			return BuildRemoteBotMCPServer(bot)
+		},
+		&sdkmcp.StreamableHTTPOptions{
+			Stateless:    true,
+			JSONResponse: true,
+			Logger:       log,
+		},
+	)
+	handler.ServeHTTP(w, req)
+}
+
+// BuildRemoteBotMCPServer builds the MCP server through which external MCP
+// clients use a bot: high-level tools plus the bot's workspace files and
+// memories as resources.
+func BuildRemoteBotMCPServer(bot RemoteBotServer) *sdkmcp.Server {
+	name := strings.TrimSpace(bot.BotName)
+	if name == "" {
+		name = bot.BotID
+	}
+	server := sdkmcp.NewServer(
+		&sdkmcp.Implementation{
+			Name:    "memoh-bot",
+			Title:   name,
+			Version: "1.0.0",
+		},
+		&sdkmcp.ServerOptions{
+			Instructions: fmt.Sprintf("Tools and resources of the Memoh bot %q. chat_with_bot talks to the bot itself; the bot answers with its own persona, tools and memory.", name),
+			Capabilities: &sdkmcp.ServerCapabilities{
+				Tools:     &sdkmcp.ToolCapabilities{},
+				Resources: &sdkmcp.ResourceCapabilities{},
+			},
+		},
+	)
+	server.AddReceivingMiddleware(RemoteBotMiddleware(bot))
+	return server
+}
+
+// RemoteBotMiddleware answers the tool and resource methods of a bot's
+// remote MCP server.
+func RemoteBotMiddleware(bot RemoteBotServer) sdkmcp.Middleware {
+	return func(next sdkmcp.MethodHandler) sdkmcp.MethodHandler {
+		return func(ctx context.Context, method string, req sdkmcp.Request) (sdkmcp.Result, error) {
+			switch strings.TrimSpace(method) {
+			case "tools/list":
+				return &sdkmcp.ListToolsResult{Tools: ConvertGatewayToolsToSDK(RemoteBotTools(bot.Access))}, nil
+			case "tools/call":
+				callReq, ok := req.(*sdkmcp.ServerRequest[*sdkmcp.CallToolParamsRaw])
+				if !ok || callReq == nil || callReq.Params == nil {
+					return nil, errors.New("tools/call params is required")
+				}
+				payload, err := BuildToolCallPayloadFromRaw(callReq.Params)
+				if err != nil {
+					return nil, err
+				}
+				result, err := bot.callTool(ctx, payload)
+				if err != nil {
+					result = BuildToolErrorResult(err.Error())
+				}
+				return ConvertGatewayCallResultToSDK(result)
+			case "resources/list":
+				return &sdkmcp.ListResourcesResult{Resources: bot.listResources(ctx)}, nil
+			case "resources/templates/list":
+				return &sdkmcp.ListResourceTemplatesResult{ResourceTemplates: bot.resourceTemplates()}, nil
+			case "resources/read":
+				readReq, ok := req.(*sdkmcp.ServerRequest[*sdkmcp.ReadResourceParams])
+				if !ok || readReq == nil || readReq.Params == nil {
+					return nil, errors.New("resources/read params is required")
+				}
+				return bot.readResource(ctx, readReq.Params.URI)
+			default:
+				return next(ctx, method, req)
+			}
+		}
+	}
+}
+
+// RemoteBotTools lists the tools access allows.
+func RemoteBotTools(access RemoteBotAccess) []ToolDescriptor {
+	var tools []ToolDescriptor
+	if access.Chat {
+		tools = append(tools, ToolDescriptor{
+			Name:        RemoteToolChatWithBot,
+			Description: "Send a message to the bot and wait for its reply. Pass the returned session_id to continue the same conversation.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"message":    map[string]any{"type": "string", "description": "Message to the bot"},
+					"session_id": map[string]any{"type": "string", "description": "Session of an earlier reply to continue"},
+				},
+				"required": []string{"message"},
+			},
+		})
+	}
+	if access.Memory {
+		tools = append(tools, ToolDescriptor{
+			Name:        RemoteToolSearchMemory,
+			Description: "Search the bot's long-term memory.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"query": map[string]any{"type": "string", "description": "What to look for"},
+					"limit": map[string]any{"type": "integer", "description": fmt.Sprintf("Maximum results (default %d, max %d)", remoteDefaultSearchTop, remoteMemorySearchMax)},
+				},
+				"required": []string{"query"},
+			},
+		})
+	}
+	if access.Files {
+		tools = append(tools, ToolDescriptor{
+			Name:        RemoteToolListFiles,
+			Description: "List a directory of the bot's workspace. Read files through their resources.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"path": map[string]any{"type": "string", "description": "Directory to list; defaults to the workspace root"},
+				},
+			},
+		})
+	}
+	if access.Schedules {
+		tools = append(tools, ToolDescriptor{
+			Name:        RemoteToolRunSchedule,
+			Description: "Run one of the bot's schedules now and wait for the result.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"schedule": map[string]any{"type": "string", "description": "Schedule ID or name"},
+				},
+				"required": []string{"schedule"},
+			},
+		})
+	}
+	return tools
+}
+
+func (b RemoteBotServer) callTool(ctx context.Context, payload ToolCallPayload) (map[string]any, error) {
+	args := payload.Arguments
+	switch {
+	case payload.Name == RemoteToolChatWithBot && b.Access.Chat:
+		message := StringArg(args, "message")
+		if message == "" {
+			return nil, errors.New("message is required")
+		}
+		result, err := b.Backend.Chat(ctx, message, StringArg(args, "session_id"))
+		if err != nil {
+			return nil, err
+		}
+		return BuildToolSuccessResult(result), nil
+	case payload.Name == RemoteToolSearchMemory && b.Access.Memory:
+		query := StringArg(args, "query")
+		if query == "" {
+			return nil, errors.New("query is required")
+		}
+		limit, ok, err := IntArg(args, "limit")
+		if err != nil {
+			return nil, err
+		}
+		if !ok || limit <= 0 {
+			limit = remoteDefaultSearchTop
+		}
+		limit = min(limit, remoteMemorySearchMax)
+		items, err := b.Backend.SearchMemory(ctx, query, limit)
+		if err != nil {
+			return nil, err
+		}
+		return BuildToolSuccessResult(map[string]any{"results": b.withMemoryURIs(items)}), nil
+	case payload.Name == RemoteToolListFiles && b.Access.Files:
+		dir := StringArg(args, "path")
+		if dir == "" {
+			dir = b.filesRoot()
+		}
+		entries, err := b.Backend.ListFiles(ctx, dir)
+		if err != nil {
+			return nil, err
+		}
+		return BuildToolSuccessResult(map[string]any{"path": dir, "entries": b.withFileURIs(entries)}), nil
+	case payload.Name == RemoteToolRunSchedule && b.Access.Schedules:
+		schedule := StringArg(args, "schedule")
+		if schedule == "" {
+			return nil, errors.New("schedule is required")
+		}
+		run, err := b.Backend.RunSchedule(ctx, schedule)
+		if err != nil {
+			return nil, err
+		}
+		return BuildToolSuccessResult(run), nil
+	default:
+		return nil, fmt.Errorf("unknown tool %q", payload.Name)
+	}
+}
+
+// listResources lists the bot's recent memories and the files at the root
+// of its workspace. A part that cannot be listed, such as the files of a
+// stopped workspace, is left out rather than failing the listing.
+func (b RemoteBotServer) listResources(ctx context.Context) []*sdkmcp.Resource {
+	resources := []*sdkmcp.Resource{}
+	if b.Access.Files {
+		if entries, err := b.Backend.ListFiles(ctx, b.filesRoot()); err == nil {
+			for _, entry := range entries {
+				if entry.IsDir {
+					continue
+				}
+				resources = append(resources, &sdkmcp.Resource{
+					URI:      b.fileURI(entry.Path),
+					Name:     path.Base(entry.Path),
+					Title:    entry.Path,
+					MIMEType: fileMIMEType(entry.Path),
+					Size:     entry.Size,
+				})
+			}
+		}
+	}
+	if b.Access.Memory {
+		if items, err := b.Backend.ListMemory(ctx, remoteMemoryListLimit); err == nil {
+			for _, item := range items {
+				resources = append(resources, &sdkmcp.Resource{
+					URI:      b.memoryURI(item.ID),
+					Name:     "memory-" + item.ID,
+					Title:    memoryTitle(item.Memory),
+					MIMEType: "text/plain",
+				})
+			}
+		}
+	}
+	return resources
+}
+
+func (b RemoteBotServer) resourceTemplates() []*sdkmcp.ResourceTemplate {
+	templates := []*sdkmcp.ResourceTemplate{}
+	if b.Access.Files {
+		templates = append(templates, &sdkmcp.ResourceTemplate{
+			Name:        "workspace-file",
+			Title:       "Workspace file",
+			Description: "A file of the bot's workspace, by absolute path",
+			URITemplate: b.resourcePrefix() + "files/{+path}",
+		})
+	}
+	if b.Access.Memory {
+		templates = append(templates, &sdkmcp.ResourceTemplate{
+			Name:        "memory",
+			Title:       "Memory",
+			Description: "A memory of the bot, by ID",
+			URITemplate: b.resourcePrefix() + "memory/{id}",
+			MIMEType:    "text/plain",
+		})
+	}
+	return templates
+}
+
+func (b RemoteBotServer) readResource(ctx context.Context, uri string) (*sdkmcp.ReadResourceResult, error) {
+	rest, ok := strings.CutPrefix(uri, b.resourcePrefix())
+	if !ok {
+		return nil, sdkmcp.ResourceNotFoundError(uri)
+	}
+	if filePath, ok := strings.CutPrefix(rest, "files/"); ok && b.Access.Files {
+		filePath = path.Clean("/" + filePath)
+		data, err := b.Backend.ReadFile(ctx, filePath)
+		if errors.Is(err, ErrRemoteNotFound) {
+			return nil, sdkmcp.ResourceNotFoundError(uri)
+		}
+		if err != nil {
+			return nil, err
+		}
+		contents := &sdkmcp.ResourceContents{URI: uri, MIMEType: fileMIMEType(filePath)}
+		if utf8.Valid(data) {
+			contents.Text = string(data)
+			if contents.MIMEType == "" {
+				contents.MIMEType = "text/plain"
+			}
+		} else {
+			contents.Blob = data
+			if contents.MIMEType == "" {
+				contents.MIMEType = "application/octet-stream"
+			}
+		}
+		return &sdkmcp.ReadResourceResult{Contents: []*sdkmcp.ResourceContents{contents}}, nil
+	}
+	if id, ok := strings.CutPrefix(rest, "memory/"); ok && b.Access.Memory {
+		item, err := b.Backend.GetMemory(ctx, id)
+		if errors.Is(err, ErrRemoteNotFound) {
+			return nil, sdkmcp.ResourceNotFoundError(uri)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &sdkmcp.ReadResourceResult{Contents: []*sdkmcp.ResourceContents{{
+			URI:      uri,
+			MIMEType: "text/plain",
+			Text:     item.Memory,
+		}}}, nil
+	}
+	return nil, sdkmcp.ResourceNotFoundError(uri)
+}
+
+func (b RemoteBotServer) withMemoryURIs(items []RemoteMemoryItem) []map[string]any {
+	out := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		entry := map[string]any{"id": item.ID, "memory": item.Memory, "uri": b.memoryURI(item.ID)}
+		if item.Score != 0 {
+			entry["score"] = item.Score
+		}
+		if item.UpdatedAt != "" {
+			entry["updated_at"] = item.UpdatedAt
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+func (b RemoteBotServer) withFileURIs(entries []RemoteFileEntry) []map[string]any {
+	out := make([]map[string]any, 0, len(entries))
+	for _, entry := range entries {
+		item := map[string]any{"path": entry.Path, "is_dir": entry.IsDir}
+		if !entry.IsDir {
+			item["size"] = entry.Size
+			item["uri"] = b.fileURI(entry.Path)
+		}
+		if entry.ModTime != "" {
+			item["mod_time"] = entry.ModTime
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+func (b RemoteBotServer) filesRoot() string {
+	if root := strings.TrimSpace(b.FilesRoot); root != "" {
+		return root
+	}
+	return "/"
+}
+
+func (b RemoteBotServer) resourcePrefix() string {
+	return remoteResourceScheme + b.BotID + "/"
+}
+
+func (b RemoteBotServer) fileURI(filePath string) string {
+	return b.resourcePrefix() + "files/" + strings.TrimPrefix(path.Clean("/"+filePath), "/")
+}
+
+func (b RemoteBotServer) memoryURI(id string) string {
+	return b.resourcePrefix() + "memory/" + id
+}
+
+// fileMIMEType guesses the type of a file from its extension, or returns ""
+// when the extension is unknown.
+func fileMIMEType(filePath string) string {
+	return mime.TypeByExtension(path.Ext(filePath))
+}
+
+func memoryTitle(memory string) string {
+	memory = strings.Join(strings.Fields(memory), " ")
+	if runes := []rune(memory); len(runes) > 80 {
+		return string(runes[:77]) + "..."
+	}
+	return memory
+}
@@ -0,0 +1,192 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sdkmcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type remoteTestBackend struct {
+	files    map[string][]byte
+	memories []RemoteMemoryItem
+	chats    []string
+}
+
+func (b *remoteTestBackend) Chat(_ context.Context, message, sessionID string) (RemoteChatResult, error) {
+	b.chats = append(b.chats, message)
+	if sessionID == "" {
+		sessionID = "session-1"
+	}
+	return RemoteChatResult{SessionID: sessionID, Reply: "echo: " + message}, nil
+}
+
+func (b *remoteTestBackend) SearchMemory(_ context.Context, query string, limit int) ([]RemoteMemoryItem, error) {
+	var out []RemoteMemoryItem
+	for _, item := range b.memories {
+		if strings.Contains(item.Memory, query) && len(out) < limit {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+func (b *remoteTestBackend) ListMemory(_ context.Context, limit int) ([]RemoteMemoryItem, error) {
+	return b.memories[:min(limit, len(b.memories))], nil
+}
+
+func (b *remoteTestBackend) GetMemory(_ context.Context, id string) (RemoteMemoryItem, error) {
+	for _, item := range b.memories {
+		if item.ID == id {
+			return item, nil
+		}
+	}
+	return RemoteMemoryItem{}, ErrRemoteNotFound
+}
+
+func (b *remoteTestBackend) ListFiles(_ context.Context, dir string) ([]RemoteFileEntry, error) {
+	entries := []RemoteFileEntry{{Path: strings.TrimSuffix(dir, "/") + "/notes", IsDir: true}}
+	for name, data := range b.files {
+		entries = append(entries, RemoteFileEntry{Path: name, Size: int64(len(data))})
+	}
+	return entries, nil
+}
+
+func (b *remoteTestBackend) ReadFile(_ context.Context, filePath string) ([]byte, error) {
+	data, ok := b.files[filePath]
+	if !ok {
+		return nil, ErrRemoteNotFound
+	}
+	return data, nil
+}
+
+func (*remoteTestBackend) RunSchedule(context.Context, string) (RemoteScheduleRun, error) {
+	return RemoteScheduleRun{}, errors.New("no schedules")
+}
+
+func connectRemoteBot(t *testing.T, bot RemoteBotServer) *sdkmcp.ClientSession {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeRemoteBotMCPHTTP(w, r, nil, bot)
+	}))
+	t.Cleanup(srv.Close)
+	client := sdkmcp.NewClient(&sdkmcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(context.Background(), &sdkmcp.StreamableClientTransport{Endpoint: srv.URL}, nil)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
+
+func TestRemoteBotServerToolsFollowAccess(t *testing.T) {
+	backend := &remoteTestBackend{memories: []RemoteMemoryItem{{ID: "m1", Memory: "likes green tea"}}}
+	session := connectRemoteBot(t, RemoteBotServer{
+		BotID:   "bot-1",
+		BotName: "Helper",
+		Access:  RemoteBotAccess{Chat: true, Memory: true},
+		Backend: backend,
+	})
+	ctx := context.Background()
+
+	tools, err := session.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("tools/list: %v", err)
+	}
+	var names []string
+	for _, tool := range tools.Tools {
+		names = append(names, tool.Name)
+	}
+	if strings.Join(names, ",") != RemoteToolChatWithBot+","+RemoteToolSearchMemory {
+		t.Fatalf("tools = %v", names)
+	}
+
+	result, err := session.CallTool(ctx, &sdkmcp.CallToolParams{Name: RemoteToolChatWithBot, Arguments: map[string]any{"message": "hi"}})
+	if err != nil || result.IsError {
+		t.Fatalf("chat_with_bot: result = %#v, err = %v", result, err)
+	}
+	var chat RemoteChatResult
+	raw, _ := json.Marshal(result.StructuredContent)
+	if err := json.Unmarshal(raw, &chat); err != nil || chat.Reply != "echo: hi" || chat.SessionID != "session-1" {
+		t.Fatalf("chat result = %s", raw)
+	}
+
+	result, err = session.CallTool(ctx, &sdkmcp.CallToolParams{Name: RemoteToolSearchMemory, Arguments: map[string]any{"query": "tea"}})
+	if err != nil || result.IsError {
+		t.Fatalf("search_bot_memory: result = %#v, err = %v", result, err)
+	}
+	raw, _ = json.Marshal(result.StructuredContent)
+	if !strings.Contains(string(raw), `"uri":"memoh://bots/bot-1/memory/m1"`) {
+		t.Fatalf("search result = %s", raw)
+	}
+
+	result, err = session.CallTool(ctx, &sdkmcp.CallToolParams{Name: RemoteToolRunSchedule, Arguments: map[string]any{"schedule": "daily"}})
+	if err != nil || !result.IsError {
+		t.Fatalf("run_bot_schedule without access: result = %#v, err = %v", result, err)
+	}
+}
+
+func TestRemoteBotServerResources(t *testing.T) {
+	backend := &remoteTestBackend{
+		files: map[string][]byte{
+			"/data/readme.md": []byte("# Hello"),
+			"/data/logo.bin":  {0xff, 0xfe, 0x00},
+		},
+		memories: []RemoteMemoryItem{{ID: "m1", Memory: "likes green tea"}},
+	}
+	session := connectRemoteBot(t, RemoteBotServer{
+		BotID:     "bot-1",
+		FilesRoot: "/data",
+		Access:    RemoteBotAccess{Files: true, Memory: true},
+		Backend:   backend,
+	})
+	ctx := context.Background()
+
+	list, err := session.ListResources(ctx, nil)
+	if err != nil {
+		t.Fatalf("resources/list: %v", err)
+	}
+	uris := map[string]bool{}
+	for _, resource := range list.Resources {
+		uris[resource.URI] = true
+	}
+	for _, want := range []string{
+		"memoh://bots/bot-1/files/data/readme.md",
+		"memoh://bots/bot-1/files/data/logo.bin",
+		"memoh://bots/bot-1/memory/m1",
+	} {
+		if !uris[want] {
+			t.Fatalf("resources/list is missing %s: %v", want, uris)
+		}
+	}
+	if len(uris) != 3 {
+		t.Fatalf("directories must not be listed: %v", uris)
+	}
+
+	read, err := session.ReadResource(ctx, &sdkmcp.ReadResourceParams{URI: "memoh://bots/bot-1/files/data/readme.md"})
+	if err != nil || read.Contents[0].Text != "# Hello" {
+		t.Fatalf("read text file: result = %#v, err = %v", read, err)
+	}
+	read, err = session.ReadResource(ctx, &sdkmcp.ReadResourceParams{URI: "memoh://bots/bot-1/files/data/logo.bin"})
+	if err != nil || len(read.Contents[0].Blob) != 3 || read.Contents[0].MIMEType != "application/octet-stream" {
+		t.Fatalf("read binary file: result = %#v, err = %v", read, err)
+	}
+	read, err = session.ReadResource(ctx, &sdkmcp.ReadResourceParams{URI: "memoh://bots/bot-1/memory/m1"})
+	if err != nil || read.Contents[0].Text != "likes green tea" {
+		t.Fatalf("read memory: result = %#v, err = %v", read, err)
+	}
+
+	for _, uri := range []string{
+		"memoh://bots/bot-1/files/data/missing.txt",
+		"memoh://bots/bot-2/memory/m1",
+	} {
+		if _, err := session.ReadResource(ctx, &sdkmcp.ReadResourceParams{URI: uri}); err == nil {
+			t.Fatalf("read %s should fail", uri)
+		}
+	}
+}
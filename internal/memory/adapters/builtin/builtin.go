@@ -10,6 +10,7 @@ import (
 	"github.com/memohai/memoh/internal/conversation"
 	"github.com/memohai/memoh/internal/mcp"
 	adapters "github.com/memohai/memoh/internal/memory/adapters"
+	storefs "github.com/memohai/memoh/internal/memory/storefs"
 )
 
 const (
@@ -26,6 +27,7 @@ const (
 type BuiltinProvider struct {
 	service      memoryRuntime
 	llm          adapters.LLM
+	history      memoryHistoryStore
 	chatAccessor conversation.Accessor
 	adminChecker AdminChecker
 	logger       *slog.Logger
@@ -126,6 +128,25 @@ func intFromConfig(m map[string]any, key string) int {
 	return 0
 }
 
+func floatFromConfig(m map[string]any, key string) float64 {
+	if m == nil {
+		return 0
+	}
+	v, ok := m[key]
+	if !ok || v == nil {
+		return 0
+	}
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	}
+	return 0
+}
+
 func (*BuiltinProvider) Type() string { return BuiltinType }
 
 func memorySourceLabel(item adapters.MemoryItem) string {
@@ -239,6 +260,7 @@ func (p *BuiltinProvider) OnAfterChat(ctx context.Context, req adapters.AfterCha
 		"bot_id":    botID,
 	}
 	metadata := adapters.BuildProfileMetadata(req.UserID, req.ChannelIdentityID, req.DisplayName)
+	ctx = storefs.WithChange(ctx, afterChatChange(req, storefs.ChangeSourceChat))
 	if _, err := p.service.Add(ctx, adapters.AddRequest{
 		Messages: req.Messages,
 		BotID:    botID,
@@ -27,13 +27,19 @@ import (
 const denseEmbedTimeout = models.DefaultProviderRequestTimeout
 
 type denseRuntime struct {
+	*denseEmbedder
 	qdrant     *qdrantclient.Client
 	store      *storefs.Service
-	embedModel *sdk.EmbeddingModel
 	dimensions int
 	collection string
 }
 
+// denseEmbedder turns text into vectors with a configured embedding model.
+// It is shared by the Qdrant dense runtime and the embedded SQLite runtime.
+type denseEmbedder struct {
+	embedModel *sdk.EmbeddingModel
+}
+
 type denseModelSpec struct {
 	modelID    string
 	clientType string
@@ -55,7 +61,7 @@ func newDenseRuntime(providerConfig map[string]any, queries dbstore.Queries, cfg
 		return nil, errors.New("dense runtime: embedding_model_id is required")
 	}
 
-	spec, err := resolveDenseEmbeddingModel(context.Background(), queries, modelRef)
+	embedder, spec, err := newDenseEmbedder(context.Background(), queries, modelRef)
 	if err != nil {
 		return nil, err
 	}
@@ -76,20 +82,28 @@ func newDenseRuntime(providerConfig map[string]any, queries dbstore.Queries, cfg
 		return nil, fmt.Errorf("dense runtime: %w", err)
 	}
 
-	embedModel := models.NewSDKEmbeddingModel(spec.clientType, spec.baseURL, spec.apiKey, spec.modelID, denseEmbedTimeout, nil)
-
 	return &denseRuntime{
-		qdrant:     qClient,
-		store:      store,
-		embedModel: embedModel,
-		dimensions: spec.dimensions,
-		collection: collection,
+		denseEmbedder: embedder,
+		qdrant:        qClient,
+		store:         store,
+		dimensions:    spec.dimensions,
+		collection:    collection,
 	}, nil
 }
 
 // --- embedder helpers using Twilight SDK ---
 
-func (r *denseRuntime) embedQuery(ctx context.Context, text string) ([]float32, error) {
+func newDenseEmbedder(ctx context.Context, queries dbstore.Queries, modelRef string) (*denseEmbedder, denseModelSpec, error) {
+	spec, err := resolveDenseEmbeddingModel(ctx, queries, modelRef)
+	if err != nil {
+		return nil, denseModelSpec{}, err
+	}
+	return &denseEmbedder{
+		embedModel: models.NewSDKEmbeddingModel(spec.clientType, spec.baseURL, spec.apiKey, spec.modelID, denseEmbedTimeout, nil),
+	}, spec, nil
+}
+
+func (r *denseEmbedder) embedQuery(ctx context.Context, text string) ([]float32, error) {
 	client := sdk.NewClient()
 	vec, err := client.Embed(ctx, text, sdk.WithEmbeddingModel(r.embedModel))
 	if err != nil {
@@ -98,7 +112,7 @@ func (r *denseRuntime) embedQuery(ctx context.Context, text string) ([]float32,
 	return float64sToFloat32s(vec), nil
 }
 
-func (r *denseRuntime) embedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+func (r *denseEmbedder) embedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
 	client := sdk.NewClient()
 	result, err := client.EmbedMany(ctx, texts, sdk.WithEmbeddingModel(r.embedModel))
 	if err != nil {
@@ -113,7 +127,7 @@ func (r *denseRuntime) embedDocuments(ctx context.Context, texts []string) ([][]
 
 // embedHealth performs a minimal smoke-test embedding to verify that the
 // configured embedding model is reachable and functional.
-func (r *denseRuntime) embedHealth(ctx context.Context) error {
+func (r *denseEmbedder) embedHealth(ctx context.Context) error {
 	client := sdk.NewClient()
 	_, err := client.Embed(ctx, "health", sdk.WithEmbeddingModel(r.embedModel))
 	if err != nil {
@@ -165,25 +179,14 @@ func (r *denseRuntime) Search(ctx context.Context, req adapters.SearchRequest) (
 	if err != nil {
 		return adapters.SearchResponse{}, err
 	}
-	if err := r.qdrant.EnsureDenseCollection(ctx, r.dimensions); err != nil {
-		return adapters.SearchResponse{}, err
-	}
 	limit := req.Limit
 	if limit <= 0 {
 		limit = 10
 	}
-	vec, err := r.embedQuery(ctx, req.Query)
-	if err != nil {
-		return adapters.SearchResponse{}, err
-	}
-	results, err := r.qdrant.SearchDense(ctx, qdrantclient.DenseVector{Values: vec}, botID, limit)
+	items, err := r.searchIndex(ctx, botID, req.Query, limit)
 	if err != nil {
 		return adapters.SearchResponse{}, err
 	}
-	items := make([]adapters.MemoryItem, 0, len(results))
-	for _, result := range results {
-		items = append(items, resultToItem(result))
-	}
 	return adapters.SearchResponse{Results: items}, nil
 }
 
@@ -275,7 +278,7 @@ func (r *denseRuntime) DeleteBatch(ctx context.Context, memoryIDs []string) (ada
 			return adapters.DeleteResponse{}, err
 		}
 	}
-	if err := r.qdrant.DeleteByIDs(ctx, pointIDs); err != nil {
+	if err := r.deleteIndexPoints(ctx, pointIDs); err != nil {
 		return adapters.DeleteResponse{}, err
 	}
 	return adapters.DeleteResponse{Message: "Memories deleted successfully!"}, nil
@@ -289,7 +292,7 @@ func (r *denseRuntime) DeleteAll(ctx context.Context, req adapters.DeleteAllRequ
 	if err := r.store.RemoveAllMemories(ctx, botID); err != nil {
 		return adapters.DeleteResponse{}, err
 	}
-	if err := r.qdrant.DeleteByBotID(ctx, botID); err != nil {
+	if err := r.deleteIndexBot(ctx, botID); err != nil {
 		return adapters.DeleteResponse{}, err
 	}
 	return adapters.DeleteResponse{Message: "All memories deleted successfully!"}, nil
@@ -416,6 +419,33 @@ func (r *denseRuntime) Rebuild(ctx context.Context, botID string) (adapters.Rebu
 	return r.syncSourceItems(ctx, botID, items)
 }
 
+func (r *denseRuntime) searchIndex(ctx context.Context, botID, query string, limit int) ([]adapters.MemoryItem, error) {
+	if err := r.qdrant.EnsureDenseCollection(ctx, r.dimensions); err != nil {
+		return nil, err
+	}
+	vec, err := r.embedQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	results, err := r.qdrant.SearchDense(ctx, qdrantclient.DenseVector{Values: vec}, botID, limit)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]adapters.MemoryItem, 0, len(results))
+	for _, result := range results {
+		items = append(items, resultToItem(result))
+	}
+	return items, nil
+}
+
+func (r *denseRuntime) deleteIndexPoints(ctx context.Context, pointIDs []string) error {
+	return r.qdrant.DeleteByIDs(ctx, pointIDs)
+}
+
+func (r *denseRuntime) deleteIndexBot(ctx context.Context, botID string) error {
+	return r.qdrant.DeleteByBotID(ctx, botID)
+}
+
 func (r *denseRuntime) syncSourceItems(ctx context.Context, botID string, items []storefs.MemoryItem) (adapters.RebuildResult, error) {
 	if err := r.qdrant.EnsureDenseCollection(ctx, r.dimensions); err != nil {
 		return adapters.RebuildResult{}, err
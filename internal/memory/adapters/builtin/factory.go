@@ -18,46 +18,71 @@ const (
 	ModeOff    BuiltinMemoryMode = "off"
 	ModeSparse BuiltinMemoryMode = "sparse"
 	ModeDense  BuiltinMemoryMode = "dense"
+	ModeHybrid BuiltinMemoryMode = "hybrid"
 )
 
 // NewBuiltinRuntimeFromConfig returns the appropriate memoryRuntime based on
 // the provider's persisted config (memory_mode field). Returns the file
-// runtime for "off" or unknown modes. Returns an error if a sparse or dense
-// runtime was explicitly requested but failed to initialise, so that callers
-// can surface configuration problems rather than silently degrading.
-func NewBuiltinRuntimeFromConfig(_ *slog.Logger, providerConfig map[string]any, fileRuntime any, store *storefs.Service, queries dbstore.Queries, cfg config.Config) (any, error) {
+// runtime for "off" or unknown modes. Returns an error if a sparse, dense or
+// hybrid runtime was explicitly requested but failed to initialise, so that
+// callers can surface configuration problems rather than silently degrading.
+func NewBuiltinRuntimeFromConfig(log *slog.Logger, providerConfig map[string]any, fileRuntime any, store *storefs.Service, queries dbstore.Queries, cfg config.Config) (any, error) {
 	mode := BuiltinMemoryMode(strings.TrimSpace(adapters.StringFromConfig(providerConfig, "memory_mode")))
 
 	switch mode {
 	case ModeSparse:
-		host, port := parseQdrantHostPort(cfg.Qdrant.BaseURL)
-		if host == "" {
-			host = "localhost"
-		}
-		if port == 0 {
-			port = 6334
-		}
-		collection := adapters.StringFromConfig(providerConfig, "qdrant_collection")
-		if collection == "" {
-			collection = "memory_sparse"
-		}
-		return newSparseRuntime(
-			host,
-			port,
-			cfg.Qdrant.APIKey,
-			collection,
-			strings.TrimSpace(cfg.Sparse.BaseURL),
-			store,
-		)
+		return newSparseRuntimeFromConfig(providerConfig, cfg, store)
 
 	case ModeDense:
 		return newDenseRuntime(providerConfig, queries, cfg, store)
 
+	case ModeHybrid:
+		sparseRT, err := newSparseRuntimeFromConfig(providerConfig, cfg, store)
+		if err != nil {
+			return nil, err
+		}
+		// qdrant_collection names the sparse collection in hybrid mode; the
+		// dense side always uses its default collection so the two indexes
+		// never share one.
+		denseConfig := make(map[string]any, len(providerConfig))
+		for k, v := range providerConfig {
+			if k != "qdrant_collection" {
+				denseConfig[k] = v
+			}
+		}
+		denseRT, err := newDenseRuntime(denseConfig, queries, cfg, store)
+		if err != nil {
+			return nil, err
+		}
+		return newHybridRuntime(log, denseRT, sparseRT, store, rrfConfigFromProvider(providerConfig)), nil
+
 	default:
 		return fileRuntime, nil
 	}
 }
 
+func newSparseRuntimeFromConfig(providerConfig map[string]any, cfg config.Config, store *storefs.Service) (*sparseRuntime, error) {
+	host, port := parseQdrantHostPort(cfg.Qdrant.BaseURL)
+	if host == "" {
+		host = "localhost"
+	}
+	if port == 0 {
+		port = 6334
+	}
+	collection := adapters.StringFromConfig(providerConfig, "qdrant_collection")
+	if collection == "" {
+		collection = "memory_sparse"
+	}
+	return newSparseRuntime(
+		host,
+		port,
+		cfg.Qdrant.APIKey,
+		collection,
+		strings.TrimSpace(cfg.Sparse.BaseURL),
+		store,
+	)
+}
+
 // parseQdrantHostPort extracts host and gRPC port from a Qdrant base URL.
 // Qdrant base URLs are typically HTTP (port 6333), but the gRPC port is 6334.
 func parseQdrantHostPort(baseURL string) (string, int) {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	adapters "github.com/memohai/memoh/internal/memory/adapters"
+	storefs "github.com/memohai/memoh/internal/memory/storefs"
 )
 
 const (
@@ -72,10 +73,26 @@ func runFormation(ctx context.Context, logger *slog.Logger, llm adapters.LLM, ru
 	}
 	metadata := adapters.BuildProfileMetadata(req.UserID, req.ChannelIdentityID, req.DisplayName)
 
+	ctx = storefs.WithChange(ctx, afterChatChange(req, storefs.ChangeSourceFormation))
 	applyActions(ctx, logger, runtime, botID, decided.Actions, filters, metadata, &result)
 	return result
 }
 
+// afterChatChange attributes memory writes made after a chat turn to the
+// conversation and the user who triggered them.
+func afterChatChange(req adapters.AfterChatRequest, source string) storefs.Change {
+	actor := strings.TrimSpace(req.UserID)
+	if actor == "" {
+		actor = strings.TrimSpace(req.ChannelIdentityID)
+	}
+	return storefs.Change{
+		Source:    source,
+		Actor:     actor,
+		ChatID:    strings.TrimSpace(req.ChatID),
+		SessionID: strings.TrimSpace(req.SessionID),
+	}
+}
+
 // gatherCandidates collects existing memories relevant to the extracted facts.
 func gatherCandidates(ctx context.Context, logger *slog.Logger, runtime memoryRuntime, botID string, facts []string) []adapters.CandidateMemory {
 	seen := make(map[string]struct{})
@@ -156,13 +173,19 @@ func gatherCandidates(ctx context.Context, logger *slog.Logger, runtime memoryRu
 	return candidates
 }
 
-// applyActions executes the decided CRUD actions against the runtime.
+// applyActions executes the decided CRUD actions against the runtime. Each
+// write is attributed to its decision so the revision history records why
+// the memory changed.
 func applyActions(ctx context.Context, logger *slog.Logger, runtime memoryRuntime, botID string, actions []adapters.DecisionAction, filters map[string]any, metadata map[string]any, result *formationResult) {
 	deleted := make(map[string]struct{})
 	updated := make(map[string]struct{})
+	baseChange := storefs.ChangeFromContext(ctx)
 
 	for _, action := range actions {
 		event := strings.ToUpper(strings.TrimSpace(action.Event))
+		change := baseChange
+		change.Decision = event
+		ctx := storefs.WithChange(ctx, change)
 		switch event {
 		case actionADD:
 			text := strings.TrimSpace(action.Text)
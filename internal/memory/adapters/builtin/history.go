@@ -0,0 +1,73 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	adapters "github.com/memohai/memoh/internal/memory/adapters"
+	storefs "github.com/memohai/memoh/internal/memory/storefs"
+)
+
+// memoryHistoryStore is the revision log kept alongside the markdown source.
+type memoryHistoryStore interface {
+	MemoryHistory(ctx context.Context, botID, memoryID string) ([]storefs.MemoryRevision, error)
+	RollbackMemory(ctx context.Context, botID, memoryID string, revision int) (storefs.MemoryItem, error)
+	RestoreMemoriesAsOf(ctx context.Context, botID string, at time.Time) (storefs.RestoreResult, error)
+}
+
+// SetHistoryStore injects the revision log used for history, rollback and
+// restore-as-of. Without it the provider reports history as unavailable.
+func (p *BuiltinProvider) SetHistoryStore(store memoryHistoryStore) {
+	p.history = store
+}
+
+func (p *BuiltinProvider) History(ctx context.Context, botID, memoryID string) ([]adapters.MemoryRevision, error) {
+	if p.history == nil {
+		return nil, errors.New("memory history not configured")
+	}
+	revisions, err := p.history.MemoryHistory(ctx, botID, memoryID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]adapters.MemoryRevision, 0, len(revisions))
+	for _, rev := range revisions {
+		out = append(out, adapters.MemoryRevision(rev))
+	}
+	return out, nil
+}
+
+// Rollback restores a memory item to an earlier revision and re-syncs the
+// derived index so retrieval sees the restored text.
+func (p *BuiltinProvider) Rollback(ctx context.Context, botID, memoryID string, revision int) (adapters.MemoryItem, error) {
+	if p.history == nil || p.service == nil {
+		return adapters.MemoryItem{}, errors.New("memory history not configured")
+	}
+	item, err := p.history.RollbackMemory(ctx, botID, memoryID, revision)
+	if err != nil {
+		return adapters.MemoryItem{}, err
+	}
+	if _, err := p.service.Rebuild(ctx, botID); err != nil {
+		return adapters.MemoryItem{}, err
+	}
+	mem := memoryItemFromStore(item)
+	mem.BotID = strings.TrimSpace(botID)
+	return mem, nil
+}
+
+// RestoreAsOf rewrites the bot's memory to its state at the given time and
+// re-syncs the derived index.
+func (p *BuiltinProvider) RestoreAsOf(ctx context.Context, botID string, at time.Time) (adapters.RestoreResult, error) {
+	if p.history == nil || p.service == nil {
+		return adapters.RestoreResult{}, errors.New("memory history not configured")
+	}
+	result, err := p.history.RestoreMemoriesAsOf(ctx, botID, at)
+	if err != nil {
+		return adapters.RestoreResult{}, err
+	}
+	if _, err := p.service.Rebuild(ctx, botID); err != nil {
+		return adapters.RestoreResult{}, err
+	}
+	return adapters.RestoreResult(result), nil
+}
@@ -0,0 +1,74 @@
+package builtin
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	storefs "github.com/memohai/memoh/internal/memory/storefs"
+)
+
+type fakeHistoryStore struct {
+	store *fakeSparseStore
+}
+
+func (*fakeHistoryStore) MemoryHistory(_ context.Context, _, memoryID string) ([]storefs.MemoryRevision, error) {
+	return []storefs.MemoryRevision{
+		{MemoryID: memoryID, Revision: 1, Action: storefs.RevisionCreate, Memory: "v1"},
+		{MemoryID: memoryID, Revision: 2, Action: storefs.RevisionUpdate, Memory: "v2", Source: storefs.ChangeSourceFormation},
+	}, nil
+}
+
+func (h *fakeHistoryStore) RollbackMemory(_ context.Context, _, memoryID string, _ int) (storefs.MemoryItem, error) {
+	item := storefs.MemoryItem{ID: memoryID, Memory: "v1"}
+	h.store.items[memoryID] = item
+	return item, nil
+}
+
+func (*fakeHistoryStore) RestoreMemoriesAsOf(_ context.Context, _ string, at time.Time) (storefs.RestoreResult, error) {
+	return storefs.RestoreResult{AsOf: at.Format(time.RFC3339), Restored: 1}, nil
+}
+
+func TestBuiltinProviderRollbackReindexes(t *testing.T) {
+	t.Parallel()
+
+	encoder := &fakeSparseEncoder{}
+	index := newFakeSparseIndex(encoder)
+	store := newFakeSparseStore(storefs.MemoryItem{ID: "bot-1:mem_1", Memory: "v2"})
+	runtime := &sparseRuntime{qdrant: index, encoder: encoder, store: store}
+	provider := NewBuiltinProvider(slog.Default(), runtime, nil, nil)
+
+	if _, err := provider.History(context.Background(), "bot-1", "bot-1:mem_1"); err == nil {
+		t.Fatal("expected history to be unavailable without a history store")
+	}
+	provider.SetHistoryStore(&fakeHistoryStore{store: store})
+
+	history, err := provider.History(context.Background(), "bot-1", "bot-1:mem_1")
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+	if len(history) != 2 || history[1].Source != storefs.ChangeSourceFormation {
+		t.Fatalf("unexpected history %#v", history)
+	}
+
+	item, err := provider.Rollback(context.Background(), "bot-1", "bot-1:mem_1", 1)
+	if err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+	if item.Memory != "v1" || item.BotID != "bot-1" {
+		t.Fatalf("unexpected rollback item %#v", item)
+	}
+	point, ok := index.points[runtimePointID("bot-1", "bot-1:mem_1")]
+	if !ok || point.Payload["memory"] != "v1" {
+		t.Fatalf("expected index to be rebuilt with rolled back text, got %#v", point)
+	}
+
+	result, err := provider.RestoreAsOf(context.Background(), "bot-1", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("RestoreAsOf() error = %v", err)
+	}
+	if result.Restored != 1 || result.AsOf != "2026-03-01T00:00:00Z" {
+		t.Fatalf("unexpected restore result %#v", result)
+	}
+}
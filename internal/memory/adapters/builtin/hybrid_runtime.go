@@ -0,0 +1,465 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/memohai/memoh/internal/config"
+	adapters "github.com/memohai/memoh/internal/memory/adapters"
+	storefs "github.com/memohai/memoh/internal/memory/storefs"
+)
+
+// retrievalIndex is the derived Qdrant index behind a runtime. Both the dense
+// and the sparse runtime implement it so the hybrid runtime can drive the two
+// indexes over one shared markdown store.
+type retrievalIndex interface {
+	searchIndex(ctx context.Context, botID, query string, limit int) ([]adapters.MemoryItem, error)
+	upsertSourceItems(ctx context.Context, botID string, items []storefs.MemoryItem) error
+	syncSourceItems(ctx context.Context, botID string, items []storefs.MemoryItem) (adapters.RebuildResult, error)
+	deleteIndexPoints(ctx context.Context, pointIDs []string) error
+	deleteIndexBot(ctx context.Context, botID string) error
+	Status(ctx context.Context, botID string) (adapters.MemoryStatusResponse, error)
+}
+
+// rrfConfig controls reciprocal rank fusion. An item ranked r (1-based) by a
+// retriever contributes weight / (K + r) to its fused score.
+type rrfConfig struct {
+	K            int
+	DenseWeight  float64
+	SparseWeight float64
+}
+
+var defaultRRFConfig = rrfConfig{
+	K:            60,
+	DenseWeight:  1,
+	SparseWeight: 1,
+}
+
+// rrfConfigFromProvider reads the hybrid fusion knobs from a provider config
+// map. Missing or non-positive values fall back to defaults.
+func rrfConfigFromProvider(providerConfig map[string]any) rrfConfig {
+	cfg := defaultRRFConfig
+	if k := intFromConfig(providerConfig, "hybrid_rrf_k"); k > 0 {
+		cfg.K = k
+	}
+	if w := floatFromConfig(providerConfig, "hybrid_dense_weight"); w > 0 {
+		cfg.DenseWeight = w
+	}
+	if w := floatFromConfig(providerConfig, "hybrid_sparse_weight"); w > 0 {
+		cfg.SparseWeight = w
+	}
+	return cfg
+}
+
+// hybridRuntime implements memoryRuntime with markdown files as the source of
+// truth and both a dense and a sparse Qdrant index. Searches query the two
+// indexes and merge the rankings with reciprocal rank fusion, so exact tokens
+// (ticket numbers, hostnames) found by the sparse index and paraphrases found
+// by the dense index both surface.
+type hybridRuntime struct {
+	dense  retrievalIndex
+	sparse retrievalIndex
+	store  sparseMemoryStore
+	fusion rrfConfig
+	logger *slog.Logger
+}
+
+func newHybridRuntime(log *slog.Logger, dense, sparse retrievalIndex, store sparseMemoryStore, fusion rrfConfig) *hybridRuntime {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &hybridRuntime{
+		dense:  dense,
+		sparse: sparse,
+		store:  store,
+		fusion: fusion,
+		logger: log.With(slog.String("runtime", string(ModeHybrid))),
+	}
+}
+
+func (*hybridRuntime) Mode() string {
+	return string(ModeHybrid)
+}
+
+func (r *hybridRuntime) Add(ctx context.Context, req adapters.AddRequest) (adapters.SearchResponse, error) {
+	botID, err := runtimeBotID(req.BotID, req.Filters)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	text := runtimeText(req.Message, req.Messages)
+	if text == "" {
+		return adapters.SearchResponse{}, errors.New("hybrid runtime: message is required")
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	item := adapters.MemoryItem{
+		ID:        runtimeMemoryID(botID, time.Now().UTC()),
+		Memory:    text,
+		Hash:      runtimeHash(text),
+		Metadata:  req.Metadata,
+		BotID:     botID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := r.store.PersistMemories(ctx, botID, []storefs.MemoryItem{storeItemFromMemoryItem(item)}, req.Filters); err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	if err := r.upsertSourceItems(ctx, botID, []storefs.MemoryItem{storeItemFromMemoryItem(item)}); err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	return adapters.SearchResponse{Results: []adapters.MemoryItem{item}}, nil
+}
+
+// Search queries both indexes concurrently and fuses the rankings. When one
+// index fails the other's ranking is used alone so a degraded encoder does
+// not take memory recall down with it.
+func (r *hybridRuntime) Search(ctx context.Context, req adapters.SearchRequest) (adapters.SearchResponse, error) {
+	botID, err := runtimeBotID(req.BotID, req.Filters)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var (
+		wg                    sync.WaitGroup
+		denseItems, sparseIts []adapters.MemoryItem
+		denseErr, sparseErr   error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		denseItems, denseErr = r.dense.searchIndex(ctx, botID, req.Query, limit)
+	}()
+	go func() {
+		defer wg.Done()
+		sparseIts, sparseErr = r.sparse.searchIndex(ctx, botID, req.Query, limit)
+	}()
+	wg.Wait()
+
+	if denseErr != nil && sparseErr != nil {
+		return adapters.SearchResponse{}, errors.Join(
+			fmt.Errorf("dense: %w", denseErr),
+			fmt.Errorf("sparse: %w", sparseErr),
+		)
+	}
+	if denseErr != nil {
+		r.logger.Warn("dense memory search failed; using sparse results only", slog.String("bot_id", botID), slog.Any("error", denseErr))
+	}
+	if sparseErr != nil {
+		r.logger.Warn("sparse memory search failed; using dense results only", slog.String("bot_id", botID), slog.Any("error", sparseErr))
+	}
+	items := fuseRRF(r.fusion, limit,
+		rankedList{items: denseItems, weight: r.fusion.DenseWeight},
+		rankedList{items: sparseIts, weight: r.fusion.SparseWeight},
+	)
+	return adapters.SearchResponse{Results: items}, nil
+}
+
+func (r *hybridRuntime) GetAll(ctx context.Context, req adapters.GetAllRequest) (adapters.SearchResponse, error) {
+	botID, err := runtimeBotID(req.BotID, req.Filters)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	result := make([]adapters.MemoryItem, 0, len(items))
+	for _, item := range items {
+		mem := memoryItemFromStore(item)
+		mem.BotID = botID
+		result = append(result, mem)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].UpdatedAt > result[j].UpdatedAt })
+	if req.Limit > 0 && len(result) > req.Limit {
+		result = result[:req.Limit]
+	}
+	return adapters.SearchResponse{Results: result}, nil
+}
+
+func (r *hybridRuntime) Update(ctx context.Context, req adapters.UpdateRequest) (adapters.MemoryItem, error) {
+	memoryID := strings.TrimSpace(req.MemoryID)
+	if memoryID == "" {
+		return adapters.MemoryItem{}, errors.New("hybrid runtime: memory_id is required")
+	}
+	text := strings.TrimSpace(req.Memory)
+	if text == "" {
+		return adapters.MemoryItem{}, errors.New("hybrid runtime: memory is required")
+	}
+	botID := runtimeBotIDFromMemoryID(memoryID)
+	if botID == "" {
+		return adapters.MemoryItem{}, errors.New("hybrid runtime: invalid memory_id")
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.MemoryItem{}, err
+	}
+	var existing *storefs.MemoryItem
+	for i := range items {
+		if strings.TrimSpace(items[i].ID) == memoryID {
+			item := items[i]
+			existing = &item
+			break
+		}
+	}
+	if existing == nil {
+		return adapters.MemoryItem{}, errors.New("hybrid runtime: memory not found")
+	}
+	existing.Memory = text
+	existing.Hash = runtimeHash(text)
+	existing.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := r.store.PersistMemories(ctx, botID, []storefs.MemoryItem{*existing}, nil); err != nil {
+		return adapters.MemoryItem{}, err
+	}
+	if err := r.upsertSourceItems(ctx, botID, []storefs.MemoryItem{*existing}); err != nil {
+		return adapters.MemoryItem{}, err
+	}
+	item := memoryItemFromStore(*existing)
+	item.BotID = botID
+	return item, nil
+}
+
+func (r *hybridRuntime) Delete(ctx context.Context, memoryID string) (adapters.DeleteResponse, error) {
+	return r.DeleteBatch(ctx, []string{memoryID})
+}
+
+func (r *hybridRuntime) DeleteBatch(ctx context.Context, memoryIDs []string) (adapters.DeleteResponse, error) {
+	grouped := map[string][]string{}
+	pointIDs := make([]string, 0, len(memoryIDs))
+	for _, rawID := range memoryIDs {
+		memoryID := strings.TrimSpace(rawID)
+		if memoryID == "" {
+			continue
+		}
+		botID := runtimeBotIDFromMemoryID(memoryID)
+		if botID == "" {
+			continue
+		}
+		grouped[botID] = append(grouped[botID], memoryID)
+		pointIDs = append(pointIDs, runtimePointID(botID, memoryID))
+	}
+	for botID, ids := range grouped {
+		if err := r.store.RemoveMemories(ctx, botID, ids); err != nil {
+			return adapters.DeleteResponse{}, err
+		}
+	}
+	if err := r.dense.deleteIndexPoints(ctx, pointIDs); err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	if err := r.sparse.deleteIndexPoints(ctx, pointIDs); err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	return adapters.DeleteResponse{Message: "Memories deleted successfully!"}, nil
+}
+
+func (r *hybridRuntime) DeleteAll(ctx context.Context, req adapters.DeleteAllRequest) (adapters.DeleteResponse, error) {
+	botID, err := runtimeBotID(req.BotID, req.Filters)
+	if err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	if err := r.store.RemoveAllMemories(ctx, botID); err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	if err := r.dense.deleteIndexBot(ctx, botID); err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	if err := r.sparse.deleteIndexBot(ctx, botID); err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	return adapters.DeleteResponse{Message: "All memories deleted successfully!"}, nil
+}
+
+func (r *hybridRuntime) Compact(ctx context.Context, filters map[string]any, ratio float64, _ int) (adapters.CompactResult, error) {
+	botID, err := runtimeBotID("", filters)
+	if err != nil {
+		return adapters.CompactResult{}, err
+	}
+	if ratio <= 0 || ratio > 1 {
+		return adapters.CompactResult{}, errors.New("ratio must be in range (0, 1]")
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.CompactResult{}, err
+	}
+	before := len(items)
+	if before == 0 {
+		return adapters.CompactResult{BeforeCount: 0, AfterCount: 0, Ratio: ratio, Results: []adapters.MemoryItem{}}, nil
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].UpdatedAt > items[j].UpdatedAt })
+	target := int(float64(before) * ratio)
+	if target < 1 {
+		target = 1
+	}
+	if target > before {
+		target = before
+	}
+	keptStore := append([]storefs.MemoryItem(nil), items[:target]...)
+	if err := r.store.RebuildFiles(ctx, botID, keptStore, filters); err != nil {
+		return adapters.CompactResult{}, err
+	}
+	if _, err := r.Rebuild(ctx, botID); err != nil {
+		return adapters.CompactResult{}, err
+	}
+	kept := make([]adapters.MemoryItem, 0, len(keptStore))
+	for _, item := range keptStore {
+		kept = append(kept, memoryItemFromStore(item))
+	}
+	return adapters.CompactResult{
+		BeforeCount: before,
+		AfterCount:  len(kept),
+		Ratio:       ratio,
+		Results:     kept,
+	}, nil
+}
+
+func (r *hybridRuntime) Usage(ctx context.Context, filters map[string]any) (adapters.UsageResponse, error) {
+	botID, err := runtimeBotID("", filters)
+	if err != nil {
+		return adapters.UsageResponse{}, err
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.UsageResponse{}, err
+	}
+	var usage adapters.UsageResponse
+	usage.Count = len(items)
+	for _, item := range items {
+		usage.TotalTextBytes += int64(len(item.Memory))
+	}
+	if usage.Count > 0 {
+		usage.AvgTextBytes = usage.TotalTextBytes / int64(usage.Count)
+	}
+	usage.EstimatedStorageBytes = usage.TotalTextBytes
+	return usage, nil
+}
+
+// Status merges the status of both indexes: a component is healthy only when
+// it is healthy for both, and the indexed count is the smaller of the two.
+func (r *hybridRuntime) Status(ctx context.Context, botID string) (adapters.MemoryStatusResponse, error) {
+	dense, err := r.dense.Status(ctx, botID)
+	if err != nil {
+		return adapters.MemoryStatusResponse{}, err
+	}
+	sparse, err := r.sparse.Status(ctx, botID)
+	if err != nil {
+		return adapters.MemoryStatusResponse{}, err
+	}
+	return adapters.MemoryStatusResponse{
+		ProviderType:      BuiltinType,
+		MemoryMode:        string(ModeHybrid),
+		CanManualSync:     true,
+		SourceDir:         path.Join(config.DefaultDataMount, "memory"),
+		OverviewPath:      path.Join(config.DefaultDataMount, "MEMORY.md"),
+		MarkdownFileCount: dense.MarkdownFileCount,
+		SourceCount:       dense.SourceCount,
+		IndexedCount:      min(dense.IndexedCount, sparse.IndexedCount),
+		QdrantCollection:  dense.QdrantCollection + ", " + sparse.QdrantCollection,
+		Encoder:           mergeHealth(dense.Encoder, sparse.Encoder),
+		Qdrant:            mergeHealth(dense.Qdrant, sparse.Qdrant),
+	}, nil
+}
+
+func (r *hybridRuntime) Rebuild(ctx context.Context, botID string) (adapters.RebuildResult, error) {
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.RebuildResult{}, err
+	}
+	if err := r.store.SyncOverview(ctx, botID); err != nil {
+		return adapters.RebuildResult{}, err
+	}
+	dense, err := r.dense.syncSourceItems(ctx, botID, items)
+	if err != nil {
+		return adapters.RebuildResult{}, fmt.Errorf("dense: %w", err)
+	}
+	sparse, err := r.sparse.syncSourceItems(ctx, botID, items)
+	if err != nil {
+		return adapters.RebuildResult{}, fmt.Errorf("sparse: %w", err)
+	}
+	return adapters.RebuildResult{
+		FsCount:       max(dense.FsCount, sparse.FsCount),
+		StorageCount:  min(dense.StorageCount, sparse.StorageCount),
+		MissingCount:  max(dense.MissingCount, sparse.MissingCount),
+		RestoredCount: max(dense.RestoredCount, sparse.RestoredCount),
+	}, nil
+}
+
+func (r *hybridRuntime) upsertSourceItems(ctx context.Context, botID string, items []storefs.MemoryItem) error {
+	if err := r.dense.upsertSourceItems(ctx, botID, items); err != nil {
+		return err
+	}
+	return r.sparse.upsertSourceItems(ctx, botID, items)
+}
+
+// rankedList is one retriever's results, best first.
+type rankedList struct {
+	items  []adapters.MemoryItem
+	weight float64
+}
+
+// fuseRRF merges ranked lists with reciprocal rank fusion and returns at most
+// limit items ordered by fused score. Items are matched by memory ID; the
+// first list an item appears in supplies its payload.
+func fuseRRF(cfg rrfConfig, limit int, lists ...rankedList) []adapters.MemoryItem {
+	if cfg.K <= 0 {
+		cfg.K = defaultRRFConfig.K
+	}
+	scores := map[string]float64{}
+	byID := map[string]adapters.MemoryItem{}
+	order := make([]string, 0)
+	for _, list := range lists {
+		seen := map[string]struct{}{}
+		rank := 0
+		for _, item := range list.items {
+			id := strings.TrimSpace(item.ID)
+			if id == "" {
+				continue
+			}
+			if _, dup := seen[id]; dup {
+				continue
+			}
+			seen[id] = struct{}{}
+			rank++
+			if _, ok := byID[id]; !ok {
+				byID[id] = item
+				order = append(order, id)
+			}
+			scores[id] += list.weight / float64(cfg.K+rank)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+	if limit > 0 && len(order) > limit {
+		order = order[:limit]
+	}
+	out := make([]adapters.MemoryItem, 0, len(order))
+	for _, id := range order {
+		item := byID[id]
+		item.Score = scores[id]
+		out = append(out, item)
+	}
+	return out
+}
+
+func mergeHealth(a, b adapters.HealthStatus) adapters.HealthStatus {
+	var errs []string
+	if a.Error != "" {
+		errs = append(errs, "dense: "+a.Error)
+	}
+	if b.Error != "" {
+		errs = append(errs, "sparse: "+b.Error)
+	}
+	return adapters.HealthStatus{
+		OK:    a.OK && b.OK,
+		Error: strings.Join(errs, "; "),
+	}
+}
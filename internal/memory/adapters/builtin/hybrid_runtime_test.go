@@ -0,0 +1,172 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"testing"
+
+	adapters "github.com/memohai/memoh/internal/memory/adapters"
+	storefs "github.com/memohai/memoh/internal/memory/storefs"
+)
+
+func newTestHybridRuntime(store *fakeSparseStore) (*hybridRuntime, *fakeSparseIndex, *fakeSparseIndex) {
+	denseEncoder := &fakeSparseEncoder{}
+	denseIndex := newFakeSparseIndex(denseEncoder)
+	sparseEncoder := &fakeSparseEncoder{}
+	sparseIndex := newFakeSparseIndex(sparseEncoder)
+	runtime := newHybridRuntime(
+		slog.Default(),
+		&sparseRuntime{qdrant: denseIndex, encoder: denseEncoder, store: store},
+		&sparseRuntime{qdrant: sparseIndex, encoder: sparseEncoder, store: store},
+		store,
+		defaultRRFConfig,
+	)
+	return runtime, denseIndex, sparseIndex
+}
+
+type failingIndex struct {
+	retrievalIndex
+}
+
+func (failingIndex) searchIndex(context.Context, string, string, int) ([]adapters.MemoryItem, error) {
+	return nil, errors.New("index unavailable")
+}
+
+func TestFuseRRF(t *testing.T) {
+	t.Parallel()
+
+	dense := []adapters.MemoryItem{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	sparse := []adapters.MemoryItem{{ID: "c"}, {ID: "d"}, {ID: "c"}}
+	got := fuseRRF(rrfConfig{K: 60}, 10,
+		rankedList{items: dense, weight: 1},
+		rankedList{items: sparse, weight: 1},
+	)
+	ids := make([]string, 0, len(got))
+	for _, item := range got {
+		ids = append(ids, item.ID)
+	}
+	want := []string{"c", "a", "b", "d"}
+	if len(ids) != len(want) {
+		t.Fatalf("fused ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("fused ids = %v, want %v", ids, want)
+		}
+	}
+	if wantScore := 1.0/63 + 1.0/61; math.Abs(got[0].Score-wantScore) > 1e-12 {
+		t.Fatalf("score of c = %v, want %v", got[0].Score, wantScore)
+	}
+
+	weighted := fuseRRF(rrfConfig{K: 60}, 1,
+		rankedList{items: dense, weight: 1},
+		rankedList{items: []adapters.MemoryItem{{ID: "d"}}, weight: 3},
+	)
+	if len(weighted) != 1 || weighted[0].ID != "d" {
+		t.Fatalf("expected sparse weight to promote d, got %#v", weighted)
+	}
+
+	if empty := fuseRRF(defaultRRFConfig, 5); len(empty) != 0 {
+		t.Fatalf("expected no results from no lists, got %#v", empty)
+	}
+}
+
+func TestRRFConfigFromProvider(t *testing.T) {
+	t.Parallel()
+
+	if got := rrfConfigFromProvider(nil); got != defaultRRFConfig {
+		t.Fatalf("nil config = %+v, want defaults", got)
+	}
+	got := rrfConfigFromProvider(map[string]any{
+		"hybrid_rrf_k":         float64(20),
+		"hybrid_dense_weight":  0.5,
+		"hybrid_sparse_weight": -1,
+	})
+	want := rrfConfig{K: 20, DenseWeight: 0.5, SparseWeight: 1}
+	if got != want {
+		t.Fatalf("config = %+v, want %+v", got, want)
+	}
+}
+
+func TestHybridRuntimeAddIndexesBothAndSearchFuses(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeSparseStore()
+	runtime, denseIndex, sparseIndex := newTestHybridRuntime(store)
+
+	resp, err := runtime.Add(context.Background(), adapters.AddRequest{
+		BotID:   "bot-1",
+		Message: "Deploy ticket OPS-4412 to host db-07",
+		Filters: map[string]any{"scopeId": "bot-1"},
+	})
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	item := resp.Results[0]
+	if len(store.items) != 1 {
+		t.Fatalf("expected one source memory, got %d", len(store.items))
+	}
+	pointID := runtimePointID("bot-1", item.ID)
+	if _, ok := denseIndex.points[pointID]; !ok {
+		t.Fatal("expected dense index point")
+	}
+	if _, ok := sparseIndex.points[pointID]; !ok {
+		t.Fatal("expected sparse index point")
+	}
+
+	searchResp, err := runtime.Search(context.Background(), adapters.SearchRequest{
+		BotID: "bot-1",
+		Query: "OPS-4412",
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(searchResp.Results) != 1 || searchResp.Results[0].ID != item.ID {
+		t.Fatalf("expected fused result %q, got %#v", item.ID, searchResp.Results)
+	}
+	if searchResp.Results[0].Score <= 0 {
+		t.Fatalf("expected positive fused score, got %v", searchResp.Results[0].Score)
+	}
+
+	if _, err := runtime.Delete(context.Background(), item.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if len(denseIndex.points) != 0 || len(sparseIndex.points) != 0 {
+		t.Fatalf("expected both indexes to be cleared, got dense=%d sparse=%d", len(denseIndex.points), len(sparseIndex.points))
+	}
+}
+
+func TestHybridRuntimeSearchFallsBackWhenOneIndexFails(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeSparseStore(storefs.MemoryItem{
+		ID:        "bot-1:mem_1",
+		Memory:    "host db-07 runs postgres",
+		Hash:      runtimeHash("host db-07 runs postgres"),
+		CreatedAt: "2026-01-01T00:00:00Z",
+		UpdatedAt: "2026-01-01T00:00:00Z",
+	})
+	runtime, _, _ := newTestHybridRuntime(store)
+	if _, err := runtime.Rebuild(context.Background(), "bot-1"); err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	runtime.dense = failingIndex{}
+
+	resp, err := runtime.Search(context.Background(), adapters.SearchRequest{
+		BotID: "bot-1",
+		Query: "db-07",
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].ID != "bot-1:mem_1" {
+		t.Fatalf("expected sparse-only result, got %#v", resp.Results)
+	}
+
+	runtime.sparse = failingIndex{}
+	if _, err := runtime.Search(context.Background(), adapters.SearchRequest{BotID: "bot-1", Query: "db-07"}); err == nil {
+		t.Fatal("expected error when both indexes fail")
+	}
+}
@@ -120,30 +120,14 @@ func (r *sparseRuntime) Search(ctx context.Context, req adapters.SearchRequest)
 	if err != nil {
 		return adapters.SearchResponse{}, err
 	}
-	if err := r.ensureCollection(ctx); err != nil {
-		return adapters.SearchResponse{}, err
-	}
-
 	limit := req.Limit
 	if limit <= 0 {
 		limit = 10
 	}
-
-	vec, err := r.encoder.EncodeQuery(ctx, req.Query)
-	if err != nil {
-		return adapters.SearchResponse{}, fmt.Errorf("sparse encode query: %w", err)
-	}
-	results, err := r.qdrant.Search(ctx, qdrantclient.SparseVector{
-		Indices: vec.Indices,
-		Values:  vec.Values,
-	}, botID, limit)
+	items, err := r.searchIndex(ctx, botID, req.Query, limit)
 	if err != nil {
 		return adapters.SearchResponse{}, err
 	}
-	items := make([]adapters.MemoryItem, 0, len(results))
-	for _, r := range results {
-		items = append(items, resultToItem(r))
-	}
 	return adapters.SearchResponse{Results: items}, nil
 }
 
@@ -236,10 +220,7 @@ func (r *sparseRuntime) DeleteBatch(ctx context.Context, memoryIDs []string) (ad
 			return adapters.DeleteResponse{}, err
 		}
 	}
-	if err := r.ensureCollection(ctx); err != nil {
-		return adapters.DeleteResponse{}, err
-	}
-	if err := r.qdrant.DeleteByIDs(ctx, pointIDs); err != nil {
+	if err := r.deleteIndexPoints(ctx, pointIDs); err != nil {
 		return adapters.DeleteResponse{}, err
 	}
 	return adapters.DeleteResponse{Message: "Memories deleted successfully!"}, nil
@@ -253,10 +234,7 @@ func (r *sparseRuntime) DeleteAll(ctx context.Context, req adapters.DeleteAllReq
 	if err := r.store.RemoveAllMemories(ctx, botID); err != nil {
 		return adapters.DeleteResponse{}, err
 	}
-	if err := r.ensureCollection(ctx); err != nil {
-		return adapters.DeleteResponse{}, err
-	}
-	if err := r.qdrant.DeleteByBotID(ctx, botID); err != nil {
+	if err := r.deleteIndexBot(ctx, botID); err != nil {
 		return adapters.DeleteResponse{}, err
 	}
 	return adapters.DeleteResponse{Message: "All memories deleted successfully!"}, nil
@@ -381,6 +359,42 @@ func (r *sparseRuntime) Rebuild(ctx context.Context, botID string) (adapters.Reb
 
 // --- helpers ---
 
+func (r *sparseRuntime) searchIndex(ctx context.Context, botID, query string, limit int) ([]adapters.MemoryItem, error) {
+	if err := r.ensureCollection(ctx); err != nil {
+		return nil, err
+	}
+	vec, err := r.encoder.EncodeQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("sparse encode query: %w", err)
+	}
+	results, err := r.qdrant.Search(ctx, qdrantclient.SparseVector{
+		Indices: vec.Indices,
+		Values:  vec.Values,
+	}, botID, limit)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]adapters.MemoryItem, 0, len(results))
+	for _, result := range results {
+		items = append(items, resultToItem(result))
+	}
+	return items, nil
+}
+
+func (r *sparseRuntime) deleteIndexPoints(ctx context.Context, pointIDs []string) error {
+	if err := r.ensureCollection(ctx); err != nil {
+		return err
+	}
+	return r.qdrant.DeleteByIDs(ctx, pointIDs)
+}
+
+func (r *sparseRuntime) deleteIndexBot(ctx context.Context, botID string) error {
+	if err := r.ensureCollection(ctx); err != nil {
+		return err
+	}
+	return r.qdrant.DeleteByBotID(ctx, botID)
+}
+
 func (r *sparseRuntime) syncSourceItems(ctx context.Context, botID string, items []storefs.MemoryItem) (adapters.RebuildResult, error) {
 	if err := r.ensureCollection(ctx); err != nil {
 		return adapters.RebuildResult{}, err
@@ -0,0 +1,522 @@
+package builtin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/memohai/memoh/internal/config"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+	adapters "github.com/memohai/memoh/internal/memory/adapters"
+	qdrantclient "github.com/memohai/memoh/internal/memory/qdrant"
+	"github.com/memohai/memoh/internal/memory/sqliteindex"
+	storefs "github.com/memohai/memoh/internal/memory/storefs"
+)
+
+// textEmbedder produces dense vectors for the SQLite runtime. denseEmbedder
+// implements it on top of a configured embedding model.
+type textEmbedder interface {
+	embedQuery(ctx context.Context, text string) ([]float32, error)
+	embedDocuments(ctx context.Context, texts []string) ([][]float32, error)
+	embedHealth(ctx context.Context) error
+}
+
+type sqliteMemoryIndex interface {
+	EnsureSchema(ctx context.Context) error
+	Upsert(ctx context.Context, points []sqliteindex.Point) error
+	SearchText(ctx context.Context, botID, query string, limit int) ([]sqliteindex.SearchResult, error)
+	SearchVector(ctx context.Context, botID string, vec []float32, limit int) ([]sqliteindex.SearchResult, error)
+	Scroll(ctx context.Context, botID string, limit int) ([]sqliteindex.SearchResult, error)
+	Count(ctx context.Context, botID string) (int, error)
+	DeleteByIDs(ctx context.Context, ids []string) error
+	DeleteByBotID(ctx context.Context, botID string) error
+}
+
+const sqliteScrollLimit = 100000
+
+// sqliteRuntime implements memoryRuntime with markdown files as the source of
+// truth and an index embedded in the application's SQLite database. Every item
+// is indexed for FTS5 BM25 search; when an embedding model is configured it
+// also stores a vector and searches fuse both rankings with reciprocal rank
+// fusion, the same way the hybrid runtime fuses Qdrant indexes.
+type sqliteRuntime struct {
+	index    sqliteMemoryIndex
+	embedder textEmbedder
+	store    sparseMemoryStore
+	fusion   rrfConfig
+	logger   *slog.Logger
+}
+
+// NewSQLiteRuntimeFromConfig builds the runtime of the sqlite memory provider.
+// conn must be the SQLite database the server runs on; embedding_model_id in
+// the provider config is optional and enables vector search.
+func NewSQLiteRuntimeFromConfig(log *slog.Logger, providerConfig map[string]any, conn *sql.DB, store *storefs.Service, queries dbstore.Queries) (any, error) {
+	if conn == nil {
+		return nil, errors.New("sqlite memory: the server is not running on the sqlite database driver")
+	}
+	if store == nil {
+		return nil, errors.New("sqlite memory: memory store is required")
+	}
+	index, err := sqliteindex.New(conn)
+	if err != nil {
+		return nil, err
+	}
+	var embedder textEmbedder
+	if modelRef := strings.TrimSpace(adapters.StringFromConfig(providerConfig, "embedding_model_id")); modelRef != "" {
+		if queries == nil {
+			return nil, errors.New("sqlite memory: queries are required to resolve the embedding model")
+		}
+		dense, _, err := newDenseEmbedder(context.Background(), queries, modelRef)
+		if err != nil {
+			return nil, err
+		}
+		embedder = dense
+	}
+	return newSQLiteRuntime(log, index, embedder, store, rrfConfigFromProvider(providerConfig))
+}
+
+func newSQLiteRuntime(log *slog.Logger, index sqliteMemoryIndex, embedder textEmbedder, store sparseMemoryStore, fusion rrfConfig) (*sqliteRuntime, error) {
+	if log == nil {
+		log = slog.Default()
+	}
+	if err := index.EnsureSchema(context.Background()); err != nil {
+		return nil, err
+	}
+	return &sqliteRuntime{
+		index:    index,
+		embedder: embedder,
+		store:    store,
+		fusion:   fusion,
+		logger:   log.With(slog.String("runtime", string(adapters.ProviderSQLite))),
+	}, nil
+}
+
+func (*sqliteRuntime) Mode() string {
+	return string(adapters.ProviderSQLite)
+}
+
+func (r *sqliteRuntime) Add(ctx context.Context, req adapters.AddRequest) (adapters.SearchResponse, error) {
+	botID, err := runtimeBotID(req.BotID, req.Filters)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	text := runtimeText(req.Message, req.Messages)
+	if text == "" {
+		return adapters.SearchResponse{}, errors.New("sqlite runtime: message is required")
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	item := adapters.MemoryItem{
+		ID:        runtimeMemoryID(botID, time.Now().UTC()),
+		Memory:    text,
+		Hash:      runtimeHash(text),
+		Metadata:  req.Metadata,
+		BotID:     botID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := r.store.PersistMemories(ctx, botID, []storefs.MemoryItem{storeItemFromMemoryItem(item)}, req.Filters); err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	if err := r.upsertSourceItems(ctx, botID, []storefs.MemoryItem{storeItemFromMemoryItem(item)}); err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	return adapters.SearchResponse{Results: []adapters.MemoryItem{item}}, nil
+}
+
+// Search ranks by BM25 and, with an embedding model, by cosine similarity,
+// then fuses the two. A failing embedding model degrades to full-text only.
+func (r *sqliteRuntime) Search(ctx context.Context, req adapters.SearchRequest) (adapters.SearchResponse, error) {
+	botID, err := runtimeBotID(req.BotID, req.Filters)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	textResults, err := r.index.SearchText(ctx, botID, req.Query, limit)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	textItems := sqliteResultsToItems(textResults)
+	if r.embedder == nil {
+		return adapters.SearchResponse{Results: textItems}, nil
+	}
+	vectorItems, err := r.searchVectors(ctx, botID, req.Query, limit)
+	if err != nil {
+		r.logger.Warn("sqlite vector search failed; using full-text results only", slog.String("bot_id", botID), slog.Any("error", err))
+		return adapters.SearchResponse{Results: textItems}, nil
+	}
+	items := fuseRRF(r.fusion, limit,
+		rankedList{items: vectorItems, weight: r.fusion.DenseWeight},
+		rankedList{items: textItems, weight: r.fusion.SparseWeight},
+	)
+	return adapters.SearchResponse{Results: items}, nil
+}
+
+func (r *sqliteRuntime) GetAll(ctx context.Context, req adapters.GetAllRequest) (adapters.SearchResponse, error) {
+	botID, err := runtimeBotID(req.BotID, req.Filters)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.SearchResponse{}, err
+	}
+	result := make([]adapters.MemoryItem, 0, len(items))
+	for _, item := range items {
+		mem := memoryItemFromStore(item)
+		mem.BotID = botID
+		result = append(result, mem)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].UpdatedAt > result[j].UpdatedAt })
+	if req.Limit > 0 && len(result) > req.Limit {
+		result = result[:req.Limit]
+	}
+	return adapters.SearchResponse{Results: result}, nil
+}
+
+func (r *sqliteRuntime) Update(ctx context.Context, req adapters.UpdateRequest) (adapters.MemoryItem, error) {
+	memoryID := strings.TrimSpace(req.MemoryID)
+	if memoryID == "" {
+		return adapters.MemoryItem{}, errors.New("sqlite runtime: memory_id is required")
+	}
+	text := strings.TrimSpace(req.Memory)
+	if text == "" {
+		return adapters.MemoryItem{}, errors.New("sqlite runtime: memory is required")
+	}
+	botID := runtimeBotIDFromMemoryID(memoryID)
+	if botID == "" {
+		return adapters.MemoryItem{}, errors.New("sqlite runtime: invalid memory_id")
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.MemoryItem{}, err
+	}
+	var existing *storefs.MemoryItem
+	for i := range items {
+		if strings.TrimSpace(items[i].ID) == memoryID {
+			item := items[i]
+			existing = &item
+			break
+		}
+	}
+	if existing == nil {
+		return adapters.MemoryItem{}, errors.New("sqlite runtime: memory not found")
+	}
+	existing.Memory = text
+	existing.Hash = runtimeHash(text)
+	existing.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := r.store.PersistMemories(ctx, botID, []storefs.MemoryItem{*existing}, nil); err != nil {
+		return adapters.MemoryItem{}, err
+	}
+	if err := r.upsertSourceItems(ctx, botID, []storefs.MemoryItem{*existing}); err != nil {
+		return adapters.MemoryItem{}, err
+	}
+	item := memoryItemFromStore(*existing)
+	item.BotID = botID
+	return item, nil
+}
+
+func (r *sqliteRuntime) Delete(ctx context.Context, memoryID string) (adapters.DeleteResponse, error) {
+	return r.DeleteBatch(ctx, []string{memoryID})
+}
+
+func (r *sqliteRuntime) DeleteBatch(ctx context.Context, memoryIDs []string) (adapters.DeleteResponse, error) {
+	grouped := map[string][]string{}
+	pointIDs := make([]string, 0, len(memoryIDs))
+	for _, rawID := range memoryIDs {
+		memoryID := strings.TrimSpace(rawID)
+		if memoryID == "" {
+			continue
+		}
+		botID := runtimeBotIDFromMemoryID(memoryID)
+		if botID == "" {
+			continue
+		}
+		grouped[botID] = append(grouped[botID], memoryID)
+		pointIDs = append(pointIDs, runtimePointID(botID, memoryID))
+	}
+	for botID, ids := range grouped {
+		if err := r.store.RemoveMemories(ctx, botID, ids); err != nil {
+			return adapters.DeleteResponse{}, err
+		}
+	}
+	if err := r.index.DeleteByIDs(ctx, pointIDs); err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	return adapters.DeleteResponse{Message: "Memories deleted successfully!"}, nil
+}
+
+func (r *sqliteRuntime) DeleteAll(ctx context.Context, req adapters.DeleteAllRequest) (adapters.DeleteResponse, error) {
+	botID, err := runtimeBotID(req.BotID, req.Filters)
+	if err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	if err := r.store.RemoveAllMemories(ctx, botID); err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	if err := r.index.DeleteByBotID(ctx, botID); err != nil {
+		return adapters.DeleteResponse{}, err
+	}
+	return adapters.DeleteResponse{Message: "All memories deleted successfully!"}, nil
+}
+
+func (r *sqliteRuntime) Compact(ctx context.Context, filters map[string]any, ratio float64, _ int) (adapters.CompactResult, error) {
+	botID, err := runtimeBotID("", filters)
+	if err != nil {
+		return adapters.CompactResult{}, err
+	}
+	if ratio <= 0 || ratio > 1 {
+		return adapters.CompactResult{}, errors.New("ratio must be in range (0, 1]")
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.CompactResult{}, err
+	}
+	before := len(items)
+	if before == 0 {
+		return adapters.CompactResult{BeforeCount: 0, AfterCount: 0, Ratio: ratio, Results: []adapters.MemoryItem{}}, nil
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].UpdatedAt > items[j].UpdatedAt })
+	target := int(float64(before) * ratio)
+	if target < 1 {
+		target = 1
+	}
+	if target > before {
+		target = before
+	}
+	keptStore := append([]storefs.MemoryItem(nil), items[:target]...)
+	if err := r.store.RebuildFiles(ctx, botID, keptStore, filters); err != nil {
+		return adapters.CompactResult{}, err
+	}
+	if _, err := r.Rebuild(ctx, botID); err != nil {
+		return adapters.CompactResult{}, err
+	}
+	kept := make([]adapters.MemoryItem, 0, len(keptStore))
+	for _, item := range keptStore {
+		kept = append(kept, memoryItemFromStore(item))
+	}
+	return adapters.CompactResult{
+		BeforeCount: before,
+		AfterCount:  len(kept),
+		Ratio:       ratio,
+		Results:     kept,
+	}, nil
+}
+
+func (r *sqliteRuntime) Usage(ctx context.Context, filters map[string]any) (adapters.UsageResponse, error) {
+	botID, err := runtimeBotID("", filters)
+	if err != nil {
+		return adapters.UsageResponse{}, err
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.UsageResponse{}, err
+	}
+	var usage adapters.UsageResponse
+	usage.Count = len(items)
+	for _, item := range items {
+		usage.TotalTextBytes += int64(len(item.Memory))
+	}
+	if usage.Count > 0 {
+		usage.AvgTextBytes = usage.TotalTextBytes / int64(usage.Count)
+	}
+	usage.EstimatedStorageBytes = usage.TotalTextBytes
+	return usage, nil
+}
+
+// Status reports the embedding model in the encoder slot and the SQLite index
+// in the vector store slot of the status response.
+func (r *sqliteRuntime) Status(ctx context.Context, botID string) (adapters.MemoryStatusResponse, error) {
+	fileCount, err := r.store.CountMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.MemoryStatusResponse{}, err
+	}
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.MemoryStatusResponse{}, err
+	}
+	status := adapters.MemoryStatusResponse{
+		ProviderType:      string(adapters.ProviderSQLite),
+		MemoryMode:        r.Mode(),
+		CanManualSync:     true,
+		SourceDir:         path.Join(config.DefaultDataMount, "memory"),
+		OverviewPath:      path.Join(config.DefaultDataMount, "MEMORY.md"),
+		MarkdownFileCount: fileCount,
+		SourceCount:       len(items),
+	}
+	status.Encoder.OK = true
+	if r.embedder != nil {
+		if err := r.embedder.embedHealth(ctx); err != nil {
+			status.Encoder.OK = false
+			status.Encoder.Error = err.Error()
+		}
+	}
+	count, err := r.index.Count(ctx, botID)
+	if err != nil {
+		status.Qdrant.Error = err.Error()
+		return status, nil
+	}
+	status.Qdrant.OK = true
+	status.IndexedCount = count
+	return status, nil
+}
+
+func (r *sqliteRuntime) Rebuild(ctx context.Context, botID string) (adapters.RebuildResult, error) {
+	items, err := r.store.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return adapters.RebuildResult{}, err
+	}
+	if err := r.store.SyncOverview(ctx, botID); err != nil {
+		return adapters.RebuildResult{}, err
+	}
+	return r.syncSourceItems(ctx, botID, items)
+}
+
+// --- helpers ---
+
+func (r *sqliteRuntime) searchVectors(ctx context.Context, botID, query string, limit int) ([]adapters.MemoryItem, error) {
+	vec, err := r.embedder.embedQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	results, err := r.index.SearchVector(ctx, botID, vec, limit)
+	if err != nil {
+		return nil, err
+	}
+	return sqliteResultsToItems(results), nil
+}
+
+// syncSourceItems reconciles the index with the markdown source: missing or
+// changed items are re-indexed, items indexed before an embedding model was
+// configured get a vector, and stale rows are dropped.
+func (r *sqliteRuntime) syncSourceItems(ctx context.Context, botID string, items []storefs.MemoryItem) (adapters.RebuildResult, error) {
+	existing, err := r.index.Scroll(ctx, botID, sqliteScrollLimit)
+	if err != nil {
+		return adapters.RebuildResult{}, err
+	}
+	existingBySource := make(map[string]sqliteindex.SearchResult, len(existing))
+	for _, item := range existing {
+		sourceID := strings.TrimSpace(item.Payload["source_entry_id"])
+		if sourceID == "" {
+			sourceID = strings.TrimSpace(item.ID)
+		}
+		if sourceID == "" {
+			continue
+		}
+		existingBySource[sourceID] = item
+	}
+	canonical := make([]storefs.MemoryItem, 0, len(items))
+	sourceIDs := make(map[string]struct{}, len(items))
+	toUpsert := make([]storefs.MemoryItem, 0, len(items))
+	missingCount := 0
+	restoredCount := 0
+	for _, item := range items {
+		item = canonicalStoreItem(item)
+		if item.ID == "" || item.Memory == "" {
+			continue
+		}
+		canonical = append(canonical, item)
+		sourceIDs[item.ID] = struct{}{}
+		existingItem, ok := existingBySource[item.ID]
+		if !ok {
+			missingCount++
+			restoredCount++
+			toUpsert = append(toUpsert, item)
+			continue
+		}
+		if !payloadMatches(existingItem.Payload, runtimePayload(botID, item)) ||
+			(r.embedder != nil && existingItem.Dimensions == 0) {
+			restoredCount++
+			toUpsert = append(toUpsert, item)
+		}
+	}
+	stalePointIDs := make([]string, 0)
+	for _, item := range existing {
+		sourceID := strings.TrimSpace(item.Payload["source_entry_id"])
+		if sourceID == "" {
+			sourceID = strings.TrimSpace(item.ID)
+		}
+		if _, ok := sourceIDs[sourceID]; ok {
+			continue
+		}
+		stalePointIDs = append(stalePointIDs, item.ID)
+	}
+	if err := r.index.DeleteByIDs(ctx, stalePointIDs); err != nil {
+		return adapters.RebuildResult{}, err
+	}
+	if err := r.upsertSourceItems(ctx, botID, toUpsert); err != nil {
+		return adapters.RebuildResult{}, err
+	}
+	count, err := r.index.Count(ctx, botID)
+	if err != nil {
+		return adapters.RebuildResult{}, err
+	}
+	return adapters.RebuildResult{
+		FsCount:       len(canonical),
+		StorageCount:  count,
+		MissingCount:  missingCount,
+		RestoredCount: restoredCount,
+	}, nil
+}
+
+// upsertSourceItems indexes items for full-text search and, when possible,
+// vector search. An embedding failure is logged and the items are indexed
+// without vectors; the next rebuild fills them in.
+func (r *sqliteRuntime) upsertSourceItems(ctx context.Context, botID string, items []storefs.MemoryItem) error {
+	points := make([]sqliteindex.Point, 0, len(items))
+	texts := make([]string, 0, len(items))
+	for _, item := range items {
+		item = canonicalStoreItem(item)
+		if item.ID == "" || item.Memory == "" {
+			continue
+		}
+		points = append(points, sqliteindex.Point{
+			ID:      runtimePointID(botID, item.ID),
+			BotID:   botID,
+			Text:    item.Memory,
+			Payload: runtimePayload(botID, item),
+		})
+		texts = append(texts, item.Memory)
+	}
+	if len(points) == 0 {
+		return nil
+	}
+	if r.embedder != nil {
+		vectors, err := r.embedder.embedDocuments(ctx, texts)
+		switch {
+		case err != nil:
+			r.logger.Warn("sqlite memory embedding failed; indexing full text only", slog.String("bot_id", botID), slog.Any("error", err))
+		case len(vectors) != len(points):
+			r.logger.Warn("sqlite memory embedding returned wrong vector count; indexing full text only",
+				slog.String("bot_id", botID), slog.Int("expected", len(points)), slog.Int("got", len(vectors)))
+		default:
+			for i := range points {
+				points[i].Vector = vectors[i]
+			}
+		}
+	}
+	if err := r.index.Upsert(ctx, points); err != nil {
+		return fmt.Errorf("sqlite runtime: %w", err)
+	}
+	return nil
+}
+
+func sqliteResultsToItems(results []sqliteindex.SearchResult) []adapters.MemoryItem {
+	items := make([]adapters.MemoryItem, 0, len(results))
+	for _, result := range results {
+		items = append(items, resultToItem(qdrantclient.SearchResult{
+			ID:      result.ID,
+			Score:   result.Score,
+			Payload: result.Payload,
+		}))
+	}
+	return items
+}
@@ -0,0 +1,228 @@
+package builtin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	adapters "github.com/memohai/memoh/internal/memory/adapters"
+	"github.com/memohai/memoh/internal/memory/sqliteindex"
+	storefs "github.com/memohai/memoh/internal/memory/storefs"
+)
+
+// fakeTextEmbedder maps text onto a tiny vocabulary so similarity is
+// predictable: each dimension counts one keyword.
+type fakeTextEmbedder struct {
+	fail bool
+}
+
+var fakeEmbedVocabulary = []string{"tea", "coffee", "deploy"}
+
+func (e *fakeTextEmbedder) embed(text string) ([]float32, error) {
+	if e.fail {
+		return nil, errors.New("embedding unavailable")
+	}
+	vec := make([]float32, len(fakeEmbedVocabulary))
+	lower := strings.ToLower(text)
+	for i, word := range fakeEmbedVocabulary {
+		vec[i] = float32(strings.Count(lower, word))
+	}
+	vec[len(vec)-1] += 0.01
+	return vec, nil
+}
+
+func (e *fakeTextEmbedder) embedQuery(_ context.Context, text string) ([]float32, error) {
+	return e.embed(text)
+}
+
+func (e *fakeTextEmbedder) embedDocuments(_ context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		vec, err := e.embed(text)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, vec)
+	}
+	return out, nil
+}
+
+func (e *fakeTextEmbedder) embedHealth(context.Context) error {
+	if e.fail {
+		return errors.New("embedding unavailable")
+	}
+	return nil
+}
+
+func newTestSQLiteRuntime(t *testing.T, store *fakeSparseStore, embedder textEmbedder) (*sqliteRuntime, *sqliteindex.Index) {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "memoh.db"))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	index, err := sqliteindex.New(db)
+	if err != nil {
+		t.Fatalf("sqliteindex.New() error = %v", err)
+	}
+	runtime, err := newSQLiteRuntime(slog.Default(), index, embedder, store, defaultRRFConfig)
+	if err != nil {
+		t.Fatalf("newSQLiteRuntime() error = %v", err)
+	}
+	return runtime, index
+}
+
+func TestSQLiteRuntimeFullTextOnly(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeSparseStore()
+	runtime, index := newTestSQLiteRuntime(t, store, nil)
+	ctx := context.Background()
+
+	resp, err := runtime.Add(ctx, adapters.AddRequest{
+		BotID:   "bot-1",
+		Message: "Deploy ticket OPS-4412 to host db-07",
+		Filters: map[string]any{"scopeId": "bot-1"},
+	})
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	item := resp.Results[0]
+	if len(store.items) != 1 {
+		t.Fatalf("expected markdown source to be written, got %d items", len(store.items))
+	}
+
+	search, err := runtime.Search(ctx, adapters.SearchRequest{BotID: "bot-1", Query: "OPS-4412"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(search.Results) != 1 || search.Results[0].ID != item.ID || search.Results[0].Memory != item.Memory {
+		t.Fatalf("unexpected search results %#v", search.Results)
+	}
+
+	updated, err := runtime.Update(ctx, adapters.UpdateRequest{MemoryID: item.ID, Memory: "Deploy ticket OPS-5000 to host db-09"})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.Memory != "Deploy ticket OPS-5000 to host db-09" {
+		t.Fatalf("unexpected update %#v", updated)
+	}
+	if search, _ := runtime.Search(ctx, adapters.SearchRequest{BotID: "bot-1", Query: "OPS-4412"}); len(search.Results) != 0 {
+		t.Fatalf("expected old text to be unindexed, got %#v", search.Results)
+	}
+
+	status, err := runtime.Status(ctx, "bot-1")
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if status.ProviderType != "sqlite" || status.IndexedCount != 1 || !status.Qdrant.OK || !status.Encoder.OK {
+		t.Fatalf("unexpected status %#v", status)
+	}
+
+	if _, err := runtime.Delete(ctx, item.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if n, _ := index.Count(ctx, "bot-1"); n != 0 {
+		t.Fatalf("expected index to be empty after delete, got %d", n)
+	}
+}
+
+func TestSQLiteRuntimeRebuildSyncsSourceAndBackfillsVectors(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeSparseStore(
+		storefs.MemoryItem{ID: "bot-1:mem_1", Memory: "User drinks tea every morning", CreatedAt: "2026-01-01T00:00:00Z", UpdatedAt: "2026-01-01T00:00:00Z"},
+		storefs.MemoryItem{ID: "bot-1:mem_2", Memory: "User dislikes coffee", CreatedAt: "2026-01-02T00:00:00Z", UpdatedAt: "2026-01-02T00:00:00Z"},
+	)
+	embedder := &fakeTextEmbedder{fail: true}
+	runtime, index := newTestSQLiteRuntime(t, store, embedder)
+	ctx := context.Background()
+
+	// Stale row that no longer exists in the markdown source.
+	if err := index.Upsert(ctx, []sqliteindex.Point{{ID: runtimePointID("bot-1", "bot-1:gone"), BotID: "bot-1", Text: "gone", Payload: map[string]string{"source_entry_id": "bot-1:gone"}}}); err != nil {
+		t.Fatalf("seed stale row: %v", err)
+	}
+
+	result, err := runtime.Rebuild(ctx, "bot-1")
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if result.FsCount != 2 || result.StorageCount != 2 || result.MissingCount != 2 {
+		t.Fatalf("unexpected rebuild result %#v", result)
+	}
+	if n, _ := index.CountVectors(ctx, "bot-1"); n != 0 {
+		t.Fatalf("expected no vectors while embedding fails, got %d", n)
+	}
+
+	// Full-text search still works while the embedding model is down.
+	search, err := runtime.Search(ctx, adapters.SearchRequest{BotID: "bot-1", Query: "coffee"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(search.Results) != 1 || search.Results[0].ID != "bot-1:mem_2" {
+		t.Fatalf("unexpected degraded results %#v", search.Results)
+	}
+
+	embedder.fail = false
+	result, err = runtime.Rebuild(ctx, "bot-1")
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if result.RestoredCount != 2 {
+		t.Fatalf("expected vectors to be backfilled, got %#v", result)
+	}
+	if n, _ := index.CountVectors(ctx, "bot-1"); n != 2 {
+		t.Fatalf("CountVectors() = %d, want 2", n)
+	}
+
+	// "a hot cup of tea" shares no full-text token with mem_1 beyond "tea",
+	// and the vector ranking agrees, so mem_1 must rank first.
+	search, err = runtime.Search(ctx, adapters.SearchRequest{BotID: "bot-1", Query: "a hot cup of tea"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(search.Results) == 0 || search.Results[0].ID != "bot-1:mem_1" {
+		t.Fatalf("unexpected fused results %#v", search.Results)
+	}
+
+	result, err = runtime.Rebuild(ctx, "bot-1")
+	if err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if result.RestoredCount != 0 || result.MissingCount != 0 {
+		t.Fatalf("expected an in-sync index, got %#v", result)
+	}
+}
+
+func TestSQLiteRuntimeDrivesBuiltinProvider(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeSparseStore()
+	runtime, _ := newTestSQLiteRuntime(t, store, &fakeTextEmbedder{})
+	provider := NewBuiltinProvider(slog.Default(), runtime, nil, nil)
+	ctx := context.Background()
+
+	if _, err := provider.Add(ctx, adapters.AddRequest{BotID: "bot-1", Message: "User prefers green tea", Filters: map[string]any{"scopeId": "bot-1"}}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	result, err := provider.OnBeforeChat(ctx, adapters.BeforeChatRequest{BotID: "bot-1", Query: "what tea does the user like?"})
+	if err != nil {
+		t.Fatalf("OnBeforeChat() error = %v", err)
+	}
+	if result == nil || !strings.Contains(result.ContextText, "green tea") {
+		t.Fatalf("expected memory context, got %#v", result)
+	}
+	compact, err := provider.Compact(ctx, map[string]any{"bot_id": "bot-1"}, 1, 0)
+	if err != nil {
+		t.Fatalf("Compact() error = %v", err)
+	}
+	if compact.AfterCount != 1 {
+		t.Fatalf("unexpected compact result %#v", compact)
+	}
+}
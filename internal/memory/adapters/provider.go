@@ -2,6 +2,7 @@ package adapters
 
 import (
 	"context"
+	"time"
 
 	"github.com/memohai/memoh/internal/mcp"
 )
@@ -45,3 +46,11 @@ type SourceSyncProvider interface {
 	Status(ctx context.Context, botID string) (MemoryStatusResponse, error)
 	Rebuild(ctx context.Context, botID string) (RebuildResult, error)
 }
+
+// HistoryProvider is implemented by providers that keep a revision history of
+// memory items and can roll items, or a whole bot, back to an earlier state.
+type HistoryProvider interface {
+	History(ctx context.Context, botID, memoryID string) ([]MemoryRevision, error)
+	Rollback(ctx context.Context, botID, memoryID string, revision int) (MemoryItem, error)
+	RestoreAsOf(ctx context.Context, botID string, at time.Time) (RestoreResult, error)
+}
@@ -46,22 +46,43 @@ func (*Service) ListMeta(_ context.Context) []ProviderMeta {
 					"memory_mode": {
 						Type:        "select",
 						Title:       "Memory Mode",
-						Description: "off = file-based, sparse = Qdrant sparse vectors, dense = embedding API + Qdrant dense vectors",
+						Description: "off = file-based, sparse = Qdrant sparse vectors, dense = embedding API + Qdrant dense vectors, hybrid = dense + sparse fused with reciprocal rank fusion",
 						Required:    false,
 					},
 					"embedding_model_id": {
 						Type:        "model_select",
 						Title:       "Embedding Model",
-						Description: "Embedding model for dense vector search (dense mode only)",
+						Description: "Embedding model for dense vector search (dense and hybrid modes)",
 						Required:    false,
 					},
 					"qdrant_collection": {
 						Type:        "string",
 						Title:       "Qdrant Collection",
-						Description: "Qdrant collection name for sparse mode. Defaults to memory_sparse.",
+						Description: "Qdrant collection name for sparse and hybrid modes. Defaults to memory_sparse.",
 						Required:    false,
 						Example:     "memory_sparse",
 					},
+					"hybrid_rrf_k": {
+						Type:        "integer",
+						Title:       "Hybrid RRF k",
+						Description: "Reciprocal rank fusion constant for hybrid mode. Larger values flatten rank differences. Defaults to 60.",
+						Required:    false,
+						Example:     60,
+					},
+					"hybrid_dense_weight": {
+						Type:        "number",
+						Title:       "Hybrid Dense Weight",
+						Description: "Weight of the dense ranking in hybrid mode. Defaults to 1.",
+						Required:    false,
+						Example:     1,
+					},
+					"hybrid_sparse_weight": {
+						Type:        "number",
+						Title:       "Hybrid Sparse Weight",
+						Description: "Weight of the sparse ranking in hybrid mode. Defaults to 1.",
+						Required:    false,
+						Example:     1,
+					},
 					"context_target_items": {
 						Type:        "integer",
 						Title:       "Context Target Items",
@@ -132,6 +153,55 @@ func (*Service) ListMeta(_ context.Context) []ProviderMeta {
 				},
 			},
 		},
+		{
+			Provider:    string(ProviderSQLite),
+			DisplayName: "SQLite",
+			ConfigSchema: ProviderConfigSchema{
+				Fields: map[string]ProviderFieldSchema{
+					"embedding_model_id": {
+						Type:        "model_select",
+						Title:       "Embedding Model",
+						Description: "Optional embedding model. When set, vectors are stored next to the FTS5 index and searches fuse both rankings; when empty, search is BM25 full-text only.",
+						Required:    false,
+					},
+					"hybrid_rrf_k": {
+						Type:        "integer",
+						Title:       "RRF k",
+						Description: "Reciprocal rank fusion constant used when an embedding model is set. Defaults to 60.",
+						Required:    false,
+						Example:     60,
+					},
+					"hybrid_dense_weight": {
+						Type:        "number",
+						Title:       "Vector Weight",
+						Description: "Weight of the vector ranking. Defaults to 1.",
+						Required:    false,
+						Example:     1,
+					},
+					"hybrid_sparse_weight": {
+						Type:        "number",
+						Title:       "Full-Text Weight",
+						Description: "Weight of the BM25 full-text ranking. Defaults to 1.",
+						Required:    false,
+						Example:     1,
+					},
+					"context_target_items": {
+						Type:        "integer",
+						Title:       "Context Target Items",
+						Description: "Target number of memory snippets to inject per chat turn. Defaults to 6.",
+						Required:    false,
+						Example:     6,
+					},
+					"context_max_total_chars": {
+						Type:        "integer",
+						Title:       "Context Max Total Chars",
+						Description: "Maximum total characters for all memory snippets combined. Defaults to 1800.",
+						Required:    false,
+						Example:     1800,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -177,6 +247,10 @@ func (s *Service) Status(ctx context.Context, id string) (ProviderStatusResponse
 	status := ProviderStatusResponse{
 		ProviderType: resp.Provider,
 	}
+	if resp.Provider == string(ProviderSQLite) {
+		status.EmbeddingModelID = StringFromConfig(resp.Config, "embedding_model_id")
+		return status, nil
+	}
 	if resp.Provider != string(ProviderBuiltin) {
 		return status, nil
 	}
@@ -332,7 +406,7 @@ func (s *Service) tryEvictAndReinstantiate(id, providerType string, config map[s
 
 func isValidProviderType(t ProviderType) bool {
 	switch t {
-	case ProviderBuiltin, ProviderMem0, ProviderOpenViking:
+	case ProviderBuiltin, ProviderMem0, ProviderOpenViking, ProviderSQLite:
 		return true
 	default:
 		return false
@@ -20,6 +20,8 @@ type BeforeChatResult struct {
 // AfterChatRequest is passed to OnAfterChat after receiving the gateway response.
 type AfterChatRequest struct {
 	BotID             string
+	ChatID            string
+	SessionID         string
 	Messages          []Message
 	UserID            string
 	ChannelIdentityID string
@@ -217,6 +219,32 @@ type RebuildResult struct {
 	RestoredCount int `json:"restored_count"`
 }
 
+// MemoryRevision is one entry in a memory item's revision history.
+type MemoryRevision struct {
+	MemoryID   string         `json:"memory_id"`
+	Revision   int            `json:"revision"`
+	Action     string         `json:"action"` // baseline, create, update or delete
+	Memory     string         `json:"memory,omitempty"`
+	Hash       string         `json:"hash,omitempty"`
+	Metadata   map[string]any `json:"metadata,omitempty"`
+	CreatedAt  string         `json:"created_at,omitempty"`
+	UpdatedAt  string         `json:"updated_at,omitempty"`
+	RecordedAt string         `json:"recorded_at"`
+	Source     string         `json:"source,omitempty"` // api, chat, formation, compact, rollback or restore
+	Actor      string         `json:"actor,omitempty"`
+	ChatID     string         `json:"chat_id,omitempty"`
+	SessionID  string         `json:"session_id,omitempty"`
+	Decision   string         `json:"decision,omitempty"` // formation decision (ADD, UPDATE, DELETE)
+	Reason     string         `json:"reason,omitempty"`
+}
+
+type RestoreResult struct {
+	AsOf     string `json:"as_of"`
+	Kept     int    `json:"kept"`
+	Restored int    `json:"restored"`
+	Removed  int    `json:"removed"`
+}
+
 type HealthStatus struct {
 	OK    bool   `json:"ok"`
 	Error string `json:"error,omitempty"`
@@ -243,6 +271,7 @@ const (
 	ProviderBuiltin    ProviderType = "builtin"
 	ProviderMem0       ProviderType = "mem0"
 	ProviderOpenViking ProviderType = "openviking"
+	ProviderSQLite     ProviderType = "sqlite"
 )
 
 type ProviderCreateRequest struct {
@@ -0,0 +1,285 @@
+// Package sqliteindex keeps a memory retrieval index inside the application's
+// SQLite database: item rows, an FTS5 table ranked with BM25 and embedding
+// vectors ranked by cosine distance. It is the embedded counterpart of the
+// Qdrant collections used by the builtin memory runtimes.
+package sqliteindex
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	itemsTable = "memory_index_items"
+	ftsTable   = "memory_index_fts"
+)
+
+// Index is a SQLite-backed memory index shared by all bots. Rows are keyed by
+// point ID and scoped by bot ID, mirroring the Qdrant payload layout.
+type Index struct {
+	db *sql.DB
+}
+
+// SearchResult is one result from a text search, vector search or scroll.
+// Dimensions is the length of the stored embedding, or 0 when the point has
+// none.
+type SearchResult struct {
+	ID         string
+	Score      float64
+	Payload    map[string]string
+	Dimensions int
+}
+
+// Point is one indexed memory item. Vector may be empty when no embedding
+// model is configured; such points are only reachable by full-text search.
+type Point struct {
+	ID      string
+	BotID   string
+	Text    string
+	Vector  []float32
+	Payload map[string]string
+}
+
+func New(db *sql.DB) (*Index, error) {
+	if db == nil {
+		return nil, errors.New("sqliteindex: database is required")
+	}
+	return &Index{db: db}, nil
+}
+
+// EnsureSchema creates the index tables if they do not exist. The tables are
+// derived storage and can be dropped at any time; Rebuild repopulates them
+// from the markdown source of truth.
+func (x *Index) EnsureSchema(ctx context.Context) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS ` + itemsTable + ` (
+  point_id TEXT PRIMARY KEY,
+  bot_id TEXT NOT NULL,
+  memory TEXT NOT NULL,
+  payload TEXT NOT NULL DEFAULT '{}',
+  embedding BLOB,
+  dimensions INTEGER NOT NULL DEFAULT 0,
+  updated_at TEXT NOT NULL
+)`,
+		`CREATE INDEX IF NOT EXISTS idx_` + itemsTable + `_bot ON ` + itemsTable + ` (bot_id)`,
+		`CREATE VIRTUAL TABLE IF NOT EXISTS ` + ftsTable + ` USING fts5(
+  body,
+  point_id UNINDEXED,
+  bot_id UNINDEXED,
+  tokenize = 'unicode61 remove_diacritics 2'
+)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := x.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("sqliteindex: ensure schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// Upsert inserts or replaces points together with their full-text rows.
+func (x *Index) Upsert(ctx context.Context, points []Point) error {
+	if len(points) == 0 {
+		return nil
+	}
+	tx, err := x.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sqliteindex: upsert: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, p := range points {
+		id := strings.TrimSpace(p.ID)
+		if id == "" {
+			continue
+		}
+		payload, err := json.Marshal(p.Payload)
+		if err != nil {
+			return fmt.Errorf("sqliteindex: encode payload: %w", err)
+		}
+		var embedding any
+		if len(p.Vector) > 0 {
+			embedding = EncodeVector(p.Vector)
+		}
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO `+itemsTable+` (point_id, bot_id, memory, payload, embedding, dimensions, updated_at)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7)
+ON CONFLICT (point_id) DO UPDATE SET
+  bot_id = excluded.bot_id,
+  memory = excluded.memory,
+  payload = excluded.payload,
+  embedding = excluded.embedding,
+  dimensions = excluded.dimensions,
+  updated_at = excluded.updated_at`,
+			id, p.BotID, p.Text, string(payload), embedding, len(p.Vector), now,
+		); err != nil {
+			return fmt.Errorf("sqliteindex: upsert item: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+ftsTable+` WHERE point_id = ?1`, id); err != nil {
+			return fmt.Errorf("sqliteindex: upsert fts: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO `+ftsTable+` (body, point_id, bot_id) VALUES (?1, ?2, ?3)`,
+			segmentText(p.Text), id, p.BotID,
+		); err != nil {
+			return fmt.Errorf("sqliteindex: upsert fts: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqliteindex: upsert: %w", err)
+	}
+	return nil
+}
+
+// SearchText ranks a bot's items against the query with FTS5 BM25. Scores are
+// positive and higher is better.
+func (x *Index) SearchText(ctx context.Context, botID, query string, limit int) ([]SearchResult, error) {
+	match := matchExpression(query)
+	if match == "" {
+		return []SearchResult{}, nil
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	rows, err := x.db.QueryContext(ctx, `
+SELECT i.point_id, i.payload, -bm25(`+ftsTable+`) AS score, i.dimensions
+FROM `+ftsTable+` f
+JOIN `+itemsTable+` i ON i.point_id = f.point_id
+WHERE `+ftsTable+` MATCH ?1 AND f.bot_id = ?2
+ORDER BY bm25(`+ftsTable+`)
+LIMIT ?3`, match, botID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("sqliteindex: search text: %w", err)
+	}
+	return scanResults(rows)
+}
+
+// SearchVector ranks a bot's embedded items by cosine similarity to vec.
+func (x *Index) SearchVector(ctx context.Context, botID string, vec []float32, limit int) ([]SearchResult, error) {
+	if len(vec) == 0 {
+		return []SearchResult{}, nil
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	rows, err := x.db.QueryContext(ctx, `
+SELECT point_id, payload, 1 - vec_distance_cosine(embedding, ?1) AS score, dimensions
+FROM `+itemsTable+`
+WHERE bot_id = ?2 AND embedding IS NOT NULL AND dimensions = ?3
+ORDER BY vec_distance_cosine(embedding, ?1)
+LIMIT ?4`, EncodeVector(vec), botID, len(vec), limit)
+	if err != nil {
+		return nil, fmt.Errorf("sqliteindex: search vector: %w", err)
+	}
+	return scanResults(rows)
+}
+
+// Scroll returns up to limit of a bot's points without ranking.
+func (x *Index) Scroll(ctx context.Context, botID string, limit int) ([]SearchResult, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := x.db.QueryContext(ctx, `
+SELECT point_id, payload, 0, dimensions
+FROM `+itemsTable+`
+WHERE bot_id = ?1
+ORDER BY point_id
+LIMIT ?2`, botID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("sqliteindex: scroll: %w", err)
+	}
+	return scanResults(rows)
+}
+
+// Count returns the number of points indexed for a bot.
+func (x *Index) Count(ctx context.Context, botID string) (int, error) {
+	var n int
+	if err := x.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM `+itemsTable+` WHERE bot_id = ?1`, botID).Scan(&n); err != nil {
+		return 0, fmt.Errorf("sqliteindex: count: %w", err)
+	}
+	return n, nil
+}
+
+// CountVectors returns the number of a bot's points that carry an embedding.
+func (x *Index) CountVectors(ctx context.Context, botID string) (int, error) {
+	var n int
+	if err := x.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM `+itemsTable+` WHERE bot_id = ?1 AND embedding IS NOT NULL`, botID).Scan(&n); err != nil {
+		return 0, fmt.Errorf("sqliteindex: count vectors: %w", err)
+	}
+	return n, nil
+}
+
+// DeleteByIDs removes points and their full-text rows.
+func (x *Index) DeleteByIDs(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	tx, err := x.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sqliteindex: delete: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+itemsTable+` WHERE point_id = ?1`, id); err != nil {
+			return fmt.Errorf("sqliteindex: delete: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+ftsTable+` WHERE point_id = ?1`, id); err != nil {
+			return fmt.Errorf("sqliteindex: delete: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqliteindex: delete: %w", err)
+	}
+	return nil
+}
+
+// DeleteByBotID removes every point belonging to a bot.
+func (x *Index) DeleteByBotID(ctx context.Context, botID string) error {
+	tx, err := x.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sqliteindex: delete bot: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM `+itemsTable+` WHERE bot_id = ?1`, botID); err != nil {
+		return fmt.Errorf("sqliteindex: delete bot: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM `+ftsTable+` WHERE bot_id = ?1`, botID); err != nil {
+		return fmt.Errorf("sqliteindex: delete bot: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqliteindex: delete bot: %w", err)
+	}
+	return nil
+}
+
+func scanResults(rows *sql.Rows) ([]SearchResult, error) {
+	defer func() { _ = rows.Close() }()
+	results := make([]SearchResult, 0)
+	for rows.Next() {
+		var (
+			id, raw    string
+			score      sql.NullFloat64
+			dimensions int
+		)
+		if err := rows.Scan(&id, &raw, &score, &dimensions); err != nil {
+			return nil, fmt.Errorf("sqliteindex: scan: %w", err)
+		}
+		payload := map[string]string{}
+		if raw != "" {
+			if err := json.Unmarshal([]byte(raw), &payload); err != nil {
+				return nil, fmt.Errorf("sqliteindex: decode payload: %w", err)
+			}
+		}
+		results = append(results, SearchResult{ID: id, Score: score.Float64, Payload: payload, Dimensions: dimensions})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqliteindex: scan: %w", err)
+	}
+	return results, nil
+}
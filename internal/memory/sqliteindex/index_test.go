@@ -0,0 +1,162 @@
+package sqliteindex
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"path/filepath"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+func newTestIndex(t *testing.T) *Index {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "memory.db"))
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	x, err := New(db)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err := x.EnsureSchema(context.Background()); err != nil {
+		t.Fatalf("EnsureSchema() error = %v", err)
+	}
+	// Idempotent.
+	if err := x.EnsureSchema(context.Background()); err != nil {
+		t.Fatalf("EnsureSchema() second call error = %v", err)
+	}
+	return x
+}
+
+func TestIndexTextSearchScopesByBot(t *testing.T) {
+	ctx := context.Background()
+	x := newTestIndex(t)
+	err := x.Upsert(ctx, []Point{
+		{ID: "p1", BotID: "bot-1", Text: "Deploy ticket OPS-4412 to host db-07", Payload: map[string]string{"memory": "Deploy ticket OPS-4412 to host db-07"}},
+		{ID: "p2", BotID: "bot-1", Text: "User prefers oolong tea", Payload: map[string]string{"memory": "User prefers oolong tea"}},
+		{ID: "p3", BotID: "bot-2", Text: "Deploy ticket OPS-4412 elsewhere"},
+		{ID: "p4", BotID: "bot-1", Text: "用户喜欢喝乌龙茶"},
+	})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+
+	results, err := x.SearchText(ctx, "bot-1", "what about OPS-4412?", 10)
+	if err != nil {
+		t.Fatalf("SearchText() error = %v", err)
+	}
+	if len(results) != 1 || results[0].ID != "p1" || results[0].Payload["memory"] == "" {
+		t.Fatalf("unexpected results %#v", results)
+	}
+	if results[0].Score <= 0 {
+		t.Fatalf("expected positive bm25 score, got %v", results[0].Score)
+	}
+
+	results, err = x.SearchText(ctx, "bot-1", "乌龙茶", 10)
+	if err != nil {
+		t.Fatalf("SearchText(cjk) error = %v", err)
+	}
+	if len(results) != 1 || results[0].ID != "p4" {
+		t.Fatalf("unexpected cjk results %#v", results)
+	}
+
+	// Re-upserting replaces the full-text row rather than duplicating it.
+	if err := x.Upsert(ctx, []Point{{ID: "p2", BotID: "bot-1", Text: "User prefers green tea"}}); err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	if results, _ := x.SearchText(ctx, "bot-1", "oolong", 10); len(results) != 0 {
+		t.Fatalf("expected stale text to be gone, got %#v", results)
+	}
+	if n, _ := x.Count(ctx, "bot-1"); n != 3 {
+		t.Fatalf("Count() = %d, want 3", n)
+	}
+
+	if err := x.DeleteByIDs(ctx, []string{"p1"}); err != nil {
+		t.Fatalf("DeleteByIDs() error = %v", err)
+	}
+	if results, _ := x.SearchText(ctx, "bot-1", "OPS-4412", 10); len(results) != 0 {
+		t.Fatalf("expected deleted point to be gone, got %#v", results)
+	}
+	if err := x.DeleteByBotID(ctx, "bot-1"); err != nil {
+		t.Fatalf("DeleteByBotID() error = %v", err)
+	}
+	if n, _ := x.Count(ctx, "bot-1"); n != 0 {
+		t.Fatalf("Count() after bot delete = %d", n)
+	}
+	if n, _ := x.Count(ctx, "bot-2"); n != 1 {
+		t.Fatalf("other bot should be untouched, Count() = %d", n)
+	}
+}
+
+func TestIndexVectorSearch(t *testing.T) {
+	ctx := context.Background()
+	x := newTestIndex(t)
+	err := x.Upsert(ctx, []Point{
+		{ID: "a", BotID: "bot-1", Text: "a", Vector: []float32{1, 0, 0}},
+		{ID: "b", BotID: "bot-1", Text: "b", Vector: []float32{0.7, 0.7, 0}},
+		{ID: "c", BotID: "bot-1", Text: "c", Vector: []float32{0, 0, 1}},
+		{ID: "d", BotID: "bot-1", Text: "no vector"},
+		{ID: "e", BotID: "bot-1", Text: "other dims", Vector: []float32{1, 0}},
+	})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	results, err := x.SearchVector(ctx, "bot-1", []float32{1, 0.1, 0}, 2)
+	if err != nil {
+		t.Fatalf("SearchVector() error = %v", err)
+	}
+	if len(results) != 2 || results[0].ID != "a" || results[1].ID != "b" {
+		t.Fatalf("unexpected results %#v", results)
+	}
+	if results[0].Score <= results[1].Score {
+		t.Fatalf("expected descending similarity, got %#v", results)
+	}
+	if n, _ := x.CountVectors(ctx, "bot-1"); n != 4 {
+		t.Fatalf("CountVectors() = %d, want 4", n)
+	}
+}
+
+func TestVectorRoundTripAndDistance(t *testing.T) {
+	in := []float32{0.5, -1.25, 3}
+	out, err := DecodeVector(EncodeVector(in))
+	if err != nil {
+		t.Fatalf("DecodeVector() error = %v", err)
+	}
+	for i := range in {
+		if in[i] != out[i] {
+			t.Fatalf("round trip = %v, want %v", out, in)
+		}
+	}
+	if _, err := DecodeVector([]byte{1, 2, 3}); err == nil {
+		t.Fatal("expected error for truncated blob")
+	}
+	d, err := cosineDistance([]float32{1, 0}, []float32{0, 1})
+	if err != nil || math.Abs(d-1) > 1e-9 {
+		t.Fatalf("orthogonal distance = %v, %v", d, err)
+	}
+	if _, err := cosineDistance([]float32{1}, []float32{1, 2}); err == nil {
+		t.Fatal("expected dimension mismatch error")
+	}
+}
+
+func TestMatchExpression(t *testing.T) {
+	cases := map[string]string{
+		"":               "",
+		"  ?! ":          "",
+		"OPS-4412 db-07": `"OPS-4412" OR "db-07"`,
+		`say "hi" hi`:    `"say" OR """hi""" OR "hi"`,
+		"喝乌龙茶":           `"喝 乌" OR "乌 龙" OR "龙 茶"`,
+		"tea茶":           `"tea" OR "茶"`,
+	}
+	for in, want := range cases {
+		if got := matchExpression(in); got != want {
+			t.Errorf("matchExpression(%q) = %s, want %s", in, got, want)
+		}
+	}
+	if got := segmentText("我喜欢tea"); got != "我 喜 欢 tea" {
+		t.Fatalf("segmentText() = %q", got)
+	}
+}
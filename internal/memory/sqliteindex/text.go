@@ -0,0 +1,100 @@
+package sqliteindex
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maxQueryTerms caps the number of OR-ed terms in a full-text query so a long
+// pasted message cannot blow up the FTS5 query plan.
+const maxQueryTerms = 32
+
+// isCJK reports whether r belongs to a script written without spaces. The
+// unicode61 tokenizer would otherwise index a whole CJK sentence as one token.
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
+
+// segmentText separates CJK characters with spaces so each one becomes its
+// own FTS5 token. Other text is left for the tokenizer to split.
+func segmentText(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text) * 2)
+	prevCJK := false
+	for _, r := range text {
+		cjk := isCJK(r)
+		if (cjk || prevCJK) && sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteRune(r)
+		prevCJK = cjk
+	}
+	return sb.String()
+}
+
+// matchExpression turns free text into an FTS5 MATCH expression. Each word
+// becomes a quoted phrase so punctuation in user input is never parsed as
+// query syntax; CJK runs become overlapping character bigrams. Terms are
+// OR-ed and ranked by BM25, so partial matches still score.
+func matchExpression(query string) string {
+	terms := make([]string, 0)
+	seen := map[string]struct{}{}
+	add := func(term string) {
+		term = strings.TrimSpace(term)
+		if term == "" || len(terms) >= maxQueryTerms {
+			return
+		}
+		if _, ok := seen[term]; ok {
+			return
+		}
+		seen[term] = struct{}{}
+		terms = append(terms, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+	}
+	for _, field := range strings.Fields(query) {
+		var (
+			word []rune
+			cjk  []rune
+		)
+		flushWord := func() {
+			if hasAlnum(word) {
+				add(string(word))
+			}
+			word = word[:0]
+		}
+		flushCJK := func() {
+			switch {
+			case len(cjk) == 1:
+				add(string(cjk))
+			case len(cjk) > 1:
+				for i := 0; i+1 < len(cjk); i++ {
+					add(string(cjk[i]) + " " + string(cjk[i+1]))
+				}
+			}
+			cjk = cjk[:0]
+		}
+		for _, r := range field {
+			if isCJK(r) {
+				flushWord()
+				cjk = append(cjk, r)
+				continue
+			}
+			flushCJK()
+			word = append(word, r)
+		}
+		flushWord()
+		flushCJK()
+	}
+	return strings.Join(terms, " OR ")
+}
+
+func hasAlnum(rs []rune) bool {
+	for _, r := range rs {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return true
+		}
+	}
+	return false
+}
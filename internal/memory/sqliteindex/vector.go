@@ -0,0 +1,78 @@
+package sqliteindex
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"modernc.org/sqlite"
+)
+
+// The pure-Go driver cannot load the sqlite-vec extension, so the index
+// registers a compatible vec_distance_cosine scalar function instead. Vectors
+// use the sqlite-vec blob layout (little-endian float32), which keeps the
+// stored data and queries portable to a build with the real extension.
+func init() {
+	sqlite.MustRegisterDeterministicScalarFunction("vec_distance_cosine", 2, vecDistanceCosine)
+}
+
+// EncodeVector serialises a vector in the sqlite-vec float32 blob layout.
+func EncodeVector(vec []float32) []byte {
+	buf := make([]byte, 4*len(vec))
+	for i, v := range vec {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(v))
+	}
+	return buf
+}
+
+// DecodeVector parses a sqlite-vec float32 blob.
+func DecodeVector(buf []byte) ([]float32, error) {
+	if len(buf)%4 != 0 {
+		return nil, fmt.Errorf("sqliteindex: vector blob length %d is not a multiple of 4", len(buf))
+	}
+	vec := make([]float32, len(buf)/4)
+	for i := range vec {
+		vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[i*4:]))
+	}
+	return vec, nil
+}
+
+func vecDistanceCosine(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	a, aok := args[0].([]byte)
+	b, bok := args[1].([]byte)
+	if !aok || !bok {
+		return nil, nil
+	}
+	va, err := DecodeVector(a)
+	if err != nil {
+		return nil, err
+	}
+	vb, err := DecodeVector(b)
+	if err != nil {
+		return nil, err
+	}
+	return cosineDistance(va, vb)
+}
+
+// cosineDistance returns 1 - cosine similarity, matching sqlite-vec.
+func cosineDistance(a, b []float32) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("sqliteindex: vector dimensions differ: %d vs %d", len(a), len(b))
+	}
+	if len(a) == 0 {
+		return 0, errors.New("sqliteindex: empty vector")
+	}
+	var dot, na, nb float64
+	for i := range a {
+		x, y := float64(a[i]), float64(b[i])
+		dot += x * y
+		na += x * x
+		nb += y * y
+	}
+	if na == 0 || nb == 0 {
+		return 1, nil
+	}
+	return 1 - dot/(math.Sqrt(na)*math.Sqrt(nb)), nil
+}
@@ -0,0 +1,450 @@
+package storefs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/memohai/memoh/internal/config"
+)
+
+// Revision actions recorded in a memory item's history.
+const (
+	RevisionBaseline = "baseline"
+	RevisionCreate   = "create"
+	RevisionUpdate   = "update"
+	RevisionDelete   = "delete"
+)
+
+// Change sources attached to revisions.
+const (
+	ChangeSourceAPI       = "api"
+	ChangeSourceChat      = "chat"
+	ChangeSourceFormation = "formation"
+	ChangeSourceCompact   = "compact"
+	ChangeSourceRollback  = "rollback"
+	ChangeSourceRestore   = "restore"
+)
+
+var (
+	ErrRevisionNotFound      = errors.New("memory revision not found")
+	ErrRevisionNotRestorable = errors.New("memory revision records a deletion and cannot be restored")
+)
+
+// Change describes who or what caused a memory write. Callers attach it to
+// the context with WithChange; every revision recorded while handling that
+// context carries it.
+type Change struct {
+	Source    string
+	Actor     string
+	ChatID    string
+	SessionID string
+	Decision  string
+	Reason    string
+}
+
+type changeContextKey struct{}
+
+// WithChange returns a context that attributes memory writes to change.
+func WithChange(ctx context.Context, change Change) context.Context {
+	return context.WithValue(ctx, changeContextKey{}, change)
+}
+
+// ChangeFromContext returns the change attached by WithChange, if any.
+func ChangeFromContext(ctx context.Context) Change {
+	change, _ := ctx.Value(changeContextKey{}).(Change)
+	return change
+}
+
+// MemoryRevision is one append-only entry in a memory item's history. It
+// snapshots the item as it was after the change (or, for deletions, as it was
+// just before it was removed).
+type MemoryRevision struct {
+	MemoryID   string         `json:"memory_id"`
+	Revision   int            `json:"revision"`
+	Action     string         `json:"action"`
+	Memory     string         `json:"memory,omitempty"`
+	Hash       string         `json:"hash,omitempty"`
+	Metadata   map[string]any `json:"metadata,omitempty"`
+	CreatedAt  string         `json:"created_at,omitempty"`
+	UpdatedAt  string         `json:"updated_at,omitempty"`
+	RecordedAt string         `json:"recorded_at"`
+	Source     string         `json:"source,omitempty"`
+	Actor      string         `json:"actor,omitempty"`
+	ChatID     string         `json:"chat_id,omitempty"`
+	SessionID  string         `json:"session_id,omitempty"`
+	Decision   string         `json:"decision,omitempty"`
+	Reason     string         `json:"reason,omitempty"`
+}
+
+// RestoreResult summarises a restore-as-of operation.
+type RestoreResult struct {
+	AsOf     string `json:"as_of"`
+	Kept     int    `json:"kept"`
+	Restored int    `json:"restored"`
+	Removed  int    `json:"removed"`
+}
+
+// itemChange pairs an item's state before and after a write. A nil before
+// means the item was created; a nil after means it was removed.
+type itemChange struct {
+	before *MemoryItem
+	after  *MemoryItem
+}
+
+// MemoryHistory returns every revision of a memory item, oldest first.
+func (s *Service) MemoryHistory(ctx context.Context, botID, memoryID string) ([]MemoryRevision, error) {
+	if s.provider == nil {
+		return nil, ErrNotConfigured
+	}
+	memoryID = strings.TrimSpace(memoryID)
+	if memoryID == "" {
+		return nil, errors.New("memory id is required")
+	}
+	return s.readHistory(ctx, botID, memoryID)
+}
+
+// RollbackMemory rewrites a memory item with the content it had at the given
+// revision. The rollback itself is recorded as a new revision, so it can be
+// undone the same way.
+func (s *Service) RollbackMemory(ctx context.Context, botID, memoryID string, revision int) (MemoryItem, error) {
+	history, err := s.MemoryHistory(ctx, botID, memoryID)
+	if err != nil {
+		return MemoryItem{}, err
+	}
+	var target *MemoryRevision
+	for i := range history {
+		if history[i].Revision == revision {
+			target = &history[i]
+			break
+		}
+	}
+	if target == nil {
+		return MemoryItem{}, ErrRevisionNotFound
+	}
+	if target.Action == RevisionDelete {
+		return MemoryItem{}, ErrRevisionNotRestorable
+	}
+	item := target.item()
+	item.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	change := ChangeFromContext(ctx)
+	change.Source = ChangeSourceRollback
+	if change.Reason == "" {
+		change.Reason = fmt.Sprintf("rollback to revision %d", revision)
+	}
+	if err := s.PersistMemories(WithChange(ctx, change), botID, []MemoryItem{item}, nil); err != nil {
+		return MemoryItem{}, err
+	}
+	return item, nil
+}
+
+// RestoreMemoriesAsOf rewrites a bot's memory files to the state they had at
+// the given time. Items that were never changed since history was recorded
+// are kept when they were created before that time.
+func (s *Service) RestoreMemoriesAsOf(ctx context.Context, botID string, at time.Time) (RestoreResult, error) {
+	if s.provider == nil {
+		return RestoreResult{}, ErrNotConfigured
+	}
+	current, err := s.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	histories, err := s.readAllHistories(ctx, botID)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	target := stateAsOf(histories, current, at)
+
+	result := RestoreResult{AsOf: at.UTC().Format(time.RFC3339)}
+	currentByID := toItemMap(current)
+	for _, item := range target {
+		if existing, ok := currentByID[item.ID]; ok && sameContent(existing, item) {
+			result.Kept++
+		} else {
+			result.Restored++
+		}
+		delete(currentByID, item.ID)
+	}
+	result.Removed = len(currentByID)
+
+	change := ChangeFromContext(ctx)
+	change.Source = ChangeSourceRestore
+	if change.Reason == "" {
+		change.Reason = "restore as of " + result.AsOf
+	}
+	if err := s.RebuildFiles(WithChange(ctx, change), botID, target, nil); err != nil {
+		return RestoreResult{}, err
+	}
+	return result, nil
+}
+
+// recordRevisions appends a revision for every effective change. Failures are
+// logged rather than returned: the memory write itself already succeeded.
+func (s *Service) recordRevisions(ctx context.Context, botID string, changes []itemChange) {
+	if len(changes) == 0 {
+		return
+	}
+	change := ChangeFromContext(ctx)
+	now := time.Now().UTC()
+	for _, c := range changes {
+		var id string
+		switch {
+		case c.after != nil:
+			id = strings.TrimSpace(c.after.ID)
+		case c.before != nil:
+			id = strings.TrimSpace(c.before.ID)
+		}
+		if id == "" {
+			continue
+		}
+		history, err := s.readHistory(ctx, botID, id)
+		if err != nil {
+			s.logger.Warn("record memory revision: read history failed",
+				slog.String("bot_id", botID), slog.String("memory_id", id), slog.Any("error", err))
+			continue
+		}
+		next := planRevisions(history, c, change, now)
+		if len(next) == 0 {
+			continue
+		}
+		if err := s.writeHistory(ctx, botID, id, append(history, next...)); err != nil {
+			s.logger.Warn("record memory revision: write history failed",
+				slog.String("bot_id", botID), slog.String("memory_id", id), slog.Any("error", err))
+		}
+	}
+}
+
+// planRevisions returns the revisions to append to history for one change.
+// The first change to an item that predates history also records a baseline
+// revision, backdated to the item's own timestamp, so that as-of restores can
+// still see the original content.
+func planRevisions(history []MemoryRevision, c itemChange, change Change, now time.Time) []MemoryRevision {
+	if c.before == nil && c.after == nil {
+		return nil
+	}
+	if c.before != nil && c.after != nil && sameContent(*c.before, *c.after) {
+		return nil
+	}
+	nextRevision := 1
+	if len(history) > 0 {
+		nextRevision = history[len(history)-1].Revision + 1
+	}
+	out := make([]MemoryRevision, 0, 2)
+	if c.before != nil && len(history) == 0 {
+		baseline := revisionFromItem(*c.before, RevisionBaseline, nextRevision)
+		baseline.RecordedAt = firstNonEmpty(c.before.UpdatedAt, c.before.CreatedAt, memoryTime(*c.before).Format(time.RFC3339))
+		out = append(out, baseline)
+		nextRevision++
+	}
+	var rev MemoryRevision
+	switch {
+	case c.after == nil:
+		rev = revisionFromItem(*c.before, RevisionDelete, nextRevision)
+	case c.before == nil:
+		rev = revisionFromItem(*c.after, RevisionCreate, nextRevision)
+	default:
+		rev = revisionFromItem(*c.after, RevisionUpdate, nextRevision)
+	}
+	rev.RecordedAt = now.Format(time.RFC3339Nano)
+	rev.Source = change.Source
+	rev.Actor = change.Actor
+	rev.ChatID = change.ChatID
+	rev.SessionID = change.SessionID
+	rev.Decision = change.Decision
+	rev.Reason = change.Reason
+	return append(out, rev)
+}
+
+// stateAsOf computes the set of memory items as they were at the given time.
+// For an item with history the latest revision recorded at or before that
+// time wins; items without history are kept if they were created by then.
+func stateAsOf(histories map[string][]MemoryRevision, current []MemoryItem, at time.Time) []MemoryItem {
+	out := make([]MemoryItem, 0, len(current))
+	for id, history := range histories {
+		var latest *MemoryRevision
+		for i := range history {
+			recordedAt, ok := parseRevisionTime(history[i].RecordedAt)
+			if !ok || recordedAt.After(at) {
+				continue
+			}
+			if latest == nil || history[i].Revision > latest.Revision {
+				latest = &history[i]
+			}
+		}
+		if latest == nil || latest.Action == RevisionDelete {
+			continue
+		}
+		item := latest.item()
+		item.ID = id
+		out = append(out, item)
+	}
+	for _, item := range current {
+		if _, tracked := histories[item.ID]; tracked {
+			continue
+		}
+		if created := memoryTime(item); !created.IsZero() && created.After(at) {
+			continue
+		}
+		out = append(out, item)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+func (s *Service) readHistory(ctx context.Context, botID, memoryID string) ([]MemoryRevision, error) {
+	content, err := s.readFile(ctx, botID, memoryHistoryPath(memoryID))
+	if err != nil {
+		if isNotFound(err) {
+			return []MemoryRevision{}, nil
+		}
+		return nil, err
+	}
+	return parseHistoryJSONL(content), nil
+}
+
+func (s *Service) readAllHistories(ctx context.Context, botID string) (map[string][]MemoryRevision, error) {
+	c, err := s.client(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.ListDirAll(ctx, memoryHistoryDirPath(), false)
+	if err != nil {
+		if isNotFound(err) {
+			return map[string][]MemoryRevision{}, nil
+		}
+		return nil, err
+	}
+	out := make(map[string][]MemoryRevision, len(entries))
+	for _, entry := range entries {
+		if entry.GetIsDir() || !strings.HasSuffix(entry.GetPath(), ".jsonl") {
+			continue
+		}
+		entryPath := path.Join(memoryHistoryDirPath(), entry.GetPath())
+		content, readErr := s.readFile(ctx, botID, entryPath)
+		if readErr != nil {
+			s.logger.Warn("readAllHistories: failed to read history file",
+				slog.String("bot_id", botID), slog.String("path", entryPath), slog.Any("error", readErr))
+			continue
+		}
+		history := parseHistoryJSONL(content)
+		if len(history) == 0 {
+			continue
+		}
+		out[history[0].MemoryID] = history
+	}
+	return out, nil
+}
+
+func (s *Service) writeHistory(ctx context.Context, botID, memoryID string, history []MemoryRevision) error {
+	var b strings.Builder
+	for _, rev := range history {
+		raw, err := json.Marshal(rev)
+		if err != nil {
+			return err
+		}
+		b.Write(raw)
+		b.WriteString("\n")
+	}
+	return s.writeFile(ctx, botID, memoryHistoryPath(memoryID), b.String())
+}
+
+// readItems loads the current content of the given IDs using a scan index.
+func (s *Service) readItems(ctx context.Context, botID string, index map[string]scanEntry, ids []string) (map[string]MemoryItem, error) {
+	byPath := map[string][]string{}
+	for _, id := range ids {
+		if entry, ok := index[id]; ok {
+			byPath[entry.FilePath] = append(byPath[entry.FilePath], id)
+		}
+	}
+	out := make(map[string]MemoryItem, len(ids))
+	for filePath, wanted := range byPath {
+		items, err := s.readMemoryDay(ctx, botID, filePath)
+		if err != nil {
+			return nil, err
+		}
+		byID := toItemMap(items)
+		for _, id := range wanted {
+			if item, ok := byID[id]; ok {
+				out[id] = item
+			}
+		}
+	}
+	return out, nil
+}
+
+func parseHistoryJSONL(content string) []MemoryRevision {
+	lines := strings.Split(content, "\n")
+	out := make([]MemoryRevision, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var rev MemoryRevision
+		if err := json.Unmarshal([]byte(line), &rev); err != nil {
+			continue
+		}
+		out = append(out, rev)
+	}
+	return out
+}
+
+func revisionFromItem(item MemoryItem, action string, revision int) MemoryRevision {
+	return MemoryRevision{
+		MemoryID:  strings.TrimSpace(item.ID),
+		Revision:  revision,
+		Action:    action,
+		Memory:    strings.TrimSpace(item.Memory),
+		Hash:      item.Hash,
+		Metadata:  item.Metadata,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+func (r MemoryRevision) item() MemoryItem {
+	return MemoryItem{
+		ID:        r.MemoryID,
+		Memory:    r.Memory,
+		Hash:      r.Hash,
+		Metadata:  r.Metadata,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func sameContent(a, b MemoryItem) bool {
+	if strings.TrimSpace(a.Memory) != strings.TrimSpace(b.Memory) {
+		return false
+	}
+	if len(a.Metadata) == 0 && len(b.Metadata) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a.Metadata, b.Metadata)
+}
+
+func parseRevisionTime(raw string) (time.Time, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, raw)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t.UTC(), true
+}
+
+func memoryHistoryDirPath() string { return path.Join(config.DefaultDataMount, "memory-history") }
+func memoryHistoryPath(memoryID string) string {
+	return path.Join(memoryHistoryDirPath(), url.PathEscape(strings.TrimSpace(memoryID))+".jsonl")
+}
@@ -0,0 +1,114 @@
+package storefs
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPlanRevisions(t *testing.T) {
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	change := Change{Source: ChangeSourceFormation, ChatID: "chat-1", Decision: "UPDATE"}
+
+	created := MemoryItem{ID: "mem_1", Memory: "likes tea", CreatedAt: "2026-03-01T09:00:00Z"}
+	revs := planRevisions(nil, itemChange{after: &created}, change, now)
+	if len(revs) != 1 || revs[0].Action != RevisionCreate || revs[0].Revision != 1 {
+		t.Fatalf("create = %#v", revs)
+	}
+	if revs[0].ChatID != "chat-1" || revs[0].Decision != "UPDATE" || revs[0].Source != ChangeSourceFormation {
+		t.Fatalf("expected change attribution, got %#v", revs[0])
+	}
+
+	updated := created
+	updated.Memory = "likes oolong tea"
+	revs = planRevisions([]MemoryRevision{{MemoryID: "mem_1", Revision: 1}}, itemChange{before: &created, after: &updated}, change, now)
+	if len(revs) != 1 || revs[0].Action != RevisionUpdate || revs[0].Revision != 2 || revs[0].Memory != "likes oolong tea" {
+		t.Fatalf("update = %#v", revs)
+	}
+
+	if revs := planRevisions(nil, itemChange{before: &created, after: &created}, change, now); len(revs) != 0 {
+		t.Fatalf("unchanged write should not record a revision, got %#v", revs)
+	}
+
+	// The first change to an item that predates history records a baseline.
+	revs = planRevisions(nil, itemChange{before: &created}, Change{}, now)
+	if len(revs) != 2 {
+		t.Fatalf("delete without history = %#v", revs)
+	}
+	if revs[0].Action != RevisionBaseline || revs[0].RecordedAt != "2026-03-01T09:00:00Z" || revs[0].Revision != 1 {
+		t.Fatalf("baseline = %#v", revs[0])
+	}
+	if revs[1].Action != RevisionDelete || revs[1].Memory != "likes tea" || revs[1].Revision != 2 {
+		t.Fatalf("delete = %#v", revs[1])
+	}
+}
+
+func TestStateAsOf(t *testing.T) {
+	histories := map[string][]MemoryRevision{
+		"mem_1": {
+			{MemoryID: "mem_1", Revision: 1, Action: RevisionCreate, Memory: "v1", RecordedAt: "2026-03-01T09:00:00Z"},
+			{MemoryID: "mem_1", Revision: 2, Action: RevisionUpdate, Memory: "v2", RecordedAt: "2026-03-03T09:00:00Z"},
+		},
+		"mem_2": {
+			{MemoryID: "mem_2", Revision: 1, Action: RevisionCreate, Memory: "gone", RecordedAt: "2026-03-01T09:00:00Z"},
+			{MemoryID: "mem_2", Revision: 2, Action: RevisionDelete, Memory: "gone", RecordedAt: "2026-03-02T09:00:00Z"},
+		},
+		"mem_3": {
+			{MemoryID: "mem_3", Revision: 1, Action: RevisionCreate, Memory: "later", RecordedAt: "2026-03-04T09:00:00Z"},
+		},
+	}
+	current := []MemoryItem{
+		{ID: "mem_1", Memory: "v2"},
+		{ID: "mem_3", Memory: "later"},
+		{ID: "legacy_old", Memory: "old", CreatedAt: "2026-02-01T00:00:00Z"},
+		{ID: "legacy_new", Memory: "new", CreatedAt: "2026-03-05T00:00:00Z"},
+	}
+
+	got := stateAsOf(histories, current, time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC))
+	ids := make([]string, 0, len(got))
+	for _, item := range got {
+		ids = append(ids, item.ID+"="+item.Memory)
+	}
+	if want := "legacy_old=old,mem_1=v1"; strings.Join(ids, ",") != want {
+		t.Fatalf("state = %s, want %s", strings.Join(ids, ","), want)
+	}
+
+	got = stateAsOf(histories, current, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	ids = ids[:0]
+	for _, item := range got {
+		ids = append(ids, item.ID+"="+item.Memory)
+	}
+	if want := "legacy_old=old,mem_1=v1,mem_2=gone"; strings.Join(ids, ",") != want {
+		t.Fatalf("state = %s, want %s", strings.Join(ids, ","), want)
+	}
+}
+
+func TestParseHistoryJSONLSkipsBadLines(t *testing.T) {
+	content := `{"memory_id":"mem_1","revision":1,"action":"create","memory":"a","recorded_at":"2026-03-01T09:00:00Z"}
+not json
+
+{"memory_id":"mem_1","revision":2,"action":"update","memory":"b","recorded_at":"2026-03-02T09:00:00Z"}
+`
+	revs := parseHistoryJSONL(content)
+	if len(revs) != 2 || revs[1].Memory != "b" {
+		t.Fatalf("parsed = %#v", revs)
+	}
+}
+
+func TestMemoryHistoryPathEscapesID(t *testing.T) {
+	got := memoryHistoryPath("bot-1:mem/1")
+	if !strings.HasSuffix(got, "/memory-history/bot-1:mem%2F1.jsonl") {
+		t.Fatalf("path = %s", got)
+	}
+}
+
+func TestChangeFromContext(t *testing.T) {
+	if got := ChangeFromContext(context.Background()); got != (Change{}) {
+		t.Fatalf("empty context = %#v", got)
+	}
+	ctx := WithChange(context.Background(), Change{Source: ChangeSourceAPI, Actor: "user-1"})
+	if got := ChangeFromContext(ctx); got.Source != ChangeSourceAPI || got.Actor != "user-1" {
+		t.Fatalf("change = %#v", got)
+	}
+}
@@ -171,12 +171,18 @@ func (s *Service) PersistMemories(ctx context.Context, botID string, items []Mem
 	now := time.Now().UTC()
 	touched := make(map[string]map[string]MemoryItem)
 	toRemoveFromOld := make(map[string]map[string]struct{})
+	persisted := make([]MemoryItem, 0, len(items))
+	existingIDs := make([]string, 0, len(items))
 	for _, item := range items {
 		item.ID = strings.TrimSpace(item.ID)
 		item.Memory = strings.TrimSpace(item.Memory)
 		if item.ID == "" || item.Memory == "" {
 			continue
 		}
+		persisted = append(persisted, item)
+		if _, ok := index[item.ID]; ok {
+			existingIDs = append(existingIDs, item.ID)
+		}
 		date := memoryDateForItem(item, now)
 		filePath := memoryDayPath(date)
 		if current, ok := index[item.ID]; ok && current.FilePath != filePath {
@@ -190,6 +196,10 @@ func (s *Service) PersistMemories(ctx context.Context, botID string, items []Mem
 		}
 		touched[filePath][item.ID] = item
 	}
+	previous, err := s.readItems(ctx, botID, index, existingIDs)
+	if err != nil {
+		return err
+	}
 
 	for filePath, incoming := range touched {
 		existing, readErr := s.readMemoryDay(ctx, botID, filePath)
@@ -202,9 +212,18 @@ func (s *Service) PersistMemories(ctx context.Context, botID string, items []Mem
 			return err
 		}
 	}
-	if err := s.removeIDsFromFiles(ctx, botID, toRemoveFromOld); err != nil {
+	if _, err := s.removeIDsFromFiles(ctx, botID, toRemoveFromOld); err != nil {
 		return err
 	}
+	changes := make([]itemChange, 0, len(persisted))
+	for i := range persisted {
+		change := itemChange{after: &persisted[i]}
+		if before, ok := previous[persisted[i].ID]; ok {
+			change.before = &before
+		}
+		changes = append(changes, change)
+	}
+	s.recordRevisions(ctx, botID, changes)
 	return s.SyncOverview(ctx, botID)
 }
 
@@ -212,11 +231,17 @@ func (s *Service) RebuildFiles(ctx context.Context, botID string, items []Memory
 	if s.provider == nil {
 		return ErrNotConfigured
 	}
+	previous, err := s.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return err
+	}
 	if err := s.deleteFile(ctx, botID, memoryDirPath(), true); err != nil && !isNotFound(err) {
 		return err
 	}
 	grouped := make(map[string][]MemoryItem)
 	now := time.Now().UTC()
+	remaining := toItemMap(previous)
+	changes := make([]itemChange, 0, len(items)+len(previous))
 	for _, item := range items {
 		item.ID = strings.TrimSpace(item.ID)
 		item.Memory = strings.TrimSpace(item.Memory)
@@ -226,12 +251,23 @@ func (s *Service) RebuildFiles(ctx context.Context, botID string, items []Memory
 		date := memoryDateForItem(item, now)
 		filePath := memoryDayPath(date)
 		grouped[filePath] = append(grouped[filePath], item)
+
+		change := itemChange{after: &item}
+		if before, ok := remaining[item.ID]; ok {
+			change.before = &before
+			delete(remaining, item.ID)
+		}
+		changes = append(changes, change)
 	}
 	for filePath, dayItems := range grouped {
 		if err := s.writeMemoryDay(ctx, botID, filePath, dayItems); err != nil {
 			return err
 		}
 	}
+	for _, item := range remaining {
+		changes = append(changes, itemChange{before: &item})
+	}
+	s.recordRevisions(ctx, botID, changes)
 	return s.SyncOverview(ctx, botID)
 }
 
@@ -263,9 +299,11 @@ func (s *Service) RemoveMemories(ctx context.Context, botID string, ids []string
 			removals[target][id] = struct{}{}
 		}
 	}
-	if err := s.removeIDsFromFiles(ctx, botID, removals); err != nil {
+	removed, err := s.removeIDsFromFiles(ctx, botID, removals)
+	if err != nil {
 		return err
 	}
+	s.recordRevisions(ctx, botID, removalChanges(removed))
 	return s.SyncOverview(ctx, botID)
 }
 
@@ -273,9 +311,14 @@ func (s *Service) RemoveAllMemories(ctx context.Context, botID string) error {
 	if s.provider == nil {
 		return ErrNotConfigured
 	}
+	previous, err := s.ReadAllMemoryFiles(ctx, botID)
+	if err != nil {
+		return err
+	}
 	if err := s.deleteFile(ctx, botID, memoryDirPath(), true); err != nil && !isNotFound(err) {
 		return err
 	}
+	s.recordRevisions(ctx, botID, removalChanges(previous))
 	return s.SyncOverview(ctx, botID)
 }
 
@@ -396,14 +439,17 @@ func (s *Service) writeMemoryDay(ctx context.Context, botID, filePath string, it
 	return s.writeFile(ctx, botID, filePath, formatMemoryDayMD(date, items))
 }
 
-func (s *Service) removeIDsFromFiles(ctx context.Context, botID string, removals map[string]map[string]struct{}) error {
+// removeIDsFromFiles drops the given IDs from their day files and returns the
+// items that were removed.
+func (s *Service) removeIDsFromFiles(ctx context.Context, botID string, removals map[string]map[string]struct{}) ([]MemoryItem, error) {
+	var removed []MemoryItem
 	for filePath, ids := range removals {
 		if len(ids) == 0 {
 			continue
 		}
 		items, err := s.readMemoryDay(ctx, botID, filePath)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if len(items) == 0 {
 			continue
@@ -411,21 +457,30 @@ func (s *Service) removeIDsFromFiles(ctx context.Context, botID string, removals
 		filtered := make([]MemoryItem, 0, len(items))
 		for _, item := range items {
 			if _, remove := ids[item.ID]; remove {
+				removed = append(removed, item)
 				continue
 			}
 			filtered = append(filtered, item)
 		}
 		if len(filtered) == 0 {
 			if err := s.deleteFile(ctx, botID, filePath, false); err != nil && !isNotFound(err) {
-				return err
+				return nil, err
 			}
 			continue
 		}
 		if err := s.writeMemoryDay(ctx, botID, filePath, filtered); err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return removed, nil
+}
+
+func removalChanges(items []MemoryItem) []itemChange {
+	changes := make([]itemChange, 0, len(items))
+	for i := range items {
+		changes = append(changes, itemChange{before: &items[i]})
+	}
+	return changes
 }
 
 // --- path helpers ---
@@ -9,12 +9,15 @@ import (
 	googleembedding "github.com/memohai/twilight-ai/provider/google/embedding"
 	openaiembedding "github.com/memohai/twilight-ai/provider/openai/embedding"
 	sdk "github.com/memohai/twilight-ai/sdk"
+
+	"github.com/memohai/memoh/internal/ollama"
 )
 
 // NewSDKEmbeddingModel creates a Twilight AI SDK EmbeddingModel for the given
 // provider configuration. It dispatches to the native Google embedding provider
-// when clientType is "google-generative-ai", and falls back to the
-// OpenAI-compatible /embeddings endpoint for all other provider types.
+// when clientType is "google-generative-ai", to Ollama's /api/embed when it is
+// "ollama", and falls back to the OpenAI-compatible /embeddings endpoint for
+// all other provider types.
 func NewSDKEmbeddingModel(clientType, baseURL, apiKey, modelID string, timeout time.Duration, httpClient *http.Client) *sdk.EmbeddingModel {
 	if timeout <= 0 {
 		timeout = DefaultProviderRequestTimeout
@@ -34,6 +37,8 @@ func NewSDKEmbeddingModel(clientType, baseURL, apiKey, modelID string, timeout t
 		}
 		p := googleembedding.New(opts...)
 		return p.EmbeddingModel(modelID)
+	case ClientTypeOllama:
+		return ollama.NewProvider(baseURL, apiKey, httpClient).EmbeddingModel(modelID)
 	default:
 		opts := []openaiembedding.Option{
 			openaiembedding.WithAPIKey(apiKey),
@@ -431,6 +431,7 @@ func IsValidClientType(clientType ClientType) bool {
 		ClientTypeGoogleGenerativeAI,
 		ClientTypeOpenAICodex,
 		ClientTypeGitHubCopilot,
+		ClientTypeOllama,
 		ClientTypeEdgeSpeech,
 		ClientTypeOpenAISpeech,
 		ClientTypeOpenAITranscription,
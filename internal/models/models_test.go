@@ -105,6 +105,30 @@ func TestModel_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid chat model with pricing",
+			model: models.Model{
+				ModelID:    "gpt-4o",
+				ProviderID: "11111111-1111-1111-1111-111111111111",
+				Type:       models.ModelTypeChat,
+				Config: models.ModelConfig{
+					Pricing: &models.ModelPricing{InputPerMillion: 2.5, OutputPerMillion: 10},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative pricing",
+			model: models.Model{
+				ModelID:    "gpt-4o",
+				ProviderID: "11111111-1111-1111-1111-111111111111",
+				Type:       models.ModelTypeChat,
+				Config: models.ModelConfig{
+					Pricing: &models.ModelPricing{InputPerMillion: -1},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -142,5 +166,7 @@ func TestModelTypes(t *testing.T) {
 		assert.Equal(t, models.ClientTypeOpenAICompletions, models.ClientType("openai-completions"))
 		assert.Equal(t, models.ClientTypeAnthropicMessages, models.ClientType("anthropic-messages"))
 		assert.Equal(t, models.ClientTypeGoogleGenerativeAI, models.ClientType("google-generative-ai"))
+		assert.Equal(t, models.ClientTypeOllama, models.ClientType("ollama"))
+		assert.True(t, models.IsLLMClientType(models.ClientTypeOllama))
 	})
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/memohai/memoh/internal/db"
 	"github.com/memohai/memoh/internal/db/postgres/sqlc"
 	"github.com/memohai/memoh/internal/oauthctx"
+	"github.com/memohai/memoh/internal/ollama"
 )
 
 const probeTimeout = DefaultProviderProbeTimeout
@@ -164,6 +165,9 @@ func NewSDKProvider(baseURL, apiKey, codexAccountID string, clientType ClientTyp
 	case ClientTypeGitHubCopilot:
 		return memohcopilot.NewProvider(apiKey, httpClient)
 
+	case ClientTypeOllama:
+		return ollama.NewProvider(baseURL, apiKey, httpClient)
+
 	case ClientTypeAnthropicMessages:
 		opts := []anthropicmessages.Option{
 			anthropicmessages.WithAPIKey(apiKey),
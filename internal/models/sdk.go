@@ -12,6 +12,7 @@ import (
 	sdk "github.com/memohai/twilight-ai/sdk"
 
 	memohcopilot "github.com/memohai/memoh/internal/copilot"
+	"github.com/memohai/memoh/internal/ollama"
 )
 
 // SDKModelConfig holds provider and model information resolved from DB,
@@ -90,6 +91,9 @@ func NewSDKChatModel(cfg SDKModelConfig) *sdk.Model {
 	case ClientTypeGitHubCopilot:
 		return memohcopilot.NewModel(cfg.APIKey, cfg.ModelID, cfg.HTTPClient)
 
+	case ClientTypeOllama:
+		return ollama.NewProvider(cfg.BaseURL, cfg.APIKey, cfg.HTTPClient).ChatModel(cfg.ModelID)
+
 	case ClientTypeAnthropicMessages:
 		opts := []anthropicmessages.Option{
 			anthropicmessages.WithAPIKey(cfg.APIKey),
@@ -149,9 +153,11 @@ func BuildReasoningOptions(cfg SDKModelConfig) []sdk.GenerateOption {
 	// maps reasoning_effort "none" to thinking-off and any other effort to
 	// thinking-on, so we forward "none" to disable and an explicit effort to
 	// enable. Enabled-without-effort (adaptive) forwards nothing and lets the
-	// provider's default thinking behavior apply.
-	if ClientType(cfg.ClientType) == ClientTypeOpenAICompletions &&
-		(isDeepSeekChatCompletionsCompat(cfg.ChatCompletionsCompat) || isMiniMaxChatCompletionsCompat(cfg.ChatCompletionsCompat)) {
+	// provider's default thinking behavior apply. Ollama's native "think" flag
+	// is the same kind of toggle.
+	if (ClientType(cfg.ClientType) == ClientTypeOpenAICompletions &&
+		(isDeepSeekChatCompletionsCompat(cfg.ChatCompletionsCompat) || isMiniMaxChatCompletionsCompat(cfg.ChatCompletionsCompat))) ||
+		ClientType(cfg.ClientType) == ClientTypeOllama {
 		switch {
 		case cfg.ReasoningConfig.Disabled:
 			return []sdk.GenerateOption{sdk.WithReasoningEffort(ReasoningEffortNone)}
@@ -216,6 +222,8 @@ func ResolveClientType(model *sdk.Model) string {
 		return string(ClientTypeGoogleGenerativeAI)
 	case strings.Contains(name, "github-copilot"), strings.Contains(name, "copilot"):
 		return string(ClientTypeGitHubCopilot)
+	case name == "ollama":
+		return string(ClientTypeOllama)
 	case strings.Contains(name, "codex"):
 		return string(ClientTypeOpenAICodex)
 	case strings.Contains(name, "responses"):
@@ -224,3 +232,18 @@ func ResolveClientType(model *sdk.Model) string {
 		return string(ClientTypeOpenAICompletions)
 	}
 }
+
+// SupportsStructuredOutput reports whether the client type can constrain a
+// reply to a JSON Schema natively. Other client types fall back to prompt
+// instructions plus validation. Chat Completions compatibility modes are
+// excluded because those vendors only implement plain JSON mode.
+func SupportsStructuredOutput(clientType, chatCompletionsCompat string) bool {
+	switch ClientType(clientType) {
+	case ClientTypeOpenAIResponses, ClientTypeGoogleGenerativeAI, ClientTypeOllama:
+		return true
+	case ClientTypeOpenAICompletions:
+		return normalizeChatCompletionsCompat(chatCompletionsCompat) == ""
+	default:
+		return false
+	}
+}
@@ -315,3 +315,27 @@ func TestResolveChatCompletionsCompatInfersMiniMaxBaseURL(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportsStructuredOutput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		clientType ClientType
+		compat     string
+		want       bool
+	}{
+		{ClientTypeOpenAIResponses, "", true},
+		{ClientTypeOpenAICompletions, "", true},
+		{ClientTypeOpenAICompletions, ChatCompletionsCompatDeepSeek, false},
+		{ClientTypeGoogleGenerativeAI, "", true},
+		{ClientTypeOllama, "", true},
+		{ClientTypeAnthropicMessages, "", false},
+		{ClientTypeOpenAICodex, "", false},
+		{ClientTypeGitHubCopilot, "", false},
+	}
+	for _, tt := range tests {
+		if got := SupportsStructuredOutput(string(tt.clientType), tt.compat); got != tt.want {
+			t.Fatalf("SupportsStructuredOutput(%q, %q) = %v, want %v", tt.clientType, tt.compat, got, tt.want)
+		}
+	}
+}
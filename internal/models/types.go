@@ -24,6 +24,7 @@ const (
 	ClientTypeGoogleGenerativeAI      ClientType = "google-generative-ai"
 	ClientTypeOpenAICodex             ClientType = "openai-codex"
 	ClientTypeGitHubCopilot           ClientType = "github-copilot"
+	ClientTypeOllama                  ClientType = "ollama"
 	ClientTypeEdgeSpeech              ClientType = "edge-speech"
 	ClientTypeOpenAISpeech            ClientType = "openai-speech"
 	ClientTypeOpenAITranscription     ClientType = "openai-transcription"
@@ -71,10 +72,20 @@ var validReasoningEfforts = map[string]struct{}{
 
 // ModelConfig holds the JSONB config stored per model.
 type ModelConfig struct {
-	Dimensions       *int     `json:"dimensions,omitempty"`
-	Compatibilities  []string `json:"compatibilities,omitempty"`
-	ContextWindow    *int     `json:"context_window,omitempty"`
-	ReasoningEfforts []string `json:"reasoning_efforts,omitempty"`
+	Dimensions       *int          `json:"dimensions,omitempty"`
+	Compatibilities  []string      `json:"compatibilities,omitempty"`
+	ContextWindow    *int          `json:"context_window,omitempty"`
+	ReasoningEfforts []string      `json:"reasoning_efforts,omitempty"`
+	Pricing          *ModelPricing `json:"pricing,omitempty"`
+}
+
+// ModelPricing is the price of a model in the operator's billing currency
+// per one million tokens. Budgets measured in cost use it to price usage;
+// cached input falls back to the input price when CacheReadPerMillion is 0.
+type ModelPricing struct {
+	InputPerMillion     float64 `json:"input_per_million"`
+	OutputPerMillion    float64 `json:"output_per_million"`
+	CacheReadPerMillion float64 `json:"cache_read_per_million,omitempty"`
 }
 
 type Model struct {
@@ -113,6 +124,11 @@ func (m *Model) Validate() error {
 			return errors.New("invalid reasoning effort: " + effort)
 		}
 	}
+	if p := m.Config.Pricing; p != nil {
+		if p.InputPerMillion < 0 || p.OutputPerMillion < 0 || p.CacheReadPerMillion < 0 {
+			return errors.New("pricing must not be negative")
+		}
+	}
 	return nil
 }
 
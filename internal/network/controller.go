@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/memohai/memoh/internal/network/egress"
 )
 
 // ErrNotSupported indicates the selected runtime or overlay driver cannot
@@ -23,6 +25,21 @@ type BindingResolver interface {
 	Resolve(ctx context.Context, botID string) (BotOverlayConfig, error)
 }
 
+// EgressPlanner is implemented by binding resolvers that also hold the
+// bots' egress policies. The controller enforces the plan after every
+// runtime network setup, before the overlay is attached.
+type EgressPlanner interface {
+	PlanEgress(ctx context.Context, botID string, runtime RuntimeNetworkStatus) (EgressPlan, error)
+}
+
+// EgressPlan is the egress enforcement of a bot's workspace.
+type EgressPlan struct {
+	Enabled  bool
+	Firewall egress.Ruleset
+	// ProxyURL is handed to overlays with the EgressProxy capability.
+	ProxyURL string
+}
+
 type controller struct {
 	runtime  Runtime
 	resolver BindingResolver
@@ -58,6 +75,9 @@ func (c *controller) EnsureAttached(ctx context.Context, req AttachmentRequest)
 		}
 		status.Runtime = runtimeStatus
 		runtimeReady = true
+		if err := c.enforceEgress(ctx, &req, runtimeStatus); err != nil {
+			return AttachmentStatus{}, rollbackRuntime(err)
+		}
 	}
 	resolvedReq, overlay, err := c.resolveOverlay(ctx, req)
 	if err != nil {
@@ -71,6 +91,43 @@ func (c *controller) EnsureAttached(ctx context.Context, req AttachmentRequest)
 	return status, nil
 }
 
+// enforceEgress applies the bot's egress firewall to the freshly attached
+// namespace. An enabled policy the runtime cannot enforce fails the
+// attachment rather than leaving the workspace unrestricted.
+func (c *controller) enforceEgress(ctx context.Context, req *AttachmentRequest, runtimeStatus RuntimeNetworkStatus) error {
+	planner, ok := c.resolver.(EgressPlanner)
+	if !ok || req.BotID == "" {
+		return nil
+	}
+	plan, err := planner.PlanEgress(ctx, req.BotID, runtimeStatus)
+	if err != nil {
+		return err
+	}
+	req.EgressProxy = ""
+	firewall, supported := c.runtime.(FirewallRuntime)
+	if !plan.Enabled {
+		if !supported {
+			return nil
+		}
+		if err := firewall.RemoveEgressFirewall(ctx, req.Runtime); err != nil && !errors.Is(err, ErrNotSupported) {
+			return fmt.Errorf("remove egress firewall: %w", err)
+		}
+		return nil
+	}
+	err = ErrNotSupported
+	if supported {
+		err = firewall.ApplyEgressFirewall(ctx, req.Runtime, plan.Firewall)
+	}
+	if errors.Is(err, ErrNotSupported) {
+		return fmt.Errorf("egress policy cannot be enforced by the %s runtime: %w", c.runtime.Kind(), err)
+	}
+	if err != nil {
+		return fmt.Errorf("apply egress firewall: %w", err)
+	}
+	req.EgressProxy = plan.ProxyURL
+	return nil
+}
+
 func (c *controller) Detach(ctx context.Context, req AttachmentRequest) error {
 	resolvedReq, overlay, err := c.resolveOverlay(ctx, req)
 	if err != nil {
@@ -128,6 +185,9 @@ func (c *controller) resolveOverlay(ctx context.Context, req AttachmentRequest)
 	if !ok {
 		return AttachmentRequest{}, nil, fmt.Errorf("network provider not registered: %s", req.Overlay.Provider)
 	}
+	if !provider.Descriptor().Capabilities.EgressProxy {
+		req.EgressProxy = ""
+	}
 	driver, err := provider.BuildDriver(req.Overlay)
 	if err != nil {
 		return AttachmentRequest{}, nil, err
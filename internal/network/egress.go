@@ -0,0 +1,280 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/memohai/memoh/internal/audit"
+	ctr "github.com/memohai/memoh/internal/container"
+	"github.com/memohai/memoh/internal/db"
+	dbsqlc "github.com/memohai/memoh/internal/db/postgres/sqlc"
+	"github.com/memohai/memoh/internal/network/egress"
+)
+
+// egressEnvFile is the file, in the bot's runtime directory, the workspace
+// bridge reads proxy variables for exec commands from.
+const egressEnvFile = "egress.env"
+
+// egressStatusBlocked bounds the blocked attempts returned with the status.
+const egressStatusBlocked = 50
+
+// ErrInvalidEgressPolicy is returned for a policy that does not parse.
+var ErrInvalidEgressPolicy = errors.New("invalid egress policy")
+
+// EgressStatus is the egress section of a bot's network status.
+type EgressStatus struct {
+	Enabled bool `json:"enabled"`
+	// Enforced reports whether the policy is applied to the running
+	// workspace.
+	Enforced     bool                       `json:"enforced"`
+	Message      string                     `json:"message,omitempty"`
+	BlockedTotal int64                      `json:"blocked_total"`
+	Blocked      []egress.BlockedConnection `json:"blocked,omitempty"`
+}
+
+// SetEgressProxy enables egress policies. runRoot is the directory holding
+// the per-bot runtime directories mounted into workspaces at /run/memoh.
+func (s *Service) SetEgressProxy(proxy *egress.Proxy, runRoot string) {
+	s.egressMu.Lock()
+	defer s.egressMu.Unlock()
+	s.egressProxy = proxy
+	s.egressRunRoot = runRoot
+}
+
+// EgressProxy returns the egress proxy, or nil when egress policies are not
+// configured.
+func (s *Service) EgressProxy() *egress.Proxy {
+	s.egressMu.Lock()
+	defer s.egressMu.Unlock()
+	return s.egressProxy
+}
+
+// EgressPolicy returns the stored policy of a bot, or a disabled policy when
+// none was saved.
+func (s *Service) EgressPolicy(ctx context.Context, botID string) (egress.Policy, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return egress.Policy{}, err
+	}
+	row, err := s.queries.GetBotEgressPolicy(ctx, pgBotID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return egress.Policy{Allow: []string{}, Deny: []string{}}, nil
+		}
+		return egress.Policy{}, err
+	}
+	var policy egress.Policy
+	if len(row.Policy) > 0 {
+		if err := json.Unmarshal(row.Policy, &policy); err != nil {
+			return egress.Policy{}, fmt.Errorf("decode egress policy: %w", err)
+		}
+	}
+	return policy.Normalize()
+}
+
+// UpdateEgressPolicy stores the policy of a bot and re-applies it to the
+// running workspace.
+func (s *Service) UpdateEgressPolicy(ctx context.Context, botID string, policy egress.Policy) (egress.Policy, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return egress.Policy{}, err
+	}
+	policy, err = policy.Normalize()
+	if err != nil {
+		return egress.Policy{}, fmt.Errorf("%w: %w", ErrInvalidEgressPolicy, err)
+	}
+	if policy.Enabled {
+		if reason := s.egressUnavailable(); reason != "" {
+			return egress.Policy{}, fmt.Errorf("%w: %s", ErrNotSupported, reason)
+		}
+	}
+	before, err := s.EgressPolicy(ctx, botID)
+	if err != nil {
+		return egress.Policy{}, err
+	}
+	raw, err := json.Marshal(policy)
+	if err != nil {
+		return egress.Policy{}, err
+	}
+	if _, err := s.queries.UpsertBotEgressPolicy(ctx, dbsqlc.UpsertBotEgressPolicyParams{
+		BotID:  pgBotID,
+		Policy: raw,
+	}); err != nil {
+		return egress.Policy{}, err
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionNetworkEgressUpdate,
+		BotID:      botID,
+		TargetType: "bot_egress_policy",
+		TargetID:   botID,
+		Before:     before,
+		After:      policy,
+	})
+	if err := s.reapplyEgress(ctx, botID); err != nil {
+		return egress.Policy{}, fmt.Errorf("apply egress policy: %w", err)
+	}
+	return policy, nil
+}
+
+// reapplyEgress re-runs the attachment of a running workspace so the
+// controller applies the new plan. Stopped workspaces pick it up on start.
+func (s *Service) reapplyEgress(ctx context.Context, botID string) error {
+	if s.controller == nil {
+		return nil
+	}
+	cfg, err := s.GetBotConfig(ctx, botID)
+	if err != nil {
+		return err
+	}
+	req, err := s.buildAttachmentRequest(ctx, botID, cfg)
+	if err != nil {
+		if errors.Is(err, ErrWorkspaceContainerMissing) {
+			return nil
+		}
+		return err
+	}
+	if strings.TrimSpace(req.Runtime.JoinTarget.Path) == "" {
+		return nil
+	}
+	_, err = s.controller.EnsureAttached(ctx, req)
+	return err
+}
+
+// PlanEgress implements EgressPlanner. For an enabled policy it writes the
+// proxy variables for exec commands and renders the firewall that only lets
+// the workspace reach the proxy and the networks the policy allows.
+func (s *Service) PlanEgress(ctx context.Context, botID string, runtime RuntimeNetworkStatus) (EgressPlan, error) {
+	policy, err := s.EgressPolicy(ctx, botID)
+	if err != nil {
+		return EgressPlan{}, err
+	}
+	if !policy.Enabled {
+		s.forgetEgress(botID)
+		return EgressPlan{}, nil
+	}
+	compiled, err := policy.Compile()
+	if err != nil {
+		return EgressPlan{}, fmt.Errorf("%w: %w", ErrInvalidEgressPolicy, err)
+	}
+	s.egressMu.Lock()
+	proxy, runRoot := s.egressProxy, s.egressRunRoot
+	s.egressMu.Unlock()
+	if proxy == nil || proxy.Port() == 0 {
+		return EgressPlan{}, errors.New("egress proxy is not running")
+	}
+	gateway, err := netip.ParseAddr(strings.TrimSpace(runtime.Gateway))
+	if err != nil {
+		return EgressPlan{}, errors.New("workspace network reported no gateway for the egress proxy")
+	}
+	proxyAddr := netip.AddrPortFrom(gateway.Unmap(), uint16(proxy.Port())) //nolint:gosec // G115: listener ports fit in uint16.
+	user, password := proxy.Credentials(botID)
+	proxyURL := (&url.URL{Scheme: "http", User: url.UserPassword(user, password), Host: proxyAddr.String()}).String()
+	if runRoot != "" {
+		if err := writeEgressEnv(filepath.Join(runRoot, botID), proxyURL); err != nil {
+			return EgressPlan{}, err
+		}
+	}
+	s.egressMu.Lock()
+	if s.egressProxies == nil {
+		s.egressProxies = map[string]string{}
+	}
+	s.egressProxies[botID] = proxyURL
+	s.egressMu.Unlock()
+	return EgressPlan{
+		Enabled:  true,
+		Firewall: compiled.Firewall(proxyAddr),
+		ProxyURL: proxyURL,
+	}, nil
+}
+
+func (s *Service) forgetEgress(botID string) {
+	s.egressMu.Lock()
+	delete(s.egressProxies, botID)
+	runRoot := s.egressRunRoot
+	s.egressMu.Unlock()
+	if runRoot == "" {
+		return
+	}
+	if err := os.Remove(filepath.Join(runRoot, botID, egressEnvFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.logger.Warn("remove egress env file failed", slog.String("bot_id", botID), slog.Any("error", err))
+	}
+}
+
+func writeEgressEnv(dir, proxyURL string) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	var b strings.Builder
+	for _, key := range []string{"HTTP_PROXY", "HTTPS_PROXY", "http_proxy", "https_proxy"} {
+		b.WriteString(key + "=" + proxyURL + "\n")
+	}
+	b.WriteString("NO_PROXY=localhost,127.0.0.1,::1\n")
+	b.WriteString("no_proxy=localhost,127.0.0.1,::1\n")
+	path := filepath.Join(dir, egressEnvFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// egressProxyURL returns the proxy URL of a workspace the policy is
+// currently enforced on.
+func (s *Service) egressProxyURL(botID string) string {
+	s.egressMu.Lock()
+	defer s.egressMu.Unlock()
+	return s.egressProxies[botID]
+}
+
+func (s *Service) egressUnavailable() string {
+	if !descriptorForBackend(s.runtimeKind).Capabilities.EgressFirewall {
+		return "the " + s.runtimeKind + " runtime cannot enforce egress policies"
+	}
+	s.egressMu.Lock()
+	defer s.egressMu.Unlock()
+	if s.egressProxy == nil || s.egressProxy.Port() == 0 {
+		return "egress proxy is not running"
+	}
+	return ""
+}
+
+func (s *Service) egressStatus(ctx context.Context, botID string, ws WorkspaceRuntimeStatus) *EgressStatus {
+	policy, err := s.EgressPolicy(ctx, botID)
+	if err != nil {
+		s.logger.Warn("egress policy lookup failed", slog.String("bot_id", botID), slog.Any("error", err))
+		return &EgressStatus{Message: "egress policy is unavailable"}
+	}
+	status := &EgressStatus{Enabled: policy.Enabled}
+	s.egressMu.Lock()
+	proxy := s.egressProxy
+	s.egressMu.Unlock()
+	if proxy != nil {
+		status.Blocked, status.BlockedTotal = proxy.Blocks().Recent(botID, egressStatusBlocked)
+	}
+	if !policy.Enabled {
+		return status
+	}
+	switch {
+	case ws.TaskStatus != ctr.TaskStatusRunning.String():
+		status.Message = "Policy is applied when the workspace starts."
+	case s.egressProxyURL(botID) != "":
+		status.Enforced = true
+	default:
+		if reason := s.egressUnavailable(); reason != "" {
+			status.Message = reason
+		} else {
+			status.Message = "Policy is not applied yet; restart the workspace to enforce it."
+		}
+	}
+	return status
+}
@@ -0,0 +1,77 @@
+package egress
+
+import (
+	"sync"
+	"time"
+)
+
+// Sources of a blocked connection.
+const (
+	SourceWorkspace = "workspace"
+	SourceWebFetch  = "web_fetch"
+	SourceWorkflow  = "workflow"
+)
+
+// DefaultLogSize is the number of blocked attempts kept per bot.
+const DefaultLogSize = 100
+
+// BlockedConnection is a connection attempt the policy refused.
+type BlockedConnection struct {
+	Time    time.Time `json:"time"`
+	Source  string    `json:"source"`
+	Host    string    `json:"host"`
+	Port    int       `json:"port"`
+	Address string    `json:"address,omitempty"`
+	Reason  string    `json:"reason"`
+	Rule    string    `json:"rule,omitempty"`
+}
+
+// Log keeps the most recent blocked attempts of each bot in memory.
+type Log struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string][]BlockedConnection
+	totals  map[string]int64
+}
+
+func NewLog(size int) *Log {
+	if size <= 0 {
+		size = DefaultLogSize
+	}
+	return &Log{
+		size:    size,
+		entries: map[string][]BlockedConnection{},
+		totals:  map[string]int64{},
+	}
+}
+
+// Record appends a blocked attempt, dropping the oldest beyond the log size.
+func (l *Log) Record(botID string, entry BlockedConnection) {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entries := append(l.entries[botID], entry)
+	if len(entries) > l.size {
+		entries = append([]BlockedConnection(nil), entries[len(entries)-l.size:]...)
+	}
+	l.entries[botID] = entries
+	l.totals[botID]++
+}
+
+// Recent returns up to limit blocked attempts, newest first, and the number
+// of attempts blocked since the server started.
+func (l *Log) Recent(botID string, limit int) ([]BlockedConnection, int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entries := l.entries[botID]
+	if limit <= 0 || limit > len(entries) {
+		limit = len(entries)
+	}
+	out := make([]BlockedConnection, 0, limit)
+	for i := len(entries) - 1; i >= 0 && len(out) < limit; i-- {
+		out = append(out, entries[i])
+	}
+	return out, l.totals[botID]
+}
@@ -0,0 +1,99 @@
+package egress
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// Ruleset is the content of the egress chain the runtime hooks into the
+// OUTPUT chain of a workspace network namespace, as iptables rule specs.
+// Traffic that reaches the end of the chain is rejected.
+type Ruleset struct {
+	IPv4 []string
+	IPv6 []string
+}
+
+// Firewall renders the egress chain of a workspace whose egress proxy
+// listens at proxy. Domain rules are left to the proxy; network rules and
+// port rules for any host also apply to traffic that bypasses it, which is
+// how overlays and non-HTTP protocols are let through.
+func (c *Compiled) Firewall(proxy netip.AddrPort) Ruleset {
+	return Ruleset{
+		IPv4: c.chain(proxy, true),
+		IPv6: c.chain(proxy, false),
+	}
+}
+
+func (c *Compiled) chain(proxy netip.AddrPort, ipv4 bool) []string {
+	rules := []string{
+		"-o lo -j RETURN",
+		"-m conntrack --ctstate ESTABLISHED,RELATED -j RETURN",
+	}
+	if proxy.IsValid() && proxy.Addr().Unmap().Is4() == ipv4 {
+		addr := proxy.Addr().Unmap()
+		rules = append(rules, fmt.Sprintf("-d %s -p tcp --dport %d -j RETURN", netip.PrefixFrom(addr, addr.BitLen()), proxy.Port()))
+	}
+	for _, rule := range c.Deny {
+		rules = append(rules, ruleSpecs(rule, ipv4, false)...)
+	}
+	var publicAllow []Rule
+	for _, rule := range c.Allow {
+		if rule.IsNetwork() && isPrivatePrefix(rule.Prefix) {
+			rules = append(rules, ruleSpecs(rule, ipv4, true)...)
+		} else {
+			publicAllow = append(publicAllow, rule)
+		}
+	}
+	if c.Policy.BlockPrivateRanges {
+		for _, prefix := range privateRanges {
+			if prefix.Addr().Is4() == ipv4 {
+				rules = append(rules, "-d "+prefix.String()+" -j REJECT")
+			}
+		}
+	}
+	for _, rule := range publicAllow {
+		rules = append(rules, ruleSpecs(rule, ipv4, true)...)
+	}
+	if ipv4 {
+		rules = append(rules, "-p tcp -j REJECT --reject-with tcp-reset", "-j REJECT --reject-with icmp-port-unreachable")
+	} else {
+		rules = append(rules, "-p tcp -j REJECT --reject-with tcp-reset", "-j REJECT --reject-with icmp6-port-unreachable")
+	}
+	return rules
+}
+
+// ruleSpecs renders a network or any-host rule for one address family.
+// Domain rules render nothing.
+func ruleSpecs(rule Rule, ipv4, allow bool) []string {
+	var match []string
+	switch {
+	case rule.IsNetwork():
+		if rule.Prefix.Addr().Is4() != ipv4 {
+			return nil
+		}
+		match = append(match, "-d "+rule.Prefix.String())
+	case !rule.anyHost():
+		return nil
+	}
+	target := "-j RETURN"
+	if !allow {
+		target = "-j REJECT"
+	}
+	if rule.PortMin == 0 {
+		return []string{strings.Join(append(match, target), " ")}
+	}
+	ports := fmt.Sprint(rule.PortMin)
+	if rule.PortMax != rule.PortMin {
+		ports = fmt.Sprintf("%d:%d", rule.PortMin, rule.PortMax)
+	}
+	specs := make([]string, 0, 2)
+	for _, proto := range []string{"tcp", "udp"} {
+		protoTarget := target
+		if !allow && proto == "tcp" {
+			protoTarget += " --reject-with tcp-reset"
+		}
+		specs = append(specs, strings.Join(append(append([]string(nil), match...), "-p "+proto+" --dport "+ports, protoTarget), " "))
+	}
+	return specs
+}
@@ -0,0 +1,339 @@
+// Package egress restricts where a bot's workspace may connect. A Policy is
+// enforced twice: a packet filter in the workspace network namespace only
+// lets traffic reach the egress proxy (and explicitly allowed networks), and
+// the proxy checks every destination against the policy before dialing.
+package egress
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Reasons a connection is blocked.
+const (
+	ReasonDeniedByRule = "denied_by_rule"
+	ReasonNotAllowed   = "not_allowed"
+	ReasonPrivateRange = "private_range"
+	// ReasonLoopback blocks loopback and unspecified destinations. The proxy
+	// dials from the server, where they would reach the server itself.
+	ReasonLoopback = "loopback"
+	// ReasonResolve is recorded when a name does not resolve.
+	ReasonResolve = "resolve_failed"
+)
+
+// Policy is a bot's egress policy as stored and exposed over the API.
+//
+// Rules are written as [host][:port[-port]] where host is a domain, a
+// wildcard domain (*.example.com), an IP address or a CIDR. IPv6 addresses
+// with a port use brackets ([2001:db8::/32]:443). An empty host or "*"
+// matches any destination, so ":22" denies SSH everywhere.
+//
+// A destination is blocked when it matches a deny rule, when it is in a
+// private range while BlockPrivateRanges is set, or when Allow is not empty
+// and no allow rule matches it. Only an allow rule naming a network inside a
+// private range lifts the private range block; domain rules never do, so a
+// name cannot be pointed at an internal address later.
+type Policy struct {
+	Enabled            bool     `json:"enabled"`
+	Allow              []string `json:"allow"`
+	Deny               []string `json:"deny"`
+	BlockPrivateRanges bool     `json:"block_private_ranges"`
+}
+
+// Decision is the outcome of evaluating a destination against a policy.
+type Decision struct {
+	Allowed bool
+	Reason  string
+	Rule    string
+}
+
+// Rule is a parsed allow or deny rule.
+type Rule struct {
+	Raw string
+	// Domain is the matched name, or its parent for a wildcard rule.
+	Domain   string
+	Wildcard bool
+	// Prefix is set for IP and CIDR rules.
+	Prefix netip.Prefix
+	// PortMin and PortMax are zero when the rule matches every port.
+	PortMin int
+	PortMax int
+}
+
+// ParseRule parses a single allow or deny rule.
+func ParseRule(raw string) (Rule, error) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	if value == "" {
+		return Rule{}, errors.New("rule is empty")
+	}
+	rule := Rule{Raw: value}
+	host, port := value, ""
+	switch {
+	case strings.HasPrefix(value, "["):
+		end := strings.Index(value, "]")
+		if end < 0 {
+			return Rule{}, fmt.Errorf("rule %q: missing ]", raw)
+		}
+		host = value[1:end]
+		rest := value[end+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ":") {
+				return Rule{}, fmt.Errorf("rule %q: expected :port after ]", raw)
+			}
+			port = rest[1:]
+		}
+	case strings.Count(value, ":") == 1:
+		host, port, _ = strings.Cut(value, ":")
+	}
+	if port != "" {
+		lo, hi, err := parsePortRange(port)
+		if err != nil {
+			return Rule{}, fmt.Errorf("rule %q: %w", raw, err)
+		}
+		rule.PortMin, rule.PortMax = lo, hi
+	}
+	switch {
+	case host == "" || host == "*":
+		if port == "" {
+			return Rule{}, fmt.Errorf("rule %q: a rule for any host needs a port", raw)
+		}
+	case strings.Contains(host, "/"):
+		prefix, err := netip.ParsePrefix(host)
+		if err != nil {
+			return Rule{}, fmt.Errorf("rule %q: invalid CIDR", raw)
+		}
+		rule.Prefix = prefix.Masked()
+	default:
+		if addr, err := netip.ParseAddr(host); err == nil {
+			addr = addr.Unmap()
+			rule.Prefix = netip.PrefixFrom(addr, addr.BitLen())
+			break
+		}
+		domain := strings.TrimSuffix(host, ".")
+		if parent, ok := strings.CutPrefix(domain, "*."); ok {
+			rule.Wildcard = true
+			domain = parent
+		}
+		if err := validateDomain(domain); err != nil {
+			return Rule{}, fmt.Errorf("rule %q: %w", raw, err)
+		}
+		rule.Domain = domain
+	}
+	return rule, nil
+}
+
+func parsePortRange(value string) (int, int, error) {
+	loText, hiText, isRange := strings.Cut(value, "-")
+	lo, err := strconv.Atoi(loText)
+	if err != nil || lo < 1 || lo > 65535 {
+		return 0, 0, errors.New("invalid port")
+	}
+	if !isRange {
+		return lo, lo, nil
+	}
+	hi, err := strconv.Atoi(hiText)
+	if err != nil || hi < lo || hi > 65535 {
+		return 0, 0, errors.New("invalid port range")
+	}
+	return lo, hi, nil
+}
+
+func validateDomain(domain string) error {
+	if domain == "" || len(domain) > 253 {
+		return errors.New("invalid domain")
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return errors.New("invalid domain")
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+				return errors.New("invalid domain")
+			}
+		}
+	}
+	return nil
+}
+
+// IsNetwork reports whether the rule matches by address.
+func (r Rule) IsNetwork() bool {
+	return r.Prefix.IsValid()
+}
+
+func (r Rule) anyHost() bool {
+	return r.Domain == "" && !r.Prefix.IsValid()
+}
+
+func (r Rule) matchesPort(port int) bool {
+	return r.PortMin == 0 || (port >= r.PortMin && port <= r.PortMax)
+}
+
+// matchesName matches domain rules against the requested host name.
+func (r Rule) matchesName(host string) bool {
+	if r.Domain == "" {
+		return false
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if r.Wildcard {
+		return strings.HasSuffix(host, "."+r.Domain)
+	}
+	return host == r.Domain
+}
+
+func (r Rule) matches(host string, port int, addr netip.Addr) bool {
+	if !r.matchesPort(port) {
+		return false
+	}
+	switch {
+	case r.anyHost():
+		return true
+	case r.IsNetwork():
+		return addr.IsValid() && r.Prefix.Contains(addr)
+	default:
+		return r.matchesName(host)
+	}
+}
+
+// Normalize trims, lowercases and de-duplicates the rules and rejects
+// invalid ones.
+func (p Policy) Normalize() (Policy, error) {
+	var err error
+	if p.Allow, err = normalizeRules("allow", p.Allow); err != nil {
+		return Policy{}, err
+	}
+	if p.Deny, err = normalizeRules("deny", p.Deny); err != nil {
+		return Policy{}, err
+	}
+	return p, nil
+}
+
+func normalizeRules(list string, rules []string) ([]string, error) {
+	out := make([]string, 0, len(rules))
+	seen := map[string]struct{}{}
+	for _, raw := range rules {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		rule, err := ParseRule(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", list, err)
+		}
+		if _, ok := seen[rule.Raw]; ok {
+			continue
+		}
+		seen[rule.Raw] = struct{}{}
+		out = append(out, rule.Raw)
+	}
+	return out, nil
+}
+
+// Compiled is a policy with parsed rules, ready for evaluation.
+type Compiled struct {
+	Policy Policy
+	Allow  []Rule
+	Deny   []Rule
+}
+
+// Compile parses the rules of a policy.
+func (p Policy) Compile() (*Compiled, error) {
+	normalized, err := p.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	c := &Compiled{Policy: normalized}
+	for _, raw := range normalized.Allow {
+		rule, _ := ParseRule(raw)
+		c.Allow = append(c.Allow, rule)
+	}
+	for _, raw := range normalized.Deny {
+		rule, _ := ParseRule(raw)
+		c.Deny = append(c.Deny, rule)
+	}
+	return c, nil
+}
+
+// DeniedByName reports a deny rule that matches the host name and port
+// alone, so the proxy can refuse before resolving the name.
+func (c *Compiled) DeniedByName(host string, port int) (Decision, bool) {
+	for _, rule := range c.Deny {
+		if rule.Domain != "" && rule.matchesPort(port) && rule.matchesName(host) {
+			return Decision{Reason: ReasonDeniedByRule, Rule: rule.Raw}, true
+		}
+	}
+	return Decision{}, false
+}
+
+// Evaluate decides whether a connection to host:port, resolved to addr, may
+// be made. host is the requested name, or the address itself when the
+// destination was given as an IP.
+func (c *Compiled) Evaluate(host string, port int, addr netip.Addr) Decision {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsUnspecified() {
+		return Decision{Reason: ReasonLoopback}
+	}
+	for _, rule := range c.Deny {
+		if rule.matches(host, port, addr) {
+			return Decision{Reason: ReasonDeniedByRule, Rule: rule.Raw}
+		}
+	}
+	if c.Policy.BlockPrivateRanges && IsPrivate(addr) {
+		for _, rule := range c.Allow {
+			if rule.IsNetwork() && isPrivatePrefix(rule.Prefix) && rule.matches(host, port, addr) {
+				return Decision{Allowed: true, Rule: rule.Raw}
+			}
+		}
+		return Decision{Reason: ReasonPrivateRange}
+	}
+	if len(c.Allow) == 0 {
+		return Decision{Allowed: true}
+	}
+	for _, rule := range c.Allow {
+		if rule.matches(host, port, addr) {
+			return Decision{Allowed: true, Rule: rule.Raw}
+		}
+	}
+	return Decision{Reason: ReasonNotAllowed}
+}
+
+// privateRanges are the ranges BlockPrivateRanges covers: RFC 1918 and
+// unique local addresses, CGNAT, loopback, link-local, multicast and
+// reserved space.
+var privateRanges = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::/128"),
+	netip.MustParsePrefix("::1/128"),
+	netip.MustParsePrefix("fc00::/7"),
+	netip.MustParsePrefix("fe80::/10"),
+	netip.MustParsePrefix("ff00::/8"),
+}
+
+// IsPrivate reports whether addr is in a range BlockPrivateRanges covers.
+func IsPrivate(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range privateRanges {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrivatePrefix(prefix netip.Prefix) bool {
+	for _, private := range privateRanges {
+		if private.Bits() <= prefix.Bits() && private.Contains(prefix.Addr()) {
+			return true
+		}
+	}
+	return false
+}
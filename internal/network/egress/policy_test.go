@@ -0,0 +1,122 @@
+package egress
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseRule(t *testing.T) {
+	for raw, want := range map[string]Rule{
+		"Example.com":           {Raw: "example.com", Domain: "example.com"},
+		"*.github.com:443":      {Raw: "*.github.com:443", Domain: "github.com", Wildcard: true, PortMin: 443, PortMax: 443},
+		"10.0.0.0/8":            {Raw: "10.0.0.0/8", Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+		"192.168.1.7:8000-8100": {Raw: "192.168.1.7:8000-8100", Prefix: netip.MustParsePrefix("192.168.1.7/32"), PortMin: 8000, PortMax: 8100},
+		"2001:db8::/32":         {Raw: "2001:db8::/32", Prefix: netip.MustParsePrefix("2001:db8::/32")},
+		"[2001:db8::1]:22":      {Raw: "[2001:db8::1]:22", Prefix: netip.MustParsePrefix("2001:db8::1/128"), PortMin: 22, PortMax: 22},
+		":25":                   {Raw: ":25", PortMin: 25, PortMax: 25},
+	} {
+		got, err := ParseRule(raw)
+		if err != nil {
+			t.Fatalf("ParseRule(%q) returned error: %v", raw, err)
+		}
+		if got != want {
+			t.Fatalf("ParseRule(%q) = %+v, want %+v", raw, got, want)
+		}
+	}
+	for _, raw := range []string{"", "*", "bad_domain-.com", "example.com:0", "example.com:90-80", "10.0.0.0/33", "[::1"} {
+		if _, err := ParseRule(raw); err == nil {
+			t.Fatalf("ParseRule(%q) expected error", raw)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	policy := Policy{
+		Enabled:            true,
+		Allow:              []string{"*.github.com", "pypi.org:443", "10.20.0.0/16", "0.0.0.0/0:22"},
+		Deny:               []string{"gist.github.com"},
+		BlockPrivateRanges: true,
+	}
+	compiled, err := policy.Compile()
+	if err != nil {
+		t.Fatalf("Compile returned error: %v", err)
+	}
+	public := netip.MustParseAddr("140.82.112.3")
+	for _, tc := range []struct {
+		host   string
+		port   int
+		addr   string
+		reason string
+	}{
+		{"api.github.com", 443, "140.82.112.3", ""},
+		{"gist.github.com", 443, "140.82.112.3", ReasonDeniedByRule},
+		{"github.com", 443, "140.82.112.3", ReasonNotAllowed},
+		{"pypi.org", 443, "151.101.0.223", ""},
+		{"pypi.org", 80, "151.101.0.223", ReasonNotAllowed},
+		{"10.20.3.4", 5432, "10.20.3.4", ""},
+		{"10.30.3.4", 5432, "10.30.3.4", ReasonPrivateRange},
+		{"api.github.com", 443, "192.168.0.10", ReasonPrivateRange},
+		{"localhost", 443, "127.0.0.1", ReasonLoopback},
+		{"203.0.113.9", 22, "203.0.113.9", ""},
+		{"192.168.0.9", 22, "192.168.0.9", ReasonPrivateRange},
+	} {
+		decision := compiled.Evaluate(tc.host, tc.port, netip.MustParseAddr(tc.addr))
+		if decision.Allowed != (tc.reason == "") || decision.Reason != tc.reason {
+			t.Fatalf("Evaluate(%s:%d -> %s) = %+v, want reason %q", tc.host, tc.port, tc.addr, decision, tc.reason)
+		}
+	}
+	if _, denied := compiled.DeniedByName("gist.github.com", 443); !denied {
+		t.Fatal("DeniedByName must match a deny domain rule")
+	}
+
+	open, err := Policy{Enabled: true, Deny: []string{":25"}}.Compile()
+	if err != nil {
+		t.Fatalf("Compile returned error: %v", err)
+	}
+	if !open.Evaluate("example.com", 443, public).Allowed || open.Evaluate("mail.example.com", 25, public).Allowed {
+		t.Fatal("an empty allow list must allow everything that is not denied")
+	}
+}
+
+func TestFirewallRendersChain(t *testing.T) {
+	policy := Policy{
+		Enabled:            true,
+		Allow:              []string{"example.com", "10.20.0.0/16", "203.0.113.0/24:443", "2001:db8::/32"},
+		Deny:               []string{"203.0.113.7"},
+		BlockPrivateRanges: true,
+	}
+	compiled, err := policy.Compile()
+	if err != nil {
+		t.Fatalf("Compile returned error: %v", err)
+	}
+	rules := compiled.Firewall(netip.MustParseAddrPort("10.88.0.1:3128"))
+
+	index := func(rules []string, rule string) int {
+		i := slices.Index(rules, rule)
+		if i < 0 {
+			t.Fatalf("missing rule %q in\n%s", rule, strings.Join(rules, "\n"))
+		}
+		return i
+	}
+	proxy := index(rules.IPv4, "-d 10.88.0.1/32 -p tcp --dport 3128 -j RETURN")
+	deny := index(rules.IPv4, "-d 203.0.113.7/32 -j REJECT")
+	privateAllow := index(rules.IPv4, "-d 10.20.0.0/16 -j RETURN")
+	privateBlock := index(rules.IPv4, "-d 10.0.0.0/8 -j REJECT")
+	publicAllow := index(rules.IPv4, "-d 203.0.113.0/24 -p tcp --dport 443 -j RETURN")
+	index(rules.IPv4, "-d 203.0.113.0/24 -p udp --dport 443 -j RETURN")
+	if !(proxy < deny && deny < privateAllow && privateAllow < privateBlock && privateBlock < publicAllow) {
+		t.Fatalf("unexpected rule order:\n%s", strings.Join(rules.IPv4, "\n"))
+	}
+	if last := rules.IPv4[len(rules.IPv4)-1]; last != "-j REJECT --reject-with icmp-port-unreachable" {
+		t.Fatalf("chain must end with a reject, got %q", last)
+	}
+	for _, rule := range rules.IPv4 {
+		if strings.Contains(rule, "example.com") || strings.Contains(rule, "2001:db8") {
+			t.Fatalf("unexpected IPv4 rule %q", rule)
+		}
+	}
+	index(rules.IPv6, "-d 2001:db8::/32 -j RETURN")
+	index(rules.IPv6, "-d fc00::/7 -j REJECT")
+}
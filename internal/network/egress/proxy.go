@@ -0,0 +1,417 @@
+package egress
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	dialTimeout = 15 * time.Second
+	proxyRealm  = `Basic realm="memoh-egress"`
+)
+
+// PolicySource returns the current policy of a bot.
+type PolicySource interface {
+	EgressPolicy(ctx context.Context, botID string) (Policy, error)
+}
+
+// ErrPolicyDisabled is returned for workspace traffic of a bot whose policy
+// is not enabled.
+var ErrPolicyDisabled = errors.New("egress policy is not enabled for this bot")
+
+// BlockedError is returned for a connection the policy refused.
+type BlockedError struct {
+	Host     string
+	Port     int
+	Decision Decision
+}
+
+func (e *BlockedError) Error() string {
+	msg := fmt.Sprintf("egress to %s blocked by policy: %s", net.JoinHostPort(e.Host, strconv.Itoa(e.Port)), e.Decision.Reason)
+	if e.Decision.Rule != "" {
+		msg += " (" + e.Decision.Rule + ")"
+	}
+	return msg
+}
+
+// Proxy is the HTTP proxy workspaces send their traffic through. Each bot
+// authenticates with credentials derived from its ID, and every destination
+// is checked against the bot's policy after the name is resolved; the proxy
+// dials the checked address itself, so a name cannot resolve differently
+// between the check and the connection.
+type Proxy struct {
+	logger   *slog.Logger
+	source   PolicySource
+	blocks   *Log
+	secret   []byte
+	resolver *net.Resolver
+	dialer   *net.Dialer
+	external *net.Dialer
+
+	mu       sync.Mutex
+	server   *http.Server
+	listener net.Listener
+}
+
+func NewProxy(log *slog.Logger, source PolicySource, blocks *Log, secret []byte) *Proxy {
+	if log == nil {
+		log = slog.Default()
+	}
+	if blocks == nil {
+		blocks = NewLog(DefaultLogSize)
+	}
+	return &Proxy{
+		logger:   log.With(slog.String("service", "egress_proxy")),
+		source:   source,
+		blocks:   blocks,
+		secret:   secret,
+		resolver: net.DefaultResolver,
+		dialer:   &net.Dialer{Timeout: dialTimeout},
+		external: NewExternalDialer(),
+	}
+}
+
+// NewExternalDialer returns a dialer that refuses every address
+// BlockPrivateRanges covers, loopback and link-local included. The check
+// runs on the address being connected to, after name resolution, so a name
+// cannot be pointed at the server's own networks.
+func NewExternalDialer() *net.Dialer {
+	return &net.Dialer{Timeout: dialTimeout, Control: refuseInternal}
+}
+
+func refuseInternal(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if IsPrivate(addrPort.Addr()) {
+		return &BlockedError{
+			Host:     addrPort.Addr().Unmap().String(),
+			Port:     int(addrPort.Port()),
+			Decision: Decision{Reason: ReasonPrivateRange},
+		}
+	}
+	return nil
+}
+
+// Blocks returns the log of blocked attempts.
+func (p *Proxy) Blocks() *Log {
+	return p.blocks
+}
+
+// Credentials returns the proxy username and password of a bot.
+func (p *Proxy) Credentials(botID string) (string, string) {
+	mac := hmac.New(sha256.New, p.secret)
+	_, _ = mac.Write([]byte("egress-proxy:" + botID))
+	return botID, hex.EncodeToString(mac.Sum(nil))
+}
+
+// Start listens on addr and serves the proxy until Close.
+func (p *Proxy) Start(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("egress proxy listen %s: %w", addr, err)
+	}
+	server := &http.Server{
+		Handler:           p,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	p.mu.Lock()
+	p.server, p.listener = server, lis
+	p.mu.Unlock()
+	go func() {
+		if err := server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			p.logger.Error("egress proxy stopped", slog.Any("error", err))
+		}
+	}()
+	p.logger.Info("egress proxy listening", slog.String("addr", lis.Addr().String()))
+	return nil
+}
+
+// Port returns the port the proxy listens on, or zero before Start.
+func (p *Proxy) Port() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.listener == nil {
+		return 0
+	}
+	if addr, ok := p.listener.Addr().(*net.TCPAddr); ok {
+		return addr.Port
+	}
+	return 0
+}
+
+func (p *Proxy) Close(ctx context.Context) error {
+	p.mu.Lock()
+	server := p.server
+	p.mu.Unlock()
+	if server == nil {
+		return nil
+	}
+	return server.Shutdown(ctx)
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	botID, ok := p.authenticate(r)
+	if !ok {
+		w.Header().Set("Proxy-Authenticate", proxyRealm)
+		http.Error(w, "proxy authentication required", http.StatusProxyAuthRequired)
+		return
+	}
+	if r.Method == http.MethodConnect {
+		p.serveConnect(w, r, botID)
+		return
+	}
+	if r.URL.Scheme != "http" || r.URL.Host == "" {
+		http.Error(w, "only absolute http:// URLs and CONNECT are supported", http.StatusBadRequest)
+		return
+	}
+	p.serveForward(w, r, botID)
+}
+
+func (p *Proxy) authenticate(r *http.Request) (string, bool) {
+	scheme, encoded, ok := strings.Cut(r.Header.Get("Proxy-Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "basic") {
+		return "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", false
+	}
+	botID, password, ok := strings.Cut(string(decoded), ":")
+	if !ok || botID == "" {
+		return "", false
+	}
+	_, want := p.Credentials(botID)
+	return botID, hmac.Equal([]byte(password), []byte(want))
+}
+
+func (p *Proxy) serveConnect(w http.ResponseWriter, r *http.Request, botID string) {
+	upstream, err := p.dial(r.Context(), botID, SourceWorkspace, "tcp", r.Host)
+	if err != nil {
+		p.writeDialError(w, err)
+		return
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		_ = upstream.Close()
+		http.Error(w, "connection hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+	client, buffered, err := hijacker.Hijack()
+	if err != nil {
+		_ = upstream.Close()
+		return
+	}
+	if _, err := client.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n")); err != nil {
+		_ = client.Close()
+		_ = upstream.Close()
+		return
+	}
+	if n := buffered.Reader.Buffered(); n > 0 {
+		pending, _ := buffered.Reader.Peek(n)
+		if _, err := upstream.Write(pending); err != nil {
+			_ = client.Close()
+			_ = upstream.Close()
+			return
+		}
+	}
+	tunnel(client, upstream)
+}
+
+func tunnel(client, upstream net.Conn) {
+	done := make(chan struct{}, 2)
+	pipe := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		if tcp, ok := dst.(interface{ CloseWrite() error }); ok {
+			_ = tcp.CloseWrite()
+		}
+		done <- struct{}{}
+	}
+	go pipe(upstream, client)
+	go pipe(client, upstream)
+	<-done
+	<-done
+	_ = client.Close()
+	_ = upstream.Close()
+}
+
+// hopHeaders are removed before a request is forwarded.
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+func (p *Proxy) serveForward(w http.ResponseWriter, r *http.Request, botID string) {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return p.dial(ctx, botID, SourceWorkspace, network, address)
+		},
+		DisableKeepAlives: true,
+	}
+	out := r.Clone(r.Context())
+	out.RequestURI = ""
+	for _, header := range hopHeaders {
+		out.Header.Del(header)
+	}
+	resp, err := transport.RoundTrip(out)
+	if err != nil {
+		p.writeDialError(w, err)
+		return
+	}
+	defer func() { _ = resp.Body.Close() }()
+	for _, header := range hopHeaders {
+		resp.Header.Del(header)
+	}
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+func (*Proxy) writeDialError(w http.ResponseWriter, err error) {
+	var blocked *BlockedError
+	if errors.As(err, &blocked) {
+		http.Error(w, blocked.Error(), http.StatusForbidden)
+		return
+	}
+	if errors.Is(err, ErrPolicyDisabled) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
+
+// DialContext dials address for server-side fetches made on behalf of a
+// bot, such as web_fetch, under the same policy as its workspace.
+func (p *Proxy) DialContext(ctx context.Context, botID, network, address string) (net.Conn, error) {
+	return p.dial(ctx, botID, SourceWebFetch, network, address)
+}
+
+// DialExternal is DialContext for requests whose destination the bot's
+// owner chooses but the server sends, such as workflow HTTP steps. Besides
+// the bot's policy it refuses internal addresses, whether or not the policy
+// is enabled.
+func (p *Proxy) DialExternal(ctx context.Context, botID, network, address string) (net.Conn, error) {
+	return p.dial(ctx, botID, SourceWorkflow, network, address)
+}
+
+func (p *Proxy) dial(ctx context.Context, botID, source, network, address string) (net.Conn, error) {
+	host, portText, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(portText, 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid port %q", portText)
+	}
+	return p.dialChecked(ctx, botID, source, network, host, uint16(port))
+}
+
+func (p *Proxy) dialChecked(ctx context.Context, botID, source, network, host string, port uint16) (net.Conn, error) {
+	address := net.JoinHostPort(host, strconv.Itoa(int(port)))
+	external := source == SourceWorkflow
+	dialer := p.dialer
+	if external {
+		dialer = p.external
+	}
+	policy, err := p.source.EgressPolicy(ctx, botID)
+	if err != nil {
+		return nil, fmt.Errorf("load egress policy: %w", err)
+	}
+	if !policy.Enabled {
+		// Workspaces only get proxy credentials while their policy is on;
+		// a stale credential must not become a way into the server's own
+		// networks.
+		if source == SourceWorkspace {
+			return nil, ErrPolicyDisabled
+		}
+		return dialer.DialContext(ctx, network, address)
+	}
+	compiled, err := policy.Compile()
+	if err != nil {
+		return nil, fmt.Errorf("load egress policy: %w", err)
+	}
+	if decision, denied := compiled.DeniedByName(host, int(port)); denied {
+		return nil, p.block(botID, source, host, int(port), netip.Addr{}, decision)
+	}
+	var addrs []netip.Addr
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{addr}
+	} else {
+		addrs, err = p.resolver.LookupNetIP(ctx, "ip", host)
+		if err != nil || len(addrs) == 0 {
+			return nil, p.block(botID, source, host, int(port), netip.Addr{}, Decision{Reason: ReasonResolve})
+		}
+	}
+	var refused Decision
+	var refusedAddr netip.Addr
+	var lastErr error
+	for _, addr := range addrs {
+		decision := compiled.Evaluate(host, int(port), addr)
+		if decision.Allowed && external && IsPrivate(addr) {
+			decision = Decision{Reason: ReasonPrivateRange}
+		}
+		if !decision.Allowed {
+			if !refusedAddr.IsValid() {
+				refused, refusedAddr = decision, addr
+			}
+			continue
+		}
+		conn, err := dialer.DialContext(ctx, network, netip.AddrPortFrom(addr.Unmap(), port).String())
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, p.block(botID, source, host, int(port), refusedAddr, refused)
+}
+
+func (p *Proxy) block(botID, source, host string, port int, addr netip.Addr, decision Decision) error {
+	entry := BlockedConnection{
+		Source: source,
+		Host:   host,
+		Port:   port,
+		Reason: decision.Reason,
+		Rule:   decision.Rule,
+	}
+	if addr.IsValid() {
+		entry.Address = addr.Unmap().String()
+	}
+	p.blocks.Record(botID, entry)
+	p.logger.Debug("egress connection blocked",
+		slog.String("bot_id", botID),
+		slog.String("source", source),
+		slog.String("host", host),
+		slog.Int("port", port),
+		slog.String("reason", decision.Reason))
+	return &BlockedError{Host: host, Port: port, Decision: decision}
+}
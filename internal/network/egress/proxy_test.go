@@ -0,0 +1,160 @@
+package egress
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type staticPolicies map[string]Policy
+
+func (s staticPolicies) EgressPolicy(_ context.Context, botID string) (Policy, error) {
+	return s[botID], nil
+}
+
+func startTestProxy(t *testing.T, policies staticPolicies) *Proxy {
+	t.Helper()
+	proxy := NewProxy(nil, policies, NewLog(2), []byte("secret"))
+	if err := proxy.Start("127.0.0.1:0"); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+	t.Cleanup(func() { _ = proxy.Close(context.Background()) })
+	return proxy
+}
+
+func proxyClient(proxy *Proxy, botID, password string) *http.Client {
+	user, want := proxy.Credentials(botID)
+	if password == "" {
+		password = want
+	}
+	proxyURL := &url.URL{
+		Scheme: "http",
+		User:   url.UserPassword(user, password),
+		Host:   fmt.Sprintf("127.0.0.1:%d", proxy.Port()),
+	}
+	return &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+}
+
+func TestProxyRequiresCredentials(t *testing.T) {
+	proxy := startTestProxy(t, staticPolicies{})
+	resp, err := proxyClient(proxy, "bot-1", "wrong").Get("http://example.com/")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusProxyAuthRequired {
+		t.Fatalf("expected 407, got %d", resp.StatusCode)
+	}
+}
+
+func TestProxyWithoutPolicy(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer upstream.Close()
+
+	proxy := startTestProxy(t, staticPolicies{"bot-1": {Enabled: false}})
+	resp, err := proxyClient(proxy, "bot-1", "").Get(upstream.URL)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("workspace traffic without a policy: expected 403, got %d", resp.StatusCode)
+	}
+
+	conn, err := proxy.DialContext(context.Background(), "bot-1", "tcp", upstream.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("DialContext without a policy returned error: %v", err)
+	}
+	_ = conn.Close()
+}
+
+func TestProxyBlocksAndLogs(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("blocked request reached upstream")
+	}))
+	defer upstream.Close()
+
+	proxy := startTestProxy(t, staticPolicies{"bot-1": {Enabled: true, Deny: []string{"blocked.example"}}})
+
+	// The upstream listens on loopback, which the proxy never dials.
+	resp, err := proxyClient(proxy, "bot-1", "").Get(upstream.URL)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", resp.StatusCode)
+	}
+
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", proxy.Port()))
+	if err != nil {
+		t.Fatalf("dial proxy: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+	req, _ := http.NewRequest(http.MethodConnect, "http://blocked.example:443", nil)
+	req.Host = "blocked.example:443"
+	user, password := proxy.Credentials("bot-1")
+	req.SetBasicAuth(user, password)
+	req.Header.Set("Proxy-Authorization", req.Header.Get("Authorization"))
+	req.Header.Del("Authorization")
+	if err := req.Write(conn); err != nil {
+		t.Fatalf("write CONNECT: %v", err)
+	}
+	connectResp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		t.Fatalf("read CONNECT response: %v", err)
+	}
+	_ = connectResp.Body.Close()
+	if connectResp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 for CONNECT, got %d", connectResp.StatusCode)
+	}
+
+	_, err = proxy.DialContext(context.Background(), "bot-1", "tcp", "blocked.example:80")
+	var blocked *BlockedError
+	if !errors.As(err, &blocked) || blocked.Decision.Reason != ReasonDeniedByRule {
+		t.Fatalf("DialContext error = %v, want denied_by_rule", err)
+	}
+
+	entries, total := proxy.Blocks().Recent("bot-1", 0)
+	if total != 3 || len(entries) != 2 {
+		t.Fatalf("expected 3 blocked attempts with 2 kept, got %d and %+v", total, entries)
+	}
+	if entries[0].Source != SourceWebFetch || entries[1].Host != "blocked.example" || entries[1].Source != SourceWorkspace {
+		t.Fatalf("unexpected log entries: %+v", entries)
+	}
+}
+
+func TestDialExternalRefusesInternalAddresses(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer upstream.Close()
+	proxy := NewProxy(nil, staticPolicies{
+		"bot-1": {Enabled: false},
+		"bot-2": {Enabled: true, Allow: []string{"127.0.0.0/8"}},
+	}, NewLog(4), []byte("secret"))
+
+	// Neither an absent policy nor an allow rule opens internal networks.
+	for _, botID := range []string{"bot-1", "bot-2"} {
+		conn, err := proxy.DialExternal(context.Background(), botID, "tcp", upstream.Listener.Addr().String())
+		if err == nil {
+			_ = conn.Close()
+			t.Fatalf("%s: DialExternal reached loopback", botID)
+		}
+		var blocked *BlockedError
+		if !errors.As(err, &blocked) {
+			t.Fatalf("%s: expected BlockedError, got %v", botID, err)
+		}
+	}
+	_, err := NewExternalDialer().DialContext(context.Background(), "tcp", "169.254.169.254:80")
+	var blocked *BlockedError
+	if !errors.As(err, &blocked) {
+		t.Fatalf("metadata address: expected BlockedError, got %v", err)
+	}
+}
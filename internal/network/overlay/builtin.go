@@ -4,6 +4,7 @@ import (
 	netctl "github.com/memohai/memoh/internal/network"
 	"github.com/memohai/memoh/internal/network/overlay/netbird"
 	"github.com/memohai/memoh/internal/network/overlay/tailscale"
+	"github.com/memohai/memoh/internal/network/overlay/wireguard"
 )
 
 func RegisterBuiltinProviders(registry *netctl.Registry, deps ProviderDeps) error {
@@ -21,6 +22,11 @@ func RegisterBuiltinProviders(registry *netctl.Registry, deps ProviderDeps) erro
 			Runtime:        deps.Runtime,
 			StateRoot:      deps.StateRoot,
 		}),
+		wireguard.NewProvider(wireguard.Deps{
+			SidecarRuntime: deps.SidecarRuntime,
+			Runtime:        deps.Runtime,
+			StateRoot:      deps.StateRoot,
+		}),
 	}
 	for _, provider := range providers {
 		if err := registry.Register(provider); err != nil {
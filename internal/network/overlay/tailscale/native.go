@@ -114,6 +114,16 @@ func (d *nativeDriver) buildSpec(req netctl.AttachmentRequest) (sidecar.Spec, er
 			proxyAddress = "http://127.0.0.1:" + strconv.Itoa(port)
 		}
 	}
+	if req.EgressProxy != "" {
+		// tailscaled sends control and DERP traffic through the standard
+		// proxy variables, which keeps the node reachable while the
+		// workspace egress is locked down to the proxy.
+		env = append(env,
+			"HTTPS_PROXY="+req.EgressProxy,
+			"HTTP_PROXY="+req.EgressProxy,
+			"NO_PROXY=localhost,127.0.0.1,::1",
+		)
+	}
 	if len(tailscaledExtraArgs) > 0 {
 		env = append(env, "TS_TAILSCALED_EXTRA_ARGS="+strings.Join(tailscaledExtraArgs, " "))
 	}
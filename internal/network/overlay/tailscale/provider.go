@@ -96,7 +96,7 @@ func (p *Provider) Status(_ context.Context, cfg netctl.BotOverlayConfig) (netct
 	}, nil
 }
 
-func (p *Provider) ExecuteAction(ctx context.Context, cfg netctl.BotOverlayConfig, actionID string, _ map[string]any) (netctl.ProviderActionExecution, error) {
+func (p *Provider) ExecuteAction(ctx context.Context, _ string, cfg netctl.BotOverlayConfig, actionID string, _ map[string]any) (netctl.ProviderActionExecution, error) {
 	switch actionID {
 	case "test_connection":
 		status, err := p.Status(ctx, cfg)
@@ -0,0 +1,92 @@
+package wireguard
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const keyFile = "private.key"
+
+func generatePrivateKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	key[0] &= 248
+	key[31] = (key[31] & 127) | 64
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+func parseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(key) != 32 {
+		return nil, errors.New("must be a base64 encoded 32-byte key")
+	}
+	return key, nil
+}
+
+func publicKey(privateKey string) (string, error) {
+	raw, err := parseKey(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("private key %w", err)
+	}
+	key, err := ecdh.X25519().NewPrivateKey(raw)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
+
+// loadOrCreatePrivateKey returns the key generated for a bot, generating it
+// on first use.
+func loadOrCreatePrivateKey(stateDir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(stateDir, keyFile)) //nolint:gosec // stateDir is derived from the configured state root.
+	if err == nil {
+		key := strings.TrimSpace(string(data))
+		if _, err := parseKey(key); err != nil {
+			return "", fmt.Errorf("stored wireguard key %w", err)
+		}
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+	return rotatePrivateKey(stateDir)
+}
+
+// rotatePrivateKey replaces the key generated for a bot.
+func rotatePrivateKey(stateDir string) (string, error) {
+	key, err := generatePrivateKey()
+	if err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(filepath.Join(stateDir, keyFile), []byte(key+"\n")); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,134 @@
+package wireguard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	ctr "github.com/memohai/memoh/internal/container"
+	netctl "github.com/memohai/memoh/internal/network"
+	"github.com/memohai/memoh/internal/network/overlay/internal/sidecar"
+)
+
+const (
+	sidecarImage  = "lscr.io/linuxserver/wireguard:latest"
+	interfaceName = "wg0"
+	runMountPath  = "/run/memoh-wireguard"
+	dumpFile      = interfaceName + ".dump"
+)
+
+// upScript brings the interface up and keeps `wg show dump` output fresh in
+// the run directory, where the host reads it for status.
+const upScript = `wg-quick up ` + interfaceName + ` || exit 1
+trap 'wg-quick down ` + interfaceName + `; exit 0' TERM INT
+while true; do
+  wg show ` + interfaceName + ` dump > ` + runMountPath + `/` + dumpFile + `.tmp && mv ` + runMountPath + `/` + dumpFile + `.tmp ` + runMountPath + `/` + dumpFile + `
+  sleep 5 & wait $!
+done`
+
+type nativeDriver struct {
+	config  netctl.BotOverlayConfig
+	root    string
+	manager sidecar.Manager
+}
+
+func newNativeDriver(cfg netctl.BotOverlayConfig, runtime sidecar.Runtime, stateRoot string) *nativeDriver {
+	d := &nativeDriver{config: cfg, root: stateRoot}
+	d.manager = sidecar.Manager{
+		Kind:         "wireguard",
+		Runtime:      runtime,
+		BuildSpec:    d.buildSpec,
+		EnrichStatus: d.enrichStatus,
+	}
+	return d
+}
+
+func (*nativeDriver) Kind() string { return "wireguard" }
+
+func (d *nativeDriver) EnsureAttached(ctx context.Context, req netctl.AttachmentRequest) (netctl.OverlayStatus, error) {
+	if !d.config.Enabled {
+		return netctl.OverlayStatus{Provider: d.Kind(), State: "disabled"}, nil
+	}
+	return d.manager.EnsureAttached(ctx, req)
+}
+
+func (d *nativeDriver) Detach(ctx context.Context, req netctl.AttachmentRequest) error {
+	return d.manager.Detach(ctx, req)
+}
+
+func (d *nativeDriver) Status(ctx context.Context, req netctl.AttachmentRequest) (netctl.OverlayStatus, error) {
+	if !d.config.Enabled {
+		return netctl.OverlayStatus{Provider: d.Kind(), State: "disabled"}, nil
+	}
+	return d.manager.Status(ctx, req)
+}
+
+func (d *nativeDriver) buildSpec(req netctl.AttachmentRequest) (sidecar.Spec, error) {
+	iface, err := parseInterfaceConfig(d.config.Config)
+	if err != nil {
+		return sidecar.Spec{}, err
+	}
+	privateKey, err := d.privateKey(req.BotID, iface)
+	if err != nil {
+		return sidecar.Spec{}, err
+	}
+	pub, err := publicKey(privateKey)
+	if err != nil {
+		return sidecar.Spec{}, err
+	}
+	if err := os.MkdirAll(d.runDir(req.BotID), 0o750); err != nil {
+		return sidecar.Spec{}, err
+	}
+	rendered := iface.render(privateKey)
+	if err := writeFileAtomic(filepath.Join(d.configDir(req.BotID), interfaceName+".conf"), []byte(rendered)); err != nil {
+		return sidecar.Spec{}, err
+	}
+	// The rendered config is mounted, so its digest goes into the env to
+	// recreate the sidecar when peers or keys change.
+	digest := sha256.Sum256([]byte(rendered))
+	return sidecar.Spec{
+		Image: sidecarImage,
+		Cmd:   []string{"/bin/sh", "-c", upScript},
+		Env:   []string{"WG_CONFIG_DIGEST=" + hex.EncodeToString(digest[:8])},
+		Mounts: []ctr.MountSpec{{
+			Destination: "/etc/wireguard",
+			Type:        "bind",
+			Source:      d.configDir(req.BotID),
+			Options:     []string{"rbind", "ro"},
+		}, {
+			Destination: runMountPath,
+			Type:        "bind",
+			Source:      d.runDir(req.BotID),
+			Options:     []string{"rbind", "rw"},
+		}},
+		AddedCaps: []string{"CAP_NET_ADMIN"},
+		Details: map[string]any{
+			"interface":  interfaceName,
+			"public_key": pub,
+			"addresses":  joinPrefixes(iface.Addresses),
+			"state_dir":  d.stateDir(req.BotID),
+		},
+	}, nil
+}
+
+// privateKey returns the configured key, or the key generated for the bot.
+func (d *nativeDriver) privateKey(botID string, iface interfaceConfig) (string, error) {
+	if iface.PrivateKey != "" {
+		return iface.PrivateKey, nil
+	}
+	return loadOrCreatePrivateKey(d.stateDir(botID))
+}
+
+func (d *nativeDriver) stateDir(botID string) string {
+	return filepath.Join(d.root, botID, "wireguard")
+}
+
+func (d *nativeDriver) configDir(botID string) string {
+	return filepath.Join(d.stateDir(botID), "conf")
+}
+
+func (d *nativeDriver) runDir(botID string) string {
+	return filepath.Join(d.stateDir(botID), "run")
+}
@@ -0,0 +1,226 @@
+package wireguard
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/memohai/memoh/internal/network/overlay/internal/configutil"
+)
+
+type peer struct {
+	Name                string
+	PublicKey           string
+	PresharedKey        string
+	Endpoint            string
+	AllowedIPs          []netip.Prefix
+	PersistentKeepalive int
+}
+
+// interfaceConfig is the validated configuration of a bot's interface.
+type interfaceConfig struct {
+	Addresses      []netip.Prefix
+	PrivateKey     string
+	PublicEndpoint string
+	ListenPort     int
+	MTU            int
+	Peers          []peer
+}
+
+func parseInterfaceConfig(cfg map[string]any) (interfaceConfig, error) {
+	out := interfaceConfig{
+		PrivateKey:     configutil.String(cfg, "private_key"),
+		PublicEndpoint: configutil.String(cfg, "public_endpoint"),
+		ListenPort:     configutil.Int(cfg, "listen_port", 0),
+		MTU:            configutil.Int(cfg, "mtu", 0),
+	}
+	addresses, err := parsePrefixes(configutil.String(cfg, "address"))
+	if err != nil {
+		return interfaceConfig{}, fmt.Errorf("address: %w", err)
+	}
+	if len(addresses) == 0 {
+		return interfaceConfig{}, errors.New("address is required")
+	}
+	out.Addresses = addresses
+	if out.PrivateKey != "" {
+		if _, err := parseKey(out.PrivateKey); err != nil {
+			return interfaceConfig{}, fmt.Errorf("private_key %w", err)
+		}
+	}
+	if out.PublicEndpoint != "" {
+		if err := validateEndpoint(out.PublicEndpoint); err != nil {
+			return interfaceConfig{}, fmt.Errorf("public_endpoint: %w", err)
+		}
+	}
+	out.Peers, err = parsePeers(configutil.String(cfg, "peers"))
+	if err != nil {
+		return interfaceConfig{}, err
+	}
+	return out, nil
+}
+
+// parsePeers reads [Peer] sections in wg-quick format. Besides the wg-quick
+// keys a section may carry Name, which names the peer for ListNodes.
+func parsePeers(text string) ([]peer, error) {
+	var peers []peer
+	var current *peer
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.EqualFold(line, "[Peer]") {
+			peers = append(peers, peer{})
+			current = &peers[len(peers)-1]
+			continue
+		}
+		if current == nil {
+			return nil, fmt.Errorf("peers line %d: expected [Peer]", i+1)
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("peers line %d: expected Key = Value", i+1)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		var err error
+		switch strings.ToLower(key) {
+		case "name":
+			current.Name = value
+		case "publickey":
+			current.PublicKey = value
+			_, err = parseKey(value)
+		case "presharedkey":
+			current.PresharedKey = value
+			_, err = parseKey(value)
+		case "endpoint":
+			current.Endpoint = value
+			err = validateEndpoint(value)
+		case "allowedips":
+			var prefixes []netip.Prefix
+			prefixes, err = parsePrefixes(value)
+			current.AllowedIPs = append(current.AllowedIPs, prefixes...)
+		case "persistentkeepalive":
+			current.PersistentKeepalive, err = strconv.Atoi(value)
+			if err == nil && (current.PersistentKeepalive < 0 || current.PersistentKeepalive > 65535) {
+				err = errors.New("must be between 0 and 65535")
+			}
+		default:
+			err = errors.New("unknown key")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("peers line %d: %s: %w", i+1, key, err)
+		}
+	}
+	if len(peers) == 0 {
+		return nil, errors.New("peers: at least one [Peer] is required")
+	}
+	names := map[string]struct{}{}
+	keys := map[string]struct{}{}
+	for i := range peers {
+		p := &peers[i]
+		if p.PublicKey == "" {
+			return nil, fmt.Errorf("peers: peer %d has no PublicKey", i+1)
+		}
+		if p.Name == "" {
+			p.Name = fmt.Sprintf("peer-%d", i+1)
+		}
+		if _, exists := names[p.Name]; exists {
+			return nil, fmt.Errorf("peers: duplicate name %q", p.Name)
+		}
+		if _, exists := keys[p.PublicKey]; exists {
+			return nil, fmt.Errorf("peers: duplicate PublicKey of %q", p.Name)
+		}
+		names[p.Name] = struct{}{}
+		keys[p.PublicKey] = struct{}{}
+	}
+	return peers, nil
+}
+
+func parsePrefixes(value string) ([]netip.Prefix, error) {
+	var out []netip.Prefix
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(item)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid address %q", item)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		out = append(out, prefix)
+	}
+	return out, nil
+}
+
+func validateEndpoint(endpoint string) error {
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil || host == "" {
+		return errors.New("must be host:port")
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return errors.New("invalid port")
+	}
+	return nil
+}
+
+// render renders the wg-quick configuration of the bot's interface.
+func (c interfaceConfig) render(privateKey string) string {
+	var b strings.Builder
+	b.WriteString("[Interface]\n")
+	fmt.Fprintf(&b, "PrivateKey = %s\n", privateKey)
+	fmt.Fprintf(&b, "Address = %s\n", joinPrefixes(c.Addresses))
+	if c.ListenPort > 0 {
+		fmt.Fprintf(&b, "ListenPort = %d\n", c.ListenPort)
+	}
+	if c.MTU > 0 {
+		fmt.Fprintf(&b, "MTU = %d\n", c.MTU)
+	}
+	for _, p := range c.Peers {
+		fmt.Fprintf(&b, "\n# %s\n[Peer]\n", p.Name)
+		fmt.Fprintf(&b, "PublicKey = %s\n", p.PublicKey)
+		if p.PresharedKey != "" {
+			fmt.Fprintf(&b, "PresharedKey = %s\n", p.PresharedKey)
+		}
+		if p.Endpoint != "" {
+			fmt.Fprintf(&b, "Endpoint = %s\n", p.Endpoint)
+		}
+		if len(p.AllowedIPs) > 0 {
+			fmt.Fprintf(&b, "AllowedIPs = %s\n", joinPrefixes(p.AllowedIPs))
+		}
+		if p.PersistentKeepalive > 0 {
+			fmt.Fprintf(&b, "PersistentKeepalive = %d\n", p.PersistentKeepalive)
+		}
+	}
+	return b.String()
+}
+
+// renderPeer renders the [Peer] section other peers add to reach the bot.
+func (c interfaceConfig) renderPeer(name, publicKey string) string {
+	hosts := make([]netip.Prefix, 0, len(c.Addresses))
+	for _, address := range c.Addresses {
+		hosts = append(hosts, netip.PrefixFrom(address.Addr(), address.Addr().BitLen()))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n[Peer]\n", name)
+	fmt.Fprintf(&b, "PublicKey = %s\n", publicKey)
+	fmt.Fprintf(&b, "AllowedIPs = %s\n", joinPrefixes(hosts))
+	if c.PublicEndpoint != "" {
+		fmt.Fprintf(&b, "Endpoint = %s\n", c.PublicEndpoint)
+	}
+	return b.String()
+}
+
+func joinPrefixes(prefixes []netip.Prefix) string {
+	items := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		items = append(items, prefix.String())
+	}
+	return strings.Join(items, ", ")
+}
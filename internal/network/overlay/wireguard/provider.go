@@ -0,0 +1,231 @@
+package wireguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	netctl "github.com/memohai/memoh/internal/network"
+	"github.com/memohai/memoh/internal/network/overlay/internal/sidecar"
+)
+
+type Deps struct {
+	SidecarRuntime sidecar.Runtime
+	Runtime        netctl.RuntimeDescriptor
+	StateRoot      string
+}
+
+type Provider struct {
+	deps Deps
+}
+
+func NewProvider(deps Deps) netctl.Provider {
+	return &Provider{deps: deps}
+}
+
+func (*Provider) Kind() string { return "wireguard" }
+
+func (p *Provider) Descriptor() netctl.ProviderDescriptor {
+	return netctl.ProviderDescriptor{
+		Kind:         p.Kind(),
+		DisplayName:  "WireGuard",
+		Description:  "Plain WireGuard overlay for workspace networking, without a control plane.",
+		ConfigSchema: schema(),
+		Capabilities: netctl.ProviderCapabilities{
+			Mesh:          true,
+			NativeClient:  true,
+			SidecarWorker: true,
+		},
+		Actions: []netctl.ProviderAction{
+			{
+				ID:      "test_connection",
+				Type:    netctl.ActionTypeTestConnection,
+				Label:   "Test Connection",
+				Primary: true,
+			},
+			{
+				ID:          "export_peer_config",
+				Label:       "Export Peer Config",
+				Description: "Show the [Peer] section other peers add to reach this bot.",
+			},
+			{
+				ID:          "rotate_keys",
+				Label:       "Rotate Keys",
+				Description: "Generate a new key pair for this bot. Peers must be updated with the new public key.",
+			},
+		},
+	}
+}
+
+func (*Provider) NormalizeConfig(raw map[string]any) (map[string]any, error) {
+	config := netctl.NormalizeConfigBySchema(schema(), raw)
+	if err := netctl.ValidateConfigBySchema(schema(), config); err != nil {
+		return nil, fmt.Errorf("invalid wireguard config: %w", err)
+	}
+	if _, err := parseInterfaceConfig(config); err != nil {
+		return nil, fmt.Errorf("invalid wireguard config: %w", err)
+	}
+	return config, nil
+}
+
+func (p *Provider) Status(_ context.Context, cfg netctl.BotOverlayConfig) (netctl.ProviderStatus, error) {
+	if !cfg.Enabled {
+		return netctl.ProviderStatus{
+			State:       netctl.StatusStateNeedsConfig,
+			Title:       "Disabled",
+			Description: "This network provider is disabled.",
+		}, nil
+	}
+	if _, err := p.NormalizeConfig(cfg.Config); err != nil {
+		return netctl.ProviderStatus{
+			State:       netctl.StatusStateNeedsConfig,
+			Title:       "Config Required",
+			Description: err.Error(),
+		}, nil
+	}
+	return netctl.ProviderStatus{
+		State:       netctl.StatusStateReady,
+		Title:       "Ready",
+		Description: "Provider configuration is valid.",
+	}, nil
+}
+
+func (p *Provider) ExecuteAction(ctx context.Context, botID string, cfg netctl.BotOverlayConfig, actionID string, _ map[string]any) (netctl.ProviderActionExecution, error) {
+	switch actionID {
+	case "test_connection":
+		status, err := p.Status(ctx, cfg)
+		return netctl.ProviderActionExecution{
+			ActionID: actionID,
+			Status:   status,
+			Output: map[string]any{
+				"provider": cfg.Provider,
+			},
+		}, err
+	case "export_peer_config", "rotate_keys":
+		iface, err := parseInterfaceConfig(cfg.Config)
+		if err != nil {
+			return netctl.ProviderActionExecution{}, err
+		}
+		driver := newNativeDriver(cfg, p.deps.SidecarRuntime, p.stateRoot())
+		var privateKey string
+		if actionID == "rotate_keys" {
+			if iface.PrivateKey != "" {
+				return netctl.ProviderActionExecution{}, errors.New("private_key is set in the config; replace it there to rotate keys")
+			}
+			privateKey, err = rotatePrivateKey(driver.stateDir(botID))
+		} else {
+			privateKey, err = driver.privateKey(botID, iface)
+		}
+		if err != nil {
+			return netctl.ProviderActionExecution{}, err
+		}
+		pub, err := publicKey(privateKey)
+		if err != nil {
+			return netctl.ProviderActionExecution{}, err
+		}
+		status, err := p.Status(ctx, cfg)
+		if err != nil {
+			return netctl.ProviderActionExecution{}, err
+		}
+		return netctl.ProviderActionExecution{
+			ActionID: actionID,
+			Status:   status,
+			Output: map[string]any{
+				"public_key":  pub,
+				"peer_config": iface.renderPeer(botID, pub),
+			},
+			Reapply: actionID == "rotate_keys",
+		}, nil
+	default:
+		return netctl.ProviderActionExecution{}, fmt.Errorf("unsupported network action %q", actionID)
+	}
+}
+
+// ListNodes lists the configured peers by name, with their handshake and
+// transfer statistics once the interface is up.
+func (p *Provider) ListNodes(_ context.Context, botID string, cfg netctl.BotOverlayConfig) ([]netctl.NodeOption, error) {
+	iface, err := parseInterfaceConfig(cfg.Config)
+	if err != nil {
+		return nil, err
+	}
+	driver := newNativeDriver(cfg, p.deps.SidecarRuntime, p.stateRoot())
+	stats, statsErr := readDump(driver.dumpPath(botID))
+	now := time.Now()
+	items := make([]netctl.NodeOption, 0, len(iface.Peers))
+	for _, peer := range iface.Peers {
+		addresses := make([]string, 0, len(peer.AllowedIPs))
+		canExitNode := false
+		for _, prefix := range peer.AllowedIPs {
+			if prefix.Bits() == prefix.Addr().BitLen() {
+				addresses = append(addresses, prefix.Addr().String())
+			} else {
+				addresses = append(addresses, prefix.String())
+			}
+			canExitNode = canExitNode || prefix.Bits() == 0
+		}
+		details := map[string]any{
+			"public_key":  peer.PublicKey,
+			"allowed_ips": joinPrefixes(peer.AllowedIPs),
+		}
+		if peer.Endpoint != "" {
+			details["endpoint"] = peer.Endpoint
+		}
+		online := false
+		if statsErr == nil {
+			if peerStat, ok := stats.Peers[peer.PublicKey]; ok {
+				details = sidecar.MergeStatusDetails(details, peerStat.details())
+				online = peerStat.online(now)
+			}
+		}
+		items = append(items, netctl.NodeOption{
+			ID:          peer.PublicKey,
+			Value:       peer.Name,
+			DisplayName: peer.Name,
+			Description: peer.Endpoint,
+			Online:      online,
+			Addresses:   addresses,
+			CanExitNode: canExitNode,
+			Details:     details,
+		})
+	}
+	return items, nil
+}
+
+func (p *Provider) BuildDriver(cfg netctl.BotOverlayConfig) (netctl.OverlayDriver, error) {
+	config, err := p.NormalizeConfig(cfg.Config)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Config = config
+	if p.deps.Runtime.Capabilities.SidecarWorker {
+		return newNativeDriver(cfg, p.deps.SidecarRuntime, p.stateRoot()), nil
+	}
+	return unsupportedDriver{kind: p.Kind(), message: "WireGuard overlay is not supported by the current runtime backend."}, nil
+}
+
+func (p *Provider) stateRoot() string {
+	return filepath.Join(p.deps.StateRoot, "network")
+}
+
+type unsupportedDriver struct {
+	kind    string
+	message string
+}
+
+func (d unsupportedDriver) Kind() string { return d.kind }
+
+func (d unsupportedDriver) EnsureAttached(context.Context, netctl.AttachmentRequest) (netctl.OverlayStatus, error) {
+	return d.status(), nil
+}
+
+func (unsupportedDriver) Detach(context.Context, netctl.AttachmentRequest) error { return nil }
+
+func (d unsupportedDriver) Status(context.Context, netctl.AttachmentRequest) (netctl.OverlayStatus, error) {
+	return d.status(), nil
+}
+
+func (d unsupportedDriver) status() netctl.OverlayStatus {
+	return netctl.OverlayStatus{Provider: d.kind, State: "unsupported", Message: d.message}
+}
@@ -0,0 +1,195 @@
+package wireguard
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	netctl "github.com/memohai/memoh/internal/network"
+)
+
+func testKey(t *testing.T) (string, string) {
+	t.Helper()
+	private, err := generatePrivateKey()
+	if err != nil {
+		t.Fatalf("generatePrivateKey: %v", err)
+	}
+	public, err := publicKey(private)
+	if err != nil {
+		t.Fatalf("publicKey: %v", err)
+	}
+	return private, public
+}
+
+func testConfig(t *testing.T) (netctl.BotOverlayConfig, string) {
+	t.Helper()
+	_, officeKey := testKey(t)
+	_, exitKey := testKey(t)
+	peers := fmt.Sprintf(`[Peer]
+Name = office
+PublicKey = %s
+Endpoint = vpn.example.com:51820
+AllowedIPs = 10.8.0.1/32, 192.168.10.0/24
+PersistentKeepalive = 25
+
+[Peer]
+PublicKey = %s
+AllowedIPs = 0.0.0.0/0`, officeKey, exitKey)
+	return netctl.BotOverlayConfig{
+		Enabled:  true,
+		Provider: "wireguard",
+		Config: map[string]any{
+			"address":         "10.8.0.2/24",
+			"peers":           peers,
+			"public_endpoint": "bot.example.com:51820",
+		},
+	}, officeKey
+}
+
+func TestNormalizeConfigValidatesPeers(t *testing.T) {
+	provider := NewProvider(Deps{StateRoot: t.TempDir()})
+	cfg, _ := testConfig(t)
+	if _, err := provider.NormalizeConfig(cfg.Config); err != nil {
+		t.Fatalf("NormalizeConfig returned error: %v", err)
+	}
+
+	for name, peers := range map[string]string{
+		"missing section": "PublicKey = abc",
+		"bad key":         "[Peer]\nPublicKey = not-a-key",
+		"unknown key":     "[Peer]\nFoo = bar",
+		"bad endpoint":    "[Peer]\nEndpoint = example.com",
+		"no peers":        "# nothing",
+	} {
+		invalid := map[string]any{"address": "10.8.0.2/32", "peers": peers}
+		if _, err := provider.NormalizeConfig(invalid); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+
+	status, err := provider.Status(context.Background(), netctl.BotOverlayConfig{Enabled: true, Provider: "wireguard", Config: map[string]any{}})
+	if err != nil {
+		t.Fatalf("Status returned error: %v", err)
+	}
+	if status.State != netctl.StatusStateNeedsConfig {
+		t.Fatalf("expected needs config, got %s", status.State)
+	}
+}
+
+func TestNativeDriverBuildSpecGeneratesAndKeepsKey(t *testing.T) {
+	cfg, officeKey := testConfig(t)
+	driver := newNativeDriver(cfg, nil, t.TempDir())
+	req := netctl.AttachmentRequest{BotID: "bot-1"}
+
+	spec, err := driver.buildSpec(req)
+	if err != nil {
+		t.Fatalf("buildSpec returned error: %v", err)
+	}
+	again, err := driver.buildSpec(req)
+	if err != nil {
+		t.Fatalf("buildSpec returned error: %v", err)
+	}
+	if spec.Details["public_key"] == "" || spec.Details["public_key"] != again.Details["public_key"] {
+		t.Fatalf("generated key changed between builds: %v vs %v", spec.Details["public_key"], again.Details["public_key"])
+	}
+	if strings.Join(spec.Env, ",") != strings.Join(again.Env, ",") {
+		t.Fatalf("unchanged config must keep the sidecar: %v vs %v", spec.Env, again.Env)
+	}
+
+	conf, err := os.ReadFile(filepath.Join(driver.configDir("bot-1"), "wg0.conf"))
+	if err != nil {
+		t.Fatalf("read rendered config: %v", err)
+	}
+	for _, want := range []string{
+		"Address = 10.8.0.2/24",
+		"# office\n[Peer]\nPublicKey = " + officeKey,
+		"AllowedIPs = 10.8.0.1/32, 192.168.10.0/24",
+		"PersistentKeepalive = 25",
+		"# peer-2\n",
+	} {
+		if !strings.Contains(string(conf), want) {
+			t.Fatalf("rendered config is missing %q:\n%s", want, conf)
+		}
+	}
+	if strings.Contains(string(conf), "Name =") {
+		t.Fatalf("Name must not reach wg-quick:\n%s", conf)
+	}
+}
+
+func TestExecuteActionExportAndRotate(t *testing.T) {
+	root := t.TempDir()
+	provider := NewProvider(Deps{StateRoot: root})
+	cfg, _ := testConfig(t)
+	ctx := context.Background()
+
+	exported, err := provider.ExecuteAction(ctx, "bot-1", cfg, "export_peer_config", nil)
+	if err != nil {
+		t.Fatalf("export_peer_config returned error: %v", err)
+	}
+	peerConfig, _ := exported.Output["peer_config"].(string)
+	for _, want := range []string{"AllowedIPs = 10.8.0.2/32", "Endpoint = bot.example.com:51820", "PublicKey = " + exported.Output["public_key"].(string)} {
+		if !strings.Contains(peerConfig, want) {
+			t.Fatalf("peer config is missing %q:\n%s", want, peerConfig)
+		}
+	}
+	if exported.Reapply {
+		t.Fatal("export must not reapply the overlay")
+	}
+
+	rotated, err := provider.ExecuteAction(ctx, "bot-1", cfg, "rotate_keys", nil)
+	if err != nil {
+		t.Fatalf("rotate_keys returned error: %v", err)
+	}
+	if !rotated.Reapply || rotated.Output["public_key"] == exported.Output["public_key"] {
+		t.Fatalf("rotate_keys = %+v, exported key %v", rotated, exported.Output["public_key"])
+	}
+
+	private, _ := testKey(t)
+	cfg.Config["private_key"] = private
+	if _, err := provider.ExecuteAction(ctx, "bot-1", cfg, "rotate_keys", nil); err == nil {
+		t.Fatal("rotate_keys must refuse a configured private key")
+	}
+}
+
+func TestListNodesReportsPeerStats(t *testing.T) {
+	root := t.TempDir()
+	provider := NewProvider(Deps{StateRoot: root})
+	cfg, officeKey := testConfig(t)
+
+	nodes, err := provider.ListNodes(context.Background(), "bot-1", cfg)
+	if err != nil {
+		t.Fatalf("ListNodes returned error: %v", err)
+	}
+	if len(nodes) != 2 || nodes[0].Value != "office" || nodes[1].Value != "peer-2" {
+		t.Fatalf("unexpected nodes: %+v", nodes)
+	}
+	if nodes[0].Online || nodes[0].CanExitNode || !nodes[1].CanExitNode {
+		t.Fatalf("unexpected node flags: %+v", nodes)
+	}
+	if strings.Join(nodes[0].Addresses, ",") != "10.8.0.1,192.168.10.0/24" {
+		t.Fatalf("unexpected addresses: %v", nodes[0].Addresses)
+	}
+
+	dumpDir := filepath.Join(root, "network", "bot-1", "wireguard", "run")
+	if err := os.MkdirAll(dumpDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	dump := fmt.Sprintf("priv\tpub\t51820\toff\n%s\t(none)\t203.0.113.5:51820\t10.8.0.1/32\t%d\t1024\t2048\t25\n", officeKey, time.Now().Add(-time.Minute).Unix())
+	if err := os.WriteFile(filepath.Join(dumpDir, dumpFile), []byte(dump), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	nodes, err = provider.ListNodes(context.Background(), "bot-1", cfg)
+	if err != nil {
+		t.Fatalf("ListNodes returned error: %v", err)
+	}
+	office := nodes[0]
+	if !office.Online || office.Details["transfer_rx"] != int64(1024) || office.Details["endpoint"] != "203.0.113.5:51820" {
+		t.Fatalf("unexpected office node: %+v", office)
+	}
+	if nodes[1].Online {
+		t.Fatalf("peer without handshake must be offline: %+v", nodes[1])
+	}
+}
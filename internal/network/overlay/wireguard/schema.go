@@ -0,0 +1,20 @@
+package wireguard
+
+import netctl "github.com/memohai/memoh/internal/network"
+
+func schema() netctl.ConfigSchema {
+	minPort, maxPort := float64(1), float64(65535)
+	minMTU, maxMTU := float64(1280), float64(9000)
+	return netctl.ConfigSchema{
+		Version: 1,
+		Title:   "WireGuard",
+		Fields: []netctl.ConfigField{
+			{Key: "address", Type: netctl.FieldTypeString, Required: true, Title: "Address", Description: "Interface address of this bot, comma separated for several.", Placeholder: "10.8.0.2/32", Order: 1},
+			{Key: "peers", Type: netctl.FieldTypeTextarea, Required: true, Title: "Peers", Description: "[Peer] sections in wg-quick format. An optional Name = line names the peer.", Placeholder: "[Peer]\nName = office\nPublicKey = ...\nEndpoint = vpn.example.com:51820\nAllowedIPs = 10.8.0.1/32\nPersistentKeepalive = 25", Multiline: true, Order: 2},
+			{Key: "private_key", Type: netctl.FieldTypeSecret, Title: "Private Key", Collapsed: true, Description: "Leave empty to generate a key for this bot.", Order: 10},
+			{Key: "public_endpoint", Type: netctl.FieldTypeString, Title: "Public Endpoint", Collapsed: true, Description: "host:port at which peers reach this bot, if any.", Order: 11},
+			{Key: "listen_port", Type: netctl.FieldTypeNumber, Title: "Listen Port", Collapsed: true, Constraint: &netctl.ConfigConstraint{Min: &minPort, Max: &maxPort}, Order: 12},
+			{Key: "mtu", Type: netctl.FieldTypeNumber, Title: "MTU", Collapsed: true, Constraint: &netctl.ConfigConstraint{Min: &minMTU, Max: &maxMTU}, Order: 13},
+		},
+	}
+}
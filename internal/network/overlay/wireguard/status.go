@@ -0,0 +1,143 @@
+package wireguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	netctl "github.com/memohai/memoh/internal/network"
+	"github.com/memohai/memoh/internal/network/overlay/internal/sidecar"
+)
+
+const (
+	// A session is rekeyed every two minutes while in use, so a peer whose
+	// last handshake is older than this has no live session.
+	handshakeTimeout = 3 * time.Minute
+	// The sidecar rewrites the dump every few seconds.
+	dumpStaleAfter = 30 * time.Second
+)
+
+type peerStats struct {
+	Endpoint        string
+	LatestHandshake time.Time
+	TransferRx      int64
+	TransferTx      int64
+}
+
+type interfaceStats struct {
+	PublicKey  string
+	ListenPort int
+	Peers      map[string]peerStats
+	UpdatedAt  time.Time
+}
+
+// readDump reads the `wg show <interface> dump` output the sidecar writes.
+func readDump(path string) (interfaceStats, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return interfaceStats{}, err
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // path is derived from the configured state root.
+	if err != nil {
+		return interfaceStats{}, err
+	}
+	stats, err := parseDump(string(data))
+	if err != nil {
+		return interfaceStats{}, err
+	}
+	stats.UpdatedAt = info.ModTime()
+	return stats, nil
+}
+
+func parseDump(dump string) (interfaceStats, error) {
+	lines := strings.Split(strings.TrimSpace(dump), "\n")
+	head := strings.Split(lines[0], "\t")
+	if len(head) < 3 {
+		return interfaceStats{}, errors.New("wireguard dump: missing interface line")
+	}
+	stats := interfaceStats{PublicKey: head[1], Peers: map[string]peerStats{}}
+	stats.ListenPort, _ = strconv.Atoi(head[2])
+	for _, line := range lines[1:] {
+		fields := strings.Split(line, "\t")
+		if len(fields) < 7 {
+			return interfaceStats{}, fmt.Errorf("wireguard dump: malformed peer line %q", line)
+		}
+		stat := peerStats{}
+		if fields[2] != "(none)" {
+			stat.Endpoint = fields[2]
+		}
+		if seconds, _ := strconv.ParseInt(fields[4], 10, 64); seconds > 0 {
+			stat.LatestHandshake = time.Unix(seconds, 0)
+		}
+		stat.TransferRx, _ = strconv.ParseInt(fields[5], 10, 64)
+		stat.TransferTx, _ = strconv.ParseInt(fields[6], 10, 64)
+		stats.Peers[fields[0]] = stat
+	}
+	return stats, nil
+}
+
+func (s peerStats) online(now time.Time) bool {
+	return !s.LatestHandshake.IsZero() && now.Sub(s.LatestHandshake) < handshakeTimeout
+}
+
+func (s peerStats) details() map[string]any {
+	details := map[string]any{
+		"transfer_rx": s.TransferRx,
+		"transfer_tx": s.TransferTx,
+	}
+	if s.Endpoint != "" {
+		details["endpoint"] = s.Endpoint
+	}
+	if !s.LatestHandshake.IsZero() {
+		details["latest_handshake"] = s.LatestHandshake.UTC().Format(time.RFC3339)
+	}
+	return details
+}
+
+func (d *nativeDriver) enrichStatus(_ context.Context, req netctl.AttachmentRequest, status *netctl.OverlayStatus) {
+	iface, err := parseInterfaceConfig(d.config.Config)
+	if err == nil && len(iface.Addresses) > 0 {
+		status.NetworkIP = iface.Addresses[0].Addr().String()
+	}
+	stats, err := readDump(d.dumpPath(req.BotID))
+	if err != nil {
+		status.Details = sidecar.MergeStatusDetails(status.Details, map[string]any{
+			"sidecar_status": "status_unavailable",
+		})
+		status.State = "starting"
+		status.Message = "WireGuard interface is starting and has not reported statistics yet."
+		return
+	}
+	now := time.Now()
+	peers := make([]map[string]any, 0, len(iface.Peers))
+	connected := 0
+	for _, p := range iface.Peers {
+		entry := map[string]any{"name": p.Name, "public_key": p.PublicKey}
+		if peerStat, ok := stats.Peers[p.PublicKey]; ok {
+			entry = sidecar.MergeStatusDetails(entry, peerStat.details())
+			if peerStat.online(now) {
+				connected++
+			}
+		}
+		peers = append(peers, entry)
+	}
+	status.Details = sidecar.MergeStatusDetails(status.Details, map[string]any{
+		"listen_port":     stats.ListenPort,
+		"peers":           peers,
+		"connected_peers": connected,
+		"stats_updated":   stats.UpdatedAt.UTC().Format(time.RFC3339),
+	})
+	if now.Sub(stats.UpdatedAt) > dumpStaleAfter {
+		status.State = "degraded"
+		status.Message = "WireGuard statistics are stale; the interface may be down."
+	}
+}
+
+func (d *nativeDriver) dumpPath(botID string) string {
+	return filepath.Join(d.runDir(botID), dumpFile)
+}
@@ -114,6 +114,9 @@ type ProviderActionExecution struct {
 	ActionID string         `json:"action_id"`
 	Status   ProviderStatus `json:"status"`
 	Output   map[string]any `json:"output,omitempty"`
+	// Reapply asks the service to re-attach the overlay because the action
+	// changed state the running sidecar depends on, such as its keys.
+	Reapply bool `json:"-"`
 }
 
 func NormalizeConfigBySchema(schema ConfigSchema, raw map[string]any) map[string]any {
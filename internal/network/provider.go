@@ -10,7 +10,7 @@ type Provider interface {
 	Descriptor() ProviderDescriptor
 	NormalizeConfig(raw map[string]any) (map[string]any, error)
 	Status(ctx context.Context, cfg BotOverlayConfig) (ProviderStatus, error)
-	ExecuteAction(ctx context.Context, cfg BotOverlayConfig, actionID string, input map[string]any) (ProviderActionExecution, error)
+	ExecuteAction(ctx context.Context, botID string, cfg BotOverlayConfig, actionID string, input map[string]any) (ProviderActionExecution, error)
 	ListNodes(ctx context.Context, botID string, cfg BotOverlayConfig) ([]NodeOption, error)
 	BuildDriver(cfg BotOverlayConfig) (OverlayDriver, error)
 }
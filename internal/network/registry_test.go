@@ -22,7 +22,7 @@ func (testProvider) Status(_ context.Context, _ BotOverlayConfig) (ProviderStatu
 	return ProviderStatus{State: StatusStateReady}, nil
 }
 
-func (testProvider) ExecuteAction(_ context.Context, _ BotOverlayConfig, _ string, _ map[string]any) (ProviderActionExecution, error) {
+func (testProvider) ExecuteAction(_ context.Context, _ string, _ BotOverlayConfig, _ string, _ map[string]any) (ProviderActionExecution, error) {
 	return ProviderActionExecution{}, nil
 }
 
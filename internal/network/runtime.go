@@ -1,6 +1,10 @@
 package network
 
-import "context"
+import (
+	"context"
+
+	"github.com/memohai/memoh/internal/network/egress"
+)
 
 // Runtime is the behavioral surface exposed by a container/runtime adapter to
 // the network controller.
@@ -12,6 +16,13 @@ type Runtime interface {
 	StatusNetwork(ctx context.Context, req RuntimeNetworkRequest) (RuntimeNetworkStatus, error)
 }
 
+// FirewallRuntime is implemented by runtimes that can filter the egress of a
+// workspace network namespace.
+type FirewallRuntime interface {
+	ApplyEgressFirewall(ctx context.Context, req RuntimeNetworkRequest, rules egress.Ruleset) error
+	RemoveEgressFirewall(ctx context.Context, req RuntimeNetworkRequest) error
+}
+
 // RuntimeDescriptor is the read-only metadata exported by a runtime adapter.
 type RuntimeDescriptor struct {
 	Kind         string              `json:"kind"`
@@ -29,4 +40,5 @@ type RuntimeCapabilities struct {
 	Devices              bool `json:"devices"`
 	Capabilities         bool `json:"capabilities"`
 	Privileged           bool `json:"privileged"`
+	EgressFirewall       bool `json:"egress_firewall"`
 }
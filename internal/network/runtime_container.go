@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	ctr "github.com/memohai/memoh/internal/container"
+	"github.com/memohai/memoh/internal/network/egress"
 )
 
 type containerRuntimeService interface {
@@ -52,6 +53,7 @@ func (r *containerRuntime) EnsureNetwork(ctx context.Context, req RuntimeNetwork
 	return RuntimeNetworkStatus{
 		Attached: ip != "",
 		IP:       ip,
+		Gateway:  strings.TrimSpace(result.Gateway),
 	}, nil
 }
 
@@ -84,6 +86,36 @@ func (r *containerRuntime) StatusNetwork(ctx context.Context, req RuntimeNetwork
 	return RuntimeNetworkStatus{Attached: true}, nil
 }
 
+func (r *containerRuntime) ApplyEgressFirewall(ctx context.Context, req RuntimeNetworkRequest, rules egress.Ruleset) error {
+	svc, ok := r.svc.(ctr.FirewallService)
+	if !ok {
+		return ErrNotSupported
+	}
+	return svc.ApplyEgressFirewall(ctx, ctr.NetworkRequest{
+		ContainerID: req.ContainerID,
+		JoinTarget: ctr.NetworkJoinTarget{
+			Kind:  req.JoinTarget.Kind,
+			Value: req.JoinTarget.Path,
+			PID:   req.JoinTarget.PID,
+		},
+	}, ctr.EgressFirewallRules{IPv4: rules.IPv4, IPv6: rules.IPv6})
+}
+
+func (r *containerRuntime) RemoveEgressFirewall(ctx context.Context, req RuntimeNetworkRequest) error {
+	svc, ok := r.svc.(ctr.FirewallService)
+	if !ok {
+		return ErrNotSupported
+	}
+	return svc.RemoveEgressFirewall(ctx, ctr.NetworkRequest{
+		ContainerID: req.ContainerID,
+		JoinTarget: ctr.NetworkJoinTarget{
+			Kind:  req.JoinTarget.Kind,
+			Value: req.JoinTarget.Path,
+			PID:   req.JoinTarget.PID,
+		},
+	})
+}
+
 func descriptorForBackend(backend string) RuntimeDescriptor {
 	switch normalizeKind(backend) {
 	case "", "containerd":
@@ -98,6 +130,7 @@ func descriptorForBackend(backend string) RuntimeDescriptor {
 				Devices:             true,
 				Capabilities:        true,
 				Privileged:          true,
+				EgressFirewall:      true,
 			},
 		}
 	case "docker":
@@ -108,6 +141,14 @@ func descriptorForBackend(backend string) RuntimeDescriptor {
 				JoinContainerNetwork: true,
 			},
 		}
+	case "podman":
+		return RuntimeDescriptor{
+			Kind:        "podman",
+			DisplayName: "Podman",
+			Capabilities: RuntimeCapabilities{
+				JoinContainerNetwork: true,
+			},
+		}
 	case "apple":
 		return RuntimeDescriptor{
 			Kind:         "apple",
@@ -28,6 +28,12 @@ func TestDescriptorForBackendCapabilities(t *testing.T) {
 			wantKind:          "docker",
 			wantJoinContainer: true,
 		},
+		{
+			name:              "podman",
+			backend:           "podman",
+			wantKind:          "podman",
+			wantJoinContainer: true,
+		},
 		{
 			name:     "apple",
 			backend:  "apple",
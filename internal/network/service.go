@@ -8,9 +8,12 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
+	"github.com/memohai/memoh/internal/audit"
 	ctr "github.com/memohai/memoh/internal/container"
 	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/network/egress"
 )
 
 type statusRuntime interface {
@@ -29,6 +32,12 @@ type Service struct {
 	cniConfDir       string
 	networkStateRoot string
 	logger           *slog.Logger
+	auditLog         *audit.Service
+
+	egressMu      sync.Mutex
+	egressProxy   *egress.Proxy
+	egressRunRoot string
+	egressProxies map[string]string // bot ID → proxy URL of enforced workspaces
 }
 
 func NewService(log *slog.Logger, queries dbstore.Queries, registry *Registry, runtime statusRuntime, runtimeKind, cniBinDir, cniConfDir, networkStateRoot string) *Service {
@@ -51,6 +60,11 @@ func (s *Service) SetController(ctrl Controller) {
 	s.controller = ctrl
 }
 
+// SetAuditLog records egress policy changes to the audit log.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
 func (s *Service) ListMeta(_ context.Context) []ProviderDescriptor {
 	if s.registry == nil {
 		return nil
@@ -82,6 +96,15 @@ func (s *Service) PrepareBotConfigForWrite(cfg BotOverlayConfig) (BotOverlayConf
 
 func (s *Service) StatusBot(ctx context.Context, botID string) (BotStatus, error) {
 	ws := s.workspaceRuntimeStatus(ctx, botID)
+	status, err := s.statusBot(ctx, botID, ws)
+	if err != nil {
+		return BotStatus{}, err
+	}
+	status.Egress = s.egressStatus(ctx, botID, ws)
+	return status, nil
+}
+
+func (s *Service) statusBot(ctx context.Context, botID string, ws WorkspaceRuntimeStatus) (BotStatus, error) {
 	cfg, err := s.GetBotConfig(ctx, botID)
 	if err != nil {
 		return BotStatus{}, err
@@ -161,9 +184,16 @@ func (s *Service) ExecuteActionBot(ctx context.Context, botID, actionID string,
 		return s.executeLogout(ctx, botID, cfg)
 	}
 	if actionID != "test_connection" {
-		return provider.ExecuteAction(ctx, cfg, actionID, input)
+		providerExec, err := provider.ExecuteAction(ctx, botID, cfg, actionID, input)
+		if err != nil || !providerExec.Reapply {
+			return providerExec, err
+		}
+		if _, ensureErr := s.ensureOverlayBot(ctx, botID, cfg); ensureErr != nil && !errors.Is(ensureErr, ErrWorkspaceContainerMissing) {
+			return providerExec, ensureErr
+		}
+		return providerExec, nil
 	}
-	providerExec, err := provider.ExecuteAction(ctx, cfg, actionID, input)
+	providerExec, err := provider.ExecuteAction(ctx, botID, cfg, actionID, input)
 	if err != nil {
 		return providerExec, err
 	}
@@ -22,7 +22,7 @@ func (validatingProvider) Status(context.Context, BotOverlayConfig) (ProviderSta
 	return ProviderStatus{State: StatusStateReady}, nil
 }
 
-func (validatingProvider) ExecuteAction(context.Context, BotOverlayConfig, string, map[string]any) (ProviderActionExecution, error) {
+func (validatingProvider) ExecuteAction(context.Context, string, BotOverlayConfig, string, map[string]any) (ProviderActionExecution, error) {
 	return ProviderActionExecution{}, nil
 }
 
@@ -28,6 +28,7 @@ type BotStatus struct {
 	ProxyAddress string                  `json:"proxy_address,omitempty"`
 	Details      map[string]any          `json:"details,omitempty"`
 	Workspace    *WorkspaceRuntimeStatus `json:"workspace,omitempty"`
+	Egress       *EgressStatus           `json:"egress,omitempty"`
 }
 
 type NodeOption struct {
@@ -60,6 +61,10 @@ type AttachmentRequest struct {
 	Runtime     RuntimeNetworkRequest
 	Overlay     BotOverlayConfig
 	OverlayOnly bool // When true, skip runtime network setup/teardown (overlay-only mutation).
+	// EgressProxy is the proxy URL, with credentials, an overlay must send its
+	// own traffic through while the bot's egress policy is enforced. It is
+	// only set for providers with the EgressProxy capability.
+	EgressProxy string
 }
 
 // AttachmentStatus reports the outcome of runtime network and overlay
@@ -87,6 +92,7 @@ type NetworkJoinTarget struct {
 type RuntimeNetworkStatus struct {
 	Attached bool
 	IP       string
+	Gateway  string
 }
 
 type OverlayStatus struct {
@@ -107,7 +107,8 @@ func (s *Service) buildAttachmentRequest(ctx context.Context, botID string, cfg
 		Runtime: RuntimeNetworkRequest{
 			ContainerID: row.ContainerID,
 		},
-		Overlay: cfg,
+		Overlay:     cfg,
+		EgressProxy: s.egressProxyURL(botID),
 	}
 	if s.runtime == nil {
 		return req, nil
@@ -0,0 +1,154 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval stops a stream of tokens with unknown key IDs from
+// hammering the JWKS endpoint.
+const minRefreshInterval = 30 * time.Second
+
+var supportedAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwksDocument struct {
+	Keys []jwk `json:"keys"`
+}
+
+// keySet caches the issuer's signing keys and refetches them when a token
+// names a key ID it has not seen, which is how providers roll keys.
+type keySet struct {
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	uri       string
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func newKeySet(httpClient *http.Client) *keySet {
+	return &keySet{httpClient: httpClient}
+}
+
+func (s *keySet) key(ctx context.Context, uri, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.uri == uri {
+		if key, ok := s.lookupLocked(kid); ok {
+			return key, nil
+		}
+		if time.Since(s.fetchedAt) < minRefreshInterval {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+	}
+	var doc jwksDocument
+	if err := getJSON(ctx, s.httpClient, uri, &doc); err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+	s.uri = uri
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	if key, ok := s.lookupLocked(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupLocked finds kid, or the only key when the token carries no kid.
+func (s *keySet) lookupLocked(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("rsa exponent out of range")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) { //nolint:staticcheck // ecdsa.PublicKey still uses big.Int coordinates
+			return nil, errors.New("ec point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, errors.New("empty key component")
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
@@ -0,0 +1,245 @@
+// Package oidctest runs an in-process OpenID Connect issuer for tests. It
+// serves discovery, JWKS, an authorization endpoint that immediately
+// "logs in" the configured user, and a token endpoint that enforces PKCE.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type authorization struct {
+	clientID      string
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+	claims        jwt.MapClaims
+}
+
+// Issuer is a fake identity provider backed by an httptest.Server.
+type Issuer struct {
+	URL      string
+	ClientID string
+
+	server *httptest.Server
+
+	mu     sync.Mutex
+	key    *rsa.PrivateKey
+	kid    string
+	user   jwt.MapClaims
+	codes  map[string]authorization
+	serial int
+}
+
+// NewIssuer starts an issuer that accepts clientID. The default user has
+// subject "user-1"; change it with SetUser.
+func NewIssuer(t testing.TB, clientID string) *Issuer {
+	t.Helper()
+	iss := &Issuer{
+		ClientID: clientID,
+		codes:    map[string]authorization{},
+		user:     jwt.MapClaims{"sub": "user-1"},
+	}
+	iss.RotateKey(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", iss.handleDiscovery)
+	mux.HandleFunc("GET /jwks", iss.handleJWKS)
+	mux.HandleFunc("GET /authorize", iss.handleAuthorize)
+	mux.HandleFunc("POST /token", iss.handleToken)
+	iss.server = httptest.NewServer(mux)
+	iss.URL = iss.server.URL
+	t.Cleanup(iss.server.Close)
+	return iss
+}
+
+// Client returns an HTTP client for the issuer's server.
+func (i *Issuer) Client() *http.Client {
+	return i.server.Client()
+}
+
+// SetUser sets the claims (beyond iss/aud/exp/iat/nonce) put into ID tokens
+// issued by later logins.
+func (i *Issuer) SetUser(claims map[string]any) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.user = jwt.MapClaims(claims)
+}
+
+// RotateKey replaces the signing key with a fresh one under a new key ID.
+func (i *Issuer) RotateKey(t testing.TB) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.serial++
+	i.key = key
+	i.kid = fmt.Sprintf("key-%d", i.serial)
+}
+
+// Sign signs claims with the current key, for tests that craft tokens.
+func (i *Issuer) Sign(t testing.TB, claims jwt.MapClaims) string {
+	t.Helper()
+	i.mu.Lock()
+	key, kid := i.key, i.kid
+	i.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign id token: %v", err)
+	}
+	return signed
+}
+
+// Login follows authURL the way a browser would after the user signs in and
+// returns the code and state the issuer redirects back with.
+func (i *Issuer) Login(t testing.TB, authURL string) (code, state string) {
+	t.Helper()
+	client := *i.server.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resp, err := client.Get(authURL)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize: status %d", resp.StatusCode)
+	}
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("authorize: bad redirect: %v", err)
+	}
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func (i *Issuer) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                i.URL,
+		"authorization_endpoint":                i.URL + "/authorize",
+		"token_endpoint":                        i.URL + "/token",
+		"jwks_uri":                              i.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (i *Issuer) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	i.mu.Lock()
+	pub, kid := i.key.PublicKey, i.kid
+	i.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"alg": "RS256",
+		"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}}})
+}
+
+func (i *Issuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("response_type") != "code" || q.Get("client_id") != i.ClientID || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirect.Scheme == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	code := rand.Text()
+	i.mu.Lock()
+	claims := jwt.MapClaims{}
+	for k, v := range i.user {
+		claims[k] = v
+	}
+	i.codes[code] = authorization{
+		clientID:      q.Get("client_id"),
+		redirectURI:   q.Get("redirect_uri"),
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+		claims:        claims,
+	}
+	i.mu.Unlock()
+
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (i *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	code := r.PostForm.Get("code")
+	i.mu.Lock()
+	auth, ok := i.codes[code]
+	delete(i.codes, code)
+	i.mu.Unlock()
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("client_id") != auth.clientID ||
+		r.PostForm.Get("redirect_uri") != auth.redirectURI {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != auth.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "PKCE verification failed"})
+		return
+	}
+
+	now := time.Now()
+	claims := auth.claims
+	claims["iss"] = i.URL
+	claims["aud"] = auth.clientID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(5 * time.Minute).Unix()
+	if auth.nonce != "" {
+		claims["nonce"] = auth.nonce
+	}
+	i.mu.Lock()
+	key, kid := i.key, i.kid
+	i.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "fake-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     signed,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,452 @@
+// Package oidc implements OpenID Connect single sign-on: discovery, the
+// authorization code flow with PKCE, and ID token validation against the
+// issuer's JWKS. It only proves who the user is; accounts.Service decides
+// which local account that identity maps to.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/memohai/memoh/internal/config"
+)
+
+const (
+	// pendingTTL bounds how long a user may spend at the identity provider
+	// between starting a login and returning with a code.
+	pendingTTL = 10 * time.Minute
+	// discoveryTTL controls how often the discovery document is refreshed.
+	discoveryTTL = time.Hour
+	// clockSkew is tolerated on exp/iat/nbf.
+	clockSkew = time.Minute
+)
+
+var (
+	ErrNotConfigured = errors.New("oidc is not configured")
+	ErrInvalidState  = errors.New("login request expired or unknown")
+	ErrNotAllowed    = errors.New("user is not in an allowed group")
+)
+
+// Identity is the user asserted by a validated ID token.
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Username      string
+	Picture       string
+	Groups        []string
+}
+
+// AuthRequest is a started login. The browser is sent to URL and must
+// return State unchanged.
+type AuthRequest struct {
+	URL   string
+	State string
+}
+
+// Discovery is the subset of the provider metadata document that is used.
+type Discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint,omitempty"`
+}
+
+type pendingLogin struct {
+	nonce        string
+	codeVerifier string
+	expiresAt    time.Time
+}
+
+// Provider runs logins against one configured issuer. Pending logins are
+// kept in memory, so a login must complete on the instance that started it.
+type Provider struct {
+	cfg        config.OIDCConfig
+	httpClient *http.Client
+	logger     *slog.Logger
+	keys       *keySet
+	now        func() time.Time
+
+	mu           sync.Mutex
+	discovery    *Discovery
+	discoveredAt time.Time
+	pending      map[string]pendingLogin
+}
+
+// NewProvider returns nil when OIDC is disabled so callers can treat a nil
+// provider as "local accounts only".
+func NewProvider(log *slog.Logger, cfg config.OIDCConfig, httpClient *http.Client) (*Provider, error) {
+	if !cfg.Enabled {
+		return nil, nil //nolint:nilnil // disabled is not an error
+	}
+	cfg.Issuer = strings.TrimSpace(cfg.Issuer)
+	cfg.ClientID = strings.TrimSpace(cfg.ClientID)
+	cfg.RedirectURL = strings.TrimSpace(cfg.RedirectURL)
+	if cfg.Issuer == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+		return nil, errors.New("oidc: issuer, client_id and redirect_url are required")
+	}
+	if _, err := url.ParseRequestURI(cfg.RedirectURL); err != nil {
+		return nil, fmt.Errorf("oidc: invalid redirect_url: %w", err)
+	}
+	if log == nil {
+		log = slog.Default()
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	p := &Provider{
+		cfg:        cfg,
+		httpClient: httpClient,
+		logger:     log.With(slog.String("service", "oidc")),
+		now:        time.Now,
+		pending:    map[string]pendingLogin{},
+	}
+	p.keys = newKeySet(httpClient)
+	return p, nil
+}
+
+// Config returns the effective configuration.
+func (p *Provider) Config() config.OIDCConfig {
+	return p.cfg
+}
+
+// Discover fetches (or returns the cached) provider metadata. The document
+// must name the configured issuer.
+func (p *Provider) Discover(ctx context.Context) (*Discovery, error) {
+	p.mu.Lock()
+	if p.discovery != nil && p.now().Sub(p.discoveredAt) < discoveryTTL {
+		doc := p.discovery
+		p.mu.Unlock()
+		return doc, nil
+	}
+	p.mu.Unlock()
+
+	wellKnown := strings.TrimRight(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	var doc Discovery
+	if err := p.getJSON(ctx, wellKnown, &doc); err != nil {
+		return nil, fmt.Errorf("oidc discovery: %w", err)
+	}
+	if strings.TrimRight(doc.Issuer, "/") != strings.TrimRight(p.cfg.Issuer, "/") {
+		return nil, fmt.Errorf("oidc discovery: issuer mismatch: got %q, want %q", doc.Issuer, p.cfg.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("oidc discovery: authorization_endpoint, token_endpoint and jwks_uri are required")
+	}
+
+	p.mu.Lock()
+	p.discovery = &doc
+	p.discoveredAt = p.now()
+	p.mu.Unlock()
+	return &doc, nil
+}
+
+// Start begins an authorization code login with PKCE (S256) and a nonce.
+func (p *Provider) Start(ctx context.Context) (AuthRequest, error) {
+	doc, err := p.Discover(ctx)
+	if err != nil {
+		return AuthRequest{}, err
+	}
+	state, err := randomToken()
+	if err != nil {
+		return AuthRequest{}, err
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return AuthRequest{}, err
+	}
+	verifier, err := randomToken()
+	if err != nil {
+		return AuthRequest{}, err
+	}
+
+	authURL, err := url.Parse(doc.AuthorizationEndpoint)
+	if err != nil {
+		return AuthRequest{}, fmt.Errorf("oidc: invalid authorization_endpoint: %w", err)
+	}
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", strings.Join(p.cfg.ScopesOrDefault(), " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge(verifier))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	p.mu.Lock()
+	p.prunePendingLocked()
+	p.pending[state] = pendingLogin{nonce: nonce, codeVerifier: verifier, expiresAt: p.now().Add(pendingTTL)}
+	p.mu.Unlock()
+
+	return AuthRequest{URL: authURL.String(), State: state}, nil
+}
+
+// Exchange completes a login started by Start: it redeems code at the token
+// endpoint and validates the returned ID token. A state can be used once.
+func (p *Provider) Exchange(ctx context.Context, state, code string) (Identity, error) {
+	state = strings.TrimSpace(state)
+	code = strings.TrimSpace(code)
+	if state == "" || code == "" {
+		return Identity{}, ErrInvalidState
+	}
+	p.mu.Lock()
+	pending, ok := p.pending[state]
+	delete(p.pending, state)
+	p.mu.Unlock()
+	if !ok || p.now().After(pending.expiresAt) {
+		return Identity{}, ErrInvalidState
+	}
+
+	doc, err := p.Discover(ctx)
+	if err != nil {
+		return Identity{}, err
+	}
+	rawIDToken, err := p.redeemCode(ctx, doc.TokenEndpoint, code, pending.codeVerifier)
+	if err != nil {
+		return Identity{}, err
+	}
+	claims, err := p.VerifyIDToken(ctx, rawIDToken, pending.nonce)
+	if err != nil {
+		return Identity{}, err
+	}
+	return p.identityFromClaims(claims), nil
+}
+
+// VerifyIDToken checks the signature against the issuer's JWKS and the
+// iss, aud, azp, exp, iat and nonce claims.
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (jwt.MapClaims, error) {
+	doc, err := p.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.keys.key(ctx, doc.JWKSURI, kid)
+	},
+		jwt.WithValidMethods(supportedAlgorithms),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+		jwt.WithTimeFunc(p.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: invalid id token: %w", err)
+	}
+	if aud, _ := claims.GetAudience(); len(aud) > 1 {
+		if azp, _ := claims["azp"].(string); azp != p.cfg.ClientID {
+			return nil, errors.New("oidc: invalid id token: azp does not match client_id")
+		}
+	}
+	if got, _ := claims["nonce"].(string); got == "" || got != nonce {
+		return nil, errors.New("oidc: invalid id token: nonce mismatch")
+	}
+	return claims, nil
+}
+
+// Role maps the identity's groups onto the local admin/member roles.
+func (p *Provider) Role(identity Identity) (string, error) {
+	return MapRole(identity.Groups, p.cfg.AdminGroups, p.cfg.AllowedGroups)
+}
+
+// MapRole returns "admin" for members of adminGroups and "member" for
+// everyone else. When allowedGroups is non-empty, users in neither list get
+// ErrNotAllowed. Group names compare case-insensitively.
+func MapRole(groups, adminGroups, allowedGroups []string) (string, error) {
+	if intersects(groups, adminGroups) {
+		return "admin", nil
+	}
+	if len(allowedGroups) > 0 && !intersects(groups, allowedGroups) {
+		return "", ErrNotAllowed
+	}
+	return "member", nil
+}
+
+func intersects(values, set []string) bool {
+	for _, v := range values {
+		for _, s := range set {
+			if strings.EqualFold(strings.TrimSpace(v), strings.TrimSpace(s)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *Provider) identityFromClaims(claims jwt.MapClaims) Identity {
+	identity := Identity{
+		Issuer:  stringClaim(claims, "iss"),
+		Subject: stringClaim(claims, "sub"),
+		Email:   strings.TrimSpace(stringClaim(claims, "email")),
+		Name:    strings.TrimSpace(stringClaim(claims, "name")),
+		Picture: strings.TrimSpace(stringClaim(claims, "picture")),
+		Groups:  stringsClaim(lookupClaim(claims, p.cfg.GroupsClaimOrDefault())),
+	}
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		identity.EmailVerified = v
+	case string:
+		identity.EmailVerified = strings.EqualFold(v, "true")
+	}
+	if v, ok := lookupClaim(claims, p.cfg.UsernameClaimOrDefault()).(string); ok {
+		identity.Username = strings.TrimSpace(v)
+	}
+	return identity
+}
+
+// lookupClaim resolves a dotted path such as "realm_access.roles", which is
+// where Keycloak puts realm roles.
+func lookupClaim(claims map[string]any, path string) any {
+	if v, ok := claims[path]; ok {
+		return v
+	}
+	var current any = claims
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = m[part]
+	}
+	return current
+}
+
+func stringClaim(claims map[string]any, name string) string {
+	v, _ := claims[name].(string)
+	return v
+}
+
+func stringsClaim(value any) []string {
+	switch v := value.(type) {
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil
+		}
+		return []string{v}
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (p *Provider) redeemCode(ctx context.Context, tokenEndpoint, code, codeVerifier string) (string, error) {
+	values := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"client_id":     {p.cfg.ClientID},
+		"code_verifier": {codeVerifier},
+	}
+	if p.cfg.ClientSecret != "" {
+		values.Set("client_secret", p.cfg.ClientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("oidc: create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req) //nolint:gosec // token endpoint comes from the configured issuer's discovery document
+	if err != nil {
+		return "", fmt.Errorf("oidc: token request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("oidc: read token response: %w", err)
+	}
+	var tokenResp tokenResponse
+	_ = json.Unmarshal(payload, &tokenResp)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 || tokenResp.Error != "" {
+		message := tokenResp.ErrorDescription
+		if message == "" {
+			message = tokenResp.Error
+		}
+		if message == "" {
+			message = strings.TrimSpace(string(payload))
+		}
+		return "", fmt.Errorf("oidc: token request failed: %s", message)
+	}
+	if tokenResp.IDToken == "" {
+		return "", errors.New("oidc: token response has no id_token")
+	}
+	return tokenResp.IDToken, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, target string, out any) error {
+	return getJSON(ctx, p.httpClient, target, out)
+}
+
+func getJSON(ctx context.Context, client *http.Client, target string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req) //nolint:gosec // URL derives from the operator-configured issuer
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("GET %s: status %d: %s", target, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(out)
+}
+
+func (p *Provider) prunePendingLocked() {
+	now := p.now()
+	for state, pending := range p.pending {
+		if now.After(pending.expiresAt) {
+			delete(p.pending, state)
+		}
+	}
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,206 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/oidc/oidctest"
+)
+
+func newTestProvider(t *testing.T, issuer *oidctest.Issuer, mutate func(*config.OIDCConfig)) *Provider {
+	t.Helper()
+	cfg := config.OIDCConfig{
+		Enabled:     true,
+		Issuer:      issuer.URL,
+		ClientID:    issuer.ClientID,
+		RedirectURL: "https://memoh.example.com/oauth/oidc/callback",
+		GroupsClaim: "realm_access.roles",
+		AdminGroups: []string{"memoh-admins"},
+	}
+	if mutate != nil {
+		mutate(&cfg)
+	}
+	p, err := NewProvider(nil, cfg, issuer.Client())
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+	return p
+}
+
+func TestNewProviderDisabled(t *testing.T) {
+	p, err := NewProvider(nil, config.OIDCConfig{}, nil)
+	if err != nil || p != nil {
+		t.Fatalf("NewProvider(disabled) = %v, %v; want nil, nil", p, err)
+	}
+	if _, err := NewProvider(nil, config.OIDCConfig{Enabled: true, Issuer: "https://id.example.com"}, nil); err == nil {
+		t.Fatal("NewProvider() without client_id should fail")
+	}
+}
+
+func TestLoginFlow(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	issuer.SetUser(map[string]any{
+		"sub":                "alice-sub",
+		"email":              "alice@example.com",
+		"email_verified":     true,
+		"name":               "Alice",
+		"preferred_username": "alice",
+		"realm_access":       map[string]any{"roles": []any{"memoh-admins", "offline_access"}},
+	})
+	p := newTestProvider(t, issuer, nil)
+	ctx := context.Background()
+
+	req, err := p.Start(ctx)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if !strings.HasPrefix(req.URL, issuer.URL+"/authorize?") || !strings.Contains(req.URL, "code_challenge_method=S256") {
+		t.Fatalf("authorization url = %s", req.URL)
+	}
+	code, state := issuer.Login(t, req.URL)
+	if state != req.State {
+		t.Fatalf("state = %q, want %q", state, req.State)
+	}
+
+	identity, err := p.Exchange(ctx, state, code)
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if identity.Issuer != issuer.URL || identity.Subject != "alice-sub" || identity.Email != "alice@example.com" ||
+		!identity.EmailVerified || identity.Username != "alice" || identity.Name != "Alice" {
+		t.Fatalf("identity = %+v", identity)
+	}
+	role, err := p.Role(identity)
+	if err != nil || role != "admin" {
+		t.Fatalf("Role() = %q, %v; want admin", role, err)
+	}
+
+	if _, err := p.Exchange(ctx, state, code); !errors.Is(err, ErrInvalidState) {
+		t.Fatalf("replayed Exchange() error = %v, want ErrInvalidState", err)
+	}
+}
+
+func TestExchangeRejectsUnknownState(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	p := newTestProvider(t, issuer, nil)
+
+	if _, err := p.Exchange(context.Background(), "forged", "code"); !errors.Is(err, ErrInvalidState) {
+		t.Fatalf("Exchange() error = %v, want ErrInvalidState", err)
+	}
+}
+
+func TestExchangeRejectsExpiredState(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	p := newTestProvider(t, issuer, nil)
+	ctx := context.Background()
+
+	req, err := p.Start(ctx)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	code, state := issuer.Login(t, req.URL)
+	p.now = func() time.Time { return time.Now().Add(pendingTTL + time.Minute) }
+	if _, err := p.Exchange(ctx, state, code); !errors.Is(err, ErrInvalidState) {
+		t.Fatalf("Exchange() error = %v, want ErrInvalidState", err)
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	p := newTestProvider(t, issuer, nil)
+	ctx := context.Background()
+	now := time.Now()
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   issuer.URL,
+			"sub":   "bob",
+			"aud":   "memoh",
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Minute).Unix(),
+			"nonce": "n-1",
+		}
+	}
+
+	if _, err := p.VerifyIDToken(ctx, issuer.Sign(t, valid()), "n-1"); err != nil {
+		t.Fatalf("VerifyIDToken(valid) error = %v", err)
+	}
+
+	cases := map[string]func(jwt.MapClaims){
+		"wrong audience": func(c jwt.MapClaims) { c["aud"] = "someone-else" },
+		"wrong issuer":   func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+		"expired":        func(c jwt.MapClaims) { c["exp"] = now.Add(-time.Hour).Unix() },
+		"wrong nonce":    func(c jwt.MapClaims) { c["nonce"] = "n-2" },
+		"missing nonce":  func(c jwt.MapClaims) { delete(c, "nonce") },
+		"azp mismatch":   func(c jwt.MapClaims) { c["aud"] = []any{"memoh", "other"}; c["azp"] = "other" },
+	}
+	for name, mutate := range cases {
+		claims := valid()
+		mutate(claims)
+		if _, err := p.VerifyIDToken(ctx, issuer.Sign(t, claims), "n-1"); err == nil {
+			t.Fatalf("VerifyIDToken(%s) succeeded, want error", name)
+		}
+	}
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, valid()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatalf("sign none: %v", err)
+	}
+	if _, err := p.VerifyIDToken(ctx, unsigned, "n-1"); err == nil {
+		t.Fatal("VerifyIDToken(alg=none) succeeded, want error")
+	}
+
+	forged := oidctest.NewIssuer(t, "memoh")
+	if _, err := p.VerifyIDToken(ctx, forged.Sign(t, valid()), "n-1"); err == nil {
+		t.Fatal("VerifyIDToken(foreign key) succeeded, want error")
+	}
+}
+
+func TestVerifyIDTokenAfterKeyRotation(t *testing.T) {
+	issuer := oidctest.NewIssuer(t, "memoh")
+	p := newTestProvider(t, issuer, nil)
+	ctx := context.Background()
+	claims := jwt.MapClaims{
+		"iss": issuer.URL, "sub": "bob", "aud": "memoh", "nonce": "n",
+		"iat": time.Now().Unix(), "exp": time.Now().Add(time.Minute).Unix(),
+	}
+	if _, err := p.VerifyIDToken(ctx, issuer.Sign(t, claims), "n"); err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+
+	issuer.RotateKey(t)
+	p.keys.fetchedAt = time.Time{}
+	if _, err := p.VerifyIDToken(ctx, issuer.Sign(t, claims), "n"); err != nil {
+		t.Fatalf("VerifyIDToken() after rotation error = %v", err)
+	}
+}
+
+func TestMapRole(t *testing.T) {
+	admins := []string{"Memoh-Admins"}
+	allowed := []string{"staff"}
+	tests := []struct {
+		name    string
+		groups  []string
+		allowed []string
+		want    string
+		wantErr error
+	}{
+		{name: "admin group", groups: []string{"memoh-admins"}, allowed: allowed, want: "admin"},
+		{name: "allowed member", groups: []string{"staff"}, allowed: allowed, want: "member"},
+		{name: "not allowed", groups: []string{"contractors"}, allowed: allowed, wantErr: ErrNotAllowed},
+		{name: "open membership", groups: nil, want: "member"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MapRole(tt.groups, admins, tt.allowed)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Fatalf("MapRole() = %q, %v; want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
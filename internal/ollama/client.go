@@ -0,0 +1,369 @@
+// Package ollama talks to the native Ollama API (/api/chat, /api/embed,
+// /api/tags, /api/show). It backs the "ollama" provider client type, which
+// unlike the OpenAI-compatible endpoint can discover installed models along
+// with their context length and capabilities.
+package ollama
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	DefaultBaseURL = "http://127.0.0.1:11434"
+
+	// maxStreamLine bounds a single NDJSON chunk of a streamed chat response.
+	maxStreamLine = 4 << 20
+)
+
+// Client is a minimal client for the native Ollama HTTP API. The API key is
+// optional; it is only sent when Ollama sits behind an authenticating proxy.
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+func NewClient(baseURL, apiKey string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    NormalizeBaseURL(baseURL),
+		apiKey:     strings.TrimSpace(apiKey),
+		httpClient: httpClient,
+	}
+}
+
+// NormalizeBaseURL returns the server root for baseURL. Providers created
+// for the OpenAI-compatible endpoint carry a "/v1" suffix, and some users
+// paste the "/api" prefix; both are stripped so the native paths resolve.
+func NormalizeBaseURL(baseURL string) string {
+	baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	if baseURL == "" {
+		return DefaultBaseURL
+	}
+	for _, suffix := range []string{"/v1", "/api"} {
+		if strings.HasSuffix(baseURL, suffix) {
+			return strings.TrimRight(strings.TrimSuffix(baseURL, suffix), "/")
+		}
+	}
+	return baseURL
+}
+
+// APIError is a non-2xx response from Ollama. Its message keeps the
+// "api error <status>" shape used by the other providers so retry and
+// failover classification treat Ollama the same way.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("ollama: api error %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is a 404 from Ollama, e.g. an unknown model.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+type VersionResponse struct {
+	Version string `json:"version"`
+}
+
+// Version calls GET /api/version. It is the cheapest reachability probe.
+func (c *Client) Version(ctx context.Context) (string, error) {
+	var resp VersionResponse
+	if err := c.do(ctx, http.MethodGet, "/api/version", nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.Version, nil
+}
+
+type ModelDetails struct {
+	Format            string   `json:"format,omitempty"`
+	Family            string   `json:"family,omitempty"`
+	Families          []string `json:"families,omitempty"`
+	ParameterSize     string   `json:"parameter_size,omitempty"`
+	QuantizationLevel string   `json:"quantization_level,omitempty"`
+}
+
+type TagModel struct {
+	Name    string       `json:"name"`
+	Model   string       `json:"model"`
+	Size    int64        `json:"size"`
+	Digest  string       `json:"digest"`
+	Details ModelDetails `json:"details"`
+}
+
+type tagsResponse struct {
+	Models []TagModel `json:"models"`
+}
+
+// Tags calls GET /api/tags and returns the locally installed models.
+func (c *Client) Tags(ctx context.Context) ([]TagModel, error) {
+	var resp tagsResponse
+	if err := c.do(ctx, http.MethodGet, "/api/tags", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Models, nil
+}
+
+// Capability values reported by /api/show.
+const (
+	CapabilityCompletion = "completion"
+	CapabilityEmbedding  = "embedding"
+	CapabilityTools      = "tools"
+	CapabilityVision     = "vision"
+	CapabilityThinking   = "thinking"
+)
+
+type ShowResponse struct {
+	Details      ModelDetails   `json:"details"`
+	ModelInfo    map[string]any `json:"model_info"`
+	Capabilities []string       `json:"capabilities"`
+}
+
+// Show calls POST /api/show for model.
+func (c *Client) Show(ctx context.Context, model string) (*ShowResponse, error) {
+	var resp ShowResponse
+	if err := c.do(ctx, http.MethodPost, "/api/show", map[string]string{"model": model}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// HasCapability reports whether the model advertises capability.
+func (s *ShowResponse) HasCapability(capability string) bool {
+	for _, c := range s.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// ContextLength returns the trained context length from model_info, which
+// is keyed by architecture (e.g. "llama.context_length"). Zero if unknown.
+func (s *ShowResponse) ContextLength() int {
+	return s.modelInfoInt(".context_length")
+}
+
+// EmbeddingLength returns the embedding width from model_info. For
+// embedding models it is the dimension of the returned vectors.
+func (s *ShowResponse) EmbeddingLength() int {
+	return s.modelInfoInt(".embedding_length")
+}
+
+func (s *ShowResponse) modelInfoInt(suffix string) int {
+	for key, value := range s.ModelInfo {
+		if !strings.HasSuffix(key, suffix) {
+			continue
+		}
+		if n, ok := value.(float64); ok && n > 0 {
+			return int(n)
+		}
+	}
+	return 0
+}
+
+type EmbedRequest struct {
+	Model      string   `json:"model"`
+	Input      []string `json:"input"`
+	Dimensions int      `json:"dimensions,omitempty"`
+}
+
+type EmbedResponse struct {
+	Model           string      `json:"model"`
+	Embeddings      [][]float64 `json:"embeddings"`
+	PromptEvalCount int         `json:"prompt_eval_count"`
+}
+
+// Embed calls POST /api/embed.
+func (c *Client) Embed(ctx context.Context, req EmbedRequest) (*EmbedResponse, error) {
+	var resp EmbedResponse
+	if err := c.do(ctx, http.MethodPost, "/api/embed", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type ToolFunction struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Parameters  any    `json:"parameters,omitempty"`
+}
+
+type Tool struct {
+	Type     string       `json:"type"`
+	Function ToolFunction `json:"function"`
+}
+
+type ToolCallFunction struct {
+	Index     int            `json:"index,omitempty"`
+	Name      string         `json:"name"`
+	Arguments map[string]any `json:"arguments"`
+}
+
+type ToolCall struct {
+	ID       string           `json:"id,omitempty"`
+	Function ToolCallFunction `json:"function"`
+}
+
+type Message struct {
+	Role      string     `json:"role"`
+	Content   string     `json:"content"`
+	Thinking  string     `json:"thinking,omitempty"`
+	Images    []string   `json:"images,omitempty"`
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+	ToolName  string     `json:"tool_name,omitempty"`
+}
+
+type ChatRequest struct {
+	Model    string         `json:"model"`
+	Messages []Message      `json:"messages"`
+	Tools    []Tool         `json:"tools,omitempty"`
+	Format   any            `json:"format,omitempty"`
+	Options  map[string]any `json:"options,omitempty"`
+	Think    *bool          `json:"think,omitempty"`
+	Stream   bool           `json:"stream"`
+}
+
+type ChatResponse struct {
+	Model           string  `json:"model"`
+	CreatedAt       string  `json:"created_at"`
+	Message         Message `json:"message"`
+	Done            bool    `json:"done"`
+	DoneReason      string  `json:"done_reason,omitempty"`
+	PromptEvalCount int     `json:"prompt_eval_count,omitempty"`
+	EvalCount       int     `json:"eval_count,omitempty"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// Chat calls POST /api/chat without streaming.
+func (c *Client) Chat(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
+	req.Stream = false
+	var resp ChatResponse
+	if err := c.do(ctx, http.MethodPost, "/api/chat", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ChatStream is an open streaming /api/chat response.
+type ChatStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	done    bool
+}
+
+// StreamChat calls POST /api/chat with streaming. Connection and HTTP
+// errors are returned here, before any chunk is read, so callers can retry
+// or fail over without having emitted partial output.
+func (c *Client) StreamChat(ctx context.Context, req ChatRequest) (*ChatStream, error) {
+	req.Stream = true
+	resp, err := c.send(ctx, http.MethodPost, "/api/chat", req)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLine)
+	return &ChatStream{body: resp.Body, scanner: scanner}, nil
+}
+
+// Next returns the next NDJSON chunk. It returns io.EOF once the final
+// chunk (Done) has been returned, io.ErrUnexpectedEOF if the body ends
+// early, and an *APIError with status 500 for an error chunk sent
+// mid-stream.
+func (s *ChatStream) Next() (ChatResponse, error) {
+	if s.done {
+		return ChatResponse{}, io.EOF
+	}
+	for s.scanner.Scan() {
+		line := bytes.TrimSpace(s.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var chunk ChatResponse
+		if err := json.Unmarshal(line, &chunk); err != nil {
+			return ChatResponse{}, fmt.Errorf("ollama: decode stream chunk: %w", err)
+		}
+		if chunk.Error != "" {
+			return ChatResponse{}, &APIError{StatusCode: http.StatusInternalServerError, Message: chunk.Error}
+		}
+		s.done = chunk.Done
+		return chunk, nil
+	}
+	if err := s.scanner.Err(); err != nil {
+		return ChatResponse{}, fmt.Errorf("ollama: read stream: %w", err)
+	}
+	return ChatResponse{}, io.ErrUnexpectedEOF
+}
+
+func (s *ChatStream) Close() error {
+	return s.body.Close()
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	resp, err := c.send(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("ollama: decode %s response: %w", path, err)
+	}
+	return nil
+}
+
+// send issues the request and returns the response when the status is 2xx;
+// otherwise the body is consumed into an *APIError.
+func (c *Client) send(ctx context.Context, method, path string, body any) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("ollama: encode %s request: %w", path, err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+	resp, err := c.httpClient.Do(req) //nolint:gosec // base URL is operator-configured provider endpoint
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	message := strings.TrimSpace(string(raw))
+	var payload struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(raw, &payload) == nil && payload.Error != "" {
+		message = payload.Error
+	}
+	if message == "" {
+		message = http.StatusText(resp.StatusCode)
+	}
+	return nil, &APIError{StatusCode: resp.StatusCode, Message: message}
+}
@@ -0,0 +1,228 @@
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/memohai/twilight-ai/sdk"
+)
+
+func TestNormalizeBaseURL(t *testing.T) {
+	tests := map[string]string{
+		"":                           DefaultBaseURL,
+		"http://127.0.0.1:11434/":    "http://127.0.0.1:11434",
+		"http://127.0.0.1:11434/v1":  "http://127.0.0.1:11434",
+		"http://gpu-box:11434/api/":  "http://gpu-box:11434",
+		"https://proxy.example/olla": "https://proxy.example/olla",
+	}
+	for in, want := range tests {
+		if got := NormalizeBaseURL(in); got != want {
+			t.Fatalf("NormalizeBaseURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	show := map[string]ShowResponse{
+		"llava:7b": {
+			Capabilities: []string{"completion", "vision"},
+			ModelInfo:    map[string]any{"llama.context_length": float64(4096)},
+		},
+		"qwen3:8b": {
+			Capabilities: []string{"completion", "tools", "thinking"},
+			ModelInfo:    map[string]any{"qwen3.context_length": float64(40960)},
+		},
+		"nomic-embed-text:latest": {
+			Capabilities: []string{"embedding"},
+			ModelInfo:    map[string]any{"nomic-bert.context_length": float64(2048), "nomic-bert.embedding_length": float64(768)},
+		},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/tags", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"models": []map[string]any{
+			{"name": "llava:7b", "model": "llava:7b"},
+			{"name": "qwen3:8b", "model": "qwen3:8b"},
+			{"name": "nomic-embed-text:latest", "model": "nomic-embed-text:latest"},
+			{"name": "broken:latest", "model": "broken:latest"},
+		}})
+	})
+	mux.HandleFunc("POST /api/show", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Model string `json:"model"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		resp, ok := show[req.Model]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"model '` + req.Model + `' not found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("POST /api/chat", func(w http.ResponseWriter, r *http.Request) {
+		var req ChatRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Model == "overloaded" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error":"server busy"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		enc := json.NewEncoder(w)
+		_ = enc.Encode(ChatResponse{Model: req.Model, Message: Message{Role: "assistant", Thinking: "hmm"}})
+		_ = enc.Encode(ChatResponse{Model: req.Model, Message: Message{Role: "assistant", Content: "Hel"}})
+		_ = enc.Encode(ChatResponse{Model: req.Model, Message: Message{Role: "assistant", Content: "lo"}})
+		_ = enc.Encode(ChatResponse{Model: req.Model, Message: Message{Role: "assistant", ToolCalls: []ToolCall{{
+			Function: ToolCallFunction{Name: "get_weather", Arguments: map[string]any{"city": "Paris"}},
+		}}}})
+		_ = enc.Encode(ChatResponse{Model: req.Model, Done: true, DoneReason: "stop", PromptEvalCount: 12, EvalCount: 5})
+	})
+	mux.HandleFunc("POST /api/embed", func(w http.ResponseWriter, r *http.Request) {
+		var req EmbedRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		embeddings := make([][]float64, len(req.Input))
+		for i := range embeddings {
+			embeddings[i] = []float64{0.1, 0.2, 0.3}
+		}
+		_ = json.NewEncoder(w).Encode(EmbedResponse{Model: req.Model, Embeddings: embeddings, PromptEvalCount: 7})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDiscover(t *testing.T) {
+	srv := newTestServer(t)
+	client := NewClient(srv.URL+"/v1", "", srv.Client())
+
+	models, err := client.Discover(context.Background())
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(models) != 4 {
+		t.Fatalf("Discover() returned %d models, want 4", len(models))
+	}
+	byID := map[string]DiscoveredModel{}
+	for _, m := range models {
+		byID[m.ID] = m
+	}
+	if m := byID["llava:7b"]; m.Type != ModelTypeChat || m.ContextWindow != 4096 || !m.HasCapability(CapabilityVision) {
+		t.Fatalf("llava = %+v", m)
+	}
+	if m := byID["qwen3:8b"]; !m.HasCapability(CapabilityTools) || !m.HasCapability(CapabilityThinking) || m.ContextWindow != 40960 {
+		t.Fatalf("qwen3 = %+v", m)
+	}
+	if m := byID["nomic-embed-text:latest"]; m.Type != ModelTypeEmbedding || m.Dimensions != 768 {
+		t.Fatalf("nomic-embed-text = %+v", m)
+	}
+	if m := byID["broken:latest"]; m.Type != ModelTypeChat || len(m.Capabilities) != 0 {
+		t.Fatalf("broken = %+v", m)
+	}
+}
+
+func TestProviderStream(t *testing.T) {
+	srv := newTestServer(t)
+	p := NewProvider(srv.URL, "", srv.Client())
+
+	result, err := p.DoStream(context.Background(), sdk.GenerateParams{
+		Model:    p.ChatModel("qwen3:8b"),
+		Messages: []sdk.Message{sdk.UserMessage("hi")},
+	})
+	if err != nil {
+		t.Fatalf("DoStream() error = %v", err)
+	}
+	var text, reasoning string
+	var toolCalls []*sdk.StreamToolCallPart
+	var finish *sdk.FinishPart
+	for part := range result.Stream {
+		switch v := part.(type) {
+		case *sdk.TextDeltaPart:
+			text += v.Text
+		case *sdk.ReasoningDeltaPart:
+			reasoning += v.Text
+		case *sdk.StreamToolCallPart:
+			toolCalls = append(toolCalls, v)
+		case *sdk.ErrorPart:
+			t.Fatalf("unexpected error part: %v", v.Error)
+		case *sdk.FinishPart:
+			finish = v
+		}
+	}
+	if text != "Hello" || reasoning != "hmm" {
+		t.Fatalf("text = %q, reasoning = %q", text, reasoning)
+	}
+	if len(toolCalls) != 1 || toolCalls[0].ToolName != "get_weather" || toolCalls[0].ToolCallID == "" {
+		t.Fatalf("tool calls = %+v", toolCalls)
+	}
+	if finish == nil || finish.FinishReason != sdk.FinishReasonToolCalls || finish.TotalUsage.InputTokens != 12 || finish.TotalUsage.OutputTokens != 5 {
+		t.Fatalf("finish = %+v", finish)
+	}
+}
+
+func TestProviderStreamStartErrorIsSynchronous(t *testing.T) {
+	srv := newTestServer(t)
+	p := NewProvider(srv.URL, "", srv.Client())
+
+	_, err := p.DoStream(context.Background(), sdk.GenerateParams{Model: p.ChatModel("overloaded")})
+	if err == nil || err.Error() != "ollama: api error 503: server busy" {
+		t.Fatalf("DoStream() error = %v", err)
+	}
+}
+
+func TestProviderEmbed(t *testing.T) {
+	srv := newTestServer(t)
+	p := NewProvider(srv.URL, "", srv.Client())
+
+	result, err := p.DoEmbed(context.Background(), sdk.EmbedParams{
+		Model:  p.EmbeddingModel("nomic-embed-text"),
+		Values: []string{"a", "b"},
+	})
+	if err != nil {
+		t.Fatalf("DoEmbed() error = %v", err)
+	}
+	if len(result.Embeddings) != 2 || len(result.Embeddings[0]) != 3 || result.Usage.Tokens != 7 {
+		t.Fatalf("DoEmbed() = %+v", result)
+	}
+}
+
+func TestBuildChatRequest(t *testing.T) {
+	effort := "none"
+	maxTokens := 256
+	req := buildChatRequest(sdk.GenerateParams{
+		Model:  &sdk.Model{ID: "qwen3:8b"},
+		System: "be brief",
+		Messages: []sdk.Message{
+			sdk.UserMessage("look", sdk.ImagePart{Image: "data:image/png;base64,AAAA", MediaType: "image/png"}),
+			{Role: sdk.MessageRoleAssistant, Content: []sdk.MessagePart{
+				sdk.ToolCallPart{ToolCallID: "call_1", ToolName: "lookup", Input: map[string]any{"q": "x"}},
+			}},
+			sdk.ToolMessage(sdk.ToolResultPart{ToolCallID: "call_1", ToolName: "lookup", Result: map[string]any{"ok": true}}),
+		},
+		Tools:           []sdk.Tool{{Name: "lookup", Description: "Look things up"}},
+		MaxTokens:       &maxTokens,
+		ReasoningEffort: &effort,
+	})
+
+	if req.Model != "qwen3:8b" || len(req.Messages) != 4 {
+		t.Fatalf("request = %+v", req)
+	}
+	if req.Messages[0].Role != "system" || req.Messages[1].Images[0] != "AAAA" {
+		t.Fatalf("messages = %+v", req.Messages)
+	}
+	if call := req.Messages[2].ToolCalls; len(call) != 1 || call[0].Function.Arguments["q"] != "x" {
+		t.Fatalf("assistant tool call = %+v", req.Messages[2])
+	}
+	if tool := req.Messages[3]; tool.Role != "tool" || tool.ToolName != "lookup" || tool.Content != `{"ok":true}` {
+		t.Fatalf("tool message = %+v", tool)
+	}
+	if len(req.Tools) != 1 || req.Tools[0].Function.Name != "lookup" {
+		t.Fatalf("tools = %+v", req.Tools)
+	}
+	if req.Think == nil || *req.Think || req.Options["num_predict"] != 256 {
+		t.Fatalf("think = %v, options = %v", req.Think, req.Options)
+	}
+}
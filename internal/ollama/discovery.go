@@ -0,0 +1,70 @@
+package ollama
+
+import (
+	"context"
+	"fmt"
+)
+
+// ModelType mirrors the chat/embedding split of the model catalog.
+type ModelType string
+
+const (
+	ModelTypeChat      ModelType = "chat"
+	ModelTypeEmbedding ModelType = "embedding"
+)
+
+// DiscoveredModel is an installed model together with what /api/show
+// reports about it.
+type DiscoveredModel struct {
+	ID            string
+	Name          string
+	Type          ModelType
+	Capabilities  []string
+	ContextWindow int
+	Dimensions    int
+}
+
+// HasCapability reports whether the model advertises capability.
+func (m DiscoveredModel) HasCapability(capability string) bool {
+	for _, c := range m.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// Discover lists the installed models and describes each one. A model whose
+// /api/show call fails is still returned as a plain chat model so a single
+// broken blob does not hide the rest of the library.
+func (c *Client) Discover(ctx context.Context) ([]DiscoveredModel, error) {
+	tags, err := c.Tags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list models: %w", err)
+	}
+	discovered := make([]DiscoveredModel, 0, len(tags))
+	for _, tag := range tags {
+		id := tag.Model
+		if id == "" {
+			id = tag.Name
+		}
+		model := DiscoveredModel{ID: id, Name: tag.Name, Type: ModelTypeChat}
+		show, err := c.Show(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			discovered = append(discovered, model)
+			continue
+		}
+		model.Capabilities = append([]string(nil), show.Capabilities...)
+		model.ContextWindow = show.ContextLength()
+		// Older servers do not report capabilities; treat those as chat.
+		if show.HasCapability(CapabilityEmbedding) && !show.HasCapability(CapabilityCompletion) {
+			model.Type = ModelTypeEmbedding
+			model.Dimensions = show.EmbeddingLength()
+		}
+		discovered = append(discovered, model)
+	}
+	return discovered, nil
+}
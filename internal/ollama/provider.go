@@ -0,0 +1,432 @@
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	sdk "github.com/memohai/twilight-ai/sdk"
+)
+
+// maxEmbeddingsPerCall keeps /api/embed batches small enough for the
+// default Ollama request limits.
+const maxEmbeddingsPerCall = 256
+
+// Provider adapts Client to the SDK chat and embedding provider contracts.
+type Provider struct {
+	client *Client
+}
+
+var (
+	_ sdk.Provider          = (*Provider)(nil)
+	_ sdk.EmbeddingProvider = (*Provider)(nil)
+)
+
+func NewProvider(baseURL, apiKey string, httpClient *http.Client) *Provider {
+	return &Provider{client: NewClient(baseURL, apiKey, httpClient)}
+}
+
+// Client returns the underlying API client, e.g. for model discovery.
+func (p *Provider) Client() *Client {
+	return p.client
+}
+
+func (*Provider) Name() string {
+	return "ollama"
+}
+
+func (p *Provider) ChatModel(id string) *sdk.Model {
+	return &sdk.Model{ID: id, Provider: p, Type: sdk.ModelTypeChat}
+}
+
+func (p *Provider) EmbeddingModel(id string) *sdk.EmbeddingModel {
+	return &sdk.EmbeddingModel{ID: id, Provider: p, MaxEmbeddingsPerCall: maxEmbeddingsPerCall}
+}
+
+func (p *Provider) ListModels(ctx context.Context) ([]sdk.Model, error) {
+	tags, err := p.client.Tags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]sdk.Model, 0, len(tags))
+	for _, tag := range tags {
+		id := tag.Model
+		if id == "" {
+			id = tag.Name
+		}
+		result = append(result, sdk.Model{ID: id, DisplayName: tag.Name, Provider: p, Type: sdk.ModelTypeChat})
+	}
+	return result, nil
+}
+
+func (p *Provider) Test(ctx context.Context) *sdk.ProviderTestResult {
+	version, err := p.client.Version(ctx)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			message := err.Error()
+			if apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden {
+				message = "authentication failed: " + apiErr.Message
+			}
+			return &sdk.ProviderTestResult{Status: sdk.ProviderStatusUnhealthy, Message: message, Error: err}
+		}
+		return &sdk.ProviderTestResult{Status: sdk.ProviderStatusUnreachable, Message: err.Error(), Error: err}
+	}
+	return &sdk.ProviderTestResult{Status: sdk.ProviderStatusOK, Message: "ollama " + version}
+}
+
+func (p *Provider) TestModel(ctx context.Context, modelID string) (*sdk.ModelTestResult, error) {
+	if _, err := p.client.Show(ctx, modelID); err != nil {
+		if IsNotFound(err) {
+			return &sdk.ModelTestResult{Supported: false, Message: "model is not installed"}, nil
+		}
+		return nil, err
+	}
+	return &sdk.ModelTestResult{Supported: true, Message: "model is installed"}, nil
+}
+
+func (p *Provider) DoGenerate(ctx context.Context, params sdk.GenerateParams) (*sdk.GenerateResult, error) {
+	resp, err := p.client.Chat(ctx, buildChatRequest(params))
+	if err != nil {
+		return nil, err
+	}
+	toolCalls := convertToolCalls(resp.Message.ToolCalls)
+	return &sdk.GenerateResult{
+		Text:            resp.Message.Content,
+		Reasoning:       resp.Message.Thinking,
+		FinishReason:    finishReason(resp.DoneReason, len(toolCalls) > 0),
+		RawFinishReason: resp.DoneReason,
+		Usage:           usage(resp),
+		ToolCalls:       toolCalls,
+		Response:        responseMetadata(resp),
+	}, nil
+}
+
+func (p *Provider) DoStream(ctx context.Context, params sdk.GenerateParams) (*sdk.StreamResult, error) {
+	stream, err := p.client.StreamChat(ctx, buildChatRequest(params))
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan sdk.StreamPart, 32)
+	go func() {
+		defer close(ch)
+		defer func() { _ = stream.Close() }()
+		emit := func(part sdk.StreamPart) bool {
+			select {
+			case ch <- part:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		if !emit(&sdk.StartPart{}) || !emit(&sdk.StartStepPart{}) {
+			return
+		}
+
+		const textID, reasoningID = "text-0", "reasoning-0"
+		var textOpen, reasoningOpen, sawToolCalls bool
+		closeReasoning := func() bool {
+			if !reasoningOpen {
+				return true
+			}
+			reasoningOpen = false
+			return emit(&sdk.ReasoningEndPart{ID: reasoningID})
+		}
+		closeText := func() bool {
+			if !textOpen {
+				return true
+			}
+			textOpen = false
+			return emit(&sdk.TextEndPart{ID: textID})
+		}
+
+		for {
+			chunk, err := stream.Next()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					emit(&sdk.ErrorPart{Error: err})
+				}
+				return
+			}
+			if chunk.Message.Thinking != "" {
+				if !reasoningOpen {
+					reasoningOpen = true
+					if !emit(&sdk.ReasoningStartPart{ID: reasoningID}) {
+						return
+					}
+				}
+				if !emit(&sdk.ReasoningDeltaPart{ID: reasoningID, Text: chunk.Message.Thinking}) {
+					return
+				}
+			}
+			if chunk.Message.Content != "" {
+				if !closeReasoning() {
+					return
+				}
+				if !textOpen {
+					textOpen = true
+					if !emit(&sdk.TextStartPart{ID: textID}) {
+						return
+					}
+				}
+				if !emit(&sdk.TextDeltaPart{ID: textID, Text: chunk.Message.Content}) {
+					return
+				}
+			}
+			for _, call := range convertToolCalls(chunk.Message.ToolCalls) {
+				sawToolCalls = true
+				if !emit(&sdk.StreamToolCallPart{ToolCallID: call.ToolCallID, ToolName: call.ToolName, Input: call.Input}) {
+					return
+				}
+			}
+			if !chunk.Done {
+				continue
+			}
+			if !closeReasoning() || !closeText() {
+				return
+			}
+			reason := finishReason(chunk.DoneReason, sawToolCalls)
+			if !emit(&sdk.FinishStepPart{
+				FinishReason:    reason,
+				RawFinishReason: chunk.DoneReason,
+				Usage:           usage(&chunk),
+				Response:        responseMetadata(&chunk),
+			}) {
+				return
+			}
+			emit(&sdk.FinishPart{FinishReason: reason, RawFinishReason: chunk.DoneReason, TotalUsage: usage(&chunk)})
+			return
+		}
+	}()
+	return &sdk.StreamResult{Stream: ch}, nil
+}
+
+func (p *Provider) DoEmbed(ctx context.Context, params sdk.EmbedParams) (*sdk.EmbedResult, error) {
+	req := EmbedRequest{Input: params.Values}
+	if params.Model != nil {
+		req.Model = params.Model.ID
+	}
+	if params.Dimensions != nil {
+		req.Dimensions = *params.Dimensions
+	}
+	resp, err := p.client.Embed(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Embeddings) != len(params.Values) {
+		return nil, errors.New("ollama: embedding count does not match input count")
+	}
+	return &sdk.EmbedResult{
+		Embeddings: resp.Embeddings,
+		Usage:      sdk.EmbeddingUsage{Tokens: resp.PromptEvalCount},
+	}, nil
+}
+
+func buildChatRequest(params sdk.GenerateParams) ChatRequest {
+	req := ChatRequest{Messages: convertMessages(params.System, params.Messages)}
+	if params.Model != nil {
+		req.Model = params.Model.ID
+	}
+	if choice, _ := params.ToolChoice.(string); choice != "none" {
+		for _, tool := range params.Tools {
+			req.Tools = append(req.Tools, Tool{
+				Type: "function",
+				Function: ToolFunction{
+					Name:        tool.Name,
+					Description: tool.Description,
+					Parameters:  tool.Parameters,
+				},
+			})
+		}
+	}
+	if rf := params.ResponseFormat; rf != nil {
+		switch rf.Type {
+		case sdk.ResponseFormatJSONObject:
+			req.Format = "json"
+		case sdk.ResponseFormatJSONSchema:
+			req.Format = rf.JSONSchema
+		}
+	}
+	// Only send "think" when reasoning was configured: models without the
+	// thinking capability reject the field outright.
+	if effort := params.ReasoningEffort; effort != nil && *effort != "" {
+		think := *effort != "none"
+		req.Think = &think
+	}
+
+	options := map[string]any{}
+	if params.Temperature != nil {
+		options["temperature"] = *params.Temperature
+	}
+	if params.TopP != nil {
+		options["top_p"] = *params.TopP
+	}
+	if params.MaxTokens != nil {
+		options["num_predict"] = *params.MaxTokens
+	}
+	if len(params.StopSequences) > 0 {
+		options["stop"] = params.StopSequences
+	}
+	if params.Seed != nil {
+		options["seed"] = *params.Seed
+	}
+	if params.FrequencyPenalty != nil {
+		options["frequency_penalty"] = *params.FrequencyPenalty
+	}
+	if params.PresencePenalty != nil {
+		options["presence_penalty"] = *params.PresencePenalty
+	}
+	if len(options) > 0 {
+		req.Options = options
+	}
+	return req
+}
+
+func convertMessages(system string, messages []sdk.Message) []Message {
+	result := make([]Message, 0, len(messages)+1)
+	if strings.TrimSpace(system) != "" {
+		result = append(result, Message{Role: "system", Content: system})
+	}
+	for _, msg := range messages {
+		out := Message{Role: string(msg.Role)}
+		var text strings.Builder
+		for _, part := range msg.Content {
+			switch p := part.(type) {
+			case sdk.TextPart:
+				text.WriteString(p.Text)
+			case sdk.ReasoningPart:
+				out.Thinking += p.Text
+			case sdk.ImagePart:
+				if image, ok := base64Image(p.Image); ok {
+					out.Images = append(out.Images, image)
+				}
+			case sdk.FilePart:
+				if strings.HasPrefix(p.MediaType, "image/") {
+					if image, ok := base64Image(p.Data); ok {
+						out.Images = append(out.Images, image)
+					}
+				}
+			case sdk.ToolCallPart:
+				out.ToolCalls = append(out.ToolCalls, ToolCall{
+					ID:       p.ToolCallID,
+					Function: ToolCallFunction{Name: p.ToolName, Arguments: toolArguments(p.Input)},
+				})
+			case sdk.ToolResultPart:
+				// Ollama expects one tool message per result.
+				result = append(result, Message{Role: "tool", Content: toolResultContent(p.Result), ToolName: p.ToolName})
+			}
+		}
+		if msg.Role == sdk.MessageRoleTool {
+			continue
+		}
+		out.Content = text.String()
+		result = append(result, out)
+	}
+	return result
+}
+
+// base64Image extracts the payload Ollama expects from a data URL or raw
+// base64 string. Remote URLs are dropped because Ollama cannot fetch them.
+func base64Image(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+		return "", false
+	}
+	if strings.HasPrefix(value, "data:") {
+		_, payload, ok := strings.Cut(value, ",")
+		return payload, ok && payload != ""
+	}
+	return value, true
+}
+
+func toolArguments(input any) map[string]any {
+	switch v := input.(type) {
+	case nil:
+		return map[string]any{}
+	case map[string]any:
+		return v
+	}
+	var raw []byte
+	switch v := input.(type) {
+	case json.RawMessage:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return map[string]any{}
+		}
+		raw = encoded
+	}
+	args := map[string]any{}
+	_ = json.Unmarshal(raw, &args)
+	return args
+}
+
+func toolResultContent(result any) string {
+	if s, ok := result.(string); ok {
+		return s
+	}
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+// convertToolCalls maps Ollama tool calls to SDK tool calls. Older servers
+// do not return call IDs, so one is generated to pair results with calls.
+func convertToolCalls(calls []ToolCall) []sdk.ToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	result := make([]sdk.ToolCall, 0, len(calls))
+	for _, call := range calls {
+		id := call.ID
+		if id == "" {
+			id = "call_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+		}
+		args := call.Function.Arguments
+		if args == nil {
+			args = map[string]any{}
+		}
+		result = append(result, sdk.ToolCall{ToolCallID: id, ToolName: call.Function.Name, Input: args})
+	}
+	return result
+}
+
+func finishReason(doneReason string, hasToolCalls bool) sdk.FinishReason {
+	if hasToolCalls {
+		return sdk.FinishReasonToolCalls
+	}
+	switch doneReason {
+	case "stop", "":
+		return sdk.FinishReasonStop
+	case "length":
+		return sdk.FinishReasonLength
+	default:
+		return sdk.FinishReasonOther
+	}
+}
+
+func usage(resp *ChatResponse) sdk.Usage {
+	return sdk.Usage{
+		InputTokens:  resp.PromptEvalCount,
+		OutputTokens: resp.EvalCount,
+		TotalTokens:  resp.PromptEvalCount + resp.EvalCount,
+	}
+}
+
+func responseMetadata(resp *ChatResponse) sdk.ResponseMetadata {
+	meta := sdk.ResponseMetadata{ModelID: resp.Model}
+	if ts, err := time.Parse(time.RFC3339Nano, resp.CreatedAt); err == nil {
+		meta.Timestamp = ts
+	}
+	return meta
+}
@@ -0,0 +1,458 @@
+package openaicompat
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/memohai/memoh/internal/attachment"
+	"github.com/memohai/memoh/internal/conversation"
+)
+
+// ErrInvalidRequest wraps request validation failures.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// instructionsPrefix introduces client instructions to the bot, which keeps
+// its own system prompt.
+const instructionsPrefix = "Instructions from the API client:\n\n"
+
+// Turn is a request translated into one bot chat turn: the last user
+// message is the query, earlier messages are context.
+type Turn struct {
+	Query        string
+	Attachments  []conversation.ChatAttachment
+	Instructions string
+	// History holds the earlier messages of the request. A bot session keeps
+	// its own history, so it only matters for turns that start a session.
+	History      []conversation.ModelMessage
+	OutputSchema json.RawMessage
+}
+
+// ContextMessages returns the messages to run the turn with. Client
+// instructions are always included; withHistory adds the request history.
+func (t Turn) ContextMessages(withHistory bool) []conversation.ModelMessage {
+	var messages []conversation.ModelMessage
+	if t.Instructions != "" {
+		messages = append(messages, textMessage("user", instructionsPrefix+t.Instructions))
+	}
+	if withHistory {
+		messages = append(messages, t.History...)
+	}
+	return messages
+}
+
+// ChatTurn translates a Chat Completions request.
+func ChatTurn(req ChatCompletionRequest) (Turn, error) {
+	turn, err := messagesTurn(req.Messages)
+	if err != nil {
+		return Turn{}, err
+	}
+	if req.ResponseFormat != nil {
+		var schema json.RawMessage
+		if req.ResponseFormat.JSONSchema != nil {
+			schema = req.ResponseFormat.JSONSchema.Schema
+		}
+		if turn.OutputSchema, err = outputSchema(req.ResponseFormat.Type, schema); err != nil {
+			return Turn{}, err
+		}
+	}
+	return turn, nil
+}
+
+// ResponseTurn translates a Responses API request.
+func ResponseTurn(req ResponseRequest) (Turn, error) {
+	var turn Turn
+	input := strings.TrimSpace(string(req.Input))
+	switch {
+	case input == "" || input == "null":
+		return Turn{}, fmt.Errorf("%w: input is required", ErrInvalidRequest)
+	case strings.HasPrefix(input, `"`):
+		var text string
+		if err := json.Unmarshal(req.Input, &text); err != nil {
+			return Turn{}, fmt.Errorf("%w: input: %s", ErrInvalidRequest, err.Error())
+		}
+		if strings.TrimSpace(text) == "" {
+			return Turn{}, fmt.Errorf("%w: input is required", ErrInvalidRequest)
+		}
+		turn.Query = text
+	default:
+		var items []ResponseInputItem
+		if err := json.Unmarshal(req.Input, &items); err != nil {
+			return Turn{}, fmt.Errorf("%w: input must be a string or an array of items", ErrInvalidRequest)
+		}
+		messages := make([]ChatMessage, 0, len(items))
+		for _, item := range items {
+			if item.Type != "" && item.Type != "message" {
+				continue
+			}
+			messages = append(messages, ChatMessage{Role: item.Role, Content: item.Content})
+		}
+		var err error
+		if turn, err = messagesTurn(messages); err != nil {
+			return Turn{}, err
+		}
+	}
+	turn.Instructions = joinNonEmpty(strings.TrimSpace(req.Instructions), turn.Instructions)
+	if req.Text != nil && req.Text.Format != nil {
+		var err error
+		if turn.OutputSchema, err = outputSchema(req.Text.Format.Type, req.Text.Format.Schema); err != nil {
+			return Turn{}, err
+		}
+	}
+	return turn, nil
+}
+
+// messagesTurn splits a message list into instructions, history and the
+// final user message. Tool messages belong to client-side tools and are
+// dropped.
+func messagesTurn(messages []ChatMessage) (Turn, error) {
+	last := -1
+	for i := len(messages) - 1; i >= 0; i-- {
+		role := messages[i].Role
+		if role != "system" && role != "developer" {
+			last = i
+			break
+		}
+	}
+	if last < 0 || messages[last].Role != "user" {
+		return Turn{}, fmt.Errorf("%w: the last message must come from the user", ErrInvalidRequest)
+	}
+
+	var turn Turn
+	var instructions []string
+	for i, message := range messages {
+		text, images, err := parseContent(message.Content)
+		if err != nil {
+			return Turn{}, fmt.Errorf("%w: messages[%d]: %s", ErrInvalidRequest, i, err.Error())
+		}
+		switch message.Role {
+		case "system", "developer":
+			if strings.TrimSpace(text) != "" {
+				instructions = append(instructions, strings.TrimSpace(text))
+			}
+		case "user", "assistant":
+			if i == last {
+				turn.Query = text
+				for _, image := range images {
+					turn.Attachments = append(turn.Attachments, imageAttachment(image))
+				}
+				continue
+			}
+			if strings.TrimSpace(text) != "" {
+				turn.History = append(turn.History, textMessage(message.Role, text))
+			}
+		case "tool", "function":
+		default:
+			return Turn{}, fmt.Errorf("%w: messages[%d]: unknown role %q", ErrInvalidRequest, i, message.Role)
+		}
+	}
+	if strings.TrimSpace(turn.Query) == "" && len(turn.Attachments) == 0 {
+		return Turn{}, fmt.Errorf("%w: the last user message is empty", ErrInvalidRequest)
+	}
+	turn.Instructions = strings.Join(instructions, "\n\n")
+	return turn, nil
+}
+
+// parseContent returns the text and image URLs of a message content, which
+// is a string or an array of content parts.
+func parseContent(raw json.RawMessage) (string, []string, error) {
+	trimmed := strings.TrimSpace(string(raw))
+	if trimmed == "" || trimmed == "null" {
+		return "", nil, nil
+	}
+	if strings.HasPrefix(trimmed, `"`) {
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return "", nil, err
+		}
+		return text, nil, nil
+	}
+	var parts []ContentPart
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return "", nil, errors.New("content must be a string or an array of parts")
+	}
+	var texts, images []string
+	for _, part := range parts {
+		switch part.Type {
+		case "text", "input_text", "output_text":
+			texts = append(texts, part.Text)
+		case "image_url", "input_image":
+			url, err := imageURL(part.ImageURL)
+			if err != nil {
+				return "", nil, err
+			}
+			images = append(images, url)
+		}
+	}
+	return strings.Join(texts, "\n"), images, nil
+}
+
+// imageURL reads an image reference, given as {"url": ...} in Chat
+// Completions and as a plain string in the Responses API. Only data and
+// http(s) URLs are accepted so a client cannot point at workspace files.
+func imageURL(raw json.RawMessage) (string, error) {
+	var url string
+	if err := json.Unmarshal(raw, &url); err != nil {
+		var object struct {
+			URL string `json:"url"`
+		}
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return "", errors.New("image_url must be a URL")
+		}
+		url = object.URL
+	}
+	url = strings.TrimSpace(url)
+	if !attachment.IsDataURL(url) && !attachment.IsHTTPURL(url) {
+		return "", errors.New("image_url must be a data or http(s) URL")
+	}
+	return url, nil
+}
+
+func imageAttachment(url string) conversation.ChatAttachment {
+	bundle := attachment.Bundle{Type: "image", URL: url}
+	if attachment.IsDataURL(url) {
+		bundle = attachment.Bundle{Type: "image", Base64: url}
+	}
+	return conversation.ChatAttachmentFromBundle(bundle.Normalize())
+}
+
+// outputSchema maps a response format to an output schema. json_object asks
+// for any JSON object; text needs no schema.
+func outputSchema(formatType string, schema json.RawMessage) (json.RawMessage, error) {
+	switch formatType {
+	case "", "text":
+		return nil, nil
+	case "json_object":
+		return json.RawMessage(`{"type":"object"}`), nil
+	case "json_schema":
+		if len(schema) == 0 {
+			return nil, fmt.Errorf("%w: json_schema response format requires a schema", ErrInvalidRequest)
+		}
+		return schema, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported response format %q", ErrInvalidRequest, formatType)
+	}
+}
+
+func textMessage(role, text string) conversation.ModelMessage {
+	content, _ := json.Marshal(text)
+	return conversation.ModelMessage{Role: role, Content: content}
+}
+
+func joinNonEmpty(values ...string) string {
+	kept := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			kept = append(kept, value)
+		}
+	}
+	return strings.Join(kept, "\n\n")
+}
+
+// Usage is token usage of a bot run.
+type Usage struct {
+	InputTokens  int `json:"inputTokens"`
+	OutputTokens int `json:"outputTokens"`
+	TotalTokens  int `json:"totalTokens"`
+}
+
+// ParseUsage reads the usage reported by the agent. Unknown or missing
+// usage yields zeros.
+func ParseUsage(raw json.RawMessage) Usage {
+	var usage Usage
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &usage)
+	}
+	if usage.TotalTokens == 0 {
+		usage.TotalTokens = usage.InputTokens + usage.OutputTokens
+	}
+	return usage
+}
+
+// Chat returns the usage in Chat Completions form.
+func (u Usage) Chat() *ChatUsage {
+	return &ChatUsage{PromptTokens: u.InputTokens, CompletionTokens: u.OutputTokens, TotalTokens: u.TotalTokens}
+}
+
+// Response returns the usage in Responses form.
+func (u Usage) Response() *ResponseUsage {
+	return &ResponseUsage{InputTokens: u.InputTokens, OutputTokens: u.OutputTokens, TotalTokens: u.TotalTokens}
+}
+
+// Reply is the outcome of a non-streamed bot run.
+type Reply struct {
+	Text        string
+	Reasoning   string
+	Annotations []Annotation
+	Usage       Usage
+}
+
+// messagePart is the subset of a stored message part a reply reads.
+type messagePart struct {
+	Type       string          `json:"type"`
+	Text       string          `json:"text,omitempty"`
+	ToolCallID string          `json:"toolCallId,omitempty"`
+	ToolName   string          `json:"toolName,omitempty"`
+	Input      json.RawMessage `json:"input,omitempty"`
+}
+
+// ReplyFromMessages builds a reply from the output messages of a run. The
+// text of every assistant step is kept, and tool calls become annotations.
+// A validated structured output replaces the text.
+func ReplyFromMessages(messages []conversation.ModelMessage, structured, usage json.RawMessage) Reply {
+	reply := Reply{Usage: ParseUsage(usage)}
+	completed := map[string]bool{}
+	for _, message := range messages {
+		for _, part := range messageParts(message) {
+			if part.Type == "tool-result" {
+				completed[part.ToolCallID] = true
+			}
+		}
+		if message.ToolCallID != "" {
+			completed[message.ToolCallID] = true
+		}
+	}
+
+	var texts, reasoning []string
+	for _, message := range messages {
+		if message.Role != "assistant" {
+			continue
+		}
+		if text := strings.TrimSpace(message.TextContent()); text != "" {
+			texts = append(texts, text)
+		}
+		for _, part := range messageParts(message) {
+			switch part.Type {
+			case "reasoning":
+				if text := strings.TrimSpace(part.Text); text != "" {
+					reasoning = append(reasoning, text)
+				}
+			case "tool-call":
+				reply.Annotations = append(reply.Annotations, toolAnnotation(part.ToolCallID, part.ToolName, string(part.Input), completed[part.ToolCallID]))
+			}
+		}
+		for _, call := range message.ToolCalls {
+			reply.Annotations = append(reply.Annotations, toolAnnotation(call.ID, call.Function.Name, call.Function.Arguments, completed[call.ID]))
+		}
+	}
+	reply.Text = strings.Join(texts, "\n\n")
+	reply.Reasoning = strings.Join(reasoning, "\n\n")
+	if len(structured) > 0 {
+		reply.Text = string(structured)
+	}
+	return reply
+}
+
+func messageParts(message conversation.ModelMessage) []messagePart {
+	var parts []messagePart
+	if err := json.Unmarshal(message.Content, &parts); err != nil {
+		return nil
+	}
+	return parts
+}
+
+func toolAnnotation(id, name, arguments string, completed bool) Annotation {
+	status := ToolCallStarted
+	if completed {
+		status = ToolCallCompleted
+	}
+	return Annotation{
+		Type: AnnotationTypeToolCall,
+		ToolCall: &ToolCallAnnotation{
+			ID:        id,
+			Name:      name,
+			Status:    status,
+			Arguments: arguments,
+		},
+	}
+}
+
+// ChatCompletionFromReply renders a reply as a chat completion.
+func ChatCompletionFromReply(id, model string, created int64, reply Reply) ChatCompletion {
+	return ChatCompletion{
+		ID:      id,
+		Object:  ObjectChatCompletion,
+		Created: created,
+		Model:   model,
+		Choices: []ChatChoice{{
+			Message: ChatResponseMessage{
+				Role:             "assistant",
+				Content:          reply.Text,
+				ReasoningContent: reply.Reasoning,
+				Annotations:      reply.Annotations,
+			},
+			FinishReason: FinishReasonStop,
+		}},
+		Usage: reply.Usage.Chat(),
+	}
+}
+
+// ResponseFromReply renders a reply as a completed response.
+func ResponseFromReply(id, model string, created int64, reply Reply) Response {
+	return Response{
+		ID:        id,
+		Object:    ObjectResponse,
+		CreatedAt: created,
+		Status:    ResponseStatusCompleted,
+		Model:     model,
+		Output:    []ResponseOutputItem{outputMessage(messageID(id), ResponseStatusCompleted, reply.Text, reply.Annotations)},
+		Usage:     reply.Usage.Response(),
+	}
+}
+
+func outputMessage(id, status, text string, annotations []Annotation) ResponseOutputItem {
+	if annotations == nil {
+		annotations = []Annotation{}
+	}
+	return ResponseOutputItem{
+		Type:   "message",
+		ID:     id,
+		Status: status,
+		Role:   "assistant",
+		Content: []ResponseContent{{
+			Type:        "output_text",
+			Text:        text,
+			Annotations: annotations,
+		}},
+	}
+}
+
+// NewChatCompletionID returns a fresh chat completion ID.
+func NewChatCompletionID() string {
+	return "chatcmpl-" + randomHex(12)
+}
+
+// NewResponseID returns a fresh response ID that embeds the session the
+// response is stored in, so previous_response_id can continue it without a
+// lookup table.
+func NewResponseID(sessionID string) string {
+	return "resp_" + strings.ReplaceAll(sessionID, "-", "") + randomHex(8)
+}
+
+// SessionFromResponseID returns the session embedded in a response ID.
+func SessionFromResponseID(id string) (string, bool) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(id), "resp_")
+	if !ok || len(rest) < 32 {
+		return "", false
+	}
+	compact := rest[:32]
+	if _, err := hex.DecodeString(compact); err != nil {
+		return "", false
+	}
+	return compact[0:8] + "-" + compact[8:12] + "-" + compact[12:16] + "-" + compact[16:20] + "-" + compact[20:32], true
+}
+
+// messageID derives the ID of a response's output message from the
+// response ID.
+func messageID(responseID string) string {
+	return "msg_" + strings.TrimPrefix(responseID, "resp_")
+}
+
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
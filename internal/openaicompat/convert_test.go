@@ -0,0 +1,124 @@
+package openaicompat
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/memohai/memoh/internal/conversation"
+)
+
+func TestChatTurn(t *testing.T) {
+	req := ChatCompletionRequest{
+		Model: "bot",
+		Messages: []ChatMessage{
+			{Role: "system", Content: json.RawMessage(`"Answer in French."`)},
+			{Role: "user", Content: json.RawMessage(`"Hi"`)},
+			{Role: "assistant", Content: json.RawMessage(`"Bonjour"`)},
+			{Role: "user", Content: json.RawMessage(`[{"type":"text","text":"What is this?"},{"type":"image_url","image_url":{"url":"https://example.com/cat.png"}}]`)},
+		},
+		ResponseFormat: &ResponseFormat{Type: "json_object"},
+	}
+	turn, err := ChatTurn(req)
+	if err != nil {
+		t.Fatalf("ChatTurn() error = %v", err)
+	}
+	if turn.Query != "What is this?" || turn.Instructions != "Answer in French." {
+		t.Fatalf("turn = %+v", turn)
+	}
+	if len(turn.Attachments) != 1 || turn.Attachments[0].URL != "https://example.com/cat.png" {
+		t.Fatalf("attachments = %+v", turn.Attachments)
+	}
+	if len(turn.History) != 2 || turn.History[1].Role != "assistant" || turn.History[1].TextContent() != "Bonjour" {
+		t.Fatalf("history = %+v", turn.History)
+	}
+	if string(turn.OutputSchema) != `{"type":"object"}` {
+		t.Fatalf("output schema = %s", turn.OutputSchema)
+	}
+	if got := turn.ContextMessages(false); len(got) != 1 || got[0].TextContent() != instructionsPrefix+"Answer in French." {
+		t.Fatalf("context without history = %+v", got)
+	}
+	if got := turn.ContextMessages(true); len(got) != 3 {
+		t.Fatalf("context with history = %+v", got)
+	}
+
+	invalid := []ChatCompletionRequest{
+		{Messages: nil},
+		{Messages: []ChatMessage{{Role: "user", Content: json.RawMessage(`"Hi"`)}, {Role: "assistant", Content: json.RawMessage(`"Hello"`)}}},
+		{Messages: []ChatMessage{{Role: "user", Content: json.RawMessage(`[{"type":"image_url","image_url":{"url":"/data/secret.png"}}]`)}}},
+		{Messages: []ChatMessage{{Role: "user", Content: json.RawMessage(`"Hi"`)}}, ResponseFormat: &ResponseFormat{Type: "json_schema"}},
+	}
+	for i, req := range invalid {
+		if _, err := ChatTurn(req); !errors.Is(err, ErrInvalidRequest) {
+			t.Fatalf("case %d: err = %v, want ErrInvalidRequest", i, err)
+		}
+	}
+}
+
+func TestResponseTurn(t *testing.T) {
+	turn, err := ResponseTurn(ResponseRequest{Input: json.RawMessage(`"Hello"`), Instructions: "Be brief."})
+	if err != nil || turn.Query != "Hello" || turn.Instructions != "Be brief." {
+		t.Fatalf("string input: turn = %+v, err = %v", turn, err)
+	}
+
+	turn, err = ResponseTurn(ResponseRequest{
+		Input: json.RawMessage(`[
+			{"role":"developer","content":"Use metric units."},
+			{"type":"function_call_output","call_id":"c1","output":"ignored"},
+			{"type":"message","role":"user","content":[{"type":"input_text","text":"How far?"}]}
+		]`),
+		Instructions: "Be brief.",
+		Text:         &ResponseTextConfig{Format: &ResponseTextFormat{Type: "json_schema", Schema: json.RawMessage(`{"type":"object"}`)}},
+	})
+	if err != nil {
+		t.Fatalf("ResponseTurn() error = %v", err)
+	}
+	if turn.Query != "How far?" || turn.Instructions != "Be brief.\n\nUse metric units." || len(turn.OutputSchema) == 0 {
+		t.Fatalf("turn = %+v", turn)
+	}
+
+	if _, err := ResponseTurn(ResponseRequest{}); !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("empty input err = %v", err)
+	}
+}
+
+func TestReplyFromMessages(t *testing.T) {
+	messages := []conversation.ModelMessage{
+		{Role: "assistant", Content: json.RawMessage(`[{"type":"reasoning","text":"Look it up."},{"type":"text","text":"Checking."},{"type":"tool-call","toolCallId":"c1","toolName":"web_search","input":{"q":"weather"}}]`)},
+		{Role: "tool", Content: json.RawMessage(`[{"type":"tool-result","toolCallId":"c1","toolName":"web_search","output":"sunny"}]`)},
+		{Role: "assistant", Content: json.RawMessage(`[{"type":"text","text":"It is sunny."}]`)},
+	}
+	reply := ReplyFromMessages(messages, nil, json.RawMessage(`{"inputTokens":10,"outputTokens":5}`))
+	if reply.Text != "Checking.\n\nIt is sunny." || reply.Reasoning != "Look it up." {
+		t.Fatalf("reply = %+v", reply)
+	}
+	if len(reply.Annotations) != 1 {
+		t.Fatalf("annotations = %+v", reply.Annotations)
+	}
+	call := reply.Annotations[0].ToolCall
+	if call.Name != "web_search" || call.Status != ToolCallCompleted || call.Arguments != `{"q":"weather"}` {
+		t.Fatalf("tool call = %+v", call)
+	}
+	if reply.Usage.TotalTokens != 15 {
+		t.Fatalf("usage = %+v", reply.Usage)
+	}
+
+	structured := ReplyFromMessages(messages, json.RawMessage(`{"sky":"sunny"}`), nil)
+	if structured.Text != `{"sky":"sunny"}` {
+		t.Fatalf("structured text = %q", structured.Text)
+	}
+}
+
+func TestResponseIDCarriesSession(t *testing.T) {
+	sessionID := "0b4f5f0e-3c1a-4d7e-9a55-2f6a1c3d9e10"
+	id := NewResponseID(sessionID)
+	got, ok := SessionFromResponseID(id)
+	if !ok || got != sessionID {
+		t.Fatalf("SessionFromResponseID(%q) = %q, %v", id, got, ok)
+	}
+	for _, bad := range []string{"", "resp_123", "chatcmpl-abc", "resp_zz4f5f0e3c1a4d7e9a552f6a1c3d9e10"} {
+		if _, ok := SessionFromResponseID(bad); ok {
+			t.Fatalf("SessionFromResponseID(%q) accepted", bad)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package openaicompat
+
+import (
+	"encoding/json"
+	"strings"
+
+	agentpkg "github.com/memohai/memoh/internal/agent"
+)
+
+// textBlockSeparator joins the text of consecutive agent steps, matching
+// the non-streamed reply.
+const textBlockSeparator = "\n\n"
+
+// runState tracks what both stream encoders need from the agent events.
+type runState struct {
+	buffered   bool
+	textBlocks int
+	text       strings.Builder
+	announced  map[string]bool
+	lastError  string
+	terminal   *agentpkg.StreamEvent
+}
+
+func newRunState(buffered bool) runState {
+	return runState{buffered: buffered, announced: map[string]bool{}}
+}
+
+// textDelta returns the text to emit for a text event. With buffering the
+// text is only collected, because a structured output run may still repair
+// its reply.
+func (s *runState) textDelta(event agentpkg.StreamEvent) string {
+	var delta string
+	switch event.Type {
+	case agentpkg.EventTextStart:
+		if s.textBlocks > 0 {
+			delta = textBlockSeparator
+		}
+		s.textBlocks++
+	case agentpkg.EventTextDelta:
+		delta = event.Delta
+	}
+	s.text.WriteString(delta)
+	if s.buffered {
+		return ""
+	}
+	return delta
+}
+
+// finalText is the text a buffered stream emits once the run ended: the
+// validated structured output when there is one.
+func (s *runState) finalText() string {
+	if s.terminal != nil && len(s.terminal.Structured) > 0 {
+		return string(s.terminal.Structured)
+	}
+	return s.text.String()
+}
+
+// annotation maps tool events to annotations. The terminal event of a run
+// stopped by a pending approval also yields one, unless the approval was
+// already announced.
+func (s *runState) annotation(event agentpkg.StreamEvent) (Annotation, bool) {
+	call := ToolCallAnnotation{ID: event.ToolCallID, Name: event.ToolName}
+	switch event.Type {
+	case agentpkg.EventToolCallStart:
+		call.Status = ToolCallStarted
+		if event.Input != nil {
+			if raw, err := json.Marshal(event.Input); err == nil {
+				call.Arguments = string(raw)
+			}
+		}
+	case agentpkg.EventToolCallEnd:
+		call.Status = ToolCallCompleted
+	case agentpkg.EventToolApprovalRequest, agentpkg.EventAgentEnd, agentpkg.EventAgentAbort:
+		if event.ApprovalID == "" || event.UserInputID != "" || s.announced[event.ApprovalID] {
+			return Annotation{}, false
+		}
+		s.announced[event.ApprovalID] = true
+		call.Status = ToolCallApprovalRequired
+		call.ApprovalID = event.ApprovalID
+	default:
+		return Annotation{}, false
+	}
+	return Annotation{Type: AnnotationTypeToolCall, ToolCall: &call}, true
+}
+
+// observe records errors and the terminal event. It reports whether the
+// event ended the run.
+func (s *runState) observe(event agentpkg.StreamEvent) bool {
+	if event.Type == agentpkg.EventError && strings.TrimSpace(event.Error) != "" {
+		s.lastError = strings.TrimSpace(event.Error)
+	}
+	if event.IsTerminal() {
+		s.terminal = &event
+		return true
+	}
+	return false
+}
+
+// failure returns why the run failed, or "" when it produced an answer. An
+// abort without an error is a cancellation and keeps the partial answer.
+func (s *runState) failure() string {
+	if s.terminal == nil {
+		return ""
+	}
+	if s.terminal.Type == agentpkg.EventAgentAbort || (s.buffered && s.terminal.ApprovalID == "" && len(s.terminal.Structured) == 0) {
+		return s.lastError
+	}
+	return ""
+}
+
+// pendingApproval reports whether the run stopped to wait for a person.
+func (s *runState) pendingApproval() bool {
+	return s.terminal != nil && s.terminal.ApprovalID != ""
+}
+
+// ChatStream turns agent stream events into chat completion chunks.
+type ChatStream struct {
+	id           string
+	model        string
+	created      int64
+	includeUsage bool
+	started      bool
+	state        runState
+}
+
+// NewChatStream creates the encoder of one streamed chat completion.
+// structured buffers the reply until the validated output is known.
+func NewChatStream(id, model string, created int64, includeUsage, structured bool) *ChatStream {
+	return &ChatStream{
+		id:           id,
+		model:        model,
+		created:      created,
+		includeUsage: includeUsage,
+		state:        newRunState(structured),
+	}
+}
+
+// Handle returns the chunks for one agent event.
+func (s *ChatStream) Handle(event agentpkg.StreamEvent) []ChatCompletionChunk {
+	if s.state.terminal != nil {
+		return nil
+	}
+	var chunks []ChatCompletionChunk
+	if !s.started {
+		s.started = true
+		chunks = append(chunks, s.chunk(ChatDelta{Role: "assistant"}, nil))
+	}
+	if annotation, ok := s.state.annotation(event); ok {
+		chunks = append(chunks, s.chunk(ChatDelta{Annotations: []Annotation{annotation}}, nil))
+	}
+	switch event.Type {
+	case agentpkg.EventTextStart, agentpkg.EventTextDelta:
+		if delta := s.state.textDelta(event); delta != "" {
+			chunks = append(chunks, s.chunk(ChatDelta{Content: delta}, nil))
+		}
+	case agentpkg.EventReasoningDelta:
+		if event.Delta != "" {
+			chunks = append(chunks, s.chunk(ChatDelta{ReasoningContent: event.Delta}, nil))
+		}
+	}
+	if !s.state.observe(event) || s.state.failure() != "" {
+		return chunks
+	}
+
+	if s.state.buffered {
+		if text := s.state.finalText(); text != "" {
+			chunks = append(chunks, s.chunk(ChatDelta{Content: text}, nil))
+		}
+	}
+	finish := FinishReasonStop
+	chunks = append(chunks, s.chunk(ChatDelta{}, &finish))
+	if s.includeUsage {
+		chunks = append(chunks, ChatCompletionChunk{
+			ID:      s.id,
+			Object:  ObjectChatCompletionChunk,
+			Created: s.created,
+			Model:   s.model,
+			Choices: []ChatChunkChoice{},
+			Usage:   ParseUsage(s.state.terminal.Usage).Chat(),
+		})
+	}
+	return chunks
+}
+
+// Done reports whether the run ended.
+func (s *ChatStream) Done() bool {
+	return s.state.terminal != nil
+}
+
+// Failure returns why the run failed, or "" when it succeeded.
+func (s *ChatStream) Failure() string {
+	return s.state.failure()
+}
+
+func (s *ChatStream) chunk(delta ChatDelta, finish *string) ChatCompletionChunk {
+	return ChatCompletionChunk{
+		ID:      s.id,
+		Object:  ObjectChatCompletionChunk,
+		Created: s.created,
+		Model:   s.model,
+		Choices: []ChatChunkChoice{{Delta: delta, FinishReason: finish}},
+	}
+}
+
+// ResponseStreamEvent is one server-sent event of a streamed response. Type
+// is also the SSE event name.
+type ResponseStreamEvent struct {
+	Type            string              `json:"type"`
+	SequenceNumber  int                 `json:"sequence_number"`
+	Response        *Response           `json:"response,omitempty"`
+	OutputIndex     *int                `json:"output_index,omitempty"`
+	ContentIndex    *int                `json:"content_index,omitempty"`
+	ItemID          string              `json:"item_id,omitempty"`
+	Item            *ResponseOutputItem `json:"item,omitempty"`
+	Part            *ResponseContent    `json:"part,omitempty"`
+	Delta           string              `json:"delta,omitempty"`
+	Text            *string             `json:"text,omitempty"`
+	AnnotationIndex *int                `json:"annotation_index,omitempty"`
+	Annotation      *Annotation         `json:"annotation,omitempty"`
+}
+
+// ResponseStream turns agent stream events into Responses API events. The
+// response has a single output message that is opened on its first text or
+// annotation.
+type ResponseStream struct {
+	response    Response
+	itemID      string
+	opened      bool
+	annotations []Annotation
+	sequence    int
+	state       runState
+}
+
+// NewResponseStream creates the encoder of one streamed response.
+// structured buffers the reply until the validated output is known.
+func NewResponseStream(id, model, previousResponseID string, created int64, structured bool) *ResponseStream {
+	return &ResponseStream{
+		response: Response{
+			ID:                 id,
+			Object:             ObjectResponse,
+			CreatedAt:          created,
+			Status:             ResponseStatusInProgress,
+			Model:              model,
+			Output:             []ResponseOutputItem{},
+			PreviousResponseID: previousResponseID,
+		},
+		itemID: messageID(id),
+		state:  newRunState(structured),
+	}
+}
+
+// Start returns the events announcing the response.
+func (s *ResponseStream) Start() []ResponseStreamEvent {
+	return []ResponseStreamEvent{
+		s.event(ResponseStreamEvent{Type: "response.created", Response: s.snapshot()}),
+		s.event(ResponseStreamEvent{Type: "response.in_progress", Response: s.snapshot()}),
+	}
+}
+
+// Handle returns the events for one agent event.
+func (s *ResponseStream) Handle(event agentpkg.StreamEvent) []ResponseStreamEvent {
+	if s.state.terminal != nil {
+		return nil
+	}
+	var events []ResponseStreamEvent
+	if annotation, ok := s.state.annotation(event); ok {
+		events = append(events, s.open()...)
+		index := len(s.annotations)
+		s.annotations = append(s.annotations, annotation)
+		events = append(events, s.event(ResponseStreamEvent{
+			Type:            "response.output_text.annotation.added",
+			ItemID:          s.itemID,
+			OutputIndex:     intPtr(0),
+			ContentIndex:    intPtr(0),
+			AnnotationIndex: intPtr(index),
+			Annotation:      &annotation,
+		}))
+	}
+	if event.Type == agentpkg.EventTextStart || event.Type == agentpkg.EventTextDelta {
+		if delta := s.state.textDelta(event); delta != "" {
+			events = append(events, s.open()...)
+			events = append(events, s.textDeltaEvent(delta))
+		}
+	}
+	if !s.state.observe(event) {
+		return events
+	}
+	if failure := s.state.failure(); failure != "" {
+		return append(events, s.Fail(failure)...)
+	}
+
+	events = append(events, s.open()...)
+	text := s.state.text.String()
+	if s.state.buffered {
+		text = s.state.finalText()
+		if text != "" {
+			events = append(events, s.textDeltaEvent(text))
+		}
+	}
+	status := ResponseStatusCompleted
+	switch {
+	case s.state.pendingApproval():
+		status = ResponseStatusIncomplete
+		s.response.IncompleteDetails = &IncompleteDetails{Reason: "approval_required"}
+	case s.state.terminal.Type == agentpkg.EventAgentAbort:
+		status = ResponseStatusIncomplete
+		s.response.IncompleteDetails = &IncompleteDetails{Reason: "interrupted"}
+	}
+	item := outputMessage(s.itemID, ResponseStatusCompleted, text, s.annotations)
+	events = append(events,
+		s.event(ResponseStreamEvent{Type: "response.output_text.done", ItemID: s.itemID, OutputIndex: intPtr(0), ContentIndex: intPtr(0), Text: &text}),
+		s.event(ResponseStreamEvent{Type: "response.content_part.done", ItemID: s.itemID, OutputIndex: intPtr(0), ContentIndex: intPtr(0), Part: &item.Content[0]}),
+		s.event(ResponseStreamEvent{Type: "response.output_item.done", OutputIndex: intPtr(0), Item: &item}),
+	)
+	s.response.Status = status
+	s.response.Output = []ResponseOutputItem{item}
+	s.response.Usage = ParseUsage(s.state.terminal.Usage).Response()
+	eventType := "response.completed"
+	if status == ResponseStatusIncomplete {
+		eventType = "response.incomplete"
+	}
+	return append(events, s.event(ResponseStreamEvent{Type: eventType, Response: s.snapshot()}))
+}
+
+// Fail ends the response with an error.
+func (s *ResponseStream) Fail(message string) []ResponseStreamEvent {
+	s.response.Status = ResponseStatusFailed
+	s.response.Error = &ResponseError{Code: "server_error", Message: message}
+	if s.state.terminal == nil {
+		s.state.terminal = &agentpkg.StreamEvent{Type: agentpkg.EventAgentAbort}
+	}
+	if s.opened {
+		s.response.Output = []ResponseOutputItem{outputMessage(s.itemID, ResponseStatusIncomplete, s.state.text.String(), s.annotations)}
+	}
+	return []ResponseStreamEvent{s.event(ResponseStreamEvent{Type: "response.failed", Response: s.snapshot()})}
+}
+
+// Done reports whether the response ended.
+func (s *ResponseStream) Done() bool {
+	return s.state.terminal != nil
+}
+
+func (s *ResponseStream) open() []ResponseStreamEvent {
+	if s.opened {
+		return nil
+	}
+	s.opened = true
+	item := outputMessage(s.itemID, ResponseStatusInProgress, "", nil)
+	part := item.Content[0]
+	item.Content = []ResponseContent{}
+	return []ResponseStreamEvent{
+		s.event(ResponseStreamEvent{Type: "response.output_item.added", OutputIndex: intPtr(0), Item: &item}),
+		s.event(ResponseStreamEvent{Type: "response.content_part.added", ItemID: s.itemID, OutputIndex: intPtr(0), ContentIndex: intPtr(0), Part: &part}),
+	}
+}
+
+func (s *ResponseStream) textDeltaEvent(delta string) ResponseStreamEvent {
+	return s.event(ResponseStreamEvent{
+		Type:         "response.output_text.delta",
+		ItemID:       s.itemID,
+		OutputIndex:  intPtr(0),
+		ContentIndex: intPtr(0),
+		Delta:        delta,
+	})
+}
+
+func (s *ResponseStream) event(event ResponseStreamEvent) ResponseStreamEvent {
+	event.SequenceNumber = s.sequence
+	s.sequence++
+	return event
+}
+
+// snapshot copies the response so events already handed out keep the state
+// they were sent with.
+func (s *ResponseStream) snapshot() *Response {
+	response := s.response
+	response.Output = append([]ResponseOutputItem(nil), s.response.Output...)
+	if response.Output == nil {
+		response.Output = []ResponseOutputItem{}
+	}
+	return &response
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -0,0 +1,138 @@
+package openaicompat
+
+import (
+	"encoding/json"
+	"testing"
+
+	agentpkg "github.com/memohai/memoh/internal/agent"
+)
+
+func toolRunEvents() []agentpkg.StreamEvent {
+	return []agentpkg.StreamEvent{
+		{Type: agentpkg.EventAgentStart},
+		{Type: agentpkg.EventTextStart},
+		{Type: agentpkg.EventTextDelta, Delta: "Checking."},
+		{Type: agentpkg.EventTextEnd},
+		{Type: agentpkg.EventToolCallStart, ToolCallID: "c1", ToolName: "web_search", Input: map[string]any{"q": "weather"}},
+		{Type: agentpkg.EventToolCallEnd, ToolCallID: "c1", ToolName: "web_search"},
+		{Type: agentpkg.EventTextStart},
+		{Type: agentpkg.EventTextDelta, Delta: "Sunny."},
+		{Type: agentpkg.EventTextEnd},
+		{Type: agentpkg.EventAgentEnd, Usage: json.RawMessage(`{"inputTokens":3,"outputTokens":2,"totalTokens":5}`)},
+	}
+}
+
+func TestChatStream(t *testing.T) {
+	stream := NewChatStream("chatcmpl-1", "bot", 1, true, false)
+	var chunks []ChatCompletionChunk
+	for _, event := range toolRunEvents() {
+		chunks = append(chunks, stream.Handle(event)...)
+	}
+	if !stream.Done() || stream.Failure() != "" {
+		t.Fatalf("done = %v, failure = %q", stream.Done(), stream.Failure())
+	}
+
+	var content string
+	var statuses []string
+	var finished bool
+	for _, chunk := range chunks {
+		if chunk.Usage != nil {
+			if chunk.Usage.TotalTokens != 5 || len(chunk.Choices) != 0 {
+				t.Fatalf("usage chunk = %+v", chunk)
+			}
+			continue
+		}
+		choice := chunk.Choices[0]
+		content += choice.Delta.Content
+		for _, annotation := range choice.Delta.Annotations {
+			statuses = append(statuses, annotation.ToolCall.Status)
+		}
+		if choice.FinishReason != nil {
+			finished = *choice.FinishReason == FinishReasonStop
+		}
+	}
+	if chunks[0].Choices[0].Delta.Role != "assistant" {
+		t.Fatalf("first chunk = %+v", chunks[0])
+	}
+	if content != "Checking.\n\nSunny." {
+		t.Fatalf("content = %q", content)
+	}
+	if len(statuses) != 2 || statuses[0] != ToolCallStarted || statuses[1] != ToolCallCompleted {
+		t.Fatalf("annotation statuses = %v", statuses)
+	}
+	if !finished {
+		t.Fatal("missing finish chunk")
+	}
+}
+
+func TestChatStreamStructuredAndFailure(t *testing.T) {
+	stream := NewChatStream("chatcmpl-2", "bot", 1, false, true)
+	var content string
+	for _, event := range []agentpkg.StreamEvent{
+		{Type: agentpkg.EventTextStart},
+		{Type: agentpkg.EventTextDelta, Delta: "not json"},
+		{Type: agentpkg.EventAgentEnd, Structured: json.RawMessage(`{"ok":true}`)},
+	} {
+		for _, chunk := range stream.Handle(event) {
+			content += chunk.Choices[0].Delta.Content
+		}
+	}
+	if content != `{"ok":true}` {
+		t.Fatalf("structured content = %q", content)
+	}
+
+	failed := NewChatStream("chatcmpl-3", "bot", 1, false, false)
+	failed.Handle(agentpkg.StreamEvent{Type: agentpkg.EventError, Error: "provider down"})
+	for _, chunk := range failed.Handle(agentpkg.StreamEvent{Type: agentpkg.EventAgentAbort}) {
+		if chunk.Choices[0].FinishReason != nil {
+			t.Fatal("failed stream must not finish normally")
+		}
+	}
+	if failed.Failure() != "provider down" {
+		t.Fatalf("failure = %q", failed.Failure())
+	}
+}
+
+func TestResponseStream(t *testing.T) {
+	stream := NewResponseStream("resp_1", "bot", "", 1, false)
+	events := stream.Start()
+	for _, event := range toolRunEvents() {
+		events = append(events, stream.Handle(event)...)
+	}
+
+	var types []string
+	for i, event := range events {
+		if event.SequenceNumber != i {
+			t.Fatalf("event %d has sequence number %d", i, event.SequenceNumber)
+		}
+		types = append(types, event.Type)
+	}
+	if types[0] != "response.created" || types[len(types)-1] != "response.completed" {
+		t.Fatalf("event types = %v", types)
+	}
+	final := events[len(events)-1].Response
+	if final.Status != ResponseStatusCompleted || final.Usage.TotalTokens != 5 {
+		t.Fatalf("final response = %+v", final)
+	}
+	content := final.Output[0].Content[0]
+	if content.Text != "Checking.\n\nSunny." || len(content.Annotations) != 2 {
+		t.Fatalf("final content = %+v", content)
+	}
+	if events[0].Response.Status != ResponseStatusInProgress || len(events[0].Response.Output) != 0 {
+		t.Fatalf("created event was mutated: %+v", events[0].Response)
+	}
+}
+
+func TestResponseStreamPendingApproval(t *testing.T) {
+	stream := NewResponseStream("resp_2", "bot", "", 1, false)
+	stream.Start()
+	events := stream.Handle(agentpkg.StreamEvent{Type: agentpkg.EventAgentEnd, ApprovalID: "a1", ToolName: "exec", Status: "pending"})
+	last := events[len(events)-1]
+	if last.Type != "response.incomplete" || last.Response.IncompleteDetails.Reason != "approval_required" {
+		t.Fatalf("last event = %+v", last)
+	}
+	annotations := last.Response.Output[0].Content[0].Annotations
+	if len(annotations) != 1 || annotations[0].ToolCall.ApprovalID != "a1" {
+		t.Fatalf("annotations = %+v", annotations)
+	}
+}
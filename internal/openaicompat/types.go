@@ -0,0 +1,286 @@
+// Package openaicompat translates between the OpenAI Chat Completions and
+// Responses wire formats and Memoh bot runs, so OpenAI SDK based tools can
+// talk to a bot as if it were a model. A request's model names the bot; the
+// bot answers with its own persona, tools, memory and model settings.
+package openaicompat
+
+import "encoding/json"
+
+// SessionHeader carries the Memoh session a request continues, and the
+// session a response was stored in.
+const SessionHeader = "X-Memoh-Session-Id"
+
+// Object names used in response payloads.
+const (
+	ObjectChatCompletion      = "chat.completion"
+	ObjectChatCompletionChunk = "chat.completion.chunk"
+	ObjectResponse            = "response"
+	ObjectModel               = "model"
+	ObjectList                = "list"
+)
+
+// Finish reasons of a chat completion choice.
+const (
+	FinishReasonStop   = "stop"
+	FinishReasonLength = "length"
+)
+
+// Response statuses.
+const (
+	ResponseStatusInProgress = "in_progress"
+	ResponseStatusCompleted  = "completed"
+	ResponseStatusIncomplete = "incomplete"
+	ResponseStatusFailed     = "failed"
+)
+
+// Tool call annotation statuses.
+const (
+	ToolCallStarted          = "started"
+	ToolCallCompleted        = "completed"
+	ToolCallApprovalRequired = "approval_required"
+)
+
+// AnnotationTypeToolCall marks an annotation describing a tool the bot used.
+const AnnotationTypeToolCall = "tool_call"
+
+// ChatCompletionRequest is the subset of a Chat Completions request a bot
+// understands. Sampling parameters and client-side tools are ignored: the bot
+// runs with its own model settings and tools.
+type ChatCompletionRequest struct {
+	Model           string          `json:"model"`
+	Messages        []ChatMessage   `json:"messages"`
+	Stream          bool            `json:"stream,omitempty"`
+	StreamOptions   *StreamOptions  `json:"stream_options,omitempty"`
+	ReasoningEffort string          `json:"reasoning_effort,omitempty"`
+	ResponseFormat  *ResponseFormat `json:"response_format,omitempty"`
+	User            string          `json:"user,omitempty"`
+}
+
+// StreamOptions controls optional chunks of a streamed chat completion.
+type StreamOptions struct {
+	IncludeUsage bool `json:"include_usage,omitempty"`
+}
+
+// ChatMessage is one input message. Content is a string or an array of
+// content parts.
+type ChatMessage struct {
+	Role    string          `json:"role"`
+	Content json.RawMessage `json:"content,omitempty" swaggertype:"object"`
+	Name    string          `json:"name,omitempty"`
+}
+
+// ContentPart is one element of an array message content, in either the
+// Chat Completions or the Responses flavour.
+type ContentPart struct {
+	Type     string          `json:"type"`
+	Text     string          `json:"text,omitempty"`
+	ImageURL json.RawMessage `json:"image_url,omitempty"`
+}
+
+// ResponseFormat asks for JSON output. Only json_schema is enforced; the
+// schema is validated the same way as a chat request's output schema.
+type ResponseFormat struct {
+	Type       string      `json:"type"`
+	JSONSchema *JSONSchema `json:"json_schema,omitempty"`
+}
+
+// JSONSchema is the named schema of a json_schema response format.
+type JSONSchema struct {
+	Name   string          `json:"name,omitempty"`
+	Schema json.RawMessage `json:"schema,omitempty" swaggertype:"object"`
+	Strict bool            `json:"strict,omitempty"`
+}
+
+// ChatCompletion is a non-streamed Chat Completions response.
+type ChatCompletion struct {
+	ID      string       `json:"id"`
+	Object  string       `json:"object"`
+	Created int64        `json:"created"`
+	Model   string       `json:"model"`
+	Choices []ChatChoice `json:"choices"`
+	Usage   *ChatUsage   `json:"usage,omitempty"`
+}
+
+// ChatChoice is the single choice of a chat completion.
+type ChatChoice struct {
+	Index        int                 `json:"index"`
+	Message      ChatResponseMessage `json:"message"`
+	FinishReason string              `json:"finish_reason"`
+}
+
+// ChatResponseMessage is the assistant message of a chat completion.
+// ReasoningContent follows the convention of OpenAI-compatible reasoning
+// models.
+type ChatResponseMessage struct {
+	Role             string       `json:"role"`
+	Content          string       `json:"content"`
+	ReasoningContent string       `json:"reasoning_content,omitempty"`
+	Annotations      []Annotation `json:"annotations,omitempty"`
+}
+
+// ChatCompletionChunk is one server-sent event of a streamed chat
+// completion.
+type ChatCompletionChunk struct {
+	ID      string            `json:"id"`
+	Object  string            `json:"object"`
+	Created int64             `json:"created"`
+	Model   string            `json:"model"`
+	Choices []ChatChunkChoice `json:"choices"`
+	Usage   *ChatUsage        `json:"usage,omitempty"`
+}
+
+// ChatChunkChoice is the delta of a chunk. FinishReason is null until the
+// last content chunk.
+type ChatChunkChoice struct {
+	Index        int       `json:"index"`
+	Delta        ChatDelta `json:"delta"`
+	FinishReason *string   `json:"finish_reason"`
+}
+
+// ChatDelta is the incremental assistant message of a chunk.
+type ChatDelta struct {
+	Role             string       `json:"role,omitempty"`
+	Content          string       `json:"content,omitempty"`
+	ReasoningContent string       `json:"reasoning_content,omitempty"`
+	Annotations      []Annotation `json:"annotations,omitempty"`
+}
+
+// ChatUsage is token usage in Chat Completions form.
+type ChatUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// Annotation reports a tool the bot used while answering. Tools run on the
+// server, so they are surfaced as annotations rather than tool calls a
+// client would try to execute; clients ignore annotation types they do not
+// know.
+type Annotation struct {
+	Type     string              `json:"type"`
+	ToolCall *ToolCallAnnotation `json:"tool_call,omitempty"`
+}
+
+// ToolCallAnnotation describes one tool call. ApprovalID is set when the
+// call waits for approval in Memoh.
+type ToolCallAnnotation struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Arguments  string `json:"arguments,omitempty"`
+	ApprovalID string `json:"approval_id,omitempty"`
+}
+
+// ResponseRequest is the subset of a Responses API request a bot
+// understands. Input is a string or an array of input items.
+// PreviousResponseID continues the session of an earlier response.
+type ResponseRequest struct {
+	Model              string              `json:"model"`
+	Input              json.RawMessage     `json:"input" swaggertype:"object"`
+	Instructions       string              `json:"instructions,omitempty"`
+	PreviousResponseID string              `json:"previous_response_id,omitempty"`
+	Stream             bool                `json:"stream,omitempty"`
+	Reasoning          *ResponseReasoning  `json:"reasoning,omitempty"`
+	Text               *ResponseTextConfig `json:"text,omitempty"`
+	User               string              `json:"user,omitempty"`
+}
+
+// ResponseReasoning selects the reasoning effort of the bot's model.
+type ResponseReasoning struct {
+	Effort string `json:"effort,omitempty"`
+}
+
+// ResponseTextConfig configures the text output of a response.
+type ResponseTextConfig struct {
+	Format *ResponseTextFormat `json:"format,omitempty"`
+}
+
+// ResponseTextFormat is the Responses flavour of a response format.
+type ResponseTextFormat struct {
+	Type   string          `json:"type"`
+	Name   string          `json:"name,omitempty"`
+	Schema json.RawMessage `json:"schema,omitempty" swaggertype:"object"`
+	Strict bool            `json:"strict,omitempty"`
+}
+
+// ResponseInputItem is one element of an array input. Items other than
+// messages, such as function call outputs, are ignored.
+type ResponseInputItem struct {
+	Type    string          `json:"type,omitempty"`
+	Role    string          `json:"role,omitempty"`
+	Content json.RawMessage `json:"content,omitempty" swaggertype:"object"`
+}
+
+// Response is a Responses API response object.
+type Response struct {
+	ID                 string               `json:"id"`
+	Object             string               `json:"object"`
+	CreatedAt          int64                `json:"created_at"`
+	Status             string               `json:"status"`
+	Model              string               `json:"model"`
+	Output             []ResponseOutputItem `json:"output"`
+	PreviousResponseID string               `json:"previous_response_id,omitempty"`
+	Error              *ResponseError       `json:"error,omitempty"`
+	IncompleteDetails  *IncompleteDetails   `json:"incomplete_details,omitempty"`
+	Usage              *ResponseUsage       `json:"usage,omitempty"`
+}
+
+// ResponseOutputItem is an output message of a response.
+type ResponseOutputItem struct {
+	Type    string            `json:"type"`
+	ID      string            `json:"id"`
+	Status  string            `json:"status"`
+	Role    string            `json:"role"`
+	Content []ResponseContent `json:"content"`
+}
+
+// ResponseContent is an output_text part of an output message.
+type ResponseContent struct {
+	Type        string       `json:"type"`
+	Text        string       `json:"text"`
+	Annotations []Annotation `json:"annotations"`
+}
+
+// ResponseError explains a failed response.
+type ResponseError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// IncompleteDetails explains an incomplete response.
+type IncompleteDetails struct {
+	Reason string `json:"reason"`
+}
+
+// ResponseUsage is token usage in Responses form.
+type ResponseUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+	TotalTokens  int `json:"total_tokens"`
+}
+
+// Model is an entry of the model list; each accessible bot is one model.
+type Model struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+}
+
+// ModelList is the response of the model list endpoint.
+type ModelList struct {
+	Object string  `json:"object"`
+	Data   []Model `json:"data"`
+}
+
+// ErrorResponse is the OpenAI error envelope.
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody describes an API error.
+type ErrorBody struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Code    string `json:"code,omitempty"`
+}
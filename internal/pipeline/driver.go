@@ -290,6 +290,13 @@ func (d *DiscussDriver) handleReplyWithAgent(ctx context.Context, sess *discussS
 	lateBinding := buildLateBindingPrompt(isMentioned)
 	runConfig.Messages = append(runConfig.Messages, sdk.UserMessage(lateBinding))
 
+	// The agent reports a switch to a fallback model before streaming, so the
+	// round is stored against the model that actually answered.
+	modelID := resolved.ModelID
+	runConfig.OnModelFallback = func(candidate agentpkg.ModelCandidate) {
+		modelID = candidate.ModelID
+	}
+
 	eventCh := agent.Stream(ctx, runConfig)
 
 	var finalMessages json.RawMessage
@@ -309,7 +316,7 @@ func (d *DiscussDriver) handleReplyWithAgent(ctx context.Context, sess *discussS
 		if json.Unmarshal(finalMessages, &sdkMsgs) == nil && len(sdkMsgs) > 0 {
 			if storeErr := d.deps.Resolver.StoreRound(ctx,
 				cfg.BotID, cfg.SessionID, cfg.ChannelIdentityID, cfg.CurrentPlatform,
-				sdkMsgs, resolved.ModelID,
+				sdkMsgs, modelID,
 			); storeErr != nil {
 				log.Error("discuss: store round failed", slog.Any("error", storeErr))
 			}
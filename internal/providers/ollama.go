@@ -0,0 +1,77 @@
+package providers
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	"github.com/memohai/memoh/internal/models"
+	"github.com/memohai/memoh/internal/ollama"
+)
+
+// fetchOllamaModels discovers installed models through the native Ollama
+// API, which unlike /v1/models reports capabilities, context length and
+// embedding width for each model.
+func (s *Service) fetchOllamaModels(ctx context.Context, provider sqlc.Provider) ([]RemoteModel, error) {
+	cfg := providerConfig(provider.Config)
+	baseURL := strings.TrimSpace(configString(cfg, "base_url"))
+	apiKey := configString(cfg, "api_key")
+
+	client := ollama.NewClient(baseURL, apiKey, models.NewProviderHTTPClient(probeTimeout))
+	discovered, err := client.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteModels := make([]RemoteModel, 0, len(discovered))
+	for _, m := range discovered {
+		remote := RemoteModel{
+			ID:      m.ID,
+			Name:    m.Name,
+			Object:  "model",
+			OwnedBy: "ollama",
+			Type:    string(m.Type),
+		}
+		if m.ContextWindow > 0 {
+			contextWindow := m.ContextWindow
+			remote.ContextWindow = &contextWindow
+		}
+		if m.Type == ollama.ModelTypeEmbedding {
+			dimensions := m.Dimensions
+			if dimensions <= 0 {
+				dimensions, err = models.InferEmbeddingDimensions(ctx, string(models.ClientTypeOllama), baseURL, apiKey, m.ID, probeTimeout, nil)
+				if err != nil {
+					s.logger.Warn("skip embedding model import because dimensions probe failed", slog.String("model_id", m.ID), slog.Any("error", err))
+					continue
+				}
+			}
+			remote.Dimensions = &dimensions
+		} else {
+			remote.Compatibilities = ollamaCompatibilities(m)
+		}
+		remoteModels = append(remoteModels, remote)
+	}
+	return remoteModels, nil
+}
+
+// ollamaCompatibilities maps /api/show capabilities to model compatibility
+// tokens. A model that reports no capabilities at all (older servers) gets
+// nil so the importer applies its defaults; otherwise the result is non-nil
+// even when empty.
+func ollamaCompatibilities(m ollama.DiscoveredModel) []string {
+	if len(m.Capabilities) == 0 {
+		return nil
+	}
+	compatibilities := make([]string, 0, 3)
+	if m.HasCapability(ollama.CapabilityVision) {
+		compatibilities = append(compatibilities, models.CompatVision)
+	}
+	if m.HasCapability(ollama.CapabilityTools) {
+		compatibilities = append(compatibilities, models.CompatToolCall)
+	}
+	if m.HasCapability(ollama.CapabilityThinking) {
+		compatibilities = append(compatibilities, models.CompatReasoning)
+	}
+	return compatibilities
+}
@@ -332,6 +332,9 @@ func (s *Service) FetchRemoteModels(ctx context.Context, id string) ([]RemoteMod
 		}
 		return remoteModels, nil
 	}
+	if models.ClientType(provider.ClientType) == models.ClientTypeOllama {
+		return s.fetchOllamaModels(ctx, provider)
+	}
 	if supportsOAuth(provider) {
 		catalog := openaicodex.Catalog()
 		remoteModels := make([]RemoteModel, 0, len(catalog))
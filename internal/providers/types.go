@@ -109,6 +109,7 @@ type RemoteModel struct {
 	Compatibilities  []string `json:"compatibilities,omitempty"`
 	ReasoningEfforts []string `json:"reasoning_efforts,omitempty"`
 	Dimensions       *int     `json:"dimensions,omitempty"`
+	ContextWindow    *int     `json:"context_window,omitempty"`
 }
 
 // ImportModelsResponse represents the response for importing models.
@@ -0,0 +1,289 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// TriggerKind identifies what fires a schedule.
+type TriggerKind string
+
+const (
+	// TriggerKindCron fires on the schedule's cron Pattern.
+	TriggerKindCron TriggerKind = "cron"
+	// TriggerKindEmail fires when a bound mailbox receives an email.
+	TriggerKindEmail TriggerKind = "email"
+	// TriggerKindChannelMessage fires when a channel message arrives on a route.
+	TriggerKindChannelMessage TriggerKind = "channel_message"
+	// TriggerKindBackgroundTask fires when a background task finishes.
+	TriggerKindBackgroundTask TriggerKind = "background_task"
+	// TriggerKindMCPStatus fires when an MCP connection probe changes status.
+	TriggerKindMCPStatus TriggerKind = "mcp_status"
+)
+
+// backgroundTaskCooldown is the least time between two runs of a
+// background_task schedule. Runs start background tasks themselves, so
+// schedules reacting to each other's tasks would otherwise loop unbounded.
+const backgroundTaskCooldown = time.Minute
+
+// eventCooldown returns the least time between two runs of a schedule
+// triggered by events of kind.
+func eventCooldown(kind TriggerKind) time.Duration {
+	if kind == TriggerKindBackgroundTask {
+		return backgroundTaskCooldown
+	}
+	return 0
+}
+
+// maxEventPayloadLen caps the event body rendered into the schedule prompt.
+const maxEventPayloadLen = 4000
+
+// TriggerFilter narrows which events fire an event-triggered schedule.
+// Empty fields match anything; fields that do not apply to the schedule's
+// trigger kind are rejected on create/update.
+type TriggerFilter struct {
+	// From is matched case-insensitively as a substring of the email sender.
+	From string `json:"from,omitempty"`
+	// Subject is a regular expression matched against the email subject.
+	Subject string `json:"subject,omitempty"`
+	// RouteID restricts channel_message triggers to a single conversation route.
+	RouteID string `json:"route_id,omitempty"`
+	// Pattern is a regular expression matched against the channel message text
+	// or the background task command.
+	Pattern string `json:"pattern,omitempty"`
+	// ConnectionID restricts mcp_status triggers to a single MCP connection.
+	ConnectionID string `json:"connection_id,omitempty"`
+	// Status matches the background task status (completed, failed, killed)
+	// or the new MCP connection status (connected, error).
+	Status string `json:"status,omitempty"`
+}
+
+// IsZero reports whether the filter matches every event of its kind.
+func (f TriggerFilter) IsZero() bool {
+	return f == TriggerFilter{}
+}
+
+// Event is an occurrence that may fire event-triggered schedules.
+type Event struct {
+	Kind  TriggerKind
+	BotID string
+
+	From         string
+	Subject      string
+	RouteID      string
+	Text         string
+	ConnectionID string
+	Status       string
+
+	// SessionID is the session that caused the event, if any. Schedules do
+	// not fire on events from their own runs' sessions.
+	SessionID string
+
+	// Payload is the human-readable description of the event rendered into
+	// the schedule prompt.
+	Payload string
+}
+
+// EmailEvent builds the event for an inbound email delivered to botID.
+func EmailEvent(botID, from, subject, body string) Event {
+	var sb strings.Builder
+	sb.WriteString("from: " + from + "\n")
+	sb.WriteString("subject: " + subject + "\n")
+	if body = strings.TrimSpace(body); body != "" {
+		sb.WriteString("\n" + truncatePayload(body))
+	}
+	return Event{
+		Kind:    TriggerKindEmail,
+		BotID:   botID,
+		From:    from,
+		Subject: subject,
+		Text:    body,
+		Payload: sb.String(),
+	}
+}
+
+// ChannelMessageEvent builds the event for an inbound channel message.
+func ChannelMessageEvent(botID, routeID, platform, sender, text string) Event {
+	var sb strings.Builder
+	sb.WriteString("platform: " + platform + "\n")
+	sb.WriteString("route_id: " + routeID + "\n")
+	if sender != "" {
+		sb.WriteString("sender: " + sender + "\n")
+	}
+	sb.WriteString("\n" + truncatePayload(strings.TrimSpace(text)))
+	return Event{
+		Kind:    TriggerKindChannelMessage,
+		BotID:   botID,
+		RouteID: routeID,
+		Text:    text,
+		Payload: sb.String(),
+	}
+}
+
+// BackgroundTaskEvent builds the event for a finished background task
+// started in sessionID.
+func BackgroundTaskEvent(botID, sessionID, taskID, status, command string, exitCode int32, outputTail string) Event {
+	var sb strings.Builder
+	sb.WriteString("task_id: " + taskID + "\n")
+	sb.WriteString("status: " + status + "\n")
+	fmt.Fprintf(&sb, "exit_code: %d\n", exitCode)
+	sb.WriteString("command: " + command + "\n")
+	if tail := strings.TrimSpace(outputTail); tail != "" {
+		sb.WriteString("\noutput (tail):\n" + truncatePayload(tail))
+	}
+	return Event{
+		Kind:      TriggerKindBackgroundTask,
+		BotID:     botID,
+		Text:      command,
+		Status:    status,
+		SessionID: sessionID,
+		Payload:   sb.String(),
+	}
+}
+
+// MCPStatusEvent builds the event for an MCP connection status change.
+func MCPStatusEvent(botID, connectionID, name, previousStatus, status, message string) Event {
+	var sb strings.Builder
+	sb.WriteString("connection_id: " + connectionID + "\n")
+	sb.WriteString("name: " + name + "\n")
+	sb.WriteString("status: " + previousStatus + " -> " + status + "\n")
+	if message = strings.TrimSpace(message); message != "" {
+		sb.WriteString("message: " + truncatePayload(message) + "\n")
+	}
+	return Event{
+		Kind:         TriggerKindMCPStatus,
+		BotID:        botID,
+		ConnectionID: connectionID,
+		Status:       status,
+		Payload:      sb.String(),
+	}
+}
+
+func truncatePayload(s string) string {
+	if len(s) <= maxEventPayloadLen {
+		return s
+	}
+	cut := maxEventPayloadLen
+	for cut > 0 && !isRuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + "\n…(truncated)"
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// normalizeTriggerKind maps an empty kind to cron and rejects unknown kinds.
+func normalizeTriggerKind(kind TriggerKind) (TriggerKind, error) {
+	switch TriggerKind(strings.TrimSpace(string(kind))) {
+	case "", TriggerKindCron:
+		return TriggerKindCron, nil
+	case TriggerKindEmail, TriggerKindChannelMessage, TriggerKindBackgroundTask, TriggerKindMCPStatus:
+		return TriggerKind(strings.TrimSpace(string(kind))), nil
+	default:
+		return "", fmt.Errorf("unsupported trigger kind: %s", kind)
+	}
+}
+
+// eventMatcher is a compiled TriggerFilter for one event-triggered schedule.
+type eventMatcher struct {
+	kind    TriggerKind
+	filter  TriggerFilter
+	subject *regexp.Regexp
+	pattern *regexp.Regexp
+}
+
+// compileEventMatcher validates filter against kind and compiles its regular
+// expressions.
+func compileEventMatcher(kind TriggerKind, filter TriggerFilter) (*eventMatcher, error) {
+	filter = TriggerFilter{
+		From:         strings.TrimSpace(filter.From),
+		Subject:      strings.TrimSpace(filter.Subject),
+		RouteID:      strings.TrimSpace(filter.RouteID),
+		Pattern:      strings.TrimSpace(filter.Pattern),
+		ConnectionID: strings.TrimSpace(filter.ConnectionID),
+		Status:       strings.TrimSpace(filter.Status),
+	}
+	var allowed TriggerFilter
+	switch kind {
+	case TriggerKindCron:
+		if !filter.IsZero() {
+			return nil, errors.New("trigger_filter is not supported for cron schedules")
+		}
+		return &eventMatcher{kind: kind}, nil
+	case TriggerKindEmail:
+		allowed = TriggerFilter{From: filter.From, Subject: filter.Subject}
+	case TriggerKindChannelMessage:
+		allowed = TriggerFilter{RouteID: filter.RouteID, Pattern: filter.Pattern}
+	case TriggerKindBackgroundTask:
+		allowed = TriggerFilter{Pattern: filter.Pattern, Status: filter.Status}
+	case TriggerKindMCPStatus:
+		allowed = TriggerFilter{ConnectionID: filter.ConnectionID, Status: filter.Status}
+	default:
+		return nil, fmt.Errorf("unsupported trigger kind: %s", kind)
+	}
+	if allowed != filter {
+		return nil, fmt.Errorf("trigger_filter has fields that do not apply to %s triggers", kind)
+	}
+	m := &eventMatcher{kind: kind, filter: filter}
+	if filter.Subject != "" {
+		re, err := regexp.Compile(filter.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subject filter: %w", err)
+		}
+		m.subject = re
+	}
+	if filter.Pattern != "" {
+		re, err := regexp.Compile(filter.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern filter: %w", err)
+		}
+		m.pattern = re
+	}
+	return m, nil
+}
+
+// matches reports whether ev satisfies the compiled filter.
+func (m *eventMatcher) matches(ev Event) bool {
+	if m == nil || ev.Kind != m.kind {
+		return false
+	}
+	f := m.filter
+	if f.From != "" && !strings.Contains(strings.ToLower(ev.From), strings.ToLower(f.From)) {
+		return false
+	}
+	if m.subject != nil && !m.subject.MatchString(ev.Subject) {
+		return false
+	}
+	if f.RouteID != "" && f.RouteID != ev.RouteID {
+		return false
+	}
+	if m.pattern != nil && !m.pattern.MatchString(ev.Text) {
+		return false
+	}
+	if f.ConnectionID != "" && f.ConnectionID != ev.ConnectionID {
+		return false
+	}
+	if f.Status != "" && !strings.EqualFold(f.Status, ev.Status) {
+		return false
+	}
+	return true
+}
+
+func encodeTriggerFilter(filter TriggerFilter) ([]byte, error) {
+	return json.Marshal(filter)
+}
+
+func decodeTriggerFilter(raw []byte) TriggerFilter {
+	var filter TriggerFilter
+	if len(raw) == 0 {
+		return filter
+	}
+	_ = json.Unmarshal(raw, &filter)
+	return filter
+}
@@ -0,0 +1,157 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/robfig/cron/v3"
+)
+
+func newValidationService() *Service {
+	return &Service{
+		parser: cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor),
+	}
+}
+
+func TestNormalizeTriggerKind(t *testing.T) {
+	if kind, err := normalizeTriggerKind(""); err != nil || kind != TriggerKindCron {
+		t.Fatalf("empty kind = %q, %v; want cron", kind, err)
+	}
+	if kind, err := normalizeTriggerKind(" email "); err != nil || kind != TriggerKindEmail {
+		t.Fatalf("email kind = %q, %v", kind, err)
+	}
+	if _, err := normalizeTriggerKind("webhook"); err == nil {
+		t.Fatal("expected unknown kind to be rejected")
+	}
+}
+
+func TestValidateTrigger(t *testing.T) {
+	svc := newValidationService()
+
+	if _, _, err := svc.validateTrigger(TriggerKindCron, "", TriggerFilter{}); err == nil {
+		t.Fatal("expected cron schedule without pattern to fail")
+	}
+	if _, _, err := svc.validateTrigger(TriggerKindCron, "not a cron", TriggerFilter{}); err == nil {
+		t.Fatal("expected invalid cron pattern to fail")
+	}
+	if _, _, err := svc.validateTrigger(TriggerKindCron, "0 9 * * *", TriggerFilter{From: "a@b"}); err == nil {
+		t.Fatal("expected cron schedule with filter to fail")
+	}
+	if _, _, err := svc.validateTrigger(TriggerKindEmail, "0 9 * * *", TriggerFilter{}); err == nil {
+		t.Fatal("expected event schedule with cron pattern to fail")
+	}
+	if _, _, err := svc.validateTrigger(TriggerKindEmail, "", TriggerFilter{RouteID: "r1"}); err == nil {
+		t.Fatal("expected route filter on email trigger to fail")
+	}
+	if _, _, err := svc.validateTrigger(TriggerKindChannelMessage, "", TriggerFilter{Pattern: "("}); err == nil {
+		t.Fatal("expected invalid regex to fail")
+	}
+
+	pattern, filter, err := svc.validateTrigger(TriggerKindChannelMessage, "  ", TriggerFilter{RouteID: " r1 ", Pattern: `^deploy\b`})
+	if err != nil {
+		t.Fatalf("validate channel trigger: %v", err)
+	}
+	if pattern != "" || filter.RouteID != "r1" || filter.Pattern != `^deploy\b` {
+		t.Fatalf("unexpected normalized trigger: %q %+v", pattern, filter)
+	}
+}
+
+func TestEventMatcher(t *testing.T) {
+	tests := []struct {
+		name   string
+		kind   TriggerKind
+		filter TriggerFilter
+		event  Event
+		want   bool
+	}{
+		{
+			name:   "email sender and subject",
+			kind:   TriggerKindEmail,
+			filter: TriggerFilter{From: "@Example.com", Subject: `(?i)invoice`},
+			event:  EmailEvent("bot", "Billing <billing@example.com>", "Your INVOICE #42", "body"),
+			want:   true,
+		},
+		{
+			name:   "email subject mismatch",
+			kind:   TriggerKindEmail,
+			filter: TriggerFilter{Subject: `invoice`},
+			event:  EmailEvent("bot", "billing@example.com", "Newsletter", ""),
+			want:   false,
+		},
+		{
+			name:   "channel message on route",
+			kind:   TriggerKindChannelMessage,
+			filter: TriggerFilter{RouteID: "route-1", Pattern: `^/deploy`},
+			event:  ChannelMessageEvent("bot", "route-1", "telegram", "alice", "/deploy prod"),
+			want:   true,
+		},
+		{
+			name:   "channel message other route",
+			kind:   TriggerKindChannelMessage,
+			filter: TriggerFilter{RouteID: "route-1"},
+			event:  ChannelMessageEvent("bot", "route-2", "telegram", "alice", "/deploy prod"),
+			want:   false,
+		},
+		{
+			name:   "background task failed",
+			kind:   TriggerKindBackgroundTask,
+			filter: TriggerFilter{Status: "failed", Pattern: `make`},
+			event:  BackgroundTaskEvent("bot", "", "bg_1", "failed", "make test", 2, "FAIL"),
+			want:   true,
+		},
+		{
+			name:   "background task completed filtered out",
+			kind:   TriggerKindBackgroundTask,
+			filter: TriggerFilter{Status: "failed"},
+			event:  BackgroundTaskEvent("bot", "", "bg_1", "completed", "make test", 0, ""),
+			want:   false,
+		},
+		{
+			name:   "mcp connection status",
+			kind:   TriggerKindMCPStatus,
+			filter: TriggerFilter{ConnectionID: "c1", Status: "error"},
+			event:  MCPStatusEvent("bot", "c1", "github", "connected", "error", "timeout"),
+			want:   true,
+		},
+		{
+			name:  "kind mismatch",
+			kind:  TriggerKindMCPStatus,
+			event: EmailEvent("bot", "a@b", "s", ""),
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := compileEventMatcher(tt.kind, tt.filter)
+			if err != nil {
+				t.Fatalf("compile matcher: %v", err)
+			}
+			if got := m.matches(tt.event); got != tt.want {
+				t.Fatalf("matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventPayloadTruncated(t *testing.T) {
+	ev := EmailEvent("bot", "a@b", "big", strings.Repeat("é", maxEventPayloadLen))
+	if !strings.HasSuffix(ev.Payload, "(truncated)") {
+		t.Fatal("expected long email body to be truncated")
+	}
+	if !strings.Contains(ev.Payload, "from: a@b\nsubject: big\n") {
+		t.Fatalf("unexpected payload header: %q", ev.Payload[:40])
+	}
+}
+
+func TestTriggerFilterRoundTrip(t *testing.T) {
+	raw, err := encodeTriggerFilter(TriggerFilter{From: "a@b", Subject: "x"})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if got := decodeTriggerFilter(raw); got.From != "a@b" || got.Subject != "x" {
+		t.Fatalf("unexpected decoded filter: %+v", got)
+	}
+	if got := decodeTriggerFilter(nil); !got.IsZero() {
+		t.Fatalf("expected zero filter, got %+v", got)
+	}
+}
@@ -0,0 +1,105 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Schedule log statuses. A run that fails with retries left is logged as
+// retrying until its next attempt starts (then retried); the last failed
+// attempt of a retrying schedule ends as dead_letter. Schedules without a
+// retry policy keep logging plain errors.
+const (
+	LogStatusOK         = "ok"
+	LogStatusError      = "error"
+	LogStatusRetrying   = "retrying"
+	LogStatusRetried    = "retried"
+	LogStatusDeadLetter = "dead_letter"
+)
+
+// ErrReplayInProgress is returned when a log is already being replayed, or
+// a replay of it already succeeded.
+var ErrReplayInProgress = errors.New("schedule run is already being replayed or was replayed successfully")
+
+const (
+	maxRetryAttempts           = 10
+	maxRetryBackoffSeconds     = 24 * 60 * 60
+	defaultRetryInitialBackoff = 60
+	defaultRetryMaxBackoff     = 60 * 60
+)
+
+// RetryPolicy controls how failed runs of a schedule are retried. The zero
+// value runs every trigger once.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per run, including the
+	// first one. Values below 2 disable retries.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// InitialBackoffSeconds is the delay before the first retry; each later
+	// retry doubles it. Defaults to 60.
+	InitialBackoffSeconds int `json:"initial_backoff_seconds,omitempty"`
+	// MaxBackoffSeconds caps the delay between attempts. Defaults to 3600.
+	MaxBackoffSeconds int `json:"max_backoff_seconds,omitempty"`
+	// Jitter shortens each delay by a random fraction of up to this value
+	// (0-1) so retries of many schedules do not fire in lockstep.
+	Jitter float64 `json:"jitter,omitempty"`
+}
+
+// Enabled reports whether failed runs are retried.
+func (p RetryPolicy) Enabled() bool {
+	return p.MaxAttempts > 1
+}
+
+// normalizeRetryPolicy validates p and fills in default backoff bounds.
+// Policies that do not retry collapse to the zero value.
+func normalizeRetryPolicy(p RetryPolicy) (RetryPolicy, error) {
+	if p.MaxAttempts < 0 || p.MaxAttempts > maxRetryAttempts {
+		return RetryPolicy{}, fmt.Errorf("retry_policy.max_attempts must be between 0 and %d", maxRetryAttempts)
+	}
+	if p.InitialBackoffSeconds < 0 || p.InitialBackoffSeconds > maxRetryBackoffSeconds ||
+		p.MaxBackoffSeconds < 0 || p.MaxBackoffSeconds > maxRetryBackoffSeconds {
+		return RetryPolicy{}, fmt.Errorf("retry_policy backoff must be between 0 and %d seconds", maxRetryBackoffSeconds)
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return RetryPolicy{}, errors.New("retry_policy.jitter must be between 0 and 1")
+	}
+	if !p.Enabled() {
+		return RetryPolicy{}, nil
+	}
+	if p.InitialBackoffSeconds == 0 {
+		p.InitialBackoffSeconds = defaultRetryInitialBackoff
+	}
+	if p.MaxBackoffSeconds == 0 {
+		p.MaxBackoffSeconds = max(defaultRetryMaxBackoff, p.InitialBackoffSeconds)
+	}
+	if p.MaxBackoffSeconds < p.InitialBackoffSeconds {
+		return RetryPolicy{}, errors.New("retry_policy.max_backoff_seconds must not be less than initial_backoff_seconds")
+	}
+	return p, nil
+}
+
+// backoff returns the delay before the attempt that follows the failed
+// attempt number failed (1-based). random must return a value in [0, 1).
+func (p RetryPolicy) backoff(failed int, random func() float64) time.Duration {
+	shift := min(max(failed-1, 0), 20)
+	delay := time.Duration(p.InitialBackoffSeconds) * time.Second << shift
+	delay = min(delay, time.Duration(p.MaxBackoffSeconds)*time.Second)
+	if p.Jitter > 0 && random != nil {
+		delay -= time.Duration(float64(delay) * p.Jitter * random())
+	}
+	return delay
+}
+
+func encodeRetryPolicy(p RetryPolicy) ([]byte, error) {
+	return json.Marshal(p)
+}
+
+func decodeRetryPolicy(raw []byte) RetryPolicy {
+	var p RetryPolicy
+	if len(raw) == 0 {
+		return p
+	}
+	_ = json.Unmarshal(raw, &p)
+	return p
+}
@@ -0,0 +1,76 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalizeRetryPolicy(t *testing.T) {
+	got, err := normalizeRetryPolicy(RetryPolicy{MaxAttempts: 1, InitialBackoffSeconds: 30})
+	if err != nil || got != (RetryPolicy{}) {
+		t.Fatalf("single attempt = %+v, %v; want zero policy", got, err)
+	}
+
+	got, err = normalizeRetryPolicy(RetryPolicy{MaxAttempts: 3})
+	if err != nil {
+		t.Fatalf("defaults: %v", err)
+	}
+	if got.InitialBackoffSeconds != defaultRetryInitialBackoff || got.MaxBackoffSeconds != defaultRetryMaxBackoff {
+		t.Fatalf("defaults = %+v", got)
+	}
+
+	got, err = normalizeRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoffSeconds: 7200})
+	if err != nil || got.MaxBackoffSeconds != 7200 {
+		t.Fatalf("max backoff below initial = %+v, %v; want raised to 7200", got, err)
+	}
+
+	invalid := []RetryPolicy{
+		{MaxAttempts: -1},
+		{MaxAttempts: maxRetryAttempts + 1},
+		{MaxAttempts: 3, InitialBackoffSeconds: -5},
+		{MaxAttempts: 3, MaxBackoffSeconds: maxRetryBackoffSeconds + 1},
+		{MaxAttempts: 3, Jitter: 1.5},
+		{MaxAttempts: 3, InitialBackoffSeconds: 120, MaxBackoffSeconds: 60},
+	}
+	for _, p := range invalid {
+		if _, err := normalizeRetryPolicy(p); err == nil {
+			t.Fatalf("expected %+v to be rejected", p)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, InitialBackoffSeconds: 10, MaxBackoffSeconds: 60}
+	want := []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second, 60 * time.Second, 60 * time.Second}
+	for i, w := range want {
+		if got := p.backoff(i+1, nil); got != w {
+			t.Fatalf("backoff(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+
+	p.Jitter = 0.5
+	if got := p.backoff(1, func() float64 { return 1 }); got != 5*time.Second {
+		t.Fatalf("full jitter = %s, want 5s", got)
+	}
+	if got := p.backoff(1, func() float64 { return 0 }); got != 10*time.Second {
+		t.Fatalf("no jitter = %s, want 10s", got)
+	}
+
+	if got := p.backoff(100, nil); got != 60*time.Second {
+		t.Fatalf("large attempt = %s, want capped at 60s", got)
+	}
+}
+
+func TestRetryPolicyRoundTrip(t *testing.T) {
+	in := RetryPolicy{MaxAttempts: 4, InitialBackoffSeconds: 15, MaxBackoffSeconds: 600, Jitter: 0.2}
+	raw, err := encodeRetryPolicy(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := decodeRetryPolicy(raw); out != in {
+		t.Fatalf("round trip = %+v, want %+v", out, in)
+	}
+	if out := decodeRetryPolicy([]byte("{}")); out.Enabled() {
+		t.Fatalf("empty policy should not retry: %+v", out)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"math/rand/v2"
 	"strings"
 	"sync"
 	"time"
@@ -15,11 +16,13 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/robfig/cron/v3"
 
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/auth"
 	"github.com/memohai/memoh/internal/boot"
 	"github.com/memohai/memoh/internal/db"
 	"github.com/memohai/memoh/internal/db/postgres/sqlc"
 	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/structured"
 )
 
 // SessionCreator creates sessions for schedule runs.
@@ -33,11 +36,38 @@ type Service struct {
 	parser          cron.Parser
 	triggerer       Triggerer
 	sessionCreator  SessionCreator
+	budgetGuard     BudgetGuard
+	workflows       WorkflowRunner
 	jwtSecret       string
 	logger          *slog.Logger
 	defaultLocation *time.Location
 	mu              sync.Mutex
 	jobs            map[string]cron.EntryID
+	events          map[string]eventJob
+	// retryGen is bumped whenever a schedule is disabled or deleted so that
+	// retries already waiting on a timer notice and give up.
+	retryGen map[string]uint64
+	// replays holds the logs being replayed until their replay has written
+	// its own log, which then guards against further replays.
+	replays map[string]struct{}
+	// runSessions maps the sessions created for runs to their schedule, so
+	// events a run causes itself do not fire the same schedule again.
+	runSessions map[string]runSession
+	// lastEventRun is when each event-triggered schedule last fired, for
+	// eventCooldown.
+	lastEventRun map[string]time.Time
+}
+
+// runSession is a session created for a schedule run.
+type runSession struct {
+	scheduleID string
+	createdAt  time.Time
+}
+
+// eventJob is an enabled event-triggered schedule waiting for Dispatch.
+type eventJob struct {
+	schedule Schedule
+	matcher  *eventMatcher
 }
 
 func NewService(log *slog.Logger, queries dbstore.Queries, triggerer Triggerer, sessionCreator SessionCreator, runtimeConfig *boot.RuntimeConfig) *Service {
@@ -57,21 +87,42 @@ func NewService(log *slog.Logger, queries dbstore.Queries, triggerer Triggerer,
 		logger:          log.With(slog.String("service", "schedule")),
 		defaultLocation: location,
 		jobs:            map[string]cron.EntryID{},
+		events:          map[string]eventJob{},
+		retryGen:        map[string]uint64{},
+		replays:         map[string]struct{}{},
+		runSessions:     map[string]runSession{},
+		lastEventRun:    map[string]time.Time{},
 	}
 	c.Start()
 	return service
 }
 
+// SetBudgetGuard makes runs of bots whose spending budget is used up skip
+// instead of calling the agent.
+func (s *Service) SetBudgetGuard(guard BudgetGuard) {
+	s.budgetGuard = guard
+}
+
 func (s *Service) Bootstrap(ctx context.Context) error {
 	if s.queries == nil {
 		return errors.New("schedule queries not configured")
 	}
+	// Pending retries live in memory only; surface the ones a restart
+	// dropped as dead letters so they can be replayed.
+	if err := s.queries.DeadLetterPendingScheduleRetries(ctx); err != nil {
+		return err
+	}
+	// Runs cut off by the restart would otherwise look in flight forever and
+	// block replays of the logs they were replaying.
+	if err := s.queries.FailInterruptedScheduleLogs(ctx); err != nil {
+		return err
+	}
 	items, err := s.queries.ListEnabledSchedules(ctx)
 	if err != nil {
 		return err
 	}
 	for _, item := range items {
-		if err := s.scheduleJob(ctx, item); err != nil {
+		if err := s.registerSchedule(ctx, item); err != nil {
 			return err
 		}
 	}
@@ -82,11 +133,40 @@ func (s *Service) Create(ctx context.Context, botID string, req CreateRequest) (
 	if s.queries == nil {
 		return Schedule{}, errors.New("schedule queries not configured")
 	}
-	if strings.TrimSpace(req.Name) == "" || strings.TrimSpace(req.Description) == "" || strings.TrimSpace(req.Pattern) == "" || strings.TrimSpace(req.Command) == "" {
-		return Schedule{}, errors.New("name, description, pattern, command are required")
+	if strings.TrimSpace(req.Name) == "" || strings.TrimSpace(req.Description) == "" || strings.TrimSpace(req.Command) == "" {
+		return Schedule{}, errors.New("name, description, command are required")
+	}
+	kind, err := normalizeTriggerKind(req.TriggerKind)
+	if err != nil {
+		return Schedule{}, err
+	}
+	filter := TriggerFilter{}
+	if req.TriggerFilter != nil {
+		filter = *req.TriggerFilter
 	}
-	if _, err := s.parser.Parse(req.Pattern); err != nil {
-		return Schedule{}, fmt.Errorf("invalid cron pattern: %w", err)
+	pattern, filter, err := s.validateTrigger(kind, req.Pattern, filter)
+	if err != nil {
+		return Schedule{}, err
+	}
+	filterPayload, err := encodeTriggerFilter(filter)
+	if err != nil {
+		return Schedule{}, err
+	}
+	retryPolicy := RetryPolicy{}
+	if req.RetryPolicy != nil {
+		retryPolicy = *req.RetryPolicy
+	}
+	retryPolicy, err = normalizeRetryPolicy(retryPolicy)
+	if err != nil {
+		return Schedule{}, err
+	}
+	retryPayload, err := encodeRetryPolicy(retryPolicy)
+	if err != nil {
+		return Schedule{}, err
+	}
+	outputSchema, err := normalizeOutputSchema(req.OutputSchema)
+	if err != nil {
+		return Schedule{}, err
 	}
 	pgBotID, err := db.ParseUUID(botID)
 	if err != nil {
@@ -104,19 +184,23 @@ func (s *Service) Create(ctx context.Context, botID string, req CreateRequest) (
 		enabled = *req.Enabled
 	}
 	row, err := s.queries.CreateSchedule(ctx, sqlc.CreateScheduleParams{
-		Name:        req.Name,
-		Description: req.Description,
-		Pattern:     req.Pattern,
-		MaxCalls:    maxCalls,
-		Enabled:     enabled,
-		Command:     req.Command,
-		BotID:       pgBotID,
+		Name:          req.Name,
+		Description:   req.Description,
+		Pattern:       pattern,
+		MaxCalls:      maxCalls,
+		Enabled:       enabled,
+		Command:       req.Command,
+		BotID:         pgBotID,
+		TriggerKind:   string(kind),
+		TriggerFilter: filterPayload,
+		RetryPolicy:   retryPayload,
+		OutputSchema:  outputSchema,
 	})
 	if err != nil {
 		return Schedule{}, err
 	}
 	if row.Enabled {
-		if err := s.scheduleJob(ctx, row); err != nil {
+		if err := s.registerSchedule(ctx, row); err != nil {
 			return Schedule{}, err
 		}
 	}
@@ -163,6 +247,11 @@ func (s *Service) Update(ctx context.Context, id string, req UpdateRequest) (Sch
 	if err != nil {
 		return Schedule{}, err
 	}
+	if existing.WorkflowID.Valid {
+		if err := checkWorkflowUpdate(existing, req); err != nil {
+			return Schedule{}, err
+		}
+	}
 	name := existing.Name
 	if req.Name != nil {
 		name = *req.Name
@@ -171,13 +260,58 @@ func (s *Service) Update(ctx context.Context, id string, req UpdateRequest) (Sch
 	if req.Description != nil {
 		description = *req.Description
 	}
+	kind, err := normalizeTriggerKind(TriggerKind(existing.TriggerKind))
+	if err != nil {
+		return Schedule{}, err
+	}
 	pattern := existing.Pattern
-	if req.Pattern != nil {
-		if _, err := s.parser.Parse(*req.Pattern); err != nil {
-			return Schedule{}, fmt.Errorf("invalid cron pattern: %w", err)
+	filter := decodeTriggerFilter(existing.TriggerFilter)
+	if req.TriggerKind != nil {
+		nextKind, err := normalizeTriggerKind(*req.TriggerKind)
+		if err != nil {
+			return Schedule{}, err
 		}
+		// Switching trigger kinds drops the settings of the previous kind
+		// unless the caller replaces them in the same request.
+		if nextKind != kind {
+			pattern = ""
+			filter = TriggerFilter{}
+		}
+		kind = nextKind
+	}
+	if req.Pattern != nil {
 		pattern = *req.Pattern
 	}
+	if req.TriggerFilter != nil {
+		filter = *req.TriggerFilter
+	}
+	pattern, filter, err = s.validateTrigger(kind, pattern, filter)
+	if err != nil {
+		return Schedule{}, err
+	}
+	filterPayload, err := encodeTriggerFilter(filter)
+	if err != nil {
+		return Schedule{}, err
+	}
+	retryPolicy := decodeRetryPolicy(existing.RetryPolicy)
+	if req.RetryPolicy != nil {
+		retryPolicy = *req.RetryPolicy
+	}
+	retryPolicy, err = normalizeRetryPolicy(retryPolicy)
+	if err != nil {
+		return Schedule{}, err
+	}
+	retryPayload, err := encodeRetryPolicy(retryPolicy)
+	if err != nil {
+		return Schedule{}, err
+	}
+	outputSchema := existing.OutputSchema
+	if req.OutputSchema != nil {
+		outputSchema, err = normalizeOutputSchema(req.OutputSchema)
+		if err != nil {
+			return Schedule{}, err
+		}
+	}
 	command := existing.Command
 	if req.Command != nil {
 		command = *req.Command
@@ -198,17 +332,24 @@ func (s *Service) Update(ctx context.Context, id string, req UpdateRequest) (Sch
 		enabled = *req.Enabled
 	}
 	updated, err := s.queries.UpdateSchedule(ctx, sqlc.UpdateScheduleParams{
-		ID:          pgID,
-		Name:        name,
-		Description: description,
-		Pattern:     pattern,
-		MaxCalls:    maxCalls,
-		Enabled:     enabled,
-		Command:     command,
+		ID:            pgID,
+		Name:          name,
+		Description:   description,
+		Pattern:       pattern,
+		MaxCalls:      maxCalls,
+		Enabled:       enabled,
+		Command:       command,
+		TriggerKind:   string(kind),
+		TriggerFilter: filterPayload,
+		RetryPolicy:   retryPayload,
+		OutputSchema:  outputSchema,
 	})
 	if err != nil {
 		return Schedule{}, err
 	}
+	if !updated.Enabled {
+		s.cancelRetries(id)
+	}
 	if err := s.rescheduleJob(ctx, updated); err != nil {
 		return Schedule{}, fmt.Errorf("reschedule job: %w", err)
 	}
@@ -220,10 +361,23 @@ func (s *Service) Delete(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
+	existing, err := s.queries.GetScheduleByID(ctx, pgID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	if err == nil && existing.WorkflowID.Valid {
+		return ErrManagedByWorkflow
+	}
+	return s.deleteSchedule(ctx, pgID)
+}
+
+func (s *Service) deleteSchedule(ctx context.Context, pgID pgtype.UUID) error {
 	if err := s.queries.DeleteSchedule(ctx, pgID); err != nil {
 		return err
 	}
+	id := pgID.String()
 	s.removeJob(id)
+	s.cancelRetries(id)
 	return nil
 }
 
@@ -238,7 +392,75 @@ func (s *Service) Trigger(ctx context.Context, scheduleID string) error {
 	if !sched.Enabled {
 		return errors.New("schedule is disabled")
 	}
-	return s.runSchedule(ctx, sched)
+	if err := s.checkBudget(ctx, sched.BotID); err != nil {
+		return err
+	}
+	return s.runSchedule(ctx, sched, nil)
+}
+
+// Dispatch fires every enabled event-triggered schedule of ev.BotID whose
+// filter matches ev. Runs execute in the background so event sources such as
+// inbound message handling or MCP probes are never blocked by the agent.
+func (s *Service) Dispatch(ctx context.Context, ev Event) {
+	botID := strings.TrimSpace(ev.BotID)
+	if botID == "" || ev.Kind == "" || ev.Kind == TriggerKindCron {
+		return
+	}
+	now := time.Now()
+	s.mu.Lock()
+	var matched []Schedule
+	for _, job := range s.events {
+		if job.schedule.BotID != botID || !job.matcher.matches(ev) {
+			continue
+		}
+		// A run must not fire its own schedule, e.g. through a background
+		// task it started, or it would loop for as long as it keeps
+		// starting tasks. The cooldown also damps loops between schedules.
+		if run, ok := s.runSessions[ev.SessionID]; ok && run.scheduleID == job.schedule.ID {
+			continue
+		}
+		if last, ok := s.lastEventRun[job.schedule.ID]; ok && now.Sub(last) < eventCooldown(ev.Kind) {
+			s.logger.Debug("event schedule in cooldown", slog.String("schedule_id", job.schedule.ID))
+			continue
+		}
+		s.lastEventRun[job.schedule.ID] = now
+		matched = append(matched, job.schedule)
+	}
+	s.mu.Unlock()
+
+	for _, sched := range matched {
+		go func(sched Schedule) {
+			runCtx, runCancel := context.WithTimeout(context.WithoutCancel(ctx), scheduleRunTimeout)
+			defer runCancel()
+			if err := s.runSchedule(runCtx, sched, &ev); err != nil {
+				s.logger.Error("event schedule failed",
+					slog.String("schedule_id", sched.ID),
+					slog.String("trigger_kind", string(ev.Kind)),
+					slog.Any("error", err))
+			}
+		}(sched)
+	}
+}
+
+// validateTrigger checks the pattern and filter against the trigger kind and
+// returns their normalized forms.
+func (s *Service) validateTrigger(kind TriggerKind, pattern string, filter TriggerFilter) (string, TriggerFilter, error) {
+	pattern = strings.TrimSpace(pattern)
+	if kind == TriggerKindCron {
+		if pattern == "" {
+			return "", TriggerFilter{}, errors.New("pattern is required for cron schedules")
+		}
+		if _, err := s.parser.Parse(pattern); err != nil {
+			return "", TriggerFilter{}, fmt.Errorf("invalid cron pattern: %w", err)
+		}
+	} else if pattern != "" {
+		return "", TriggerFilter{}, errors.New("pattern is only supported for cron schedules")
+	}
+	matcher, err := compileEventMatcher(kind, filter)
+	if err != nil {
+		return "", TriggerFilter{}, err
+	}
+	return pattern, matcher.filter, nil
 }
 
 const scheduleTokenTTL = 10 * time.Minute
@@ -247,10 +469,15 @@ const scheduleTokenTTL = 10 * time.Minute
 // This prevents unbounded Generate() calls from hanging forever.
 const scheduleRunTimeout = 5 * time.Minute
 
-func (s *Service) runSchedule(ctx context.Context, sched Schedule) error {
+func (s *Service) runSchedule(ctx context.Context, sched Schedule, ev *Event) error {
 	if s.triggerer == nil {
 		return errors.New("schedule triggerer not configured")
 	}
+	// Skipped runs are not counted against max_calls or logged.
+	if err := s.checkBudget(ctx, sched.BotID); err != nil {
+		s.logger.Warn("schedule run skipped", slog.String("schedule_id", sched.ID), slog.Any("error", err))
+		return nil
+	}
 	updated, err := s.queries.IncrementScheduleCalls(ctx, toUUID(sched.ID))
 	if err != nil {
 		return err
@@ -258,7 +485,38 @@ func (s *Service) runSchedule(ctx context.Context, sched Schedule) error {
 	if !updated.Enabled {
 		s.removeJob(sched.ID)
 	}
+	// Concurrent event dispatches can race past max_calls before the job is
+	// removed; drop the extra runs instead of exceeding the limit.
+	if updated.MaxCalls.Valid && updated.CurrentCalls > updated.MaxCalls.Int32 {
+		return nil
+	}
+
+	run := runAttempt{attempt: 1}
+	if ev != nil {
+		run.event = ev.Payload
+	}
+	return s.execute(ctx, sched, run)
+}
+
+// runAttempt identifies one attempt of a schedule run. Retries and replays
+// reuse the event payload of the run they repeat and do not count against
+// max_calls.
+type runAttempt struct {
+	attempt  int
+	event    string
+	replayOf pgtype.UUID
+}
 
+func (s *Service) execute(ctx context.Context, sched Schedule, run runAttempt) error {
+	// Actions taken during the run are attributed to the schedule, unless a
+	// user triggered it by hand.
+	ctx = audit.WithDefaultActor(ctx, audit.Actor{Type: audit.ActorSchedule, ID: sched.ID})
+	if sched.WorkflowID != "" {
+		return s.executeWorkflow(ctx, sched, run)
+	}
+	if s.triggerer == nil {
+		return errors.New("schedule triggerer not configured")
+	}
 	ownerUserID, err := s.resolveBotOwner(ctx, sched.BotID)
 	if err != nil {
 		return fmt.Errorf("resolve bot owner: %w", err)
@@ -273,6 +531,7 @@ func (s *Service) runSchedule(ctx context.Context, sched Schedule) error {
 		} else {
 			sessionID = sid
 			pgSessionID = db.ParseUUIDOrEmpty(sid)
+			s.trackRunSession(sid, sched.ID)
 		}
 	}
 
@@ -280,9 +539,12 @@ func (s *Service) runSchedule(ctx context.Context, sched Schedule) error {
 	pgBotID := toUUID(sched.BotID)
 
 	logRow, err := s.queries.CreateScheduleLog(ctx, sqlc.CreateScheduleLogParams{
-		ScheduleID: pgScheduleID,
-		BotID:      pgBotID,
-		SessionID:  pgSessionID,
+		ScheduleID:   pgScheduleID,
+		BotID:        pgBotID,
+		SessionID:    pgSessionID,
+		Attempt:      int32(min(run.attempt, maxRetryAttempts)), //nolint:gosec // bounded by maxRetryAttempts
+		TriggerEvent: run.event,
+		ReplayOf:     run.replayOf,
 	})
 	if err != nil {
 		s.logger.Error("create schedule log failed", slog.String("schedule_id", sched.ID), slog.Any("error", err))
@@ -290,32 +552,201 @@ func (s *Service) runSchedule(ctx context.Context, sched Schedule) error {
 
 	token, err := s.generateTriggerToken(ownerUserID)
 	if err != nil {
-		s.completeLog(ctx, logRow.ID, "error", "", err.Error(), nil, pgtype.UUID{})
-		return fmt.Errorf("generate trigger token: %w", err)
+		return s.failRun(ctx, sched, run, logRow.ID, fmt.Errorf("generate trigger token: %w", err))
 	}
 
 	result, triggerErr := s.triggerer.TriggerSchedule(ctx, sched.BotID, TriggerPayload{
-		ID:          sched.ID,
-		Name:        sched.Name,
-		Description: sched.Description,
-		Pattern:     sched.Pattern,
-		MaxCalls:    sched.MaxCalls,
-		Command:     sched.Command,
-		OwnerUserID: ownerUserID,
-		SessionID:   sessionID,
+		ID:           sched.ID,
+		Name:         sched.Name,
+		Description:  sched.Description,
+		Pattern:      sched.Pattern,
+		MaxCalls:     sched.MaxCalls,
+		Command:      sched.Command,
+		OwnerUserID:  ownerUserID,
+		SessionID:    sessionID,
+		TriggerKind:  sched.TriggerKind,
+		Event:        run.event,
+		OutputSchema: sched.OutputSchema,
 	}, token)
 	if triggerErr != nil {
-		s.completeLog(ctx, logRow.ID, "error", "", triggerErr.Error(), nil, pgtype.UUID{})
-		return triggerErr
+		return s.failRun(ctx, sched, run, logRow.ID, triggerErr)
 	}
 
 	modelID := db.ParseUUIDOrEmpty(result.ModelID)
-	s.completeLog(ctx, logRow.ID, result.Status, result.Text, "", result.UsageBytes, modelID)
+	s.completeLog(ctx, logRow.ID, result.Status, result.Text, "", result.UsageBytes, modelID, result.Structured)
 	s.logger.Info("schedule completed", slog.String("schedule_id", sched.ID), slog.String("status", result.Status))
 	return nil
 }
 
-func (s *Service) completeLog(ctx context.Context, logID pgtype.UUID, status, resultText, errorMessage string, usageBytes []byte, modelID pgtype.UUID) {
+// runSessionTTL is how long a run's session is remembered for Dispatch;
+// background tasks started by a run rarely outlive it by more.
+const runSessionTTL = 24 * time.Hour
+
+// trackRunSession remembers that sessionID belongs to a run of scheduleID
+// and forgets sessions older than runSessionTTL.
+func (s *Service) trackRunSession(sessionID, scheduleID string) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, run := range s.runSessions {
+		if now.Sub(run.createdAt) > runSessionTTL {
+			delete(s.runSessions, id)
+		}
+	}
+	s.runSessions[sessionID] = runSession{scheduleID: scheduleID, createdAt: now}
+}
+
+// failRun logs a failed attempt and, when the schedule's retry policy has
+// attempts left, arms the next one. It returns runErr unchanged.
+func (s *Service) failRun(ctx context.Context, sched Schedule, run runAttempt, logID pgtype.UUID, runErr error) error {
+	policy := sched.RetryPolicy
+	status := LogStatusError
+	if policy.Enabled() {
+		status = LogStatusDeadLetter
+		if run.attempt < policy.MaxAttempts {
+			status = LogStatusRetrying
+		}
+	}
+	s.completeLog(ctx, logID, status, "", runErr.Error(), nil, pgtype.UUID{}, nil)
+	if status == LogStatusRetrying {
+		s.scheduleRetry(ctx, sched.ID, sched.BotID, run, logID, policy.backoff(run.attempt, rand.Float64))
+	}
+	return runErr
+}
+
+// scheduleRetry runs the next attempt of run after delay. The schedule is
+// reloaded when the timer fires so edits made in the meantime apply; a
+// schedule disabled or deleted while waiting, or a bot that ran out of
+// budget, abandons the retry and leaves the failed attempt as a dead letter.
+func (s *Service) scheduleRetry(ctx context.Context, scheduleID, botID string, run runAttempt, logID pgtype.UUID, delay time.Duration) {
+	s.mu.Lock()
+	gen := s.retryGen[scheduleID]
+	s.mu.Unlock()
+	s.logger.Warn("schedule run failed, retrying",
+		slog.String("schedule_id", scheduleID),
+		slog.Int("attempt", run.attempt),
+		slog.Duration("delay", delay))
+
+	time.AfterFunc(delay, func() {
+		runCtx, runCancel := context.WithTimeout(context.WithoutCancel(ctx), scheduleRunTimeout)
+		defer runCancel()
+
+		s.mu.Lock()
+		canceled := s.retryGen[scheduleID] != gen
+		s.mu.Unlock()
+		if !canceled {
+			if err := s.checkBudget(runCtx, botID); err != nil {
+				s.logger.Warn("schedule retry skipped", slog.String("schedule_id", scheduleID), slog.Any("error", err))
+				canceled = true
+			}
+		}
+		outcome := LogStatusRetried
+		if canceled {
+			outcome = LogStatusDeadLetter
+		}
+		if logID.Valid {
+			if err := s.queries.ResolveScheduleLogRetry(runCtx, sqlc.ResolveScheduleLogRetryParams{ID: logID, Status: outcome}); err != nil {
+				s.logger.Error("resolve schedule retry failed", slog.String("schedule_id", scheduleID), slog.Any("error", err))
+			}
+		}
+		if canceled {
+			return
+		}
+		sched, err := s.Get(runCtx, scheduleID)
+		if err != nil {
+			s.logger.Warn("schedule retry skipped", slog.String("schedule_id", scheduleID), slog.Any("error", err))
+			return
+		}
+		next := runAttempt{attempt: run.attempt + 1, event: run.event, replayOf: run.replayOf}
+		if err := s.execute(runCtx, sched, next); err != nil {
+			s.logger.Error("schedule retry failed",
+				slog.String("schedule_id", scheduleID),
+				slog.Int("attempt", next.attempt),
+				slog.Any("error", err))
+		}
+	})
+}
+
+// cancelRetries abandons every retry of the schedule still waiting on a timer.
+func (s *Service) cancelRetries(scheduleID string) {
+	s.mu.Lock()
+	s.retryGen[scheduleID]++
+	s.mu.Unlock()
+}
+
+// Replay re-runs a failed (error or dead-letter) run in the background with
+// the same triggering event. The replay is logged as a new run pointing back
+// at the original, follows the schedule's retry policy, and does not count
+// against max_calls.
+func (s *Service) Replay(ctx context.Context, logID string) error {
+	if s.triggerer == nil {
+		return errors.New("schedule triggerer not configured")
+	}
+	item, err := s.GetLog(ctx, logID)
+	if err != nil {
+		return err
+	}
+	if item.Status != LogStatusError && item.Status != LogStatusDeadLetter {
+		return errors.New("only failed schedule runs can be replayed")
+	}
+	sched, err := s.Get(ctx, item.ScheduleID)
+	if err != nil {
+		return err
+	}
+	if !sched.Enabled {
+		return errors.New("schedule is disabled")
+	}
+	if err := s.checkBudget(ctx, sched.BotID); err != nil {
+		return err
+	}
+	if err := s.claimReplay(ctx, item.ID); err != nil {
+		return err
+	}
+	run := runAttempt{attempt: 1, event: item.TriggerEvent, replayOf: toUUID(item.ID)}
+	go func() {
+		runCtx, runCancel := context.WithTimeout(context.WithoutCancel(ctx), scheduleRunTimeout)
+		defer runCancel()
+		defer s.releaseReplay(item.ID)
+		if err := s.execute(runCtx, sched, run); err != nil {
+			s.logger.Error("schedule replay failed",
+				slog.String("schedule_id", sched.ID),
+				slog.String("replay_of", item.ID),
+				slog.Any("error", err))
+		}
+	}()
+	return nil
+}
+
+// claimReplay reserves logID for a replay. A log is replayed at most once
+// at a time and not again after a replay succeeded; a replay that failed
+// for good can be replayed itself, or the original replayed once more.
+func (s *Service) claimReplay(ctx context.Context, logID string) error {
+	s.mu.Lock()
+	if _, ok := s.replays[logID]; ok {
+		s.mu.Unlock()
+		return ErrReplayInProgress
+	}
+	s.replays[logID] = struct{}{}
+	s.mu.Unlock()
+
+	active, err := s.queries.CountActiveScheduleReplays(ctx, toUUID(logID))
+	if err == nil && active > 0 {
+		err = ErrReplayInProgress
+	}
+	if err != nil {
+		s.releaseReplay(logID)
+		return err
+	}
+	return nil
+}
+
+func (s *Service) releaseReplay(logID string) {
+	s.mu.Lock()
+	delete(s.replays, logID)
+	s.mu.Unlock()
+}
+
+func (s *Service) completeLog(ctx context.Context, logID pgtype.UUID, status, resultText, errorMessage string, usageBytes []byte, modelID pgtype.UUID, resultJSON []byte) {
 	if !logID.Valid {
 		return
 	}
@@ -326,6 +757,7 @@ func (s *Service) completeLog(ctx context.Context, logID pgtype.UUID, status, re
 		ErrorMessage: errorMessage,
 		Usage:        usageBytes,
 		ModelID:      modelID,
+		ResultJson:   resultJSON,
 	})
 	if err != nil {
 		s.logger.Error("complete schedule log failed", slog.Any("error", err))
@@ -396,6 +828,53 @@ func (s *Service) ListLogsBySchedule(ctx context.Context, scheduleID string, lim
 	return items, total, nil
 }
 
+// GetLog returns a single schedule run log.
+func (s *Service) GetLog(ctx context.Context, id string) (Log, error) {
+	pgID, err := db.ParseUUID(id)
+	if err != nil {
+		return Log{}, err
+	}
+	row, err := s.queries.GetScheduleLogByID(ctx, pgID)
+	if err != nil {
+		return Log{}, err
+	}
+	return toScheduleLog(sqlc.ListScheduleLogsByBotRow(row)), nil
+}
+
+// ListFailedLogs lists the runs of a bot's schedules that failed for good:
+// plain errors and dead letters. Attempts that were retried are excluded.
+func (s *Service) ListFailedLogs(ctx context.Context, botID string, limit, offset int) ([]Log, int64, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	total, err := s.queries.CountFailedScheduleLogsByBot(ctx, pgBotID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := s.queries.ListFailedScheduleLogsByBot(ctx, sqlc.ListFailedScheduleLogsByBotParams{
+		BotID:  pgBotID,
+		Limit:  int32(limit),  //nolint:gosec // capped to 100 above
+		Offset: int32(offset), //nolint:gosec // validated above
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	items := make([]Log, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, toScheduleLog(sqlc.ListScheduleLogsByBotRow(row)))
+	}
+	return items, total, nil
+}
+
 func (s *Service) DeleteLogs(ctx context.Context, botID string) error {
 	pgBotID, err := db.ParseUUID(botID)
 	if err != nil {
@@ -413,6 +892,14 @@ func toScheduleLog(row sqlc.ListScheduleLogsByBotRow) Log {
 		Status:       row.Status,
 		ResultText:   row.ResultText,
 		ErrorMessage: row.ErrorMessage,
+		Attempt:      int(row.Attempt),
+		TriggerEvent: row.TriggerEvent,
+	}
+	if row.ReplayOf.Valid {
+		l.ReplayOf = row.ReplayOf.String()
+	}
+	if len(row.ResultJson) > 0 {
+		l.ResultJSON = row.ResultJson
 	}
 	if row.StartedAt.Valid {
 		l.StartedAt = row.StartedAt.Time
@@ -431,29 +918,7 @@ func toScheduleLog(row sqlc.ListScheduleLogsByBotRow) Log {
 }
 
 func toScheduleLogFromSchedule(row sqlc.ListScheduleLogsByScheduleRow) Log {
-	l := Log{
-		ID:           row.ID.String(),
-		ScheduleID:   row.ScheduleID.String(),
-		BotID:        row.BotID.String(),
-		SessionID:    row.SessionID.String(),
-		Status:       row.Status,
-		ResultText:   row.ResultText,
-		ErrorMessage: row.ErrorMessage,
-	}
-	if row.StartedAt.Valid {
-		l.StartedAt = row.StartedAt.Time
-	}
-	if row.CompletedAt.Valid {
-		t := row.CompletedAt.Time
-		l.CompletedAt = &t
-	}
-	if row.Usage != nil {
-		var usage any
-		if err := json.Unmarshal(row.Usage, &usage); err == nil {
-			l.Usage = usage
-		}
-	}
-	return l
+	return toScheduleLog(sqlc.ListScheduleLogsByBotRow(row))
 }
 
 // resolveBotOwner returns the owner user ID for the given bot.
@@ -473,6 +938,15 @@ func (s *Service) resolveBotOwner(ctx context.Context, botID string) (string, er
 	return ownerID, nil
 }
 
+// checkBudget returns the budget guard's verdict for the bot, or nil when no
+// guard is configured.
+func (s *Service) checkBudget(ctx context.Context, botID string) error {
+	if s.budgetGuard == nil {
+		return nil
+	}
+	return s.budgetGuard.Check(ctx, botID)
+}
+
 // generateTriggerToken creates a short-lived JWT for schedule trigger callbacks.
 func (s *Service) generateTriggerToken(userID string) (string, error) {
 	if strings.TrimSpace(s.jwtSecret) == "" {
@@ -493,7 +967,7 @@ func (s *Service) scheduleJob(ctx context.Context, schedule sqlc.Schedule) error
 	job := func() {
 		runCtx, runCancel := context.WithTimeout(context.WithoutCancel(ctx), scheduleRunTimeout)
 		defer runCancel()
-		if err := s.runSchedule(runCtx, toSchedule(schedule)); err != nil {
+		if err := s.runSchedule(runCtx, toSchedule(schedule), nil); err != nil {
 			s.logger.Error("scheduled job failed", slog.String("schedule_id", schedule.ID.String()), slog.Any("error", err))
 		}
 	}
@@ -512,6 +986,30 @@ func (s *Service) scheduleJob(ctx context.Context, schedule sqlc.Schedule) error
 	return nil
 }
 
+// registerSchedule arms an enabled schedule: cron schedules get a cron entry,
+// event-triggered ones are indexed for Dispatch.
+func (s *Service) registerSchedule(ctx context.Context, schedule sqlc.Schedule) error {
+	kind, err := normalizeTriggerKind(TriggerKind(schedule.TriggerKind))
+	if err != nil {
+		return err
+	}
+	if kind == TriggerKindCron {
+		return s.scheduleJob(ctx, schedule)
+	}
+	item := toSchedule(schedule)
+	if item.ID == "" {
+		return errors.New("schedule id missing")
+	}
+	matcher, err := compileEventMatcher(kind, item.TriggerFilter)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.events[item.ID] = eventJob{schedule: item, matcher: matcher}
+	s.mu.Unlock()
+	return nil
+}
+
 func (s *Service) rescheduleJob(ctx context.Context, schedule sqlc.Schedule) error {
 	id := schedule.ID.String()
 	if id == "" {
@@ -519,7 +1017,7 @@ func (s *Service) rescheduleJob(ctx context.Context, schedule sqlc.Schedule) err
 	}
 	s.removeJob(id)
 	if schedule.Enabled {
-		return s.scheduleJob(ctx, schedule)
+		return s.registerSchedule(ctx, schedule)
 	}
 	return nil
 }
@@ -532,6 +1030,8 @@ func (s *Service) removeJob(id string) {
 		s.cron.Remove(entryID)
 		delete(s.jobs, id)
 	}
+	delete(s.events, id)
+	delete(s.lastEventRun, id)
 }
 
 func toSchedule(row sqlc.Schedule) Schedule {
@@ -544,6 +1044,18 @@ func toSchedule(row sqlc.Schedule) Schedule {
 		Enabled:      row.Enabled,
 		Command:      row.Command,
 		BotID:        row.BotID.String(),
+		TriggerKind:  TriggerKindCron,
+	}
+	if kind, err := normalizeTriggerKind(TriggerKind(row.TriggerKind)); err == nil {
+		item.TriggerKind = kind
+	}
+	item.TriggerFilter = decodeTriggerFilter(row.TriggerFilter)
+	item.RetryPolicy = decodeRetryPolicy(row.RetryPolicy)
+	if len(row.OutputSchema) > 0 {
+		item.OutputSchema = row.OutputSchema
+	}
+	if row.WorkflowID.Valid {
+		item.WorkflowID = row.WorkflowID.String()
 	}
 	if row.MaxCalls.Valid {
 		maxCalls := int(row.MaxCalls.Int32)
@@ -558,6 +1070,16 @@ func toSchedule(row sqlc.Schedule) Schedule {
 	return item
 }
 
+// normalizeOutputSchema validates an output schema and returns it in compact
+// form. Empty and null schemas normalize to nil.
+func normalizeOutputSchema(raw json.RawMessage) ([]byte, error) {
+	schema, err := structured.Compile(raw)
+	if err != nil {
+		return nil, err
+	}
+	return schema.Raw(), nil
+}
+
 func toUUID(id string) pgtype.UUID {
 	pgID, err := db.ParseUUID(id)
 	if err != nil {
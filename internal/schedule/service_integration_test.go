@@ -3,6 +3,7 @@ package schedule_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"os"
 	"strings"
@@ -156,3 +157,40 @@ func TestIntegrationTrigger_CallsTriggererWithCorrectPayload(t *testing.T) {
 		t.Errorf("token should have Bearer prefix, got: %s", mock.token)
 	}
 }
+
+type blockingBudgetGuard struct {
+	botID string
+}
+
+func (g *blockingBudgetGuard) Check(_ context.Context, botID string) error {
+	g.botID = botID
+	return errors.New("budget exceeded")
+}
+
+func TestIntegrationTrigger_BudgetGuardBlocksRun(t *testing.T) {
+	svc, queries, pool, mock, cleanup := setupScheduleIntegrationTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ownerUserID, botID, scheduleID := createUserBotAndSchedule(ctx, t, queries)
+	defer cleanupScheduleTestData(ctx, t, queries, pool, ownerUserID, botID, scheduleID)
+
+	guard := &blockingBudgetGuard{}
+	svc.SetBudgetGuard(guard)
+	if err := svc.Trigger(ctx, scheduleID); err == nil {
+		t.Fatal("expected Trigger to fail while the budget is exceeded")
+	}
+	if guard.botID != botID {
+		t.Errorf("guard botID = %s, want %s", guard.botID, botID)
+	}
+	if mock.called {
+		t.Fatal("triggerer must not be called while the budget is exceeded")
+	}
+	sched, err := svc.Get(ctx, scheduleID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if sched.CurrentCalls != 0 {
+		t.Errorf("CurrentCalls = %d, want 0", sched.CurrentCalls)
+	}
+}
@@ -1,12 +1,23 @@
 package schedule
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/robfig/cron/v3"
+
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/structured"
 )
 
 func TestGenerateTriggerToken(t *testing.T) {
@@ -73,3 +84,430 @@ func TestGenerateTriggerToken_EmptyUserID(t *testing.T) {
 		t.Fatal("expected error for empty user ID")
 	}
 }
+
+func TestOutputSchemaRoundTrip(t *testing.T) {
+	raw, err := normalizeOutputSchema([]byte(`{
+  "type": "object",
+  "properties": {"ok": {"type": "boolean"}}
+}`))
+	if err != nil {
+		t.Fatalf("normalize: %v", err)
+	}
+	if string(raw) != `{"type":"object","properties":{"ok":{"type":"boolean"}}}` {
+		t.Fatalf("normalized schema = %s", raw)
+	}
+	if got := toSchedule(sqlc.Schedule{OutputSchema: raw}); string(got.OutputSchema) != string(raw) {
+		t.Fatalf("schedule output schema = %s", got.OutputSchema)
+	}
+	if got := toSchedule(sqlc.Schedule{}); got.OutputSchema != nil {
+		t.Fatalf("schedule without schema = %s", got.OutputSchema)
+	}
+
+	if raw, err := normalizeOutputSchema([]byte("null")); err != nil || raw != nil {
+		t.Fatalf("null schema = %s, %v", raw, err)
+	}
+	if _, err := normalizeOutputSchema([]byte(`{"type": 1}`)); !errors.Is(err, structured.ErrInvalidSchema) {
+		t.Fatalf("invalid schema err = %v", err)
+	}
+
+	log := toScheduleLog(sqlc.ListScheduleLogsByBotRow{ResultJson: []byte(`{"ok":true}`)})
+	if string(log.ResultJSON) != `{"ok":true}` {
+		t.Fatalf("log result json = %s", log.ResultJSON)
+	}
+}
+
+const (
+	testBotID      = "5b0c7a2e-3f4d-4e1a-8b6c-9d0e1f2a3b4c"
+	testScheduleID = "6c1d8b3f-4a5e-4f2b-9c7d-0e1f2a3b4c5d"
+	testOwnerID    = "7d2e9c4a-5b6f-4a3c-8d8e-1f2a3b4c5d6e"
+)
+
+// fakeQueries keeps a single schedule and its logs in memory.
+type fakeQueries struct {
+	dbstore.Queries
+	mu       sync.Mutex
+	schedule sqlc.Schedule
+	logs     []sqlc.GetScheduleLogByIDRow
+}
+
+func newFakeQueries() *fakeQueries {
+	return &fakeQueries{schedule: sqlc.Schedule{
+		ID:          toUUID(testScheduleID),
+		BotID:       toUUID(testBotID),
+		Name:        "nightly",
+		Command:     "run the report",
+		Enabled:     true,
+		TriggerKind: string(TriggerKindCron),
+	}}
+}
+
+func (f *fakeQueries) addLog(status string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := uuid.NewString()
+	f.logs = append(f.logs, sqlc.GetScheduleLogByIDRow{
+		ID:          toUUID(id),
+		ScheduleID:  f.schedule.ID,
+		BotID:       f.schedule.BotID,
+		Status:      status,
+		CompletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	return id
+}
+
+func (f *fakeQueries) GetScheduleByID(context.Context, pgtype.UUID) (sqlc.Schedule, error) {
+	return f.schedule, nil
+}
+
+func (*fakeQueries) GetBotByID(_ context.Context, id pgtype.UUID) (sqlc.GetBotByIDRow, error) {
+	return sqlc.GetBotByIDRow{ID: id, OwnerUserID: toUUID(testOwnerID)}, nil
+}
+
+func (f *fakeQueries) GetScheduleLogByID(_ context.Context, id pgtype.UUID) (sqlc.GetScheduleLogByIDRow, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, l := range f.logs {
+		if l.ID == id {
+			return l, nil
+		}
+	}
+	return sqlc.GetScheduleLogByIDRow{}, pgx.ErrNoRows
+}
+
+func (f *fakeQueries) CreateScheduleLog(_ context.Context, arg sqlc.CreateScheduleLogParams) (sqlc.CreateScheduleLogRow, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	row := sqlc.GetScheduleLogByIDRow{
+		ID:         toUUID(uuid.NewString()),
+		ScheduleID: arg.ScheduleID,
+		BotID:      arg.BotID,
+		Status:     LogStatusOK,
+		Attempt:    arg.Attempt,
+		ReplayOf:   arg.ReplayOf,
+	}
+	f.logs = append(f.logs, row)
+	return sqlc.CreateScheduleLogRow{ID: row.ID}, nil
+}
+
+func (f *fakeQueries) CompleteScheduleLog(_ context.Context, arg sqlc.CompleteScheduleLogParams) (sqlc.ScheduleLog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range f.logs {
+		if f.logs[i].ID == arg.ID {
+			f.logs[i].Status = arg.Status
+			f.logs[i].ResultText = arg.ResultText
+			f.logs[i].ErrorMessage = arg.ErrorMessage
+			f.logs[i].CompletedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		}
+	}
+	return sqlc.ScheduleLog{}, nil
+}
+
+func (f *fakeQueries) CountActiveScheduleReplays(_ context.Context, replayOf pgtype.UUID) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var n int64
+	for _, l := range f.logs {
+		if l.ReplayOf != replayOf {
+			continue
+		}
+		switch {
+		case !l.CompletedAt.Valid, l.Status == LogStatusOK, l.Status == LogStatusRetrying, l.Status == LogStatusRetried:
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (f *fakeQueries) DeadLetterPendingScheduleRetries(context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range f.logs {
+		if f.logs[i].Status == LogStatusRetrying {
+			f.logs[i].Status = LogStatusDeadLetter
+		}
+	}
+	return nil
+}
+
+func (f *fakeQueries) FailInterruptedScheduleLogs(context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range f.logs {
+		if !f.logs[i].CompletedAt.Valid {
+			f.logs[i].Status = LogStatusError
+			f.logs[i].CompletedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		}
+	}
+	return nil
+}
+
+func (*fakeQueries) ListEnabledSchedules(context.Context) ([]sqlc.Schedule, error) {
+	return nil, nil
+}
+
+// gatedTriggerer reports each run on started and finishes it with the next
+// error sent on results.
+type gatedTriggerer struct {
+	started chan string
+	results chan error
+}
+
+func newGatedTriggerer() *gatedTriggerer {
+	return &gatedTriggerer{started: make(chan string, 8), results: make(chan error)}
+}
+
+func (g *gatedTriggerer) TriggerSchedule(_ context.Context, _ string, payload TriggerPayload, _ string) (TriggerResult, error) {
+	g.started <- payload.Event
+	if err := <-g.results; err != nil {
+		return TriggerResult{}, err
+	}
+	return TriggerResult{Status: LogStatusOK}, nil
+}
+
+func newTestService(queries *fakeQueries, triggerer Triggerer) *Service {
+	return &Service{
+		queries:      queries,
+		triggerer:    triggerer,
+		jwtSecret:    "test-secret",
+		logger:       slog.Default(),
+		jobs:         map[string]cron.EntryID{},
+		events:       map[string]eventJob{},
+		retryGen:     map[string]uint64{},
+		replays:      map[string]struct{}{},
+		runSessions:  map[string]runSession{},
+		lastEventRun: map[string]time.Time{},
+	}
+}
+
+// waitForLog waits until the replay run of logID has completed.
+func waitForLog(t *testing.T, queries *fakeQueries, logID string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		queries.mu.Lock()
+		done := false
+		for _, l := range queries.logs {
+			if l.ReplayOf == toUUID(logID) && l.CompletedAt.Valid {
+				done = true
+			}
+		}
+		queries.mu.Unlock()
+		if done {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("replay of %s did not complete", logID)
+}
+
+func TestReplayRunsAFailedLogOnce(t *testing.T) {
+	queries := newFakeQueries()
+	triggerer := newGatedTriggerer()
+	svc := newTestService(queries, triggerer)
+	ctx := context.Background()
+	failed := queries.addLog(LogStatusDeadLetter)
+
+	if err := svc.Replay(ctx, failed); err != nil {
+		t.Fatalf("first replay: %v", err)
+	}
+	<-triggerer.started
+	if err := svc.Replay(ctx, failed); !errors.Is(err, ErrReplayInProgress) {
+		t.Fatalf("replay while running = %v, want ErrReplayInProgress", err)
+	}
+
+	triggerer.results <- nil
+	waitForLog(t, queries, failed)
+	if err := svc.Replay(ctx, failed); !errors.Is(err, ErrReplayInProgress) {
+		t.Fatalf("replay after success = %v, want ErrReplayInProgress", err)
+	}
+}
+
+func TestReplayAllowsRetryAfterFailedReplay(t *testing.T) {
+	queries := newFakeQueries()
+	triggerer := newGatedTriggerer()
+	svc := newTestService(queries, triggerer)
+	ctx := context.Background()
+	failed := queries.addLog(LogStatusError)
+
+	if err := svc.Replay(ctx, failed); err != nil {
+		t.Fatalf("first replay: %v", err)
+	}
+	<-triggerer.started
+	triggerer.results <- errors.New("model unavailable")
+	waitForLog(t, queries, failed)
+
+	if err := svc.Replay(ctx, failed); err != nil {
+		t.Fatalf("replay after a failed replay: %v", err)
+	}
+	<-triggerer.started
+	triggerer.results <- nil
+}
+
+func TestBootstrapReleasesReplaysInterruptedByARestart(t *testing.T) {
+	queries := newFakeQueries()
+	triggerer := newGatedTriggerer()
+	svc := newTestService(queries, triggerer)
+	ctx := context.Background()
+	failed := queries.addLog(LogStatusError)
+	// A replay that was running when the server stopped.
+	queries.logs = append(queries.logs, sqlc.GetScheduleLogByIDRow{
+		ID:         toUUID(uuid.NewString()),
+		ScheduleID: queries.schedule.ID,
+		BotID:      queries.schedule.BotID,
+		Status:     LogStatusOK,
+		ReplayOf:   toUUID(failed),
+	})
+
+	if err := svc.Replay(ctx, failed); !errors.Is(err, ErrReplayInProgress) {
+		t.Fatalf("replay before bootstrap = %v, want ErrReplayInProgress", err)
+	}
+	if err := svc.Bootstrap(ctx); err != nil {
+		t.Fatalf("bootstrap: %v", err)
+	}
+	if err := svc.Replay(ctx, failed); err != nil {
+		t.Fatalf("replay after bootstrap: %v", err)
+	}
+	<-triggerer.started
+	triggerer.results <- nil
+}
+
+type fixedSessionCreator struct{ id string }
+
+func (c fixedSessionCreator) CreateSession(context.Context, string, string) (string, error) {
+	return c.id, nil
+}
+
+func (f *fakeQueries) IncrementScheduleCalls(context.Context, pgtype.UUID) (sqlc.Schedule, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.schedule.CurrentCalls++
+	return f.schedule, nil
+}
+
+func TestDispatchSkipsBackgroundTasksFromTheScheduleOwnRuns(t *testing.T) {
+	const runSessionID = "8e3fad5b-6c7a-4b4d-9e9f-2a3b4c5d6e7f"
+	queries := newFakeQueries()
+	queries.schedule.TriggerKind = string(TriggerKindBackgroundTask)
+	triggerer := newGatedTriggerer()
+	svc := newTestService(queries, triggerer)
+	svc.sessionCreator = fixedSessionCreator{id: runSessionID}
+	if err := svc.registerSchedule(context.Background(), queries.schedule); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	// A task from someone else's session fires the schedule; its run, in
+	// runSessionID, starts a task matching the schedule's own filter.
+	svc.Dispatch(context.Background(), BackgroundTaskEvent(testBotID, "chat-session", "bg_1", "completed", "make test", 0, ""))
+	<-triggerer.started
+	triggerer.results <- nil
+	waitForCalls(t, queries, 1)
+
+	svc.mu.Lock()
+	delete(svc.lastEventRun, testScheduleID)
+	svc.mu.Unlock()
+	svc.Dispatch(context.Background(), BackgroundTaskEvent(testBotID, runSessionID, "bg_2", "completed", "make test", 0, ""))
+	svc.mu.Lock()
+	_, fired := svc.lastEventRun[testScheduleID]
+	svc.mu.Unlock()
+	if fired {
+		t.Fatal("schedule fired on a background task started by its own run")
+	}
+
+	// Other sessions' tasks fire it again, but not twice within the cooldown.
+	svc.Dispatch(context.Background(), BackgroundTaskEvent(testBotID, "chat-session", "bg_3", "completed", "make test", 0, ""))
+	svc.Dispatch(context.Background(), BackgroundTaskEvent(testBotID, "chat-session", "bg_4", "completed", "make test", 0, ""))
+	if event := <-triggerer.started; !strings.Contains(event, "bg_3") {
+		t.Fatalf("run event = %q, want bg_3", event)
+	}
+	triggerer.results <- nil
+	waitForCalls(t, queries, 2)
+	select {
+	case event := <-triggerer.started:
+		t.Fatalf("schedule ran again within the cooldown: %q", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func waitForCalls(t *testing.T, queries *fakeQueries, calls int32) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		queries.mu.Lock()
+		done := queries.schedule.CurrentCalls == calls && len(queries.logs) == int(calls) && queries.logs[calls-1].CompletedAt.Valid
+		queries.mu.Unlock()
+		if done {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("schedule did not complete %d runs", calls)
+}
+
+type fakeWorkflowRunner struct {
+	err   error
+	calls []string
+}
+
+func (r *fakeWorkflowRunner) RunScheduledWorkflow(_ context.Context, workflowID string) (string, error) {
+	r.calls = append(r.calls, workflowID)
+	if r.err != nil {
+		return "", r.err
+	}
+	return "Workflow run completed.", nil
+}
+
+func TestWorkflowSchedulesRunTheWorkflowInsteadOfTheAgent(t *testing.T) {
+	const workflowID = "8e3fad5b-6c7a-4b4d-9e9f-2a3b4c5d6e7f"
+	queries := newFakeQueries()
+	queries.schedule.WorkflowID = toUUID(workflowID)
+	// Without a triggerer, a run that reached the agent would fail.
+	svc := newTestService(queries, nil)
+	runner := &fakeWorkflowRunner{err: errors.New("step fetch failed")}
+	svc.SetWorkflowRunner(runner)
+	ctx := context.Background()
+	sched := toSchedule(queries.schedule)
+	if sched.WorkflowID != workflowID {
+		t.Fatalf("schedule workflow = %q", sched.WorkflowID)
+	}
+
+	if err := svc.execute(ctx, sched, runAttempt{attempt: 1}); err == nil {
+		t.Fatal("failed workflow run reported success")
+	}
+	runner.err = nil
+	if err := svc.execute(ctx, sched, runAttempt{attempt: 1}); err != nil {
+		t.Fatalf("workflow run: %v", err)
+	}
+
+	if len(runner.calls) != 2 || runner.calls[0] != workflowID {
+		t.Fatalf("runner calls = %v", runner.calls)
+	}
+	if len(queries.logs) != 2 {
+		t.Fatalf("logs = %+v", queries.logs)
+	}
+	if l := queries.logs[0]; l.Status != LogStatusError || l.ErrorMessage != "step fetch failed" {
+		t.Fatalf("failed run log = %+v", l)
+	}
+	if l := queries.logs[1]; l.Status != LogStatusOK || l.ResultText != "Workflow run completed." {
+		t.Fatalf("successful run log = %+v", l)
+	}
+}
+
+func TestWorkflowSchedulesOnlyAcceptRetryPolicyChanges(t *testing.T) {
+	existing := newFakeQueries().schedule
+	existing.Pattern = "@daily"
+	existing.WorkflowID = toUUID("8e3fad5b-6c7a-4b4d-9e9f-2a3b4c5d6e7f")
+	name, pattern, enabled := existing.Name, existing.Pattern, existing.Enabled
+
+	if err := checkWorkflowUpdate(existing, UpdateRequest{Name: &name, Pattern: &pattern, Enabled: &enabled, RetryPolicy: &RetryPolicy{MaxAttempts: 3}}); err != nil {
+		t.Fatalf("unchanged fields with a new retry policy: %v", err)
+	}
+	other := "@hourly"
+	if err := checkWorkflowUpdate(existing, UpdateRequest{Pattern: &other}); !errors.Is(err, ErrManagedByWorkflow) {
+		t.Fatalf("pattern change err = %v, want ErrManagedByWorkflow", err)
+	}
+	disabled := false
+	if err := checkWorkflowUpdate(existing, UpdateRequest{Enabled: &disabled}); !errors.Is(err, ErrManagedByWorkflow) {
+		t.Fatalf("enabled change err = %v, want ErrManagedByWorkflow", err)
+	}
+}
@@ -1,6 +1,9 @@
 package schedule
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+)
 
 // TriggerPayload describes the parameters passed to the chat side when a schedule triggers.
 type TriggerPayload struct {
@@ -12,6 +15,13 @@ type TriggerPayload struct {
 	Command     string
 	OwnerUserID string
 	SessionID   string
+	// TriggerKind and Event describe what fired the run. Event is empty for
+	// cron ticks and manual triggers.
+	TriggerKind TriggerKind
+	Event       string
+	// OutputSchema, when set, constrains the run's final reply to JSON
+	// matching this JSON Schema.
+	OutputSchema json.RawMessage
 }
 
 // TriggerResult carries execution metadata back from the resolver.
@@ -20,9 +30,17 @@ type TriggerResult struct {
 	Text       string
 	UsageBytes []byte
 	ModelID    string
+	// Structured is the validated JSON reply of a run with an OutputSchema.
+	Structured json.RawMessage
 }
 
 // Triggerer triggers schedule execution for chat-related jobs.
 type Triggerer interface {
 	TriggerSchedule(ctx context.Context, botID string, payload TriggerPayload, token string) (TriggerResult, error)
 }
+
+// BudgetGuard reports whether a bot may still spend tokens. Check returns an
+// error once one of the bot's spending budgets is used up.
+type BudgetGuard interface {
+	Check(ctx context.Context, botID string) error
+}
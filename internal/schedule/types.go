@@ -6,17 +6,27 @@ import (
 )
 
 type Schedule struct {
-	ID           string    `json:"id"`
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	Pattern      string    `json:"pattern"`
-	MaxCalls     *int      `json:"max_calls,omitempty"`
-	CurrentCalls int       `json:"current_calls"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-	Enabled      bool      `json:"enabled"`
-	Command      string    `json:"command"`
-	BotID        string    `json:"bot_id"`
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	Description   string        `json:"description"`
+	Pattern       string        `json:"pattern"`
+	MaxCalls      *int          `json:"max_calls,omitempty"`
+	CurrentCalls  int           `json:"current_calls"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	Enabled       bool          `json:"enabled"`
+	Command       string        `json:"command"`
+	BotID         string        `json:"bot_id"`
+	TriggerKind   TriggerKind   `json:"trigger_kind"`
+	TriggerFilter TriggerFilter `json:"trigger_filter"`
+	RetryPolicy   RetryPolicy   `json:"retry_policy"`
+	// OutputSchema is the JSON Schema the final reply of every run must
+	// satisfy. Runs of a schedule without one return free text only.
+	OutputSchema json.RawMessage `json:"output_schema,omitempty" swaggertype:"object"`
+	// WorkflowID is set on the schedule that runs a workflow on its cron
+	// pattern. Only its retry policy can be changed; the rest follows the
+	// workflow.
+	WorkflowID string `json:"workflow_id,omitempty"`
 }
 
 type NullableInt struct {
@@ -49,22 +59,35 @@ func (n *NullableInt) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// CreateRequest creates a schedule. Pattern is required for cron schedules
+// (the default TriggerKind) and must be empty for event-triggered ones.
 type CreateRequest struct {
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	Pattern     string      `json:"pattern"`
-	MaxCalls    NullableInt `json:"max_calls,omitempty"`
-	Command     string      `json:"command"`
-	Enabled     *bool       `json:"enabled,omitempty"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Pattern       string         `json:"pattern"`
+	MaxCalls      NullableInt    `json:"max_calls,omitempty"`
+	Command       string         `json:"command"`
+	Enabled       *bool          `json:"enabled,omitempty"`
+	TriggerKind   TriggerKind    `json:"trigger_kind,omitempty"`
+	TriggerFilter *TriggerFilter `json:"trigger_filter,omitempty"`
+	RetryPolicy   *RetryPolicy   `json:"retry_policy,omitempty"`
+	// OutputSchema is an optional JSON Schema for structured run results.
+	OutputSchema json.RawMessage `json:"output_schema,omitempty" swaggertype:"object"`
 }
 
 type UpdateRequest struct {
-	Name        *string     `json:"name,omitempty"`
-	Description *string     `json:"description,omitempty"`
-	Pattern     *string     `json:"pattern,omitempty"`
-	MaxCalls    NullableInt `json:"max_calls,omitempty"`
-	Command     *string     `json:"command,omitempty"`
-	Enabled     *bool       `json:"enabled,omitempty"`
+	Name          *string        `json:"name,omitempty"`
+	Description   *string        `json:"description,omitempty"`
+	Pattern       *string        `json:"pattern,omitempty"`
+	MaxCalls      NullableInt    `json:"max_calls,omitempty"`
+	Command       *string        `json:"command,omitempty"`
+	Enabled       *bool          `json:"enabled,omitempty"`
+	TriggerKind   *TriggerKind   `json:"trigger_kind,omitempty"`
+	TriggerFilter *TriggerFilter `json:"trigger_filter,omitempty"`
+	RetryPolicy   *RetryPolicy   `json:"retry_policy,omitempty"`
+	// OutputSchema replaces the schedule's output schema when present; an
+	// explicit null removes it.
+	OutputSchema json.RawMessage `json:"output_schema,omitempty" swaggertype:"object"`
 }
 
 type ListResponse struct {
@@ -82,6 +105,12 @@ type Log struct {
 	Usage        any        `json:"usage,omitempty"`
 	StartedAt    time.Time  `json:"started_at"`
 	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	Attempt      int        `json:"attempt"`
+	TriggerEvent string     `json:"trigger_event,omitempty"`
+	ReplayOf     string     `json:"replay_of,omitempty"`
+	// ResultJSON is the validated structured result of a run of a schedule
+	// with an output schema.
+	ResultJSON json.RawMessage `json:"result_json,omitempty" swaggertype:"object"`
 }
 
 type ListLogsResponse struct {
@@ -0,0 +1,165 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+)
+
+// ErrManagedByWorkflow is returned when a change to the schedule of a
+// workflow must be made to the workflow instead.
+var ErrManagedByWorkflow = errors.New("schedule is managed by its workflow; edit the workflow to change or remove it")
+
+// WorkflowRunner runs the workflows schedules are linked to. Cron-triggered
+// workflows run through their schedule, so budget checks, retries, dead
+// letters and replays apply to them like to any other schedule.
+type WorkflowRunner interface {
+	// RunScheduledWorkflow starts a run of a workflow and waits for it to
+	// finish while ctx allows. It returns a summary of the run, and an error
+	// when the run failed.
+	RunScheduledWorkflow(ctx context.Context, workflowID string) (string, error)
+}
+
+// SetWorkflowRunner sets the runner that executes workflow schedules.
+func (s *Service) SetWorkflowRunner(runner WorkflowRunner) {
+	s.workflows = runner
+}
+
+// SyncWorkflowSchedule keeps the schedule of a workflow in step with its
+// cron pattern: it is created on the first pattern, follows later changes
+// and is removed when the pattern is cleared. The retry policy set on the
+// schedule is kept.
+func (s *Service) SyncWorkflowSchedule(ctx context.Context, botID, workflowID, name, pattern string, enabled bool) error {
+	pgWorkflowID, err := db.ParseUUID(workflowID)
+	if err != nil {
+		return err
+	}
+	existing, err := s.queries.GetScheduleByWorkflowID(ctx, pgWorkflowID)
+	found := err == nil
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	if strings.TrimSpace(pattern) == "" {
+		if found {
+			return s.deleteSchedule(ctx, existing.ID)
+		}
+		return nil
+	}
+	pattern, filter, err := s.validateTrigger(TriggerKindCron, pattern, TriggerFilter{})
+	if err != nil {
+		return err
+	}
+	filterPayload, err := encodeTriggerFilter(filter)
+	if err != nil {
+		return err
+	}
+	title := "Workflow: " + name
+	description := fmt.Sprintf("Runs workflow %s on its cron pattern.", name)
+	command := "Run workflow " + name
+	if !found {
+		retryPayload, err := encodeRetryPolicy(RetryPolicy{})
+		if err != nil {
+			return err
+		}
+		pgBotID, err := db.ParseUUID(botID)
+		if err != nil {
+			return err
+		}
+		row, err := s.queries.CreateSchedule(ctx, sqlc.CreateScheduleParams{
+			Name:          title,
+			Description:   description,
+			Pattern:       pattern,
+			Enabled:       enabled,
+			Command:       command,
+			BotID:         pgBotID,
+			TriggerKind:   string(TriggerKindCron),
+			TriggerFilter: filterPayload,
+			RetryPolicy:   retryPayload,
+			WorkflowID:    pgWorkflowID,
+		})
+		if err != nil {
+			return err
+		}
+		if row.Enabled {
+			return s.registerSchedule(ctx, row)
+		}
+		return nil
+	}
+	updated, err := s.queries.UpdateSchedule(ctx, sqlc.UpdateScheduleParams{
+		ID:            existing.ID,
+		Name:          title,
+		Description:   description,
+		Pattern:       pattern,
+		MaxCalls:      existing.MaxCalls,
+		Enabled:       enabled,
+		Command:       command,
+		TriggerKind:   string(TriggerKindCron),
+		TriggerFilter: filterPayload,
+		RetryPolicy:   existing.RetryPolicy,
+		OutputSchema:  existing.OutputSchema,
+	})
+	if err != nil {
+		return err
+	}
+	if !updated.Enabled {
+		s.cancelRetries(updated.ID.String())
+	}
+	return s.rescheduleJob(ctx, updated)
+}
+
+// RemoveWorkflowSchedule removes the schedule of a workflow, if it has one.
+func (s *Service) RemoveWorkflowSchedule(ctx context.Context, workflowID string) error {
+	return s.SyncWorkflowSchedule(ctx, "", workflowID, "", "", false)
+}
+
+// checkWorkflowUpdate rejects changes to a workflow schedule other than its
+// retry policy; everything else follows the workflow. Fields sent with their
+// current value are not changes.
+func checkWorkflowUpdate(existing sqlc.Schedule, req UpdateRequest) error {
+	changed := (req.Name != nil && *req.Name != existing.Name) ||
+		(req.Description != nil && *req.Description != existing.Description) ||
+		(req.Pattern != nil && strings.TrimSpace(*req.Pattern) != existing.Pattern) ||
+		(req.Command != nil && *req.Command != existing.Command) ||
+		(req.Enabled != nil && *req.Enabled != existing.Enabled) ||
+		(req.TriggerKind != nil && *req.TriggerKind != TriggerKind(existing.TriggerKind)) ||
+		(req.TriggerFilter != nil && *req.TriggerFilter != decodeTriggerFilter(existing.TriggerFilter)) ||
+		(req.MaxCalls.Set && req.MaxCalls.Value != nil) ||
+		(len(req.OutputSchema) > 0 && string(req.OutputSchema) != "null")
+	if changed {
+		return ErrManagedByWorkflow
+	}
+	return nil
+}
+
+// executeWorkflow runs the workflow of a schedule. The run has its own
+// session, so the schedule run creates none and does not call the agent.
+func (s *Service) executeWorkflow(ctx context.Context, sched Schedule, run runAttempt) error {
+	if s.workflows == nil {
+		return errors.New("schedule workflow runner not configured")
+	}
+	logRow, err := s.queries.CreateScheduleLog(ctx, sqlc.CreateScheduleLogParams{
+		ScheduleID:   toUUID(sched.ID),
+		BotID:        toUUID(sched.BotID),
+		Attempt:      int32(min(run.attempt, maxRetryAttempts)), //nolint:gosec // bounded by maxRetryAttempts
+		TriggerEvent: run.event,
+		ReplayOf:     run.replayOf,
+	})
+	if err != nil {
+		s.logger.Error("create schedule log failed", slog.String("schedule_id", sched.ID), slog.Any("error", err))
+	}
+	summary, err := s.workflows.RunScheduledWorkflow(ctx, sched.WorkflowID)
+	if err != nil {
+		return s.failRun(ctx, sched, run, logRow.ID, err)
+	}
+	s.completeLog(ctx, logRow.ID, LogStatusOK, summary, "", nil, pgtype.UUID{}, nil)
+	s.logger.Info("workflow schedule completed", slog.String("schedule_id", sched.ID), slog.String("workflow_id", sched.WorkflowID))
+	return nil
+}
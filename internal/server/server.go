@@ -8,6 +8,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/auth"
 )
 
@@ -21,7 +22,7 @@ type Handler interface {
 	Register(e *echo.Echo)
 }
 
-func NewServer(log *slog.Logger, addr string, jwtSecret string,
+func NewServer(log *slog.Logger, addr string, jwtSecret string, tokens auth.PersonalTokenVerifier,
 	handlers ...Handler,
 ) *Server {
 	if addr == "" {
@@ -51,9 +52,12 @@ func NewServer(log *slog.Logger, addr string, jwtSecret string,
 			return nil
 		},
 	}))
-	e.Use(auth.JWTMiddleware(jwtSecret, func(c echo.Context) bool {
+	skipAuth := func(c echo.Context) bool {
 		return shouldSkipJWT(c.Request().URL.Path)
-	}))
+	}
+	e.Use(auth.PersonalTokenMiddleware(tokens, skipAuth))
+	e.Use(auth.JWTMiddleware(jwtSecret, skipAuth))
+	e.Use(audit.Middleware())
 
 	for _, h := range handlers {
 		if h != nil {
@@ -104,6 +108,9 @@ func shouldSkipJWT(path string) bool {
 	if strings.HasPrefix(path, "/auth/callback") {
 		return true
 	}
+	if path == "/auth/oidc" || strings.HasPrefix(path, "/auth/oidc/") {
+		return true
+	}
 	return false
 }
 
@@ -32,3 +32,16 @@ func TestShouldSkipJWT_MCPOAuthCallbackPaths(t *testing.T) {
 		}
 	}
 }
+
+func TestShouldSkipJWT_OIDCPaths(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{"/auth/oidc", "/auth/oidc/authorize", "/auth/oidc/exchange"} {
+		if !shouldSkipJWT(path) {
+			t.Fatalf("path=%q should skip jwt", path)
+		}
+	}
+	if shouldSkipJWT("/auth/oidcx") {
+		t.Fatal("path=/auth/oidcx should not skip jwt")
+	}
+}
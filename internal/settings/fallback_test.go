@@ -0,0 +1,206 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+)
+
+const (
+	fallbackBotID   = "00000000-0000-0000-0000-0000000000f2"
+	fallbackModelA  = "00000000-0000-0000-0000-0000000000fa"
+	fallbackModelB  = "00000000-0000-0000-0000-0000000000fb"
+	fallbackModelID = "local-llama"
+)
+
+func newSQLiteSettingsService(t *testing.T) *Service {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('00000000-0000-0000-0000-0000000000f1','fallback@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES('` + fallbackBotID + `','00000000-0000-0000-0000-0000000000f1','personal','fallbackbot','Fallback Bot')`,
+		`INSERT INTO providers(id,name) VALUES('00000000-0000-0000-0000-0000000000f3','openai')`,
+		`INSERT INTO providers(id,name) VALUES('00000000-0000-0000-0000-0000000000f4','local')`,
+		`INSERT INTO models(id,model_id,provider_id) VALUES('` + fallbackModelA + `','gpt-4o','00000000-0000-0000-0000-0000000000f3')`,
+		`INSERT INTO models(id,model_id,provider_id) VALUES('` + fallbackModelB + `','` + fallbackModelID + `','00000000-0000-0000-0000-0000000000f4')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	return NewService(slog.Default(), sqlitestore.NewQueries(store), nil, nil)
+}
+
+func TestUpsertBotFallbackModelChain(t *testing.T) {
+	svc := newSQLiteSettingsService(t)
+	ctx := context.Background()
+
+	got, err := svc.GetBot(ctx, fallbackBotID)
+	if err != nil {
+		t.Fatalf("GetBot() error = %v", err)
+	}
+	if got.FallbackModelIDs == nil || len(got.FallbackModelIDs) != 0 {
+		t.Fatalf("default fallback chain = %#v, want empty", got.FallbackModelIDs)
+	}
+
+	// Slugs resolve to UUIDs, duplicates collapse and order is kept.
+	updated, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{
+		FallbackModelIDs: []string{fallbackModelID, fallbackModelA, fallbackModelB},
+	})
+	if err != nil {
+		t.Fatalf("UpsertBot() error = %v", err)
+	}
+	want := []string{fallbackModelB, fallbackModelA}
+	if !slices.Equal(updated.FallbackModelIDs, want) {
+		t.Fatalf("fallback chain = %v, want %v", updated.FallbackModelIDs, want)
+	}
+
+	// Omitting the field keeps the chain.
+	if _, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{Language: "en"}); err != nil {
+		t.Fatalf("UpsertBot() error = %v", err)
+	}
+	got, err = svc.GetBot(ctx, fallbackBotID)
+	if err != nil || !slices.Equal(got.FallbackModelIDs, want) {
+		t.Fatalf("GetBot() fallback chain = %v, %v", got.FallbackModelIDs, err)
+	}
+
+	if _, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{FallbackModelIDs: []string{"missing-model"}}); !errors.Is(err, ErrInvalidModelRef) {
+		t.Fatalf("UpsertBot() unknown model error = %v, want ErrInvalidModelRef", err)
+	}
+
+	cleared, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{FallbackModelIDs: []string{}})
+	if err != nil || len(cleared.FallbackModelIDs) != 0 {
+		t.Fatalf("UpsertBot() clear = %v, %v", cleared.FallbackModelIDs, err)
+	}
+}
+
+func TestUpsertBotToolApprovalPolicies(t *testing.T) {
+	svc := newSQLiteSettingsService(t)
+	ctx := context.Background()
+
+	cfg := DefaultToolApprovalConfig()
+	cfg.Enabled = true
+	cfg.Policies = []ToolApprovalPolicyRule{{Name: " no rm ", Expression: `tool == "exec" && args.command.contains("rm ")`, Effect: " Deny "}}
+	updated, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &cfg})
+	if err != nil {
+		t.Fatalf("UpsertBot() error = %v", err)
+	}
+	got := updated.ToolApprovalConfig.Policies
+	if len(got) != 1 || got[0].Name != "no rm" || got[0].Effect != ToolPolicyEffectDeny {
+		t.Fatalf("policies = %+v", got)
+	}
+
+	for _, rule := range []ToolApprovalPolicyRule{
+		{Expression: `tool ==`, Effect: ToolPolicyEffectDeny},
+		{Expression: `tool`, Effect: ToolPolicyEffectDeny},
+		{Expression: `true`, Effect: "maybe"},
+	} {
+		bad := cfg
+		bad.Policies = []ToolApprovalPolicyRule{rule}
+		if _, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &bad}); !errors.Is(err, ErrInvalidToolPolicy) {
+			t.Fatalf("UpsertBot(%+v) error = %v, want ErrInvalidToolPolicy", rule, err)
+		}
+	}
+	stored, err := svc.GetBot(ctx, fallbackBotID)
+	if err != nil || len(stored.ToolApprovalConfig.Policies) != 1 {
+		t.Fatalf("stored policies = %+v, %v", stored.ToolApprovalConfig.Policies, err)
+	}
+
+	// A config without policies keeps the stored ones; an empty list clears them.
+	legacy := DefaultToolApprovalConfig()
+	legacy.Policies = nil
+	updated, err = svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &legacy})
+	if err != nil || len(updated.ToolApprovalConfig.Policies) != 1 {
+		t.Fatalf("legacy upsert policies = %+v, %v", updated.ToolApprovalConfig.Policies, err)
+	}
+	legacy.Policies = []ToolApprovalPolicyRule{}
+	updated, err = svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &legacy})
+	if err != nil || len(updated.ToolApprovalConfig.Policies) != 0 {
+		t.Fatalf("cleared policies = %+v, %v", updated.ToolApprovalConfig.Policies, err)
+	}
+}
+
+func TestUpsertBotToolApprovalWorkflow(t *testing.T) {
+	svc := newSQLiteSettingsService(t)
+	ctx := context.Background()
+	const approverID = "00000000-0000-0000-0000-00000000f001"
+
+	cfg := DefaultToolApprovalConfig()
+	cfg.Enabled = true
+	cfg.Workflow = &ToolApprovalWorkflow{
+		Approvers:          []ToolApprovalApprover{{ChannelIdentityID: " " + approverID + " "}},
+		ExpireAfterSeconds: 600,
+	}
+	cfg.Policies = []ToolApprovalPolicyRule{{
+		Expression: `tool == "exec"`,
+		Effect:     ToolPolicyEffectRequireApproval,
+		Workflow:   &ToolApprovalWorkflow{Approvers: []ToolApprovalApprover{{ACLRuleID: approverID}}, Quorum: 1},
+	}}
+	updated, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &cfg})
+	if err != nil {
+		t.Fatalf("UpsertBot() error = %v", err)
+	}
+	wf := updated.ToolApprovalConfig.Workflow
+	if wf == nil || wf.Approvers[0].ChannelIdentityID != approverID || wf.ExpireAfterSeconds != 600 {
+		t.Fatalf("workflow = %+v", wf)
+	}
+
+	for name, bad := range map[string]*ToolApprovalWorkflow{
+		"both ids":          {Approvers: []ToolApprovalApprover{{ChannelIdentityID: approverID, ACLRuleID: approverID}}},
+		"bad id":            {Approvers: []ToolApprovalApprover{{ChannelIdentityID: "alice"}}},
+		"quorum only":       {Quorum: 2},
+		"escalate no one":   {EscalateAfterSeconds: 60},
+		"escalate too late": {EscalateAfterSeconds: 60, EscalateTo: []ToolApprovalApprover{{ChannelIdentityID: approverID}}, ExpireAfterSeconds: 60},
+	} {
+		next := cfg
+		next.Workflow = bad
+		if _, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &next}); !errors.Is(err, ErrInvalidToolPolicy) {
+			t.Fatalf("%s: error = %v, want ErrInvalidToolPolicy", name, err)
+		}
+	}
+	allow := cfg
+	allow.Policies = []ToolApprovalPolicyRule{{Expression: "true", Effect: ToolPolicyEffectAllow, Workflow: cfg.Workflow}}
+	if _, err := svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &allow}); !errors.Is(err, ErrInvalidToolPolicy) {
+		t.Fatalf("allow rule with workflow error = %v, want ErrInvalidToolPolicy", err)
+	}
+
+	// A config without a workflow keeps the stored one; an empty object clears it.
+	legacy := DefaultToolApprovalConfig()
+	updated, err = svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &legacy})
+	if err != nil || updated.ToolApprovalConfig.Workflow == nil {
+		t.Fatalf("legacy upsert workflow = %+v, %v", updated.ToolApprovalConfig.Workflow, err)
+	}
+	legacy.Workflow = &ToolApprovalWorkflow{}
+	updated, err = svc.UpsertBot(ctx, fallbackBotID, UpsertRequest{ToolApprovalConfig: &legacy})
+	if err != nil || updated.ToolApprovalConfig.Workflow != nil {
+		t.Fatalf("cleared workflow = %+v, %v", updated.ToolApprovalConfig.Workflow, err)
+	}
+}
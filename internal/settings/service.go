@@ -13,6 +13,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/memohai/memoh/internal/acl"
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/db"
 	"github.com/memohai/memoh/internal/db/postgres/sqlc"
 	dbstore "github.com/memohai/memoh/internal/db/store"
@@ -21,15 +22,19 @@ import (
 )
 
 type Service struct {
-	queries dbstore.Queries
-	acl     *acl.Service
-	network *netctl.Service
-	logger  *slog.Logger
+	queries  dbstore.Queries
+	acl      *acl.Service
+	network  *netctl.Service
+	logger   *slog.Logger
+	auditLog *audit.Service
 }
 
 var (
 	ErrModelIDAmbiguous = errors.New("model_id is ambiguous across providers")
 	ErrInvalidModelRef  = errors.New("invalid model reference")
+	// ErrInvalidToolPolicy marks a tool approval policy rule that cannot
+	// be saved.
+	ErrInvalidToolPolicy = errors.New("invalid tool approval policy")
 )
 
 func NewService(log *slog.Logger, queries dbstore.Queries, aclService *acl.Service, networkService *netctl.Service) *Service {
@@ -41,6 +46,11 @@ func NewService(log *slog.Logger, queries dbstore.Queries, aclService *acl.Servi
 	}
 }
 
+// SetAuditLog records settings changes to the audit log.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
 func (s *Service) GetBot(ctx context.Context, botID string) (Settings, error) {
 	pgID, err := db.ParseUUID(botID)
 	if err != nil {
@@ -56,6 +66,10 @@ func (s *Service) GetBot(ctx context.Context, botID string) (Settings, error) {
 		return Settings{}, err
 	}
 	settings.AclDefaultEffect = aclDefaultEffect
+	settings.FallbackModelIDs, err = s.listFallbackModelIDs(ctx, pgID)
+	if err != nil {
+		return Settings{}, err
+	}
 	return settings, nil
 }
 
@@ -89,6 +103,12 @@ func (s *Service) UpsertBot(ctx context.Context, botID string, req UpsertRequest
 	if err != nil {
 		return Settings{}, err
 	}
+	var before any
+	if s.auditLog != nil {
+		if previous, err := s.GetBot(ctx, botID); err == nil {
+			before = previous
+		}
+	}
 	overlayBindingRow, err := s.queries.GetBotOverlayConfig(ctx, pgID)
 	if err != nil {
 		return Settings{}, err
@@ -149,7 +169,20 @@ func (s *Service) UpsertBot(ctx context.Context, botID string, req UpsertRequest
 		current.ShowToolCallsInIM = *req.ShowToolCallsInIM
 	}
 	if req.ToolApprovalConfig != nil {
-		current.ToolApprovalConfig = NormalizeToolApprovalConfig(*req.ToolApprovalConfig)
+		// Clients that predate policies send the config without them; a nil
+		// list keeps the stored policies and an empty one clears them. The
+		// same goes for the workflow, which an empty object clears.
+		incoming := *req.ToolApprovalConfig
+		if incoming.Policies == nil {
+			incoming.Policies = current.ToolApprovalConfig.Policies
+		}
+		if incoming.Workflow == nil {
+			incoming.Workflow = current.ToolApprovalConfig.Workflow
+		}
+		current.ToolApprovalConfig = NormalizeToolApprovalConfig(incoming)
+		if err := ValidateToolApprovalConfig(current.ToolApprovalConfig); err != nil {
+			return Settings{}, err
+		}
 	}
 	if req.DisplayEnabled != nil {
 		current.DisplayEnabled = *req.DisplayEnabled
@@ -205,6 +238,15 @@ func (s *Service) UpsertBot(ctx context.Context, botID string, req UpsertRequest
 		}
 		titleModelUUID = modelID
 	}
+	// A nil fallback chain leaves the stored one untouched; an empty array
+	// clears it.
+	var fallbackModelUUIDs []pgtype.UUID
+	if req.FallbackModelIDs != nil {
+		fallbackModelUUIDs, err = s.resolveFallbackModelUUIDs(ctx, req.FallbackModelIDs)
+		if err != nil {
+			return Settings{}, err
+		}
+	}
 	imageModelUUID := pgtype.UUID{}
 	if value := strings.TrimSpace(req.ImageModelID); value != "" {
 		modelID, err := s.resolveModelUUID(ctx, value)
@@ -318,8 +360,25 @@ func (s *Service) UpsertBot(ctx context.Context, botID string, req UpsertRequest
 	if err := s.setDefaultEffect(ctx, botID, current.AclDefaultEffect); err != nil {
 		return Settings{}, err
 	}
+	if req.FallbackModelIDs != nil {
+		if err := s.replaceFallbackModels(ctx, pgID, fallbackModelUUIDs); err != nil {
+			return Settings{}, err
+		}
+	}
 	settings := normalizeBotSettingsWriteRow(updated)
 	settings.AclDefaultEffect = current.AclDefaultEffect
+	settings.FallbackModelIDs, err = s.listFallbackModelIDs(ctx, pgID)
+	if err != nil {
+		return Settings{}, err
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionSettingsUpdate,
+		BotID:      botID,
+		TargetType: "bot_settings",
+		TargetID:   botID,
+		Before:     before,
+		After:      settings,
+	})
 	return settings, nil
 }
 
@@ -331,9 +390,22 @@ func (s *Service) Delete(ctx context.Context, botID string) error {
 	if err != nil {
 		return err
 	}
+	var before any
+	if s.auditLog != nil {
+		if previous, err := s.GetBot(ctx, botID); err == nil {
+			before = previous
+		}
+	}
 	if err := s.queries.DeleteSettingsByBotID(ctx, pgID); err != nil {
 		return err
 	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionSettingsDelete,
+		BotID:      botID,
+		TargetType: "bot_settings",
+		TargetID:   botID,
+		Before:     before,
+	})
 	return nil
 }
 
@@ -660,6 +732,53 @@ func (s *Service) resolveModelUUID(ctx context.Context, modelID string) (pgtype.
 	return rows[0].ID, nil
 }
 
+// resolveFallbackModelUUIDs resolves a fallback chain to model UUIDs,
+// keeping the first occurrence of each model.
+func (s *Service) resolveFallbackModelUUIDs(ctx context.Context, refs []string) ([]pgtype.UUID, error) {
+	ids := make([]pgtype.UUID, 0, len(refs))
+	seen := make(map[pgtype.UUID]struct{}, len(refs))
+	for _, ref := range refs {
+		id, err := s.resolveModelUUID(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (s *Service) replaceFallbackModels(ctx context.Context, botID pgtype.UUID, modelIDs []pgtype.UUID) error {
+	if err := s.queries.DeleteBotModelFallbacks(ctx, botID); err != nil {
+		return fmt.Errorf("clear fallback models: %w", err)
+	}
+	for i, modelID := range modelIDs {
+		if err := s.queries.CreateBotModelFallback(ctx, sqlc.CreateBotModelFallbackParams{
+			BotID:    botID,
+			ModelID:  modelID,
+			Position: int32(i), //nolint:gosec // bounded by request size
+		}); err != nil {
+			return fmt.Errorf("save fallback model: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) listFallbackModelIDs(ctx context.Context, botID pgtype.UUID) ([]string, error) {
+	rows, err := s.queries.ListBotModelFallbacks(ctx, botID)
+	if err != nil {
+		return nil, fmt.Errorf("list fallback models: %w", err)
+	}
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, uuid.UUID(row.Bytes).String())
+	}
+	return ids, nil
+}
+
 func normalizeOptionalTimezone(raw string) (pgtype.Text, error) {
 	normalized := strings.TrimSpace(raw)
 	if normalized == "" {
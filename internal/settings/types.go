@@ -1,5 +1,15 @@
 package settings
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/memohai/memoh/internal/toolpolicy"
+)
+
 const (
 	DefaultLanguage          = "auto"
 	DefaultCommandUILanguage = "auto"
@@ -9,6 +19,7 @@ const (
 
 type Settings struct {
 	ChatModelID            string             `json:"chat_model_id"`
+	FallbackModelIDs       []string           `json:"fallback_model_ids"`
 	ImageModelID           string             `json:"image_model_id"`
 	SearchProviderID       string             `json:"search_provider_id"`
 	MemoryProviderID       string             `json:"memory_provider_id"`
@@ -40,6 +51,7 @@ type Settings struct {
 
 type UpsertRequest struct {
 	ChatModelID            string              `json:"chat_model_id,omitempty"`
+	FallbackModelIDs       []string            `json:"fallback_model_ids,omitempty"`
 	ImageModelID           string              `json:"image_model_id,omitempty"`
 	SearchProviderID       string              `json:"search_provider_id,omitempty"`
 	MemoryProviderID       string              `json:"memory_provider_id,omitempty"`
@@ -74,6 +86,57 @@ type ToolApprovalConfig struct {
 	Write   ToolApprovalFilePolicy `json:"write"`
 	Edit    ToolApprovalFilePolicy `json:"edit"`
 	Exec    ToolApprovalExecPolicy `json:"exec"`
+	// Policies are evaluated in order before the write/edit/exec rules
+	// above; the first enabled rule whose expression is true decides.
+	Policies []ToolApprovalPolicyRule `json:"policies"`
+	// Workflow decides who may answer approval requests that have no
+	// rule-level workflow. Nil lets anyone in the conversation decide.
+	Workflow *ToolApprovalWorkflow `json:"workflow,omitempty"`
+}
+
+const (
+	ToolPolicyEffectAllow           = "allow"
+	ToolPolicyEffectDeny            = "deny"
+	ToolPolicyEffectRequireApproval = "require_approval"
+)
+
+// ToolApprovalPolicyRule is a CEL expression over a tool call; see package
+// toolpolicy for the variables it can use.
+type ToolApprovalPolicyRule struct {
+	Name       string `json:"name,omitempty"`
+	Expression string `json:"expression"`
+	Effect     string `json:"effect"`
+	Disabled   bool   `json:"disabled,omitempty"`
+	// Workflow overrides the config-level workflow for calls this rule
+	// sends for approval. Only valid with the require_approval effect.
+	Workflow *ToolApprovalWorkflow `json:"workflow,omitempty"`
+}
+
+// ToolApprovalWorkflow routes an approval request to named approvers.
+// Approvers may answer from any channel the bot is on, not only the
+// conversation that made the call.
+type ToolApprovalWorkflow struct {
+	// Approvers may vote on the request. Empty lets anyone in the
+	// conversation decide, as without a workflow.
+	Approvers []ToolApprovalApprover `json:"approvers,omitempty"`
+	// Quorum is how many approvers must approve; 0 means 1. A reject from
+	// any approver rejects the request.
+	Quorum int `json:"quorum,omitempty"`
+	// EscalateAfterSeconds hands the request to EscalateTo when it is still
+	// pending after this long. A single escalation approver can then decide.
+	EscalateAfterSeconds int                    `json:"escalate_after_seconds,omitempty"`
+	EscalateTo           []ToolApprovalApprover `json:"escalate_to,omitempty"`
+	// ExpireAfterSeconds rejects the request, as expired, when it is still
+	// pending after this long.
+	ExpireAfterSeconds int `json:"expire_after_seconds,omitempty"`
+}
+
+// ToolApprovalApprover is a channel identity or the subject of one of the
+// bot's ACL rules: that rule's channel identity, or everyone on its
+// subject channel type.
+type ToolApprovalApprover struct {
+	ChannelIdentityID string `json:"channel_identity_id,omitempty"`
+	ACLRuleID         string `json:"acl_rule_id,omitempty"`
 }
 
 type ToolApprovalFilePolicy struct {
@@ -107,6 +170,7 @@ func DefaultToolApprovalConfig() ToolApprovalConfig {
 			BypassCommands:      []string{},
 			ForceReviewCommands: []string{},
 		},
+		Policies: []ToolApprovalPolicyRule{},
 	}
 }
 
@@ -116,9 +180,113 @@ func NormalizeToolApprovalConfig(cfg ToolApprovalConfig) ToolApprovalConfig {
 	defaults.Write = normalizeFilePolicy(cfg.Write, defaults.Write)
 	defaults.Edit = normalizeFilePolicy(cfg.Edit, defaults.Edit)
 	defaults.Exec = normalizeExecPolicy(cfg.Exec, defaults.Exec)
+	for _, rule := range cfg.Policies {
+		rule.Name = strings.TrimSpace(rule.Name)
+		rule.Expression = strings.TrimSpace(rule.Expression)
+		rule.Effect = strings.ToLower(strings.TrimSpace(rule.Effect))
+		rule.Workflow = normalizeWorkflow(rule.Workflow)
+		defaults.Policies = append(defaults.Policies, rule)
+	}
+	defaults.Workflow = normalizeWorkflow(cfg.Workflow)
 	return defaults
 }
 
+// ValidateToolApprovalConfig reports the first policy rule with an unknown
+// effect or an expression that does not compile, wrapping
+// ErrInvalidToolPolicy.
+func ValidateToolApprovalConfig(cfg ToolApprovalConfig) error {
+	for i, rule := range cfg.Policies {
+		switch rule.Effect {
+		case ToolPolicyEffectAllow, ToolPolicyEffectDeny, ToolPolicyEffectRequireApproval:
+		default:
+			return fmt.Errorf("%w: policies[%d]: effect must be allow, deny or require_approval", ErrInvalidToolPolicy, i)
+		}
+		if err := toolpolicy.Validate(rule.Expression); err != nil {
+			return fmt.Errorf("%w: policies[%d]: %w", ErrInvalidToolPolicy, i, err)
+		}
+		if rule.Workflow != nil && rule.Effect != ToolPolicyEffectRequireApproval {
+			return fmt.Errorf("%w: policies[%d]: workflow requires the require_approval effect", ErrInvalidToolPolicy, i)
+		}
+		if err := validateWorkflow(rule.Workflow); err != nil {
+			return fmt.Errorf("%w: policies[%d].workflow: %w", ErrInvalidToolPolicy, i, err)
+		}
+	}
+	if err := validateWorkflow(cfg.Workflow); err != nil {
+		return fmt.Errorf("%w: workflow: %w", ErrInvalidToolPolicy, err)
+	}
+	return nil
+}
+
+// normalizeWorkflow trims approver ids and drops a workflow that sets
+// nothing, so an empty object clears it.
+func normalizeWorkflow(wf *ToolApprovalWorkflow) *ToolApprovalWorkflow {
+	if wf == nil {
+		return nil
+	}
+	out := *wf
+	out.Approvers = normalizeApprovers(wf.Approvers)
+	out.EscalateTo = normalizeApprovers(wf.EscalateTo)
+	if len(out.Approvers) == 0 && len(out.EscalateTo) == 0 && out.Quorum == 0 &&
+		out.EscalateAfterSeconds == 0 && out.ExpireAfterSeconds == 0 {
+		return nil
+	}
+	return &out
+}
+
+func normalizeApprovers(approvers []ToolApprovalApprover) []ToolApprovalApprover {
+	if len(approvers) == 0 {
+		return nil
+	}
+	out := make([]ToolApprovalApprover, 0, len(approvers))
+	for _, approver := range approvers {
+		approver.ChannelIdentityID = strings.TrimSpace(approver.ChannelIdentityID)
+		approver.ACLRuleID = strings.TrimSpace(approver.ACLRuleID)
+		out = append(out, approver)
+	}
+	return out
+}
+
+func validateWorkflow(wf *ToolApprovalWorkflow) error {
+	if wf == nil {
+		return nil
+	}
+	if err := validateApprovers("approvers", wf.Approvers); err != nil {
+		return err
+	}
+	if err := validateApprovers("escalate_to", wf.EscalateTo); err != nil {
+		return err
+	}
+	switch {
+	case wf.Quorum < 0:
+		return errors.New("quorum must not be negative")
+	case wf.Quorum > 1 && len(wf.Approvers) == 0:
+		return errors.New("quorum needs approvers")
+	case wf.EscalateAfterSeconds < 0 || wf.ExpireAfterSeconds < 0:
+		return errors.New("timeouts must not be negative")
+	case (wf.EscalateAfterSeconds > 0) != (len(wf.EscalateTo) > 0):
+		return errors.New("escalate_after_seconds and escalate_to must be set together")
+	case wf.ExpireAfterSeconds > 0 && wf.EscalateAfterSeconds >= wf.ExpireAfterSeconds:
+		return errors.New("escalate_after_seconds must be less than expire_after_seconds")
+	}
+	return nil
+}
+
+func validateApprovers(field string, approvers []ToolApprovalApprover) error {
+	for i, approver := range approvers {
+		id := approver.ChannelIdentityID
+		if (id == "") == (approver.ACLRuleID == "") {
+			return fmt.Errorf("%s[%d]: set exactly one of channel_identity_id and acl_rule_id", field, i)
+		}
+		if id == "" {
+			id = approver.ACLRuleID
+		}
+		if _, err := uuid.Parse(id); err != nil {
+			return fmt.Errorf("%s[%d]: %w", field, i, err)
+		}
+	}
+	return nil
+}
+
 func normalizeFilePolicy(policy, defaults ToolApprovalFilePolicy) ToolApprovalFilePolicy {
 	defaults.RequireApproval = policy.RequireApproval
 	if policy.BypassGlobs != nil {
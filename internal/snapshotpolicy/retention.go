@@ -0,0 +1,90 @@
+package snapshotpolicy
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/memohai/memoh/internal/workspace"
+)
+
+// hookGrace is how long snapshots taken before a tool call are kept
+// regardless of retention: the one taken right before a destructive command
+// must outlive the snapshots taken after it in the same hour until someone
+// had the chance to notice.
+const hookGrace = 24 * time.Hour
+
+// tier is one level of grandfather-father-son retention.
+type tier struct {
+	keep   int
+	bucket func(time.Time) string
+}
+
+// Prunable returns the versions the policy no longer retains, oldest first.
+// Only automatic versions are considered. Of those, the newest one in each
+// of the latest KeepHourly hours, KeepDaily days, KeepWeekly ISO weeks and
+// KeepMonthly months is kept, as are hook snapshots younger than hookGrace
+// and the newest version of the workspace.
+func Prunable(policy Policy, versions []workspace.VersionInfo, now time.Time) []int {
+	if len(versions) == 0 {
+		return nil
+	}
+	candidates := make([]workspace.VersionInfo, 0, len(versions))
+	newest := versions[0]
+	for _, v := range versions {
+		if v.Version > newest.Version {
+			newest = v
+		}
+		if Automatic(v.Source) {
+			candidates = append(candidates, v)
+		}
+	}
+	slices.SortFunc(candidates, func(a, b workspace.VersionInfo) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return b.Version - a.Version
+	})
+
+	keep := map[int]bool{newest.Version: true}
+	for _, t := range policyTiers(policy) {
+		seen := map[string]bool{}
+		for _, v := range candidates {
+			if len(seen) >= t.keep {
+				break
+			}
+			key := t.bucket(v.CreatedAt.UTC())
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			keep[v.Version] = true
+		}
+	}
+	for _, v := range candidates {
+		if v.Source != workspace.SnapshotSourceScheduled && now.Sub(v.CreatedAt) < hookGrace {
+			keep[v.Version] = true
+		}
+	}
+
+	var out []int
+	for _, v := range candidates {
+		if !keep[v.Version] {
+			out = append(out, v.Version)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+func policyTiers(p Policy) []tier {
+	return []tier{
+		{keep: p.KeepHourly, bucket: func(t time.Time) string { return t.Format("2006-01-02T15") }},
+		{keep: p.KeepDaily, bucket: func(t time.Time) string { return t.Format("2006-01-02") }},
+		{keep: p.KeepWeekly, bucket: func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{keep: p.KeepMonthly, bucket: func(t time.Time) string { return t.Format("2006-01") }},
+	}
+}
@@ -0,0 +1,100 @@
+package snapshotpolicy
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/memohai/memoh/internal/workspace"
+)
+
+func TestPrunableKeepsNewestPerBucket(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 30, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return now.Add(-d) }
+	versions := []workspace.VersionInfo{
+		{Version: 1, Source: workspace.SnapshotSourceScheduled, CreatedAt: at(10 * 24 * time.Hour)},
+		{Version: 2, Source: workspace.SnapshotSourceManual, CreatedAt: at(9 * 24 * time.Hour)},
+		{Version: 3, Source: workspace.SnapshotSourceScheduled, CreatedAt: at(3*24*time.Hour + time.Hour)},
+		{Version: 4, Source: workspace.SnapshotSourceScheduled, CreatedAt: at(3 * 24 * time.Hour)},
+		{Version: 5, Source: workspace.SnapshotSourceScheduled, CreatedAt: at(2 * 24 * time.Hour)},
+		{Version: 6, Source: workspace.SnapshotSourceScheduled, CreatedAt: at(3 * time.Hour)},
+		{Version: 7, Source: workspace.SnapshotSourceScheduled, CreatedAt: at(2 * time.Hour)},
+		{Version: 8, Source: workspace.SnapshotSourceScheduled, CreatedAt: at(90 * time.Minute)},
+		{Version: 9, Source: workspace.SnapshotSourceScheduled, CreatedAt: at(20 * time.Minute)},
+	}
+	policy := Policy{KeepHourly: 2, KeepDaily: 3}
+
+	// Hourly keeps 9 (12:xx) and 8 (11:xx). Daily keeps 9 (today), 5 (two
+	// days ago) and 4 (three days ago). The manual version 2 is never
+	// considered.
+	got := Prunable(policy, versions, now)
+	want := []int{1, 3, 6, 7}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Prunable() = %v, want %v", got, want)
+	}
+}
+
+func TestPrunableKeepsRecentHookSnapshotsAndNewestVersion(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 30, 0, 0, time.UTC)
+	versions := []workspace.VersionInfo{
+		{Version: 1, Source: workspace.SnapshotSourcePreExec, CreatedAt: now.Add(-50 * time.Hour)},
+		{Version: 2, Source: workspace.SnapshotSourcePreExec, CreatedAt: now.Add(-10 * time.Minute)},
+		{Version: 3, Source: workspace.SnapshotSourcePreSkillInstall, CreatedAt: now.Add(-5 * time.Minute)},
+		{Version: 4, Source: workspace.SnapshotSourceScheduled, CreatedAt: now.Add(-4 * time.Minute)},
+		{Version: 5, Source: workspace.SnapshotSourceRollback, CreatedAt: now.Add(-time.Minute)},
+	}
+	// Only the newest automatic snapshot fits the hourly tier, but the hook
+	// snapshots from the last day stay, and version 1 is past the grace.
+	got := Prunable(Policy{KeepHourly: 1}, versions, now)
+	if !slices.Equal(got, []int{1}) {
+		t.Fatalf("Prunable() = %v, want [1]", got)
+	}
+
+	only := []workspace.VersionInfo{{Version: 7, Source: workspace.SnapshotSourceScheduled, CreatedAt: now.Add(-90 * 24 * time.Hour)}}
+	if got := Prunable(Policy{KeepHourly: 1}, only, now); len(got) != 0 {
+		t.Fatalf("Prunable() = %v, the newest version must be kept", got)
+	}
+}
+
+func TestPrunableWeeklyAndMonthlyBuckets(t *testing.T) {
+	now := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	var versions []workspace.VersionInfo
+	for day := range 70 {
+		versions = append(versions, workspace.VersionInfo{
+			Version:   day + 1,
+			Source:    workspace.SnapshotSourceScheduled,
+			CreatedAt: now.Add(-time.Duration(69-day) * 24 * time.Hour),
+		})
+	}
+	got := Prunable(Policy{KeepWeekly: 4, KeepMonthly: 3}, versions, now)
+	kept := len(versions) - len(got)
+	// The newest of each of the last four weeks, plus the last days of
+	// February and January; March's newest is already kept.
+	if kept != 6 {
+		t.Fatalf("kept %d versions, want 6", kept)
+	}
+}
+
+func TestPolicyNormalize(t *testing.T) {
+	p, err := Policy{KeepHourly: 24, ExecPatterns: []string{" rm\\s+-rf ", ""}, BeforeSkillInstall: true}.Normalize()
+	if err != nil {
+		t.Fatalf("Normalize() error = %v", err)
+	}
+	if !slices.Equal(p.ExecPatterns, []string{"rm\\s+-rf"}) {
+		t.Fatalf("exec patterns = %q", p.ExecPatterns)
+	}
+	for name, bad := range map[string]Policy{
+		"bad regexp":    {KeepDaily: 1, ExecPatterns: []string{"("}},
+		"negative keep": {KeepDaily: -1},
+		"no retention":  {Enabled: true},
+		"hook only":     {BeforeSkillInstall: true},
+	} {
+		if _, err := bad.Normalize(); !errors.Is(err, ErrInvalidPolicy) {
+			t.Fatalf("%s: Normalize() error = %v, want ErrInvalidPolicy", name, err)
+		}
+	}
+	if _, err := (Policy{}).Normalize(); err != nil {
+		t.Fatalf("an all-off policy should be valid, got %v", err)
+	}
+}
@@ -0,0 +1,436 @@
+package snapshotpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/audit"
+	"github.com/memohai/memoh/internal/db"
+	dbsqlc "github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/workspace"
+)
+
+const (
+	// checkInterval is how often the scheduler looks for due policies.
+	checkInterval = 5 * time.Minute
+	// hookCooldown spaces the snapshots taken before tool calls of one bot;
+	// a burst of matching commands is covered by the first snapshot.
+	hookCooldown = time.Minute
+	// hookTimeout bounds a snapshot taken before a tool call.
+	hookTimeout = 2 * time.Minute
+	// snapshotNameMax bounds the command or skill shown in a snapshot name.
+	snapshotNameMax = 80
+)
+
+// Workspace is the part of the workspace manager the policies drive.
+// Automatic snapshots are taken with CreateDataSnapshot: an archive of /data
+// that never restarts the workspace, so background tasks and dev servers keep
+// running, and that pruning can delete on its own to free its space.
+type Workspace interface {
+	CreateDataSnapshot(ctx context.Context, botID, snapshotName, source string) (*workspace.SnapshotCreateInfo, error)
+	ListVersions(ctx context.Context, botID string) ([]workspace.VersionInfo, error)
+	DeleteVersion(ctx context.Context, botID string, version int) error
+	DataManifest(ctx context.Context, botID string) ([]workspace.FileEntry, error)
+	VersionManifest(ctx context.Context, botID string, version int) ([]workspace.FileEntry, error)
+	DiffVersions(ctx context.Context, botID string, from, to int) (*workspace.VersionDiff, error)
+}
+
+// compiledPolicy is a policy with its exec patterns compiled, cached per bot
+// so the exec hook does not hit the database on every command.
+type compiledPolicy struct {
+	Policy
+	exec []*regexp.Regexp
+}
+
+// Service stores snapshot policies, runs the scheduled snapshots and
+// retention, and takes snapshots before matching tool calls.
+type Service struct {
+	queries   dbstore.Queries
+	workspace Workspace
+	auditLog  *audit.Service
+	logger    *slog.Logger
+	now       func() time.Time
+
+	mu       sync.Mutex
+	policies map[string]*compiledPolicy
+	busy     map[string]bool
+	lastHook map[string]time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewService(log *slog.Logger, queries dbstore.Queries, ws Workspace) *Service {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &Service{
+		queries:   queries,
+		workspace: ws,
+		logger:    log.With(slog.String("service", "snapshot_policy")),
+		now:       time.Now,
+		policies:  map[string]*compiledPolicy{},
+		busy:      map[string]bool{},
+		lastHook:  map[string]time.Time{},
+	}
+}
+
+// SetAuditLog records policy changes and pruned versions to the audit log.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
+// Start runs the scheduler until Stop is called.
+func (s *Service) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.RunDue(ctx)
+			}
+		}
+	}()
+}
+
+// Stop ends the scheduler. A snapshot in flight is finished, the rest of
+// the pass is left to the next start.
+func (s *Service) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	s.wg.Wait()
+}
+
+// Get returns the policy of a bot, or DefaultPolicy when none was saved.
+func (s *Service) Get(ctx context.Context, botID string) (Policy, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return Policy{}, err
+	}
+	row, err := s.queries.GetBotSnapshotPolicy(ctx, pgBotID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return DefaultPolicy(), nil
+		}
+		return Policy{}, err
+	}
+	return toPolicy(row)
+}
+
+// Update stores the policy of a bot.
+func (s *Service) Update(ctx context.Context, botID string, policy Policy) (Policy, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return Policy{}, err
+	}
+	policy, err = policy.Normalize()
+	if err != nil {
+		return Policy{}, err
+	}
+	before, err := s.Get(ctx, botID)
+	if err != nil {
+		return Policy{}, err
+	}
+	patterns, err := json.Marshal(policy.ExecPatterns)
+	if err != nil {
+		return Policy{}, err
+	}
+	row, err := s.queries.UpsertBotSnapshotPolicy(ctx, dbsqlc.UpsertBotSnapshotPolicyParams{
+		BotID:              pgBotID,
+		Enabled:            policy.Enabled,
+		KeepHourly:         int32(policy.KeepHourly),  //nolint:gosec // bounded by Normalize
+		KeepDaily:          int32(policy.KeepDaily),   //nolint:gosec // bounded by Normalize
+		KeepWeekly:         int32(policy.KeepWeekly),  //nolint:gosec // bounded by Normalize
+		KeepMonthly:        int32(policy.KeepMonthly), //nolint:gosec // bounded by Normalize
+		ExecPatterns:       patterns,
+		BeforeSkillInstall: policy.BeforeSkillInstall,
+	})
+	if err != nil {
+		return Policy{}, err
+	}
+	saved, err := toPolicy(row)
+	if err != nil {
+		return Policy{}, err
+	}
+	s.mu.Lock()
+	delete(s.policies, botID)
+	s.mu.Unlock()
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionWorkspaceSnapshotPolicyUpdate,
+		BotID:      botID,
+		TargetType: "bot_snapshot_policy",
+		TargetID:   botID,
+		Before:     before,
+		After:      saved,
+	})
+	return saved, nil
+}
+
+// Diff lists the files changed between two versions of a bot's workspace,
+// or between a version and the live workspace when to is 0.
+func (s *Service) Diff(ctx context.Context, botID string, from, to int) (*workspace.VersionDiff, error) {
+	return s.workspace.DiffVersions(ctx, botID, from, to)
+}
+
+// Prune deletes the automatic versions of a bot its policy no longer keeps.
+func (s *Service) Prune(ctx context.Context, botID string) (PruneResult, error) {
+	policy, err := s.Get(ctx, botID)
+	if err != nil {
+		return PruneResult{}, err
+	}
+	return s.prune(ctx, botID, policy)
+}
+
+// RunDue takes a scheduled snapshot of every bot whose policy is due and
+// prunes its versions. The scheduler calls it every checkInterval.
+func (s *Service) RunDue(ctx context.Context) {
+	rows, err := s.queries.ListEnabledBotSnapshotPolicies(ctx)
+	if err != nil {
+		s.logger.Warn("list snapshot policies failed", slog.Any("error", err))
+		return
+	}
+	now := s.now()
+	for _, row := range rows {
+		policy, err := toPolicy(row)
+		if err != nil {
+			s.logger.Warn("skip invalid snapshot policy", slog.String("bot_id", uuidString(row.BotID)), slog.Any("error", err))
+			continue
+		}
+		interval := policy.interval()
+		if interval == 0 || (policy.LastRunAt != nil && now.Sub(*policy.LastRunAt) < interval) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		botID := uuidString(row.BotID)
+		if err := s.snapshotIfChanged(ctx, botID, "", workspace.SnapshotSourceScheduled); err != nil {
+			s.logger.Warn("scheduled snapshot failed", slog.String("bot_id", botID), slog.Any("error", err))
+		}
+		if err := s.queries.MarkBotSnapshotPolicyRun(ctx, row.BotID); err != nil {
+			s.logger.Warn("mark snapshot policy run failed", slog.String("bot_id", botID), slog.Any("error", err))
+		}
+		if _, err := s.prune(ctx, botID, policy); err != nil {
+			s.logger.Warn("prune snapshots failed", slog.String("bot_id", botID), slog.Any("error", err))
+		}
+	}
+}
+
+// BeforeExec snapshots the workspace before an exec command that matches
+// one of the bot's exec patterns. Failures are logged and never block the
+// command.
+func (s *Service) BeforeExec(ctx context.Context, botID, command string) {
+	policy := s.cachedPolicy(ctx, botID)
+	if policy == nil || !policy.matchesExec(command) {
+		return
+	}
+	s.hookSnapshot(ctx, botID, "before exec: "+command, workspace.SnapshotSourcePreExec, policy.Policy)
+}
+
+// BeforeSkillInstall snapshots the workspace before a skill is installed
+// when the bot's policy asks for it.
+func (s *Service) BeforeSkillInstall(ctx context.Context, botID, skill string) {
+	policy := s.cachedPolicy(ctx, botID)
+	if policy == nil || !policy.BeforeSkillInstall {
+		return
+	}
+	s.hookSnapshot(ctx, botID, "before skill install: "+skill, workspace.SnapshotSourcePreSkillInstall, policy.Policy)
+}
+
+func (s *Service) hookSnapshot(ctx context.Context, botID, name, source string, policy Policy) {
+	s.mu.Lock()
+	if last, ok := s.lastHook[botID]; ok && s.now().Sub(last) < hookCooldown {
+		s.mu.Unlock()
+		return
+	}
+	s.lastHook[botID] = s.now()
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), hookTimeout)
+	defer cancel()
+	if err := s.snapshotIfChanged(ctx, botID, truncate(name, snapshotNameMax), source); err != nil {
+		s.logger.Warn("snapshot before tool call failed", slog.String("bot_id", botID), slog.String("source", source), slog.Any("error", err))
+		return
+	}
+	if _, err := s.prune(ctx, botID, policy); err != nil {
+		s.logger.Warn("prune snapshots failed", slog.String("bot_id", botID), slog.Any("error", err))
+	}
+}
+
+// snapshotIfChanged takes a snapshot unless /data is unchanged since the
+// newest version. A workspace whose files cannot be listed is not running
+// and so cannot have changed; it is skipped too.
+func (s *Service) snapshotIfChanged(ctx context.Context, botID, name, source string) error {
+	s.mu.Lock()
+	if s.busy[botID] {
+		s.mu.Unlock()
+		return nil
+	}
+	s.busy[botID] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.busy, botID)
+		s.mu.Unlock()
+	}()
+
+	live, err := s.workspace.DataManifest(ctx, botID)
+	if err != nil {
+		s.logger.Debug("skip snapshot of unreachable workspace", slog.String("bot_id", botID), slog.Any("error", err))
+		return nil
+	}
+	versions, err := s.workspace.ListVersions(ctx, botID)
+	if err != nil {
+		return err
+	}
+	if len(versions) > 0 {
+		latest := versions[len(versions)-1]
+		if prev, err := s.workspace.VersionManifest(ctx, botID, latest.Version); err == nil && workspace.DiffManifests(prev, live).Empty() {
+			return nil
+		}
+	}
+	_, err = s.workspace.CreateDataSnapshot(ctx, botID, name, source)
+	return err
+}
+
+func (s *Service) prune(ctx context.Context, botID string, policy Policy) (PruneResult, error) {
+	result := PruneResult{Deleted: []int{}}
+	if policy.interval() == 0 {
+		return result, nil
+	}
+	versions, err := s.workspace.ListVersions(ctx, botID)
+	if err != nil {
+		return PruneResult{}, err
+	}
+	for _, version := range Prunable(policy, versions, s.now()) {
+		if err := s.workspace.DeleteVersion(ctx, botID, version); err != nil {
+			result.Failed = append(result.Failed, version)
+			result.Errors = append(result.Errors, fmt.Sprintf("version %d: %v", version, err))
+			continue
+		}
+		result.Deleted = append(result.Deleted, version)
+	}
+	if len(result.Deleted) > 0 {
+		s.auditLog.Record(ctx, audit.Entry{
+			Actor:      audit.Actor{Type: audit.ActorSystem},
+			Action:     audit.ActionWorkspaceSnapshotPrune,
+			BotID:      botID,
+			TargetType: "workspace_version",
+			TargetID:   joinVersions(result.Deleted),
+			After:      result,
+		})
+	}
+	return result, nil
+}
+
+func (s *Service) cachedPolicy(ctx context.Context, botID string) *compiledPolicy {
+	s.mu.Lock()
+	cached, ok := s.policies[botID]
+	s.mu.Unlock()
+	if ok {
+		return cached
+	}
+	policy, err := s.Get(ctx, botID)
+	if err != nil {
+		s.logger.Warn("load snapshot policy failed", slog.String("bot_id", botID), slog.Any("error", err))
+		return nil
+	}
+	compiled := &compiledPolicy{Policy: policy}
+	for _, pattern := range policy.ExecPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			continue
+		}
+		compiled.exec = append(compiled.exec, re)
+	}
+	s.mu.Lock()
+	s.policies[botID] = compiled
+	s.mu.Unlock()
+	return compiled
+}
+
+func (p *compiledPolicy) matchesExec(command string) bool {
+	for _, re := range p.exec {
+		if re.MatchString(command) {
+			return true
+		}
+	}
+	return false
+}
+
+func toPolicy(row dbsqlc.BotSnapshotPolicy) (Policy, error) {
+	policy := Policy{
+		Enabled:            row.Enabled,
+		KeepHourly:         int(row.KeepHourly),
+		KeepDaily:          int(row.KeepDaily),
+		KeepWeekly:         int(row.KeepWeekly),
+		KeepMonthly:        int(row.KeepMonthly),
+		ExecPatterns:       []string{},
+		BeforeSkillInstall: row.BeforeSkillInstall,
+	}
+	if len(row.ExecPatterns) > 0 {
+		if err := json.Unmarshal(row.ExecPatterns, &policy.ExecPatterns); err != nil {
+			return Policy{}, fmt.Errorf("decode exec patterns: %w", err)
+		}
+	}
+	if row.LastRunAt.Valid {
+		t := row.LastRunAt.Time
+		policy.LastRunAt = &t
+	}
+	return policy, nil
+}
+
+func truncate(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if len([]rune(s)) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
+
+func joinVersions(versions []int) string {
+	parts := make([]string, len(versions))
+	for i, v := range versions {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func uuidString(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return uuid.UUID(id.Bytes).String()
+}
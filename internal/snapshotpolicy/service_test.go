@@ -0,0 +1,127 @@
+package snapshotpolicy
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	dbsqlc "github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/workspace"
+)
+
+const testBotID = "7d1f3c5a-0b6e-4f2a-9c8d-1e2f3a4b5c6d"
+
+type fakeQueries struct {
+	dbstore.Queries
+	policy dbsqlc.BotSnapshotPolicy
+}
+
+func (f *fakeQueries) GetBotSnapshotPolicy(_ context.Context, _ pgtype.UUID) (dbsqlc.BotSnapshotPolicy, error) {
+	return f.policy, nil
+}
+
+type fakeWorkspace struct {
+	live      []workspace.FileEntry
+	versions  []workspace.VersionInfo
+	manifests map[int][]workspace.FileEntry
+	created   []string
+	deleted   []int
+}
+
+func (f *fakeWorkspace) CreateDataSnapshot(_ context.Context, _, name, source string) (*workspace.SnapshotCreateInfo, error) {
+	version := len(f.versions) + 1
+	f.versions = append(f.versions, workspace.VersionInfo{Version: version, Source: source, CreatedAt: time.Now()})
+	f.manifests[version] = slices.Clone(f.live)
+	f.created = append(f.created, name)
+	return &workspace.SnapshotCreateInfo{Version: version}, nil
+}
+
+func (f *fakeWorkspace) ListVersions(context.Context, string) ([]workspace.VersionInfo, error) {
+	return f.versions, nil
+}
+
+func (f *fakeWorkspace) DeleteVersion(_ context.Context, _ string, version int) error {
+	f.deleted = append(f.deleted, version)
+	return nil
+}
+
+func (f *fakeWorkspace) DataManifest(context.Context, string) ([]workspace.FileEntry, error) {
+	return f.live, nil
+}
+
+func (f *fakeWorkspace) VersionManifest(_ context.Context, _ string, version int) ([]workspace.FileEntry, error) {
+	entries, ok := f.manifests[version]
+	if !ok {
+		return nil, workspace.ErrManifestUnavailable
+	}
+	return entries, nil
+}
+
+func (*fakeWorkspace) DiffVersions(context.Context, string, int, int) (*workspace.VersionDiff, error) {
+	return &workspace.VersionDiff{}, nil
+}
+
+func TestBeforeExecSnapshotsMatchingCommandsWhenDataChanged(t *testing.T) {
+	ws := &fakeWorkspace{
+		live:      []workspace.FileEntry{{Path: "project/main.go", Size: 10}},
+		manifests: map[int][]workspace.FileEntry{},
+	}
+	queries := &fakeQueries{policy: dbsqlc.BotSnapshotPolicy{
+		KeepHourly:   24,
+		ExecPatterns: []byte(`["\\brm\\s+-[a-z]*r"]`),
+	}}
+	svc := NewService(nil, queries, ws)
+	clock := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return clock }
+
+	svc.BeforeExec(context.Background(), testBotID, "ls -la")
+	if len(ws.created) != 0 {
+		t.Fatalf("non-matching command took a snapshot: %v", ws.created)
+	}
+
+	svc.BeforeExec(context.Background(), testBotID, "rm -rf project")
+	if !slices.Equal(ws.created, []string{"before exec: rm -rf project"}) {
+		t.Fatalf("snapshots = %q, want one before rm", ws.created)
+	}
+
+	// Within the cooldown nothing is taken, and after it an unchanged /data
+	// is not snapshotted again.
+	svc.BeforeExec(context.Background(), testBotID, "rm -r project")
+	clock = clock.Add(2 * hookCooldown)
+	svc.BeforeExec(context.Background(), testBotID, "rm -r project")
+	if len(ws.created) != 1 {
+		t.Fatalf("snapshots = %q, want no more while /data is unchanged", ws.created)
+	}
+
+	ws.live = append(ws.live, workspace.FileEntry{Path: "project/new.go", Size: 1})
+	clock = clock.Add(2 * hookCooldown)
+	svc.BeforeExec(context.Background(), testBotID, "rm -r project")
+	if len(ws.created) != 2 || ws.versions[1].Source != workspace.SnapshotSourcePreExec {
+		t.Fatalf("versions = %+v, want a second pre_exec snapshot", ws.versions)
+	}
+}
+
+func TestBeforeSkillInstallFollowsPolicy(t *testing.T) {
+	ws := &fakeWorkspace{live: []workspace.FileEntry{{Path: "a"}}, manifests: map[int][]workspace.FileEntry{}}
+	queries := &fakeQueries{policy: dbsqlc.BotSnapshotPolicy{KeepDaily: 7}}
+	svc := NewService(nil, queries, ws)
+
+	svc.BeforeSkillInstall(context.Background(), testBotID, "deploy")
+	if len(ws.created) != 0 {
+		t.Fatal("skill install took a snapshot with the hook off")
+	}
+
+	// Update drops the cached policy the same way.
+	queries.policy.BeforeSkillInstall = true
+	svc.mu.Lock()
+	delete(svc.policies, testBotID)
+	svc.mu.Unlock()
+	svc.BeforeSkillInstall(context.Background(), testBotID, "deploy")
+	if !slices.Equal(ws.created, []string{"before skill install: deploy"}) {
+		t.Fatalf("snapshots = %q", ws.created)
+	}
+}
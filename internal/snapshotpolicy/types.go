@@ -0,0 +1,119 @@
+// Package snapshotpolicy takes workspace snapshots of a bot on a schedule and
+// before risky tool calls, and prunes the automatic ones with
+// grandfather-father-son retention. Automatic snapshots are archives of /data
+// taken while the workspace keeps running; they never restart it.
+package snapshotpolicy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/memohai/memoh/internal/workspace"
+)
+
+const (
+	maxKeep         = 1000
+	maxExecPatterns = 32
+)
+
+var ErrInvalidPolicy = errors.New("invalid snapshot policy")
+
+// Policy controls the automatic snapshots of one bot. Enabled turns on the
+// scheduled snapshots; the exec patterns and BeforeSkillInstall hooks work
+// on their own. Each Keep count is the number of hourly, daily, weekly or
+// monthly snapshots retained, and the finest non-zero one sets how often a
+// scheduled snapshot is taken.
+type Policy struct {
+	Enabled     bool `json:"enabled"`
+	KeepHourly  int  `json:"keep_hourly"`
+	KeepDaily   int  `json:"keep_daily"`
+	KeepWeekly  int  `json:"keep_weekly"`
+	KeepMonthly int  `json:"keep_monthly"`
+	// ExecPatterns are regular expressions; an exec command matching any of
+	// them is preceded by a snapshot.
+	ExecPatterns       []string   `json:"exec_patterns"`
+	BeforeSkillInstall bool       `json:"before_skill_install"`
+	LastRunAt          *time.Time `json:"last_run_at,omitempty"`
+}
+
+// DefaultPolicy is returned for bots that never saved a policy: disabled,
+// with retention of a day of hourly, two weeks of daily and two months of
+// weekly snapshots ready to be switched on.
+func DefaultPolicy() Policy {
+	return Policy{
+		KeepHourly:   24,
+		KeepDaily:    14,
+		KeepWeekly:   8,
+		ExecPatterns: []string{},
+	}
+}
+
+// Normalize trims and validates the policy.
+func (p Policy) Normalize() (Policy, error) {
+	for _, n := range []int{p.KeepHourly, p.KeepDaily, p.KeepWeekly, p.KeepMonthly} {
+		if n < 0 || n > maxKeep {
+			return Policy{}, fmt.Errorf("%w: keep counts must be between 0 and %d", ErrInvalidPolicy, maxKeep)
+		}
+	}
+	patterns := make([]string, 0, len(p.ExecPatterns))
+	for _, raw := range p.ExecPatterns {
+		pattern := strings.TrimSpace(raw)
+		if pattern == "" {
+			continue
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return Policy{}, fmt.Errorf("%w: exec pattern %q: %w", ErrInvalidPolicy, pattern, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	if len(patterns) > maxExecPatterns {
+		return Policy{}, fmt.Errorf("%w: at most %d exec patterns", ErrInvalidPolicy, maxExecPatterns)
+	}
+	p.ExecPatterns = patterns
+	if (p.Enabled || p.hooked()) && p.interval() == 0 {
+		return Policy{}, fmt.Errorf("%w: set at least one keep count", ErrInvalidPolicy)
+	}
+	return p, nil
+}
+
+func (p Policy) hooked() bool {
+	return len(p.ExecPatterns) > 0 || p.BeforeSkillInstall
+}
+
+// interval is the period of the finest retention tier, 0 when none is set.
+func (p Policy) interval() time.Duration {
+	switch {
+	case p.KeepHourly > 0:
+		return time.Hour
+	case p.KeepDaily > 0:
+		return 24 * time.Hour
+	case p.KeepWeekly > 0:
+		return 7 * 24 * time.Hour
+	case p.KeepMonthly > 0:
+		return 30 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// PruneResult lists the versions a prune deleted and the ones it had to
+// keep because deleting them failed.
+type PruneResult struct {
+	Deleted []int    `json:"deleted"`
+	Failed  []int    `json:"failed,omitempty"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+// Automatic reports whether versions from source are managed by the policy.
+// Manual snapshots and rollback points are never pruned.
+func Automatic(source string) bool {
+	switch source {
+	case workspace.SnapshotSourceScheduled, workspace.SnapshotSourcePreExec, workspace.SnapshotSourcePreSkillInstall:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,34 @@
+package structured
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Instructions returns the system prompt section that asks the model to
+// answer with JSON matching the schema.
+func Instructions(s *Schema) string {
+	if s == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("## Output format\n\n")
+	b.WriteString("Your final reply must be a single JSON value that validates against the JSON Schema below. ")
+	b.WriteString("You may call tools before answering, but the final reply must contain only the JSON: ")
+	b.WriteString("no prose, no Markdown and no code fence.\n\n")
+	b.WriteString(string(s.raw))
+	return b.String()
+}
+
+// RepairPrompt returns the user message sent back to the model when its
+// reply did not satisfy the schema.
+func RepairPrompt(s *Schema, cause error) string {
+	reason := "it was not valid JSON"
+	if cause != nil {
+		reason = strings.TrimPrefix(cause.Error(), ErrInvalidOutput.Error()+": ")
+	}
+	return fmt.Sprintf(
+		"Your previous reply cannot be accepted because %s. Reply again with only a JSON value that validates against this JSON Schema, without any other text:\n\n%s",
+		reason, string(s.Raw()),
+	)
+}
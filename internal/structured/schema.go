@@ -0,0 +1,156 @@
+// Package structured implements JSON-schema-constrained agent output: it
+// compiles caller-supplied schemas, extracts JSON from model replies and
+// validates it, and builds the prompts used to steer and repair the model.
+package structured
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+const (
+	// MaxSchemaBytes caps the size of an output schema document.
+	MaxSchemaBytes = 64 << 10
+	// MaxRepairAttempts is how many times an invalid reply is sent back to
+	// the model for correction before the run fails.
+	MaxRepairAttempts = 2
+)
+
+var (
+	// ErrInvalidSchema is returned when an output schema cannot be compiled.
+	ErrInvalidSchema = errors.New("invalid output schema")
+	// ErrInvalidOutput is returned when a reply does not contain JSON that
+	// satisfies the output schema.
+	ErrInvalidOutput = errors.New("output does not match schema")
+)
+
+// Schema is a compiled output schema.
+type Schema struct {
+	raw      json.RawMessage
+	document map[string]any
+	resolved *jsonschema.Resolved
+}
+
+// Compile parses and resolves a JSON Schema document. The schema must be a
+// JSON object; an empty input yields a nil schema and no error so callers can
+// pass optional fields straight through.
+func Compile(raw json.RawMessage) (*Schema, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, nil
+	}
+	if len(trimmed) > MaxSchemaBytes {
+		return nil, fmt.Errorf("%w: schema exceeds %d bytes", ErrInvalidSchema, MaxSchemaBytes)
+	}
+	var document map[string]any
+	if err := json.Unmarshal(trimmed, &document); err != nil {
+		return nil, fmt.Errorf("%w: schema must be a JSON object: %s", ErrInvalidSchema, err.Error())
+	}
+	var schema jsonschema.Schema
+	if err := json.Unmarshal(trimmed, &schema); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSchema, err.Error())
+	}
+	resolved, err := schema.Resolve(nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSchema, err.Error())
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, trimmed); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSchema, err.Error())
+	}
+	return &Schema{raw: compact.Bytes(), document: document, resolved: resolved}, nil
+}
+
+// Raw returns the compact schema document.
+func (s *Schema) Raw() json.RawMessage {
+	if s == nil {
+		return nil
+	}
+	return s.raw
+}
+
+// Document returns the schema as a generic JSON value, the form providers
+// expect for native structured output.
+func (s *Schema) Document() map[string]any {
+	if s == nil {
+		return nil
+	}
+	return s.document
+}
+
+// ObjectRoot reports whether the schema constrains the reply to a JSON
+// object. Providers with native structured output only accept object roots.
+func (s *Schema) ObjectRoot() bool {
+	if s == nil {
+		return false
+	}
+	typ, _ := s.document["type"].(string)
+	return typ == "object"
+}
+
+// Validate extracts the JSON value from a model reply and checks it against
+// the schema. It returns the value in compact form.
+func (s *Schema) Validate(text string) (json.RawMessage, error) {
+	value, err := Extract(text)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return value, nil
+	}
+	var instance any
+	if err := json.Unmarshal(value, &instance); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOutput, err.Error())
+	}
+	if err := s.resolved.Validate(instance); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOutput, err.Error())
+	}
+	return value, nil
+}
+
+// Extract returns the first JSON object or array in text. Models often wrap
+// JSON in a Markdown code fence or a sentence of prose, so both are
+// tolerated.
+func Extract(text string) (json.RawMessage, error) {
+	text = strings.TrimSpace(stripCodeFence(text))
+	if text == "" {
+		return nil, fmt.Errorf("%w: reply is empty", ErrInvalidOutput)
+	}
+	for start := 0; start < len(text); start++ {
+		if text[start] != '{' && text[start] != '[' {
+			continue
+		}
+		decoder := json.NewDecoder(strings.NewReader(text[start:]))
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			continue
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, value); err != nil {
+			continue
+		}
+		return compact.Bytes(), nil
+	}
+	return nil, fmt.Errorf("%w: reply contains no JSON object or array", ErrInvalidOutput)
+}
+
+// stripCodeFence unwraps a reply whose JSON sits inside a ``` fence.
+func stripCodeFence(text string) string {
+	open := strings.Index(text, "```")
+	if open < 0 {
+		return text
+	}
+	body := text[open+3:]
+	if newline := strings.IndexByte(body, '\n'); newline >= 0 {
+		body = body[newline+1:]
+	}
+	if end := strings.Index(body, "```"); end >= 0 {
+		body = body[:end]
+	}
+	return body
+}
@@ -0,0 +1,98 @@
+package structured
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const personSchema = `{
+  "type": "object",
+  "properties": {
+    "name": {"type": "string"},
+    "age": {"type": "integer", "minimum": 0}
+  },
+  "required": ["name", "age"]
+}`
+
+func TestCompile(t *testing.T) {
+	schema, err := Compile([]byte(personSchema))
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	if !schema.ObjectRoot() {
+		t.Fatal("expected an object root")
+	}
+	if strings.ContainsAny(string(schema.Raw()), "\n ") {
+		t.Fatalf("raw schema is not compact: %s", schema.Raw())
+	}
+
+	if schema, err := Compile(nil); err != nil || schema != nil {
+		t.Fatalf("empty schema = %v, %v", schema, err)
+	}
+	if schema, err := Compile([]byte(" null ")); err != nil || schema != nil {
+		t.Fatalf("null schema = %v, %v", schema, err)
+	}
+
+	for _, source := range []string{`[1]`, `{"type": 3}`, `{"$ref": "#/missing"}`, `{`} {
+		if _, err := Compile([]byte(source)); !errors.Is(err, ErrInvalidSchema) {
+			t.Fatalf("Compile(%s) err = %v, want ErrInvalidSchema", source, err)
+		}
+	}
+	big := `{"description": "` + strings.Repeat("x", MaxSchemaBytes) + `"}`
+	if _, err := Compile([]byte(big)); !errors.Is(err, ErrInvalidSchema) {
+		t.Fatalf("oversized schema err = %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	schema, err := Compile([]byte(personSchema))
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	cases := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"plain", `{"name": "Ada", "age": 36}`, `{"name":"Ada","age":36}`},
+		{"fenced", "```json\n{\"name\": \"Ada\", \"age\": 36}\n```", `{"name":"Ada","age":36}`},
+		{"prose", `Here you go: {"name":"Ada","age":36} Hope that helps.`, `{"name":"Ada","age":36}`},
+		{"skips broken braces", `use {braces} like {"name":"Ada","age":36}`, `{"name":"Ada","age":36}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := schema.Validate(tc.text)
+			if err != nil {
+				t.Fatalf("validate: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+
+	for _, text := range []string{"", "no json here", `{"name":"Ada"}`, `{"name":"Ada","age":-1}`, `["Ada"]`} {
+		if _, err := schema.Validate(text); !errors.Is(err, ErrInvalidOutput) {
+			t.Fatalf("Validate(%q) err = %v, want ErrInvalidOutput", text, err)
+		}
+	}
+}
+
+func TestPrompts(t *testing.T) {
+	schema, err := Compile([]byte(personSchema))
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	if Instructions(nil) != "" {
+		t.Fatal("expected no instructions without a schema")
+	}
+	if !strings.Contains(Instructions(schema), string(schema.Raw())) {
+		t.Fatal("instructions should embed the schema")
+	}
+	_, cause := schema.Validate(`{"name":"Ada"}`)
+	repair := RepairPrompt(schema, cause)
+	if !strings.Contains(repair, "age") || strings.Contains(repair, ErrInvalidOutput.Error()) {
+		t.Fatalf("repair prompt = %q", repair)
+	}
+}
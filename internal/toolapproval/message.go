@@ -39,6 +39,36 @@ func BuildPrompt(req Request) channel.Message {
 	}
 }
 
+// BuildApproverPrompt is the prompt sent to a workflow approver outside the
+// conversation that made the request. Short IDs are per session, so it
+// refers to the request by UUID.
+func BuildApproverPrompt(req Request, escalated bool) channel.Message {
+	heading := "Tool approval requested"
+	if escalated {
+		heading = "Tool approval escalated"
+	}
+	text := fmt.Sprintf(
+		"%s\nTool: %s\nInput: %s\n\nApprove with /approve %s.\nReject with /reject %s [reason].",
+		heading,
+		req.ToolName,
+		summarizeInput(req),
+		req.ID,
+		req.ID,
+	)
+	return channel.Message{
+		Format: channel.MessageFormatPlain,
+		Text:   text,
+		Actions: []channel.Action{
+			{Type: ActionTypeToolApproval, Label: "Approve", Value: ActionApprove + ":" + req.ID},
+			{Type: ActionTypeToolApproval, Label: "Reject", Value: ActionReject + ":" + req.ID},
+		},
+		Metadata: map[string]any{
+			"tool_approval_id": req.ID,
+			"tool_call_id":     req.ToolCallID,
+		},
+	}
+}
+
 func summarizeInput(req Request) string {
 	switch req.ToolName {
 	case "write", "edit":
@@ -7,8 +7,62 @@ import (
 	"strings"
 
 	"github.com/memohai/memoh/internal/settings"
+	"github.com/memohai/memoh/internal/toolpolicy"
 )
 
+const (
+	SourceDisabled = "disabled"
+	SourcePolicy   = "policy"
+	SourceBuiltin  = "builtin"
+)
+
+// decide applies cfg to one tool call: policy rules first, in order, then
+// the built-in write/edit/exec rules. Rules whose expression fails to
+// evaluate are reported in the result; a failing allow rule does not match,
+// while a failing deny or require_approval rule fails closed and matches.
+func decide(cfg settings.ToolApprovalConfig, in toolpolicy.Input) PolicyResult {
+	cfg = settings.NormalizeToolApprovalConfig(cfg)
+	if !cfg.Enabled {
+		return PolicyResult{Effect: settings.ToolPolicyEffectAllow, Source: SourceDisabled}
+	}
+	var errs []PolicyRuleError
+	for i, rule := range cfg.Policies {
+		if rule.Disabled {
+			continue
+		}
+		matched, err := toolpolicy.Eval(rule.Expression, in)
+		if err != nil {
+			errs = append(errs, PolicyRuleError{Index: i, Name: rule.Name, Error: err.Error()})
+			if rule.Effect == settings.ToolPolicyEffectAllow {
+				continue
+			}
+			matched = true
+		}
+		if matched {
+			index := i
+			result := PolicyResult{
+				Effect:     rule.Effect,
+				Source:     SourcePolicy,
+				RuleIndex:  &index,
+				RuleName:   rule.Name,
+				Expression: rule.Expression,
+				Errors:     errs,
+			}
+			if rule.Effect == settings.ToolPolicyEffectRequireApproval {
+				result.Workflow = cfg.Workflow
+				if rule.Workflow != nil {
+					result.Workflow = rule.Workflow
+				}
+			}
+			return result
+		}
+	}
+	if needsApproval(cfg, in.Tool, in.Args) {
+		return PolicyResult{Effect: settings.ToolPolicyEffectRequireApproval, Source: SourceBuiltin, Errors: errs, Workflow: cfg.Workflow}
+	}
+	return PolicyResult{Effect: settings.ToolPolicyEffectAllow, Source: SourceBuiltin, Errors: errs}
+}
+
 func needsApproval(cfg settings.ToolApprovalConfig, toolName string, input any) bool {
 	cfg = settings.NormalizeToolApprovalConfig(cfg)
 	if !cfg.Enabled {
@@ -2,8 +2,10 @@ package toolapproval
 
 import (
 	"testing"
+	"time"
 
 	"github.com/memohai/memoh/internal/settings"
+	"github.com/memohai/memoh/internal/toolpolicy"
 )
 
 func TestNeedsApprovalFileBypass(t *testing.T) {
@@ -55,3 +57,92 @@ func TestNeedsApprovalExecForceReview(t *testing.T) {
 		t.Fatal("expected force-review command to require approval")
 	}
 }
+
+func TestDecidePolicyRulesFirstMatchWins(t *testing.T) {
+	cfg := settings.DefaultToolApprovalConfig()
+	cfg.Enabled = true
+	cfg.Policies = []settings.ToolApprovalPolicyRule{
+		{Name: "off", Expression: `true`, Effect: settings.ToolPolicyEffectDeny, Disabled: true},
+		{Name: "no force push", Expression: `tool == "exec" && args.command.contains("--force")`, Effect: settings.ToolPolicyEffectDeny},
+		{Name: "night writes", Expression: `tool == "write" && (hour >= 22 || hour < 7)`, Effect: settings.ToolPolicyEffectRequireApproval},
+		{Name: "github reads", Expression: `tool.startsWith("github_") && session.type != "heartbeat"`, Effect: settings.ToolPolicyEffectAllow},
+	}
+	noon := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	night := time.Date(2026, 3, 2, 23, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		in     toolpolicy.Input
+		effect string
+		source string
+		rule   string
+	}{
+		{"deny", toolpolicy.Input{Tool: "exec", Args: map[string]any{"command": "git push --force"}, Now: noon}, settings.ToolPolicyEffectDeny, SourcePolicy, "no force push"},
+		{"night write", toolpolicy.Input{Tool: "write", Args: map[string]any{"path": "/data/a.txt"}, Now: night}, settings.ToolPolicyEffectRequireApproval, SourcePolicy, "night writes"},
+		{"day write falls through to builtin bypass", toolpolicy.Input{Tool: "write", Args: map[string]any{"path": "/data/a.txt"}, Now: noon}, settings.ToolPolicyEffectAllow, SourceBuiltin, ""},
+		{"mcp tool", toolpolicy.Input{Tool: "github_list_issues", SessionType: "chat", Now: noon}, settings.ToolPolicyEffectAllow, SourcePolicy, "github reads"},
+		{"builtin edit", toolpolicy.Input{Tool: "edit", Args: map[string]any{"path": "/etc/hosts"}, Now: noon}, settings.ToolPolicyEffectRequireApproval, SourceBuiltin, ""},
+	}
+	for _, tc := range cases {
+		got := decide(cfg, tc.in)
+		if got.Effect != tc.effect || got.Source != tc.source || got.RuleName != tc.rule {
+			t.Fatalf("%s: decide = %+v", tc.name, got)
+		}
+	}
+
+	cfg.Enabled = false
+	if got := decide(cfg, cases[0].in); got.Effect != settings.ToolPolicyEffectAllow || got.Source != SourceDisabled {
+		t.Fatalf("disabled decide = %+v", got)
+	}
+}
+
+func TestDecideFailsClosedOnRuleErrors(t *testing.T) {
+	cfg := settings.DefaultToolApprovalConfig()
+	cfg.Enabled = true
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	// Each rule reads a missing argument for tools without a command.
+	read := toolpolicy.Input{Tool: "read", Now: now}
+
+	for _, effect := range []string{settings.ToolPolicyEffectDeny, settings.ToolPolicyEffectRequireApproval} {
+		cfg.Policies = []settings.ToolApprovalPolicyRule{
+			{Name: "broken", Expression: `args.command.startsWith("rm")`, Effect: effect},
+		}
+		got := decide(cfg, read)
+		if got.Effect != effect || got.Source != SourcePolicy || got.RuleName != "broken" {
+			t.Fatalf("failing %s rule: decide = %+v", effect, got)
+		}
+		if len(got.Errors) != 1 || got.Errors[0].Name != "broken" {
+			t.Fatalf("failing %s rule: errors = %+v", effect, got.Errors)
+		}
+	}
+
+	// A failing allow rule is skipped, so it cannot widen access either.
+	cfg.Policies = []settings.ToolApprovalPolicyRule{
+		{Name: "broken", Expression: `args.command.startsWith("ls")`, Effect: settings.ToolPolicyEffectAllow},
+	}
+	got := decide(cfg, toolpolicy.Input{Tool: "edit", Args: map[string]any{"path": "/etc/hosts"}, Now: now})
+	if got.Effect != settings.ToolPolicyEffectRequireApproval || got.Source != SourceBuiltin || len(got.Errors) != 1 {
+		t.Fatalf("failing allow rule: decide = %+v", got)
+	}
+}
+
+func TestDecideAttachesWorkflow(t *testing.T) {
+	cfg := settings.DefaultToolApprovalConfig()
+	cfg.Enabled = true
+	cfg.Workflow = &settings.ToolApprovalWorkflow{ExpireAfterSeconds: 600}
+	ruleWorkflow := &settings.ToolApprovalWorkflow{Approvers: []settings.ToolApprovalApprover{{ChannelIdentityID: "00000000-0000-0000-0000-0000000000a1"}}, Quorum: 1}
+	cfg.Policies = []settings.ToolApprovalPolicyRule{
+		{Name: "external mail", Expression: `tool == "send_email"`, Effect: settings.ToolPolicyEffectRequireApproval, Workflow: ruleWorkflow},
+	}
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	if got := decide(cfg, toolpolicy.Input{Tool: "send_email", Now: now}); got.Workflow == nil || got.Workflow.Quorum != 1 || len(got.Workflow.Approvers) != 1 {
+		t.Fatalf("rule workflow = %+v", got.Workflow)
+	}
+	if got := decide(cfg, toolpolicy.Input{Tool: "edit", Args: map[string]any{"path": "/etc/hosts"}, Now: now}); got.Workflow == nil || got.Workflow.ExpireAfterSeconds != 600 {
+		t.Fatalf("builtin workflow = %+v", got.Workflow)
+	}
+	if got := decide(cfg, toolpolicy.Input{Tool: "read", Now: now}); got.Workflow != nil {
+		t.Fatalf("allowed call workflow = %+v", got.Workflow)
+	}
+}
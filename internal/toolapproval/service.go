@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"strconv"
 	"strings"
@@ -13,16 +14,22 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/db"
 	"github.com/memohai/memoh/internal/db/postgres/sqlc"
 	dbstore "github.com/memohai/memoh/internal/db/store"
 	"github.com/memohai/memoh/internal/settings"
+	tzutil "github.com/memohai/memoh/internal/timezone"
+	"github.com/memohai/memoh/internal/toolpolicy"
 )
 
 type Service struct {
 	queries  dbstore.Queries
 	settings *settings.Service
 	logger   *slog.Logger
+	auditLog *audit.Service
+	sender   Sender
+	onExpire func(ctx context.Context, req Request)
 }
 
 func NewService(log *slog.Logger, queries dbstore.Queries, settings *settings.Service) *Service {
@@ -36,18 +43,27 @@ func NewService(log *slog.Logger, queries dbstore.Queries, settings *settings.Se
 	}
 }
 
+// SetAuditLog records approval requests and decisions to the audit log.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
 func (s *Service) Evaluate(ctx context.Context, input CreatePendingInput) (Evaluation, error) {
 	eval, err := s.EvaluatePolicy(ctx, input)
-	if err != nil || eval.Decision == DecisionBypass {
+	if err != nil || eval.Decision != DecisionNeedsApproval {
 		return eval, err
 	}
+	input.Workflow = eval.Policy.Workflow
 	req, err := s.CreatePending(ctx, input)
 	if err != nil {
 		return Evaluation{}, err
 	}
-	return Evaluation{Decision: DecisionNeedsApproval, Request: req}, nil
+	eval.Request = req
+	return eval, nil
 }
 
+// EvaluatePolicy decides whether a tool call may run as is, needs approval
+// or is denied outright. It does not create a pending request.
 func (s *Service) EvaluatePolicy(ctx context.Context, input CreatePendingInput) (Evaluation, error) {
 	if s == nil || s.settings == nil {
 		return Evaluation{Decision: DecisionBypass}, nil
@@ -56,10 +72,77 @@ func (s *Service) EvaluatePolicy(ctx context.Context, input CreatePendingInput)
 	if err != nil {
 		return Evaluation{}, err
 	}
-	if !needsApproval(botSettings.ToolApprovalConfig, input.ToolName, input.ToolInput) {
-		return Evaluation{Decision: DecisionBypass}, nil
+	result := decide(botSettings.ToolApprovalConfig, policyInput(botSettings, toolpolicy.Input{
+		Tool:             input.ToolName,
+		Args:             inputMap(input.ToolInput),
+		SessionID:        input.SessionID,
+		SessionType:      input.SessionType,
+		Platform:         input.SourcePlatform,
+		ConversationType: input.ConversationType,
+		ChannelIdentity:  input.ChannelIdentityID,
+		Now:              time.Now(),
+	}))
+	for _, ruleErr := range result.Errors {
+		s.logger.Warn("tool policy rule failed",
+			slog.String("bot_id", input.BotID),
+			slog.String("tool", input.ToolName),
+			slog.Int("rule", ruleErr.Index),
+			slog.String("error", ruleErr.Error))
+	}
+	eval := Evaluation{Policy: result}
+	switch result.Effect {
+	case settings.ToolPolicyEffectDeny:
+		eval.Decision = DecisionDeny
+	case settings.ToolPolicyEffectRequireApproval:
+		eval.Decision = DecisionNeedsApproval
+	default:
+		eval.Decision = DecisionBypass
+	}
+	return eval, nil
+}
+
+// DryRun evaluates a sample tool call against the bot's tool approval config,
+// or against req.Config when set, and reports which rule decided.
+func (s *Service) DryRun(ctx context.Context, botID string, req DryRunRequest) (PolicyResult, error) {
+	if s == nil || s.settings == nil {
+		return PolicyResult{}, errors.New("tool approval settings not configured")
+	}
+	if strings.TrimSpace(req.ToolName) == "" {
+		return PolicyResult{}, fmt.Errorf("%w: tool_name is required", ErrInvalidDryRun)
 	}
-	return Evaluation{Decision: DecisionNeedsApproval}, nil
+	botSettings, err := s.settings.GetBot(ctx, botID)
+	if err != nil {
+		return PolicyResult{}, err
+	}
+	cfg := botSettings.ToolApprovalConfig
+	if req.Config != nil {
+		cfg = settings.NormalizeToolApprovalConfig(*req.Config)
+		if err := settings.ValidateToolApprovalConfig(cfg); err != nil {
+			return PolicyResult{}, fmt.Errorf("%w: %w", ErrInvalidDryRun, err)
+		}
+	}
+	now := time.Now()
+	if req.Time != nil {
+		now = *req.Time
+	}
+	return decide(cfg, policyInput(botSettings, toolpolicy.Input{
+		Tool:             req.ToolName,
+		Args:             req.Arguments,
+		SessionType:      req.SessionType,
+		Platform:         req.Platform,
+		ConversationType: req.ConversationType,
+		ChannelIdentity:  req.ChannelIdentityID,
+		Now:              now,
+	})), nil
+}
+
+// policyInput fills in the bot timezone and settings.
+func policyInput(botSettings settings.Settings, in toolpolicy.Input) toolpolicy.Input {
+	if loc, _, err := tzutil.Resolve(botSettings.Timezone); err == nil {
+		in.Location = loc
+	}
+	in.Settings = inputMap(botSettings)
+	return in
 }
 
 func (s *Service) CreatePending(ctx context.Context, input CreatePendingInput) (Request, error) {
@@ -103,7 +186,21 @@ func (s *Service) CreatePending(ctx context.Context, input CreatePendingInput) (
 	if err != nil {
 		return Request{}, err
 	}
-	return requestFromRow(row), nil
+	req := requestFromRow(row)
+	s.auditLog.Record(ctx, audit.Entry{
+		Actor:      audit.Actor{Type: audit.ActorBot, ID: req.BotID},
+		Action:     audit.ActionToolApprovalRequest,
+		BotID:      req.BotID,
+		TargetType: "tool_approval",
+		TargetID:   req.ID,
+		After:      req,
+	})
+	if input.Workflow != nil {
+		if err := s.createWorkflow(ctx, row, input.Workflow, input.RequestedByChannelIdentityID); err != nil {
+			return Request{}, err
+		}
+	}
+	return req, nil
 }
 
 func (s *Service) ResolveTarget(ctx context.Context, input ResolveInput) (Request, error) {
@@ -145,9 +242,20 @@ func (s *Service) ResolveTarget(ctx context.Context, input ResolveInput) (Reques
 				return Request{}, mapLookupErr(err)
 			}
 			req := requestFromRow(row)
-			if req.BotID != uuid.UUID(botID.Bytes).String() || req.SessionID != uuid.UUID(sessionID.Bytes).String() || req.Status != StatusPending {
+			if req.BotID != uuid.UUID(botID.Bytes).String() || req.Status != StatusPending {
 				return Request{}, ErrNotFound
 			}
+			if req.SessionID != uuid.UUID(sessionID.Bytes).String() {
+				// Named approvers may answer from any conversation with
+				// the bot, such as Slack for a Telegram session.
+				ok, err := s.canAnswerRemotely(ctx, req, input.ActorChannelIdentityID)
+				if err != nil {
+					return Request{}, err
+				}
+				if !ok {
+					return Request{}, ErrNotFound
+				}
+			}
 			return req, nil
 		}
 		return Request{}, ErrNotFound
@@ -172,11 +280,44 @@ func (s *Service) ResolveTarget(ctx context.Context, input ResolveInput) (Reques
 	return requestFromRowOrErr(row, err)
 }
 
+// Approve records actorID's approval. Requests without a workflow are
+// approved at once; requests with one stay pending until quorum is reached,
+// and actors who are not approvers get ErrForbidden.
 func (s *Service) Approve(ctx context.Context, approvalID, actorID, reason string) (Request, error) {
+	return s.decide(ctx, approvalID, actorID, reason, VoteApprove)
+}
+
+// Reject records actorID's rejection. Any approver can reject a request
+// with a workflow on their own.
+func (s *Service) Reject(ctx context.Context, approvalID, actorID, reason string) (Request, error) {
+	return s.decide(ctx, approvalID, actorID, reason, VoteReject)
+}
+
+// Dismiss rejects a pending request on behalf of the system, regardless of
+// its workflow, for example when nobody is left to answer it.
+func (s *Service) Dismiss(ctx context.Context, approvalID, reason string) (Request, error) {
+	id, err := db.ParseUUID(approvalID)
+	if err != nil {
+		return Request{}, err
+	}
+	return s.reject(ctx, id, "", reason)
+}
+
+func (s *Service) decide(ctx context.Context, approvalID, actorID, reason, decision string) (Request, error) {
 	id, err := db.ParseUUID(approvalID)
 	if err != nil {
 		return Request{}, err
 	}
+	wf, err := s.loadWorkflow(ctx, id)
+	if err != nil {
+		return Request{}, err
+	}
+	if wf != nil {
+		return s.vote(ctx, id, wf, actorID, decision, reason)
+	}
+	if decision == VoteReject {
+		return s.reject(ctx, id, actorID, reason)
+	}
 	decidedBy, err := s.optionalChannelIdentityUUID(ctx, actorID)
 	if err != nil {
 		return Request{}, err
@@ -186,14 +327,14 @@ func (s *Service) Approve(ctx context.Context, approvalID, actorID, reason strin
 		Reason:                     strings.TrimSpace(reason),
 		DecidedByChannelIdentityID: decidedBy,
 	})
-	return requestFromRowOrErr(row, err)
+	req, err := requestFromRowOrErr(row, err)
+	if err == nil {
+		s.recordDecision(ctx, audit.ActionToolApprovalApprove, actorID, req)
+	}
+	return req, err
 }
 
-func (s *Service) Reject(ctx context.Context, approvalID, actorID, reason string) (Request, error) {
-	id, err := db.ParseUUID(approvalID)
-	if err != nil {
-		return Request{}, err
-	}
+func (s *Service) reject(ctx context.Context, id pgtype.UUID, actorID, reason string) (Request, error) {
 	decidedBy, err := s.optionalChannelIdentityUUID(ctx, actorID)
 	if err != nil {
 		return Request{}, err
@@ -203,7 +344,11 @@ func (s *Service) Reject(ctx context.Context, approvalID, actorID, reason string
 		Reason:                     strings.TrimSpace(reason),
 		DecidedByChannelIdentityID: decidedBy,
 	})
-	return requestFromRowOrErr(row, err)
+	req, err := requestFromRowOrErr(row, err)
+	if err == nil {
+		s.recordDecision(ctx, audit.ActionToolApprovalReject, actorID, req)
+	}
+	return req, err
 }
 
 func (s *Service) Get(ctx context.Context, approvalID string) (Request, error) {
@@ -290,6 +435,27 @@ func (s *Service) listBySession(ctx context.Context, botID, sessionID string, pe
 	return result, nil
 }
 
+// recordDecision attributes a decision to the caller's actor when there is
+// one, such as a user in the web UI, and otherwise to the deciding channel
+// identity.
+func (s *Service) recordDecision(ctx context.Context, action, actorID string, req Request) {
+	if s.auditLog == nil {
+		return
+	}
+	if actorID = strings.TrimSpace(actorID); actorID != "" {
+		ctx = audit.WithDefaultActor(ctx, audit.Actor{Type: audit.ActorChannelIdentity, ID: actorID})
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     action,
+		BotID:      req.BotID,
+		TargetType: "tool_approval",
+		TargetID:   req.ID,
+		Before:     map[string]any{"status": StatusPending},
+		After:      map[string]any{"status": req.Status, "decision_reason": req.DecisionReason},
+		Metadata:   map[string]any{"tool_name": req.ToolName},
+	})
+}
+
 func requestFromRowOrErr(row sqlc.ToolApprovalRequest, err error) (Request, error) {
 	if err != nil {
 		return Request{}, mapLookupErr(err)
@@ -0,0 +1,144 @@
+package toolapproval
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+	"github.com/memohai/memoh/internal/settings"
+)
+
+const (
+	testBotID     = "00000000-0000-0000-0000-0000000000e2"
+	testSessionID = "00000000-0000-0000-0000-0000000000e3"
+
+	testOtherSessionID = "00000000-0000-0000-0000-0000000000e4"
+	testAliceID        = "00000000-0000-0000-0000-0000000000a1"
+	testBobID          = "00000000-0000-0000-0000-0000000000a2"
+	testCarolID        = "00000000-0000-0000-0000-0000000000a3"
+	testDaveID         = "00000000-0000-0000-0000-0000000000a4"
+	testEveID          = "00000000-0000-0000-0000-0000000000a5"
+	testSlackRuleID    = "00000000-0000-0000-0000-0000000000f1"
+)
+
+func newSQLiteApprovalService(t *testing.T) (*Service, *settings.Service) {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('00000000-0000-0000-0000-0000000000e1','policy@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES('` + testBotID + `','00000000-0000-0000-0000-0000000000e1','personal','policybot','Policy Bot')`,
+		`INSERT INTO bot_sessions(id,bot_id,type) VALUES('` + testSessionID + `','` + testBotID + `','chat')`,
+		`INSERT INTO bot_sessions(id,bot_id,type) VALUES('` + testOtherSessionID + `','` + testBotID + `','chat')`,
+		`INSERT INTO channel_identities(id,channel_type,channel_subject_id) VALUES
+			('` + testAliceID + `','telegram','alice'),
+			('` + testBobID + `','slack','bob'),
+			('` + testCarolID + `','telegram','carol'),
+			('` + testDaveID + `','discord','dave'),
+			('` + testEveID + `','slack','eve')`,
+		`INSERT INTO bot_acl_rules(id,bot_id,action,effect,subject_channel_type) VALUES('` + testSlackRuleID + `','` + testBotID + `','chat.trigger','allow','slack')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	queries := sqlitestore.NewQueries(store)
+	settingsService := settings.NewService(slog.Default(), queries, nil, nil)
+	return NewService(slog.Default(), queries, settingsService), settingsService
+}
+
+func TestEvaluatePolicyAndDryRun(t *testing.T) {
+	svc, settingsService := newSQLiteApprovalService(t)
+	ctx := context.Background()
+
+	cfg := settings.DefaultToolApprovalConfig()
+	cfg.Enabled = true
+	cfg.Policies = []settings.ToolApprovalPolicyRule{
+		{Name: "no deletes", Expression: `tool == "exec" && args.command.startsWith("rm ")`, Effect: settings.ToolPolicyEffectDeny},
+		{Name: "office hours", Expression: `tool == "send" && (hour < 9 || hour >= 18)`, Effect: settings.ToolPolicyEffectRequireApproval},
+	}
+	timezone := "Asia/Tokyo"
+	if _, err := settingsService.UpsertBot(ctx, testBotID, settings.UpsertRequest{ToolApprovalConfig: &cfg, Timezone: &timezone}); err != nil {
+		t.Fatalf("upsert settings: %v", err)
+	}
+
+	eval, err := svc.EvaluatePolicy(ctx, CreatePendingInput{BotID: testBotID, ToolName: "exec", ToolInput: map[string]any{"command": "rm -rf /data"}})
+	if err != nil {
+		t.Fatalf("evaluate: %v", err)
+	}
+	if eval.Decision != DecisionDeny || eval.Policy.DenyReason() != `tool call denied by policy "no deletes"` {
+		t.Fatalf("eval = %+v", eval)
+	}
+	eval, err = svc.Evaluate(ctx, CreatePendingInput{BotID: testBotID, ToolName: "exec", ToolInput: map[string]any{"command": "rm x"}})
+	if err != nil || eval.Decision != DecisionDeny || eval.Request.ID != "" {
+		t.Fatalf("evaluate deny created a request: %+v, %v", eval, err)
+	}
+
+	// 10:00 UTC is 19:00 in Tokyo.
+	at := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	res, err := svc.DryRun(ctx, testBotID, DryRunRequest{ToolName: "send", Time: &at})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if res.Effect != settings.ToolPolicyEffectRequireApproval || res.RuleIndex == nil || *res.RuleIndex != 1 || res.RuleName != "office hours" {
+		t.Fatalf("dry run = %+v", res)
+	}
+
+	// An unsaved config is tested without touching the stored one.
+	draft := cfg
+	draft.Policies = []settings.ToolApprovalPolicyRule{{Expression: `settings.timezone == "Asia/Tokyo"`, Effect: settings.ToolPolicyEffectAllow}}
+	res, err = svc.DryRun(ctx, testBotID, DryRunRequest{ToolName: "exec", Arguments: map[string]any{"command": "rm x"}, Config: &draft})
+	if err != nil || res.Effect != settings.ToolPolicyEffectAllow || res.Source != SourcePolicy {
+		t.Fatalf("draft dry run = %+v, %v", res, err)
+	}
+
+	draft.Policies = []settings.ToolApprovalPolicyRule{{Expression: `tool +`, Effect: settings.ToolPolicyEffectAllow}}
+	if _, err := svc.DryRun(ctx, testBotID, DryRunRequest{ToolName: "exec", Config: &draft}); !errors.Is(err, ErrInvalidDryRun) {
+		t.Fatalf("invalid draft err = %v", err)
+	}
+	if _, err := svc.DryRun(ctx, testBotID, DryRunRequest{}); !errors.Is(err, ErrInvalidDryRun) {
+		t.Fatalf("missing tool err = %v", err)
+	}
+}
+
+func TestCreatePendingAcceptsAnyTool(t *testing.T) {
+	svc, _ := newSQLiteApprovalService(t)
+	req, err := svc.CreatePending(context.Background(), CreatePendingInput{
+		BotID:      testBotID,
+		SessionID:  testSessionID,
+		ToolCallID: "call-1",
+		ToolName:   "github_create_issue",
+		ToolInput:  map[string]any{"title": "x"},
+	})
+	if err != nil {
+		t.Fatalf("create pending: %v", err)
+	}
+	if req.Status != StatusPending || req.ToolName != "github_create_issue" || req.ShortID != 1 {
+		t.Fatalf("request = %+v", req)
+	}
+}
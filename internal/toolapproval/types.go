@@ -2,7 +2,10 @@ package toolapproval
 
 import (
 	"errors"
+	"strconv"
 	"time"
+
+	"github.com/memohai/memoh/internal/settings"
 )
 
 const (
@@ -14,6 +17,10 @@ const (
 
 	DecisionBypass        = "bypass"
 	DecisionNeedsApproval = "needs_approval"
+	DecisionDeny          = "deny"
+
+	VoteApprove = "approve"
+	VoteReject  = "reject"
 )
 
 var (
@@ -21,6 +28,7 @@ var (
 	ErrAlreadyDecided = errors.New("tool approval request already decided")
 	ErrForbidden      = errors.New("tool approval forbidden")
 	ErrAmbiguous      = errors.New("tool approval request is ambiguous")
+	ErrInvalidDryRun  = errors.New("invalid tool policy dry run")
 )
 
 type CreatePendingInput struct {
@@ -36,11 +44,65 @@ type CreatePendingInput struct {
 	SourcePlatform               string
 	ReplyTarget                  string
 	ConversationType             string
+	SessionType                  string
+	// Workflow routes the request to named approvers. Nil lets anyone in
+	// the conversation decide.
+	Workflow *settings.ToolApprovalWorkflow
 }
 
 type Evaluation struct {
 	Decision string
 	Request  Request
+	Policy   PolicyResult
+}
+
+// PolicyResult explains how a bot's tool approval config decided a call.
+type PolicyResult struct {
+	// Effect is allow, deny or require_approval.
+	Effect string `json:"effect"`
+	// Source is "policy" when a policy rule matched, "builtin" when the
+	// write/edit/exec rules decided and "disabled" when approval is off.
+	Source     string            `json:"source"`
+	RuleIndex  *int              `json:"rule_index,omitempty"`
+	RuleName   string            `json:"rule_name,omitempty"`
+	Expression string            `json:"expression,omitempty"`
+	Errors     []PolicyRuleError `json:"errors,omitempty"`
+	// Workflow is the approval workflow a require_approval result is
+	// routed through, from the matched rule or the config.
+	Workflow *settings.ToolApprovalWorkflow `json:"workflow,omitempty"`
+}
+
+// DenyReason is the rejection text reported to the model for a denied call.
+func (r PolicyResult) DenyReason() string {
+	if r.RuleName != "" {
+		return "tool call denied by policy " + strconv.Quote(r.RuleName)
+	}
+	if r.RuleIndex != nil {
+		return "tool call denied by policy #" + strconv.Itoa(*r.RuleIndex+1)
+	}
+	return "tool call denied by policy"
+}
+
+// PolicyRuleError is a policy rule whose expression failed to evaluate and
+// was skipped.
+type PolicyRuleError struct {
+	Index int    `json:"index"`
+	Name  string `json:"name,omitempty"`
+	Error string `json:"error"`
+}
+
+// DryRunRequest is a sample tool call to test a bot's policies against.
+type DryRunRequest struct {
+	ToolName          string         `json:"tool_name"`
+	Arguments         map[string]any `json:"arguments,omitempty"`
+	SessionType       string         `json:"session_type,omitempty"`
+	Platform          string         `json:"platform,omitempty"`
+	ConversationType  string         `json:"conversation_type,omitempty"`
+	ChannelIdentityID string         `json:"channel_identity_id,omitempty"`
+	// Time defaults to now.
+	Time *time.Time `json:"time,omitempty"`
+	// Config tests an unsaved tool approval config instead of the stored one.
+	Config *settings.ToolApprovalConfig `json:"config,omitempty"`
 }
 
 type ResolveInput struct {
@@ -48,6 +110,9 @@ type ResolveInput struct {
 	SessionID              string
 	ExplicitID             string
 	ReplyExternalMessageID string
+	// ActorChannelIdentityID lets a workflow approver answer a request by
+	// its UUID from a session other than the one that made it.
+	ActorChannelIdentityID string
 }
 
 type Request struct {
@@ -68,4 +133,49 @@ type Request struct {
 	ConversationType        string         `json:"conversation_type,omitempty"`
 	CreatedAt               time.Time      `json:"created_at"`
 	DecidedAt               *time.Time     `json:"decided_at,omitempty"`
+	// Workflow is set on the results of Approve and Reject for requests
+	// created with an approval workflow.
+	Workflow *Workflow `json:"workflow,omitempty"`
+}
+
+// Workflow is the approval workflow a request was created with, with the
+// approvers resolved at creation time.
+type Workflow struct {
+	Approvers []Approver `json:"approvers,omitempty"`
+	// Quorum is how many approvers must approve. 0 means the workflow names
+	// no approvers and anyone in the conversation may decide.
+	Quorum              int        `json:"quorum"`
+	EscalationApprovers []Approver `json:"escalation_approvers,omitempty"`
+	EscalateAt          *time.Time `json:"escalate_at,omitempty"`
+	EscalatedAt         *time.Time `json:"escalated_at,omitempty"`
+	ExpiresAt           *time.Time `json:"expires_at,omitempty"`
+	Votes               []Vote     `json:"votes,omitempty"`
+}
+
+// Approver is a channel identity, or everyone on a channel type, that may
+// vote on a request.
+type Approver struct {
+	ChannelIdentityID string `json:"channel_identity_id,omitempty"`
+	ChannelType       string `json:"channel_type,omitempty"`
+}
+
+type Vote struct {
+	ChannelIdentityID string    `json:"channel_identity_id"`
+	Decision          string    `json:"decision"`
+	Reason            string    `json:"reason,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// Approvals counts the approve votes cast so far.
+func (w *Workflow) Approvals() int {
+	if w == nil {
+		return 0
+	}
+	n := 0
+	for _, vote := range w.Votes {
+		if vote.Decision == VoteApprove {
+			n++
+		}
+	}
+	return n
 }
@@ -0,0 +1,424 @@
+package toolapproval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/audit"
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	"github.com/memohai/memoh/internal/settings"
+)
+
+const (
+	// DefaultSweepInterval is how often StartSweepLoop escalates and
+	// expires overdue requests.
+	DefaultSweepInterval = 15 * time.Second
+
+	expiredReason = "tool approval expired"
+)
+
+// Sender delivers approval prompts to approvers on their own channel.
+// *channel.Manager implements it.
+type Sender interface {
+	Send(ctx context.Context, botID string, channelType channel.ChannelType, req channel.SendRequest) error
+}
+
+// SetSender makes the service send approval prompts directly to the named
+// approvers of a workflow, and to escalation approvers on escalation.
+func (s *Service) SetSender(sender Sender) {
+	s.sender = sender
+}
+
+// SetExpiryHandler sets the function told about each request Sweep expires,
+// so the conversation that made the call can move on.
+func (s *Service) SetExpiryHandler(fn func(ctx context.Context, req Request)) {
+	s.onExpire = fn
+}
+
+// createWorkflow stores the workflow of a newly created request, resolving
+// ACL rule approvers to their subjects, and notifies the approvers.
+func (s *Service) createWorkflow(ctx context.Context, row sqlc.ToolApprovalRequest, cfg *settings.ToolApprovalWorkflow, requesterID string) error {
+	if _, err := s.queries.GetToolApprovalWorkflow(ctx, row.ID); err == nil {
+		return nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	approvers, err := s.resolveApprovers(ctx, row.BotID, cfg.Approvers)
+	if err != nil {
+		return err
+	}
+	escalation, err := s.resolveApprovers(ctx, row.BotID, cfg.EscalateTo)
+	if err != nil {
+		return err
+	}
+	// A workflow that names approvers stays restricted to them even when
+	// none of them resolve, so the request can only escalate or expire.
+	quorum := 0
+	if len(cfg.Approvers) > 0 {
+		quorum = max(cfg.Quorum, 1)
+	}
+	approversJSON, err := json.Marshal(approvers)
+	if err != nil {
+		return err
+	}
+	escalationJSON, err := json.Marshal(escalation)
+	if err != nil {
+		return err
+	}
+	created := row.CreatedAt.Time
+	params := sqlc.CreateToolApprovalWorkflowParams{
+		RequestID:           row.ID,
+		Approvers:           approversJSON,
+		Quorum:              int32(quorum), //nolint:gosec // quorum is a small count of approvers.
+		EscalationApprovers: escalationJSON,
+	}
+	if cfg.EscalateAfterSeconds > 0 {
+		params.EscalateAt = pgtype.Timestamptz{Time: created.Add(time.Duration(cfg.EscalateAfterSeconds) * time.Second), Valid: true}
+	}
+	if cfg.ExpireAfterSeconds > 0 {
+		params.ExpiresAt = pgtype.Timestamptz{Time: created.Add(time.Duration(cfg.ExpireAfterSeconds) * time.Second), Valid: true}
+	}
+	if err := s.queries.CreateToolApprovalWorkflow(ctx, params); err != nil {
+		return err
+	}
+	s.notifyApprovers(ctx, requestFromRow(row), approvers, requesterID, false)
+	return nil
+}
+
+// resolveApprovers turns configured approvers into channel identities and
+// channel types. ACL rules that no longer exist, are disabled or belong to
+// another bot are skipped.
+func (s *Service) resolveApprovers(ctx context.Context, botID pgtype.UUID, configured []settings.ToolApprovalApprover) ([]Approver, error) {
+	out := make([]Approver, 0, len(configured))
+	for _, approver := range configured {
+		if id := strings.TrimSpace(approver.ChannelIdentityID); id != "" {
+			out = append(out, Approver{ChannelIdentityID: id})
+			continue
+		}
+		ruleID, err := db.ParseUUID(approver.ACLRuleID)
+		if err != nil {
+			continue
+		}
+		rule, err := s.queries.GetBotACLRuleByID(ctx, ruleID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				s.logger.Warn("tool approval approver rule not found", slog.String("acl_rule_id", approver.ACLRuleID))
+				continue
+			}
+			return nil, err
+		}
+		if !rule.Enabled || rule.BotID != botID {
+			continue
+		}
+		switch {
+		case rule.ChannelIdentityID.Valid:
+			out = append(out, Approver{ChannelIdentityID: uuid.UUID(rule.ChannelIdentityID.Bytes).String()})
+		case strings.TrimSpace(rule.SubjectChannelType.String) != "":
+			out = append(out, Approver{ChannelType: strings.TrimSpace(rule.SubjectChannelType.String)})
+		}
+	}
+	return out, nil
+}
+
+// loadWorkflow returns the request's workflow with its votes, or nil when
+// the request was created without one.
+func (s *Service) loadWorkflow(ctx context.Context, requestID pgtype.UUID) (*Workflow, error) {
+	row, err := s.queries.GetToolApprovalWorkflow(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	votes, err := s.queries.ListToolApprovalVotes(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	wf := workflowFromRow(row)
+	for _, vote := range votes {
+		wf.Votes = append(wf.Votes, Vote{
+			ChannelIdentityID: uuid.UUID(vote.ChannelIdentityID.Bytes).String(),
+			Decision:          vote.Decision,
+			Reason:            vote.Reason,
+			CreatedAt:         vote.CreatedAt.Time,
+		})
+	}
+	return wf, nil
+}
+
+// vote records actorID's decision on a request that has a workflow and
+// decides the request when the decision settles it: any reject, an
+// approval from an escalation approver once escalated, or reaching quorum.
+// Otherwise the request stays pending.
+func (s *Service) vote(ctx context.Context, id pgtype.UUID, wf *Workflow, actorID, decision, reason string) (Request, error) {
+	row, err := s.queries.GetToolApprovalRequest(ctx, id)
+	if err != nil {
+		return Request{}, mapLookupErr(err)
+	}
+	if row.Status != StatusPending {
+		return Request{}, ErrAlreadyDecided
+	}
+	primary, escalation, err := s.eligibility(ctx, wf, actorID)
+	if err != nil {
+		return Request{}, err
+	}
+	if !primary && !escalation {
+		return Request{}, ErrForbidden
+	}
+	voterID, err := db.ParseUUID(actorID)
+	if err != nil {
+		return Request{}, ErrForbidden
+	}
+	reason = strings.TrimSpace(reason)
+	if err := s.queries.UpsertToolApprovalVote(ctx, sqlc.UpsertToolApprovalVoteParams{
+		RequestID:         id,
+		ChannelIdentityID: voterID,
+		Decision:          decision,
+		Reason:            reason,
+	}); err != nil {
+		return Request{}, err
+	}
+	if wf, err = s.loadWorkflow(ctx, id); err != nil {
+		return Request{}, err
+	}
+
+	final := decision == VoteReject || escalation || wf.Quorum == 0 || wf.Approvals() >= wf.Quorum
+	if !final {
+		req := requestFromRow(row)
+		req.Workflow = wf
+		s.auditLog.Record(withActorDefault(ctx, actorID), audit.Entry{
+			Action:     audit.ActionToolApprovalVote,
+			BotID:      req.BotID,
+			TargetType: "tool_approval",
+			TargetID:   req.ID,
+			After:      map[string]any{"decision": decision, "approvals": wf.Approvals(), "quorum": wf.Quorum},
+			Metadata:   map[string]any{"tool_name": req.ToolName},
+		})
+		return req, nil
+	}
+	decidedBy, err := s.optionalChannelIdentityUUID(ctx, actorID)
+	if err != nil {
+		return Request{}, err
+	}
+	action := audit.ActionToolApprovalApprove
+	if decision == VoteReject {
+		action = audit.ActionToolApprovalReject
+		row, err = s.queries.RejectToolApprovalRequest(ctx, sqlc.RejectToolApprovalRequestParams{
+			ID:                         id,
+			Reason:                     reason,
+			DecidedByChannelIdentityID: decidedBy,
+		})
+	} else {
+		row, err = s.queries.ApproveToolApprovalRequest(ctx, sqlc.ApproveToolApprovalRequestParams{
+			ID:                         id,
+			Reason:                     reason,
+			DecidedByChannelIdentityID: decidedBy,
+		})
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Request{}, ErrAlreadyDecided
+		}
+		return Request{}, err
+	}
+	req := requestFromRow(row)
+	req.Workflow = wf
+	s.recordDecision(ctx, action, actorID, req)
+	return req, nil
+}
+
+// eligibility reports whether actorID is one of the workflow's approvers
+// and whether it is an escalation approver of an escalated request.
+func (s *Service) eligibility(ctx context.Context, wf *Workflow, actorID string) (primary, escalation bool, err error) {
+	actorID = strings.TrimSpace(actorID)
+	if actorID == "" {
+		return false, false, nil
+	}
+	channelType := ""
+	if parsed, parseErr := db.ParseUUID(actorID); parseErr == nil {
+		ci, err := s.queries.GetChannelIdentityByID(ctx, parsed)
+		switch {
+		case err == nil:
+			channelType = strings.TrimSpace(ci.ChannelType)
+		case !errors.Is(err, pgx.ErrNoRows):
+			return false, false, err
+		}
+	}
+	primary = wf.Quorum == 0 || matchesApprover(wf.Approvers, actorID, channelType)
+	escalation = wf.EscalatedAt != nil && matchesApprover(wf.EscalationApprovers, actorID, channelType)
+	return primary, escalation, nil
+}
+
+func matchesApprover(approvers []Approver, channelIdentityID, channelType string) bool {
+	return slices.ContainsFunc(approvers, func(a Approver) bool {
+		if a.ChannelIdentityID != "" {
+			return strings.EqualFold(a.ChannelIdentityID, channelIdentityID)
+		}
+		return a.ChannelType != "" && a.ChannelType == channelType
+	})
+}
+
+// canAnswerRemotely reports whether actorID may answer req from outside
+// its session: only named approvers can, not anyone in a conversation.
+func (s *Service) canAnswerRemotely(ctx context.Context, req Request, actorID string) (bool, error) {
+	id, err := db.ParseUUID(req.ID)
+	if err != nil {
+		return false, err
+	}
+	wf, err := s.loadWorkflow(ctx, id)
+	if err != nil || wf == nil {
+		return false, err
+	}
+	primary, escalation, err := s.eligibility(ctx, wf, actorID)
+	if err != nil {
+		return false, err
+	}
+	return (primary && wf.Quorum > 0) || escalation, nil
+}
+
+// Sweep escalates and expires requests whose deadlines have passed by now.
+func (s *Service) Sweep(ctx context.Context, now time.Time) error {
+	if s == nil || s.queries == nil {
+		return errors.New("tool approval queries not configured")
+	}
+	at := pgtype.Timestamptz{Time: now.UTC(), Valid: true}
+	escalated, err := s.queries.ClaimDueToolApprovalEscalations(ctx, at)
+	if err != nil {
+		return err
+	}
+	for _, row := range escalated {
+		reqRow, err := s.queries.GetToolApprovalRequest(ctx, row.RequestID)
+		if err != nil {
+			s.logger.Warn("load escalated tool approval failed", slog.Any("error", err))
+			continue
+		}
+		req := requestFromRow(reqRow)
+		wf := workflowFromRow(row)
+		s.auditLog.Record(ctx, audit.Entry{
+			Actor:      audit.Actor{Type: audit.ActorSystem},
+			Action:     audit.ActionToolApprovalEscalate,
+			BotID:      req.BotID,
+			TargetType: "tool_approval",
+			TargetID:   req.ID,
+			After:      map[string]any{"escalation_approvers": wf.EscalationApprovers},
+			Metadata:   map[string]any{"tool_name": req.ToolName},
+		})
+		s.notifyApprovers(ctx, req, wf.EscalationApprovers, "", true)
+	}
+	expired, err := s.queries.ExpireDueToolApprovalRequests(ctx, sqlc.ExpireDueToolApprovalRequestsParams{
+		Reason: expiredReason,
+		Now:    at,
+	})
+	if err != nil {
+		return err
+	}
+	for _, row := range expired {
+		req := requestFromRow(row)
+		s.auditLog.Record(ctx, audit.Entry{
+			Actor:      audit.Actor{Type: audit.ActorSystem},
+			Action:     audit.ActionToolApprovalExpire,
+			BotID:      req.BotID,
+			TargetType: "tool_approval",
+			TargetID:   req.ID,
+			Before:     map[string]any{"status": StatusPending},
+			After:      map[string]any{"status": req.Status, "decision_reason": req.DecisionReason},
+			Metadata:   map[string]any{"tool_name": req.ToolName},
+		})
+		if s.onExpire != nil {
+			s.onExpire(ctx, req)
+		}
+	}
+	return nil
+}
+
+// StartSweepLoop runs Sweep every interval until done is closed.
+func (s *Service) StartSweepLoop(done <-chan struct{}, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSweepInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Sweep(context.Background(), time.Now()); err != nil {
+				s.logger.Warn("tool approval sweep failed", slog.Any("error", err))
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// notifyApprovers sends the approval prompt to each approver named by
+// channel identity, skipping the requester, who already sees the prompt in
+// the conversation. Approvers given as a channel type cannot be addressed
+// and are not notified.
+func (s *Service) notifyApprovers(ctx context.Context, req Request, approvers []Approver, requesterID string, escalated bool) {
+	if s.sender == nil {
+		return
+	}
+	for _, approver := range approvers {
+		if approver.ChannelIdentityID == "" || strings.EqualFold(approver.ChannelIdentityID, requesterID) {
+			continue
+		}
+		id, err := db.ParseUUID(approver.ChannelIdentityID)
+		if err != nil {
+			continue
+		}
+		ci, err := s.queries.GetChannelIdentityByID(ctx, id)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				s.logger.Warn("load tool approval approver failed", slog.String("channel_identity_id", approver.ChannelIdentityID), slog.Any("error", err))
+			}
+			continue
+		}
+		err = s.sender.Send(ctx, req.BotID, channel.ChannelType(ci.ChannelType), channel.SendRequest{
+			Target:  ci.ChannelSubjectID,
+			Message: BuildApproverPrompt(req, escalated),
+		})
+		if err != nil {
+			s.logger.Warn("send tool approval prompt failed",
+				slog.String("approval_id", req.ID),
+				slog.String("channel", ci.ChannelType),
+				slog.Any("error", err))
+		}
+	}
+}
+
+func workflowFromRow(row sqlc.ToolApprovalWorkflow) *Workflow {
+	wf := &Workflow{Quorum: int(row.Quorum)}
+	_ = json.Unmarshal(row.Approvers, &wf.Approvers)
+	_ = json.Unmarshal(row.EscalationApprovers, &wf.EscalationApprovers)
+	wf.EscalateAt = optionalTime(row.EscalateAt)
+	wf.EscalatedAt = optionalTime(row.EscalatedAt)
+	wf.ExpiresAt = optionalTime(row.ExpiresAt)
+	return wf
+}
+
+func optionalTime(value pgtype.Timestamptz) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	t := value.Time
+	return &t
+}
+
+func withActorDefault(ctx context.Context, actorID string) context.Context {
+	if actorID = strings.TrimSpace(actorID); actorID != "" {
+		return audit.WithDefaultActor(ctx, audit.Actor{Type: audit.ActorChannelIdentity, ID: actorID})
+	}
+	return ctx
+}
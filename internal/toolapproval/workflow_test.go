@@ -0,0 +1,155 @@
+package toolapproval
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/memohai/memoh/internal/channel"
+	"github.com/memohai/memoh/internal/settings"
+)
+
+type recordingSender struct {
+	targets []string
+}
+
+func (s *recordingSender) Send(_ context.Context, _ string, channelType channel.ChannelType, req channel.SendRequest) error {
+	s.targets = append(s.targets, string(channelType)+":"+req.Target)
+	return nil
+}
+
+func createWorkflowRequest(t *testing.T, svc *Service, callID string, wf settings.ToolApprovalWorkflow) Request {
+	t.Helper()
+	req, err := svc.CreatePending(context.Background(), CreatePendingInput{
+		BotID:                        testBotID,
+		SessionID:                    testSessionID,
+		RequestedByChannelIdentityID: testCarolID,
+		ToolCallID:                   callID,
+		ToolName:                     "exec",
+		ToolInput:                    map[string]any{"command": "ls /etc"},
+		Workflow:                     &wf,
+	})
+	if err != nil {
+		t.Fatalf("create pending: %v", err)
+	}
+	return req
+}
+
+func TestWorkflowQuorum(t *testing.T) {
+	svc, _ := newSQLiteApprovalService(t)
+	sender := &recordingSender{}
+	svc.SetSender(sender)
+	ctx := context.Background()
+
+	req := createWorkflowRequest(t, svc, "call-quorum", settings.ToolApprovalWorkflow{
+		Approvers: []settings.ToolApprovalApprover{{ChannelIdentityID: testAliceID}, {ChannelIdentityID: testBobID}},
+		Quorum:    2,
+	})
+	if len(sender.targets) != 2 || sender.targets[0] != "telegram:alice" || sender.targets[1] != "slack:bob" {
+		t.Fatalf("notified = %v", sender.targets)
+	}
+
+	if _, err := svc.Approve(ctx, req.ID, testCarolID, ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("non-approver err = %v", err)
+	}
+	for range 2 {
+		got, err := svc.Approve(ctx, req.ID, testAliceID, "")
+		if err != nil {
+			t.Fatalf("alice approve: %v", err)
+		}
+		if got.Status != StatusPending || got.Workflow == nil || got.Workflow.Approvals() != 1 {
+			t.Fatalf("after alice = %+v", got)
+		}
+	}
+
+	// Bob answers from another conversation by the request UUID.
+	if _, err := svc.ResolveTarget(ctx, ResolveInput{BotID: testBotID, SessionID: testOtherSessionID, ExplicitID: req.ID, ActorChannelIdentityID: testCarolID}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("remote non-approver err = %v", err)
+	}
+	target, err := svc.ResolveTarget(ctx, ResolveInput{BotID: testBotID, SessionID: testOtherSessionID, ExplicitID: req.ID, ActorChannelIdentityID: testBobID})
+	if err != nil || target.ID != req.ID {
+		t.Fatalf("remote approver target = %+v, %v", target, err)
+	}
+	got, err := svc.Approve(ctx, req.ID, testBobID, "looks fine")
+	if err != nil || got.Status != StatusApproved {
+		t.Fatalf("bob approve = %+v, %v", got, err)
+	}
+	if _, err := svc.Approve(ctx, req.ID, testBobID, ""); !errors.Is(err, ErrAlreadyDecided) {
+		t.Fatalf("second decision err = %v", err)
+	}
+}
+
+func TestWorkflowApproverGroupVeto(t *testing.T) {
+	svc, _ := newSQLiteApprovalService(t)
+	ctx := context.Background()
+
+	req := createWorkflowRequest(t, svc, "call-group", settings.ToolApprovalWorkflow{
+		Approvers: []settings.ToolApprovalApprover{{ACLRuleID: testSlackRuleID}, {ChannelIdentityID: testAliceID}},
+		Quorum:    2,
+	})
+	if _, err := svc.Reject(ctx, req.ID, testDaveID, ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("discord user err = %v", err)
+	}
+	if got, err := svc.Approve(ctx, req.ID, testAliceID, ""); err != nil || got.Status != StatusPending {
+		t.Fatalf("alice approve = %+v, %v", got, err)
+	}
+	got, err := svc.Reject(ctx, req.ID, testEveID, "not today")
+	if err != nil || got.Status != StatusRejected || got.DecisionReason != "not today" {
+		t.Fatalf("slack user reject = %+v, %v", got, err)
+	}
+}
+
+func TestWorkflowEscalationAndExpiry(t *testing.T) {
+	svc, _ := newSQLiteApprovalService(t)
+	sender := &recordingSender{}
+	svc.SetSender(sender)
+	var expired []Request
+	svc.SetExpiryHandler(func(_ context.Context, req Request) { expired = append(expired, req) })
+	ctx := context.Background()
+
+	wf := settings.ToolApprovalWorkflow{
+		Approvers:            []settings.ToolApprovalApprover{{ChannelIdentityID: testAliceID}},
+		EscalateAfterSeconds: 60,
+		EscalateTo:           []settings.ToolApprovalApprover{{ChannelIdentityID: testDaveID}},
+		ExpireAfterSeconds:   300,
+	}
+	escalated := createWorkflowRequest(t, svc, "call-escalate", wf)
+	if _, err := svc.Approve(ctx, escalated.ID, testDaveID, ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("escalation approver before escalation err = %v", err)
+	}
+	sender.targets = nil
+	if err := svc.Sweep(ctx, time.Now().Add(2*time.Minute)); err != nil {
+		t.Fatalf("sweep: %v", err)
+	}
+	if len(sender.targets) != 1 || sender.targets[0] != "discord:dave" {
+		t.Fatalf("escalation notified = %v", sender.targets)
+	}
+	got, err := svc.Approve(ctx, escalated.ID, testDaveID, "")
+	if err != nil || got.Status != StatusApproved {
+		t.Fatalf("escalated approve = %+v, %v", got, err)
+	}
+
+	stale := createWorkflowRequest(t, svc, "call-expire", wf)
+	if err := svc.Sweep(ctx, time.Now().Add(10*time.Minute)); err != nil {
+		t.Fatalf("sweep: %v", err)
+	}
+	if len(expired) != 1 || expired[0].ID != stale.ID || expired[0].Status != StatusExpired {
+		t.Fatalf("expired = %+v", expired)
+	}
+	if _, err := svc.Approve(ctx, stale.ID, testAliceID, ""); !errors.Is(err, ErrAlreadyDecided) {
+		t.Fatalf("approve expired err = %v", err)
+	}
+}
+
+func TestDismissBypassesWorkflow(t *testing.T) {
+	svc, _ := newSQLiteApprovalService(t)
+	req := createWorkflowRequest(t, svc, "call-dismiss", settings.ToolApprovalWorkflow{
+		Approvers: []settings.ToolApprovalApprover{{ChannelIdentityID: testAliceID}, {ChannelIdentityID: testBobID}},
+		Quorum:    2,
+	})
+	got, err := svc.Dismiss(context.Background(), req.ID, "tool approval timed out")
+	if err != nil || got.Status != StatusRejected || got.DecisionReason != "tool approval timed out" {
+		t.Fatalf("dismiss = %+v, %v", got, err)
+	}
+}
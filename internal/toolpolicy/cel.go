@@ -0,0 +1,176 @@
+// Package toolpolicy compiles and evaluates the CEL expressions used by bot
+// tool approval policies. An expression sees the tool call and its context
+// through these variables:
+//
+//	tool              string               tool name, e.g. "exec" or "github_create_issue"
+//	args              map(string, dyn)     tool call arguments
+//	session           map(string, string)  id, type, platform, conversation_type
+//	channel_identity  string               channel identity that triggered the call
+//	now               timestamp            evaluation time
+//	hour, minute      int                  wall clock in the bot timezone
+//	weekday           int                  0 (Sunday) to 6 in the bot timezone
+//	settings          map(string, dyn)     bot settings, as returned by the settings API
+//
+// An expression must evaluate to a bool.
+package toolpolicy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+)
+
+const (
+	// maxCachedPrograms bounds the compiled program cache. Policies change
+	// rarely, so dropping the whole cache when it fills is good enough.
+	maxCachedPrograms = 512
+	// maxEvalCost bounds the work one evaluation may do, so an expression
+	// iterating over large arguments cannot stall a tool call.
+	maxEvalCost = 1_000_000
+)
+
+var ErrInvalidExpression = errors.New("invalid policy expression")
+
+// Input is the tool call an expression is evaluated against.
+type Input struct {
+	Tool             string
+	Args             map[string]any
+	SessionID        string
+	SessionType      string
+	Platform         string
+	ConversationType string
+	ChannelIdentity  string
+	Now              time.Time
+	Location         *time.Location
+	Settings         map[string]any
+}
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	errEnv  error
+
+	cacheMu  sync.Mutex
+	programs = map[string]cel.Program{}
+)
+
+func celEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, errEnv = cel.NewEnv(
+			cel.Variable("tool", cel.StringType),
+			cel.Variable("args", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("session", cel.MapType(cel.StringType, cel.StringType)),
+			cel.Variable("channel_identity", cel.StringType),
+			cel.Variable("now", cel.TimestampType),
+			cel.Variable("hour", cel.IntType),
+			cel.Variable("minute", cel.IntType),
+			cel.Variable("weekday", cel.IntType),
+			cel.Variable("settings", cel.MapType(cel.StringType, cel.DynType)),
+			ext.Strings(),
+			ext.Lists(),
+		)
+	})
+	return env, errEnv
+}
+
+// Validate reports whether expr compiles to a bool expression.
+func Validate(expr string) error {
+	_, err := program(expr)
+	return err
+}
+
+// Eval evaluates expr against in. Runtime errors, such as reading a missing
+// argument without has() or exceeding the cost limit, are returned as is.
+func Eval(expr string, in Input) (bool, error) {
+	prg, err := program(expr)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := prg.Eval(activation(in))
+	if err != nil {
+		return false, err
+	}
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %s, want bool", out.Type().TypeName())
+	}
+	return matched, nil
+}
+
+func program(expr string) (cel.Program, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("%w: expression is required", ErrInvalidExpression)
+	}
+	cacheMu.Lock()
+	prg, ok := programs[expr]
+	cacheMu.Unlock()
+	if ok {
+		return prg, nil
+	}
+
+	e, err := celEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := e.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpression, iss.Err())
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("%w: expression returns %s, want bool", ErrInvalidExpression, ast.OutputType())
+	}
+	prg, err = e.Program(ast, cel.CostLimit(maxEvalCost))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpression, err)
+	}
+
+	cacheMu.Lock()
+	if len(programs) >= maxCachedPrograms {
+		clear(programs)
+	}
+	programs[expr] = prg
+	cacheMu.Unlock()
+	return prg, nil
+}
+
+func activation(in Input) map[string]any {
+	now := in.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	loc := in.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+	args := in.Args
+	if args == nil {
+		args = map[string]any{}
+	}
+	settings := in.Settings
+	if settings == nil {
+		settings = map[string]any{}
+	}
+	return map[string]any{
+		"tool": strings.TrimSpace(in.Tool),
+		"args": args,
+		"session": map[string]string{
+			"id":                strings.TrimSpace(in.SessionID),
+			"type":              strings.TrimSpace(in.SessionType),
+			"platform":          strings.TrimSpace(in.Platform),
+			"conversation_type": strings.TrimSpace(in.ConversationType),
+		},
+		"channel_identity": strings.TrimSpace(in.ChannelIdentity),
+		"now":              now,
+		"hour":             int64(local.Hour()),
+		"minute":           int64(local.Minute()),
+		"weekday":          int64(local.Weekday()),
+		"settings":         settings,
+	}
+}
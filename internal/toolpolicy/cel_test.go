@@ -0,0 +1,67 @@
+package toolpolicy
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEval(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	in := Input{
+		Tool:             "exec",
+		Args:             map[string]any{"command": "git push --force", "timeout": float64(30)},
+		SessionType:      "heartbeat",
+		Platform:         "telegram",
+		ConversationType: "group",
+		ChannelIdentity:  "ci-1",
+		Now:              time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC), // Monday 23:30 in Shanghai
+		Location:         shanghai,
+		Settings:         map[string]any{"language": "en"},
+	}
+	cases := map[string]bool{
+		`tool == "exec" && args.command.startsWith("git push")`: true,
+		`has(args.path)`: false,
+		`session.type == "heartbeat" && session.platform == "telegram"`: true,
+		`hour >= 22 || hour < 7`:                            true,
+		`weekday == 1 && minute == 30`:                      true,
+		`channel_identity in ["ci-1", "ci-2"]`:              true,
+		`settings.language == "zh"`:                         false,
+		`args.timeout > 10.0`:                               true,
+		`now > timestamp("2026-01-01T00:00:00Z")`:           true,
+		`args.command.split(" ").exists(w, w == "--force")`: true,
+	}
+	for expr, want := range cases {
+		got, err := Eval(expr, in)
+		if err != nil {
+			t.Fatalf("%s: %v", expr, err)
+		}
+		if got != want {
+			t.Fatalf("%s = %v, want %v", expr, got, want)
+		}
+	}
+
+	if _, err := Eval(`args.path == "/etc"`, in); err == nil {
+		t.Fatal("missing argument evaluated without error")
+	}
+	// Six nested loops over ten items take a million iterations.
+	expensive := strings.Repeat(`[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(x, `, 6) + "x > 0" + strings.Repeat(")", 6)
+	if _, err := Eval(expensive, in); err == nil {
+		t.Fatal("expression over the cost limit evaluated without error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(`tool == "write" && args.path.startsWith("/etc/")`); err != nil {
+		t.Fatalf("valid expression: %v", err)
+	}
+	for _, expr := range []string{"", "tool ==", `tool + "x"`, `unknown_var == 1`} {
+		if err := Validate(expr); !errors.Is(err, ErrInvalidExpression) {
+			t.Fatalf("Validate(%q) = %v, want ErrInvalidExpression", expr, err)
+		}
+	}
+}
@@ -40,6 +40,13 @@ type LoginResponse struct {
 	Timezone    string `json:"timezone,omitempty"`
 }
 
+// BotFromTemplate is the bot created by POST /bot-templates/{id}/bots.
+type BotFromTemplate struct {
+	Bot        bots.Bot `json:"bot"`
+	TemplateID string   `json:"template_id"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
 type ChatRequest struct {
 	BotID           string
 	SessionID       string
@@ -105,6 +112,14 @@ func (c *Client) CreateBot(ctx context.Context, req bots.CreateBotRequest) (bots
 	return resp, err
 }
 
+// CreateBotFromTemplate creates a bot from the template with the given ID or
+// name. Empty fields of req fall back to the template.
+func (c *Client) CreateBotFromTemplate(ctx context.Context, template string, req bots.CreateBotRequest) (BotFromTemplate, error) {
+	var resp BotFromTemplate
+	err := c.doJSON(ctx, http.MethodPost, "/bot-templates/"+url.PathEscape(template)+"/bots", req, &resp)
+	return resp, err
+}
+
 func (c *Client) DeleteBot(ctx context.Context, botID string) error {
 	return c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/bots/%s", botID), nil, nil)
 }
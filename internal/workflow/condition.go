@@ -0,0 +1,77 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+)
+
+// Condition steps are CEL expressions that see the run through these
+// variables and must evaluate to a bool:
+//
+//	inputs  map(string, dyn)  the inputs of the run
+//	steps   map(string, dyn)  finished steps by id, each with status and output
+var (
+	condEnvOnce sync.Once
+	condEnv     *cel.Env
+	errCondEnv  error
+)
+
+func conditionEnv() (*cel.Env, error) {
+	condEnvOnce.Do(func() {
+		condEnv, errCondEnv = cel.NewEnv(
+			cel.Variable("inputs", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("steps", cel.MapType(cel.StringType, cel.DynType)),
+			ext.Strings(),
+			ext.Lists(),
+		)
+	})
+	return condEnv, errCondEnv
+}
+
+func compileCondition(expr string) (cel.Program, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, errors.New("if is required")
+	}
+	env, err := conditionEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("if must evaluate to a bool, not %s", ast.OutputType())
+	}
+	return env.Program(ast)
+}
+
+func validateCondition(expr string) error {
+	_, err := compileCondition(expr)
+	return err
+}
+
+func evalCondition(expr string, scope map[string]any) (bool, error) {
+	prg, err := compileCondition(expr)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := prg.Eval(map[string]any{
+		"inputs": scope["inputs"],
+		"steps":  scope["steps"],
+	})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("if returned %s, want bool", out.Type().TypeName())
+	}
+	return result, nil
+}
@@ -0,0 +1,226 @@
+package workflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	maxSteps        = 50
+	maxFanOutItems  = 50
+	maxSourceLength = 64 * 1024
+)
+
+var (
+	stepIDPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+	cronParser    = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+)
+
+// ParseDefinition parses and validates a workflow written in YAML or JSON.
+// JSON is valid YAML, so both go through the YAML decoder and are then
+// mapped onto Definition with its JSON field names.
+func ParseDefinition(source string) (Definition, error) {
+	if strings.TrimSpace(source) == "" {
+		return Definition{}, fmt.Errorf("%w: source is required", ErrInvalidDefinition)
+	}
+	if len(source) > maxSourceLength {
+		return Definition{}, fmt.Errorf("%w: source is longer than %d bytes", ErrInvalidDefinition, maxSourceLength)
+	}
+	var raw any
+	if err := yaml.Unmarshal([]byte(source), &raw); err != nil {
+		return Definition{}, fmt.Errorf("%w: %w", ErrInvalidDefinition, err)
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return Definition{}, fmt.Errorf("%w: %w", ErrInvalidDefinition, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var def Definition
+	if err := dec.Decode(&def); err != nil {
+		return Definition{}, fmt.Errorf("%w: %w", ErrInvalidDefinition, err)
+	}
+	if err := def.Validate(); err != nil {
+		return Definition{}, err
+	}
+	return def, nil
+}
+
+// Validate checks the definition's fields, step references and that the
+// steps form a graph without cycles.
+func (d *Definition) Validate() error {
+	d.Name = strings.TrimSpace(d.Name)
+	d.Schedule = strings.TrimSpace(d.Schedule)
+	if d.Name == "" {
+		return invalidf("name is required")
+	}
+	if d.Schedule != "" {
+		if _, err := cronParser.Parse(d.Schedule); err != nil {
+			return invalidf("schedule: %v", err)
+		}
+	}
+	if len(d.Steps) == 0 {
+		return invalidf("at least one step is required")
+	}
+	if len(d.Steps) > maxSteps {
+		return invalidf("%d steps, the maximum is %d", len(d.Steps), maxSteps)
+	}
+	ids := make(map[string]int, len(d.Steps))
+	for i := range d.Steps {
+		step := &d.Steps[i]
+		step.ID = strings.TrimSpace(step.ID)
+		if !stepIDPattern.MatchString(step.ID) {
+			return invalidf("steps[%d].id %q must be lowercase letters, digits and underscores", i, step.ID)
+		}
+		if _, dup := ids[step.ID]; dup {
+			return invalidf("duplicate step id %q", step.ID)
+		}
+		ids[step.ID] = i
+	}
+	for i := range d.Steps {
+		step := &d.Steps[i]
+		for _, need := range step.Needs {
+			if _, ok := ids[need]; !ok {
+				return invalidf("step %q needs unknown step %q", step.ID, need)
+			}
+			if need == step.ID {
+				return invalidf("step %q needs itself", step.ID)
+			}
+		}
+		if err := validateStep(step, step.ID, false); err != nil {
+			return err
+		}
+	}
+	if cycle := findCycle(d.Steps); cycle != "" {
+		return invalidf("steps form a cycle through %q", cycle)
+	}
+	return nil
+}
+
+func validateStep(step *Step, id string, nested bool) error {
+	step.Type = strings.TrimSpace(step.Type)
+	if step.TimeoutSeconds < 0 {
+		return invalidf("step %q: timeout_seconds must not be negative", id)
+	}
+	switch step.Type {
+	case StepAgent:
+		if strings.TrimSpace(step.Prompt) == "" {
+			return invalidf("step %q: prompt is required", id)
+		}
+	case StepTool:
+		if strings.TrimSpace(step.Tool) == "" {
+			return invalidf("step %q: tool is required", id)
+		}
+	case StepHTTP:
+		step.Method = strings.ToUpper(strings.TrimSpace(step.Method))
+		if step.Method == "" {
+			step.Method = "GET"
+		}
+		if strings.TrimSpace(step.URL) == "" {
+			return invalidf("step %q: url is required", id)
+		}
+		if !strings.Contains(step.URL, "{{") {
+			if err := checkHTTPURL(step.URL); err != nil {
+				return invalidf("step %q: %v", id, err)
+			}
+		}
+	case StepApproval, StepInput, StepCondition, StepFanOut:
+		if nested {
+			return invalidf("step %q: a fan-out can only run agent, tool and http steps", id)
+		}
+		switch step.Type {
+		case StepApproval:
+			if strings.TrimSpace(step.Message) == "" {
+				return invalidf("step %q: message is required", id)
+			}
+		case StepInput:
+			if len(step.Questions) == 0 {
+				return invalidf("step %q: questions are required", id)
+			}
+		case StepCondition:
+			if err := validateCondition(step.If); err != nil {
+				return invalidf("step %q: %v", id, err)
+			}
+		case StepFanOut:
+			if step.Items == nil {
+				return invalidf("step %q: items is required", id)
+			}
+			if items, ok := step.Items.([]any); ok && len(items) > maxFanOutItems {
+				return invalidf("step %q: %d items, the maximum is %d", id, len(items), maxFanOutItems)
+			}
+			if step.Step == nil {
+				return invalidf("step %q: step is required", id)
+			}
+			if err := validateStep(step.Step, id+".step", true); err != nil {
+				return err
+			}
+		}
+	case "":
+		return invalidf("step %q: type is required", id)
+	default:
+		return invalidf("step %q: unknown type %q", id, step.Type)
+	}
+	return nil
+}
+
+func checkHTTPURL(raw string) error {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url scheme must be http or https, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("url host is required")
+	}
+	return nil
+}
+
+// findCycle returns a step on a dependency cycle, or "" when there is none.
+func findCycle(steps []Step) string {
+	needs := make(map[string][]string, len(steps))
+	for _, step := range steps {
+		needs[step.ID] = step.Needs
+	}
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(steps))
+	var visit func(id string) string
+	visit = func(id string) string {
+		switch state[id] {
+		case visiting:
+			return id
+		case done:
+			return ""
+		}
+		state[id] = visiting
+		for _, need := range needs[id] {
+			if cycle := visit(need); cycle != "" {
+				return cycle
+			}
+		}
+		state[id] = done
+		return ""
+	}
+	for _, step := range steps {
+		if cycle := visit(step.ID); cycle != "" {
+			return cycle
+		}
+	}
+	return ""
+}
+
+func invalidf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidDefinition, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,110 @@
+package workflow
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseDefinitionYAMLAndJSON(t *testing.T) {
+	yamlSource := `
+name: Daily digest
+schedule: "0 9 * * *"
+inputs:
+  topic: go
+steps:
+  - id: research
+    type: agent
+    bot: researcher
+    prompt: "Find news about {{ inputs.topic }}"
+  - id: notify
+    type: http
+    needs: [research]
+    url: https://example.com/hook
+    body:
+      text: "{{ steps.research.output.text }}"
+`
+	def, err := ParseDefinition(yamlSource)
+	if err != nil {
+		t.Fatalf("parse yaml: %v", err)
+	}
+	if def.Name != "Daily digest" || def.Schedule != "0 9 * * *" || def.Inputs["topic"] != "go" || len(def.Steps) != 2 {
+		t.Fatalf("definition = %+v", def)
+	}
+	if def.Steps[1].Method != "GET" || def.Steps[1].Needs[0] != "research" {
+		t.Fatalf("http step = %+v", def.Steps[1])
+	}
+
+	jsonSource := `{"name":"json","steps":[{"id":"check","type":"condition","if":"inputs.n > 1"}]}`
+	def, err = ParseDefinition(jsonSource)
+	if err != nil {
+		t.Fatalf("parse json: %v", err)
+	}
+	if def.Steps[0].If != "inputs.n > 1" {
+		t.Fatalf("condition step = %+v", def.Steps[0])
+	}
+}
+
+func TestParseDefinitionRejectsInvalid(t *testing.T) {
+	cases := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{"empty", "", "source is required"},
+		{"unknown field", "name: x\nsteps: [{id: a, type: agent, prompt: hi, colour: red}]", "unknown field"},
+		{"missing name", "steps: [{id: a, type: agent, prompt: hi}]", "name is required"},
+		{"bad schedule", "name: x\nschedule: every day\nsteps: [{id: a, type: agent, prompt: hi}]", "schedule"},
+		{"no steps", "name: x\nsteps: []", "at least one step"},
+		{"bad id", "name: x\nsteps: [{id: Fetch, type: agent, prompt: hi}]", "must be lowercase"},
+		{"duplicate id", "name: x\nsteps: [{id: a, type: agent, prompt: hi}, {id: a, type: agent, prompt: hi}]", "duplicate step id"},
+		{"unknown need", "name: x\nsteps: [{id: a, type: agent, prompt: hi, needs: [b]}]", "unknown step"},
+		{"unknown type", "name: x\nsteps: [{id: a, type: shell}]", "unknown type"},
+		{"agent without prompt", "name: x\nsteps: [{id: a, type: agent}]", "prompt is required"},
+		{"http scheme", "name: x\nsteps: [{id: a, type: http, url: 'ftp://example.com'}]", "scheme"},
+		{"bad condition", "name: x\nsteps: [{id: a, type: condition, if: 'inputs.'}]", "step \"a\""},
+		{"nested approval", "name: x\nsteps: [{id: a, type: fan_out, items: [1], step: {type: approval, message: ok}}]", "fan-out can only run"},
+		{"cycle", "name: x\nsteps: [{id: a, type: agent, prompt: hi, needs: [b]}, {id: b, type: agent, prompt: hi, needs: [a]}]", "cycle"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseDefinition(tc.source)
+			if !errors.Is(err, ErrInvalidDefinition) {
+				t.Fatalf("err = %v, want ErrInvalidDefinition", err)
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("err = %v, want it to mention %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	scope := map[string]any{
+		"inputs": map[string]any{"topic": "go"},
+		"steps": map[string]any{
+			"fetch": map[string]any{"output": map[string]any{"items": []any{"a", "b"}, "count": float64(2)}},
+		},
+	}
+	got, err := render(map[string]any{
+		"list":  "{{ steps.fetch.output.items }}",
+		"first": "{{steps.fetch.output.items.0}}",
+		"text":  "{{ inputs.topic }} has {{ steps.fetch.output.count }} items: {{ steps.fetch.output.items }}",
+	}, scope)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	m := got.(map[string]any)
+	if list, ok := m["list"].([]any); !ok || len(list) != 2 {
+		t.Fatalf("list = %#v", m["list"])
+	}
+	if m["first"] != "a" {
+		t.Fatalf("first = %#v", m["first"])
+	}
+	if m["text"] != `go has 2 items: ["a","b"]` {
+		t.Fatalf("text = %#v", m["text"])
+	}
+	if _, err := renderText("{{ steps.missing.output }}", scope); err == nil {
+		t.Fatal("expected an error for a missing step")
+	}
+}
@@ -0,0 +1,645 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/memohai/memoh/internal/mcp"
+	sessionpkg "github.com/memohai/memoh/internal/session"
+	"github.com/memohai/memoh/internal/toolapproval"
+	"github.com/memohai/memoh/internal/userinput"
+)
+
+const (
+	// workflowSessionType is the runtime session mode of agent steps and
+	// tool calls; it makes them non-interactive.
+	workflowSessionType = "workflow"
+	approvalToolName    = "workflow_approval"
+
+	defaultAgentTimeout = 30 * time.Minute
+	defaultToolTimeout  = 10 * time.Minute
+	defaultHTTPTimeout  = time.Minute
+	maxHTTPResponseSize = 1 << 20
+	fanOutParallelism   = 4
+)
+
+// errShutdown cancels runs when the server stops. Their state is kept so
+// Bootstrap resumes them on the next start.
+var errShutdown = errors.New("workflow service stopped")
+
+// launch executes a run in the background. Cancel stops it through the
+// registered cancel function.
+func (s *Service) launch(run Run) {
+	ctx, cancel := context.WithCancelCause(s.ctx)
+	s.mu.Lock()
+	if _, running := s.active[run.ID]; running {
+		s.mu.Unlock()
+		cancel(nil)
+		return
+	}
+	s.active[run.ID] = cancel
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			s.mu.Lock()
+			delete(s.active, run.ID)
+			s.mu.Unlock()
+			cancel(nil)
+		}()
+		s.execute(ctx, run)
+	}()
+}
+
+// execute walks the step graph of a run. Ready steps run concurrently; a step
+// is ready once all the steps it needs have finished. Steps already finished
+// before a restart keep their recorded state, and steps that were running or
+// waiting start again, picking up the request they were waiting on.
+func (s *Service) execute(ctx context.Context, run Run) {
+	def := run.Definition
+	byID := make(map[string]Step, len(def.Steps))
+	for _, step := range def.Steps {
+		byID[step.ID] = step
+	}
+	states := make(map[string]StepRun, len(run.Steps))
+	failure := ""
+	for _, st := range run.Steps {
+		states[st.StepID] = st
+		if st.Status == StatusFailed && !byID[st.StepID].ContinueOnError && failure == "" {
+			failure = fmt.Sprintf("step %s: %s", st.StepID, st.Error)
+		}
+	}
+	inflight := map[string]bool{}
+	results := make(chan StepRun)
+
+	for {
+		for progressed := failure == "" && ctx.Err() == nil; progressed; {
+			progressed = false
+			for _, step := range def.Steps {
+				if inflight[step.ID] || isFinished(states[step.ID].Status) {
+					continue
+				}
+				ready, skip := readiness(step, byID, states)
+				if !ready {
+					continue
+				}
+				if skip {
+					states[step.ID] = s.saveStep(ctx, run.ID, StepRun{StepID: step.ID, Type: step.Type, Status: StatusSkipped})
+					progressed = true
+					continue
+				}
+				inflight[step.ID] = true
+				scope := runScope(run, states)
+				prev := states[step.ID]
+				go func(step Step) {
+					results <- s.runStep(ctx, run, step, prev, scope)
+				}(step)
+			}
+		}
+		if len(inflight) == 0 {
+			break
+		}
+		st := <-results
+		delete(inflight, st.StepID)
+		states[st.StepID] = st
+		if st.Status == StatusFailed && !byID[st.StepID].ContinueOnError && failure == "" {
+			failure = fmt.Sprintf("step %s: %s", st.StepID, st.Error)
+		}
+	}
+
+	cause := context.Cause(ctx)
+	if errors.Is(cause, errShutdown) {
+		return
+	}
+	status, errText := StatusCompleted, ""
+	switch {
+	case errors.Is(cause, ErrCancelled):
+		status, errText = StatusCancelled, ErrCancelled.Error()
+	case failure != "":
+		status, errText = StatusFailed, failure
+	}
+	s.finishRun(context.WithoutCancel(ctx), run.ID, status, errText)
+}
+
+func isFinished(status string) bool {
+	return status == StatusCompleted || status == StatusFailed || status == StatusSkipped
+}
+
+// readiness reports whether all steps that step needs have finished, and
+// whether step must be skipped because one of them was skipped or was a
+// condition that evaluated to false. A step never becomes ready after a
+// need failed without continue_on_error; the run fails instead.
+func readiness(step Step, byID map[string]Step, states map[string]StepRun) (ready, skip bool) {
+	for _, need := range step.Needs {
+		st := states[need]
+		if !isFinished(st.Status) {
+			return false, false
+		}
+		switch {
+		case st.Status == StatusSkipped:
+			skip = true
+		case st.Status == StatusFailed && !byID[need].ContinueOnError:
+			return false, false
+		case byID[need].Type == StepCondition && st.Status == StatusCompleted && !conditionResult(st.Output):
+			skip = true
+		}
+	}
+	return true, skip
+}
+
+func conditionResult(output any) bool {
+	m, ok := output.(map[string]any)
+	if !ok {
+		return false
+	}
+	result, _ := m["result"].(bool)
+	return result
+}
+
+// runScope is what templates and conditions of a step can read.
+func runScope(run Run, states map[string]StepRun) map[string]any {
+	steps := make(map[string]any, len(states))
+	for id, st := range states {
+		if !isFinished(st.Status) {
+			continue
+		}
+		steps[id] = map[string]any{
+			"status": st.Status,
+			"output": toJSONValue(st.Output),
+			"error":  st.Error,
+		}
+	}
+	inputs := toJSONValue(run.Inputs)
+	if inputs == nil {
+		inputs = map[string]any{}
+	}
+	return map[string]any{
+		"inputs": inputs,
+		"steps":  steps,
+		"run": map[string]any{
+			"id":          run.ID,
+			"workflow_id": run.WorkflowID,
+			"bot_id":      run.BotID,
+			"trigger":     run.Trigger,
+		},
+		"workflow": map[string]any{"name": run.Definition.Name},
+	}
+}
+
+// runStep runs one step and records its state before and after. Steps
+// interrupted by a shutdown are left as they are for the next start.
+func (s *Service) runStep(ctx context.Context, run Run, step Step, prev StepRun, scope map[string]any) StepRun {
+	state := StepRun{
+		StepID:    step.ID,
+		Type:      step.Type,
+		Status:    StatusRunning,
+		Ref:       prev.Ref,
+		SessionID: prev.SessionID,
+	}
+	if prev.Status == StatusWaiting {
+		state.Status = StatusWaiting
+	}
+	state = s.saveStep(ctx, run.ID, state)
+
+	stepCtx := ctx
+	if timeout := stepTimeout(step); timeout > 0 {
+		var cancel context.CancelFunc
+		stepCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	output, err := s.dispatch(stepCtx, run, step, &state, scope)
+	if err != nil && errors.Is(context.Cause(ctx), errShutdown) {
+		return state
+	}
+	if err != nil {
+		state.Status = StatusFailed
+		state.Error = err.Error()
+		if errors.Is(context.Cause(ctx), ErrCancelled) {
+			state.Error = ErrCancelled.Error()
+		}
+		s.logger.Warn("workflow step failed",
+			slog.String("run_id", run.ID),
+			slog.String("step_id", step.ID),
+			slog.Any("error", err),
+		)
+	} else {
+		state.Status = StatusCompleted
+	}
+	state.Output = toJSONValue(output)
+	return s.saveStep(context.WithoutCancel(ctx), run.ID, state)
+}
+
+func stepTimeout(step Step) time.Duration {
+	if step.TimeoutSeconds > 0 {
+		return time.Duration(step.TimeoutSeconds) * time.Second
+	}
+	switch step.Type {
+	case StepAgent:
+		return defaultAgentTimeout
+	case StepTool:
+		return defaultToolTimeout
+	case StepHTTP:
+		return defaultHTTPTimeout
+	default:
+		return 0
+	}
+}
+
+func (s *Service) dispatch(ctx context.Context, run Run, step Step, state *StepRun, scope map[string]any) (any, error) {
+	switch step.Type {
+	case StepAgent:
+		return s.runAgent(ctx, run, step, state, scope)
+	case StepTool:
+		return s.runTool(ctx, run, step, state, scope)
+	case StepHTTP:
+		return s.runHTTP(ctx, run, step, state, scope)
+	case StepApproval:
+		return s.runApproval(ctx, run, step, state, scope)
+	case StepInput:
+		return s.runInput(ctx, run, step, state, scope)
+	case StepCondition:
+		state.Input = map[string]any{"if": step.If}
+		result, err := evalCondition(step.If, scope)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"result": result}, nil
+	case StepFanOut:
+		return s.runFanOut(ctx, run, step, state, scope)
+	default:
+		return nil, fmt.Errorf("unknown step type %q", step.Type)
+	}
+}
+
+func (s *Service) runAgent(ctx context.Context, run Run, step Step, state *StepRun, scope map[string]any) (any, error) {
+	prompt, err := renderText(step.Prompt, scope)
+	if err != nil {
+		return nil, err
+	}
+	botRef, err := renderText(step.Bot, scope)
+	if err != nil {
+		return nil, err
+	}
+	state.Input = map[string]any{"bot": botRef, "prompt": prompt}
+	if s.runner == nil {
+		return nil, errors.New("workflow agent runner not configured")
+	}
+	target, err := s.resolveAgentBot(ctx, run.BotID, botRef)
+	if err != nil {
+		return nil, err
+	}
+	if state.SessionID == "" {
+		sess, err := s.sessions.Create(ctx, sessionpkg.CreateInput{
+			BotID: target.ID,
+			Type:  sessionpkg.TypeChat,
+			Title: truncate("Workflow "+run.Definition.Name+": "+step.ID, sessionTitleMax),
+			Metadata: map[string]any{
+				"workflow_id":     run.WorkflowID,
+				"workflow_run_id": run.ID,
+				"workflow_step":   step.ID,
+			},
+			CreatedByUserID: target.OwnerUserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create step session: %w", err)
+		}
+		state.SessionID = sess.ID
+		*state = s.saveStep(ctx, run.ID, *state)
+	}
+	result, err := s.runner.RunWorkflowStep(ctx, AgentStepInput{
+		RunID:        run.ID,
+		WorkflowName: run.Definition.Name,
+		StepID:       step.ID,
+		BotID:        target.ID,
+		SessionID:    state.SessionID,
+		OwnerUserID:  target.OwnerUserID,
+		Prompt:       prompt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"bot_id":   target.ID,
+		"text":     result.Text,
+		"model_id": result.ModelID,
+		"usage":    result.Usage,
+	}, nil
+}
+
+func (s *Service) runTool(ctx context.Context, run Run, step Step, state *StepRun, scope map[string]any) (any, error) {
+	args, err := render(toJSONValue(step.Arguments), scope)
+	if err != nil {
+		return nil, err
+	}
+	arguments, _ := args.(map[string]any)
+	state.Input = map[string]any{"tool": step.Tool, "arguments": arguments}
+	if s.tools == nil {
+		return nil, errors.New("workflow tool caller not configured")
+	}
+	result, err := s.tools.CallTool(ctx, mcp.ToolSessionContext{
+		BotID:       run.BotID,
+		ChatID:      run.BotID,
+		SessionID:   run.SessionID,
+		SessionType: workflowSessionType,
+		ToolCallID:  requestKey(run.ID, step.ID),
+	}, mcp.ToolCallPayload{Name: step.Tool, Arguments: arguments})
+	if err != nil {
+		return nil, err
+	}
+	if isError, _ := result["isError"].(bool); isError {
+		return result, fmt.Errorf("tool %s failed: %s", step.Tool, toolResultText(result))
+	}
+	if structured, ok := result["structuredContent"]; ok && structured != nil {
+		return structured, nil
+	}
+	return result, nil
+}
+
+func toolResultText(result map[string]any) string {
+	var parts []string
+	content, _ := toJSONValue(result["content"]).([]any)
+	for _, item := range content {
+		if m, ok := item.(map[string]any); ok {
+			if text, ok := m["text"].(string); ok && text != "" {
+				parts = append(parts, text)
+			}
+		}
+	}
+	if len(parts) == 0 {
+		return "unknown error"
+	}
+	return strings.Join(parts, "\n")
+}
+
+func (s *Service) runHTTP(ctx context.Context, run Run, step Step, state *StepRun, scope map[string]any) (any, error) {
+	rawURL, err := renderText(step.URL, scope)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkHTTPURL(rawURL); err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string, len(step.Headers))
+	for key, value := range step.Headers {
+		if headers[key], err = renderText(value, scope); err != nil {
+			return nil, err
+		}
+	}
+	body, err := render(toJSONValue(step.Body), scope)
+	if err != nil {
+		return nil, err
+	}
+	state.Input = map[string]any{"method": step.Method, "url": rawURL, "headers": headers, "body": body}
+
+	var reader io.Reader
+	contentType := ""
+	switch b := body.(type) {
+	case nil:
+	case string:
+		reader = strings.NewReader(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+	req, err := http.NewRequestWithContext(ctx, step.Method, rawURL, reader)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	client := s.httpClient(run.BotID)
+	defer client.CloseIdleConnections()
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	var respBody any = string(data)
+	if strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		var parsed any
+		if err := json.Unmarshal(data, &parsed); err == nil {
+			respBody = parsed
+		}
+	}
+	output := map[string]any{"status": resp.StatusCode, "body": respBody}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return output, fmt.Errorf("%s %s returned %s", step.Method, rawURL, resp.Status)
+	}
+	return output, nil
+}
+
+// httpClient returns a client whose connections go through the egress
+// dialer of botID, redirects included. Proxies from the environment are
+// ignored, as they would dial on the step's behalf unchecked.
+func (s *Service) httpClient(botID string) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		return s.dial(ctx, botID, network, address)
+	}
+	return &http.Client{Transport: transport}
+}
+
+// runApproval asks for a decision through the tool approval flow, in the
+// run's session, and waits for it. The request ID is stored before waiting
+// so a restarted server waits for the same request.
+func (s *Service) runApproval(ctx context.Context, run Run, step Step, state *StepRun, scope map[string]any) (any, error) {
+	message, err := renderText(step.Message, scope)
+	if err != nil {
+		return nil, err
+	}
+	state.Input = map[string]any{"message": message}
+	if s.approvals == nil {
+		return nil, errors.New("workflow approvals not configured")
+	}
+	if state.Ref == "" {
+		req, err := s.approvals.CreatePending(ctx, toolapproval.CreatePendingInput{
+			BotID:       run.BotID,
+			SessionID:   run.SessionID,
+			ToolCallID:  requestKey(run.ID, step.ID),
+			ToolName:    approvalToolName,
+			SessionType: workflowSessionType,
+			ToolInput: map[string]any{
+				"workflow": run.Definition.Name,
+				"run_id":   run.ID,
+				"step":     step.ID,
+				"message":  message,
+			},
+			Workflow: step.Approval,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create approval request: %w", err)
+		}
+		state.Ref = req.ID
+	}
+	state.Status = StatusWaiting
+	*state = s.saveStep(ctx, run.ID, *state)
+
+	req, err := s.approvals.WaitForDecision(ctx, state.Ref)
+	if err != nil {
+		if errors.Is(context.Cause(ctx), ErrCancelled) {
+			_, _ = s.approvals.Dismiss(context.WithoutCancel(ctx), state.Ref, ErrCancelled.Error())
+		}
+		return nil, err
+	}
+	output := map[string]any{"approval_id": req.ID, "status": req.Status, "reason": req.DecisionReason}
+	if req.Status != toolapproval.StatusApproved {
+		return output, fmt.Errorf("approval %s", req.Status)
+	}
+	return output, nil
+}
+
+// runInput asks ask_user style questions in the run's session and waits for
+// the answers.
+func (s *Service) runInput(ctx context.Context, run Run, step Step, state *StepRun, scope map[string]any) (any, error) {
+	questions, err := render(toJSONValue(step.Questions), scope)
+	if err != nil {
+		return nil, err
+	}
+	state.Input = map[string]any{"questions": questions}
+	if s.inputs == nil {
+		return nil, errors.New("workflow user input not configured")
+	}
+	if state.Ref == "" {
+		req, err := s.inputs.CreatePending(ctx, userinput.CreatePendingInput{
+			BotID:      run.BotID,
+			SessionID:  run.SessionID,
+			ToolCallID: requestKey(run.ID, step.ID),
+			ToolName:   userinput.ToolNameAskUser,
+			Input:      map[string]any{"questions": questions},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create user input request: %w", err)
+		}
+		state.Ref = req.ID
+	}
+	state.Status = StatusWaiting
+	*state = s.saveStep(ctx, run.ID, *state)
+
+	req, err := s.inputs.WaitForResponse(ctx, state.Ref)
+	if err != nil {
+		if errors.Is(context.Cause(ctx), ErrCancelled) {
+			_, _ = s.inputs.Cancel(context.WithoutCancel(ctx), userinput.CancelInput{RequestID: state.Ref, Reason: ErrCancelled.Error()})
+		}
+		return nil, err
+	}
+	if req.Status != userinput.StatusSubmitted {
+		return nil, fmt.Errorf("user input %s", req.Status)
+	}
+	return req.Result, nil
+}
+
+// runFanOut runs the nested step once per item, a few at a time. Items are
+// not recorded individually: a fan-out interrupted by a restart runs again.
+func (s *Service) runFanOut(ctx context.Context, run Run, step Step, state *StepRun, scope map[string]any) (any, error) {
+	rendered, err := render(toJSONValue(step.Items), scope)
+	if err != nil {
+		return nil, err
+	}
+	if text, ok := rendered.(string); ok {
+		var parsed []any
+		if err := json.Unmarshal([]byte(text), &parsed); err == nil {
+			rendered = parsed
+		}
+	}
+	items, ok := rendered.([]any)
+	if !ok {
+		return nil, fmt.Errorf("items must resolve to a list, got %T", rendered)
+	}
+	if len(items) > maxFanOutItems {
+		return nil, fmt.Errorf("%d items, the maximum is %d", len(items), maxFanOutItems)
+	}
+	state.Input = map[string]any{"items": items}
+
+	results := make([]any, len(items))
+	failed := 0
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, fanOutParallelism)
+	for i, item := range items {
+		wg.Add(1)
+		go func(i int, item any) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				mu.Lock()
+				results[i] = map[string]any{"status": StatusFailed, "error": ctx.Err().Error()}
+				failed++
+				mu.Unlock()
+				return
+			}
+			defer func() { <-sem }()
+
+			itemScope := make(map[string]any, len(scope)+2)
+			for key, value := range scope {
+				itemScope[key] = value
+			}
+			itemScope["item"] = item
+			itemScope["index"] = i
+			child := *step.Step
+			child.ID = fmt.Sprintf("%s_%d", step.ID, i)
+			itemState := StepRun{StepID: child.ID, Type: child.Type}
+			itemCtx := ctx
+			if timeout := stepTimeout(child); timeout > 0 {
+				var cancel context.CancelFunc
+				itemCtx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			output, err := s.dispatch(itemCtx, run, child, &itemState, itemScope)
+			result := map[string]any{"status": StatusCompleted, "output": toJSONValue(output)}
+			if err != nil {
+				result["status"] = StatusFailed
+				result["error"] = err.Error()
+			}
+			mu.Lock()
+			results[i] = result
+			if err != nil {
+				failed++
+			}
+			mu.Unlock()
+		}(i, item)
+	}
+	wg.Wait()
+	output := map[string]any{"items": results}
+	if failed > 0 {
+		return output, fmt.Errorf("%d of %d items failed", failed, len(items))
+	}
+	return output, nil
+}
+
+// requestKey identifies the tool call, approval or question of a step.
+func requestKey(runID, stepID string) string {
+	return "workflow:" + runID + ":" + stepID
+}
+
+func truncate(text string, limit int) string {
+	if runes := []rune(text); len(runes) > limit {
+		return string(runes[:limit-1]) + "…"
+	}
+	return text
+}
@@ -0,0 +1,705 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/audit"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/db"
+	"github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/network/egress"
+	sessionpkg "github.com/memohai/memoh/internal/session"
+)
+
+const (
+	defaultRunListLimit = 20
+	maxRunListLimit     = 100
+	sessionTitleMax     = 80
+)
+
+// Service stores workflows and executes their runs. Every step's input,
+// output and state is persisted as it changes, so runs left unfinished by a
+// restart are resumed by Bootstrap.
+type Service struct {
+	queries   dbstore.Queries
+	bots      *bots.Service
+	sessions  *sessionpkg.Service
+	runner    AgentRunner
+	tools     ToolCaller
+	approvals Approvals
+	inputs    UserInputs
+	auditLog  *audit.Service
+	scheduler Scheduler
+	dial      func(ctx context.Context, botID, network, address string) (net.Conn, error)
+	logger    *slog.Logger
+
+	ctx    context.Context
+	stop   context.CancelCauseFunc
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	active map[string]context.CancelCauseFunc
+}
+
+func NewService(log *slog.Logger, queries dbstore.Queries, botService *bots.Service, sessions *sessionpkg.Service) *Service {
+	if log == nil {
+		log = slog.Default()
+	}
+	ctx, stop := context.WithCancelCause(context.Background())
+	return &Service{
+		queries:  queries,
+		bots:     botService,
+		sessions: sessions,
+		dial:     directDial(egress.NewExternalDialer()),
+		logger:   log.With(slog.String("service", "workflow")),
+		ctx:      ctx,
+		stop:     stop,
+		active:   map[string]context.CancelCauseFunc{},
+	}
+}
+
+// SetAgentRunner sets the runner that executes agent steps as a bot.
+func (s *Service) SetAgentRunner(runner AgentRunner) {
+	s.runner = runner
+}
+
+// SetEgressDialer sends HTTP steps through the egress policy of the
+// workflow's bot. Without it they still cannot reach internal addresses.
+func (s *Service) SetEgressDialer(d EgressDialer) {
+	s.dial = d.DialExternal
+}
+
+// directDial dials through d, ignoring the bot.
+func directDial(d *net.Dialer) func(ctx context.Context, botID, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, _, network, address string) (net.Conn, error) {
+		return d.DialContext(ctx, network, address)
+	}
+}
+
+// SetToolCaller sets the gateway that executes tool steps.
+func (s *Service) SetToolCaller(tools ToolCaller) {
+	s.tools = tools
+}
+
+// SetApprovals routes approval steps through the tool approval flow.
+func (s *Service) SetApprovals(approvals Approvals) {
+	s.approvals = approvals
+}
+
+// SetUserInputs routes input steps through the ask_user flow.
+func (s *Service) SetUserInputs(inputs UserInputs) {
+	s.inputs = inputs
+}
+
+// SetAuditLog records workflow changes to the audit log.
+func (s *Service) SetAuditLog(auditLog *audit.Service) {
+	s.auditLog = auditLog
+}
+
+// SetScheduler runs workflows with a cron pattern through schedules.
+func (s *Service) SetScheduler(scheduler Scheduler) {
+	s.scheduler = scheduler
+}
+
+// Bootstrap resumes the runs a previous process left running. Cron runs are
+// started by the schedules of the workflows.
+func (s *Service) Bootstrap(ctx context.Context) error {
+	if s.queries == nil {
+		return errors.New("workflow queries not configured")
+	}
+	runs, err := s.queries.ListRunningWorkflowRuns(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range runs {
+		run, err := s.loadRun(ctx, row)
+		if err != nil {
+			s.logger.Warn("resume workflow run failed", slog.String("run_id", uuidString(row.ID)), slog.Any("error", err))
+			continue
+		}
+		s.logger.Info("resuming workflow run", slog.String("run_id", run.ID), slog.String("workflow_id", run.WorkflowID))
+		s.launch(run)
+	}
+	return nil
+}
+
+// Stop interrupts the runs in flight without finishing them and waits for
+// them to return. Bootstrap picks them up again on the next start.
+func (s *Service) Stop() {
+	s.stop(errShutdown)
+	s.wg.Wait()
+}
+
+// Create parses source and stores it as a workflow of botID.
+func (s *Service) Create(ctx context.Context, botID string, req CreateRequest) (Workflow, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return Workflow{}, err
+	}
+	def, err := ParseDefinition(req.Source)
+	if err != nil {
+		return Workflow{}, err
+	}
+	if err := s.checkBots(ctx, botID, def); err != nil {
+		return Workflow{}, err
+	}
+	defJSON, err := json.Marshal(def)
+	if err != nil {
+		return Workflow{}, err
+	}
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	row, err := s.queries.CreateWorkflow(ctx, sqlc.CreateWorkflowParams{
+		BotID:       pgBotID,
+		Name:        def.Name,
+		Description: def.Description,
+		Source:      req.Source,
+		Definition:  defJSON,
+		Pattern:     def.Schedule,
+		Enabled:     enabled,
+	})
+	if err != nil {
+		return Workflow{}, err
+	}
+	wf, err := toWorkflow(row)
+	if err != nil {
+		return Workflow{}, err
+	}
+	if err := s.syncSchedule(ctx, wf); err != nil {
+		return Workflow{}, err
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionWorkflowCreate,
+		BotID:      wf.BotID,
+		TargetType: "workflow",
+		TargetID:   wf.ID,
+		After:      auditState(wf),
+	})
+	return wf, nil
+}
+
+func (s *Service) Get(ctx context.Context, id string) (Workflow, error) {
+	pgID, err := db.ParseUUID(id)
+	if err != nil {
+		return Workflow{}, ErrNotFound
+	}
+	row, err := s.queries.GetWorkflowByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Workflow{}, ErrNotFound
+		}
+		return Workflow{}, err
+	}
+	return toWorkflow(row)
+}
+
+func (s *Service) List(ctx context.Context, botID string) ([]Workflow, error) {
+	pgBotID, err := db.ParseUUID(botID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.ListWorkflowsByBot(ctx, pgBotID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Workflow, 0, len(rows))
+	for _, row := range rows {
+		wf, err := toWorkflow(row)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, wf)
+	}
+	return items, nil
+}
+
+// Update replaces the source or toggles a workflow. Runs in flight keep the
+// definition they started with.
+func (s *Service) Update(ctx context.Context, id string, req UpdateRequest) (Workflow, error) {
+	existing, err := s.Get(ctx, id)
+	if err != nil {
+		return Workflow{}, err
+	}
+	source, def := existing.Source, existing.Definition
+	if req.Source != nil {
+		source = *req.Source
+		if def, err = ParseDefinition(source); err != nil {
+			return Workflow{}, err
+		}
+		if err := s.checkBots(ctx, existing.BotID, def); err != nil {
+			return Workflow{}, err
+		}
+	}
+	enabled := existing.Enabled
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	defJSON, err := json.Marshal(def)
+	if err != nil {
+		return Workflow{}, err
+	}
+	row, err := s.queries.UpdateWorkflow(ctx, sqlc.UpdateWorkflowParams{
+		Name:        def.Name,
+		Description: def.Description,
+		Source:      source,
+		Definition:  defJSON,
+		Pattern:     def.Schedule,
+		Enabled:     enabled,
+		ID:          db.ParseUUIDOrEmpty(existing.ID),
+	})
+	if err != nil {
+		return Workflow{}, err
+	}
+	wf, err := toWorkflow(row)
+	if err != nil {
+		return Workflow{}, err
+	}
+	if err := s.syncSchedule(ctx, wf); err != nil {
+		return Workflow{}, err
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionWorkflowUpdate,
+		BotID:      wf.BotID,
+		TargetType: "workflow",
+		TargetID:   wf.ID,
+		Before:     auditState(existing),
+		After:      auditState(wf),
+	})
+	return wf, nil
+}
+
+// Delete removes a workflow and cancels its runs in flight.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	existing, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if s.scheduler != nil {
+		if err := s.scheduler.RemoveWorkflowSchedule(ctx, existing.ID); err != nil {
+			return err
+		}
+	}
+	s.cancelRunsOf(existing.ID)
+	if err := s.queries.DeleteWorkflow(ctx, db.ParseUUIDOrEmpty(existing.ID)); err != nil {
+		return err
+	}
+	s.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionWorkflowDelete,
+		BotID:      existing.BotID,
+		TargetType: "workflow",
+		TargetID:   existing.ID,
+		Before:     auditState(existing),
+	})
+	return nil
+}
+
+// Trigger starts a run of a workflow. Inputs override the definition's
+// defaults. The run executes in the background; its progress is read with
+// GetRun.
+func (s *Service) Trigger(ctx context.Context, workflowID, trigger, userID string, inputs map[string]any) (Run, error) {
+	wf, err := s.Get(ctx, workflowID)
+	if err != nil {
+		return Run{}, err
+	}
+	bot, err := s.bots.Get(ctx, wf.BotID)
+	if err != nil {
+		return Run{}, err
+	}
+	merged := map[string]any{}
+	maps.Copy(merged, wf.Definition.Inputs)
+	maps.Copy(merged, inputs)
+	inputsJSON, err := json.Marshal(merged)
+	if err != nil {
+		return Run{}, err
+	}
+	defJSON, err := json.Marshal(wf.Definition)
+	if err != nil {
+		return Run{}, err
+	}
+	runID := uuid.New()
+	createdBy := userID
+	if createdBy == "" {
+		createdBy = bot.OwnerUserID
+	}
+	// Approvals and questions of the run are asked in this session.
+	sess, err := s.sessions.Create(ctx, sessionpkg.CreateInput{
+		BotID: wf.BotID,
+		Type:  sessionpkg.TypeChat,
+		Title: truncate("Workflow: "+wf.Name, sessionTitleMax),
+		Metadata: map[string]any{
+			"workflow_id":     wf.ID,
+			"workflow_run_id": runID.String(),
+		},
+		CreatedByUserID: createdBy,
+	})
+	if err != nil {
+		return Run{}, fmt.Errorf("create run session: %w", err)
+	}
+	params := sqlc.CreateWorkflowRunParams{
+		WorkflowID: db.ParseUUIDOrEmpty(wf.ID),
+		BotID:      db.ParseUUIDOrEmpty(wf.BotID),
+		SessionID:  db.ParseUUIDOrEmpty(sess.ID),
+		Trigger:    trigger,
+		Definition: defJSON,
+		Inputs:     inputsJSON,
+	}
+	if userID != "" {
+		params.TriggeredByUserID = db.ParseUUIDOrEmpty(userID)
+	}
+	row, err := s.queries.CreateWorkflowRun(ctx, params)
+	if err != nil {
+		return Run{}, err
+	}
+	run, err := toRun(row)
+	if err != nil {
+		return Run{}, err
+	}
+	s.launch(run)
+	return run, nil
+}
+
+// RunScheduledWorkflow starts a cron run of a workflow for its schedule and
+// waits for it to finish while ctx allows. A run still going when ctx ends,
+// e.g. one waiting on an approval, keeps running and is reported as started.
+func (s *Service) RunScheduledWorkflow(ctx context.Context, workflowID string) (string, error) {
+	run, err := s.Trigger(ctx, workflowID, TriggerCron, "", nil)
+	if err != nil {
+		return "", err
+	}
+	s.waitInactive(ctx, run.ID)
+	run, err = s.GetRun(context.WithoutCancel(ctx), run.ID)
+	if err != nil {
+		return "", err
+	}
+	switch run.Status {
+	case StatusCompleted:
+		return fmt.Sprintf("Workflow run %s completed.", run.ID), nil
+	case StatusRunning:
+		return fmt.Sprintf("Workflow run %s started and is still running.", run.ID), nil
+	case StatusCancelled:
+		return fmt.Sprintf("Workflow run %s was cancelled.", run.ID), nil
+	default:
+		return "", fmt.Errorf("workflow run %s failed: %s", run.ID, run.Error)
+	}
+}
+
+// GetRun returns a run with the recorded state of its steps.
+func (s *Service) GetRun(ctx context.Context, runID string) (Run, error) {
+	pgID, err := db.ParseUUID(runID)
+	if err != nil {
+		return Run{}, ErrRunNotFound
+	}
+	row, err := s.queries.GetWorkflowRunByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Run{}, ErrRunNotFound
+		}
+		return Run{}, err
+	}
+	return s.loadRun(ctx, row)
+}
+
+// ListRuns pages through the runs of a workflow, newest first, without their
+// steps.
+func (s *Service) ListRuns(ctx context.Context, workflowID string, limit, offset int) ([]Run, int64, error) {
+	pgID, err := db.ParseUUID(workflowID)
+	if err != nil {
+		return nil, 0, ErrNotFound
+	}
+	if limit <= 0 {
+		limit = defaultRunListLimit
+	}
+	if limit > maxRunListLimit {
+		limit = maxRunListLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	rows, err := s.queries.ListWorkflowRunsByWorkflow(ctx, sqlc.ListWorkflowRunsByWorkflowParams{
+		WorkflowID: pgID,
+		PageLimit:  int32(limit),  //nolint:gosec // bounded by maxRunListLimit
+		PageOffset: int32(offset), //nolint:gosec // pagination offset
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.queries.CountWorkflowRunsByWorkflow(ctx, pgID)
+	if err != nil {
+		return nil, 0, err
+	}
+	items := make([]Run, 0, len(rows))
+	for _, row := range rows {
+		run, err := toRun(row)
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, run)
+	}
+	return items, total, nil
+}
+
+// CancelRun stops a running run. Pending approvals and questions of its
+// waiting steps are withdrawn.
+func (s *Service) CancelRun(ctx context.Context, runID string) (Run, error) {
+	run, err := s.GetRun(ctx, runID)
+	if err != nil {
+		return Run{}, err
+	}
+	if run.Status != StatusRunning {
+		return Run{}, ErrRunFinished
+	}
+	s.mu.Lock()
+	cancel, inflight := s.active[run.ID]
+	s.mu.Unlock()
+	if inflight {
+		cancel(ErrCancelled)
+		s.waitInactive(ctx, run.ID)
+	} else {
+		s.finishRun(ctx, run.ID, StatusCancelled, ErrCancelled.Error())
+	}
+	return s.GetRun(ctx, run.ID)
+}
+
+func (s *Service) waitInactive(ctx context.Context, runID string) {
+	ticker := time.NewTicker(20 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		s.mu.Lock()
+		_, inflight := s.active[runID]
+		s.mu.Unlock()
+		if !inflight {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) cancelRunsOf(workflowID string) {
+	runs, err := s.queries.ListRunningWorkflowRuns(context.Background())
+	if err != nil {
+		return
+	}
+	for _, row := range runs {
+		if uuidString(row.WorkflowID) != workflowID {
+			continue
+		}
+		s.mu.Lock()
+		cancel, inflight := s.active[uuidString(row.ID)]
+		s.mu.Unlock()
+		if inflight {
+			cancel(ErrCancelled)
+		}
+	}
+}
+
+func (s *Service) finishRun(ctx context.Context, runID, status, errText string) {
+	_, err := s.queries.CompleteWorkflowRun(ctx, sqlc.CompleteWorkflowRunParams{
+		Status: status,
+		Error:  errText,
+		ID:     db.ParseUUIDOrEmpty(runID),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		s.logger.Error("complete workflow run failed", slog.String("run_id", runID), slog.Any("error", err))
+	}
+}
+
+// saveStep persists the state of a step and returns it with the timestamps
+// the database recorded. Errors are logged: a step whose state could not be
+// saved runs again after a restart.
+func (s *Service) saveStep(ctx context.Context, runID string, st StepRun) StepRun {
+	input, err := json.Marshal(st.Input)
+	if err != nil || st.Input == nil {
+		input = []byte("{}")
+	}
+	output, err := json.Marshal(st.Output)
+	if err != nil {
+		output = []byte("null")
+	}
+	params := sqlc.UpsertWorkflowRunStepParams{
+		RunID:  db.ParseUUIDOrEmpty(runID),
+		StepID: st.StepID,
+		Type:   st.Type,
+		Status: st.Status,
+		Input:  input,
+		Output: output,
+		Error:  st.Error,
+		Ref:    st.Ref,
+	}
+	if st.SessionID != "" {
+		params.SessionID = db.ParseUUIDOrEmpty(st.SessionID)
+	}
+	row, err := s.queries.UpsertWorkflowRunStep(ctx, params)
+	if err != nil {
+		s.logger.Error("save workflow step failed",
+			slog.String("run_id", runID),
+			slog.String("step_id", st.StepID),
+			slog.Any("error", err),
+		)
+		return st
+	}
+	return toStepRun(row)
+}
+
+// resolveAgentBot picks the bot an agent step runs as. Steps run as the
+// workflow's bot unless they name another bot, which must have granted it
+// delegation.
+func (s *Service) resolveAgentBot(ctx context.Context, workflowBotID, ref string) (bots.Bot, error) {
+	ref = strings.TrimSpace(ref)
+	own, err := s.bots.Get(ctx, workflowBotID)
+	if err != nil {
+		return bots.Bot{}, err
+	}
+	if ref == "" || ref == own.ID || ref == own.Name {
+		return own, nil
+	}
+	target, err := s.bots.AuthorizeBotDelegation(ctx, own.ID, ref)
+	if err != nil {
+		if errors.Is(err, bots.ErrBotAccessDenied) || errors.Is(err, bots.ErrBotNotFound) {
+			return bots.Bot{}, fmt.Errorf("bot %q is not available to this workflow: %w", ref, err)
+		}
+		return bots.Bot{}, err
+	}
+	return target, nil
+}
+
+// checkBots rejects definitions whose agent steps name bots the workflow's
+// bot may not delegate to. Templated names are checked when the step runs.
+func (s *Service) checkBots(ctx context.Context, botID string, def Definition) error {
+	for _, step := range def.Steps {
+		target := step
+		if step.Type == StepFanOut && step.Step != nil {
+			target = *step.Step
+		}
+		if target.Type != StepAgent || strings.TrimSpace(target.Bot) == "" || strings.Contains(target.Bot, "{{") {
+			continue
+		}
+		if _, err := s.resolveAgentBot(ctx, botID, target.Bot); err != nil {
+			return invalidf("step %q: %v", step.ID, err)
+		}
+	}
+	return nil
+}
+
+// syncSchedule creates, updates or removes the schedule that runs wf on
+// its cron pattern.
+func (s *Service) syncSchedule(ctx context.Context, wf Workflow) error {
+	if s.scheduler == nil {
+		return nil
+	}
+	return s.scheduler.SyncWorkflowSchedule(ctx, wf.BotID, wf.ID, wf.Name, wf.Schedule, wf.Enabled)
+}
+
+func (s *Service) loadRun(ctx context.Context, row sqlc.WorkflowRun) (Run, error) {
+	run, err := toRun(row)
+	if err != nil {
+		return Run{}, err
+	}
+	steps, err := s.queries.ListWorkflowRunSteps(ctx, row.ID)
+	if err != nil {
+		return Run{}, err
+	}
+	run.Steps = make([]StepRun, 0, len(steps))
+	for _, step := range steps {
+		run.Steps = append(run.Steps, toStepRun(step))
+	}
+	return run, nil
+}
+
+func toWorkflow(row sqlc.Workflow) (Workflow, error) {
+	wf := Workflow{
+		ID:          uuidString(row.ID),
+		BotID:       uuidString(row.BotID),
+		Name:        row.Name,
+		Description: row.Description,
+		Schedule:    row.Pattern,
+		Enabled:     row.Enabled,
+		Source:      row.Source,
+		CreatedAt:   db.TimeFromPg(row.CreatedAt),
+		UpdatedAt:   db.TimeFromPg(row.UpdatedAt),
+	}
+	if err := json.Unmarshal(row.Definition, &wf.Definition); err != nil {
+		return Workflow{}, fmt.Errorf("decode workflow definition: %w", err)
+	}
+	return wf, nil
+}
+
+func toRun(row sqlc.WorkflowRun) (Run, error) {
+	run := Run{
+		ID:                uuidString(row.ID),
+		WorkflowID:        uuidString(row.WorkflowID),
+		BotID:             uuidString(row.BotID),
+		SessionID:         uuidString(row.SessionID),
+		Trigger:           row.Trigger,
+		TriggeredByUserID: uuidString(row.TriggeredByUserID),
+		Status:            row.Status,
+		Error:             row.Error,
+		CreatedAt:         db.TimeFromPg(row.CreatedAt),
+	}
+	if err := json.Unmarshal(row.Definition, &run.Definition); err != nil {
+		return Run{}, fmt.Errorf("decode run definition: %w", err)
+	}
+	_ = json.Unmarshal(row.Inputs, &run.Inputs)
+	if run.Inputs == nil {
+		run.Inputs = map[string]any{}
+	}
+	if row.CompletedAt.Valid {
+		completedAt := row.CompletedAt.Time
+		run.CompletedAt = &completedAt
+	}
+	return run, nil
+}
+
+func toStepRun(row sqlc.WorkflowRunStep) StepRun {
+	st := StepRun{
+		StepID:    row.StepID,
+		Type:      row.Type,
+		Status:    row.Status,
+		Error:     row.Error,
+		Ref:       row.Ref,
+		SessionID: uuidString(row.SessionID),
+		StartedAt: db.TimeFromPg(row.StartedAt),
+	}
+	_ = json.Unmarshal(row.Input, &st.Input)
+	_ = json.Unmarshal(row.Output, &st.Output)
+	if row.CompletedAt.Valid {
+		completedAt := row.CompletedAt.Time
+		st.CompletedAt = &completedAt
+	}
+	return st
+}
+
+// auditState is the configured part of a workflow.
+func auditState(wf Workflow) map[string]any {
+	return map[string]any{
+		"name":     wf.Name,
+		"schedule": wf.Schedule,
+		"enabled":  wf.Enabled,
+		"source":   wf.Source,
+	}
+}
+
+func uuidString(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return uuid.UUID(id.Bytes).String()
+}
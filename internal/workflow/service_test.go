@@ -0,0 +1,571 @@
+package workflow
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	embeddeddb "github.com/memohai/memoh/db"
+	"github.com/memohai/memoh/internal/boot"
+	"github.com/memohai/memoh/internal/bots"
+	"github.com/memohai/memoh/internal/config"
+	"github.com/memohai/memoh/internal/db"
+	sqlitestore "github.com/memohai/memoh/internal/db/sqlite/store"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+	"github.com/memohai/memoh/internal/schedule"
+	sessionpkg "github.com/memohai/memoh/internal/session"
+	"github.com/memohai/memoh/internal/toolapproval"
+)
+
+const (
+	testOwnerID = "00000000-0000-0000-0000-0000000000d1"
+	testOtherID = "00000000-0000-0000-0000-0000000000d2"
+
+	testBotA = "00000000-0000-0000-0000-0000000000b1"
+	testBotB = "00000000-0000-0000-0000-0000000000b2"
+	testBotC = "00000000-0000-0000-0000-0000000000b3"
+)
+
+type fakeRunner struct {
+	mu     sync.Mutex
+	inputs []AgentStepInput
+}
+
+func (r *fakeRunner) RunWorkflowStep(_ context.Context, input AgentStepInput) (AgentStepResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.inputs = append(r.inputs, input)
+	return AgentStepResult{Text: "answer to " + input.Prompt, Usage: map[string]any{"inputTokens": float64(5)}}, nil
+}
+
+// fakeApprovals holds every request pending until decide is called.
+type fakeApprovals struct {
+	mu        sync.Mutex
+	created   []toolapproval.CreatePendingInput
+	decisions map[string]string
+	dismissed []string
+}
+
+func (a *fakeApprovals) CreatePending(_ context.Context, input toolapproval.CreatePendingInput) (toolapproval.Request, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.created = append(a.created, input)
+	return toolapproval.Request{ID: input.ToolCallID, Status: toolapproval.StatusPending}, nil
+}
+
+func (a *fakeApprovals) WaitForDecision(ctx context.Context, id string) (toolapproval.Request, error) {
+	for {
+		a.mu.Lock()
+		status := a.decisions[id]
+		a.mu.Unlock()
+		if status != "" {
+			return toolapproval.Request{ID: id, Status: status}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return toolapproval.Request{}, ctx.Err()
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
+
+func (a *fakeApprovals) Dismiss(_ context.Context, id, _ string) (toolapproval.Request, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.dismissed = append(a.dismissed, id)
+	return toolapproval.Request{ID: id, Status: toolapproval.StatusCancelled}, nil
+}
+
+func (a *fakeApprovals) decide(id, status string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.decisions == nil {
+		a.decisions = map[string]string{}
+	}
+	a.decisions[id] = status
+}
+
+func (a *fakeApprovals) createdCount() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.created)
+}
+
+func newSQLiteQueries(t *testing.T) dbstore.Queries {
+	t.Helper()
+	ctx := context.Background()
+	migrations, err := fs.Sub(embeddeddb.MigrationsFS, "sqlite/migrations")
+	if err != nil {
+		t.Fatalf("sqlite migrations fs: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "memoh.db")
+	if err := db.RunMigrateTarget(nil, db.MigrationTarget{Driver: db.DriverSQLite, DSN: "sqlite://" + path}, migrations, "up", nil); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	// Steps of a run write concurrently.
+	conn, err := db.OpenSQLite(ctx, config.SQLiteConfig{DSN: "sqlite://" + path + "?_busy_timeout=5000&_journal_mode=WAL"})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	seed(t, conn)
+	store, err := sqlitestore.New(conn)
+	if err != nil {
+		t.Fatalf("sqlite store: %v", err)
+	}
+	return sqlitestore.NewQueries(store)
+}
+
+func seed(t *testing.T, conn *sql.DB) {
+	t.Helper()
+	stmts := []string{
+		`INSERT INTO users(id,email,role) VALUES('` + testOwnerID + `','owner@example.com','member'),('` + testOtherID + `','other@example.com','member')`,
+		`INSERT INTO bots(id,owner_user_id,type,name,display_name) VALUES
+			('` + testBotA + `','` + testOwnerID + `','personal','alpha','Alpha'),
+			('` + testBotB + `','` + testOtherID + `','personal','bravo','Bravo'),
+			('` + testBotC + `','` + testOwnerID + `','personal','charlie','Charlie')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(context.Background(), stmt); err != nil {
+			t.Fatalf("exec %q: %v", stmt, err)
+		}
+	}
+}
+
+func newTestService(t *testing.T, queries dbstore.Queries) (*Service, *fakeRunner, *fakeApprovals) {
+	t.Helper()
+	svc := NewService(slog.Default(), queries, bots.NewService(slog.Default(), queries), sessionpkg.NewService(slog.Default(), queries))
+	runner := &fakeRunner{}
+	approvals := &fakeApprovals{}
+	svc.SetAgentRunner(runner)
+	svc.SetApprovals(approvals)
+	// The HTTP steps under test call servers on loopback.
+	svc.dial = directDial(&net.Dialer{})
+	t.Cleanup(svc.Stop)
+	return svc, runner, approvals
+}
+
+func waitForRun(t *testing.T, svc *Service, runID string, done func(Run) bool) Run {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		run, err := svc.GetRun(context.Background(), runID)
+		if err != nil {
+			t.Fatalf("get run: %v", err)
+		}
+		if done(run) {
+			return run
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("run did not reach the expected state: %+v", run)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func finished(run Run) bool {
+	return run.Status != StatusRunning
+}
+
+func stepOf(run Run, id string) StepRun {
+	for _, st := range run.Steps {
+		if st.StepID == id {
+			return st
+		}
+	}
+	return StepRun{}
+}
+
+func TestRunExecutesStepGraph(t *testing.T) {
+	var mu sync.Mutex
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, string(body))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	svc, runner, _ := newTestService(t, newSQLiteQueries(t))
+	ctx := context.Background()
+	wf, err := svc.Create(ctx, testBotA, CreateRequest{Source: `
+name: digest
+inputs:
+  topic: go
+  repos: [a, b, c]
+steps:
+  - id: research
+    type: agent
+    bot: charlie
+    prompt: "news about {{ inputs.topic }}"
+  - id: publish
+    type: http
+    method: post
+    needs: [research]
+    url: ` + server.URL + `
+    body:
+      text: "{{ steps.research.output.text }}"
+  - id: many
+    type: condition
+    if: "size(inputs.repos) > 5"
+  - id: alert
+    type: agent
+    needs: [many]
+    prompt: "too many repos"
+  - id: each
+    type: fan_out
+    items: "{{ inputs.repos }}"
+    step:
+      type: http
+      method: post
+      url: ` + server.URL + `
+      body: "repo {{ item }} #{{ index }}"
+`})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	started, err := svc.Trigger(ctx, wf.ID, TriggerManual, testOwnerID, map[string]any{"topic": "rust"})
+	if err != nil {
+		t.Fatalf("trigger: %v", err)
+	}
+	run := waitForRun(t, svc, started.ID, finished)
+	if run.Status != StatusCompleted || run.Inputs["topic"] != "rust" || run.TriggeredByUserID != testOwnerID || run.SessionID == "" {
+		t.Fatalf("run = %+v", run)
+	}
+
+	research := stepOf(run, "research")
+	if research.Status != StatusCompleted || research.SessionID == "" || research.Input["prompt"] != "news about rust" {
+		t.Fatalf("research = %+v", research)
+	}
+	if len(runner.inputs) != 1 || runner.inputs[0].BotID != testBotC || runner.inputs[0].OwnerUserID != testOwnerID {
+		t.Fatalf("runner inputs = %+v", runner.inputs)
+	}
+	if publish := stepOf(run, "publish"); publish.Status != StatusCompleted || publish.Output.(map[string]any)["status"] != float64(200) {
+		t.Fatalf("publish = %+v", publish)
+	}
+	if many := stepOf(run, "many"); many.Status != StatusCompleted || many.Output.(map[string]any)["result"] != false {
+		t.Fatalf("many = %+v", many)
+	}
+	if alert := stepOf(run, "alert"); alert.Status != StatusSkipped {
+		t.Fatalf("alert = %+v", alert)
+	}
+	each := stepOf(run, "each")
+	items, _ := each.Output.(map[string]any)["items"].([]any)
+	if each.Status != StatusCompleted || len(items) != 3 {
+		t.Fatalf("each = %+v", each)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	joined := strings.Join(received, "\n")
+	for _, want := range []string{`{"text":"answer to news about rust"}`, "repo a #0", "repo b #1", "repo c #2"} {
+		if !strings.Contains(joined, want) {
+			t.Fatalf("requests %q do not contain %q", received, want)
+		}
+	}
+
+	runs, total, err := svc.ListRuns(ctx, wf.ID, 0, 0)
+	if err != nil || total != 1 || len(runs) != 1 || runs[0].ID != run.ID {
+		t.Fatalf("list runs = %+v, %d, %v", runs, total, err)
+	}
+}
+
+func TestRunFailsAndStopsAtFailedStep(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "boom", http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	svc, runner, _ := newTestService(t, newSQLiteQueries(t))
+	ctx := context.Background()
+	wf, err := svc.Create(ctx, testBotA, CreateRequest{Source: `
+name: fragile
+steps:
+  - id: fetch
+    type: http
+    url: ` + server.URL + `
+  - id: tolerant
+    type: http
+    url: ` + server.URL + `
+    continue_on_error: true
+  - id: after_tolerant
+    type: agent
+    needs: [tolerant]
+    prompt: "tolerant said {{ steps.tolerant.status }}"
+  - id: summarize
+    type: agent
+    needs: [fetch]
+    prompt: summarize
+`})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	started, err := svc.Trigger(ctx, wf.ID, TriggerManual, testOwnerID, nil)
+	if err != nil {
+		t.Fatalf("trigger: %v", err)
+	}
+	run := waitForRun(t, svc, started.ID, finished)
+	if run.Status != StatusFailed || !strings.Contains(run.Error, "step fetch") || run.CompletedAt == nil {
+		t.Fatalf("run = %+v", run)
+	}
+	if fetch := stepOf(run, "fetch"); fetch.Status != StatusFailed || !strings.Contains(fetch.Error, "502") {
+		t.Fatalf("fetch = %+v", fetch)
+	}
+	if summarize := stepOf(run, "summarize"); summarize.StepID != "" {
+		t.Fatalf("summarize should not run: %+v", summarize)
+	}
+	for _, input := range runner.inputs {
+		if input.StepID == "summarize" {
+			t.Fatalf("summarize ran: %+v", input)
+		}
+	}
+}
+
+func TestHTTPStepRefusesInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("HTTP step reached a loopback server")
+	}))
+	defer server.Close()
+
+	queries := newSQLiteQueries(t)
+	svc := NewService(slog.Default(), queries, bots.NewService(slog.Default(), queries), sessionpkg.NewService(slog.Default(), queries))
+	t.Cleanup(svc.Stop)
+	ctx := context.Background()
+	wf, err := svc.Create(ctx, testBotA, CreateRequest{Source: `
+name: probe
+steps:
+  - id: loopback
+    type: http
+    url: ` + server.URL + `
+    continue_on_error: true
+  - id: metadata
+    type: http
+    url: http://169.254.169.254/latest/meta-data/
+`})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	started, err := svc.Trigger(ctx, wf.ID, TriggerManual, testOwnerID, nil)
+	if err != nil {
+		t.Fatalf("trigger: %v", err)
+	}
+	run := waitForRun(t, svc, started.ID, finished)
+	for _, id := range []string{"loopback", "metadata"} {
+		if step := stepOf(run, id); step.Status != StatusFailed || !strings.Contains(step.Error, "blocked") {
+			t.Fatalf("%s = %+v, want refused", id, step)
+		}
+	}
+}
+
+func TestApprovalStepResumesAfterRestart(t *testing.T) {
+	queries := newSQLiteQueries(t)
+	svc, _, approvals := newTestService(t, queries)
+	ctx := context.Background()
+	wf, err := svc.Create(ctx, testBotA, CreateRequest{Source: `
+name: gated
+steps:
+  - id: approve
+    type: approval
+    message: "Ship {{ inputs.version }}?"
+  - id: ship
+    type: agent
+    needs: [approve]
+    prompt: "ship it"
+`})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	started, err := svc.Trigger(ctx, wf.ID, TriggerManual, testOwnerID, map[string]any{"version": "1.2"})
+	if err != nil {
+		t.Fatalf("trigger: %v", err)
+	}
+	waiting := waitForRun(t, svc, started.ID, func(r Run) bool { return stepOf(r, "approve").Status == StatusWaiting })
+	approve := stepOf(waiting, "approve")
+	if approve.Ref == "" || approve.Input["message"] != "Ship 1.2?" {
+		t.Fatalf("approve = %+v", approve)
+	}
+	if approvals.created[0].SessionID != started.SessionID || approvals.created[0].ToolName != approvalToolName {
+		t.Fatalf("approval request = %+v", approvals.created[0])
+	}
+
+	// A restart leaves the run as it was and the next process resumes it,
+	// waiting on the same approval request.
+	svc.Stop()
+	if run, _ := svc.GetRun(ctx, started.ID); run.Status != StatusRunning {
+		t.Fatalf("run after stop = %+v", run)
+	}
+	restarted, runner, _ := newTestService(t, queries)
+	restarted.SetApprovals(approvals)
+	if err := restarted.Bootstrap(ctx); err != nil {
+		t.Fatalf("bootstrap: %v", err)
+	}
+	approvals.decide(approve.Ref, toolapproval.StatusApproved)
+
+	run := waitForRun(t, restarted, started.ID, finished)
+	if run.Status != StatusCompleted || stepOf(run, "ship").Status != StatusCompleted {
+		t.Fatalf("run = %+v", run)
+	}
+	if approvals.createdCount() != 1 {
+		t.Fatalf("approval requests = %d, want 1", approvals.createdCount())
+	}
+	if len(runner.inputs) != 1 || runner.inputs[0].StepID != "ship" {
+		t.Fatalf("runner inputs = %+v", runner.inputs)
+	}
+}
+
+func TestCancelRunDismissesPendingApproval(t *testing.T) {
+	svc, _, approvals := newTestService(t, newSQLiteQueries(t))
+	ctx := context.Background()
+	wf, err := svc.Create(ctx, testBotA, CreateRequest{Source: `
+name: gated
+steps:
+  - id: approve
+    type: approval
+    message: go?
+`})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	started, err := svc.Trigger(ctx, wf.ID, TriggerManual, testOwnerID, nil)
+	if err != nil {
+		t.Fatalf("trigger: %v", err)
+	}
+	waitForRun(t, svc, started.ID, func(r Run) bool { return stepOf(r, "approve").Status == StatusWaiting })
+
+	run, err := svc.CancelRun(ctx, started.ID)
+	if err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	if run.Status != StatusCancelled || stepOf(run, "approve").Status != StatusFailed {
+		t.Fatalf("run = %+v", run)
+	}
+	if len(approvals.dismissed) != 1 {
+		t.Fatalf("dismissed = %v", approvals.dismissed)
+	}
+	if _, err := svc.CancelRun(ctx, started.ID); !errors.Is(err, ErrRunFinished) {
+		t.Fatalf("second cancel err = %v", err)
+	}
+}
+
+func TestCreateChecksAgentBots(t *testing.T) {
+	svc, _, _ := newTestService(t, newSQLiteQueries(t))
+	ctx := context.Background()
+	_, err := svc.Create(ctx, testBotA, CreateRequest{Source: `
+name: foreign
+steps:
+  - id: ask
+    type: agent
+    bot: bravo
+    prompt: hi
+`})
+	if !errors.Is(err, ErrInvalidDefinition) {
+		t.Fatalf("err = %v, want ErrInvalidDefinition", err)
+	}
+
+	disabled := false
+	wf, err := svc.Create(ctx, testBotA, CreateRequest{Source: "name: own\nschedule: '@daily'\nsteps: [{id: ask, type: agent, bot: alpha, prompt: hi}]", Enabled: &disabled})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if wf.Enabled || wf.Schedule != "@daily" {
+		t.Fatalf("workflow = %+v", wf)
+	}
+	enabled := true
+	updated, err := svc.Update(ctx, wf.ID, UpdateRequest{Enabled: &enabled})
+	if err != nil || !updated.Enabled || updated.Definition.Steps[0].Bot != "alpha" {
+		t.Fatalf("update = %+v, %v", updated, err)
+	}
+	if err := svc.Delete(ctx, wf.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := svc.Get(ctx, wf.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("get after delete err = %v", err)
+	}
+}
+
+// nopTriggerer stands in for the agent; workflow schedules never call it.
+type nopTriggerer struct{}
+
+func (nopTriggerer) TriggerSchedule(context.Context, string, schedule.TriggerPayload, string) (schedule.TriggerResult, error) {
+	return schedule.TriggerResult{}, errors.New("agent triggered for a workflow schedule")
+}
+
+func TestCronWorkflowsRunThroughSchedules(t *testing.T) {
+	queries := newSQLiteQueries(t)
+	svc, runner, _ := newTestService(t, queries)
+	scheduler := schedule.NewService(slog.Default(), queries, nopTriggerer{}, nil, &boot.RuntimeConfig{})
+	svc.SetScheduler(scheduler)
+	scheduler.SetWorkflowRunner(svc)
+	ctx := context.Background()
+
+	disabled := false
+	wf, err := svc.Create(ctx, testBotA, CreateRequest{Source: "name: nightly\nschedule: '@daily'\nsteps: [{id: ask, type: agent, prompt: hi}]", Enabled: &disabled})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	items, err := scheduler.List(ctx, testBotA)
+	if err != nil || len(items) != 1 {
+		t.Fatalf("schedules = %+v, %v", items, err)
+	}
+	sched := items[0]
+	if sched.WorkflowID != wf.ID || sched.Pattern != "@daily" || sched.Enabled {
+		t.Fatalf("schedule = %+v", sched)
+	}
+
+	enabled := true
+	if _, err := svc.Update(ctx, wf.ID, UpdateRequest{Enabled: &enabled}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if sched, err = scheduler.Get(ctx, sched.ID); err != nil || !sched.Enabled {
+		t.Fatalf("schedule after enabling = %+v, %v", sched, err)
+	}
+	pattern := "@hourly"
+	if _, err := scheduler.Update(ctx, sched.ID, schedule.UpdateRequest{Pattern: &pattern}); !errors.Is(err, schedule.ErrManagedByWorkflow) {
+		t.Fatalf("pattern update err = %v, want ErrManagedByWorkflow", err)
+	}
+	if _, err := scheduler.Update(ctx, sched.ID, schedule.UpdateRequest{RetryPolicy: &schedule.RetryPolicy{MaxAttempts: 3}}); err != nil {
+		t.Fatalf("retry policy update: %v", err)
+	}
+	if err := scheduler.Delete(ctx, sched.ID); !errors.Is(err, schedule.ErrManagedByWorkflow) {
+		t.Fatalf("delete err = %v, want ErrManagedByWorkflow", err)
+	}
+
+	if err := scheduler.Trigger(ctx, sched.ID); err != nil {
+		t.Fatalf("trigger schedule: %v", err)
+	}
+	runs, _, err := svc.ListRuns(ctx, wf.ID, 0, 0)
+	if err != nil || len(runs) != 1 || runs[0].Trigger != TriggerCron || runs[0].Status != StatusCompleted {
+		t.Fatalf("runs = %+v, %v", runs, err)
+	}
+	if len(runner.inputs) != 1 {
+		t.Fatalf("agent steps run = %d", len(runner.inputs))
+	}
+	if sched, err = scheduler.Get(ctx, sched.ID); err != nil || sched.CurrentCalls != 1 {
+		t.Fatalf("schedule after run = %+v, %v", sched, err)
+	}
+	if _, logs, err := scheduler.ListLogsBySchedule(ctx, sched.ID, 0, 0); err != nil || logs != 1 {
+		t.Fatalf("schedule logs = %d, %v", logs, err)
+	}
+
+	if err := svc.Delete(ctx, wf.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if items, err := scheduler.List(ctx, testBotA); err != nil || len(items) != 0 {
+		t.Fatalf("schedules after delete = %+v, %v", items, err)
+	}
+}
@@ -0,0 +1,133 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var templatePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z0-9_-]+)*)\s*\}\}`)
+
+// render resolves the templates in value against scope. A string that is a
+// single template is replaced by the referenced value as is, so lists and
+// objects can be passed to tool arguments and fan-outs; templates embedded
+// in longer strings are replaced by their text, with objects as JSON.
+func render(value any, scope map[string]any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return renderString(v, scope)
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			rendered, err := render(item, scope)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = rendered
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			rendered, err := render(item, scope)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = rendered
+		}
+		return out, nil
+	default:
+		return value, nil
+	}
+}
+
+// renderText renders a template string to text.
+func renderText(text string, scope map[string]any) (string, error) {
+	value, err := renderString(text, scope)
+	if err != nil {
+		return "", err
+	}
+	return stringify(value), nil
+}
+
+func renderString(text string, scope map[string]any) (any, error) {
+	if m := templatePattern.FindStringSubmatchIndex(text); m != nil && m[0] == 0 && m[1] == len(text) {
+		return lookup(scope, text[m[2]:m[3]])
+	}
+	var firstErr error
+	out := templatePattern.ReplaceAllStringFunc(text, func(match string) string {
+		path := templatePattern.FindStringSubmatch(match)[1]
+		value, err := lookup(scope, path)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return match
+		}
+		return stringify(value)
+	})
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return out, nil
+}
+
+// lookup resolves a dotted path such as steps.fetch.output.items.0 against
+// scope. Numeric segments index into lists.
+func lookup(scope map[string]any, path string) (any, error) {
+	var current any = scope
+	for _, segment := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[segment]
+			if !ok {
+				return nil, fmt.Errorf("template {{ %s }}: %q not found", path, segment)
+			}
+			current = value
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, fmt.Errorf("template {{ %s }}: no element %q", path, segment)
+			}
+			current = node[idx]
+		default:
+			return nil, fmt.Errorf("template {{ %s }}: cannot read %q of a %T", path, segment, current)
+		}
+	}
+	return current, nil
+}
+
+func stringify(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// toJSONValue round-trips value through JSON so scopes and outputs only hold
+// maps, slices and scalars that render and CEL understand.
+func toJSONValue(value any) any {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,236 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/memohai/memoh/internal/mcp"
+	"github.com/memohai/memoh/internal/settings"
+	"github.com/memohai/memoh/internal/toolapproval"
+	"github.com/memohai/memoh/internal/userinput"
+)
+
+// Step types.
+const (
+	StepAgent     = "agent"
+	StepTool      = "tool"
+	StepHTTP      = "http"
+	StepApproval  = "approval"
+	StepInput     = "input"
+	StepCondition = "condition"
+	StepFanOut    = "fan_out"
+)
+
+// Run and step statuses. Steps that wait for a person are "waiting"; their
+// run stays "running".
+const (
+	StatusRunning   = "running"
+	StatusWaiting   = "waiting"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+	StatusSkipped   = "skipped"
+	StatusCancelled = "cancelled"
+)
+
+// Run triggers.
+const (
+	TriggerManual = "manual"
+	TriggerCron   = "cron"
+)
+
+var (
+	ErrNotFound          = errors.New("workflow not found")
+	ErrRunNotFound       = errors.New("workflow run not found")
+	ErrInvalidDefinition = errors.New("invalid workflow definition")
+	ErrRunFinished       = errors.New("workflow run already finished")
+	ErrCancelled         = errors.New("workflow run cancelled")
+)
+
+// Definition is a workflow as written by its author, in YAML or JSON.
+type Definition struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Schedule is a cron expression that triggers the workflow, in the
+	// timezone of its bot. Empty workflows only run when triggered manually.
+	Schedule string `json:"schedule,omitempty"`
+	// Inputs are default values for the inputs of a run; a manual trigger
+	// may override them.
+	Inputs map[string]any `json:"inputs,omitempty"`
+	Steps  []Step         `json:"steps"`
+}
+
+// Step is one node of the workflow graph. It starts once every step in Needs
+// has finished. String fields may reference earlier results with templates
+// such as {{ steps.scrape.output.text }}, {{ inputs.topic }} or, inside a
+// fan-out, {{ item }}.
+type Step struct {
+	ID    string   `json:"id"`
+	Type  string   `json:"type"`
+	Needs []string `json:"needs,omitempty"`
+	// ContinueOnError lets dependent steps run when this step fails.
+	ContinueOnError bool `json:"continue_on_error,omitempty"`
+	TimeoutSeconds  int  `json:"timeout_seconds,omitempty"`
+
+	// agent: Prompt is run as Bot, which defaults to the workflow's bot.
+	Bot    string `json:"bot,omitempty"`
+	Prompt string `json:"prompt,omitempty"`
+
+	// tool: Tool is called with Arguments in the workflow bot's context.
+	Tool      string         `json:"tool,omitempty"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+
+	// http: a request to URL. Non-string bodies are sent as JSON.
+	Method  string            `json:"method,omitempty"`
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    any               `json:"body,omitempty"`
+
+	// approval: Message is shown to approvers, routed by Approval when set.
+	Message  string                         `json:"message,omitempty"`
+	Approval *settings.ToolApprovalWorkflow `json:"approval,omitempty"`
+
+	// input: ask_user style questions answered by a person.
+	Questions []map[string]any `json:"questions,omitempty"`
+
+	// condition: a CEL expression over inputs and steps. Steps that need a
+	// condition which evaluated to false are skipped.
+	If string `json:"if,omitempty"`
+
+	// fan_out: Step runs once per element of Items, which must resolve to
+	// a list.
+	Items any   `json:"items,omitempty"`
+	Step  *Step `json:"step,omitempty"`
+}
+
+// Workflow is a stored workflow definition.
+type Workflow struct {
+	ID          string     `json:"id"`
+	BotID       string     `json:"bot_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Schedule    string     `json:"schedule,omitempty"`
+	Enabled     bool       `json:"enabled"`
+	Source      string     `json:"source"`
+	Definition  Definition `json:"definition"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type ListResponse struct {
+	Items []Workflow `json:"items"`
+}
+
+// CreateRequest creates a workflow from its YAML or JSON source.
+type CreateRequest struct {
+	Source  string `json:"source"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+type UpdateRequest struct {
+	Source  *string `json:"source,omitempty"`
+	Enabled *bool   `json:"enabled,omitempty"`
+}
+
+// TriggerRequest starts a run by hand. Inputs override the defaults of the
+// definition.
+type TriggerRequest struct {
+	Inputs map[string]any `json:"inputs,omitempty"`
+}
+
+// Run is one execution of a workflow. It keeps a copy of the definition it
+// started with, so editing a workflow does not change runs in flight.
+type Run struct {
+	ID                string         `json:"id"`
+	WorkflowID        string         `json:"workflow_id"`
+	BotID             string         `json:"bot_id"`
+	SessionID         string         `json:"session_id,omitempty"`
+	Trigger           string         `json:"trigger"`
+	TriggeredByUserID string         `json:"triggered_by_user_id,omitempty"`
+	Definition        Definition     `json:"definition"`
+	Inputs            map[string]any `json:"inputs"`
+	Status            string         `json:"status"`
+	Error             string         `json:"error,omitempty"`
+	Steps             []StepRun      `json:"steps,omitempty"`
+	CreatedAt         time.Time      `json:"created_at"`
+	CompletedAt       *time.Time     `json:"completed_at,omitempty"`
+}
+
+// StepRun is the persisted state of one step of a run. Ref holds the ID of
+// the approval or user input request a waiting step is blocked on.
+type StepRun struct {
+	StepID      string         `json:"step_id"`
+	Type        string         `json:"type"`
+	Status      string         `json:"status"`
+	Input       map[string]any `json:"input,omitempty"`
+	Output      any            `json:"output,omitempty"`
+	Error       string         `json:"error,omitempty"`
+	Ref         string         `json:"ref,omitempty"`
+	SessionID   string         `json:"session_id,omitempty"`
+	StartedAt   time.Time      `json:"started_at"`
+	CompletedAt *time.Time     `json:"completed_at,omitempty"`
+}
+
+type RunListResponse struct {
+	Items []Run `json:"items"`
+	Total int64 `json:"total"`
+}
+
+// AgentStepInput is an agent step handed to an AgentRunner.
+type AgentStepInput struct {
+	RunID        string
+	WorkflowName string
+	StepID       string
+	BotID        string
+	SessionID    string
+	OwnerUserID  string
+	Prompt       string
+}
+
+// AgentStepResult is the bot's answer and the cost of producing it.
+type AgentStepResult struct {
+	Text    string
+	ModelID string
+	Usage   map[string]any
+}
+
+// AgentRunner runs an agent step as a bot, with its own workspace, memory
+// and model settings.
+type AgentRunner interface {
+	RunWorkflowStep(ctx context.Context, input AgentStepInput) (AgentStepResult, error)
+}
+
+// EgressDialer dials the destination of an HTTP step on behalf of a bot,
+// under its egress policy and never into internal networks.
+type EgressDialer interface {
+	DialExternal(ctx context.Context, botID, network, address string) (net.Conn, error)
+}
+
+// Scheduler keeps the schedule that runs a workflow on its cron pattern.
+// Cron runs go through the schedule service, so budget checks, retries,
+// dead letters and replays apply to them.
+type Scheduler interface {
+	SyncWorkflowSchedule(ctx context.Context, botID, workflowID, name, pattern string, enabled bool) error
+	RemoveWorkflowSchedule(ctx context.Context, workflowID string) error
+}
+
+// ToolCaller calls a tool in a bot's context.
+type ToolCaller interface {
+	CallTool(ctx context.Context, session mcp.ToolSessionContext, payload mcp.ToolCallPayload) (map[string]any, error)
+}
+
+// Approvals creates the approval requests of approval steps and waits for
+// their decision.
+type Approvals interface {
+	CreatePending(ctx context.Context, input toolapproval.CreatePendingInput) (toolapproval.Request, error)
+	WaitForDecision(ctx context.Context, approvalID string) (toolapproval.Request, error)
+	Dismiss(ctx context.Context, approvalID, reason string) (toolapproval.Request, error)
+}
+
+// UserInputs creates the questions of input steps and waits for answers.
+type UserInputs interface {
+	CreatePending(ctx context.Context, input userinput.CreatePendingInput) (userinput.Request, error)
+	WaitForResponse(ctx context.Context, requestID string) (userinput.Request, error)
+	Cancel(ctx context.Context, input userinput.CancelInput) (userinput.Request, error)
+}
@@ -44,6 +44,9 @@ type Options struct {
 	DataMount         string
 	AllowHostAbsolute bool
 	ReverseHTTP       *ReverseHTTPBroker
+	// EnvFile holds KEY=VALUE lines added to the environment of every exec.
+	// It is read per command so the server can change it while running.
+	EnvFile string
 }
 
 type Server struct {
@@ -53,6 +56,7 @@ type Server struct {
 	dataMount         string
 	allowHostAbsolute bool
 	reverseHTTP       *ReverseHTTPBroker
+	envFile           string
 }
 
 func New(opts Options) *Server {
@@ -76,6 +80,7 @@ func New(opts Options) *Server {
 		dataMount:         filepath.Clean(dataMount),
 		allowHostAbsolute: opts.AllowHostAbsolute,
 		reverseHTTP:       opts.ReverseHTTP,
+		envFile:           strings.TrimSpace(opts.EnvFile),
 	}
 }
 
@@ -377,7 +382,7 @@ func (s *Server) execPTY(stream pb.ContainerService_ExecServer, firstMsg *pb.Exe
 	}
 	configurePTYCommandCancellation(cmd)
 	cmd.Dir = workDir
-	cmd.Env = append(os.Environ(), firstMsg.GetEnv()...)
+	cmd.Env = append(s.execEnv(), firstMsg.GetEnv()...)
 	cmd.Env = append(cmd.Env, "TERM=xterm-256color")
 
 	initialSize := &pty.Winsize{Rows: 24, Cols: 80}
@@ -441,9 +446,7 @@ func (s *Server) execPipe(stream pb.ContainerService_ExecServer, firstMsg *pb.Ex
 	cmd := exec.CommandContext(procCtx, "/bin/sh", "-c", command) //nolint:gosec // G204: intentional agent command execution.
 	configureCommandCancellation(cmd)
 	cmd.Dir = workDir
-	if len(firstMsg.GetEnv()) > 0 {
-		cmd.Env = append(os.Environ(), firstMsg.GetEnv()...)
-	}
+	cmd.Env = append(s.execEnv(), firstMsg.GetEnv()...)
 
 	stdinPipe, err := cmd.StdinPipe()
 	if err != nil {
@@ -690,6 +693,29 @@ func (s *Server) Rename(_ context.Context, req *pb.RenameRequest) (*pb.RenameRes
 	return &pb.RenameResponse{}, nil
 }
 
+// execEnv returns the base environment of an exec: the bridge's own
+// environment plus the entries of the env file, when present.
+func (s *Server) execEnv() []string {
+	env := os.Environ()
+	if s.envFile == "" {
+		return env
+	}
+	f, err := os.Open(s.envFile)
+	if err != nil {
+		return env
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || !strings.Contains(line, "=") {
+			continue
+		}
+		env = append(env, line)
+	}
+	return env
+}
+
 func (s *Server) resolveExecWorkDir(path string) string {
 	if strings.TrimSpace(path) == "" {
 		return s.defaultWorkDir
@@ -4,7 +4,9 @@ package bridgesvc
 
 import (
 	"context"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"syscall"
@@ -49,6 +51,33 @@ func TestExecPipePreservesExitCodeAcrossStreamCancellation(t *testing.T) {
 	}
 }
 
+func TestExecPipeAppliesEnvFile(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), "exec.env")
+	if err := os.WriteFile(envFile, []byte("# proxy\nMEMOH_TEST_PROXY=http://proxy:3128\n"), 0o600); err != nil {
+		t.Fatalf("write env file: %v", err)
+	}
+	stream := newCancelOnStdoutExecStream()
+	srv := New(Options{DefaultWorkDir: "/tmp", AllowHostAbsolute: true, EnvFile: envFile})
+
+	err := srv.execPipe(stream, &pb.ExecInput{
+		Command:        `printf "$MEMOH_TEST_PROXY"; sleep 0.2`,
+		WorkDir:        "/tmp",
+		TimeoutSeconds: 5,
+	})
+	if err != nil {
+		t.Fatalf("execPipe returned error: %v", err)
+	}
+	var stdout string
+	for _, output := range stream.outputs {
+		if output.GetStream() == pb.ExecOutput_STDOUT {
+			stdout += string(output.GetData())
+		}
+	}
+	if stdout != "http://proxy:3128" {
+		t.Fatalf("stdout = %q, want the env file value", stdout)
+	}
+}
+
 func TestExecPipeNegativeTimeoutCancelsProcessOnStreamClose(t *testing.T) {
 	stream := newCancelOnStdoutExecStream()
 	srv := New(Options{DefaultWorkDir: "/tmp", AllowHostAbsolute: true})
@@ -167,6 +167,18 @@ func workspaceSkillDiscoveryRootsFromMetadata(metadata map[string]any) ([]string
 	return normalized, true
 }
 
+// ImagePreferenceFromMetadata returns the workspace image a bot's metadata
+// asks for, or "" when it uses the default image.
+func ImagePreferenceFromMetadata(metadata map[string]any) string {
+	return workspaceImageFromMetadata(metadata)
+}
+
+// WithImagePreference returns a copy of metadata that makes the bot's
+// workspace start from image when it is first created.
+func WithImagePreference(metadata map[string]any, image string) map[string]any {
+	return withWorkspaceImagePreference(metadata, image)
+}
+
 func withWorkspaceImagePreference(metadata map[string]any, image string) map[string]any {
 	next := cloneAnyMap(metadata)
 	section := workspaceSection(next)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/config"
 	ctr "github.com/memohai/memoh/internal/container"
 	"github.com/memohai/memoh/internal/db"
@@ -95,6 +96,7 @@ type Manager struct {
 	grpcPool          *bridge.Pool
 	legacyMu          sync.RWMutex
 	legacyIPs         map[string]string // botID → IP for pre-bridge containers
+	auditLog          *audit.Service
 }
 
 type WorkspaceStartConfig struct {
@@ -186,6 +188,11 @@ func (m *Manager) dialTarget(botID string) string {
 	return "unix://" + m.socketPath(botID)
 }
 
+// SetAuditLog records workspace rollbacks to the audit log.
+func (m *Manager) SetAuditLog(auditLog *audit.Service) {
+	m.auditLog = auditLog
+}
+
 // SetLegacyIP records the IP address of a legacy (pre-bridge) container
 // so the gRPC pool can reach it via TCP.
 func (m *Manager) SetLegacyIP(botID, ip string) {
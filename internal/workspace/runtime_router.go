@@ -226,6 +226,19 @@ func (r *RuntimeRouter) SnapshotMounts(ctx context.Context, snapshotter, key str
 	return mounter.SnapshotMounts(ctx, snapshotter, key)
 }
 
+func (r *RuntimeRouter) RemoveSnapshot(ctx context.Context, snapshotter, key string) error {
+	if strings.TrimSpace(snapshotter) == localRuntimeName {
+		return ctr.ErrNotSupported
+	}
+	remover, ok := r.container.(interface {
+		RemoveSnapshot(context.Context, string, string) error
+	})
+	if !ok {
+		return ctr.ErrNotSupported
+	}
+	return remover.RemoveSnapshot(ctx, snapshotter, key)
+}
+
 func (r *RuntimeRouter) MCPClient(ctx context.Context, botID string) (*bridge.Client, error) {
 	if r.localEnabled() {
 		if _, err := r.local.GetContainer(ctx, LocalContainerPrefix+strings.TrimSpace(botID)); err == nil {
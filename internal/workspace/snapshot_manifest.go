@@ -0,0 +1,340 @@
+package workspace
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	manifestsSubdir = "manifests"
+	// manifestCaptureTimeout bounds the /data listing taken before a
+	// snapshot so an unresponsive bridge never delays the snapshot itself.
+	manifestCaptureTimeout = 30 * time.Second
+	// maxDiffChanges caps the changes a diff returns; the counts stay exact.
+	maxDiffChanges = 5000
+)
+
+const (
+	FileAdded    = "added"
+	FileRemoved  = "removed"
+	FileModified = "modified"
+)
+
+var (
+	ErrVersionNotFound = errors.New("workspace version not found")
+	// ErrManifestUnavailable is returned for versions whose file list was
+	// neither recorded when they were taken nor readable from their archive.
+	ErrManifestUnavailable = errors.New("file list is not available for this version")
+)
+
+// snapshotRemover is the private counterpart of snapshotMountProvider for
+// runtimes that can drop committed snapshots.
+type snapshotRemover interface {
+	RemoveSnapshot(ctx context.Context, snapshotter, key string) error
+}
+
+// FileEntry is one path under /data, relative to it.
+type FileEntry struct {
+	Path    string    `json:"path"`
+	IsDir   bool      `json:"is_dir,omitempty"`
+	Size    int64     `json:"size"`
+	Mode    string    `json:"mode,omitempty"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// FileChange is a file that differs between two file lists.
+type FileChange struct {
+	Path    string `json:"path"`
+	Change  string `json:"change"`
+	OldSize int64  `json:"old_size"`
+	NewSize int64  `json:"new_size"`
+}
+
+// VersionDiff lists the files changed between two versions. To is 0 when the
+// diff is against the live workspace.
+type VersionDiff struct {
+	From      int          `json:"from"`
+	To        int          `json:"to"`
+	Added     int          `json:"added"`
+	Removed   int          `json:"removed"`
+	Modified  int          `json:"modified"`
+	Changes   []FileChange `json:"changes"`
+	Truncated bool         `json:"truncated,omitempty"`
+}
+
+// Empty reports whether the diff found no changed files.
+func (d VersionDiff) Empty() bool {
+	return d.Added == 0 && d.Removed == 0 && d.Modified == 0
+}
+
+// DataManifest lists /data of the running workspace over the bridge.
+func (m *Manager) DataManifest(ctx context.Context, botID string) ([]FileEntry, error) {
+	if err := validateBotID(botID); err != nil {
+		return nil, err
+	}
+	client, err := m.MCPClient(ctx, botID)
+	if err != nil {
+		return nil, fmt.Errorf("grpc connect: %w", err)
+	}
+	entries, err := client.ListDirAll(ctx, containerDataDir, true)
+	if err != nil {
+		return nil, fmt.Errorf("list dir: %w", err)
+	}
+	out := make([]FileEntry, 0, len(entries))
+	for _, entry := range entries {
+		path := strings.TrimPrefix(filepath.ToSlash(entry.GetPath()), "/")
+		if path == "" || path == "." {
+			continue
+		}
+		item := FileEntry{
+			Path:  path,
+			IsDir: entry.GetIsDir(),
+			Size:  entry.GetSize(),
+			Mode:  entry.GetMode(),
+		}
+		if t, err := time.Parse(time.RFC3339, entry.GetModTime()); err == nil {
+			item.ModTime = t
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// VersionManifest returns the file list of a version: the one recorded when
+// it was taken, or for archive snapshots the archive's own listing.
+func (m *Manager) VersionManifest(ctx context.Context, botID string, version int) ([]FileEntry, error) {
+	if m.queries == nil {
+		return nil, errors.New("db is not configured")
+	}
+	if err := validateBotID(botID); err != nil {
+		return nil, err
+	}
+	target, err := m.lockedVersion(ctx, m.resolveContainerID(ctx, botID), version)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := m.readVersionManifest(target.ID)
+	if err == nil {
+		return entries, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if strings.HasPrefix(target.RuntimeSnapshotName, archivePrefix) {
+		entries, err := listArchiveManifest(m.archiveSnapshotPath(target.RuntimeSnapshotName))
+		if err == nil {
+			return entries, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, ErrManifestUnavailable
+}
+
+// DiffVersions lists the files changed from version from to version to, or
+// to the live workspace when to is 0.
+func (m *Manager) DiffVersions(ctx context.Context, botID string, from, to int) (*VersionDiff, error) {
+	if from < 1 || to < 0 {
+		return nil, errors.New("version out of range")
+	}
+	older, err := m.VersionManifest(ctx, botID, from)
+	if err != nil {
+		return nil, err
+	}
+	var newer []FileEntry
+	if to == 0 {
+		newer, err = m.DataManifest(ctx, botID)
+	} else {
+		newer, err = m.VersionManifest(ctx, botID, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+	diff := DiffManifests(older, newer)
+	diff.From = from
+	diff.To = to
+	return &diff, nil
+}
+
+// DiffManifests compares two file lists. Directories are skipped; a file is
+// modified when its size, mode or modification time (to the second) differ.
+func DiffManifests(older, newer []FileEntry) VersionDiff {
+	before := make(map[string]FileEntry, len(older))
+	for _, entry := range older {
+		if !entry.IsDir {
+			before[entry.Path] = entry
+		}
+	}
+	diff := VersionDiff{Changes: []FileChange{}}
+	for _, entry := range newer {
+		if entry.IsDir {
+			continue
+		}
+		prev, ok := before[entry.Path]
+		delete(before, entry.Path)
+		switch {
+		case !ok:
+			diff.Added++
+			diff.Changes = append(diff.Changes, FileChange{Path: entry.Path, Change: FileAdded, NewSize: entry.Size})
+		case fileChanged(prev, entry):
+			diff.Modified++
+			diff.Changes = append(diff.Changes, FileChange{Path: entry.Path, Change: FileModified, OldSize: prev.Size, NewSize: entry.Size})
+		}
+	}
+	for _, entry := range before {
+		diff.Removed++
+		diff.Changes = append(diff.Changes, FileChange{Path: entry.Path, Change: FileRemoved, OldSize: entry.Size})
+	}
+	slices.SortFunc(diff.Changes, func(a, b FileChange) int { return strings.Compare(a.Path, b.Path) })
+	if len(diff.Changes) > maxDiffChanges {
+		diff.Changes = diff.Changes[:maxDiffChanges]
+		diff.Truncated = true
+	}
+	return diff
+}
+
+func fileChanged(a, b FileEntry) bool {
+	if a.Size != b.Size {
+		return true
+	}
+	if a.Mode != "" && b.Mode != "" && a.Mode != b.Mode {
+		return true
+	}
+	if a.ModTime.IsZero() || b.ModTime.IsZero() {
+		return false
+	}
+	return !a.ModTime.Truncate(time.Second).Equal(b.ModTime.Truncate(time.Second))
+}
+
+// captureDataManifest lists /data before a snapshot is taken. It is
+// best-effort: a stopped workspace simply yields a version without a list.
+func (m *Manager) captureDataManifest(ctx context.Context, botID string) []FileEntry {
+	ctx, cancel := context.WithTimeout(ctx, manifestCaptureTimeout)
+	defer cancel()
+	entries, err := m.DataManifest(ctx, botID)
+	if err != nil {
+		m.logger.Debug("snapshot file list unavailable", slog.String("bot_id", botID), slog.Any("error", err))
+		return nil
+	}
+	return entries
+}
+
+func (m *Manager) versionManifestPath(versionID string) string {
+	return filepath.Join(m.dataRoot(), snapshotsSubdir, manifestsSubdir, versionID+".json.gz")
+}
+
+func (m *Manager) storeVersionManifest(versionID string, entries []FileEntry) {
+	if versionID == "" || entries == nil {
+		return
+	}
+	if err := m.writeVersionManifest(versionID, entries); err != nil {
+		m.logger.Warn("store snapshot file list failed", slog.String("version_id", versionID), slog.Any("error", err))
+	}
+}
+
+func (m *Manager) writeVersionManifest(versionID string, entries []FileEntry) error {
+	path := m.versionManifestPath(versionID)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	f, err := os.Create(path) //nolint:gosec // G304: path is derived from a version ID
+	if err != nil {
+		return err
+	}
+	gw := gzip.NewWriter(f)
+	if err := json.NewEncoder(gw).Encode(entries); err != nil {
+		_ = gw.Close()
+		_ = f.Close()
+		_ = os.Remove(path)
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return err
+	}
+	return f.Close()
+}
+
+func (m *Manager) readVersionManifest(versionID string) ([]FileEntry, error) {
+	if versionID == "" {
+		return nil, fs.ErrNotExist
+	}
+	f, err := os.Open(m.versionManifestPath(versionID)) //nolint:gosec // G304: path is derived from a version ID
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = gr.Close() }()
+	var entries []FileEntry
+	if err := json.NewDecoder(gr).Decode(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (m *Manager) removeVersionManifest(versionID string) {
+	if versionID == "" {
+		return
+	}
+	if err := os.Remove(m.versionManifestPath(versionID)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		m.logger.Warn("remove snapshot file list failed", slog.String("version_id", versionID), slog.Any("error", err))
+	}
+}
+
+// listArchiveManifest reads the file list of an archive snapshot from its
+// tar headers.
+func listArchiveManifest(path string) ([]FileEntry, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: archive path is derived from managed snapshot metadata
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("gzip reader: %w", err)
+	}
+	defer func() { _ = gr.Close() }()
+	tr := tar.NewReader(gr)
+	var entries []FileEntry
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tar next: %w", err)
+		}
+		name, err := sanitizeArchivePath(header.Name)
+		if err != nil || name == "" {
+			continue
+		}
+		if header.Typeflag != tar.TypeDir && header.Typeflag != tar.TypeReg {
+			continue
+		}
+		entries = append(entries, FileEntry{
+			Path:    filepath.ToSlash(name),
+			IsDir:   header.Typeflag == tar.TypeDir,
+			Size:    header.Size,
+			Mode:    header.FileInfo().Mode().String(),
+			ModTime: header.ModTime,
+		})
+	}
+}
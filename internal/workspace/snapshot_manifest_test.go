@@ -0,0 +1,93 @@
+package workspace
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestDiffManifests(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	older := []FileEntry{
+		{Path: "project", IsDir: true},
+		{Path: "project/main.go", Size: 10, Mode: "-rw-r--r--", ModTime: at},
+		{Path: "project/go.mod", Size: 5, Mode: "-rw-r--r--", ModTime: at},
+		{Path: "notes.txt", Size: 3, Mode: "-rw-r--r--", ModTime: at},
+		{Path: "run.sh", Size: 7, Mode: "-rw-r--r--", ModTime: at},
+	}
+	newer := []FileEntry{
+		{Path: "project/main.go", Size: 10, Mode: "-rw-r--r--", ModTime: at.Add(400 * time.Millisecond)},
+		{Path: "notes.txt", Size: 4, Mode: "-rw-r--r--", ModTime: at.Add(time.Minute)},
+		{Path: "run.sh", Size: 7, Mode: "-rwxr-xr-x", ModTime: at},
+		{Path: "todo.md", Size: 2, Mode: "-rw-r--r--", ModTime: at},
+		{Path: "cache", IsDir: true},
+	}
+
+	diff := DiffManifests(older, newer)
+	if diff.Added != 1 || diff.Removed != 1 || diff.Modified != 2 {
+		t.Fatalf("counts = +%d -%d ~%d, want +1 -1 ~2", diff.Added, diff.Removed, diff.Modified)
+	}
+	want := []FileChange{
+		{Path: "notes.txt", Change: FileModified, OldSize: 3, NewSize: 4},
+		{Path: "project/go.mod", Change: FileRemoved, OldSize: 5},
+		{Path: "run.sh", Change: FileModified, OldSize: 7, NewSize: 7},
+		{Path: "todo.md", Change: FileAdded, NewSize: 2},
+	}
+	if len(diff.Changes) != len(want) {
+		t.Fatalf("changes = %+v, want %+v", diff.Changes, want)
+	}
+	for i := range want {
+		if diff.Changes[i] != want[i] {
+			t.Fatalf("change %d = %+v, want %+v", i, diff.Changes[i], want[i])
+		}
+	}
+	if !DiffManifests(newer, newer).Empty() {
+		t.Fatal("identical file lists should not differ")
+	}
+}
+
+func TestDiffManifestsTruncates(t *testing.T) {
+	t.Parallel()
+
+	newer := make([]FileEntry, 0, maxDiffChanges+10)
+	for i := range maxDiffChanges + 10 {
+		newer = append(newer, FileEntry{Path: "f/" + strconv.Itoa(i)})
+	}
+	diff := DiffManifests(nil, newer)
+	if !diff.Truncated || len(diff.Changes) != maxDiffChanges || diff.Added != maxDiffChanges+10 {
+		t.Fatalf("diff = %d changes, %d added, truncated %v", len(diff.Changes), diff.Added, diff.Truncated)
+	}
+}
+
+func TestListArchiveManifestMatchesDataDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "project"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "project", "main.go"), []byte("package main"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tarGzDir(&buf, dir); err != nil {
+		t.Fatalf("tarGzDir() error = %v", err)
+	}
+	archive := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	if err := os.WriteFile(archive, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := listArchiveManifest(archive)
+	if err != nil {
+		t.Fatalf("listArchiveManifest() error = %v", err)
+	}
+	if len(entries) != 2 || !entries[0].IsDir || entries[1].Path != "project/main.go" || entries[1].Size != int64(len("package main")) {
+		t.Fatalf("entries = %+v", entries)
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
+	"log/slog"
 	"math"
+	"os"
 	"strings"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/memohai/memoh/internal/audit"
 	"github.com/memohai/memoh/internal/config"
 	ctr "github.com/memohai/memoh/internal/container"
 	"github.com/memohai/memoh/internal/db"
@@ -24,6 +28,11 @@ const (
 	SnapshotSourceManual   = "manual"
 	SnapshotSourcePreExec  = "pre_exec"
 	SnapshotSourceRollback = "rollback"
+	// SnapshotSourceScheduled marks snapshots taken by a bot's snapshot
+	// policy; SnapshotSourcePreSkillInstall marks ones taken before a skill
+	// was installed into the workspace.
+	SnapshotSourceScheduled       = "scheduled"
+	SnapshotSourcePreSkillInstall = "pre_skill_install"
 )
 
 type VersionInfo struct {
@@ -32,6 +41,8 @@ type VersionInfo struct {
 	SnapshotName        string
 	RuntimeSnapshotName string
 	DisplayName         string
+	Snapshotter         string
+	Source              string
 	CreatedAt           time.Time
 }
 
@@ -69,6 +80,7 @@ func (m *Manager) CreateSnapshot(ctx context.Context, botID, snapshotName, sourc
 	if err := validateBotID(botID); err != nil {
 		return nil, err
 	}
+	manifest := m.captureDataManifest(ctx, botID)
 
 	ref, err := m.loadLockedContainer(ctx, botID)
 	if err != nil {
@@ -108,31 +120,77 @@ func (m *Manager) CreateSnapshot(ctx context.Context, botID, snapshotName, sourc
 		}
 	}
 
-	_, versionNumber, createdAt, err := m.recordSnapshotVersion(
-		dctx,
-		containerID,
+	return m.recordCreatedSnapshot(dctx, ref, runtimeSnapshotName, displayName, snapshotter, normalizedSource, manifest)
+}
+
+// CreateDataSnapshot records a version holding an archive of /data read over
+// the bridge. Unlike CreateSnapshot it never stops, commits or replaces the
+// container, so exec tasks and servers in the workspace keep running; the
+// price is that files written while the archive is taken may be caught
+// mid-change. Each archive stands on its own, so deleting any version frees
+// its space. Snapshot policies take their automatic snapshots this way.
+func (m *Manager) CreateDataSnapshot(ctx context.Context, botID, snapshotName, source string) (*SnapshotCreateInfo, error) {
+	if m.queries == nil {
+		return nil, errors.New("db is not configured")
+	}
+	if err := validateBotID(botID); err != nil {
+		return nil, err
+	}
+	manifest := m.captureDataManifest(ctx, botID)
+
+	ref, err := m.loadLockedContainer(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+	defer ref.Close()
+	if err := ref.EnsureDBRecords(ctx); err != nil {
+		return nil, err
+	}
+
+	runtimeSnapshotName := m.archiveSnapshotKey(botID)
+	if err := m.preserveDataViaGRPC(ctx, botID, m.archiveSnapshotPath(runtimeSnapshotName)); err != nil {
+		return nil, fmt.Errorf("archive workspace data: %w", err)
+	}
+	snapshot, err := m.recordCreatedSnapshot(context.WithoutCancel(ctx), ref, runtimeSnapshotName, strings.TrimSpace(snapshotName), "archive", normalizeSnapshotSource(source), manifest)
+	if err != nil {
+		if rmErr := os.Remove(m.archiveSnapshotPath(runtimeSnapshotName)); rmErr != nil && !errors.Is(rmErr, fs.ErrNotExist) {
+			m.logger.Warn("remove unrecorded archive snapshot failed",
+				slog.String("bot_id", botID), slog.String("snapshot", runtimeSnapshotName), slog.Any("error", rmErr))
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// recordCreatedSnapshot records a new snapshot as the next version of the
+// container, with its file list and lifecycle event.
+func (m *Manager) recordCreatedSnapshot(ctx context.Context, ref *lockedContainerRef, runtimeSnapshotName, displayName, snapshotter, source string, manifest []FileEntry) (*SnapshotCreateInfo, error) {
+	versionID, versionNumber, createdAt, err := m.recordSnapshotVersion(
+		ctx,
+		ref.containerID,
 		runtimeSnapshotName,
 		displayName,
-		info.StorageRef.Key,
+		ref.info.StorageRef.Key,
 		snapshotter,
-		normalizedSource,
+		source,
 	)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.insertEvent(dctx, containerID, "snapshot_create", map[string]any{
+	m.storeVersionManifest(versionID, manifest)
+	if err := m.insertEvent(ctx, ref.containerID, "snapshot_create", map[string]any{
 		"snapshot_name":         coalesceSnapshotName(displayName, versionNumber),
 		"display_name":          displayName,
 		"runtime_snapshot_name": runtimeSnapshotName,
 		"snapshotter":           snapshotter,
-		"source":                normalizedSource,
+		"source":                source,
 		"version":               versionNumber,
 	}); err != nil {
 		return nil, err
 	}
 
 	return &SnapshotCreateInfo{
-		ContainerID:         containerID,
+		ContainerID:         ref.containerID,
 		SnapshotName:        coalesceSnapshotName(displayName, versionNumber),
 		RuntimeSnapshotName: runtimeSnapshotName,
 		DisplayName:         displayName,
@@ -149,6 +207,7 @@ func (m *Manager) CreateVersion(ctx context.Context, botID string) (*VersionInfo
 	if err := validateBotID(botID); err != nil {
 		return nil, err
 	}
+	manifest := m.captureDataManifest(ctx, botID)
 
 	ref, err := m.loadLockedContainer(ctx, botID)
 	if err != nil {
@@ -191,6 +250,7 @@ func (m *Manager) CreateVersion(ctx context.Context, botID string) (*VersionInfo
 	if err != nil {
 		return nil, err
 	}
+	m.storeVersionManifest(versionID, manifest)
 
 	if err := m.insertEvent(dctx, containerID, "version_create", map[string]any{
 		"snapshot_name": versionSnapshotName,
@@ -206,6 +266,8 @@ func (m *Manager) CreateVersion(ctx context.Context, botID string) (*VersionInfo
 		SnapshotName:        fmt.Sprintf("Version %d", versionNumber),
 		RuntimeSnapshotName: versionSnapshotName,
 		DisplayName:         "",
+		Snapshotter:         archiveAwareSnapshotter(info.StorageRef.Driver, versionSnapshotName),
+		Source:              SnapshotSourcePreExec,
 		CreatedAt:           createdAt,
 	}, nil
 }
@@ -321,6 +383,8 @@ func (m *Manager) ListVersions(ctx context.Context, botID string) ([]VersionInfo
 			SnapshotName:        coalesceSnapshotName(row.DisplayName.String, int(row.Version)),
 			RuntimeSnapshotName: row.RuntimeSnapshotName,
 			DisplayName:         strings.TrimSpace(row.DisplayName.String),
+			Snapshotter:         strings.TrimSpace(row.Snapshotter),
+			Source:              normalizeSnapshotSource(row.Source),
 			CreatedAt:           createdAt,
 		})
 	}
@@ -358,6 +422,7 @@ func (m *Manager) RollbackVersion(ctx context.Context, botID string, version int
 		if err := m.restoreArchiveSnapshotFromRef(dctx, ref, snapshotName); err != nil {
 			return err
 		}
+		m.recordRollback(dctx, botID, version, snapshotName)
 		return m.insertEvent(dctx, ref.containerID, "version_rollback", map[string]any{
 			"snapshot_name": snapshotName,
 			"version":       version,
@@ -372,6 +437,7 @@ func (m *Manager) RollbackVersion(ctx context.Context, botID string, version int
 	if err := m.replaceLockedContainerFromSnapshot(dctx, ref, "rollback", snapshotName); err != nil {
 		return err
 	}
+	m.recordRollback(dctx, botID, version, snapshotName)
 
 	return m.insertEvent(dctx, ref.containerID, "version_rollback", map[string]any{
 		"snapshot_name": snapshotName,
@@ -380,6 +446,100 @@ func (m *Manager) RollbackVersion(ctx context.Context, botID string, version int
 	})
 }
 
+func (m *Manager) recordRollback(ctx context.Context, botID string, version int, snapshotName string) {
+	m.auditLog.Record(ctx, audit.Entry{
+		Action:     audit.ActionWorkspaceRollback,
+		BotID:      botID,
+		TargetType: "workspace_version",
+		TargetID:   fmt.Sprintf("%d", version),
+		After:      map[string]any{"version": version, "snapshot_name": snapshotName},
+	})
+}
+
+// DeleteVersion removes a version together with its archive or runtime
+// snapshot and recorded file manifest. A native snapshot newer snapshots, or
+// the running container, are built on stays in the runtime, and in the
+// snapshot list, until they are gone; archives are always removed.
+func (m *Manager) DeleteVersion(ctx context.Context, botID string, version int) error {
+	if m.queries == nil {
+		return errors.New("db is not configured")
+	}
+	if err := validateBotID(botID); err != nil {
+		return err
+	}
+	if version < 1 || version > math.MaxInt32 {
+		return errors.New("version out of range")
+	}
+
+	ref, err := m.loadLockedContainer(ctx, botID)
+	if err != nil {
+		return err
+	}
+	defer ref.Close()
+
+	target, err := m.lockedVersion(ctx, ref.containerID, version)
+	if err != nil {
+		return err
+	}
+
+	dctx := context.WithoutCancel(ctx)
+	snapshotID, err := m.queries.DeleteVersion(dctx, dbsqlc.DeleteVersionParams{
+		ContainerID: ref.containerID,
+		Version:     int32(version),
+	})
+	if err != nil {
+		return err
+	}
+	m.removeVersionManifest(target.ID)
+
+	removed := true
+	if strings.HasPrefix(target.RuntimeSnapshotName, archivePrefix) {
+		if err := os.Remove(m.archiveSnapshotPath(target.RuntimeSnapshotName)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			m.logger.Warn("remove archive snapshot failed",
+				slog.String("bot_id", botID), slog.String("snapshot", target.RuntimeSnapshotName), slog.Any("error", err))
+		}
+	} else if remover, ok := m.service.(snapshotRemover); ok {
+		if err := remover.RemoveSnapshot(dctx, target.Snapshotter, target.RuntimeSnapshotName); err != nil && !ctr.IsNotFound(err) {
+			removed = false
+			m.logger.Debug("runtime snapshot kept",
+				slog.String("bot_id", botID), slog.String("snapshot", target.RuntimeSnapshotName), slog.Any("error", err))
+		}
+	}
+	if removed {
+		if err := m.queries.DeleteUnversionedSnapshot(dctx, snapshotID); err != nil {
+			return err
+		}
+	}
+
+	return m.insertEvent(dctx, ref.containerID, "version_delete", map[string]any{
+		"snapshot_name":    target.RuntimeSnapshotName,
+		"version":          version,
+		"snapshot_removed": removed,
+	})
+}
+
+// lockedVersion looks up one version of a container. Callers hold the
+// container lock.
+func (m *Manager) lockedVersion(ctx context.Context, containerID string, version int) (VersionInfo, error) {
+	rows, err := m.queries.ListVersionsByContainerID(ctx, containerID)
+	if err != nil {
+		return VersionInfo{}, err
+	}
+	for _, row := range rows {
+		if int(row.Version) != version {
+			continue
+		}
+		return VersionInfo{
+			ID:                  uuidString(row.ID),
+			Version:             version,
+			RuntimeSnapshotName: strings.TrimSpace(row.RuntimeSnapshotName),
+			Snapshotter:         strings.TrimSpace(row.Snapshotter),
+			Source:              normalizeSnapshotSource(row.Source),
+		}, nil
+	}
+	return VersionInfo{}, ErrVersionNotFound
+}
+
 func (m *Manager) VersionSnapshotName(ctx context.Context, botID string, version int) (string, error) {
 	if m.queries == nil {
 		return "", errors.New("db is not configured")
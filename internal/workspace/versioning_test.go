@@ -0,0 +1,98 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/memohai/memoh/internal/config"
+	ctr "github.com/memohai/memoh/internal/container"
+	dbsqlc "github.com/memohai/memoh/internal/db/postgres/sqlc"
+	dbstore "github.com/memohai/memoh/internal/db/store"
+)
+
+type versionTestQueries struct {
+	dbstore.Queries
+	containerID string
+	versions    []dbsqlc.ListVersionsByContainerIDRow
+	removed     []pgtype.UUID
+}
+
+func (q *versionTestQueries) GetContainerByBotID(context.Context, pgtype.UUID) (dbsqlc.Container, error) {
+	return dbsqlc.Container{ContainerID: q.containerID}, nil
+}
+
+func (q *versionTestQueries) ListVersionsByContainerID(context.Context, string) ([]dbsqlc.ListVersionsByContainerIDRow, error) {
+	return q.versions, nil
+}
+
+func (q *versionTestQueries) DeleteVersion(_ context.Context, arg dbsqlc.DeleteVersionParams) (pgtype.UUID, error) {
+	for i, row := range q.versions {
+		if row.Version == arg.Version {
+			q.versions = append(q.versions[:i], q.versions[i+1:]...)
+			return row.SnapshotID, nil
+		}
+	}
+	return pgtype.UUID{}, errors.New("version not found")
+}
+
+func (q *versionTestQueries) DeleteUnversionedSnapshot(_ context.Context, id pgtype.UUID) error {
+	q.removed = append(q.removed, id)
+	return nil
+}
+
+func (*versionTestQueries) InsertLifecycleEvent(context.Context, dbsqlc.InsertLifecycleEventParams) error {
+	return nil
+}
+
+func TestDeleteVersionRemovesArchiveOfOlderAutomaticVersion(t *testing.T) {
+	const botID = "00000000-0000-0000-0000-000000000001"
+	containerID := ContainerPrefix + botID
+	svc := &legacyRouteTestService{created: true, container: ctr.ContainerInfo{ID: containerID}}
+	m := newLegacyRouteTestManager(t, svc, config.WorkspaceConfig{DataRoot: t.TempDir()})
+
+	queries := &versionTestQueries{containerID: containerID}
+	for i := 1; i <= 3; i++ {
+		key := archivePrefix + botID + "/" + strconv.Itoa(i) + ".tar.gz"
+		path := m.archiveSnapshotPath(key)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("archive"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		queries.versions = append(queries.versions, dbsqlc.ListVersionsByContainerIDRow{
+			ID:                  pgtype.UUID{Bytes: [16]byte{byte(i)}, Valid: true},
+			ContainerID:         containerID,
+			SnapshotID:          pgtype.UUID{Bytes: [16]byte{0xff, byte(i)}, Valid: true},
+			Version:             int32(i), //nolint:gosec // small test values
+			RuntimeSnapshotName: key,
+			Snapshotter:         "archive",
+			Source:              SnapshotSourceScheduled,
+		})
+	}
+	m.queries = queries
+	older := queries.versions[0]
+	newer := []string{queries.versions[1].RuntimeSnapshotName, queries.versions[2].RuntimeSnapshotName}
+
+	// Version 1 is the base of the history, not its newest leaf.
+	if err := m.DeleteVersion(context.Background(), botID, 1); err != nil {
+		t.Fatalf("DeleteVersion() error = %v", err)
+	}
+	if _, err := os.Stat(m.archiveSnapshotPath(older.RuntimeSnapshotName)); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("archive of version 1 still on disk: %v", err)
+	}
+	if len(queries.removed) != 1 || queries.removed[0] != older.SnapshotID {
+		t.Fatalf("removed snapshots = %v, want the one of version 1", queries.removed)
+	}
+	for _, key := range newer {
+		if _, err := os.Stat(m.archiveSnapshotPath(key)); err != nil {
+			t.Fatalf("archive %s of a kept version: %v", key, err)
+		}
+	}
+}
@@ -2,7 +2,7 @@
 
 import { type Client, formDataBodySerializer, type Options as Options2, type TDataShape } from './client';
 import { client } from './client.gen';
-import type { DeleteBotsByBotIdAclRulesByRuleIdData, DeleteBotsByBotIdAclRulesByRuleIdErrors, DeleteBotsByBotIdAclRulesByRuleIdResponses, DeleteBotsByBotIdAcpRuntimesByRuntimeIdData, DeleteBotsByBotIdAcpRuntimesByRuntimeIdErrors, DeleteBotsByBotIdAcpRuntimesByRuntimeIdResponses, DeleteBotsByBotIdCompactionLogsData, DeleteBotsByBotIdCompactionLogsErrors, DeleteBotsByBotIdCompactionLogsResponses, DeleteBotsByBotIdContainerBrowserSessionsBySessionIdData, DeleteBotsByBotIdContainerBrowserSessionsBySessionIdErrors, DeleteBotsByBotIdContainerBrowserSessionsBySessionIdResponses, DeleteBotsByBotIdContainerData, DeleteBotsByBotIdContainerDisplaySessionsBySessionIdData, DeleteBotsByBotIdContainerDisplaySessionsBySessionIdErrors, DeleteBotsByBotIdContainerDisplaySessionsBySessionIdResponses, DeleteBotsByBotIdContainerErrors, DeleteBotsByBotIdContainerResponses, DeleteBotsByBotIdContainerSkillsData, DeleteBotsByBotIdContainerSkillsErrors, DeleteBotsByBotIdContainerSkillsResponses, DeleteBotsByBotIdEmailBindingsByIdData, DeleteBotsByBotIdEmailBindingsByIdErrors, DeleteBotsByBotIdEmailBindingsByIdResponses, DeleteBotsByBotIdHeartbeatLogsData, DeleteBotsByBotIdHeartbeatLogsErrors, DeleteBotsByBotIdHeartbeatLogsResponses, DeleteBotsByBotIdMcpByIdData, DeleteBotsByBotIdMcpByIdErrors, DeleteBotsByBotIdMcpByIdOauthTokenData, DeleteBotsByBotIdMcpByIdOauthTokenErrors, DeleteBotsByBotIdMcpByIdOauthTokenResponses, DeleteBotsByBotIdMcpByIdResponses, DeleteBotsByBotIdMemoryByIdData, DeleteBotsByBotIdMemoryByIdErrors, DeleteBotsByBotIdMemoryByIdResponses, DeleteBotsByBotIdMemoryData, DeleteBotsByBotIdMemoryErrors, DeleteBotsByBotIdMemoryResponses, DeleteBotsByBotIdMessagesData, DeleteBotsByBotIdMessagesErrors, DeleteBotsByBotIdMessagesResponses, DeleteBotsByBotIdPluginsByIdData, DeleteBotsByBotIdPluginsByIdErrors, DeleteBotsByBotIdPluginsByIdResponses, DeleteBotsByBotIdScheduleByIdData, DeleteBotsByBotIdScheduleByIdErrors, DeleteBotsByBotIdScheduleByIdResponses, DeleteBotsByBotIdScheduleLogsData, DeleteBotsByBotIdScheduleLogsErrors, DeleteBotsByBotIdScheduleLogsResponses, DeleteBotsByBotIdSessionsBySessionIdData, DeleteBotsByBotIdSessionsBySessionIdErrors, DeleteBotsByBotIdSessionsBySessionIdResponses, DeleteBotsByBotIdSettingsData, DeleteBotsByBotIdSettingsErrors, DeleteBotsByBotIdSettingsResponses, DeleteBotsByBotIdUserAccessByGrantIdData, DeleteBotsByBotIdUserAccessByGrantIdErrors, DeleteBotsByBotIdUserAccessByGrantIdResponses, DeleteBotsByIdChannelByPlatformData, DeleteBotsByIdChannelByPlatformErrors, DeleteBotsByIdChannelByPlatformResponses, DeleteBotsByIdData, DeleteBotsByIdErrors, DeleteBotsByIdResponses, DeleteEmailProvidersByIdData, DeleteEmailProvidersByIdErrors, DeleteEmailProvidersByIdOauthTokenData, DeleteEmailProvidersByIdOauthTokenErrors, DeleteEmailProvidersByIdOauthTokenResponses, DeleteEmailProvidersByIdResponses, DeleteMemoryProvidersByIdData, DeleteMemoryProvidersByIdErrors, DeleteMemoryProvidersByIdResponses, DeleteModelsByIdData, DeleteModelsByIdErrors, DeleteModelsByIdResponses, DeleteModelsModelByModelIdData, DeleteModelsModelByModelIdErrors, DeleteModelsModelByModelIdResponses, DeleteProvidersByIdData, DeleteProvidersByIdErrors, DeleteProvidersByIdOauthTokenData, DeleteProvidersByIdOauthTokenErrors, DeleteProvidersByIdOauthTokenResponses, DeleteProvidersByIdResponses, DeleteSearchProvidersByIdData, DeleteSearchProvidersByIdErrors, DeleteSearchProvidersByIdResponses, DeleteUsersByIdData, DeleteUsersByIdErrors, DeleteUsersByIdResponses, GetAcpProfilesData, GetAcpProfilesResponses, GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsData, GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsErrors, GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponses, GetBotsByBotIdAclChannelIdentitiesData, GetBotsByBotIdAclChannelIdentitiesErrors, GetBotsByBotIdAclChannelIdentitiesResponses, GetBotsByBotIdAclChannelTypesByChannelTypeConversationsData, GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors, GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponses, GetBotsByBotIdAclDefaultEffectData, GetBotsByBotIdAclDefaultEffectErrors, GetBotsByBotIdAclDefaultEffectResponses, GetBotsByBotIdAclRulesData, GetBotsByBotIdAclRulesErrors, GetBotsByBotIdAclRulesResponses, GetBotsByBotIdAcpClaudeCodeOauthAuthorizeData, GetBotsByBotIdAcpClaudeCodeOauthAuthorizeErrors, GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponses, GetBotsByBotIdAcpClaudeCodeOauthStatusData, GetBotsByBotIdAcpClaudeCodeOauthStatusErrors, GetBotsByBotIdAcpClaudeCodeOauthStatusResponses, GetBotsByBotIdAcpRuntimesByRuntimeIdData, GetBotsByBotIdAcpRuntimesByRuntimeIdErrors, GetBotsByBotIdAcpRuntimesByRuntimeIdResponses, GetBotsByBotIdBackupSummaryData, GetBotsByBotIdBackupSummaryErrors, GetBotsByBotIdBackupSummaryResponses, GetBotsByBotIdCompactionLogsData, GetBotsByBotIdCompactionLogsErrors, GetBotsByBotIdCompactionLogsResponses, GetBotsByBotIdContainerData, GetBotsByBotIdContainerDisplayData, GetBotsByBotIdContainerDisplayErrors, GetBotsByBotIdContainerDisplayResponses, GetBotsByBotIdContainerDisplaySessionsData, GetBotsByBotIdContainerDisplaySessionsErrors, GetBotsByBotIdContainerDisplaySessionsResponses, GetBotsByBotIdContainerErrors, GetBotsByBotIdContainerFsData, GetBotsByBotIdContainerFsDownloadData, GetBotsByBotIdContainerFsDownloadErrors, GetBotsByBotIdContainerFsDownloadResponses, GetBotsByBotIdContainerFsErrors, GetBotsByBotIdContainerFsListData, GetBotsByBotIdContainerFsListErrors, GetBotsByBotIdContainerFsListResponses, GetBotsByBotIdContainerFsReadData, GetBotsByBotIdContainerFsReadErrors, GetBotsByBotIdContainerFsReadResponses, GetBotsByBotIdContainerFsResponses, GetBotsByBotIdContainerMetricsData, GetBotsByBotIdContainerMetricsErrors, GetBotsByBotIdContainerMetricsResponses, GetBotsByBotIdContainerResponses, GetBotsByBotIdContainerSkillsData, GetBotsByBotIdContainerSkillsErrors, GetBotsByBotIdContainerSkillsResponses, GetBotsByBotIdContainerSnapshotsData, GetBotsByBotIdContainerSnapshotsErrors, GetBotsByBotIdContainerSnapshotsResponses, GetBotsByBotIdContainerTerminalData, GetBotsByBotIdContainerTerminalErrors, GetBotsByBotIdContainerTerminalResponses, GetBotsByBotIdContainerTerminalWsData, GetBotsByBotIdContainerTerminalWsErrors, GetBotsByBotIdEmailBindingsData, GetBotsByBotIdEmailBindingsErrors, GetBotsByBotIdEmailBindingsResponses, GetBotsByBotIdEmailOutboxByIdData, GetBotsByBotIdEmailOutboxByIdErrors, GetBotsByBotIdEmailOutboxByIdResponses, GetBotsByBotIdEmailOutboxData, GetBotsByBotIdEmailOutboxErrors, GetBotsByBotIdEmailOutboxResponses, GetBotsByBotIdHeartbeatLogsData, GetBotsByBotIdHeartbeatLogsErrors, GetBotsByBotIdHeartbeatLogsResponses, GetBotsByBotIdLocalStreamData, GetBotsByBotIdLocalStreamErrors, GetBotsByBotIdLocalStreamResponses, GetBotsByBotIdLocalWsData, GetBotsByBotIdLocalWsErrors, GetBotsByBotIdMcpByIdData, GetBotsByBotIdMcpByIdErrors, GetBotsByBotIdMcpByIdOauthStatusData, GetBotsByBotIdMcpByIdOauthStatusErrors, GetBotsByBotIdMcpByIdOauthStatusResponses, GetBotsByBotIdMcpByIdResponses, GetBotsByBotIdMcpData, GetBotsByBotIdMcpErrors, GetBotsByBotIdMcpExportData, GetBotsByBotIdMcpExportErrors, GetBotsByBotIdMcpExportResponses, GetBotsByBotIdMcpResponses, GetBotsByBotIdMemoryData, GetBotsByBotIdMemoryErrors, GetBotsByBotIdMemoryResponses, GetBotsByBotIdMemoryStatusData, GetBotsByBotIdMemoryStatusErrors, GetBotsByBotIdMemoryStatusResponses, GetBotsByBotIdMemoryUsageData, GetBotsByBotIdMemoryUsageErrors, GetBotsByBotIdMemoryUsageResponses, GetBotsByBotIdMessagesData, GetBotsByBotIdMessagesErrors, GetBotsByBotIdMessagesLocateData, GetBotsByBotIdMessagesLocateErrors, GetBotsByBotIdMessagesLocateResponses, GetBotsByBotIdMessagesResponses, GetBotsByBotIdPluginsByIdData, GetBotsByBotIdPluginsByIdErrors, GetBotsByBotIdPluginsByIdOauthStatusData, GetBotsByBotIdPluginsByIdOauthStatusErrors, GetBotsByBotIdPluginsByIdOauthStatusResponses, GetBotsByBotIdPluginsByIdResponses, GetBotsByBotIdPluginsData, GetBotsByBotIdPluginsErrors, GetBotsByBotIdPluginsResponses, GetBotsByBotIdScheduleByIdData, GetBotsByBotIdScheduleByIdErrors, GetBotsByBotIdScheduleByIdLogsData, GetBotsByBotIdScheduleByIdLogsErrors, GetBotsByBotIdScheduleByIdLogsResponses, GetBotsByBotIdScheduleByIdResponses, GetBotsByBotIdScheduleData, GetBotsByBotIdScheduleErrors, GetBotsByBotIdScheduleLogsData, GetBotsByBotIdScheduleLogsErrors, GetBotsByBotIdScheduleLogsResponses, GetBotsByBotIdScheduleResponses, GetBotsByBotIdSessionsBySessionIdAcpRuntimeData, GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors, GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponses, GetBotsByBotIdSessionsBySessionIdData, GetBotsByBotIdSessionsBySessionIdErrors, GetBotsByBotIdSessionsBySessionIdResponses, GetBotsByBotIdSessionsBySessionIdStatusData, GetBotsByBotIdSessionsBySessionIdStatusErrors, GetBotsByBotIdSessionsBySessionIdStatusResponses, GetBotsByBotIdSessionsData, GetBotsByBotIdSessionsErrors, GetBotsByBotIdSessionsResponses, GetBotsByBotIdSettingsData, GetBotsByBotIdSettingsErrors, GetBotsByBotIdSettingsResponses, GetBotsByBotIdTokenUsageData, GetBotsByBotIdTokenUsageErrors, GetBotsByBotIdTokenUsageRecordsData, GetBotsByBotIdTokenUsageRecordsErrors, GetBotsByBotIdTokenUsageRecordsResponses, GetBotsByBotIdTokenUsageResponses, GetBotsByBotIdUserAccessCandidatesData, GetBotsByBotIdUserAccessCandidatesErrors, GetBotsByBotIdUserAccessCandidatesResponses, GetBotsByBotIdUserAccessData, GetBotsByBotIdUserAccessErrors, GetBotsByBotIdUserAccessResponses, GetBotsByIdChannelByPlatformData, GetBotsByIdChannelByPlatformErrors, GetBotsByIdChannelByPlatformResponses, GetBotsByIdChecksData, GetBotsByIdChecksErrors, GetBotsByIdChecksResponses, GetBotsByIdData, GetBotsByIdErrors, GetBotsByIdResponses, GetBotsData, GetBotsErrors, GetBotsNameAvailabilityData, GetBotsNameAvailabilityErrors, GetBotsNameAvailabilityResponses, GetBotsResponses, GetChannelsByPlatformData, GetChannelsByPlatformErrors, GetChannelsByPlatformResponses, GetChannelsData, GetChannelsErrors, GetChannelsResponses, GetEmailOauthCallbackData, GetEmailOauthCallbackErrors, GetEmailOauthCallbackResponses, GetEmailProvidersByIdData, GetEmailProvidersByIdErrors, GetEmailProvidersByIdOauthAuthorizeData, GetEmailProvidersByIdOauthAuthorizeErrors, GetEmailProvidersByIdOauthAuthorizeResponses, GetEmailProvidersByIdOauthStatusData, GetEmailProvidersByIdOauthStatusErrors, GetEmailProvidersByIdOauthStatusResponses, GetEmailProvidersByIdResponses, GetEmailProvidersData, GetEmailProvidersErrors, GetEmailProvidersMetaData, GetEmailProvidersMetaResponses, GetEmailProvidersResponses, GetMemoryProvidersByIdData, GetMemoryProvidersByIdErrors, GetMemoryProvidersByIdResponses, GetMemoryProvidersByIdStatusData, GetMemoryProvidersByIdStatusErrors, GetMemoryProvidersByIdStatusResponses, GetMemoryProvidersData, GetMemoryProvidersErrors, GetMemoryProvidersMetaData, GetMemoryProvidersMetaResponses, GetMemoryProvidersResponses, GetModelsByIdData, GetModelsByIdErrors, GetModelsByIdResponses, GetModelsCountData, GetModelsCountErrors, GetModelsCountResponses, GetModelsData, GetModelsErrors, GetModelsModelByModelIdData, GetModelsModelByModelIdErrors, GetModelsModelByModelIdResponses, GetModelsResponses, GetOauthMcpCallbackData, GetOauthMcpCallbackErrors, GetOauthMcpCallbackResponses, GetPingData, GetPingResponses, GetProvidersByIdData, GetProvidersByIdErrors, GetProvidersByIdModelsData, GetProvidersByIdModelsErrors, GetProvidersByIdModelsResponses, GetProvidersByIdOauthAuthorizeData, GetProvidersByIdOauthAuthorizeErrors, GetProvidersByIdOauthAuthorizeResponses, GetProvidersByIdOauthStatusData, GetProvidersByIdOauthStatusErrors, GetProvidersByIdOauthStatusResponses, GetProvidersByIdResponses, GetProvidersCountData, GetProvidersCountErrors, GetProvidersCountResponses, GetProvidersData, GetProvidersErrors, GetProvidersNameByNameData, GetProvidersNameByNameErrors, GetProvidersNameByNameResponses, GetProvidersOauthCallbackData, GetProvidersOauthCallbackErrors, GetProvidersOauthCallbackResponses, GetProvidersResponses, GetSearchProvidersByIdData, GetSearchProvidersByIdErrors, GetSearchProvidersByIdResponses, GetSearchProvidersData, GetSearchProvidersErrors, GetSearchProvidersMetaData, GetSearchProvidersMetaResponses, GetSearchProvidersResponses, GetSpeechModelsByIdCapabilitiesData, GetSpeechModelsByIdCapabilitiesErrors, GetSpeechModelsByIdCapabilitiesResponses, GetSpeechModelsByIdData, GetSpeechModelsByIdErrors, GetSpeechModelsByIdResponses, GetSpeechModelsData, GetSpeechModelsErrors, GetSpeechModelsResponses, GetSpeechProvidersByIdData, GetSpeechProvidersByIdErrors, GetSpeechProvidersByIdModelsData, GetSpeechProvidersByIdModelsErrors, GetSpeechProvidersByIdModelsResponses, GetSpeechProvidersByIdResponses, GetSpeechProvidersData, GetSpeechProvidersErrors, GetSpeechProvidersMetaData, GetSpeechProvidersMetaResponses, GetSpeechProvidersResponses, GetSupermarketPluginsByIdData, GetSupermarketPluginsByIdErrors, GetSupermarketPluginsByIdResponses, GetSupermarketPluginsData, GetSupermarketPluginsErrors, GetSupermarketPluginsResponses, GetSupermarketSkillsByIdData, GetSupermarketSkillsByIdErrors, GetSupermarketSkillsByIdResponses, GetSupermarketSkillsData, GetSupermarketSkillsErrors, GetSupermarketSkillsResponses, GetSupermarketTagsData, GetSupermarketTagsErrors, GetSupermarketTagsResponses, GetTranscriptionModelsByIdCapabilitiesData, GetTranscriptionModelsByIdCapabilitiesErrors, GetTranscriptionModelsByIdCapabilitiesResponses, GetTranscriptionModelsByIdData, GetTranscriptionModelsByIdErrors, GetTranscriptionModelsByIdResponses, GetTranscriptionModelsData, GetTranscriptionModelsErrors, GetTranscriptionModelsResponses, GetTranscriptionProvidersByIdData, GetTranscriptionProvidersByIdErrors, GetTranscriptionProvidersByIdModelsData, GetTranscriptionProvidersByIdModelsErrors, GetTranscriptionProvidersByIdModelsResponses, GetTranscriptionProvidersByIdResponses, GetTranscriptionProvidersData, GetTranscriptionProvidersErrors, GetTranscriptionProvidersMetaData, GetTranscriptionProvidersMetaResponses, GetTranscriptionProvidersResponses, GetUsersByIdData, GetUsersByIdErrors, GetUsersByIdResponses, GetUsersData, GetUsersErrors, GetUsersMeChannelsByPlatformData, GetUsersMeChannelsByPlatformErrors, GetUsersMeChannelsByPlatformResponses, GetUsersMeData, GetUsersMeErrors, GetUsersMeResponses, GetUsersResponses, PatchBotsByBotIdAcpRuntimesByRuntimeIdModelData, PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors, PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponses, PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelData, PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors, PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponses, PatchBotsByBotIdSessionsBySessionIdData, PatchBotsByBotIdSessionsBySessionIdErrors, PatchBotsByBotIdSessionsBySessionIdResponses, PatchBotsByIdChannelByPlatformStatusData, PatchBotsByIdChannelByPlatformStatusErrors, PatchBotsByIdChannelByPlatformStatusResponses, PostAuthLoginData, PostAuthLoginErrors, PostAuthLoginResponses, PostAuthRefreshData, PostAuthRefreshErrors, PostAuthRefreshResponses, PostBotsBackupImportData, PostBotsBackupImportErrors, PostBotsBackupImportPreviewData, PostBotsBackupImportPreviewErrors, PostBotsBackupImportPreviewResponses, PostBotsBackupImportResponses, PostBotsByBotIdAclRulesData, PostBotsByBotIdAclRulesErrors, PostBotsByBotIdAclRulesResponses, PostBotsByBotIdAcpClaudeCodeOauthExchangeData, PostBotsByBotIdAcpClaudeCodeOauthExchangeErrors, PostBotsByBotIdAcpClaudeCodeOauthExchangeResponses, PostBotsByBotIdAcpRuntimesData, PostBotsByBotIdAcpRuntimesErrors, PostBotsByBotIdAcpRuntimesResponses, PostBotsByBotIdBackupExportData, PostBotsByBotIdBackupExportErrors, PostBotsByBotIdBackupExportResponses, PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveData, PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveErrors, PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponses, PostBotsByBotIdContainerBrowserSessionsData, PostBotsByBotIdContainerBrowserSessionsErrors, PostBotsByBotIdContainerBrowserSessionsResponses, PostBotsByBotIdContainerData, PostBotsByBotIdContainerDataRestoreData, PostBotsByBotIdContainerDataRestoreErrors, PostBotsByBotIdContainerDataRestoreResponses, PostBotsByBotIdContainerDisplayPrepareData, PostBotsByBotIdContainerDisplayPrepareErrors, PostBotsByBotIdContainerDisplayPrepareResponses, PostBotsByBotIdContainerDisplayWebrtcOfferData, PostBotsByBotIdContainerDisplayWebrtcOfferErrors, PostBotsByBotIdContainerDisplayWebrtcOfferResponses, PostBotsByBotIdContainerErrors, PostBotsByBotIdContainerFsArchiveData, PostBotsByBotIdContainerFsArchiveErrors, PostBotsByBotIdContainerFsArchiveResponses, PostBotsByBotIdContainerFsDeleteData, PostBotsByBotIdContainerFsDeleteErrors, PostBotsByBotIdContainerFsDeleteResponses, PostBotsByBotIdContainerFsExtractData, PostBotsByBotIdContainerFsExtractErrors, PostBotsByBotIdContainerFsExtractResponses, PostBotsByBotIdContainerFsMkdirData, PostBotsByBotIdContainerFsMkdirErrors, PostBotsByBotIdContainerFsMkdirResponses, PostBotsByBotIdContainerFsRenameData, PostBotsByBotIdContainerFsRenameErrors, PostBotsByBotIdContainerFsRenameResponses, PostBotsByBotIdContainerFsUploadData, PostBotsByBotIdContainerFsUploadErrors, PostBotsByBotIdContainerFsUploadResponses, PostBotsByBotIdContainerFsWriteData, PostBotsByBotIdContainerFsWriteErrors, PostBotsByBotIdContainerFsWriteResponses, PostBotsByBotIdContainerResponses, PostBotsByBotIdContainerSkillsActionsData, PostBotsByBotIdContainerSkillsActionsErrors, PostBotsByBotIdContainerSkillsActionsResponses, PostBotsByBotIdContainerSkillsData, PostBotsByBotIdContainerSkillsErrors, PostBotsByBotIdContainerSkillsResponses, PostBotsByBotIdContainerSnapshotsData, PostBotsByBotIdContainerSnapshotsErrors, PostBotsByBotIdContainerSnapshotsResponses, PostBotsByBotIdContainerSnapshotsRollbackData, PostBotsByBotIdContainerSnapshotsRollbackErrors, PostBotsByBotIdContainerSnapshotsRollbackResponses, PostBotsByBotIdContainerStartData, PostBotsByBotIdContainerStartErrors, PostBotsByBotIdContainerStartResponses, PostBotsByBotIdContainerStopData, PostBotsByBotIdContainerStopErrors, PostBotsByBotIdContainerStopResponses, PostBotsByBotIdEmailBindingsData, PostBotsByBotIdEmailBindingsErrors, PostBotsByBotIdEmailBindingsResponses, PostBotsByBotIdLocalMessagesData, PostBotsByBotIdLocalMessagesErrors, PostBotsByBotIdLocalMessagesResponses, PostBotsByBotIdMcpByIdOauthAuthorizeData, PostBotsByBotIdMcpByIdOauthAuthorizeErrors, PostBotsByBotIdMcpByIdOauthAuthorizeResponses, PostBotsByBotIdMcpByIdOauthDiscoverData, PostBotsByBotIdMcpByIdOauthDiscoverErrors, PostBotsByBotIdMcpByIdOauthDiscoverResponses, PostBotsByBotIdMcpByIdOauthExchangeData, PostBotsByBotIdMcpByIdOauthExchangeErrors, PostBotsByBotIdMcpByIdOauthExchangeResponses, PostBotsByBotIdMcpByIdProbeData, PostBotsByBotIdMcpByIdProbeErrors, PostBotsByBotIdMcpByIdProbeResponses, PostBotsByBotIdMcpData, PostBotsByBotIdMcpErrors, PostBotsByBotIdMcpOpsBatchDeleteData, PostBotsByBotIdMcpOpsBatchDeleteErrors, PostBotsByBotIdMcpOpsBatchDeleteResponses, PostBotsByBotIdMcpResponses, PostBotsByBotIdMcpStdioByConnectionIdData, PostBotsByBotIdMcpStdioByConnectionIdErrors, PostBotsByBotIdMcpStdioByConnectionIdResponses, PostBotsByBotIdMcpStdioData, PostBotsByBotIdMcpStdioErrors, PostBotsByBotIdMcpStdioResponses, PostBotsByBotIdMemoryCompactData, PostBotsByBotIdMemoryCompactErrors, PostBotsByBotIdMemoryCompactResponses, PostBotsByBotIdMemoryData, PostBotsByBotIdMemoryErrors, PostBotsByBotIdMemoryRebuildData, PostBotsByBotIdMemoryRebuildErrors, PostBotsByBotIdMemoryRebuildResponses, PostBotsByBotIdMemoryResponses, PostBotsByBotIdMemorySearchData, PostBotsByBotIdMemorySearchErrors, PostBotsByBotIdMemorySearchResponses, PostBotsByBotIdPluginsByIdDisableData, PostBotsByBotIdPluginsByIdDisableErrors, PostBotsByBotIdPluginsByIdDisableResponses, PostBotsByBotIdPluginsByIdEnableData, PostBotsByBotIdPluginsByIdEnableErrors, PostBotsByBotIdPluginsByIdEnableResponses, PostBotsByBotIdPluginsByIdOauthAuthorizeData, PostBotsByBotIdPluginsByIdOauthAuthorizeErrors, PostBotsByBotIdPluginsByIdOauthAuthorizeResponses, PostBotsByBotIdPluginsByIdUninstallData, PostBotsByBotIdPluginsByIdUninstallErrors, PostBotsByBotIdPluginsByIdUninstallResponses, PostBotsByBotIdPluginsData, PostBotsByBotIdPluginsErrors, PostBotsByBotIdPluginsResponses, PostBotsByBotIdScheduleData, PostBotsByBotIdScheduleErrors, PostBotsByBotIdScheduleResponses, PostBotsByBotIdSessionsBySessionIdAcpRuntimeData, PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors, PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponses, PostBotsByBotIdSessionsBySessionIdCompactData, PostBotsByBotIdSessionsBySessionIdCompactErrors, PostBotsByBotIdSessionsBySessionIdCompactResponses, PostBotsByBotIdSessionsData, PostBotsByBotIdSessionsErrors, PostBotsByBotIdSessionsResponses, PostBotsByBotIdSettingsData, PostBotsByBotIdSettingsErrors, PostBotsByBotIdSettingsResponses, PostBotsByBotIdSupermarketInstallPluginData, PostBotsByBotIdSupermarketInstallPluginErrors, PostBotsByBotIdSupermarketInstallPluginResponses, PostBotsByBotIdSupermarketInstallSkillData, PostBotsByBotIdSupermarketInstallSkillErrors, PostBotsByBotIdSupermarketInstallSkillResponses, PostBotsByBotIdToolApprovalsByApprovalIdApproveData, PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors, PostBotsByBotIdToolApprovalsByApprovalIdApproveResponses, PostBotsByBotIdToolApprovalsByApprovalIdRejectData, PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors, PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses, PostBotsByBotIdToolsData, PostBotsByBotIdToolsErrors, PostBotsByBotIdToolsResponses, PostBotsByBotIdTtsSynthesizeData, PostBotsByBotIdTtsSynthesizeErrors, PostBotsByBotIdTtsSynthesizeResponses, PostBotsByBotIdUserAccessData, PostBotsByBotIdUserAccessErrors, PostBotsByBotIdUserAccessResponses, PostBotsByIdChannelByPlatformSendChatData, PostBotsByIdChannelByPlatformSendChatErrors, PostBotsByIdChannelByPlatformSendChatResponses, PostBotsByIdChannelByPlatformSendData, PostBotsByIdChannelByPlatformSendErrors, PostBotsByIdChannelByPlatformSendResponses, PostBotsData, PostBotsErrors, PostBotsResponses, PostEmailMailgunWebhookByConfigIdData, PostEmailMailgunWebhookByConfigIdErrors, PostEmailMailgunWebhookByConfigIdResponses, PostEmailProvidersData, PostEmailProvidersErrors, PostEmailProvidersResponses, PostMemoryProvidersData, PostMemoryProvidersErrors, PostMemoryProvidersResponses, PostModelsByIdTestData, PostModelsByIdTestErrors, PostModelsByIdTestResponses, PostModelsData, PostModelsErrors, PostModelsResponses, PostProvidersByIdImportModelsData, PostProvidersByIdImportModelsErrors, PostProvidersByIdImportModelsResponses, PostProvidersByIdOauthPollData, PostProvidersByIdOauthPollErrors, PostProvidersByIdOauthPollResponses, PostProvidersByIdTestData, PostProvidersByIdTestErrors, PostProvidersByIdTestResponses, PostProvidersData, PostProvidersErrors, PostProvidersResponses, PostSearchProvidersData, PostSearchProvidersErrors, PostSearchProvidersResponses, PostSpeechModelsByIdTestData, PostSpeechModelsByIdTestErrors, PostSpeechModelsByIdTestResponses, PostSpeechProvidersByIdImportModelsData, PostSpeechProvidersByIdImportModelsErrors, PostSpeechProvidersByIdImportModelsResponses, PostTranscriptionModelsByIdTestData, PostTranscriptionModelsByIdTestErrors, PostTranscriptionModelsByIdTestResponses, PostTranscriptionProvidersByIdImportModelsData, PostTranscriptionProvidersByIdImportModelsErrors, PostTranscriptionProvidersByIdImportModelsResponses, PostUsersData, PostUsersErrors, PostUsersResponses, PutBotsByBotIdAclDefaultEffectData, PutBotsByBotIdAclDefaultEffectErrors, PutBotsByBotIdAclDefaultEffectResponses, PutBotsByBotIdAclRulesByRuleIdData, PutBotsByBotIdAclRulesByRuleIdErrors, PutBotsByBotIdAclRulesByRuleIdResponses, PutBotsByBotIdContainerMetricsData, PutBotsByBotIdContainerMetricsErrors, PutBotsByBotIdContainerMetricsResponses, PutBotsByBotIdEmailBindingsByIdData, PutBotsByBotIdEmailBindingsByIdErrors, PutBotsByBotIdEmailBindingsByIdResponses, PutBotsByBotIdMcpByIdData, PutBotsByBotIdMcpByIdErrors, PutBotsByBotIdMcpByIdResponses, PutBotsByBotIdMcpImportData, PutBotsByBotIdMcpImportErrors, PutBotsByBotIdMcpImportResponses, PutBotsByBotIdScheduleByIdData, PutBotsByBotIdScheduleByIdErrors, PutBotsByBotIdScheduleByIdResponses, PutBotsByBotIdSettingsData, PutBotsByBotIdSettingsErrors, PutBotsByBotIdSettingsResponses, PutBotsByBotIdUserAccessByGrantIdData, PutBotsByBotIdUserAccessByGrantIdErrors, PutBotsByBotIdUserAccessByGrantIdResponses, PutBotsByIdChannelByPlatformData, PutBotsByIdChannelByPlatformErrors, PutBotsByIdChannelByPlatformResponses, PutBotsByIdData, PutBotsByIdErrors, PutBotsByIdOwnerData, PutBotsByIdOwnerErrors, PutBotsByIdOwnerResponses, PutBotsByIdResponses, PutEmailProvidersByIdData, PutEmailProvidersByIdErrors, PutEmailProvidersByIdResponses, PutMemoryProvidersByIdData, PutMemoryProvidersByIdErrors, PutMemoryProvidersByIdResponses, PutModelsByIdData, PutModelsByIdErrors, PutModelsByIdResponses, PutModelsModelByModelIdData, PutModelsModelByModelIdErrors, PutModelsModelByModelIdResponses, PutProvidersByIdData, PutProvidersByIdErrors, PutProvidersByIdResponses, PutSearchProvidersByIdData, PutSearchProvidersByIdErrors, PutSearchProvidersByIdResponses, PutSpeechModelsByIdData, PutSpeechModelsByIdErrors, PutSpeechModelsByIdResponses, PutTranscriptionModelsByIdData, PutTranscriptionModelsByIdErrors, PutTranscriptionModelsByIdResponses, PutUsersByIdData, PutUsersByIdErrors, PutUsersByIdPasswordData, PutUsersByIdPasswordErrors, PutUsersByIdPasswordResponses, PutUsersByIdResponses, PutUsersMeChannelsByPlatformData, PutUsersMeChannelsByPlatformErrors, PutUsersMeChannelsByPlatformResponses, PutUsersMeData, PutUsersMeErrors, PutUsersMePasswordData, PutUsersMePasswordErrors, PutUsersMePasswordResponses, PutUsersMeResponses } from './types.gen';
+import type { DeleteBotsByBotIdAclRulesByRuleIdData, DeleteBotsByBotIdAclRulesByRuleIdErrors, DeleteBotsByBotIdAclRulesByRuleIdResponses, DeleteBotsByBotIdAcpRuntimesByRuntimeIdData, DeleteBotsByBotIdAcpRuntimesByRuntimeIdErrors, DeleteBotsByBotIdAcpRuntimesByRuntimeIdResponses, DeleteBotsByBotIdBudgetsByIdData, DeleteBotsByBotIdBudgetsByIdErrors, DeleteBotsByBotIdBudgetsByIdResponses, DeleteBotsByBotIdCompactionLogsData, DeleteBotsByBotIdCompactionLogsErrors, DeleteBotsByBotIdCompactionLogsResponses, DeleteBotsByBotIdContainerBrowserSessionsBySessionIdData, DeleteBotsByBotIdContainerBrowserSessionsBySessionIdErrors, DeleteBotsByBotIdContainerBrowserSessionsBySessionIdResponses, DeleteBotsByBotIdContainerData, DeleteBotsByBotIdContainerDisplaySessionsBySessionIdData, DeleteBotsByBotIdContainerDisplaySessionsBySessionIdErrors, DeleteBotsByBotIdContainerDisplaySessionsBySessionIdResponses, DeleteBotsByBotIdContainerErrors, DeleteBotsByBotIdContainerResponses, DeleteBotsByBotIdContainerSkillsData, DeleteBotsByBotIdContainerSkillsErrors, DeleteBotsByBotIdContainerSkillsResponses, DeleteBotsByBotIdEmailBindingsByIdData, DeleteBotsByBotIdEmailBindingsByIdErrors, DeleteBotsByBotIdEmailBindingsByIdResponses, DeleteBotsByBotIdHeartbeatLogsData, DeleteBotsByBotIdHeartbeatLogsErrors, DeleteBotsByBotIdHeartbeatLogsResponses, DeleteBotsByBotIdMcpByIdData, DeleteBotsByBotIdMcpByIdErrors, DeleteBotsByBotIdMcpByIdOauthTokenData, DeleteBotsByBotIdMcpByIdOauthTokenErrors, DeleteBotsByBotIdMcpByIdOauthTokenResponses, DeleteBotsByBotIdMcpByIdResponses, DeleteBotsByBotIdMemoryByIdData, DeleteBotsByBotIdMemoryByIdErrors, DeleteBotsByBotIdMemoryByIdResponses, DeleteBotsByBotIdMemoryData, DeleteBotsByBotIdMemoryErrors, DeleteBotsByBotIdMemoryResponses, DeleteBotsByBotIdMessagesData, DeleteBotsByBotIdMessagesErrors, DeleteBotsByBotIdMessagesResponses, DeleteBotsByBotIdPluginsByIdData, DeleteBotsByBotIdPluginsByIdErrors, DeleteBotsByBotIdPluginsByIdResponses, DeleteBotsByBotIdScheduleByIdData, DeleteBotsByBotIdScheduleByIdErrors, DeleteBotsByBotIdScheduleByIdResponses, DeleteBotsByBotIdScheduleLogsData, DeleteBotsByBotIdScheduleLogsErrors, DeleteBotsByBotIdScheduleLogsResponses, DeleteBotsByBotIdSessionsBySessionIdData, DeleteBotsByBotIdSessionsBySessionIdErrors, DeleteBotsByBotIdSessionsBySessionIdResponses, DeleteBotsByBotIdSettingsData, DeleteBotsByBotIdSettingsErrors, DeleteBotsByBotIdSettingsResponses, DeleteBotsByBotIdUserAccessByGrantIdData, DeleteBotsByBotIdUserAccessByGrantIdErrors, DeleteBotsByBotIdUserAccessByGrantIdResponses, DeleteBotsByBotIdWorkflowsByIdData, DeleteBotsByBotIdWorkflowsByIdErrors, DeleteBotsByBotIdWorkflowsByIdResponses, DeleteBotsByIdChannelByPlatformData, DeleteBotsByIdChannelByPlatformErrors, DeleteBotsByIdChannelByPlatformResponses, DeleteBotsByIdData, DeleteBotsByIdErrors, DeleteBotsByIdResponses, DeleteBotTemplatesByIdData, DeleteBotTemplatesByIdErrors, DeleteBotTemplatesByIdResponses, DeleteEmailProvidersByIdData, DeleteEmailProvidersByIdErrors, DeleteEmailProvidersByIdOauthTokenData, DeleteEmailProvidersByIdOauthTokenErrors, DeleteEmailProvidersByIdOauthTokenResponses, DeleteEmailProvidersByIdResponses, DeleteMemoryProvidersByIdData, DeleteMemoryProvidersByIdErrors, DeleteMemoryProvidersByIdResponses, DeleteModelsByIdData, DeleteModelsByIdErrors, DeleteModelsByIdResponses, DeleteModelsModelByModelIdData, DeleteModelsModelByModelIdErrors, DeleteModelsModelByModelIdResponses, DeleteProvidersByIdData, DeleteProvidersByIdErrors, DeleteProvidersByIdOauthTokenData, DeleteProvidersByIdOauthTokenErrors, DeleteProvidersByIdOauthTokenResponses, DeleteProvidersByIdResponses, DeleteSearchProvidersByIdData, DeleteSearchProvidersByIdErrors, DeleteSearchProvidersByIdResponses, DeleteUsersByIdData, DeleteUsersByIdErrors, DeleteUsersByIdResponses, DeleteUsersByUserIdBudgetsByIdData, DeleteUsersByUserIdBudgetsByIdErrors, DeleteUsersByUserIdBudgetsByIdResponses, DeleteUsersMeTokensByIdData, DeleteUsersMeTokensByIdErrors, DeleteUsersMeTokensByIdResponses, GetAcpProfilesData, GetAcpProfilesResponses, GetAuditLogsData, GetAuditLogsErrors, GetAuditLogsExportData, GetAuditLogsExportErrors, GetAuditLogsExportResponses, GetAuditLogsResponses, GetAuthOidcData, GetAuthOidcResponses, GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsData, GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsErrors, GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponses, GetBotsByBotIdAclChannelIdentitiesData, GetBotsByBotIdAclChannelIdentitiesErrors, GetBotsByBotIdAclChannelIdentitiesResponses, GetBotsByBotIdAclChannelTypesByChannelTypeConversationsData, GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors, GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponses, GetBotsByBotIdAclDefaultEffectData, GetBotsByBotIdAclDefaultEffectErrors, GetBotsByBotIdAclDefaultEffectResponses, GetBotsByBotIdAclRulesData, GetBotsByBotIdAclRulesErrors, GetBotsByBotIdAclRulesResponses, GetBotsByBotIdAcpClaudeCodeOauthAuthorizeData, GetBotsByBotIdAcpClaudeCodeOauthAuthorizeErrors, GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponses, GetBotsByBotIdAcpClaudeCodeOauthStatusData, GetBotsByBotIdAcpClaudeCodeOauthStatusErrors, GetBotsByBotIdAcpClaudeCodeOauthStatusResponses, GetBotsByBotIdAcpRuntimesByRuntimeIdData, GetBotsByBotIdAcpRuntimesByRuntimeIdErrors, GetBotsByBotIdAcpRuntimesByRuntimeIdResponses, GetBotsByBotIdAuditLogsData, GetBotsByBotIdAuditLogsErrors, GetBotsByBotIdAuditLogsExportData, GetBotsByBotIdAuditLogsExportErrors, GetBotsByBotIdAuditLogsExportResponses, GetBotsByBotIdAuditLogsResponses, GetBotsByBotIdBackupSummaryData, GetBotsByBotIdBackupSummaryErrors, GetBotsByBotIdBackupSummaryResponses, GetBotsByBotIdBudgetsData, GetBotsByBotIdBudgetsErrors, GetBotsByBotIdBudgetsResponses, GetBotsByBotIdCompactionLogsData, GetBotsByBotIdCompactionLogsErrors, GetBotsByBotIdCompactionLogsResponses, GetBotsByBotIdContainerData, GetBotsByBotIdContainerDisplayData, GetBotsByBotIdContainerDisplayErrors, GetBotsByBotIdContainerDisplayResponses, GetBotsByBotIdContainerDisplaySessionsData, GetBotsByBotIdContainerDisplaySessionsErrors, GetBotsByBotIdContainerDisplaySessionsResponses, GetBotsByBotIdContainerErrors, GetBotsByBotIdContainerFsData, GetBotsByBotIdContainerFsDownloadData, GetBotsByBotIdContainerFsDownloadErrors, GetBotsByBotIdContainerFsDownloadResponses, GetBotsByBotIdContainerFsErrors, GetBotsByBotIdContainerFsListData, GetBotsByBotIdContainerFsListErrors, GetBotsByBotIdContainerFsListResponses, GetBotsByBotIdContainerFsReadData, GetBotsByBotIdContainerFsReadErrors, GetBotsByBotIdContainerFsReadResponses, GetBotsByBotIdContainerFsResponses, GetBotsByBotIdContainerMetricsData, GetBotsByBotIdContainerMetricsErrors, GetBotsByBotIdContainerMetricsResponses, GetBotsByBotIdContainerResponses, GetBotsByBotIdContainerSkillsData, GetBotsByBotIdContainerSkillsErrors, GetBotsByBotIdContainerSkillsResponses, GetBotsByBotIdContainerSnapshotsData, GetBotsByBotIdContainerSnapshotsDiffData, GetBotsByBotIdContainerSnapshotsDiffErrors, GetBotsByBotIdContainerSnapshotsDiffResponses, GetBotsByBotIdContainerSnapshotsErrors, GetBotsByBotIdContainerSnapshotsPolicyData, GetBotsByBotIdContainerSnapshotsPolicyErrors, GetBotsByBotIdContainerSnapshotsPolicyResponses, GetBotsByBotIdContainerSnapshotsResponses, GetBotsByBotIdContainerTerminalData, GetBotsByBotIdContainerTerminalErrors, GetBotsByBotIdContainerTerminalResponses, GetBotsByBotIdContainerTerminalWsData, GetBotsByBotIdContainerTerminalWsErrors, GetBotsByBotIdDelegationsData, GetBotsByBotIdDelegationsErrors, GetBotsByBotIdDelegationsResponses, GetBotsByBotIdEmailBindingsData, GetBotsByBotIdEmailBindingsErrors, GetBotsByBotIdEmailBindingsResponses, GetBotsByBotIdEmailOutboxByIdData, GetBotsByBotIdEmailOutboxByIdErrors, GetBotsByBotIdEmailOutboxByIdResponses, GetBotsByBotIdEmailOutboxData, GetBotsByBotIdEmailOutboxErrors, GetBotsByBotIdEmailOutboxResponses, GetBotsByBotIdHeartbeatLogsData, GetBotsByBotIdHeartbeatLogsErrors, GetBotsByBotIdHeartbeatLogsResponses, GetBotsByBotIdLocalStreamData, GetBotsByBotIdLocalStreamErrors, GetBotsByBotIdLocalStreamResponses, GetBotsByBotIdLocalWsData, GetBotsByBotIdLocalWsErrors, GetBotsByBotIdMcpByIdData, GetBotsByBotIdMcpByIdErrors, GetBotsByBotIdMcpByIdOauthStatusData, GetBotsByBotIdMcpByIdOauthStatusErrors, GetBotsByBotIdMcpByIdOauthStatusResponses, GetBotsByBotIdMcpByIdResponses, GetBotsByBotIdMcpData, GetBotsByBotIdMcpErrors, GetBotsByBotIdMcpExportData, GetBotsByBotIdMcpExportErrors, GetBotsByBotIdMcpExportResponses, GetBotsByBotIdMcpResponses, GetBotsByBotIdMemoryByIdHistoryData, GetBotsByBotIdMemoryByIdHistoryErrors, GetBotsByBotIdMemoryByIdHistoryResponses, GetBotsByBotIdMemoryData, GetBotsByBotIdMemoryErrors, GetBotsByBotIdMemoryResponses, GetBotsByBotIdMemoryStatusData, GetBotsByBotIdMemoryStatusErrors, GetBotsByBotIdMemoryStatusResponses, GetBotsByBotIdMemoryUsageData, GetBotsByBotIdMemoryUsageErrors, GetBotsByBotIdMemoryUsageResponses, GetBotsByBotIdMessagesData, GetBotsByBotIdMessagesErrors, GetBotsByBotIdMessagesLocateData, GetBotsByBotIdMessagesLocateErrors, GetBotsByBotIdMessagesLocateResponses, GetBotsByBotIdMessagesResponses, GetBotsByBotIdPluginsByIdData, GetBotsByBotIdPluginsByIdErrors, GetBotsByBotIdPluginsByIdOauthStatusData, GetBotsByBotIdPluginsByIdOauthStatusErrors, GetBotsByBotIdPluginsByIdOauthStatusResponses, GetBotsByBotIdPluginsByIdResponses, GetBotsByBotIdPluginsData, GetBotsByBotIdPluginsErrors, GetBotsByBotIdPluginsResponses, GetBotsByBotIdScheduleByIdData, GetBotsByBotIdScheduleByIdErrors, GetBotsByBotIdScheduleByIdLogsData, GetBotsByBotIdScheduleByIdLogsErrors, GetBotsByBotIdScheduleByIdLogsResponses, GetBotsByBotIdScheduleByIdResponses, GetBotsByBotIdScheduleData, GetBotsByBotIdScheduleErrors, GetBotsByBotIdScheduleLogsData, GetBotsByBotIdScheduleLogsErrors, GetBotsByBotIdScheduleLogsFailedData, GetBotsByBotIdScheduleLogsFailedErrors, GetBotsByBotIdScheduleLogsFailedResponses, GetBotsByBotIdScheduleLogsResponses, GetBotsByBotIdScheduleResponses, GetBotsByBotIdSessionsBySessionIdAcpRuntimeData, GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors, GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponses, GetBotsByBotIdSessionsBySessionIdData, GetBotsByBotIdSessionsBySessionIdErrors, GetBotsByBotIdSessionsBySessionIdResponses, GetBotsByBotIdSessionsBySessionIdStatusData, GetBotsByBotIdSessionsBySessionIdStatusErrors, GetBotsByBotIdSessionsBySessionIdStatusResponses, GetBotsByBotIdSessionsData, GetBotsByBotIdSessionsErrors, GetBotsByBotIdSessionsResponses, GetBotsByBotIdSettingsData, GetBotsByBotIdSettingsErrors, GetBotsByBotIdSettingsResponses, GetBotsByBotIdTokenUsageData, GetBotsByBotIdTokenUsageErrors, GetBotsByBotIdTokenUsageRecordsData, GetBotsByBotIdTokenUsageRecordsErrors, GetBotsByBotIdTokenUsageRecordsResponses, GetBotsByBotIdTokenUsageResponses, GetBotsByBotIdUserAccessCandidatesData, GetBotsByBotIdUserAccessCandidatesErrors, GetBotsByBotIdUserAccessCandidatesResponses, GetBotsByBotIdUserAccessData, GetBotsByBotIdUserAccessErrors, GetBotsByBotIdUserAccessResponses, GetBotsByBotIdWorkflowsByIdData, GetBotsByBotIdWorkflowsByIdErrors, GetBotsByBotIdWorkflowsByIdResponses, GetBotsByBotIdWorkflowsByIdRunsByRunIdData, GetBotsByBotIdWorkflowsByIdRunsByRunIdErrors, GetBotsByBotIdWorkflowsByIdRunsByRunIdResponses, GetBotsByBotIdWorkflowsByIdRunsData, GetBotsByBotIdWorkflowsByIdRunsErrors, GetBotsByBotIdWorkflowsByIdRunsResponses, GetBotsByBotIdWorkflowsData, GetBotsByBotIdWorkflowsErrors, GetBotsByBotIdWorkflowsResponses, GetBotsByIdChannelByPlatformData, GetBotsByIdChannelByPlatformErrors, GetBotsByIdChannelByPlatformResponses, GetBotsByIdChecksData, GetBotsByIdChecksErrors, GetBotsByIdChecksResponses, GetBotsByIdData, GetBotsByIdErrors, GetBotsByIdResponses, GetBotsData, GetBotsErrors, GetBotsNameAvailabilityData, GetBotsNameAvailabilityErrors, GetBotsNameAvailabilityResponses, GetBotsResponses, GetBotTemplatesByIdBundleData, GetBotTemplatesByIdBundleErrors, GetBotTemplatesByIdBundleResponses, GetBotTemplatesByIdData, GetBotTemplatesByIdErrors, GetBotTemplatesByIdResponses, GetBotTemplatesData, GetBotTemplatesErrors, GetBotTemplatesResponses, GetChannelsByPlatformData, GetChannelsByPlatformErrors, GetChannelsByPlatformResponses, GetChannelsData, GetChannelsErrors, GetChannelsResponses, GetEmailOauthCallbackData, GetEmailOauthCallbackErrors, GetEmailOauthCallbackResponses, GetEmailProvidersByIdData, GetEmailProvidersByIdErrors, GetEmailProvidersByIdOauthAuthorizeData, GetEmailProvidersByIdOauthAuthorizeErrors, GetEmailProvidersByIdOauthAuthorizeResponses, GetEmailProvidersByIdOauthStatusData, GetEmailProvidersByIdOauthStatusErrors, GetEmailProvidersByIdOauthStatusResponses, GetEmailProvidersByIdResponses, GetEmailProvidersData, GetEmailProvidersErrors, GetEmailProvidersMetaData, GetEmailProvidersMetaResponses, GetEmailProvidersResponses, GetMemoryProvidersByIdData, GetMemoryProvidersByIdErrors, GetMemoryProvidersByIdResponses, GetMemoryProvidersByIdStatusData, GetMemoryProvidersByIdStatusErrors, GetMemoryProvidersByIdStatusResponses, GetMemoryProvidersData, GetMemoryProvidersErrors, GetMemoryProvidersMetaData, GetMemoryProvidersMetaResponses, GetMemoryProvidersResponses, GetModelsByIdData, GetModelsByIdErrors, GetModelsByIdResponses, GetModelsCountData, GetModelsCountErrors, GetModelsCountResponses, GetModelsData, GetModelsErrors, GetModelsModelByModelIdData, GetModelsModelByModelIdErrors, GetModelsModelByModelIdResponses, GetModelsResponses, GetOauthMcpCallbackData, GetOauthMcpCallbackErrors, GetOauthMcpCallbackResponses, GetPingData, GetPingResponses, GetProvidersByIdData, GetProvidersByIdErrors, GetProvidersByIdModelsData, GetProvidersByIdModelsErrors, GetProvidersByIdModelsResponses, GetProvidersByIdOauthAuthorizeData, GetProvidersByIdOauthAuthorizeErrors, GetProvidersByIdOauthAuthorizeResponses, GetProvidersByIdOauthStatusData, GetProvidersByIdOauthStatusErrors, GetProvidersByIdOauthStatusResponses, GetProvidersByIdResponses, GetProvidersCountData, GetProvidersCountErrors, GetProvidersCountResponses, GetProvidersData, GetProvidersErrors, GetProvidersNameByNameData, GetProvidersNameByNameErrors, GetProvidersNameByNameResponses, GetProvidersOauthCallbackData, GetProvidersOauthCallbackErrors, GetProvidersOauthCallbackResponses, GetProvidersResponses, GetSearchProvidersByIdData, GetSearchProvidersByIdErrors, GetSearchProvidersByIdResponses, GetSearchProvidersData, GetSearchProvidersErrors, GetSearchProvidersMetaData, GetSearchProvidersMetaResponses, GetSearchProvidersResponses, GetSpeechModelsByIdCapabilitiesData, GetSpeechModelsByIdCapabilitiesErrors, GetSpeechModelsByIdCapabilitiesResponses, GetSpeechModelsByIdData, GetSpeechModelsByIdErrors, GetSpeechModelsByIdResponses, GetSpeechModelsData, GetSpeechModelsErrors, GetSpeechModelsResponses, GetSpeechProvidersByIdData, GetSpeechProvidersByIdErrors, GetSpeechProvidersByIdModelsData, GetSpeechProvidersByIdModelsErrors, GetSpeechProvidersByIdModelsResponses, GetSpeechProvidersByIdResponses, GetSpeechProvidersData, GetSpeechProvidersErrors, GetSpeechProvidersMetaData, GetSpeechProvidersMetaResponses, GetSpeechProvidersResponses, GetSupermarketPluginsByIdData, GetSupermarketPluginsByIdErrors, GetSupermarketPluginsByIdResponses, GetSupermarketPluginsData, GetSupermarketPluginsErrors, GetSupermarketPluginsResponses, GetSupermarketSkillsByIdData, GetSupermarketSkillsByIdErrors, GetSupermarketSkillsByIdResponses, GetSupermarketSkillsData, GetSupermarketSkillsErrors, GetSupermarketSkillsResponses, GetSupermarketTagsData, GetSupermarketTagsErrors, GetSupermarketTagsResponses, GetTranscriptionModelsByIdCapabilitiesData, GetTranscriptionModelsByIdCapabilitiesErrors, GetTranscriptionModelsByIdCapabilitiesResponses, GetTranscriptionModelsByIdData, GetTranscriptionModelsByIdErrors, GetTranscriptionModelsByIdResponses, GetTranscriptionModelsData, GetTranscriptionModelsErrors, GetTranscriptionModelsResponses, GetTranscriptionProvidersByIdData, GetTranscriptionProvidersByIdErrors, GetTranscriptionProvidersByIdModelsData, GetTranscriptionProvidersByIdModelsErrors, GetTranscriptionProvidersByIdModelsResponses, GetTranscriptionProvidersByIdResponses, GetTranscriptionProvidersData, GetTranscriptionProvidersErrors, GetTranscriptionProvidersMetaData, GetTranscriptionProvidersMetaResponses, GetTranscriptionProvidersResponses, GetUsersByIdData, GetUsersByIdErrors, GetUsersByIdResponses, GetUsersByUserIdBudgetsData, GetUsersByUserIdBudgetsErrors, GetUsersByUserIdBudgetsResponses, GetUsersData, GetUsersErrors, GetUsersMeChannelsByPlatformData, GetUsersMeChannelsByPlatformErrors, GetUsersMeChannelsByPlatformResponses, GetUsersMeData, GetUsersMeErrors, GetUsersMeResponses, GetUsersMeTokensData, GetUsersMeTokensErrors, GetUsersMeTokensResponses, GetUsersMeTokensScopesData, GetUsersMeTokensScopesResponses, GetUsersResponses, GetV1ModelsData, GetV1ModelsErrors, GetV1ModelsResponses, PatchBotsByBotIdAcpRuntimesByRuntimeIdModelData, PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors, PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponses, PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelData, PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors, PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponses, PatchBotsByBotIdSessionsBySessionIdData, PatchBotsByBotIdSessionsBySessionIdErrors, PatchBotsByBotIdSessionsBySessionIdResponses, PatchBotsByIdChannelByPlatformStatusData, PatchBotsByIdChannelByPlatformStatusErrors, PatchBotsByIdChannelByPlatformStatusResponses, PostAuthLoginData, PostAuthLoginErrors, PostAuthLoginResponses, PostAuthOidcAuthorizeData, PostAuthOidcAuthorizeErrors, PostAuthOidcAuthorizeResponses, PostAuthOidcExchangeData, PostAuthOidcExchangeErrors, PostAuthOidcExchangeResponses, PostAuthRefreshData, PostAuthRefreshErrors, PostAuthRefreshResponses, PostBotsBackupImportData, PostBotsBackupImportErrors, PostBotsBackupImportPreviewData, PostBotsBackupImportPreviewErrors, PostBotsBackupImportPreviewResponses, PostBotsBackupImportResponses, PostBotsByBotIdAclRulesData, PostBotsByBotIdAclRulesErrors, PostBotsByBotIdAclRulesResponses, PostBotsByBotIdAcpClaudeCodeOauthExchangeData, PostBotsByBotIdAcpClaudeCodeOauthExchangeErrors, PostBotsByBotIdAcpClaudeCodeOauthExchangeResponses, PostBotsByBotIdAcpRuntimesData, PostBotsByBotIdAcpRuntimesErrors, PostBotsByBotIdAcpRuntimesResponses, PostBotsByBotIdBackupExportData, PostBotsByBotIdBackupExportErrors, PostBotsByBotIdBackupExportResponses, PostBotsByBotIdBudgetsData, PostBotsByBotIdBudgetsErrors, PostBotsByBotIdBudgetsResponses, PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveData, PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveErrors, PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponses, PostBotsByBotIdContainerBrowserSessionsData, PostBotsByBotIdContainerBrowserSessionsErrors, PostBotsByBotIdContainerBrowserSessionsResponses, PostBotsByBotIdContainerData, PostBotsByBotIdContainerDataRestoreData, PostBotsByBotIdContainerDataRestoreErrors, PostBotsByBotIdContainerDataRestoreResponses, PostBotsByBotIdContainerDisplayPrepareData, PostBotsByBotIdContainerDisplayPrepareErrors, PostBotsByBotIdContainerDisplayPrepareResponses, PostBotsByBotIdContainerDisplayWebrtcOfferData, PostBotsByBotIdContainerDisplayWebrtcOfferErrors, PostBotsByBotIdContainerDisplayWebrtcOfferResponses, PostBotsByBotIdContainerErrors, PostBotsByBotIdContainerFsArchiveData, PostBotsByBotIdContainerFsArchiveErrors, PostBotsByBotIdContainerFsArchiveResponses, PostBotsByBotIdContainerFsDeleteData, PostBotsByBotIdContainerFsDeleteErrors, PostBotsByBotIdContainerFsDeleteResponses, PostBotsByBotIdContainerFsExtractData, PostBotsByBotIdContainerFsExtractErrors, PostBotsByBotIdContainerFsExtractResponses, PostBotsByBotIdContainerFsMkdirData, PostBotsByBotIdContainerFsMkdirErrors, PostBotsByBotIdContainerFsMkdirResponses, PostBotsByBotIdContainerFsRenameData, PostBotsByBotIdContainerFsRenameErrors, PostBotsByBotIdContainerFsRenameResponses, PostBotsByBotIdContainerFsUploadData, PostBotsByBotIdContainerFsUploadErrors, PostBotsByBotIdContainerFsUploadResponses, PostBotsByBotIdContainerFsWriteData, PostBotsByBotIdContainerFsWriteErrors, PostBotsByBotIdContainerFsWriteResponses, PostBotsByBotIdContainerResponses, PostBotsByBotIdContainerSkillsActionsData, PostBotsByBotIdContainerSkillsActionsErrors, PostBotsByBotIdContainerSkillsActionsResponses, PostBotsByBotIdContainerSkillsData, PostBotsByBotIdContainerSkillsErrors, PostBotsByBotIdContainerSkillsResponses, PostBotsByBotIdContainerSnapshotsData, PostBotsByBotIdContainerSnapshotsErrors, PostBotsByBotIdContainerSnapshotsPruneData, PostBotsByBotIdContainerSnapshotsPruneErrors, PostBotsByBotIdContainerSnapshotsPruneResponses, PostBotsByBotIdContainerSnapshotsResponses, PostBotsByBotIdContainerSnapshotsRollbackData, PostBotsByBotIdContainerSnapshotsRollbackErrors, PostBotsByBotIdContainerSnapshotsRollbackResponses, PostBotsByBotIdContainerStartData, PostBotsByBotIdContainerStartErrors, PostBotsByBotIdContainerStartResponses, PostBotsByBotIdContainerStopData, PostBotsByBotIdContainerStopErrors, PostBotsByBotIdContainerStopResponses, PostBotsByBotIdEmailBindingsData, PostBotsByBotIdEmailBindingsErrors, PostBotsByBotIdEmailBindingsResponses, PostBotsByBotIdLocalMessagesData, PostBotsByBotIdLocalMessagesErrors, PostBotsByBotIdLocalMessagesResponses, PostBotsByBotIdMcpByIdOauthAuthorizeData, PostBotsByBotIdMcpByIdOauthAuthorizeErrors, PostBotsByBotIdMcpByIdOauthAuthorizeResponses, PostBotsByBotIdMcpByIdOauthDiscoverData, PostBotsByBotIdMcpByIdOauthDiscoverErrors, PostBotsByBotIdMcpByIdOauthDiscoverResponses, PostBotsByBotIdMcpByIdOauthExchangeData, PostBotsByBotIdMcpByIdOauthExchangeErrors, PostBotsByBotIdMcpByIdOauthExchangeResponses, PostBotsByBotIdMcpByIdProbeData, PostBotsByBotIdMcpByIdProbeErrors, PostBotsByBotIdMcpByIdProbeResponses, PostBotsByBotIdMcpData, PostBotsByBotIdMcpErrors, PostBotsByBotIdMcpOpsBatchDeleteData, PostBotsByBotIdMcpOpsBatchDeleteErrors, PostBotsByBotIdMcpOpsBatchDeleteResponses, PostBotsByBotIdMcpResponses, PostBotsByBotIdMcpServerData, PostBotsByBotIdMcpServerErrors, PostBotsByBotIdMcpServerResponses, PostBotsByBotIdMcpStdioByConnectionIdData, PostBotsByBotIdMcpStdioByConnectionIdErrors, PostBotsByBotIdMcpStdioByConnectionIdResponses, PostBotsByBotIdMcpStdioData, PostBotsByBotIdMcpStdioErrors, PostBotsByBotIdMcpStdioResponses, PostBotsByBotIdMemoryByIdRollbackData, PostBotsByBotIdMemoryByIdRollbackErrors, PostBotsByBotIdMemoryByIdRollbackResponses, PostBotsByBotIdMemoryCompactData, PostBotsByBotIdMemoryCompactErrors, PostBotsByBotIdMemoryCompactResponses, PostBotsByBotIdMemoryData, PostBotsByBotIdMemoryErrors, PostBotsByBotIdMemoryRebuildData, PostBotsByBotIdMemoryRebuildErrors, PostBotsByBotIdMemoryRebuildResponses, PostBotsByBotIdMemoryResponses, PostBotsByBotIdMemoryRestoreData, PostBotsByBotIdMemoryRestoreErrors, PostBotsByBotIdMemoryRestoreResponses, PostBotsByBotIdMemorySearchData, PostBotsByBotIdMemorySearchErrors, PostBotsByBotIdMemorySearchResponses, PostBotsByBotIdPluginsByIdDisableData, PostBotsByBotIdPluginsByIdDisableErrors, PostBotsByBotIdPluginsByIdDisableResponses, PostBotsByBotIdPluginsByIdEnableData, PostBotsByBotIdPluginsByIdEnableErrors, PostBotsByBotIdPluginsByIdEnableResponses, PostBotsByBotIdPluginsByIdOauthAuthorizeData, PostBotsByBotIdPluginsByIdOauthAuthorizeErrors, PostBotsByBotIdPluginsByIdOauthAuthorizeResponses, PostBotsByBotIdPluginsByIdUninstallData, PostBotsByBotIdPluginsByIdUninstallErrors, PostBotsByBotIdPluginsByIdUninstallResponses, PostBotsByBotIdPluginsData, PostBotsByBotIdPluginsErrors, PostBotsByBotIdPluginsResponses, PostBotsByBotIdScheduleData, PostBotsByBotIdScheduleErrors, PostBotsByBotIdScheduleLogsByLogIdReplayData, PostBotsByBotIdScheduleLogsByLogIdReplayErrors, PostBotsByBotIdScheduleLogsByLogIdReplayResponses, PostBotsByBotIdScheduleResponses, PostBotsByBotIdSessionsBySessionIdAcpRuntimeData, PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors, PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponses, PostBotsByBotIdSessionsBySessionIdCompactData, PostBotsByBotIdSessionsBySessionIdCompactErrors, PostBotsByBotIdSessionsBySessionIdCompactResponses, PostBotsByBotIdSessionsData, PostBotsByBotIdSessionsErrors, PostBotsByBotIdSessionsResponses, PostBotsByBotIdSettingsData, PostBotsByBotIdSettingsErrors, PostBotsByBotIdSettingsResponses, PostBotsByBotIdSupermarketInstallPluginData, PostBotsByBotIdSupermarketInstallPluginErrors, PostBotsByBotIdSupermarketInstallPluginResponses, PostBotsByBotIdSupermarketInstallSkillData, PostBotsByBotIdSupermarketInstallSkillErrors, PostBotsByBotIdSupermarketInstallSkillResponses, PostBotsByBotIdToolApprovalsByApprovalIdApproveData, PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors, PostBotsByBotIdToolApprovalsByApprovalIdApproveResponses, PostBotsByBotIdToolApprovalsByApprovalIdRejectData, PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors, PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses, PostBotsByBotIdToolApprovalsDryRunData, PostBotsByBotIdToolApprovalsDryRunErrors, PostBotsByBotIdToolApprovalsDryRunResponses, PostBotsByBotIdToolsData, PostBotsByBotIdToolsErrors, PostBotsByBotIdToolsResponses, PostBotsByBotIdTtsSynthesizeData, PostBotsByBotIdTtsSynthesizeErrors, PostBotsByBotIdTtsSynthesizeResponses, PostBotsByBotIdUserAccessData, PostBotsByBotIdUserAccessErrors, PostBotsByBotIdUserAccessResponses, PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelData, PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelErrors, PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelResponses, PostBotsByBotIdWorkflowsByIdRunsData, PostBotsByBotIdWorkflowsByIdRunsErrors, PostBotsByBotIdWorkflowsByIdRunsResponses, PostBotsByBotIdWorkflowsData, PostBotsByBotIdWorkflowsErrors, PostBotsByBotIdWorkflowsResponses, PostBotsByIdChannelByPlatformSendChatData, PostBotsByIdChannelByPlatformSendChatErrors, PostBotsByIdChannelByPlatformSendChatResponses, PostBotsByIdChannelByPlatformSendData, PostBotsByIdChannelByPlatformSendErrors, PostBotsByIdChannelByPlatformSendResponses, PostBotsData, PostBotsErrors, PostBotsResponses, PostBotTemplatesByIdBotsData, PostBotTemplatesByIdBotsErrors, PostBotTemplatesByIdBotsResponses, PostBotTemplatesData, PostBotTemplatesErrors, PostBotTemplatesImportData, PostBotTemplatesImportErrors, PostBotTemplatesImportResponses, PostBotTemplatesResponses, PostEmailMailgunWebhookByConfigIdData, PostEmailMailgunWebhookByConfigIdErrors, PostEmailMailgunWebhookByConfigIdResponses, PostEmailProvidersData, PostEmailProvidersErrors, PostEmailProvidersResponses, PostMemoryProvidersData, PostMemoryProvidersErrors, PostMemoryProvidersResponses, PostModelsByIdTestData, PostModelsByIdTestErrors, PostModelsByIdTestResponses, PostModelsData, PostModelsErrors, PostModelsResponses, PostProvidersByIdImportModelsData, PostProvidersByIdImportModelsErrors, PostProvidersByIdImportModelsResponses, PostProvidersByIdOauthPollData, PostProvidersByIdOauthPollErrors, PostProvidersByIdOauthPollResponses, PostProvidersByIdTestData, PostProvidersByIdTestErrors, PostProvidersByIdTestResponses, PostProvidersData, PostProvidersErrors, PostProvidersResponses, PostSearchProvidersData, PostSearchProvidersErrors, PostSearchProvidersResponses, PostSpeechModelsByIdTestData, PostSpeechModelsByIdTestErrors, PostSpeechModelsByIdTestResponses, PostSpeechProvidersByIdImportModelsData, PostSpeechProvidersByIdImportModelsErrors, PostSpeechProvidersByIdImportModelsResponses, PostTranscriptionModelsByIdTestData, PostTranscriptionModelsByIdTestErrors, PostTranscriptionModelsByIdTestResponses, PostTranscriptionProvidersByIdImportModelsData, PostTranscriptionProvidersByIdImportModelsErrors, PostTranscriptionProvidersByIdImportModelsResponses, PostUsersByUserIdBudgetsData, PostUsersByUserIdBudgetsErrors, PostUsersByUserIdBudgetsResponses, PostUsersData, PostUsersErrors, PostUsersMeTokensData, PostUsersMeTokensErrors, PostUsersMeTokensResponses, PostUsersResponses, PostV1ChatCompletionsData, PostV1ChatCompletionsErrors, PostV1ChatCompletionsResponses, PostV1ResponsesData, PostV1ResponsesErrors, PostV1ResponsesResponses, PutBotsByBotIdAclDefaultEffectData, PutBotsByBotIdAclDefaultEffectErrors, PutBotsByBotIdAclDefaultEffectResponses, PutBotsByBotIdAclRulesByRuleIdData, PutBotsByBotIdAclRulesByRuleIdErrors, PutBotsByBotIdAclRulesByRuleIdResponses, PutBotsByBotIdBudgetsByIdData, PutBotsByBotIdBudgetsByIdErrors, PutBotsByBotIdBudgetsByIdResponses, PutBotsByBotIdContainerMetricsData, PutBotsByBotIdContainerMetricsErrors, PutBotsByBotIdContainerMetricsResponses, PutBotsByBotIdContainerSnapshotsPolicyData, PutBotsByBotIdContainerSnapshotsPolicyErrors, PutBotsByBotIdContainerSnapshotsPolicyResponses, PutBotsByBotIdEmailBindingsByIdData, PutBotsByBotIdEmailBindingsByIdErrors, PutBotsByBotIdEmailBindingsByIdResponses, PutBotsByBotIdMcpByIdData, PutBotsByBotIdMcpByIdErrors, PutBotsByBotIdMcpByIdResponses, PutBotsByBotIdMcpImportData, PutBotsByBotIdMcpImportErrors, PutBotsByBotIdMcpImportResponses, PutBotsByBotIdScheduleByIdData, PutBotsByBotIdScheduleByIdErrors, PutBotsByBotIdScheduleByIdResponses, PutBotsByBotIdSettingsData, PutBotsByBotIdSettingsErrors, PutBotsByBotIdSettingsResponses, PutBotsByBotIdUserAccessByGrantIdData, PutBotsByBotIdUserAccessByGrantIdErrors, PutBotsByBotIdUserAccessByGrantIdResponses, PutBotsByBotIdWorkflowsByIdData, PutBotsByBotIdWorkflowsByIdErrors, PutBotsByBotIdWorkflowsByIdResponses, PutBotsByIdChannelByPlatformData, PutBotsByIdChannelByPlatformErrors, PutBotsByIdChannelByPlatformResponses, PutBotsByIdData, PutBotsByIdErrors, PutBotsByIdOwnerData, PutBotsByIdOwnerErrors, PutBotsByIdOwnerResponses, PutBotsByIdResponses, PutBotTemplatesByIdData, PutBotTemplatesByIdErrors, PutBotTemplatesByIdResponses, PutEmailProvidersByIdData, PutEmailProvidersByIdErrors, PutEmailProvidersByIdResponses, PutMemoryProvidersByIdData, PutMemoryProvidersByIdErrors, PutMemoryProvidersByIdResponses, PutModelsByIdData, PutModelsByIdErrors, PutModelsByIdResponses, PutModelsModelByModelIdData, PutModelsModelByModelIdErrors, PutModelsModelByModelIdResponses, PutProvidersByIdData, PutProvidersByIdErrors, PutProvidersByIdResponses, PutSearchProvidersByIdData, PutSearchProvidersByIdErrors, PutSearchProvidersByIdResponses, PutSpeechModelsByIdData, PutSpeechModelsByIdErrors, PutSpeechModelsByIdResponses, PutTranscriptionModelsByIdData, PutTranscriptionModelsByIdErrors, PutTranscriptionModelsByIdResponses, PutUsersByIdData, PutUsersByIdErrors, PutUsersByIdPasswordData, PutUsersByIdPasswordErrors, PutUsersByIdPasswordResponses, PutUsersByIdResponses, PutUsersByUserIdBudgetsByIdData, PutUsersByUserIdBudgetsByIdErrors, PutUsersByUserIdBudgetsByIdResponses, PutUsersMeChannelsByPlatformData, PutUsersMeChannelsByPlatformErrors, PutUsersMeChannelsByPlatformResponses, PutUsersMeData, PutUsersMeErrors, PutUsersMePasswordData, PutUsersMePasswordErrors, PutUsersMePasswordResponses, PutUsersMeResponses } from './types.gen';
 
 export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends boolean = boolean> = Options2<TData, ThrowOnError> & {
     /**
@@ -25,6 +25,20 @@ export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends
  */
 export const getAcpProfiles = <ThrowOnError extends boolean = false>(options?: Options<GetAcpProfilesData, ThrowOnError>) => (options?.client ?? client).get<GetAcpProfilesResponses, unknown, ThrowOnError>({ url: '/acp/profiles', ...options });
 
+/**
+ * List audit logs
+ *
+ * List audit log entries across all bots, newest first (admin only)
+ */
+export const getAuditLogs = <ThrowOnError extends boolean = false>(options?: Options<GetAuditLogsData, ThrowOnError>) => (options?.client ?? client).get<GetAuditLogsResponses, GetAuditLogsErrors, ThrowOnError>({ url: '/audit-logs', ...options });
+
+/**
+ * Export audit logs
+ *
+ * Download every audit log entry matching the filters as JSON Lines, newest first (admin only)
+ */
+export const getAuditLogsExport = <ThrowOnError extends boolean = false>(options?: Options<GetAuditLogsExportData, ThrowOnError>) => (options?.client ?? client).get<GetAuditLogsExportResponses, GetAuditLogsExportErrors, ThrowOnError>({ url: '/audit-logs/export', ...options });
+
 /**
  * Login
  *
@@ -39,6 +53,34 @@ export const postAuthLogin = <ThrowOnError extends boolean = false>(options: Opt
     }
 });
 
+/**
+ * Get SSO configuration
+ *
+ * Report whether OpenID Connect single sign-on is enabled, for the login page
+ */
+export const getAuthOidc = <ThrowOnError extends boolean = false>(options?: Options<GetAuthOidcData, ThrowOnError>) => (options?.client ?? client).get<GetAuthOidcResponses, unknown, ThrowOnError>({ url: '/auth/oidc', ...options });
+
+/**
+ * Start SSO login
+ *
+ * Start an OpenID Connect authorization code login with PKCE and return the identity provider URL to redirect the browser to
+ */
+export const postAuthOidcAuthorize = <ThrowOnError extends boolean = false>(options?: Options<PostAuthOidcAuthorizeData, ThrowOnError>) => (options?.client ?? client).post<PostAuthOidcAuthorizeResponses, PostAuthOidcAuthorizeErrors, ThrowOnError>({ url: '/auth/oidc/authorize', ...options });
+
+/**
+ * Complete SSO login
+ *
+ * Redeem the authorization code returned by the identity provider, validate the ID token, provision or resolve the account and issue a JWT
+ */
+export const postAuthOidcExchange = <ThrowOnError extends boolean = false>(options: Options<PostAuthOidcExchangeData, ThrowOnError>) => (options.client ?? client).post<PostAuthOidcExchangeResponses, PostAuthOidcExchangeErrors, ThrowOnError>({
+    url: '/auth/oidc/exchange',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Refresh Token
  *
@@ -46,6 +88,91 @@ export const postAuthLogin = <ThrowOnError extends boolean = false>(options: Opt
  */
 export const postAuthRefresh = <ThrowOnError extends boolean = false>(options?: Options<PostAuthRefreshData, ThrowOnError>) => (options?.client ?? client).post<PostAuthRefreshResponses, PostAuthRefreshErrors, ThrowOnError>({ url: '/auth/refresh', ...options });
 
+/**
+ * List bot templates
+ *
+ * List the bot templates new bots can be created from: the caller's own and the shared ones. Admins see every template.
+ */
+export const getBotTemplates = <ThrowOnError extends boolean = false>(options?: Options<GetBotTemplatesData, ThrowOnError>) => (options?.client ?? client).get<GetBotTemplatesResponses, GetBotTemplatesErrors, ThrowOnError>({ url: '/bot-templates', ...options });
+
+/**
+ * Create bot template from bot
+ *
+ * Capture the settings, MCP connections, schedules and /data of a bot as a template. Model choices and credentials are left out.
+ */
+export const postBotTemplates = <ThrowOnError extends boolean = false>(options: Options<PostBotTemplatesData, ThrowOnError>) => (options.client ?? client).post<PostBotTemplatesResponses, PostBotTemplatesErrors, ThrowOnError>({
+    url: '/bot-templates',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Import bot template
+ *
+ * Store a bot backup bundle as a template. Sections other than settings, MCP, schedules and workspace are dropped, as are credentials.
+ */
+export const postBotTemplatesImport = <ThrowOnError extends boolean = false>(options: Options<PostBotTemplatesImportData, ThrowOnError>) => (options.client ?? client).post<PostBotTemplatesImportResponses, PostBotTemplatesImportErrors, ThrowOnError>({
+    ...formDataBodySerializer,
+    url: '/bot-templates/import',
+    ...options,
+    headers: {
+        'Content-Type': null,
+        ...options.headers
+    }
+});
+
+/**
+ * Get bot template
+ *
+ * Get a template the caller created, a shared one, or any template for admins.
+ */
+export const getBotTemplatesById = <ThrowOnError extends boolean = false>(options: Options<GetBotTemplatesByIdData, ThrowOnError>) => (options.client ?? client).get<GetBotTemplatesByIdResponses, GetBotTemplatesByIdErrors, ThrowOnError>({ url: '/bot-templates/{id}', ...options });
+
+/**
+ * Update bot template
+ *
+ * Rename a template, change its description or workspace image, or share it with every user. Only the creator or an admin may change a template.
+ */
+export const putBotTemplatesById = <ThrowOnError extends boolean = false>(options: Options<PutBotTemplatesByIdData, ThrowOnError>) => (options.client ?? client).put<PutBotTemplatesByIdResponses, PutBotTemplatesByIdErrors, ThrowOnError>({
+    url: '/bot-templates/{id}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete bot template
+ *
+ * Delete a template. Bots created from it are not affected. Only the creator or an admin may delete a template.
+ */
+export const deleteBotTemplatesById = <ThrowOnError extends boolean = false>(options: Options<DeleteBotTemplatesByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteBotTemplatesByIdResponses, DeleteBotTemplatesByIdErrors, ThrowOnError>({ url: '/bot-templates/{id}', ...options });
+
+/**
+ * Create bot from template
+ *
+ * Create a bot owned by the current user from a template. Only templates visible to the caller can be used. Empty fields of the payload fall back to the template; the template image applies unless the metadata picks one.
+ */
+export const postBotTemplatesByIdBots = <ThrowOnError extends boolean = false>(options: Options<PostBotTemplatesByIdBotsData, ThrowOnError>) => (options.client ?? client).post<PostBotTemplatesByIdBotsResponses, PostBotTemplatesByIdBotsErrors, ThrowOnError>({
+    url: '/bot-templates/{id}/bots',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Download bot template bundle
+ *
+ * Download the template as a bot backup bundle. Only templates visible to the caller can be downloaded.
+ */
+export const getBotTemplatesByIdBundle = <ThrowOnError extends boolean = false>(options: Options<GetBotTemplatesByIdBundleData, ThrowOnError>) => (options.client ?? client).get<GetBotTemplatesByIdBundleResponses, GetBotTemplatesByIdBundleErrors, ThrowOnError>({ url: '/bot-templates/{id}/bundle', ...options });
+
 /**
  * List bots
  *
@@ -244,6 +371,20 @@ export const postBotsByBotIdAcpClaudeCodeOauthExchange = <ThrowOnError extends b
  */
 export const getBotsByBotIdAcpClaudeCodeOauthStatus = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdAcpClaudeCodeOauthStatusData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdAcpClaudeCodeOauthStatusResponses, GetBotsByBotIdAcpClaudeCodeOauthStatusErrors, ThrowOnError>({ url: '/bots/{bot_id}/acp/claude-code/oauth/status', ...options });
 
+/**
+ * List bot audit logs
+ *
+ * List audit log entries of a bot, newest first
+ */
+export const getBotsByBotIdAuditLogs = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdAuditLogsData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdAuditLogsResponses, GetBotsByBotIdAuditLogsErrors, ThrowOnError>({ url: '/bots/{bot_id}/audit-logs', ...options });
+
+/**
+ * Export bot audit logs
+ *
+ * Download every audit log entry of a bot matching the filters as JSON Lines, newest first
+ */
+export const getBotsByBotIdAuditLogsExport = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdAuditLogsExportData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdAuditLogsExportResponses, GetBotsByBotIdAuditLogsExportErrors, ThrowOnError>({ url: '/bots/{bot_id}/audit-logs/export', ...options });
+
 /**
  * Export a full bot backup
  */
@@ -261,6 +402,48 @@ export const postBotsByBotIdBackupExport = <ThrowOnError extends boolean = false
  */
 export const getBotsByBotIdBackupSummary = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdBackupSummaryData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdBackupSummaryResponses, GetBotsByBotIdBackupSummaryErrors, ThrowOnError>({ url: '/bots/{bot_id}/backup/summary', ...options });
 
+/**
+ * List bot budgets
+ *
+ * List the spending budgets of a bot with their usage in the current period
+ */
+export const getBotsByBotIdBudgets = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdBudgetsData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdBudgetsResponses, GetBotsByBotIdBudgetsErrors, ThrowOnError>({ url: '/bots/{bot_id}/budgets', ...options });
+
+/**
+ * Create bot budget
+ *
+ * Create a daily or monthly token or cost budget for a bot
+ */
+export const postBotsByBotIdBudgets = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdBudgetsData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdBudgetsResponses, PostBotsByBotIdBudgetsErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/budgets',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Update bot budget
+ *
+ * Update a bot budget; changes re-arm its notifications for the current period
+ */
+export const putBotsByBotIdBudgetsById = <ThrowOnError extends boolean = false>(options: Options<PutBotsByBotIdBudgetsByIdData, ThrowOnError>) => (options.client ?? client).put<PutBotsByBotIdBudgetsByIdResponses, PutBotsByBotIdBudgetsByIdErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/budgets/{id}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete bot budget
+ *
+ * Delete a bot budget
+ */
+export const deleteBotsByBotIdBudgetsById = <ThrowOnError extends boolean = false>(options: Options<DeleteBotsByBotIdBudgetsByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteBotsByBotIdBudgetsByIdResponses, DeleteBotsByBotIdBudgetsByIdErrors, ThrowOnError>({ url: '/bots/{bot_id}/budgets/{id}', ...options });
+
 /**
  * Delete compaction logs
  *
@@ -560,6 +743,41 @@ export const postBotsByBotIdContainerSnapshots = <ThrowOnError extends boolean =
     }
 });
 
+/**
+ * Diff snapshot versions
+ *
+ * List the files added, removed or modified between two versions, or between a version and the live workspace when to is omitted.
+ */
+export const getBotsByBotIdContainerSnapshotsDiff = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdContainerSnapshotsDiffData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdContainerSnapshotsDiffResponses, GetBotsByBotIdContainerSnapshotsDiffErrors, ThrowOnError>({ url: '/bots/{bot_id}/container/snapshots/diff', ...options });
+
+/**
+ * Get snapshot policy
+ *
+ * Get the scheduled snapshot, retention and pre-tool-call snapshot settings of a bot. Bots without a saved policy get a disabled default.
+ */
+export const getBotsByBotIdContainerSnapshotsPolicy = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdContainerSnapshotsPolicyData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdContainerSnapshotsPolicyResponses, GetBotsByBotIdContainerSnapshotsPolicyErrors, ThrowOnError>({ url: '/bots/{bot_id}/container/snapshots/policy', ...options });
+
+/**
+ * Update snapshot policy
+ *
+ * Enable scheduled snapshots with hourly, daily, weekly and monthly retention, and snapshots before exec commands matching a pattern or before skill installs. Automatic snapshots archive /data without restarting the workspace, and only they are pruned.
+ */
+export const putBotsByBotIdContainerSnapshotsPolicy = <ThrowOnError extends boolean = false>(options: Options<PutBotsByBotIdContainerSnapshotsPolicyData, ThrowOnError>) => (options.client ?? client).put<PutBotsByBotIdContainerSnapshotsPolicyResponses, PutBotsByBotIdContainerSnapshotsPolicyErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/container/snapshots/policy',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Prune snapshots
+ *
+ * Delete the automatic snapshots the bot's policy no longer keeps. Manual snapshots are never deleted.
+ */
+export const postBotsByBotIdContainerSnapshotsPrune = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdContainerSnapshotsPruneData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdContainerSnapshotsPruneResponses, PostBotsByBotIdContainerSnapshotsPruneErrors, ThrowOnError>({ url: '/bots/{bot_id}/container/snapshots/prune', ...options });
+
 /**
  * Rollback container to a previous snapshot version
  */
@@ -592,6 +810,13 @@ export const getBotsByBotIdContainerTerminal = <ThrowOnError extends boolean = f
  */
 export const getBotsByBotIdContainerTerminalWs = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdContainerTerminalWsData, ThrowOnError>) => (options.client ?? client).get<unknown, GetBotsByBotIdContainerTerminalWsErrors, ThrowOnError>({ url: '/bots/{bot_id}/container/terminal/ws', ...options });
 
+/**
+ * List bot delegations
+ *
+ * List tasks the bot delegated to other bots or received from them, newest first
+ */
+export const getBotsByBotIdDelegations = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdDelegationsData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdDelegationsResponses, GetBotsByBotIdDelegationsErrors, ThrowOnError>({ url: '/bots/{bot_id}/delegations', ...options });
+
 /**
  * List email bindings for a bot
  */
@@ -713,6 +938,20 @@ export const postBotsByBotIdMcpOpsBatchDelete = <ThrowOnError extends boolean =
     }
 });
 
+/**
+ * Bot remote MCP server
+ *
+ * Streamable HTTP MCP endpoint exposing the bot to external MCP clients. Tools: chat_with_bot, search_bot_memory, list_bot_files and run_bot_schedule; resources: workspace files and memories. Only what the caller may access is listed.
+ */
+export const postBotsByBotIdMcpServer = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdMcpServerData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdMcpServerResponses, PostBotsByBotIdMcpServerErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/mcp-server',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Create MCP stdio proxy
  *
@@ -916,6 +1155,20 @@ export const postBotsByBotIdMemoryCompact = <ThrowOnError extends boolean = fals
  */
 export const postBotsByBotIdMemoryRebuild = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdMemoryRebuildData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdMemoryRebuildResponses, PostBotsByBotIdMemoryRebuildErrors, ThrowOnError>({ url: '/bots/{bot_id}/memory/rebuild', ...options });
 
+/**
+ * Restore memory as of a time
+ *
+ * Rewrite all of a bot's memories to the state they had at the given time. Items created later are removed, and items changed or deleted since are restored.
+ */
+export const postBotsByBotIdMemoryRestore = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdMemoryRestoreData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdMemoryRestoreResponses, PostBotsByBotIdMemoryRestoreErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/memory/restore',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Search memory
  *
@@ -951,6 +1204,27 @@ export const getBotsByBotIdMemoryUsage = <ThrowOnError extends boolean = false>(
  */
 export const deleteBotsByBotIdMemoryById = <ThrowOnError extends boolean = false>(options: Options<DeleteBotsByBotIdMemoryByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteBotsByBotIdMemoryByIdResponses, DeleteBotsByBotIdMemoryByIdErrors, ThrowOnError>({ url: '/bots/{bot_id}/memory/{id}', ...options });
 
+/**
+ * Get memory revision history
+ *
+ * List every recorded revision of a memory item, oldest first, including who or what changed it and the formation decision behind it
+ */
+export const getBotsByBotIdMemoryByIdHistory = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdMemoryByIdHistoryData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdMemoryByIdHistoryResponses, GetBotsByBotIdMemoryByIdHistoryErrors, ThrowOnError>({ url: '/bots/{bot_id}/memory/{id}/history', ...options });
+
+/**
+ * Roll back a memory
+ *
+ * Rewrite a memory item with the content of an earlier revision. The rollback is recorded as a new revision.
+ */
+export const postBotsByBotIdMemoryByIdRollback = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdMemoryByIdRollbackData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdMemoryByIdRollbackResponses, PostBotsByBotIdMemoryByIdRollbackErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/memory/{id}/rollback',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Delete all bot history messages
  *
@@ -1066,10 +1340,24 @@ export const deleteBotsByBotIdScheduleLogs = <ThrowOnError extends boolean = fal
  */
 export const getBotsByBotIdScheduleLogs = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdScheduleLogsData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdScheduleLogsResponses, GetBotsByBotIdScheduleLogsErrors, ThrowOnError>({ url: '/bots/{bot_id}/schedule/logs', ...options });
 
+/**
+ * List failed schedule runs
+ *
+ * List schedule runs of a bot that failed for good (errors and dead letters)
+ */
+export const getBotsByBotIdScheduleLogsFailed = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdScheduleLogsFailedData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdScheduleLogsFailedResponses, GetBotsByBotIdScheduleLogsFailedErrors, ThrowOnError>({ url: '/bots/{bot_id}/schedule/logs/failed', ...options });
+
+/**
+ * Replay failed schedule run
+ *
+ * Re-run a failed schedule run in the background with its original trigger event. A run is not replayed again while a replay of it is in flight or after one succeeded
+ */
+export const postBotsByBotIdScheduleLogsByLogIdReplay = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdScheduleLogsByLogIdReplayData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdScheduleLogsByLogIdReplayResponses, PostBotsByBotIdScheduleLogsByLogIdReplayErrors, ThrowOnError>({ url: '/bots/{bot_id}/schedule/logs/{log_id}/replay', ...options });
+
 /**
  * Delete schedule
  *
- * Delete a schedule by ID
+ * Delete a schedule by ID. The schedule of a workflow is removed with the workflow or its cron pattern; deleting it returns 409.
  */
 export const deleteBotsByBotIdScheduleById = <ThrowOnError extends boolean = false>(options: Options<DeleteBotsByBotIdScheduleByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteBotsByBotIdScheduleByIdResponses, DeleteBotsByBotIdScheduleByIdErrors, ThrowOnError>({ url: '/bots/{bot_id}/schedule/{id}', ...options });
 
@@ -1083,7 +1371,7 @@ export const getBotsByBotIdScheduleById = <ThrowOnError extends boolean = false>
 /**
  * Update schedule
  *
- * Update a schedule by ID
+ * Update a schedule by ID. Only the retry policy of a workflow's schedule can be changed; 409 is returned for other changes.
  */
 export const putBotsByBotIdScheduleById = <ThrowOnError extends boolean = false>(options: Options<PutBotsByBotIdScheduleByIdData, ThrowOnError>) => (options.client ?? client).put<PutBotsByBotIdScheduleByIdResponses, PutBotsByBotIdScheduleByIdErrors, ThrowOnError>({
     url: '/bots/{bot_id}/schedule/{id}',
@@ -1256,6 +1544,20 @@ export const getBotsByBotIdTokenUsage = <ThrowOnError extends boolean = false>(o
  */
 export const getBotsByBotIdTokenUsageRecords = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdTokenUsageRecordsData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdTokenUsageRecordsResponses, GetBotsByBotIdTokenUsageRecordsErrors, ThrowOnError>({ url: '/bots/{bot_id}/token-usage/records', ...options });
 
+/**
+ * Test tool approval policies
+ *
+ * Evaluate a sample tool call against the bot's tool approval policies, or against an unsaved config, and report which rule decided
+ */
+export const postBotsByBotIdToolApprovalsDryRun = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdToolApprovalsDryRunData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdToolApprovalsDryRunResponses, PostBotsByBotIdToolApprovalsDryRunErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/tool-approvals/dry-run',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Approve a pending tool call
  */
@@ -1343,6 +1645,90 @@ export const getBotsByBotIdUserAccessCandidates = <ThrowOnError extends boolean
  */
 export const deleteBotsByBotIdUserAccessByGrantId = <ThrowOnError extends boolean = false>(options: Options<DeleteBotsByBotIdUserAccessByGrantIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteBotsByBotIdUserAccessByGrantIdResponses, DeleteBotsByBotIdUserAccessByGrantIdErrors, ThrowOnError>({ url: '/bots/{bot_id}/user-access/{grant_id}', ...options });
 
+/**
+ * List workflows
+ *
+ * List the workflows of a bot
+ */
+export const getBotsByBotIdWorkflows = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdWorkflowsData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdWorkflowsResponses, GetBotsByBotIdWorkflowsErrors, ThrowOnError>({ url: '/bots/{bot_id}/workflows', ...options });
+
+/**
+ * Create workflow
+ *
+ * Create a workflow from its YAML or JSON definition
+ */
+export const postBotsByBotIdWorkflows = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdWorkflowsData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdWorkflowsResponses, PostBotsByBotIdWorkflowsErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/workflows',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get workflow
+ *
+ * Get a workflow by ID
+ */
+export const getBotsByBotIdWorkflowsById = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdWorkflowsByIdData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdWorkflowsByIdResponses, GetBotsByBotIdWorkflowsByIdErrors, ThrowOnError>({ url: '/bots/{bot_id}/workflows/{id}', ...options });
+
+/**
+ * Update workflow
+ *
+ * Replace the definition of a workflow or enable and disable it. Runs in progress keep their definition.
+ */
+export const putBotsByBotIdWorkflowsById = <ThrowOnError extends boolean = false>(options: Options<PutBotsByBotIdWorkflowsByIdData, ThrowOnError>) => (options.client ?? client).put<PutBotsByBotIdWorkflowsByIdResponses, PutBotsByBotIdWorkflowsByIdErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/workflows/{id}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete workflow
+ *
+ * Delete a workflow with its run history. Runs in progress are cancelled.
+ */
+export const deleteBotsByBotIdWorkflowsById = <ThrowOnError extends boolean = false>(options: Options<DeleteBotsByBotIdWorkflowsByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteBotsByBotIdWorkflowsByIdResponses, DeleteBotsByBotIdWorkflowsByIdErrors, ThrowOnError>({ url: '/bots/{bot_id}/workflows/{id}', ...options });
+
+/**
+ * List workflow runs
+ *
+ * List the runs of a workflow, newest first, without their steps
+ */
+export const getBotsByBotIdWorkflowsByIdRuns = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdWorkflowsByIdRunsData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdWorkflowsByIdRunsResponses, GetBotsByBotIdWorkflowsByIdRunsErrors, ThrowOnError>({ url: '/bots/{bot_id}/workflows/{id}/runs', ...options });
+
+/**
+ * Run workflow
+ *
+ * Start a run of a workflow. The run continues in the background; poll it for progress.
+ */
+export const postBotsByBotIdWorkflowsByIdRuns = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdWorkflowsByIdRunsData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdWorkflowsByIdRunsResponses, PostBotsByBotIdWorkflowsByIdRunsErrors, ThrowOnError>({
+    url: '/bots/{bot_id}/workflows/{id}/runs',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get workflow run
+ *
+ * Get a run with the input, output and state of each step
+ */
+export const getBotsByBotIdWorkflowsByIdRunsByRunId = <ThrowOnError extends boolean = false>(options: Options<GetBotsByBotIdWorkflowsByIdRunsByRunIdData, ThrowOnError>) => (options.client ?? client).get<GetBotsByBotIdWorkflowsByIdRunsByRunIdResponses, GetBotsByBotIdWorkflowsByIdRunsByRunIdErrors, ThrowOnError>({ url: '/bots/{bot_id}/workflows/{id}/runs/{run_id}', ...options });
+
+/**
+ * Cancel workflow run
+ *
+ * Cancel a running run. Pending approvals and questions of the run are withdrawn.
+ */
+export const postBotsByBotIdWorkflowsByIdRunsByRunIdCancel = <ThrowOnError extends boolean = false>(options: Options<PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelData, ThrowOnError>) => (options.client ?? client).post<PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelResponses, PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelErrors, ThrowOnError>({ url: '/bots/{bot_id}/workflows/{id}/runs/{run_id}/cancel', ...options });
+
 /**
  * Update bot user access grant
  *
@@ -2157,6 +2543,41 @@ export const putUsersMePassword = <ThrowOnError extends boolean = false>(options
     }
 });
 
+/**
+ * List personal access tokens
+ *
+ * List the personal access tokens of the current user, including revoked and expired ones
+ */
+export const getUsersMeTokens = <ThrowOnError extends boolean = false>(options?: Options<GetUsersMeTokensData, ThrowOnError>) => (options?.client ?? client).get<GetUsersMeTokensResponses, GetUsersMeTokensErrors, ThrowOnError>({ url: '/users/me/tokens', ...options });
+
+/**
+ * Create personal access token
+ *
+ * Create a scoped personal access token. The token secret is only returned in this response.
+ */
+export const postUsersMeTokens = <ThrowOnError extends boolean = false>(options: Options<PostUsersMeTokensData, ThrowOnError>) => (options.client ?? client).post<PostUsersMeTokensResponses, PostUsersMeTokensErrors, ThrowOnError>({
+    url: '/users/me/tokens',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List token scopes
+ *
+ * List the scopes a personal access token can be granted
+ */
+export const getUsersMeTokensScopes = <ThrowOnError extends boolean = false>(options?: Options<GetUsersMeTokensScopesData, ThrowOnError>) => (options?.client ?? client).get<GetUsersMeTokensScopesResponses, unknown, ThrowOnError>({ url: '/users/me/tokens/scopes', ...options });
+
+/**
+ * Revoke personal access token
+ *
+ * Revoke a personal access token of the current user. Requests using it fail immediately.
+ */
+export const deleteUsersMeTokensById = <ThrowOnError extends boolean = false>(options: Options<DeleteUsersMeTokensByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteUsersMeTokensByIdResponses, DeleteUsersMeTokensByIdErrors, ThrowOnError>({ url: '/users/me/tokens/{id}', ...options });
+
 /**
  * Remove member (admin only)
  *
@@ -2198,3 +2619,80 @@ export const putUsersByIdPassword = <ThrowOnError extends boolean = false>(optio
         ...options.headers
     }
 });
+
+/**
+ * List user budgets
+ *
+ * List the budgets covering all bots owned by a user. Users may read their own; admins may read anyone's.
+ */
+export const getUsersByUserIdBudgets = <ThrowOnError extends boolean = false>(options: Options<GetUsersByUserIdBudgetsData, ThrowOnError>) => (options.client ?? client).get<GetUsersByUserIdBudgetsResponses, GetUsersByUserIdBudgetsErrors, ThrowOnError>({ url: '/users/{user_id}/budgets', ...options });
+
+/**
+ * Create user budget
+ *
+ * Create a budget covering all bots owned by a user (admin only)
+ */
+export const postUsersByUserIdBudgets = <ThrowOnError extends boolean = false>(options: Options<PostUsersByUserIdBudgetsData, ThrowOnError>) => (options.client ?? client).post<PostUsersByUserIdBudgetsResponses, PostUsersByUserIdBudgetsErrors, ThrowOnError>({
+    url: '/users/{user_id}/budgets',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Update user budget
+ *
+ * Update a user budget (admin only); changes re-arm its notifications for the current period
+ */
+export const putUsersByUserIdBudgetsById = <ThrowOnError extends boolean = false>(options: Options<PutUsersByUserIdBudgetsByIdData, ThrowOnError>) => (options.client ?? client).put<PutUsersByUserIdBudgetsByIdResponses, PutUsersByUserIdBudgetsByIdErrors, ThrowOnError>({
+    url: '/users/{user_id}/budgets/{id}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete user budget
+ *
+ * Delete a user budget (admin only)
+ */
+export const deleteUsersByUserIdBudgetsById = <ThrowOnError extends boolean = false>(options: Options<DeleteUsersByUserIdBudgetsByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteUsersByUserIdBudgetsByIdResponses, DeleteUsersByUserIdBudgetsByIdErrors, ThrowOnError>({ url: '/users/{user_id}/budgets/{id}', ...options });
+
+/**
+ * Chat with a bot through the Chat Completions API
+ *
+ * OpenAI-compatible chat completion. The model is a bot ID; it may be omitted with a token restricted to one bot. Tools the bot uses are reported as tool_call annotations. Pass X-Memoh-Session-Id to continue a session; otherwise a new session is created and earlier request messages are used as context. The session is returned in the same header.
+ */
+export const postV1ChatCompletions = <ThrowOnError extends boolean = false>(options: Options<PostV1ChatCompletionsData, ThrowOnError>) => (options.client ?? client).post<PostV1ChatCompletionsResponses, PostV1ChatCompletionsErrors, ThrowOnError>({
+    url: '/v1/chat/completions',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List bots as OpenAI models
+ *
+ * List the bots the caller can chat with, in the OpenAI model list format
+ */
+export const getV1Models = <ThrowOnError extends boolean = false>(options?: Options<GetV1ModelsData, ThrowOnError>) => (options?.client ?? client).get<GetV1ModelsResponses, GetV1ModelsErrors, ThrowOnError>({ url: '/v1/models', ...options });
+
+/**
+ * Chat with a bot through the Responses API
+ *
+ * OpenAI-compatible response. The model is a bot ID; it may be omitted with a token restricted to one bot. Response IDs identify the bot session, so previous_response_id continues it; X-Memoh-Session-Id works as well. Tools the bot uses are reported as tool_call annotations.
+ */
+export const postV1Responses = <ThrowOnError extends boolean = false>(options: Options<PostV1ResponsesData, ThrowOnError>) => (options.client ?? client).post<PostV1ResponsesResponses, PostV1ResponsesErrors, ThrowOnError>({
+    url: '/v1/responses',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
@@ -4,6 +4,53 @@ export type ClientOptions = {
     baseUrl: string;
 };
 
+export type AccesstokenCreateRequest = {
+    /**
+     * BotID restricts the token to a single bot.
+     */
+    bot_id?: string;
+    /**
+     * ExpiresInDays is the token lifetime; zero means the token never
+     * expires.
+     */
+    expires_in_days?: number;
+    name?: string;
+    scopes?: Array<string>;
+};
+
+export type AccesstokenCreateResponse = {
+    bot_id?: string;
+    created_at?: string;
+    expires_at?: string;
+    id?: string;
+    last_used_at?: string;
+    name?: string;
+    prefix?: string;
+    revoked_at?: string;
+    scopes?: Array<string>;
+    token?: string;
+};
+
+export type AccesstokenListResponse = {
+    items?: Array<AccesstokenToken>;
+};
+
+export type AccesstokenScopesResponse = {
+    scopes?: Array<string>;
+};
+
+export type AccesstokenToken = {
+    bot_id?: string;
+    created_at?: string;
+    expires_at?: string;
+    id?: string;
+    last_used_at?: string;
+    name?: string;
+    prefix?: string;
+    revoked_at?: string;
+    scopes?: Array<string>;
+};
+
 export type AccountsAccount = {
     avatar_url?: string;
     created_at?: string;
@@ -231,6 +278,35 @@ export type AdaptersMemoryItem = {
     updated_at?: string;
 };
 
+export type AdaptersMemoryRevision = {
+    /**
+     * baseline, create, update or delete
+     */
+    action?: string;
+    actor?: string;
+    chat_id?: string;
+    created_at?: string;
+    /**
+     * formation decision (ADD, UPDATE, DELETE)
+     */
+    decision?: string;
+    hash?: string;
+    memory?: string;
+    memory_id?: string;
+    metadata?: {
+        [key: string]: unknown;
+    };
+    reason?: string;
+    recorded_at?: string;
+    revision?: number;
+    session_id?: string;
+    /**
+     * api, chat, formation, compact, rollback or restore
+     */
+    source?: string;
+    updated_at?: string;
+};
+
 export type AdaptersMemoryStatusResponse = {
     can_manual_sync?: boolean;
     encoder?: AdaptersHealthStatus;
@@ -305,7 +381,7 @@ export type AdaptersProviderStatusResponse = {
     provider_type?: string;
 };
 
-export type AdaptersProviderType = 'builtin' | 'mem0' | 'openviking';
+export type AdaptersProviderType = 'builtin' | 'mem0' | 'openviking' | 'sqlite';
 
 export type AdaptersProviderUpdateRequest = {
     config?: {
@@ -321,6 +397,13 @@ export type AdaptersRebuildResult = {
     storage_count?: number;
 };
 
+export type AdaptersRestoreResult = {
+    as_of?: string;
+    kept?: number;
+    removed?: number;
+    restored?: number;
+};
+
 export type AdaptersSearchResponse = {
     relations?: Array<unknown>;
     results?: Array<AdaptersMemoryItem>;
@@ -484,6 +567,46 @@ export type AudioVoiceInfo = {
     name?: string;
 };
 
+export type AuditActor = {
+    id?: string;
+    type?: string;
+};
+
+export type AuditChange = {
+    after?: {
+        [key: string]: unknown;
+    };
+    before?: {
+        [key: string]: unknown;
+    };
+    field?: string;
+};
+
+export type AuditListResponse = {
+    items?: Array<AuditRecord>;
+    total?: number;
+};
+
+export type AuditRecord = {
+    action?: string;
+    actor?: AuditActor;
+    after?: {
+        [key: string]: unknown;
+    };
+    before?: {
+        [key: string]: unknown;
+    };
+    bot_id?: string;
+    changes?: Array<AuditChange>;
+    created_at?: string;
+    id?: number;
+    metadata?: {
+        [key: string]: unknown;
+    };
+    target_id?: string;
+    target_type?: string;
+};
+
 export type BotbackupExportRequest = {
     passphrase?: string;
     sections?: Array<BotbackupSection>;
@@ -578,6 +701,22 @@ export type BotbackupSummaryResult = {
     sections?: Array<BotbackupSectionSummary>;
 };
 
+export type BotbackupTemplateContents = {
+    /**
+     * IdentityFile reports whether the seed carries an AGENTS.md.
+     */
+    identity_file?: boolean;
+    sections?: Array<BotbackupSectionSummary>;
+    /**
+     * Skills are the skill directories under /data/skills in the seed.
+     */
+    skills?: Array<string>;
+    /**
+     * Warnings list what was stripped from the bundle, such as credentials.
+     */
+    warnings?: Array<string>;
+};
+
 export type BotsBot = {
     avatar_url?: string;
     check_issue_count?: number;
@@ -680,6 +819,101 @@ export type BotsUserGrant = {
     user_username?: string;
 };
 
+export type BottemplateCreateRequest = {
+    bot_id?: string;
+    description?: string;
+    /**
+     * Image defaults to the workspace image preference of the source bot.
+     */
+    image?: string;
+    name?: string;
+    shared?: boolean;
+};
+
+export type BottemplateInstantiateResult = {
+    bot?: BotsBot;
+    imported?: {
+        [key: string]: number;
+    };
+    template_id?: string;
+    warnings?: Array<string>;
+};
+
+export type BottemplateListResponse = {
+    items?: Array<BottemplateTemplate>;
+};
+
+export type BottemplateTemplate = {
+    contents?: BotbackupTemplateContents;
+    created_at?: string;
+    created_by_user_id?: string;
+    description?: string;
+    id?: string;
+    /**
+     * Image is the workspace image bots created from the template start
+     * from. Empty means the server's default workspace image.
+     */
+    image?: string;
+    name?: string;
+    /**
+     * Shared makes the template visible to every user. Otherwise only its
+     * creator and admins see, download and use it.
+     */
+    shared?: boolean;
+    size_bytes?: number;
+    source_bot_id?: string;
+    updated_at?: string;
+};
+
+export type BottemplateUpdateRequest = {
+    description?: string;
+    image?: string;
+    name?: string;
+    shared?: boolean;
+};
+
+export type BudgetCreateRequest = {
+    enabled?: boolean;
+    limit?: number;
+    period?: BudgetPeriod;
+    unit?: BudgetUnit;
+    warn_percent?: number;
+};
+
+export type BudgetListResponse = {
+    items?: Array<BudgetStatus>;
+};
+
+export type BudgetPeriod = 'daily' | 'monthly';
+
+export type BudgetStatus = {
+    bot_id?: string;
+    created_at?: string;
+    enabled?: boolean;
+    exceeded?: boolean;
+    id?: string;
+    limit?: number;
+    period?: BudgetPeriod;
+    period_end?: string;
+    period_start?: string;
+    unit?: BudgetUnit;
+    updated_at?: string;
+    used?: number;
+    user_id?: string;
+    warn_percent?: number;
+    warning?: boolean;
+};
+
+export type BudgetUnit = 'tokens' | 'cost';
+
+export type BudgetUpdateRequest = {
+    enabled?: boolean;
+    limit?: number;
+    period?: BudgetPeriod;
+    unit?: BudgetUnit;
+    warn_percent?: number;
+};
+
 export type ChannelAction = {
     label?: string;
     /**
@@ -911,6 +1145,32 @@ export type CompactionLog = {
     usage?: unknown;
 };
 
+export type DelegationDelegation = {
+    chain?: Array<string>;
+    completed_at?: string;
+    created_at?: string;
+    error?: string;
+    from_bot_id?: string;
+    from_session_id?: string;
+    hop?: number;
+    id?: string;
+    model_id?: string;
+    parent_id?: string;
+    result?: string;
+    status?: string;
+    task?: string;
+    to_bot_id?: string;
+    to_bot_name?: string;
+    to_session_id?: string;
+    usage?: {
+        [key: string]: unknown;
+    };
+};
+
+export type DelegationListResponse = {
+    items?: Array<DelegationDelegation>;
+};
+
 export type DisplaySessionInfo = {
     codec?: string;
     created_at?: string;
@@ -1320,6 +1580,14 @@ export type HandlersListSnapshotsResponse = {
 export type HandlersLocalChannelMessageRequest = {
     message?: ChannelMessage;
     model_id?: string;
+    /**
+     * OutputSchema constrains the reply to JSON matching this JSON Schema.
+     * The validated JSON is stored in the reply's metadata under
+     * "structured_output".
+     */
+    output_schema?: {
+        [key: string]: unknown;
+    };
     reasoning_effort?: string;
 };
 
@@ -1355,6 +1623,17 @@ export type HandlersMcpStdioResponse = {
     url?: string;
 };
 
+export type HandlersMemoryRestorePayload = {
+    /**
+     * RFC3339 timestamp
+     */
+    as_of?: string;
+};
+
+export type HandlersMemoryRollbackPayload = {
+    revision?: number;
+};
+
 export type HandlersModelTokenUsage = {
     input_tokens?: number;
     model_id?: string;
@@ -1364,6 +1643,21 @@ export type HandlersModelTokenUsage = {
     provider_name?: string;
 };
 
+export type HandlersOIDCAuthorizeResponse = {
+    authorization_url?: string;
+    state?: string;
+};
+
+export type HandlersOIDCConfigResponse = {
+    display_name?: string;
+    enabled?: boolean;
+};
+
+export type HandlersOIDCExchangeRequest = {
+    code?: string;
+    state?: string;
+};
+
 export type HandlersPingResponse = {
     commit_hash?: string;
     container_backend?: string;
@@ -1859,9 +2153,16 @@ export type ModelsModelConfig = {
     compatibilities?: Array<string>;
     context_window?: number;
     dimensions?: number;
+    pricing?: ModelsModelPricing;
     reasoning_efforts?: Array<string>;
 };
 
+export type ModelsModelPricing = {
+    cache_read_per_million?: number;
+    input_per_million?: number;
+    output_per_million?: number;
+};
+
 export type ModelsModelType = 'chat' | 'embedding' | 'speech' | 'transcription';
 
 export type ModelsTestResponse = {
@@ -1881,68 +2182,238 @@ export type ModelsUpdateRequest = {
     type?: ModelsModelType;
 };
 
-export type PluginsAuthRequirement = {
-    client_ref?: string;
-    key?: string;
-    scopes?: Array<string>;
+export type OpenaicompatAnnotation = {
+    tool_call?: OpenaicompatToolCallAnnotation;
     type?: string;
-    variables?: Array<string>;
 };
 
-export type PluginsAuthor = {
-    email?: string;
-    name?: string;
+export type OpenaicompatChatChoice = {
+    finish_reason?: string;
+    index?: number;
+    message?: OpenaicompatChatResponseMessage;
 };
 
-export type PluginsConfigVar = {
-    defaultValue?: string;
-    description?: string;
-    key?: string;
-    required?: boolean;
-    secret?: boolean;
+export type OpenaicompatChatCompletion = {
+    choices?: Array<OpenaicompatChatChoice>;
+    created?: number;
+    id?: string;
+    model?: string;
+    object?: string;
+    usage?: OpenaicompatChatUsage;
 };
 
-export type PluginsIcon = {
-    kind?: string;
-    name?: string;
-    url?: string;
+export type OpenaicompatChatCompletionRequest = {
+    messages?: Array<OpenaicompatChatMessage>;
+    model?: string;
+    reasoning_effort?: string;
+    response_format?: OpenaicompatResponseFormat;
+    stream?: boolean;
+    stream_options?: OpenaicompatStreamOptions;
+    user?: string;
 };
 
-export type PluginsInstallRequest = {
-    manifest?: PluginsManifest;
-    variables?: {
-        [key: string]: string;
+export type OpenaicompatChatMessage = {
+    content?: {
+        [key: string]: unknown;
     };
+    name?: string;
+    role?: string;
 };
 
-export type PluginsInstallation = {
-    bot_id?: string;
-    config?: {
+export type OpenaicompatChatResponseMessage = {
+    annotations?: Array<OpenaicompatAnnotation>;
+    content?: string;
+    reasoning_content?: string;
+    role?: string;
+};
+
+export type OpenaicompatChatUsage = {
+    completion_tokens?: number;
+    prompt_tokens?: number;
+    total_tokens?: number;
+};
+
+export type OpenaicompatErrorBody = {
+    code?: string;
+    message?: string;
+    type?: string;
+};
+
+export type OpenaicompatErrorResponse = {
+    error?: OpenaicompatErrorBody;
+};
+
+export type OpenaicompatIncompleteDetails = {
+    reason?: string;
+};
+
+export type OpenaicompatJSONSchema = {
+    name?: string;
+    schema?: {
         [key: string]: unknown;
     };
-    enabled?: boolean;
+    strict?: boolean;
+};
+
+export type OpenaicompatModel = {
+    created?: number;
     id?: string;
-    installed_at?: string;
-    manifest?: PluginsManifest;
-    metadata?: {
-        [key: string]: unknown;
-    };
-    plugin_id?: string;
-    plugin_name?: string;
-    resources?: Array<PluginsResource>;
+    object?: string;
+    owned_by?: string;
+};
+
+export type OpenaicompatModelList = {
+    data?: Array<OpenaicompatModel>;
+    object?: string;
+};
+
+export type OpenaicompatResponse = {
+    created_at?: number;
+    error?: OpenaicompatResponseError;
+    id?: string;
+    incomplete_details?: OpenaicompatIncompleteDetails;
+    model?: string;
+    object?: string;
+    output?: Array<OpenaicompatResponseOutputItem>;
+    previous_response_id?: string;
     status?: string;
-    updated_at?: string;
-    version?: string;
+    usage?: OpenaicompatResponseUsage;
 };
 
-export type PluginsListResponse = {
-    items?: Array<PluginsInstallation>;
+export type OpenaicompatResponseContent = {
+    annotations?: Array<OpenaicompatAnnotation>;
+    text?: string;
+    type?: string;
 };
 
-export type PluginsMcpResource = {
-    args?: Array<string>;
-    auth_ref?: string;
-    capabilities?: Array<string>;
+export type OpenaicompatResponseError = {
+    code?: string;
+    message?: string;
+};
+
+export type OpenaicompatResponseFormat = {
+    json_schema?: OpenaicompatJSONSchema;
+    type?: string;
+};
+
+export type OpenaicompatResponseOutputItem = {
+    content?: Array<OpenaicompatResponseContent>;
+    id?: string;
+    role?: string;
+    status?: string;
+    type?: string;
+};
+
+export type OpenaicompatResponseReasoning = {
+    effort?: string;
+};
+
+export type OpenaicompatResponseRequest = {
+    input?: {
+        [key: string]: unknown;
+    };
+    instructions?: string;
+    model?: string;
+    previous_response_id?: string;
+    reasoning?: OpenaicompatResponseReasoning;
+    stream?: boolean;
+    text?: OpenaicompatResponseTextConfig;
+    user?: string;
+};
+
+export type OpenaicompatResponseTextConfig = {
+    format?: OpenaicompatResponseTextFormat;
+};
+
+export type OpenaicompatResponseTextFormat = {
+    name?: string;
+    schema?: {
+        [key: string]: unknown;
+    };
+    strict?: boolean;
+    type?: string;
+};
+
+export type OpenaicompatResponseUsage = {
+    input_tokens?: number;
+    output_tokens?: number;
+    total_tokens?: number;
+};
+
+export type OpenaicompatStreamOptions = {
+    include_usage?: boolean;
+};
+
+export type OpenaicompatToolCallAnnotation = {
+    approval_id?: string;
+    arguments?: string;
+    id?: string;
+    name?: string;
+    status?: string;
+};
+
+export type PluginsAuthRequirement = {
+    client_ref?: string;
+    key?: string;
+    scopes?: Array<string>;
+    type?: string;
+    variables?: Array<string>;
+};
+
+export type PluginsAuthor = {
+    email?: string;
+    name?: string;
+};
+
+export type PluginsConfigVar = {
+    defaultValue?: string;
+    description?: string;
+    key?: string;
+    required?: boolean;
+    secret?: boolean;
+};
+
+export type PluginsIcon = {
+    kind?: string;
+    name?: string;
+    url?: string;
+};
+
+export type PluginsInstallRequest = {
+    manifest?: PluginsManifest;
+    variables?: {
+        [key: string]: string;
+    };
+};
+
+export type PluginsInstallation = {
+    bot_id?: string;
+    config?: {
+        [key: string]: unknown;
+    };
+    enabled?: boolean;
+    id?: string;
+    installed_at?: string;
+    manifest?: PluginsManifest;
+    metadata?: {
+        [key: string]: unknown;
+    };
+    plugin_id?: string;
+    plugin_name?: string;
+    resources?: Array<PluginsResource>;
+    status?: string;
+    updated_at?: string;
+    version?: string;
+};
+
+export type PluginsListResponse = {
+    items?: Array<PluginsInstallation>;
+};
+
+export type PluginsMcpResource = {
+    args?: Array<string>;
+    auth_ref?: string;
+    capabilities?: Array<string>;
     command?: string;
     cwd?: string;
     description?: string;
@@ -2108,7 +2579,16 @@ export type ScheduleCreateRequest = {
     enabled?: boolean;
     max_calls?: ScheduleNullableInt;
     name?: string;
+    /**
+     * OutputSchema is an optional JSON Schema for structured run results.
+     */
+    output_schema?: {
+        [key: string]: unknown;
+    };
     pattern?: string;
+    retry_policy?: ScheduleRetryPolicy;
+    trigger_filter?: ScheduleTriggerFilter;
+    trigger_kind?: ScheduleTriggerKind;
 };
 
 export type ScheduleListLogsResponse = {
@@ -2121,15 +2601,25 @@ export type ScheduleListResponse = {
 };
 
 export type ScheduleLog = {
+    attempt?: number;
     bot_id?: string;
     completed_at?: string;
     error_message?: string;
     id?: string;
+    replay_of?: string;
+    /**
+     * ResultJSON is the validated structured result of a run of a schedule
+     * with an output schema.
+     */
+    result_json?: {
+        [key: string]: unknown;
+    };
     result_text?: string;
     schedule_id?: string;
     session_id?: string;
     started_at?: string;
     status?: string;
+    trigger_event?: string;
     usage?: unknown;
 };
 
@@ -2138,6 +2628,28 @@ export type ScheduleNullableInt = {
     value?: number;
 };
 
+export type ScheduleRetryPolicy = {
+    /**
+     * InitialBackoffSeconds is the delay before the first retry; each later
+     * retry doubles it. Defaults to 60.
+     */
+    initial_backoff_seconds?: number;
+    /**
+     * Jitter shortens each delay by a random fraction of up to this value
+     * (0-1) so retries of many schedules do not fire in lockstep.
+     */
+    jitter?: number;
+    /**
+     * MaxAttempts is the total number of attempts per run, including the
+     * first one. Values below 2 disable retries.
+     */
+    max_attempts?: number;
+    /**
+     * MaxBackoffSeconds caps the delay between attempts. Defaults to 3600.
+     */
+    max_backoff_seconds?: number;
+};
+
 export type ScheduleSchedule = {
     bot_id?: string;
     command?: string;
@@ -2148,17 +2660,74 @@ export type ScheduleSchedule = {
     id?: string;
     max_calls?: number;
     name?: string;
+    /**
+     * OutputSchema is the JSON Schema the final reply of every run must
+     * satisfy. Runs of a schedule without one return free text only.
+     */
+    output_schema?: {
+        [key: string]: unknown;
+    };
     pattern?: string;
+    retry_policy?: ScheduleRetryPolicy;
+    trigger_filter?: ScheduleTriggerFilter;
+    trigger_kind?: ScheduleTriggerKind;
     updated_at?: string;
+    /**
+     * WorkflowID is set on the schedule that runs a workflow on its cron
+     * pattern. Only its retry policy can be changed; the rest follows the
+     * workflow.
+     */
+    workflow_id?: string;
+};
+
+export type ScheduleTriggerFilter = {
+    /**
+     * ConnectionID restricts mcp_status triggers to a single MCP connection.
+     */
+    connection_id?: string;
+    /**
+     * From is matched case-insensitively as a substring of the email sender.
+     */
+    from?: string;
+    /**
+     * Pattern is a regular expression matched against the channel message text
+     * or the background task command.
+     */
+    pattern?: string;
+    /**
+     * RouteID restricts channel_message triggers to a single conversation route.
+     */
+    route_id?: string;
+    /**
+     * Status matches the background task status (completed, failed, killed)
+     * or the new MCP connection status (connected, error).
+     */
+    status?: string;
+    /**
+     * Subject is a regular expression matched against the email subject.
+     */
+    subject?: string;
 };
 
+export type ScheduleTriggerKind = 'cron' | 'email' | 'channel_message' | 'background_task' | 'mcp_status';
+
 export type ScheduleUpdateRequest = {
     command?: string;
     description?: string;
     enabled?: boolean;
     max_calls?: ScheduleNullableInt;
     name?: string;
+    /**
+     * OutputSchema replaces the schedule's output schema when present; an
+     * explicit null removes it.
+     */
+    output_schema?: {
+        [key: string]: unknown;
+    };
     pattern?: string;
+    retry_policy?: ScheduleRetryPolicy;
+    trigger_filter?: ScheduleTriggerFilter;
+    trigger_kind?: ScheduleTriggerKind;
 };
 
 export type SearchprovidersCreateRequest = {
@@ -2243,6 +2812,7 @@ export type SettingsSettings = {
     compaction_threshold?: number;
     discuss_probe_model_id?: string;
     display_enabled?: boolean;
+    fallback_model_ids?: Array<string>;
     heartbeat_enabled?: boolean;
     heartbeat_interval?: number;
     heartbeat_model_id?: string;
@@ -2266,10 +2836,25 @@ export type SettingsSettings = {
     tts_model_id?: string;
 };
 
+export type SettingsToolApprovalApprover = {
+    acl_rule_id?: string;
+    channel_identity_id?: string;
+};
+
 export type SettingsToolApprovalConfig = {
     edit?: SettingsToolApprovalFilePolicy;
     enabled?: boolean;
     exec?: SettingsToolApprovalExecPolicy;
+    /**
+     * Policies are evaluated in order before the write/edit/exec rules
+     * above; the first enabled rule whose expression is true decides.
+     */
+    policies?: Array<SettingsToolApprovalPolicyRule>;
+    /**
+     * Workflow decides who may answer approval requests that have no
+     * rule-level workflow. Nil lets anyone in the conversation decide.
+     */
+    workflow?: SettingsToolApprovalWorkflow;
     write?: SettingsToolApprovalFilePolicy;
 };
 
@@ -2285,6 +2870,42 @@ export type SettingsToolApprovalFilePolicy = {
     require_approval?: boolean;
 };
 
+export type SettingsToolApprovalPolicyRule = {
+    disabled?: boolean;
+    effect?: string;
+    expression?: string;
+    name?: string;
+    /**
+     * Workflow overrides the config-level workflow for calls this rule
+     * sends for approval. Only valid with the require_approval effect.
+     */
+    workflow?: SettingsToolApprovalWorkflow;
+};
+
+export type SettingsToolApprovalWorkflow = {
+    /**
+     * Approvers may vote on the request. Empty lets anyone in the
+     * conversation decide, as without a workflow.
+     */
+    approvers?: Array<SettingsToolApprovalApprover>;
+    /**
+     * EscalateAfterSeconds hands the request to EscalateTo when it is still
+     * pending after this long. A single escalation approver can then decide.
+     */
+    escalate_after_seconds?: number;
+    escalate_to?: Array<SettingsToolApprovalApprover>;
+    /**
+     * ExpireAfterSeconds rejects the request, as expired, when it is still
+     * pending after this long.
+     */
+    expire_after_seconds?: number;
+    /**
+     * Quorum is how many approvers must approve; 0 means 1. A reject from
+     * any approver rejects the request.
+     */
+    quorum?: number;
+};
+
 export type SettingsUpsertRequest = {
     acl_default_effect?: string;
     chat_model_id?: string;
@@ -2295,6 +2916,7 @@ export type SettingsUpsertRequest = {
     compaction_threshold?: number;
     discuss_probe_model_id?: string;
     display_enabled?: boolean;
+    fallback_model_ids?: Array<string>;
     heartbeat_enabled?: boolean;
     heartbeat_interval?: number;
     heartbeat_model_id?: string;
@@ -2318,190 +2940,2817 @@ export type SettingsUpsertRequest = {
     tts_model_id?: string;
 };
 
-export type GetAcpProfilesData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/acp/profiles';
-};
-
-export type GetAcpProfilesResponses = {
+export type SnapshotpolicyPolicy = {
+    before_skill_install?: boolean;
+    enabled?: boolean;
     /**
-     * OK
+     * ExecPatterns are regular expressions; an exec command matching any of
+     * them is preceded by a snapshot.
      */
-    200: AcpprofileProfilesResponse;
+    exec_patterns?: Array<string>;
+    keep_daily?: number;
+    keep_hourly?: number;
+    keep_monthly?: number;
+    keep_weekly?: number;
+    last_run_at?: string;
 };
 
-export type GetAcpProfilesResponse = GetAcpProfilesResponses[keyof GetAcpProfilesResponses];
+export type SnapshotpolicyPruneResult = {
+    deleted?: Array<number>;
+    errors?: Array<string>;
+    failed?: Array<number>;
+};
 
-export type PostAuthLoginData = {
+export type ToolapprovalDryRunRequest = {
+    arguments?: {
+        [key: string]: unknown;
+    };
+    channel_identity_id?: string;
     /**
-     * Login request
+     * Config tests an unsaved tool approval config instead of the stored one.
      */
-    body: HandlersLoginRequest;
-    path?: never;
-    query?: never;
-    url: '/auth/login';
+    config?: SettingsToolApprovalConfig;
+    conversation_type?: string;
+    platform?: string;
+    session_type?: string;
+    /**
+     * Time defaults to now.
+     */
+    time?: string;
+    tool_name?: string;
 };
 
-export type PostAuthLoginErrors = {
+export type ToolapprovalPolicyResult = {
     /**
-     * Bad Request
+     * Effect is allow, deny or require_approval.
      */
-    400: HandlersErrorResponse;
+    effect?: string;
+    errors?: Array<ToolapprovalPolicyRuleError>;
+    expression?: string;
+    rule_index?: number;
+    rule_name?: string;
     /**
-     * Unauthorized
+     * Source is "policy" when a policy rule matched, "builtin" when the
+     * write/edit/exec rules decided and "disabled" when approval is off.
      */
-    401: HandlersErrorResponse;
+    source?: string;
     /**
-     * Internal Server Error
+     * Workflow is the approval workflow a require_approval result is
+     * routed through, from the matched rule or the config.
      */
-    500: HandlersErrorResponse;
+    workflow?: SettingsToolApprovalWorkflow;
 };
 
-export type PostAuthLoginError = PostAuthLoginErrors[keyof PostAuthLoginErrors];
-
-export type PostAuthLoginResponses = {
-    /**
-     * OK
-     */
-    200: HandlersLoginResponse;
+export type ToolapprovalPolicyRuleError = {
+    error?: string;
+    index?: number;
+    name?: string;
 };
 
-export type PostAuthLoginResponse = PostAuthLoginResponses[keyof PostAuthLoginResponses];
-
-export type PostAuthRefreshData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/auth/refresh';
+export type WorkflowCreateRequest = {
+    enabled?: boolean;
+    source?: string;
 };
 
-export type PostAuthRefreshErrors = {
+export type WorkflowDefinition = {
+    description?: string;
     /**
-     * Unauthorized
+     * Inputs are default values for the inputs of a run; a manual trigger
+     * may override them.
      */
-    401: HandlersErrorResponse;
+    inputs?: {
+        [key: string]: unknown;
+    };
+    name?: string;
     /**
-     * Internal Server Error
+     * Schedule is a cron expression that triggers the workflow, in the
+     * timezone of its bot. Empty workflows only run when triggered manually.
      */
-    500: HandlersErrorResponse;
+    schedule?: string;
+    steps?: Array<WorkflowStep>;
 };
 
-export type PostAuthRefreshError = PostAuthRefreshErrors[keyof PostAuthRefreshErrors];
+export type WorkflowListResponse = {
+    items?: Array<WorkflowWorkflow>;
+};
 
-export type PostAuthRefreshResponses = {
-    /**
-     * OK
-     */
-    200: HandlersRefreshResponse;
+export type WorkflowRun = {
+    bot_id?: string;
+    completed_at?: string;
+    created_at?: string;
+    definition?: WorkflowDefinition;
+    error?: string;
+    id?: string;
+    inputs?: {
+        [key: string]: unknown;
+    };
+    session_id?: string;
+    status?: string;
+    steps?: Array<WorkflowStepRun>;
+    trigger?: string;
+    triggered_by_user_id?: string;
+    workflow_id?: string;
 };
 
-export type PostAuthRefreshResponse = PostAuthRefreshResponses[keyof PostAuthRefreshResponses];
+export type WorkflowRunListResponse = {
+    items?: Array<WorkflowRun>;
+    total?: number;
+};
 
-export type GetBotsData = {
+export type WorkflowStep = {
+    approval?: SettingsToolApprovalWorkflow;
+    arguments?: {
+        [key: string]: unknown;
+    };
+    body?: unknown;
+    /**
+     * agent: Prompt is run as Bot, which defaults to the workflow's bot.
+     */
+    bot?: string;
+    /**
+     * ContinueOnError lets dependent steps run when this step fails.
+     */
+    continue_on_error?: boolean;
+    headers?: {
+        [key: string]: string;
+    };
+    id?: string;
+    /**
+     * condition: a CEL expression over inputs and steps. Steps that need a
+     * condition which evaluated to false are skipped.
+     */
+    if?: string;
+    /**
+     * fan_out: Step runs once per element of Items, which must resolve to
+     * a list.
+     */
+    items?: unknown;
+    /**
+     * approval: Message is shown to approvers, routed by Approval when set.
+     */
+    message?: string;
+    /**
+     * http: a request to URL. Non-string bodies are sent as JSON.
+     */
+    method?: string;
+    needs?: Array<string>;
+    prompt?: string;
+    /**
+     * input: ask_user style questions answered by a person.
+     */
+    questions?: Array<{
+        [key: string]: unknown;
+    }>;
+    step?: WorkflowStep;
+    timeout_seconds?: number;
+    /**
+     * tool: Tool is called with Arguments in the workflow bot's context.
+     */
+    tool?: string;
+    type?: string;
+    url?: string;
+};
+
+export type WorkflowStepRun = {
+    completed_at?: string;
+    error?: string;
+    input?: {
+        [key: string]: unknown;
+    };
+    output?: unknown;
+    ref?: string;
+    session_id?: string;
+    started_at?: string;
+    status?: string;
+    step_id?: string;
+    type?: string;
+};
+
+export type WorkflowTriggerRequest = {
+    inputs?: {
+        [key: string]: unknown;
+    };
+};
+
+export type WorkflowUpdateRequest = {
+    enabled?: boolean;
+    source?: string;
+};
+
+export type WorkflowWorkflow = {
+    bot_id?: string;
+    created_at?: string;
+    definition?: WorkflowDefinition;
+    description?: string;
+    enabled?: boolean;
+    id?: string;
+    name?: string;
+    schedule?: string;
+    source?: string;
+    updated_at?: string;
+};
+
+export type WorkspaceFileChange = {
+    change?: string;
+    new_size?: number;
+    old_size?: number;
+    path?: string;
+};
+
+export type WorkspaceVersionDiff = {
+    added?: number;
+    changes?: Array<WorkspaceFileChange>;
+    from?: number;
+    modified?: number;
+    removed?: number;
+    to?: number;
+    truncated?: boolean;
+};
+
+export type GetAcpProfilesData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/acp/profiles';
+};
+
+export type GetAcpProfilesResponses = {
+    /**
+     * OK
+     */
+    200: AcpprofileProfilesResponse;
+};
+
+export type GetAcpProfilesResponse = GetAcpProfilesResponses[keyof GetAcpProfilesResponses];
+
+export type GetAuditLogsData = {
     body?: never;
     path?: never;
     query?: {
         /**
-         * Owner user ID (admin only)
+         * Bot ID
          */
-        owner_id?: string;
+        bot_id?: string;
+        /**
+         * Actor type (user, bot, schedule, channel_identity, system)
+         */
+        actor_type?: string;
+        /**
+         * Actor ID
+         */
+        actor_id?: string;
+        /**
+         * Action, or a dotted prefix such as acl or mcp.connection
+         */
+        action?: string;
+        /**
+         * Target type
+         */
+        target_type?: string;
+        /**
+         * Target ID
+         */
+        target_id?: string;
+        /**
+         * Earliest entry time (RFC3339, inclusive)
+         */
+        since?: string;
+        /**
+         * Latest entry time (RFC3339, exclusive)
+         */
+        until?: string;
+        /**
+         * Page size (default 50, max 500)
+         */
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
     };
-    url: '/bots';
+    url: '/audit-logs';
 };
 
-export type GetBotsErrors = {
+export type GetAuditLogsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetAuditLogsError = GetAuditLogsErrors[keyof GetAuditLogsErrors];
+
+export type GetAuditLogsResponses = {
+    /**
+     * OK
+     */
+    200: AuditListResponse;
+};
+
+export type GetAuditLogsResponse = GetAuditLogsResponses[keyof GetAuditLogsResponses];
+
+export type GetAuditLogsExportData = {
+    body?: never;
+    path?: never;
+    query?: {
+        /**
+         * Bot ID
+         */
+        bot_id?: string;
+        /**
+         * Actor type (user, bot, schedule, channel_identity, system)
+         */
+        actor_type?: string;
+        /**
+         * Actor ID
+         */
+        actor_id?: string;
+        /**
+         * Action, or a dotted prefix such as acl or mcp.connection
+         */
+        action?: string;
+        /**
+         * Target type
+         */
+        target_type?: string;
+        /**
+         * Target ID
+         */
+        target_id?: string;
+        /**
+         * Earliest entry time (RFC3339, inclusive)
+         */
+        since?: string;
+        /**
+         * Latest entry time (RFC3339, exclusive)
+         */
+        until?: string;
+    };
+    url: '/audit-logs/export';
+};
+
+export type GetAuditLogsExportErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetAuditLogsExportError = GetAuditLogsExportErrors[keyof GetAuditLogsExportErrors];
+
+export type GetAuditLogsExportResponses = {
+    /**
+     * OK
+     */
+    200: Blob | File;
+};
+
+export type GetAuditLogsExportResponse = GetAuditLogsExportResponses[keyof GetAuditLogsExportResponses];
+
+export type PostAuthLoginData = {
+    /**
+     * Login request
+     */
+    body: HandlersLoginRequest;
+    path?: never;
+    query?: never;
+    url: '/auth/login';
+};
+
+export type PostAuthLoginErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostAuthLoginError = PostAuthLoginErrors[keyof PostAuthLoginErrors];
+
+export type PostAuthLoginResponses = {
+    /**
+     * OK
+     */
+    200: HandlersLoginResponse;
+};
+
+export type PostAuthLoginResponse = PostAuthLoginResponses[keyof PostAuthLoginResponses];
+
+export type GetAuthOidcData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/auth/oidc';
+};
+
+export type GetAuthOidcResponses = {
+    /**
+     * OK
+     */
+    200: HandlersOIDCConfigResponse;
+};
+
+export type GetAuthOidcResponse = GetAuthOidcResponses[keyof GetAuthOidcResponses];
+
+export type PostAuthOidcAuthorizeData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/auth/oidc/authorize';
+};
+
+export type PostAuthOidcAuthorizeErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Bad Gateway
+     */
+    502: HandlersErrorResponse;
+};
+
+export type PostAuthOidcAuthorizeError = PostAuthOidcAuthorizeErrors[keyof PostAuthOidcAuthorizeErrors];
+
+export type PostAuthOidcAuthorizeResponses = {
+    /**
+     * OK
+     */
+    200: HandlersOIDCAuthorizeResponse;
+};
+
+export type PostAuthOidcAuthorizeResponse = PostAuthOidcAuthorizeResponses[keyof PostAuthOidcAuthorizeResponses];
+
+export type PostAuthOidcExchangeData = {
+    /**
+     * Authorization response
+     */
+    body: HandlersOIDCExchangeRequest;
+    path?: never;
+    query?: never;
+    url: '/auth/oidc/exchange';
+};
+
+export type PostAuthOidcExchangeErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: HandlersErrorResponse;
     /**
      * Forbidden
      */
-    403: HandlersErrorResponse;
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostAuthOidcExchangeError = PostAuthOidcExchangeErrors[keyof PostAuthOidcExchangeErrors];
+
+export type PostAuthOidcExchangeResponses = {
+    /**
+     * OK
+     */
+    200: HandlersLoginResponse;
+};
+
+export type PostAuthOidcExchangeResponse = PostAuthOidcExchangeResponses[keyof PostAuthOidcExchangeResponses];
+
+export type PostAuthRefreshData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/auth/refresh';
+};
+
+export type PostAuthRefreshErrors = {
+    /**
+     * Unauthorized
+     */
+    401: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostAuthRefreshError = PostAuthRefreshErrors[keyof PostAuthRefreshErrors];
+
+export type PostAuthRefreshResponses = {
+    /**
+     * OK
+     */
+    200: HandlersRefreshResponse;
+};
+
+export type PostAuthRefreshResponse = PostAuthRefreshResponses[keyof PostAuthRefreshResponses];
+
+export type GetBotTemplatesData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/bot-templates';
+};
+
+export type GetBotTemplatesErrors = {
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotTemplatesError = GetBotTemplatesErrors[keyof GetBotTemplatesErrors];
+
+export type GetBotTemplatesResponses = {
+    /**
+     * OK
+     */
+    200: BottemplateListResponse;
+};
+
+export type GetBotTemplatesResponse = GetBotTemplatesResponses[keyof GetBotTemplatesResponses];
+
+export type PostBotTemplatesData = {
+    /**
+     * Template payload
+     */
+    body: BottemplateCreateRequest;
+    path?: never;
+    query?: never;
+    url: '/bot-templates';
+};
+
+export type PostBotTemplatesErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotTemplatesError = PostBotTemplatesErrors[keyof PostBotTemplatesErrors];
+
+export type PostBotTemplatesResponses = {
+    /**
+     * Created
+     */
+    201: BottemplateTemplate;
+};
+
+export type PostBotTemplatesResponse = PostBotTemplatesResponses[keyof PostBotTemplatesResponses];
+
+export type PostBotTemplatesImportData = {
+    body: {
+        /**
+         * Bot backup bundle (.memoh.zip)
+         */
+        file: Blob | File;
+        /**
+         * Template name
+         */
+        name: string;
+        /**
+         * Template description
+         */
+        description?: string;
+        /**
+         * Workspace image reference
+         */
+        image?: string;
+        /**
+         * Share the template with every user
+         */
+        shared?: boolean;
+    };
+    path?: never;
+    query?: never;
+    url: '/bot-templates/import';
+};
+
+export type PostBotTemplatesImportErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotTemplatesImportError = PostBotTemplatesImportErrors[keyof PostBotTemplatesImportErrors];
+
+export type PostBotTemplatesImportResponses = {
+    /**
+     * Created
+     */
+    201: BottemplateTemplate;
+};
+
+export type PostBotTemplatesImportResponse = PostBotTemplatesImportResponses[keyof PostBotTemplatesImportResponses];
+
+export type GetBotTemplatesByIdData = {
+    body?: never;
+    path: {
+        /**
+         * Template ID or name
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/bot-templates/{id}';
+};
+
+export type GetBotTemplatesByIdErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotTemplatesByIdError = GetBotTemplatesByIdErrors[keyof GetBotTemplatesByIdErrors];
+
+export type GetBotTemplatesByIdResponses = {
+    /**
+     * OK
+     */
+    200: BottemplateTemplate;
+};
+
+export type GetBotTemplatesByIdResponse = GetBotTemplatesByIdResponses[keyof GetBotTemplatesByIdResponses];
+
+export type PutBotTemplatesByIdData = {
+    /**
+     * Template changes
+     */
+    body: BottemplateUpdateRequest;
+    path: {
+        /**
+         * Template ID or name
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/bot-templates/{id}';
+};
+
+export type PutBotTemplatesByIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PutBotTemplatesByIdError = PutBotTemplatesByIdErrors[keyof PutBotTemplatesByIdErrors];
+
+export type PutBotTemplatesByIdResponses = {
+    /**
+     * OK
+     */
+    200: BottemplateTemplate;
+};
+
+export type PutBotTemplatesByIdResponse = PutBotTemplatesByIdResponses[keyof PutBotTemplatesByIdResponses];
+
+export type DeleteBotTemplatesByIdData = {
+    body?: never;
+    path: {
+        /**
+         * Template ID or name
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/bot-templates/{id}';
+};
+
+export type DeleteBotTemplatesByIdErrors = {
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type DeleteBotTemplatesByIdError = DeleteBotTemplatesByIdErrors[keyof DeleteBotTemplatesByIdErrors];
+
+export type DeleteBotTemplatesByIdResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type DeleteBotTemplatesByIdResponse = DeleteBotTemplatesByIdResponses[keyof DeleteBotTemplatesByIdResponses];
+
+export type PostBotTemplatesByIdBotsData = {
+    /**
+     * Bot payload
+     */
+    body: BotsCreateBotRequest;
+    path: {
+        /**
+         * Template ID or name
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/bot-templates/{id}/bots';
+};
+
+export type PostBotTemplatesByIdBotsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotTemplatesByIdBotsError = PostBotTemplatesByIdBotsErrors[keyof PostBotTemplatesByIdBotsErrors];
+
+export type PostBotTemplatesByIdBotsResponses = {
+    /**
+     * Created
+     */
+    201: BottemplateInstantiateResult;
+};
+
+export type PostBotTemplatesByIdBotsResponse = PostBotTemplatesByIdBotsResponses[keyof PostBotTemplatesByIdBotsResponses];
+
+export type GetBotTemplatesByIdBundleData = {
+    body?: never;
+    path: {
+        /**
+         * Template ID or name
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/bot-templates/{id}/bundle';
+};
+
+export type GetBotTemplatesByIdBundleErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotTemplatesByIdBundleError = GetBotTemplatesByIdBundleErrors[keyof GetBotTemplatesByIdBundleErrors];
+
+export type GetBotTemplatesByIdBundleResponses = {
+    /**
+     * OK
+     */
+    200: Blob | File;
+};
+
+export type GetBotTemplatesByIdBundleResponse = GetBotTemplatesByIdBundleResponses[keyof GetBotTemplatesByIdBundleResponses];
+
+export type GetBotsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        /**
+         * Owner user ID (admin only)
+         */
+        owner_id?: string;
+    };
+    url: '/bots';
+};
+
+export type GetBotsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsError = GetBotsErrors[keyof GetBotsErrors];
+
+export type GetBotsResponses = {
+    /**
+     * OK
+     */
+    200: BotsListBotsResponse;
+};
+
+export type GetBotsResponse = GetBotsResponses[keyof GetBotsResponses];
+
+export type PostBotsData = {
+    /**
+     * Bot payload
+     */
+    body: BotsCreateBotRequest;
+    path?: never;
+    query?: never;
+    url: '/bots';
+};
+
+export type PostBotsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsError = PostBotsErrors[keyof PostBotsErrors];
+
+export type PostBotsResponses = {
+    /**
+     * Created
+     */
+    201: BotsBot;
+};
+
+export type PostBotsResponse = PostBotsResponses[keyof PostBotsResponses];
+
+export type PostBotsBackupImportData = {
+    body: {
+        /**
+         * Bot backup zip
+         */
+        file: Blob | File;
+        /**
+         * Import mode
+         */
+        mode?: string;
+        /**
+         * Target bot ID for overwrite mode
+         */
+        target_bot_id?: string;
+        /**
+         * JSON object mapping section to strategy (skip|merge|replace), e.g. {\
+         */
+        sections?: string;
+        /**
+         * Passphrase to decrypt an encrypted backup
+         */
+        passphrase?: string;
+    };
+    path?: never;
+    query?: never;
+    url: '/bots/backup/import';
+};
+
+export type PostBotsBackupImportErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsBackupImportError = PostBotsBackupImportErrors[keyof PostBotsBackupImportErrors];
+
+export type PostBotsBackupImportResponses = {
+    /**
+     * OK
+     */
+    200: BotbackupImportResult;
+};
+
+export type PostBotsBackupImportResponse = PostBotsBackupImportResponses[keyof PostBotsBackupImportResponses];
+
+export type PostBotsBackupImportPreviewData = {
+    body: {
+        /**
+         * Bot backup zip
+         */
+        file: Blob | File;
+        /**
+         * Import mode
+         */
+        mode?: string;
+        /**
+         * Target bot ID for overwrite mode
+         */
+        target_bot_id?: string;
+        /**
+         * JSON object mapping section to strategy (skip|merge|replace), e.g. {\
+         */
+        sections?: string;
+        /**
+         * Passphrase to decrypt an encrypted backup
+         */
+        passphrase?: string;
+    };
+    path?: never;
+    query?: never;
+    url: '/bots/backup/import/preview';
+};
+
+export type PostBotsBackupImportPreviewErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsBackupImportPreviewError = PostBotsBackupImportPreviewErrors[keyof PostBotsBackupImportPreviewErrors];
+
+export type PostBotsBackupImportPreviewResponses = {
+    /**
+     * OK
+     */
+    200: BotbackupPreviewResult;
+};
+
+export type PostBotsBackupImportPreviewResponse = PostBotsBackupImportPreviewResponses[keyof PostBotsBackupImportPreviewResponses];
+
+export type GetBotsNameAvailabilityData = {
+    body?: never;
+    path?: never;
+    query: {
+        /**
+         * Candidate bot name
+         */
+        name: string;
+    };
+    url: '/bots/name-availability';
+};
+
+export type GetBotsNameAvailabilityErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsNameAvailabilityError = GetBotsNameAvailabilityErrors[keyof GetBotsNameAvailabilityErrors];
+
+export type GetBotsNameAvailabilityResponses = {
+    /**
+     * OK
+     */
+    200: BotsNameAvailability;
+};
+
+export type GetBotsNameAvailabilityResponse = GetBotsNameAvailabilityResponses[keyof GetBotsNameAvailabilityResponses];
+
+export type GetBotsByBotIdAclChannelIdentitiesData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: {
+        /**
+         * Search query
+         */
+        q?: string;
+        /**
+         * Max results
+         */
+        limit?: number;
+    };
+    url: '/bots/{bot_id}/acl/channel-identities';
+};
+
+export type GetBotsByBotIdAclChannelIdentitiesErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAclChannelIdentitiesError = GetBotsByBotIdAclChannelIdentitiesErrors[keyof GetBotsByBotIdAclChannelIdentitiesErrors];
+
+export type GetBotsByBotIdAclChannelIdentitiesResponses = {
+    /**
+     * OK
+     */
+    200: AclChannelIdentityCandidateListResponse;
+};
+
+export type GetBotsByBotIdAclChannelIdentitiesResponse = GetBotsByBotIdAclChannelIdentitiesResponses[keyof GetBotsByBotIdAclChannelIdentitiesResponses];
+
+export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Channel Identity ID
+         */
+        channel_identity_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acl/channel-identities/{channel_identity_id}/conversations';
+};
+
+export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsError = GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsErrors[keyof GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsErrors];
+
+export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponses = {
+    /**
+     * OK
+     */
+    200: AclObservedConversationCandidateListResponse;
+};
+
+export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponse = GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponses[keyof GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponses];
+
+export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Channel type (e.g. telegram, discord)
+         */
+        channel_type: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acl/channel-types/{channel_type}/conversations';
+};
+
+export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsError = GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors[keyof GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors];
+
+export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponses = {
+    /**
+     * OK
+     */
+    200: AclObservedConversationCandidateListResponse;
+};
+
+export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponse = GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponses[keyof GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponses];
+
+export type GetBotsByBotIdAclDefaultEffectData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acl/default-effect';
+};
+
+export type GetBotsByBotIdAclDefaultEffectErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAclDefaultEffectError = GetBotsByBotIdAclDefaultEffectErrors[keyof GetBotsByBotIdAclDefaultEffectErrors];
+
+export type GetBotsByBotIdAclDefaultEffectResponses = {
+    /**
+     * OK
+     */
+    200: AclDefaultEffectResponse;
+};
+
+export type GetBotsByBotIdAclDefaultEffectResponse = GetBotsByBotIdAclDefaultEffectResponses[keyof GetBotsByBotIdAclDefaultEffectResponses];
+
+export type PutBotsByBotIdAclDefaultEffectData = {
+    /**
+     * Default effect payload
+     */
+    body: AclDefaultEffectResponse;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acl/default-effect';
+};
+
+export type PutBotsByBotIdAclDefaultEffectErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PutBotsByBotIdAclDefaultEffectError = PutBotsByBotIdAclDefaultEffectErrors[keyof PutBotsByBotIdAclDefaultEffectErrors];
+
+export type PutBotsByBotIdAclDefaultEffectResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type GetBotsByBotIdAclRulesData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acl/rules';
+};
+
+export type GetBotsByBotIdAclRulesErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAclRulesError = GetBotsByBotIdAclRulesErrors[keyof GetBotsByBotIdAclRulesErrors];
+
+export type GetBotsByBotIdAclRulesResponses = {
+    /**
+     * OK
+     */
+    200: AclListRulesResponse;
+};
+
+export type GetBotsByBotIdAclRulesResponse = GetBotsByBotIdAclRulesResponses[keyof GetBotsByBotIdAclRulesResponses];
+
+export type PostBotsByBotIdAclRulesData = {
+    /**
+     * Rule payload
+     */
+    body: AclCreateRuleRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acl/rules';
+};
+
+export type PostBotsByBotIdAclRulesErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdAclRulesError = PostBotsByBotIdAclRulesErrors[keyof PostBotsByBotIdAclRulesErrors];
+
+export type PostBotsByBotIdAclRulesResponses = {
+    /**
+     * Created
+     */
+    201: AclRule;
+};
+
+export type PostBotsByBotIdAclRulesResponse = PostBotsByBotIdAclRulesResponses[keyof PostBotsByBotIdAclRulesResponses];
+
+export type DeleteBotsByBotIdAclRulesByRuleIdData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Rule ID
+         */
+        rule_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acl/rules/{rule_id}';
+};
+
+export type DeleteBotsByBotIdAclRulesByRuleIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type DeleteBotsByBotIdAclRulesByRuleIdError = DeleteBotsByBotIdAclRulesByRuleIdErrors[keyof DeleteBotsByBotIdAclRulesByRuleIdErrors];
+
+export type DeleteBotsByBotIdAclRulesByRuleIdResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type PutBotsByBotIdAclRulesByRuleIdData = {
+    /**
+     * Rule payload
+     */
+    body: AclUpdateRuleRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Rule ID
+         */
+        rule_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acl/rules/{rule_id}';
+};
+
+export type PutBotsByBotIdAclRulesByRuleIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PutBotsByBotIdAclRulesByRuleIdError = PutBotsByBotIdAclRulesByRuleIdErrors[keyof PutBotsByBotIdAclRulesByRuleIdErrors];
+
+export type PutBotsByBotIdAclRulesByRuleIdResponses = {
+    /**
+     * OK
+     */
+    200: AclRule;
+};
+
+export type PutBotsByBotIdAclRulesByRuleIdResponse = PutBotsByBotIdAclRulesByRuleIdResponses[keyof PutBotsByBotIdAclRulesByRuleIdResponses];
+
+export type PostBotsByBotIdAcpRuntimesData = {
+    /**
+     * Runtime spec
+     */
+    body: HandlersAcpRuntimeCreateRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acp-runtimes';
+};
+
+export type PostBotsByBotIdAcpRuntimesErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Too Many Requests
+     */
+    429: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdAcpRuntimesError = PostBotsByBotIdAcpRuntimesErrors[keyof PostBotsByBotIdAcpRuntimesErrors];
+
+export type PostBotsByBotIdAcpRuntimesResponses = {
+    /**
+     * OK
+     */
+    200: AcpagentRuntimeStatus;
+};
+
+export type PostBotsByBotIdAcpRuntimesResponse = PostBotsByBotIdAcpRuntimesResponses[keyof PostBotsByBotIdAcpRuntimesResponses];
+
+export type DeleteBotsByBotIdAcpRuntimesByRuntimeIdData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Runtime ID
+         */
+        runtime_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acp-runtimes/{runtime_id}';
+};
+
+export type DeleteBotsByBotIdAcpRuntimesByRuntimeIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type DeleteBotsByBotIdAcpRuntimesByRuntimeIdError = DeleteBotsByBotIdAcpRuntimesByRuntimeIdErrors[keyof DeleteBotsByBotIdAcpRuntimesByRuntimeIdErrors];
+
+export type DeleteBotsByBotIdAcpRuntimesByRuntimeIdResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type GetBotsByBotIdAcpRuntimesByRuntimeIdData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Runtime ID
+         */
+        runtime_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acp-runtimes/{runtime_id}';
+};
+
+export type GetBotsByBotIdAcpRuntimesByRuntimeIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAcpRuntimesByRuntimeIdError = GetBotsByBotIdAcpRuntimesByRuntimeIdErrors[keyof GetBotsByBotIdAcpRuntimesByRuntimeIdErrors];
+
+export type GetBotsByBotIdAcpRuntimesByRuntimeIdResponses = {
+    /**
+     * OK
+     */
+    200: AcpagentRuntimeStatus;
+};
+
+export type GetBotsByBotIdAcpRuntimesByRuntimeIdResponse = GetBotsByBotIdAcpRuntimesByRuntimeIdResponses[keyof GetBotsByBotIdAcpRuntimesByRuntimeIdResponses];
+
+export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelData = {
+    /**
+     * Model selection
+     */
+    body: HandlersAcpRuntimeModelRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Runtime ID
+         */
+        runtime_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acp-runtimes/{runtime_id}/model';
+};
+
+export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
+};
+
+export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelError = PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors[keyof PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors];
+
+export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponses = {
+    /**
+     * OK
+     */
+    200: AcpagentRuntimeStatus;
+};
+
+export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponse = PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponses[keyof PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponses];
+
+export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acp/claude-code/oauth/authorize';
+};
+
+export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeError = GetBotsByBotIdAcpClaudeCodeOauthAuthorizeErrors[keyof GetBotsByBotIdAcpClaudeCodeOauthAuthorizeErrors];
+
+export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponses = {
+    /**
+     * OK
+     */
+    200: HandlersAcpClaudeCodeOAuthAuthorizeResponse;
+};
+
+export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponse = GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponses[keyof GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponses];
+
+export type PostBotsByBotIdAcpClaudeCodeOauthExchangeData = {
+    /**
+     * OAuth exchange request
+     */
+    body: HandlersAcpClaudeCodeOAuthExchangeRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acp/claude-code/oauth/exchange';
+};
+
+export type PostBotsByBotIdAcpClaudeCodeOauthExchangeErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdAcpClaudeCodeOauthExchangeError = PostBotsByBotIdAcpClaudeCodeOauthExchangeErrors[keyof PostBotsByBotIdAcpClaudeCodeOauthExchangeErrors];
+
+export type PostBotsByBotIdAcpClaudeCodeOauthExchangeResponses = {
+    /**
+     * OK
+     */
+    200: HandlersAcpClaudeCodeOAuthStatus;
+};
+
+export type PostBotsByBotIdAcpClaudeCodeOauthExchangeResponse = PostBotsByBotIdAcpClaudeCodeOauthExchangeResponses[keyof PostBotsByBotIdAcpClaudeCodeOauthExchangeResponses];
+
+export type GetBotsByBotIdAcpClaudeCodeOauthStatusData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/acp/claude-code/oauth/status';
+};
+
+export type GetBotsByBotIdAcpClaudeCodeOauthStatusErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAcpClaudeCodeOauthStatusError = GetBotsByBotIdAcpClaudeCodeOauthStatusErrors[keyof GetBotsByBotIdAcpClaudeCodeOauthStatusErrors];
+
+export type GetBotsByBotIdAcpClaudeCodeOauthStatusResponses = {
+    /**
+     * OK
+     */
+    200: HandlersAcpClaudeCodeOAuthStatus;
+};
+
+export type GetBotsByBotIdAcpClaudeCodeOauthStatusResponse = GetBotsByBotIdAcpClaudeCodeOauthStatusResponses[keyof GetBotsByBotIdAcpClaudeCodeOauthStatusResponses];
+
+export type GetBotsByBotIdAuditLogsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: {
+        /**
+         * Actor type (user, bot, schedule, channel_identity, system)
+         */
+        actor_type?: string;
+        /**
+         * Actor ID
+         */
+        actor_id?: string;
+        /**
+         * Action, or a dotted prefix such as acl or mcp.connection
+         */
+        action?: string;
+        /**
+         * Target type
+         */
+        target_type?: string;
+        /**
+         * Target ID
+         */
+        target_id?: string;
+        /**
+         * Earliest entry time (RFC3339, inclusive)
+         */
+        since?: string;
+        /**
+         * Latest entry time (RFC3339, exclusive)
+         */
+        until?: string;
+        /**
+         * Page size (default 50, max 500)
+         */
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
+    };
+    url: '/bots/{bot_id}/audit-logs';
+};
+
+export type GetBotsByBotIdAuditLogsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAuditLogsError = GetBotsByBotIdAuditLogsErrors[keyof GetBotsByBotIdAuditLogsErrors];
+
+export type GetBotsByBotIdAuditLogsResponses = {
+    /**
+     * OK
+     */
+    200: AuditListResponse;
+};
+
+export type GetBotsByBotIdAuditLogsResponse = GetBotsByBotIdAuditLogsResponses[keyof GetBotsByBotIdAuditLogsResponses];
+
+export type GetBotsByBotIdAuditLogsExportData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: {
+        /**
+         * Actor type (user, bot, schedule, channel_identity, system)
+         */
+        actor_type?: string;
+        /**
+         * Actor ID
+         */
+        actor_id?: string;
+        /**
+         * Action, or a dotted prefix such as acl or mcp.connection
+         */
+        action?: string;
+        /**
+         * Target type
+         */
+        target_type?: string;
+        /**
+         * Target ID
+         */
+        target_id?: string;
+        /**
+         * Earliest entry time (RFC3339, inclusive)
+         */
+        since?: string;
+        /**
+         * Latest entry time (RFC3339, exclusive)
+         */
+        until?: string;
+    };
+    url: '/bots/{bot_id}/audit-logs/export';
+};
+
+export type GetBotsByBotIdAuditLogsExportErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdAuditLogsExportError = GetBotsByBotIdAuditLogsExportErrors[keyof GetBotsByBotIdAuditLogsExportErrors];
+
+export type GetBotsByBotIdAuditLogsExportResponses = {
+    /**
+     * OK
+     */
+    200: Blob | File;
+};
+
+export type GetBotsByBotIdAuditLogsExportResponse = GetBotsByBotIdAuditLogsExportResponses[keyof GetBotsByBotIdAuditLogsExportResponses];
+
+export type PostBotsByBotIdBackupExportData = {
+    /**
+     * Export options
+     */
+    body: BotbackupExportRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/backup/export';
+};
+
+export type PostBotsByBotIdBackupExportErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdBackupExportError = PostBotsByBotIdBackupExportErrors[keyof PostBotsByBotIdBackupExportErrors];
+
+export type PostBotsByBotIdBackupExportResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type GetBotsByBotIdBackupSummaryData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/backup/summary';
+};
+
+export type GetBotsByBotIdBackupSummaryErrors = {
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdBackupSummaryError = GetBotsByBotIdBackupSummaryErrors[keyof GetBotsByBotIdBackupSummaryErrors];
+
+export type GetBotsByBotIdBackupSummaryResponses = {
+    /**
+     * OK
+     */
+    200: BotbackupSummaryResult;
+};
+
+export type GetBotsByBotIdBackupSummaryResponse = GetBotsByBotIdBackupSummaryResponses[keyof GetBotsByBotIdBackupSummaryResponses];
+
+export type GetBotsByBotIdBudgetsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/budgets';
+};
+
+export type GetBotsByBotIdBudgetsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdBudgetsError = GetBotsByBotIdBudgetsErrors[keyof GetBotsByBotIdBudgetsErrors];
+
+export type GetBotsByBotIdBudgetsResponses = {
+    /**
+     * OK
+     */
+    200: BudgetListResponse;
+};
+
+export type GetBotsByBotIdBudgetsResponse = GetBotsByBotIdBudgetsResponses[keyof GetBotsByBotIdBudgetsResponses];
+
+export type PostBotsByBotIdBudgetsData = {
+    /**
+     * Budget payload
+     */
+    body: BudgetCreateRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/budgets';
+};
+
+export type PostBotsByBotIdBudgetsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdBudgetsError = PostBotsByBotIdBudgetsErrors[keyof PostBotsByBotIdBudgetsErrors];
+
+export type PostBotsByBotIdBudgetsResponses = {
+    /**
+     * Created
+     */
+    201: BudgetStatus;
+};
+
+export type PostBotsByBotIdBudgetsResponse = PostBotsByBotIdBudgetsResponses[keyof PostBotsByBotIdBudgetsResponses];
+
+export type PutBotsByBotIdBudgetsByIdData = {
+    /**
+     * Budget payload
+     */
+    body: BudgetUpdateRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Budget ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/budgets/{id}';
+};
+
+export type PutBotsByBotIdBudgetsByIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PutBotsByBotIdBudgetsByIdError = PutBotsByBotIdBudgetsByIdErrors[keyof PutBotsByBotIdBudgetsByIdErrors];
+
+export type PutBotsByBotIdBudgetsByIdResponses = {
+    /**
+     * OK
+     */
+    200: BudgetStatus;
+};
+
+export type PutBotsByBotIdBudgetsByIdResponse = PutBotsByBotIdBudgetsByIdResponses[keyof PutBotsByBotIdBudgetsByIdResponses];
+
+export type DeleteBotsByBotIdBudgetsByIdData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Budget ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/budgets/{id}';
+};
+
+export type DeleteBotsByBotIdBudgetsByIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type DeleteBotsByBotIdBudgetsByIdError = DeleteBotsByBotIdBudgetsByIdErrors[keyof DeleteBotsByBotIdBudgetsByIdErrors];
+
+export type DeleteBotsByBotIdBudgetsByIdResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type DeleteBotsByBotIdBudgetsByIdResponse = DeleteBotsByBotIdBudgetsByIdResponses[keyof DeleteBotsByBotIdBudgetsByIdResponses];
+
+export type DeleteBotsByBotIdCompactionLogsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/compaction/logs';
+};
+
+export type DeleteBotsByBotIdCompactionLogsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type DeleteBotsByBotIdCompactionLogsError = DeleteBotsByBotIdCompactionLogsErrors[keyof DeleteBotsByBotIdCompactionLogsErrors];
+
+export type DeleteBotsByBotIdCompactionLogsResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type GetBotsByBotIdCompactionLogsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: {
+        /**
+         * Limit
+         */
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
+    };
+    url: '/bots/{bot_id}/compaction/logs';
+};
+
+export type GetBotsByBotIdCompactionLogsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdCompactionLogsError = GetBotsByBotIdCompactionLogsErrors[keyof GetBotsByBotIdCompactionLogsErrors];
+
+export type GetBotsByBotIdCompactionLogsResponses = {
+    /**
+     * OK
+     */
+    200: CompactionListLogsResponse;
+};
+
+export type GetBotsByBotIdCompactionLogsResponse = GetBotsByBotIdCompactionLogsResponses[keyof GetBotsByBotIdCompactionLogsResponses];
+
+export type DeleteBotsByBotIdContainerData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: {
+        /**
+         * Export /data before deletion
+         */
+        preserve_data?: boolean;
+    };
+    url: '/bots/{bot_id}/container';
+};
+
+export type DeleteBotsByBotIdContainerErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type DeleteBotsByBotIdContainerError = DeleteBotsByBotIdContainerErrors[keyof DeleteBotsByBotIdContainerErrors];
+
+export type DeleteBotsByBotIdContainerResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type GetBotsByBotIdContainerData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container';
+};
+
+export type GetBotsByBotIdContainerErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdContainerError = GetBotsByBotIdContainerErrors[keyof GetBotsByBotIdContainerErrors];
+
+export type GetBotsByBotIdContainerResponses = {
+    /**
+     * OK
+     */
+    200: HandlersGetContainerResponse;
+};
+
+export type GetBotsByBotIdContainerResponse = GetBotsByBotIdContainerResponses[keyof GetBotsByBotIdContainerResponses];
+
+export type PostBotsByBotIdContainerData = {
+    /**
+     * Create container payload
+     */
+    body: HandlersCreateContainerRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container';
+};
+
+export type PostBotsByBotIdContainerErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdContainerError = PostBotsByBotIdContainerErrors[keyof PostBotsByBotIdContainerErrors];
+
+export type PostBotsByBotIdContainerResponses = {
+    /**
+     * SSE stream of container creation events
+     */
+    200: HandlersCreateContainerResponse;
+};
+
+export type PostBotsByBotIdContainerResponse = PostBotsByBotIdContainerResponses[keyof PostBotsByBotIdContainerResponses];
+
+export type PostBotsByBotIdContainerBrowserSessionsData = {
+    /**
+     * Browser session request
+     */
+    body: HandlersBrowserSessionCreateRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/browser/sessions';
+};
+
+export type PostBotsByBotIdContainerBrowserSessionsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdContainerBrowserSessionsError = PostBotsByBotIdContainerBrowserSessionsErrors[keyof PostBotsByBotIdContainerBrowserSessionsErrors];
+
+export type PostBotsByBotIdContainerBrowserSessionsResponses = {
+    /**
+     * OK
+     */
+    200: HandlersBrowserSessionCreateResponse;
+};
+
+export type PostBotsByBotIdContainerBrowserSessionsResponse = PostBotsByBotIdContainerBrowserSessionsResponses[keyof PostBotsByBotIdContainerBrowserSessionsResponses];
+
+export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Browser session ID
+         */
+        session_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/browser/sessions/{session_id}';
+};
+
+export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdErrors = {
+    /**
+     * Unauthorized
+     */
+    401: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+};
+
+export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdError = DeleteBotsByBotIdContainerBrowserSessionsBySessionIdErrors[keyof DeleteBotsByBotIdContainerBrowserSessionsBySessionIdErrors];
+
+export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Browser session ID
+         */
+        session_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/browser/sessions/{session_id}/keepalive';
+};
+
+export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveErrors = {
+    /**
+     * Unauthorized
+     */
+    401: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveError = PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveErrors[keyof PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveErrors];
+
+export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponses = {
+    /**
+     * OK
+     */
+    200: HandlersBrowserSessionKeepAliveResponse;
+};
+
+export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponse = PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponses[keyof PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponses];
+
+export type PostBotsByBotIdContainerDataRestoreData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/data/restore';
+};
+
+export type PostBotsByBotIdContainerDataRestoreErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdContainerDataRestoreError = PostBotsByBotIdContainerDataRestoreErrors[keyof PostBotsByBotIdContainerDataRestoreErrors];
+
+export type PostBotsByBotIdContainerDataRestoreResponses = {
+    /**
+     * OK
+     */
+    200: {
+        [key: string]: unknown;
+    };
+};
+
+export type PostBotsByBotIdContainerDataRestoreResponse = PostBotsByBotIdContainerDataRestoreResponses[keyof PostBotsByBotIdContainerDataRestoreResponses];
+
+export type GetBotsByBotIdContainerDisplayData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/display';
+};
+
+export type GetBotsByBotIdContainerDisplayErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdContainerDisplayError = GetBotsByBotIdContainerDisplayErrors[keyof GetBotsByBotIdContainerDisplayErrors];
+
+export type GetBotsByBotIdContainerDisplayResponses = {
+    /**
+     * OK
+     */
+    200: HandlersDisplayInfoResponse;
+};
+
+export type GetBotsByBotIdContainerDisplayResponse = GetBotsByBotIdContainerDisplayResponses[keyof GetBotsByBotIdContainerDisplayResponses];
+
+export type PostBotsByBotIdContainerDisplayPrepareData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/display/prepare';
+};
+
+export type PostBotsByBotIdContainerDisplayPrepareErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdContainerDisplayPrepareError = PostBotsByBotIdContainerDisplayPrepareErrors[keyof PostBotsByBotIdContainerDisplayPrepareErrors];
+
+export type PostBotsByBotIdContainerDisplayPrepareResponses = {
+    /**
+     * SSE stream of display preparation events
+     */
+    200: string;
+};
+
+export type PostBotsByBotIdContainerDisplayPrepareResponse = PostBotsByBotIdContainerDisplayPrepareResponses[keyof PostBotsByBotIdContainerDisplayPrepareResponses];
+
+export type GetBotsByBotIdContainerDisplaySessionsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/display/sessions';
+};
+
+export type GetBotsByBotIdContainerDisplaySessionsErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdContainerDisplaySessionsError = GetBotsByBotIdContainerDisplaySessionsErrors[keyof GetBotsByBotIdContainerDisplaySessionsErrors];
+
+export type GetBotsByBotIdContainerDisplaySessionsResponses = {
+    /**
+     * OK
+     */
+    200: HandlersDisplaySessionListResponse;
+};
+
+export type GetBotsByBotIdContainerDisplaySessionsResponse = GetBotsByBotIdContainerDisplaySessionsResponses[keyof GetBotsByBotIdContainerDisplaySessionsResponses];
+
+export type DeleteBotsByBotIdContainerDisplaySessionsBySessionIdData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Display session ID
+         */
+        session_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/display/sessions/{session_id}';
+};
+
+export type DeleteBotsByBotIdContainerDisplaySessionsBySessionIdErrors = {
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+};
+
+export type DeleteBotsByBotIdContainerDisplaySessionsBySessionIdError = DeleteBotsByBotIdContainerDisplaySessionsBySessionIdErrors[keyof DeleteBotsByBotIdContainerDisplaySessionsBySessionIdErrors];
+
+export type DeleteBotsByBotIdContainerDisplaySessionsBySessionIdResponses = {
+    /**
+     * No Content
+     */
+    204: unknown;
+};
+
+export type PostBotsByBotIdContainerDisplayWebrtcOfferData = {
+    /**
+     * WebRTC offer payload
+     */
+    body: HandlersDisplayWebRtcOfferRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/display/webrtc/offer';
+};
+
+export type PostBotsByBotIdContainerDisplayWebrtcOfferErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdContainerDisplayWebrtcOfferError = PostBotsByBotIdContainerDisplayWebrtcOfferErrors[keyof PostBotsByBotIdContainerDisplayWebrtcOfferErrors];
+
+export type PostBotsByBotIdContainerDisplayWebrtcOfferResponses = {
+    /**
+     * OK
+     */
+    200: HandlersDisplayWebRtcOfferResponse;
+};
+
+export type PostBotsByBotIdContainerDisplayWebrtcOfferResponse = PostBotsByBotIdContainerDisplayWebrtcOfferResponses[keyof PostBotsByBotIdContainerDisplayWebrtcOfferResponses];
+
+export type GetBotsByBotIdContainerFsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query: {
+        /**
+         * Container path
+         */
+        path: string;
+    };
+    url: '/bots/{bot_id}/container/fs';
+};
+
+export type GetBotsByBotIdContainerFsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdContainerFsError = GetBotsByBotIdContainerFsErrors[keyof GetBotsByBotIdContainerFsErrors];
+
+export type GetBotsByBotIdContainerFsResponses = {
+    /**
+     * OK
+     */
+    200: HandlersFsFileInfo;
+};
+
+export type GetBotsByBotIdContainerFsResponse = GetBotsByBotIdContainerFsResponses[keyof GetBotsByBotIdContainerFsResponses];
+
+export type PostBotsByBotIdContainerFsArchiveData = {
+    /**
+     * Archive request
+     */
+    body: HandlersFsArchiveRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/fs/archive';
+};
+
+export type PostBotsByBotIdContainerFsArchiveErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type PostBotsByBotIdContainerFsArchiveError = PostBotsByBotIdContainerFsArchiveErrors[keyof PostBotsByBotIdContainerFsArchiveErrors];
+
+export type PostBotsByBotIdContainerFsArchiveResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type PostBotsByBotIdContainerFsDeleteData = {
+    /**
+     * Delete request
+     */
+    body: HandlersFsDeleteRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query?: never;
+    url: '/bots/{bot_id}/container/fs/delete';
+};
+
+export type PostBotsByBotIdContainerFsDeleteErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsError = GetBotsErrors[keyof GetBotsErrors];
+export type PostBotsByBotIdContainerFsDeleteError = PostBotsByBotIdContainerFsDeleteErrors[keyof PostBotsByBotIdContainerFsDeleteErrors];
 
-export type GetBotsResponses = {
+export type PostBotsByBotIdContainerFsDeleteResponses = {
     /**
      * OK
      */
-    200: BotsListBotsResponse;
+    200: HandlersFsOpResponse;
 };
 
-export type GetBotsResponse = GetBotsResponses[keyof GetBotsResponses];
+export type PostBotsByBotIdContainerFsDeleteResponse = PostBotsByBotIdContainerFsDeleteResponses[keyof PostBotsByBotIdContainerFsDeleteResponses];
 
-export type PostBotsData = {
-    /**
-     * Bot payload
-     */
-    body: BotsCreateBotRequest;
-    path?: never;
-    query?: never;
-    url: '/bots';
+export type GetBotsByBotIdContainerFsDownloadData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
+    query: {
+        /**
+         * Container file path
+         */
+        path: string;
+    };
+    url: '/bots/{bot_id}/container/fs/download';
 };
 
-export type PostBotsErrors = {
+export type GetBotsByBotIdContainerFsDownloadErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsError = PostBotsErrors[keyof PostBotsErrors];
+export type GetBotsByBotIdContainerFsDownloadError = GetBotsByBotIdContainerFsDownloadErrors[keyof GetBotsByBotIdContainerFsDownloadErrors];
 
-export type PostBotsResponses = {
+export type GetBotsByBotIdContainerFsDownloadResponses = {
     /**
-     * Created
+     * OK
      */
-    201: BotsBot;
+    200: unknown;
 };
 
-export type PostBotsResponse = PostBotsResponses[keyof PostBotsResponses];
-
-export type PostBotsBackupImportData = {
-    body: {
-        /**
-         * Bot backup zip
-         */
-        file: Blob | File;
-        /**
-         * Import mode
-         */
-        mode?: string;
-        /**
-         * Target bot ID for overwrite mode
-         */
-        target_bot_id?: string;
-        /**
-         * JSON object mapping section to strategy (skip|merge|replace), e.g. {\
-         */
-        sections?: string;
+export type PostBotsByBotIdContainerFsExtractData = {
+    /**
+     * Extract request
+     */
+    body: HandlersFsExtractRequest;
+    path: {
         /**
-         * Passphrase to decrypt an encrypted backup
+         * Bot ID
          */
-        passphrase?: string;
+        bot_id: string;
     };
-    path?: never;
     query?: never;
-    url: '/bots/backup/import';
+    url: '/bots/{bot_id}/container/fs/extract';
 };
 
-export type PostBotsBackupImportErrors = {
+export type PostBotsByBotIdContainerFsExtractErrors = {
     /**
      * Bad Request
      */
@@ -2510,108 +5759,116 @@ export type PostBotsBackupImportErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsBackupImportError = PostBotsBackupImportErrors[keyof PostBotsBackupImportErrors];
+export type PostBotsByBotIdContainerFsExtractError = PostBotsByBotIdContainerFsExtractErrors[keyof PostBotsByBotIdContainerFsExtractErrors];
 
-export type PostBotsBackupImportResponses = {
+export type PostBotsByBotIdContainerFsExtractResponses = {
     /**
      * OK
      */
-    200: BotbackupImportResult;
+    200: HandlersFsExtractResponse;
 };
 
-export type PostBotsBackupImportResponse = PostBotsBackupImportResponses[keyof PostBotsBackupImportResponses];
+export type PostBotsByBotIdContainerFsExtractResponse = PostBotsByBotIdContainerFsExtractResponses[keyof PostBotsByBotIdContainerFsExtractResponses];
 
-export type PostBotsBackupImportPreviewData = {
-    body: {
-        /**
-         * Bot backup zip
-         */
-        file: Blob | File;
-        /**
-         * Import mode
-         */
-        mode?: string;
-        /**
-         * Target bot ID for overwrite mode
-         */
-        target_bot_id?: string;
+export type GetBotsByBotIdContainerFsListData = {
+    body?: never;
+    path: {
         /**
-         * JSON object mapping section to strategy (skip|merge|replace), e.g. {\
+         * Bot ID
          */
-        sections?: string;
+        bot_id: string;
+    };
+    query: {
         /**
-         * Passphrase to decrypt an encrypted backup
+         * Container directory path
          */
-        passphrase?: string;
+        path: string;
     };
-    path?: never;
-    query?: never;
-    url: '/bots/backup/import/preview';
+    url: '/bots/{bot_id}/container/fs/list';
 };
 
-export type PostBotsBackupImportPreviewErrors = {
+export type GetBotsByBotIdContainerFsListErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsBackupImportPreviewError = PostBotsBackupImportPreviewErrors[keyof PostBotsBackupImportPreviewErrors];
+export type GetBotsByBotIdContainerFsListError = GetBotsByBotIdContainerFsListErrors[keyof GetBotsByBotIdContainerFsListErrors];
 
-export type PostBotsBackupImportPreviewResponses = {
+export type GetBotsByBotIdContainerFsListResponses = {
     /**
      * OK
      */
-    200: BotbackupPreviewResult;
+    200: HandlersFsListResponse;
 };
 
-export type PostBotsBackupImportPreviewResponse = PostBotsBackupImportPreviewResponses[keyof PostBotsBackupImportPreviewResponses];
+export type GetBotsByBotIdContainerFsListResponse = GetBotsByBotIdContainerFsListResponses[keyof GetBotsByBotIdContainerFsListResponses];
 
-export type GetBotsNameAvailabilityData = {
-    body?: never;
-    path?: never;
-    query: {
+export type PostBotsByBotIdContainerFsMkdirData = {
+    /**
+     * Mkdir request
+     */
+    body: HandlersFsMkdirRequest;
+    path: {
         /**
-         * Candidate bot name
+         * Bot ID
          */
-        name: string;
+        bot_id: string;
     };
-    url: '/bots/name-availability';
+    query?: never;
+    url: '/bots/{bot_id}/container/fs/mkdir';
 };
 
-export type GetBotsNameAvailabilityErrors = {
+export type PostBotsByBotIdContainerFsMkdirErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsNameAvailabilityError = GetBotsNameAvailabilityErrors[keyof GetBotsNameAvailabilityErrors];
+export type PostBotsByBotIdContainerFsMkdirError = PostBotsByBotIdContainerFsMkdirErrors[keyof PostBotsByBotIdContainerFsMkdirErrors];
 
-export type GetBotsNameAvailabilityResponses = {
+export type PostBotsByBotIdContainerFsMkdirResponses = {
     /**
      * OK
      */
-    200: BotsNameAvailability;
+    200: HandlersFsOpResponse;
 };
 
-export type GetBotsNameAvailabilityResponse = GetBotsNameAvailabilityResponses[keyof GetBotsNameAvailabilityResponses];
+export type PostBotsByBotIdContainerFsMkdirResponse = PostBotsByBotIdContainerFsMkdirResponses[keyof PostBotsByBotIdContainerFsMkdirResponses];
 
-export type GetBotsByBotIdAclChannelIdentitiesData = {
+export type GetBotsByBotIdContainerFsReadData = {
     body?: never;
     path: {
         /**
@@ -2619,62 +5876,57 @@ export type GetBotsByBotIdAclChannelIdentitiesData = {
          */
         bot_id: string;
     };
-    query?: {
-        /**
-         * Search query
-         */
-        q?: string;
+    query: {
         /**
-         * Max results
+         * Container file path
          */
-        limit?: number;
+        path: string;
     };
-    url: '/bots/{bot_id}/acl/channel-identities';
+    url: '/bots/{bot_id}/container/fs/read';
 };
 
-export type GetBotsByBotIdAclChannelIdentitiesErrors = {
+export type GetBotsByBotIdContainerFsReadErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdAclChannelIdentitiesError = GetBotsByBotIdAclChannelIdentitiesErrors[keyof GetBotsByBotIdAclChannelIdentitiesErrors];
+export type GetBotsByBotIdContainerFsReadError = GetBotsByBotIdContainerFsReadErrors[keyof GetBotsByBotIdContainerFsReadErrors];
 
-export type GetBotsByBotIdAclChannelIdentitiesResponses = {
+export type GetBotsByBotIdContainerFsReadResponses = {
     /**
      * OK
      */
-    200: AclChannelIdentityCandidateListResponse;
+    200: HandlersFsReadResponse;
 };
 
-export type GetBotsByBotIdAclChannelIdentitiesResponse = GetBotsByBotIdAclChannelIdentitiesResponses[keyof GetBotsByBotIdAclChannelIdentitiesResponses];
+export type GetBotsByBotIdContainerFsReadResponse = GetBotsByBotIdContainerFsReadResponses[keyof GetBotsByBotIdContainerFsReadResponses];
 
-export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsData = {
-    body?: never;
+export type PostBotsByBotIdContainerFsRenameData = {
+    /**
+     * Rename request
+     */
+    body: HandlersFsRenameRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Channel Identity ID
-         */
-        channel_identity_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acl/channel-identities/{channel_identity_id}/conversations';
+    url: '/bots/{bot_id}/container/fs/rename';
 };
 
-export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsErrors = {
+export type PostBotsByBotIdContainerFsRenameErrors = {
     /**
      * Bad Request
      */
@@ -2683,40 +5935,49 @@ export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsEr
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsError = GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsErrors[keyof GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsErrors];
+export type PostBotsByBotIdContainerFsRenameError = PostBotsByBotIdContainerFsRenameErrors[keyof PostBotsByBotIdContainerFsRenameErrors];
 
-export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponses = {
+export type PostBotsByBotIdContainerFsRenameResponses = {
     /**
      * OK
      */
-    200: AclObservedConversationCandidateListResponse;
+    200: HandlersFsOpResponse;
 };
 
-export type GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponse = GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponses[keyof GetBotsByBotIdAclChannelIdentitiesByChannelIdentityIdConversationsResponses];
+export type PostBotsByBotIdContainerFsRenameResponse = PostBotsByBotIdContainerFsRenameResponses[keyof PostBotsByBotIdContainerFsRenameResponses];
 
-export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsData = {
-    body?: never;
+export type PostBotsByBotIdContainerFsUploadData = {
+    body: {
+        /**
+         * Destination container path
+         */
+        path: string;
+        /**
+         * File to upload
+         */
+        file: Blob | File;
+    };
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Channel type (e.g. telegram, discord)
-         */
-        channel_type: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acl/channel-types/{channel_type}/conversations';
+    url: '/bots/{bot_id}/container/fs/upload';
 };
 
-export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors = {
+export type PostBotsByBotIdContainerFsUploadErrors = {
     /**
      * Bad Request
      */
@@ -2731,19 +5992,22 @@ export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsError = GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors[keyof GetBotsByBotIdAclChannelTypesByChannelTypeConversationsErrors];
+export type PostBotsByBotIdContainerFsUploadError = PostBotsByBotIdContainerFsUploadErrors[keyof PostBotsByBotIdContainerFsUploadErrors];
 
-export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponses = {
+export type PostBotsByBotIdContainerFsUploadResponses = {
     /**
      * OK
      */
-    200: AclObservedConversationCandidateListResponse;
+    200: HandlersFsUploadResponse;
 };
 
-export type GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponse = GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponses[keyof GetBotsByBotIdAclChannelTypesByChannelTypeConversationsResponses];
+export type PostBotsByBotIdContainerFsUploadResponse = PostBotsByBotIdContainerFsUploadResponses[keyof PostBotsByBotIdContainerFsUploadResponses];
 
-export type GetBotsByBotIdAclDefaultEffectData = {
-    body?: never;
+export type PostBotsByBotIdContainerFsWriteData = {
+    /**
+     * Write request
+     */
+    body: HandlersFsWriteRequest;
     path: {
         /**
          * Bot ID
@@ -2751,10 +6015,10 @@ export type GetBotsByBotIdAclDefaultEffectData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acl/default-effect';
+    url: '/bots/{bot_id}/container/fs/write';
 };
 
-export type GetBotsByBotIdAclDefaultEffectErrors = {
+export type PostBotsByBotIdContainerFsWriteErrors = {
     /**
      * Bad Request
      */
@@ -2769,22 +6033,19 @@ export type GetBotsByBotIdAclDefaultEffectErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdAclDefaultEffectError = GetBotsByBotIdAclDefaultEffectErrors[keyof GetBotsByBotIdAclDefaultEffectErrors];
+export type PostBotsByBotIdContainerFsWriteError = PostBotsByBotIdContainerFsWriteErrors[keyof PostBotsByBotIdContainerFsWriteErrors];
 
-export type GetBotsByBotIdAclDefaultEffectResponses = {
+export type PostBotsByBotIdContainerFsWriteResponses = {
     /**
      * OK
      */
-    200: AclDefaultEffectResponse;
+    200: HandlersFsOpResponse;
 };
 
-export type GetBotsByBotIdAclDefaultEffectResponse = GetBotsByBotIdAclDefaultEffectResponses[keyof GetBotsByBotIdAclDefaultEffectResponses];
+export type PostBotsByBotIdContainerFsWriteResponse = PostBotsByBotIdContainerFsWriteResponses[keyof PostBotsByBotIdContainerFsWriteResponses];
 
-export type PutBotsByBotIdAclDefaultEffectData = {
-    /**
-     * Default effect payload
-     */
-    body: AclDefaultEffectResponse;
+export type GetBotsByBotIdContainerMetricsData = {
+    body?: never;
     path: {
         /**
          * Bot ID
@@ -2792,35 +6053,32 @@ export type PutBotsByBotIdAclDefaultEffectData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acl/default-effect';
+    url: '/bots/{bot_id}/container/metrics';
 };
 
-export type PutBotsByBotIdAclDefaultEffectErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
+export type GetBotsByBotIdContainerMetricsErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdAclDefaultEffectError = PutBotsByBotIdAclDefaultEffectErrors[keyof PutBotsByBotIdAclDefaultEffectErrors];
+export type GetBotsByBotIdContainerMetricsError = GetBotsByBotIdContainerMetricsErrors[keyof GetBotsByBotIdContainerMetricsErrors];
 
-export type PutBotsByBotIdAclDefaultEffectResponses = {
+export type GetBotsByBotIdContainerMetricsResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: HandlersGetContainerMetricsResponse;
 };
 
-export type GetBotsByBotIdAclRulesData = {
-    body?: never;
+export type GetBotsByBotIdContainerMetricsResponse = GetBotsByBotIdContainerMetricsResponses[keyof GetBotsByBotIdContainerMetricsResponses];
+
+export type PutBotsByBotIdContainerMetricsData = {
+    /**
+     * Metrics settings payload
+     */
+    body: HandlersUpdateContainerMetricsRequest;
     path: {
         /**
          * Bot ID
@@ -2828,40 +6086,36 @@ export type GetBotsByBotIdAclRulesData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acl/rules';
+    url: '/bots/{bot_id}/container/metrics';
 };
 
-export type GetBotsByBotIdAclRulesErrors = {
+export type PutBotsByBotIdContainerMetricsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdAclRulesError = GetBotsByBotIdAclRulesErrors[keyof GetBotsByBotIdAclRulesErrors];
+export type PutBotsByBotIdContainerMetricsError = PutBotsByBotIdContainerMetricsErrors[keyof PutBotsByBotIdContainerMetricsErrors];
 
-export type GetBotsByBotIdAclRulesResponses = {
+export type PutBotsByBotIdContainerMetricsResponses = {
     /**
      * OK
      */
-    200: AclListRulesResponse;
+    200: HandlersGetContainerMetricsResponse;
 };
 
-export type GetBotsByBotIdAclRulesResponse = GetBotsByBotIdAclRulesResponses[keyof GetBotsByBotIdAclRulesResponses];
+export type PutBotsByBotIdContainerMetricsResponse = PutBotsByBotIdContainerMetricsResponses[keyof PutBotsByBotIdContainerMetricsResponses];
 
-export type PostBotsByBotIdAclRulesData = {
+export type DeleteBotsByBotIdContainerSkillsData = {
     /**
-     * Rule payload
+     * Delete skills payload
      */
-    body: AclCreateRuleRequest;
+    body: HandlersSkillsDeleteRequest;
     path: {
         /**
          * Bot ID
@@ -2869,125 +6123,119 @@ export type PostBotsByBotIdAclRulesData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acl/rules';
+    url: '/bots/{bot_id}/container/skills';
 };
 
-export type PostBotsByBotIdAclRulesErrors = {
+export type DeleteBotsByBotIdContainerSkillsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdAclRulesError = PostBotsByBotIdAclRulesErrors[keyof PostBotsByBotIdAclRulesErrors];
+export type DeleteBotsByBotIdContainerSkillsError = DeleteBotsByBotIdContainerSkillsErrors[keyof DeleteBotsByBotIdContainerSkillsErrors];
 
-export type PostBotsByBotIdAclRulesResponses = {
+export type DeleteBotsByBotIdContainerSkillsResponses = {
     /**
-     * Created
+     * OK
      */
-    201: AclRule;
+    200: HandlersSkillsOpResponse;
 };
 
-export type PostBotsByBotIdAclRulesResponse = PostBotsByBotIdAclRulesResponses[keyof PostBotsByBotIdAclRulesResponses];
+export type DeleteBotsByBotIdContainerSkillsResponse = DeleteBotsByBotIdContainerSkillsResponses[keyof DeleteBotsByBotIdContainerSkillsResponses];
 
-export type DeleteBotsByBotIdAclRulesByRuleIdData = {
+export type GetBotsByBotIdContainerSkillsData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Rule ID
-         */
-        rule_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acl/rules/{rule_id}';
+    url: '/bots/{bot_id}/container/skills';
 };
 
-export type DeleteBotsByBotIdAclRulesByRuleIdErrors = {
+export type GetBotsByBotIdContainerSkillsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdAclRulesByRuleIdError = DeleteBotsByBotIdAclRulesByRuleIdErrors[keyof DeleteBotsByBotIdAclRulesByRuleIdErrors];
+export type GetBotsByBotIdContainerSkillsError = GetBotsByBotIdContainerSkillsErrors[keyof GetBotsByBotIdContainerSkillsErrors];
 
-export type DeleteBotsByBotIdAclRulesByRuleIdResponses = {
+export type GetBotsByBotIdContainerSkillsResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: HandlersSkillsResponse;
 };
 
-export type PutBotsByBotIdAclRulesByRuleIdData = {
+export type GetBotsByBotIdContainerSkillsResponse = GetBotsByBotIdContainerSkillsResponses[keyof GetBotsByBotIdContainerSkillsResponses];
+
+export type PostBotsByBotIdContainerSkillsData = {
     /**
-     * Rule payload
+     * Skills payload
      */
-    body: AclUpdateRuleRequest;
+    body: HandlersSkillsUpsertRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Rule ID
-         */
-        rule_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acl/rules/{rule_id}';
+    url: '/bots/{bot_id}/container/skills';
 };
 
-export type PutBotsByBotIdAclRulesByRuleIdErrors = {
+export type PostBotsByBotIdContainerSkillsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdAclRulesByRuleIdError = PutBotsByBotIdAclRulesByRuleIdErrors[keyof PutBotsByBotIdAclRulesByRuleIdErrors];
+export type PostBotsByBotIdContainerSkillsError = PostBotsByBotIdContainerSkillsErrors[keyof PostBotsByBotIdContainerSkillsErrors];
 
-export type PutBotsByBotIdAclRulesByRuleIdResponses = {
+export type PostBotsByBotIdContainerSkillsResponses = {
     /**
      * OK
      */
-    200: AclRule;
+    200: HandlersSkillsOpResponse;
 };
 
-export type PutBotsByBotIdAclRulesByRuleIdResponse = PutBotsByBotIdAclRulesByRuleIdResponses[keyof PutBotsByBotIdAclRulesByRuleIdResponses];
+export type PostBotsByBotIdContainerSkillsResponse = PostBotsByBotIdContainerSkillsResponses[keyof PostBotsByBotIdContainerSkillsResponses];
 
-export type PostBotsByBotIdAcpRuntimesData = {
+export type PostBotsByBotIdContainerSkillsActionsData = {
     /**
-     * Runtime spec
+     * Skill action payload
      */
-    body: HandlersAcpRuntimeCreateRequest;
+    body: HandlersSkillsActionRequest;
     path: {
         /**
          * Bot ID
@@ -2995,137 +6243,133 @@ export type PostBotsByBotIdAcpRuntimesData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acp-runtimes';
+    url: '/bots/{bot_id}/container/skills/actions';
 };
 
-export type PostBotsByBotIdAcpRuntimesErrors = {
+export type PostBotsByBotIdContainerSkillsActionsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
-     * Too Many Requests
+     * Internal Server Error
      */
-    429: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdAcpRuntimesError = PostBotsByBotIdAcpRuntimesErrors[keyof PostBotsByBotIdAcpRuntimesErrors];
+export type PostBotsByBotIdContainerSkillsActionsError = PostBotsByBotIdContainerSkillsActionsErrors[keyof PostBotsByBotIdContainerSkillsActionsErrors];
 
-export type PostBotsByBotIdAcpRuntimesResponses = {
+export type PostBotsByBotIdContainerSkillsActionsResponses = {
     /**
      * OK
      */
-    200: AcpagentRuntimeStatus;
+    200: HandlersSkillsOpResponse;
 };
 
-export type PostBotsByBotIdAcpRuntimesResponse = PostBotsByBotIdAcpRuntimesResponses[keyof PostBotsByBotIdAcpRuntimesResponses];
+export type PostBotsByBotIdContainerSkillsActionsResponse = PostBotsByBotIdContainerSkillsActionsResponses[keyof PostBotsByBotIdContainerSkillsActionsResponses];
 
-export type DeleteBotsByBotIdAcpRuntimesByRuntimeIdData = {
+export type GetBotsByBotIdContainerSnapshotsData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+    };
+    query?: {
         /**
-         * Runtime ID
+         * Snapshotter name
          */
-        runtime_id: string;
+        snapshotter?: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/acp-runtimes/{runtime_id}';
+    url: '/bots/{bot_id}/container/snapshots';
 };
 
-export type DeleteBotsByBotIdAcpRuntimesByRuntimeIdErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
+export type GetBotsByBotIdContainerSnapshotsErrors = {
     /**
-     * Not Found
+     * Snapshots currently not supported on this backend
      */
-    404: HandlersErrorResponse;
+    501: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdAcpRuntimesByRuntimeIdError = DeleteBotsByBotIdAcpRuntimesByRuntimeIdErrors[keyof DeleteBotsByBotIdAcpRuntimesByRuntimeIdErrors];
+export type GetBotsByBotIdContainerSnapshotsError = GetBotsByBotIdContainerSnapshotsErrors[keyof GetBotsByBotIdContainerSnapshotsErrors];
 
-export type DeleteBotsByBotIdAcpRuntimesByRuntimeIdResponses = {
+export type GetBotsByBotIdContainerSnapshotsResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: HandlersListSnapshotsResponse;
 };
 
-export type GetBotsByBotIdAcpRuntimesByRuntimeIdData = {
-    body?: never;
+export type GetBotsByBotIdContainerSnapshotsResponse = GetBotsByBotIdContainerSnapshotsResponses[keyof GetBotsByBotIdContainerSnapshotsResponses];
+
+export type PostBotsByBotIdContainerSnapshotsData = {
+    /**
+     * Create snapshot payload
+     */
+    body: HandlersCreateSnapshotRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Runtime ID
-         */
-        runtime_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acp-runtimes/{runtime_id}';
+    url: '/bots/{bot_id}/container/snapshots';
 };
 
-export type GetBotsByBotIdAcpRuntimesByRuntimeIdErrors = {
+export type PostBotsByBotIdContainerSnapshotsErrors = {
     /**
-     * Bad Request
+     * Not Found
      */
-    400: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Internal Server Error
      */
-    403: HandlersErrorResponse;
+    500: HandlersErrorResponse;
     /**
-     * Not Found
+     * Snapshots currently not supported on this backend
      */
-    404: HandlersErrorResponse;
+    501: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdAcpRuntimesByRuntimeIdError = GetBotsByBotIdAcpRuntimesByRuntimeIdErrors[keyof GetBotsByBotIdAcpRuntimesByRuntimeIdErrors];
+export type PostBotsByBotIdContainerSnapshotsError = PostBotsByBotIdContainerSnapshotsErrors[keyof PostBotsByBotIdContainerSnapshotsErrors];
 
-export type GetBotsByBotIdAcpRuntimesByRuntimeIdResponses = {
+export type PostBotsByBotIdContainerSnapshotsResponses = {
     /**
      * OK
      */
-    200: AcpagentRuntimeStatus;
+    200: HandlersCreateSnapshotResponse;
 };
 
-export type GetBotsByBotIdAcpRuntimesByRuntimeIdResponse = GetBotsByBotIdAcpRuntimesByRuntimeIdResponses[keyof GetBotsByBotIdAcpRuntimesByRuntimeIdResponses];
+export type PostBotsByBotIdContainerSnapshotsResponse = PostBotsByBotIdContainerSnapshotsResponses[keyof PostBotsByBotIdContainerSnapshotsResponses];
 
-export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelData = {
-    /**
-     * Model selection
-     */
-    body: HandlersAcpRuntimeModelRequest;
+export type GetBotsByBotIdContainerSnapshotsDiffData = {
+    body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+    };
+    query: {
         /**
-         * Runtime ID
+         * Older version
          */
-        runtime_id: string;
+        from: number;
+        /**
+         * Newer version; omit to compare with the live workspace
+         */
+        to?: number;
     };
-    query?: never;
-    url: '/bots/{bot_id}/acp-runtimes/{runtime_id}/model';
+    url: '/bots/{bot_id}/container/snapshots/diff';
 };
 
-export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors = {
+export type GetBotsByBotIdContainerSnapshotsDiffErrors = {
     /**
      * Bad Request
      */
@@ -3142,20 +6386,24 @@ export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors = {
      * Conflict
      */
     409: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelError = PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors[keyof PatchBotsByBotIdAcpRuntimesByRuntimeIdModelErrors];
+export type GetBotsByBotIdContainerSnapshotsDiffError = GetBotsByBotIdContainerSnapshotsDiffErrors[keyof GetBotsByBotIdContainerSnapshotsDiffErrors];
 
-export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponses = {
+export type GetBotsByBotIdContainerSnapshotsDiffResponses = {
     /**
      * OK
      */
-    200: AcpagentRuntimeStatus;
+    200: WorkspaceVersionDiff;
 };
 
-export type PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponse = PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponses[keyof PatchBotsByBotIdAcpRuntimesByRuntimeIdModelResponses];
+export type GetBotsByBotIdContainerSnapshotsDiffResponse = GetBotsByBotIdContainerSnapshotsDiffResponses[keyof GetBotsByBotIdContainerSnapshotsDiffResponses];
 
-export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeData = {
+export type GetBotsByBotIdContainerSnapshotsPolicyData = {
     body?: never;
     path: {
         /**
@@ -3164,36 +6412,36 @@ export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acp/claude-code/oauth/authorize';
+    url: '/bots/{bot_id}/container/snapshots/policy';
 };
 
-export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeErrors = {
+export type GetBotsByBotIdContainerSnapshotsPolicyErrors = {
     /**
-     * Bad Request
+     * Forbidden
      */
-    400: HandlersErrorResponse;
+    403: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeError = GetBotsByBotIdAcpClaudeCodeOauthAuthorizeErrors[keyof GetBotsByBotIdAcpClaudeCodeOauthAuthorizeErrors];
+export type GetBotsByBotIdContainerSnapshotsPolicyError = GetBotsByBotIdContainerSnapshotsPolicyErrors[keyof GetBotsByBotIdContainerSnapshotsPolicyErrors];
 
-export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponses = {
+export type GetBotsByBotIdContainerSnapshotsPolicyResponses = {
     /**
      * OK
      */
-    200: HandlersAcpClaudeCodeOAuthAuthorizeResponse;
+    200: SnapshotpolicyPolicy;
 };
 
-export type GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponse = GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponses[keyof GetBotsByBotIdAcpClaudeCodeOauthAuthorizeResponses];
+export type GetBotsByBotIdContainerSnapshotsPolicyResponse = GetBotsByBotIdContainerSnapshotsPolicyResponses[keyof GetBotsByBotIdContainerSnapshotsPolicyResponses];
 
-export type PostBotsByBotIdAcpClaudeCodeOauthExchangeData = {
+export type PutBotsByBotIdContainerSnapshotsPolicyData = {
     /**
-     * OAuth exchange request
+     * Snapshot policy
      */
-    body: HandlersAcpClaudeCodeOAuthExchangeRequest;
+    body: SnapshotpolicyPolicy;
     path: {
         /**
          * Bot ID
@@ -3201,32 +6449,36 @@ export type PostBotsByBotIdAcpClaudeCodeOauthExchangeData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acp/claude-code/oauth/exchange';
+    url: '/bots/{bot_id}/container/snapshots/policy';
 };
 
-export type PostBotsByBotIdAcpClaudeCodeOauthExchangeErrors = {
+export type PutBotsByBotIdContainerSnapshotsPolicyErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Forbidden
      */
-    404: HandlersErrorResponse;
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdAcpClaudeCodeOauthExchangeError = PostBotsByBotIdAcpClaudeCodeOauthExchangeErrors[keyof PostBotsByBotIdAcpClaudeCodeOauthExchangeErrors];
+export type PutBotsByBotIdContainerSnapshotsPolicyError = PutBotsByBotIdContainerSnapshotsPolicyErrors[keyof PutBotsByBotIdContainerSnapshotsPolicyErrors];
 
-export type PostBotsByBotIdAcpClaudeCodeOauthExchangeResponses = {
+export type PutBotsByBotIdContainerSnapshotsPolicyResponses = {
     /**
      * OK
      */
-    200: HandlersAcpClaudeCodeOAuthStatus;
+    200: SnapshotpolicyPolicy;
 };
 
-export type PostBotsByBotIdAcpClaudeCodeOauthExchangeResponse = PostBotsByBotIdAcpClaudeCodeOauthExchangeResponses[keyof PostBotsByBotIdAcpClaudeCodeOauthExchangeResponses];
+export type PutBotsByBotIdContainerSnapshotsPolicyResponse = PutBotsByBotIdContainerSnapshotsPolicyResponses[keyof PutBotsByBotIdContainerSnapshotsPolicyResponses];
 
-export type GetBotsByBotIdAcpClaudeCodeOauthStatusData = {
+export type PostBotsByBotIdContainerSnapshotsPruneData = {
     body?: never;
     path: {
         /**
@@ -3235,36 +6487,36 @@ export type GetBotsByBotIdAcpClaudeCodeOauthStatusData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/acp/claude-code/oauth/status';
+    url: '/bots/{bot_id}/container/snapshots/prune';
 };
 
-export type GetBotsByBotIdAcpClaudeCodeOauthStatusErrors = {
+export type PostBotsByBotIdContainerSnapshotsPruneErrors = {
     /**
-     * Bad Request
+     * Forbidden
      */
-    400: HandlersErrorResponse;
+    403: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdAcpClaudeCodeOauthStatusError = GetBotsByBotIdAcpClaudeCodeOauthStatusErrors[keyof GetBotsByBotIdAcpClaudeCodeOauthStatusErrors];
+export type PostBotsByBotIdContainerSnapshotsPruneError = PostBotsByBotIdContainerSnapshotsPruneErrors[keyof PostBotsByBotIdContainerSnapshotsPruneErrors];
 
-export type GetBotsByBotIdAcpClaudeCodeOauthStatusResponses = {
+export type PostBotsByBotIdContainerSnapshotsPruneResponses = {
     /**
      * OK
      */
-    200: HandlersAcpClaudeCodeOAuthStatus;
+    200: SnapshotpolicyPruneResult;
 };
 
-export type GetBotsByBotIdAcpClaudeCodeOauthStatusResponse = GetBotsByBotIdAcpClaudeCodeOauthStatusResponses[keyof GetBotsByBotIdAcpClaudeCodeOauthStatusResponses];
+export type PostBotsByBotIdContainerSnapshotsPruneResponse = PostBotsByBotIdContainerSnapshotsPruneResponses[keyof PostBotsByBotIdContainerSnapshotsPruneResponses];
 
-export type PostBotsByBotIdBackupExportData = {
+export type PostBotsByBotIdContainerSnapshotsRollbackData = {
     /**
-     * Export options
+     * Rollback payload
      */
-    body: BotbackupExportRequest;
+    body: HandlersRollbackRequest;
     path: {
         /**
          * Bot ID
@@ -3272,34 +6524,34 @@ export type PostBotsByBotIdBackupExportData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/backup/export';
+    url: '/bots/{bot_id}/container/snapshots/rollback';
 };
 
-export type PostBotsByBotIdBackupExportErrors = {
+export type PostBotsByBotIdContainerSnapshotsRollbackErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdBackupExportError = PostBotsByBotIdBackupExportErrors[keyof PostBotsByBotIdBackupExportErrors];
+export type PostBotsByBotIdContainerSnapshotsRollbackError = PostBotsByBotIdContainerSnapshotsRollbackErrors[keyof PostBotsByBotIdContainerSnapshotsRollbackErrors];
 
-export type PostBotsByBotIdBackupExportResponses = {
+export type PostBotsByBotIdContainerSnapshotsRollbackResponses = {
     /**
      * OK
      */
-    200: unknown;
+    200: {
+        [key: string]: unknown;
+    };
 };
 
-export type GetBotsByBotIdBackupSummaryData = {
+export type PostBotsByBotIdContainerSnapshotsRollbackResponse = PostBotsByBotIdContainerSnapshotsRollbackResponses[keyof PostBotsByBotIdContainerSnapshotsRollbackResponses];
+
+export type PostBotsByBotIdContainerStartData = {
     body?: never;
     path: {
         /**
@@ -3308,32 +6560,34 @@ export type GetBotsByBotIdBackupSummaryData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/backup/summary';
+    url: '/bots/{bot_id}/container/start';
 };
 
-export type GetBotsByBotIdBackupSummaryErrors = {
+export type PostBotsByBotIdContainerStartErrors = {
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdBackupSummaryError = GetBotsByBotIdBackupSummaryErrors[keyof GetBotsByBotIdBackupSummaryErrors];
+export type PostBotsByBotIdContainerStartError = PostBotsByBotIdContainerStartErrors[keyof PostBotsByBotIdContainerStartErrors];
 
-export type GetBotsByBotIdBackupSummaryResponses = {
+export type PostBotsByBotIdContainerStartResponses = {
     /**
      * OK
      */
-    200: BotbackupSummaryResult;
+    200: {
+        [key: string]: unknown;
+    };
 };
 
-export type GetBotsByBotIdBackupSummaryResponse = GetBotsByBotIdBackupSummaryResponses[keyof GetBotsByBotIdBackupSummaryResponses];
+export type PostBotsByBotIdContainerStartResponse = PostBotsByBotIdContainerStartResponses[keyof PostBotsByBotIdContainerStartResponses];
 
-export type DeleteBotsByBotIdCompactionLogsData = {
+export type PostBotsByBotIdContainerStopData = {
     body?: never;
     path: {
         /**
@@ -3342,30 +6596,34 @@ export type DeleteBotsByBotIdCompactionLogsData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/compaction/logs';
+    url: '/bots/{bot_id}/container/stop';
 };
 
-export type DeleteBotsByBotIdCompactionLogsErrors = {
+export type PostBotsByBotIdContainerStopErrors = {
     /**
-     * Bad Request
+     * Not Found
      */
-    400: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdCompactionLogsError = DeleteBotsByBotIdCompactionLogsErrors[keyof DeleteBotsByBotIdCompactionLogsErrors];
+export type PostBotsByBotIdContainerStopError = PostBotsByBotIdContainerStopErrors[keyof PostBotsByBotIdContainerStopErrors];
 
-export type DeleteBotsByBotIdCompactionLogsResponses = {
+export type PostBotsByBotIdContainerStopResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: {
+        [key: string]: unknown;
+    };
 };
 
-export type GetBotsByBotIdCompactionLogsData = {
+export type PostBotsByBotIdContainerStopResponse = PostBotsByBotIdContainerStopResponses[keyof PostBotsByBotIdContainerStopResponses];
+
+export type GetBotsByBotIdContainerTerminalData = {
     body?: never;
     path: {
         /**
@@ -3373,42 +6631,29 @@ export type GetBotsByBotIdCompactionLogsData = {
          */
         bot_id: string;
     };
-    query?: {
-        /**
-         * Limit
-         */
-        limit?: number;
-        /**
-         * Offset
-         */
-        offset?: number;
-    };
-    url: '/bots/{bot_id}/compaction/logs';
+    query?: never;
+    url: '/bots/{bot_id}/container/terminal';
 };
 
-export type GetBotsByBotIdCompactionLogsErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetBotsByBotIdContainerTerminalErrors = {
     /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdCompactionLogsError = GetBotsByBotIdCompactionLogsErrors[keyof GetBotsByBotIdCompactionLogsErrors];
+export type GetBotsByBotIdContainerTerminalError = GetBotsByBotIdContainerTerminalErrors[keyof GetBotsByBotIdContainerTerminalErrors];
 
-export type GetBotsByBotIdCompactionLogsResponses = {
+export type GetBotsByBotIdContainerTerminalResponses = {
     /**
      * OK
      */
-    200: CompactionListLogsResponse;
+    200: HandlersTerminalInfoResponse;
 };
 
-export type GetBotsByBotIdCompactionLogsResponse = GetBotsByBotIdCompactionLogsResponses[keyof GetBotsByBotIdCompactionLogsResponses];
+export type GetBotsByBotIdContainerTerminalResponse = GetBotsByBotIdContainerTerminalResponses[keyof GetBotsByBotIdContainerTerminalResponses];
 
-export type DeleteBotsByBotIdContainerData = {
+export type GetBotsByBotIdDelegationsData = {
     body?: never;
     path: {
         /**
@@ -3418,34 +6663,40 @@ export type DeleteBotsByBotIdContainerData = {
     };
     query?: {
         /**
-         * Export /data before deletion
+         * Page size (default 50, max 200)
          */
-        preserve_data?: boolean;
+        limit?: number;
     };
-    url: '/bots/{bot_id}/container';
+    url: '/bots/{bot_id}/delegations';
 };
 
-export type DeleteBotsByBotIdContainerErrors = {
+export type GetBotsByBotIdDelegationsErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdContainerError = DeleteBotsByBotIdContainerErrors[keyof DeleteBotsByBotIdContainerErrors];
+export type GetBotsByBotIdDelegationsError = GetBotsByBotIdDelegationsErrors[keyof GetBotsByBotIdDelegationsErrors];
 
-export type DeleteBotsByBotIdContainerResponses = {
+export type GetBotsByBotIdDelegationsResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: DelegationListResponse;
 };
 
-export type GetBotsByBotIdContainerData = {
+export type GetBotsByBotIdDelegationsResponse = GetBotsByBotIdDelegationsResponses[keyof GetBotsByBotIdDelegationsResponses];
+
+export type GetBotsByBotIdContainerTerminalWsData = {
     body?: never;
     path: {
         /**
@@ -3453,37 +6704,38 @@ export type GetBotsByBotIdContainerData = {
          */
         bot_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/container';
+    query?: {
+        /**
+         * Initial terminal columns
+         */
+        cols?: number;
+        /**
+         * Initial terminal rows
+         */
+        rows?: number;
+        /**
+         * Auth token
+         */
+        token?: string;
+    };
+    url: '/bots/{bot_id}/container/terminal/ws';
 };
 
-export type GetBotsByBotIdContainerErrors = {
+export type GetBotsByBotIdContainerTerminalWsErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerError = GetBotsByBotIdContainerErrors[keyof GetBotsByBotIdContainerErrors];
-
-export type GetBotsByBotIdContainerResponses = {
-    /**
-     * OK
-     */
-    200: HandlersGetContainerResponse;
-};
-
-export type GetBotsByBotIdContainerResponse = GetBotsByBotIdContainerResponses[keyof GetBotsByBotIdContainerResponses];
+export type GetBotsByBotIdContainerTerminalWsError = GetBotsByBotIdContainerTerminalWsErrors[keyof GetBotsByBotIdContainerTerminalWsErrors];
 
-export type PostBotsByBotIdContainerData = {
-    /**
-     * Create container payload
-     */
-    body: HandlersCreateContainerRequest;
+export type GetBotsByBotIdEmailBindingsData = {
+    body?: never;
     path: {
         /**
          * Bot ID
@@ -3491,36 +6743,32 @@ export type PostBotsByBotIdContainerData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container';
+    url: '/bots/{bot_id}/email-bindings';
 };
 
-export type PostBotsByBotIdContainerErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetBotsByBotIdEmailBindingsErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerError = PostBotsByBotIdContainerErrors[keyof PostBotsByBotIdContainerErrors];
+export type GetBotsByBotIdEmailBindingsError = GetBotsByBotIdEmailBindingsErrors[keyof GetBotsByBotIdEmailBindingsErrors];
 
-export type PostBotsByBotIdContainerResponses = {
+export type GetBotsByBotIdEmailBindingsResponses = {
     /**
-     * SSE stream of container creation events
+     * OK
      */
-    200: HandlersCreateContainerResponse;
+    200: Array<EmailBindingResponse>;
 };
 
-export type PostBotsByBotIdContainerResponse = PostBotsByBotIdContainerResponses[keyof PostBotsByBotIdContainerResponses];
+export type GetBotsByBotIdEmailBindingsResponse = GetBotsByBotIdEmailBindingsResponses[keyof GetBotsByBotIdEmailBindingsResponses];
 
-export type PostBotsByBotIdContainerBrowserSessionsData = {
+export type PostBotsByBotIdEmailBindingsData = {
     /**
-     * Browser session request
+     * Binding configuration
      */
-    body: HandlersBrowserSessionCreateRequest;
+    body: EmailCreateBindingRequest;
     path: {
         /**
          * Bot ID
@@ -3528,40 +6776,32 @@ export type PostBotsByBotIdContainerBrowserSessionsData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/browser/sessions';
+    url: '/bots/{bot_id}/email-bindings';
 };
-
-export type PostBotsByBotIdContainerBrowserSessionsErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
-    /**
-     * Unauthorized
-     */
-    401: HandlersErrorResponse;
+
+export type PostBotsByBotIdEmailBindingsErrors = {
     /**
-     * Forbidden
+     * Bad Request
      */
-    403: HandlersErrorResponse;
+    400: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerBrowserSessionsError = PostBotsByBotIdContainerBrowserSessionsErrors[keyof PostBotsByBotIdContainerBrowserSessionsErrors];
+export type PostBotsByBotIdEmailBindingsError = PostBotsByBotIdEmailBindingsErrors[keyof PostBotsByBotIdEmailBindingsErrors];
 
-export type PostBotsByBotIdContainerBrowserSessionsResponses = {
+export type PostBotsByBotIdEmailBindingsResponses = {
     /**
-     * OK
+     * Created
      */
-    200: HandlersBrowserSessionCreateResponse;
+    201: EmailBindingResponse;
 };
 
-export type PostBotsByBotIdContainerBrowserSessionsResponse = PostBotsByBotIdContainerBrowserSessionsResponses[keyof PostBotsByBotIdContainerBrowserSessionsResponses];
+export type PostBotsByBotIdEmailBindingsResponse = PostBotsByBotIdEmailBindingsResponses[keyof PostBotsByBotIdEmailBindingsResponses];
 
-export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdData = {
+export type DeleteBotsByBotIdEmailBindingsByIdData = {
     body?: never;
     path: {
         /**
@@ -3569,77 +6809,72 @@ export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdData = {
          */
         bot_id: string;
         /**
-         * Browser session ID
+         * Binding ID
          */
-        session_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/browser/sessions/{session_id}';
+    url: '/bots/{bot_id}/email-bindings/{id}';
 };
 
-export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdErrors = {
-    /**
-     * Unauthorized
-     */
-    401: HandlersErrorResponse;
+export type DeleteBotsByBotIdEmailBindingsByIdErrors = {
     /**
-     * Forbidden
+     * Internal Server Error
      */
-    403: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdError = DeleteBotsByBotIdContainerBrowserSessionsBySessionIdErrors[keyof DeleteBotsByBotIdContainerBrowserSessionsBySessionIdErrors];
+export type DeleteBotsByBotIdEmailBindingsByIdError = DeleteBotsByBotIdEmailBindingsByIdErrors[keyof DeleteBotsByBotIdEmailBindingsByIdErrors];
 
-export type DeleteBotsByBotIdContainerBrowserSessionsBySessionIdResponses = {
+export type DeleteBotsByBotIdEmailBindingsByIdResponses = {
     /**
      * No Content
      */
     204: unknown;
 };
 
-export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveData = {
-    body?: never;
+export type PutBotsByBotIdEmailBindingsByIdData = {
+    /**
+     * Updated binding
+     */
+    body: EmailUpdateBindingRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
         /**
-         * Browser session ID
+         * Binding ID
          */
-        session_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/browser/sessions/{session_id}/keepalive';
+    url: '/bots/{bot_id}/email-bindings/{id}';
 };
 
-export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveErrors = {
-    /**
-     * Unauthorized
-     */
-    401: HandlersErrorResponse;
+export type PutBotsByBotIdEmailBindingsByIdErrors = {
     /**
-     * Forbidden
+     * Bad Request
      */
-    403: HandlersErrorResponse;
+    400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveError = PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveErrors[keyof PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveErrors];
+export type PutBotsByBotIdEmailBindingsByIdError = PutBotsByBotIdEmailBindingsByIdErrors[keyof PutBotsByBotIdEmailBindingsByIdErrors];
 
-export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponses = {
+export type PutBotsByBotIdEmailBindingsByIdResponses = {
     /**
      * OK
      */
-    200: HandlersBrowserSessionKeepAliveResponse;
+    200: EmailBindingResponse;
 };
 
-export type PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponse = PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponses[keyof PostBotsByBotIdContainerBrowserSessionsBySessionIdKeepaliveResponses];
+export type PutBotsByBotIdEmailBindingsByIdResponse = PutBotsByBotIdEmailBindingsByIdResponses[keyof PutBotsByBotIdEmailBindingsByIdResponses];
 
-export type PostBotsByBotIdContainerDataRestoreData = {
+export type GetBotsByBotIdEmailOutboxData = {
     body?: never;
     path: {
         /**
@@ -3647,24 +6882,29 @@ export type PostBotsByBotIdContainerDataRestoreData = {
          */
         bot_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/container/data/restore';
+    query?: {
+        /**
+         * Limit
+         */
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
+    };
+    url: '/bots/{bot_id}/email-outbox';
 };
 
-export type PostBotsByBotIdContainerDataRestoreErrors = {
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
+export type GetBotsByBotIdEmailOutboxErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerDataRestoreError = PostBotsByBotIdContainerDataRestoreErrors[keyof PostBotsByBotIdContainerDataRestoreErrors];
+export type GetBotsByBotIdEmailOutboxError = GetBotsByBotIdEmailOutboxErrors[keyof GetBotsByBotIdEmailOutboxErrors];
 
-export type PostBotsByBotIdContainerDataRestoreResponses = {
+export type GetBotsByBotIdEmailOutboxResponses = {
     /**
      * OK
      */
@@ -3673,39 +6913,43 @@ export type PostBotsByBotIdContainerDataRestoreResponses = {
     };
 };
 
-export type PostBotsByBotIdContainerDataRestoreResponse = PostBotsByBotIdContainerDataRestoreResponses[keyof PostBotsByBotIdContainerDataRestoreResponses];
+export type GetBotsByBotIdEmailOutboxResponse = GetBotsByBotIdEmailOutboxResponses[keyof GetBotsByBotIdEmailOutboxResponses];
 
-export type GetBotsByBotIdContainerDisplayData = {
+export type GetBotsByBotIdEmailOutboxByIdData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Email ID
+         */
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/display';
+    url: '/bots/{bot_id}/email-outbox/{id}';
 };
 
-export type GetBotsByBotIdContainerDisplayErrors = {
+export type GetBotsByBotIdEmailOutboxByIdErrors = {
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerDisplayError = GetBotsByBotIdContainerDisplayErrors[keyof GetBotsByBotIdContainerDisplayErrors];
+export type GetBotsByBotIdEmailOutboxByIdError = GetBotsByBotIdEmailOutboxByIdErrors[keyof GetBotsByBotIdEmailOutboxByIdErrors];
 
-export type GetBotsByBotIdContainerDisplayResponses = {
+export type GetBotsByBotIdEmailOutboxByIdResponses = {
     /**
      * OK
      */
-    200: HandlersDisplayInfoResponse;
+    200: EmailOutboxItemResponse;
 };
 
-export type GetBotsByBotIdContainerDisplayResponse = GetBotsByBotIdContainerDisplayResponses[keyof GetBotsByBotIdContainerDisplayResponses];
+export type GetBotsByBotIdEmailOutboxByIdResponse = GetBotsByBotIdEmailOutboxByIdResponses[keyof GetBotsByBotIdEmailOutboxByIdResponses];
 
-export type PostBotsByBotIdContainerDisplayPrepareData = {
+export type DeleteBotsByBotIdHeartbeatLogsData = {
     body?: never;
     path: {
         /**
@@ -3714,28 +6958,30 @@ export type PostBotsByBotIdContainerDisplayPrepareData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/display/prepare';
+    url: '/bots/{bot_id}/heartbeat/logs';
 };
 
-export type PostBotsByBotIdContainerDisplayPrepareErrors = {
+export type DeleteBotsByBotIdHeartbeatLogsErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerDisplayPrepareError = PostBotsByBotIdContainerDisplayPrepareErrors[keyof PostBotsByBotIdContainerDisplayPrepareErrors];
+export type DeleteBotsByBotIdHeartbeatLogsError = DeleteBotsByBotIdHeartbeatLogsErrors[keyof DeleteBotsByBotIdHeartbeatLogsErrors];
 
-export type PostBotsByBotIdContainerDisplayPrepareResponses = {
+export type DeleteBotsByBotIdHeartbeatLogsResponses = {
     /**
-     * SSE stream of display preparation events
+     * No Content
      */
-    200: string;
+    204: unknown;
 };
 
-export type PostBotsByBotIdContainerDisplayPrepareResponse = PostBotsByBotIdContainerDisplayPrepareResponses[keyof PostBotsByBotIdContainerDisplayPrepareResponses];
-
-export type GetBotsByBotIdContainerDisplaySessionsData = {
+export type GetBotsByBotIdHeartbeatLogsData = {
     body?: never;
     path: {
         /**
@@ -3743,65 +6989,46 @@ export type GetBotsByBotIdContainerDisplaySessionsData = {
          */
         bot_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/container/display/sessions';
-};
-
-export type GetBotsByBotIdContainerDisplaySessionsErrors = {
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
-};
-
-export type GetBotsByBotIdContainerDisplaySessionsError = GetBotsByBotIdContainerDisplaySessionsErrors[keyof GetBotsByBotIdContainerDisplaySessionsErrors];
-
-export type GetBotsByBotIdContainerDisplaySessionsResponses = {
-    /**
-     * OK
-     */
-    200: HandlersDisplaySessionListResponse;
-};
-
-export type GetBotsByBotIdContainerDisplaySessionsResponse = GetBotsByBotIdContainerDisplaySessionsResponses[keyof GetBotsByBotIdContainerDisplaySessionsResponses];
-
-export type DeleteBotsByBotIdContainerDisplaySessionsBySessionIdData = {
-    body?: never;
-    path: {
+    query?: {
         /**
-         * Bot ID
+         * Limit
          */
-        bot_id: string;
+        limit?: number;
         /**
-         * Display session ID
+         * Offset
          */
-        session_id: string;
+        offset?: number;
     };
-    query?: never;
-    url: '/bots/{bot_id}/container/display/sessions/{session_id}';
+    url: '/bots/{bot_id}/heartbeat/logs';
 };
 
-export type DeleteBotsByBotIdContainerDisplaySessionsBySessionIdErrors = {
+export type GetBotsByBotIdHeartbeatLogsErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdContainerDisplaySessionsBySessionIdError = DeleteBotsByBotIdContainerDisplaySessionsBySessionIdErrors[keyof DeleteBotsByBotIdContainerDisplaySessionsBySessionIdErrors];
+export type GetBotsByBotIdHeartbeatLogsError = GetBotsByBotIdHeartbeatLogsErrors[keyof GetBotsByBotIdHeartbeatLogsErrors];
 
-export type DeleteBotsByBotIdContainerDisplaySessionsBySessionIdResponses = {
+export type GetBotsByBotIdHeartbeatLogsResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: HeartbeatListLogsResponse;
 };
 
-export type PostBotsByBotIdContainerDisplayWebrtcOfferData = {
+export type GetBotsByBotIdHeartbeatLogsResponse = GetBotsByBotIdHeartbeatLogsResponses[keyof GetBotsByBotIdHeartbeatLogsResponses];
+
+export type PostBotsByBotIdLocalMessagesData = {
     /**
-     * WebRTC offer payload
+     * Message payload
      */
-    body: HandlersDisplayWebRtcOfferRequest;
+    body: HandlersLocalChannelMessageRequest;
     path: {
         /**
          * Bot ID
@@ -3809,32 +7036,38 @@ export type PostBotsByBotIdContainerDisplayWebrtcOfferData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/display/webrtc/offer';
+    url: '/bots/{bot_id}/local/messages';
 };
 
-export type PostBotsByBotIdContainerDisplayWebrtcOfferErrors = {
+export type PostBotsByBotIdLocalMessagesErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Service Unavailable
+     * Forbidden
      */
-    503: HandlersErrorResponse;
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerDisplayWebrtcOfferError = PostBotsByBotIdContainerDisplayWebrtcOfferErrors[keyof PostBotsByBotIdContainerDisplayWebrtcOfferErrors];
+export type PostBotsByBotIdLocalMessagesError = PostBotsByBotIdLocalMessagesErrors[keyof PostBotsByBotIdLocalMessagesErrors];
 
-export type PostBotsByBotIdContainerDisplayWebrtcOfferResponses = {
+export type PostBotsByBotIdLocalMessagesResponses = {
     /**
      * OK
      */
-    200: HandlersDisplayWebRtcOfferResponse;
+    200: {
+        [key: string]: string;
+    };
 };
 
-export type PostBotsByBotIdContainerDisplayWebrtcOfferResponse = PostBotsByBotIdContainerDisplayWebrtcOfferResponses[keyof PostBotsByBotIdContainerDisplayWebrtcOfferResponses];
+export type PostBotsByBotIdLocalMessagesResponse = PostBotsByBotIdLocalMessagesResponses[keyof PostBotsByBotIdLocalMessagesResponses];
 
-export type GetBotsByBotIdContainerFsData = {
+export type GetBotsByBotIdLocalStreamData = {
     body?: never;
     path: {
         /**
@@ -3842,16 +7075,11 @@ export type GetBotsByBotIdContainerFsData = {
          */
         bot_id: string;
     };
-    query: {
-        /**
-         * Container path
-         */
-        path: string;
-    };
-    url: '/bots/{bot_id}/container/fs';
+    query?: never;
+    url: '/bots/{bot_id}/local/stream';
 };
 
-export type GetBotsByBotIdContainerFsErrors = {
+export type GetBotsByBotIdLocalStreamErrors = {
     /**
      * Bad Request
      */
@@ -3860,32 +7088,25 @@ export type GetBotsByBotIdContainerFsErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerFsError = GetBotsByBotIdContainerFsErrors[keyof GetBotsByBotIdContainerFsErrors];
+export type GetBotsByBotIdLocalStreamError = GetBotsByBotIdLocalStreamErrors[keyof GetBotsByBotIdLocalStreamErrors];
 
-export type GetBotsByBotIdContainerFsResponses = {
+export type GetBotsByBotIdLocalStreamResponses = {
     /**
-     * OK
+     * SSE stream
      */
-    200: HandlersFsFileInfo;
+    200: string;
 };
 
-export type GetBotsByBotIdContainerFsResponse = GetBotsByBotIdContainerFsResponses[keyof GetBotsByBotIdContainerFsResponses];
+export type GetBotsByBotIdLocalStreamResponse = GetBotsByBotIdLocalStreamResponses[keyof GetBotsByBotIdLocalStreamResponses];
 
-export type PostBotsByBotIdContainerFsArchiveData = {
-    /**
-     * Archive request
-     */
-    body: HandlersFsArchiveRequest;
+export type GetBotsByBotIdLocalWsData = {
+    body?: never;
     path: {
         /**
          * Bot ID
@@ -3893,10 +7114,10 @@ export type PostBotsByBotIdContainerFsArchiveData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/fs/archive';
+    url: '/bots/{bot_id}/local/ws';
 };
 
-export type PostBotsByBotIdContainerFsArchiveErrors = {
+export type GetBotsByBotIdLocalWsErrors = {
     /**
      * Bad Request
      */
@@ -3905,41 +7126,27 @@ export type PostBotsByBotIdContainerFsArchiveErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerFsArchiveError = PostBotsByBotIdContainerFsArchiveErrors[keyof PostBotsByBotIdContainerFsArchiveErrors];
-
-export type PostBotsByBotIdContainerFsArchiveResponses = {
-    /**
-     * OK
-     */
-    200: unknown;
-};
+export type GetBotsByBotIdLocalWsError = GetBotsByBotIdLocalWsErrors[keyof GetBotsByBotIdLocalWsErrors];
 
-export type PostBotsByBotIdContainerFsDeleteData = {
-    /**
-     * Delete request
-     */
-    body: HandlersFsDeleteRequest;
-    path: {
+export type GetBotsByBotIdMcpData = {
+    body?: never;
+    path?: never;
+    query?: {
         /**
-         * Bot ID
+         * Include plugin-managed hidden MCP connections
          */
-        bot_id: string;
+        include_managed?: boolean;
     };
-    query?: never;
-    url: '/bots/{bot_id}/container/fs/delete';
+    url: '/bots/{bot_id}/mcp';
 };
 
-export type PostBotsByBotIdContainerFsDeleteErrors = {
+export type GetBotsByBotIdMcpErrors = {
     /**
      * Bad Request
      */
@@ -3958,39 +7165,36 @@ export type PostBotsByBotIdContainerFsDeleteErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerFsDeleteError = PostBotsByBotIdContainerFsDeleteErrors[keyof PostBotsByBotIdContainerFsDeleteErrors];
+export type GetBotsByBotIdMcpError = GetBotsByBotIdMcpErrors[keyof GetBotsByBotIdMcpErrors];
 
-export type PostBotsByBotIdContainerFsDeleteResponses = {
+export type GetBotsByBotIdMcpResponses = {
     /**
      * OK
      */
-    200: HandlersFsOpResponse;
+    200: McpListResponse;
 };
 
-export type PostBotsByBotIdContainerFsDeleteResponse = PostBotsByBotIdContainerFsDeleteResponses[keyof PostBotsByBotIdContainerFsDeleteResponses];
+export type GetBotsByBotIdMcpResponse = GetBotsByBotIdMcpResponses[keyof GetBotsByBotIdMcpResponses];
 
-export type GetBotsByBotIdContainerFsDownloadData = {
-    body?: never;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
-    query: {
-        /**
-         * Container file path
-         */
-        path: string;
-    };
-    url: '/bots/{bot_id}/container/fs/download';
+export type PostBotsByBotIdMcpData = {
+    /**
+     * MCP payload
+     */
+    body: McpUpsertRequest;
+    path?: never;
+    query?: never;
+    url: '/bots/{bot_id}/mcp';
 };
 
-export type GetBotsByBotIdContainerFsDownloadErrors = {
+export type PostBotsByBotIdMcpErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Not Found
      */
@@ -4001,20 +7205,24 @@ export type GetBotsByBotIdContainerFsDownloadErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerFsDownloadError = GetBotsByBotIdContainerFsDownloadErrors[keyof GetBotsByBotIdContainerFsDownloadErrors];
+export type PostBotsByBotIdMcpError = PostBotsByBotIdMcpErrors[keyof PostBotsByBotIdMcpErrors];
 
-export type GetBotsByBotIdContainerFsDownloadResponses = {
+export type PostBotsByBotIdMcpResponses = {
     /**
-     * OK
+     * Created
      */
-    200: unknown;
+    201: GithubComMemohaiMemohInternalMcpConnection;
 };
 
-export type PostBotsByBotIdContainerFsExtractData = {
+export type PostBotsByBotIdMcpResponse = PostBotsByBotIdMcpResponses[keyof PostBotsByBotIdMcpResponses];
+
+export type PostBotsByBotIdMcpServerData = {
     /**
-     * Extract request
+     * JSON-RPC request
      */
-    body: HandlersFsExtractRequest;
+    body: {
+        [key: string]: unknown;
+    };
     path: {
         /**
          * Bot ID
@@ -4022,10 +7230,10 @@ export type PostBotsByBotIdContainerFsExtractData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/fs/extract';
+    url: '/bots/{bot_id}/mcp-server';
 };
 
-export type PostBotsByBotIdContainerFsExtractErrors = {
+export type PostBotsByBotIdMcpServerErrors = {
     /**
      * Bad Request
      */
@@ -4038,75 +7246,64 @@ export type PostBotsByBotIdContainerFsExtractErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Conflict
-     */
-    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerFsExtractError = PostBotsByBotIdContainerFsExtractErrors[keyof PostBotsByBotIdContainerFsExtractErrors];
+export type PostBotsByBotIdMcpServerError = PostBotsByBotIdMcpServerErrors[keyof PostBotsByBotIdMcpServerErrors];
 
-export type PostBotsByBotIdContainerFsExtractResponses = {
+export type PostBotsByBotIdMcpServerResponses = {
     /**
-     * OK
+     * JSON-RPC response: {jsonrpc,id,result|error}
      */
-    200: HandlersFsExtractResponse;
+    200: {
+        [key: string]: unknown;
+    };
 };
 
-export type PostBotsByBotIdContainerFsExtractResponse = PostBotsByBotIdContainerFsExtractResponses[keyof PostBotsByBotIdContainerFsExtractResponses];
+export type PostBotsByBotIdMcpServerResponse = PostBotsByBotIdMcpServerResponses[keyof PostBotsByBotIdMcpServerResponses];
 
-export type GetBotsByBotIdContainerFsListData = {
-    body?: never;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
-    query: {
-        /**
-         * Container directory path
-         */
-        path: string;
-    };
-    url: '/bots/{bot_id}/container/fs/list';
+export type PostBotsByBotIdMcpOpsBatchDeleteData = {
+    /**
+     * IDs to delete
+     */
+    body: HandlersBatchDeleteRequest;
+    path?: never;
+    query?: never;
+    url: '/bots/{bot_id}/mcp-ops/batch-delete';
 };
 
-export type GetBotsByBotIdContainerFsListErrors = {
+export type PostBotsByBotIdMcpOpsBatchDeleteErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Forbidden
      */
-    404: HandlersErrorResponse;
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerFsListError = GetBotsByBotIdContainerFsListErrors[keyof GetBotsByBotIdContainerFsListErrors];
+export type PostBotsByBotIdMcpOpsBatchDeleteError = PostBotsByBotIdMcpOpsBatchDeleteErrors[keyof PostBotsByBotIdMcpOpsBatchDeleteErrors];
 
-export type GetBotsByBotIdContainerFsListResponses = {
+export type PostBotsByBotIdMcpOpsBatchDeleteResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: HandlersFsListResponse;
+    204: unknown;
 };
 
-export type GetBotsByBotIdContainerFsListResponse = GetBotsByBotIdContainerFsListResponses[keyof GetBotsByBotIdContainerFsListResponses];
-
-export type PostBotsByBotIdContainerFsMkdirData = {
+export type PostBotsByBotIdMcpStdioData = {
     /**
-     * Mkdir request
+     * Stdio MCP payload
      */
-    body: HandlersFsMkdirRequest;
+    body: HandlersMcpStdioRequest;
     path: {
         /**
          * Bot ID
@@ -4114,53 +7311,57 @@ export type PostBotsByBotIdContainerFsMkdirData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/fs/mkdir';
+    url: '/bots/{bot_id}/mcp-stdio';
 };
 
-export type PostBotsByBotIdContainerFsMkdirErrors = {
+export type PostBotsByBotIdMcpStdioErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerFsMkdirError = PostBotsByBotIdContainerFsMkdirErrors[keyof PostBotsByBotIdContainerFsMkdirErrors];
+export type PostBotsByBotIdMcpStdioError = PostBotsByBotIdMcpStdioErrors[keyof PostBotsByBotIdMcpStdioErrors];
 
-export type PostBotsByBotIdContainerFsMkdirResponses = {
+export type PostBotsByBotIdMcpStdioResponses = {
     /**
      * OK
      */
-    200: HandlersFsOpResponse;
+    200: HandlersMcpStdioResponse;
 };
 
-export type PostBotsByBotIdContainerFsMkdirResponse = PostBotsByBotIdContainerFsMkdirResponses[keyof PostBotsByBotIdContainerFsMkdirResponses];
+export type PostBotsByBotIdMcpStdioResponse = PostBotsByBotIdMcpStdioResponses[keyof PostBotsByBotIdMcpStdioResponses];
 
-export type GetBotsByBotIdContainerFsReadData = {
-    body?: never;
+export type PostBotsByBotIdMcpStdioByConnectionIdData = {
+    /**
+     * JSON-RPC request
+     */
+    body: {
+        [key: string]: unknown;
+    };
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-    };
-    query: {
         /**
-         * Container file path
+         * Connection ID
          */
-        path: string;
+        connection_id: string;
     };
-    url: '/bots/{bot_id}/container/fs/read';
+    query?: never;
+    url: '/bots/{bot_id}/mcp-stdio/{connection_id}';
 };
 
-export type GetBotsByBotIdContainerFsReadErrors = {
+export type PostBotsByBotIdMcpStdioByConnectionIdErrors = {
     /**
      * Bad Request
      */
@@ -4175,33 +7376,27 @@ export type GetBotsByBotIdContainerFsReadErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerFsReadError = GetBotsByBotIdContainerFsReadErrors[keyof GetBotsByBotIdContainerFsReadErrors];
+export type PostBotsByBotIdMcpStdioByConnectionIdError = PostBotsByBotIdMcpStdioByConnectionIdErrors[keyof PostBotsByBotIdMcpStdioByConnectionIdErrors];
 
-export type GetBotsByBotIdContainerFsReadResponses = {
+export type PostBotsByBotIdMcpStdioByConnectionIdResponses = {
     /**
-     * OK
+     * JSON-RPC response: {jsonrpc,id,result|error}
      */
-    200: HandlersFsReadResponse;
+    200: {
+        [key: string]: unknown;
+    };
 };
 
-export type GetBotsByBotIdContainerFsReadResponse = GetBotsByBotIdContainerFsReadResponses[keyof GetBotsByBotIdContainerFsReadResponses];
+export type PostBotsByBotIdMcpStdioByConnectionIdResponse = PostBotsByBotIdMcpStdioByConnectionIdResponses[keyof PostBotsByBotIdMcpStdioByConnectionIdResponses];
 
-export type PostBotsByBotIdContainerFsRenameData = {
-    /**
-     * Rename request
-     */
-    body: HandlersFsRenameRequest;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
+export type GetBotsByBotIdMcpExportData = {
+    body?: never;
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/container/fs/rename';
+    url: '/bots/{bot_id}/mcp/export';
 };
 
-export type PostBotsByBotIdContainerFsRenameErrors = {
+export type GetBotsByBotIdMcpExportErrors = {
     /**
      * Bad Request
      */
@@ -4210,49 +7405,34 @@ export type PostBotsByBotIdContainerFsRenameErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerFsRenameError = PostBotsByBotIdContainerFsRenameErrors[keyof PostBotsByBotIdContainerFsRenameErrors];
+export type GetBotsByBotIdMcpExportError = GetBotsByBotIdMcpExportErrors[keyof GetBotsByBotIdMcpExportErrors];
 
-export type PostBotsByBotIdContainerFsRenameResponses = {
+export type GetBotsByBotIdMcpExportResponses = {
     /**
      * OK
      */
-    200: HandlersFsOpResponse;
+    200: McpExportResponse;
 };
 
-export type PostBotsByBotIdContainerFsRenameResponse = PostBotsByBotIdContainerFsRenameResponses[keyof PostBotsByBotIdContainerFsRenameResponses];
+export type GetBotsByBotIdMcpExportResponse = GetBotsByBotIdMcpExportResponses[keyof GetBotsByBotIdMcpExportResponses];
 
-export type PostBotsByBotIdContainerFsUploadData = {
-    body: {
-        /**
-         * Destination container path
-         */
-        path: string;
-        /**
-         * File to upload
-         */
-        file: Blob | File;
-    };
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
+export type PutBotsByBotIdMcpImportData = {
+    /**
+     * mcpServers dict
+     */
+    body: McpImportRequest;
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/container/fs/upload';
+    url: '/bots/{bot_id}/mcp/import';
 };
 
-export type PostBotsByBotIdContainerFsUploadErrors = {
+export type PutBotsByBotIdMcpImportErrors = {
     /**
      * Bad Request
      */
@@ -4267,33 +7447,30 @@ export type PostBotsByBotIdContainerFsUploadErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerFsUploadError = PostBotsByBotIdContainerFsUploadErrors[keyof PostBotsByBotIdContainerFsUploadErrors];
+export type PutBotsByBotIdMcpImportError = PutBotsByBotIdMcpImportErrors[keyof PutBotsByBotIdMcpImportErrors];
 
-export type PostBotsByBotIdContainerFsUploadResponses = {
+export type PutBotsByBotIdMcpImportResponses = {
     /**
      * OK
      */
-    200: HandlersFsUploadResponse;
+    200: McpListResponse;
 };
 
-export type PostBotsByBotIdContainerFsUploadResponse = PostBotsByBotIdContainerFsUploadResponses[keyof PostBotsByBotIdContainerFsUploadResponses];
+export type PutBotsByBotIdMcpImportResponse = PutBotsByBotIdMcpImportResponses[keyof PutBotsByBotIdMcpImportResponses];
 
-export type PostBotsByBotIdContainerFsWriteData = {
-    /**
-     * Write request
-     */
-    body: HandlersFsWriteRequest;
+export type DeleteBotsByBotIdMcpByIdData = {
+    body?: never;
     path: {
         /**
-         * Bot ID
+         * MCP ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/fs/write';
+    url: '/bots/{bot_id}/mcp/{id}';
 };
 
-export type PostBotsByBotIdContainerFsWriteErrors = {
+export type DeleteBotsByBotIdMcpByIdErrors = {
     /**
      * Bad Request
      */
@@ -4302,106 +7479,128 @@ export type PostBotsByBotIdContainerFsWriteErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerFsWriteError = PostBotsByBotIdContainerFsWriteErrors[keyof PostBotsByBotIdContainerFsWriteErrors];
+export type DeleteBotsByBotIdMcpByIdError = DeleteBotsByBotIdMcpByIdErrors[keyof DeleteBotsByBotIdMcpByIdErrors];
 
-export type PostBotsByBotIdContainerFsWriteResponses = {
+export type DeleteBotsByBotIdMcpByIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: HandlersFsOpResponse;
+    204: unknown;
 };
 
-export type PostBotsByBotIdContainerFsWriteResponse = PostBotsByBotIdContainerFsWriteResponses[keyof PostBotsByBotIdContainerFsWriteResponses];
-
-export type GetBotsByBotIdContainerMetricsData = {
+export type GetBotsByBotIdMcpByIdData = {
     body?: never;
     path: {
         /**
-         * Bot ID
+         * MCP ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/metrics';
+    url: '/bots/{bot_id}/mcp/{id}';
 };
 
-export type GetBotsByBotIdContainerMetricsErrors = {
+export type GetBotsByBotIdMcpByIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerMetricsError = GetBotsByBotIdContainerMetricsErrors[keyof GetBotsByBotIdContainerMetricsErrors];
+export type GetBotsByBotIdMcpByIdError = GetBotsByBotIdMcpByIdErrors[keyof GetBotsByBotIdMcpByIdErrors];
 
-export type GetBotsByBotIdContainerMetricsResponses = {
+export type GetBotsByBotIdMcpByIdResponses = {
     /**
      * OK
      */
-    200: HandlersGetContainerMetricsResponse;
+    200: GithubComMemohaiMemohInternalMcpConnection;
 };
 
-export type GetBotsByBotIdContainerMetricsResponse = GetBotsByBotIdContainerMetricsResponses[keyof GetBotsByBotIdContainerMetricsResponses];
+export type GetBotsByBotIdMcpByIdResponse = GetBotsByBotIdMcpByIdResponses[keyof GetBotsByBotIdMcpByIdResponses];
 
-export type PutBotsByBotIdContainerMetricsData = {
+export type PutBotsByBotIdMcpByIdData = {
     /**
-     * Metrics settings payload
+     * MCP payload
      */
-    body: HandlersUpdateContainerMetricsRequest;
+    body: McpUpsertRequest;
     path: {
         /**
-         * Bot ID
+         * MCP ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/metrics';
+    url: '/bots/{bot_id}/mcp/{id}';
 };
 
-export type PutBotsByBotIdContainerMetricsErrors = {
+export type PutBotsByBotIdMcpByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdContainerMetricsError = PutBotsByBotIdContainerMetricsErrors[keyof PutBotsByBotIdContainerMetricsErrors];
+export type PutBotsByBotIdMcpByIdError = PutBotsByBotIdMcpByIdErrors[keyof PutBotsByBotIdMcpByIdErrors];
 
-export type PutBotsByBotIdContainerMetricsResponses = {
+export type PutBotsByBotIdMcpByIdResponses = {
     /**
      * OK
      */
-    200: HandlersGetContainerMetricsResponse;
+    200: GithubComMemohaiMemohInternalMcpConnection;
 };
 
-export type PutBotsByBotIdContainerMetricsResponse = PutBotsByBotIdContainerMetricsResponses[keyof PutBotsByBotIdContainerMetricsResponses];
+export type PutBotsByBotIdMcpByIdResponse = PutBotsByBotIdMcpByIdResponses[keyof PutBotsByBotIdMcpByIdResponses];
 
-export type DeleteBotsByBotIdContainerSkillsData = {
+export type PostBotsByBotIdMcpByIdOauthAuthorizeData = {
     /**
-     * Delete skills payload
+     * Optional client_id
      */
-    body: HandlersSkillsDeleteRequest;
+    body?: HandlersOauthAuthorizeRequest;
     path: {
         /**
-         * Bot ID
+         * MCP connection ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/skills';
+    url: '/bots/{bot_id}/mcp/{id}/oauth/authorize';
 };
 
-export type DeleteBotsByBotIdContainerSkillsErrors = {
+export type PostBotsByBotIdMcpByIdOauthAuthorizeErrors = {
     /**
      * Bad Request
      */
@@ -4410,36 +7609,35 @@ export type DeleteBotsByBotIdContainerSkillsErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdContainerSkillsError = DeleteBotsByBotIdContainerSkillsErrors[keyof DeleteBotsByBotIdContainerSkillsErrors];
+export type PostBotsByBotIdMcpByIdOauthAuthorizeError = PostBotsByBotIdMcpByIdOauthAuthorizeErrors[keyof PostBotsByBotIdMcpByIdOauthAuthorizeErrors];
 
-export type DeleteBotsByBotIdContainerSkillsResponses = {
+export type PostBotsByBotIdMcpByIdOauthAuthorizeResponses = {
     /**
      * OK
      */
-    200: HandlersSkillsOpResponse;
+    200: McpAuthorizeResult;
 };
 
-export type DeleteBotsByBotIdContainerSkillsResponse = DeleteBotsByBotIdContainerSkillsResponses[keyof DeleteBotsByBotIdContainerSkillsResponses];
+export type PostBotsByBotIdMcpByIdOauthAuthorizeResponse = PostBotsByBotIdMcpByIdOauthAuthorizeResponses[keyof PostBotsByBotIdMcpByIdOauthAuthorizeResponses];
 
-export type GetBotsByBotIdContainerSkillsData = {
-    body?: never;
+export type PostBotsByBotIdMcpByIdOauthDiscoverData = {
+    /**
+     * Optional URL override
+     */
+    body?: HandlersOauthDiscoverRequest;
     path: {
         /**
-         * Bot ID
+         * MCP connection ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/skills';
+    url: '/bots/{bot_id}/mcp/{id}/oauth/discover';
 };
 
-export type GetBotsByBotIdContainerSkillsErrors = {
+export type PostBotsByBotIdMcpByIdOauthDiscoverErrors = {
     /**
      * Bad Request
      */
@@ -4448,80 +7646,62 @@ export type GetBotsByBotIdContainerSkillsErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerSkillsError = GetBotsByBotIdContainerSkillsErrors[keyof GetBotsByBotIdContainerSkillsErrors];
+export type PostBotsByBotIdMcpByIdOauthDiscoverError = PostBotsByBotIdMcpByIdOauthDiscoverErrors[keyof PostBotsByBotIdMcpByIdOauthDiscoverErrors];
 
-export type GetBotsByBotIdContainerSkillsResponses = {
+export type PostBotsByBotIdMcpByIdOauthDiscoverResponses = {
     /**
      * OK
      */
-    200: HandlersSkillsResponse;
+    200: McpDiscoveryResult;
 };
 
-export type GetBotsByBotIdContainerSkillsResponse = GetBotsByBotIdContainerSkillsResponses[keyof GetBotsByBotIdContainerSkillsResponses];
+export type PostBotsByBotIdMcpByIdOauthDiscoverResponse = PostBotsByBotIdMcpByIdOauthDiscoverResponses[keyof PostBotsByBotIdMcpByIdOauthDiscoverResponses];
 
-export type PostBotsByBotIdContainerSkillsData = {
+export type PostBotsByBotIdMcpByIdOauthExchangeData = {
     /**
-     * Skills payload
+     * Authorization code and state
      */
-    body: HandlersSkillsUpsertRequest;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
+    body: HandlersOauthExchangeRequest;
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/container/skills';
+    url: '/bots/{bot_id}/mcp/{id}/oauth/exchange';
 };
 
-export type PostBotsByBotIdContainerSkillsErrors = {
+export type PostBotsByBotIdMcpByIdOauthExchangeErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerSkillsError = PostBotsByBotIdContainerSkillsErrors[keyof PostBotsByBotIdContainerSkillsErrors];
+export type PostBotsByBotIdMcpByIdOauthExchangeError = PostBotsByBotIdMcpByIdOauthExchangeErrors[keyof PostBotsByBotIdMcpByIdOauthExchangeErrors];
 
-export type PostBotsByBotIdContainerSkillsResponses = {
+export type PostBotsByBotIdMcpByIdOauthExchangeResponses = {
     /**
      * OK
      */
-    200: HandlersSkillsOpResponse;
+    200: {
+        [key: string]: boolean;
+    };
 };
 
-export type PostBotsByBotIdContainerSkillsResponse = PostBotsByBotIdContainerSkillsResponses[keyof PostBotsByBotIdContainerSkillsResponses];
+export type PostBotsByBotIdMcpByIdOauthExchangeResponse = PostBotsByBotIdMcpByIdOauthExchangeResponses[keyof PostBotsByBotIdMcpByIdOauthExchangeResponses];
 
-export type PostBotsByBotIdContainerSkillsActionsData = {
-    /**
-     * Skill action payload
-     */
-    body: HandlersSkillsActionRequest;
+export type GetBotsByBotIdMcpByIdOauthStatusData = {
+    body?: never;
     path: {
         /**
-         * Bot ID
+         * MCP connection ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/skills/actions';
+    url: '/bots/{bot_id}/mcp/{id}/oauth/status';
 };
 
-export type PostBotsByBotIdContainerSkillsActionsErrors = {
+export type GetBotsByBotIdMcpByIdOauthStatusErrors = {
     /**
      * Bad Request
      */
@@ -4530,74 +7710,68 @@ export type PostBotsByBotIdContainerSkillsActionsErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerSkillsActionsError = PostBotsByBotIdContainerSkillsActionsErrors[keyof PostBotsByBotIdContainerSkillsActionsErrors];
+export type GetBotsByBotIdMcpByIdOauthStatusError = GetBotsByBotIdMcpByIdOauthStatusErrors[keyof GetBotsByBotIdMcpByIdOauthStatusErrors];
 
-export type PostBotsByBotIdContainerSkillsActionsResponses = {
+export type GetBotsByBotIdMcpByIdOauthStatusResponses = {
     /**
      * OK
      */
-    200: HandlersSkillsOpResponse;
+    200: McpOAuthStatus;
 };
 
-export type PostBotsByBotIdContainerSkillsActionsResponse = PostBotsByBotIdContainerSkillsActionsResponses[keyof PostBotsByBotIdContainerSkillsActionsResponses];
+export type GetBotsByBotIdMcpByIdOauthStatusResponse = GetBotsByBotIdMcpByIdOauthStatusResponses[keyof GetBotsByBotIdMcpByIdOauthStatusResponses];
 
-export type GetBotsByBotIdContainerSnapshotsData = {
+export type DeleteBotsByBotIdMcpByIdOauthTokenData = {
     body?: never;
     path: {
         /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
-    query?: {
-        /**
-         * Snapshotter name
+         * MCP connection ID
          */
-        snapshotter?: string;
+        id: string;
     };
-    url: '/bots/{bot_id}/container/snapshots';
+    query?: never;
+    url: '/bots/{bot_id}/mcp/{id}/oauth/token';
 };
 
-export type GetBotsByBotIdContainerSnapshotsErrors = {
+export type DeleteBotsByBotIdMcpByIdOauthTokenErrors = {
     /**
-     * Snapshots currently not supported on this backend
+     * Bad Request
      */
-    501: HandlersErrorResponse;
+    400: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerSnapshotsError = GetBotsByBotIdContainerSnapshotsErrors[keyof GetBotsByBotIdContainerSnapshotsErrors];
+export type DeleteBotsByBotIdMcpByIdOauthTokenError = DeleteBotsByBotIdMcpByIdOauthTokenErrors[keyof DeleteBotsByBotIdMcpByIdOauthTokenErrors];
 
-export type GetBotsByBotIdContainerSnapshotsResponses = {
+export type DeleteBotsByBotIdMcpByIdOauthTokenResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: HandlersListSnapshotsResponse;
+    204: unknown;
 };
 
-export type GetBotsByBotIdContainerSnapshotsResponse = GetBotsByBotIdContainerSnapshotsResponses[keyof GetBotsByBotIdContainerSnapshotsResponses];
-
-export type PostBotsByBotIdContainerSnapshotsData = {
-    /**
-     * Create snapshot payload
-     */
-    body: HandlersCreateSnapshotRequest;
+export type PostBotsByBotIdMcpByIdProbeData = {
+    body?: never;
     path: {
         /**
-         * Bot ID
+         * MCP connection ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/snapshots';
+    url: '/bots/{bot_id}/mcp/{id}/probe';
 };
 
-export type PostBotsByBotIdContainerSnapshotsErrors = {
+export type PostBotsByBotIdMcpByIdProbeErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Not Found
      */
@@ -4606,28 +7780,24 @@ export type PostBotsByBotIdContainerSnapshotsErrors = {
      * Internal Server Error
      */
     500: HandlersErrorResponse;
-    /**
-     * Snapshots currently not supported on this backend
-     */
-    501: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerSnapshotsError = PostBotsByBotIdContainerSnapshotsErrors[keyof PostBotsByBotIdContainerSnapshotsErrors];
+export type PostBotsByBotIdMcpByIdProbeError = PostBotsByBotIdMcpByIdProbeErrors[keyof PostBotsByBotIdMcpByIdProbeErrors];
 
-export type PostBotsByBotIdContainerSnapshotsResponses = {
+export type PostBotsByBotIdMcpByIdProbeResponses = {
     /**
      * OK
      */
-    200: HandlersCreateSnapshotResponse;
+    200: HandlersProbeResponse;
 };
 
-export type PostBotsByBotIdContainerSnapshotsResponse = PostBotsByBotIdContainerSnapshotsResponses[keyof PostBotsByBotIdContainerSnapshotsResponses];
+export type PostBotsByBotIdMcpByIdProbeResponse = PostBotsByBotIdMcpByIdProbeResponses[keyof PostBotsByBotIdMcpByIdProbeResponses];
 
-export type PostBotsByBotIdContainerSnapshotsRollbackData = {
+export type DeleteBotsByBotIdMemoryData = {
     /**
-     * Rollback payload
+     * Optional: specify memory_ids to delete; if omitted, deletes all
      */
-    body: HandlersRollbackRequest;
+    body?: HandlersMemoryDeletePayload;
     path: {
         /**
          * Bot ID
@@ -4635,34 +7805,40 @@ export type PostBotsByBotIdContainerSnapshotsRollbackData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/snapshots/rollback';
+    url: '/bots/{bot_id}/memory';
 };
 
-export type PostBotsByBotIdContainerSnapshotsRollbackErrors = {
+export type DeleteBotsByBotIdMemoryErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerSnapshotsRollbackError = PostBotsByBotIdContainerSnapshotsRollbackErrors[keyof PostBotsByBotIdContainerSnapshotsRollbackErrors];
+export type DeleteBotsByBotIdMemoryError = DeleteBotsByBotIdMemoryErrors[keyof DeleteBotsByBotIdMemoryErrors];
 
-export type PostBotsByBotIdContainerSnapshotsRollbackResponses = {
+export type DeleteBotsByBotIdMemoryResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: unknown;
-    };
+    200: AdaptersDeleteResponse;
 };
 
-export type PostBotsByBotIdContainerSnapshotsRollbackResponse = PostBotsByBotIdContainerSnapshotsRollbackResponses[keyof PostBotsByBotIdContainerSnapshotsRollbackResponses];
+export type DeleteBotsByBotIdMemoryResponse = DeleteBotsByBotIdMemoryResponses[keyof DeleteBotsByBotIdMemoryResponses];
 
-export type PostBotsByBotIdContainerStartData = {
+export type GetBotsByBotIdMemoryData = {
     body?: never;
     path: {
         /**
@@ -4670,36 +7846,50 @@ export type PostBotsByBotIdContainerStartData = {
          */
         bot_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/container/start';
+    query?: {
+        /**
+         * Skip optional stats in memory search response
+         */
+        no_stats?: boolean;
+    };
+    url: '/bots/{bot_id}/memory';
 };
 
-export type PostBotsByBotIdContainerStartErrors = {
+export type GetBotsByBotIdMemoryErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerStartError = PostBotsByBotIdContainerStartErrors[keyof PostBotsByBotIdContainerStartErrors];
+export type GetBotsByBotIdMemoryError = GetBotsByBotIdMemoryErrors[keyof GetBotsByBotIdMemoryErrors];
 
-export type PostBotsByBotIdContainerStartResponses = {
+export type GetBotsByBotIdMemoryResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: unknown;
-    };
+    200: AdaptersSearchResponse;
 };
 
-export type PostBotsByBotIdContainerStartResponse = PostBotsByBotIdContainerStartResponses[keyof PostBotsByBotIdContainerStartResponses];
+export type GetBotsByBotIdMemoryResponse = GetBotsByBotIdMemoryResponses[keyof GetBotsByBotIdMemoryResponses];
 
-export type PostBotsByBotIdContainerStopData = {
-    body?: never;
+export type PostBotsByBotIdMemoryData = {
+    /**
+     * Memory add payload
+     */
+    body: HandlersMemoryAddPayload;
     path: {
         /**
          * Bot ID
@@ -4707,35 +7897,44 @@ export type PostBotsByBotIdContainerStopData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/stop';
+    url: '/bots/{bot_id}/memory';
 };
 
-export type PostBotsByBotIdContainerStopErrors = {
+export type PostBotsByBotIdMemoryErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdContainerStopError = PostBotsByBotIdContainerStopErrors[keyof PostBotsByBotIdContainerStopErrors];
+export type PostBotsByBotIdMemoryError = PostBotsByBotIdMemoryErrors[keyof PostBotsByBotIdMemoryErrors];
 
-export type PostBotsByBotIdContainerStopResponses = {
+export type PostBotsByBotIdMemoryResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: unknown;
-    };
+    200: AdaptersSearchResponse;
 };
 
-export type PostBotsByBotIdContainerStopResponse = PostBotsByBotIdContainerStopResponses[keyof PostBotsByBotIdContainerStopResponses];
+export type PostBotsByBotIdMemoryResponse = PostBotsByBotIdMemoryResponses[keyof PostBotsByBotIdMemoryResponses];
 
-export type GetBotsByBotIdContainerTerminalData = {
-    body?: never;
+export type PostBotsByBotIdMemoryCompactData = {
+    /**
+     * ratio (0,1] required; decay_days optional
+     */
+    body: HandlersMemoryCompactPayload;
     path: {
         /**
          * Bot ID
@@ -4743,28 +7942,40 @@ export type GetBotsByBotIdContainerTerminalData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/container/terminal';
+    url: '/bots/{bot_id}/memory/compact';
 };
 
-export type GetBotsByBotIdContainerTerminalErrors = {
+export type PostBotsByBotIdMemoryCompactErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdContainerTerminalError = GetBotsByBotIdContainerTerminalErrors[keyof GetBotsByBotIdContainerTerminalErrors];
+export type PostBotsByBotIdMemoryCompactError = PostBotsByBotIdMemoryCompactErrors[keyof PostBotsByBotIdMemoryCompactErrors];
 
-export type GetBotsByBotIdContainerTerminalResponses = {
+export type PostBotsByBotIdMemoryCompactResponses = {
     /**
      * OK
      */
-    200: HandlersTerminalInfoResponse;
+    200: AdaptersCompactResult;
 };
 
-export type GetBotsByBotIdContainerTerminalResponse = GetBotsByBotIdContainerTerminalResponses[keyof GetBotsByBotIdContainerTerminalResponses];
+export type PostBotsByBotIdMemoryCompactResponse = PostBotsByBotIdMemoryCompactResponses[keyof PostBotsByBotIdMemoryCompactResponses];
 
-export type GetBotsByBotIdContainerTerminalWsData = {
+export type PostBotsByBotIdMemoryRebuildData = {
     body?: never;
     path: {
         /**
@@ -4772,71 +7983,49 @@ export type GetBotsByBotIdContainerTerminalWsData = {
          */
         bot_id: string;
     };
-    query?: {
-        /**
-         * Initial terminal columns
-         */
-        cols?: number;
-        /**
-         * Initial terminal rows
-         */
-        rows?: number;
-        /**
-         * Auth token
-         */
-        token?: string;
-    };
-    url: '/bots/{bot_id}/container/terminal/ws';
+    query?: never;
+    url: '/bots/{bot_id}/memory/rebuild';
 };
 
-export type GetBotsByBotIdContainerTerminalWsErrors = {
+export type PostBotsByBotIdMemoryRebuildErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Forbidden
      */
-    500: HandlersErrorResponse;
-};
-
-export type GetBotsByBotIdContainerTerminalWsError = GetBotsByBotIdContainerTerminalWsErrors[keyof GetBotsByBotIdContainerTerminalWsErrors];
-
-export type GetBotsByBotIdEmailBindingsData = {
-    body?: never;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
-    query?: never;
-    url: '/bots/{bot_id}/email-bindings';
-};
-
-export type GetBotsByBotIdEmailBindingsErrors = {
+    403: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdEmailBindingsError = GetBotsByBotIdEmailBindingsErrors[keyof GetBotsByBotIdEmailBindingsErrors];
+export type PostBotsByBotIdMemoryRebuildError = PostBotsByBotIdMemoryRebuildErrors[keyof PostBotsByBotIdMemoryRebuildErrors];
 
-export type GetBotsByBotIdEmailBindingsResponses = {
+export type PostBotsByBotIdMemoryRebuildResponses = {
     /**
      * OK
      */
-    200: Array<EmailBindingResponse>;
+    200: AdaptersRebuildResult;
 };
 
-export type GetBotsByBotIdEmailBindingsResponse = GetBotsByBotIdEmailBindingsResponses[keyof GetBotsByBotIdEmailBindingsResponses];
+export type PostBotsByBotIdMemoryRebuildResponse = PostBotsByBotIdMemoryRebuildResponses[keyof PostBotsByBotIdMemoryRebuildResponses];
 
-export type PostBotsByBotIdEmailBindingsData = {
+export type PostBotsByBotIdMemoryRestoreData = {
     /**
-     * Binding configuration
+     * RFC3339 point in time to restore
      */
-    body: EmailCreateBindingRequest;
+    body: HandlersMemoryRestorePayload;
     path: {
         /**
          * Bot ID
@@ -4844,105 +8033,93 @@ export type PostBotsByBotIdEmailBindingsData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/email-bindings';
+    url: '/bots/{bot_id}/memory/restore';
 };
 
-export type PostBotsByBotIdEmailBindingsErrors = {
+export type PostBotsByBotIdMemoryRestoreErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Forbidden
      */
-    500: HandlersErrorResponse;
-};
-
-export type PostBotsByBotIdEmailBindingsError = PostBotsByBotIdEmailBindingsErrors[keyof PostBotsByBotIdEmailBindingsErrors];
-
-export type PostBotsByBotIdEmailBindingsResponses = {
+    403: HandlersErrorResponse;
     /**
-     * Created
-     */
-    201: EmailBindingResponse;
-};
-
-export type PostBotsByBotIdEmailBindingsResponse = PostBotsByBotIdEmailBindingsResponses[keyof PostBotsByBotIdEmailBindingsResponses];
-
-export type DeleteBotsByBotIdEmailBindingsByIdData = {
-    body?: never;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-        /**
-         * Binding ID
-         */
-        id: string;
-    };
-    query?: never;
-    url: '/bots/{bot_id}/email-bindings/{id}';
-};
-
-export type DeleteBotsByBotIdEmailBindingsByIdErrors = {
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdEmailBindingsByIdError = DeleteBotsByBotIdEmailBindingsByIdErrors[keyof DeleteBotsByBotIdEmailBindingsByIdErrors];
+export type PostBotsByBotIdMemoryRestoreError = PostBotsByBotIdMemoryRestoreErrors[keyof PostBotsByBotIdMemoryRestoreErrors];
 
-export type DeleteBotsByBotIdEmailBindingsByIdResponses = {
+export type PostBotsByBotIdMemoryRestoreResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: AdaptersRestoreResult;
 };
 
-export type PutBotsByBotIdEmailBindingsByIdData = {
+export type PostBotsByBotIdMemoryRestoreResponse = PostBotsByBotIdMemoryRestoreResponses[keyof PostBotsByBotIdMemoryRestoreResponses];
+
+export type PostBotsByBotIdMemorySearchData = {
     /**
-     * Updated binding
+     * Memory search payload
      */
-    body: EmailUpdateBindingRequest;
+    body: HandlersMemorySearchPayload;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Binding ID
-         */
-        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/email-bindings/{id}';
+    url: '/bots/{bot_id}/memory/search';
 };
 
-export type PutBotsByBotIdEmailBindingsByIdErrors = {
+export type PostBotsByBotIdMemorySearchErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdEmailBindingsByIdError = PutBotsByBotIdEmailBindingsByIdErrors[keyof PutBotsByBotIdEmailBindingsByIdErrors];
+export type PostBotsByBotIdMemorySearchError = PostBotsByBotIdMemorySearchErrors[keyof PostBotsByBotIdMemorySearchErrors];
 
-export type PutBotsByBotIdEmailBindingsByIdResponses = {
+export type PostBotsByBotIdMemorySearchResponses = {
     /**
      * OK
      */
-    200: EmailBindingResponse;
+    200: AdaptersSearchResponse;
 };
 
-export type PutBotsByBotIdEmailBindingsByIdResponse = PutBotsByBotIdEmailBindingsByIdResponses[keyof PutBotsByBotIdEmailBindingsByIdResponses];
+export type PostBotsByBotIdMemorySearchResponse = PostBotsByBotIdMemorySearchResponses[keyof PostBotsByBotIdMemorySearchResponses];
 
-export type GetBotsByBotIdEmailOutboxData = {
+export type GetBotsByBotIdMemoryStatusData = {
     body?: never;
     path: {
         /**
@@ -4950,164 +8127,202 @@ export type GetBotsByBotIdEmailOutboxData = {
          */
         bot_id: string;
     };
-    query?: {
-        /**
-         * Limit
-         */
-        limit?: number;
-        /**
-         * Offset
-         */
-        offset?: number;
-    };
-    url: '/bots/{bot_id}/email-outbox';
+    query?: never;
+    url: '/bots/{bot_id}/memory/status';
 };
 
-export type GetBotsByBotIdEmailOutboxErrors = {
+export type GetBotsByBotIdMemoryStatusErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdEmailOutboxError = GetBotsByBotIdEmailOutboxErrors[keyof GetBotsByBotIdEmailOutboxErrors];
+export type GetBotsByBotIdMemoryStatusError = GetBotsByBotIdMemoryStatusErrors[keyof GetBotsByBotIdMemoryStatusErrors];
 
-export type GetBotsByBotIdEmailOutboxResponses = {
+export type GetBotsByBotIdMemoryStatusResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: unknown;
-    };
+    200: AdaptersMemoryStatusResponse;
 };
 
-export type GetBotsByBotIdEmailOutboxResponse = GetBotsByBotIdEmailOutboxResponses[keyof GetBotsByBotIdEmailOutboxResponses];
+export type GetBotsByBotIdMemoryStatusResponse = GetBotsByBotIdMemoryStatusResponses[keyof GetBotsByBotIdMemoryStatusResponses];
 
-export type GetBotsByBotIdEmailOutboxByIdData = {
+export type GetBotsByBotIdMemoryUsageData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Email ID
-         */
-        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/email-outbox/{id}';
+    url: '/bots/{bot_id}/memory/usage';
 };
 
-export type GetBotsByBotIdEmailOutboxByIdErrors = {
+export type GetBotsByBotIdMemoryUsageErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdEmailOutboxByIdError = GetBotsByBotIdEmailOutboxByIdErrors[keyof GetBotsByBotIdEmailOutboxByIdErrors];
+export type GetBotsByBotIdMemoryUsageError = GetBotsByBotIdMemoryUsageErrors[keyof GetBotsByBotIdMemoryUsageErrors];
 
-export type GetBotsByBotIdEmailOutboxByIdResponses = {
+export type GetBotsByBotIdMemoryUsageResponses = {
     /**
      * OK
      */
-    200: EmailOutboxItemResponse;
+    200: AdaptersUsageResponse;
 };
 
-export type GetBotsByBotIdEmailOutboxByIdResponse = GetBotsByBotIdEmailOutboxByIdResponses[keyof GetBotsByBotIdEmailOutboxByIdResponses];
+export type GetBotsByBotIdMemoryUsageResponse = GetBotsByBotIdMemoryUsageResponses[keyof GetBotsByBotIdMemoryUsageResponses];
 
-export type DeleteBotsByBotIdHeartbeatLogsData = {
+export type DeleteBotsByBotIdMemoryByIdData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Memory ID
+         */
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/heartbeat/logs';
+    url: '/bots/{bot_id}/memory/{id}';
 };
 
-export type DeleteBotsByBotIdHeartbeatLogsErrors = {
+export type DeleteBotsByBotIdMemoryByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdHeartbeatLogsError = DeleteBotsByBotIdHeartbeatLogsErrors[keyof DeleteBotsByBotIdHeartbeatLogsErrors];
+export type DeleteBotsByBotIdMemoryByIdError = DeleteBotsByBotIdMemoryByIdErrors[keyof DeleteBotsByBotIdMemoryByIdErrors];
 
-export type DeleteBotsByBotIdHeartbeatLogsResponses = {
+export type DeleteBotsByBotIdMemoryByIdResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: AdaptersDeleteResponse;
 };
 
-export type GetBotsByBotIdHeartbeatLogsData = {
+export type DeleteBotsByBotIdMemoryByIdResponse = DeleteBotsByBotIdMemoryByIdResponses[keyof DeleteBotsByBotIdMemoryByIdResponses];
+
+export type GetBotsByBotIdMemoryByIdHistoryData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-    };
-    query?: {
-        /**
-         * Limit
-         */
-        limit?: number;
         /**
-         * Offset
+         * Memory ID
          */
-        offset?: number;
+        id: string;
     };
-    url: '/bots/{bot_id}/heartbeat/logs';
+    query?: never;
+    url: '/bots/{bot_id}/memory/{id}/history';
 };
 
-export type GetBotsByBotIdHeartbeatLogsErrors = {
+export type GetBotsByBotIdMemoryByIdHistoryErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdHeartbeatLogsError = GetBotsByBotIdHeartbeatLogsErrors[keyof GetBotsByBotIdHeartbeatLogsErrors];
+export type GetBotsByBotIdMemoryByIdHistoryError = GetBotsByBotIdMemoryByIdHistoryErrors[keyof GetBotsByBotIdMemoryByIdHistoryErrors];
 
-export type GetBotsByBotIdHeartbeatLogsResponses = {
+export type GetBotsByBotIdMemoryByIdHistoryResponses = {
     /**
      * OK
      */
-    200: HeartbeatListLogsResponse;
+    200: Array<AdaptersMemoryRevision>;
 };
 
-export type GetBotsByBotIdHeartbeatLogsResponse = GetBotsByBotIdHeartbeatLogsResponses[keyof GetBotsByBotIdHeartbeatLogsResponses];
+export type GetBotsByBotIdMemoryByIdHistoryResponse = GetBotsByBotIdMemoryByIdHistoryResponses[keyof GetBotsByBotIdMemoryByIdHistoryResponses];
 
-export type PostBotsByBotIdLocalMessagesData = {
+export type PostBotsByBotIdMemoryByIdRollbackData = {
     /**
-     * Message payload
+     * Revision to roll back to
      */
-    body: HandlersLocalChannelMessageRequest;
+    body: HandlersMemoryRollbackPayload;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Memory ID
+         */
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/local/messages';
+    url: '/bots/{bot_id}/memory/{id}/rollback';
 };
 
-export type PostBotsByBotIdLocalMessagesErrors = {
+export type PostBotsByBotIdMemoryByIdRollbackErrors = {
     /**
      * Bad Request
      */
@@ -5116,26 +8331,36 @@ export type PostBotsByBotIdLocalMessagesErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
+    /**
+     * Service Unavailable
+     */
+    503: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdLocalMessagesError = PostBotsByBotIdLocalMessagesErrors[keyof PostBotsByBotIdLocalMessagesErrors];
+export type PostBotsByBotIdMemoryByIdRollbackError = PostBotsByBotIdMemoryByIdRollbackErrors[keyof PostBotsByBotIdMemoryByIdRollbackErrors];
 
-export type PostBotsByBotIdLocalMessagesResponses = {
+export type PostBotsByBotIdMemoryByIdRollbackResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: string;
-    };
+    200: AdaptersMemoryItem;
 };
 
-export type PostBotsByBotIdLocalMessagesResponse = PostBotsByBotIdLocalMessagesResponses[keyof PostBotsByBotIdLocalMessagesResponses];
+export type PostBotsByBotIdMemoryByIdRollbackResponse = PostBotsByBotIdMemoryByIdRollbackResponses[keyof PostBotsByBotIdMemoryByIdRollbackResponses];
 
-export type GetBotsByBotIdLocalStreamData = {
+export type DeleteBotsByBotIdMessagesData = {
     body?: never;
     path: {
         /**
@@ -5144,10 +8369,10 @@ export type GetBotsByBotIdLocalStreamData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/local/stream';
+    url: '/bots/{bot_id}/messages';
 };
 
-export type GetBotsByBotIdLocalStreamErrors = {
+export type DeleteBotsByBotIdMessagesErrors = {
     /**
      * Bad Request
      */
@@ -5162,18 +8387,16 @@ export type GetBotsByBotIdLocalStreamErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdLocalStreamError = GetBotsByBotIdLocalStreamErrors[keyof GetBotsByBotIdLocalStreamErrors];
+export type DeleteBotsByBotIdMessagesError = DeleteBotsByBotIdMessagesErrors[keyof DeleteBotsByBotIdMessagesErrors];
 
-export type GetBotsByBotIdLocalStreamResponses = {
+export type DeleteBotsByBotIdMessagesResponses = {
     /**
-     * SSE stream
+     * No Content
      */
-    200: string;
+    204: unknown;
 };
 
-export type GetBotsByBotIdLocalStreamResponse = GetBotsByBotIdLocalStreamResponses[keyof GetBotsByBotIdLocalStreamResponses];
-
-export type GetBotsByBotIdLocalWsData = {
+export type GetBotsByBotIdMessagesData = {
     body?: never;
     path: {
         /**
@@ -5181,11 +8404,20 @@ export type GetBotsByBotIdLocalWsData = {
          */
         bot_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/local/ws';
+    query?: {
+        /**
+         * Limit
+         */
+        limit?: number;
+        /**
+         * Before
+         */
+        before?: string;
+    };
+    url: '/bots/{bot_id}/messages';
 };
 
-export type GetBotsByBotIdLocalWsErrors = {
+export type GetBotsByBotIdMessagesErrors = {
     /**
      * Bad Request
      */
@@ -5200,21 +8432,49 @@ export type GetBotsByBotIdLocalWsErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdLocalWsError = GetBotsByBotIdLocalWsErrors[keyof GetBotsByBotIdLocalWsErrors];
+export type GetBotsByBotIdMessagesError = GetBotsByBotIdMessagesErrors[keyof GetBotsByBotIdMessagesErrors];
 
-export type GetBotsByBotIdMcpData = {
+export type GetBotsByBotIdMessagesResponses = {
+    /**
+     * OK
+     */
+    200: {
+        [key: string]: Array<MessageMessage>;
+    };
+};
+
+export type GetBotsByBotIdMessagesResponse = GetBotsByBotIdMessagesResponses[keyof GetBotsByBotIdMessagesResponses];
+
+export type GetBotsByBotIdMessagesLocateData = {
     body?: never;
-    path?: never;
-    query?: {
+    path: {
         /**
-         * Include plugin-managed hidden MCP connections
+         * Bot ID
          */
-        include_managed?: boolean;
+        bot_id: string;
     };
-    url: '/bots/{bot_id}/mcp';
+    query: {
+        /**
+         * Session ID
+         */
+        session_id: string;
+        /**
+         * External message ID
+         */
+        external_message_id: string;
+        /**
+         * Messages before target
+         */
+        before?: number;
+        /**
+         * Messages after target
+         */
+        after?: number;
+    };
+    url: '/bots/{bot_id}/messages/locate';
 };
 
-export type GetBotsByBotIdMcpErrors = {
+export type GetBotsByBotIdMessagesLocateErrors = {
     /**
      * Bad Request
      */
@@ -5233,28 +8493,32 @@ export type GetBotsByBotIdMcpErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMcpError = GetBotsByBotIdMcpErrors[keyof GetBotsByBotIdMcpErrors];
+export type GetBotsByBotIdMessagesLocateError = GetBotsByBotIdMessagesLocateErrors[keyof GetBotsByBotIdMessagesLocateErrors];
 
-export type GetBotsByBotIdMcpResponses = {
+export type GetBotsByBotIdMessagesLocateResponses = {
     /**
      * OK
      */
-    200: McpListResponse;
+    200: {
+        [key: string]: unknown;
+    };
 };
 
-export type GetBotsByBotIdMcpResponse = GetBotsByBotIdMcpResponses[keyof GetBotsByBotIdMcpResponses];
+export type GetBotsByBotIdMessagesLocateResponse = GetBotsByBotIdMessagesLocateResponses[keyof GetBotsByBotIdMessagesLocateResponses];
 
-export type PostBotsByBotIdMcpData = {
-    /**
-     * MCP payload
-     */
-    body: McpUpsertRequest;
-    path?: never;
+export type GetBotsByBotIdPluginsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/mcp';
+    url: '/bots/{bot_id}/plugins';
 };
 
-export type PostBotsByBotIdMcpErrors = {
+export type GetBotsByBotIdPluginsErrors = {
     /**
      * Bad Request
      */
@@ -5263,38 +8527,39 @@ export type PostBotsByBotIdMcpErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMcpError = PostBotsByBotIdMcpErrors[keyof PostBotsByBotIdMcpErrors];
+export type GetBotsByBotIdPluginsError = GetBotsByBotIdPluginsErrors[keyof GetBotsByBotIdPluginsErrors];
 
-export type PostBotsByBotIdMcpResponses = {
+export type GetBotsByBotIdPluginsResponses = {
     /**
-     * Created
+     * OK
      */
-    201: GithubComMemohaiMemohInternalMcpConnection;
+    200: PluginsListResponse;
 };
 
-export type PostBotsByBotIdMcpResponse = PostBotsByBotIdMcpResponses[keyof PostBotsByBotIdMcpResponses];
+export type GetBotsByBotIdPluginsResponse = GetBotsByBotIdPluginsResponses[keyof GetBotsByBotIdPluginsResponses];
 
-export type PostBotsByBotIdMcpOpsBatchDeleteData = {
+export type PostBotsByBotIdPluginsData = {
     /**
-     * IDs to delete
+     * Plugin install request
      */
-    body: HandlersBatchDeleteRequest;
-    path?: never;
+    body: PluginsInstallRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/mcp-ops/batch-delete';
+    url: '/bots/{bot_id}/plugins';
 };
 
-export type PostBotsByBotIdMcpOpsBatchDeleteErrors = {
+export type PostBotsByBotIdPluginsErrors = {
     /**
      * Bad Request
      */
@@ -5309,82 +8574,82 @@ export type PostBotsByBotIdMcpOpsBatchDeleteErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMcpOpsBatchDeleteError = PostBotsByBotIdMcpOpsBatchDeleteErrors[keyof PostBotsByBotIdMcpOpsBatchDeleteErrors];
+export type PostBotsByBotIdPluginsError = PostBotsByBotIdPluginsErrors[keyof PostBotsByBotIdPluginsErrors];
 
-export type PostBotsByBotIdMcpOpsBatchDeleteResponses = {
+export type PostBotsByBotIdPluginsResponses = {
     /**
-     * No Content
+     * Created
      */
-    204: unknown;
+    201: PluginsInstallation;
 };
 
-export type PostBotsByBotIdMcpStdioData = {
-    /**
-     * Stdio MCP payload
-     */
-    body: HandlersMcpStdioRequest;
+export type PostBotsByBotIdPluginsResponse = PostBotsByBotIdPluginsResponses[keyof PostBotsByBotIdPluginsResponses];
+
+export type DeleteBotsByBotIdPluginsByIdData = {
+    body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Plugin installation ID
+         */
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/mcp-stdio';
+    url: '/bots/{bot_id}/plugins/{id}';
 };
 
-export type PostBotsByBotIdMcpStdioErrors = {
+export type DeleteBotsByBotIdPluginsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Forbidden
      */
-    404: HandlersErrorResponse;
+    403: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMcpStdioError = PostBotsByBotIdMcpStdioErrors[keyof PostBotsByBotIdMcpStdioErrors];
+export type DeleteBotsByBotIdPluginsByIdError = DeleteBotsByBotIdPluginsByIdErrors[keyof DeleteBotsByBotIdPluginsByIdErrors];
 
-export type PostBotsByBotIdMcpStdioResponses = {
+export type DeleteBotsByBotIdPluginsByIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: HandlersMcpStdioResponse;
+    204: unknown;
 };
 
-export type PostBotsByBotIdMcpStdioResponse = PostBotsByBotIdMcpStdioResponses[keyof PostBotsByBotIdMcpStdioResponses];
-
-export type PostBotsByBotIdMcpStdioByConnectionIdData = {
-    /**
-     * JSON-RPC request
-     */
-    body: {
-        [key: string]: unknown;
-    };
+export type GetBotsByBotIdPluginsByIdData = {
+    body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
         /**
-         * Connection ID
+         * Plugin installation ID
          */
-        connection_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/mcp-stdio/{connection_id}';
+    url: '/bots/{bot_id}/plugins/{id}';
 };
 
-export type PostBotsByBotIdMcpStdioByConnectionIdErrors = {
+export type GetBotsByBotIdPluginsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Not Found
      */
@@ -5395,27 +8660,34 @@ export type PostBotsByBotIdMcpStdioByConnectionIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMcpStdioByConnectionIdError = PostBotsByBotIdMcpStdioByConnectionIdErrors[keyof PostBotsByBotIdMcpStdioByConnectionIdErrors];
+export type GetBotsByBotIdPluginsByIdError = GetBotsByBotIdPluginsByIdErrors[keyof GetBotsByBotIdPluginsByIdErrors];
 
-export type PostBotsByBotIdMcpStdioByConnectionIdResponses = {
+export type GetBotsByBotIdPluginsByIdResponses = {
     /**
-     * JSON-RPC response: {jsonrpc,id,result|error}
+     * OK
      */
-    200: {
-        [key: string]: unknown;
-    };
+    200: PluginsInstallation;
 };
 
-export type PostBotsByBotIdMcpStdioByConnectionIdResponse = PostBotsByBotIdMcpStdioByConnectionIdResponses[keyof PostBotsByBotIdMcpStdioByConnectionIdResponses];
+export type GetBotsByBotIdPluginsByIdResponse = GetBotsByBotIdPluginsByIdResponses[keyof GetBotsByBotIdPluginsByIdResponses];
 
-export type GetBotsByBotIdMcpExportData = {
+export type PostBotsByBotIdPluginsByIdDisableData = {
     body?: never;
-    path?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Plugin installation ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/mcp/export';
+    url: '/bots/{bot_id}/plugins/{id}/disable';
 };
 
-export type GetBotsByBotIdMcpExportErrors = {
+export type PostBotsByBotIdPluginsByIdDisableErrors = {
     /**
      * Bad Request
      */
@@ -5425,33 +8697,39 @@ export type GetBotsByBotIdMcpExportErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMcpExportError = GetBotsByBotIdMcpExportErrors[keyof GetBotsByBotIdMcpExportErrors];
+export type PostBotsByBotIdPluginsByIdDisableError = PostBotsByBotIdPluginsByIdDisableErrors[keyof PostBotsByBotIdPluginsByIdDisableErrors];
 
-export type GetBotsByBotIdMcpExportResponses = {
+export type PostBotsByBotIdPluginsByIdDisableResponses = {
     /**
      * OK
      */
-    200: McpExportResponse;
+    200: PluginsInstallation;
 };
 
-export type GetBotsByBotIdMcpExportResponse = GetBotsByBotIdMcpExportResponses[keyof GetBotsByBotIdMcpExportResponses];
+export type PostBotsByBotIdPluginsByIdDisableResponse = PostBotsByBotIdPluginsByIdDisableResponses[keyof PostBotsByBotIdPluginsByIdDisableResponses];
 
-export type PutBotsByBotIdMcpImportData = {
-    /**
-     * mcpServers dict
-     */
-    body: McpImportRequest;
-    path?: never;
+export type PostBotsByBotIdPluginsByIdEnableData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Plugin installation ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/mcp/import';
+    url: '/bots/{bot_id}/plugins/{id}/enable';
 };
 
-export type PutBotsByBotIdMcpImportErrors = {
+export type PostBotsByBotIdPluginsByIdEnableErrors = {
     /**
      * Bad Request
      */
@@ -5461,35 +8739,42 @@ export type PutBotsByBotIdMcpImportErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdMcpImportError = PutBotsByBotIdMcpImportErrors[keyof PutBotsByBotIdMcpImportErrors];
+export type PostBotsByBotIdPluginsByIdEnableError = PostBotsByBotIdPluginsByIdEnableErrors[keyof PostBotsByBotIdPluginsByIdEnableErrors];
 
-export type PutBotsByBotIdMcpImportResponses = {
+export type PostBotsByBotIdPluginsByIdEnableResponses = {
     /**
      * OK
      */
-    200: McpListResponse;
+    200: PluginsInstallation;
 };
 
-export type PutBotsByBotIdMcpImportResponse = PutBotsByBotIdMcpImportResponses[keyof PutBotsByBotIdMcpImportResponses];
+export type PostBotsByBotIdPluginsByIdEnableResponse = PostBotsByBotIdPluginsByIdEnableResponses[keyof PostBotsByBotIdPluginsByIdEnableResponses];
 
-export type DeleteBotsByBotIdMcpByIdData = {
-    body?: never;
+export type PostBotsByBotIdPluginsByIdOauthAuthorizeData = {
+    /**
+     * OAuth authorize request
+     */
+    body?: PluginsOAuthAuthorizeRequest;
     path: {
         /**
-         * MCP ID
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Plugin installation ID
          */
         id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/mcp/{id}';
+    url: '/bots/{bot_id}/plugins/{id}/oauth/authorize';
 };
 
-export type DeleteBotsByBotIdMcpByIdErrors = {
+export type PostBotsByBotIdPluginsByIdOauthAuthorizeErrors = {
     /**
      * Bad Request
      */
@@ -5502,34 +8787,36 @@ export type DeleteBotsByBotIdMcpByIdErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdMcpByIdError = DeleteBotsByBotIdMcpByIdErrors[keyof DeleteBotsByBotIdMcpByIdErrors];
+export type PostBotsByBotIdPluginsByIdOauthAuthorizeError = PostBotsByBotIdPluginsByIdOauthAuthorizeErrors[keyof PostBotsByBotIdPluginsByIdOauthAuthorizeErrors];
 
-export type DeleteBotsByBotIdMcpByIdResponses = {
+export type PostBotsByBotIdPluginsByIdOauthAuthorizeResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: McpAuthorizeResult;
 };
 
-export type GetBotsByBotIdMcpByIdData = {
+export type PostBotsByBotIdPluginsByIdOauthAuthorizeResponse = PostBotsByBotIdPluginsByIdOauthAuthorizeResponses[keyof PostBotsByBotIdPluginsByIdOauthAuthorizeResponses];
+
+export type GetBotsByBotIdPluginsByIdOauthStatusData = {
     body?: never;
     path: {
         /**
-         * MCP ID
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Plugin installation ID
          */
         id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/mcp/{id}';
+    url: '/bots/{bot_id}/plugins/{id}/oauth/status';
 };
 
-export type GetBotsByBotIdMcpByIdErrors = {
+export type GetBotsByBotIdPluginsByIdOauthStatusErrors = {
     /**
      * Bad Request
      */
@@ -5542,39 +8829,36 @@ export type GetBotsByBotIdMcpByIdErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMcpByIdError = GetBotsByBotIdMcpByIdErrors[keyof GetBotsByBotIdMcpByIdErrors];
+export type GetBotsByBotIdPluginsByIdOauthStatusError = GetBotsByBotIdPluginsByIdOauthStatusErrors[keyof GetBotsByBotIdPluginsByIdOauthStatusErrors];
 
-export type GetBotsByBotIdMcpByIdResponses = {
+export type GetBotsByBotIdPluginsByIdOauthStatusResponses = {
     /**
      * OK
      */
-    200: GithubComMemohaiMemohInternalMcpConnection;
+    200: PluginsInstallation;
 };
 
-export type GetBotsByBotIdMcpByIdResponse = GetBotsByBotIdMcpByIdResponses[keyof GetBotsByBotIdMcpByIdResponses];
+export type GetBotsByBotIdPluginsByIdOauthStatusResponse = GetBotsByBotIdPluginsByIdOauthStatusResponses[keyof GetBotsByBotIdPluginsByIdOauthStatusResponses];
 
-export type PutBotsByBotIdMcpByIdData = {
-    /**
-     * MCP payload
-     */
-    body: McpUpsertRequest;
+export type PostBotsByBotIdPluginsByIdUninstallData = {
+    body?: never;
     path: {
         /**
-         * MCP ID
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Plugin installation ID
          */
         id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/mcp/{id}';
+    url: '/bots/{bot_id}/plugins/{id}/uninstall';
 };
 
-export type PutBotsByBotIdMcpByIdErrors = {
+export type PostBotsByBotIdPluginsByIdUninstallErrors = {
     /**
      * Bad Request
      */
@@ -5587,202 +8871,215 @@ export type PutBotsByBotIdMcpByIdErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdMcpByIdError = PutBotsByBotIdMcpByIdErrors[keyof PutBotsByBotIdMcpByIdErrors];
+export type PostBotsByBotIdPluginsByIdUninstallError = PostBotsByBotIdPluginsByIdUninstallErrors[keyof PostBotsByBotIdPluginsByIdUninstallErrors];
 
-export type PutBotsByBotIdMcpByIdResponses = {
+export type PostBotsByBotIdPluginsByIdUninstallResponses = {
     /**
      * OK
      */
-    200: GithubComMemohaiMemohInternalMcpConnection;
+    200: PluginsInstallation;
 };
 
-export type PutBotsByBotIdMcpByIdResponse = PutBotsByBotIdMcpByIdResponses[keyof PutBotsByBotIdMcpByIdResponses];
+export type PostBotsByBotIdPluginsByIdUninstallResponse = PostBotsByBotIdPluginsByIdUninstallResponses[keyof PostBotsByBotIdPluginsByIdUninstallResponses];
 
-export type PostBotsByBotIdMcpByIdOauthAuthorizeData = {
-    /**
-     * Optional client_id
-     */
-    body?: HandlersOauthAuthorizeRequest;
-    path: {
-        /**
-         * MCP connection ID
-         */
-        id: string;
-    };
+export type GetBotsByBotIdScheduleData = {
+    body?: never;
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/mcp/{id}/oauth/authorize';
+    url: '/bots/{bot_id}/schedule';
 };
 
-export type PostBotsByBotIdMcpByIdOauthAuthorizeErrors = {
+export type GetBotsByBotIdScheduleErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMcpByIdOauthAuthorizeError = PostBotsByBotIdMcpByIdOauthAuthorizeErrors[keyof PostBotsByBotIdMcpByIdOauthAuthorizeErrors];
+export type GetBotsByBotIdScheduleError = GetBotsByBotIdScheduleErrors[keyof GetBotsByBotIdScheduleErrors];
 
-export type PostBotsByBotIdMcpByIdOauthAuthorizeResponses = {
+export type GetBotsByBotIdScheduleResponses = {
     /**
      * OK
      */
-    200: McpAuthorizeResult;
+    200: ScheduleListResponse;
 };
 
-export type PostBotsByBotIdMcpByIdOauthAuthorizeResponse = PostBotsByBotIdMcpByIdOauthAuthorizeResponses[keyof PostBotsByBotIdMcpByIdOauthAuthorizeResponses];
+export type GetBotsByBotIdScheduleResponse = GetBotsByBotIdScheduleResponses[keyof GetBotsByBotIdScheduleResponses];
 
-export type PostBotsByBotIdMcpByIdOauthDiscoverData = {
+export type PostBotsByBotIdScheduleData = {
     /**
-     * Optional URL override
+     * Schedule payload
      */
-    body?: HandlersOauthDiscoverRequest;
-    path: {
-        /**
-         * MCP connection ID
-         */
-        id: string;
-    };
+    body: ScheduleCreateRequest;
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/mcp/{id}/oauth/discover';
+    url: '/bots/{bot_id}/schedule';
 };
 
-export type PostBotsByBotIdMcpByIdOauthDiscoverErrors = {
+export type PostBotsByBotIdScheduleErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMcpByIdOauthDiscoverError = PostBotsByBotIdMcpByIdOauthDiscoverErrors[keyof PostBotsByBotIdMcpByIdOauthDiscoverErrors];
+export type PostBotsByBotIdScheduleError = PostBotsByBotIdScheduleErrors[keyof PostBotsByBotIdScheduleErrors];
 
-export type PostBotsByBotIdMcpByIdOauthDiscoverResponses = {
+export type PostBotsByBotIdScheduleResponses = {
     /**
-     * OK
+     * Created
      */
-    200: McpDiscoveryResult;
+    201: ScheduleSchedule;
 };
 
-export type PostBotsByBotIdMcpByIdOauthDiscoverResponse = PostBotsByBotIdMcpByIdOauthDiscoverResponses[keyof PostBotsByBotIdMcpByIdOauthDiscoverResponses];
+export type PostBotsByBotIdScheduleResponse = PostBotsByBotIdScheduleResponses[keyof PostBotsByBotIdScheduleResponses];
 
-export type PostBotsByBotIdMcpByIdOauthExchangeData = {
-    /**
-     * Authorization code and state
-     */
-    body: HandlersOauthExchangeRequest;
-    path?: never;
+export type DeleteBotsByBotIdScheduleLogsData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/mcp/{id}/oauth/exchange';
+    url: '/bots/{bot_id}/schedule/logs';
 };
 
-export type PostBotsByBotIdMcpByIdOauthExchangeErrors = {
+export type DeleteBotsByBotIdScheduleLogsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMcpByIdOauthExchangeError = PostBotsByBotIdMcpByIdOauthExchangeErrors[keyof PostBotsByBotIdMcpByIdOauthExchangeErrors];
+export type DeleteBotsByBotIdScheduleLogsError = DeleteBotsByBotIdScheduleLogsErrors[keyof DeleteBotsByBotIdScheduleLogsErrors];
 
-export type PostBotsByBotIdMcpByIdOauthExchangeResponses = {
+export type DeleteBotsByBotIdScheduleLogsResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: {
-        [key: string]: boolean;
-    };
+    204: unknown;
 };
 
-export type PostBotsByBotIdMcpByIdOauthExchangeResponse = PostBotsByBotIdMcpByIdOauthExchangeResponses[keyof PostBotsByBotIdMcpByIdOauthExchangeResponses];
-
-export type GetBotsByBotIdMcpByIdOauthStatusData = {
+export type GetBotsByBotIdScheduleLogsData = {
     body?: never;
     path: {
         /**
-         * MCP connection ID
+         * Bot ID
          */
-        id: string;
+        bot_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/mcp/{id}/oauth/status';
+    query?: {
+        /**
+         * Limit
+         */
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
+    };
+    url: '/bots/{bot_id}/schedule/logs';
 };
 
-export type GetBotsByBotIdMcpByIdOauthStatusErrors = {
+export type GetBotsByBotIdScheduleLogsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMcpByIdOauthStatusError = GetBotsByBotIdMcpByIdOauthStatusErrors[keyof GetBotsByBotIdMcpByIdOauthStatusErrors];
+export type GetBotsByBotIdScheduleLogsError = GetBotsByBotIdScheduleLogsErrors[keyof GetBotsByBotIdScheduleLogsErrors];
 
-export type GetBotsByBotIdMcpByIdOauthStatusResponses = {
+export type GetBotsByBotIdScheduleLogsResponses = {
     /**
      * OK
      */
-    200: McpOAuthStatus;
+    200: ScheduleListLogsResponse;
 };
 
-export type GetBotsByBotIdMcpByIdOauthStatusResponse = GetBotsByBotIdMcpByIdOauthStatusResponses[keyof GetBotsByBotIdMcpByIdOauthStatusResponses];
+export type GetBotsByBotIdScheduleLogsResponse = GetBotsByBotIdScheduleLogsResponses[keyof GetBotsByBotIdScheduleLogsResponses];
 
-export type DeleteBotsByBotIdMcpByIdOauthTokenData = {
+export type GetBotsByBotIdScheduleLogsFailedData = {
     body?: never;
     path: {
         /**
-         * MCP connection ID
+         * Bot ID
          */
-        id: string;
+        bot_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/mcp/{id}/oauth/token';
+    query?: {
+        /**
+         * Limit
+         */
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
+    };
+    url: '/bots/{bot_id}/schedule/logs/failed';
 };
 
-export type DeleteBotsByBotIdMcpByIdOauthTokenErrors = {
+export type GetBotsByBotIdScheduleLogsFailedErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdMcpByIdOauthTokenError = DeleteBotsByBotIdMcpByIdOauthTokenErrors[keyof DeleteBotsByBotIdMcpByIdOauthTokenErrors];
+export type GetBotsByBotIdScheduleLogsFailedError = GetBotsByBotIdScheduleLogsFailedErrors[keyof GetBotsByBotIdScheduleLogsFailedErrors];
 
-export type DeleteBotsByBotIdMcpByIdOauthTokenResponses = {
+export type GetBotsByBotIdScheduleLogsFailedResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: ScheduleListLogsResponse;
 };
 
-export type PostBotsByBotIdMcpByIdProbeData = {
+export type GetBotsByBotIdScheduleLogsFailedResponse = GetBotsByBotIdScheduleLogsFailedResponses[keyof GetBotsByBotIdScheduleLogsFailedResponses];
+
+export type PostBotsByBotIdScheduleLogsByLogIdReplayData = {
     body?: never;
     path: {
         /**
-         * MCP connection ID
+         * Bot ID
          */
-        id: string;
+        bot_id: string;
+        /**
+         * Schedule log ID
+         */
+        log_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/mcp/{id}/probe';
+    url: '/bots/{bot_id}/schedule/logs/{log_id}/replay';
 };
 
-export type PostBotsByBotIdMcpByIdProbeErrors = {
+export type PostBotsByBotIdScheduleLogsByLogIdReplayErrors = {
     /**
      * Bad Request
      */
@@ -5796,205 +9093,183 @@ export type PostBotsByBotIdMcpByIdProbeErrors = {
      */
     404: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Conflict
      */
-    500: HandlersErrorResponse;
+    409: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMcpByIdProbeError = PostBotsByBotIdMcpByIdProbeErrors[keyof PostBotsByBotIdMcpByIdProbeErrors];
+export type PostBotsByBotIdScheduleLogsByLogIdReplayError = PostBotsByBotIdScheduleLogsByLogIdReplayErrors[keyof PostBotsByBotIdScheduleLogsByLogIdReplayErrors];
 
-export type PostBotsByBotIdMcpByIdProbeResponses = {
+export type PostBotsByBotIdScheduleLogsByLogIdReplayResponses = {
     /**
-     * OK
+     * Accepted
      */
-    200: HandlersProbeResponse;
+    202: unknown;
 };
 
-export type PostBotsByBotIdMcpByIdProbeResponse = PostBotsByBotIdMcpByIdProbeResponses[keyof PostBotsByBotIdMcpByIdProbeResponses];
-
-export type DeleteBotsByBotIdMemoryData = {
-    /**
-     * Optional: specify memory_ids to delete; if omitted, deletes all
-     */
-    body?: HandlersMemoryDeletePayload;
+export type DeleteBotsByBotIdScheduleByIdData = {
+    body?: never;
     path: {
         /**
-         * Bot ID
+         * Schedule ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/memory';
+    url: '/bots/{bot_id}/schedule/{id}';
 };
 
-export type DeleteBotsByBotIdMemoryErrors = {
+export type DeleteBotsByBotIdScheduleByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Conflict
      */
-    403: HandlersErrorResponse;
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
-    500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
-     */
-    503: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdMemoryError = DeleteBotsByBotIdMemoryErrors[keyof DeleteBotsByBotIdMemoryErrors];
+export type DeleteBotsByBotIdScheduleByIdError = DeleteBotsByBotIdScheduleByIdErrors[keyof DeleteBotsByBotIdScheduleByIdErrors];
 
-export type DeleteBotsByBotIdMemoryResponses = {
+export type DeleteBotsByBotIdScheduleByIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: AdaptersDeleteResponse;
+    204: unknown;
 };
 
-export type DeleteBotsByBotIdMemoryResponse = DeleteBotsByBotIdMemoryResponses[keyof DeleteBotsByBotIdMemoryResponses];
-
-export type GetBotsByBotIdMemoryData = {
+export type GetBotsByBotIdScheduleByIdData = {
     body?: never;
     path: {
         /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
-    query?: {
-        /**
-         * Skip optional stats in memory search response
+         * Schedule ID
          */
-        no_stats?: boolean;
+        id: string;
     };
-    url: '/bots/{bot_id}/memory';
+    query?: never;
+    url: '/bots/{bot_id}/schedule/{id}';
 };
 
-export type GetBotsByBotIdMemoryErrors = {
+export type GetBotsByBotIdScheduleByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
-     */
-    503: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMemoryError = GetBotsByBotIdMemoryErrors[keyof GetBotsByBotIdMemoryErrors];
+export type GetBotsByBotIdScheduleByIdError = GetBotsByBotIdScheduleByIdErrors[keyof GetBotsByBotIdScheduleByIdErrors];
 
-export type GetBotsByBotIdMemoryResponses = {
+export type GetBotsByBotIdScheduleByIdResponses = {
     /**
      * OK
      */
-    200: AdaptersSearchResponse;
+    200: ScheduleSchedule;
 };
 
-export type GetBotsByBotIdMemoryResponse = GetBotsByBotIdMemoryResponses[keyof GetBotsByBotIdMemoryResponses];
+export type GetBotsByBotIdScheduleByIdResponse = GetBotsByBotIdScheduleByIdResponses[keyof GetBotsByBotIdScheduleByIdResponses];
 
-export type PostBotsByBotIdMemoryData = {
+export type PutBotsByBotIdScheduleByIdData = {
     /**
-     * Memory add payload
+     * Schedule payload
      */
-    body: HandlersMemoryAddPayload;
+    body: ScheduleUpdateRequest;
     path: {
         /**
-         * Bot ID
+         * Schedule ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/memory';
+    url: '/bots/{bot_id}/schedule/{id}';
 };
 
-export type PostBotsByBotIdMemoryErrors = {
+export type PutBotsByBotIdScheduleByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Conflict
      */
-    403: HandlersErrorResponse;
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
-     */
-    503: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMemoryError = PostBotsByBotIdMemoryErrors[keyof PostBotsByBotIdMemoryErrors];
+export type PutBotsByBotIdScheduleByIdError = PutBotsByBotIdScheduleByIdErrors[keyof PutBotsByBotIdScheduleByIdErrors];
 
-export type PostBotsByBotIdMemoryResponses = {
+export type PutBotsByBotIdScheduleByIdResponses = {
     /**
      * OK
      */
-    200: AdaptersSearchResponse;
+    200: ScheduleSchedule;
 };
 
-export type PostBotsByBotIdMemoryResponse = PostBotsByBotIdMemoryResponses[keyof PostBotsByBotIdMemoryResponses];
+export type PutBotsByBotIdScheduleByIdResponse = PutBotsByBotIdScheduleByIdResponses[keyof PutBotsByBotIdScheduleByIdResponses];
 
-export type PostBotsByBotIdMemoryCompactData = {
-    /**
-     * ratio (0,1] required; decay_days optional
-     */
-    body: HandlersMemoryCompactPayload;
+export type GetBotsByBotIdScheduleByIdLogsData = {
+    body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Schedule ID
+         */
+        id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/memory/compact';
+    query?: {
+        /**
+         * Limit
+         */
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
+    };
+    url: '/bots/{bot_id}/schedule/{id}/logs';
 };
 
-export type PostBotsByBotIdMemoryCompactErrors = {
+export type GetBotsByBotIdScheduleByIdLogsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
-     */
-    503: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMemoryCompactError = PostBotsByBotIdMemoryCompactErrors[keyof PostBotsByBotIdMemoryCompactErrors];
+export type GetBotsByBotIdScheduleByIdLogsError = GetBotsByBotIdScheduleByIdLogsErrors[keyof GetBotsByBotIdScheduleByIdLogsErrors];
 
-export type PostBotsByBotIdMemoryCompactResponses = {
+export type GetBotsByBotIdScheduleByIdLogsResponses = {
     /**
      * OK
      */
-    200: AdaptersCompactResult;
+    200: ScheduleListLogsResponse;
 };
 
-export type PostBotsByBotIdMemoryCompactResponse = PostBotsByBotIdMemoryCompactResponses[keyof PostBotsByBotIdMemoryCompactResponses];
+export type GetBotsByBotIdScheduleByIdLogsResponse = GetBotsByBotIdScheduleByIdLogsResponses[keyof GetBotsByBotIdScheduleByIdLogsResponses];
 
-export type PostBotsByBotIdMemoryRebuildData = {
+export type GetBotsByBotIdSessionsData = {
     body?: never;
     path: {
         /**
@@ -6003,10 +9278,10 @@ export type PostBotsByBotIdMemoryRebuildData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/memory/rebuild';
+    url: '/bots/{bot_id}/sessions';
 };
 
-export type PostBotsByBotIdMemoryRebuildErrors = {
+export type GetBotsByBotIdSessionsErrors = {
     /**
      * Bad Request
      */
@@ -6015,36 +9290,26 @@ export type PostBotsByBotIdMemoryRebuildErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Conflict
-     */
-    409: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
-     */
-    503: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMemoryRebuildError = PostBotsByBotIdMemoryRebuildErrors[keyof PostBotsByBotIdMemoryRebuildErrors];
+export type GetBotsByBotIdSessionsError = GetBotsByBotIdSessionsErrors[keyof GetBotsByBotIdSessionsErrors];
 
-export type PostBotsByBotIdMemoryRebuildResponses = {
+export type GetBotsByBotIdSessionsResponses = {
     /**
      * OK
      */
-    200: AdaptersRebuildResult;
+    200: {
+        [key: string]: Array<SessionSession>;
+    };
 };
 
-export type PostBotsByBotIdMemoryRebuildResponse = PostBotsByBotIdMemoryRebuildResponses[keyof PostBotsByBotIdMemoryRebuildResponses];
+export type GetBotsByBotIdSessionsResponse = GetBotsByBotIdSessionsResponses[keyof GetBotsByBotIdSessionsResponses];
 
-export type PostBotsByBotIdMemorySearchData = {
+export type PostBotsByBotIdSessionsData = {
     /**
-     * Memory search payload
+     * Session data
      */
-    body: HandlersMemorySearchPayload;
+    body: HandlersCreateSessionRequest;
     path: {
         /**
          * Bot ID
@@ -6052,10 +9317,10 @@ export type PostBotsByBotIdMemorySearchData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/memory/search';
+    url: '/bots/{bot_id}/sessions';
 };
 
-export type PostBotsByBotIdMemorySearchErrors = {
+export type PostBotsByBotIdSessionsErrors = {
     /**
      * Bad Request
      */
@@ -6064,44 +9329,36 @@ export type PostBotsByBotIdMemorySearchErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
-     */
-    503: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdMemorySearchError = PostBotsByBotIdMemorySearchErrors[keyof PostBotsByBotIdMemorySearchErrors];
+export type PostBotsByBotIdSessionsError = PostBotsByBotIdSessionsErrors[keyof PostBotsByBotIdSessionsErrors];
 
-export type PostBotsByBotIdMemorySearchResponses = {
+export type PostBotsByBotIdSessionsResponses = {
     /**
-     * OK
+     * Created
      */
-    200: AdaptersSearchResponse;
+    201: SessionSession;
 };
 
-export type PostBotsByBotIdMemorySearchResponse = PostBotsByBotIdMemorySearchResponses[keyof PostBotsByBotIdMemorySearchResponses];
+export type PostBotsByBotIdSessionsResponse = PostBotsByBotIdSessionsResponses[keyof PostBotsByBotIdSessionsResponses];
 
-export type GetBotsByBotIdMemoryStatusData = {
+export type DeleteBotsByBotIdSessionsBySessionIdData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Session ID
+         */
+        session_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/memory/status';
+    url: '/bots/{bot_id}/sessions/{session_id}';
 };
 
-export type GetBotsByBotIdMemoryStatusErrors = {
+export type DeleteBotsByBotIdSessionsBySessionIdErrors = {
     /**
      * Bad Request
      */
@@ -6110,44 +9367,34 @@ export type GetBotsByBotIdMemoryStatusErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Conflict
-     */
-    409: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
-     */
-    503: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMemoryStatusError = GetBotsByBotIdMemoryStatusErrors[keyof GetBotsByBotIdMemoryStatusErrors];
+export type DeleteBotsByBotIdSessionsBySessionIdError = DeleteBotsByBotIdSessionsBySessionIdErrors[keyof DeleteBotsByBotIdSessionsBySessionIdErrors];
 
-export type GetBotsByBotIdMemoryStatusResponses = {
+export type DeleteBotsByBotIdSessionsBySessionIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: AdaptersMemoryStatusResponse;
+    204: unknown;
 };
 
-export type GetBotsByBotIdMemoryStatusResponse = GetBotsByBotIdMemoryStatusResponses[keyof GetBotsByBotIdMemoryStatusResponses];
-
-export type GetBotsByBotIdMemoryUsageData = {
+export type GetBotsByBotIdSessionsBySessionIdData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Session ID
+         */
+        session_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/memory/usage';
+    url: '/bots/{bot_id}/sessions/{session_id}';
 };
 
-export type GetBotsByBotIdMemoryUsageErrors = {
+export type GetBotsByBotIdSessionsBySessionIdErrors = {
     /**
      * Bad Request
      */
@@ -6157,43 +9404,42 @@ export type GetBotsByBotIdMemoryUsageErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
+     * Not Found
      */
-    503: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMemoryUsageError = GetBotsByBotIdMemoryUsageErrors[keyof GetBotsByBotIdMemoryUsageErrors];
+export type GetBotsByBotIdSessionsBySessionIdError = GetBotsByBotIdSessionsBySessionIdErrors[keyof GetBotsByBotIdSessionsBySessionIdErrors];
 
-export type GetBotsByBotIdMemoryUsageResponses = {
+export type GetBotsByBotIdSessionsBySessionIdResponses = {
     /**
      * OK
      */
-    200: AdaptersUsageResponse;
+    200: SessionSession;
 };
 
-export type GetBotsByBotIdMemoryUsageResponse = GetBotsByBotIdMemoryUsageResponses[keyof GetBotsByBotIdMemoryUsageResponses];
+export type GetBotsByBotIdSessionsBySessionIdResponse = GetBotsByBotIdSessionsBySessionIdResponses[keyof GetBotsByBotIdSessionsBySessionIdResponses];
 
-export type DeleteBotsByBotIdMemoryByIdData = {
-    body?: never;
+export type PatchBotsByBotIdSessionsBySessionIdData = {
+    /**
+     * Fields to update
+     */
+    body: HandlersUpdateSessionRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
         /**
-         * Memory ID
+         * Session ID
          */
-        id: string;
+        session_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/memory/{id}';
+    url: '/bots/{bot_id}/sessions/{session_id}';
 };
 
-export type DeleteBotsByBotIdMemoryByIdErrors = {
+export type PatchBotsByBotIdSessionsBySessionIdErrors = {
     /**
      * Bad Request
      */
@@ -6203,39 +9449,39 @@ export type DeleteBotsByBotIdMemoryByIdErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
-    /**
-     * Service Unavailable
+     * Not Found
      */
-    503: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdMemoryByIdError = DeleteBotsByBotIdMemoryByIdErrors[keyof DeleteBotsByBotIdMemoryByIdErrors];
+export type PatchBotsByBotIdSessionsBySessionIdError = PatchBotsByBotIdSessionsBySessionIdErrors[keyof PatchBotsByBotIdSessionsBySessionIdErrors];
 
-export type DeleteBotsByBotIdMemoryByIdResponses = {
+export type PatchBotsByBotIdSessionsBySessionIdResponses = {
     /**
      * OK
      */
-    200: AdaptersDeleteResponse;
+    200: SessionSession;
 };
 
-export type DeleteBotsByBotIdMemoryByIdResponse = DeleteBotsByBotIdMemoryByIdResponses[keyof DeleteBotsByBotIdMemoryByIdResponses];
+export type PatchBotsByBotIdSessionsBySessionIdResponse = PatchBotsByBotIdSessionsBySessionIdResponses[keyof PatchBotsByBotIdSessionsBySessionIdResponses];
 
-export type DeleteBotsByBotIdMessagesData = {
+export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Session ID
+         */
+        session_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/messages';
+    url: '/bots/{bot_id}/sessions/{session_id}/acp-runtime';
 };
 
-export type DeleteBotsByBotIdMessagesErrors = {
+export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors = {
     /**
      * Bad Request
      */
@@ -6245,42 +9491,39 @@ export type DeleteBotsByBotIdMessagesErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdMessagesError = DeleteBotsByBotIdMessagesErrors[keyof DeleteBotsByBotIdMessagesErrors];
+export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeError = GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors[keyof GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors];
 
-export type DeleteBotsByBotIdMessagesResponses = {
+export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: AcpagentRuntimeStatus;
 };
 
-export type GetBotsByBotIdMessagesData = {
+export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponse = GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponses[keyof GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponses];
+
+export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-    };
-    query?: {
-        /**
-         * Limit
-         */
-        limit?: number;
         /**
-         * Before
+         * Session ID
          */
-        before?: string;
+        session_id: string;
     };
-    url: '/bots/{bot_id}/messages';
+    query?: never;
+    url: '/bots/{bot_id}/sessions/{session_id}/acp-runtime';
 };
 
-export type GetBotsByBotIdMessagesErrors = {
+export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors = {
     /**
      * Bad Request
      */
@@ -6290,54 +9533,42 @@ export type GetBotsByBotIdMessagesErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMessagesError = GetBotsByBotIdMessagesErrors[keyof GetBotsByBotIdMessagesErrors];
+export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeError = PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors[keyof PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors];
 
-export type GetBotsByBotIdMessagesResponses = {
+export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: Array<MessageMessage>;
-    };
+    200: AcpagentRuntimeStatus;
 };
 
-export type GetBotsByBotIdMessagesResponse = GetBotsByBotIdMessagesResponses[keyof GetBotsByBotIdMessagesResponses];
+export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponse = PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponses[keyof PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponses];
 
-export type GetBotsByBotIdMessagesLocateData = {
-    body?: never;
+export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelData = {
+    /**
+     * ACP model selection
+     */
+    body: HandlersAcpRuntimeModelRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-    };
-    query: {
         /**
          * Session ID
          */
         session_id: string;
-        /**
-         * External message ID
-         */
-        external_message_id: string;
-        /**
-         * Messages before target
-         */
-        before?: number;
-        /**
-         * Messages after target
-         */
-        after?: number;
     };
-    url: '/bots/{bot_id}/messages/locate';
+    query?: never;
+    url: '/bots/{bot_id}/sessions/{session_id}/acp-runtime/model';
 };
 
-export type GetBotsByBotIdMessagesLocateErrors = {
+export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors = {
     /**
      * Bad Request
      */
@@ -6350,79 +9581,79 @@ export type GetBotsByBotIdMessagesLocateErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdMessagesLocateError = GetBotsByBotIdMessagesLocateErrors[keyof GetBotsByBotIdMessagesLocateErrors];
+export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelError = PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors[keyof PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors];
 
-export type GetBotsByBotIdMessagesLocateResponses = {
+export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: unknown;
-    };
+    200: AcpagentRuntimeStatus;
 };
 
-export type GetBotsByBotIdMessagesLocateResponse = GetBotsByBotIdMessagesLocateResponses[keyof GetBotsByBotIdMessagesLocateResponses];
+export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponse = PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponses[keyof PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponses];
 
-export type GetBotsByBotIdPluginsData = {
+export type PostBotsByBotIdSessionsBySessionIdCompactData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Session ID
+         */
+        session_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/plugins';
+    url: '/bots/{bot_id}/sessions/{session_id}/compact';
 };
 
-export type GetBotsByBotIdPluginsErrors = {
+export type PostBotsByBotIdSessionsBySessionIdCompactErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdPluginsError = GetBotsByBotIdPluginsErrors[keyof GetBotsByBotIdPluginsErrors];
+export type PostBotsByBotIdSessionsBySessionIdCompactError = PostBotsByBotIdSessionsBySessionIdCompactErrors[keyof PostBotsByBotIdSessionsBySessionIdCompactErrors];
 
-export type GetBotsByBotIdPluginsResponses = {
+export type PostBotsByBotIdSessionsBySessionIdCompactResponses = {
     /**
      * OK
      */
-    200: PluginsListResponse;
+    200: HandlersTriggerCompactResponse;
 };
 
-export type GetBotsByBotIdPluginsResponse = GetBotsByBotIdPluginsResponses[keyof GetBotsByBotIdPluginsResponses];
+export type PostBotsByBotIdSessionsBySessionIdCompactResponse = PostBotsByBotIdSessionsBySessionIdCompactResponses[keyof PostBotsByBotIdSessionsBySessionIdCompactResponses];
 
-export type PostBotsByBotIdPluginsData = {
-    /**
-     * Plugin install request
-     */
-    body: PluginsInstallRequest;
+export type GetBotsByBotIdSessionsBySessionIdStatusData = {
+    body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+        /**
+         * Session ID
+         */
+        session_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/plugins';
+    query?: {
+        /**
+         * Optional model UUID override for context window
+         */
+        model_id?: string;
+    };
+    url: '/bots/{bot_id}/sessions/{session_id}/status';
 };
 
-export type PostBotsByBotIdPluginsErrors = {
+export type GetBotsByBotIdSessionsBySessionIdStatusErrors = {
     /**
      * Bad Request
      */
@@ -6437,249 +9668,247 @@ export type PostBotsByBotIdPluginsErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdPluginsError = PostBotsByBotIdPluginsErrors[keyof PostBotsByBotIdPluginsErrors];
+export type GetBotsByBotIdSessionsBySessionIdStatusError = GetBotsByBotIdSessionsBySessionIdStatusErrors[keyof GetBotsByBotIdSessionsBySessionIdStatusErrors];
 
-export type PostBotsByBotIdPluginsResponses = {
+export type GetBotsByBotIdSessionsBySessionIdStatusResponses = {
     /**
-     * Created
+     * OK
      */
-    201: PluginsInstallation;
+    200: HandlersSessionInfoResponse;
 };
 
-export type PostBotsByBotIdPluginsResponse = PostBotsByBotIdPluginsResponses[keyof PostBotsByBotIdPluginsResponses];
+export type GetBotsByBotIdSessionsBySessionIdStatusResponse = GetBotsByBotIdSessionsBySessionIdStatusResponses[keyof GetBotsByBotIdSessionsBySessionIdStatusResponses];
 
-export type DeleteBotsByBotIdPluginsByIdData = {
+export type DeleteBotsByBotIdSettingsData = {
     body?: never;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-        /**
-         * Plugin installation ID
-         */
-        id: string;
-    };
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/plugins/{id}';
+    url: '/bots/{bot_id}/settings';
 };
 
-export type DeleteBotsByBotIdPluginsByIdErrors = {
+export type DeleteBotsByBotIdSettingsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
-    /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdPluginsByIdError = DeleteBotsByBotIdPluginsByIdErrors[keyof DeleteBotsByBotIdPluginsByIdErrors];
+export type DeleteBotsByBotIdSettingsError = DeleteBotsByBotIdSettingsErrors[keyof DeleteBotsByBotIdSettingsErrors];
 
-export type DeleteBotsByBotIdPluginsByIdResponses = {
+export type DeleteBotsByBotIdSettingsResponses = {
     /**
      * No Content
      */
     204: unknown;
 };
 
-export type GetBotsByBotIdPluginsByIdData = {
+export type GetBotsByBotIdSettingsData = {
     body?: never;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-        /**
-         * Plugin installation ID
-         */
-        id: string;
-    };
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/plugins/{id}';
+    url: '/bots/{bot_id}/settings';
 };
 
-export type GetBotsByBotIdPluginsByIdErrors = {
+export type GetBotsByBotIdSettingsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Internal Server Error
      */
-    403: HandlersErrorResponse;
+    500: HandlersErrorResponse;
+};
+
+export type GetBotsByBotIdSettingsError = GetBotsByBotIdSettingsErrors[keyof GetBotsByBotIdSettingsErrors];
+
+export type GetBotsByBotIdSettingsResponses = {
     /**
-     * Not Found
+     * OK
      */
-    404: HandlersErrorResponse;
+    200: SettingsSettings;
+};
+
+export type GetBotsByBotIdSettingsResponse = GetBotsByBotIdSettingsResponses[keyof GetBotsByBotIdSettingsResponses];
+
+export type PostBotsByBotIdSettingsData = {
+    /**
+     * Settings payload
+     */
+    body: SettingsUpsertRequest;
+    path?: never;
+    query?: never;
+    url: '/bots/{bot_id}/settings';
+};
+
+export type PostBotsByBotIdSettingsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdPluginsByIdError = GetBotsByBotIdPluginsByIdErrors[keyof GetBotsByBotIdPluginsByIdErrors];
+export type PostBotsByBotIdSettingsError = PostBotsByBotIdSettingsErrors[keyof PostBotsByBotIdSettingsErrors];
 
-export type GetBotsByBotIdPluginsByIdResponses = {
+export type PostBotsByBotIdSettingsResponses = {
     /**
      * OK
      */
-    200: PluginsInstallation;
+    200: SettingsSettings;
 };
 
-export type GetBotsByBotIdPluginsByIdResponse = GetBotsByBotIdPluginsByIdResponses[keyof GetBotsByBotIdPluginsByIdResponses];
+export type PostBotsByBotIdSettingsResponse = PostBotsByBotIdSettingsResponses[keyof PostBotsByBotIdSettingsResponses];
 
-export type PostBotsByBotIdPluginsByIdDisableData = {
-    body?: never;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-        /**
-         * Plugin installation ID
-         */
-        id: string;
-    };
+export type PutBotsByBotIdSettingsData = {
+    /**
+     * Settings payload
+     */
+    body: SettingsUpsertRequest;
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/plugins/{id}/disable';
+    url: '/bots/{bot_id}/settings';
 };
 
-export type PostBotsByBotIdPluginsByIdDisableErrors = {
+export type PutBotsByBotIdSettingsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
-    /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdPluginsByIdDisableError = PostBotsByBotIdPluginsByIdDisableErrors[keyof PostBotsByBotIdPluginsByIdDisableErrors];
+export type PutBotsByBotIdSettingsError = PutBotsByBotIdSettingsErrors[keyof PutBotsByBotIdSettingsErrors];
 
-export type PostBotsByBotIdPluginsByIdDisableResponses = {
+export type PutBotsByBotIdSettingsResponses = {
     /**
      * OK
      */
-    200: PluginsInstallation;
+    200: SettingsSettings;
 };
 
-export type PostBotsByBotIdPluginsByIdDisableResponse = PostBotsByBotIdPluginsByIdDisableResponses[keyof PostBotsByBotIdPluginsByIdDisableResponses];
+export type PutBotsByBotIdSettingsResponse = PutBotsByBotIdSettingsResponses[keyof PutBotsByBotIdSettingsResponses];
 
-export type PostBotsByBotIdPluginsByIdEnableData = {
-    body?: never;
+export type PostBotsByBotIdSupermarketInstallPluginData = {
+    /**
+     * Install plugin request
+     */
+    body: HandlersInstallPluginRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Plugin installation ID
-         */
-        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/plugins/{id}/enable';
+    url: '/bots/{bot_id}/supermarket/install-plugin';
 };
 
-export type PostBotsByBotIdPluginsByIdEnableErrors = {
+export type PostBotsByBotIdSupermarketInstallPluginErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Bad Gateway
+     */
+    502: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdPluginsByIdEnableError = PostBotsByBotIdPluginsByIdEnableErrors[keyof PostBotsByBotIdPluginsByIdEnableErrors];
+export type PostBotsByBotIdSupermarketInstallPluginError = PostBotsByBotIdSupermarketInstallPluginErrors[keyof PostBotsByBotIdSupermarketInstallPluginErrors];
 
-export type PostBotsByBotIdPluginsByIdEnableResponses = {
+export type PostBotsByBotIdSupermarketInstallPluginResponses = {
     /**
      * OK
      */
     200: PluginsInstallation;
 };
 
-export type PostBotsByBotIdPluginsByIdEnableResponse = PostBotsByBotIdPluginsByIdEnableResponses[keyof PostBotsByBotIdPluginsByIdEnableResponses];
+export type PostBotsByBotIdSupermarketInstallPluginResponse = PostBotsByBotIdSupermarketInstallPluginResponses[keyof PostBotsByBotIdSupermarketInstallPluginResponses];
 
-export type PostBotsByBotIdPluginsByIdOauthAuthorizeData = {
+export type PostBotsByBotIdSupermarketInstallSkillData = {
     /**
-     * OAuth authorize request
+     * Install skill request
      */
-    body?: PluginsOAuthAuthorizeRequest;
+    body: HandlersInstallSkillRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Plugin installation ID
-         */
-        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/plugins/{id}/oauth/authorize';
+    url: '/bots/{bot_id}/supermarket/install-skill';
 };
 
-export type PostBotsByBotIdPluginsByIdOauthAuthorizeErrors = {
+export type PostBotsByBotIdSupermarketInstallSkillErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Bad Gateway
+     */
+    502: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdPluginsByIdOauthAuthorizeError = PostBotsByBotIdPluginsByIdOauthAuthorizeErrors[keyof PostBotsByBotIdPluginsByIdOauthAuthorizeErrors];
+export type PostBotsByBotIdSupermarketInstallSkillError = PostBotsByBotIdSupermarketInstallSkillErrors[keyof PostBotsByBotIdSupermarketInstallSkillErrors];
 
-export type PostBotsByBotIdPluginsByIdOauthAuthorizeResponses = {
+export type PostBotsByBotIdSupermarketInstallSkillResponses = {
     /**
      * OK
      */
-    200: McpAuthorizeResult;
+    200: {
+        [key: string]: boolean;
+    };
 };
 
-export type PostBotsByBotIdPluginsByIdOauthAuthorizeResponse = PostBotsByBotIdPluginsByIdOauthAuthorizeResponses[keyof PostBotsByBotIdPluginsByIdOauthAuthorizeResponses];
+export type PostBotsByBotIdSupermarketInstallSkillResponse = PostBotsByBotIdSupermarketInstallSkillResponses[keyof PostBotsByBotIdSupermarketInstallSkillResponses];
 
-export type GetBotsByBotIdPluginsByIdOauthStatusData = {
+export type GetBotsByBotIdTokenUsageData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+    };
+    query: {
         /**
-         * Plugin installation ID
+         * Start date (YYYY-MM-DD)
          */
-        id: string;
+        from: string;
+        /**
+         * End date exclusive (YYYY-MM-DD)
+         */
+        to: string;
+        /**
+         * Optional model UUID to filter by
+         */
+        model_id?: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/plugins/{id}/oauth/status';
+    url: '/bots/{bot_id}/token-usage';
 };
 
-export type GetBotsByBotIdPluginsByIdOauthStatusErrors = {
+export type GetBotsByBotIdTokenUsageErrors = {
     /**
      * Bad Request
      */
@@ -6689,39 +9918,60 @@ export type GetBotsByBotIdPluginsByIdOauthStatusErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdPluginsByIdOauthStatusError = GetBotsByBotIdPluginsByIdOauthStatusErrors[keyof GetBotsByBotIdPluginsByIdOauthStatusErrors];
+export type GetBotsByBotIdTokenUsageError = GetBotsByBotIdTokenUsageErrors[keyof GetBotsByBotIdTokenUsageErrors];
 
-export type GetBotsByBotIdPluginsByIdOauthStatusResponses = {
+export type GetBotsByBotIdTokenUsageResponses = {
     /**
      * OK
      */
-    200: PluginsInstallation;
+    200: HandlersTokenUsageResponse;
 };
 
-export type GetBotsByBotIdPluginsByIdOauthStatusResponse = GetBotsByBotIdPluginsByIdOauthStatusResponses[keyof GetBotsByBotIdPluginsByIdOauthStatusResponses];
+export type GetBotsByBotIdTokenUsageResponse = GetBotsByBotIdTokenUsageResponses[keyof GetBotsByBotIdTokenUsageResponses];
 
-export type PostBotsByBotIdPluginsByIdUninstallData = {
+export type GetBotsByBotIdTokenUsageRecordsData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
+    };
+    query: {
         /**
-         * Plugin installation ID
+         * Start date (YYYY-MM-DD)
          */
-        id: string;
+        from: string;
+        /**
+         * End date exclusive (YYYY-MM-DD)
+         */
+        to: string;
+        /**
+         * Optional model UUID to filter by
+         */
+        model_id?: string;
+        /**
+         * Optional session type: chat, heartbeat, schedule, or acp_agent
+         */
+        session_type?: string;
+        /**
+         * Page size (default 20, max 100)
+         */
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
     };
-    query?: never;
-    url: '/bots/{bot_id}/plugins/{id}/uninstall';
+    url: '/bots/{bot_id}/token-usage/records';
 };
 
-export type PostBotsByBotIdPluginsByIdUninstallErrors = {
+export type GetBotsByBotIdTokenUsageRecordsErrors = {
     /**
      * Bad Request
      */
@@ -6730,86 +9980,28 @@ export type PostBotsByBotIdPluginsByIdUninstallErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
-};
-
-export type PostBotsByBotIdPluginsByIdUninstallError = PostBotsByBotIdPluginsByIdUninstallErrors[keyof PostBotsByBotIdPluginsByIdUninstallErrors];
-
-export type PostBotsByBotIdPluginsByIdUninstallResponses = {
-    /**
-     * OK
-     */
-    200: PluginsInstallation;
-};
-
-export type PostBotsByBotIdPluginsByIdUninstallResponse = PostBotsByBotIdPluginsByIdUninstallResponses[keyof PostBotsByBotIdPluginsByIdUninstallResponses];
-
-export type GetBotsByBotIdScheduleData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/bots/{bot_id}/schedule';
-};
-
-export type GetBotsByBotIdScheduleErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdScheduleError = GetBotsByBotIdScheduleErrors[keyof GetBotsByBotIdScheduleErrors];
+export type GetBotsByBotIdTokenUsageRecordsError = GetBotsByBotIdTokenUsageRecordsErrors[keyof GetBotsByBotIdTokenUsageRecordsErrors];
 
-export type GetBotsByBotIdScheduleResponses = {
+export type GetBotsByBotIdTokenUsageRecordsResponses = {
     /**
      * OK
      */
-    200: ScheduleListResponse;
-};
-
-export type GetBotsByBotIdScheduleResponse = GetBotsByBotIdScheduleResponses[keyof GetBotsByBotIdScheduleResponses];
-
-export type PostBotsByBotIdScheduleData = {
-    /**
-     * Schedule payload
-     */
-    body: ScheduleCreateRequest;
-    path?: never;
-    query?: never;
-    url: '/bots/{bot_id}/schedule';
-};
-
-export type PostBotsByBotIdScheduleErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
+    200: HandlersTokenUsageRecordsResponse;
 };
 
-export type PostBotsByBotIdScheduleError = PostBotsByBotIdScheduleErrors[keyof PostBotsByBotIdScheduleErrors];
+export type GetBotsByBotIdTokenUsageRecordsResponse = GetBotsByBotIdTokenUsageRecordsResponses[keyof GetBotsByBotIdTokenUsageRecordsResponses];
 
-export type PostBotsByBotIdScheduleResponses = {
+export type PostBotsByBotIdToolApprovalsDryRunData = {
     /**
-     * Created
+     * Sample tool call
      */
-    201: ScheduleSchedule;
-};
-
-export type PostBotsByBotIdScheduleResponse = PostBotsByBotIdScheduleResponses[keyof PostBotsByBotIdScheduleResponses];
-
-export type DeleteBotsByBotIdScheduleLogsData = {
-    body?: never;
+    body: ToolapprovalDryRunRequest;
     path: {
         /**
          * Bot ID
@@ -6817,117 +10009,163 @@ export type DeleteBotsByBotIdScheduleLogsData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/schedule/logs';
+    url: '/bots/{bot_id}/tool-approvals/dry-run';
 };
 
-export type DeleteBotsByBotIdScheduleLogsErrors = {
+export type PostBotsByBotIdToolApprovalsDryRunErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdScheduleLogsError = DeleteBotsByBotIdScheduleLogsErrors[keyof DeleteBotsByBotIdScheduleLogsErrors];
+export type PostBotsByBotIdToolApprovalsDryRunError = PostBotsByBotIdToolApprovalsDryRunErrors[keyof PostBotsByBotIdToolApprovalsDryRunErrors];
 
-export type DeleteBotsByBotIdScheduleLogsResponses = {
+export type PostBotsByBotIdToolApprovalsDryRunResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: ToolapprovalPolicyResult;
 };
 
-export type GetBotsByBotIdScheduleLogsData = {
-    body?: never;
+export type PostBotsByBotIdToolApprovalsDryRunResponse = PostBotsByBotIdToolApprovalsDryRunResponses[keyof PostBotsByBotIdToolApprovalsDryRunResponses];
+
+export type PostBotsByBotIdToolApprovalsByApprovalIdApproveData = {
+    /**
+     * Approval payload
+     */
+    body?: HandlersToolApprovalDecisionRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-    };
-    query?: {
-        /**
-         * Limit
-         */
-        limit?: number;
         /**
-         * Offset
+         * Approval ID
          */
-        offset?: number;
+        approval_id: string;
     };
-    url: '/bots/{bot_id}/schedule/logs';
+    query?: never;
+    url: '/bots/{bot_id}/tool-approvals/{approval_id}/approve';
 };
 
-export type GetBotsByBotIdScheduleLogsErrors = {
+export type PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdScheduleLogsError = GetBotsByBotIdScheduleLogsErrors[keyof GetBotsByBotIdScheduleLogsErrors];
+export type PostBotsByBotIdToolApprovalsByApprovalIdApproveError = PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors[keyof PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors];
 
-export type GetBotsByBotIdScheduleLogsResponses = {
+export type PostBotsByBotIdToolApprovalsByApprovalIdApproveResponses = {
     /**
      * OK
      */
-    200: ScheduleListLogsResponse;
+    200: {
+        [key: string]: string;
+    };
 };
 
-export type GetBotsByBotIdScheduleLogsResponse = GetBotsByBotIdScheduleLogsResponses[keyof GetBotsByBotIdScheduleLogsResponses];
+export type PostBotsByBotIdToolApprovalsByApprovalIdApproveResponse = PostBotsByBotIdToolApprovalsByApprovalIdApproveResponses[keyof PostBotsByBotIdToolApprovalsByApprovalIdApproveResponses];
 
-export type DeleteBotsByBotIdScheduleByIdData = {
-    body?: never;
+export type PostBotsByBotIdToolApprovalsByApprovalIdRejectData = {
+    /**
+     * Rejection payload
+     */
+    body?: HandlersToolApprovalDecisionRequest;
     path: {
         /**
-         * Schedule ID
+         * Bot ID
          */
-        id: string;
+        bot_id: string;
+        /**
+         * Approval ID
+         */
+        approval_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/schedule/{id}';
+    url: '/bots/{bot_id}/tool-approvals/{approval_id}/reject';
 };
 
-export type DeleteBotsByBotIdScheduleByIdErrors = {
+export type PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdScheduleByIdError = DeleteBotsByBotIdScheduleByIdErrors[keyof DeleteBotsByBotIdScheduleByIdErrors];
+export type PostBotsByBotIdToolApprovalsByApprovalIdRejectError = PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors[keyof PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors];
 
-export type DeleteBotsByBotIdScheduleByIdResponses = {
+export type PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: {
+        [key: string]: string;
+    };
 };
 
-export type GetBotsByBotIdScheduleByIdData = {
-    body?: never;
+export type PostBotsByBotIdToolApprovalsByApprovalIdRejectResponse = PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses[keyof PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses];
+
+export type PostBotsByBotIdToolsData = {
+    /**
+     * JSON-RPC request
+     */
+    body: {
+        [key: string]: unknown;
+    };
     path: {
         /**
-         * Schedule ID
+         * Bot ID
          */
-        id: string;
+        bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/schedule/{id}';
+    url: '/bots/{bot_id}/tools';
 };
 
-export type GetBotsByBotIdScheduleByIdErrors = {
+export type PostBotsByBotIdToolsErrors = {
     /**
      * Bad Request
      */
@@ -6942,33 +10180,35 @@ export type GetBotsByBotIdScheduleByIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdScheduleByIdError = GetBotsByBotIdScheduleByIdErrors[keyof GetBotsByBotIdScheduleByIdErrors];
+export type PostBotsByBotIdToolsError = PostBotsByBotIdToolsErrors[keyof PostBotsByBotIdToolsErrors];
 
-export type GetBotsByBotIdScheduleByIdResponses = {
+export type PostBotsByBotIdToolsResponses = {
     /**
-     * OK
+     * JSON-RPC response: {jsonrpc,id,result|error}
      */
-    200: ScheduleSchedule;
+    200: {
+        [key: string]: unknown;
+    };
 };
 
-export type GetBotsByBotIdScheduleByIdResponse = GetBotsByBotIdScheduleByIdResponses[keyof GetBotsByBotIdScheduleByIdResponses];
+export type PostBotsByBotIdToolsResponse = PostBotsByBotIdToolsResponses[keyof PostBotsByBotIdToolsResponses];
 
-export type PutBotsByBotIdScheduleByIdData = {
+export type PostBotsByBotIdTtsSynthesizeData = {
     /**
-     * Schedule payload
+     * Text to synthesize
      */
-    body: ScheduleUpdateRequest;
+    body: HandlersSynthesizeRequest;
     path: {
         /**
-         * Schedule ID
+         * Bot ID
          */
-        id: string;
+        bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/schedule/{id}';
+    url: '/bots/{bot_id}/tts/synthesize';
 };
 
-export type PutBotsByBotIdScheduleByIdErrors = {
+export type PostBotsByBotIdTtsSynthesizeErrors = {
     /**
      * Bad Request
      */
@@ -6979,66 +10219,60 @@ export type PutBotsByBotIdScheduleByIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdScheduleByIdError = PutBotsByBotIdScheduleByIdErrors[keyof PutBotsByBotIdScheduleByIdErrors];
+export type PostBotsByBotIdTtsSynthesizeError = PostBotsByBotIdTtsSynthesizeErrors[keyof PostBotsByBotIdTtsSynthesizeErrors];
 
-export type PutBotsByBotIdScheduleByIdResponses = {
+export type PostBotsByBotIdTtsSynthesizeResponses = {
     /**
      * OK
      */
-    200: ScheduleSchedule;
+    200: HandlersSynthesizeResponse;
 };
 
-export type PutBotsByBotIdScheduleByIdResponse = PutBotsByBotIdScheduleByIdResponses[keyof PutBotsByBotIdScheduleByIdResponses];
+export type PostBotsByBotIdTtsSynthesizeResponse = PostBotsByBotIdTtsSynthesizeResponses[keyof PostBotsByBotIdTtsSynthesizeResponses];
 
-export type GetBotsByBotIdScheduleByIdLogsData = {
+export type GetBotsByBotIdUserAccessData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Schedule ID
-         */
-        id: string;
-    };
-    query?: {
-        /**
-         * Limit
-         */
-        limit?: number;
-        /**
-         * Offset
-         */
-        offset?: number;
     };
-    url: '/bots/{bot_id}/schedule/{id}/logs';
+    query?: never;
+    url: '/bots/{bot_id}/user-access';
 };
 
-export type GetBotsByBotIdScheduleByIdLogsErrors = {
+export type GetBotsByBotIdUserAccessErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdScheduleByIdLogsError = GetBotsByBotIdScheduleByIdLogsErrors[keyof GetBotsByBotIdScheduleByIdLogsErrors];
+export type GetBotsByBotIdUserAccessError = GetBotsByBotIdUserAccessErrors[keyof GetBotsByBotIdUserAccessErrors];
 
-export type GetBotsByBotIdScheduleByIdLogsResponses = {
+export type GetBotsByBotIdUserAccessResponses = {
     /**
      * OK
      */
-    200: ScheduleListLogsResponse;
+    200: HandlersBotUserGrantListResponse;
 };
 
-export type GetBotsByBotIdScheduleByIdLogsResponse = GetBotsByBotIdScheduleByIdLogsResponses[keyof GetBotsByBotIdScheduleByIdLogsResponses];
+export type GetBotsByBotIdUserAccessResponse = GetBotsByBotIdUserAccessResponses[keyof GetBotsByBotIdUserAccessResponses];
 
-export type GetBotsByBotIdSessionsData = {
-    body?: never;
+export type PostBotsByBotIdUserAccessData = {
+    /**
+     * Grant payload
+     */
+    body: BotsCreateUserGrantRequest;
     path: {
         /**
          * Bot ID
@@ -7046,10 +10280,10 @@ export type GetBotsByBotIdSessionsData = {
         bot_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/sessions';
+    url: '/bots/{bot_id}/user-access';
 };
 
-export type GetBotsByBotIdSessionsErrors = {
+export type PostBotsByBotIdUserAccessErrors = {
     /**
      * Bad Request
      */
@@ -7058,37 +10292,49 @@ export type GetBotsByBotIdSessionsErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdSessionsError = GetBotsByBotIdSessionsErrors[keyof GetBotsByBotIdSessionsErrors];
+export type PostBotsByBotIdUserAccessError = PostBotsByBotIdUserAccessErrors[keyof PostBotsByBotIdUserAccessErrors];
 
-export type GetBotsByBotIdSessionsResponses = {
+export type PostBotsByBotIdUserAccessResponses = {
     /**
-     * OK
+     * Created
      */
-    200: {
-        [key: string]: Array<SessionSession>;
-    };
+    201: BotsUserGrant;
 };
 
-export type GetBotsByBotIdSessionsResponse = GetBotsByBotIdSessionsResponses[keyof GetBotsByBotIdSessionsResponses];
+export type PostBotsByBotIdUserAccessResponse = PostBotsByBotIdUserAccessResponses[keyof PostBotsByBotIdUserAccessResponses];
 
-export type PostBotsByBotIdSessionsData = {
-    /**
-     * Session data
-     */
-    body: HandlersCreateSessionRequest;
+export type GetBotsByBotIdUserAccessCandidatesData = {
+    body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
     };
-    query?: never;
-    url: '/bots/{bot_id}/sessions';
+    query?: {
+        /**
+         * Search query
+         */
+        q?: string;
+        /**
+         * Max results
+         */
+        limit?: number;
+    };
+    url: '/bots/{bot_id}/user-access/candidates';
 };
 
-export type PostBotsByBotIdSessionsErrors = {
+export type GetBotsByBotIdUserAccessCandidatesErrors = {
     /**
      * Bad Request
      */
@@ -7097,20 +10343,24 @@ export type PostBotsByBotIdSessionsErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdSessionsError = PostBotsByBotIdSessionsErrors[keyof PostBotsByBotIdSessionsErrors];
+export type GetBotsByBotIdUserAccessCandidatesError = GetBotsByBotIdUserAccessCandidatesErrors[keyof GetBotsByBotIdUserAccessCandidatesErrors];
 
-export type PostBotsByBotIdSessionsResponses = {
+export type GetBotsByBotIdUserAccessCandidatesResponses = {
     /**
-     * Created
+     * OK
      */
-    201: SessionSession;
+    200: HandlersBotUserCandidateListResponse;
 };
 
-export type PostBotsByBotIdSessionsResponse = PostBotsByBotIdSessionsResponses[keyof PostBotsByBotIdSessionsResponses];
+export type GetBotsByBotIdUserAccessCandidatesResponse = GetBotsByBotIdUserAccessCandidatesResponses[keyof GetBotsByBotIdUserAccessCandidatesResponses];
 
-export type DeleteBotsByBotIdSessionsBySessionIdData = {
+export type DeleteBotsByBotIdUserAccessByGrantIdData = {
     body?: never;
     path: {
         /**
@@ -7118,15 +10368,15 @@ export type DeleteBotsByBotIdSessionsBySessionIdData = {
          */
         bot_id: string;
         /**
-         * Session ID
+         * Grant ID
          */
-        session_id: string;
+        grant_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/sessions/{session_id}';
+    url: '/bots/{bot_id}/user-access/{grant_id}';
 };
 
-export type DeleteBotsByBotIdSessionsBySessionIdErrors = {
+export type DeleteBotsByBotIdUserAccessByGrantIdErrors = {
     /**
      * Bad Request
      */
@@ -7135,34 +10385,45 @@ export type DeleteBotsByBotIdSessionsBySessionIdErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdSessionsBySessionIdError = DeleteBotsByBotIdSessionsBySessionIdErrors[keyof DeleteBotsByBotIdSessionsBySessionIdErrors];
+export type DeleteBotsByBotIdUserAccessByGrantIdError = DeleteBotsByBotIdUserAccessByGrantIdErrors[keyof DeleteBotsByBotIdUserAccessByGrantIdErrors];
 
-export type DeleteBotsByBotIdSessionsBySessionIdResponses = {
+export type DeleteBotsByBotIdUserAccessByGrantIdResponses = {
     /**
      * No Content
      */
     204: unknown;
 };
 
-export type GetBotsByBotIdSessionsBySessionIdData = {
-    body?: never;
+export type PutBotsByBotIdUserAccessByGrantIdData = {
+    /**
+     * Grant payload
+     */
+    body: BotsUpdateUserGrantRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
         /**
-         * Session ID
+         * Grant ID
          */
-        session_id: string;
+        grant_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/sessions/{session_id}';
+    url: '/bots/{bot_id}/user-access/{grant_id}';
 };
 
-export type GetBotsByBotIdSessionsBySessionIdErrors = {
+export type PutBotsByBotIdUserAccessByGrantIdErrors = {
     /**
      * Bad Request
      */
@@ -7175,39 +10436,36 @@ export type GetBotsByBotIdSessionsBySessionIdErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdSessionsBySessionIdError = GetBotsByBotIdSessionsBySessionIdErrors[keyof GetBotsByBotIdSessionsBySessionIdErrors];
+export type PutBotsByBotIdUserAccessByGrantIdError = PutBotsByBotIdUserAccessByGrantIdErrors[keyof PutBotsByBotIdUserAccessByGrantIdErrors];
 
-export type GetBotsByBotIdSessionsBySessionIdResponses = {
+export type PutBotsByBotIdUserAccessByGrantIdResponses = {
     /**
      * OK
      */
-    200: SessionSession;
+    200: BotsUserGrant;
 };
 
-export type GetBotsByBotIdSessionsBySessionIdResponse = GetBotsByBotIdSessionsBySessionIdResponses[keyof GetBotsByBotIdSessionsBySessionIdResponses];
+export type PutBotsByBotIdUserAccessByGrantIdResponse = PutBotsByBotIdUserAccessByGrantIdResponses[keyof PutBotsByBotIdUserAccessByGrantIdResponses];
 
-export type PatchBotsByBotIdSessionsBySessionIdData = {
-    /**
-     * Fields to update
-     */
-    body: HandlersUpdateSessionRequest;
+export type GetBotsByBotIdWorkflowsData = {
+    body?: never;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Session ID
-         */
-        session_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/sessions/{session_id}';
+    url: '/bots/{bot_id}/workflows';
 };
 
-export type PatchBotsByBotIdSessionsBySessionIdErrors = {
+export type GetBotsByBotIdWorkflowsErrors = {
     /**
      * Bad Request
      */
@@ -7217,39 +10475,38 @@ export type PatchBotsByBotIdSessionsBySessionIdErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PatchBotsByBotIdSessionsBySessionIdError = PatchBotsByBotIdSessionsBySessionIdErrors[keyof PatchBotsByBotIdSessionsBySessionIdErrors];
+export type GetBotsByBotIdWorkflowsError = GetBotsByBotIdWorkflowsErrors[keyof GetBotsByBotIdWorkflowsErrors];
 
-export type PatchBotsByBotIdSessionsBySessionIdResponses = {
+export type GetBotsByBotIdWorkflowsResponses = {
     /**
      * OK
      */
-    200: SessionSession;
+    200: WorkflowListResponse;
 };
 
-export type PatchBotsByBotIdSessionsBySessionIdResponse = PatchBotsByBotIdSessionsBySessionIdResponses[keyof PatchBotsByBotIdSessionsBySessionIdResponses];
+export type GetBotsByBotIdWorkflowsResponse = GetBotsByBotIdWorkflowsResponses[keyof GetBotsByBotIdWorkflowsResponses];
 
-export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeData = {
-    body?: never;
+export type PostBotsByBotIdWorkflowsData = {
+    /**
+     * Workflow payload
+     */
+    body: WorkflowCreateRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
-        /**
-         * Session ID
-         */
-        session_id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/sessions/{session_id}/acp-runtime';
+    url: '/bots/{bot_id}/workflows';
 };
 
-export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors = {
+export type PostBotsByBotIdWorkflowsErrors = {
     /**
      * Bad Request
      */
@@ -7259,23 +10516,23 @@ export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeError = GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors[keyof GetBotsByBotIdSessionsBySessionIdAcpRuntimeErrors];
+export type PostBotsByBotIdWorkflowsError = PostBotsByBotIdWorkflowsErrors[keyof PostBotsByBotIdWorkflowsErrors];
 
-export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponses = {
+export type PostBotsByBotIdWorkflowsResponses = {
     /**
-     * OK
+     * Created
      */
-    200: AcpagentRuntimeStatus;
+    201: WorkflowWorkflow;
 };
 
-export type GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponse = GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponses[keyof GetBotsByBotIdSessionsBySessionIdAcpRuntimeResponses];
+export type PostBotsByBotIdWorkflowsResponse = PostBotsByBotIdWorkflowsResponses[keyof PostBotsByBotIdWorkflowsResponses];
 
-export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeData = {
+export type GetBotsByBotIdWorkflowsByIdData = {
     body?: never;
     path: {
         /**
@@ -7283,19 +10540,15 @@ export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeData = {
          */
         bot_id: string;
         /**
-         * Session ID
+         * Workflow ID
          */
-        session_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/sessions/{session_id}/acp-runtime';
+    url: '/bots/{bot_id}/workflows/{id}';
 };
 
-export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetBotsByBotIdWorkflowsByIdErrors = {
     /**
      * Forbidden
      */
@@ -7304,39 +10557,43 @@ export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeError = PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors[keyof PostBotsByBotIdSessionsBySessionIdAcpRuntimeErrors];
+export type GetBotsByBotIdWorkflowsByIdError = GetBotsByBotIdWorkflowsByIdErrors[keyof GetBotsByBotIdWorkflowsByIdErrors];
 
-export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponses = {
+export type GetBotsByBotIdWorkflowsByIdResponses = {
     /**
      * OK
      */
-    200: AcpagentRuntimeStatus;
+    200: WorkflowWorkflow;
 };
 
-export type PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponse = PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponses[keyof PostBotsByBotIdSessionsBySessionIdAcpRuntimeResponses];
+export type GetBotsByBotIdWorkflowsByIdResponse = GetBotsByBotIdWorkflowsByIdResponses[keyof GetBotsByBotIdWorkflowsByIdResponses];
 
-export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelData = {
+export type PutBotsByBotIdWorkflowsByIdData = {
     /**
-     * ACP model selection
+     * Workflow payload
      */
-    body: HandlersAcpRuntimeModelRequest;
+    body: WorkflowUpdateRequest;
     path: {
         /**
          * Bot ID
          */
         bot_id: string;
         /**
-         * Session ID
+         * Workflow ID
          */
-        session_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/sessions/{session_id}/acp-runtime/model';
+    url: '/bots/{bot_id}/workflows/{id}';
 };
 
-export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors = {
+export type PutBotsByBotIdWorkflowsByIdErrors = {
     /**
      * Bad Request
      */
@@ -7349,20 +10606,24 @@ export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelError = PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors[keyof PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelErrors];
+export type PutBotsByBotIdWorkflowsByIdError = PutBotsByBotIdWorkflowsByIdErrors[keyof PutBotsByBotIdWorkflowsByIdErrors];
 
-export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponses = {
+export type PutBotsByBotIdWorkflowsByIdResponses = {
     /**
      * OK
      */
-    200: AcpagentRuntimeStatus;
+    200: WorkflowWorkflow;
 };
 
-export type PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponse = PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponses[keyof PatchBotsByBotIdSessionsBySessionIdAcpRuntimeModelResponses];
+export type PutBotsByBotIdWorkflowsByIdResponse = PutBotsByBotIdWorkflowsByIdResponses[keyof PutBotsByBotIdWorkflowsByIdResponses];
 
-export type PostBotsByBotIdSessionsBySessionIdCompactData = {
+export type DeleteBotsByBotIdWorkflowsByIdData = {
     body?: never;
     path: {
         /**
@@ -7370,37 +10631,41 @@ export type PostBotsByBotIdSessionsBySessionIdCompactData = {
          */
         bot_id: string;
         /**
-         * Session ID
+         * Workflow ID
          */
-        session_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/sessions/{session_id}/compact';
+    url: '/bots/{bot_id}/workflows/{id}';
 };
 
-export type PostBotsByBotIdSessionsBySessionIdCompactErrors = {
+export type DeleteBotsByBotIdWorkflowsByIdErrors = {
     /**
-     * Bad Request
+     * Forbidden
      */
-    400: HandlersErrorResponse;
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdSessionsBySessionIdCompactError = PostBotsByBotIdSessionsBySessionIdCompactErrors[keyof PostBotsByBotIdSessionsBySessionIdCompactErrors];
+export type DeleteBotsByBotIdWorkflowsByIdError = DeleteBotsByBotIdWorkflowsByIdErrors[keyof DeleteBotsByBotIdWorkflowsByIdErrors];
 
-export type PostBotsByBotIdSessionsBySessionIdCompactResponses = {
+export type DeleteBotsByBotIdWorkflowsByIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: HandlersTriggerCompactResponse;
+    204: unknown;
 };
 
-export type PostBotsByBotIdSessionsBySessionIdCompactResponse = PostBotsByBotIdSessionsBySessionIdCompactResponses[keyof PostBotsByBotIdSessionsBySessionIdCompactResponses];
+export type DeleteBotsByBotIdWorkflowsByIdResponse = DeleteBotsByBotIdWorkflowsByIdResponses[keyof DeleteBotsByBotIdWorkflowsByIdResponses];
 
-export type GetBotsByBotIdSessionsBySessionIdStatusData = {
+export type GetBotsByBotIdWorkflowsByIdRunsData = {
     body?: never;
     path: {
         /**
@@ -7408,20 +10673,24 @@ export type GetBotsByBotIdSessionsBySessionIdStatusData = {
          */
         bot_id: string;
         /**
-         * Session ID
+         * Workflow ID
          */
-        session_id: string;
+        id: string;
     };
     query?: {
         /**
-         * Optional model UUID override for context window
+         * Page size (default 20, max 100)
          */
-        model_id?: string;
+        limit?: number;
+        /**
+         * Offset
+         */
+        offset?: number;
     };
-    url: '/bots/{bot_id}/sessions/{session_id}/status';
+    url: '/bots/{bot_id}/workflows/{id}/runs';
 };
 
-export type GetBotsByBotIdSessionsBySessionIdStatusErrors = {
+export type GetBotsByBotIdWorkflowsByIdRunsErrors = {
     /**
      * Bad Request
      */
@@ -7430,253 +10699,320 @@ export type GetBotsByBotIdSessionsBySessionIdStatusErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdSessionsBySessionIdStatusError = GetBotsByBotIdSessionsBySessionIdStatusErrors[keyof GetBotsByBotIdSessionsBySessionIdStatusErrors];
+export type GetBotsByBotIdWorkflowsByIdRunsError = GetBotsByBotIdWorkflowsByIdRunsErrors[keyof GetBotsByBotIdWorkflowsByIdRunsErrors];
 
-export type GetBotsByBotIdSessionsBySessionIdStatusResponses = {
+export type GetBotsByBotIdWorkflowsByIdRunsResponses = {
     /**
      * OK
      */
-    200: HandlersSessionInfoResponse;
+    200: WorkflowRunListResponse;
 };
 
-export type GetBotsByBotIdSessionsBySessionIdStatusResponse = GetBotsByBotIdSessionsBySessionIdStatusResponses[keyof GetBotsByBotIdSessionsBySessionIdStatusResponses];
+export type GetBotsByBotIdWorkflowsByIdRunsResponse = GetBotsByBotIdWorkflowsByIdRunsResponses[keyof GetBotsByBotIdWorkflowsByIdRunsResponses];
 
-export type DeleteBotsByBotIdSettingsData = {
-    body?: never;
-    path?: never;
+export type PostBotsByBotIdWorkflowsByIdRunsData = {
+    /**
+     * Run inputs
+     */
+    body?: WorkflowTriggerRequest;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Workflow ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/settings';
+    url: '/bots/{bot_id}/workflows/{id}/runs';
 };
 
-export type DeleteBotsByBotIdSettingsErrors = {
+export type PostBotsByBotIdWorkflowsByIdRunsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdSettingsError = DeleteBotsByBotIdSettingsErrors[keyof DeleteBotsByBotIdSettingsErrors];
+export type PostBotsByBotIdWorkflowsByIdRunsError = PostBotsByBotIdWorkflowsByIdRunsErrors[keyof PostBotsByBotIdWorkflowsByIdRunsErrors];
 
-export type DeleteBotsByBotIdSettingsResponses = {
+export type PostBotsByBotIdWorkflowsByIdRunsResponses = {
     /**
-     * No Content
+     * Accepted
      */
-    204: unknown;
+    202: WorkflowRun;
 };
 
-export type GetBotsByBotIdSettingsData = {
+export type PostBotsByBotIdWorkflowsByIdRunsResponse = PostBotsByBotIdWorkflowsByIdRunsResponses[keyof PostBotsByBotIdWorkflowsByIdRunsResponses];
+
+export type GetBotsByBotIdWorkflowsByIdRunsByRunIdData = {
     body?: never;
-    path?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Workflow ID
+         */
+        id: string;
+        /**
+         * Run ID
+         */
+        run_id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/settings';
+    url: '/bots/{bot_id}/workflows/{id}/runs/{run_id}';
 };
 
-export type GetBotsByBotIdSettingsErrors = {
+export type GetBotsByBotIdWorkflowsByIdRunsByRunIdErrors = {
     /**
-     * Bad Request
+     * Forbidden
      */
-    400: HandlersErrorResponse;
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdSettingsError = GetBotsByBotIdSettingsErrors[keyof GetBotsByBotIdSettingsErrors];
+export type GetBotsByBotIdWorkflowsByIdRunsByRunIdError = GetBotsByBotIdWorkflowsByIdRunsByRunIdErrors[keyof GetBotsByBotIdWorkflowsByIdRunsByRunIdErrors];
 
-export type GetBotsByBotIdSettingsResponses = {
+export type GetBotsByBotIdWorkflowsByIdRunsByRunIdResponses = {
     /**
      * OK
      */
-    200: SettingsSettings;
+    200: WorkflowRun;
 };
 
-export type GetBotsByBotIdSettingsResponse = GetBotsByBotIdSettingsResponses[keyof GetBotsByBotIdSettingsResponses];
+export type GetBotsByBotIdWorkflowsByIdRunsByRunIdResponse = GetBotsByBotIdWorkflowsByIdRunsByRunIdResponses[keyof GetBotsByBotIdWorkflowsByIdRunsByRunIdResponses];
 
-export type PostBotsByBotIdSettingsData = {
-    /**
-     * Settings payload
-     */
-    body: SettingsUpsertRequest;
-    path?: never;
+export type PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        bot_id: string;
+        /**
+         * Workflow ID
+         */
+        id: string;
+        /**
+         * Run ID
+         */
+        run_id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/settings';
+    url: '/bots/{bot_id}/workflows/{id}/runs/{run_id}/cancel';
 };
 
-export type PostBotsByBotIdSettingsErrors = {
+export type PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelErrors = {
     /**
-     * Bad Request
+     * Forbidden
      */
-    400: HandlersErrorResponse;
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
+    /**
+     * Conflict
+     */
+    409: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdSettingsError = PostBotsByBotIdSettingsErrors[keyof PostBotsByBotIdSettingsErrors];
+export type PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelError = PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelErrors[keyof PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelErrors];
 
-export type PostBotsByBotIdSettingsResponses = {
+export type PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelResponses = {
     /**
      * OK
      */
-    200: SettingsSettings;
+    200: WorkflowRun;
 };
 
-export type PostBotsByBotIdSettingsResponse = PostBotsByBotIdSettingsResponses[keyof PostBotsByBotIdSettingsResponses];
+export type PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelResponse = PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelResponses[keyof PostBotsByBotIdWorkflowsByIdRunsByRunIdCancelResponses];
 
-export type PutBotsByBotIdSettingsData = {
-    /**
-     * Settings payload
-     */
-    body: SettingsUpsertRequest;
-    path?: never;
+export type DeleteBotsByIdData = {
+    body?: never;
+    path: {
+        /**
+         * Bot ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/bots/{bot_id}/settings';
+    url: '/bots/{id}';
 };
 
-export type PutBotsByBotIdSettingsErrors = {
+export type DeleteBotsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdSettingsError = PutBotsByBotIdSettingsErrors[keyof PutBotsByBotIdSettingsErrors];
+export type DeleteBotsByIdError = DeleteBotsByIdErrors[keyof DeleteBotsByIdErrors];
 
-export type PutBotsByBotIdSettingsResponses = {
+export type DeleteBotsByIdResponses = {
     /**
-     * OK
+     * Accepted
      */
-    200: SettingsSettings;
+    202: {
+        [key: string]: string;
+    };
 };
 
-export type PutBotsByBotIdSettingsResponse = PutBotsByBotIdSettingsResponses[keyof PutBotsByBotIdSettingsResponses];
+export type DeleteBotsByIdResponse = DeleteBotsByIdResponses[keyof DeleteBotsByIdResponses];
 
-export type PostBotsByBotIdSupermarketInstallPluginData = {
-    /**
-     * Install plugin request
-     */
-    body: HandlersInstallPluginRequest;
+export type GetBotsByIdData = {
+    body?: never;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/supermarket/install-plugin';
+    url: '/bots/{id}';
 };
 
-export type PostBotsByBotIdSupermarketInstallPluginErrors = {
+export type GetBotsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
     /**
-     * Bad Gateway
+     * Internal Server Error
      */
-    502: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdSupermarketInstallPluginError = PostBotsByBotIdSupermarketInstallPluginErrors[keyof PostBotsByBotIdSupermarketInstallPluginErrors];
+export type GetBotsByIdError = GetBotsByIdErrors[keyof GetBotsByIdErrors];
 
-export type PostBotsByBotIdSupermarketInstallPluginResponses = {
+export type GetBotsByIdResponses = {
     /**
      * OK
      */
-    200: PluginsInstallation;
+    200: BotsBot;
 };
 
-export type PostBotsByBotIdSupermarketInstallPluginResponse = PostBotsByBotIdSupermarketInstallPluginResponses[keyof PostBotsByBotIdSupermarketInstallPluginResponses];
+export type GetBotsByIdResponse = GetBotsByIdResponses[keyof GetBotsByIdResponses];
 
-export type PostBotsByBotIdSupermarketInstallSkillData = {
+export type PutBotsByIdData = {
     /**
-     * Install skill request
+     * Bot update payload
      */
-    body: HandlersInstallSkillRequest;
+    body: BotsUpdateBotRequest;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/supermarket/install-skill';
+    url: '/bots/{id}';
 };
 
-export type PostBotsByBotIdSupermarketInstallSkillErrors = {
+export type PutBotsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
     /**
-     * Bad Gateway
+     * Internal Server Error
      */
-    502: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdSupermarketInstallSkillError = PostBotsByBotIdSupermarketInstallSkillErrors[keyof PostBotsByBotIdSupermarketInstallSkillErrors];
+export type PutBotsByIdError = PutBotsByIdErrors[keyof PutBotsByIdErrors];
 
-export type PostBotsByBotIdSupermarketInstallSkillResponses = {
+export type PutBotsByIdResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: boolean;
-    };
+    200: BotsBot;
 };
 
-export type PostBotsByBotIdSupermarketInstallSkillResponse = PostBotsByBotIdSupermarketInstallSkillResponses[keyof PostBotsByBotIdSupermarketInstallSkillResponses];
+export type PutBotsByIdResponse = PutBotsByIdResponses[keyof PutBotsByIdResponses];
 
-export type GetBotsByBotIdTokenUsageData = {
+export type DeleteBotsByIdChannelByPlatformData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
-    };
-    query: {
-        /**
-         * Start date (YYYY-MM-DD)
-         */
-        from: string;
-        /**
-         * End date exclusive (YYYY-MM-DD)
-         */
-        to: string;
+        id: string;
         /**
-         * Optional model UUID to filter by
+         * Channel platform
          */
-        model_id?: string;
+        platform: string;
     };
-    url: '/bots/{bot_id}/token-usage';
+    query?: never;
+    url: '/bots/{id}/channel/{platform}';
 };
 
-export type GetBotsByBotIdTokenUsageErrors = {
+export type DeleteBotsByIdChannelByPlatformErrors = {
     /**
      * Bad Request
      */
@@ -7691,55 +11027,32 @@ export type GetBotsByBotIdTokenUsageErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdTokenUsageError = GetBotsByBotIdTokenUsageErrors[keyof GetBotsByBotIdTokenUsageErrors];
+export type DeleteBotsByIdChannelByPlatformError = DeleteBotsByIdChannelByPlatformErrors[keyof DeleteBotsByIdChannelByPlatformErrors];
 
-export type GetBotsByBotIdTokenUsageResponses = {
+export type DeleteBotsByIdChannelByPlatformResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: HandlersTokenUsageResponse;
+    204: unknown;
 };
 
-export type GetBotsByBotIdTokenUsageResponse = GetBotsByBotIdTokenUsageResponses[keyof GetBotsByBotIdTokenUsageResponses];
-
-export type GetBotsByBotIdTokenUsageRecordsData = {
+export type GetBotsByIdChannelByPlatformData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
-    };
-    query: {
-        /**
-         * Start date (YYYY-MM-DD)
-         */
-        from: string;
-        /**
-         * End date exclusive (YYYY-MM-DD)
-         */
-        to: string;
-        /**
-         * Optional model UUID to filter by
-         */
-        model_id?: string;
-        /**
-         * Optional session type: chat, heartbeat, schedule, or acp_agent
-         */
-        session_type?: string;
-        /**
-         * Page size (default 20, max 100)
-         */
-        limit?: number;
+        id: string;
         /**
-         * Offset
+         * Channel platform
          */
-        offset?: number;
+        platform: string;
     };
-    url: '/bots/{bot_id}/token-usage/records';
+    query?: never;
+    url: '/bots/{id}/channel/{platform}';
 };
 
-export type GetBotsByBotIdTokenUsageRecordsErrors = {
+export type GetBotsByIdChannelByPlatformErrors = {
     /**
      * Bad Request
      */
@@ -7748,43 +11061,47 @@ export type GetBotsByBotIdTokenUsageRecordsErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdTokenUsageRecordsError = GetBotsByBotIdTokenUsageRecordsErrors[keyof GetBotsByBotIdTokenUsageRecordsErrors];
+export type GetBotsByIdChannelByPlatformError = GetBotsByIdChannelByPlatformErrors[keyof GetBotsByIdChannelByPlatformErrors];
 
-export type GetBotsByBotIdTokenUsageRecordsResponses = {
+export type GetBotsByIdChannelByPlatformResponses = {
     /**
      * OK
      */
-    200: HandlersTokenUsageRecordsResponse;
+    200: ChannelChannelConfig;
 };
 
-export type GetBotsByBotIdTokenUsageRecordsResponse = GetBotsByBotIdTokenUsageRecordsResponses[keyof GetBotsByBotIdTokenUsageRecordsResponses];
+export type GetBotsByIdChannelByPlatformResponse = GetBotsByIdChannelByPlatformResponses[keyof GetBotsByIdChannelByPlatformResponses];
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdApproveData = {
+export type PutBotsByIdChannelByPlatformData = {
     /**
-     * Approval payload
+     * Channel config payload
      */
-    body?: HandlersToolApprovalDecisionRequest;
+    body: ChannelUpsertConfigRequest;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
+        id: string;
         /**
-         * Approval ID
+         * Channel platform
          */
-        approval_id: string;
+        platform: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/tool-approvals/{approval_id}/approve';
+    url: '/bots/{id}/channel/{platform}';
 };
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors = {
+export type PutBotsByIdChannelByPlatformErrors = {
     /**
      * Bad Request
      */
@@ -7793,45 +11110,47 @@ export type PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdApproveError = PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors[keyof PostBotsByBotIdToolApprovalsByApprovalIdApproveErrors];
+export type PutBotsByIdChannelByPlatformError = PutBotsByIdChannelByPlatformErrors[keyof PutBotsByIdChannelByPlatformErrors];
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdApproveResponses = {
+export type PutBotsByIdChannelByPlatformResponses = {
     /**
      * OK
      */
-    200: {
-        [key: string]: string;
-    };
+    200: ChannelChannelConfig;
 };
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdApproveResponse = PostBotsByBotIdToolApprovalsByApprovalIdApproveResponses[keyof PostBotsByBotIdToolApprovalsByApprovalIdApproveResponses];
+export type PutBotsByIdChannelByPlatformResponse = PutBotsByIdChannelByPlatformResponses[keyof PutBotsByIdChannelByPlatformResponses];
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdRejectData = {
+export type PostBotsByIdChannelByPlatformSendData = {
     /**
-     * Rejection payload
+     * Send payload
      */
-    body?: HandlersToolApprovalDecisionRequest;
+    body: ChannelSendRequest;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
+        id: string;
         /**
-         * Approval ID
+         * Channel platform
          */
-        approval_id: string;
+        platform: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/tool-approvals/{approval_id}/reject';
+    url: '/bots/{id}/channel/{platform}/send';
 };
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors = {
+export type PostBotsByIdChannelByPlatformSendErrors = {
     /**
      * Bad Request
      */
@@ -7840,15 +11159,19 @@ export type PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdRejectError = PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors[keyof PostBotsByBotIdToolApprovalsByApprovalIdRejectErrors];
+export type PostBotsByIdChannelByPlatformSendError = PostBotsByIdChannelByPlatformSendErrors[keyof PostBotsByIdChannelByPlatformSendErrors];
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses = {
+export type PostBotsByIdChannelByPlatformSendResponses = {
     /**
      * OK
      */
@@ -7857,103 +11180,121 @@ export type PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses = {
     };
 };
 
-export type PostBotsByBotIdToolApprovalsByApprovalIdRejectResponse = PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses[keyof PostBotsByBotIdToolApprovalsByApprovalIdRejectResponses];
+export type PostBotsByIdChannelByPlatformSendResponse = PostBotsByIdChannelByPlatformSendResponses[keyof PostBotsByIdChannelByPlatformSendResponses];
 
-export type PostBotsByBotIdToolsData = {
+export type PostBotsByIdChannelByPlatformSendChatData = {
     /**
-     * JSON-RPC request
+     * Send payload
      */
-    body: {
-        [key: string]: unknown;
-    };
+    body: ChannelSendRequest;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
+        id: string;
+        /**
+         * Channel platform
+         */
+        platform: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/tools';
+    url: '/bots/{id}/channel/{platform}/send_chat';
 };
 
-export type PostBotsByBotIdToolsErrors = {
+export type PostBotsByIdChannelByPlatformSendChatErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Unauthorized
      */
-    404: HandlersErrorResponse;
+    401: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdToolsError = PostBotsByBotIdToolsErrors[keyof PostBotsByBotIdToolsErrors];
+export type PostBotsByIdChannelByPlatformSendChatError = PostBotsByIdChannelByPlatformSendChatErrors[keyof PostBotsByIdChannelByPlatformSendChatErrors];
 
-export type PostBotsByBotIdToolsResponses = {
+export type PostBotsByIdChannelByPlatformSendChatResponses = {
     /**
-     * JSON-RPC response: {jsonrpc,id,result|error}
+     * OK
      */
     200: {
-        [key: string]: unknown;
+        [key: string]: string;
     };
 };
 
-export type PostBotsByBotIdToolsResponse = PostBotsByBotIdToolsResponses[keyof PostBotsByBotIdToolsResponses];
+export type PostBotsByIdChannelByPlatformSendChatResponse = PostBotsByIdChannelByPlatformSendChatResponses[keyof PostBotsByIdChannelByPlatformSendChatResponses];
 
-export type PostBotsByBotIdTtsSynthesizeData = {
+export type PatchBotsByIdChannelByPlatformStatusData = {
     /**
-     * Text to synthesize
+     * Channel status payload
      */
-    body: HandlersSynthesizeRequest;
+    body: ChannelUpdateChannelStatusRequest;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
+        id: string;
+        /**
+         * Channel platform
+         */
+        platform: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/tts/synthesize';
+    url: '/bots/{id}/channel/{platform}/status';
 };
 
-export type PostBotsByBotIdTtsSynthesizeErrors = {
+export type PatchBotsByIdChannelByPlatformStatusErrors = {
     /**
      * Bad Request
      */
-    400: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdTtsSynthesizeError = PostBotsByBotIdTtsSynthesizeErrors[keyof PostBotsByBotIdTtsSynthesizeErrors];
+export type PatchBotsByIdChannelByPlatformStatusError = PatchBotsByIdChannelByPlatformStatusErrors[keyof PatchBotsByIdChannelByPlatformStatusErrors];
 
-export type PostBotsByBotIdTtsSynthesizeResponses = {
+export type PatchBotsByIdChannelByPlatformStatusResponses = {
     /**
      * OK
      */
-    200: HandlersSynthesizeResponse;
+    200: ChannelChannelConfig;
 };
 
-export type PostBotsByBotIdTtsSynthesizeResponse = PostBotsByBotIdTtsSynthesizeResponses[keyof PostBotsByBotIdTtsSynthesizeResponses];
+export type PatchBotsByIdChannelByPlatformStatusResponse = PatchBotsByIdChannelByPlatformStatusResponses[keyof PatchBotsByIdChannelByPlatformStatusResponses];
 
-export type GetBotsByBotIdUserAccessData = {
+export type GetBotsByIdChecksData = {
     body?: never;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/user-access';
+    url: '/bots/{id}/checks';
 };
 
-export type GetBotsByBotIdUserAccessErrors = {
+export type GetBotsByIdChecksErrors = {
     /**
      * Bad Request
      */
@@ -7962,39 +11303,43 @@ export type GetBotsByBotIdUserAccessErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdUserAccessError = GetBotsByBotIdUserAccessErrors[keyof GetBotsByBotIdUserAccessErrors];
+export type GetBotsByIdChecksError = GetBotsByIdChecksErrors[keyof GetBotsByIdChecksErrors];
 
-export type GetBotsByBotIdUserAccessResponses = {
+export type GetBotsByIdChecksResponses = {
     /**
      * OK
      */
-    200: HandlersBotUserGrantListResponse;
+    200: BotsListChecksResponse;
 };
 
-export type GetBotsByBotIdUserAccessResponse = GetBotsByBotIdUserAccessResponses[keyof GetBotsByBotIdUserAccessResponses];
+export type GetBotsByIdChecksResponse = GetBotsByIdChecksResponses[keyof GetBotsByIdChecksResponses];
 
-export type PostBotsByBotIdUserAccessData = {
+export type PutBotsByIdOwnerData = {
     /**
-     * Grant payload
+     * Transfer payload
      */
-    body: BotsCreateUserGrantRequest;
+    body: BotsTransferBotRequest;
     path: {
         /**
          * Bot ID
          */
-        bot_id: string;
+        id: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/user-access';
+    url: '/bots/{id}/owner';
 };
 
-export type PostBotsByBotIdUserAccessErrors = {
+export type PutBotsByIdOwnerErrors = {
     /**
      * Bad Request
      */
@@ -8004,452 +11349,373 @@ export type PostBotsByBotIdUserAccessErrors = {
      */
     403: HandlersErrorResponse;
     /**
-     * Conflict
+     * Not Found
      */
-    409: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByBotIdUserAccessError = PostBotsByBotIdUserAccessErrors[keyof PostBotsByBotIdUserAccessErrors];
+export type PutBotsByIdOwnerError = PutBotsByIdOwnerErrors[keyof PutBotsByIdOwnerErrors];
 
-export type PostBotsByBotIdUserAccessResponses = {
+export type PutBotsByIdOwnerResponses = {
     /**
-     * Created
+     * OK
      */
-    201: BotsUserGrant;
+    200: BotsBot;
 };
 
-export type PostBotsByBotIdUserAccessResponse = PostBotsByBotIdUserAccessResponses[keyof PostBotsByBotIdUserAccessResponses];
+export type PutBotsByIdOwnerResponse = PutBotsByIdOwnerResponses[keyof PutBotsByIdOwnerResponses];
 
-export type GetBotsByBotIdUserAccessCandidatesData = {
+export type GetChannelsData = {
     body?: never;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-    };
-    query?: {
-        /**
-         * Search query
-         */
-        q?: string;
-        /**
-         * Max results
-         */
-        limit?: number;
-    };
-    url: '/bots/{bot_id}/user-access/candidates';
+    path?: never;
+    query?: never;
+    url: '/channels';
 };
 
-export type GetBotsByBotIdUserAccessCandidatesErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
+export type GetChannelsErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByBotIdUserAccessCandidatesError = GetBotsByBotIdUserAccessCandidatesErrors[keyof GetBotsByBotIdUserAccessCandidatesErrors];
+export type GetChannelsError = GetChannelsErrors[keyof GetChannelsErrors];
 
-export type GetBotsByBotIdUserAccessCandidatesResponses = {
+export type GetChannelsResponses = {
     /**
      * OK
      */
-    200: HandlersBotUserCandidateListResponse;
+    200: Array<HandlersChannelMeta>;
 };
 
-export type GetBotsByBotIdUserAccessCandidatesResponse = GetBotsByBotIdUserAccessCandidatesResponses[keyof GetBotsByBotIdUserAccessCandidatesResponses];
+export type GetChannelsResponse = GetChannelsResponses[keyof GetChannelsResponses];
 
-export type DeleteBotsByBotIdUserAccessByGrantIdData = {
+export type GetChannelsByPlatformData = {
     body?: never;
     path: {
         /**
-         * Bot ID
-         */
-        bot_id: string;
-        /**
-         * Grant ID
+         * Channel platform
          */
-        grant_id: string;
+        platform: string;
     };
     query?: never;
-    url: '/bots/{bot_id}/user-access/{grant_id}';
+    url: '/channels/{platform}';
 };
 
-export type DeleteBotsByBotIdUserAccessByGrantIdErrors = {
+export type GetChannelsByPlatformErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
+};
+
+export type GetChannelsByPlatformError = GetChannelsByPlatformErrors[keyof GetChannelsByPlatformErrors];
+
+export type GetChannelsByPlatformResponses = {
+    /**
+     * OK
+     */
+    200: HandlersChannelMeta;
+};
+
+export type GetChannelsByPlatformResponse = GetChannelsByPlatformResponses[keyof GetChannelsByPlatformResponses];
+
+export type GetEmailProvidersData = {
+    body?: never;
+    path?: never;
+    query?: {
+        /**
+         * Provider type filter
+         */
+        provider?: string;
+    };
+    url: '/email-providers';
+};
+
+export type GetEmailProvidersErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByBotIdUserAccessByGrantIdError = DeleteBotsByBotIdUserAccessByGrantIdErrors[keyof DeleteBotsByBotIdUserAccessByGrantIdErrors];
+export type GetEmailProvidersError = GetEmailProvidersErrors[keyof GetEmailProvidersErrors];
 
-export type DeleteBotsByBotIdUserAccessByGrantIdResponses = {
+export type GetEmailProvidersResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: Array<EmailProviderResponse>;
 };
 
-export type PutBotsByBotIdUserAccessByGrantIdData = {
+export type GetEmailProvidersResponse = GetEmailProvidersResponses[keyof GetEmailProvidersResponses];
+
+export type PostEmailProvidersData = {
     /**
-     * Grant payload
+     * Email provider configuration
      */
-    body: BotsUpdateUserGrantRequest;
-    path: {
-        /**
-         * Bot ID
-         */
-        bot_id: string;
-        /**
-         * Grant ID
-         */
-        grant_id: string;
-    };
+    body: EmailCreateProviderRequest;
+    path?: never;
     query?: never;
-    url: '/bots/{bot_id}/user-access/{grant_id}';
+    url: '/email-providers';
 };
 
-export type PutBotsByBotIdUserAccessByGrantIdErrors = {
+export type PostEmailProvidersErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutBotsByBotIdUserAccessByGrantIdError = PutBotsByBotIdUserAccessByGrantIdErrors[keyof PutBotsByBotIdUserAccessByGrantIdErrors];
+export type PostEmailProvidersError = PostEmailProvidersErrors[keyof PostEmailProvidersErrors];
 
-export type PutBotsByBotIdUserAccessByGrantIdResponses = {
+export type PostEmailProvidersResponses = {
+    /**
+     * Created
+     */
+    201: EmailProviderResponse;
+};
+
+export type PostEmailProvidersResponse = PostEmailProvidersResponses[keyof PostEmailProvidersResponses];
+
+export type GetEmailProvidersMetaData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/email-providers/meta';
+};
+
+export type GetEmailProvidersMetaResponses = {
     /**
      * OK
      */
-    200: BotsUserGrant;
+    200: Array<EmailProviderMeta>;
 };
 
-export type PutBotsByBotIdUserAccessByGrantIdResponse = PutBotsByBotIdUserAccessByGrantIdResponses[keyof PutBotsByBotIdUserAccessByGrantIdResponses];
+export type GetEmailProvidersMetaResponse = GetEmailProvidersMetaResponses[keyof GetEmailProvidersMetaResponses];
 
-export type DeleteBotsByIdData = {
+export type DeleteEmailProvidersByIdData = {
     body?: never;
     path: {
         /**
-         * Bot ID
+         * Provider ID
          */
         id: string;
     };
     query?: never;
-    url: '/bots/{id}';
+    url: '/email-providers/{id}';
 };
 
-export type DeleteBotsByIdErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
+export type DeleteEmailProvidersByIdErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteBotsByIdError = DeleteBotsByIdErrors[keyof DeleteBotsByIdErrors];
+export type DeleteEmailProvidersByIdError = DeleteEmailProvidersByIdErrors[keyof DeleteEmailProvidersByIdErrors];
 
-export type DeleteBotsByIdResponses = {
+export type DeleteEmailProvidersByIdResponses = {
     /**
-     * Accepted
+     * No Content
      */
-    202: {
-        [key: string]: string;
-    };
+    204: unknown;
 };
 
-export type DeleteBotsByIdResponse = DeleteBotsByIdResponses[keyof DeleteBotsByIdResponses];
-
-export type GetBotsByIdData = {
+export type GetEmailProvidersByIdData = {
     body?: never;
     path: {
         /**
-         * Bot ID
+         * Provider ID
          */
         id: string;
     };
     query?: never;
-    url: '/bots/{id}';
+    url: '/email-providers/{id}';
 };
 
-export type GetBotsByIdErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
+export type GetEmailProvidersByIdErrors = {
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type GetBotsByIdError = GetBotsByIdErrors[keyof GetBotsByIdErrors];
+export type GetEmailProvidersByIdError = GetEmailProvidersByIdErrors[keyof GetEmailProvidersByIdErrors];
 
-export type GetBotsByIdResponses = {
+export type GetEmailProvidersByIdResponses = {
     /**
      * OK
      */
-    200: BotsBot;
+    200: EmailProviderResponse;
 };
 
-export type GetBotsByIdResponse = GetBotsByIdResponses[keyof GetBotsByIdResponses];
+export type GetEmailProvidersByIdResponse = GetEmailProvidersByIdResponses[keyof GetEmailProvidersByIdResponses];
 
-export type PutBotsByIdData = {
+export type PutEmailProvidersByIdData = {
     /**
-     * Bot update payload
+     * Updated configuration
      */
-    body: BotsUpdateBotRequest;
+    body: EmailUpdateProviderRequest;
     path: {
         /**
-         * Bot ID
+         * Provider ID
          */
         id: string;
     };
     query?: never;
-    url: '/bots/{id}';
+    url: '/email-providers/{id}';
 };
 
-export type PutBotsByIdErrors = {
+export type PutEmailProvidersByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutBotsByIdError = PutBotsByIdErrors[keyof PutBotsByIdErrors];
+export type PutEmailProvidersByIdError = PutEmailProvidersByIdErrors[keyof PutEmailProvidersByIdErrors];
 
-export type PutBotsByIdResponses = {
+export type PutEmailProvidersByIdResponses = {
     /**
      * OK
      */
-    200: BotsBot;
+    200: EmailProviderResponse;
 };
 
-export type PutBotsByIdResponse = PutBotsByIdResponses[keyof PutBotsByIdResponses];
+export type PutEmailProvidersByIdResponse = PutEmailProvidersByIdResponses[keyof PutEmailProvidersByIdResponses];
 
-export type DeleteBotsByIdChannelByPlatformData = {
+export type GetEmailProvidersByIdOauthAuthorizeData = {
     body?: never;
     path: {
         /**
-         * Bot ID
+         * Email provider ID
          */
         id: string;
-        /**
-         * Channel platform
-         */
-        platform: string;
     };
     query?: never;
-    url: '/bots/{id}/channel/{platform}';
+    url: '/email-providers/{id}/oauth/authorize';
 };
 
-export type DeleteBotsByIdChannelByPlatformErrors = {
+export type GetEmailProvidersByIdOauthAuthorizeErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
-    /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
+    404: HandlersErrorResponse;
 };
 
-export type DeleteBotsByIdChannelByPlatformError = DeleteBotsByIdChannelByPlatformErrors[keyof DeleteBotsByIdChannelByPlatformErrors];
+export type GetEmailProvidersByIdOauthAuthorizeError = GetEmailProvidersByIdOauthAuthorizeErrors[keyof GetEmailProvidersByIdOauthAuthorizeErrors];
 
-export type DeleteBotsByIdChannelByPlatformResponses = {
+export type GetEmailProvidersByIdOauthAuthorizeResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: {
+        [key: string]: string;
+    };
 };
 
-export type GetBotsByIdChannelByPlatformData = {
+export type GetEmailProvidersByIdOauthAuthorizeResponse = GetEmailProvidersByIdOauthAuthorizeResponses[keyof GetEmailProvidersByIdOauthAuthorizeResponses];
+
+export type GetEmailProvidersByIdOauthStatusData = {
     body?: never;
     path: {
         /**
-         * Bot ID
+         * Email provider ID
          */
         id: string;
-        /**
-         * Channel platform
-         */
-        platform: string;
     };
     query?: never;
-    url: '/bots/{id}/channel/{platform}';
+    url: '/email-providers/{id}/oauth/status';
 };
 
-export type GetBotsByIdChannelByPlatformErrors = {
+export type GetEmailProvidersByIdOauthStatusErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type GetBotsByIdChannelByPlatformError = GetBotsByIdChannelByPlatformErrors[keyof GetBotsByIdChannelByPlatformErrors];
+export type GetEmailProvidersByIdOauthStatusError = GetEmailProvidersByIdOauthStatusErrors[keyof GetEmailProvidersByIdOauthStatusErrors];
 
-export type GetBotsByIdChannelByPlatformResponses = {
+export type GetEmailProvidersByIdOauthStatusResponses = {
     /**
      * OK
      */
-    200: ChannelChannelConfig;
+    200: HandlersEmailOAuthStatusResponse;
 };
 
-export type GetBotsByIdChannelByPlatformResponse = GetBotsByIdChannelByPlatformResponses[keyof GetBotsByIdChannelByPlatformResponses];
+export type GetEmailProvidersByIdOauthStatusResponse = GetEmailProvidersByIdOauthStatusResponses[keyof GetEmailProvidersByIdOauthStatusResponses];
 
-export type PutBotsByIdChannelByPlatformData = {
-    /**
-     * Channel config payload
-     */
-    body: ChannelUpsertConfigRequest;
+export type DeleteEmailProvidersByIdOauthTokenData = {
+    body?: never;
     path: {
         /**
-         * Bot ID
+         * Email provider ID
          */
         id: string;
-        /**
-         * Channel platform
-         */
-        platform: string;
     };
     query?: never;
-    url: '/bots/{id}/channel/{platform}';
+    url: '/email-providers/{id}/oauth/token';
 };
 
-export type PutBotsByIdChannelByPlatformErrors = {
+export type DeleteEmailProvidersByIdOauthTokenErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type PutBotsByIdChannelByPlatformError = PutBotsByIdChannelByPlatformErrors[keyof PutBotsByIdChannelByPlatformErrors];
+export type DeleteEmailProvidersByIdOauthTokenError = DeleteEmailProvidersByIdOauthTokenErrors[keyof DeleteEmailProvidersByIdOauthTokenErrors];
 
-export type PutBotsByIdChannelByPlatformResponses = {
+export type DeleteEmailProvidersByIdOauthTokenResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: ChannelChannelConfig;
+    204: unknown;
 };
 
-export type PutBotsByIdChannelByPlatformResponse = PutBotsByIdChannelByPlatformResponses[keyof PutBotsByIdChannelByPlatformResponses];
-
-export type PostBotsByIdChannelByPlatformSendData = {
-    /**
-     * Send payload
-     */
-    body: ChannelSendRequest;
+export type PostEmailMailgunWebhookByConfigIdData = {
+    body?: never;
     path: {
         /**
-         * Bot ID
-         */
-        id: string;
-        /**
-         * Channel platform
+         * Email provider config ID
          */
-        platform: string;
+        config_id: string;
     };
     query?: never;
-    url: '/bots/{id}/channel/{platform}/send';
+    url: '/email/mailgun/webhook/{config_id}';
 };
 
-export type PostBotsByIdChannelByPlatformSendErrors = {
+export type PostEmailMailgunWebhookByConfigIdErrors = {
     /**
      * Bad Request
      */
@@ -8458,19 +11724,15 @@ export type PostBotsByIdChannelByPlatformSendErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByIdChannelByPlatformSendError = PostBotsByIdChannelByPlatformSendErrors[keyof PostBotsByIdChannelByPlatformSendErrors];
+export type PostEmailMailgunWebhookByConfigIdError = PostEmailMailgunWebhookByConfigIdErrors[keyof PostEmailMailgunWebhookByConfigIdErrors];
 
-export type PostBotsByIdChannelByPlatformSendResponses = {
+export type PostEmailMailgunWebhookByConfigIdResponses = {
     /**
      * OK
      */
@@ -8479,49 +11741,38 @@ export type PostBotsByIdChannelByPlatformSendResponses = {
     };
 };
 
-export type PostBotsByIdChannelByPlatformSendResponse = PostBotsByIdChannelByPlatformSendResponses[keyof PostBotsByIdChannelByPlatformSendResponses];
+export type PostEmailMailgunWebhookByConfigIdResponse = PostEmailMailgunWebhookByConfigIdResponses[keyof PostEmailMailgunWebhookByConfigIdResponses];
 
-export type PostBotsByIdChannelByPlatformSendChatData = {
-    /**
-     * Send payload
-     */
-    body: ChannelSendRequest;
-    path: {
+export type GetEmailOauthCallbackData = {
+    body?: never;
+    path?: never;
+    query: {
         /**
-         * Bot ID
+         * Authorization code
          */
-        id: string;
+        code: string;
         /**
-         * Channel platform
+         * State parameter
          */
-        platform: string;
+        state: string;
     };
-    query?: never;
-    url: '/bots/{id}/channel/{platform}/send_chat';
+    url: '/email/oauth/callback';
 };
 
-export type PostBotsByIdChannelByPlatformSendChatErrors = {
+export type GetEmailOauthCallbackErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Unauthorized
-     */
-    401: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostBotsByIdChannelByPlatformSendChatError = PostBotsByIdChannelByPlatformSendChatErrors[keyof PostBotsByIdChannelByPlatformSendChatErrors];
+export type GetEmailOauthCallbackError = GetEmailOauthCallbackErrors[keyof GetEmailOauthCallbackErrors];
 
-export type PostBotsByIdChannelByPlatformSendChatResponses = {
+export type GetEmailOauthCallbackResponses = {
     /**
      * OK
      */
@@ -8530,182 +11781,197 @@ export type PostBotsByIdChannelByPlatformSendChatResponses = {
     };
 };
 
-export type PostBotsByIdChannelByPlatformSendChatResponse = PostBotsByIdChannelByPlatformSendChatResponses[keyof PostBotsByIdChannelByPlatformSendChatResponses];
+export type GetEmailOauthCallbackResponse = GetEmailOauthCallbackResponses[keyof GetEmailOauthCallbackResponses];
 
-export type PatchBotsByIdChannelByPlatformStatusData = {
+export type GetMemoryProvidersData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/memory-providers';
+};
+
+export type GetMemoryProvidersErrors = {
     /**
-     * Channel status payload
+     * Internal Server Error
      */
-    body: ChannelUpdateChannelStatusRequest;
-    path: {
-        /**
-         * Bot ID
-         */
-        id: string;
-        /**
-         * Channel platform
-         */
-        platform: string;
-    };
-    query?: never;
-    url: '/bots/{id}/channel/{platform}/status';
+    500: HandlersErrorResponse;
 };
 
-export type PatchBotsByIdChannelByPlatformStatusErrors = {
+export type GetMemoryProvidersError = GetMemoryProvidersErrors[keyof GetMemoryProvidersErrors];
+
+export type GetMemoryProvidersResponses = {
     /**
-     * Bad Request
+     * OK
      */
-    400: HandlersErrorResponse;
+    200: Array<AdaptersProviderGetResponse>;
+};
+
+export type GetMemoryProvidersResponse = GetMemoryProvidersResponses[keyof GetMemoryProvidersResponses];
+
+export type PostMemoryProvidersData = {
     /**
-     * Forbidden
+     * Memory provider configuration
      */
-    403: HandlersErrorResponse;
+    body: AdaptersProviderCreateRequest;
+    path?: never;
+    query?: never;
+    url: '/memory-providers';
+};
+
+export type PostMemoryProvidersErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PatchBotsByIdChannelByPlatformStatusError = PatchBotsByIdChannelByPlatformStatusErrors[keyof PatchBotsByIdChannelByPlatformStatusErrors];
+export type PostMemoryProvidersError = PostMemoryProvidersErrors[keyof PostMemoryProvidersErrors];
+
+export type PostMemoryProvidersResponses = {
+    /**
+     * Created
+     */
+    201: AdaptersProviderGetResponse;
+};
+
+export type PostMemoryProvidersResponse = PostMemoryProvidersResponses[keyof PostMemoryProvidersResponses];
 
-export type PatchBotsByIdChannelByPlatformStatusResponses = {
+export type GetMemoryProvidersMetaData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/memory-providers/meta';
+};
+
+export type GetMemoryProvidersMetaResponses = {
     /**
      * OK
      */
-    200: ChannelChannelConfig;
+    200: Array<AdaptersProviderMeta>;
 };
 
-export type PatchBotsByIdChannelByPlatformStatusResponse = PatchBotsByIdChannelByPlatformStatusResponses[keyof PatchBotsByIdChannelByPlatformStatusResponses];
+export type GetMemoryProvidersMetaResponse = GetMemoryProvidersMetaResponses[keyof GetMemoryProvidersMetaResponses];
 
-export type GetBotsByIdChecksData = {
+export type DeleteMemoryProvidersByIdData = {
     body?: never;
     path: {
         /**
-         * Bot ID
+         * Provider ID
          */
         id: string;
     };
     query?: never;
-    url: '/bots/{id}/checks';
+    url: '/memory-providers/{id}';
 };
 
-export type GetBotsByIdChecksErrors = {
+export type DeleteMemoryProvidersByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetBotsByIdChecksError = GetBotsByIdChecksErrors[keyof GetBotsByIdChecksErrors];
+export type DeleteMemoryProvidersByIdError = DeleteMemoryProvidersByIdErrors[keyof DeleteMemoryProvidersByIdErrors];
 
-export type GetBotsByIdChecksResponses = {
+export type DeleteMemoryProvidersByIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: BotsListChecksResponse;
+    204: unknown;
 };
 
-export type GetBotsByIdChecksResponse = GetBotsByIdChecksResponses[keyof GetBotsByIdChecksResponses];
-
-export type PutBotsByIdOwnerData = {
-    /**
-     * Transfer payload
-     */
-    body: BotsTransferBotRequest;
+export type GetMemoryProvidersByIdData = {
+    body?: never;
     path: {
         /**
-         * Bot ID
+         * Provider ID
          */
         id: string;
     };
     query?: never;
-    url: '/bots/{id}/owner';
+    url: '/memory-providers/{id}';
 };
 
-export type PutBotsByIdOwnerErrors = {
+export type GetMemoryProvidersByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type PutBotsByIdOwnerError = PutBotsByIdOwnerErrors[keyof PutBotsByIdOwnerErrors];
+export type GetMemoryProvidersByIdError = GetMemoryProvidersByIdErrors[keyof GetMemoryProvidersByIdErrors];
 
-export type PutBotsByIdOwnerResponses = {
+export type GetMemoryProvidersByIdResponses = {
     /**
      * OK
      */
-    200: BotsBot;
+    200: AdaptersProviderGetResponse;
 };
 
-export type PutBotsByIdOwnerResponse = PutBotsByIdOwnerResponses[keyof PutBotsByIdOwnerResponses];
+export type GetMemoryProvidersByIdResponse = GetMemoryProvidersByIdResponses[keyof GetMemoryProvidersByIdResponses];
 
-export type GetChannelsData = {
-    body?: never;
-    path?: never;
+export type PutMemoryProvidersByIdData = {
+    /**
+     * Updated configuration
+     */
+    body: AdaptersProviderUpdateRequest;
+    path: {
+        /**
+         * Provider ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/channels';
+    url: '/memory-providers/{id}';
 };
 
-export type GetChannelsErrors = {
+export type PutMemoryProvidersByIdErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetChannelsError = GetChannelsErrors[keyof GetChannelsErrors];
+export type PutMemoryProvidersByIdError = PutMemoryProvidersByIdErrors[keyof PutMemoryProvidersByIdErrors];
 
-export type GetChannelsResponses = {
+export type PutMemoryProvidersByIdResponses = {
     /**
      * OK
      */
-    200: Array<HandlersChannelMeta>;
+    200: AdaptersProviderGetResponse;
 };
 
-export type GetChannelsResponse = GetChannelsResponses[keyof GetChannelsResponses];
+export type PutMemoryProvidersByIdResponse = PutMemoryProvidersByIdResponses[keyof PutMemoryProvidersByIdResponses];
 
-export type GetChannelsByPlatformData = {
+export type GetMemoryProvidersByIdStatusData = {
     body?: never;
     path: {
         /**
-         * Channel platform
+         * Provider ID
          */
-        platform: string;
+        id: string;
     };
     query?: never;
-    url: '/channels/{platform}';
+    url: '/memory-providers/{id}/status';
 };
 
-export type GetChannelsByPlatformErrors = {
+export type GetMemoryProvidersByIdStatusErrors = {
     /**
      * Bad Request
      */
@@ -8714,60 +11980,72 @@ export type GetChannelsByPlatformErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetChannelsByPlatformError = GetChannelsByPlatformErrors[keyof GetChannelsByPlatformErrors];
+export type GetMemoryProvidersByIdStatusError = GetMemoryProvidersByIdStatusErrors[keyof GetMemoryProvidersByIdStatusErrors];
 
-export type GetChannelsByPlatformResponses = {
+export type GetMemoryProvidersByIdStatusResponses = {
     /**
      * OK
      */
-    200: HandlersChannelMeta;
+    200: AdaptersProviderStatusResponse;
 };
 
-export type GetChannelsByPlatformResponse = GetChannelsByPlatformResponses[keyof GetChannelsByPlatformResponses];
+export type GetMemoryProvidersByIdStatusResponse = GetMemoryProvidersByIdStatusResponses[keyof GetMemoryProvidersByIdStatusResponses];
 
-export type GetEmailProvidersData = {
+export type GetModelsData = {
     body?: never;
     path?: never;
     query?: {
         /**
-         * Provider type filter
+         * Model type (chat, embedding)
          */
-        provider?: string;
+        type?: string;
+        /**
+         * Provider client type (openai-responses, openai-completions, anthropic-messages, google-generative-ai)
+         */
+        client_type?: string;
     };
-    url: '/email-providers';
+    url: '/models';
 };
 
-export type GetEmailProvidersErrors = {
+export type GetModelsErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetEmailProvidersError = GetEmailProvidersErrors[keyof GetEmailProvidersErrors];
+export type GetModelsError = GetModelsErrors[keyof GetModelsErrors];
 
-export type GetEmailProvidersResponses = {
+export type GetModelsResponses = {
     /**
      * OK
      */
-    200: Array<EmailProviderResponse>;
+    200: Array<ModelsGetResponse>;
 };
 
-export type GetEmailProvidersResponse = GetEmailProvidersResponses[keyof GetEmailProvidersResponses];
+export type GetModelsResponse = GetModelsResponses[keyof GetModelsResponses];
 
-export type PostEmailProvidersData = {
+export type PostModelsData = {
     /**
-     * Email provider configuration
+     * Model configuration
      */
-    body: EmailCreateProviderRequest;
+    body: ModelsAddRequest;
     path?: never;
     query?: never;
-    url: '/email-providers';
+    url: '/models';
 };
 
-export type PostEmailProvidersErrors = {
+export type PostModelsErrors = {
     /**
      * Bad Request
      */
@@ -8778,107 +12056,30 @@ export type PostEmailProvidersErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostEmailProvidersError = PostEmailProvidersErrors[keyof PostEmailProvidersErrors];
+export type PostModelsError = PostModelsErrors[keyof PostModelsErrors];
 
-export type PostEmailProvidersResponses = {
+export type PostModelsResponses = {
     /**
      * Created
      */
-    201: EmailProviderResponse;
+    201: ModelsAddResponse;
 };
 
-export type PostEmailProvidersResponse = PostEmailProvidersResponses[keyof PostEmailProvidersResponses];
+export type PostModelsResponse = PostModelsResponses[keyof PostModelsResponses];
 
-export type GetEmailProvidersMetaData = {
+export type GetModelsCountData = {
     body?: never;
     path?: never;
-    query?: never;
-    url: '/email-providers/meta';
-};
-
-export type GetEmailProvidersMetaResponses = {
-    /**
-     * OK
-     */
-    200: Array<EmailProviderMeta>;
-};
-
-export type GetEmailProvidersMetaResponse = GetEmailProvidersMetaResponses[keyof GetEmailProvidersMetaResponses];
-
-export type DeleteEmailProvidersByIdData = {
-    body?: never;
-    path: {
-        /**
-         * Provider ID
-         */
-        id: string;
-    };
-    query?: never;
-    url: '/email-providers/{id}';
-};
-
-export type DeleteEmailProvidersByIdErrors = {
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
-};
-
-export type DeleteEmailProvidersByIdError = DeleteEmailProvidersByIdErrors[keyof DeleteEmailProvidersByIdErrors];
-
-export type DeleteEmailProvidersByIdResponses = {
-    /**
-     * No Content
-     */
-    204: unknown;
-};
-
-export type GetEmailProvidersByIdData = {
-    body?: never;
-    path: {
-        /**
-         * Provider ID
-         */
-        id: string;
-    };
-    query?: never;
-    url: '/email-providers/{id}';
-};
-
-export type GetEmailProvidersByIdErrors = {
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
-};
-
-export type GetEmailProvidersByIdError = GetEmailProvidersByIdErrors[keyof GetEmailProvidersByIdErrors];
-
-export type GetEmailProvidersByIdResponses = {
-    /**
-     * OK
-     */
-    200: EmailProviderResponse;
-};
-
-export type GetEmailProvidersByIdResponse = GetEmailProvidersByIdResponses[keyof GetEmailProvidersByIdResponses];
-
-export type PutEmailProvidersByIdData = {
-    /**
-     * Updated configuration
-     */
-    body: EmailUpdateProviderRequest;
-    path: {
+    query?: {
         /**
-         * Provider ID
+         * Model type (chat, embedding)
          */
-        id: string;
+        type?: string;
     };
-    query?: never;
-    url: '/email-providers/{id}';
+    url: '/models/count';
 };
 
-export type PutEmailProvidersByIdErrors = {
+export type GetModelsCountErrors = {
     /**
      * Bad Request
      */
@@ -8889,30 +12090,30 @@ export type PutEmailProvidersByIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PutEmailProvidersByIdError = PutEmailProvidersByIdErrors[keyof PutEmailProvidersByIdErrors];
+export type GetModelsCountError = GetModelsCountErrors[keyof GetModelsCountErrors];
 
-export type PutEmailProvidersByIdResponses = {
+export type GetModelsCountResponses = {
     /**
      * OK
      */
-    200: EmailProviderResponse;
+    200: ModelsCountResponse;
 };
 
-export type PutEmailProvidersByIdResponse = PutEmailProvidersByIdResponses[keyof PutEmailProvidersByIdResponses];
+export type GetModelsCountResponse = GetModelsCountResponses[keyof GetModelsCountResponses];
 
-export type GetEmailProvidersByIdOauthAuthorizeData = {
+export type DeleteModelsModelByModelIdData = {
     body?: never;
     path: {
         /**
-         * Email provider ID
+         * Model ID (e.g., gpt-4)
          */
-        id: string;
+        modelId: string;
     };
     query?: never;
-    url: '/email-providers/{id}/oauth/authorize';
+    url: '/models/model/{modelId}';
 };
 
-export type GetEmailProvidersByIdOauthAuthorizeErrors = {
+export type DeleteModelsModelByModelIdErrors = {
     /**
      * Bad Request
      */
@@ -8921,34 +12122,34 @@ export type GetEmailProvidersByIdOauthAuthorizeErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetEmailProvidersByIdOauthAuthorizeError = GetEmailProvidersByIdOauthAuthorizeErrors[keyof GetEmailProvidersByIdOauthAuthorizeErrors];
+export type DeleteModelsModelByModelIdError = DeleteModelsModelByModelIdErrors[keyof DeleteModelsModelByModelIdErrors];
 
-export type GetEmailProvidersByIdOauthAuthorizeResponses = {
+export type DeleteModelsModelByModelIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: {
-        [key: string]: string;
-    };
+    204: unknown;
 };
 
-export type GetEmailProvidersByIdOauthAuthorizeResponse = GetEmailProvidersByIdOauthAuthorizeResponses[keyof GetEmailProvidersByIdOauthAuthorizeResponses];
-
-export type GetEmailProvidersByIdOauthStatusData = {
+export type GetModelsModelByModelIdData = {
     body?: never;
     path: {
         /**
-         * Email provider ID
+         * Model ID (e.g., gpt-4)
          */
-        id: string;
+        modelId: string;
     };
     query?: never;
-    url: '/email-providers/{id}/oauth/status';
+    url: '/models/model/{modelId}';
 };
 
-export type GetEmailProvidersByIdOauthStatusErrors = {
+export type GetModelsModelByModelIdErrors = {
     /**
      * Bad Request
      */
@@ -8957,32 +12158,39 @@ export type GetEmailProvidersByIdOauthStatusErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetEmailProvidersByIdOauthStatusError = GetEmailProvidersByIdOauthStatusErrors[keyof GetEmailProvidersByIdOauthStatusErrors];
+export type GetModelsModelByModelIdError = GetModelsModelByModelIdErrors[keyof GetModelsModelByModelIdErrors];
 
-export type GetEmailProvidersByIdOauthStatusResponses = {
+export type GetModelsModelByModelIdResponses = {
     /**
      * OK
      */
-    200: HandlersEmailOAuthStatusResponse;
+    200: ModelsGetResponse;
 };
 
-export type GetEmailProvidersByIdOauthStatusResponse = GetEmailProvidersByIdOauthStatusResponses[keyof GetEmailProvidersByIdOauthStatusResponses];
+export type GetModelsModelByModelIdResponse = GetModelsModelByModelIdResponses[keyof GetModelsModelByModelIdResponses];
 
-export type DeleteEmailProvidersByIdOauthTokenData = {
-    body?: never;
+export type PutModelsModelByModelIdData = {
+    /**
+     * Updated model configuration
+     */
+    body: ModelsUpdateRequest;
     path: {
         /**
-         * Email provider ID
+         * Model ID (e.g., gpt-4)
          */
-        id: string;
+        modelId: string;
     };
     query?: never;
-    url: '/email-providers/{id}/oauth/token';
+    url: '/models/model/{modelId}';
 };
 
-export type DeleteEmailProvidersByIdOauthTokenErrors = {
+export type PutModelsModelByModelIdErrors = {
     /**
      * Bad Request
      */
@@ -8991,428 +12199,401 @@ export type DeleteEmailProvidersByIdOauthTokenErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type DeleteEmailProvidersByIdOauthTokenError = DeleteEmailProvidersByIdOauthTokenErrors[keyof DeleteEmailProvidersByIdOauthTokenErrors];
+export type PutModelsModelByModelIdError = PutModelsModelByModelIdErrors[keyof PutModelsModelByModelIdErrors];
 
-export type DeleteEmailProvidersByIdOauthTokenResponses = {
+export type PutModelsModelByModelIdResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: ModelsGetResponse;
 };
 
-export type PostEmailMailgunWebhookByConfigIdData = {
+export type PutModelsModelByModelIdResponse = PutModelsModelByModelIdResponses[keyof PutModelsModelByModelIdResponses];
+
+export type DeleteModelsByIdData = {
     body?: never;
     path: {
         /**
-         * Email provider config ID
+         * Model internal ID (UUID)
          */
-        config_id: string;
+        id: string;
     };
     query?: never;
-    url: '/email/mailgun/webhook/{config_id}';
+    url: '/models/{id}';
 };
 
-export type PostEmailMailgunWebhookByConfigIdErrors = {
+export type DeleteModelsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Forbidden
+     * Not Found
      */
-    403: HandlersErrorResponse;
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostEmailMailgunWebhookByConfigIdError = PostEmailMailgunWebhookByConfigIdErrors[keyof PostEmailMailgunWebhookByConfigIdErrors];
+export type DeleteModelsByIdError = DeleteModelsByIdErrors[keyof DeleteModelsByIdErrors];
 
-export type PostEmailMailgunWebhookByConfigIdResponses = {
+export type DeleteModelsByIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: {
-        [key: string]: string;
-    };
+    204: unknown;
 };
 
-export type PostEmailMailgunWebhookByConfigIdResponse = PostEmailMailgunWebhookByConfigIdResponses[keyof PostEmailMailgunWebhookByConfigIdResponses];
-
-export type GetEmailOauthCallbackData = {
+export type GetModelsByIdData = {
     body?: never;
-    path?: never;
-    query: {
-        /**
-         * Authorization code
-         */
-        code: string;
+    path: {
         /**
-         * State parameter
+         * Model internal ID (UUID)
          */
-        state: string;
+        id: string;
     };
-    url: '/email/oauth/callback';
+    query?: never;
+    url: '/models/{id}';
 };
 
-export type GetEmailOauthCallbackErrors = {
+export type GetModelsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
-};
-
-export type GetEmailOauthCallbackError = GetEmailOauthCallbackErrors[keyof GetEmailOauthCallbackErrors];
-
-export type GetEmailOauthCallbackResponses = {
-    /**
-     * OK
+     * Not Found
      */
-    200: {
-        [key: string]: string;
-    };
-};
-
-export type GetEmailOauthCallbackResponse = GetEmailOauthCallbackResponses[keyof GetEmailOauthCallbackResponses];
-
-export type GetMemoryProvidersData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/memory-providers';
-};
-
-export type GetMemoryProvidersErrors = {
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetMemoryProvidersError = GetMemoryProvidersErrors[keyof GetMemoryProvidersErrors];
+export type GetModelsByIdError = GetModelsByIdErrors[keyof GetModelsByIdErrors];
 
-export type GetMemoryProvidersResponses = {
+export type GetModelsByIdResponses = {
     /**
      * OK
      */
-    200: Array<AdaptersProviderGetResponse>;
+    200: ModelsGetResponse;
 };
 
-export type GetMemoryProvidersResponse = GetMemoryProvidersResponses[keyof GetMemoryProvidersResponses];
+export type GetModelsByIdResponse = GetModelsByIdResponses[keyof GetModelsByIdResponses];
 
-export type PostMemoryProvidersData = {
+export type PutModelsByIdData = {
     /**
-     * Memory provider configuration
+     * Updated model configuration
      */
-    body: AdaptersProviderCreateRequest;
-    path?: never;
+    body: ModelsUpdateRequest;
+    path: {
+        /**
+         * Model internal ID (UUID)
+         */
+        id: string;
+    };
     query?: never;
-    url: '/memory-providers';
+    url: '/models/{id}';
 };
 
-export type PostMemoryProvidersErrors = {
+export type PutModelsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
-};
-
-export type PostMemoryProvidersError = PostMemoryProvidersErrors[keyof PostMemoryProvidersErrors];
-
-export type PostMemoryProvidersResponses = {
+    404: HandlersErrorResponse;
     /**
-     * Created
+     * Internal Server Error
      */
-    201: AdaptersProviderGetResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostMemoryProvidersResponse = PostMemoryProvidersResponses[keyof PostMemoryProvidersResponses];
-
-export type GetMemoryProvidersMetaData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/memory-providers/meta';
-};
+export type PutModelsByIdError = PutModelsByIdErrors[keyof PutModelsByIdErrors];
 
-export type GetMemoryProvidersMetaResponses = {
+export type PutModelsByIdResponses = {
     /**
      * OK
      */
-    200: Array<AdaptersProviderMeta>;
+    200: ModelsGetResponse;
 };
 
-export type GetMemoryProvidersMetaResponse = GetMemoryProvidersMetaResponses[keyof GetMemoryProvidersMetaResponses];
+export type PutModelsByIdResponse = PutModelsByIdResponses[keyof PutModelsByIdResponses];
 
-export type DeleteMemoryProvidersByIdData = {
+export type PostModelsByIdTestData = {
     body?: never;
     path: {
         /**
-         * Provider ID
+         * Model internal ID (UUID)
          */
         id: string;
     };
     query?: never;
-    url: '/memory-providers/{id}';
+    url: '/models/{id}/test';
 };
 
-export type DeleteMemoryProvidersByIdErrors = {
+export type PostModelsByIdTestErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteMemoryProvidersByIdError = DeleteMemoryProvidersByIdErrors[keyof DeleteMemoryProvidersByIdErrors];
+export type PostModelsByIdTestError = PostModelsByIdTestErrors[keyof PostModelsByIdTestErrors];
 
-export type DeleteMemoryProvidersByIdResponses = {
+export type PostModelsByIdTestResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: ModelsTestResponse;
 };
 
-export type GetMemoryProvidersByIdData = {
+export type PostModelsByIdTestResponse = PostModelsByIdTestResponses[keyof PostModelsByIdTestResponses];
+
+export type GetOauthMcpCallbackData = {
     body?: never;
-    path: {
+    path?: never;
+    query?: {
         /**
-         * Provider ID
+         * Authorization code
          */
-        id: string;
+        code?: string;
+        /**
+         * State parameter
+         */
+        state?: string;
+        /**
+         * OAuth error
+         */
+        error?: string;
+        /**
+         * OAuth error description
+         */
+        error_description?: string;
     };
-    query?: never;
-    url: '/memory-providers/{id}';
+    url: '/oauth/mcp/callback';
 };
 
-export type GetMemoryProvidersByIdErrors = {
+export type GetOauthMcpCallbackErrors = {
     /**
-     * Bad Request
+     * HTML error page
      */
-    400: HandlersErrorResponse;
+    400: string;
+};
+
+export type GetOauthMcpCallbackError = GetOauthMcpCallbackErrors[keyof GetOauthMcpCallbackErrors];
+
+export type GetOauthMcpCallbackResponses = {
     /**
-     * Not Found
+     * HTML result page
      */
-    404: HandlersErrorResponse;
+    200: string;
 };
 
-export type GetMemoryProvidersByIdError = GetMemoryProvidersByIdErrors[keyof GetMemoryProvidersByIdErrors];
+export type GetOauthMcpCallbackResponse = GetOauthMcpCallbackResponses[keyof GetOauthMcpCallbackResponses];
 
-export type GetMemoryProvidersByIdResponses = {
+export type GetPingData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/ping';
+};
+
+export type GetPingResponses = {
     /**
      * OK
      */
-    200: AdaptersProviderGetResponse;
+    200: HandlersPingResponse;
 };
 
-export type GetMemoryProvidersByIdResponse = GetMemoryProvidersByIdResponses[keyof GetMemoryProvidersByIdResponses];
+export type GetPingResponse = GetPingResponses[keyof GetPingResponses];
 
-export type PutMemoryProvidersByIdData = {
-    /**
-     * Updated configuration
-     */
-    body: AdaptersProviderUpdateRequest;
-    path: {
-        /**
-         * Provider ID
-         */
-        id: string;
-    };
+export type GetProvidersData = {
+    body?: never;
+    path?: never;
     query?: never;
-    url: '/memory-providers/{id}';
+    url: '/providers';
 };
 
-export type PutMemoryProvidersByIdErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetProvidersErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutMemoryProvidersByIdError = PutMemoryProvidersByIdErrors[keyof PutMemoryProvidersByIdErrors];
+export type GetProvidersError = GetProvidersErrors[keyof GetProvidersErrors];
 
-export type PutMemoryProvidersByIdResponses = {
+export type GetProvidersResponses = {
     /**
      * OK
      */
-    200: AdaptersProviderGetResponse;
+    200: Array<ProvidersGetResponse>;
 };
 
-export type PutMemoryProvidersByIdResponse = PutMemoryProvidersByIdResponses[keyof PutMemoryProvidersByIdResponses];
+export type GetProvidersResponse = GetProvidersResponses[keyof GetProvidersResponses];
 
-export type GetMemoryProvidersByIdStatusData = {
-    body?: never;
-    path: {
-        /**
-         * Provider ID
-         */
-        id: string;
-    };
+export type PostProvidersData = {
+    /**
+     * Provider configuration
+     */
+    body: ProvidersCreateRequest;
+    path?: never;
     query?: never;
-    url: '/memory-providers/{id}/status';
+    url: '/providers';
 };
 
-export type GetMemoryProvidersByIdStatusErrors = {
+export type PostProvidersErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetMemoryProvidersByIdStatusError = GetMemoryProvidersByIdStatusErrors[keyof GetMemoryProvidersByIdStatusErrors];
+export type PostProvidersError = PostProvidersErrors[keyof PostProvidersErrors];
 
-export type GetMemoryProvidersByIdStatusResponses = {
+export type PostProvidersResponses = {
     /**
-     * OK
+     * Created
      */
-    200: AdaptersProviderStatusResponse;
+    201: ProvidersGetResponse;
 };
 
-export type GetMemoryProvidersByIdStatusResponse = GetMemoryProvidersByIdStatusResponses[keyof GetMemoryProvidersByIdStatusResponses];
+export type PostProvidersResponse = PostProvidersResponses[keyof PostProvidersResponses];
 
-export type GetModelsData = {
+export type GetProvidersCountData = {
     body?: never;
     path?: never;
-    query?: {
-        /**
-         * Model type (chat, embedding)
-         */
-        type?: string;
-        /**
-         * Provider client type (openai-responses, openai-completions, anthropic-messages, google-generative-ai)
-         */
-        client_type?: string;
-    };
-    url: '/models';
+    query?: never;
+    url: '/providers/count';
 };
 
-export type GetModelsErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetProvidersCountErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetModelsError = GetModelsErrors[keyof GetModelsErrors];
+export type GetProvidersCountError = GetProvidersCountErrors[keyof GetProvidersCountErrors];
 
-export type GetModelsResponses = {
+export type GetProvidersCountResponses = {
     /**
      * OK
      */
-    200: Array<ModelsGetResponse>;
+    200: ProvidersCountResponse;
 };
 
-export type GetModelsResponse = GetModelsResponses[keyof GetModelsResponses];
+export type GetProvidersCountResponse = GetProvidersCountResponses[keyof GetProvidersCountResponses];
 
-export type PostModelsData = {
-    /**
-     * Model configuration
-     */
-    body: ModelsAddRequest;
-    path?: never;
+export type GetProvidersNameByNameData = {
+    body?: never;
+    path: {
+        /**
+         * Provider name
+         */
+        name: string;
+    };
     query?: never;
-    url: '/models';
+    url: '/providers/name/{name}';
 };
 
-export type PostModelsErrors = {
+export type GetProvidersNameByNameErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostModelsError = PostModelsErrors[keyof PostModelsErrors];
+export type GetProvidersNameByNameError = GetProvidersNameByNameErrors[keyof GetProvidersNameByNameErrors];
 
-export type PostModelsResponses = {
+export type GetProvidersNameByNameResponses = {
     /**
-     * Created
+     * OK
      */
-    201: ModelsAddResponse;
+    200: ProvidersGetResponse;
 };
 
-export type PostModelsResponse = PostModelsResponses[keyof PostModelsResponses];
+export type GetProvidersNameByNameResponse = GetProvidersNameByNameResponses[keyof GetProvidersNameByNameResponses];
 
-export type GetModelsCountData = {
+export type GetProvidersOauthCallbackData = {
     body?: never;
     path?: never;
-    query?: {
+    query: {
         /**
-         * Model type (chat, embedding)
+         * Authorization code
          */
-        type?: string;
+        code: string;
+        /**
+         * State parameter
+         */
+        state: string;
     };
-    url: '/models/count';
+    url: '/providers/oauth/callback';
 };
 
-export type GetModelsCountErrors = {
+export type GetProvidersOauthCallbackErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type GetModelsCountError = GetModelsCountErrors[keyof GetModelsCountErrors];
+export type GetProvidersOauthCallbackError = GetProvidersOauthCallbackErrors[keyof GetProvidersOauthCallbackErrors];
 
-export type GetModelsCountResponses = {
+export type GetProvidersOauthCallbackResponses = {
     /**
-     * OK
+     * HTML success page
      */
-    200: ModelsCountResponse;
+    200: string;
 };
 
-export type GetModelsCountResponse = GetModelsCountResponses[keyof GetModelsCountResponses];
+export type GetProvidersOauthCallbackResponse = GetProvidersOauthCallbackResponses[keyof GetProvidersOauthCallbackResponses];
 
-export type DeleteModelsModelByModelIdData = {
+export type DeleteProvidersByIdData = {
     body?: never;
     path: {
         /**
-         * Model ID (e.g., gpt-4)
+         * Provider ID (UUID)
          */
-        modelId: string;
+        id: string;
     };
     query?: never;
-    url: '/models/model/{modelId}';
+    url: '/providers/{id}';
 };
 
-export type DeleteModelsModelByModelIdErrors = {
+export type DeleteProvidersByIdErrors = {
     /**
      * Bad Request
      */
@@ -9427,28 +12608,28 @@ export type DeleteModelsModelByModelIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type DeleteModelsModelByModelIdError = DeleteModelsModelByModelIdErrors[keyof DeleteModelsModelByModelIdErrors];
+export type DeleteProvidersByIdError = DeleteProvidersByIdErrors[keyof DeleteProvidersByIdErrors];
 
-export type DeleteModelsModelByModelIdResponses = {
+export type DeleteProvidersByIdResponses = {
     /**
      * No Content
      */
     204: unknown;
 };
 
-export type GetModelsModelByModelIdData = {
+export type GetProvidersByIdData = {
     body?: never;
     path: {
         /**
-         * Model ID (e.g., gpt-4)
+         * Provider ID (UUID)
          */
-        modelId: string;
+        id: string;
     };
     query?: never;
-    url: '/models/model/{modelId}';
+    url: '/providers/{id}';
 };
 
-export type GetModelsModelByModelIdErrors = {
+export type GetProvidersByIdErrors = {
     /**
      * Bad Request
      */
@@ -9463,33 +12644,33 @@ export type GetModelsModelByModelIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetModelsModelByModelIdError = GetModelsModelByModelIdErrors[keyof GetModelsModelByModelIdErrors];
+export type GetProvidersByIdError = GetProvidersByIdErrors[keyof GetProvidersByIdErrors];
 
-export type GetModelsModelByModelIdResponses = {
+export type GetProvidersByIdResponses = {
     /**
      * OK
      */
-    200: ModelsGetResponse;
+    200: ProvidersGetResponse;
 };
 
-export type GetModelsModelByModelIdResponse = GetModelsModelByModelIdResponses[keyof GetModelsModelByModelIdResponses];
+export type GetProvidersByIdResponse = GetProvidersByIdResponses[keyof GetProvidersByIdResponses];
 
-export type PutModelsModelByModelIdData = {
+export type PutProvidersByIdData = {
     /**
-     * Updated model configuration
+     * Updated provider configuration
      */
-    body: ModelsUpdateRequest;
+    body: ProvidersUpdateRequest;
     path: {
         /**
-         * Model ID (e.g., gpt-4)
+         * Provider ID (UUID)
          */
-        modelId: string;
+        id: string;
     };
     query?: never;
-    url: '/models/model/{modelId}';
+    url: '/providers/{id}';
 };
 
-export type PutModelsModelByModelIdErrors = {
+export type PutProvidersByIdErrors = {
     /**
      * Bad Request
      */
@@ -9504,30 +12685,30 @@ export type PutModelsModelByModelIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PutModelsModelByModelIdError = PutModelsModelByModelIdErrors[keyof PutModelsModelByModelIdErrors];
+export type PutProvidersByIdError = PutProvidersByIdErrors[keyof PutProvidersByIdErrors];
 
-export type PutModelsModelByModelIdResponses = {
+export type PutProvidersByIdResponses = {
     /**
      * OK
      */
-    200: ModelsGetResponse;
+    200: ProvidersGetResponse;
 };
 
-export type PutModelsModelByModelIdResponse = PutModelsModelByModelIdResponses[keyof PutModelsModelByModelIdResponses];
+export type PutProvidersByIdResponse = PutProvidersByIdResponses[keyof PutProvidersByIdResponses];
 
-export type DeleteModelsByIdData = {
+export type PostProvidersByIdImportModelsData = {
     body?: never;
     path: {
         /**
-         * Model internal ID (UUID)
+         * Provider ID (UUID)
          */
         id: string;
     };
     query?: never;
-    url: '/models/{id}';
+    url: '/providers/{id}/import-models';
 };
 
-export type DeleteModelsByIdErrors = {
+export type PostProvidersByIdImportModelsErrors = {
     /**
      * Bad Request
      */
@@ -9542,28 +12723,35 @@ export type DeleteModelsByIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type DeleteModelsByIdError = DeleteModelsByIdErrors[keyof DeleteModelsByIdErrors];
+export type PostProvidersByIdImportModelsError = PostProvidersByIdImportModelsErrors[keyof PostProvidersByIdImportModelsErrors];
 
-export type DeleteModelsByIdResponses = {
+export type PostProvidersByIdImportModelsResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: ProvidersImportModelsResponse;
 };
 
-export type GetModelsByIdData = {
+export type PostProvidersByIdImportModelsResponse = PostProvidersByIdImportModelsResponses[keyof PostProvidersByIdImportModelsResponses];
+
+export type GetProvidersByIdModelsData = {
     body?: never;
     path: {
         /**
-         * Model internal ID (UUID)
+         * Provider ID (UUID)
          */
         id: string;
     };
-    query?: never;
-    url: '/models/{id}';
+    query?: {
+        /**
+         * Model type (chat, embedding)
+         */
+        type?: string;
+    };
+    url: '/providers/{id}/models';
 };
 
-export type GetModelsByIdErrors = {
+export type GetProvidersByIdModelsErrors = {
     /**
      * Bad Request
      */
@@ -9578,33 +12766,30 @@ export type GetModelsByIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetModelsByIdError = GetModelsByIdErrors[keyof GetModelsByIdErrors];
+export type GetProvidersByIdModelsError = GetProvidersByIdModelsErrors[keyof GetProvidersByIdModelsErrors];
 
-export type GetModelsByIdResponses = {
+export type GetProvidersByIdModelsResponses = {
     /**
      * OK
      */
-    200: ModelsGetResponse;
+    200: Array<ModelsGetResponse>;
 };
 
-export type GetModelsByIdResponse = GetModelsByIdResponses[keyof GetModelsByIdResponses];
+export type GetProvidersByIdModelsResponse = GetProvidersByIdModelsResponses[keyof GetProvidersByIdModelsResponses];
 
-export type PutModelsByIdData = {
-    /**
-     * Updated model configuration
-     */
-    body: ModelsUpdateRequest;
+export type GetProvidersByIdOauthAuthorizeData = {
+    body?: never;
     path: {
         /**
-         * Model internal ID (UUID)
+         * Provider ID (UUID)
          */
         id: string;
     };
     query?: never;
-    url: '/models/{id}';
+    url: '/providers/{id}/oauth/authorize';
 };
 
-export type PutModelsByIdErrors = {
+export type GetProvidersByIdOauthAuthorizeErrors = {
     /**
      * Bad Request
      */
@@ -9613,36 +12798,32 @@ export type PutModelsByIdErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type PutModelsByIdError = PutModelsByIdErrors[keyof PutModelsByIdErrors];
+export type GetProvidersByIdOauthAuthorizeError = GetProvidersByIdOauthAuthorizeErrors[keyof GetProvidersByIdOauthAuthorizeErrors];
 
-export type PutModelsByIdResponses = {
+export type GetProvidersByIdOauthAuthorizeResponses = {
     /**
      * OK
      */
-    200: ModelsGetResponse;
+    200: ProvidersOAuthAuthorizeResponse;
 };
 
-export type PutModelsByIdResponse = PutModelsByIdResponses[keyof PutModelsByIdResponses];
+export type GetProvidersByIdOauthAuthorizeResponse = GetProvidersByIdOauthAuthorizeResponses[keyof GetProvidersByIdOauthAuthorizeResponses];
 
-export type PostModelsByIdTestData = {
+export type PostProvidersByIdOauthPollData = {
     body?: never;
     path: {
         /**
-         * Model internal ID (UUID)
+         * Provider ID (UUID)
          */
         id: string;
     };
     query?: never;
-    url: '/models/{id}/test';
+    url: '/providers/{id}/oauth/poll';
 };
 
-export type PostModelsByIdTestErrors = {
+export type PostProvidersByIdOauthPollErrors = {
     /**
      * Bad Request
      */
@@ -9651,284 +12832,246 @@ export type PostModelsByIdTestErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type PostModelsByIdTestError = PostModelsByIdTestErrors[keyof PostModelsByIdTestErrors];
+export type PostProvidersByIdOauthPollError = PostProvidersByIdOauthPollErrors[keyof PostProvidersByIdOauthPollErrors];
 
-export type PostModelsByIdTestResponses = {
+export type PostProvidersByIdOauthPollResponses = {
     /**
      * OK
      */
-    200: ModelsTestResponse;
+    200: ProvidersOAuthStatus;
 };
 
-export type PostModelsByIdTestResponse = PostModelsByIdTestResponses[keyof PostModelsByIdTestResponses];
+export type PostProvidersByIdOauthPollResponse = PostProvidersByIdOauthPollResponses[keyof PostProvidersByIdOauthPollResponses];
 
-export type GetOauthMcpCallbackData = {
+export type GetProvidersByIdOauthStatusData = {
     body?: never;
-    path?: never;
-    query?: {
-        /**
-         * Authorization code
-         */
-        code?: string;
-        /**
-         * State parameter
-         */
-        state?: string;
-        /**
-         * OAuth error
-         */
-        error?: string;
+    path: {
         /**
-         * OAuth error description
+         * Provider ID (UUID)
          */
-        error_description?: string;
+        id: string;
     };
-    url: '/oauth/mcp/callback';
+    query?: never;
+    url: '/providers/{id}/oauth/status';
 };
 
-export type GetOauthMcpCallbackErrors = {
+export type GetProvidersByIdOauthStatusErrors = {
     /**
-     * HTML error page
+     * Bad Request
      */
-    400: string;
-};
-
-export type GetOauthMcpCallbackError = GetOauthMcpCallbackErrors[keyof GetOauthMcpCallbackErrors];
-
-export type GetOauthMcpCallbackResponses = {
+    400: HandlersErrorResponse;
     /**
-     * HTML result page
+     * Not Found
      */
-    200: string;
+    404: HandlersErrorResponse;
 };
 
-export type GetOauthMcpCallbackResponse = GetOauthMcpCallbackResponses[keyof GetOauthMcpCallbackResponses];
-
-export type GetPingData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/ping';
-};
+export type GetProvidersByIdOauthStatusError = GetProvidersByIdOauthStatusErrors[keyof GetProvidersByIdOauthStatusErrors];
 
-export type GetPingResponses = {
+export type GetProvidersByIdOauthStatusResponses = {
     /**
      * OK
      */
-    200: HandlersPingResponse;
+    200: ProvidersOAuthStatus;
 };
 
-export type GetPingResponse = GetPingResponses[keyof GetPingResponses];
+export type GetProvidersByIdOauthStatusResponse = GetProvidersByIdOauthStatusResponses[keyof GetProvidersByIdOauthStatusResponses];
 
-export type GetProvidersData = {
+export type DeleteProvidersByIdOauthTokenData = {
     body?: never;
-    path?: never;
+    path: {
+        /**
+         * Provider ID (UUID)
+         */
+        id: string;
+    };
     query?: never;
-    url: '/providers';
+    url: '/providers/{id}/oauth/token';
 };
 
-export type GetProvidersErrors = {
+export type DeleteProvidersByIdOauthTokenErrors = {
     /**
-     * Internal Server Error
+     * Bad Request
      */
-    500: HandlersErrorResponse;
-};
-
-export type GetProvidersError = GetProvidersErrors[keyof GetProvidersErrors];
-
-export type GetProvidersResponses = {
+    400: HandlersErrorResponse;
     /**
-     * OK
+     * Not Found
      */
-    200: Array<ProvidersGetResponse>;
+    404: HandlersErrorResponse;
 };
 
-export type GetProvidersResponse = GetProvidersResponses[keyof GetProvidersResponses];
+export type DeleteProvidersByIdOauthTokenError = DeleteProvidersByIdOauthTokenErrors[keyof DeleteProvidersByIdOauthTokenErrors];
 
-export type PostProvidersData = {
+export type DeleteProvidersByIdOauthTokenResponses = {
     /**
-     * Provider configuration
+     * No Content
      */
-    body: ProvidersCreateRequest;
-    path?: never;
+    204: unknown;
+};
+
+export type PostProvidersByIdTestData = {
+    body?: never;
+    path: {
+        /**
+         * Provider ID (UUID)
+         */
+        id: string;
+    };
     query?: never;
-    url: '/providers';
+    url: '/providers/{id}/test';
 };
 
-export type PostProvidersErrors = {
+export type PostProvidersByIdTestErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostProvidersError = PostProvidersErrors[keyof PostProvidersErrors];
+export type PostProvidersByIdTestError = PostProvidersByIdTestErrors[keyof PostProvidersByIdTestErrors];
 
-export type PostProvidersResponses = {
+export type PostProvidersByIdTestResponses = {
     /**
-     * Created
+     * OK
      */
-    201: ProvidersGetResponse;
+    200: ProvidersTestResponse;
 };
 
-export type PostProvidersResponse = PostProvidersResponses[keyof PostProvidersResponses];
+export type PostProvidersByIdTestResponse = PostProvidersByIdTestResponses[keyof PostProvidersByIdTestResponses];
 
-export type GetProvidersCountData = {
+export type GetSearchProvidersData = {
     body?: never;
     path?: never;
-    query?: never;
-    url: '/providers/count';
+    query?: {
+        /**
+         * Provider filter (brave)
+         */
+        provider?: string;
+    };
+    url: '/search-providers';
 };
 
-export type GetProvidersCountErrors = {
+export type GetSearchProvidersErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetProvidersCountError = GetProvidersCountErrors[keyof GetProvidersCountErrors];
+export type GetSearchProvidersError = GetSearchProvidersErrors[keyof GetSearchProvidersErrors];
 
-export type GetProvidersCountResponses = {
+export type GetSearchProvidersResponses = {
     /**
      * OK
      */
-    200: ProvidersCountResponse;
+    200: Array<SearchprovidersGetResponse>;
 };
 
-export type GetProvidersCountResponse = GetProvidersCountResponses[keyof GetProvidersCountResponses];
+export type GetSearchProvidersResponse = GetSearchProvidersResponses[keyof GetSearchProvidersResponses];
 
-export type GetProvidersNameByNameData = {
-    body?: never;
-    path: {
-        /**
-         * Provider name
-         */
-        name: string;
-    };
+export type PostSearchProvidersData = {
+    /**
+     * Search provider configuration
+     */
+    body: SearchprovidersCreateRequest;
+    path?: never;
     query?: never;
-    url: '/providers/name/{name}';
+    url: '/search-providers';
 };
 
-export type GetProvidersNameByNameErrors = {
+export type PostSearchProvidersErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetProvidersNameByNameError = GetProvidersNameByNameErrors[keyof GetProvidersNameByNameErrors];
+export type PostSearchProvidersError = PostSearchProvidersErrors[keyof PostSearchProvidersErrors];
 
-export type GetProvidersNameByNameResponses = {
+export type PostSearchProvidersResponses = {
     /**
-     * OK
+     * Created
      */
-    200: ProvidersGetResponse;
+    201: SearchprovidersGetResponse;
 };
 
-export type GetProvidersNameByNameResponse = GetProvidersNameByNameResponses[keyof GetProvidersNameByNameResponses];
+export type PostSearchProvidersResponse = PostSearchProvidersResponses[keyof PostSearchProvidersResponses];
 
-export type GetProvidersOauthCallbackData = {
+export type GetSearchProvidersMetaData = {
     body?: never;
     path?: never;
-    query: {
-        /**
-         * Authorization code
-         */
-        code: string;
-        /**
-         * State parameter
-         */
-        state: string;
-    };
-    url: '/providers/oauth/callback';
-};
-
-export type GetProvidersOauthCallbackErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+    query?: never;
+    url: '/search-providers/meta';
 };
 
-export type GetProvidersOauthCallbackError = GetProvidersOauthCallbackErrors[keyof GetProvidersOauthCallbackErrors];
-
-export type GetProvidersOauthCallbackResponses = {
+export type GetSearchProvidersMetaResponses = {
     /**
-     * HTML success page
+     * OK
      */
-    200: string;
+    200: Array<SearchprovidersProviderMeta>;
 };
 
-export type GetProvidersOauthCallbackResponse = GetProvidersOauthCallbackResponses[keyof GetProvidersOauthCallbackResponses];
+export type GetSearchProvidersMetaResponse = GetSearchProvidersMetaResponses[keyof GetSearchProvidersMetaResponses];
 
-export type DeleteProvidersByIdData = {
+export type DeleteSearchProvidersByIdData = {
     body?: never;
     path: {
         /**
-         * Provider ID (UUID)
+         * Provider ID
          */
         id: string;
     };
     query?: never;
-    url: '/providers/{id}';
+    url: '/search-providers/{id}';
 };
 
-export type DeleteProvidersByIdErrors = {
+export type DeleteSearchProvidersByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type DeleteProvidersByIdError = DeleteProvidersByIdErrors[keyof DeleteProvidersByIdErrors];
+export type DeleteSearchProvidersByIdError = DeleteSearchProvidersByIdErrors[keyof DeleteSearchProvidersByIdErrors];
 
-export type DeleteProvidersByIdResponses = {
+export type DeleteSearchProvidersByIdResponses = {
     /**
      * No Content
      */
     204: unknown;
 };
 
-export type GetProvidersByIdData = {
+export type GetSearchProvidersByIdData = {
     body?: never;
     path: {
         /**
-         * Provider ID (UUID)
+         * Provider ID
          */
         id: string;
     };
     query?: never;
-    url: '/providers/{id}';
+    url: '/search-providers/{id}';
 };
 
-export type GetProvidersByIdErrors = {
+export type GetSearchProvidersByIdErrors = {
     /**
      * Bad Request
      */
@@ -9937,214 +13080,255 @@ export type GetProvidersByIdErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type GetProvidersByIdError = GetProvidersByIdErrors[keyof GetProvidersByIdErrors];
+export type GetSearchProvidersByIdError = GetSearchProvidersByIdErrors[keyof GetSearchProvidersByIdErrors];
 
-export type GetProvidersByIdResponses = {
+export type GetSearchProvidersByIdResponses = {
     /**
      * OK
      */
-    200: ProvidersGetResponse;
+    200: SearchprovidersGetResponse;
 };
 
-export type GetProvidersByIdResponse = GetProvidersByIdResponses[keyof GetProvidersByIdResponses];
+export type GetSearchProvidersByIdResponse = GetSearchProvidersByIdResponses[keyof GetSearchProvidersByIdResponses];
 
-export type PutProvidersByIdData = {
+export type PutSearchProvidersByIdData = {
     /**
-     * Updated provider configuration
+     * Updated configuration
      */
-    body: ProvidersUpdateRequest;
+    body: SearchprovidersUpdateRequest;
     path: {
         /**
-         * Provider ID (UUID)
+         * Provider ID
          */
         id: string;
     };
     query?: never;
-    url: '/providers/{id}';
+    url: '/search-providers/{id}';
 };
 
-export type PutProvidersByIdErrors = {
+export type PutSearchProvidersByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
+};
+
+export type PutSearchProvidersByIdError = PutSearchProvidersByIdErrors[keyof PutSearchProvidersByIdErrors];
+
+export type PutSearchProvidersByIdResponses = {
+    /**
+     * OK
+     */
+    200: SearchprovidersGetResponse;
+};
+
+export type PutSearchProvidersByIdResponse = PutSearchProvidersByIdResponses[keyof PutSearchProvidersByIdResponses];
+
+export type GetSpeechModelsData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/speech-models';
+};
+
+export type GetSpeechModelsErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutProvidersByIdError = PutProvidersByIdErrors[keyof PutProvidersByIdErrors];
+export type GetSpeechModelsError = GetSpeechModelsErrors[keyof GetSpeechModelsErrors];
 
-export type PutProvidersByIdResponses = {
+export type GetSpeechModelsResponses = {
     /**
      * OK
      */
-    200: ProvidersGetResponse;
+    200: Array<AudioSpeechModelResponse>;
 };
 
-export type PutProvidersByIdResponse = PutProvidersByIdResponses[keyof PutProvidersByIdResponses];
+export type GetSpeechModelsResponse = GetSpeechModelsResponses[keyof GetSpeechModelsResponses];
 
-export type PostProvidersByIdImportModelsData = {
+export type GetSpeechModelsByIdData = {
     body?: never;
     path: {
         /**
-         * Provider ID (UUID)
+         * Model ID
          */
         id: string;
     };
     query?: never;
-    url: '/providers/{id}/import-models';
+    url: '/speech-models/{id}';
 };
 
-export type PostProvidersByIdImportModelsErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetSpeechModelsByIdErrors = {
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
 };
 
-export type PostProvidersByIdImportModelsError = PostProvidersByIdImportModelsErrors[keyof PostProvidersByIdImportModelsErrors];
+export type GetSpeechModelsByIdError = GetSpeechModelsByIdErrors[keyof GetSpeechModelsByIdErrors];
 
-export type PostProvidersByIdImportModelsResponses = {
+export type GetSpeechModelsByIdResponses = {
     /**
      * OK
      */
-    200: ProvidersImportModelsResponse;
+    200: AudioSpeechModelResponse;
 };
 
-export type PostProvidersByIdImportModelsResponse = PostProvidersByIdImportModelsResponses[keyof PostProvidersByIdImportModelsResponses];
+export type GetSpeechModelsByIdResponse = GetSpeechModelsByIdResponses[keyof GetSpeechModelsByIdResponses];
 
-export type GetProvidersByIdModelsData = {
-    body?: never;
+export type PutSpeechModelsByIdData = {
+    /**
+     * Model update payload
+     */
+    body: AudioUpdateSpeechModelRequest;
     path: {
         /**
-         * Provider ID (UUID)
+         * Model ID
          */
         id: string;
     };
-    query?: {
-        /**
-         * Model type (chat, embedding)
-         */
-        type?: string;
-    };
-    url: '/providers/{id}/models';
+    query?: never;
+    url: '/speech-models/{id}';
 };
 
-export type GetProvidersByIdModelsErrors = {
+export type PutSpeechModelsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetProvidersByIdModelsError = GetProvidersByIdModelsErrors[keyof GetProvidersByIdModelsErrors];
+export type PutSpeechModelsByIdError = PutSpeechModelsByIdErrors[keyof PutSpeechModelsByIdErrors];
 
-export type GetProvidersByIdModelsResponses = {
+export type PutSpeechModelsByIdResponses = {
     /**
      * OK
      */
-    200: Array<ModelsGetResponse>;
+    200: AudioSpeechModelResponse;
 };
 
-export type GetProvidersByIdModelsResponse = GetProvidersByIdModelsResponses[keyof GetProvidersByIdModelsResponses];
+export type PutSpeechModelsByIdResponse = PutSpeechModelsByIdResponses[keyof PutSpeechModelsByIdResponses];
 
-export type GetProvidersByIdOauthAuthorizeData = {
+export type GetSpeechModelsByIdCapabilitiesData = {
     body?: never;
     path: {
         /**
-         * Provider ID (UUID)
+         * Model ID
          */
         id: string;
     };
     query?: never;
-    url: '/providers/{id}/oauth/authorize';
+    url: '/speech-models/{id}/capabilities';
 };
 
-export type GetProvidersByIdOauthAuthorizeErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetSpeechModelsByIdCapabilitiesErrors = {
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
 };
 
-export type GetProvidersByIdOauthAuthorizeError = GetProvidersByIdOauthAuthorizeErrors[keyof GetProvidersByIdOauthAuthorizeErrors];
+export type GetSpeechModelsByIdCapabilitiesError = GetSpeechModelsByIdCapabilitiesErrors[keyof GetSpeechModelsByIdCapabilitiesErrors];
 
-export type GetProvidersByIdOauthAuthorizeResponses = {
+export type GetSpeechModelsByIdCapabilitiesResponses = {
     /**
      * OK
      */
-    200: ProvidersOAuthAuthorizeResponse;
+    200: AudioModelCapabilities;
 };
 
-export type GetProvidersByIdOauthAuthorizeResponse = GetProvidersByIdOauthAuthorizeResponses[keyof GetProvidersByIdOauthAuthorizeResponses];
+export type GetSpeechModelsByIdCapabilitiesResponse = GetSpeechModelsByIdCapabilitiesResponses[keyof GetSpeechModelsByIdCapabilitiesResponses];
 
-export type PostProvidersByIdOauthPollData = {
-    body?: never;
+export type PostSpeechModelsByIdTestData = {
+    /**
+     * Text to synthesize
+     */
+    body: AudioTestSynthesizeRequest;
     path: {
         /**
-         * Provider ID (UUID)
+         * Model ID
          */
         id: string;
     };
     query?: never;
-    url: '/providers/{id}/oauth/poll';
+    url: '/speech-models/{id}/test';
 };
 
-export type PostProvidersByIdOauthPollErrors = {
+export type PostSpeechModelsByIdTestErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Internal Server Error
      */
-    404: HandlersErrorResponse;
+    500: HandlersErrorResponse;
 };
 
-export type PostProvidersByIdOauthPollError = PostProvidersByIdOauthPollErrors[keyof PostProvidersByIdOauthPollErrors];
+export type PostSpeechModelsByIdTestError = PostSpeechModelsByIdTestErrors[keyof PostSpeechModelsByIdTestErrors];
 
-export type PostProvidersByIdOauthPollResponses = {
+export type PostSpeechModelsByIdTestResponses = {
+    /**
+     * Audio data
+     */
+    200: unknown;
+};
+
+export type GetSpeechProvidersData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/speech-providers';
+};
+
+export type GetSpeechProvidersErrors = {
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
+};
+
+export type GetSpeechProvidersError = GetSpeechProvidersErrors[keyof GetSpeechProvidersErrors];
+
+export type GetSpeechProvidersResponses = {
     /**
      * OK
      */
-    200: ProvidersOAuthStatus;
+    200: Array<AudioSpeechProviderResponse>;
 };
 
-export type PostProvidersByIdOauthPollResponse = PostProvidersByIdOauthPollResponses[keyof PostProvidersByIdOauthPollResponses];
+export type GetSpeechProvidersResponse = GetSpeechProvidersResponses[keyof GetSpeechProvidersResponses];
 
-export type GetProvidersByIdOauthStatusData = {
+export type GetSpeechProvidersMetaData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/speech-providers/meta';
+};
+
+export type GetSpeechProvidersMetaResponses = {
+    /**
+     * OK
+     */
+    200: Array<AudioProviderMetaResponse>;
+};
+
+export type GetSpeechProvidersMetaResponse = GetSpeechProvidersMetaResponses[keyof GetSpeechProvidersMetaResponses];
+
+export type GetSpeechProvidersByIdData = {
     body?: never;
     path: {
         /**
@@ -10153,10 +13337,10 @@ export type GetProvidersByIdOauthStatusData = {
         id: string;
     };
     query?: never;
-    url: '/providers/{id}/oauth/status';
+    url: '/speech-providers/{id}';
 };
 
-export type GetProvidersByIdOauthStatusErrors = {
+export type GetSpeechProvidersByIdErrors = {
     /**
      * Bad Request
      */
@@ -10167,18 +13351,18 @@ export type GetProvidersByIdOauthStatusErrors = {
     404: HandlersErrorResponse;
 };
 
-export type GetProvidersByIdOauthStatusError = GetProvidersByIdOauthStatusErrors[keyof GetProvidersByIdOauthStatusErrors];
+export type GetSpeechProvidersByIdError = GetSpeechProvidersByIdErrors[keyof GetSpeechProvidersByIdErrors];
 
-export type GetProvidersByIdOauthStatusResponses = {
+export type GetSpeechProvidersByIdResponses = {
     /**
      * OK
      */
-    200: ProvidersOAuthStatus;
+    200: AudioSpeechProviderResponse;
 };
 
-export type GetProvidersByIdOauthStatusResponse = GetProvidersByIdOauthStatusResponses[keyof GetProvidersByIdOauthStatusResponses];
+export type GetSpeechProvidersByIdResponse = GetSpeechProvidersByIdResponses[keyof GetSpeechProvidersByIdResponses];
 
-export type DeleteProvidersByIdOauthTokenData = {
+export type PostSpeechProvidersByIdImportModelsData = {
     body?: never;
     path: {
         /**
@@ -10187,10 +13371,10 @@ export type DeleteProvidersByIdOauthTokenData = {
         id: string;
     };
     query?: never;
-    url: '/providers/{id}/oauth/token';
+    url: '/speech-providers/{id}/import-models';
 };
 
-export type DeleteProvidersByIdOauthTokenErrors = {
+export type PostSpeechProvidersByIdImportModelsErrors = {
     /**
      * Bad Request
      */
@@ -10199,18 +13383,24 @@ export type DeleteProvidersByIdOauthTokenErrors = {
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type DeleteProvidersByIdOauthTokenError = DeleteProvidersByIdOauthTokenErrors[keyof DeleteProvidersByIdOauthTokenErrors];
+export type PostSpeechProvidersByIdImportModelsError = PostSpeechProvidersByIdImportModelsErrors[keyof PostSpeechProvidersByIdImportModelsErrors];
 
-export type DeleteProvidersByIdOauthTokenResponses = {
+export type PostSpeechProvidersByIdImportModelsResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: AudioImportModelsResponse;
 };
 
-export type PostProvidersByIdTestData = {
+export type PostSpeechProvidersByIdImportModelsResponse = PostSpeechProvidersByIdImportModelsResponses[keyof PostSpeechProvidersByIdImportModelsResponses];
+
+export type GetSpeechProvidersByIdModelsData = {
     body?: never;
     path: {
         /**
@@ -10219,242 +13409,234 @@ export type PostProvidersByIdTestData = {
         id: string;
     };
     query?: never;
-    url: '/providers/{id}/test';
+    url: '/speech-providers/{id}/models';
 };
 
-export type PostProvidersByIdTestErrors = {
+export type GetSpeechProvidersByIdModelsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostProvidersByIdTestError = PostProvidersByIdTestErrors[keyof PostProvidersByIdTestErrors];
+export type GetSpeechProvidersByIdModelsError = GetSpeechProvidersByIdModelsErrors[keyof GetSpeechProvidersByIdModelsErrors];
 
-export type PostProvidersByIdTestResponses = {
+export type GetSpeechProvidersByIdModelsResponses = {
     /**
      * OK
      */
-    200: ProvidersTestResponse;
+    200: Array<AudioSpeechModelResponse>;
 };
 
-export type PostProvidersByIdTestResponse = PostProvidersByIdTestResponses[keyof PostProvidersByIdTestResponses];
+export type GetSpeechProvidersByIdModelsResponse = GetSpeechProvidersByIdModelsResponses[keyof GetSpeechProvidersByIdModelsResponses];
 
-export type GetSearchProvidersData = {
+export type GetSupermarketPluginsData = {
     body?: never;
     path?: never;
     query?: {
         /**
-         * Provider filter (brave)
+         * Search query
          */
-        provider?: string;
+        q?: string;
+        /**
+         * Filter by tag
+         */
+        tag?: string;
+        /**
+         * Page number
+         */
+        page?: number;
+        /**
+         * Items per page
+         */
+        limit?: number;
     };
-    url: '/search-providers';
+    url: '/supermarket/plugins';
 };
 
-export type GetSearchProvidersErrors = {
+export type GetSupermarketPluginsErrors = {
     /**
-     * Internal Server Error
+     * Bad Gateway
      */
-    500: HandlersErrorResponse;
+    502: HandlersErrorResponse;
 };
 
-export type GetSearchProvidersError = GetSearchProvidersErrors[keyof GetSearchProvidersErrors];
+export type GetSupermarketPluginsError = GetSupermarketPluginsErrors[keyof GetSupermarketPluginsErrors];
 
-export type GetSearchProvidersResponses = {
+export type GetSupermarketPluginsResponses = {
     /**
      * OK
      */
-    200: Array<SearchprovidersGetResponse>;
+    200: HandlersSupermarketPluginListResponse;
 };
 
-export type GetSearchProvidersResponse = GetSearchProvidersResponses[keyof GetSearchProvidersResponses];
+export type GetSupermarketPluginsResponse = GetSupermarketPluginsResponses[keyof GetSupermarketPluginsResponses];
 
-export type PostSearchProvidersData = {
-    /**
-     * Search provider configuration
-     */
-    body: SearchprovidersCreateRequest;
-    path?: never;
+export type GetSupermarketPluginsByIdData = {
+    body?: never;
+    path: {
+        /**
+         * Plugin ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/search-providers';
+    url: '/supermarket/plugins/{id}';
 };
 
-export type PostSearchProvidersErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetSupermarketPluginsByIdErrors = {
     /**
-     * Internal Server Error
+     * Not Found
      */
-    500: HandlersErrorResponse;
-};
-
-export type PostSearchProvidersError = PostSearchProvidersErrors[keyof PostSearchProvidersErrors];
-
-export type PostSearchProvidersResponses = {
+    404: HandlersErrorResponse;
     /**
-     * Created
+     * Bad Gateway
      */
-    201: SearchprovidersGetResponse;
+    502: HandlersErrorResponse;
 };
 
-export type PostSearchProvidersResponse = PostSearchProvidersResponses[keyof PostSearchProvidersResponses];
-
-export type GetSearchProvidersMetaData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/search-providers/meta';
-};
+export type GetSupermarketPluginsByIdError = GetSupermarketPluginsByIdErrors[keyof GetSupermarketPluginsByIdErrors];
 
-export type GetSearchProvidersMetaResponses = {
+export type GetSupermarketPluginsByIdResponses = {
     /**
      * OK
      */
-    200: Array<SearchprovidersProviderMeta>;
+    200: PluginsManifest;
 };
 
-export type GetSearchProvidersMetaResponse = GetSearchProvidersMetaResponses[keyof GetSearchProvidersMetaResponses];
+export type GetSupermarketPluginsByIdResponse = GetSupermarketPluginsByIdResponses[keyof GetSupermarketPluginsByIdResponses];
 
-export type DeleteSearchProvidersByIdData = {
+export type GetSupermarketSkillsData = {
     body?: never;
-    path: {
+    path?: never;
+    query?: {
         /**
-         * Provider ID
+         * Search query
          */
-        id: string;
+        q?: string;
+        /**
+         * Filter by tag
+         */
+        tag?: string;
+        /**
+         * Page number
+         */
+        page?: number;
+        /**
+         * Items per page
+         */
+        limit?: number;
     };
-    query?: never;
-    url: '/search-providers/{id}';
+    url: '/supermarket/skills';
 };
 
-export type DeleteSearchProvidersByIdErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetSupermarketSkillsErrors = {
     /**
-     * Internal Server Error
+     * Bad Gateway
      */
-    500: HandlersErrorResponse;
+    502: HandlersErrorResponse;
 };
 
-export type DeleteSearchProvidersByIdError = DeleteSearchProvidersByIdErrors[keyof DeleteSearchProvidersByIdErrors];
+export type GetSupermarketSkillsError = GetSupermarketSkillsErrors[keyof GetSupermarketSkillsErrors];
 
-export type DeleteSearchProvidersByIdResponses = {
+export type GetSupermarketSkillsResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: HandlersSupermarketSkillListResponse;
 };
 
-export type GetSearchProvidersByIdData = {
+export type GetSupermarketSkillsResponse = GetSupermarketSkillsResponses[keyof GetSupermarketSkillsResponses];
+
+export type GetSupermarketSkillsByIdData = {
     body?: never;
     path: {
         /**
-         * Provider ID
+         * Skill ID
          */
         id: string;
     };
     query?: never;
-    url: '/search-providers/{id}';
+    url: '/supermarket/skills/{id}';
 };
 
-export type GetSearchProvidersByIdErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetSupermarketSkillsByIdErrors = {
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Bad Gateway
+     */
+    502: HandlersErrorResponse;
 };
 
-export type GetSearchProvidersByIdError = GetSearchProvidersByIdErrors[keyof GetSearchProvidersByIdErrors];
+export type GetSupermarketSkillsByIdError = GetSupermarketSkillsByIdErrors[keyof GetSupermarketSkillsByIdErrors];
 
-export type GetSearchProvidersByIdResponses = {
+export type GetSupermarketSkillsByIdResponses = {
     /**
      * OK
      */
-    200: SearchprovidersGetResponse;
+    200: HandlersSupermarketSkillEntry;
 };
 
-export type GetSearchProvidersByIdResponse = GetSearchProvidersByIdResponses[keyof GetSearchProvidersByIdResponses];
+export type GetSupermarketSkillsByIdResponse = GetSupermarketSkillsByIdResponses[keyof GetSupermarketSkillsByIdResponses];
 
-export type PutSearchProvidersByIdData = {
-    /**
-     * Updated configuration
-     */
-    body: SearchprovidersUpdateRequest;
-    path: {
-        /**
-         * Provider ID
-         */
-        id: string;
-    };
+export type GetSupermarketTagsData = {
+    body?: never;
+    path?: never;
     query?: never;
-    url: '/search-providers/{id}';
+    url: '/supermarket/tags';
 };
 
-export type PutSearchProvidersByIdErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
+export type GetSupermarketTagsErrors = {
     /**
-     * Internal Server Error
+     * Bad Gateway
      */
-    500: HandlersErrorResponse;
+    502: HandlersErrorResponse;
 };
 
-export type PutSearchProvidersByIdError = PutSearchProvidersByIdErrors[keyof PutSearchProvidersByIdErrors];
+export type GetSupermarketTagsError = GetSupermarketTagsErrors[keyof GetSupermarketTagsErrors];
 
-export type PutSearchProvidersByIdResponses = {
+export type GetSupermarketTagsResponses = {
     /**
      * OK
      */
-    200: SearchprovidersGetResponse;
+    200: HandlersSupermarketTagsResponse;
 };
 
-export type PutSearchProvidersByIdResponse = PutSearchProvidersByIdResponses[keyof PutSearchProvidersByIdResponses];
+export type GetSupermarketTagsResponse = GetSupermarketTagsResponses[keyof GetSupermarketTagsResponses];
 
-export type GetSpeechModelsData = {
+export type GetTranscriptionModelsData = {
     body?: never;
     path?: never;
     query?: never;
-    url: '/speech-models';
+    url: '/transcription-models';
 };
 
-export type GetSpeechModelsErrors = {
+export type GetTranscriptionModelsErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetSpeechModelsError = GetSpeechModelsErrors[keyof GetSpeechModelsErrors];
+export type GetTranscriptionModelsError = GetTranscriptionModelsErrors[keyof GetTranscriptionModelsErrors];
 
-export type GetSpeechModelsResponses = {
+export type GetTranscriptionModelsResponses = {
     /**
      * OK
      */
-    200: Array<AudioSpeechModelResponse>;
+    200: Array<AudioTranscriptionModelResponse>;
 };
 
-export type GetSpeechModelsResponse = GetSpeechModelsResponses[keyof GetSpeechModelsResponses];
+export type GetTranscriptionModelsResponse = GetTranscriptionModelsResponses[keyof GetTranscriptionModelsResponses];
 
-export type GetSpeechModelsByIdData = {
+export type GetTranscriptionModelsByIdData = {
     body?: never;
     path: {
         /**
@@ -10463,28 +13645,28 @@ export type GetSpeechModelsByIdData = {
         id: string;
     };
     query?: never;
-    url: '/speech-models/{id}';
+    url: '/transcription-models/{id}';
 };
 
-export type GetSpeechModelsByIdErrors = {
+export type GetTranscriptionModelsByIdErrors = {
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
 };
 
-export type GetSpeechModelsByIdError = GetSpeechModelsByIdErrors[keyof GetSpeechModelsByIdErrors];
+export type GetTranscriptionModelsByIdError = GetTranscriptionModelsByIdErrors[keyof GetTranscriptionModelsByIdErrors];
 
-export type GetSpeechModelsByIdResponses = {
+export type GetTranscriptionModelsByIdResponses = {
     /**
      * OK
      */
-    200: AudioSpeechModelResponse;
+    200: AudioTranscriptionModelResponse;
 };
 
-export type GetSpeechModelsByIdResponse = GetSpeechModelsByIdResponses[keyof GetSpeechModelsByIdResponses];
+export type GetTranscriptionModelsByIdResponse = GetTranscriptionModelsByIdResponses[keyof GetTranscriptionModelsByIdResponses];
 
-export type PutSpeechModelsByIdData = {
+export type PutTranscriptionModelsByIdData = {
     /**
      * Model update payload
      */
@@ -10496,10 +13678,10 @@ export type PutSpeechModelsByIdData = {
         id: string;
     };
     query?: never;
-    url: '/speech-models/{id}';
+    url: '/transcription-models/{id}';
 };
 
-export type PutSpeechModelsByIdErrors = {
+export type PutTranscriptionModelsByIdErrors = {
     /**
      * Bad Request
      */
@@ -10510,18 +13692,18 @@ export type PutSpeechModelsByIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PutSpeechModelsByIdError = PutSpeechModelsByIdErrors[keyof PutSpeechModelsByIdErrors];
+export type PutTranscriptionModelsByIdError = PutTranscriptionModelsByIdErrors[keyof PutTranscriptionModelsByIdErrors];
 
-export type PutSpeechModelsByIdResponses = {
+export type PutTranscriptionModelsByIdResponses = {
     /**
      * OK
      */
-    200: AudioSpeechModelResponse;
+    200: AudioTranscriptionModelResponse;
 };
 
-export type PutSpeechModelsByIdResponse = PutSpeechModelsByIdResponses[keyof PutSpeechModelsByIdResponses];
+export type PutTranscriptionModelsByIdResponse = PutTranscriptionModelsByIdResponses[keyof PutTranscriptionModelsByIdResponses];
 
-export type GetSpeechModelsByIdCapabilitiesData = {
+export type GetTranscriptionModelsByIdCapabilitiesData = {
     body?: never;
     path: {
         /**
@@ -10530,32 +13712,38 @@ export type GetSpeechModelsByIdCapabilitiesData = {
         id: string;
     };
     query?: never;
-    url: '/speech-models/{id}/capabilities';
+    url: '/transcription-models/{id}/capabilities';
 };
 
-export type GetSpeechModelsByIdCapabilitiesErrors = {
+export type GetTranscriptionModelsByIdCapabilitiesErrors = {
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
 };
 
-export type GetSpeechModelsByIdCapabilitiesError = GetSpeechModelsByIdCapabilitiesErrors[keyof GetSpeechModelsByIdCapabilitiesErrors];
+export type GetTranscriptionModelsByIdCapabilitiesError = GetTranscriptionModelsByIdCapabilitiesErrors[keyof GetTranscriptionModelsByIdCapabilitiesErrors];
 
-export type GetSpeechModelsByIdCapabilitiesResponses = {
+export type GetTranscriptionModelsByIdCapabilitiesResponses = {
     /**
      * OK
      */
     200: AudioModelCapabilities;
 };
 
-export type GetSpeechModelsByIdCapabilitiesResponse = GetSpeechModelsByIdCapabilitiesResponses[keyof GetSpeechModelsByIdCapabilitiesResponses];
+export type GetTranscriptionModelsByIdCapabilitiesResponse = GetTranscriptionModelsByIdCapabilitiesResponses[keyof GetTranscriptionModelsByIdCapabilitiesResponses];
 
-export type PostSpeechModelsByIdTestData = {
-    /**
-     * Text to synthesize
-     */
-    body: AudioTestSynthesizeRequest;
+export type PostTranscriptionModelsByIdTestData = {
+    body: {
+        /**
+         * Audio file
+         */
+        file: Blob | File;
+        /**
+         * Optional JSON config
+         */
+        config?: string;
+    };
     path: {
         /**
          * Model ID
@@ -10563,10 +13751,10 @@ export type PostSpeechModelsByIdTestData = {
         id: string;
     };
     query?: never;
-    url: '/speech-models/{id}/test';
+    url: '/transcription-models/{id}/test';
 };
 
-export type PostSpeechModelsByIdTestErrors = {
+export type PostTranscriptionModelsByIdTestErrors = {
     /**
      * Bad Request
      */
@@ -10577,57 +13765,59 @@ export type PostSpeechModelsByIdTestErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostSpeechModelsByIdTestError = PostSpeechModelsByIdTestErrors[keyof PostSpeechModelsByIdTestErrors];
+export type PostTranscriptionModelsByIdTestError = PostTranscriptionModelsByIdTestErrors[keyof PostTranscriptionModelsByIdTestErrors];
 
-export type PostSpeechModelsByIdTestResponses = {
+export type PostTranscriptionModelsByIdTestResponses = {
     /**
-     * Audio data
+     * OK
      */
-    200: unknown;
+    200: AudioTestTranscriptionResponse;
 };
 
-export type GetSpeechProvidersData = {
+export type PostTranscriptionModelsByIdTestResponse = PostTranscriptionModelsByIdTestResponses[keyof PostTranscriptionModelsByIdTestResponses];
+
+export type GetTranscriptionProvidersData = {
     body?: never;
     path?: never;
     query?: never;
-    url: '/speech-providers';
+    url: '/transcription-providers';
 };
 
-export type GetSpeechProvidersErrors = {
+export type GetTranscriptionProvidersErrors = {
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetSpeechProvidersError = GetSpeechProvidersErrors[keyof GetSpeechProvidersErrors];
+export type GetTranscriptionProvidersError = GetTranscriptionProvidersErrors[keyof GetTranscriptionProvidersErrors];
 
-export type GetSpeechProvidersResponses = {
+export type GetTranscriptionProvidersResponses = {
     /**
      * OK
      */
     200: Array<AudioSpeechProviderResponse>;
 };
 
-export type GetSpeechProvidersResponse = GetSpeechProvidersResponses[keyof GetSpeechProvidersResponses];
+export type GetTranscriptionProvidersResponse = GetTranscriptionProvidersResponses[keyof GetTranscriptionProvidersResponses];
 
-export type GetSpeechProvidersMetaData = {
+export type GetTranscriptionProvidersMetaData = {
     body?: never;
     path?: never;
     query?: never;
-    url: '/speech-providers/meta';
+    url: '/transcription-providers/meta';
 };
 
-export type GetSpeechProvidersMetaResponses = {
+export type GetTranscriptionProvidersMetaResponses = {
     /**
      * OK
      */
     200: Array<AudioProviderMetaResponse>;
 };
 
-export type GetSpeechProvidersMetaResponse = GetSpeechProvidersMetaResponses[keyof GetSpeechProvidersMetaResponses];
+export type GetTranscriptionProvidersMetaResponse = GetTranscriptionProvidersMetaResponses[keyof GetTranscriptionProvidersMetaResponses];
 
-export type GetSpeechProvidersByIdData = {
+export type GetTranscriptionProvidersByIdData = {
     body?: never;
     path: {
         /**
@@ -10636,10 +13826,10 @@ export type GetSpeechProvidersByIdData = {
         id: string;
     };
     query?: never;
-    url: '/speech-providers/{id}';
+    url: '/transcription-providers/{id}';
 };
 
-export type GetSpeechProvidersByIdErrors = {
+export type GetTranscriptionProvidersByIdErrors = {
     /**
      * Bad Request
      */
@@ -10650,18 +13840,18 @@ export type GetSpeechProvidersByIdErrors = {
     404: HandlersErrorResponse;
 };
 
-export type GetSpeechProvidersByIdError = GetSpeechProvidersByIdErrors[keyof GetSpeechProvidersByIdErrors];
+export type GetTranscriptionProvidersByIdError = GetTranscriptionProvidersByIdErrors[keyof GetTranscriptionProvidersByIdErrors];
 
-export type GetSpeechProvidersByIdResponses = {
+export type GetTranscriptionProvidersByIdResponses = {
     /**
      * OK
      */
     200: AudioSpeechProviderResponse;
 };
 
-export type GetSpeechProvidersByIdResponse = GetSpeechProvidersByIdResponses[keyof GetSpeechProvidersByIdResponses];
+export type GetTranscriptionProvidersByIdResponse = GetTranscriptionProvidersByIdResponses[keyof GetTranscriptionProvidersByIdResponses];
 
-export type PostSpeechProvidersByIdImportModelsData = {
+export type PostTranscriptionProvidersByIdImportModelsData = {
     body?: never;
     path: {
         /**
@@ -10670,10 +13860,10 @@ export type PostSpeechProvidersByIdImportModelsData = {
         id: string;
     };
     query?: never;
-    url: '/speech-providers/{id}/import-models';
+    url: '/transcription-providers/{id}/import-models';
 };
 
-export type PostSpeechProvidersByIdImportModelsErrors = {
+export type PostTranscriptionProvidersByIdImportModelsErrors = {
     /**
      * Bad Request
      */
@@ -10688,18 +13878,18 @@ export type PostSpeechProvidersByIdImportModelsErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostSpeechProvidersByIdImportModelsError = PostSpeechProvidersByIdImportModelsErrors[keyof PostSpeechProvidersByIdImportModelsErrors];
+export type PostTranscriptionProvidersByIdImportModelsError = PostTranscriptionProvidersByIdImportModelsErrors[keyof PostTranscriptionProvidersByIdImportModelsErrors];
 
-export type PostSpeechProvidersByIdImportModelsResponses = {
+export type PostTranscriptionProvidersByIdImportModelsResponses = {
     /**
      * OK
      */
     200: AudioImportModelsResponse;
 };
 
-export type PostSpeechProvidersByIdImportModelsResponse = PostSpeechProvidersByIdImportModelsResponses[keyof PostSpeechProvidersByIdImportModelsResponses];
+export type PostTranscriptionProvidersByIdImportModelsResponse = PostTranscriptionProvidersByIdImportModelsResponses[keyof PostTranscriptionProvidersByIdImportModelsResponses];
 
-export type GetSpeechProvidersByIdModelsData = {
+export type GetTranscriptionProvidersByIdModelsData = {
     body?: never;
     path: {
         /**
@@ -10708,10 +13898,10 @@ export type GetSpeechProvidersByIdModelsData = {
         id: string;
     };
     query?: never;
-    url: '/speech-providers/{id}/models';
+    url: '/transcription-providers/{id}/models';
 };
 
-export type GetSpeechProvidersByIdModelsErrors = {
+export type GetTranscriptionProvidersByIdModelsErrors = {
     /**
      * Bad Request
      */
@@ -10722,265 +13912,126 @@ export type GetSpeechProvidersByIdModelsErrors = {
     500: HandlersErrorResponse;
 };
 
-export type GetSpeechProvidersByIdModelsError = GetSpeechProvidersByIdModelsErrors[keyof GetSpeechProvidersByIdModelsErrors];
-
-export type GetSpeechProvidersByIdModelsResponses = {
-    /**
-     * OK
-     */
-    200: Array<AudioSpeechModelResponse>;
-};
-
-export type GetSpeechProvidersByIdModelsResponse = GetSpeechProvidersByIdModelsResponses[keyof GetSpeechProvidersByIdModelsResponses];
-
-export type GetSupermarketPluginsData = {
-    body?: never;
-    path?: never;
-    query?: {
-        /**
-         * Search query
-         */
-        q?: string;
-        /**
-         * Filter by tag
-         */
-        tag?: string;
-        /**
-         * Page number
-         */
-        page?: number;
-        /**
-         * Items per page
-         */
-        limit?: number;
-    };
-    url: '/supermarket/plugins';
-};
-
-export type GetSupermarketPluginsErrors = {
-    /**
-     * Bad Gateway
-     */
-    502: HandlersErrorResponse;
-};
-
-export type GetSupermarketPluginsError = GetSupermarketPluginsErrors[keyof GetSupermarketPluginsErrors];
-
-export type GetSupermarketPluginsResponses = {
-    /**
-     * OK
-     */
-    200: HandlersSupermarketPluginListResponse;
-};
-
-export type GetSupermarketPluginsResponse = GetSupermarketPluginsResponses[keyof GetSupermarketPluginsResponses];
-
-export type GetSupermarketPluginsByIdData = {
-    body?: never;
-    path: {
-        /**
-         * Plugin ID
-         */
-        id: string;
-    };
-    query?: never;
-    url: '/supermarket/plugins/{id}';
-};
-
-export type GetSupermarketPluginsByIdErrors = {
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
-    /**
-     * Bad Gateway
-     */
-    502: HandlersErrorResponse;
-};
-
-export type GetSupermarketPluginsByIdError = GetSupermarketPluginsByIdErrors[keyof GetSupermarketPluginsByIdErrors];
-
-export type GetSupermarketPluginsByIdResponses = {
-    /**
-     * OK
-     */
-    200: PluginsManifest;
-};
-
-export type GetSupermarketPluginsByIdResponse = GetSupermarketPluginsByIdResponses[keyof GetSupermarketPluginsByIdResponses];
-
-export type GetSupermarketSkillsData = {
-    body?: never;
-    path?: never;
-    query?: {
-        /**
-         * Search query
-         */
-        q?: string;
-        /**
-         * Filter by tag
-         */
-        tag?: string;
-        /**
-         * Page number
-         */
-        page?: number;
-        /**
-         * Items per page
-         */
-        limit?: number;
-    };
-    url: '/supermarket/skills';
-};
-
-export type GetSupermarketSkillsErrors = {
-    /**
-     * Bad Gateway
-     */
-    502: HandlersErrorResponse;
-};
-
-export type GetSupermarketSkillsError = GetSupermarketSkillsErrors[keyof GetSupermarketSkillsErrors];
-
-export type GetSupermarketSkillsResponses = {
-    /**
-     * OK
-     */
-    200: HandlersSupermarketSkillListResponse;
-};
-
-export type GetSupermarketSkillsResponse = GetSupermarketSkillsResponses[keyof GetSupermarketSkillsResponses];
-
-export type GetSupermarketSkillsByIdData = {
-    body?: never;
-    path: {
-        /**
-         * Skill ID
-         */
-        id: string;
-    };
-    query?: never;
-    url: '/supermarket/skills/{id}';
-};
-
-export type GetSupermarketSkillsByIdErrors = {
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
-    /**
-     * Bad Gateway
-     */
-    502: HandlersErrorResponse;
-};
-
-export type GetSupermarketSkillsByIdError = GetSupermarketSkillsByIdErrors[keyof GetSupermarketSkillsByIdErrors];
+export type GetTranscriptionProvidersByIdModelsError = GetTranscriptionProvidersByIdModelsErrors[keyof GetTranscriptionProvidersByIdModelsErrors];
 
-export type GetSupermarketSkillsByIdResponses = {
+export type GetTranscriptionProvidersByIdModelsResponses = {
     /**
      * OK
      */
-    200: HandlersSupermarketSkillEntry;
+    200: Array<AudioTranscriptionModelResponse>;
 };
 
-export type GetSupermarketSkillsByIdResponse = GetSupermarketSkillsByIdResponses[keyof GetSupermarketSkillsByIdResponses];
+export type GetTranscriptionProvidersByIdModelsResponse = GetTranscriptionProvidersByIdModelsResponses[keyof GetTranscriptionProvidersByIdModelsResponses];
 
-export type GetSupermarketTagsData = {
+export type GetUsersData = {
     body?: never;
     path?: never;
     query?: never;
-    url: '/supermarket/tags';
+    url: '/users';
 };
 
-export type GetSupermarketTagsErrors = {
+export type GetUsersErrors = {
     /**
-     * Bad Gateway
+     * Bad Request
      */
-    502: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetSupermarketTagsError = GetSupermarketTagsErrors[keyof GetSupermarketTagsErrors];
+export type GetUsersError = GetUsersErrors[keyof GetUsersErrors];
 
-export type GetSupermarketTagsResponses = {
+export type GetUsersResponses = {
     /**
      * OK
      */
-    200: HandlersSupermarketTagsResponse;
+    200: AccountsListAccountsResponse;
 };
 
-export type GetSupermarketTagsResponse = GetSupermarketTagsResponses[keyof GetSupermarketTagsResponses];
+export type GetUsersResponse = GetUsersResponses[keyof GetUsersResponses];
 
-export type GetTranscriptionModelsData = {
-    body?: never;
+export type PostUsersData = {
+    /**
+     * User payload
+     */
+    body: AccountsCreateAccountRequest;
     path?: never;
     query?: never;
-    url: '/transcription-models';
+    url: '/users';
 };
 
-export type GetTranscriptionModelsErrors = {
+export type PostUsersErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetTranscriptionModelsError = GetTranscriptionModelsErrors[keyof GetTranscriptionModelsErrors];
+export type PostUsersError = PostUsersErrors[keyof PostUsersErrors];
 
-export type GetTranscriptionModelsResponses = {
+export type PostUsersResponses = {
     /**
-     * OK
+     * Created
      */
-    200: Array<AudioTranscriptionModelResponse>;
+    201: AccountsAccount;
 };
 
-export type GetTranscriptionModelsResponse = GetTranscriptionModelsResponses[keyof GetTranscriptionModelsResponses];
+export type PostUsersResponse = PostUsersResponses[keyof PostUsersResponses];
 
-export type GetTranscriptionModelsByIdData = {
+export type GetUsersMeData = {
     body?: never;
-    path: {
-        /**
-         * Model ID
-         */
-        id: string;
-    };
+    path?: never;
     query?: never;
-    url: '/transcription-models/{id}';
+    url: '/users/me';
 };
 
-export type GetTranscriptionModelsByIdErrors = {
+export type GetUsersMeErrors = {
     /**
-     * Not Found
+     * Bad Request
      */
-    404: HandlersErrorResponse;
+    400: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetTranscriptionModelsByIdError = GetTranscriptionModelsByIdErrors[keyof GetTranscriptionModelsByIdErrors];
+export type GetUsersMeError = GetUsersMeErrors[keyof GetUsersMeErrors];
 
-export type GetTranscriptionModelsByIdResponses = {
+export type GetUsersMeResponses = {
     /**
      * OK
      */
-    200: AudioTranscriptionModelResponse;
+    200: AccountsAccount;
 };
 
-export type GetTranscriptionModelsByIdResponse = GetTranscriptionModelsByIdResponses[keyof GetTranscriptionModelsByIdResponses];
+export type GetUsersMeResponse = GetUsersMeResponses[keyof GetUsersMeResponses];
 
-export type PutTranscriptionModelsByIdData = {
+export type PutUsersMeData = {
     /**
-     * Model update payload
+     * Profile payload
      */
-    body: AudioUpdateSpeechModelRequest;
-    path: {
-        /**
-         * Model ID
-         */
-        id: string;
-    };
+    body: AccountsUpdateProfileRequest;
+    path?: never;
     query?: never;
-    url: '/transcription-models/{id}';
+    url: '/users/me';
 };
 
-export type PutTranscriptionModelsByIdErrors = {
+export type PutUsersMeErrors = {
     /**
      * Bad Request
      */
@@ -10991,69 +14042,71 @@ export type PutTranscriptionModelsByIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PutTranscriptionModelsByIdError = PutTranscriptionModelsByIdErrors[keyof PutTranscriptionModelsByIdErrors];
+export type PutUsersMeError = PutUsersMeErrors[keyof PutUsersMeErrors];
 
-export type PutTranscriptionModelsByIdResponses = {
+export type PutUsersMeResponses = {
     /**
      * OK
      */
-    200: AudioTranscriptionModelResponse;
+    200: AccountsAccount;
 };
 
-export type PutTranscriptionModelsByIdResponse = PutTranscriptionModelsByIdResponses[keyof PutTranscriptionModelsByIdResponses];
+export type PutUsersMeResponse = PutUsersMeResponses[keyof PutUsersMeResponses];
 
-export type GetTranscriptionModelsByIdCapabilitiesData = {
+export type GetUsersMeChannelsByPlatformData = {
     body?: never;
     path: {
         /**
-         * Model ID
+         * Channel platform
          */
-        id: string;
+        platform: string;
     };
     query?: never;
-    url: '/transcription-models/{id}/capabilities';
+    url: '/users/me/channels/{platform}';
 };
 
-export type GetTranscriptionModelsByIdCapabilitiesErrors = {
+export type GetUsersMeChannelsByPlatformErrors = {
+    /**
+     * Bad Request
+     */
+    400: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetTranscriptionModelsByIdCapabilitiesError = GetTranscriptionModelsByIdCapabilitiesErrors[keyof GetTranscriptionModelsByIdCapabilitiesErrors];
+export type GetUsersMeChannelsByPlatformError = GetUsersMeChannelsByPlatformErrors[keyof GetUsersMeChannelsByPlatformErrors];
 
-export type GetTranscriptionModelsByIdCapabilitiesResponses = {
+export type GetUsersMeChannelsByPlatformResponses = {
     /**
      * OK
      */
-    200: AudioModelCapabilities;
+    200: ChannelChannelIdentityBinding;
 };
 
-export type GetTranscriptionModelsByIdCapabilitiesResponse = GetTranscriptionModelsByIdCapabilitiesResponses[keyof GetTranscriptionModelsByIdCapabilitiesResponses];
+export type GetUsersMeChannelsByPlatformResponse = GetUsersMeChannelsByPlatformResponses[keyof GetUsersMeChannelsByPlatformResponses];
 
-export type PostTranscriptionModelsByIdTestData = {
-    body: {
-        /**
-         * Audio file
-         */
-        file: Blob | File;
-        /**
-         * Optional JSON config
-         */
-        config?: string;
-    };
+export type PutUsersMeChannelsByPlatformData = {
+    /**
+     * Channel user config payload
+     */
+    body: ChannelUpsertChannelIdentityConfigRequest;
     path: {
         /**
-         * Model ID
+         * Channel platform
          */
-        id: string;
+        platform: string;
     };
     query?: never;
-    url: '/transcription-models/{id}/test';
+    url: '/users/me/channels/{platform}';
 };
 
-export type PostTranscriptionModelsByIdTestErrors = {
+export type PutUsersMeChannelsByPlatformErrors = {
     /**
      * Bad Request
      */
@@ -11064,208 +14117,183 @@ export type PostTranscriptionModelsByIdTestErrors = {
     500: HandlersErrorResponse;
 };
 
-export type PostTranscriptionModelsByIdTestError = PostTranscriptionModelsByIdTestErrors[keyof PostTranscriptionModelsByIdTestErrors];
+export type PutUsersMeChannelsByPlatformError = PutUsersMeChannelsByPlatformErrors[keyof PutUsersMeChannelsByPlatformErrors];
 
-export type PostTranscriptionModelsByIdTestResponses = {
+export type PutUsersMeChannelsByPlatformResponses = {
     /**
      * OK
      */
-    200: AudioTestTranscriptionResponse;
+    200: ChannelChannelIdentityBinding;
 };
 
-export type PostTranscriptionModelsByIdTestResponse = PostTranscriptionModelsByIdTestResponses[keyof PostTranscriptionModelsByIdTestResponses];
+export type PutUsersMeChannelsByPlatformResponse = PutUsersMeChannelsByPlatformResponses[keyof PutUsersMeChannelsByPlatformResponses];
 
-export type GetTranscriptionProvidersData = {
+export type GetUsersMeTokensData = {
     body?: never;
     path?: never;
     query?: never;
-    url: '/transcription-providers';
+    url: '/users/me/tokens';
 };
 
-export type GetTranscriptionProvidersErrors = {
+export type GetUsersMeTokensErrors = {
+    /**
+     * Unauthorized
+     */
+    401: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetTranscriptionProvidersError = GetTranscriptionProvidersErrors[keyof GetTranscriptionProvidersErrors];
+export type GetUsersMeTokensError = GetUsersMeTokensErrors[keyof GetUsersMeTokensErrors];
 
-export type GetTranscriptionProvidersResponses = {
+export type GetUsersMeTokensResponses = {
     /**
      * OK
      */
-    200: Array<AudioSpeechProviderResponse>;
+    200: AccesstokenListResponse;
 };
 
-export type GetTranscriptionProvidersResponse = GetTranscriptionProvidersResponses[keyof GetTranscriptionProvidersResponses];
-
-export type GetTranscriptionProvidersMetaData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/transcription-providers/meta';
-};
+export type GetUsersMeTokensResponse = GetUsersMeTokensResponses[keyof GetUsersMeTokensResponses];
 
-export type GetTranscriptionProvidersMetaResponses = {
+export type PostUsersMeTokensData = {
     /**
-     * OK
+     * Token payload
      */
-    200: Array<AudioProviderMetaResponse>;
-};
-
-export type GetTranscriptionProvidersMetaResponse = GetTranscriptionProvidersMetaResponses[keyof GetTranscriptionProvidersMetaResponses];
-
-export type GetTranscriptionProvidersByIdData = {
-    body?: never;
-    path: {
-        /**
-         * Provider ID (UUID)
-         */
-        id: string;
-    };
+    body: AccesstokenCreateRequest;
+    path?: never;
     query?: never;
-    url: '/transcription-providers/{id}';
+    url: '/users/me/tokens';
 };
 
-export type GetTranscriptionProvidersByIdErrors = {
+export type PostUsersMeTokensErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Not Found
      */
     404: HandlersErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: HandlersErrorResponse;
 };
 
-export type GetTranscriptionProvidersByIdError = GetTranscriptionProvidersByIdErrors[keyof GetTranscriptionProvidersByIdErrors];
+export type PostUsersMeTokensError = PostUsersMeTokensErrors[keyof PostUsersMeTokensErrors];
 
-export type GetTranscriptionProvidersByIdResponses = {
+export type PostUsersMeTokensResponses = {
     /**
-     * OK
+     * Created
      */
-    200: AudioSpeechProviderResponse;
+    201: AccesstokenCreateResponse;
 };
 
-export type GetTranscriptionProvidersByIdResponse = GetTranscriptionProvidersByIdResponses[keyof GetTranscriptionProvidersByIdResponses];
+export type PostUsersMeTokensResponse = PostUsersMeTokensResponses[keyof PostUsersMeTokensResponses];
 
-export type PostTranscriptionProvidersByIdImportModelsData = {
+export type GetUsersMeTokensScopesData = {
     body?: never;
-    path: {
-        /**
-         * Provider ID (UUID)
-         */
-        id: string;
-    };
+    path?: never;
     query?: never;
-    url: '/transcription-providers/{id}/import-models';
-};
-
-export type PostTranscriptionProvidersByIdImportModelsErrors = {
-    /**
-     * Bad Request
-     */
-    400: HandlersErrorResponse;
-    /**
-     * Not Found
-     */
-    404: HandlersErrorResponse;
-    /**
-     * Internal Server Error
-     */
-    500: HandlersErrorResponse;
+    url: '/users/me/tokens/scopes';
 };
 
-export type PostTranscriptionProvidersByIdImportModelsError = PostTranscriptionProvidersByIdImportModelsErrors[keyof PostTranscriptionProvidersByIdImportModelsErrors];
-
-export type PostTranscriptionProvidersByIdImportModelsResponses = {
+export type GetUsersMeTokensScopesResponses = {
     /**
      * OK
      */
-    200: AudioImportModelsResponse;
+    200: AccesstokenScopesResponse;
 };
 
-export type PostTranscriptionProvidersByIdImportModelsResponse = PostTranscriptionProvidersByIdImportModelsResponses[keyof PostTranscriptionProvidersByIdImportModelsResponses];
+export type GetUsersMeTokensScopesResponse = GetUsersMeTokensScopesResponses[keyof GetUsersMeTokensScopesResponses];
 
-export type GetTranscriptionProvidersByIdModelsData = {
+export type DeleteUsersMeTokensByIdData = {
     body?: never;
     path: {
         /**
-         * Provider ID (UUID)
+         * Token ID
          */
         id: string;
     };
     query?: never;
-    url: '/transcription-providers/{id}/models';
+    url: '/users/me/tokens/{id}';
 };
 
-export type GetTranscriptionProvidersByIdModelsErrors = {
+export type DeleteUsersMeTokensByIdErrors = {
     /**
-     * Bad Request
+     * Unauthorized
      */
-    400: HandlersErrorResponse;
+    401: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetTranscriptionProvidersByIdModelsError = GetTranscriptionProvidersByIdModelsErrors[keyof GetTranscriptionProvidersByIdModelsErrors];
+export type DeleteUsersMeTokensByIdError = DeleteUsersMeTokensByIdErrors[keyof DeleteUsersMeTokensByIdErrors];
 
-export type GetTranscriptionProvidersByIdModelsResponses = {
+export type DeleteUsersMeTokensByIdResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: Array<AudioTranscriptionModelResponse>;
+    204: unknown;
 };
 
-export type GetTranscriptionProvidersByIdModelsResponse = GetTranscriptionProvidersByIdModelsResponses[keyof GetTranscriptionProvidersByIdModelsResponses];
+export type DeleteUsersMeTokensByIdResponse = DeleteUsersMeTokensByIdResponses[keyof DeleteUsersMeTokensByIdResponses];
 
-export type GetUsersData = {
-    body?: never;
+export type PutUsersMePasswordData = {
+    /**
+     * Password payload
+     */
+    body: AccountsUpdatePasswordRequest;
     path?: never;
     query?: never;
-    url: '/users';
+    url: '/users/me/password';
 };
 
-export type GetUsersErrors = {
+export type PutUsersMePasswordErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
-    /**
-     * Forbidden
-     */
-    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetUsersError = GetUsersErrors[keyof GetUsersErrors];
+export type PutUsersMePasswordError = PutUsersMePasswordErrors[keyof PutUsersMePasswordErrors];
 
-export type GetUsersResponses = {
+export type PutUsersMePasswordResponses = {
     /**
-     * OK
+     * No Content
      */
-    200: AccountsListAccountsResponse;
+    204: unknown;
 };
 
-export type GetUsersResponse = GetUsersResponses[keyof GetUsersResponses];
-
-export type PostUsersData = {
-    /**
-     * User payload
-     */
-    body: AccountsCreateAccountRequest;
-    path?: never;
+export type DeleteUsersByIdData = {
+    body?: never;
+    path: {
+        /**
+         * User ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/users';
+    url: '/users/{id}';
 };
 
-export type PostUsersErrors = {
+export type DeleteUsersByIdErrors = {
     /**
      * Bad Request
      */
@@ -11274,202 +14302,257 @@ export type PostUsersErrors = {
      * Forbidden
      */
     403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PostUsersError = PostUsersErrors[keyof PostUsersErrors];
+export type DeleteUsersByIdError = DeleteUsersByIdErrors[keyof DeleteUsersByIdErrors];
 
-export type PostUsersResponses = {
+export type DeleteUsersByIdResponses = {
     /**
-     * Created
+     * No Content
      */
-    201: AccountsAccount;
+    204: unknown;
 };
 
-export type PostUsersResponse = PostUsersResponses[keyof PostUsersResponses];
-
-export type GetUsersMeData = {
+export type GetUsersByIdData = {
     body?: never;
-    path?: never;
+    path: {
+        /**
+         * User ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/users/me';
+    url: '/users/{id}';
 };
 
-export type GetUsersMeErrors = {
+export type GetUsersByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetUsersMeError = GetUsersMeErrors[keyof GetUsersMeErrors];
+export type GetUsersByIdError = GetUsersByIdErrors[keyof GetUsersByIdErrors];
 
-export type GetUsersMeResponses = {
+export type GetUsersByIdResponses = {
     /**
      * OK
      */
     200: AccountsAccount;
 };
 
-export type GetUsersMeResponse = GetUsersMeResponses[keyof GetUsersMeResponses];
+export type GetUsersByIdResponse = GetUsersByIdResponses[keyof GetUsersByIdResponses];
 
-export type PutUsersMeData = {
+export type PutUsersByIdData = {
     /**
-     * Profile payload
+     * User update payload
      */
-    body: AccountsUpdateProfileRequest;
-    path?: never;
+    body: AccountsUpdateAccountRequest;
+    path: {
+        /**
+         * User ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/users/me';
+    url: '/users/{id}';
 };
 
-export type PutUsersMeErrors = {
+export type PutUsersByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutUsersMeError = PutUsersMeErrors[keyof PutUsersMeErrors];
+export type PutUsersByIdError = PutUsersByIdErrors[keyof PutUsersByIdErrors];
 
-export type PutUsersMeResponses = {
+export type PutUsersByIdResponses = {
     /**
      * OK
      */
     200: AccountsAccount;
 };
 
-export type PutUsersMeResponse = PutUsersMeResponses[keyof PutUsersMeResponses];
+export type PutUsersByIdResponse = PutUsersByIdResponses[keyof PutUsersByIdResponses];
 
-export type GetUsersMeChannelsByPlatformData = {
+export type GetUsersByUserIdBudgetsData = {
     body?: never;
     path: {
         /**
-         * Channel platform
+         * User ID
          */
-        platform: string;
+        user_id: string;
     };
     query?: never;
-    url: '/users/me/channels/{platform}';
+    url: '/users/{user_id}/budgets';
 };
 
-export type GetUsersMeChannelsByPlatformErrors = {
+export type GetUsersByUserIdBudgetsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
     /**
-     * Not Found
+     * Forbidden
      */
-    404: HandlersErrorResponse;
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type GetUsersMeChannelsByPlatformError = GetUsersMeChannelsByPlatformErrors[keyof GetUsersMeChannelsByPlatformErrors];
+export type GetUsersByUserIdBudgetsError = GetUsersByUserIdBudgetsErrors[keyof GetUsersByUserIdBudgetsErrors];
 
-export type GetUsersMeChannelsByPlatformResponses = {
+export type GetUsersByUserIdBudgetsResponses = {
     /**
      * OK
      */
-    200: ChannelChannelIdentityBinding;
+    200: BudgetListResponse;
 };
 
-export type GetUsersMeChannelsByPlatformResponse = GetUsersMeChannelsByPlatformResponses[keyof GetUsersMeChannelsByPlatformResponses];
+export type GetUsersByUserIdBudgetsResponse = GetUsersByUserIdBudgetsResponses[keyof GetUsersByUserIdBudgetsResponses];
 
-export type PutUsersMeChannelsByPlatformData = {
+export type PostUsersByUserIdBudgetsData = {
     /**
-     * Channel user config payload
+     * Budget payload
      */
-    body: ChannelUpsertChannelIdentityConfigRequest;
+    body: BudgetCreateRequest;
     path: {
         /**
-         * Channel platform
+         * User ID
          */
-        platform: string;
+        user_id: string;
     };
     query?: never;
-    url: '/users/me/channels/{platform}';
+    url: '/users/{user_id}/budgets';
 };
 
-export type PutUsersMeChannelsByPlatformErrors = {
+export type PostUsersByUserIdBudgetsErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutUsersMeChannelsByPlatformError = PutUsersMeChannelsByPlatformErrors[keyof PutUsersMeChannelsByPlatformErrors];
+export type PostUsersByUserIdBudgetsError = PostUsersByUserIdBudgetsErrors[keyof PostUsersByUserIdBudgetsErrors];
 
-export type PutUsersMeChannelsByPlatformResponses = {
+export type PostUsersByUserIdBudgetsResponses = {
     /**
-     * OK
+     * Created
      */
-    200: ChannelChannelIdentityBinding;
+    201: BudgetStatus;
 };
 
-export type PutUsersMeChannelsByPlatformResponse = PutUsersMeChannelsByPlatformResponses[keyof PutUsersMeChannelsByPlatformResponses];
+export type PostUsersByUserIdBudgetsResponse = PostUsersByUserIdBudgetsResponses[keyof PostUsersByUserIdBudgetsResponses];
 
-export type PutUsersMePasswordData = {
+export type PutUsersByUserIdBudgetsByIdData = {
     /**
-     * Password payload
+     * Budget payload
      */
-    body: AccountsUpdatePasswordRequest;
-    path?: never;
+    body: BudgetUpdateRequest;
+    path: {
+        /**
+         * User ID
+         */
+        user_id: string;
+        /**
+         * Budget ID
+         */
+        id: string;
+    };
     query?: never;
-    url: '/users/me/password';
+    url: '/users/{user_id}/budgets/{id}';
 };
 
-export type PutUsersMePasswordErrors = {
+export type PutUsersByUserIdBudgetsByIdErrors = {
     /**
      * Bad Request
      */
     400: HandlersErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: HandlersErrorResponse;
+    /**
+     * Not Found
+     */
+    404: HandlersErrorResponse;
     /**
      * Internal Server Error
      */
     500: HandlersErrorResponse;
 };
 
-export type PutUsersMePasswordError = PutUsersMePasswordErrors[keyof PutUsersMePasswordErrors];
+export type PutUsersByUserIdBudgetsByIdError = PutUsersByUserIdBudgetsByIdErrors[keyof PutUsersByUserIdBudgetsByIdErrors];
 
-export type PutUsersMePasswordResponses = {
+export type PutUsersByUserIdBudgetsByIdResponses = {
     /**
-     * No Content
+     * OK
      */
-    204: unknown;
+    200: BudgetStatus;
 };
 
-export type DeleteUsersByIdData = {
+export type PutUsersByUserIdBudgetsByIdResponse = PutUsersByUserIdBudgetsByIdResponses[keyof PutUsersByUserIdBudgetsByIdResponses];
+
+export type DeleteUsersByUserIdBudgetsByIdData = {
     body?: never;
     path: {
         /**
          * User ID
          */
+        user_id: string;
+        /**
+         * Budget ID
+         */
         id: string;
     };
     query?: never;
-    url: '/users/{id}';
+    url: '/users/{user_id}/budgets/{id}';
 };
 
-export type DeleteUsersByIdErrors = {
+export type DeleteUsersByUserIdBudgetsByIdErrors = {
     /**
      * Bad Request
      */
@@ -11488,101 +14571,133 @@ export type DeleteUsersByIdErrors = {
     500: HandlersErrorResponse;
 };
 
-export type DeleteUsersByIdError = DeleteUsersByIdErrors[keyof DeleteUsersByIdErrors];
+export type DeleteUsersByUserIdBudgetsByIdError = DeleteUsersByUserIdBudgetsByIdErrors[keyof DeleteUsersByUserIdBudgetsByIdErrors];
 
-export type DeleteUsersByIdResponses = {
+export type DeleteUsersByUserIdBudgetsByIdResponses = {
     /**
      * No Content
      */
     204: unknown;
 };
 
-export type GetUsersByIdData = {
-    body?: never;
-    path: {
-        /**
-         * User ID
-         */
-        id: string;
-    };
+export type DeleteUsersByUserIdBudgetsByIdResponse = DeleteUsersByUserIdBudgetsByIdResponses[keyof DeleteUsersByUserIdBudgetsByIdResponses];
+
+export type PostV1ChatCompletionsData = {
+    /**
+     * Chat completion request
+     */
+    body: OpenaicompatChatCompletionRequest;
+    path?: never;
     query?: never;
-    url: '/users/{id}';
+    url: '/v1/chat/completions';
 };
 
-export type GetUsersByIdErrors = {
+export type PostV1ChatCompletionsErrors = {
     /**
      * Bad Request
      */
-    400: HandlersErrorResponse;
+    400: OpenaicompatErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: OpenaicompatErrorResponse;
     /**
      * Forbidden
      */
-    403: HandlersErrorResponse;
+    403: OpenaicompatErrorResponse;
     /**
      * Not Found
      */
-    404: HandlersErrorResponse;
+    404: OpenaicompatErrorResponse;
     /**
      * Internal Server Error
      */
-    500: HandlersErrorResponse;
+    500: OpenaicompatErrorResponse;
 };
 
-export type GetUsersByIdError = GetUsersByIdErrors[keyof GetUsersByIdErrors];
+export type PostV1ChatCompletionsError = PostV1ChatCompletionsErrors[keyof PostV1ChatCompletionsErrors];
 
-export type GetUsersByIdResponses = {
+export type PostV1ChatCompletionsResponses = {
     /**
      * OK
      */
-    200: AccountsAccount;
+    200: OpenaicompatChatCompletion;
 };
 
-export type GetUsersByIdResponse = GetUsersByIdResponses[keyof GetUsersByIdResponses];
+export type PostV1ChatCompletionsResponse = PostV1ChatCompletionsResponses[keyof PostV1ChatCompletionsResponses];
 
-export type PutUsersByIdData = {
+export type GetV1ModelsData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/v1/models';
+};
+
+export type GetV1ModelsErrors = {
     /**
-     * User update payload
+     * Unauthorized
      */
-    body: AccountsUpdateAccountRequest;
-    path: {
-        /**
-         * User ID
-         */
-        id: string;
-    };
+    401: OpenaicompatErrorResponse;
+    /**
+     * Internal Server Error
+     */
+    500: OpenaicompatErrorResponse;
+};
+
+export type GetV1ModelsError = GetV1ModelsErrors[keyof GetV1ModelsErrors];
+
+export type GetV1ModelsResponses = {
+    /**
+     * OK
+     */
+    200: OpenaicompatModelList;
+};
+
+export type GetV1ModelsResponse = GetV1ModelsResponses[keyof GetV1ModelsResponses];
+
+export type PostV1ResponsesData = {
+    /**
+     * Response request
+     */
+    body: OpenaicompatResponseRequest;
+    path?: never;
     query?: never;
-    url: '/users/{id}';
+    url: '/v1/responses';
 };
 
-export type PutUsersByIdErrors = {
+export type PostV1ResponsesErrors = {
     /**
      * Bad Request
      */
-    400: HandlersErrorResponse;
+    400: OpenaicompatErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: OpenaicompatErrorResponse;
     /**
      * Forbidden
      */
-    403: HandlersErrorResponse;
+    403: OpenaicompatErrorResponse;
     /**
      * Not Found
      */
-    404: HandlersErrorResponse;
+    404: OpenaicompatErrorResponse;
     /**
      * Internal Server Error
      */
-    500: HandlersErrorResponse;
+    500: OpenaicompatErrorResponse;
 };
 
-export type PutUsersByIdError = PutUsersByIdErrors[keyof PutUsersByIdErrors];
+export type PostV1ResponsesError = PostV1ResponsesErrors[keyof PostV1ResponsesErrors];
 
-export type PutUsersByIdResponses = {
+export type PostV1ResponsesResponses = {
     /**
      * OK
      */
-    200: AccountsAccount;
+    200: OpenaicompatResponse;
 };
 
-export type PutUsersByIdResponse = PutUsersByIdResponses[keyof PutUsersByIdResponses];
+export type PostV1ResponsesResponse = PostV1ResponsesResponses[keyof PostV1ResponsesResponses];
 
 export type PutUsersByIdPasswordData = {
     /**